@@ -0,0 +1,88 @@
+package client
+
+import (
+	"context"
+
+	pb "github.com/textileio/textile/api/admin/pb"
+	"google.golang.org/grpc"
+)
+
+// Client provides the client api.
+// Contexts must carry the admin token, see common.NewAdminTokenContext.
+type Client struct {
+	c    pb.APIClient
+	conn *grpc.ClientConn
+}
+
+// NewClient starts the client.
+func NewClient(target string, opts ...grpc.DialOption) (*Client, error) {
+	conn, err := grpc.Dial(target, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		c:    pb.NewAPIClient(conn),
+		conn: conn,
+	}, nil
+}
+
+// Close closes the client's grpc connection and cancels any active requests.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// ListMigrations returns all known database migrations and whether or not they've been applied.
+func (c *Client) ListMigrations(ctx context.Context) (*pb.ListMigrationsReply, error) {
+	return c.c.ListMigrations(ctx, &pb.ListMigrationsRequest{})
+}
+
+// RunMigrations applies all pending database migrations.
+func (c *Client) RunMigrations(ctx context.Context) (*pb.RunMigrationsReply, error) {
+	return c.c.RunMigrations(ctx, &pb.RunMigrationsRequest{})
+}
+
+// RebuildUsage recalculates the buckets total size of the account with username, or of all accounts if username is empty.
+// If dryRun is true, the results are returned without being saved.
+func (c *Client) RebuildUsage(ctx context.Context, username string, dryRun bool) (*pb.RebuildUsageReply, error) {
+	return c.c.RebuildUsage(ctx, &pb.RebuildUsageRequest{
+		Username: username,
+		DryRun:   dryRun,
+	})
+}
+
+// AuditPins checks that the buckets of the account with username, or of all accounts if username is empty, are pinned.
+// If repair is true, missing public bucket pins are added.
+func (c *Client) AuditPins(ctx context.Context, username string, repair bool) (*pb.AuditPinsReply, error) {
+	return c.c.AuditPins(ctx, &pb.AuditPinsRequest{
+		Username: username,
+		Repair:   repair,
+	})
+}
+
+// ListLargestAccounts returns up to limit accounts with the largest buckets total size.
+func (c *Client) ListLargestAccounts(ctx context.Context, limit int64) (*pb.ListLargestAccountsReply, error) {
+	return c.c.ListLargestAccounts(ctx, &pb.ListLargestAccountsRequest{
+		Limit: limit,
+	})
+}
+
+// SetBucketQuota sets the max size of a bucket owned by the account with username.
+// A max size of zero removes the quota.
+func (c *Client) SetBucketQuota(ctx context.Context, username, threadID, key string, maxSize int64) error {
+	_, err := c.c.SetBucketQuota(ctx, &pb.SetBucketQuotaRequest{
+		Username: username,
+		Thread:   threadID,
+		Key:      key,
+		MaxSize:  maxSize,
+	})
+	return err
+}
+
+// RotateToken replaces the admin token, returning the new token.
+func (c *Client) RotateToken(ctx context.Context) (string, error) {
+	res, err := c.c.RotateToken(ctx, &pb.RotateTokenRequest{})
+	if err != nil {
+		return "", err
+	}
+	return res.Token, nil
+}
@@ -0,0 +1,120 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tutil "github.com/textileio/go-threads/util"
+	c "github.com/textileio/textile/api/admin/client"
+	"github.com/textileio/textile/api/apitest"
+	"github.com/textileio/textile/api/common"
+	"github.com/textileio/textile/core"
+	"google.golang.org/grpc"
+)
+
+const adminToken = "hubadmin"
+
+func TestClient_Unauthorized(t *testing.T) {
+	t.Parallel()
+	client := setup(t)
+
+	_, err := client.ListMigrations(context.Background())
+	require.Error(t, err)
+	_, err = client.ListMigrations(common.NewAdminTokenContext(context.Background(), "bad"))
+	require.Error(t, err)
+}
+
+func TestClient_Migrations(t *testing.T) {
+	t.Parallel()
+	client := setup(t)
+	ctx := common.NewAdminTokenContext(context.Background(), adminToken)
+
+	list, err := client.ListMigrations(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, list.Migrations)
+
+	_, err = client.RunMigrations(ctx)
+	require.NoError(t, err)
+	res, err := client.RunMigrations(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, res.Applied)
+
+	list, err = client.ListMigrations(ctx)
+	require.NoError(t, err)
+	for _, m := range list.Migrations {
+		assert.True(t, m.Applied)
+	}
+}
+
+func TestClient_Usage(t *testing.T) {
+	t.Parallel()
+	client := setup(t)
+	ctx := common.NewAdminTokenContext(context.Background(), adminToken)
+
+	_, err := client.RebuildUsage(ctx, "", true)
+	require.NoError(t, err)
+	_, err = client.RebuildUsage(ctx, "notfound", false)
+	require.Error(t, err)
+
+	audit, err := client.AuditPins(ctx, "", false)
+	require.NoError(t, err)
+	assert.Empty(t, audit.Unpinned)
+
+	_, err = client.ListLargestAccounts(ctx, 10)
+	require.NoError(t, err)
+
+	err = client.SetBucketQuota(ctx, "notfound", "", "", 1024)
+	require.Error(t, err)
+	err = client.SetBucketQuota(ctx, "", "", "", -1)
+	require.Error(t, err)
+}
+
+func TestClient_RotateToken(t *testing.T) {
+	t.Parallel()
+	conf := apitest.DefaultTextileConfig(t)
+	conf.AdminToken = adminToken
+	client := setupWithConf(t, conf)
+	ctx := common.NewAdminTokenContext(context.Background(), adminToken)
+
+	token, err := client.RotateToken(ctx)
+	require.NoError(t, err)
+	assert.NotEqual(t, adminToken, token)
+
+	_, err = client.ListMigrations(ctx)
+	require.Error(t, err)
+	_, err = client.ListMigrations(common.NewAdminTokenContext(context.Background(), token))
+	require.NoError(t, err)
+
+	// Another hub with the same database, or the same hub after a restart, uses the rotated token.
+	other := apitest.DefaultTextileConfig(t)
+	other.MongoName = conf.MongoName
+	other.AdminToken = adminToken
+	otherClient := setupWithConf(t, other)
+	_, err = otherClient.ListMigrations(ctx)
+	require.Error(t, err)
+	_, err = otherClient.ListMigrations(common.NewAdminTokenContext(context.Background(), token))
+	require.NoError(t, err)
+}
+
+func setup(t *testing.T) *c.Client {
+	conf := apitest.DefaultTextileConfig(t)
+	conf.AdminToken = adminToken
+	return setupWithConf(t, conf)
+}
+
+func setupWithConf(t *testing.T, conf core.Config) *c.Client {
+	apitest.MakeTextileWithConfig(t, conf, true)
+	target, err := tutil.TCPAddrFromMultiAddr(conf.AddrAPI)
+	require.NoError(t, err)
+	opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithPerRPCCredentials(common.Credentials{})}
+	client, err := c.NewClient(target, opts...)
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		err := client.Close()
+		require.NoError(t, err)
+	})
+	return client
+}
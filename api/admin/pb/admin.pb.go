@@ -0,0 +1,1209 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: admin.proto
+
+package admin_pb
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type ListMigrationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMigrationsRequest) Reset()         { *m = ListMigrationsRequest{} }
+func (m *ListMigrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListMigrationsRequest) ProtoMessage()    {}
+func (*ListMigrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{0}
+}
+
+func (m *ListMigrationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMigrationsRequest.Unmarshal(m, b)
+}
+func (m *ListMigrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMigrationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListMigrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMigrationsRequest.Merge(m, src)
+}
+func (m *ListMigrationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListMigrationsRequest.Size(m)
+}
+func (m *ListMigrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMigrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMigrationsRequest proto.InternalMessageInfo
+
+type ListMigrationsReply struct {
+	Migrations           []*ListMigrationsReply_Migration `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ListMigrationsReply) Reset()         { *m = ListMigrationsReply{} }
+func (m *ListMigrationsReply) String() string { return proto.CompactTextString(m) }
+func (*ListMigrationsReply) ProtoMessage()    {}
+func (*ListMigrationsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{1}
+}
+
+func (m *ListMigrationsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMigrationsReply.Unmarshal(m, b)
+}
+func (m *ListMigrationsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMigrationsReply.Marshal(b, m, deterministic)
+}
+func (m *ListMigrationsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMigrationsReply.Merge(m, src)
+}
+func (m *ListMigrationsReply) XXX_Size() int {
+	return xxx_messageInfo_ListMigrationsReply.Size(m)
+}
+func (m *ListMigrationsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMigrationsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMigrationsReply proto.InternalMessageInfo
+
+func (m *ListMigrationsReply) GetMigrations() []*ListMigrationsReply_Migration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
+type ListMigrationsReply_Migration struct {
+	Version              int64    `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Applied              bool     `protobuf:"varint,3,opt,name=applied,proto3" json:"applied,omitempty"`
+	AppliedAt            int64    `protobuf:"varint,4,opt,name=appliedAt,proto3" json:"appliedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListMigrationsReply_Migration) Reset()         { *m = ListMigrationsReply_Migration{} }
+func (m *ListMigrationsReply_Migration) String() string { return proto.CompactTextString(m) }
+func (*ListMigrationsReply_Migration) ProtoMessage()    {}
+func (*ListMigrationsReply_Migration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{1, 0}
+}
+
+func (m *ListMigrationsReply_Migration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListMigrationsReply_Migration.Unmarshal(m, b)
+}
+func (m *ListMigrationsReply_Migration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListMigrationsReply_Migration.Marshal(b, m, deterministic)
+}
+func (m *ListMigrationsReply_Migration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListMigrationsReply_Migration.Merge(m, src)
+}
+func (m *ListMigrationsReply_Migration) XXX_Size() int {
+	return xxx_messageInfo_ListMigrationsReply_Migration.Size(m)
+}
+func (m *ListMigrationsReply_Migration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListMigrationsReply_Migration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListMigrationsReply_Migration proto.InternalMessageInfo
+
+func (m *ListMigrationsReply_Migration) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ListMigrationsReply_Migration) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListMigrationsReply_Migration) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+func (m *ListMigrationsReply_Migration) GetAppliedAt() int64 {
+	if m != nil {
+		return m.AppliedAt
+	}
+	return 0
+}
+
+type RunMigrationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RunMigrationsRequest) Reset()         { *m = RunMigrationsRequest{} }
+func (m *RunMigrationsRequest) String() string { return proto.CompactTextString(m) }
+func (*RunMigrationsRequest) ProtoMessage()    {}
+func (*RunMigrationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{2}
+}
+
+func (m *RunMigrationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunMigrationsRequest.Unmarshal(m, b)
+}
+func (m *RunMigrationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunMigrationsRequest.Marshal(b, m, deterministic)
+}
+func (m *RunMigrationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunMigrationsRequest.Merge(m, src)
+}
+func (m *RunMigrationsRequest) XXX_Size() int {
+	return xxx_messageInfo_RunMigrationsRequest.Size(m)
+}
+func (m *RunMigrationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunMigrationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunMigrationsRequest proto.InternalMessageInfo
+
+type RunMigrationsReply struct {
+	Applied              []string `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RunMigrationsReply) Reset()         { *m = RunMigrationsReply{} }
+func (m *RunMigrationsReply) String() string { return proto.CompactTextString(m) }
+func (*RunMigrationsReply) ProtoMessage()    {}
+func (*RunMigrationsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{3}
+}
+
+func (m *RunMigrationsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RunMigrationsReply.Unmarshal(m, b)
+}
+func (m *RunMigrationsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RunMigrationsReply.Marshal(b, m, deterministic)
+}
+func (m *RunMigrationsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RunMigrationsReply.Merge(m, src)
+}
+func (m *RunMigrationsReply) XXX_Size() int {
+	return xxx_messageInfo_RunMigrationsReply.Size(m)
+}
+func (m *RunMigrationsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RunMigrationsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RunMigrationsReply proto.InternalMessageInfo
+
+func (m *RunMigrationsReply) GetApplied() []string {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+type RebuildUsageRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	DryRun               bool     `protobuf:"varint,2,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebuildUsageRequest) Reset()         { *m = RebuildUsageRequest{} }
+func (m *RebuildUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*RebuildUsageRequest) ProtoMessage()    {}
+func (*RebuildUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{4}
+}
+
+func (m *RebuildUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebuildUsageRequest.Unmarshal(m, b)
+}
+func (m *RebuildUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebuildUsageRequest.Marshal(b, m, deterministic)
+}
+func (m *RebuildUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildUsageRequest.Merge(m, src)
+}
+func (m *RebuildUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_RebuildUsageRequest.Size(m)
+}
+func (m *RebuildUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildUsageRequest proto.InternalMessageInfo
+
+func (m *RebuildUsageRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RebuildUsageRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type RebuildUsageReply struct {
+	Accounts             []*RebuildUsageReply_Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *RebuildUsageReply) Reset()         { *m = RebuildUsageReply{} }
+func (m *RebuildUsageReply) String() string { return proto.CompactTextString(m) }
+func (*RebuildUsageReply) ProtoMessage()    {}
+func (*RebuildUsageReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{5}
+}
+
+func (m *RebuildUsageReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebuildUsageReply.Unmarshal(m, b)
+}
+func (m *RebuildUsageReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebuildUsageReply.Marshal(b, m, deterministic)
+}
+func (m *RebuildUsageReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildUsageReply.Merge(m, src)
+}
+func (m *RebuildUsageReply) XXX_Size() int {
+	return xxx_messageInfo_RebuildUsageReply.Size(m)
+}
+func (m *RebuildUsageReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildUsageReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildUsageReply proto.InternalMessageInfo
+
+func (m *RebuildUsageReply) GetAccounts() []*RebuildUsageReply_Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type RebuildUsageReply_Account struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	PreviousSize         int64    `protobuf:"varint,2,opt,name=previousSize,proto3" json:"previousSize,omitempty"`
+	Size                 int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RebuildUsageReply_Account) Reset()         { *m = RebuildUsageReply_Account{} }
+func (m *RebuildUsageReply_Account) String() string { return proto.CompactTextString(m) }
+func (*RebuildUsageReply_Account) ProtoMessage()    {}
+func (*RebuildUsageReply_Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{5, 0}
+}
+
+func (m *RebuildUsageReply_Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RebuildUsageReply_Account.Unmarshal(m, b)
+}
+func (m *RebuildUsageReply_Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RebuildUsageReply_Account.Marshal(b, m, deterministic)
+}
+func (m *RebuildUsageReply_Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RebuildUsageReply_Account.Merge(m, src)
+}
+func (m *RebuildUsageReply_Account) XXX_Size() int {
+	return xxx_messageInfo_RebuildUsageReply_Account.Size(m)
+}
+func (m *RebuildUsageReply_Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_RebuildUsageReply_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RebuildUsageReply_Account proto.InternalMessageInfo
+
+func (m *RebuildUsageReply_Account) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *RebuildUsageReply_Account) GetPreviousSize() int64 {
+	if m != nil {
+		return m.PreviousSize
+	}
+	return 0
+}
+
+func (m *RebuildUsageReply_Account) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type AuditPinsRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Repair               bool     `protobuf:"varint,2,opt,name=repair,proto3" json:"repair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditPinsRequest) Reset()         { *m = AuditPinsRequest{} }
+func (m *AuditPinsRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditPinsRequest) ProtoMessage()    {}
+func (*AuditPinsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{6}
+}
+
+func (m *AuditPinsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditPinsRequest.Unmarshal(m, b)
+}
+func (m *AuditPinsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditPinsRequest.Marshal(b, m, deterministic)
+}
+func (m *AuditPinsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditPinsRequest.Merge(m, src)
+}
+func (m *AuditPinsRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditPinsRequest.Size(m)
+}
+func (m *AuditPinsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditPinsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditPinsRequest proto.InternalMessageInfo
+
+func (m *AuditPinsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *AuditPinsRequest) GetRepair() bool {
+	if m != nil {
+		return m.Repair
+	}
+	return false
+}
+
+type AuditPinsReply struct {
+	Checked              int64                    `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Unpinned             []*AuditPinsReply_Bucket `protobuf:"bytes,2,rep,name=unpinned,proto3" json:"unpinned,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *AuditPinsReply) Reset()         { *m = AuditPinsReply{} }
+func (m *AuditPinsReply) String() string { return proto.CompactTextString(m) }
+func (*AuditPinsReply) ProtoMessage()    {}
+func (*AuditPinsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{7}
+}
+
+func (m *AuditPinsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditPinsReply.Unmarshal(m, b)
+}
+func (m *AuditPinsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditPinsReply.Marshal(b, m, deterministic)
+}
+func (m *AuditPinsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditPinsReply.Merge(m, src)
+}
+func (m *AuditPinsReply) XXX_Size() int {
+	return xxx_messageInfo_AuditPinsReply.Size(m)
+}
+func (m *AuditPinsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditPinsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditPinsReply proto.InternalMessageInfo
+
+func (m *AuditPinsReply) GetChecked() int64 {
+	if m != nil {
+		return m.Checked
+	}
+	return 0
+}
+
+func (m *AuditPinsReply) GetUnpinned() []*AuditPinsReply_Bucket {
+	if m != nil {
+		return m.Unpinned
+	}
+	return nil
+}
+
+type AuditPinsReply_Bucket struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Thread               string   `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Key                  string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Path                 string   `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Private              bool     `protobuf:"varint,5,opt,name=private,proto3" json:"private,omitempty"`
+	Repaired             bool     `protobuf:"varint,6,opt,name=repaired,proto3" json:"repaired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditPinsReply_Bucket) Reset()         { *m = AuditPinsReply_Bucket{} }
+func (m *AuditPinsReply_Bucket) String() string { return proto.CompactTextString(m) }
+func (*AuditPinsReply_Bucket) ProtoMessage()    {}
+func (*AuditPinsReply_Bucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{7, 0}
+}
+
+func (m *AuditPinsReply_Bucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditPinsReply_Bucket.Unmarshal(m, b)
+}
+func (m *AuditPinsReply_Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditPinsReply_Bucket.Marshal(b, m, deterministic)
+}
+func (m *AuditPinsReply_Bucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditPinsReply_Bucket.Merge(m, src)
+}
+func (m *AuditPinsReply_Bucket) XXX_Size() int {
+	return xxx_messageInfo_AuditPinsReply_Bucket.Size(m)
+}
+func (m *AuditPinsReply_Bucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditPinsReply_Bucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditPinsReply_Bucket proto.InternalMessageInfo
+
+func (m *AuditPinsReply_Bucket) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *AuditPinsReply_Bucket) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *AuditPinsReply_Bucket) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AuditPinsReply_Bucket) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *AuditPinsReply_Bucket) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *AuditPinsReply_Bucket) GetRepaired() bool {
+	if m != nil {
+		return m.Repaired
+	}
+	return false
+}
+
+type ListLargestAccountsRequest struct {
+	Limit                int64    `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLargestAccountsRequest) Reset()         { *m = ListLargestAccountsRequest{} }
+func (m *ListLargestAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLargestAccountsRequest) ProtoMessage()    {}
+func (*ListLargestAccountsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{8}
+}
+
+func (m *ListLargestAccountsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLargestAccountsRequest.Unmarshal(m, b)
+}
+func (m *ListLargestAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLargestAccountsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListLargestAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLargestAccountsRequest.Merge(m, src)
+}
+func (m *ListLargestAccountsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLargestAccountsRequest.Size(m)
+}
+func (m *ListLargestAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLargestAccountsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLargestAccountsRequest proto.InternalMessageInfo
+
+func (m *ListLargestAccountsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListLargestAccountsReply struct {
+	Accounts             []*ListLargestAccountsReply_Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *ListLargestAccountsReply) Reset()         { *m = ListLargestAccountsReply{} }
+func (m *ListLargestAccountsReply) String() string { return proto.CompactTextString(m) }
+func (*ListLargestAccountsReply) ProtoMessage()    {}
+func (*ListLargestAccountsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{9}
+}
+
+func (m *ListLargestAccountsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLargestAccountsReply.Unmarshal(m, b)
+}
+func (m *ListLargestAccountsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLargestAccountsReply.Marshal(b, m, deterministic)
+}
+func (m *ListLargestAccountsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLargestAccountsReply.Merge(m, src)
+}
+func (m *ListLargestAccountsReply) XXX_Size() int {
+	return xxx_messageInfo_ListLargestAccountsReply.Size(m)
+}
+func (m *ListLargestAccountsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLargestAccountsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLargestAccountsReply proto.InternalMessageInfo
+
+func (m *ListLargestAccountsReply) GetAccounts() []*ListLargestAccountsReply_Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type ListLargestAccountsReply_Account struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	BucketsTotalSize     int64    `protobuf:"varint,3,opt,name=bucketsTotalSize,proto3" json:"bucketsTotalSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLargestAccountsReply_Account) Reset()         { *m = ListLargestAccountsReply_Account{} }
+func (m *ListLargestAccountsReply_Account) String() string { return proto.CompactTextString(m) }
+func (*ListLargestAccountsReply_Account) ProtoMessage()    {}
+func (*ListLargestAccountsReply_Account) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{9, 0}
+}
+
+func (m *ListLargestAccountsReply_Account) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLargestAccountsReply_Account.Unmarshal(m, b)
+}
+func (m *ListLargestAccountsReply_Account) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLargestAccountsReply_Account.Marshal(b, m, deterministic)
+}
+func (m *ListLargestAccountsReply_Account) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLargestAccountsReply_Account.Merge(m, src)
+}
+func (m *ListLargestAccountsReply_Account) XXX_Size() int {
+	return xxx_messageInfo_ListLargestAccountsReply_Account.Size(m)
+}
+func (m *ListLargestAccountsReply_Account) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLargestAccountsReply_Account.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLargestAccountsReply_Account proto.InternalMessageInfo
+
+func (m *ListLargestAccountsReply_Account) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ListLargestAccountsReply_Account) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ListLargestAccountsReply_Account) GetBucketsTotalSize() int64 {
+	if m != nil {
+		return m.BucketsTotalSize
+	}
+	return 0
+}
+
+type SetBucketQuotaRequest struct {
+	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Thread               string   `protobuf:"bytes,2,opt,name=thread,proto3" json:"thread,omitempty"`
+	Key                  string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	MaxSize              int64    `protobuf:"varint,4,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketQuotaRequest) Reset()         { *m = SetBucketQuotaRequest{} }
+func (m *SetBucketQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SetBucketQuotaRequest) ProtoMessage()    {}
+func (*SetBucketQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{10}
+}
+
+func (m *SetBucketQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketQuotaRequest.Unmarshal(m, b)
+}
+func (m *SetBucketQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *SetBucketQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketQuotaRequest.Merge(m, src)
+}
+func (m *SetBucketQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_SetBucketQuotaRequest.Size(m)
+}
+func (m *SetBucketQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketQuotaRequest proto.InternalMessageInfo
+
+func (m *SetBucketQuotaRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *SetBucketQuotaRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *SetBucketQuotaRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetBucketQuotaRequest) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+type SetBucketQuotaReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetBucketQuotaReply) Reset()         { *m = SetBucketQuotaReply{} }
+func (m *SetBucketQuotaReply) String() string { return proto.CompactTextString(m) }
+func (*SetBucketQuotaReply) ProtoMessage()    {}
+func (*SetBucketQuotaReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{11}
+}
+
+func (m *SetBucketQuotaReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetBucketQuotaReply.Unmarshal(m, b)
+}
+func (m *SetBucketQuotaReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetBucketQuotaReply.Marshal(b, m, deterministic)
+}
+func (m *SetBucketQuotaReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetBucketQuotaReply.Merge(m, src)
+}
+func (m *SetBucketQuotaReply) XXX_Size() int {
+	return xxx_messageInfo_SetBucketQuotaReply.Size(m)
+}
+func (m *SetBucketQuotaReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetBucketQuotaReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetBucketQuotaReply proto.InternalMessageInfo
+
+type RotateTokenRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateTokenRequest) Reset()         { *m = RotateTokenRequest{} }
+func (m *RotateTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RotateTokenRequest) ProtoMessage()    {}
+func (*RotateTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{12}
+}
+
+func (m *RotateTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateTokenRequest.Unmarshal(m, b)
+}
+func (m *RotateTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RotateTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateTokenRequest.Merge(m, src)
+}
+func (m *RotateTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RotateTokenRequest.Size(m)
+}
+func (m *RotateTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateTokenRequest proto.InternalMessageInfo
+
+type RotateTokenReply struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RotateTokenReply) Reset()         { *m = RotateTokenReply{} }
+func (m *RotateTokenReply) String() string { return proto.CompactTextString(m) }
+func (*RotateTokenReply) ProtoMessage()    {}
+func (*RotateTokenReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_73a7fc70dcc2027c, []int{13}
+}
+
+func (m *RotateTokenReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateTokenReply.Unmarshal(m, b)
+}
+func (m *RotateTokenReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateTokenReply.Marshal(b, m, deterministic)
+}
+func (m *RotateTokenReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateTokenReply.Merge(m, src)
+}
+func (m *RotateTokenReply) XXX_Size() int {
+	return xxx_messageInfo_RotateTokenReply.Size(m)
+}
+func (m *RotateTokenReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateTokenReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RotateTokenReply proto.InternalMessageInfo
+
+func (m *RotateTokenReply) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ListMigrationsRequest)(nil), "admin.pb.ListMigrationsRequest")
+	proto.RegisterType((*ListMigrationsReply)(nil), "admin.pb.ListMigrationsReply")
+	proto.RegisterType((*ListMigrationsReply_Migration)(nil), "admin.pb.ListMigrationsReply.Migration")
+	proto.RegisterType((*RunMigrationsRequest)(nil), "admin.pb.RunMigrationsRequest")
+	proto.RegisterType((*RunMigrationsReply)(nil), "admin.pb.RunMigrationsReply")
+	proto.RegisterType((*RebuildUsageRequest)(nil), "admin.pb.RebuildUsageRequest")
+	proto.RegisterType((*RebuildUsageReply)(nil), "admin.pb.RebuildUsageReply")
+	proto.RegisterType((*RebuildUsageReply_Account)(nil), "admin.pb.RebuildUsageReply.Account")
+	proto.RegisterType((*AuditPinsRequest)(nil), "admin.pb.AuditPinsRequest")
+	proto.RegisterType((*AuditPinsReply)(nil), "admin.pb.AuditPinsReply")
+	proto.RegisterType((*AuditPinsReply_Bucket)(nil), "admin.pb.AuditPinsReply.Bucket")
+	proto.RegisterType((*ListLargestAccountsRequest)(nil), "admin.pb.ListLargestAccountsRequest")
+	proto.RegisterType((*ListLargestAccountsReply)(nil), "admin.pb.ListLargestAccountsReply")
+	proto.RegisterType((*ListLargestAccountsReply_Account)(nil), "admin.pb.ListLargestAccountsReply.Account")
+	proto.RegisterType((*SetBucketQuotaRequest)(nil), "admin.pb.SetBucketQuotaRequest")
+	proto.RegisterType((*SetBucketQuotaReply)(nil), "admin.pb.SetBucketQuotaReply")
+	proto.RegisterType((*RotateTokenRequest)(nil), "admin.pb.RotateTokenRequest")
+	proto.RegisterType((*RotateTokenReply)(nil), "admin.pb.RotateTokenReply")
+}
+
+func init() { proto.RegisterFile("admin.proto", fileDescriptor_73a7fc70dcc2027c) }
+
+var fileDescriptor_73a7fc70dcc2027c = []byte{
+	// 748 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xc1, 0x6e, 0xda, 0x4c,
+	0x10, 0xc6, 0x40, 0x08, 0x4c, 0xf2, 0x47, 0xfc, 0x0b, 0xa4, 0x96, 0x9b, 0x34, 0x68, 0x5b, 0xa9,
+	0x28, 0x07, 0x1f, 0xe8, 0xb1, 0x87, 0x8a, 0xb4, 0x4a, 0x85, 0x94, 0xb4, 0xe9, 0x86, 0x1e, 0xab,
+	0x68, 0xc1, 0x2b, 0xb2, 0x02, 0x6c, 0xc7, 0x5e, 0xa7, 0xa1, 0xc7, 0x3e, 0x45, 0xcf, 0x7d, 0x85,
+	0x3e, 0x40, 0xcf, 0x3d, 0xf6, 0x8d, 0xaa, 0xdd, 0x35, 0xc6, 0x0e, 0x26, 0xe4, 0xb6, 0xdf, 0x8c,
+	0x67, 0x76, 0xe6, 0x9b, 0x6f, 0xc7, 0xb0, 0x43, 0x9d, 0x19, 0x77, 0x6d, 0x3f, 0xf0, 0x84, 0x87,
+	0xaa, 0x31, 0x18, 0xe2, 0x27, 0xd0, 0x3a, 0xe3, 0xa1, 0x38, 0xe7, 0xe3, 0x80, 0x0a, 0xee, 0xb9,
+	0x21, 0x61, 0x37, 0x11, 0x0b, 0x05, 0xfe, 0x6b, 0x40, 0xe3, 0xbe, 0xc7, 0x9f, 0xce, 0xd1, 0x7b,
+	0x80, 0x59, 0x62, 0x32, 0x8d, 0x76, 0xa9, 0xb3, 0xd3, 0x7d, 0x69, 0x2f, 0xf2, 0xd9, 0x39, 0x21,
+	0x76, 0x82, 0x49, 0x2a, 0xd4, 0xba, 0x81, 0x5a, 0xe2, 0x40, 0x26, 0x6c, 0xdf, 0xb2, 0x20, 0xe4,
+	0x9e, 0x6b, 0x1a, 0x6d, 0xa3, 0x53, 0x22, 0x0b, 0x88, 0x10, 0x94, 0x5d, 0x3a, 0x63, 0x66, 0xb1,
+	0x6d, 0x74, 0x6a, 0x44, 0x9d, 0xe5, 0xd7, 0xd4, 0xf7, 0xa7, 0x9c, 0x39, 0x66, 0xa9, 0x6d, 0x74,
+	0xaa, 0x64, 0x01, 0xd1, 0x01, 0xd4, 0xe2, 0x63, 0x4f, 0x98, 0x65, 0x95, 0x69, 0x69, 0xc0, 0xfb,
+	0xd0, 0x24, 0x91, 0xbb, 0xda, 0xab, 0x0d, 0xe8, 0x9e, 0x5d, 0x76, 0x9a, 0xba, 0x45, 0xb6, 0x59,
+	0x4b, 0x6e, 0xc1, 0x7d, 0x68, 0x10, 0x36, 0x8c, 0xf8, 0xd4, 0xf9, 0x1c, 0xd2, 0x31, 0x8b, 0xd3,
+	0x20, 0x0b, 0xaa, 0x51, 0xc8, 0x02, 0x55, 0xae, 0xa1, 0xca, 0x4d, 0x30, 0xda, 0x87, 0x8a, 0x13,
+	0xcc, 0x49, 0xe4, 0xaa, 0x46, 0xaa, 0x24, 0x46, 0xf8, 0x97, 0x01, 0xff, 0x67, 0x73, 0xc9, 0xab,
+	0xdf, 0x40, 0x95, 0x8e, 0x46, 0x5e, 0xe4, 0x8a, 0x05, 0xc5, 0xcf, 0x97, 0x14, 0xaf, 0x7c, 0x6e,
+	0xf7, 0xf4, 0xb7, 0x24, 0x09, 0xb2, 0xbe, 0xc0, 0x76, 0x6c, 0x7c, 0xb0, 0x2a, 0x0c, 0xbb, 0x7e,
+	0xc0, 0x6e, 0xb9, 0x17, 0x85, 0x97, 0xfc, 0x9b, 0x26, 0xb9, 0x44, 0x32, 0x36, 0x39, 0x80, 0x50,
+	0xfa, 0x4a, 0xca, 0xa7, 0xce, 0xf8, 0x14, 0xea, 0xbd, 0xc8, 0xe1, 0xe2, 0x82, 0x27, 0x24, 0x6e,
+	0xea, 0x3e, 0x60, 0x3e, 0xe5, 0xc1, 0xa2, 0x7b, 0x8d, 0xf0, 0xf7, 0x22, 0xec, 0xa5, 0x12, 0xc5,
+	0xac, 0x8f, 0xae, 0xd9, 0x68, 0xa2, 0x58, 0x57, 0x4a, 0x88, 0x21, 0x7a, 0x0d, 0xd5, 0xc8, 0xf5,
+	0xb9, 0xeb, 0x32, 0xc7, 0x2c, 0x2a, 0x52, 0x8e, 0x96, 0xa4, 0x64, 0xb3, 0xd8, 0x27, 0xd1, 0x68,
+	0xc2, 0x04, 0x49, 0x02, 0xac, 0x1f, 0x06, 0x54, 0xb4, 0x71, 0x53, 0xa1, 0xe2, 0x3a, 0x60, 0xd4,
+	0x89, 0xf5, 0x16, 0x23, 0x54, 0x87, 0xd2, 0x84, 0xcd, 0x15, 0x07, 0x35, 0x22, 0x8f, 0x92, 0x16,
+	0x9f, 0x8a, 0x6b, 0x25, 0xb2, 0x1a, 0x51, 0x67, 0x59, 0xbb, 0x1f, 0xf0, 0x5b, 0x2a, 0x98, 0xb9,
+	0xa5, 0x75, 0x19, 0x43, 0x79, 0xa7, 0x6e, 0x99, 0x39, 0x66, 0x45, 0xb9, 0x12, 0x8c, 0xbb, 0x60,
+	0xc9, 0x57, 0x73, 0x46, 0x83, 0x31, 0x0b, 0x45, 0x3c, 0xb6, 0x84, 0xd6, 0x26, 0x6c, 0x4d, 0xf9,
+	0x8c, 0x8b, 0x98, 0x0d, 0x0d, 0xf0, 0x1f, 0x03, 0xcc, 0xdc, 0x20, 0x49, 0xe1, 0xe9, 0x8a, 0x7a,
+	0x8e, 0xb3, 0x0f, 0x34, 0x2f, 0x2a, 0x47, 0x44, 0xec, 0x71, 0x22, 0x42, 0x50, 0x16, 0x73, 0x3f,
+	0x79, 0xa1, 0xf2, 0x8c, 0x8e, 0xa1, 0x3e, 0x54, 0x6c, 0x87, 0x03, 0x4f, 0xd0, 0xe9, 0xe5, 0x52,
+	0x40, 0x2b, 0x76, 0xfc, 0x15, 0x5a, 0x97, 0x4c, 0xe8, 0xe1, 0x7c, 0x8a, 0x3c, 0x41, 0x1f, 0xa9,
+	0xa8, 0x47, 0x0e, 0xca, 0x84, 0xed, 0x19, 0xbd, 0x53, 0x15, 0xe8, 0x85, 0xb0, 0x80, 0xb8, 0x05,
+	0x8d, 0xfb, 0x17, 0xfb, 0xd3, 0x39, 0x6e, 0x02, 0x22, 0x9e, 0xa0, 0x82, 0x0d, 0xbc, 0x09, 0x73,
+	0x17, 0x3b, 0xa2, 0x03, 0xf5, 0x8c, 0x55, 0x12, 0xdd, 0x84, 0x2d, 0x21, 0x51, 0x5c, 0x9d, 0x06,
+	0xdd, 0xdf, 0x65, 0x28, 0xf5, 0x2e, 0xfa, 0x88, 0xc0, 0x5e, 0x76, 0x1b, 0xa2, 0xa3, 0xf5, 0x7b,
+	0x52, 0x5d, 0x62, 0x1d, 0x3e, 0xb8, 0x48, 0x71, 0x01, 0x7d, 0x84, 0xff, 0x32, 0x9b, 0x0a, 0x3d,
+	0x5b, 0x46, 0xe4, 0xad, 0x36, 0xeb, 0x60, 0xad, 0x5f, 0x27, 0x3c, 0x83, 0xdd, 0xf4, 0x3e, 0x41,
+	0x87, 0xeb, 0xf6, 0x8c, 0x4e, 0xf7, 0xf4, 0x81, 0x35, 0x84, 0x0b, 0xe8, 0x2d, 0xd4, 0x92, 0x87,
+	0x88, 0xac, 0xdc, 0xd7, 0xa9, 0xf3, 0x98, 0xeb, 0x5e, 0x2e, 0x2e, 0x20, 0x0a, 0x8d, 0x1c, 0x91,
+	0xa2, 0x17, 0x1b, 0x34, 0xac, 0x13, 0xe3, 0xcd, 0x4a, 0xc7, 0x05, 0x39, 0x9a, 0xec, 0xe4, 0xd3,
+	0xa3, 0xc9, 0x15, 0xa3, 0x75, 0xb8, 0xfe, 0x03, 0x9d, 0xb3, 0x0f, 0x3b, 0x29, 0x81, 0xa0, 0x34,
+	0xf1, 0x2b, 0x6a, 0xb2, 0xac, 0x35, 0x5e, 0x95, 0xea, 0xa4, 0x0b, 0x2d, 0xee, 0xd9, 0x82, 0xdd,
+	0x09, 0x3e, 0x65, 0xfa, 0xcb, 0xab, 0x71, 0xe0, 0x8f, 0x4e, 0x76, 0x07, 0xda, 0xd6, 0x93, 0xa6,
+	0x0b, 0xe3, 0x67, 0xb1, 0x3a, 0x18, 0x5c, 0xf5, 0xde, 0x9d, 0xf7, 0x3f, 0x0c, 0x2b, 0xea, 0xcf,
+	0xfe, 0xea, 0xdf, 0x00, 0x02, 0x01, 0xed, 0xfa, 0xe8, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	ListMigrations(ctx context.Context, in *ListMigrationsRequest, opts ...grpc.CallOption) (*ListMigrationsReply, error)
+	RunMigrations(ctx context.Context, in *RunMigrationsRequest, opts ...grpc.CallOption) (*RunMigrationsReply, error)
+	RebuildUsage(ctx context.Context, in *RebuildUsageRequest, opts ...grpc.CallOption) (*RebuildUsageReply, error)
+	AuditPins(ctx context.Context, in *AuditPinsRequest, opts ...grpc.CallOption) (*AuditPinsReply, error)
+	ListLargestAccounts(ctx context.Context, in *ListLargestAccountsRequest, opts ...grpc.CallOption) (*ListLargestAccountsReply, error)
+	SetBucketQuota(ctx context.Context, in *SetBucketQuotaRequest, opts ...grpc.CallOption) (*SetBucketQuotaReply, error)
+	RotateToken(ctx context.Context, in *RotateTokenRequest, opts ...grpc.CallOption) (*RotateTokenReply, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) ListMigrations(ctx context.Context, in *ListMigrationsRequest, opts ...grpc.CallOption) (*ListMigrationsReply, error) {
+	out := new(ListMigrationsReply)
+	err := c.cc.Invoke(ctx, "/admin.pb.API/ListMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RunMigrations(ctx context.Context, in *RunMigrationsRequest, opts ...grpc.CallOption) (*RunMigrationsReply, error) {
+	out := new(RunMigrationsReply)
+	err := c.cc.Invoke(ctx, "/admin.pb.API/RunMigrations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RebuildUsage(ctx context.Context, in *RebuildUsageRequest, opts ...grpc.CallOption) (*RebuildUsageReply, error) {
+	out := new(RebuildUsageReply)
+	err := c.cc.Invoke(ctx, "/admin.pb.API/RebuildUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) AuditPins(ctx context.Context, in *AuditPinsRequest, opts ...grpc.CallOption) (*AuditPinsReply, error) {
+	out := new(AuditPinsReply)
+	err := c.cc.Invoke(ctx, "/admin.pb.API/AuditPins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListLargestAccounts(ctx context.Context, in *ListLargestAccountsRequest, opts ...grpc.CallOption) (*ListLargestAccountsReply, error) {
+	out := new(ListLargestAccountsReply)
+	err := c.cc.Invoke(ctx, "/admin.pb.API/ListLargestAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetBucketQuota(ctx context.Context, in *SetBucketQuotaRequest, opts ...grpc.CallOption) (*SetBucketQuotaReply, error) {
+	out := new(SetBucketQuotaReply)
+	err := c.cc.Invoke(ctx, "/admin.pb.API/SetBucketQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RotateToken(ctx context.Context, in *RotateTokenRequest, opts ...grpc.CallOption) (*RotateTokenReply, error) {
+	out := new(RotateTokenReply)
+	err := c.cc.Invoke(ctx, "/admin.pb.API/RotateToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// APIServer is the server API for API service.
+type APIServer interface {
+	ListMigrations(context.Context, *ListMigrationsRequest) (*ListMigrationsReply, error)
+	RunMigrations(context.Context, *RunMigrationsRequest) (*RunMigrationsReply, error)
+	RebuildUsage(context.Context, *RebuildUsageRequest) (*RebuildUsageReply, error)
+	AuditPins(context.Context, *AuditPinsRequest) (*AuditPinsReply, error)
+	ListLargestAccounts(context.Context, *ListLargestAccountsRequest) (*ListLargestAccountsReply, error)
+	SetBucketQuota(context.Context, *SetBucketQuotaRequest) (*SetBucketQuotaReply, error)
+	RotateToken(context.Context, *RotateTokenRequest) (*RotateTokenReply, error)
+}
+
+// UnimplementedAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
+}
+
+func (*UnimplementedAPIServer) ListMigrations(ctx context.Context, req *ListMigrationsRequest) (*ListMigrationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMigrations not implemented")
+}
+func (*UnimplementedAPIServer) RunMigrations(ctx context.Context, req *RunMigrationsRequest) (*RunMigrationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunMigrations not implemented")
+}
+func (*UnimplementedAPIServer) RebuildUsage(ctx context.Context, req *RebuildUsageRequest) (*RebuildUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildUsage not implemented")
+}
+func (*UnimplementedAPIServer) AuditPins(ctx context.Context, req *AuditPinsRequest) (*AuditPinsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditPins not implemented")
+}
+func (*UnimplementedAPIServer) ListLargestAccounts(ctx context.Context, req *ListLargestAccountsRequest) (*ListLargestAccountsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLargestAccounts not implemented")
+}
+func (*UnimplementedAPIServer) SetBucketQuota(ctx context.Context, req *SetBucketQuotaRequest) (*SetBucketQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBucketQuota not implemented")
+}
+func (*UnimplementedAPIServer) RotateToken(ctx context.Context, req *RotateTokenRequest) (*RotateTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateToken not implemented")
+}
+
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
+}
+
+func _API_ListMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.pb.API/ListMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListMigrations(ctx, req.(*ListMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RunMigrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunMigrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RunMigrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.pb.API/RunMigrations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RunMigrations(ctx, req.(*RunMigrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RebuildUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RebuildUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.pb.API/RebuildUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RebuildUsage(ctx, req.(*RebuildUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_AuditPins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditPinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).AuditPins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.pb.API/AuditPins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AuditPins(ctx, req.(*AuditPinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListLargestAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLargestAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListLargestAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.pb.API/ListLargestAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListLargestAccounts(ctx, req.(*ListLargestAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetBucketQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBucketQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetBucketQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.pb.API/SetBucketQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetBucketQuota(ctx, req.(*SetBucketQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RotateToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RotateToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/admin.pb.API/RotateToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RotateToken(ctx, req.(*RotateTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _API_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "admin.pb.API",
+	HandlerType: (*APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListMigrations",
+			Handler:    _API_ListMigrations_Handler,
+		},
+		{
+			MethodName: "RunMigrations",
+			Handler:    _API_RunMigrations_Handler,
+		},
+		{
+			MethodName: "RebuildUsage",
+			Handler:    _API_RebuildUsage_Handler,
+		},
+		{
+			MethodName: "AuditPins",
+			Handler:    _API_AuditPins_Handler,
+		},
+		{
+			MethodName: "ListLargestAccounts",
+			Handler:    _API_ListLargestAccounts_Handler,
+		},
+		{
+			MethodName: "SetBucketQuota",
+			Handler:    _API_SetBucketQuota_Handler,
+		},
+		{
+			MethodName: "RotateToken",
+			Handler:    _API_RotateToken_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "admin.proto",
+}
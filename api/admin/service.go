@@ -0,0 +1,360 @@
+package admin
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	logging "github.com/ipfs/go-log"
+	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/options"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	threads "github.com/textileio/go-threads/api/client"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/go-threads/db"
+	pb "github.com/textileio/textile/api/admin/pb"
+	"github.com/textileio/textile/buckets"
+	mdb "github.com/textileio/textile/mongodb"
+	tdb "github.com/textileio/textile/threaddb"
+	"github.com/textileio/textile/util"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	log = logging.Logger("adminapi")
+
+	// tokenLen is the length of rotated admin tokens.
+	tokenLen = 44
+)
+
+// Service provides deployment operators with administration tasks.
+// Requests are authorized with a shared admin token, see ValidToken.
+type Service struct {
+	Collections *mdb.Collections
+	Threads     *threads.Client
+	Buckets     *tdb.Buckets
+	IPFSClient  iface.CoreAPI
+
+	// token is the configured admin token, which is used until the token is rotated.
+	token string
+}
+
+// NewService returns a new admin service that accepts token.
+func NewService(collections *mdb.Collections, threads *threads.Client, bucks *tdb.Buckets, ipfs iface.CoreAPI, token string) *Service {
+	return &Service{
+		Collections: collections,
+		Threads:     threads,
+		Buckets:     bucks,
+		IPFSClient:  ipfs,
+		token:       token,
+	}
+}
+
+// ValidToken returns whether or not token is the current admin token.
+// Once the token has been rotated, the rotated token is checked instead of the configured token.
+func (s *Service) ValidToken(ctx context.Context, token string) bool {
+	if token == "" {
+		return false
+	}
+	rotated, err := s.Collections.AdminTokens.Get(ctx)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return s.token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) == 1
+	} else if err != nil {
+		log.Errorf("getting admin token: %v", err)
+		return false
+	}
+	hash := sha256.Sum256([]byte(token))
+	return subtle.ConstantTimeCompare(hash[:], rotated.Hash) == 1
+}
+
+func (s *Service) ListMigrations(ctx context.Context, _ *pb.ListMigrationsRequest) (*pb.ListMigrationsReply, error) {
+	log.Debugf("received list migrations request")
+
+	list, err := s.Collections.Migrations.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListMigrationsReply{}
+	for _, m := range list {
+		pm := &pb.ListMigrationsReply_Migration{
+			Version: m.Version,
+			Name:    m.Name,
+			Applied: m.Applied,
+		}
+		if m.Applied {
+			pm.AppliedAt = m.AppliedAt.Unix()
+		}
+		reply.Migrations = append(reply.Migrations, pm)
+	}
+	return reply, nil
+}
+
+func (s *Service) RunMigrations(ctx context.Context, _ *pb.RunMigrationsRequest) (*pb.RunMigrationsReply, error) {
+	log.Debugf("received run migrations request")
+
+	ran, err := s.Collections.Migrations.Run(ctx)
+	reply := &pb.RunMigrationsReply{}
+	for _, m := range ran {
+		reply.Applied = append(reply.Applied, m.Name)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Migration failed after applying %d: %v", len(ran), err)
+	}
+	return reply, nil
+}
+
+// RebuildUsage recalculates the buckets total size of accounts from their buckets and snapshots.
+// Deduplicated content is only counted once.
+func (s *Service) RebuildUsage(ctx context.Context, req *pb.RebuildUsageRequest) (*pb.RebuildUsageReply, error) {
+	log.Debugf("received rebuild usage request")
+
+	accounts, err := s.accounts(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.RebuildUsageReply{}
+	for _, a := range accounts {
+		size, err := s.accountSize(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		if !req.DryRun && size != a.BucketsTotalSize {
+			if err := s.Collections.Accounts.SetBucketsTotalSize(ctx, a.Key, size); err != nil {
+				return nil, err
+			}
+		}
+		reply.Accounts = append(reply.Accounts, &pb.RebuildUsageReply_Account{
+			Username:     a.Username,
+			PreviousSize: a.BucketsTotalSize,
+			Size:         size,
+		})
+	}
+	return reply, nil
+}
+
+// AuditPins checks that the root of every bucket is pinned.
+// If repair is true, missing public bucket pins are added.
+// Encrypted bucket branches can only be repaired by the buckets API.
+func (s *Service) AuditPins(ctx context.Context, req *pb.AuditPinsRequest) (*pb.AuditPinsReply, error) {
+	log.Debugf("received audit pins request")
+
+	accounts, err := s.accounts(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	pins, err := s.IPFSClient.Pin().Ls(ctx, options.Pin.Type.All())
+	if err != nil {
+		return nil, err
+	}
+	pinned := make(map[cid.Cid]struct{}, len(pins))
+	for _, p := range pins {
+		pinned[p.Path().Cid()] = struct{}{}
+	}
+
+	reply := &pb.AuditPinsReply{}
+	for _, a := range accounts {
+		bucks, err := s.buckets(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+		for id, list := range bucks {
+			for _, b := range list {
+				reply.Checked++
+				root, err := util.NewResolvedPath(b.Path)
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := pinned[root.Cid()]; ok {
+					continue
+				}
+				ub := &pb.AuditPinsReply_Bucket{
+					Username: a.Username,
+					Thread:   id,
+					Key:      b.Key,
+					Path:     b.Path,
+					Private:  b.GetEncKey() != nil,
+				}
+				if req.Repair && !ub.Private {
+					if err := s.IPFSClient.Pin().Add(ctx, root); err != nil {
+						return nil, err
+					}
+					ub.Repaired = true
+				}
+				reply.Unpinned = append(reply.Unpinned, ub)
+			}
+		}
+	}
+	return reply, nil
+}
+
+func (s *Service) ListLargestAccounts(ctx context.Context, req *pb.ListLargestAccountsRequest) (*pb.ListLargestAccountsReply, error) {
+	log.Debugf("received list largest accounts request")
+
+	accounts, err := s.Collections.Accounts.ListBySize(ctx, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListLargestAccountsReply{}
+	for _, a := range accounts {
+		typ := "dev"
+		if a.Type == mdb.Org {
+			typ = "org"
+		}
+		reply.Accounts = append(reply.Accounts, &pb.ListLargestAccountsReply_Account{
+			Username:         a.Username,
+			Type:             typ,
+			BucketsTotalSize: a.BucketsTotalSize,
+		})
+	}
+	return reply, nil
+}
+
+// SetBucketQuota sets the max size of a bucket owned by an account.
+// Owners can change the quota with the buckets API.
+func (s *Service) SetBucketQuota(ctx context.Context, req *pb.SetBucketQuotaRequest) (*pb.SetBucketQuotaReply, error) {
+	log.Debugf("received set bucket quota request")
+
+	if req.MaxSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Max size must not be negative")
+	}
+	accounts, err := s.accounts(ctx, req.Username)
+	if err != nil {
+		return nil, err
+	}
+	a := accounts[0]
+	ts, err := s.threadsForOwner(ctx, a.Key)
+	if err != nil {
+		return nil, err
+	}
+	var dbID thread.ID
+	for _, t := range ts {
+		if t.ID.String() == req.Thread {
+			dbID = t.ID
+			break
+		}
+	}
+	if !dbID.Defined() {
+		return nil, status.Error(codes.NotFound, "Thread not found")
+	}
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(a.Token)); err != nil {
+		return nil, status.Error(codes.NotFound, "Bucket not found")
+	}
+	buck.MaxSize = req.MaxSize
+	buck.UpdatedAt = time.Now().UnixNano()
+	if err := s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(a.Token)); err != nil {
+		return nil, err
+	}
+	log.Infof("set max size of bucket %s to %d", buck.Key, buck.MaxSize)
+	return &pb.SetBucketQuotaReply{}, nil
+}
+
+// RotateToken replaces the admin token with a new random token.
+// The old token stops working immediately on all hub instances, and the configured token is
+// no longer accepted. Only the hash of the new token is stored.
+// Other deployment secrets are not rotated; they are changed through the hub's config.
+func (s *Service) RotateToken(ctx context.Context, _ *pb.RotateTokenRequest) (*pb.RotateTokenReply, error) {
+	log.Debugf("received rotate token request")
+
+	token := util.MakeToken(tokenLen)
+	hash := sha256.Sum256([]byte(token))
+	if err := s.Collections.AdminTokens.Set(ctx, hash[:]); err != nil {
+		return nil, err
+	}
+
+	log.Info("admin token was rotated")
+	return &pb.RotateTokenReply{Token: token}, nil
+}
+
+// accounts returns the account with username, or all accounts if username is empty.
+func (s *Service) accounts(ctx context.Context, username string) ([]mdb.Account, error) {
+	if username == "" {
+		return s.Collections.Accounts.List(ctx)
+	}
+	a, err := s.Collections.Accounts.GetByUsername(ctx, username)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, status.Error(codes.NotFound, "Account not found")
+	} else if err != nil {
+		return nil, err
+	}
+	return []mdb.Account{*a}, nil
+}
+
+// accountSize returns the storage used by the buckets and snapshots of a.
+func (s *Service) accountSize(ctx context.Context, a mdb.Account) (int64, error) {
+	bucks, err := s.buckets(ctx, a)
+	if err != nil {
+		return 0, err
+	}
+	var size int64
+	for _, list := range bucks {
+		for _, b := range list {
+			stat, err := s.IPFSClient.Object().Stat(ctx, path.New(b.Path))
+			if err != nil {
+				return 0, err
+			}
+			size += int64(stat.CumulativeSize)
+			snapshots, err := s.Collections.BucketSnapshots.List(ctx, b.Key)
+			if err != nil {
+				return 0, err
+			}
+			for _, snapshot := range snapshots {
+				size += snapshot.Size
+			}
+		}
+	}
+	stats, err := s.Collections.ContentRefs.Stats(ctx, a.Key)
+	if err != nil {
+		return 0, err
+	}
+	if size -= stats.SavedSize(); size < 0 {
+		size = 0
+	}
+	return size, nil
+}
+
+// buckets returns the buckets of a, keyed by thread ID.
+func (s *Service) buckets(ctx context.Context, a mdb.Account) (map[string][]*tdb.Bucket, error) {
+	ts, err := s.threadsForOwner(ctx, a.Key)
+	if err != nil {
+		return nil, err
+	}
+	bucks := make(map[string][]*tdb.Bucket)
+	for _, t := range ts {
+		if !t.IsDB {
+			continue
+		}
+		res, err := s.Threads.Find(ctx, t.ID, buckets.CollectionName, &db.Query{}, &tdb.Bucket{}, db.WithTxnToken(a.Token))
+		if err != nil {
+			return nil, err
+		}
+		bucks[t.ID.String()] = res.([]*tdb.Bucket)
+	}
+	return bucks, nil
+}
+
+// threadsForOwner returns threads owned directly or via an API key.
+func (s *Service) threadsForOwner(ctx context.Context, owner crypto.PubKey) ([]mdb.Thread, error) {
+	ts, err := s.Collections.Threads.ListByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.Collections.APIKeys.ListByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		kts, err := s.Collections.Threads.ListByKey(ctx, k.Key)
+		if err != nil {
+			return nil, err
+		}
+		ts = append(ts, kts...)
+	}
+	return ts, nil
+}
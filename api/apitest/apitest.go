@@ -56,6 +56,18 @@ func DefaultTextileConfig(t util.TestingTWithCleanup) core.Config {
 
 		EmailSessionSecret: SessionSecret,
 
+		BucketsArchiveSchedules: true,
+		BucketsArchiveRenewals:  true,
+		BucketsLifecycles:       true,
+		BucketsReplication:      true,
+		BucketsImports:          true,
+		BucketsPinMirrors:       true,
+		BucketsDomains:          true,
+		BucketsWebhooks:         true,
+		BucketsSearchIndex:      true,
+
+		BucketsAllowPrivateEndpoints: true,
+
 		Hub:   true,
 		Debug: true,
 	}
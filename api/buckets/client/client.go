@@ -4,21 +4,32 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"sync"
+	"time"
 
 	"github.com/gogo/status"
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/textileio/go-threads/core/thread"
 	pb "github.com/textileio/textile/api/buckets/pb"
+	"github.com/textileio/textile/buckets"
 	"github.com/textileio/textile/util"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
 )
 
 const (
 	// chunkSize for add file requests.
 	chunkSize = 1024
+	// defaultPushConcurrency is the default number of files sent at the same time by PushPaths.
+	defaultPushConcurrency = 8
 )
 
+// ErrNotModified indicates a file was not pulled because its ETag matched WithIfNoneMatch.
+var ErrNotModified = fmt.Errorf("not modified")
+
 // Client provides the client api.
 type Client struct {
 	c    pb.APIClient
@@ -26,6 +37,7 @@ type Client struct {
 }
 
 // NewClient starts the client.
+// Use common.WithRetry and common.WithTimeout to retry transient failures and set default call timeouts.
 func NewClient(target string, opts ...grpc.DialOption) (*Client, error) {
 	conn, err := grpc.Dial(target, opts...)
 	if err != nil {
@@ -56,6 +68,7 @@ func (c *Client) Init(ctx context.Context, opts ...InitOption) (*pb.InitReply, e
 	return c.c.Init(ctx, &pb.InitRequest{
 		Name:         args.name,
 		Private:      args.private,
+		Key:          args.key,
 		BootstrapCid: strCid,
 	})
 }
@@ -68,15 +81,39 @@ func (c *Client) Root(ctx context.Context, key string) (*pb.RootReply, error) {
 }
 
 // Links returns a list of links that can be used to view the bucket.
-func (c *Client) Links(ctx context.Context, key string) (*pb.LinksReply, error) {
-	return c.c.Links(ctx, &pb.LinksRequest{
+// Use WithGateway to point the links at the lowest latency gateway.
+func (c *Client) Links(ctx context.Context, key string, opts ...Option) (*pb.LinksReply, error) {
+	args := &options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	links, err := c.c.Links(ctx, &pb.LinksRequest{
 		Key: key,
 	})
+	if err != nil {
+		return nil, err
+	}
+	if args.gateway != nil {
+		gateway, err := args.gateway.Resolve(ctx)
+		if err != nil {
+			return nil, err
+		}
+		links.URL = rewrite(links.URL, gateway)
+		links.IPNS = rewrite(links.IPNS, gateway)
+	}
+	return links, nil
 }
 
 // List returns a list of all bucket roots.
-func (c *Client) List(ctx context.Context) (*pb.ListReply, error) {
-	return c.c.List(ctx, &pb.ListRequest{})
+// Use WithTagFilter to only list buckets with matching tags.
+func (c *Client) List(ctx context.Context, opts ...ListOption) (*pb.ListReply, error) {
+	args := &listOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	return c.c.List(ctx, &pb.ListRequest{
+		Tags: args.tags,
+	})
 }
 
 // ListIpfsPath returns items at a particular path in a UnixFS path living in the IPFS network.
@@ -85,22 +122,130 @@ func (c *Client) ListIpfsPath(ctx context.Context, pth path.Path) (*pb.ListIpfsP
 }
 
 // ListPath returns information about a bucket path.
-func (c *Client) ListPath(ctx context.Context, key, pth string) (*pb.ListPathReply, error) {
+// Large directories can be listed in pages with WithListLimit and WithListCursor.
+// Use WithListRecursive and WithListGlob to list a subtree in one request.
+func (c *Client) ListPath(ctx context.Context, key, pth string, opts ...ListPathOption) (*pb.ListPathReply, error) {
+	args := &listPathOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var root string
+	if args.root != nil {
+		root = args.root.String()
+	}
 	return c.c.ListPath(ctx, &pb.ListPathRequest{
+		Key:       key,
+		Path:      pth,
+		Limit:     args.limit,
+		Cursor:    args.cursor,
+		Recursive: args.recursive,
+		MaxDepth:  args.maxDepth,
+		Glob:      args.glob,
+		Root:      root,
+	})
+}
+
+// SearchPath returns bucket items whose name or metadata match query.
+// Results come from an index that is updated shortly after each bucket change.
+// The reply's Pending field is true if the index is being updated.
+func (c *Client) SearchPath(ctx context.Context, key, query string, opts ...SearchOption) (*pb.SearchPathReply, error) {
+	args := &searchOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	return c.c.SearchPath(ctx, &pb.SearchPathRequest{
+		Key:   key,
+		Query: query,
+		Mode:  args.mode,
+		Path:  args.path,
+		Limit: args.limit,
+	})
+}
+
+// GetBlock returns the raw data of a block in the bucket DAG.
+// Use WithBlockPath to limit the search to part of the bucket.
+func (c *Client) GetBlock(ctx context.Context, key string, bc cid.Cid, opts ...BlockOption) ([]byte, error) {
+	args := &blockOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	res, err := c.c.GetBlock(ctx, &pb.GetBlockRequest{
 		Key:  key,
-		Path: pth,
+		Cid:  bc.String(),
+		Path: args.path,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// HasBlock returns whether or not a block is in the bucket DAG.
+// Use WithBlockPath to limit the search to part of the bucket.
+func (c *Client) HasBlock(ctx context.Context, key string, bc cid.Cid, opts ...BlockOption) (bool, error) {
+	args := &blockOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	res, err := c.c.HasBlock(ctx, &pb.HasBlockRequest{
+		Key:  key,
+		Cid:  bc.String(),
+		Path: args.path,
+	})
+	if err != nil {
+		return false, err
+	}
+	return res.Has, nil
+}
+
+// PutBlock adds a block, counting it against the bucket's size quotas.
+// The block is only retained once it's linked into the bucket, e.g., with SetPath.
+// Use WithBlockFormat to set the block format and WithBlockCid to verify the resulting cid.
+func (c *Client) PutBlock(ctx context.Context, key string, data []byte, opts ...BlockOption) (cid.Cid, error) {
+	args := &blockOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xc string
+	if args.cid.Defined() {
+		xc = args.cid.String()
+	}
+	res, err := c.c.PutBlock(ctx, &pb.PutBlockRequest{
+		Key:    key,
+		Data:   data,
+		Format: args.format,
+		Cid:    xc,
 	})
+	if err != nil {
+		return cid.Undef, err
+	}
+	return cid.Decode(res.Cid)
 }
 
 // SetPath set a particular path to an existing IPFS UnixFS DAG.
-func (c *Client) SetPath(ctx context.Context, key, pth string, remoteCid cid.Cid) (*pb.SetPathReply, error) {
+func (c *Client) SetPath(ctx context.Context, key, pth string, remoteCid cid.Cid, opts ...Option) (*pb.SetPathReply, error) {
+	args := &options{}
+	for _, opt := range opts {
+		opt(args)
+	}
 	return c.c.SetPath(ctx, &pb.SetPathRequest{
-		Key:  key,
-		Path: pth,
-		Cid:  remoteCid.String(),
+		Key:     key,
+		Path:    pth,
+		Cid:     remoteCid.String(),
+		Message: args.message,
 	})
 }
 
+// PushProgress describes the progress of a file pushed with PushPath.
+type PushProgress struct {
+	// Stage is the current stage of the push.
+	Stage pb.PushPathReply_Event_Stage
+	// Received is the number of bytes received by the remote.
+	Received int64
+	// Added is the number of bytes added to IPFS by the remote.
+	Added int64
+}
+
 type pushPathResult struct {
 	path path.Resolved
 	root path.Resolved
@@ -117,6 +262,9 @@ func (c *Client) PushPath(ctx context.Context, key, pth string, reader io.Reader
 	if args.progress != nil {
 		defer close(args.progress)
 	}
+	if args.pushProgress != nil {
+		defer close(args.pushProgress)
+	}
 
 	stream, err := c.c.PushPath(ctx)
 	if err != nil {
@@ -129,9 +277,14 @@ func (c *Client) PushPath(ctx context.Context, key, pth string, reader io.Reader
 	if err = stream.Send(&pb.PushPathRequest{
 		Payload: &pb.PushPathRequest_Header_{
 			Header: &pb.PushPathRequest_Header{
-				Key:  key,
-				Path: pth,
-				Root: xr,
+				Key:           key,
+				Path:          pth,
+				Root:          xr,
+				Message:       args.message,
+				ContentType:   args.contentType,
+				Attributes:    args.attributes,
+				Compress:      args.compress,
+				Deterministic: args.deterministic,
 			},
 		},
 	}); err != nil {
@@ -162,12 +315,30 @@ func (c *Client) PushPath(ctx context.Context, key, pth string, reader io.Reader
 						waitCh <- pushPathResult{err: err}
 						return
 					}
+					if args.conflictPath != nil {
+						*args.conflictPath = payload.Event.ConflictPath
+					}
+					if args.contentRoot != nil && payload.Event.ContentRoot != "" {
+						if *args.contentRoot, err = parseContentRoot(payload.Event.ContentRoot); err != nil {
+							waitCh <- pushPathResult{err: err}
+							return
+						}
+					}
 					waitCh <- pushPathResult{
 						path: path.IpfsPath(id),
 						root: r,
 					}
-				} else if args.progress != nil {
-					args.progress <- payload.Event.Bytes
+				} else {
+					if args.progress != nil {
+						args.progress <- payload.Event.Bytes
+					}
+					if args.pushProgress != nil {
+						args.pushProgress <- PushProgress{
+							Stage:    payload.Event.Stage,
+							Received: payload.Event.Received,
+							Added:    payload.Event.Bytes,
+						}
+					}
 				}
 			case *pb.PushPathReply_Error:
 				waitCh <- pushPathResult{err: fmt.Errorf(payload.Error)}
@@ -209,7 +380,371 @@ func (c *Client) PushPath(ctx context.Context, key, pth string, reader io.Reader
 	return res.path, res.root, res.err
 }
 
+// PushPathsFile is a file to be pushed with PushPaths.
+type PushPathsFile struct {
+	// Path is the destination path in the bucket.
+	Path string
+	// Open returns a reader for the file's content.
+	Open func() (io.ReadCloser, error)
+	// Attributes are merged into the file's metadata attributes. An empty value removes an attribute.
+	Attributes map[string]string
+}
+
+// PushPaths pushes many files to a bucket in a single stream.
+// Files are sent in parallel, see WithConcurrency, and are committed to the bucket as a single update.
+// Progress updates report the total number of bytes sent.
+// Results are keyed by the path each file was written to, which differs from its requested path
+// if the bucket kept it next to a conflicting change.
+func (c *Client) PushPaths(ctx context.Context, key string, files []PushPathsFile, opts ...Option) (results map[string]path.Resolved, root path.Resolved, err error) {
+	args := &options{concurrency: defaultPushConcurrency}
+	for _, opt := range opts {
+		opt(args)
+	}
+	if args.progress != nil {
+		defer close(args.progress)
+	}
+	if args.concurrency < 1 {
+		args.concurrency = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := c.c.PushPaths(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	var xr string
+	if args.root != nil {
+		xr = args.root.String()
+	}
+	if err = stream.Send(&pb.PushPathsRequest{
+		Payload: &pb.PushPathsRequest_Header_{
+			Header: &pb.PushPathsRequest_Header{
+				Key:           key,
+				Root:          xr,
+				Message:       args.message,
+				Deterministic: args.deterministic,
+			},
+		},
+	}); err != nil {
+		return nil, nil, err
+	}
+
+	type pushPathsResult struct {
+		results map[string]path.Resolved
+		root    path.Resolved
+		err     error
+	}
+	waitCh := make(chan pushPathsResult, 1)
+	go func() {
+		res := pushPathsResult{results: make(map[string]path.Resolved)}
+		defer func() {
+			waitCh <- res
+		}()
+		for {
+			rep, err := stream.Recv()
+			if err == io.EOF {
+				if res.root == nil {
+					res.err = fmt.Errorf("push paths stream ended without a root")
+				}
+				return
+			} else if err != nil {
+				res.err = err
+				return
+			}
+			if rep.Root != nil {
+				res.root, res.err = util.NewResolvedPath(rep.Root.Path)
+				if res.err != nil {
+					return
+				}
+				if args.contentRoot != nil && rep.ContentRoot != "" {
+					if *args.contentRoot, res.err = parseContentRoot(rep.ContentRoot); res.err != nil {
+						return
+					}
+				}
+				continue
+			}
+			id, err := cid.Parse(rep.Cid)
+			if err != nil {
+				res.err = err
+				return
+			}
+			if rep.ConflictPath != "" {
+				res.results[rep.ConflictPath] = path.IpfsPath(id)
+			} else {
+				res.results[rep.Path] = path.IpfsPath(id)
+			}
+		}
+	}()
+
+	var (
+		lk    sync.Mutex
+		sent  int64
+		wg    sync.WaitGroup
+		errCh = make(chan error, len(files))
+		queue = make(chan PushPathsFile)
+	)
+	send := func(chunk *pb.PushPathsRequest_Chunk) error {
+		lk.Lock()
+		defer lk.Unlock()
+		if err := stream.Send(&pb.PushPathsRequest{
+			Payload: &pb.PushPathsRequest_Chunk_{
+				Chunk: chunk,
+			},
+		}); err != nil {
+			return err
+		}
+		sent += int64(len(chunk.Data))
+		if args.progress != nil && len(chunk.Data) > 0 {
+			args.progress <- sent
+		}
+		return nil
+	}
+	pushFile := func(f PushPathsFile) error {
+		reader, err := f.Open()
+		if err != nil {
+			return err
+		}
+		defer reader.Close()
+		// Attributes only need to be sent with the first chunk of a file
+		attrs := f.Attributes
+		buf := make([]byte, chunkSize)
+		for {
+			n, err := reader.Read(buf)
+			if n > 0 {
+				if err := send(&pb.PushPathsRequest_Chunk{
+					Path:       f.Path,
+					Data:       append([]byte(nil), buf[:n]...),
+					Attributes: attrs,
+				}); err != nil {
+					return err
+				}
+				attrs = nil
+			}
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return err
+			}
+		}
+		return send(&pb.PushPathsRequest_Chunk{
+			Path:       f.Path,
+			Eof:        true,
+			Attributes: attrs,
+		})
+	}
+	for i := 0; i < args.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for f := range queue {
+				if err := pushFile(f); err != nil {
+					errCh <- err
+					return
+				}
+			}
+		}()
+	}
+	var sendErr error
+loop:
+	for _, f := range files {
+		select {
+		case queue <- f:
+		case sendErr = <-errCh:
+			break loop
+		}
+	}
+	close(queue)
+	wg.Wait()
+	if sendErr == nil {
+		select {
+		case sendErr = <-errCh:
+		default:
+		}
+	}
+	if sendErr == io.EOF {
+		// The server closed the stream, the real error is returned by the receiver.
+		res := <-waitCh
+		return nil, nil, res.err
+	} else if sendErr != nil {
+		cancel()
+		return nil, nil, sendErr
+	}
+	if err = stream.CloseSend(); err != nil {
+		return nil, nil, err
+	}
+	res := <-waitCh
+	if res.err != nil {
+		return nil, nil, res.err
+	}
+	return res.results, res.root, nil
+}
+
+// StartUpload starts a resumable upload to a bucket path.
+// Size is the total number of bytes that will be uploaded, or zero if unknown.
+// Use WithFastForwardOnly and WithMessage to control how the bucket will be updated when the upload is completed.
+func (c *Client) StartUpload(ctx context.Context, key, pth string, size int64, opts ...Option) (*pb.StartUploadReply, error) {
+	args := &options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.root != nil {
+		xr = args.root.String()
+	}
+	return c.c.StartUpload(ctx, &pb.StartUploadRequest{
+		Key:     key,
+		Path:    pth,
+		Root:    xr,
+		Message: args.message,
+		Size:    size,
+	})
+}
+
+// UploadStatus returns the status of an upload session.
+// The offset is the number of bytes received by the remote.
+func (c *Client) UploadStatus(ctx context.Context, id string) (*pb.UploadStatusReply, error) {
+	return c.c.UploadStatus(ctx, &pb.UploadStatusRequest{
+		SessionID: id,
+	})
+}
+
+// PushUpload sends data from reader to an upload session, starting at offset.
+// The last offset acknowledged by the remote is returned, even if an error occurs,
+// so that the upload can be resumed from there.
+// Use WithProgress to receive acknowledged offsets.
+func (c *Client) PushUpload(ctx context.Context, id string, offset int64, reader io.Reader, opts ...Option) (int64, error) {
+	args := &options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	if args.progress != nil {
+		defer close(args.progress)
+	}
+
+	stream, err := c.c.PushUpload(ctx)
+	if err != nil {
+		return offset, err
+	}
+	if err = stream.Send(&pb.PushUploadRequest{
+		Payload: &pb.PushUploadRequest_Header_{
+			Header: &pb.PushUploadRequest_Header{
+				SessionID: id,
+				Offset:    offset,
+			},
+		},
+	}); err != nil {
+		return offset, err
+	}
+
+	acked := offset
+	var recvErr error
+	waitCh := make(chan struct{})
+	go func() {
+		defer close(waitCh)
+		for {
+			rep, err := stream.Recv()
+			if err == io.EOF {
+				return
+			} else if err != nil {
+				recvErr = err
+				return
+			}
+			acked = rep.Offset
+			if args.progress != nil {
+				args.progress <- rep.Offset
+			}
+		}
+	}()
+
+	var sendErr error
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.PushUploadRequest{
+				Payload: &pb.PushUploadRequest_Chunk{
+					Chunk: buf[:n],
+				},
+			}); err == io.EOF {
+				break // The remote closed the stream, the reason will be received
+			} else if err != nil {
+				sendErr = err
+				break
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			sendErr = err
+			break
+		}
+	}
+	if err := stream.CloseSend(); err != nil && sendErr == nil {
+		sendErr = err
+	}
+	<-waitCh
+	if recvErr != nil {
+		return acked, recvErr
+	}
+	return acked, sendErr
+}
+
+// CompleteUpload adds the data of an upload session to its bucket.
+// This will return the resolved path and the bucket's new root path.
+func (c *Client) CompleteUpload(ctx context.Context, id string, opts ...Option) (result path.Resolved, root path.Resolved, err error) {
+	args := &options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	res, err := c.c.CompleteUpload(ctx, &pb.CompleteUploadRequest{
+		SessionID: id,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if args.conflictPath != nil {
+		*args.conflictPath = res.ConflictPath
+	}
+	result, err = util.NewResolvedPath(res.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	root, err = util.NewResolvedPath(res.Root.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return result, root, nil
+}
+
+// CancelUpload ends an upload session and discards its data.
+func (c *Client) CancelUpload(ctx context.Context, id string) error {
+	_, err := c.c.CancelUpload(ctx, &pb.CancelUploadRequest{
+		SessionID: id,
+	})
+	return err
+}
+
+// ResumeUpload continues an upload session from the offset last received by the remote
+// and completes it.
+// Reader must contain the entire file. It will be seeked to the remote offset before sending.
+func (c *Client) ResumeUpload(ctx context.Context, id string, reader io.ReadSeeker, opts ...Option) (result path.Resolved, root path.Resolved, err error) {
+	st, err := c.UploadStatus(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, err = reader.Seek(st.Offset, io.SeekStart); err != nil {
+		return nil, nil, err
+	}
+	if _, err = c.PushUpload(ctx, id, st.Offset, reader, opts...); err != nil {
+		return nil, nil, err
+	}
+	return c.CompleteUpload(ctx, id)
+}
+
 // PullPath pulls the bucket path, writing it to writer if it's a file.
+// Use WithETag to get the file's ETag and WithIfNoneMatch to skip unchanged files.
+// Use WithRange to pull part of the file.
 func (c *Client) PullPath(ctx context.Context, key, pth string, writer io.Writer, opts ...Option) error {
 	args := &options{}
 	for _, opt := range opts {
@@ -219,9 +754,18 @@ func (c *Client) PullPath(ctx context.Context, key, pth string, writer io.Writer
 		defer close(args.progress)
 	}
 
+	var root string
+	if args.at != nil {
+		root = args.at.String()
+	}
 	stream, err := c.c.PullPath(ctx, &pb.PullPathRequest{
-		Key:  key,
-		Path: pth,
+		Key:         key,
+		Path:        pth,
+		IfNoneMatch: args.ifNoneMatch,
+		Offset:      args.offset,
+		Length:      args.length,
+		Encoded:     args.encoded,
+		Root:        root,
 	})
 	if err != nil {
 		return err
@@ -235,6 +779,18 @@ func (c *Client) PullPath(ctx context.Context, key, pth string, writer io.Writer
 		} else if err != nil {
 			return err
 		}
+		if rep.Etag != "" && args.etag != nil {
+			*args.etag = rep.Etag
+		}
+		if rep.Size != 0 && args.size != nil {
+			*args.size = rep.Size
+		}
+		if rep.NotModified {
+			return ErrNotModified
+		}
+		if len(rep.Chunk) == 0 {
+			continue
+		}
 		n, err := writer.Write(rep.Chunk)
 		if err != nil {
 			return err
@@ -248,6 +804,8 @@ func (c *Client) PullPath(ctx context.Context, key, pth string, writer io.Writer
 }
 
 // PullIpfsPath pulls the path from a remote UnixFS dag, writing it to writer if it's a file.
+// Use WithGateway to pull from the lowest latency gateway.
+// If no gateway is reachable, the path is pulled from the remote.
 func (c *Client) PullIpfsPath(ctx context.Context, pth path.Path, writer io.Writer, opts ...Option) error {
 	args := &options{}
 	for _, opt := range opts {
@@ -256,6 +814,11 @@ func (c *Client) PullIpfsPath(ctx context.Context, pth path.Path, writer io.Writ
 	if args.progress != nil {
 		defer close(args.progress)
 	}
+	if args.gateway != nil {
+		if err := args.gateway.pull(ctx, pth, writer, args.progress); err != ErrNoGateway {
+			return err
+		}
+	}
 
 	stream, err := c.c.PullIpfsPath(ctx, &pb.PullIpfsPathRequest{
 		Path: pth.String(),
@@ -284,15 +847,226 @@ func (c *Client) PullIpfsPath(ctx context.Context, pth path.Path, writer io.Writ
 	return nil
 }
 
-// Remove removes an entire bucket.
-// Files and directories will be unpinned.
-func (c *Client) Remove(ctx context.Context, key string) error {
-	_, err := c.c.Remove(ctx, &pb.RemoveRequest{
-		Key: key,
-	})
-	return err
-}
-
+// ExportBucket writes the bucket root DAG to writer as a CARv1 file.
+// Use WithProgress to receive the number of bytes written.
+func (c *Client) ExportBucket(ctx context.Context, key string, writer io.Writer, opts ...Option) error {
+	args := &options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	if args.progress != nil {
+		defer close(args.progress)
+	}
+
+	stream, err := c.c.ExportBucket(ctx, &pb.ExportBucketRequest{
+		Key: key,
+	})
+	if err != nil {
+		return err
+	}
+
+	var written int64
+	for {
+		rep, err := stream.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		n, err := writer.Write(rep.Chunk)
+		if err != nil {
+			return err
+		}
+		written += int64(n)
+		if args.progress != nil {
+			args.progress <- written
+		}
+	}
+	return nil
+}
+
+// ImportBucket replaces the root of a bucket with the root of the CARv1 file in reader.
+// The CAR root must be a directory of the bucket, e.g., from ExportBucket.
+// Use WithFastForwardOnly to reject the import if the bucket changed, WithMessage to describe the change,
+// and WithProgress to receive the number of bytes sent.
+func (c *Client) ImportBucket(ctx context.Context, key string, reader io.Reader, opts ...Option) (*pb.Root, error) {
+	args := &options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.root != nil {
+		xr = args.root.String()
+	}
+	return c.importCar(ctx, &pb.ImportBucketRequest_Header{
+		Key:     key,
+		Root:    xr,
+		Message: args.message,
+	}, reader, args.progress)
+}
+
+// ImportNewBucket initializes a new bucket from the CARv1 file in reader.
+// The CAR root must be a UnixFS directory. Use WithName and WithPrivate to configure the bucket.
+func (c *Client) ImportNewBucket(ctx context.Context, reader io.Reader, opts ...InitOption) (*pb.Root, error) {
+	args := &initOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	return c.importCar(ctx, &pb.ImportBucketRequest_Header{
+		Name:    args.name,
+		Private: args.private,
+	}, reader, nil)
+}
+
+func (c *Client) importCar(ctx context.Context, header *pb.ImportBucketRequest_Header, reader io.Reader, progress chan<- int64) (*pb.Root, error) {
+	if progress != nil {
+		defer close(progress)
+	}
+	stream, err := c.c.ImportBucket(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&pb.ImportBucketRequest{
+		Payload: &pb.ImportBucketRequest_Header_{
+			Header: header,
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	var sent int64
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if err := stream.Send(&pb.ImportBucketRequest{
+				Payload: &pb.ImportBucketRequest_Chunk{
+					Chunk: buf[:n],
+				},
+			}); err == io.EOF {
+				// The server closed the stream, the reply holds the error.
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			sent += int64(n)
+			if progress != nil {
+				progress <- sent
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			_ = stream.CloseSend()
+			return nil, err
+		}
+	}
+	rep, err := stream.CloseAndRecv()
+	if err != nil {
+		return nil, err
+	}
+	return rep.Root, nil
+}
+
+// ImportBucketIPNSKey replaces the hub-managed IPNS key of a bucket with privKey.
+// The bucket key does not change, but the bucket is published under the returned IPNS name of privKey.
+// Importing the same key on another hub keeps the bucket's public name when moving between hubs.
+func (c *Client) ImportBucketIPNSKey(ctx context.Context, key string, privKey crypto.PrivKey) (string, error) {
+	data, err := crypto.MarshalPrivateKey(privKey)
+	if err != nil {
+		return "", err
+	}
+	res, err := c.c.ImportBucketIPNSKey(ctx, &pb.ImportBucketIPNSKeyRequest{
+		Key:        key,
+		PrivateKey: data,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.Name, nil
+}
+
+// GenerateBucketIPNSKey replaces the hub-managed IPNS key of a bucket with a new key generated by the hub.
+// The new key is returned along with its IPNS name so that it can be imported on another hub.
+func (c *Client) GenerateBucketIPNSKey(ctx context.Context, key string) (string, crypto.PrivKey, error) {
+	res, err := c.c.ImportBucketIPNSKey(ctx, &pb.ImportBucketIPNSKeyRequest{
+		Key: key,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	privKey, err := crypto.UnmarshalPrivateKey(res.PrivateKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return res.Name, privKey, nil
+}
+
+// StartS3Import imports the objects of the S3 bucket named bucket into a bucket in the background.
+// Use ListImports to follow the progress of the import.
+func (c *Client) StartS3Import(ctx context.Context, key, bucket string, opts ...S3ImportOption) (*pb.BucketImport, error) {
+	args := &s3ImportOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	res, err := c.c.StartS3Import(ctx, &pb.StartS3ImportRequest{
+		Key:       key,
+		Path:      args.path,
+		Endpoint:  args.endpoint,
+		Region:    args.region,
+		Bucket:    bucket,
+		Prefix:    args.prefix,
+		AccessKey: args.accessKey,
+		SecretKey: args.secretKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Import, nil
+}
+
+// ListImports returns the imports of a bucket, newest first.
+func (c *Client) ListImports(ctx context.Context, key string) ([]*pb.BucketImport, error) {
+	res, err := c.c.ListImports(ctx, &pb.ListImportsRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Imports, nil
+}
+
+// CancelImport stops the import with id. Objects that were already imported are kept.
+func (c *Client) CancelImport(ctx context.Context, key, id string) error {
+	_, err := c.c.CancelImport(ctx, &pb.CancelImportRequest{
+		Key: key,
+		Id:  id,
+	})
+	return err
+}
+
+// Diff returns the files that changed in a bucket since root.
+// If root is nil, all files are returned as added.
+func (c *Client) Diff(ctx context.Context, key string, root path.Resolved) (*pb.DiffReply, error) {
+	var r string
+	if root != nil {
+		r = root.String()
+	}
+	return c.c.Diff(ctx, &pb.DiffRequest{
+		Key:  key,
+		Root: r,
+	})
+}
+
+// Remove removes an entire bucket.
+// Files and directories will be unpinned.
+func (c *Client) Remove(ctx context.Context, key string) error {
+	_, err := c.c.Remove(ctx, &pb.RemoveRequest{
+		Key: key,
+	})
+	return err
+}
+
 // RemovePath removes the file or directory at path.
 // Files and directories will be unpinned.
 func (c *Client) RemovePath(ctx context.Context, key, pth string, opts ...Option) (path.Resolved, error) {
@@ -305,8 +1079,497 @@ func (c *Client) RemovePath(ctx context.Context, key, pth string, opts ...Option
 		xr = args.root.String()
 	}
 	res, err := c.c.RemovePath(ctx, &pb.RemovePathRequest{
+		Key:     key,
+		Path:    pth,
+		Root:    xr,
+		Message: args.message,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return util.NewResolvedPath(res.Root.Path)
+}
+
+// MovePath moves the file or directory at from to to.
+// The data is relinked in place, so item metadata is kept and nothing is re-pushed.
+func (c *Client) MovePath(ctx context.Context, key, from, to string, opts ...Option) (path.Resolved, error) {
+	args := &options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.root != nil {
+		xr = args.root.String()
+	}
+	res, err := c.c.MovePath(ctx, &pb.MovePathRequest{
+		Key:      key,
+		FromPath: from,
+		ToPath:   to,
+		Root:     xr,
+		Message:  args.message,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return util.NewResolvedPath(res.Root.Path)
+}
+
+// SetLifecycle replaces the lifecycle rules of a bucket.
+// Setting no rules removes all rules from the bucket.
+func (c *Client) SetLifecycle(ctx context.Context, key string, rules ...*pb.LifecycleRule) (*pb.Lifecycle, error) {
+	res, err := c.c.SetLifecycle(ctx, &pb.SetLifecycleRequest{
+		Key:   key,
+		Rules: rules,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Lifecycle, nil
+}
+
+// GetLifecycle returns the lifecycle rules of a bucket, including the status of their last run.
+func (c *Client) GetLifecycle(ctx context.Context, key string) (*pb.Lifecycle, error) {
+	res, err := c.c.GetLifecycle(ctx, &pb.GetLifecycleRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Lifecycle, nil
+}
+
+// SetWebsite replaces the website config of a bucket.
+// The gateway uses the config to render the bucket as a static website.
+// Setting an empty config removes it.
+func (c *Client) SetWebsite(ctx context.Context, key string, website *pb.Website) (*pb.Website, error) {
+	res, err := c.c.SetWebsite(ctx, &pb.SetWebsiteRequest{
+		Key:     key,
+		Website: website,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Website, nil
+}
+
+// GetWebsite returns the website config of a bucket.
+func (c *Client) GetWebsite(ctx context.Context, key string) (*pb.Website, error) {
+	res, err := c.c.GetWebsite(ctx, &pb.GetWebsiteRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Website, nil
+}
+
+// SetConflictStrategy sets how a bucket handles path updates that are based on a stale root,
+// i.e., pushes and removals made with WithFastForwardOnly after another change.
+func (c *Client) SetConflictStrategy(ctx context.Context, key string, strategy pb.SetConflictStrategyRequest_Strategy) error {
+	_, err := c.c.SetConflictStrategy(ctx, &pb.SetConflictStrategyRequest{
+		Key:      key,
+		Strategy: strategy,
+	})
+	return err
+}
+
+// GetConflictStrategy returns the conflict strategy of a bucket.
+func (c *Client) GetConflictStrategy(ctx context.Context, key string) (pb.SetConflictStrategyRequest_Strategy, error) {
+	res, err := c.c.GetConflictStrategy(ctx, &pb.GetConflictStrategyRequest{
+		Key: key,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return res.Strategy, nil
+}
+
+// AddReplicationTarget mirrors a bucket to the bucket with remoteKey in remoteThread on the hub at address.
+// The remote bucket's contents are replaced each time the bucket changes.
+// Use WithRemoteAPIKey to authenticate with the remote hub.
+// Connections to the remote hub use TLS unless WithInsecureReplication is used.
+func (c *Client) AddReplicationTarget(ctx context.Context, key, address string, remoteThread thread.ID, remoteKey string, opts ...ReplicationOption) (*pb.ReplicationTarget, error) {
+	args := &replicationOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	res, err := c.c.AddReplicationTarget(ctx, &pb.AddReplicationTargetRequest{
+		Key:       key,
+		Address:   address,
+		ApiKey:    args.apiKey,
+		ApiSecret: args.apiSecret,
+		Thread:    remoteThread.String(),
+		Token:     string(args.token),
+		RemoteKey: remoteKey,
+		Insecure:  args.insecure,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Target, nil
+}
+
+// ListReplicationTargets returns the replication targets of a bucket, including the status of their last replication.
+func (c *Client) ListReplicationTargets(ctx context.Context, key string) ([]*pb.ReplicationTarget, error) {
+	res, err := c.c.ListReplicationTargets(ctx, &pb.ListReplicationTargetsRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Targets, nil
+}
+
+// RemoveReplicationTarget stops mirroring a bucket to the target with id.
+func (c *Client) RemoveReplicationTarget(ctx context.Context, key, id string) error {
+	_, err := c.c.RemoveReplicationTarget(ctx, &pb.RemoveReplicationTargetRequest{
+		Key: key,
+		Id:  id,
+	})
+	return err
+}
+
+// AddPinMirror pins the root of a bucket on the pinning service at endpoint.
+// The endpoint must implement the IPFS Pinning Service API. Requests are authenticated with token.
+// The remote pin is replaced each time the bucket root changes.
+func (c *Client) AddPinMirror(ctx context.Context, key, endpoint, token string) (*pb.PinMirror, error) {
+	res, err := c.c.AddPinMirror(ctx, &pb.AddPinMirrorRequest{
+		Key:      key,
+		Endpoint: endpoint,
+		Token:    token,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Mirror, nil
+}
+
+// ListPinMirrors returns the pin mirrors of a bucket, including the status of their remote pins.
+func (c *Client) ListPinMirrors(ctx context.Context, key string) ([]*pb.PinMirror, error) {
+	res, err := c.c.ListPinMirrors(ctx, &pb.ListPinMirrorsRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Mirrors, nil
+}
+
+// RemovePinMirror stops mirroring a bucket to the pinning service with id.
+func (c *Client) RemovePinMirror(ctx context.Context, key, id string) error {
+	_, err := c.c.RemovePinMirror(ctx, &pb.RemovePinMirrorRequest{
+		Key: key,
+		Id:  id,
+	})
+	return err
+}
+
+// AddDomain points the DNSLink record of the custom domain name at the root of a bucket.
+// The record is managed with the DNS provider API once the domain is verified by adding
+// a TXT record with the returned challenge name and value.
+func (c *Client) AddDomain(ctx context.Context, key, name string, provider DomainProvider) (*pb.Domain, error) {
+	args := &domainProviderOptions{}
+	provider(args)
+	res, err := c.c.AddDomain(ctx, &pb.AddDomainRequest{
+		Key:       key,
+		Name:      name,
+		Provider:  args.name,
+		ZoneId:    args.zoneID,
+		Token:     args.token,
+		AccessKey: args.accessKey,
+		SecretKey: args.secretKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Domain, nil
+}
+
+// ListDomains returns the custom domains of a bucket, including their verification and sync status.
+func (c *Client) ListDomains(ctx context.Context, key string) ([]*pb.Domain, error) {
+	res, err := c.c.ListDomains(ctx, &pb.ListDomainsRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Domains, nil
+}
+
+// VerifyDomain checks the verification record of the custom domain with id.
+// Unverified domains are also checked periodically by the hub.
+func (c *Client) VerifyDomain(ctx context.Context, key, id string) (*pb.Domain, error) {
+	res, err := c.c.VerifyDomain(ctx, &pb.VerifyDomainRequest{
+		Key: key,
+		Id:  id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Domain, nil
+}
+
+// RemoveDomain stops managing the DNSLink record of the custom domain with id.
+func (c *Client) RemoveDomain(ctx context.Context, key, id string) error {
+	_, err := c.c.RemoveDomain(ctx, &pb.RemoveDomainRequest{
+		Key: key,
+		Id:  id,
+	})
+	return err
+}
+
+// CreateShareLink returns a gateway URL that gives read-only access to pth until expiresAt.
+// Use an empty path to share the whole bucket.
+// Use WithSharePassword to require a password to view the link.
+func (c *Client) CreateShareLink(ctx context.Context, key, pth string, expiresAt time.Time, opts ...ShareLinkOption) (*pb.ShareLink, error) {
+	args := &shareLinkOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	res, err := c.c.CreateShareLink(ctx, &pb.CreateShareLinkRequest{
+		Key:       key,
+		Path:      pth,
+		ExpiresAt: expiresAt.UnixNano(),
+		Password:  args.password,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Link, nil
+}
+
+// ListShareLinks returns the share links of a bucket that have not expired.
+func (c *Client) ListShareLinks(ctx context.Context, key string) ([]*pb.ShareLink, error) {
+	res, err := c.c.ListShareLinks(ctx, &pb.ListShareLinksRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Links, nil
+}
+
+// RevokeShareLink removes the share link with id.
+func (c *Client) RevokeShareLink(ctx context.Context, key, id string) error {
+	_, err := c.c.RevokeShareLink(ctx, &pb.RevokeShareLinkRequest{
+		Key: key,
+		Id:  id,
+	})
+	return err
+}
+
+// AddWebhook registers url to receive signed callbacks for bucket events.
+// If no events are given, the webhook receives all events.
+// The returned secret is used to verify callback signatures and is not returned again.
+func (c *Client) AddWebhook(ctx context.Context, key, url string, events ...string) (*pb.AddWebhookReply, error) {
+	return c.c.AddWebhook(ctx, &pb.AddWebhookRequest{
+		Key:    key,
+		Url:    url,
+		Events: events,
+	})
+}
+
+// ListWebhooks returns the webhooks of a bucket.
+func (c *Client) ListWebhooks(ctx context.Context, key string) ([]*pb.Webhook, error) {
+	res, err := c.c.ListWebhooks(ctx, &pb.ListWebhooksRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Webhooks, nil
+}
+
+// RemoveWebhook removes the webhook with id.
+func (c *Client) RemoveWebhook(ctx context.Context, key, id string) error {
+	_, err := c.c.RemoveWebhook(ctx, &pb.RemoveWebhookRequest{
+		Key: key,
+		Id:  id,
+	})
+	return err
+}
+
+// ListWebhookFailures returns the webhook callbacks of a bucket that failed after all retries.
+func (c *Client) ListWebhookFailures(ctx context.Context, key string) ([]*pb.WebhookFailure, error) {
+	res, err := c.c.ListWebhookFailures(ctx, &pb.ListWebhookFailuresRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Failures, nil
+}
+
+// SetQuota sets the max size of a bucket in bytes.
+// A max size of zero removes the quota. The hub's max bucket size always applies.
+func (c *Client) SetQuota(ctx context.Context, key string, maxSize int64) (*pb.SetQuotaReply, error) {
+	return c.c.SetQuota(ctx, &pb.SetQuotaRequest{
+		Key:     key,
+		MaxSize: maxSize,
+	})
+}
+
+// GetQuota returns the max size, current size, and remaining capacity of a bucket.
+func (c *Client) GetQuota(ctx context.Context, key string) (*pb.Quota, error) {
+	res, err := c.c.GetQuota(ctx, &pb.GetQuotaRequest{
+		Key: key,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Quota, nil
+}
+
+// RenameBucket sets the name of a bucket.
+func (c *Client) RenameBucket(ctx context.Context, key, name string) (*pb.RenameBucketReply, error) {
+	return c.c.RenameBucket(ctx, &pb.RenameBucketRequest{
+		Key:  key,
+		Name: name,
+	})
+}
+
+// CloneBucket creates a new bucket with the root of the bucket with key.
+// The clone shares data with the source bucket, so cloning is fast regardless of bucket size.
+// Private buckets cannot be cloned.
+func (c *Client) CloneBucket(ctx context.Context, key string, opts ...CloneOption) (*pb.CloneBucketReply, error) {
+	args := &cloneOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var strThread string
+	if args.thread.Defined() {
+		strThread = args.thread.String()
+	}
+	return c.c.CloneBucket(ctx, &pb.CloneBucketRequest{
+		Key:    key,
+		Name:   args.name,
+		Thread: strThread,
+	})
+}
+
+// SetPathMetadata sets the content type and attributes of an existing bucket path.
+// An empty contentType leaves the current content type unchanged.
+// Attributes are merged into existing attributes. An empty value removes an attribute.
+func (c *Client) SetPathMetadata(ctx context.Context, key, pth, contentType string, attrs map[string]string) (*pb.SetPathMetadataReply, error) {
+	return c.c.SetPathMetadata(ctx, &pb.SetPathMetadataRequest{
+		Key:         key,
+		Path:        pth,
+		ContentType: contentType,
+		Attributes:  attrs,
+	})
+}
+
+// SetTags replaces the key/value tags for a bucket.
+// Setting empty tags removes all tags from the bucket.
+func (c *Client) SetTags(ctx context.Context, key string, tags map[string]string) (*pb.SetTagsReply, error) {
+	return c.c.SetTags(ctx, &pb.SetTagsRequest{
+		Key:  key,
+		Tags: tags,
+	})
+}
+
+// SetLegalHold places a legal hold on a bucket, blocking all deletions and overwrites until released.
+// Only org owners can set legal holds.
+func (c *Client) SetLegalHold(ctx context.Context, key, reason string) (*pb.SetLegalHoldReply, error) {
+	return c.c.SetLegalHold(ctx, &pb.SetLegalHoldRequest{
+		Key:    key,
+		Hold:   true,
+		Reason: reason,
+	})
+}
+
+// ReleaseLegalHold releases a legal hold on a bucket.
+// Only org owners can release legal holds.
+func (c *Client) ReleaseLegalHold(ctx context.Context, key, reason string) error {
+	_, err := c.c.SetLegalHold(ctx, &pb.SetLegalHoldRequest{
+		Key:    key,
+		Hold:   false,
+		Reason: reason,
+	})
+	return err
+}
+
+// GetLegalHold returns the legal hold on a bucket.
+// The reply hold will be nil if the bucket is not under legal hold.
+func (c *Client) GetLegalHold(ctx context.Context, key string) (*pb.GetLegalHoldReply, error) {
+	return c.c.GetLegalHold(ctx, &pb.GetLegalHoldRequest{
+		Key: key,
+	})
+}
+
+// SetLicense sets the license of a bucket path.
+// Use an empty path to license the entire bucket.
+// Paths without a license of their own inherit the license of their closest parent.
+func (c *Client) SetLicense(ctx context.Context, key, pth, license string, opts ...LicenseOption) (*pb.License, error) {
+	args := &licenseOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	res, err := c.c.SetLicense(ctx, &pb.SetLicenseRequest{
+		Key:         key,
+		Path:        pth,
+		License:     license,
+		URL:         args.url,
+		Attribution: args.attribution,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.License, nil
+}
+
+// GetLicense returns the license that applies to a bucket path.
+// The returned license is nil if none applies.
+func (c *Client) GetLicense(ctx context.Context, key, pth string) (*pb.License, error) {
+	res, err := c.c.GetLicense(ctx, &pb.GetLicenseRequest{
 		Key:  key,
 		Path: pth,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.License, nil
+}
+
+// ListLicenses returns all licenses set in a bucket.
+func (c *Client) ListLicenses(ctx context.Context, key string) (*pb.ListLicensesReply, error) {
+	return c.c.ListLicenses(ctx, &pb.ListLicensesRequest{
+		Key: key,
+	})
+}
+
+// RemoveLicense removes the license set at a bucket path.
+func (c *Client) RemoveLicense(ctx context.Context, key, pth string) error {
+	_, err := c.c.RemoveLicense(ctx, &pb.RemoveLicenseRequest{
+		Key:  key,
+		Path: pth,
+	})
+	return err
+}
+
+// ListVersions returns the root history of a bucket, newest first.
+// All versions are returned if limit is zero.
+func (c *Client) ListVersions(ctx context.Context, key string, limit int64) (*pb.ListVersionsReply, error) {
+	return c.c.ListVersions(ctx, &pb.ListVersionsRequest{
+		Key:   key,
+		Limit: limit,
+	})
+}
+
+// RestoreVersion sets the root of a bucket to the root of a previous version.
+// The restore is recorded as a new version.
+func (c *Client) RestoreVersion(ctx context.Context, key, id string, opts ...Option) (path.Resolved, error) {
+	args := &options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.root != nil {
+		xr = args.root.String()
+	}
+	res, err := c.c.RestoreVersion(ctx, &pb.RestoreVersionRequest{
+		Key:  key,
+		ID:   id,
 		Root: xr,
 	})
 	if err != nil {
@@ -315,13 +1578,92 @@ func (c *Client) RemovePath(ctx context.Context, key, pth string, opts ...Option
 	return util.NewResolvedPath(res.Root.Path)
 }
 
-// Archive creates a Filecoin bucket archive via Powergate.
-func (c *Client) Archive(ctx context.Context, key string) (*pb.ArchiveReply, error) {
-	return c.c.Archive(ctx, &pb.ArchiveRequest{
+// ListHistory returns a page of the root history of a bucket, newest first.
+// Entries are annotated with the names of snapshots of the same root.
+// Use the cursor from the previous reply to get the next page.
+func (c *Client) ListHistory(ctx context.Context, key, cursor string, limit int64) (*pb.ListHistoryReply, error) {
+	return c.c.ListHistory(ctx, &pb.ListHistoryRequest{
+		Key:    key,
+		Cursor: cursor,
+		Limit:  limit,
+	})
+}
+
+// SnapshotBucket pins the current root of a bucket under name.
+// Snapshot data stays available until the snapshot is removed.
+func (c *Client) SnapshotBucket(ctx context.Context, key, name, description string) (*pb.Snapshot, error) {
+	res, err := c.c.SnapshotBucket(ctx, &pb.SnapshotBucketRequest{
+		Key:         key,
+		Name:        name,
+		Description: description,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Snapshot, nil
+}
+
+// ListSnapshots returns the snapshots of a bucket, newest first.
+func (c *Client) ListSnapshots(ctx context.Context, key string) (*pb.ListSnapshotsReply, error) {
+	return c.c.ListSnapshots(ctx, &pb.ListSnapshotsRequest{
 		Key: key,
 	})
 }
 
+// RestoreSnapshot sets the root of a bucket to the root of a snapshot.
+// The restore is recorded as a new version.
+func (c *Client) RestoreSnapshot(ctx context.Context, key, name string, opts ...Option) (path.Resolved, error) {
+	args := &options{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var xr string
+	if args.root != nil {
+		xr = args.root.String()
+	}
+	res, err := c.c.RestoreSnapshot(ctx, &pb.RestoreSnapshotRequest{
+		Key:  key,
+		Name: name,
+		Root: xr,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return util.NewResolvedPath(res.Root.Path)
+}
+
+// RemoveSnapshot removes a snapshot and unpins its data.
+func (c *Client) RemoveSnapshot(ctx context.Context, key, name string) error {
+	_, err := c.c.RemoveSnapshot(ctx, &pb.RemoveSnapshotRequest{
+		Key:  key,
+		Name: name,
+	})
+	return err
+}
+
+// Archive creates a Filecoin bucket archive via Powergate.
+// Deal options that aren't set use the default storage config of the bucket.
+func (c *Client) Archive(ctx context.Context, key string, opts ...ArchiveOption) (*pb.ArchiveReply, error) {
+	args := &archiveOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	req := &pb.ArchiveRequest{
+		Key: key,
+	}
+	if len(opts) > 0 {
+		req.Options = &pb.ArchiveOptions{
+			TrustedMiners:   args.trustedMiners,
+			ExcludedMiners:  args.excludedMiners,
+			CountryCodes:    args.countryCodes,
+			MaxPrice:        args.maxPrice,
+			RepFactor:       args.repFactor,
+			DealMinDuration: args.dealMinDuration,
+		}
+	}
+	return c.c.Archive(ctx, req)
+}
+
 // ArchiveStatus returns the status of a Filecoin bucket archive.
 func (c *Client) ArchiveStatus(ctx context.Context, key string) (*pb.ArchiveStatusReply, error) {
 	return c.c.ArchiveStatus(ctx, &pb.ArchiveStatusRequest{
@@ -350,9 +1692,127 @@ func (c *Client) ArchiveWatch(ctx context.Context, key string, ch chan<- string)
 	return nil
 }
 
+// ArchiveList returns all Filecoin archives of a bucket, newest first, including the state of their deals.
+func (c *Client) ArchiveList(ctx context.Context, key string) (*pb.ArchiveListReply, error) {
+	return c.c.ArchiveList(ctx, &pb.ArchiveListRequest{
+		Key: key,
+	})
+}
+
+// RestoreArchive retrieves the current Filecoin archive of a bucket and restores the bucket root from it.
+// Progress messages are sent to ch. The returned root is of a new bucket if WithRestoreNewBucket is used.
+func (c *Client) RestoreArchive(ctx context.Context, key string, ch chan<- string, opts ...RestoreArchiveOption) (*pb.Root, error) {
+	args := &restoreArchiveOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	stream, err := c.c.RestoreArchive(ctx, &pb.RestoreArchiveRequest{
+		Key:       key,
+		NewBucket: args.newBucket,
+		Name:      args.name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for {
+		reply, err := stream.Recv()
+		if err == io.EOF {
+			return nil, fmt.Errorf("restore ended before the bucket was restored")
+		}
+		if err != nil {
+			return nil, err
+		}
+		ch <- reply.Msg
+		if reply.Root != nil {
+			return reply.Root, nil
+		}
+	}
+}
+
 // ArchiveInfo returns info about a Filecoin bucket archive.
 func (c *Client) ArchiveInfo(ctx context.Context, key string) (*pb.ArchiveInfoReply, error) {
 	return c.c.ArchiveInfo(ctx, &pb.ArchiveInfoRequest{
 		Key: key,
 	})
 }
+
+// SetArchiveSchedule sets the policy for archiving a bucket automatically.
+// The bucket is archived when interval has passed or its root changed everyChanges times, whichever comes first.
+// A zero interval and change count removes the schedule.
+func (c *Client) SetArchiveSchedule(ctx context.Context, key string, interval time.Duration, everyChanges int64) (*pb.ArchiveSchedule, error) {
+	res, err := c.c.SetArchiveSchedule(ctx, &pb.SetArchiveScheduleRequest{
+		Key:          key,
+		Interval:     int64(interval / time.Second),
+		EveryChanges: everyChanges,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Schedule, nil
+}
+
+// SetArchiveRenewal sets the policy for renewing the Filecoin deals of a bucket's archives.
+// Deals are renewed when they are within threshold epochs of expiring. A zero threshold removes the policy.
+func (c *Client) SetArchiveRenewal(ctx context.Context, key string, threshold int64) (*pb.ArchiveRenewal, error) {
+	res, err := c.c.SetArchiveRenewal(ctx, &pb.SetArchiveRenewalRequest{
+		Key:       key,
+		Threshold: threshold,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.Renewal, nil
+}
+
+// QuotaExceeded returns the bucket quota that caused err.
+// The second return value is false if err was not caused by an exceeded bucket quota.
+func QuotaExceeded(err error) (*buckets.QuotaExceededError, bool) {
+	st, ok := grpcstatus.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, d := range st.Details() {
+		if q, ok := d.(*pb.QuotaExceeded); ok {
+			return &buckets.QuotaExceededError{
+				Size:      q.Size,
+				MaxSize:   q.MaxSize,
+				Remaining: q.Remaining,
+			}, true
+		}
+	}
+	return nil, false
+}
+
+// PushRejection returns the push policy violations carried by err.
+// The second return value is false if err is not a push policy rejection.
+func PushRejection(err error) (*buckets.PushRejectedError, bool) {
+	st, ok := grpcstatus.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, d := range st.Details() {
+		if r, ok := d.(*pb.PushRejection); ok {
+			rerr := &buckets.PushRejectedError{
+				Violations: make([]buckets.PolicyViolation, len(r.Violations)),
+			}
+			for i, v := range r.Violations {
+				rerr.Violations[i] = buckets.PolicyViolation{
+					Rule:   v.Rule,
+					Path:   v.Path,
+					Detail: v.Detail,
+				}
+			}
+			return rerr, true
+		}
+	}
+	return nil, false
+}
+
+// parseContentRoot returns the path of a content root cid.
+func parseContentRoot(c string) (path.Resolved, error) {
+	id, err := cid.Parse(c)
+	if err != nil {
+		return nil, err
+	}
+	return path.IpfsPath(id), nil
+}
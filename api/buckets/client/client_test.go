@@ -2,16 +2,29 @@ package client_test
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	ipfsfiles "github.com/ipfs/go-ipfs-files"
 	httpapi "github.com/ipfs/go-ipfs-http-client"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tc "github.com/textileio/go-threads/api/client"
@@ -20,11 +33,15 @@ import (
 	"github.com/textileio/textile/api/apitest"
 	"github.com/textileio/textile/api/buckets"
 	c "github.com/textileio/textile/api/buckets/client"
+	pb "github.com/textileio/textile/api/buckets/pb"
 	"github.com/textileio/textile/api/common"
 	hc "github.com/textileio/textile/api/hub/client"
+	bucks "github.com/textileio/textile/buckets"
 	"github.com/textileio/textile/core"
 	"github.com/textileio/textile/util"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func TestClient_Init(t *testing.T) {
@@ -52,6 +69,16 @@ func TestClient_Init(t *testing.T) {
 	assert.NotEmpty(t, pbuck.Root.UpdatedAt)
 	assert.NotEmpty(t, pbuck.Links)
 	assert.NotEmpty(t, pbuck.Seed)
+
+	key := make([]byte, 64)
+	_, err = rand.Read(key)
+	require.NoError(t, err)
+	kbuck, err := client.Init(ctx, c.WithName("mykeybuck"), c.WithKey(key))
+	require.NoError(t, err)
+	assert.NotEmpty(t, kbuck.Root)
+
+	_, err = client.Init(ctx, c.WithKey(key[:32]))
+	require.Error(t, err)
 }
 
 func TestClient_InitExceedLimit(t *testing.T) {
@@ -152,6 +179,45 @@ func TestClient_Links(t *testing.T) {
 	assert.NotEmpty(t, links.IPNS)
 }
 
+func TestClient_ImportBucketIPNSKey(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	buck1, err := client.Init(ctx)
+	require.NoError(t, err)
+	buck2, err := client.Init(ctx)
+	require.NoError(t, err)
+
+	name, key, err := client.GenerateBucketIPNSKey(ctx, buck1.Root.Key)
+	require.NoError(t, err)
+	assert.NotEqual(t, buck1.Root.Key, name)
+	links, err := client.Links(ctx, buck1.Root.Key)
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(links.IPNS, "/ipns/"+name))
+
+	// Keys can only be used by one bucket
+	_, err = client.ImportBucketIPNSKey(ctx, buck2.Root.Key, key)
+	require.Error(t, err)
+
+	key2, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	name2, err := client.ImportBucketIPNSKey(ctx, buck2.Root.Key, key2)
+	require.NoError(t, err)
+	pid, err := peer.IDFromPrivateKey(key2)
+	require.NoError(t, err)
+	id, err := peer.Decode(name2)
+	require.NoError(t, err)
+	assert.Equal(t, pid, id)
+	links, err = client.Links(ctx, buck2.Root.Key)
+	require.NoError(t, err)
+	assert.True(t, strings.HasSuffix(links.IPNS, "/ipns/"+name2))
+
+	// The bucket key doesn't change
+	rep, err := client.Root(ctx, buck2.Root.Key)
+	require.NoError(t, err)
+	assert.Equal(t, buck2.Root.Key, rep.Root.Key)
+}
+
 func TestClient_List(t *testing.T) {
 	t.Parallel()
 	ctx, client := setup(t)
@@ -176,489 +242,1814 @@ func TestClient_List(t *testing.T) {
 	})
 }
 
-func TestClient_ListPath(t *testing.T) {
+func TestClient_Lifecycle(t *testing.T) {
 	t.Parallel()
 	ctx, client := setup(t)
 
-	t.Run("public", func(t *testing.T) {
-		listPath(t, ctx, client, false)
-	})
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
 
-	t.Run("private", func(t *testing.T) {
-		listPath(t, ctx, client, true)
+	lifecycle, err := client.GetLifecycle(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	assert.Empty(t, lifecycle.Rules)
+
+	lifecycle, err = client.SetLifecycle(ctx, buck.Root.Key, &pb.LifecycleRule{
+		Action: "expire",
+		Prefix: "/tmp",
+		Days:   7,
 	})
-}
+	require.NoError(t, err)
+	require.Len(t, lifecycle.Rules, 1)
+	assert.Equal(t, "expire-0", lifecycle.Rules[0].Id)
+	assert.Equal(t, "tmp", lifecycle.Rules[0].Prefix)
+	assert.True(t, lifecycle.NextRunAt > 0)
 
-func listPath(t *testing.T, ctx context.Context, client *c.Client, private bool) {
-	buck, err := client.Init(ctx, c.WithPrivate(private))
+	lifecycle, err = client.GetLifecycle(ctx, buck.Root.Key)
 	require.NoError(t, err)
+	require.Len(t, lifecycle.Rules, 1)
+	assert.Equal(t, int32(7), lifecycle.Rules[0].Days)
 
-	t.Run("empty", func(t *testing.T) {
-		rep, err := client.ListPath(ctx, buck.Root.Key, "")
+	t.Run("invalid", func(t *testing.T) {
+		_, err := client.SetLifecycle(ctx, buck.Root.Key, &pb.LifecycleRule{Action: "expire", Days: 7})
+		require.Error(t, err)
+		_, err = client.SetLifecycle(ctx, buck.Root.Key, &pb.LifecycleRule{Action: "expire", Prefix: "tmp"})
+		require.Error(t, err)
+		_, err = client.SetLifecycle(ctx, buck.Root.Key, &pb.LifecycleRule{Action: "unknown", Days: 1})
+		require.Error(t, err)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		lifecycle, err := client.SetLifecycle(ctx, buck.Root.Key)
 		require.NoError(t, err)
-		assert.NotEmpty(t, rep.Root)
-		assert.True(t, rep.Item.IsDir)
-		assert.Equal(t, 1, len(rep.Item.Items))
+		assert.Empty(t, lifecycle.Rules)
+		assert.Equal(t, int64(0), lifecycle.NextRunAt)
 	})
+}
 
-	file, err := os.Open("testdata/file1.jpg")
+func TestClient_ConflictStrategy(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	buck, err := client.Init(ctx)
 	require.NoError(t, err)
-	defer file.Close()
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "dir1/file1.jpg", file)
+	strategy, err := client.GetConflictStrategy(ctx, buck.Root.Key)
 	require.NoError(t, err)
-	_, root, err := client.PushPath(ctx, buck.Root.Key, "dir2/file1.jpg", file)
+	assert.Equal(t, pb.SetConflictStrategyRequest_LastWriterWins, strategy)
+
+	_, base, err := client.PushPath(ctx, buck.Root.Key, "a.txt", strings.NewReader("a"))
+	require.NoError(t, err)
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "b.txt", strings.NewReader("b"), c.WithFastForwardOnly(base))
 	require.NoError(t, err)
 
-	t.Run("root dir", func(t *testing.T) {
-		rep, err := client.ListPath(ctx, buck.Root.Key, "")
+	// Stale pushes are rejected by default
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "c.txt", strings.NewReader("c"), c.WithFastForwardOnly(base))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), bucks.ErrNonFastForward.Error())
+
+	t.Run("merge", func(t *testing.T) {
+		err := client.SetConflictStrategy(ctx, buck.Root.Key, pb.SetConflictStrategyRequest_Merge)
 		require.NoError(t, err)
-		assert.True(t, rep.Item.IsDir)
-		assert.Equal(t, 3, len(rep.Item.Items))
-		dir1i := sort.Search(len(rep.Item.Items), func(i int) bool {
-			return rep.Item.Items[i].Name == "dir1"
-		})
-		assert.True(t, dir1i < len(rep.Item.Items))
-		assert.True(t, rep.Item.Items[dir1i].IsDir)
+		_, _, err = client.PushPath(ctx, buck.Root.Key, "c.txt", strings.NewReader("c"), c.WithFastForwardOnly(base))
+		require.NoError(t, err)
+		_, _, err = client.PushPath(ctx, buck.Root.Key, "b.txt", strings.NewReader("b2"), c.WithFastForwardOnly(base))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), bucks.ErrPathConflict.Error())
+		_, err = client.RemovePath(ctx, buck.Root.Key, "b.txt", c.WithFastForwardOnly(base))
+		require.Error(t, err)
 	})
 
-	t.Run("nested dir", func(t *testing.T) {
-		rep, err := client.ListPath(ctx, buck.Root.Key, "dir1")
+	t.Run("keep both", func(t *testing.T) {
+		err := client.SetConflictStrategy(ctx, buck.Root.Key, pb.SetConflictStrategyRequest_KeepBoth)
 		require.NoError(t, err)
-		assert.True(t, rep.Item.IsDir)
-		assert.Equal(t, 1, len(rep.Item.Items))
+		var conflictPath string
+		_, _, err = client.PushPath(ctx, buck.Root.Key, "b.txt", strings.NewReader("b2"), c.WithFastForwardOnly(base), c.WithConflictPath(&conflictPath))
+		require.NoError(t, err)
+		assert.Equal(t, "b-conflict-1.txt", conflictPath)
+		rep, err := client.ListPath(ctx, buck.Root.Key, "b.txt")
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), rep.Item.Size)
 	})
 
-	t.Run("file", func(t *testing.T) {
-		rep, err := client.ListPath(ctx, buck.Root.Key, "dir1/file1.jpg")
+	t.Run("reject", func(t *testing.T) {
+		err := client.SetConflictStrategy(ctx, buck.Root.Key, pb.SetConflictStrategyRequest_Reject)
 		require.NoError(t, err)
-		assert.True(t, strings.HasSuffix(rep.Item.Path, "file1.jpg"))
-		assert.False(t, rep.Item.IsDir)
-		assert.Equal(t, root.String(), rep.Root.Path)
+		strategy, err := client.GetConflictStrategy(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		assert.Equal(t, pb.SetConflictStrategyRequest_Reject, strategy)
+		_, _, err = client.PushPath(ctx, buck.Root.Key, "d.txt", strings.NewReader("d"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), bucks.ErrRootRequired.Error())
 	})
 }
 
-func TestClient_PushPath(t *testing.T) {
+func TestClient_Website(t *testing.T) {
 	t.Parallel()
 	ctx, client := setup(t)
 
-	t.Run("public", func(t *testing.T) {
-		pushPath(t, ctx, client, false)
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
+
+	website, err := client.GetWebsite(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	assert.Empty(t, website.IndexDocument)
+
+	website, err = client.SetWebsite(ctx, buck.Root.Key, &pb.Website{
+		IndexDocument: "home.html",
+		ErrorDocument: "404.html",
+		Redirects: []*pb.Website_Redirect{
+			{From: "/app/*", To: "/home.html", Status: 200},
+		},
+		Headers: []*pb.Website_Header{
+			{Path: "assets/**", Name: "cache-control", Value: "max-age=3600"},
+		},
 	})
+	require.NoError(t, err)
+	assert.Equal(t, "home.html", website.IndexDocument)
+	require.Len(t, website.Headers, 1)
+	assert.Equal(t, "Cache-Control", website.Headers[0].Name)
 
-	t.Run("private", func(t *testing.T) {
-		pushPath(t, ctx, client, true)
+	website, err = client.GetWebsite(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	assert.Equal(t, "404.html", website.ErrorDocument)
+	require.Len(t, website.Redirects, 1)
+	assert.Equal(t, int32(200), website.Redirects[0].Status)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := client.SetWebsite(ctx, buck.Root.Key, &pb.Website{IndexDocument: "a/index.html"})
+		require.Error(t, err)
+		_, err = client.SetWebsite(ctx, buck.Root.Key, &pb.Website{
+			Redirects: []*pb.Website_Redirect{{From: "/a", To: "/b", Status: 500}},
+		})
+		require.Error(t, err)
+		_, err = client.SetWebsite(ctx, buck.Root.Key, &pb.Website{
+			Headers: []*pb.Website_Header{{Path: "**", Name: "Content-Length", Value: "1"}},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		website, err := client.SetWebsite(ctx, buck.Root.Key, &pb.Website{})
+		require.NoError(t, err)
+		assert.Empty(t, website.IndexDocument)
+		assert.Empty(t, website.Redirects)
 	})
 }
 
-func TestClient_PushPathBucketExceedLimit(t *testing.T) {
+func TestClient_ReplicationTargets(t *testing.T) {
 	t.Parallel()
-	firstFile := "testdata/file1.jpg"
-	file1, err := os.Open(firstFile)
+	ctx, client := setup(t)
+
+	buck, err := client.Init(ctx)
 	require.NoError(t, err)
-	defer file1.Close()
-	stat, err := os.Stat(firstFile)
+
+	remoteThread := thread.NewIDV1(thread.Raw, 32)
+	target, err := client.AddReplicationTarget(ctx, buck.Root.Key, "remote:443", remoteThread, "remotekey", c.WithRemoteAPIKey("key", "secret"))
 	require.NoError(t, err)
+	assert.NotEmpty(t, target.Id)
+	assert.Equal(t, remoteThread.String(), target.Thread)
+	assert.Equal(t, "remotekey", target.Key)
+	assert.True(t, target.Pending)
+	assert.False(t, target.Insecure)
 
-	// Calculate a max-size which lets the first file
-	// be accepted, with a bit of margin. A second file
-	// will fail since it exceeded the bucket limit.
-	maxBucketSize := stat.Size() + 1024
+	list, err := client.ListReplicationTargets(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, target.Id, list[0].Id)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := client.AddReplicationTarget(ctx, buck.Root.Key, "", remoteThread, "remotekey")
+		require.Error(t, err)
+		_, err = client.AddReplicationTarget(ctx, buck.Root.Key, "remote:443", remoteThread, "")
+		require.Error(t, err)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		pbuck, err := client.Init(ctx, c.WithPrivate(true))
+		require.NoError(t, err)
+		_, err = client.AddReplicationTarget(ctx, pbuck.Root.Key, "remote:443", remoteThread, "remotekey")
+		require.Error(t, err)
+	})
+
+	t.Run("insecure", func(t *testing.T) {
+		_, err := client.AddReplicationTarget(ctx, buck.Root.Key, "remote", remoteThread, "remotekey")
+		require.Error(t, err)
+		insecure, err := client.AddReplicationTarget(ctx, buck.Root.Key, "127.0.0.1:3006", remoteThread, "remotekey", c.WithInsecureReplication())
+		require.NoError(t, err)
+		assert.True(t, insecure.Insecure)
+		err = client.RemoveReplicationTarget(ctx, buck.Root.Key, insecure.Id)
+		require.NoError(t, err)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err := client.RemoveReplicationTarget(ctx, buck.Root.Key, target.Id)
+		require.NoError(t, err)
+		list, err := client.ListReplicationTargets(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+		err = client.RemoveReplicationTarget(ctx, buck.Root.Key, target.Id)
+		require.Error(t, err)
+	})
+}
 
+func TestClient_ReplicationTargetsPublicOnly(t *testing.T) {
+	t.Parallel()
 	conf := apitest.DefaultTextileConfig(t)
-	conf.BucketsMaxSize = maxBucketSize
+	conf.BucketsAllowPrivateEndpoints = false
 	ctx, client := setupWithConf(t, conf)
 
 	buck, err := client.Init(ctx)
 	require.NoError(t, err)
+	remoteThread := thread.NewIDV1(thread.Raw, 32)
 
-	pth1, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
-	require.NoError(t, err)
-	assert.NotEmpty(t, pth1)
-	assert.NotEmpty(t, root1)
-
-	file2, err := os.Open("testdata/file2.jpg")
-	require.NoError(t, err)
-	defer file2.Close()
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to/file2.jpg", file2)
+	for _, addr := range []string{"127.0.0.1:3006", "localhost:443", "10.0.0.1:443", "169.254.169.254:80", "[::1]:443"} {
+		_, err := client.AddReplicationTarget(ctx, buck.Root.Key, addr, remoteThread, "remotekey")
+		require.Error(t, err, addr)
+	}
+	_, err = client.AddReplicationTarget(ctx, buck.Root.Key, "8.8.8.8:443", remoteThread, "remotekey", c.WithInsecureReplication())
 	require.Error(t, err)
-	require.Contains(t, err.Error(), buckets.ErrBucketExceedsMaxSize.Error())
+	list, err := client.ListReplicationTargets(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	assert.Empty(t, list)
 }
 
-func TestClient_PushPathBucketsExceedLimit(t *testing.T) {
+func TestClient_SearchPath(t *testing.T) {
 	t.Parallel()
-	firstFile := "testdata/file1.jpg"
-	file1, err := os.Open(firstFile)
+	ctx, client := setup(t)
+
+	buck, err := client.Init(ctx)
 	require.NoError(t, err)
-	defer file1.Close()
-	stat, err := os.Stat(firstFile)
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "photos/cat.jpg", strings.NewReader("cat"))
+	require.NoError(t, err)
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "photos/dog.png", strings.NewReader("dog"))
+	require.NoError(t, err)
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "notes.txt", strings.NewReader("notes"))
+	require.NoError(t, err)
+	_, err = client.SetPathMetadata(ctx, buck.Root.Key, "notes.txt", "", map[string]string{"author": "carol"})
 	require.NoError(t, err)
 
-	maxBucketsSize := stat.Size() + 1024
+	var rep *pb.SearchPathReply
+	require.Eventually(t, func() bool {
+		rep, err = client.SearchPath(ctx, buck.Root.Key, "CAT")
+		return err == nil && !rep.Pending
+	}, time.Minute, time.Second)
+	require.Len(t, rep.Items, 1)
+	assert.Equal(t, "cat.jpg", rep.Items[0].Name)
+	assert.True(t, strings.HasSuffix(rep.Items[0].Path, "photos/cat.jpg"))
+
+	t.Run("glob", func(t *testing.T) {
+		rep, err := client.SearchPath(ctx, buck.Root.Key, "*.png", c.WithSearchMode(pb.SearchPathRequest_Glob))
+		require.NoError(t, err)
+		require.Len(t, rep.Items, 1)
+		assert.Equal(t, "dog.png", rep.Items[0].Name)
+
+		rep, err = client.SearchPath(ctx, buck.Root.Key, "photos/*", c.WithSearchMode(pb.SearchPathRequest_Glob))
+		require.NoError(t, err)
+		assert.Len(t, rep.Items, 2)
+	})
+
+	t.Run("regex", func(t *testing.T) {
+		rep, err := client.SearchPath(ctx, buck.Root.Key, "^author=car", c.WithSearchMode(pb.SearchPathRequest_Regex))
+		require.NoError(t, err)
+		require.Len(t, rep.Items, 1)
+		assert.Equal(t, "notes.txt", rep.Items[0].Name)
+		assert.Equal(t, "carol", rep.Items[0].Metadata.Attributes["author"])
+
+		_, err = client.SearchPath(ctx, buck.Root.Key, "(", c.WithSearchMode(pb.SearchPathRequest_Regex))
+		require.Error(t, err)
+	})
+
+	t.Run("path and limit", func(t *testing.T) {
+		rep, err := client.SearchPath(ctx, buck.Root.Key, "", c.WithSearchPath("photos"))
+		require.NoError(t, err)
+		assert.Len(t, rep.Items, 3)
+
+		rep, err = client.SearchPath(ctx, buck.Root.Key, "", c.WithSearchPath("photos"), c.WithSearchLimit(1))
+		require.NoError(t, err)
+		assert.Len(t, rep.Items, 1)
+	})
+}
 
+func TestClient_SearchPathDisabled(t *testing.T) {
+	t.Parallel()
 	conf := apitest.DefaultTextileConfig(t)
-	conf.BucketsTotalMaxSize = maxBucketsSize
+	conf.BucketsSearchIndex = false
 	ctx, client := setupWithConf(t, conf)
 
 	buck, err := client.Init(ctx)
 	require.NoError(t, err)
+	_, err = client.SearchPath(ctx, buck.Root.Key, "cat")
+	require.Error(t, err)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}
 
-	pth1, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
+func TestClient_Webhooks(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	buck, err := client.Init(ctx)
 	require.NoError(t, err)
-	assert.NotEmpty(t, pth1)
-	assert.NotEmpty(t, root1)
 
-	// Create a second bucket. Since the limit is bucket-wide
-	// should fail.
-	buck, err = client.Init(ctx)
+	res, err := client.AddWebhook(ctx, buck.Root.Key, "https://example.com/hook", "path.pushed")
 	require.NoError(t, err)
+	assert.NotEmpty(t, res.Webhook.Id)
+	assert.NotEmpty(t, res.Secret)
+	assert.Equal(t, []string{"path.pushed"}, res.Webhook.Events)
 
-	file2, err := os.Open("testdata/file2.jpg")
+	list, err := client.ListWebhooks(ctx, buck.Root.Key)
 	require.NoError(t, err)
-	defer file2.Close()
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to/file2.jpg", file2)
-	require.Error(t, err)
-	require.Contains(t, err.Error(), buckets.ErrBucketsTotalSizeExceedsMaxSize.Error())
+	require.Len(t, list, 1)
+	assert.Equal(t, res.Webhook.Id, list[0].Id)
+
+	failures, err := client.ListWebhookFailures(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	assert.Empty(t, failures)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := client.AddWebhook(ctx, buck.Root.Key, "example.com/hook")
+		require.Error(t, err)
+		_, err = client.AddWebhook(ctx, buck.Root.Key, "https://example.com/hook", "unknown")
+		require.Error(t, err)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		err := client.RemoveWebhook(ctx, buck.Root.Key, res.Webhook.Id)
+		require.NoError(t, err)
+		list, err := client.ListWebhooks(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+		err = client.RemoveWebhook(ctx, buck.Root.Key, res.Webhook.Id)
+		require.Error(t, err)
+	})
 }
 
-func TestClient_PushPathPrivateBucketsExceedLimit(t *testing.T) {
+func TestClient_ShareLinks(t *testing.T) {
 	t.Parallel()
-	firstFile := "testdata/file1.jpg"
-	file1, err := os.Open(firstFile)
+	ctx, client := setup(t)
+
+	buck, err := client.Init(ctx)
 	require.NoError(t, err)
-	defer file1.Close()
-	stat, err := os.Stat(firstFile)
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "dir/file.txt", strings.NewReader("hello"))
 	require.NoError(t, err)
 
-	maxBucketsSize := stat.Size() + 1024
+	link, err := client.CreateShareLink(ctx, buck.Root.Key, "dir/file.txt", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.NotEmpty(t, link.Id)
+	assert.Equal(t, "dir/file.txt", link.Path)
+	assert.True(t, strings.HasSuffix(link.Url, "/share/"+link.Id))
 
-	conf := apitest.DefaultTextileConfig(t)
-	conf.BucketsTotalMaxSize = maxBucketsSize
-	ctx, client := setupWithConf(t, conf)
+	list, err := client.ListShareLinks(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, link.Id, list[0].Id)
 
-	buck, err := client.Init(ctx, c.WithPrivate(true))
+	t.Run("invalid", func(t *testing.T) {
+		_, err := client.CreateShareLink(ctx, buck.Root.Key, "dir/file.txt", time.Now().Add(-time.Hour))
+		require.Error(t, err)
+		_, err = client.CreateShareLink(ctx, buck.Root.Key, "missing", time.Now().Add(time.Hour))
+		require.Error(t, err)
+	})
+
+	t.Run("password", func(t *testing.T) {
+		plink, err := client.CreateShareLink(ctx, buck.Root.Key, "dir", time.Now().Add(time.Hour), c.WithSharePassword("secret"))
+		require.NoError(t, err)
+		assert.True(t, plink.Protected)
+		assert.False(t, link.Protected)
+		err = client.RevokeShareLink(ctx, buck.Root.Key, plink.Id)
+		require.NoError(t, err)
+	})
+
+	t.Run("revoke", func(t *testing.T) {
+		err := client.RevokeShareLink(ctx, buck.Root.Key, link.Id)
+		require.NoError(t, err)
+		list, err := client.ListShareLinks(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+		err = client.RevokeShareLink(ctx, buck.Root.Key, link.Id)
+		require.Error(t, err)
+	})
+}
+
+func TestClient_RenameBucket(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	buck, err := client.Init(ctx, c.WithName("before"))
 	require.NoError(t, err)
 
-	pth1, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
+	res, err := client.RenameBucket(ctx, buck.Root.Key, "after")
 	require.NoError(t, err)
-	assert.NotEmpty(t, pth1)
-	assert.NotEmpty(t, root1)
+	assert.Equal(t, "after", res.Root.Name)
+	assert.Equal(t, buck.Root.Path, res.Root.Path)
 
-	// Create a second bucket. Since the limit is bucket-wide
-	// should fail.
-	buck, err = client.Init(ctx)
+	root, err := client.Root(ctx, buck.Root.Key)
 	require.NoError(t, err)
+	assert.Equal(t, "after", root.Root.Name)
 
-	file2, err := os.Open("testdata/file2.jpg")
+	links, err := client.Links(ctx, buck.Root.Key)
 	require.NoError(t, err)
-	defer file2.Close()
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to/file2.jpg", file2)
+	assert.Contains(t, links.URL, buck.Root.Key)
+
+	_, err = client.RenameBucket(ctx, buck.Root.Key, " ")
 	require.Error(t, err)
-	require.Contains(t, err.Error(), buckets.ErrBucketsTotalSizeExceedsMaxSize.Error())
 }
 
-func pushPath(t *testing.T, ctx context.Context, client *c.Client, private bool) {
-	buck, err := client.Init(ctx, c.WithPrivate(private))
-	require.NoError(t, err)
+func TestClient_CloneBucket(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
 
-	file1, err := os.Open("testdata/file1.jpg")
+	buck, err := client.Init(ctx, c.WithName("source"))
 	require.NoError(t, err)
-	defer file1.Close()
-	progress1 := make(chan int64)
-	go func() {
-		for p := range progress1 {
-			t.Logf("progress: %d", p)
-		}
-	}()
-	pth1, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1, c.WithProgress(progress1))
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "a.txt", strings.NewReader("a"))
 	require.NoError(t, err)
-	assert.NotEmpty(t, pth1)
-	assert.NotEmpty(t, root1)
 
+	t.Run("default name", func(t *testing.T) {
+		res, err := client.CloneBucket(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		assert.Equal(t, "source", res.Root.Name)
+		assert.NotEqual(t, buck.Root.Key, res.Root.Key)
+	})
+
+	t.Run("shares data", func(t *testing.T) {
+		res, err := client.CloneBucket(ctx, buck.Root.Key, c.WithCloneName("clone"))
+		require.NoError(t, err)
+		assert.Equal(t, "clone", res.Root.Name)
+		assert.Equal(t, buck.Root.Thread, res.Root.Thread)
+		assert.NotEmpty(t, res.Links.URL)
+
+		_, _, err = client.PushPath(ctx, res.Root.Key, "b.txt", strings.NewReader("b"))
+		require.NoError(t, err)
+		_, err = client.ListPath(ctx, buck.Root.Key, "b.txt")
+		require.Error(t, err)
+
+		err = client.Remove(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		err = client.PullPath(ctx, res.Root.Key, "a.txt", &buf)
+		require.NoError(t, err)
+		assert.Equal(t, "a", buf.String())
+	})
+
+	t.Run("private", func(t *testing.T) {
+		pbuck, err := client.Init(ctx, c.WithPrivate(true))
+		require.NoError(t, err)
+		_, err = client.CloneBucket(ctx, pbuck.Root.Key)
+		require.Error(t, err)
+	})
+}
+
+func TestClient_SetTags(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	buck1, err := client.Init(ctx, c.WithName("buck1"))
+	require.NoError(t, err)
+	_, err = client.Init(ctx, c.WithName("buck2"))
+	require.NoError(t, err)
+
+	res, err := client.SetTags(ctx, buck1.Root.Key, map[string]string{"team": "eng"})
+	require.NoError(t, err)
+	assert.Equal(t, "eng", res.Root.Tags["team"])
+
+	root, err := client.Root(ctx, buck1.Root.Key)
+	require.NoError(t, err)
+	assert.Equal(t, "eng", root.Root.Tags["team"])
+
+	t.Run("filter", func(t *testing.T) {
+		rep, err := client.List(ctx, c.WithTagFilter(map[string]string{"team": "eng"}))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(rep.Roots))
+		assert.Equal(t, buck1.Root.Key, rep.Roots[0].Key)
+
+		rep, err = client.List(ctx, c.WithTagFilter(map[string]string{"team": "ops"}))
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(rep.Roots))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := client.SetTags(ctx, buck1.Root.Key, map[string]string{"": "eng"})
+		require.Error(t, err)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		res, err := client.SetTags(ctx, buck1.Root.Key, nil)
+		require.NoError(t, err)
+		assert.Empty(t, res.Root.Tags)
+	})
+}
+
+func TestClient_LegalHold(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
+
+	res, err := client.GetLegalHold(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	assert.Nil(t, res.Hold)
+
+	t.Run("requires org", func(t *testing.T) {
+		_, err := client.SetLegalHold(ctx, buck.Root.Key, "litigation")
+		require.Error(t, err)
+	})
+}
+
+func TestClient_ListPath(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	t.Run("public", func(t *testing.T) {
+		listPath(t, ctx, client, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		listPath(t, ctx, client, true)
+	})
+}
+
+func listPath(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
+
+	t.Run("empty", func(t *testing.T) {
+		rep, err := client.ListPath(ctx, buck.Root.Key, "")
+		require.NoError(t, err)
+		assert.NotEmpty(t, rep.Root)
+		assert.True(t, rep.Item.IsDir)
+		assert.Equal(t, 1, len(rep.Item.Items))
+	})
+
+	file, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file.Close()
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "dir1/file1.jpg", file)
+	require.NoError(t, err)
+	_, root, err := client.PushPath(ctx, buck.Root.Key, "dir2/file1.jpg", file)
+	require.NoError(t, err)
+
+	t.Run("root dir", func(t *testing.T) {
+		rep, err := client.ListPath(ctx, buck.Root.Key, "")
+		require.NoError(t, err)
+		assert.True(t, rep.Item.IsDir)
+		assert.Equal(t, 3, len(rep.Item.Items))
+		dir1i := sort.Search(len(rep.Item.Items), func(i int) bool {
+			return rep.Item.Items[i].Name == "dir1"
+		})
+		assert.True(t, dir1i < len(rep.Item.Items))
+		assert.True(t, rep.Item.Items[dir1i].IsDir)
+	})
+
+	t.Run("paged root dir", func(t *testing.T) {
+		rep, err := client.ListPath(ctx, buck.Root.Key, "", c.WithListLimit(2))
+		require.NoError(t, err)
+		assert.True(t, rep.Item.IsDir)
+		assert.Equal(t, 2, len(rep.Item.Items))
+		require.NotEmpty(t, rep.NextCursor)
+		assert.Equal(t, rep.Item.Items[1].Name, rep.NextCursor)
+
+		rep, err = client.ListPath(ctx, buck.Root.Key, "", c.WithListLimit(2), c.WithListCursor(rep.NextCursor))
+		require.NoError(t, err)
+		assert.True(t, rep.Item.IsDir)
+		require.Equal(t, 1, len(rep.Item.Items))
+		assert.Equal(t, "dir2", rep.Item.Items[0].Name)
+		assert.Empty(t, rep.NextCursor)
+
+		_, err = client.ListPath(ctx, buck.Root.Key, "", c.WithListLimit(-1))
+		require.Error(t, err)
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		rep, err := client.ListPath(ctx, buck.Root.Key, "", c.WithListRecursive(0))
+		require.NoError(t, err)
+		require.Equal(t, 3, len(rep.Item.Items))
+		dir1 := rep.Item.Items[1]
+		assert.Equal(t, "dir1", dir1.Name)
+		require.Equal(t, 1, len(dir1.Items))
+		assert.Equal(t, "file1.jpg", dir1.Items[0].Name)
+		assert.NotEmpty(t, dir1.Items[0].Cid)
+
+		rep, err = client.ListPath(ctx, buck.Root.Key, "", c.WithListRecursive(1))
+		require.NoError(t, err)
+		require.Equal(t, 3, len(rep.Item.Items))
+		require.Equal(t, 1, len(rep.Item.Items[1].Items))
+		assert.Empty(t, rep.Item.Items[1].Items[0].Cid)
+	})
+
+	t.Run("glob", func(t *testing.T) {
+		rep, err := client.ListPath(ctx, buck.Root.Key, "", c.WithListRecursive(0), c.WithListGlob("dir1/*.jpg"))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(rep.Item.Items))
+		assert.Equal(t, "dir1", rep.Item.Items[0].Name)
+		require.Equal(t, 1, len(rep.Item.Items[0].Items))
+
+		rep, err = client.ListPath(ctx, buck.Root.Key, "", c.WithListRecursive(0), c.WithListGlob("**/*.jpg"))
+		require.NoError(t, err)
+		assert.Equal(t, 2, len(rep.Item.Items))
+
+		rep, err = client.ListPath(ctx, buck.Root.Key, "", c.WithListGlob("*.png"))
+		require.NoError(t, err)
+		assert.Empty(t, rep.Item.Items)
+
+		_, err = client.ListPath(ctx, buck.Root.Key, "", c.WithListGlob("["))
+		require.Error(t, err)
+	})
+
+	t.Run("nested dir", func(t *testing.T) {
+		rep, err := client.ListPath(ctx, buck.Root.Key, "dir1")
+		require.NoError(t, err)
+		assert.True(t, rep.Item.IsDir)
+		assert.Equal(t, 1, len(rep.Item.Items))
+	})
+
+	t.Run("file", func(t *testing.T) {
+		rep, err := client.ListPath(ctx, buck.Root.Key, "dir1/file1.jpg")
+		require.NoError(t, err)
+		assert.True(t, strings.HasSuffix(rep.Item.Path, "file1.jpg"))
+		assert.False(t, rep.Item.IsDir)
+		assert.Equal(t, root.String(), rep.Root.Path)
+	})
+}
+
+func TestClient_PushPath(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	t.Run("public", func(t *testing.T) {
+		pushPath(t, ctx, client, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		pushPath(t, ctx, client, true)
+	})
+}
+
+func TestClient_PushPaths(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	t.Run("public", func(t *testing.T) {
+		pushPaths(t, ctx, client, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		pushPaths(t, ctx, client, true)
+	})
+}
+
+func pushPaths(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
+
+	openFile := func(name string) func() (io.ReadCloser, error) {
+		return func() (io.ReadCloser, error) {
+			return os.Open(name)
+		}
+	}
+	files := []c.PushPathsFile{
+		{Path: "file1.jpg", Open: openFile("testdata/file1.jpg")},
+		{Path: "path/to/file2.jpg", Open: openFile("testdata/file2.jpg")},
+		{Path: "path/to/hello.txt", Open: func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader("hello")), nil
+		}, Attributes: map[string]string{"owner": "alice"}},
+	}
+	progress := make(chan int64)
+	go func() {
+		for p := range progress {
+			t.Logf("progress: %d", p)
+		}
+	}()
+	results, root, err := client.PushPaths(ctx, buck.Root.Key, files, c.WithProgress(progress), c.WithConcurrency(2))
+	require.NoError(t, err)
+	assert.NotEmpty(t, root)
+	assert.Len(t, results, 3)
+	assert.NotEmpty(t, results["path/to/hello.txt"])
+
+	rep, err := client.ListPath(ctx, buck.Root.Key, "path/to")
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(rep.Item.Items))
+
+	hello, err := client.ListPath(ctx, buck.Root.Key, "path/to/hello.txt")
+	require.NoError(t, err)
+	assert.Equal(t, "alice", hello.Item.Metadata.Attributes["owner"])
+
+	rr, err := client.Root(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	assert.Equal(t, root.String(), rr.Root.Path)
+
+	// Pushing the same path twice in one stream is not allowed
+	_, _, err = client.PushPaths(ctx, buck.Root.Key, []c.PushPathsFile{
+		{Path: "a.txt", Open: func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader("a")), nil
+		}},
+		{Path: "a.txt", Open: func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader("b")), nil
+		}},
+	}, c.WithConcurrency(1))
+	require.Error(t, err)
+
+	// Non-fast-forward updates are rejected
+	old, err := util.NewResolvedPath(buck.Root.Path)
+	require.NoError(t, err)
+	_, _, err = client.PushPaths(ctx, buck.Root.Key, files[2:], c.WithFastForwardOnly(old))
+	require.Error(t, err)
+}
+
+func TestClient_PathMetadata(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	t.Run("public", func(t *testing.T) {
+		pathMetadata(t, ctx, client, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		pathMetadata(t, ctx, client, true)
+	})
+}
+
+func pathMetadata(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
+
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "page.html", strings.NewReader("<html></html>"))
+	require.NoError(t, err)
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "data", strings.NewReader("%PDF-1.4 document"))
+	require.NoError(t, err)
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "dir/file.bin", strings.NewReader("binary"),
+		c.WithContentType("application/x-custom"),
+		c.WithAttributes(map[string]string{"owner": "alice"}))
+	require.NoError(t, err)
+
+	rep, err := client.ListPath(ctx, buck.Root.Key, "page.html")
+	require.NoError(t, err)
+	require.NotNil(t, rep.Item.Metadata)
+	assert.Equal(t, "text/html; charset=utf-8", rep.Item.Metadata.ContentType)
+
+	rep, err = client.ListPath(ctx, buck.Root.Key, "data")
+	require.NoError(t, err)
+	require.NotNil(t, rep.Item.Metadata)
+	assert.Equal(t, "application/pdf", rep.Item.Metadata.ContentType)
+
+	rep, err = client.ListPath(ctx, buck.Root.Key, "dir")
+	require.NoError(t, err)
+	require.Len(t, rep.Item.Items, 1)
+	require.NotNil(t, rep.Item.Items[0].Metadata)
+	assert.Equal(t, "application/x-custom", rep.Item.Items[0].Metadata.ContentType)
+	assert.Equal(t, "alice", rep.Item.Items[0].Metadata.Attributes["owner"])
+
+	t.Run("set", func(t *testing.T) {
+		res, err := client.SetPathMetadata(ctx, buck.Root.Key, "dir/file.bin", "", map[string]string{
+			"owner": "",
+			"label": "draft",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "application/x-custom", res.Metadata.ContentType)
+		assert.Equal(t, map[string]string{"label": "draft"}, res.Metadata.Attributes)
+
+		rep, err := client.ListPath(ctx, buck.Root.Key, "dir/file.bin")
+		require.NoError(t, err)
+		require.NotNil(t, rep.Item.Metadata)
+		assert.Equal(t, "draft", rep.Item.Metadata.Attributes["label"])
+	})
+
+	t.Run("missing path", func(t *testing.T) {
+		_, err := client.SetPathMetadata(ctx, buck.Root.Key, "missing", "text/plain", nil)
+		require.Error(t, err)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		_, err := client.RemovePath(ctx, buck.Root.Key, "dir")
+		require.NoError(t, err)
+		_, _, err = client.PushPath(ctx, buck.Root.Key, "dir/file.bin", strings.NewReader("binary"))
+		require.NoError(t, err)
+
+		rep, err := client.ListPath(ctx, buck.Root.Key, "dir/file.bin")
+		require.NoError(t, err)
+		require.NotNil(t, rep.Item.Metadata)
+		assert.Empty(t, rep.Item.Metadata.Attributes)
+	})
+}
+
+func TestClient_PushPathBucketExceedLimit(t *testing.T) {
+	t.Parallel()
+	firstFile := "testdata/file1.jpg"
+	file1, err := os.Open(firstFile)
+	require.NoError(t, err)
+	defer file1.Close()
+	stat, err := os.Stat(firstFile)
+	require.NoError(t, err)
+
+	// Calculate a max-size which lets the first file
+	// be accepted, with a bit of margin. A second file
+	// will fail since it exceeded the bucket limit.
+	maxBucketSize := stat.Size() + 1024
+
+	conf := apitest.DefaultTextileConfig(t)
+	conf.BucketsMaxSize = maxBucketSize
+	ctx, client := setupWithConf(t, conf)
+
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
+
+	pth1, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
+	require.NoError(t, err)
+	assert.NotEmpty(t, pth1)
+	assert.NotEmpty(t, root1)
+
+	file2, err := os.Open("testdata/file2.jpg")
+	require.NoError(t, err)
+	defer file2.Close()
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to/file2.jpg", file2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), buckets.ErrBucketExceedsMaxSize.Error())
+}
+
+func TestClient_Quota(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	stat, err := os.Stat("testdata/file1.jpg")
+	require.NoError(t, err)
+	maxSize := stat.Size() + 1024
+
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
+
+	res, err := client.SetQuota(ctx, buck.Root.Key, maxSize)
+	require.NoError(t, err)
+	assert.Equal(t, maxSize, res.Quota.MaxSize)
+	assert.True(t, res.Quota.Remaining > 0)
+
+	file1, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file1.Close()
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
+	require.NoError(t, err)
+
+	quota, err := client.GetQuota(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	assert.Equal(t, maxSize, quota.MaxSize)
+	assert.True(t, quota.Size > stat.Size())
+	assert.Equal(t, maxSize-quota.Size, quota.Remaining)
+
+	file2, err := os.Open("testdata/file2.jpg")
+	require.NoError(t, err)
+	defer file2.Close()
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "file2.jpg", file2)
+	require.Error(t, err)
+	qerr, ok := c.QuotaExceeded(err)
+	require.True(t, ok)
+	assert.Equal(t, maxSize, qerr.MaxSize)
+	assert.Equal(t, quota.Remaining, qerr.Remaining)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := client.SetQuota(ctx, buck.Root.Key, -1)
+		require.Error(t, err)
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		res, err := client.SetQuota(ctx, buck.Root.Key, 0)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), res.Quota.MaxSize)
+
+		_, err = file2.Seek(0, io.SeekStart)
+		require.NoError(t, err)
+		_, _, err = client.PushPath(ctx, buck.Root.Key, "file2.jpg", file2)
+		require.NoError(t, err)
+	})
+}
+
+func TestClient_PushPathBucketsExceedLimit(t *testing.T) {
+	t.Parallel()
+	firstFile := "testdata/file1.jpg"
+	file1, err := os.Open(firstFile)
+	require.NoError(t, err)
+	defer file1.Close()
+	stat, err := os.Stat(firstFile)
+	require.NoError(t, err)
+
+	maxBucketsSize := stat.Size() + 1024
+
+	conf := apitest.DefaultTextileConfig(t)
+	conf.BucketsTotalMaxSize = maxBucketsSize
+	ctx, client := setupWithConf(t, conf)
+
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
+
+	pth1, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
+	require.NoError(t, err)
+	assert.NotEmpty(t, pth1)
+	assert.NotEmpty(t, root1)
+
+	// Create a second bucket. Since the limit is bucket-wide
+	// should fail.
+	buck, err = client.Init(ctx)
+	require.NoError(t, err)
+
+	file2, err := os.Open("testdata/file2.jpg")
+	require.NoError(t, err)
+	defer file2.Close()
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to/file2.jpg", file2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), buckets.ErrBucketsTotalSizeExceedsMaxSize.Error())
+}
+
+func TestClient_PushPathPrivateBucketsExceedLimit(t *testing.T) {
+	t.Parallel()
+	firstFile := "testdata/file1.jpg"
+	file1, err := os.Open(firstFile)
+	require.NoError(t, err)
+	defer file1.Close()
+	stat, err := os.Stat(firstFile)
+	require.NoError(t, err)
+
+	maxBucketsSize := stat.Size() + 1024
+
+	conf := apitest.DefaultTextileConfig(t)
+	conf.BucketsTotalMaxSize = maxBucketsSize
+	ctx, client := setupWithConf(t, conf)
+
+	buck, err := client.Init(ctx, c.WithPrivate(true))
+	require.NoError(t, err)
+
+	pth1, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
+	require.NoError(t, err)
+	assert.NotEmpty(t, pth1)
+	assert.NotEmpty(t, root1)
+
+	// Create a second bucket. Since the limit is bucket-wide
+	// should fail.
+	buck, err = client.Init(ctx)
+	require.NoError(t, err)
+
+	file2, err := os.Open("testdata/file2.jpg")
+	require.NoError(t, err)
+	defer file2.Close()
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to/file2.jpg", file2)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), buckets.ErrBucketsTotalSizeExceedsMaxSize.Error())
+}
+
+func pushPath(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
+
+	file1, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file1.Close()
+	progress1 := make(chan int64)
+	go func() {
+		for p := range progress1 {
+			t.Logf("progress: %d", p)
+		}
+	}()
+	pth1, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1, c.WithProgress(progress1))
+	require.NoError(t, err)
+	assert.NotEmpty(t, pth1)
+	assert.NotEmpty(t, root1)
+
+	file2, err := os.Open("testdata/file2.jpg")
+	require.NoError(t, err)
+	defer file2.Close()
+	info2, err := file2.Stat()
+	require.NoError(t, err)
+	progress2 := make(chan c.PushProgress)
+	stagesCh := make(chan []pb.PushPathReply_Event_Stage)
+	go func() {
+		var stages []pb.PushPathReply_Event_Stage
+		for p := range progress2 {
+			t.Logf("progress: %s %d/%d", p.Stage, p.Added, p.Received)
+			if len(stages) == 0 || stages[len(stages)-1] != p.Stage {
+				stages = append(stages, p.Stage)
+			}
+			if p.Stage != pb.PushPathReply_Event_Adding {
+				assert.Equal(t, info2.Size(), p.Received)
+			}
+		}
+		stagesCh <- stages
+	}()
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to/file2.jpg", file2, c.WithPushProgress(progress2))
+	require.NoError(t, err)
+	stages := <-stagesCh
+	require.NotEmpty(t, stages)
+	assert.Equal(t, []pb.PushPathReply_Event_Stage{
+		pb.PushPathReply_Event_Pinning,
+		pb.PushPathReply_Event_UpdatingRoot,
+	}, stages[len(stages)-2:])
+
+	rep1, err := client.ListPath(ctx, buck.Root.Key, "")
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(rep1.Item.Items))
+
+	// Try overwriting the path
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to/file2.jpg", strings.NewReader("seeya!"))
+	require.NoError(t, err)
+
+	rep2, err := client.ListPath(ctx, buck.Root.Key, "")
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(rep2.Item.Items))
+
+	// Overwrite the path again, this time replacing a file link with a dir link
+	file3, err := os.Open("testdata/file2.jpg")
+	require.NoError(t, err)
+	defer file3.Close()
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to", file3)
+	require.NoError(t, err)
+
+	rep3, err := client.ListPath(ctx, buck.Root.Key, "")
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(rep3.Item.Items))
+}
+
+func TestClient_Blocks(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	t.Run("public", func(t *testing.T) {
+		blocks(t, ctx, client, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		blocks(t, ctx, client, true)
+	})
+}
+
+func blocks(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
+
+	file, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file.Close()
+	res, _, err := client.PushPath(ctx, buck.Root.Key, "dir/file1.jpg", file)
+	require.NoError(t, err)
+
+	has, err := client.HasBlock(ctx, buck.Root.Key, res.Cid())
+	require.NoError(t, err)
+	assert.True(t, has)
+	has, err = client.HasBlock(ctx, buck.Root.Key, res.Cid(), c.WithBlockPath("dir"))
+	require.NoError(t, err)
+	assert.True(t, has)
+	data, err := client.GetBlock(ctx, buck.Root.Key, res.Cid(), c.WithBlockPath("dir/file1.jpg"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, data)
+
+	put, err := client.PutBlock(ctx, buck.Root.Key, []byte("not in bucket"), c.WithBlockFormat("raw"))
+	require.NoError(t, err)
+	has, err = client.HasBlock(ctx, buck.Root.Key, put)
+	require.NoError(t, err)
+	assert.False(t, has)
+	_, err = client.GetBlock(ctx, buck.Root.Key, put)
+	require.Error(t, err)
+
+	_, err = client.PutBlock(ctx, buck.Root.Key, []byte("wrong cid"), c.WithBlockFormat("raw"), c.WithBlockCid(put))
+	require.Error(t, err)
+}
+
+func TestClient_SetPath(t *testing.T) {
+	t.Parallel()
+
+	t.Run("public", func(t *testing.T) {
+		setPath(t, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		setPath(t, true)
+	})
+}
+
+func setPath(t *testing.T, private bool) {
+	innerPaths := []struct {
+		Name string
+		Path string
+		// How many files should be expected at the bucket root
+		// in the first SetHead.
+		NumFilesAtRootFirst int
+		// How many files should be expected at the *imported* Cid
+		// level.
+		NumFilesAtImportedLevelFirst int
+		// How many files should be expected at the bucket root
+		// in the second SetHead.
+		NumFilesAtRootSecond int
+		// How many files should be expected at the *imported* Cid
+		// level.
+		NumFilesAtImportedLevelSecond int
+	}{
+		{
+			Name: "nested",
+			Path: "nested",
+			// At root level, .seed and nested dir.
+			NumFilesAtRootFirst: 2,
+			// The first SetHead has one file, and one dir.
+			NumFilesAtImportedLevelFirst: 2,
+			NumFilesAtRootSecond:         2,
+			// The second SetHead only has one file.
+			NumFilesAtImportedLevelSecond: 1,
+		},
+		// Edge case, Path is empty. So "AtRoot" or "AtImportedLevel"
+		// is the same.
+		{
+			Name:                         "root",
+			Path:                         "",
+			NumFilesAtRootFirst:          3,
+			NumFilesAtImportedLevelFirst: 3,
+			// In both below cases, the files are the .seed
+			// and the fileVersion2.jpg.
+			NumFilesAtRootSecond:          2,
+			NumFilesAtImportedLevelSecond: 2,
+		},
+	}
+
+	for _, innerPath := range innerPaths {
+		t.Run(innerPath.Name, func(t *testing.T) {
+			ctx, client := setup(t)
+
+			file1, err := os.Open("testdata/file1.jpg")
+			require.NoError(t, err)
+			defer file1.Close()
+			file2, err := os.Open("testdata/file2.jpg")
+			require.NoError(t, err)
+			defer file2.Close()
+
+			ipfs, err := httpapi.NewApi(util.MustParseAddr("/ip4/127.0.0.1/tcp/5001"))
+			require.NoError(t, err)
+			p, err := ipfs.Unixfs().Add(
+				ctx,
+				ipfsfiles.NewMapDirectory(map[string]ipfsfiles.Node{
+					"file1.jpg": ipfsfiles.NewReaderFile(file1),
+					"folder1": ipfsfiles.NewMapDirectory(map[string]ipfsfiles.Node{
+						"file2.jpg": ipfsfiles.NewReaderFile(file2),
+					}),
+				}),
+			)
+			require.NoError(t, err)
+
+			buck, err := client.Init(ctx, c.WithPrivate(private))
+			require.NoError(t, err)
+
+			_, err = client.SetPath(ctx, buck.Root.Key, innerPath.Path, p.Cid())
+			require.NoError(t, err)
+
+			rep, err := client.ListPath(ctx, buck.Root.Key, "")
+			require.NoError(t, err)
+			assert.True(t, rep.Item.IsDir)
+			assert.Len(t, rep.Item.Items, innerPath.NumFilesAtRootFirst)
+
+			rep, err = client.ListPath(ctx, buck.Root.Key, innerPath.Path)
+			require.NoError(t, err)
+			assert.True(t, rep.Item.IsDir)
+			assert.Len(t, rep.Item.Items, innerPath.NumFilesAtImportedLevelFirst)
+
+			// SetPath again in the same path, but with a different dag.
+			// Should replace what was already under that path.
+			file1, err = os.Open("testdata/file1.jpg")
+			require.NoError(t, err)
+			defer file1.Close()
+			p, err = ipfs.Unixfs().Add(
+				ctx,
+				ipfsfiles.NewMapDirectory(map[string]ipfsfiles.Node{
+					"fileVersion2.jpg": ipfsfiles.NewReaderFile(file1),
+				}),
+			)
+			require.NoError(t, err)
+			_, err = client.SetPath(ctx, buck.Root.Key, innerPath.Path, p.Cid())
+			require.NoError(t, err)
+
+			rep, err = client.ListPath(ctx, buck.Root.Key, "")
+			require.NoError(t, err)
+			assert.True(t, rep.Item.IsDir)
+			assert.Len(t, rep.Item.Items, innerPath.NumFilesAtRootSecond)
+
+			rep, err = client.ListPath(ctx, buck.Root.Key, innerPath.Path)
+			require.NoError(t, err)
+			assert.True(t, rep.Item.IsDir)
+			assert.Len(t, rep.Item.Items, innerPath.NumFilesAtImportedLevelSecond)
+		})
+	}
+}
+
+func TestClient_ResumableUpload(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	t.Run("public", func(t *testing.T) {
+		resumableUpload(t, ctx, client, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		resumableUpload(t, ctx, client, true)
+	})
+}
+
+func resumableUpload(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
+
+	file, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file.Close()
+	info, err := file.Stat()
+	require.NoError(t, err)
+
+	start, err := client.StartUpload(ctx, buck.Root.Key, "dir/file1.jpg", info.Size(), c.WithMessage("big file"))
+	require.NoError(t, err)
+	assert.NotEmpty(t, start.SessionID)
+
+	half := info.Size() / 2
+	offset, err := client.PushUpload(ctx, start.SessionID, 0, io.LimitReader(file, half))
+	require.NoError(t, err)
+	assert.Equal(t, half, offset)
+
+	_, _, err = client.CompleteUpload(ctx, start.SessionID)
+	require.Error(t, err)
+
+	st, err := client.UploadStatus(ctx, start.SessionID)
+	require.NoError(t, err)
+	assert.Equal(t, half, st.Offset)
+	assert.Equal(t, info.Size(), st.Size)
+
+	_, err = client.PushUpload(ctx, start.SessionID, info.Size(), file)
+	require.Error(t, err)
+
+	result, root, err := client.ResumeUpload(ctx, start.SessionID, file)
+	require.NoError(t, err)
+	assert.NotEmpty(t, result)
+
+	rep, err := client.ListPath(ctx, buck.Root.Key, "dir/file1.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, root.String(), rep.Root.Path)
+	var buf bytes.Buffer
+	err = client.PullPath(ctx, buck.Root.Key, "dir/file1.jpg", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, info.Size(), int64(buf.Len()))
+
+	_, err = client.UploadStatus(ctx, start.SessionID)
+	require.Error(t, err)
+
+	start, err = client.StartUpload(ctx, buck.Root.Key, "file2.jpg", 0)
+	require.NoError(t, err)
+	err = client.CancelUpload(ctx, start.SessionID)
+	require.NoError(t, err)
+	_, err = client.UploadStatus(ctx, start.SessionID)
+	require.Error(t, err)
+}
+
+func TestClient_PullPath(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	t.Run("public", func(t *testing.T) {
+		pullPath(t, ctx, client, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		pullPath(t, ctx, client, true)
+	})
+}
+
+func pullPath(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
+
+	file, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file.Close()
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "file1.jpg", file)
+	require.NoError(t, err)
+
+	tmp, err := ioutil.TempFile("", "")
+	require.NoError(t, err)
+	defer tmp.Close()
+
+	progress := make(chan int64)
+	go func() {
+		for p := range progress {
+			t.Logf("progress: %d", p)
+		}
+	}()
+	err = client.PullPath(ctx, buck.Root.Key, "file1.jpg", tmp, c.WithProgress(progress))
+	require.NoError(t, err)
+	info, err := tmp.Stat()
+	require.NoError(t, err)
+	t.Logf("wrote file with size %d", info.Size())
+
+	note := "baps!"
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "one/two/note.txt", strings.NewReader(note))
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	var etag string
+	err = client.PullPath(ctx, buck.Root.Key, "one/two/note.txt", &buf, c.WithETag(&etag))
+	require.NoError(t, err)
+	assert.Equal(t, note, buf.String())
+	rep, err := client.ListPath(ctx, buck.Root.Key, "one/two/note.txt")
+	require.NoError(t, err)
+	assert.Equal(t, `"`+rep.Item.Cid+`"`, etag)
+
+	buf.Reset()
+	err = client.PullPath(ctx, buck.Root.Key, "one/two/note.txt", &buf, c.WithIfNoneMatch(etag))
+	require.True(t, errors.Is(err, c.ErrNotModified))
+	assert.Equal(t, 0, buf.Len())
+
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "one/two/note.txt", strings.NewReader("changed"))
+	require.NoError(t, err)
+	err = client.PullPath(ctx, buck.Root.Key, "one/two/note.txt", &buf, c.WithIfNoneMatch(etag))
+	require.NoError(t, err)
+	assert.Equal(t, "changed", buf.String())
+
+	buf.Reset()
+	var size int64
+	err = client.PullPath(ctx, buck.Root.Key, "one/two/note.txt", &buf, c.WithRange(2, 3), c.WithFileSize(&size))
+	require.NoError(t, err)
+	assert.Equal(t, "ang", buf.String())
+	if !private {
+		assert.Equal(t, int64(7), size)
+	}
+
+	buf.Reset()
+	err = client.PullPath(ctx, buck.Root.Key, "one/two/note.txt", &buf, c.WithRange(4, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "ged", buf.String())
+
+	err = client.PullPath(ctx, buck.Root.Key, "one/two/note.txt", &buf, c.WithRange(8, 0))
+	require.Error(t, err)
+}
+
+func TestClient_Compression(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
+
+	data := strings.Repeat(`{"level":"info","msg":"hello"}`+"\n", 1000)
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "logs/app.json", strings.NewReader(data), c.WithCompression())
+	require.NoError(t, err)
+	rep, err := client.ListPath(ctx, buck.Root.Key, "logs/app.json")
+	require.NoError(t, err)
+	assert.Equal(t, bucks.EncodingGzip, rep.Item.Metadata.ContentEncoding)
+	assert.Less(t, rep.Item.Size, int64(len(data)))
+
+	var buf bytes.Buffer
+	err = client.PullPath(ctx, buck.Root.Key, "logs/app.json", &buf)
+	require.NoError(t, err)
+	assert.Equal(t, data, buf.String())
+
+	buf.Reset()
+	err = client.PullPath(ctx, buck.Root.Key, "logs/app.json", &buf, c.WithRange(int64(len(data))-7, 0))
+	require.NoError(t, err)
+	assert.Equal(t, "ello\"}\n", buf.String())
+
+	buf.Reset()
+	err = client.PullPath(ctx, buck.Root.Key, "logs/app.json", &buf, c.WithEncoded())
+	require.NoError(t, err)
+	zr, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	plain, err := ioutil.ReadAll(zr)
+	require.NoError(t, err)
+	assert.Equal(t, data, string(plain))
+
+	// Non text-like content is stored as is
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "image.jpg", strings.NewReader("jpeg"), c.WithCompression())
+	require.NoError(t, err)
+	rep, err = client.ListPath(ctx, buck.Root.Key, "image.jpg")
+	require.NoError(t, err)
+	assert.Empty(t, rep.Item.Metadata.ContentEncoding)
+
+	// Overwriting without compression clears the encoding
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "logs/app.json", strings.NewReader("{}"))
+	require.NoError(t, err)
+	rep, err = client.ListPath(ctx, buck.Root.Key, "logs/app.json")
+	require.NoError(t, err)
+	assert.Empty(t, rep.Item.Metadata.ContentEncoding)
+}
+
+func TestClient_DeterministicPush(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	buck1, err := client.Init(ctx)
+	require.NoError(t, err)
+	buck2, err := client.Init(ctx)
+	require.NoError(t, err)
+
+	data := strings.Repeat("release", 100000)
+	var content1, content2 path.Resolved
+	p1, root1, err := client.PushPath(ctx, buck1.Root.Key, "dist/app.js", strings.NewReader(data),
+		c.WithDeterministic(), c.WithContentRoot(&content1))
+	require.NoError(t, err)
+	_, root2, err := client.PushPaths(ctx, buck2.Root.Key, []c.PushPathsFile{
+		{Path: "dist/app.js", Open: func() (io.ReadCloser, error) {
+			return ioutil.NopCloser(strings.NewReader(data)), nil
+		}},
+	}, c.WithDeterministic(), c.WithContentRoot(&content2))
+	require.NoError(t, err)
+	require.NotNil(t, content1)
+	assert.Equal(t, content1.String(), content2.String())
+	assert.NotEqual(t, root1.String(), root2.String())
+
+	rep, err := client.ListPath(ctx, buck2.Root.Key, "dist/app.js")
+	require.NoError(t, err)
+	assert.Equal(t, p1.Cid().String(), rep.Item.Cid)
+
+	_, _, err = client.PushPath(ctx, buck1.Root.Key, "app.json", strings.NewReader("{}"),
+		c.WithDeterministic(), c.WithCompression())
+	require.Error(t, err)
+
+	private, err := client.Init(ctx, c.WithPrivate(true))
+	require.NoError(t, err)
+	_, _, err = client.PushPath(ctx, private.Root.Key, "dist/app.js", strings.NewReader(data), c.WithDeterministic())
+	require.Error(t, err)
+}
+
+func TestClient_Remove(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	t.Run("public", func(t *testing.T) {
+		remove(t, ctx, client, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		remove(t, ctx, client, true)
+	})
+}
+
+func remove(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
+
+	file1, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file1.Close()
 	file2, err := os.Open("testdata/file2.jpg")
 	require.NoError(t, err)
 	defer file2.Close()
-	progress2 := make(chan int64)
-	go func() {
-		for p := range progress2 {
-			t.Logf("progress: %d", p)
-		}
-	}()
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to/file2.jpg", file2, c.WithProgress(progress2))
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
+	require.NoError(t, err)
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "again/file2.jpg", file2)
 	require.NoError(t, err)
 
-	rep1, err := client.ListPath(ctx, buck.Root.Key, "")
+	err = client.Remove(ctx, buck.Root.Key)
 	require.NoError(t, err)
-	assert.Equal(t, 3, len(rep1.Item.Items))
+	_, err = client.ListPath(ctx, buck.Root.Key, "again/file2.jpg")
+	require.Error(t, err)
+}
 
-	// Try overwriting the path
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to/file2.jpg", strings.NewReader("seeya!"))
+func TestClient_RemovePath(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	t.Run("public", func(t *testing.T) {
+		removePath(t, ctx, client, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		removePath(t, ctx, client, true)
+	})
+}
+
+func removePath(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
 	require.NoError(t, err)
 
-	rep2, err := client.ListPath(ctx, buck.Root.Key, "")
+	file1, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file1.Close()
+	file2, err := os.Open("testdata/file2.jpg")
+	require.NoError(t, err)
+	defer file2.Close()
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
+	require.NoError(t, err)
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "again/file2.jpg", file1)
 	require.NoError(t, err)
-	assert.Equal(t, 3, len(rep2.Item.Items))
 
-	// Overwrite the path again, this time replacing a file link with a dir link
-	file3, err := os.Open("testdata/file2.jpg")
+	pth, err := client.RemovePath(ctx, buck.Root.Key, "again/file2.jpg")
 	require.NoError(t, err)
-	defer file3.Close()
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "path/to", file3)
+	assert.NotEmpty(t, pth)
+	_, err = client.ListPath(ctx, buck.Root.Key, "again/file2.jpg")
+	require.Error(t, err)
+	rep, err := client.ListPath(ctx, buck.Root.Key, "")
 	require.NoError(t, err)
+	assert.Equal(t, 3, len(rep.Item.Items))
 
-	rep3, err := client.ListPath(ctx, buck.Root.Key, "")
+	_, err = client.RemovePath(ctx, buck.Root.Key, "again")
 	require.NoError(t, err)
-	assert.Equal(t, 3, len(rep3.Item.Items))
+	_, err = client.ListPath(ctx, buck.Root.Key, "again")
+	require.Error(t, err)
+	rep, err = client.ListPath(ctx, buck.Root.Key, "")
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(rep.Item.Items))
 }
 
-func TestClient_SetPath(t *testing.T) {
+func TestClient_MovePath(t *testing.T) {
 	t.Parallel()
+	ctx, client := setup(t)
 
 	t.Run("public", func(t *testing.T) {
-		setPath(t, false)
+		movePath(t, ctx, client, false)
 	})
 
 	t.Run("private", func(t *testing.T) {
-		setPath(t, true)
+		movePath(t, ctx, client, true)
 	})
 }
 
-func setPath(t *testing.T, private bool) {
-	innerPaths := []struct {
-		Name string
-		Path string
-		// How many files should be expected at the bucket root
-		// in the first SetHead.
-		NumFilesAtRootFirst int
-		// How many files should be expected at the *imported* Cid
-		// level.
-		NumFilesAtImportedLevelFirst int
-		// How many files should be expected at the bucket root
-		// in the second SetHead.
-		NumFilesAtRootSecond int
-		// How many files should be expected at the *imported* Cid
-		// level.
-		NumFilesAtImportedLevelSecond int
-	}{
-		{
-			Name: "nested",
-			Path: "nested",
-			// At root level, .seed and nested dir.
-			NumFilesAtRootFirst: 2,
-			// The first SetHead has one file, and one dir.
-			NumFilesAtImportedLevelFirst: 2,
-			NumFilesAtRootSecond:         2,
-			// The second SetHead only has one file.
-			NumFilesAtImportedLevelSecond: 1,
-		},
-		// Edge case, Path is empty. So "AtRoot" or "AtImportedLevel"
-		// is the same.
-		{
-			Name:                         "root",
-			Path:                         "",
-			NumFilesAtRootFirst:          3,
-			NumFilesAtImportedLevelFirst: 3,
-			// In both below cases, the files are the .seed
-			// and the fileVersion2.jpg.
-			NumFilesAtRootSecond:          2,
-			NumFilesAtImportedLevelSecond: 2,
-		},
-	}
+func movePath(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
 
-	for _, innerPath := range innerPaths {
-		t.Run(innerPath.Name, func(t *testing.T) {
-			ctx, client := setup(t)
+	file1, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file1.Close()
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "dir/file1.jpg", file1)
+	require.NoError(t, err)
+	_, err = client.SetPathMetadata(ctx, buck.Root.Key, "dir/file1.jpg", "image/jpeg", map[string]string{"author": "carol"})
+	require.NoError(t, err)
+	before, err := client.ListPath(ctx, buck.Root.Key, "dir/file1.jpg")
+	require.NoError(t, err)
 
-			file1, err := os.Open("testdata/file1.jpg")
-			require.NoError(t, err)
-			defer file1.Close()
-			file2, err := os.Open("testdata/file2.jpg")
-			require.NoError(t, err)
-			defer file2.Close()
+	_, err = client.MovePath(ctx, buck.Root.Key, "dir", "dir/sub")
+	require.Error(t, err)
+	_, err = client.MovePath(ctx, buck.Root.Key, "missing", "other")
+	require.Error(t, err)
 
-			ipfs, err := httpapi.NewApi(util.MustParseAddr("/ip4/127.0.0.1/tcp/5001"))
-			require.NoError(t, err)
-			p, err := ipfs.Unixfs().Add(
-				ctx,
-				ipfsfiles.NewMapDirectory(map[string]ipfsfiles.Node{
-					"file1.jpg": ipfsfiles.NewReaderFile(file1),
-					"folder1": ipfsfiles.NewMapDirectory(map[string]ipfsfiles.Node{
-						"file2.jpg": ipfsfiles.NewReaderFile(file2),
-					}),
-				}),
-			)
-			require.NoError(t, err)
+	pth, err := client.MovePath(ctx, buck.Root.Key, "dir/file1.jpg", "renamed.jpg")
+	require.NoError(t, err)
+	assert.NotEmpty(t, pth)
+	_, err = client.ListPath(ctx, buck.Root.Key, "dir/file1.jpg")
+	require.Error(t, err)
+	after, err := client.ListPath(ctx, buck.Root.Key, "renamed.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, before.Item.Size, after.Item.Size)
+	require.NotNil(t, after.Item.Metadata)
+	assert.Equal(t, "carol", after.Item.Metadata.Attributes["author"])
+	assert.Equal(t, before.Item.Metadata.UpdatedAt, after.Item.Metadata.UpdatedAt)
 
-			buck, err := client.Init(ctx, c.WithPrivate(private))
-			require.NoError(t, err)
+	_, err = client.MovePath(ctx, buck.Root.Key, "dir", "moved/dir")
+	require.NoError(t, err)
+	_, err = client.ListPath(ctx, buck.Root.Key, "dir")
+	require.Error(t, err)
+	_, err = client.ListPath(ctx, buck.Root.Key, "moved/dir")
+	require.NoError(t, err)
 
-			_, err = client.SetPath(ctx, buck.Root.Key, innerPath.Path, p.Cid())
-			require.NoError(t, err)
+	var buf bytes.Buffer
+	err = client.PullPath(ctx, buck.Root.Key, "renamed.jpg", &buf)
+	require.NoError(t, err)
+	info, err := file1.Stat()
+	require.NoError(t, err)
+	assert.Equal(t, info.Size(), int64(buf.Len()))
+}
 
-			rep, err := client.ListPath(ctx, buck.Root.Key, "")
-			require.NoError(t, err)
-			assert.True(t, rep.Item.IsDir)
-			assert.Len(t, rep.Item.Items, innerPath.NumFilesAtRootFirst)
+func TestClient_Licenses(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
 
-			rep, err = client.ListPath(ctx, buck.Root.Key, innerPath.Path)
-			require.NoError(t, err)
-			assert.True(t, rep.Item.IsDir)
-			assert.Len(t, rep.Item.Items, innerPath.NumFilesAtImportedLevelFirst)
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
 
-			// SetPath again in the same path, but with a different dag.
-			// Should replace what was already under that path.
-			file1, err = os.Open("testdata/file1.jpg")
-			require.NoError(t, err)
-			defer file1.Close()
-			p, err = ipfs.Unixfs().Add(
-				ctx,
-				ipfsfiles.NewMapDirectory(map[string]ipfsfiles.Node{
-					"fileVersion2.jpg": ipfsfiles.NewReaderFile(file1),
-				}),
-			)
-			require.NoError(t, err)
-			_, err = client.SetPath(ctx, buck.Root.Key, innerPath.Path, p.Cid())
-			require.NoError(t, err)
+	license, err := client.GetLicense(ctx, buck.Root.Key, "data/file.csv")
+	require.NoError(t, err)
+	assert.Nil(t, license)
+
+	_, err = client.SetLicense(ctx, buck.Root.Key, "", "")
+	require.Error(t, err)
 
-			rep, err = client.ListPath(ctx, buck.Root.Key, "")
-			require.NoError(t, err)
-			assert.True(t, rep.Item.IsDir)
-			assert.Len(t, rep.Item.Items, innerPath.NumFilesAtRootSecond)
+	_, err = client.SetLicense(ctx, buck.Root.Key, "", "MIT")
+	require.NoError(t, err)
+	license, err = client.SetLicense(ctx, buck.Root.Key, "/data", "CC-BY-4.0",
+		c.WithLicenseURL("https://creativecommons.org/licenses/by/4.0/"),
+		c.WithAttribution("Jon Doe"))
+	require.NoError(t, err)
+	assert.Equal(t, "data", license.Path)
 
-			rep, err = client.ListPath(ctx, buck.Root.Key, innerPath.Path)
-			require.NoError(t, err)
-			assert.True(t, rep.Item.IsDir)
-			assert.Len(t, rep.Item.Items, innerPath.NumFilesAtImportedLevelSecond)
-		})
-	}
+	license, err = client.GetLicense(ctx, buck.Root.Key, "data/file.csv")
+	require.NoError(t, err)
+	assert.Equal(t, "CC-BY-4.0", license.License)
+	assert.Equal(t, "Jon Doe", license.Attribution)
+	license, err = client.GetLicense(ctx, buck.Root.Key, "other.csv")
+	require.NoError(t, err)
+	assert.Equal(t, "MIT", license.License)
+
+	list, err := client.ListLicenses(ctx, buck.Root.Key)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(list.Licenses))
+
+	err = client.RemoveLicense(ctx, buck.Root.Key, "data")
+	require.NoError(t, err)
+	err = client.RemoveLicense(ctx, buck.Root.Key, "data")
+	require.Error(t, err)
+	license, err = client.GetLicense(ctx, buck.Root.Key, "data/file.csv")
+	require.NoError(t, err)
+	assert.Equal(t, "MIT", license.License)
 }
 
-func TestClient_PullPath(t *testing.T) {
+func TestClient_Versions(t *testing.T) {
 	t.Parallel()
 	ctx, client := setup(t)
 
 	t.Run("public", func(t *testing.T) {
-		pullPath(t, ctx, client, false)
+		versions(t, ctx, client, false)
 	})
 
 	t.Run("private", func(t *testing.T) {
-		pullPath(t, ctx, client, true)
+		versions(t, ctx, client, true)
 	})
 }
 
-func pullPath(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+func versions(t *testing.T, ctx context.Context, client *c.Client, private bool) {
 	buck, err := client.Init(ctx, c.WithPrivate(private))
 	require.NoError(t, err)
 
-	file, err := os.Open("testdata/file1.jpg")
+	file1, err := os.Open("testdata/file1.jpg")
 	require.NoError(t, err)
-	defer file.Close()
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "file1.jpg", file)
+	defer file1.Close()
+	_, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1, c.WithMessage("add file1"))
+	require.NoError(t, err)
+	_, err = client.RemovePath(ctx, buck.Root.Key, "file1.jpg", c.WithMessage("oops"))
 	require.NoError(t, err)
 
-	tmp, err := ioutil.TempFile("", "")
+	// Removed files can still be read at a previous root
+	_, err = client.ListPath(ctx, buck.Root.Key, "file1.jpg")
+	require.Error(t, err)
+	item, err := client.ListPath(ctx, buck.Root.Key, "file1.jpg", c.WithListRoot(root1))
 	require.NoError(t, err)
-	defer tmp.Close()
+	assert.Equal(t, "file1.jpg", item.Item.Name)
+	var buf bytes.Buffer
+	err = client.PullPath(ctx, buck.Root.Key, "file1.jpg", &buf, c.WithPullRoot(root1))
+	require.NoError(t, err)
+	data, err := ioutil.ReadFile("testdata/file1.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, data, buf.Bytes())
 
-	progress := make(chan int64)
-	go func() {
-		for p := range progress {
-			t.Logf("progress: %d", p)
-		}
-	}()
-	err = client.PullPath(ctx, buck.Root.Key, "file1.jpg", tmp, c.WithProgress(progress))
+	res, err := client.ListVersions(ctx, buck.Root.Key, 0)
 	require.NoError(t, err)
-	info, err := tmp.Stat()
+	require.Equal(t, 3, len(res.Versions))
+	assert.Equal(t, "oops", res.Versions[0].Message)
+	assert.Equal(t, "add file1", res.Versions[1].Message)
+	assert.Equal(t, root1.String(), res.Versions[1].Path)
+
+	res, err = client.ListVersions(ctx, buck.Root.Key, 1)
 	require.NoError(t, err)
-	t.Logf("wrote file with size %d", info.Size())
+	assert.Equal(t, 1, len(res.Versions))
 
-	note := "baps!"
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "one/two/note.txt", strings.NewReader(note))
+	_, err = client.RestoreVersion(ctx, buck.Root.Key, "bad")
+	require.Error(t, err)
+
+	root, err := client.RestoreVersion(ctx, buck.Root.Key, res.Versions[0].ID)
 	require.NoError(t, err)
+	assert.NotEqual(t, root1.String(), root.String())
 
-	var buf bytes.Buffer
-	err = client.PullPath(ctx, buck.Root.Key, "one/two/note.txt", &buf)
+	res, err = client.ListVersions(ctx, buck.Root.Key, 0)
 	require.NoError(t, err)
-	assert.Equal(t, note, buf.String())
+	root, err = client.RestoreVersion(ctx, buck.Root.Key, res.Versions[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, root1.String(), root.String())
+	_, err = client.ListPath(ctx, buck.Root.Key, "file1.jpg")
+	require.NoError(t, err)
+
+	res, err = client.ListVersions(ctx, buck.Root.Key, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 4, len(res.Versions))
 }
 
-func TestClient_Remove(t *testing.T) {
+func TestClient_Diff(t *testing.T) {
 	t.Parallel()
 	ctx, client := setup(t)
 
 	t.Run("public", func(t *testing.T) {
-		remove(t, ctx, client, false)
+		diff(t, ctx, client, false)
 	})
 
 	t.Run("private", func(t *testing.T) {
-		remove(t, ctx, client, true)
+		diff(t, ctx, client, true)
 	})
 }
 
-func remove(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+func diff(t *testing.T, ctx context.Context, client *c.Client, private bool) {
 	buck, err := client.Init(ctx, c.WithPrivate(private))
 	require.NoError(t, err)
 
 	file1, err := os.Open("testdata/file1.jpg")
 	require.NoError(t, err)
 	defer file1.Close()
+	_, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
+	require.NoError(t, err)
+
+	rep, err := client.Diff(ctx, buck.Root.Key, nil)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(rep.Changes))
+	assert.Equal(t, pb.DiffReply_Change_Add, rep.Changes[0].Type)
+	assert.Equal(t, "file1.jpg", rep.Changes[0].Path)
+
+	rep, err = client.Diff(ctx, buck.Root.Key, root1)
+	require.NoError(t, err)
+	assert.Empty(t, rep.Changes)
+
 	file2, err := os.Open("testdata/file2.jpg")
 	require.NoError(t, err)
 	defer file2.Close()
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
+	_, _, err = client.PushPath(ctx, buck.Root.Key, "dir/file2.jpg", file2)
 	require.NoError(t, err)
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "again/file2.jpg", file2)
+	_, err = client.RemovePath(ctx, buck.Root.Key, "file1.jpg")
 	require.NoError(t, err)
 
-	err = client.Remove(ctx, buck.Root.Key)
+	rep, err = client.Diff(ctx, buck.Root.Key, root1)
 	require.NoError(t, err)
-	_, err = client.ListPath(ctx, buck.Root.Key, "again/file2.jpg")
-	require.Error(t, err)
+	require.Equal(t, 2, len(rep.Changes))
+	types := map[string]pb.DiffReply_Change_Type{}
+	for _, ch := range rep.Changes {
+		types[ch.Path] = ch.Type
+	}
+	assert.Equal(t, pb.DiffReply_Change_Add, types["dir/file2.jpg"])
+	assert.Equal(t, pb.DiffReply_Change_Remove, types["file1.jpg"])
 }
 
-func TestClient_RemovePath(t *testing.T) {
+func TestClient_Snapshots(t *testing.T) {
 	t.Parallel()
 	ctx, client := setup(t)
 
 	t.Run("public", func(t *testing.T) {
-		removePath(t, ctx, client, false)
+		snapshots(t, ctx, client, false)
 	})
 
 	t.Run("private", func(t *testing.T) {
-		removePath(t, ctx, client, true)
+		snapshots(t, ctx, client, true)
 	})
 }
 
-func removePath(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+func snapshots(t *testing.T, ctx context.Context, client *c.Client, private bool) {
 	buck, err := client.Init(ctx, c.WithPrivate(private))
 	require.NoError(t, err)
 
 	file1, err := os.Open("testdata/file1.jpg")
 	require.NoError(t, err)
 	defer file1.Close()
-	file2, err := os.Open("testdata/file2.jpg")
+	_, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
 	require.NoError(t, err)
-	defer file2.Close()
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1)
+
+	_, err = client.SnapshotBucket(ctx, buck.Root.Key, "", "")
+	require.Error(t, err)
+	snap, err := client.SnapshotBucket(ctx, buck.Root.Key, "v1", "first")
 	require.NoError(t, err)
-	_, _, err = client.PushPath(ctx, buck.Root.Key, "again/file2.jpg", file1)
+	assert.Equal(t, root1.String(), snap.Path)
+	_, err = client.SnapshotBucket(ctx, buck.Root.Key, "v1", "")
+	require.Error(t, err)
+
+	_, err = client.RemovePath(ctx, buck.Root.Key, "file1.jpg")
 	require.NoError(t, err)
 
-	pth, err := client.RemovePath(ctx, buck.Root.Key, "again/file2.jpg")
+	res, err := client.ListSnapshots(ctx, buck.Root.Key)
 	require.NoError(t, err)
-	assert.NotEmpty(t, pth)
-	_, err = client.ListPath(ctx, buck.Root.Key, "again/file2.jpg")
+	require.Equal(t, 1, len(res.Snapshots))
+	assert.Equal(t, "first", res.Snapshots[0].Description)
+
+	_, err = client.RestoreSnapshot(ctx, buck.Root.Key, "bad")
 	require.Error(t, err)
-	rep, err := client.ListPath(ctx, buck.Root.Key, "")
+	root, err := client.RestoreSnapshot(ctx, buck.Root.Key, "v1")
+	require.NoError(t, err)
+	assert.Equal(t, root1.String(), root.String())
+	_, err = client.ListPath(ctx, buck.Root.Key, "file1.jpg")
 	require.NoError(t, err)
-	assert.Equal(t, 3, len(rep.Item.Items))
 
-	_, err = client.RemovePath(ctx, buck.Root.Key, "again")
+	err = client.RemoveSnapshot(ctx, buck.Root.Key, "v1")
 	require.NoError(t, err)
-	_, err = client.ListPath(ctx, buck.Root.Key, "again")
-	require.Error(t, err)
-	rep, err = client.ListPath(ctx, buck.Root.Key, "")
+	res, err = client.ListSnapshots(ctx, buck.Root.Key)
 	require.NoError(t, err)
-	assert.Equal(t, 2, len(rep.Item.Items))
+	assert.Empty(t, res.Snapshots)
+}
+
+func TestClient_ListHistory(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
+	file1, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file1.Close()
+	_, root1, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file1, c.WithMessage("add file1"))
+	require.NoError(t, err)
+	_, err = client.SnapshotBucket(ctx, buck.Root.Key, "v1", "")
+	require.NoError(t, err)
+	_, err = client.RemovePath(ctx, buck.Root.Key, "file1.jpg")
+	require.NoError(t, err)
+
+	res, err := client.ListHistory(ctx, buck.Root.Key, "", 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Entries))
+	assert.NotEmpty(t, res.NextCursor)
+	assert.Equal(t, root1.String(), res.Entries[1].Path)
+	assert.Equal(t, []string{"v1"}, res.Entries[1].Snapshots)
+
+	res, err = client.ListHistory(ctx, buck.Root.Key, res.NextCursor, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(res.Entries))
+	assert.Empty(t, res.NextCursor)
+
+	_, err = client.ListHistory(ctx, buck.Root.Key, "bad", 2)
+	require.Error(t, err)
 }
 
 func TestClient_ListIpfsPath(t *testing.T) {
@@ -738,6 +2129,323 @@ func TestClient_PullIpfsPath(t *testing.T) {
 	require.True(t, bytes.Equal(origBytes, tmpBytes))
 }
 
+func TestClient_ExportBucket(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	t.Run("public", func(t *testing.T) {
+		exportBucket(t, ctx, client, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		exportBucket(t, ctx, client, true)
+	})
+}
+
+func exportBucket(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
+
+	file1, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file1.Close()
+	info, err := file1.Stat()
+	require.NoError(t, err)
+	_, root, err := client.PushPath(ctx, buck.Root.Key, "dir/file1.jpg", file1)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	progress := make(chan int64)
+	go func() {
+		for range progress {
+		}
+	}()
+	err = client.ExportBucket(ctx, buck.Root.Key, &buf, c.WithProgress(progress))
+	require.NoError(t, err)
+	assert.Greater(t, int64(buf.Len()), info.Size())
+
+	// The header lists the bucket root
+	size, n := binary.Uvarint(buf.Bytes())
+	require.Greater(t, n, 0)
+	assert.True(t, bytes.Contains(buf.Bytes()[n:n+int(size)], root.Cid().Bytes()))
+}
+
+func TestClient_ImportBucket(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	t.Run("public", func(t *testing.T) {
+		importBucket(t, ctx, client, false)
+	})
+
+	t.Run("private", func(t *testing.T) {
+		importBucket(t, ctx, client, true)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		buck, err := client.Init(ctx)
+		require.NoError(t, err)
+		_, err = client.ImportBucket(ctx, buck.Root.Key, strings.NewReader("not a car file"))
+		require.Error(t, err)
+	})
+}
+
+func importBucket(t *testing.T, ctx context.Context, client *c.Client, private bool) {
+	buck, err := client.Init(ctx, c.WithPrivate(private))
+	require.NoError(t, err)
+
+	file1, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file1.Close()
+	_, root1, err := client.PushPath(ctx, buck.Root.Key, "dir/file1.jpg", file1)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = client.ExportBucket(ctx, buck.Root.Key, &buf)
+	require.NoError(t, err)
+	exported := buf.Bytes()
+
+	file2, err := os.Open("testdata/file2.jpg")
+	require.NoError(t, err)
+	defer file2.Close()
+	_, root2, err := client.PushPath(ctx, buck.Root.Key, "file2.jpg", file2)
+	require.NoError(t, err)
+
+	// Imports are rejected if the bucket changed
+	_, err = client.ImportBucket(ctx, buck.Root.Key, bytes.NewReader(exported), c.WithFastForwardOnly(root1))
+	require.Error(t, err)
+
+	root, err := client.ImportBucket(ctx, buck.Root.Key, bytes.NewReader(exported), c.WithFastForwardOnly(root2))
+	require.NoError(t, err)
+	assert.Equal(t, root1.String(), root.Path)
+	rep, err := client.ListPath(ctx, buck.Root.Key, "")
+	require.NoError(t, err)
+	assert.Len(t, rep.Item.Items, 2) // seed and dir
+
+	// Exports of private buckets can't be imported elsewhere
+	other, err := client.Init(ctx)
+	require.NoError(t, err)
+	_, err = client.ImportBucket(ctx, other.Root.Key, bytes.NewReader(exported))
+	if private {
+		require.Error(t, err)
+	} else {
+		require.NoError(t, err)
+	}
+
+	if !private {
+		nroot, err := client.ImportNewBucket(ctx, bytes.NewReader(exported), c.WithName("imported"))
+		require.NoError(t, err)
+		assert.NotEqual(t, buck.Root.Key, nroot.Key)
+		assert.Equal(t, "imported", nroot.Name)
+		rep, err = client.ListPath(ctx, nroot.Key, "dir/file1.jpg")
+		require.NoError(t, err)
+		assert.False(t, rep.Item.IsDir)
+	}
+}
+
+func TestClient_AddDomain(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := client.AddDomain(ctx, buck.Root.Key, "not a domain", c.WithCloudflare("zone", "token"))
+		require.Error(t, err)
+		_, err = client.AddDomain(ctx, buck.Root.Key, "site.example.invalid", c.WithRoute53("zone", "", ""))
+		require.Error(t, err)
+	})
+
+	t.Run("add", func(t *testing.T) {
+		d, err := client.AddDomain(ctx, buck.Root.Key, "Site.Example.invalid", c.WithCloudflare("zone", "token"))
+		require.NoError(t, err)
+		assert.Equal(t, "site.example.invalid", d.Name)
+		assert.Equal(t, "_textile-challenge.site.example.invalid", d.ChallengeName)
+		assert.NotEmpty(t, d.ChallengeValue)
+		assert.False(t, d.Verified)
+
+		// Domains can only be used by one bucket
+		_, err = client.AddDomain(ctx, buck.Root.Key, "site.example.invalid", c.WithRoute53("zone", "key", "secret"))
+		require.Error(t, err)
+
+		// The challenge record doesn't exist
+		_, err = client.VerifyDomain(ctx, buck.Root.Key, d.Id)
+		require.Error(t, err)
+
+		list, err := client.ListDomains(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, d.Id, list[0].Id)
+		rep, err := client.Root(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		require.Len(t, rep.Domains, 1)
+
+		err = client.RemoveDomain(ctx, buck.Root.Key, d.Id)
+		require.NoError(t, err)
+		list, err = client.ListDomains(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+	})
+}
+
+func TestClient_AddPinMirror(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	var lock sync.Mutex
+	pins := make(map[string]string)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		id := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, "/pins"), "/")
+		switch r.Method {
+		case http.MethodPost:
+			var pin struct {
+				Cid string `json:"cid"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&pin))
+			if id == "" {
+				id = fmt.Sprintf("req%d", len(pins))
+			}
+			pins[id] = pin.Cid
+			w.WriteHeader(http.StatusAccepted)
+			_, _ = fmt.Fprintf(w, `{"requestid":"%s","status":"pinned","pin":{"cid":"%s"}}`, id, pin.Cid)
+		case http.MethodDelete:
+			delete(pins, id)
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer srv.Close()
+
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
+
+	t.Run("invalid endpoint", func(t *testing.T) {
+		_, err := client.AddPinMirror(ctx, buck.Root.Key, "ftp://pins", "token")
+		require.Error(t, err)
+	})
+
+	t.Run("mirror", func(t *testing.T) {
+		m, err := client.AddPinMirror(ctx, buck.Root.Key, srv.URL, "token")
+		require.NoError(t, err)
+		assert.True(t, m.Pending)
+
+		file, err := os.Open("testdata/file1.jpg")
+		require.NoError(t, err)
+		defer file.Close()
+		_, root, err := client.PushPath(ctx, buck.Root.Key, "file1.jpg", file)
+		require.NoError(t, err)
+
+		require.Eventually(t, func() bool {
+			rep, err := client.Root(ctx, buck.Root.Key)
+			require.NoError(t, err)
+			require.Len(t, rep.Mirrors, 1)
+			return !rep.Mirrors[0].Pending && rep.Mirrors[0].LastRoot == root.String()
+		}, time.Minute, time.Second)
+		list, err := client.ListPinMirrors(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, "pinned", list[0].Status)
+		lock.Lock()
+		assert.Equal(t, root.Cid().String(), pins[list[0].RequestId])
+		assert.Len(t, pins, 1)
+		lock.Unlock()
+
+		err = client.RemovePinMirror(ctx, buck.Root.Key, m.Id)
+		require.NoError(t, err)
+		list, err = client.ListPinMirrors(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		assert.Empty(t, list)
+		lock.Lock()
+		assert.Empty(t, pins)
+		lock.Unlock()
+	})
+}
+
+func TestClient_StartS3Import(t *testing.T) {
+	t.Parallel()
+	ctx, client := setup(t)
+
+	objects := map[string]string{
+		"src/":          "",
+		"src/a.txt":     "hello",
+		"src/dir/b.txt": "world",
+		"other/c.txt":   "skipped",
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/source/")
+		if !strings.HasPrefix(r.URL.Path, "/source/") {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if key != "" {
+			data, ok := objects[key]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(data))
+			return
+		}
+		var keys []string
+		for k := range objects {
+			if strings.HasPrefix(k, r.URL.Query().Get("prefix")) && k > r.URL.Query().Get("start-after") {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		res := "<ListBucketResult><IsTruncated>false</IsTruncated>"
+		for _, k := range keys {
+			res += fmt.Sprintf("<Contents><Key>%s</Key><Size>%d</Size></Contents>", k, len(objects[k]))
+		}
+		_, _ = w.Write([]byte(res + "</ListBucketResult>"))
+	}))
+	defer srv.Close()
+
+	buck, err := client.Init(ctx)
+	require.NoError(t, err)
+
+	t.Run("invalid source", func(t *testing.T) {
+		_, err := client.StartS3Import(ctx, buck.Root.Key, "missing", c.WithS3Endpoint(srv.URL))
+		require.Error(t, err)
+	})
+
+	t.Run("import", func(t *testing.T) {
+		imp, err := client.StartS3Import(ctx, buck.Root.Key, "source",
+			c.WithS3Endpoint(srv.URL),
+			c.WithS3Prefix("src/"),
+			c.WithImportPath("imported"))
+		require.NoError(t, err)
+		assert.Equal(t, "s3://source/src/", imp.Source)
+		assert.Equal(t, pb.BucketImport_Queued, imp.Status)
+
+		require.Eventually(t, func() bool {
+			list, err := client.ListImports(ctx, buck.Root.Key)
+			require.NoError(t, err)
+			require.Len(t, list, 1)
+			return list[0].Status == pb.BucketImport_Done
+		}, time.Minute, time.Second)
+		list, err := client.ListImports(ctx, buck.Root.Key)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), list[0].Objects)
+		assert.Equal(t, int64(10), list[0].Bytes)
+
+		var buf bytes.Buffer
+		err = client.PullPath(ctx, buck.Root.Key, "imported/dir/b.txt", &buf)
+		require.NoError(t, err)
+		assert.Equal(t, "world", buf.String())
+
+		// Finished imports can't be canceled
+		err = client.CancelImport(ctx, buck.Root.Key, imp.Id)
+		require.Error(t, err)
+	})
+}
+
 func TestClose(t *testing.T) {
 	t.Parallel()
 	conf := apitest.MakeTextile(t)
@@ -0,0 +1,37 @@
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pb "github.com/textileio/textile/api/buckets/pb"
+	"github.com/textileio/textile/buckets"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPushRejection(t *testing.T) {
+	t.Run("rejection", func(t *testing.T) {
+		st, err := status.New(codes.FailedPrecondition, "push rejected").WithDetails(&pb.PushRejection{
+			Violations: []*pb.PushRejection_Violation{
+				{Rule: buckets.RuleForbiddenExtension, Path: "a.exe", Detail: "not allowed"},
+				{Rule: buckets.RuleRequiredPath, Path: "LICENSE", Detail: "missing"},
+			},
+		})
+		require.NoError(t, err)
+		rerr, ok := PushRejection(st.Err())
+		require.True(t, ok)
+		require.Equal(t, 2, len(rerr.Violations))
+		assert.Equal(t, buckets.PolicyViolation{Rule: buckets.RuleRequiredPath, Path: "LICENSE", Detail: "missing"}, rerr.Violations[1])
+		assert.Contains(t, rerr.Error(), "forbidden_extension: a.exe (not allowed)")
+	})
+
+	t.Run("other errors", func(t *testing.T) {
+		_, ok := PushRejection(status.Error(codes.FailedPrecondition, "nope"))
+		assert.False(t, ok)
+		_, ok = PushRejection(fmt.Errorf("nope"))
+		assert.False(t, ok)
+	})
+}
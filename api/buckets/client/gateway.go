@@ -0,0 +1,252 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ipfs/interface-go-ipfs-core/path"
+)
+
+const (
+	defaultProbeTimeout = time.Second * 5
+	defaultProbeTTL     = time.Minute * 10
+)
+
+// ErrNoGateway indicates none of the configured gateways are reachable.
+var ErrNoGateway = fmt.Errorf("no gateway is reachable")
+
+type gatewayOptions struct {
+	timeout time.Duration
+	ttl     time.Duration
+	client  *http.Client
+}
+
+type GatewayOption func(*gatewayOptions)
+
+// WithProbeTimeout sets the max time to wait for a gateway to respond to a probe.
+func WithProbeTimeout(timeout time.Duration) GatewayOption {
+	return func(args *gatewayOptions) {
+		args.timeout = timeout
+	}
+}
+
+// WithProbeTTL sets how long probe results are cached before gateways are probed again.
+func WithProbeTTL(ttl time.Duration) GatewayOption {
+	return func(args *gatewayOptions) {
+		args.ttl = ttl
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to probe and pull from gateways.
+func WithHTTPClient(client *http.Client) GatewayOption {
+	return func(args *gatewayOptions) {
+		args.client = client
+	}
+}
+
+// GatewayResolver picks the lowest latency gateway from a set of gateway URLs.
+// Gateways are probed with their health endpoint and the results are cached.
+// Gateways that fail are skipped until the next probe.
+type GatewayResolver struct {
+	gateways []string
+	args     *gatewayOptions
+
+	lk      sync.Mutex
+	ranked  []string
+	expires time.Time
+}
+
+// NewGatewayResolver returns a resolver for gateways, e.g., https://hub.textile.io.
+func NewGatewayResolver(gateways []string, opts ...GatewayOption) (*GatewayResolver, error) {
+	if len(gateways) == 0 {
+		return nil, fmt.Errorf("at least one gateway is required")
+	}
+	args := &gatewayOptions{
+		timeout: defaultProbeTimeout,
+		ttl:     defaultProbeTTL,
+		client:  http.DefaultClient,
+	}
+	for _, opt := range opts {
+		opt(args)
+	}
+	gws := make([]string, len(gateways))
+	for i, g := range gateways {
+		u, err := url.Parse(g)
+		if err != nil {
+			return nil, err
+		}
+		if u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("gateway %s must include a scheme and host", g)
+		}
+		gws[i] = strings.TrimSuffix(g, "/")
+	}
+	return &GatewayResolver{gateways: gws, args: args}, nil
+}
+
+// Resolve returns the lowest latency reachable gateway.
+func (r *GatewayResolver) Resolve(ctx context.Context) (string, error) {
+	ranked, err := r.rank(ctx)
+	if err != nil {
+		return "", err
+	}
+	return ranked[0], nil
+}
+
+// Fail marks gateway as unreachable until the next probe.
+func (r *GatewayResolver) Fail(gateway string) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	for i, g := range r.ranked {
+		if g == gateway {
+			r.ranked = append(r.ranked[:i:i], r.ranked[i+1:]...)
+			break
+		}
+	}
+	if len(r.ranked) == 0 {
+		r.expires = time.Time{}
+	}
+}
+
+// rank returns reachable gateways ordered by latency, probing them if the cache has expired.
+func (r *GatewayResolver) rank(ctx context.Context) ([]string, error) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	if len(r.ranked) > 0 && time.Now().Before(r.expires) {
+		return append([]string(nil), r.ranked...), nil
+	}
+
+	type result struct {
+		gateway string
+		latency time.Duration
+	}
+	var (
+		results []result
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+	)
+	for _, g := range r.gateways {
+		wg.Add(1)
+		go func(g string) {
+			defer wg.Done()
+			latency, err := r.probe(ctx, g)
+			if err != nil {
+				return
+			}
+			mu.Lock()
+			results = append(results, result{gateway: g, latency: latency})
+			mu.Unlock()
+		}(g)
+	}
+	wg.Wait()
+	if len(results) == 0 {
+		return nil, ErrNoGateway
+	}
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].latency < results[j].latency
+	})
+	r.ranked = make([]string, len(results))
+	for i, res := range results {
+		r.ranked[i] = res.gateway
+	}
+	r.expires = time.Now().Add(r.args.ttl)
+	return append([]string(nil), r.ranked...), nil
+}
+
+// probe returns the time it takes gateway to respond to a health check.
+func (r *GatewayResolver) probe(ctx context.Context, gateway string) (time.Duration, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.args.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gateway+"/health", nil)
+	if err != nil {
+		return 0, err
+	}
+	start := time.Now()
+	res, err := r.args.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= http.StatusBadRequest {
+		return 0, fmt.Errorf("gateway %s responded with status %d", gateway, res.StatusCode)
+	}
+	return time.Since(start), nil
+}
+
+// rewrite replaces the scheme and host of link with that of gateway.
+func rewrite(link, gateway string) string {
+	if link == "" {
+		return link
+	}
+	u, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	g, err := url.Parse(gateway)
+	if err != nil {
+		return link
+	}
+	u.Scheme = g.Scheme
+	u.Host = g.Host
+	return u.String()
+}
+
+// pull writes the UnixFS file at pth to writer from the fastest gateway.
+// If a gateway fails before any data is written, the next fastest gateway is tried.
+func (r *GatewayResolver) pull(ctx context.Context, pth path.Path, writer io.Writer, progress chan<- int64) error {
+	ranked, err := r.rank(ctx)
+	if err != nil {
+		return err
+	}
+	for _, g := range ranked {
+		written, err := r.pullFrom(ctx, g, pth, writer, progress)
+		if err == nil {
+			return nil
+		}
+		if written > 0 || ctx.Err() != nil {
+			return err
+		}
+		r.Fail(g)
+	}
+	return ErrNoGateway
+}
+
+func (r *GatewayResolver) pullFrom(ctx context.Context, gateway string, pth path.Path, writer io.Writer, progress chan<- int64) (int64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, gateway+pth.String(), nil)
+	if err != nil {
+		return 0, err
+	}
+	res, err := r.args.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("gateway %s responded with status %d", gateway, res.StatusCode)
+	}
+	var written int64
+	buf := make([]byte, chunkSize*32)
+	for {
+		n, err := res.Body.Read(buf)
+		if n > 0 {
+			if _, werr := writer.Write(buf[:n]); werr != nil {
+				return written, werr
+			}
+			written += int64(n)
+			if progress != nil {
+				progress <- written
+			}
+		}
+		if err == io.EOF {
+			return written, nil
+		} else if err != nil {
+			return written, err
+		}
+	}
+}
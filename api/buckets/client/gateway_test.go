@@ -0,0 +1,69 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGateway(t *testing.T, delay time.Duration, content string) *httptest.Server {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		if r.URL.Path == "/health" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		_, _ = w.Write([]byte(content))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func TestGatewayResolver(t *testing.T) {
+	t.Parallel()
+	fast := newTestGateway(t, 0, "fast")
+	slow := newTestGateway(t, time.Millisecond*200, "slow")
+
+	t.Run("resolves lowest latency", func(t *testing.T) {
+		r, err := NewGatewayResolver([]string{slow.URL, fast.URL})
+		require.NoError(t, err)
+		g, err := r.Resolve(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, fast.URL, g)
+	})
+
+	t.Run("fails over", func(t *testing.T) {
+		down := newTestGateway(t, 0, "")
+		down.Close()
+		r, err := NewGatewayResolver([]string{down.URL, slow.URL})
+		require.NoError(t, err)
+		g, err := r.Resolve(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, slow.URL, g)
+
+		r.Fail(slow.URL)
+		_, err = r.Resolve(context.Background())
+		require.NoError(t, err)
+	})
+
+	t.Run("pulls", func(t *testing.T) {
+		r, err := NewGatewayResolver([]string{fast.URL})
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		err = r.pull(context.Background(), path.New("/ipfs/bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"), &buf, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "fast", buf.String())
+	})
+
+	t.Run("rewrites links", func(t *testing.T) {
+		link := rewrite("http://127.0.0.1:8006/ipns/key", "https://gw.example.com")
+		assert.Equal(t, "https://gw.example.com/ipns/key", link)
+	})
+}
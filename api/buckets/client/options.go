@@ -3,11 +3,14 @@ package client
 import (
 	"github.com/ipfs/go-cid"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-threads/core/thread"
+	pb "github.com/textileio/textile/api/buckets/pb"
 )
 
 type initOptions struct {
 	name    string
 	private bool
+	key     []byte
 	fromCid cid.Cid
 }
 
@@ -27,6 +30,15 @@ func WithPrivate(private bool) InitOption {
 	}
 }
 
+// WithKey sets the 64 byte encryption key of a private bucket.
+// By default, the remote generates a random key.
+func WithKey(key []byte) InitOption {
+	return func(args *initOptions) {
+		args.private = true
+		args.key = key
+	}
+}
+
 // WithCid indicates that an inited bucket should be boostraped
 // with a particular UnixFS DAG.
 func WithCid(c cid.Cid) InitOption {
@@ -35,9 +47,40 @@ func WithCid(c cid.Cid) InitOption {
 	}
 }
 
+type listOptions struct {
+	tags map[string]string
+}
+
+type ListOption func(*listOptions)
+
+// WithTagFilter only lists buckets that have all of the given key/value tags.
+// Bucket tags inherit from the tags of their thread.
+func WithTagFilter(tags map[string]string) ListOption {
+	return func(args *listOptions) {
+		args.tags = tags
+	}
+}
+
 type options struct {
-	root     path.Resolved
-	progress chan<- int64
+	root          path.Resolved
+	progress      chan<- int64
+	pushProgress  chan<- PushProgress
+	gateway       *GatewayResolver
+	message       string
+	concurrency   int
+	contentType   string
+	attributes    map[string]string
+	ifNoneMatch   string
+	etag          *string
+	conflictPath  *string
+	offset        int64
+	length        int64
+	size          *int64
+	compress      bool
+	encoded       bool
+	deterministic bool
+	contentRoot   *path.Resolved
+	at            path.Resolved
 }
 
 type Option func(*options)
@@ -55,3 +98,483 @@ func WithProgress(ch chan<- int64) Option {
 		args.progress = ch
 	}
 }
+
+// WithPushProgress writes the stage and byte counts of a file pushed with PushPath to the given channel.
+// Updates are sent periodically while the file is added, and once each following stage starts.
+func WithPushProgress(ch chan<- PushProgress) Option {
+	return func(args *options) {
+		args.pushProgress = ch
+	}
+}
+
+// WithGateway uses the lowest latency gateway from r to generate links and pull IPFS paths.
+func WithGateway(r *GatewayResolver) Option {
+	return func(args *options) {
+		args.gateway = r
+	}
+}
+
+// WithMessage describes the change in the bucket's version history.
+func WithMessage(msg string) Option {
+	return func(args *options) {
+		args.message = msg
+	}
+}
+
+// WithConcurrency sets the number of files that are read and sent at the same time by PushPaths.
+func WithConcurrency(n int) Option {
+	return func(args *options) {
+		args.concurrency = n
+	}
+}
+
+// WithContentType overrides the content type detected by the remote for a file pushed with PushPath.
+func WithContentType(contentType string) Option {
+	return func(args *options) {
+		args.contentType = contentType
+	}
+}
+
+// WithIfNoneMatch skips pulling a file with PullPath if its ETag matches etag.
+// PullPath returns ErrNotModified if the file was skipped.
+func WithIfNoneMatch(etag string) Option {
+	return func(args *options) {
+		args.ifNoneMatch = etag
+	}
+}
+
+// WithETag stores the ETag of the file pulled with PullPath in etag.
+// The ETag is a quoted CID that only changes if the file content changes.
+func WithETag(etag *string) Option {
+	return func(args *options) {
+		args.etag = etag
+	}
+}
+
+// WithConflictPath stores the path a file pushed with PushPath or CompleteUpload was written to in pth
+// if the bucket kept it next to a conflicting change. Otherwise, pth is set to an empty string.
+func WithConflictPath(pth *string) Option {
+	return func(args *options) {
+		args.conflictPath = pth
+	}
+}
+
+// WithRange limits a file pulled with PullPath to length bytes starting at offset.
+// A zero length reads to the end of the file.
+func WithRange(offset, length int64) Option {
+	return func(args *options) {
+		args.offset = offset
+		args.length = length
+	}
+}
+
+// WithFileSize stores the total size of the file pulled with PullPath in size.
+// The size of files in private buckets is not known by the remote, so size is left unchanged.
+func WithFileSize(size *int64) Option {
+	return func(args *options) {
+		args.size = size
+	}
+}
+
+// WithCompression compresses a text-like file pushed with PushPath at rest.
+// The file's metadata records the content encoding, and PullPath decompresses the file
+// unless WithEncoded is used. Files that are not text-like are stored as is.
+// Note that compressed files have a different CID than the original content.
+func WithCompression() Option {
+	return func(args *options) {
+		args.compress = true
+	}
+}
+
+// WithDeterministic pushes files with PushPath or PushPaths using fixed UnixFS parameters,
+// so that the same files always have the same CIDs. Not supported for private buckets or with compression.
+// Use WithContentRoot to get the resulting root of the bucket's files without the bucket's random seed.
+func WithDeterministic() Option {
+	return func(args *options) {
+		args.deterministic = true
+	}
+}
+
+// WithContentRoot stores the content root of a bucket after a deterministic push in root.
+// The content root is the bucket root without the bucket's random seed,
+// so pushing the same files deterministically to any bucket results in the same content root.
+func WithContentRoot(root *path.Resolved) Option {
+	return func(args *options) {
+		args.contentRoot = root
+	}
+}
+
+// WithEncoded pulls a compressed file with PullPath as it's stored, without decompressing it.
+func WithEncoded() Option {
+	return func(args *options) {
+		args.encoded = true
+	}
+}
+
+// WithPullRoot pulls a file with PullPath from a previous bucket root, e.g., the path of a version or snapshot.
+func WithPullRoot(root path.Resolved) Option {
+	return func(args *options) {
+		args.at = root
+	}
+}
+
+// WithAttributes attaches app-specific key/value attributes to a file pushed with PushPath.
+// Attributes are merged into existing attributes. An empty value removes an attribute.
+func WithAttributes(attrs map[string]string) Option {
+	return func(args *options) {
+		args.attributes = attrs
+	}
+}
+
+type licenseOptions struct {
+	url         string
+	attribution string
+}
+
+type LicenseOption func(*licenseOptions)
+
+// WithLicenseURL sets a URL where the full license text can be found.
+func WithLicenseURL(url string) LicenseOption {
+	return func(args *licenseOptions) {
+		args.url = url
+	}
+}
+
+// WithAttribution sets the attribution that must accompany licensed content.
+func WithAttribution(attribution string) LicenseOption {
+	return func(args *licenseOptions) {
+		args.attribution = attribution
+	}
+}
+
+type replicationOptions struct {
+	apiKey    string
+	apiSecret string
+	token     thread.Token
+	insecure  bool
+}
+
+type ReplicationOption func(*replicationOptions)
+
+// WithRemoteAPIKey sets the API key and secret used to authenticate with the remote hub.
+// The secret is only required for secure keys.
+func WithRemoteAPIKey(key, secret string) ReplicationOption {
+	return func(args *replicationOptions) {
+		args.apiKey = key
+		args.apiSecret = secret
+	}
+}
+
+// WithInsecureReplication connects to the remote hub without TLS.
+// The hub only accepts insecure targets if it allows private endpoints, which is meant for development.
+func WithInsecureReplication() ReplicationOption {
+	return func(args *replicationOptions) {
+		args.insecure = true
+	}
+}
+
+// WithRemoteToken sets a thread token for the remote thread.
+// This is required when authenticating with a user group API key.
+func WithRemoteToken(token thread.Token) ReplicationOption {
+	return func(args *replicationOptions) {
+		args.token = token
+	}
+}
+
+type s3ImportOptions struct {
+	path      string
+	endpoint  string
+	region    string
+	prefix    string
+	accessKey string
+	secretKey string
+}
+
+type S3ImportOption func(*s3ImportOptions)
+
+// WithImportPath sets the bucket path objects are imported to.
+// By default, objects are imported to the bucket root.
+func WithImportPath(pth string) S3ImportOption {
+	return func(args *s3ImportOptions) {
+		args.path = pth
+	}
+}
+
+// WithS3Endpoint sets the base URL of an S3 compatible service.
+// By default, AWS is used.
+func WithS3Endpoint(endpoint string) S3ImportOption {
+	return func(args *s3ImportOptions) {
+		args.endpoint = endpoint
+	}
+}
+
+// WithS3Region sets the region of the source bucket.
+func WithS3Region(region string) S3ImportOption {
+	return func(args *s3ImportOptions) {
+		args.region = region
+	}
+}
+
+// WithS3Prefix limits the import to objects with prefix.
+// The prefix is stripped from object keys to get their bucket paths.
+func WithS3Prefix(prefix string) S3ImportOption {
+	return func(args *s3ImportOptions) {
+		args.prefix = prefix
+	}
+}
+
+// WithS3Credentials sets the access key used to read the source bucket.
+// Public buckets can be read without credentials.
+func WithS3Credentials(accessKey, secretKey string) S3ImportOption {
+	return func(args *s3ImportOptions) {
+		args.accessKey = accessKey
+		args.secretKey = secretKey
+	}
+}
+
+type domainProviderOptions struct {
+	name      string
+	zoneID    string
+	token     string
+	accessKey string
+	secretKey string
+}
+
+// DomainProvider describes the DNS provider that hosts the zone of a custom domain.
+type DomainProvider func(*domainProviderOptions)
+
+// WithCloudflare manages DNSLink records in the Cloudflare zone with zoneID.
+// The token must be a Cloudflare API token with permission to edit the zone's DNS records.
+func WithCloudflare(zoneID, token string) DomainProvider {
+	return func(args *domainProviderOptions) {
+		args.name = "cloudflare"
+		args.zoneID = zoneID
+		args.token = token
+	}
+}
+
+// WithRoute53 manages DNSLink records in the AWS Route53 hosted zone with zoneID.
+// The access key must be allowed to change the zone's record sets.
+func WithRoute53(zoneID, accessKey, secretKey string) DomainProvider {
+	return func(args *domainProviderOptions) {
+		args.name = "route53"
+		args.zoneID = zoneID
+		args.accessKey = accessKey
+		args.secretKey = secretKey
+	}
+}
+
+type shareLinkOptions struct {
+	password string
+}
+
+type ShareLinkOption func(*shareLinkOptions)
+
+// WithSharePassword requires viewers of a share link to enter password.
+func WithSharePassword(password string) ShareLinkOption {
+	return func(args *shareLinkOptions) {
+		args.password = password
+	}
+}
+
+type blockOptions struct {
+	path   string
+	format string
+	cid    cid.Cid
+}
+
+type BlockOption func(*blockOptions)
+
+// WithBlockPath limits a block lookup to the part of the bucket at path.
+// This avoids searching the entire bucket DAG.
+func WithBlockPath(pth string) BlockOption {
+	return func(args *blockOptions) {
+		args.path = pth
+	}
+}
+
+// WithBlockFormat sets the format of a block being put, e.g., "raw", "protobuf", or "cbor".
+func WithBlockFormat(format string) BlockOption {
+	return func(args *blockOptions) {
+		args.format = format
+	}
+}
+
+// WithBlockCid instructs the remote to reject a block being put unless its cid matches c.
+func WithBlockCid(c cid.Cid) BlockOption {
+	return func(args *blockOptions) {
+		args.cid = c
+	}
+}
+
+type listPathOptions struct {
+	limit     int64
+	cursor    string
+	recursive bool
+	maxDepth  int32
+	glob      string
+	root      path.Resolved
+}
+
+type ListPathOption func(*listPathOptions)
+
+// WithListLimit limits the number of directory items returned by ListPath.
+// Use the reply's NextCursor with WithListCursor to get the next page.
+func WithListLimit(limit int64) ListPathOption {
+	return func(args *listPathOptions) {
+		args.limit = limit
+	}
+}
+
+// WithListCursor starts a ListPath directory listing after the item named cursor.
+func WithListCursor(cursor string) ListPathOption {
+	return func(args *listPathOptions) {
+		args.cursor = cursor
+	}
+}
+
+// WithListRecursive lists the entire subtree below a directory, up to maxDepth levels.
+// A zero max depth lists all levels.
+func WithListRecursive(maxDepth int32) ListPathOption {
+	return func(args *listPathOptions) {
+		args.recursive = true
+		args.maxDepth = maxDepth
+	}
+}
+
+// WithListGlob only lists items whose path relative to the listed directory matches pattern,
+// along with the directories that contain them.
+// A "**" path segment matches any number of directories, e.g., "**/*.jpg".
+func WithListGlob(pattern string) ListPathOption {
+	return func(args *listPathOptions) {
+		args.glob = pattern
+	}
+}
+
+// WithListRoot lists a path in a previous bucket root, e.g., the path of a version or snapshot.
+func WithListRoot(root path.Resolved) ListPathOption {
+	return func(args *listPathOptions) {
+		args.root = root
+	}
+}
+
+type searchOptions struct {
+	mode  pb.SearchPathRequest_Mode
+	path  string
+	limit int64
+}
+
+type SearchOption func(*searchOptions)
+
+// WithSearchMode sets how the query is matched against item names and metadata.
+// The default is a case-insensitive substring match. Glob queries without a "/" are matched
+// against item names, and otherwise against paths.
+func WithSearchMode(mode pb.SearchPathRequest_Mode) SearchOption {
+	return func(args *searchOptions) {
+		args.mode = mode
+	}
+}
+
+// WithSearchPath limits a search to the part of the bucket at path.
+func WithSearchPath(pth string) SearchOption {
+	return func(args *searchOptions) {
+		args.path = pth
+	}
+}
+
+// WithSearchLimit limits the number of search results.
+func WithSearchLimit(limit int64) SearchOption {
+	return func(args *searchOptions) {
+		args.limit = limit
+	}
+}
+
+type archiveOptions struct {
+	trustedMiners   []string
+	excludedMiners  []string
+	countryCodes    []string
+	maxPrice        uint64
+	repFactor       int32
+	dealMinDuration int64
+}
+
+type ArchiveOption func(*archiveOptions)
+
+// WithTrustedMiners only makes archive deals with the given miners.
+func WithTrustedMiners(miners ...string) ArchiveOption {
+	return func(args *archiveOptions) {
+		args.trustedMiners = miners
+	}
+}
+
+// WithExcludedMiners never makes archive deals with the given miners.
+func WithExcludedMiners(miners ...string) ArchiveOption {
+	return func(args *archiveOptions) {
+		args.excludedMiners = miners
+	}
+}
+
+// WithCountryCodes only makes archive deals with miners in the given countries.
+func WithCountryCodes(codes ...string) ArchiveOption {
+	return func(args *archiveOptions) {
+		args.countryCodes = codes
+	}
+}
+
+// WithMaxPrice sets the max price of archive deals in attoFIL per GiB per epoch.
+func WithMaxPrice(price uint64) ArchiveOption {
+	return func(args *archiveOptions) {
+		args.maxPrice = price
+	}
+}
+
+// WithRepFactor sets the number of miners that store the archive.
+func WithRepFactor(n int32) ArchiveOption {
+	return func(args *archiveOptions) {
+		args.repFactor = n
+	}
+}
+
+// WithDealDuration sets the min duration of archive deals in epochs.
+func WithDealDuration(epochs int64) ArchiveOption {
+	return func(args *archiveOptions) {
+		args.dealMinDuration = epochs
+	}
+}
+
+type restoreArchiveOptions struct {
+	newBucket bool
+	name      string
+}
+
+type RestoreArchiveOption func(*restoreArchiveOptions)
+
+// WithRestoreNewBucket restores an archive into a new bucket with name instead of replacing the bucket root.
+func WithRestoreNewBucket(name string) RestoreArchiveOption {
+	return func(args *restoreArchiveOptions) {
+		args.newBucket = true
+		args.name = name
+	}
+}
+
+type cloneOptions struct {
+	name   string
+	thread thread.ID
+}
+
+type CloneOption func(*cloneOptions)
+
+// WithCloneName sets the name of the clone.
+// By default, the clone has the name of the source bucket.
+func WithCloneName(name string) CloneOption {
+	return func(args *cloneOptions) {
+		args.name = name
+	}
+}
+
+// WithCloneThread creates the clone in the thread with id instead of the thread in the context.
+func WithCloneThread(id thread.ID) CloneOption {
+	return func(args *cloneOptions) {
+		args.thread = id
+	}
+}
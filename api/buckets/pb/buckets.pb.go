@@ -24,6 +24,155 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type PushPathReply_Event_Stage int32
+
+const (
+	PushPathReply_Event_Adding       PushPathReply_Event_Stage = 0
+	PushPathReply_Event_Pinning      PushPathReply_Event_Stage = 1
+	PushPathReply_Event_UpdatingRoot PushPathReply_Event_Stage = 2
+)
+
+var PushPathReply_Event_Stage_name = map[int32]string{
+	0: "Adding",
+	1: "Pinning",
+	2: "UpdatingRoot",
+}
+
+var PushPathReply_Event_Stage_value = map[string]int32{
+	"Adding":       0,
+	"Pinning":      1,
+	"UpdatingRoot": 2,
+}
+
+func (x PushPathReply_Event_Stage) String() string {
+	return proto.EnumName(PushPathReply_Event_Stage_name, int32(x))
+}
+
+func (PushPathReply_Event_Stage) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{16, 0, 0}
+}
+
+type DiffReply_Change_Type int32
+
+const (
+	DiffReply_Change_Add    DiffReply_Change_Type = 0
+	DiffReply_Change_Modify DiffReply_Change_Type = 1
+	DiffReply_Change_Remove DiffReply_Change_Type = 2
+)
+
+var DiffReply_Change_Type_name = map[int32]string{
+	0: "Add",
+	1: "Modify",
+	2: "Remove",
+}
+
+var DiffReply_Change_Type_value = map[string]int32{
+	"Add":    0,
+	"Modify": 1,
+	"Remove": 2,
+}
+
+func (x DiffReply_Change_Type) String() string {
+	return proto.EnumName(DiffReply_Change_Type_name, int32(x))
+}
+
+func (DiffReply_Change_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{34, 0, 0}
+}
+
+type BucketImport_Status int32
+
+const (
+	BucketImport_Queued   BucketImport_Status = 0
+	BucketImport_Running  BucketImport_Status = 1
+	BucketImport_Done     BucketImport_Status = 2
+	BucketImport_Failed   BucketImport_Status = 3
+	BucketImport_Canceled BucketImport_Status = 4
+)
+
+var BucketImport_Status_name = map[int32]string{
+	0: "Queued",
+	1: "Running",
+	2: "Done",
+	3: "Failed",
+	4: "Canceled",
+}
+
+var BucketImport_Status_value = map[string]int32{
+	"Queued":   0,
+	"Running":  1,
+	"Done":     2,
+	"Failed":   3,
+	"Canceled": 4,
+}
+
+func (x BucketImport_Status) String() string {
+	return proto.EnumName(BucketImport_Status_name, int32(x))
+}
+
+func (BucketImport_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{45, 0}
+}
+
+type SetConflictStrategyRequest_Strategy int32
+
+const (
+	SetConflictStrategyRequest_LastWriterWins SetConflictStrategyRequest_Strategy = 0
+	SetConflictStrategyRequest_Reject         SetConflictStrategyRequest_Strategy = 1
+	SetConflictStrategyRequest_KeepBoth       SetConflictStrategyRequest_Strategy = 2
+	SetConflictStrategyRequest_Merge          SetConflictStrategyRequest_Strategy = 3
+)
+
+var SetConflictStrategyRequest_Strategy_name = map[int32]string{
+	0: "LastWriterWins",
+	1: "Reject",
+	2: "KeepBoth",
+	3: "Merge",
+}
+
+var SetConflictStrategyRequest_Strategy_value = map[string]int32{
+	"LastWriterWins": 0,
+	"Reject":         1,
+	"KeepBoth":       2,
+	"Merge":          3,
+}
+
+func (x SetConflictStrategyRequest_Strategy) String() string {
+	return proto.EnumName(SetConflictStrategyRequest_Strategy_name, int32(x))
+}
+
+func (SetConflictStrategyRequest_Strategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{79, 0}
+}
+
+type SearchPathRequest_Mode int32
+
+const (
+	SearchPathRequest_Substring SearchPathRequest_Mode = 0
+	SearchPathRequest_Glob      SearchPathRequest_Mode = 1
+	SearchPathRequest_Regex     SearchPathRequest_Mode = 2
+)
+
+var SearchPathRequest_Mode_name = map[int32]string{
+	0: "Substring",
+	1: "Glob",
+	2: "Regex",
+}
+
+var SearchPathRequest_Mode_value = map[string]int32{
+	"Substring": 0,
+	"Glob":      1,
+	"Regex":     2,
+}
+
+func (x SearchPathRequest_Mode) String() string {
+	return proto.EnumName(SearchPathRequest_Mode_name, int32(x))
+}
+
+func (SearchPathRequest_Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{123, 0}
+}
+
 type ArchiveStatusReply_Status int32
 
 const (
@@ -52,19 +201,20 @@ func (x ArchiveStatusReply_Status) String() string {
 }
 
 func (ArchiveStatusReply_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{29, 0}
+	return fileDescriptor_95035767e889ecda, []int{167, 0}
 }
 
 type Root struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	CreatedAt            int64    `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt            int64    `protobuf:"varint,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Thread               string   `protobuf:"bytes,6,opt,name=thread,proto3" json:"thread,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path                 string            `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	CreatedAt            int64             `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt            int64             `protobuf:"varint,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Thread               string            `protobuf:"bytes,6,opt,name=thread,proto3" json:"thread,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Root) Reset()         { *m = Root{} }
@@ -134,10 +284,18 @@ func (m *Root) GetThread() string {
 	return ""
 }
 
+func (m *Root) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type ListRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Tags                 map[string]string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ListRequest) Reset()         { *m = ListRequest{} }
@@ -165,6 +323,13 @@ func (m *ListRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListRequest proto.InternalMessageInfo
 
+func (m *ListRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
 type ListReply struct {
 	Roots                []*Root  `protobuf:"bytes,1,rep,name=roots,proto3" json:"roots,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -208,6 +373,7 @@ type InitRequest struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	BootstrapCid         string   `protobuf:"bytes,2,opt,name=bootstrapCid,proto3" json:"bootstrapCid,omitempty"`
 	Private              bool     `protobuf:"varint,3,opt,name=private,proto3" json:"private,omitempty"`
+	Key                  []byte   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -259,6 +425,13 @@ func (m *InitRequest) GetPrivate() bool {
 	return false
 }
 
+func (m *InitRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
 type InitReply struct {
 	Root                 *Root       `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
 	Links                *LinksReply `protobuf:"bytes,2,opt,name=links,proto3" json:"links,omitempty"`
@@ -362,10 +535,12 @@ func (m *RootRequest) GetKey() string {
 }
 
 type RootReply struct {
-	Root                 *Root    `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Root                 *Root        `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Mirrors              []*PinMirror `protobuf:"bytes,2,rep,name=mirrors,proto3" json:"mirrors,omitempty"`
+	Domains              []*Domain    `protobuf:"bytes,3,rep,name=domains,proto3" json:"domains,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *RootReply) Reset()         { *m = RootReply{} }
@@ -400,6 +575,20 @@ func (m *RootReply) GetRoot() *Root {
 	return nil
 }
 
+func (m *RootReply) GetMirrors() []*PinMirror {
+	if m != nil {
+		return m.Mirrors
+	}
+	return nil
+}
+
+func (m *RootReply) GetDomains() []*Domain {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
+}
+
 type LinksRequest struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -497,6 +686,12 @@ func (m *LinksReply) GetIPNS() string {
 type ListPathRequest struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Cursor               string   `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Recursive            bool     `protobuf:"varint,5,opt,name=recursive,proto3" json:"recursive,omitempty"`
+	MaxDepth             int32    `protobuf:"varint,6,opt,name=maxDepth,proto3" json:"maxDepth,omitempty"`
+	Glob                 string   `protobuf:"bytes,7,opt,name=glob,proto3" json:"glob,omitempty"`
+	Root                 string   `protobuf:"bytes,8,opt,name=root,proto3" json:"root,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -541,9 +736,52 @@ func (m *ListPathRequest) GetPath() string {
 	return ""
 }
 
+func (m *ListPathRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListPathRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListPathRequest) GetRecursive() bool {
+	if m != nil {
+		return m.Recursive
+	}
+	return false
+}
+
+func (m *ListPathRequest) GetMaxDepth() int32 {
+	if m != nil {
+		return m.MaxDepth
+	}
+	return 0
+}
+
+func (m *ListPathRequest) GetGlob() string {
+	if m != nil {
+		return m.Glob
+	}
+	return ""
+}
+
+func (m *ListPathRequest) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
 type ListPathReply struct {
 	Item                 *ListPathItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	Root                 *Root         `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	NextCursor           string        `protobuf:"bytes,3,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -588,6 +826,13 @@ func (m *ListPathReply) GetRoot() *Root {
 	return nil
 }
 
+func (m *ListPathReply) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
 type ListPathItem struct {
 	Cid                  string          `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
 	Name                 string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -595,6 +840,7 @@ type ListPathItem struct {
 	Size                 int64           `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
 	IsDir                bool            `protobuf:"varint,5,opt,name=isDir,proto3" json:"isDir,omitempty"`
 	Items                []*ListPathItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	Metadata             *Metadata       `protobuf:"bytes,7,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -667,6 +913,76 @@ func (m *ListPathItem) GetItems() []*ListPathItem {
 	return nil
 }
 
+func (m *ListPathItem) GetMetadata() *Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type Metadata struct {
+	ContentType          string            `protobuf:"bytes,1,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Attributes           map[string]string `protobuf:"bytes,2,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	UpdatedAt            int64             `protobuf:"varint,3,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	ContentEncoding      string            `protobuf:"bytes,4,opt,name=contentEncoding,proto3" json:"contentEncoding,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Metadata) Reset()         { *m = Metadata{} }
+func (m *Metadata) String() string { return proto.CompactTextString(m) }
+func (*Metadata) ProtoMessage()    {}
+func (*Metadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{12}
+}
+
+func (m *Metadata) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Metadata.Unmarshal(m, b)
+}
+func (m *Metadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Metadata.Marshal(b, m, deterministic)
+}
+func (m *Metadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Metadata.Merge(m, src)
+}
+func (m *Metadata) XXX_Size() int {
+	return xxx_messageInfo_Metadata.Size(m)
+}
+func (m *Metadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_Metadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Metadata proto.InternalMessageInfo
+
+func (m *Metadata) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *Metadata) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Metadata) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func (m *Metadata) GetContentEncoding() string {
+	if m != nil {
+		return m.ContentEncoding
+	}
+	return ""
+}
+
 type ListIpfsPathRequest struct {
 	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -678,7 +994,7 @@ func (m *ListIpfsPathRequest) Reset()         { *m = ListIpfsPathRequest{} }
 func (m *ListIpfsPathRequest) String() string { return proto.CompactTextString(m) }
 func (*ListIpfsPathRequest) ProtoMessage()    {}
 func (*ListIpfsPathRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{12}
+	return fileDescriptor_95035767e889ecda, []int{13}
 }
 
 func (m *ListIpfsPathRequest) XXX_Unmarshal(b []byte) error {
@@ -717,7 +1033,7 @@ func (m *ListIpfsPathReply) Reset()         { *m = ListIpfsPathReply{} }
 func (m *ListIpfsPathReply) String() string { return proto.CompactTextString(m) }
 func (*ListIpfsPathReply) ProtoMessage()    {}
 func (*ListIpfsPathReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{13}
+	return fileDescriptor_95035767e889ecda, []int{14}
 }
 
 func (m *ListIpfsPathReply) XXX_Unmarshal(b []byte) error {
@@ -759,7 +1075,7 @@ func (m *PushPathRequest) Reset()         { *m = PushPathRequest{} }
 func (m *PushPathRequest) String() string { return proto.CompactTextString(m) }
 func (*PushPathRequest) ProtoMessage()    {}
 func (*PushPathRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{14}
+	return fileDescriptor_95035767e889ecda, []int{15}
 }
 
 func (m *PushPathRequest) XXX_Unmarshal(b []byte) error {
@@ -826,19 +1142,24 @@ func (*PushPathRequest) XXX_OneofWrappers() []interface{} {
 }
 
 type PushPathRequest_Header struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Root                 string   `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path                 string            `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Root                 string            `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Message              string            `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	ContentType          string            `protobuf:"bytes,5,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Attributes           map[string]string `protobuf:"bytes,6,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Compress             bool              `protobuf:"varint,7,opt,name=compress,proto3" json:"compress,omitempty"`
+	Deterministic        bool              `protobuf:"varint,8,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *PushPathRequest_Header) Reset()         { *m = PushPathRequest_Header{} }
 func (m *PushPathRequest_Header) String() string { return proto.CompactTextString(m) }
 func (*PushPathRequest_Header) ProtoMessage()    {}
 func (*PushPathRequest_Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{14, 0}
+	return fileDescriptor_95035767e889ecda, []int{15, 0}
 }
 
 func (m *PushPathRequest_Header) XXX_Unmarshal(b []byte) error {
@@ -880,6 +1201,41 @@ func (m *PushPathRequest_Header) GetRoot() string {
 	return ""
 }
 
+func (m *PushPathRequest_Header) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *PushPathRequest_Header) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *PushPathRequest_Header) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *PushPathRequest_Header) GetCompress() bool {
+	if m != nil {
+		return m.Compress
+	}
+	return false
+}
+
+func (m *PushPathRequest_Header) GetDeterministic() bool {
+	if m != nil {
+		return m.Deterministic
+	}
+	return false
+}
+
 type PushPathReply struct {
 	// Types that are valid to be assigned to Payload:
 	//	*PushPathReply_Event_
@@ -894,7 +1250,7 @@ func (m *PushPathReply) Reset()         { *m = PushPathReply{} }
 func (m *PushPathReply) String() string { return proto.CompactTextString(m) }
 func (*PushPathReply) ProtoMessage()    {}
 func (*PushPathReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{15}
+	return fileDescriptor_95035767e889ecda, []int{16}
 }
 
 func (m *PushPathReply) XXX_Unmarshal(b []byte) error {
@@ -961,21 +1317,26 @@ func (*PushPathReply) XXX_OneofWrappers() []interface{} {
 }
 
 type PushPathReply_Event struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Bytes                int64    `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
-	Size                 string   `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
-	Root                 *Root    `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                 string                    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Path                 string                    `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Bytes                int64                     `protobuf:"varint,3,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Size                 string                    `protobuf:"bytes,4,opt,name=size,proto3" json:"size,omitempty"`
+	Root                 *Root                     `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	Quota                *Quota                    `protobuf:"bytes,6,opt,name=quota,proto3" json:"quota,omitempty"`
+	Stage                PushPathReply_Event_Stage `protobuf:"varint,7,opt,name=stage,proto3,enum=buckets.pb.PushPathReply_Event_Stage" json:"stage,omitempty"`
+	Received             int64                     `protobuf:"varint,8,opt,name=received,proto3" json:"received,omitempty"`
+	ConflictPath         string                    `protobuf:"bytes,9,opt,name=conflictPath,proto3" json:"conflictPath,omitempty"`
+	ContentRoot          string                    `protobuf:"bytes,10,opt,name=contentRoot,proto3" json:"contentRoot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
 
 func (m *PushPathReply_Event) Reset()         { *m = PushPathReply_Event{} }
 func (m *PushPathReply_Event) String() string { return proto.CompactTextString(m) }
 func (*PushPathReply_Event) ProtoMessage()    {}
 func (*PushPathReply_Event) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{15, 0}
+	return fileDescriptor_95035767e889ecda, []int{16, 0}
 }
 
 func (m *PushPathReply_Event) XXX_Unmarshal(b []byte) error {
@@ -1031,1547 +1392,12668 @@ func (m *PushPathReply_Event) GetRoot() *Root {
 	return nil
 }
 
-type PullPathRequest struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PushPathReply_Event) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
 }
 
-func (m *PullPathRequest) Reset()         { *m = PullPathRequest{} }
-func (m *PullPathRequest) String() string { return proto.CompactTextString(m) }
-func (*PullPathRequest) ProtoMessage()    {}
-func (*PullPathRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{16}
+func (m *PushPathReply_Event) GetStage() PushPathReply_Event_Stage {
+	if m != nil {
+		return m.Stage
+	}
+	return PushPathReply_Event_Adding
 }
 
-func (m *PullPathRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PullPathRequest.Unmarshal(m, b)
-}
-func (m *PullPathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PullPathRequest.Marshal(b, m, deterministic)
+func (m *PushPathReply_Event) GetReceived() int64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
 }
-func (m *PullPathRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullPathRequest.Merge(m, src)
+
+func (m *PushPathReply_Event) GetConflictPath() string {
+	if m != nil {
+		return m.ConflictPath
+	}
+	return ""
 }
-func (m *PullPathRequest) XXX_Size() int {
-	return xxx_messageInfo_PullPathRequest.Size(m)
+
+func (m *PushPathReply_Event) GetContentRoot() string {
+	if m != nil {
+		return m.ContentRoot
+	}
+	return ""
 }
-func (m *PullPathRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullPathRequest.DiscardUnknown(m)
+
+type PushPathsRequest struct {
+	// Types that are valid to be assigned to Payload:
+	//	*PushPathsRequest_Header_
+	//	*PushPathsRequest_Chunk_
+	Payload              isPushPathsRequest_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *PushPathsRequest) Reset()         { *m = PushPathsRequest{} }
+func (m *PushPathsRequest) String() string { return proto.CompactTextString(m) }
+func (*PushPathsRequest) ProtoMessage()    {}
+func (*PushPathsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{17}
 }
 
-var xxx_messageInfo_PullPathRequest proto.InternalMessageInfo
+func (m *PushPathsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushPathsRequest.Unmarshal(m, b)
+}
+func (m *PushPathsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushPathsRequest.Marshal(b, m, deterministic)
+}
+func (m *PushPathsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPathsRequest.Merge(m, src)
+}
+func (m *PushPathsRequest) XXX_Size() int {
+	return xxx_messageInfo_PushPathsRequest.Size(m)
+}
+func (m *PushPathsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPathsRequest.DiscardUnknown(m)
+}
 
-func (m *PullPathRequest) GetKey() string {
+var xxx_messageInfo_PushPathsRequest proto.InternalMessageInfo
+
+type isPushPathsRequest_Payload interface {
+	isPushPathsRequest_Payload()
+}
+
+type PushPathsRequest_Header_ struct {
+	Header *PushPathsRequest_Header `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type PushPathsRequest_Chunk_ struct {
+	Chunk *PushPathsRequest_Chunk `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*PushPathsRequest_Header_) isPushPathsRequest_Payload() {}
+
+func (*PushPathsRequest_Chunk_) isPushPathsRequest_Payload() {}
+
+func (m *PushPathsRequest) GetPayload() isPushPathsRequest_Payload {
 	if m != nil {
-		return m.Key
+		return m.Payload
 	}
-	return ""
+	return nil
 }
 
-func (m *PullPathRequest) GetPath() string {
-	if m != nil {
-		return m.Path
+func (m *PushPathsRequest) GetHeader() *PushPathsRequest_Header {
+	if x, ok := m.GetPayload().(*PushPathsRequest_Header_); ok {
+		return x.Header
 	}
-	return ""
+	return nil
 }
 
-type PullPathReply struct {
-	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+func (m *PushPathsRequest) GetChunk() *PushPathsRequest_Chunk {
+	if x, ok := m.GetPayload().(*PushPathsRequest_Chunk_); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PushPathsRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PushPathsRequest_Header_)(nil),
+		(*PushPathsRequest_Chunk_)(nil),
+	}
+}
+
+type PushPathsRequest_Header struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Root                 string   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Deterministic        bool     `protobuf:"varint,4,opt,name=deterministic,proto3" json:"deterministic,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PullPathReply) Reset()         { *m = PullPathReply{} }
-func (m *PullPathReply) String() string { return proto.CompactTextString(m) }
-func (*PullPathReply) ProtoMessage()    {}
-func (*PullPathReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{17}
+func (m *PushPathsRequest_Header) Reset()         { *m = PushPathsRequest_Header{} }
+func (m *PushPathsRequest_Header) String() string { return proto.CompactTextString(m) }
+func (*PushPathsRequest_Header) ProtoMessage()    {}
+func (*PushPathsRequest_Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{17, 0}
 }
 
-func (m *PullPathReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PullPathReply.Unmarshal(m, b)
+func (m *PushPathsRequest_Header) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushPathsRequest_Header.Unmarshal(m, b)
 }
-func (m *PullPathReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PullPathReply.Marshal(b, m, deterministic)
+func (m *PushPathsRequest_Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushPathsRequest_Header.Marshal(b, m, deterministic)
 }
-func (m *PullPathReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullPathReply.Merge(m, src)
+func (m *PushPathsRequest_Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPathsRequest_Header.Merge(m, src)
 }
-func (m *PullPathReply) XXX_Size() int {
-	return xxx_messageInfo_PullPathReply.Size(m)
+func (m *PushPathsRequest_Header) XXX_Size() int {
+	return xxx_messageInfo_PushPathsRequest_Header.Size(m)
 }
-func (m *PullPathReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullPathReply.DiscardUnknown(m)
+func (m *PushPathsRequest_Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPathsRequest_Header.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PullPathReply proto.InternalMessageInfo
+var xxx_messageInfo_PushPathsRequest_Header proto.InternalMessageInfo
 
-func (m *PullPathReply) GetChunk() []byte {
+func (m *PushPathsRequest_Header) GetKey() string {
 	if m != nil {
-		return m.Chunk
+		return m.Key
 	}
-	return nil
+	return ""
 }
 
-type PullIpfsPathRequest struct {
-	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PushPathsRequest_Header) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
 }
 
-func (m *PullIpfsPathRequest) Reset()         { *m = PullIpfsPathRequest{} }
-func (m *PullIpfsPathRequest) String() string { return proto.CompactTextString(m) }
-func (*PullIpfsPathRequest) ProtoMessage()    {}
-func (*PullIpfsPathRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{18}
+func (m *PushPathsRequest_Header) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *PullIpfsPathRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PullIpfsPathRequest.Unmarshal(m, b)
+func (m *PushPathsRequest_Header) GetDeterministic() bool {
+	if m != nil {
+		return m.Deterministic
+	}
+	return false
 }
-func (m *PullIpfsPathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PullIpfsPathRequest.Marshal(b, m, deterministic)
+
+type PushPathsRequest_Chunk struct {
+	Path                 string            `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Data                 []byte            `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Eof                  bool              `protobuf:"varint,3,opt,name=eof,proto3" json:"eof,omitempty"`
+	Attributes           map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *PullIpfsPathRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullIpfsPathRequest.Merge(m, src)
+
+func (m *PushPathsRequest_Chunk) Reset()         { *m = PushPathsRequest_Chunk{} }
+func (m *PushPathsRequest_Chunk) String() string { return proto.CompactTextString(m) }
+func (*PushPathsRequest_Chunk) ProtoMessage()    {}
+func (*PushPathsRequest_Chunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{17, 1}
 }
-func (m *PullIpfsPathRequest) XXX_Size() int {
-	return xxx_messageInfo_PullIpfsPathRequest.Size(m)
+
+func (m *PushPathsRequest_Chunk) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushPathsRequest_Chunk.Unmarshal(m, b)
 }
-func (m *PullIpfsPathRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullIpfsPathRequest.DiscardUnknown(m)
+func (m *PushPathsRequest_Chunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushPathsRequest_Chunk.Marshal(b, m, deterministic)
+}
+func (m *PushPathsRequest_Chunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPathsRequest_Chunk.Merge(m, src)
+}
+func (m *PushPathsRequest_Chunk) XXX_Size() int {
+	return xxx_messageInfo_PushPathsRequest_Chunk.Size(m)
+}
+func (m *PushPathsRequest_Chunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPathsRequest_Chunk.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PullIpfsPathRequest proto.InternalMessageInfo
+var xxx_messageInfo_PushPathsRequest_Chunk proto.InternalMessageInfo
 
-func (m *PullIpfsPathRequest) GetPath() string {
+func (m *PushPathsRequest_Chunk) GetPath() string {
 	if m != nil {
 		return m.Path
 	}
 	return ""
 }
 
-type PullIpfsPathReply struct {
-	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+func (m *PushPathsRequest_Chunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *PushPathsRequest_Chunk) GetEof() bool {
+	if m != nil {
+		return m.Eof
+	}
+	return false
+}
+
+func (m *PushPathsRequest_Chunk) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+type PushPathsReply struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Cid                  string   `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Size                 int64    `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Root                 *Root    `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	ConflictPath         string   `protobuf:"bytes,5,opt,name=conflictPath,proto3" json:"conflictPath,omitempty"`
+	ContentRoot          string   `protobuf:"bytes,6,opt,name=contentRoot,proto3" json:"contentRoot,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *PullIpfsPathReply) Reset()         { *m = PullIpfsPathReply{} }
-func (m *PullIpfsPathReply) String() string { return proto.CompactTextString(m) }
-func (*PullIpfsPathReply) ProtoMessage()    {}
-func (*PullIpfsPathReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{19}
+func (m *PushPathsReply) Reset()         { *m = PushPathsReply{} }
+func (m *PushPathsReply) String() string { return proto.CompactTextString(m) }
+func (*PushPathsReply) ProtoMessage()    {}
+func (*PushPathsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{18}
 }
 
-func (m *PullIpfsPathReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PullIpfsPathReply.Unmarshal(m, b)
+func (m *PushPathsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushPathsReply.Unmarshal(m, b)
 }
-func (m *PullIpfsPathReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PullIpfsPathReply.Marshal(b, m, deterministic)
+func (m *PushPathsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushPathsReply.Marshal(b, m, deterministic)
 }
-func (m *PullIpfsPathReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PullIpfsPathReply.Merge(m, src)
+func (m *PushPathsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPathsReply.Merge(m, src)
 }
-func (m *PullIpfsPathReply) XXX_Size() int {
-	return xxx_messageInfo_PullIpfsPathReply.Size(m)
+func (m *PushPathsReply) XXX_Size() int {
+	return xxx_messageInfo_PushPathsReply.Size(m)
 }
-func (m *PullIpfsPathReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_PullIpfsPathReply.DiscardUnknown(m)
+func (m *PushPathsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPathsReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PullIpfsPathReply proto.InternalMessageInfo
+var xxx_messageInfo_PushPathsReply proto.InternalMessageInfo
 
-func (m *PullIpfsPathReply) GetChunk() []byte {
+func (m *PushPathsReply) GetPath() string {
 	if m != nil {
-		return m.Chunk
+		return m.Path
+	}
+	return ""
+}
+
+func (m *PushPathsReply) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *PushPathsReply) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *PushPathsReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
 	}
 	return nil
 }
 
-type SetPathRequest struct {
+func (m *PushPathsReply) GetConflictPath() string {
+	if m != nil {
+		return m.ConflictPath
+	}
+	return ""
+}
+
+func (m *PushPathsReply) GetContentRoot() string {
+	if m != nil {
+		return m.ContentRoot
+	}
+	return ""
+}
+
+type StartUploadRequest struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Cid                  string   `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Root                 string   `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Size                 int64    `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetPathRequest) Reset()         { *m = SetPathRequest{} }
-func (m *SetPathRequest) String() string { return proto.CompactTextString(m) }
-func (*SetPathRequest) ProtoMessage()    {}
-func (*SetPathRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{20}
+func (m *StartUploadRequest) Reset()         { *m = StartUploadRequest{} }
+func (m *StartUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*StartUploadRequest) ProtoMessage()    {}
+func (*StartUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{19}
 }
 
-func (m *SetPathRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetPathRequest.Unmarshal(m, b)
+func (m *StartUploadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartUploadRequest.Unmarshal(m, b)
 }
-func (m *SetPathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetPathRequest.Marshal(b, m, deterministic)
+func (m *StartUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartUploadRequest.Marshal(b, m, deterministic)
 }
-func (m *SetPathRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetPathRequest.Merge(m, src)
+func (m *StartUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartUploadRequest.Merge(m, src)
 }
-func (m *SetPathRequest) XXX_Size() int {
-	return xxx_messageInfo_SetPathRequest.Size(m)
+func (m *StartUploadRequest) XXX_Size() int {
+	return xxx_messageInfo_StartUploadRequest.Size(m)
 }
-func (m *SetPathRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetPathRequest.DiscardUnknown(m)
+func (m *StartUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartUploadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetPathRequest proto.InternalMessageInfo
+var xxx_messageInfo_StartUploadRequest proto.InternalMessageInfo
 
-func (m *SetPathRequest) GetKey() string {
+func (m *StartUploadRequest) GetKey() string {
 	if m != nil {
 		return m.Key
 	}
 	return ""
 }
 
-func (m *SetPathRequest) GetPath() string {
+func (m *StartUploadRequest) GetPath() string {
 	if m != nil {
 		return m.Path
 	}
 	return ""
 }
 
-func (m *SetPathRequest) GetCid() string {
+func (m *StartUploadRequest) GetRoot() string {
 	if m != nil {
-		return m.Cid
+		return m.Root
 	}
 	return ""
 }
 
-type SetPathReply struct {
+func (m *StartUploadRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *StartUploadRequest) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+type StartUploadReply struct {
+	SessionID            string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,2,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetPathReply) Reset()         { *m = SetPathReply{} }
-func (m *SetPathReply) String() string { return proto.CompactTextString(m) }
-func (*SetPathReply) ProtoMessage()    {}
-func (*SetPathReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{21}
+func (m *StartUploadReply) Reset()         { *m = StartUploadReply{} }
+func (m *StartUploadReply) String() string { return proto.CompactTextString(m) }
+func (*StartUploadReply) ProtoMessage()    {}
+func (*StartUploadReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{20}
 }
 
-func (m *SetPathReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetPathReply.Unmarshal(m, b)
+func (m *StartUploadReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartUploadReply.Unmarshal(m, b)
 }
-func (m *SetPathReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetPathReply.Marshal(b, m, deterministic)
+func (m *StartUploadReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartUploadReply.Marshal(b, m, deterministic)
 }
-func (m *SetPathReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetPathReply.Merge(m, src)
+func (m *StartUploadReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartUploadReply.Merge(m, src)
 }
-func (m *SetPathReply) XXX_Size() int {
-	return xxx_messageInfo_SetPathReply.Size(m)
+func (m *StartUploadReply) XXX_Size() int {
+	return xxx_messageInfo_StartUploadReply.Size(m)
 }
-func (m *SetPathReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetPathReply.DiscardUnknown(m)
+func (m *StartUploadReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartUploadReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetPathReply proto.InternalMessageInfo
+var xxx_messageInfo_StartUploadReply proto.InternalMessageInfo
 
-type RemoveRequest struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+func (m *StartUploadReply) GetSessionID() string {
+	if m != nil {
+		return m.SessionID
+	}
+	return ""
+}
+
+func (m *StartUploadReply) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type UploadStatusRequest struct {
+	SessionID            string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveRequest) Reset()         { *m = RemoveRequest{} }
-func (m *RemoveRequest) String() string { return proto.CompactTextString(m) }
-func (*RemoveRequest) ProtoMessage()    {}
-func (*RemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{22}
+func (m *UploadStatusRequest) Reset()         { *m = UploadStatusRequest{} }
+func (m *UploadStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*UploadStatusRequest) ProtoMessage()    {}
+func (*UploadStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{21}
 }
 
-func (m *RemoveRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveRequest.Unmarshal(m, b)
+func (m *UploadStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadStatusRequest.Unmarshal(m, b)
 }
-func (m *RemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveRequest.Marshal(b, m, deterministic)
+func (m *UploadStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadStatusRequest.Marshal(b, m, deterministic)
 }
-func (m *RemoveRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveRequest.Merge(m, src)
+func (m *UploadStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadStatusRequest.Merge(m, src)
 }
-func (m *RemoveRequest) XXX_Size() int {
-	return xxx_messageInfo_RemoveRequest.Size(m)
+func (m *UploadStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_UploadStatusRequest.Size(m)
 }
-func (m *RemoveRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveRequest.DiscardUnknown(m)
+func (m *UploadStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadStatusRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveRequest proto.InternalMessageInfo
+var xxx_messageInfo_UploadStatusRequest proto.InternalMessageInfo
 
-func (m *RemoveRequest) GetKey() string {
+func (m *UploadStatusRequest) GetSessionID() string {
 	if m != nil {
-		return m.Key
+		return m.SessionID
 	}
 	return ""
 }
 
-type RemoveReply struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *RemoveReply) Reset()         { *m = RemoveReply{} }
-func (m *RemoveReply) String() string { return proto.CompactTextString(m) }
-func (*RemoveReply) ProtoMessage()    {}
-func (*RemoveReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{23}
-}
-
-func (m *RemoveReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveReply.Unmarshal(m, b)
-}
-func (m *RemoveReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveReply.Marshal(b, m, deterministic)
-}
-func (m *RemoveReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveReply.Merge(m, src)
-}
-func (m *RemoveReply) XXX_Size() int {
-	return xxx_messageInfo_RemoveReply.Size(m)
-}
-func (m *RemoveReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveReply.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_RemoveReply proto.InternalMessageInfo
-
-type RemovePathRequest struct {
+type UploadStatusReply struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
-	Root                 string   `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Offset               int64    `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	Size                 int64    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemovePathRequest) Reset()         { *m = RemovePathRequest{} }
-func (m *RemovePathRequest) String() string { return proto.CompactTextString(m) }
-func (*RemovePathRequest) ProtoMessage()    {}
-func (*RemovePathRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{24}
+func (m *UploadStatusReply) Reset()         { *m = UploadStatusReply{} }
+func (m *UploadStatusReply) String() string { return proto.CompactTextString(m) }
+func (*UploadStatusReply) ProtoMessage()    {}
+func (*UploadStatusReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{22}
 }
 
-func (m *RemovePathRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemovePathRequest.Unmarshal(m, b)
+func (m *UploadStatusReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UploadStatusReply.Unmarshal(m, b)
 }
-func (m *RemovePathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemovePathRequest.Marshal(b, m, deterministic)
+func (m *UploadStatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UploadStatusReply.Marshal(b, m, deterministic)
 }
-func (m *RemovePathRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemovePathRequest.Merge(m, src)
+func (m *UploadStatusReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UploadStatusReply.Merge(m, src)
 }
-func (m *RemovePathRequest) XXX_Size() int {
-	return xxx_messageInfo_RemovePathRequest.Size(m)
+func (m *UploadStatusReply) XXX_Size() int {
+	return xxx_messageInfo_UploadStatusReply.Size(m)
 }
-func (m *RemovePathRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemovePathRequest.DiscardUnknown(m)
+func (m *UploadStatusReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UploadStatusReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemovePathRequest proto.InternalMessageInfo
+var xxx_messageInfo_UploadStatusReply proto.InternalMessageInfo
 
-func (m *RemovePathRequest) GetKey() string {
+func (m *UploadStatusReply) GetKey() string {
 	if m != nil {
 		return m.Key
 	}
 	return ""
 }
 
-func (m *RemovePathRequest) GetPath() string {
+func (m *UploadStatusReply) GetPath() string {
 	if m != nil {
 		return m.Path
 	}
 	return ""
 }
 
-func (m *RemovePathRequest) GetRoot() string {
+func (m *UploadStatusReply) GetOffset() int64 {
 	if m != nil {
-		return m.Root
+		return m.Offset
 	}
-	return ""
+	return 0
 }
 
-type RemovePathReply struct {
-	Root                 *Root    `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *UploadStatusReply) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
 }
 
-func (m *RemovePathReply) Reset()         { *m = RemovePathReply{} }
-func (m *RemovePathReply) String() string { return proto.CompactTextString(m) }
-func (*RemovePathReply) ProtoMessage()    {}
-func (*RemovePathReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{25}
+func (m *UploadStatusReply) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
 }
 
-func (m *RemovePathReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemovePathReply.Unmarshal(m, b)
+type PushUploadRequest struct {
+	// Types that are valid to be assigned to Payload:
+	//	*PushUploadRequest_Header_
+	//	*PushUploadRequest_Chunk
+	Payload              isPushUploadRequest_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *PushUploadRequest) Reset()         { *m = PushUploadRequest{} }
+func (m *PushUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*PushUploadRequest) ProtoMessage()    {}
+func (*PushUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{23}
 }
-func (m *RemovePathReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemovePathReply.Marshal(b, m, deterministic)
+
+func (m *PushUploadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushUploadRequest.Unmarshal(m, b)
 }
-func (m *RemovePathReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemovePathReply.Merge(m, src)
+func (m *PushUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushUploadRequest.Marshal(b, m, deterministic)
 }
-func (m *RemovePathReply) XXX_Size() int {
-	return xxx_messageInfo_RemovePathReply.Size(m)
+func (m *PushUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushUploadRequest.Merge(m, src)
 }
-func (m *RemovePathReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemovePathReply.DiscardUnknown(m)
+func (m *PushUploadRequest) XXX_Size() int {
+	return xxx_messageInfo_PushUploadRequest.Size(m)
+}
+func (m *PushUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushUploadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemovePathReply proto.InternalMessageInfo
+var xxx_messageInfo_PushUploadRequest proto.InternalMessageInfo
 
-func (m *RemovePathReply) GetRoot() *Root {
+type isPushUploadRequest_Payload interface {
+	isPushUploadRequest_Payload()
+}
+
+type PushUploadRequest_Header_ struct {
+	Header *PushUploadRequest_Header `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type PushUploadRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*PushUploadRequest_Header_) isPushUploadRequest_Payload() {}
+
+func (*PushUploadRequest_Chunk) isPushUploadRequest_Payload() {}
+
+func (m *PushUploadRequest) GetPayload() isPushUploadRequest_Payload {
 	if m != nil {
-		return m.Root
+		return m.Payload
 	}
 	return nil
 }
 
-type ArchiveRequest struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+func (m *PushUploadRequest) GetHeader() *PushUploadRequest_Header {
+	if x, ok := m.GetPayload().(*PushUploadRequest_Header_); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (m *PushUploadRequest) GetChunk() []byte {
+	if x, ok := m.GetPayload().(*PushUploadRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*PushUploadRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*PushUploadRequest_Header_)(nil),
+		(*PushUploadRequest_Chunk)(nil),
+	}
+}
+
+type PushUploadRequest_Header struct {
+	SessionID            string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	Offset               int64    `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ArchiveRequest) Reset()         { *m = ArchiveRequest{} }
-func (m *ArchiveRequest) String() string { return proto.CompactTextString(m) }
-func (*ArchiveRequest) ProtoMessage()    {}
-func (*ArchiveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{26}
+func (m *PushUploadRequest_Header) Reset()         { *m = PushUploadRequest_Header{} }
+func (m *PushUploadRequest_Header) String() string { return proto.CompactTextString(m) }
+func (*PushUploadRequest_Header) ProtoMessage()    {}
+func (*PushUploadRequest_Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{23, 0}
 }
 
-func (m *ArchiveRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ArchiveRequest.Unmarshal(m, b)
+func (m *PushUploadRequest_Header) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushUploadRequest_Header.Unmarshal(m, b)
 }
-func (m *ArchiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ArchiveRequest.Marshal(b, m, deterministic)
+func (m *PushUploadRequest_Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushUploadRequest_Header.Marshal(b, m, deterministic)
 }
-func (m *ArchiveRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ArchiveRequest.Merge(m, src)
+func (m *PushUploadRequest_Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushUploadRequest_Header.Merge(m, src)
 }
-func (m *ArchiveRequest) XXX_Size() int {
-	return xxx_messageInfo_ArchiveRequest.Size(m)
+func (m *PushUploadRequest_Header) XXX_Size() int {
+	return xxx_messageInfo_PushUploadRequest_Header.Size(m)
 }
-func (m *ArchiveRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ArchiveRequest.DiscardUnknown(m)
+func (m *PushUploadRequest_Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushUploadRequest_Header.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ArchiveRequest proto.InternalMessageInfo
+var xxx_messageInfo_PushUploadRequest_Header proto.InternalMessageInfo
 
-func (m *ArchiveRequest) GetKey() string {
+func (m *PushUploadRequest_Header) GetSessionID() string {
 	if m != nil {
-		return m.Key
+		return m.SessionID
 	}
 	return ""
 }
 
-type ArchiveReply struct {
+func (m *PushUploadRequest_Header) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type PushUploadReply struct {
+	Offset               int64    `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ArchiveReply) Reset()         { *m = ArchiveReply{} }
-func (m *ArchiveReply) String() string { return proto.CompactTextString(m) }
-func (*ArchiveReply) ProtoMessage()    {}
-func (*ArchiveReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{27}
+func (m *PushUploadReply) Reset()         { *m = PushUploadReply{} }
+func (m *PushUploadReply) String() string { return proto.CompactTextString(m) }
+func (*PushUploadReply) ProtoMessage()    {}
+func (*PushUploadReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{24}
 }
 
-func (m *ArchiveReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ArchiveReply.Unmarshal(m, b)
+func (m *PushUploadReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushUploadReply.Unmarshal(m, b)
 }
-func (m *ArchiveReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ArchiveReply.Marshal(b, m, deterministic)
+func (m *PushUploadReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushUploadReply.Marshal(b, m, deterministic)
 }
-func (m *ArchiveReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ArchiveReply.Merge(m, src)
+func (m *PushUploadReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushUploadReply.Merge(m, src)
 }
-func (m *ArchiveReply) XXX_Size() int {
-	return xxx_messageInfo_ArchiveReply.Size(m)
+func (m *PushUploadReply) XXX_Size() int {
+	return xxx_messageInfo_PushUploadReply.Size(m)
 }
-func (m *ArchiveReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ArchiveReply.DiscardUnknown(m)
+func (m *PushUploadReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushUploadReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ArchiveReply proto.InternalMessageInfo
+var xxx_messageInfo_PushUploadReply proto.InternalMessageInfo
 
-type ArchiveStatusRequest struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+func (m *PushUploadReply) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type CompleteUploadRequest struct {
+	SessionID            string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ArchiveStatusRequest) Reset()         { *m = ArchiveStatusRequest{} }
-func (m *ArchiveStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*ArchiveStatusRequest) ProtoMessage()    {}
-func (*ArchiveStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{28}
+func (m *CompleteUploadRequest) Reset()         { *m = CompleteUploadRequest{} }
+func (m *CompleteUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*CompleteUploadRequest) ProtoMessage()    {}
+func (*CompleteUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{25}
 }
 
-func (m *ArchiveStatusRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ArchiveStatusRequest.Unmarshal(m, b)
+func (m *CompleteUploadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompleteUploadRequest.Unmarshal(m, b)
 }
-func (m *ArchiveStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ArchiveStatusRequest.Marshal(b, m, deterministic)
+func (m *CompleteUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompleteUploadRequest.Marshal(b, m, deterministic)
 }
-func (m *ArchiveStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ArchiveStatusRequest.Merge(m, src)
+func (m *CompleteUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteUploadRequest.Merge(m, src)
 }
-func (m *ArchiveStatusRequest) XXX_Size() int {
-	return xxx_messageInfo_ArchiveStatusRequest.Size(m)
+func (m *CompleteUploadRequest) XXX_Size() int {
+	return xxx_messageInfo_CompleteUploadRequest.Size(m)
 }
-func (m *ArchiveStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ArchiveStatusRequest.DiscardUnknown(m)
+func (m *CompleteUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteUploadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ArchiveStatusRequest proto.InternalMessageInfo
+var xxx_messageInfo_CompleteUploadRequest proto.InternalMessageInfo
 
-func (m *ArchiveStatusRequest) GetKey() string {
+func (m *CompleteUploadRequest) GetSessionID() string {
 	if m != nil {
-		return m.Key
+		return m.SessionID
 	}
 	return ""
 }
 
-type ArchiveStatusReply struct {
-	Key                  string                    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Status               ArchiveStatusReply_Status `protobuf:"varint,2,opt,name=status,proto3,enum=buckets.pb.ArchiveStatusReply_Status" json:"status,omitempty"`
-	FailedMsg            string                    `protobuf:"bytes,3,opt,name=failedMsg,proto3" json:"failedMsg,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+type CompleteUploadReply struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Root                 *Root    `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	ConflictPath         string   `protobuf:"bytes,3,opt,name=conflictPath,proto3" json:"conflictPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ArchiveStatusReply) Reset()         { *m = ArchiveStatusReply{} }
-func (m *ArchiveStatusReply) String() string { return proto.CompactTextString(m) }
-func (*ArchiveStatusReply) ProtoMessage()    {}
-func (*ArchiveStatusReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{29}
+func (m *CompleteUploadReply) Reset()         { *m = CompleteUploadReply{} }
+func (m *CompleteUploadReply) String() string { return proto.CompactTextString(m) }
+func (*CompleteUploadReply) ProtoMessage()    {}
+func (*CompleteUploadReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{26}
 }
 
-func (m *ArchiveStatusReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ArchiveStatusReply.Unmarshal(m, b)
+func (m *CompleteUploadReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompleteUploadReply.Unmarshal(m, b)
 }
-func (m *ArchiveStatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ArchiveStatusReply.Marshal(b, m, deterministic)
+func (m *CompleteUploadReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompleteUploadReply.Marshal(b, m, deterministic)
 }
-func (m *ArchiveStatusReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ArchiveStatusReply.Merge(m, src)
+func (m *CompleteUploadReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteUploadReply.Merge(m, src)
 }
-func (m *ArchiveStatusReply) XXX_Size() int {
-	return xxx_messageInfo_ArchiveStatusReply.Size(m)
+func (m *CompleteUploadReply) XXX_Size() int {
+	return xxx_messageInfo_CompleteUploadReply.Size(m)
 }
-func (m *ArchiveStatusReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ArchiveStatusReply.DiscardUnknown(m)
+func (m *CompleteUploadReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteUploadReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ArchiveStatusReply proto.InternalMessageInfo
+var xxx_messageInfo_CompleteUploadReply proto.InternalMessageInfo
 
-func (m *ArchiveStatusReply) GetKey() string {
+func (m *CompleteUploadReply) GetPath() string {
 	if m != nil {
-		return m.Key
+		return m.Path
 	}
 	return ""
 }
 
-func (m *ArchiveStatusReply) GetStatus() ArchiveStatusReply_Status {
+func (m *CompleteUploadReply) GetRoot() *Root {
 	if m != nil {
-		return m.Status
+		return m.Root
 	}
-	return ArchiveStatusReply_Executing
+	return nil
 }
 
-func (m *ArchiveStatusReply) GetFailedMsg() string {
+func (m *CompleteUploadReply) GetConflictPath() string {
 	if m != nil {
-		return m.FailedMsg
+		return m.ConflictPath
 	}
 	return ""
 }
 
-type ArchiveInfoRequest struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+type CancelUploadRequest struct {
+	SessionID            string   `protobuf:"bytes,1,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ArchiveInfoRequest) Reset()         { *m = ArchiveInfoRequest{} }
-func (m *ArchiveInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*ArchiveInfoRequest) ProtoMessage()    {}
-func (*ArchiveInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{30}
+func (m *CancelUploadRequest) Reset()         { *m = CancelUploadRequest{} }
+func (m *CancelUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelUploadRequest) ProtoMessage()    {}
+func (*CancelUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{27}
 }
 
-func (m *ArchiveInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ArchiveInfoRequest.Unmarshal(m, b)
+func (m *CancelUploadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelUploadRequest.Unmarshal(m, b)
 }
-func (m *ArchiveInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ArchiveInfoRequest.Marshal(b, m, deterministic)
+func (m *CancelUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelUploadRequest.Marshal(b, m, deterministic)
 }
-func (m *ArchiveInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ArchiveInfoRequest.Merge(m, src)
+func (m *CancelUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelUploadRequest.Merge(m, src)
 }
-func (m *ArchiveInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_ArchiveInfoRequest.Size(m)
+func (m *CancelUploadRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelUploadRequest.Size(m)
 }
-func (m *ArchiveInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ArchiveInfoRequest.DiscardUnknown(m)
+func (m *CancelUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelUploadRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ArchiveInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_CancelUploadRequest proto.InternalMessageInfo
 
-func (m *ArchiveInfoRequest) GetKey() string {
+func (m *CancelUploadRequest) GetSessionID() string {
 	if m != nil {
-		return m.Key
+		return m.SessionID
 	}
 	return ""
 }
 
-type ArchiveInfoReply struct {
-	Key                  string                    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Archive              *ArchiveInfoReply_Archive `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+type CancelUploadReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ArchiveInfoReply) Reset()         { *m = ArchiveInfoReply{} }
-func (m *ArchiveInfoReply) String() string { return proto.CompactTextString(m) }
-func (*ArchiveInfoReply) ProtoMessage()    {}
-func (*ArchiveInfoReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{31}
+func (m *CancelUploadReply) Reset()         { *m = CancelUploadReply{} }
+func (m *CancelUploadReply) String() string { return proto.CompactTextString(m) }
+func (*CancelUploadReply) ProtoMessage()    {}
+func (*CancelUploadReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{28}
 }
 
-func (m *ArchiveInfoReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ArchiveInfoReply.Unmarshal(m, b)
+func (m *CancelUploadReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelUploadReply.Unmarshal(m, b)
 }
-func (m *ArchiveInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ArchiveInfoReply.Marshal(b, m, deterministic)
+func (m *CancelUploadReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelUploadReply.Marshal(b, m, deterministic)
 }
-func (m *ArchiveInfoReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ArchiveInfoReply.Merge(m, src)
+func (m *CancelUploadReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelUploadReply.Merge(m, src)
 }
-func (m *ArchiveInfoReply) XXX_Size() int {
-	return xxx_messageInfo_ArchiveInfoReply.Size(m)
+func (m *CancelUploadReply) XXX_Size() int {
+	return xxx_messageInfo_CancelUploadReply.Size(m)
 }
-func (m *ArchiveInfoReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ArchiveInfoReply.DiscardUnknown(m)
+func (m *CancelUploadReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelUploadReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ArchiveInfoReply proto.InternalMessageInfo
+var xxx_messageInfo_CancelUploadReply proto.InternalMessageInfo
 
-func (m *ArchiveInfoReply) GetKey() string {
+type PullPathRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	IfNoneMatch          string   `protobuf:"bytes,3,opt,name=ifNoneMatch,proto3" json:"ifNoneMatch,omitempty"`
+	Offset               int64    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Length               int64    `protobuf:"varint,5,opt,name=length,proto3" json:"length,omitempty"`
+	Encoded              bool     `protobuf:"varint,6,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	Root                 string   `protobuf:"bytes,7,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PullPathRequest) Reset()         { *m = PullPathRequest{} }
+func (m *PullPathRequest) String() string { return proto.CompactTextString(m) }
+func (*PullPathRequest) ProtoMessage()    {}
+func (*PullPathRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{29}
+}
+
+func (m *PullPathRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PullPathRequest.Unmarshal(m, b)
+}
+func (m *PullPathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PullPathRequest.Marshal(b, m, deterministic)
+}
+func (m *PullPathRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullPathRequest.Merge(m, src)
+}
+func (m *PullPathRequest) XXX_Size() int {
+	return xxx_messageInfo_PullPathRequest.Size(m)
+}
+func (m *PullPathRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullPathRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PullPathRequest proto.InternalMessageInfo
+
+func (m *PullPathRequest) GetKey() string {
 	if m != nil {
 		return m.Key
 	}
 	return ""
 }
 
-func (m *ArchiveInfoReply) GetArchive() *ArchiveInfoReply_Archive {
+func (m *PullPathRequest) GetPath() string {
 	if m != nil {
-		return m.Archive
+		return m.Path
 	}
-	return nil
+	return ""
 }
 
-type ArchiveInfoReply_Archive struct {
-	Cid                  string                           `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Deals                []*ArchiveInfoReply_Archive_Deal `protobuf:"bytes,2,rep,name=deals,proto3" json:"deals,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
-	XXX_unrecognized     []byte                           `json:"-"`
-	XXX_sizecache        int32                            `json:"-"`
+func (m *PullPathRequest) GetIfNoneMatch() string {
+	if m != nil {
+		return m.IfNoneMatch
+	}
+	return ""
 }
 
-func (m *ArchiveInfoReply_Archive) Reset()         { *m = ArchiveInfoReply_Archive{} }
-func (m *ArchiveInfoReply_Archive) String() string { return proto.CompactTextString(m) }
-func (*ArchiveInfoReply_Archive) ProtoMessage()    {}
-func (*ArchiveInfoReply_Archive) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{31, 0}
+func (m *PullPathRequest) GetOffset() int64 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
 }
 
-func (m *ArchiveInfoReply_Archive) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ArchiveInfoReply_Archive.Unmarshal(m, b)
-}
-func (m *ArchiveInfoReply_Archive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ArchiveInfoReply_Archive.Marshal(b, m, deterministic)
-}
-func (m *ArchiveInfoReply_Archive) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ArchiveInfoReply_Archive.Merge(m, src)
-}
-func (m *ArchiveInfoReply_Archive) XXX_Size() int {
-	return xxx_messageInfo_ArchiveInfoReply_Archive.Size(m)
-}
-func (m *ArchiveInfoReply_Archive) XXX_DiscardUnknown() {
-	xxx_messageInfo_ArchiveInfoReply_Archive.DiscardUnknown(m)
+func (m *PullPathRequest) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
 }
 
-var xxx_messageInfo_ArchiveInfoReply_Archive proto.InternalMessageInfo
-
-func (m *ArchiveInfoReply_Archive) GetCid() string {
+func (m *PullPathRequest) GetEncoded() bool {
 	if m != nil {
-		return m.Cid
+		return m.Encoded
 	}
-	return ""
+	return false
 }
 
-func (m *ArchiveInfoReply_Archive) GetDeals() []*ArchiveInfoReply_Archive_Deal {
+func (m *PullPathRequest) GetRoot() string {
 	if m != nil {
-		return m.Deals
+		return m.Root
 	}
-	return nil
+	return ""
 }
 
-type ArchiveInfoReply_Archive_Deal struct {
-	ProposalCid          string   `protobuf:"bytes,1,opt,name=proposalCid,proto3" json:"proposalCid,omitempty"`
-	Miner                string   `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
+type PullPathReply struct {
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	Etag                 string   `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	NotModified          bool     `protobuf:"varint,3,opt,name=notModified,proto3" json:"notModified,omitempty"`
+	Size                 int64    `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	ContentEncoding      string   `protobuf:"bytes,5,opt,name=contentEncoding,proto3" json:"contentEncoding,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ArchiveInfoReply_Archive_Deal) Reset()         { *m = ArchiveInfoReply_Archive_Deal{} }
-func (m *ArchiveInfoReply_Archive_Deal) String() string { return proto.CompactTextString(m) }
-func (*ArchiveInfoReply_Archive_Deal) ProtoMessage()    {}
-func (*ArchiveInfoReply_Archive_Deal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{31, 0, 0}
+func (m *PullPathReply) Reset()         { *m = PullPathReply{} }
+func (m *PullPathReply) String() string { return proto.CompactTextString(m) }
+func (*PullPathReply) ProtoMessage()    {}
+func (*PullPathReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{30}
 }
 
-func (m *ArchiveInfoReply_Archive_Deal) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ArchiveInfoReply_Archive_Deal.Unmarshal(m, b)
+func (m *PullPathReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PullPathReply.Unmarshal(m, b)
 }
-func (m *ArchiveInfoReply_Archive_Deal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ArchiveInfoReply_Archive_Deal.Marshal(b, m, deterministic)
+func (m *PullPathReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PullPathReply.Marshal(b, m, deterministic)
 }
-func (m *ArchiveInfoReply_Archive_Deal) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ArchiveInfoReply_Archive_Deal.Merge(m, src)
+func (m *PullPathReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullPathReply.Merge(m, src)
 }
-func (m *ArchiveInfoReply_Archive_Deal) XXX_Size() int {
-	return xxx_messageInfo_ArchiveInfoReply_Archive_Deal.Size(m)
+func (m *PullPathReply) XXX_Size() int {
+	return xxx_messageInfo_PullPathReply.Size(m)
 }
-func (m *ArchiveInfoReply_Archive_Deal) XXX_DiscardUnknown() {
-	xxx_messageInfo_ArchiveInfoReply_Archive_Deal.DiscardUnknown(m)
+func (m *PullPathReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullPathReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ArchiveInfoReply_Archive_Deal proto.InternalMessageInfo
+var xxx_messageInfo_PullPathReply proto.InternalMessageInfo
 
-func (m *ArchiveInfoReply_Archive_Deal) GetProposalCid() string {
+func (m *PullPathReply) GetChunk() []byte {
 	if m != nil {
-		return m.ProposalCid
+		return m.Chunk
+	}
+	return nil
+}
+
+func (m *PullPathReply) GetEtag() string {
+	if m != nil {
+		return m.Etag
 	}
 	return ""
 }
 
-func (m *ArchiveInfoReply_Archive_Deal) GetMiner() string {
+func (m *PullPathReply) GetNotModified() bool {
 	if m != nil {
-		return m.Miner
+		return m.NotModified
+	}
+	return false
+}
+
+func (m *PullPathReply) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *PullPathReply) GetContentEncoding() string {
+	if m != nil {
+		return m.ContentEncoding
 	}
 	return ""
 }
 
-type ArchiveWatchRequest struct {
-	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+type PullIpfsPathRequest struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ArchiveWatchRequest) Reset()         { *m = ArchiveWatchRequest{} }
-func (m *ArchiveWatchRequest) String() string { return proto.CompactTextString(m) }
-func (*ArchiveWatchRequest) ProtoMessage()    {}
-func (*ArchiveWatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{32}
+func (m *PullIpfsPathRequest) Reset()         { *m = PullIpfsPathRequest{} }
+func (m *PullIpfsPathRequest) String() string { return proto.CompactTextString(m) }
+func (*PullIpfsPathRequest) ProtoMessage()    {}
+func (*PullIpfsPathRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{31}
 }
 
-func (m *ArchiveWatchRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ArchiveWatchRequest.Unmarshal(m, b)
+func (m *PullIpfsPathRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PullIpfsPathRequest.Unmarshal(m, b)
 }
-func (m *ArchiveWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ArchiveWatchRequest.Marshal(b, m, deterministic)
+func (m *PullIpfsPathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PullIpfsPathRequest.Marshal(b, m, deterministic)
 }
-func (m *ArchiveWatchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ArchiveWatchRequest.Merge(m, src)
+func (m *PullIpfsPathRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullIpfsPathRequest.Merge(m, src)
 }
-func (m *ArchiveWatchRequest) XXX_Size() int {
-	return xxx_messageInfo_ArchiveWatchRequest.Size(m)
+func (m *PullIpfsPathRequest) XXX_Size() int {
+	return xxx_messageInfo_PullIpfsPathRequest.Size(m)
 }
-func (m *ArchiveWatchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ArchiveWatchRequest.DiscardUnknown(m)
+func (m *PullIpfsPathRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullIpfsPathRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ArchiveWatchRequest proto.InternalMessageInfo
+var xxx_messageInfo_PullIpfsPathRequest proto.InternalMessageInfo
 
-func (m *ArchiveWatchRequest) GetKey() string {
+func (m *PullIpfsPathRequest) GetPath() string {
 	if m != nil {
-		return m.Key
+		return m.Path
 	}
 	return ""
 }
 
-type ArchiveWatchReply struct {
-	Msg                  string   `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+type PullIpfsPathReply struct {
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ArchiveWatchReply) Reset()         { *m = ArchiveWatchReply{} }
-func (m *ArchiveWatchReply) String() string { return proto.CompactTextString(m) }
-func (*ArchiveWatchReply) ProtoMessage()    {}
-func (*ArchiveWatchReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95035767e889ecda, []int{33}
+func (m *PullIpfsPathReply) Reset()         { *m = PullIpfsPathReply{} }
+func (m *PullIpfsPathReply) String() string { return proto.CompactTextString(m) }
+func (*PullIpfsPathReply) ProtoMessage()    {}
+func (*PullIpfsPathReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{32}
 }
 
-func (m *ArchiveWatchReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ArchiveWatchReply.Unmarshal(m, b)
+func (m *PullIpfsPathReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PullIpfsPathReply.Unmarshal(m, b)
 }
-func (m *ArchiveWatchReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ArchiveWatchReply.Marshal(b, m, deterministic)
+func (m *PullIpfsPathReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PullIpfsPathReply.Marshal(b, m, deterministic)
 }
-func (m *ArchiveWatchReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ArchiveWatchReply.Merge(m, src)
+func (m *PullIpfsPathReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PullIpfsPathReply.Merge(m, src)
 }
-func (m *ArchiveWatchReply) XXX_Size() int {
-	return xxx_messageInfo_ArchiveWatchReply.Size(m)
+func (m *PullIpfsPathReply) XXX_Size() int {
+	return xxx_messageInfo_PullIpfsPathReply.Size(m)
 }
-func (m *ArchiveWatchReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_ArchiveWatchReply.DiscardUnknown(m)
+func (m *PullIpfsPathReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PullIpfsPathReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ArchiveWatchReply proto.InternalMessageInfo
+var xxx_messageInfo_PullIpfsPathReply proto.InternalMessageInfo
 
-func (m *ArchiveWatchReply) GetMsg() string {
+func (m *PullIpfsPathReply) GetChunk() []byte {
 	if m != nil {
-		return m.Msg
+		return m.Chunk
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterEnum("buckets.pb.ArchiveStatusReply_Status", ArchiveStatusReply_Status_name, ArchiveStatusReply_Status_value)
-	proto.RegisterType((*Root)(nil), "buckets.pb.Root")
-	proto.RegisterType((*ListRequest)(nil), "buckets.pb.ListRequest")
-	proto.RegisterType((*ListReply)(nil), "buckets.pb.ListReply")
-	proto.RegisterType((*InitRequest)(nil), "buckets.pb.InitRequest")
-	proto.RegisterType((*InitReply)(nil), "buckets.pb.InitReply")
-	proto.RegisterType((*RootRequest)(nil), "buckets.pb.RootRequest")
-	proto.RegisterType((*RootReply)(nil), "buckets.pb.RootReply")
-	proto.RegisterType((*LinksRequest)(nil), "buckets.pb.LinksRequest")
-	proto.RegisterType((*LinksReply)(nil), "buckets.pb.LinksReply")
-	proto.RegisterType((*ListPathRequest)(nil), "buckets.pb.ListPathRequest")
-	proto.RegisterType((*ListPathReply)(nil), "buckets.pb.ListPathReply")
-	proto.RegisterType((*ListPathItem)(nil), "buckets.pb.ListPathItem")
-	proto.RegisterType((*ListIpfsPathRequest)(nil), "buckets.pb.ListIpfsPathRequest")
-	proto.RegisterType((*ListIpfsPathReply)(nil), "buckets.pb.ListIpfsPathReply")
-	proto.RegisterType((*PushPathRequest)(nil), "buckets.pb.PushPathRequest")
-	proto.RegisterType((*PushPathRequest_Header)(nil), "buckets.pb.PushPathRequest.Header")
-	proto.RegisterType((*PushPathReply)(nil), "buckets.pb.PushPathReply")
-	proto.RegisterType((*PushPathReply_Event)(nil), "buckets.pb.PushPathReply.Event")
-	proto.RegisterType((*PullPathRequest)(nil), "buckets.pb.PullPathRequest")
-	proto.RegisterType((*PullPathReply)(nil), "buckets.pb.PullPathReply")
-	proto.RegisterType((*PullIpfsPathRequest)(nil), "buckets.pb.PullIpfsPathRequest")
-	proto.RegisterType((*PullIpfsPathReply)(nil), "buckets.pb.PullIpfsPathReply")
-	proto.RegisterType((*SetPathRequest)(nil), "buckets.pb.SetPathRequest")
-	proto.RegisterType((*SetPathReply)(nil), "buckets.pb.SetPathReply")
-	proto.RegisterType((*RemoveRequest)(nil), "buckets.pb.RemoveRequest")
-	proto.RegisterType((*RemoveReply)(nil), "buckets.pb.RemoveReply")
-	proto.RegisterType((*RemovePathRequest)(nil), "buckets.pb.RemovePathRequest")
-	proto.RegisterType((*RemovePathReply)(nil), "buckets.pb.RemovePathReply")
-	proto.RegisterType((*ArchiveRequest)(nil), "buckets.pb.ArchiveRequest")
-	proto.RegisterType((*ArchiveReply)(nil), "buckets.pb.ArchiveReply")
-	proto.RegisterType((*ArchiveStatusRequest)(nil), "buckets.pb.ArchiveStatusRequest")
-	proto.RegisterType((*ArchiveStatusReply)(nil), "buckets.pb.ArchiveStatusReply")
-	proto.RegisterType((*ArchiveInfoRequest)(nil), "buckets.pb.ArchiveInfoRequest")
-	proto.RegisterType((*ArchiveInfoReply)(nil), "buckets.pb.ArchiveInfoReply")
-	proto.RegisterType((*ArchiveInfoReply_Archive)(nil), "buckets.pb.ArchiveInfoReply.Archive")
-	proto.RegisterType((*ArchiveInfoReply_Archive_Deal)(nil), "buckets.pb.ArchiveInfoReply.Archive.Deal")
-	proto.RegisterType((*ArchiveWatchRequest)(nil), "buckets.pb.ArchiveWatchRequest")
-	proto.RegisterType((*ArchiveWatchReply)(nil), "buckets.pb.ArchiveWatchReply")
+type DiffRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Root                 string   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("buckets.proto", fileDescriptor_95035767e889ecda) }
-
-var fileDescriptor_95035767e889ecda = []byte{
-	// 1264 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x57, 0x4d, 0x6f, 0x22, 0x47,
-	0x13, 0x66, 0x80, 0xc1, 0x50, 0x7c, 0x2c, 0xee, 0xf5, 0xbb, 0xe6, 0x65, 0x3f, 0x4c, 0x5a, 0xeb,
-	0x8d, 0x2d, 0xad, 0xd0, 0xc6, 0x7b, 0xf0, 0x4a, 0x9b, 0x38, 0x02, 0xdb, 0x2b, 0x93, 0xd8, 0x11,
-	0x1a, 0xdb, 0xf2, 0xd1, 0x1a, 0x43, 0xdb, 0x8c, 0x3c, 0x30, 0x93, 0x99, 0xc6, 0x5a, 0x72, 0xc9,
-	0x21, 0xe7, 0xdc, 0x72, 0xcc, 0x69, 0x7f, 0x44, 0xce, 0xf9, 0x41, 0xf9, 0x01, 0x39, 0x46, 0xd5,
-	0xdd, 0x33, 0xcc, 0xc0, 0x80, 0xf0, 0x89, 0xae, 0xea, 0xa7, 0xaa, 0x9f, 0xaa, 0xa9, 0xae, 0x6a,
-	0xa0, 0x7c, 0x33, 0xee, 0xdd, 0x33, 0xee, 0x37, 0x5d, 0xcf, 0xe1, 0x0e, 0x81, 0x50, 0xbc, 0xa1,
-	0x7f, 0x68, 0x90, 0x35, 0x1c, 0x87, 0x93, 0x2a, 0x64, 0xee, 0xd9, 0xa4, 0xa6, 0x35, 0xb4, 0x9d,
-	0x82, 0x81, 0x4b, 0x42, 0x20, 0x3b, 0x32, 0x87, 0xac, 0x96, 0x16, 0x2a, 0xb1, 0x46, 0x9d, 0x6b,
-	0xf2, 0x41, 0x2d, 0x23, 0x75, 0xb8, 0x26, 0x2f, 0xa0, 0xd0, 0xf3, 0x98, 0xc9, 0x59, 0xbf, 0xc5,
-	0x6b, 0xd9, 0x86, 0xb6, 0x93, 0x31, 0xa6, 0x0a, 0xdc, 0x1d, 0xbb, 0x7d, 0xb5, 0xab, 0xcb, 0xdd,
-	0x50, 0x41, 0x9e, 0x41, 0x8e, 0x0f, 0x3c, 0x66, 0xf6, 0x6b, 0x39, 0xe1, 0x51, 0x49, 0xb4, 0x0c,
-	0xc5, 0x53, 0xcb, 0xe7, 0x06, 0xfb, 0x79, 0xcc, 0x7c, 0x4e, 0xdf, 0x43, 0x41, 0x8a, 0xae, 0x3d,
-	0x21, 0x6f, 0x40, 0xf7, 0x1c, 0x87, 0xfb, 0x35, 0xad, 0x91, 0xd9, 0x29, 0xee, 0x55, 0x9b, 0xd3,
-	0x70, 0x9a, 0x18, 0x8a, 0x21, 0xb7, 0xe9, 0x35, 0x14, 0x3b, 0x23, 0x2b, 0xf0, 0x11, 0x86, 0xa3,
-	0x45, 0xc2, 0xa1, 0x50, 0xba, 0x41, 0x2c, 0xf7, 0x4c, 0xf7, 0xd0, 0xea, 0xab, 0x50, 0x63, 0x3a,
-	0x52, 0x83, 0x35, 0xd7, 0xb3, 0x1e, 0x4c, 0xce, 0x44, 0xd4, 0x79, 0x23, 0x10, 0xe9, 0xef, 0x1a,
-	0x14, 0xe4, 0x09, 0x48, 0xeb, 0x35, 0x64, 0xf1, 0x5c, 0xe1, 0x3f, 0x89, 0x95, 0xd8, 0x25, 0x6f,
-	0x41, 0xb7, 0xad, 0xd1, 0xbd, 0x2f, 0x8e, 0x2a, 0xee, 0x3d, 0x8b, 0xc2, 0x4e, 0x71, 0x43, 0x38,
-	0x33, 0x24, 0x08, 0x39, 0xfb, 0x8c, 0xf5, 0xc5, 0xc1, 0x25, 0x43, 0xac, 0x91, 0x0f, 0xfe, 0x22,
-	0xdd, 0xac, 0xa0, 0x1b, 0x88, 0x74, 0x0b, 0x8a, 0xe2, 0x24, 0x15, 0xf0, 0xdc, 0x17, 0xa5, 0xdf,
-	0x40, 0x41, 0x02, 0x56, 0xe6, 0x4b, 0x1b, 0x50, 0x52, 0xb4, 0x16, 0x39, 0x3d, 0x02, 0x98, 0x12,
-	0xc7, 0xfd, 0x4b, 0xe3, 0x34, 0xd8, 0xbf, 0x34, 0x4e, 0x51, 0x73, 0x75, 0x75, 0xa5, 0x52, 0x8b,
-	0x4b, 0x8c, 0xaa, 0xd3, 0xfd, 0xe9, 0x3c, 0x28, 0x22, 0x5c, 0xd3, 0x7d, 0x78, 0x82, 0x5f, 0xb8,
-	0x6b, 0xf2, 0xc1, 0xc2, 0xa3, 0xc2, 0xea, 0x4b, 0x4f, 0xab, 0x8f, 0xf6, 0xa0, 0x3c, 0x35, 0x44,
-	0x06, 0x6f, 0x21, 0x6b, 0x71, 0x36, 0x54, 0x71, 0xd5, 0xe2, 0x09, 0x96, 0xc0, 0x0e, 0x67, 0x43,
-	0x43, 0xa0, 0xc2, 0x2c, 0xa4, 0x97, 0x66, 0xe1, 0x8b, 0x86, 0x69, 0x98, 0x1a, 0x23, 0xb7, 0x9e,
-	0xd5, 0x0f, 0xb8, 0xf5, 0xac, 0xfe, 0xca, 0xb7, 0x05, 0x3f, 0xa9, 0xf5, 0x0b, 0x53, 0x17, 0x45,
-	0xac, 0xc9, 0x06, 0xe8, 0x96, 0x7f, 0x64, 0x79, 0xe2, 0x7e, 0xe4, 0x0d, 0x29, 0x90, 0x26, 0xe8,
-	0x48, 0xd1, 0xaf, 0xe5, 0x44, 0x9d, 0x2f, 0x8e, 0x44, 0xc2, 0xe8, 0x2e, 0x3c, 0x45, 0x75, 0xc7,
-	0xbd, 0xf5, 0xa3, 0x69, 0x0c, 0x48, 0x68, 0x91, 0xa4, 0xb5, 0x60, 0x3d, 0x0e, 0x7d, 0x74, 0xe2,
-	0xe8, 0x5f, 0x1a, 0x3c, 0xe9, 0x8e, 0xfd, 0x41, 0xf4, 0xa8, 0x6f, 0x21, 0x37, 0x60, 0x66, 0x9f,
-	0x79, 0xca, 0x07, 0x8d, 0xfa, 0x98, 0x01, 0x37, 0x4f, 0x04, 0xf2, 0x24, 0x65, 0x28, 0x1b, 0xf2,
-	0x0c, 0xf4, 0xde, 0x60, 0x3c, 0xba, 0x17, 0x29, 0x2c, 0x9d, 0xa4, 0x0c, 0x29, 0xd6, 0xdb, 0x90,
-	0x93, 0xd8, 0xd5, 0x2a, 0x02, 0x75, 0xe2, 0x93, 0xaa, 0xac, 0xe3, 0xba, 0x5d, 0x80, 0x35, 0xd7,
-	0x9c, 0xd8, 0x8e, 0xd9, 0xa7, 0xff, 0x68, 0x50, 0x9e, 0x72, 0xc1, 0xc0, 0xf7, 0x41, 0x67, 0x0f,
-	0x6c, 0x14, 0x5c, 0x85, 0xad, 0x64, 0xd6, 0xae, 0x3d, 0x69, 0x1e, 0x23, 0x0c, 0x99, 0x09, 0x3c,
-	0x32, 0x66, 0x9e, 0xe7, 0x78, 0xf2, 0x78, 0xa1, 0x47, 0xb1, 0xfe, 0x2b, 0xe8, 0x02, 0x99, 0xd8,
-	0x73, 0x92, 0x28, 0x6f, 0x80, 0x7e, 0x33, 0xe1, 0xcc, 0x17, 0x9c, 0x33, 0x86, 0x14, 0x62, 0xa5,
-	0x52, 0x50, 0xa5, 0x12, 0xd4, 0xab, 0xbe, 0xac, 0x5e, 0xa3, 0xe1, 0xee, 0xe3, 0x67, 0xb2, 0xed,
-	0xc7, 0x5f, 0xac, 0x6d, 0x4c, 0x53, 0x60, 0x88, 0x69, 0xda, 0x08, 0xbe, 0x8f, 0x26, 0xba, 0x91,
-	0x14, 0xb0, 0xea, 0x10, 0xb6, 0x4a, 0xd5, 0xed, 0xc2, 0x7a, 0x1c, 0xba, 0xd8, 0xeb, 0x09, 0x54,
-	0xce, 0xd9, 0xe3, 0xbb, 0x41, 0x70, 0x2f, 0x33, 0xe1, 0xbd, 0xa4, 0x15, 0x28, 0x85, 0x9e, 0x5c,
-	0x7b, 0x42, 0xbf, 0x82, 0xb2, 0xc1, 0x86, 0xce, 0x03, 0x5b, 0xdc, 0xd1, 0xca, 0x50, 0x0c, 0x20,
-	0x68, 0x71, 0x06, 0xeb, 0x52, 0x7c, 0x3c, 0x9d, 0x84, 0x52, 0xc4, 0x0f, 0x12, 0x75, 0xb7, 0x7a,
-	0x2b, 0xa6, 0x50, 0x69, 0x79, 0xbd, 0x81, 0xb5, 0x8c, 0x7a, 0x05, 0x4a, 0x21, 0x06, 0xb9, 0xef,
-	0xc0, 0x86, 0x92, 0xcf, 0xb9, 0xc9, 0xc7, 0x4b, 0xda, 0xf8, 0xdf, 0x1a, 0x90, 0x19, 0xa8, 0xea,
-	0xe7, 0x33, 0x71, 0x7e, 0x07, 0x39, 0x5f, 0x00, 0x44, 0xa4, 0x95, 0xbd, 0xed, 0x28, 0xdd, 0x79,
-	0x0f, 0x4d, 0xb5, 0x56, 0x46, 0xf8, 0x1e, 0xb8, 0x35, 0x2d, 0x9b, 0xf5, 0xcf, 0xfc, 0x3b, 0x95,
-	0x97, 0xa9, 0x82, 0x7e, 0x84, 0x9c, 0xc4, 0x93, 0x32, 0x14, 0x8e, 0x3f, 0xb3, 0xde, 0x98, 0x5b,
-	0xa3, 0xbb, 0x6a, 0x8a, 0x00, 0xe4, 0x3e, 0x09, 0x54, 0x55, 0x23, 0x79, 0xc8, 0x1e, 0x39, 0x23,
-	0x56, 0x4d, 0x93, 0x12, 0xe4, 0x0f, 0xcd, 0x51, 0x8f, 0xa1, 0x3e, 0x43, 0xdf, 0x84, 0x11, 0x74,
-	0x46, 0xb7, 0xce, 0xe2, 0x50, 0x7f, 0x4b, 0x43, 0x35, 0x06, 0x4c, 0x0e, 0xf4, 0x00, 0xd6, 0x4c,
-	0x89, 0x52, 0xd3, 0xe1, 0x75, 0x42, 0xa4, 0xa1, 0x83, 0x40, 0x61, 0x04, 0x46, 0xf5, 0x3f, 0x35,
-	0x58, 0x53, 0xca, 0x84, 0x79, 0xf1, 0x3d, 0xe8, 0x7d, 0x66, 0xda, 0x98, 0x45, 0xec, 0xee, 0xbb,
-	0xab, 0xf8, 0x6e, 0x1e, 0x31, 0xd3, 0x36, 0xa4, 0x5d, 0xfd, 0x00, 0xb2, 0x28, 0x92, 0x06, 0x14,
-	0x5d, 0xcf, 0x71, 0x1d, 0xdf, 0xb4, 0x0f, 0xc3, 0x23, 0xa2, 0x2a, 0xbc, 0x62, 0x43, 0x6b, 0xc4,
-	0x54, 0x9b, 0x32, 0xa4, 0x40, 0xbf, 0x86, 0xa7, 0xca, 0xed, 0x95, 0xc9, 0x7b, 0x8b, 0x0b, 0x9b,
-	0x6e, 0xc3, 0x7a, 0x1c, 0xa8, 0xd2, 0x35, 0xf4, 0xef, 0x02, 0xd8, 0xd0, 0xbf, 0xdb, 0xfb, 0x37,
-	0x0f, 0x99, 0x56, 0xb7, 0x43, 0x3e, 0x40, 0x16, 0xc7, 0x05, 0xd9, 0x9c, 0x1d, 0x20, 0xea, 0x84,
-	0xfa, 0xff, 0xe6, 0x37, 0xb0, 0x54, 0x53, 0x68, 0x89, 0xcf, 0xa9, 0xb8, 0x65, 0xe4, 0x09, 0x17,
-	0xb7, 0x0c, 0x5f, 0x5e, 0xd2, 0x52, 0x3c, 0x62, 0x37, 0xe7, 0xae, 0x4e, 0x92, 0x65, 0xf8, 0x06,
-	0xa2, 0x29, 0xf2, 0x11, 0x74, 0xf1, 0x7a, 0x21, 0xb5, 0x84, 0x97, 0x98, 0xb4, 0x5d, 0xf0, 0x46,
-	0xa3, 0x29, 0x72, 0x04, 0xf9, 0x60, 0x32, 0x92, 0xe7, 0x49, 0xf3, 0x32, 0x70, 0xf1, 0xff, 0xe4,
-	0x4d, 0xe9, 0xa5, 0x2b, 0xdf, 0x16, 0x41, 0x5b, 0x24, 0x5b, 0xb3, 0xe0, 0x99, 0xde, 0x5a, 0x7f,
-	0xb9, 0x18, 0x20, 0x3d, 0x9e, 0x40, 0x3e, 0x98, 0x5b, 0x71, 0x5e, 0x33, 0x33, 0x38, 0xce, 0x2b,
-	0x36, 0xea, 0x68, 0x6a, 0x47, 0x7b, 0xa7, 0x91, 0x4f, 0xe8, 0x49, 0x0e, 0x81, 0x59, 0x4f, 0xb1,
-	0x99, 0x32, 0xeb, 0x29, 0x32, 0x37, 0x68, 0xea, 0x9d, 0x46, 0x0c, 0x28, 0x45, 0x5b, 0x3f, 0xd9,
-	0x9a, 0x85, 0x2f, 0x8d, 0x71, 0x6e, 0x6a, 0x08, 0x9f, 0x2d, 0x58, 0x53, 0x9d, 0x9d, 0xd4, 0xa3,
-	0xe8, 0xf8, 0xe0, 0xa8, 0xd7, 0x12, 0xf7, 0x64, 0xa2, 0x0e, 0x20, 0x27, 0x7b, 0x31, 0x89, 0xf1,
-	0x8f, 0x0d, 0x88, 0xfa, 0x66, 0xd2, 0x96, 0xb4, 0xff, 0x01, 0x60, 0xda, 0xcb, 0xc9, 0xcb, 0x79,
-	0x60, 0x94, 0xc8, 0xf3, 0x45, 0xdb, 0xd2, 0x57, 0x6b, 0xda, 0x2d, 0xea, 0x09, 0xcd, 0x20, 0x31,
-	0x9c, 0x58, 0xaf, 0x4f, 0x91, 0x73, 0x28, 0xc7, 0x1a, 0x30, 0x69, 0x2c, 0xe9, 0xcd, 0xd2, 0xdd,
-	0xab, 0xe5, 0xdd, 0x9b, 0xa6, 0xc8, 0x19, 0x14, 0x23, 0xfd, 0x88, 0xbc, 0x5a, 0xd8, 0xa8, 0xa4,
-	0xc3, 0x17, 0xcb, 0x1a, 0x19, 0x4d, 0x61, 0x25, 0x44, 0xbb, 0x49, 0xbc, 0x12, 0x12, 0x1a, 0x52,
-	0xbc, 0x12, 0xe6, 0x1a, 0x11, 0x56, 0x42, 0xfb, 0x03, 0x6c, 0x5a, 0x4e, 0x93, 0xb3, 0xcf, 0xdc,
-	0xb2, 0x59, 0x00, 0xbf, 0xbe, 0xf3, 0xdc, 0x5e, 0xbb, 0x72, 0x21, 0xb5, 0x6d, 0xa9, 0xec, 0x6a,
-	0x5f, 0xd2, 0x70, 0x71, 0x71, 0xdd, 0xbe, 0x3c, 0xfc, 0xf1, 0xf8, 0xe2, 0xfc, 0x26, 0x27, 0xfe,
-	0x11, 0xbf, 0xff, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa8, 0xdf, 0x8c, 0x42, 0x22, 0x0f, 0x00, 0x00,
+func (m *DiffRequest) Reset()         { *m = DiffRequest{} }
+func (m *DiffRequest) String() string { return proto.CompactTextString(m) }
+func (*DiffRequest) ProtoMessage()    {}
+func (*DiffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{33}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// APIClient is the client API for API service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIClient interface {
-	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListReply, error)
-	Init(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitReply, error)
-	Root(ctx context.Context, in *RootRequest, opts ...grpc.CallOption) (*RootReply, error)
-	Links(ctx context.Context, in *LinksRequest, opts ...grpc.CallOption) (*LinksReply, error)
-	ListPath(ctx context.Context, in *ListPathRequest, opts ...grpc.CallOption) (*ListPathReply, error)
-	ListIpfsPath(ctx context.Context, in *ListIpfsPathRequest, opts ...grpc.CallOption) (*ListIpfsPathReply, error)
-	PushPath(ctx context.Context, opts ...grpc.CallOption) (API_PushPathClient, error)
-	PullPath(ctx context.Context, in *PullPathRequest, opts ...grpc.CallOption) (API_PullPathClient, error)
-	PullIpfsPath(ctx context.Context, in *PullIpfsPathRequest, opts ...grpc.CallOption) (API_PullIpfsPathClient, error)
-	SetPath(ctx context.Context, in *SetPathRequest, opts ...grpc.CallOption) (*SetPathReply, error)
-	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveReply, error)
-	RemovePath(ctx context.Context, in *RemovePathRequest, opts ...grpc.CallOption) (*RemovePathReply, error)
-	// Archive
-	Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (*ArchiveReply, error)
-	ArchiveStatus(ctx context.Context, in *ArchiveStatusRequest, opts ...grpc.CallOption) (*ArchiveStatusReply, error)
-	ArchiveInfo(ctx context.Context, in *ArchiveInfoRequest, opts ...grpc.CallOption) (*ArchiveInfoReply, error)
-	ArchiveWatch(ctx context.Context, in *ArchiveWatchRequest, opts ...grpc.CallOption) (API_ArchiveWatchClient, error)
+func (m *DiffRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffRequest.Unmarshal(m, b)
 }
-
-type aPIClient struct {
-	cc *grpc.ClientConn
+func (m *DiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffRequest.Marshal(b, m, deterministic)
 }
-
-func NewAPIClient(cc *grpc.ClientConn) APIClient {
-	return &aPIClient{cc}
+func (m *DiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffRequest.Merge(m, src)
+}
+func (m *DiffRequest) XXX_Size() int {
+	return xxx_messageInfo_DiffRequest.Size(m)
+}
+func (m *DiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffRequest.DiscardUnknown(m)
 }
 
-func (c *aPIClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListReply, error) {
-	out := new(ListReply)
-	err := c.cc.Invoke(ctx, "/buckets.pb.API/List", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DiffRequest proto.InternalMessageInfo
+
+func (m *DiffRequest) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) Init(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitReply, error) {
-	out := new(InitReply)
-	err := c.cc.Invoke(ctx, "/buckets.pb.API/Init", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *DiffRequest) GetRoot() string {
+	if m != nil {
+		return m.Root
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) Root(ctx context.Context, in *RootRequest, opts ...grpc.CallOption) (*RootReply, error) {
-	out := new(RootReply)
-	err := c.cc.Invoke(ctx, "/buckets.pb.API/Root", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type DiffReply struct {
+	Changes              []*DiffReply_Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	Root                 *Root               `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (c *aPIClient) Links(ctx context.Context, in *LinksRequest, opts ...grpc.CallOption) (*LinksReply, error) {
-	out := new(LinksReply)
-	err := c.cc.Invoke(ctx, "/buckets.pb.API/Links", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DiffReply) Reset()         { *m = DiffReply{} }
+func (m *DiffReply) String() string { return proto.CompactTextString(m) }
+func (*DiffReply) ProtoMessage()    {}
+func (*DiffReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{34}
 }
 
-func (c *aPIClient) ListPath(ctx context.Context, in *ListPathRequest, opts ...grpc.CallOption) (*ListPathReply, error) {
-	out := new(ListPathReply)
-	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListPath", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *DiffReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffReply.Unmarshal(m, b)
+}
+func (m *DiffReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffReply.Marshal(b, m, deterministic)
+}
+func (m *DiffReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffReply.Merge(m, src)
+}
+func (m *DiffReply) XXX_Size() int {
+	return xxx_messageInfo_DiffReply.Size(m)
+}
+func (m *DiffReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffReply.DiscardUnknown(m)
 }
 
-func (c *aPIClient) ListIpfsPath(ctx context.Context, in *ListIpfsPathRequest, opts ...grpc.CallOption) (*ListIpfsPathReply, error) {
-	out := new(ListIpfsPathReply)
-	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListIpfsPath", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_DiffReply proto.InternalMessageInfo
+
+func (m *DiffReply) GetChanges() []*DiffReply_Change {
+	if m != nil {
+		return m.Changes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIClient) PushPath(ctx context.Context, opts ...grpc.CallOption) (API_PushPathClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/buckets.pb.API/PushPath", opts...)
-	if err != nil {
-		return nil, err
+func (m *DiffReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
 	}
-	x := &aPIPushPathClient{stream}
-	return x, nil
+	return nil
 }
 
-type API_PushPathClient interface {
-	Send(*PushPathRequest) error
-	Recv() (*PushPathReply, error)
-	grpc.ClientStream
+type DiffReply_Change struct {
+	Type                 DiffReply_Change_Type `protobuf:"varint,1,opt,name=type,proto3,enum=buckets.pb.DiffReply_Change_Type" json:"type,omitempty"`
+	Path                 string                `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Cid                  string                `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Size                 int64                 `protobuf:"varint,4,opt,name=size,proto3" json:"size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-type aPIPushPathClient struct {
-	grpc.ClientStream
+func (m *DiffReply_Change) Reset()         { *m = DiffReply_Change{} }
+func (m *DiffReply_Change) String() string { return proto.CompactTextString(m) }
+func (*DiffReply_Change) ProtoMessage()    {}
+func (*DiffReply_Change) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{34, 0}
 }
 
-func (x *aPIPushPathClient) Send(m *PushPathRequest) error {
-	return x.ClientStream.SendMsg(m)
+func (m *DiffReply_Change) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DiffReply_Change.Unmarshal(m, b)
 }
-
-func (x *aPIPushPathClient) Recv() (*PushPathReply, error) {
-	m := new(PushPathReply)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *DiffReply_Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DiffReply_Change.Marshal(b, m, deterministic)
+}
+func (m *DiffReply_Change) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffReply_Change.Merge(m, src)
+}
+func (m *DiffReply_Change) XXX_Size() int {
+	return xxx_messageInfo_DiffReply_Change.Size(m)
+}
+func (m *DiffReply_Change) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffReply_Change.DiscardUnknown(m)
 }
 
-func (c *aPIClient) PullPath(ctx context.Context, in *PullPathRequest, opts ...grpc.CallOption) (API_PullPathClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/buckets.pb.API/PullPath", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIPullPathClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_DiffReply_Change proto.InternalMessageInfo
+
+func (m *DiffReply_Change) GetType() DiffReply_Change_Type {
+	if m != nil {
+		return m.Type
 	}
-	return x, nil
+	return DiffReply_Change_Add
 }
 
-type API_PullPathClient interface {
-	Recv() (*PullPathReply, error)
-	grpc.ClientStream
+func (m *DiffReply_Change) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
 }
 
-type aPIPullPathClient struct {
-	grpc.ClientStream
+func (m *DiffReply_Change) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
 }
 
-func (x *aPIPullPathClient) Recv() (*PullPathReply, error) {
-	m := new(PullPathReply)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *DiffReply_Change) GetSize() int64 {
+	if m != nil {
+		return m.Size
 	}
-	return m, nil
+	return 0
 }
 
-func (c *aPIClient) PullIpfsPath(ctx context.Context, in *PullIpfsPathRequest, opts ...grpc.CallOption) (API_PullIpfsPathClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/buckets.pb.API/PullIpfsPath", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &aPIPullIpfsPathClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+type GetBlockRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Cid                  string   `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type API_PullIpfsPathClient interface {
-	Recv() (*PullIpfsPathReply, error)
-	grpc.ClientStream
+func (m *GetBlockRequest) Reset()         { *m = GetBlockRequest{} }
+func (m *GetBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*GetBlockRequest) ProtoMessage()    {}
+func (*GetBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{35}
 }
 
-type aPIPullIpfsPathClient struct {
-	grpc.ClientStream
+func (m *GetBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockRequest.Unmarshal(m, b)
+}
+func (m *GetBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockRequest.Marshal(b, m, deterministic)
+}
+func (m *GetBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockRequest.Merge(m, src)
+}
+func (m *GetBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_GetBlockRequest.Size(m)
+}
+func (m *GetBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockRequest.DiscardUnknown(m)
 }
 
-func (x *aPIPullIpfsPathClient) Recv() (*PullIpfsPathReply, error) {
-	m := new(PullIpfsPathReply)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+var xxx_messageInfo_GetBlockRequest proto.InternalMessageInfo
+
+func (m *GetBlockRequest) GetKey() string {
+	if m != nil {
+		return m.Key
 	}
-	return m, nil
+	return ""
 }
 
-func (c *aPIClient) SetPath(ctx context.Context, in *SetPathRequest, opts ...grpc.CallOption) (*SetPathReply, error) {
-	out := new(SetPathReply)
-	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetPath", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetBlockRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveReply, error) {
-	out := new(RemoveReply)
-	err := c.cc.Invoke(ctx, "/buckets.pb.API/Remove", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetBlockRequest) GetPath() string {
+	if m != nil {
+		return m.Path
 	}
-	return out, nil
+	return ""
 }
 
-func (c *aPIClient) RemovePath(ctx context.Context, in *RemovePathRequest, opts ...grpc.CallOption) (*RemovePathReply, error) {
-	out := new(RemovePathReply)
-	err := c.cc.Invoke(ctx, "/buckets.pb.API/RemovePath", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetBlockReply struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *aPIClient) Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (*ArchiveReply, error) {
-	out := new(ArchiveReply)
-	err := c.cc.Invoke(ctx, "/buckets.pb.API/Archive", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetBlockReply) Reset()         { *m = GetBlockReply{} }
+func (m *GetBlockReply) String() string { return proto.CompactTextString(m) }
+func (*GetBlockReply) ProtoMessage()    {}
+func (*GetBlockReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{36}
 }
 
-func (c *aPIClient) ArchiveStatus(ctx context.Context, in *ArchiveStatusRequest, opts ...grpc.CallOption) (*ArchiveStatusReply, error) {
-	out := new(ArchiveStatusReply)
+func (m *GetBlockReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetBlockReply.Unmarshal(m, b)
+}
+func (m *GetBlockReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetBlockReply.Marshal(b, m, deterministic)
+}
+func (m *GetBlockReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBlockReply.Merge(m, src)
+}
+func (m *GetBlockReply) XXX_Size() int {
+	return xxx_messageInfo_GetBlockReply.Size(m)
+}
+func (m *GetBlockReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBlockReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBlockReply proto.InternalMessageInfo
+
+func (m *GetBlockReply) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type HasBlockRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Cid                  string   `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HasBlockRequest) Reset()         { *m = HasBlockRequest{} }
+func (m *HasBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*HasBlockRequest) ProtoMessage()    {}
+func (*HasBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{37}
+}
+
+func (m *HasBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasBlockRequest.Unmarshal(m, b)
+}
+func (m *HasBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasBlockRequest.Marshal(b, m, deterministic)
+}
+func (m *HasBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasBlockRequest.Merge(m, src)
+}
+func (m *HasBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_HasBlockRequest.Size(m)
+}
+func (m *HasBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HasBlockRequest proto.InternalMessageInfo
+
+func (m *HasBlockRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *HasBlockRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *HasBlockRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type HasBlockReply struct {
+	Has                  bool     `protobuf:"varint,1,opt,name=has,proto3" json:"has,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HasBlockReply) Reset()         { *m = HasBlockReply{} }
+func (m *HasBlockReply) String() string { return proto.CompactTextString(m) }
+func (*HasBlockReply) ProtoMessage()    {}
+func (*HasBlockReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{38}
+}
+
+func (m *HasBlockReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasBlockReply.Unmarshal(m, b)
+}
+func (m *HasBlockReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasBlockReply.Marshal(b, m, deterministic)
+}
+func (m *HasBlockReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasBlockReply.Merge(m, src)
+}
+func (m *HasBlockReply) XXX_Size() int {
+	return xxx_messageInfo_HasBlockReply.Size(m)
+}
+func (m *HasBlockReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasBlockReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HasBlockReply proto.InternalMessageInfo
+
+func (m *HasBlockReply) GetHas() bool {
+	if m != nil {
+		return m.Has
+	}
+	return false
+}
+
+type PutBlockRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Format               string   `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	Cid                  string   `protobuf:"bytes,4,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutBlockRequest) Reset()         { *m = PutBlockRequest{} }
+func (m *PutBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*PutBlockRequest) ProtoMessage()    {}
+func (*PutBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{39}
+}
+
+func (m *PutBlockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutBlockRequest.Unmarshal(m, b)
+}
+func (m *PutBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutBlockRequest.Marshal(b, m, deterministic)
+}
+func (m *PutBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutBlockRequest.Merge(m, src)
+}
+func (m *PutBlockRequest) XXX_Size() int {
+	return xxx_messageInfo_PutBlockRequest.Size(m)
+}
+func (m *PutBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutBlockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutBlockRequest proto.InternalMessageInfo
+
+func (m *PutBlockRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *PutBlockRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *PutBlockRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
+func (m *PutBlockRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+type PutBlockReply struct {
+	Cid                  string   `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PutBlockReply) Reset()         { *m = PutBlockReply{} }
+func (m *PutBlockReply) String() string { return proto.CompactTextString(m) }
+func (*PutBlockReply) ProtoMessage()    {}
+func (*PutBlockReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{40}
+}
+
+func (m *PutBlockReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PutBlockReply.Unmarshal(m, b)
+}
+func (m *PutBlockReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PutBlockReply.Marshal(b, m, deterministic)
+}
+func (m *PutBlockReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PutBlockReply.Merge(m, src)
+}
+func (m *PutBlockReply) XXX_Size() int {
+	return xxx_messageInfo_PutBlockReply.Size(m)
+}
+func (m *PutBlockReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_PutBlockReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PutBlockReply proto.InternalMessageInfo
+
+func (m *PutBlockReply) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+type ExportBucketRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportBucketRequest) Reset()         { *m = ExportBucketRequest{} }
+func (m *ExportBucketRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportBucketRequest) ProtoMessage()    {}
+func (*ExportBucketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{41}
+}
+
+func (m *ExportBucketRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportBucketRequest.Unmarshal(m, b)
+}
+func (m *ExportBucketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportBucketRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportBucketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportBucketRequest.Merge(m, src)
+}
+func (m *ExportBucketRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportBucketRequest.Size(m)
+}
+func (m *ExportBucketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportBucketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportBucketRequest proto.InternalMessageInfo
+
+func (m *ExportBucketRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ExportBucketReply struct {
+	Chunk                []byte   `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportBucketReply) Reset()         { *m = ExportBucketReply{} }
+func (m *ExportBucketReply) String() string { return proto.CompactTextString(m) }
+func (*ExportBucketReply) ProtoMessage()    {}
+func (*ExportBucketReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{42}
+}
+
+func (m *ExportBucketReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportBucketReply.Unmarshal(m, b)
+}
+func (m *ExportBucketReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportBucketReply.Marshal(b, m, deterministic)
+}
+func (m *ExportBucketReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportBucketReply.Merge(m, src)
+}
+func (m *ExportBucketReply) XXX_Size() int {
+	return xxx_messageInfo_ExportBucketReply.Size(m)
+}
+func (m *ExportBucketReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportBucketReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportBucketReply proto.InternalMessageInfo
+
+func (m *ExportBucketReply) GetChunk() []byte {
+	if m != nil {
+		return m.Chunk
+	}
+	return nil
+}
+
+type ImportBucketRequest struct {
+	// Types that are valid to be assigned to Payload:
+	//	*ImportBucketRequest_Header_
+	//	*ImportBucketRequest_Chunk
+	Payload              isImportBucketRequest_Payload `protobuf_oneof:"payload"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *ImportBucketRequest) Reset()         { *m = ImportBucketRequest{} }
+func (m *ImportBucketRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportBucketRequest) ProtoMessage()    {}
+func (*ImportBucketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{43}
+}
+
+func (m *ImportBucketRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportBucketRequest.Unmarshal(m, b)
+}
+func (m *ImportBucketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportBucketRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportBucketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportBucketRequest.Merge(m, src)
+}
+func (m *ImportBucketRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportBucketRequest.Size(m)
+}
+func (m *ImportBucketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportBucketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportBucketRequest proto.InternalMessageInfo
+
+type isImportBucketRequest_Payload interface {
+	isImportBucketRequest_Payload()
+}
+
+type ImportBucketRequest_Header_ struct {
+	Header *ImportBucketRequest_Header `protobuf:"bytes,1,opt,name=header,proto3,oneof"`
+}
+
+type ImportBucketRequest_Chunk struct {
+	Chunk []byte `protobuf:"bytes,2,opt,name=chunk,proto3,oneof"`
+}
+
+func (*ImportBucketRequest_Header_) isImportBucketRequest_Payload() {}
+
+func (*ImportBucketRequest_Chunk) isImportBucketRequest_Payload() {}
+
+func (m *ImportBucketRequest) GetPayload() isImportBucketRequest_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ImportBucketRequest) GetHeader() *ImportBucketRequest_Header {
+	if x, ok := m.GetPayload().(*ImportBucketRequest_Header_); ok {
+		return x.Header
+	}
+	return nil
+}
+
+func (m *ImportBucketRequest) GetChunk() []byte {
+	if x, ok := m.GetPayload().(*ImportBucketRequest_Chunk); ok {
+		return x.Chunk
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ImportBucketRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ImportBucketRequest_Header_)(nil),
+		(*ImportBucketRequest_Chunk)(nil),
+	}
+}
+
+type ImportBucketRequest_Header struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Root                 string   `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Private              bool     `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportBucketRequest_Header) Reset()         { *m = ImportBucketRequest_Header{} }
+func (m *ImportBucketRequest_Header) String() string { return proto.CompactTextString(m) }
+func (*ImportBucketRequest_Header) ProtoMessage()    {}
+func (*ImportBucketRequest_Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{43, 0}
+}
+
+func (m *ImportBucketRequest_Header) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportBucketRequest_Header.Unmarshal(m, b)
+}
+func (m *ImportBucketRequest_Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportBucketRequest_Header.Marshal(b, m, deterministic)
+}
+func (m *ImportBucketRequest_Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportBucketRequest_Header.Merge(m, src)
+}
+func (m *ImportBucketRequest_Header) XXX_Size() int {
+	return xxx_messageInfo_ImportBucketRequest_Header.Size(m)
+}
+func (m *ImportBucketRequest_Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportBucketRequest_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportBucketRequest_Header proto.InternalMessageInfo
+
+func (m *ImportBucketRequest_Header) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ImportBucketRequest_Header) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *ImportBucketRequest_Header) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImportBucketRequest_Header) GetPrivate() bool {
+	if m != nil {
+		return m.Private
+	}
+	return false
+}
+
+func (m *ImportBucketRequest_Header) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ImportBucketReply struct {
+	Root                 *Root    `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Blocks               int64    `protobuf:"varint,2,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportBucketReply) Reset()         { *m = ImportBucketReply{} }
+func (m *ImportBucketReply) String() string { return proto.CompactTextString(m) }
+func (*ImportBucketReply) ProtoMessage()    {}
+func (*ImportBucketReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{44}
+}
+
+func (m *ImportBucketReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportBucketReply.Unmarshal(m, b)
+}
+func (m *ImportBucketReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportBucketReply.Marshal(b, m, deterministic)
+}
+func (m *ImportBucketReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportBucketReply.Merge(m, src)
+}
+func (m *ImportBucketReply) XXX_Size() int {
+	return xxx_messageInfo_ImportBucketReply.Size(m)
+}
+func (m *ImportBucketReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportBucketReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportBucketReply proto.InternalMessageInfo
+
+func (m *ImportBucketReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *ImportBucketReply) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+type BucketImport struct {
+	Id                   string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Source               string              `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Path                 string              `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Status               BucketImport_Status `protobuf:"varint,4,opt,name=status,proto3,enum=buckets.pb.BucketImport_Status" json:"status,omitempty"`
+	Objects              int64               `protobuf:"varint,5,opt,name=objects,proto3" json:"objects,omitempty"`
+	Bytes                int64               `protobuf:"varint,6,opt,name=bytes,proto3" json:"bytes,omitempty"`
+	Cursor               string              `protobuf:"bytes,7,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	LastError            string              `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedAt            int64               `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt            int64               `protobuf:"varint,10,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *BucketImport) Reset()         { *m = BucketImport{} }
+func (m *BucketImport) String() string { return proto.CompactTextString(m) }
+func (*BucketImport) ProtoMessage()    {}
+func (*BucketImport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{45}
+}
+
+func (m *BucketImport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BucketImport.Unmarshal(m, b)
+}
+func (m *BucketImport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BucketImport.Marshal(b, m, deterministic)
+}
+func (m *BucketImport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BucketImport.Merge(m, src)
+}
+func (m *BucketImport) XXX_Size() int {
+	return xxx_messageInfo_BucketImport.Size(m)
+}
+func (m *BucketImport) XXX_DiscardUnknown() {
+	xxx_messageInfo_BucketImport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BucketImport proto.InternalMessageInfo
+
+func (m *BucketImport) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *BucketImport) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *BucketImport) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *BucketImport) GetStatus() BucketImport_Status {
+	if m != nil {
+		return m.Status
+	}
+	return BucketImport_Queued
+}
+
+func (m *BucketImport) GetObjects() int64 {
+	if m != nil {
+		return m.Objects
+	}
+	return 0
+}
+
+func (m *BucketImport) GetBytes() int64 {
+	if m != nil {
+		return m.Bytes
+	}
+	return 0
+}
+
+func (m *BucketImport) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *BucketImport) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *BucketImport) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *BucketImport) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type StartS3ImportRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Endpoint             string   `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Region               string   `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	Bucket               string   `protobuf:"bytes,5,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Prefix               string   `protobuf:"bytes,6,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	AccessKey            string   `protobuf:"bytes,7,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	SecretKey            string   `protobuf:"bytes,8,opt,name=secretKey,proto3" json:"secretKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartS3ImportRequest) Reset()         { *m = StartS3ImportRequest{} }
+func (m *StartS3ImportRequest) String() string { return proto.CompactTextString(m) }
+func (*StartS3ImportRequest) ProtoMessage()    {}
+func (*StartS3ImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{46}
+}
+
+func (m *StartS3ImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartS3ImportRequest.Unmarshal(m, b)
+}
+func (m *StartS3ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartS3ImportRequest.Marshal(b, m, deterministic)
+}
+func (m *StartS3ImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartS3ImportRequest.Merge(m, src)
+}
+func (m *StartS3ImportRequest) XXX_Size() int {
+	return xxx_messageInfo_StartS3ImportRequest.Size(m)
+}
+func (m *StartS3ImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartS3ImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartS3ImportRequest proto.InternalMessageInfo
+
+func (m *StartS3ImportRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *StartS3ImportRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *StartS3ImportRequest) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *StartS3ImportRequest) GetRegion() string {
+	if m != nil {
+		return m.Region
+	}
+	return ""
+}
+
+func (m *StartS3ImportRequest) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
+func (m *StartS3ImportRequest) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *StartS3ImportRequest) GetAccessKey() string {
+	if m != nil {
+		return m.AccessKey
+	}
+	return ""
+}
+
+func (m *StartS3ImportRequest) GetSecretKey() string {
+	if m != nil {
+		return m.SecretKey
+	}
+	return ""
+}
+
+type StartS3ImportReply struct {
+	Import               *BucketImport `protobuf:"bytes,1,opt,name=import,proto3" json:"import,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *StartS3ImportReply) Reset()         { *m = StartS3ImportReply{} }
+func (m *StartS3ImportReply) String() string { return proto.CompactTextString(m) }
+func (*StartS3ImportReply) ProtoMessage()    {}
+func (*StartS3ImportReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{47}
+}
+
+func (m *StartS3ImportReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartS3ImportReply.Unmarshal(m, b)
+}
+func (m *StartS3ImportReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartS3ImportReply.Marshal(b, m, deterministic)
+}
+func (m *StartS3ImportReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartS3ImportReply.Merge(m, src)
+}
+func (m *StartS3ImportReply) XXX_Size() int {
+	return xxx_messageInfo_StartS3ImportReply.Size(m)
+}
+func (m *StartS3ImportReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartS3ImportReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartS3ImportReply proto.InternalMessageInfo
+
+func (m *StartS3ImportReply) GetImport() *BucketImport {
+	if m != nil {
+		return m.Import
+	}
+	return nil
+}
+
+type ListImportsRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListImportsRequest) Reset()         { *m = ListImportsRequest{} }
+func (m *ListImportsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListImportsRequest) ProtoMessage()    {}
+func (*ListImportsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{48}
+}
+
+func (m *ListImportsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListImportsRequest.Unmarshal(m, b)
+}
+func (m *ListImportsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListImportsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListImportsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListImportsRequest.Merge(m, src)
+}
+func (m *ListImportsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListImportsRequest.Size(m)
+}
+func (m *ListImportsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListImportsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListImportsRequest proto.InternalMessageInfo
+
+func (m *ListImportsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListImportsReply struct {
+	Imports              []*BucketImport `protobuf:"bytes,1,rep,name=imports,proto3" json:"imports,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListImportsReply) Reset()         { *m = ListImportsReply{} }
+func (m *ListImportsReply) String() string { return proto.CompactTextString(m) }
+func (*ListImportsReply) ProtoMessage()    {}
+func (*ListImportsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{49}
+}
+
+func (m *ListImportsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListImportsReply.Unmarshal(m, b)
+}
+func (m *ListImportsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListImportsReply.Marshal(b, m, deterministic)
+}
+func (m *ListImportsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListImportsReply.Merge(m, src)
+}
+func (m *ListImportsReply) XXX_Size() int {
+	return xxx_messageInfo_ListImportsReply.Size(m)
+}
+func (m *ListImportsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListImportsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListImportsReply proto.InternalMessageInfo
+
+func (m *ListImportsReply) GetImports() []*BucketImport {
+	if m != nil {
+		return m.Imports
+	}
+	return nil
+}
+
+type CancelImportRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelImportRequest) Reset()         { *m = CancelImportRequest{} }
+func (m *CancelImportRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelImportRequest) ProtoMessage()    {}
+func (*CancelImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{50}
+}
+
+func (m *CancelImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelImportRequest.Unmarshal(m, b)
+}
+func (m *CancelImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelImportRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelImportRequest.Merge(m, src)
+}
+func (m *CancelImportRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelImportRequest.Size(m)
+}
+func (m *CancelImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelImportRequest proto.InternalMessageInfo
+
+func (m *CancelImportRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CancelImportRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type CancelImportReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelImportReply) Reset()         { *m = CancelImportReply{} }
+func (m *CancelImportReply) String() string { return proto.CompactTextString(m) }
+func (*CancelImportReply) ProtoMessage()    {}
+func (*CancelImportReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{51}
+}
+
+func (m *CancelImportReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelImportReply.Unmarshal(m, b)
+}
+func (m *CancelImportReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelImportReply.Marshal(b, m, deterministic)
+}
+func (m *CancelImportReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelImportReply.Merge(m, src)
+}
+func (m *CancelImportReply) XXX_Size() int {
+	return xxx_messageInfo_CancelImportReply.Size(m)
+}
+func (m *CancelImportReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelImportReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelImportReply proto.InternalMessageInfo
+
+type ImportBucketIPNSKeyRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	PrivateKey           []byte   `protobuf:"bytes,2,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportBucketIPNSKeyRequest) Reset()         { *m = ImportBucketIPNSKeyRequest{} }
+func (m *ImportBucketIPNSKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportBucketIPNSKeyRequest) ProtoMessage()    {}
+func (*ImportBucketIPNSKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{52}
+}
+
+func (m *ImportBucketIPNSKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportBucketIPNSKeyRequest.Unmarshal(m, b)
+}
+func (m *ImportBucketIPNSKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportBucketIPNSKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *ImportBucketIPNSKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportBucketIPNSKeyRequest.Merge(m, src)
+}
+func (m *ImportBucketIPNSKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportBucketIPNSKeyRequest.Size(m)
+}
+func (m *ImportBucketIPNSKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportBucketIPNSKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportBucketIPNSKeyRequest proto.InternalMessageInfo
+
+func (m *ImportBucketIPNSKeyRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ImportBucketIPNSKeyRequest) GetPrivateKey() []byte {
+	if m != nil {
+		return m.PrivateKey
+	}
+	return nil
+}
+
+type ImportBucketIPNSKeyReply struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	PrivateKey           []byte   `protobuf:"bytes,2,opt,name=privateKey,proto3" json:"privateKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportBucketIPNSKeyReply) Reset()         { *m = ImportBucketIPNSKeyReply{} }
+func (m *ImportBucketIPNSKeyReply) String() string { return proto.CompactTextString(m) }
+func (*ImportBucketIPNSKeyReply) ProtoMessage()    {}
+func (*ImportBucketIPNSKeyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{53}
+}
+
+func (m *ImportBucketIPNSKeyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportBucketIPNSKeyReply.Unmarshal(m, b)
+}
+func (m *ImportBucketIPNSKeyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportBucketIPNSKeyReply.Marshal(b, m, deterministic)
+}
+func (m *ImportBucketIPNSKeyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportBucketIPNSKeyReply.Merge(m, src)
+}
+func (m *ImportBucketIPNSKeyReply) XXX_Size() int {
+	return xxx_messageInfo_ImportBucketIPNSKeyReply.Size(m)
+}
+func (m *ImportBucketIPNSKeyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportBucketIPNSKeyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportBucketIPNSKeyReply proto.InternalMessageInfo
+
+func (m *ImportBucketIPNSKeyReply) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImportBucketIPNSKeyReply) GetPrivateKey() []byte {
+	if m != nil {
+		return m.PrivateKey
+	}
+	return nil
+}
+
+type SetPathRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Cid                  string   `protobuf:"bytes,3,opt,name=cid,proto3" json:"cid,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetPathRequest) Reset()         { *m = SetPathRequest{} }
+func (m *SetPathRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPathRequest) ProtoMessage()    {}
+func (*SetPathRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{54}
+}
+
+func (m *SetPathRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPathRequest.Unmarshal(m, b)
+}
+func (m *SetPathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPathRequest.Marshal(b, m, deterministic)
+}
+func (m *SetPathRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPathRequest.Merge(m, src)
+}
+func (m *SetPathRequest) XXX_Size() int {
+	return xxx_messageInfo_SetPathRequest.Size(m)
+}
+func (m *SetPathRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPathRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPathRequest proto.InternalMessageInfo
+
+func (m *SetPathRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetPathRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SetPathRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *SetPathRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type SetPathReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetPathReply) Reset()         { *m = SetPathReply{} }
+func (m *SetPathReply) String() string { return proto.CompactTextString(m) }
+func (*SetPathReply) ProtoMessage()    {}
+func (*SetPathReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{55}
+}
+
+func (m *SetPathReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPathReply.Unmarshal(m, b)
+}
+func (m *SetPathReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPathReply.Marshal(b, m, deterministic)
+}
+func (m *SetPathReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPathReply.Merge(m, src)
+}
+func (m *SetPathReply) XXX_Size() int {
+	return xxx_messageInfo_SetPathReply.Size(m)
+}
+func (m *SetPathReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPathReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPathReply proto.InternalMessageInfo
+
+type RemoveRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveRequest) Reset()         { *m = RemoveRequest{} }
+func (m *RemoveRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveRequest) ProtoMessage()    {}
+func (*RemoveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{56}
+}
+
+func (m *RemoveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveRequest.Unmarshal(m, b)
+}
+func (m *RemoveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveRequest.Merge(m, src)
+}
+func (m *RemoveRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveRequest.Size(m)
+}
+func (m *RemoveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveRequest proto.InternalMessageInfo
+
+func (m *RemoveRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type RemoveReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveReply) Reset()         { *m = RemoveReply{} }
+func (m *RemoveReply) String() string { return proto.CompactTextString(m) }
+func (*RemoveReply) ProtoMessage()    {}
+func (*RemoveReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{57}
+}
+
+func (m *RemoveReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveReply.Unmarshal(m, b)
+}
+func (m *RemoveReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveReply.Marshal(b, m, deterministic)
+}
+func (m *RemoveReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveReply.Merge(m, src)
+}
+func (m *RemoveReply) XXX_Size() int {
+	return xxx_messageInfo_RemoveReply.Size(m)
+}
+func (m *RemoveReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveReply proto.InternalMessageInfo
+
+type RemovePathRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Root                 string   `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePathRequest) Reset()         { *m = RemovePathRequest{} }
+func (m *RemovePathRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePathRequest) ProtoMessage()    {}
+func (*RemovePathRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{58}
+}
+
+func (m *RemovePathRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePathRequest.Unmarshal(m, b)
+}
+func (m *RemovePathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePathRequest.Marshal(b, m, deterministic)
+}
+func (m *RemovePathRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePathRequest.Merge(m, src)
+}
+func (m *RemovePathRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePathRequest.Size(m)
+}
+func (m *RemovePathRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePathRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePathRequest proto.InternalMessageInfo
+
+func (m *RemovePathRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RemovePathRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *RemovePathRequest) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *RemovePathRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type RemovePathReply struct {
+	Root                 *Root    `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePathReply) Reset()         { *m = RemovePathReply{} }
+func (m *RemovePathReply) String() string { return proto.CompactTextString(m) }
+func (*RemovePathReply) ProtoMessage()    {}
+func (*RemovePathReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{59}
+}
+
+func (m *RemovePathReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePathReply.Unmarshal(m, b)
+}
+func (m *RemovePathReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePathReply.Marshal(b, m, deterministic)
+}
+func (m *RemovePathReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePathReply.Merge(m, src)
+}
+func (m *RemovePathReply) XXX_Size() int {
+	return xxx_messageInfo_RemovePathReply.Size(m)
+}
+func (m *RemovePathReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePathReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePathReply proto.InternalMessageInfo
+
+func (m *RemovePathReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type MovePathRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	FromPath             string   `protobuf:"bytes,2,opt,name=fromPath,proto3" json:"fromPath,omitempty"`
+	ToPath               string   `protobuf:"bytes,3,opt,name=toPath,proto3" json:"toPath,omitempty"`
+	Root                 string   `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MovePathRequest) Reset()         { *m = MovePathRequest{} }
+func (m *MovePathRequest) String() string { return proto.CompactTextString(m) }
+func (*MovePathRequest) ProtoMessage()    {}
+func (*MovePathRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{60}
+}
+
+func (m *MovePathRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MovePathRequest.Unmarshal(m, b)
+}
+func (m *MovePathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MovePathRequest.Marshal(b, m, deterministic)
+}
+func (m *MovePathRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MovePathRequest.Merge(m, src)
+}
+func (m *MovePathRequest) XXX_Size() int {
+	return xxx_messageInfo_MovePathRequest.Size(m)
+}
+func (m *MovePathRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MovePathRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MovePathRequest proto.InternalMessageInfo
+
+func (m *MovePathRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *MovePathRequest) GetFromPath() string {
+	if m != nil {
+		return m.FromPath
+	}
+	return ""
+}
+
+func (m *MovePathRequest) GetToPath() string {
+	if m != nil {
+		return m.ToPath
+	}
+	return ""
+}
+
+func (m *MovePathRequest) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *MovePathRequest) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type MovePathReply struct {
+	Root                 *Root    `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MovePathReply) Reset()         { *m = MovePathReply{} }
+func (m *MovePathReply) String() string { return proto.CompactTextString(m) }
+func (*MovePathReply) ProtoMessage()    {}
+func (*MovePathReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{61}
+}
+
+func (m *MovePathReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MovePathReply.Unmarshal(m, b)
+}
+func (m *MovePathReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MovePathReply.Marshal(b, m, deterministic)
+}
+func (m *MovePathReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MovePathReply.Merge(m, src)
+}
+func (m *MovePathReply) XXX_Size() int {
+	return xxx_messageInfo_MovePathReply.Size(m)
+}
+func (m *MovePathReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MovePathReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MovePathReply proto.InternalMessageInfo
+
+func (m *MovePathReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type Quota struct {
+	MaxSize              int64    `protobuf:"varint,1,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Remaining            int64    `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Quota) Reset()         { *m = Quota{} }
+func (m *Quota) String() string { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()    {}
+func (*Quota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{62}
+}
+
+func (m *Quota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Quota.Unmarshal(m, b)
+}
+func (m *Quota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Quota.Marshal(b, m, deterministic)
+}
+func (m *Quota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quota.Merge(m, src)
+}
+func (m *Quota) XXX_Size() int {
+	return xxx_messageInfo_Quota.Size(m)
+}
+func (m *Quota) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quota proto.InternalMessageInfo
+
+func (m *Quota) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *Quota) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Quota) GetRemaining() int64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+type QuotaExceeded struct {
+	Size                 int64    `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+	MaxSize              int64    `protobuf:"varint,2,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	Remaining            int64    `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuotaExceeded) Reset()         { *m = QuotaExceeded{} }
+func (m *QuotaExceeded) String() string { return proto.CompactTextString(m) }
+func (*QuotaExceeded) ProtoMessage()    {}
+func (*QuotaExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{63}
+}
+
+func (m *QuotaExceeded) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaExceeded.Unmarshal(m, b)
+}
+func (m *QuotaExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaExceeded.Marshal(b, m, deterministic)
+}
+func (m *QuotaExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaExceeded.Merge(m, src)
+}
+func (m *QuotaExceeded) XXX_Size() int {
+	return xxx_messageInfo_QuotaExceeded.Size(m)
+}
+func (m *QuotaExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaExceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaExceeded proto.InternalMessageInfo
+
+func (m *QuotaExceeded) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *QuotaExceeded) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+func (m *QuotaExceeded) GetRemaining() int64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+type SetQuotaRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	MaxSize              int64    `protobuf:"varint,2,opt,name=maxSize,proto3" json:"maxSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetQuotaRequest) Reset()         { *m = SetQuotaRequest{} }
+func (m *SetQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SetQuotaRequest) ProtoMessage()    {}
+func (*SetQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{64}
+}
+
+func (m *SetQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetQuotaRequest.Unmarshal(m, b)
+}
+func (m *SetQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *SetQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetQuotaRequest.Merge(m, src)
+}
+func (m *SetQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_SetQuotaRequest.Size(m)
+}
+func (m *SetQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetQuotaRequest proto.InternalMessageInfo
+
+func (m *SetQuotaRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetQuotaRequest) GetMaxSize() int64 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+type SetQuotaReply struct {
+	Quota                *Quota   `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	Root                 *Root    `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetQuotaReply) Reset()         { *m = SetQuotaReply{} }
+func (m *SetQuotaReply) String() string { return proto.CompactTextString(m) }
+func (*SetQuotaReply) ProtoMessage()    {}
+func (*SetQuotaReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{65}
+}
+
+func (m *SetQuotaReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetQuotaReply.Unmarshal(m, b)
+}
+func (m *SetQuotaReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetQuotaReply.Marshal(b, m, deterministic)
+}
+func (m *SetQuotaReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetQuotaReply.Merge(m, src)
+}
+func (m *SetQuotaReply) XXX_Size() int {
+	return xxx_messageInfo_SetQuotaReply.Size(m)
+}
+func (m *SetQuotaReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetQuotaReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetQuotaReply proto.InternalMessageInfo
+
+func (m *SetQuotaReply) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *SetQuotaReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type GetQuotaRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetQuotaRequest) Reset()         { *m = GetQuotaRequest{} }
+func (m *GetQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQuotaRequest) ProtoMessage()    {}
+func (*GetQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{66}
+}
+
+func (m *GetQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuotaRequest.Unmarshal(m, b)
+}
+func (m *GetQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuotaRequest.Merge(m, src)
+}
+func (m *GetQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQuotaRequest.Size(m)
+}
+func (m *GetQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuotaRequest proto.InternalMessageInfo
+
+func (m *GetQuotaRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type GetQuotaReply struct {
+	Quota                *Quota   `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetQuotaReply) Reset()         { *m = GetQuotaReply{} }
+func (m *GetQuotaReply) String() string { return proto.CompactTextString(m) }
+func (*GetQuotaReply) ProtoMessage()    {}
+func (*GetQuotaReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{67}
+}
+
+func (m *GetQuotaReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuotaReply.Unmarshal(m, b)
+}
+func (m *GetQuotaReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuotaReply.Marshal(b, m, deterministic)
+}
+func (m *GetQuotaReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuotaReply.Merge(m, src)
+}
+func (m *GetQuotaReply) XXX_Size() int {
+	return xxx_messageInfo_GetQuotaReply.Size(m)
+}
+func (m *GetQuotaReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuotaReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuotaReply proto.InternalMessageInfo
+
+func (m *GetQuotaReply) GetQuota() *Quota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+type LifecycleRule struct {
+	Id                   string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action               string                `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Prefix               string                `protobuf:"bytes,3,opt,name=prefix,proto3" json:"prefix,omitempty"`
+	Days                 int32                 `protobuf:"varint,4,opt,name=days,proto3" json:"days,omitempty"`
+	Status               *LifecycleRule_Status `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *LifecycleRule) Reset()         { *m = LifecycleRule{} }
+func (m *LifecycleRule) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule) ProtoMessage()    {}
+func (*LifecycleRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{68}
+}
+
+func (m *LifecycleRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LifecycleRule.Unmarshal(m, b)
+}
+func (m *LifecycleRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LifecycleRule.Marshal(b, m, deterministic)
+}
+func (m *LifecycleRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule.Merge(m, src)
+}
+func (m *LifecycleRule) XXX_Size() int {
+	return xxx_messageInfo_LifecycleRule.Size(m)
+}
+func (m *LifecycleRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleRule proto.InternalMessageInfo
+
+func (m *LifecycleRule) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *LifecycleRule) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *LifecycleRule) GetPrefix() string {
+	if m != nil {
+		return m.Prefix
+	}
+	return ""
+}
+
+func (m *LifecycleRule) GetDays() int32 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+func (m *LifecycleRule) GetStatus() *LifecycleRule_Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type LifecycleRule_Status struct {
+	LastRunAt            int64    `protobuf:"varint,1,opt,name=lastRunAt,proto3" json:"lastRunAt,omitempty"`
+	Affected             int64    `protobuf:"varint,2,opt,name=affected,proto3" json:"affected,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LifecycleRule_Status) Reset()         { *m = LifecycleRule_Status{} }
+func (m *LifecycleRule_Status) String() string { return proto.CompactTextString(m) }
+func (*LifecycleRule_Status) ProtoMessage()    {}
+func (*LifecycleRule_Status) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{68, 0}
+}
+
+func (m *LifecycleRule_Status) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LifecycleRule_Status.Unmarshal(m, b)
+}
+func (m *LifecycleRule_Status) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LifecycleRule_Status.Marshal(b, m, deterministic)
+}
+func (m *LifecycleRule_Status) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LifecycleRule_Status.Merge(m, src)
+}
+func (m *LifecycleRule_Status) XXX_Size() int {
+	return xxx_messageInfo_LifecycleRule_Status.Size(m)
+}
+func (m *LifecycleRule_Status) XXX_DiscardUnknown() {
+	xxx_messageInfo_LifecycleRule_Status.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LifecycleRule_Status proto.InternalMessageInfo
+
+func (m *LifecycleRule_Status) GetLastRunAt() int64 {
+	if m != nil {
+		return m.LastRunAt
+	}
+	return 0
+}
+
+func (m *LifecycleRule_Status) GetAffected() int64 {
+	if m != nil {
+		return m.Affected
+	}
+	return 0
+}
+
+func (m *LifecycleRule_Status) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type Lifecycle struct {
+	Rules                []*LifecycleRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	NextRunAt            int64            `protobuf:"varint,2,opt,name=nextRunAt,proto3" json:"nextRunAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *Lifecycle) Reset()         { *m = Lifecycle{} }
+func (m *Lifecycle) String() string { return proto.CompactTextString(m) }
+func (*Lifecycle) ProtoMessage()    {}
+func (*Lifecycle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{69}
+}
+
+func (m *Lifecycle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Lifecycle.Unmarshal(m, b)
+}
+func (m *Lifecycle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Lifecycle.Marshal(b, m, deterministic)
+}
+func (m *Lifecycle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Lifecycle.Merge(m, src)
+}
+func (m *Lifecycle) XXX_Size() int {
+	return xxx_messageInfo_Lifecycle.Size(m)
+}
+func (m *Lifecycle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Lifecycle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Lifecycle proto.InternalMessageInfo
+
+func (m *Lifecycle) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+func (m *Lifecycle) GetNextRunAt() int64 {
+	if m != nil {
+		return m.NextRunAt
+	}
+	return 0
+}
+
+type SetLifecycleRequest struct {
+	Key                  string           `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Rules                []*LifecycleRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetLifecycleRequest) Reset()         { *m = SetLifecycleRequest{} }
+func (m *SetLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLifecycleRequest) ProtoMessage()    {}
+func (*SetLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{70}
+}
+
+func (m *SetLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLifecycleRequest.Unmarshal(m, b)
+}
+func (m *SetLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *SetLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLifecycleRequest.Merge(m, src)
+}
+func (m *SetLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLifecycleRequest.Size(m)
+}
+func (m *SetLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLifecycleRequest proto.InternalMessageInfo
+
+func (m *SetLifecycleRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetLifecycleRequest) GetRules() []*LifecycleRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+type SetLifecycleReply struct {
+	Lifecycle            *Lifecycle `protobuf:"bytes,1,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetLifecycleReply) Reset()         { *m = SetLifecycleReply{} }
+func (m *SetLifecycleReply) String() string { return proto.CompactTextString(m) }
+func (*SetLifecycleReply) ProtoMessage()    {}
+func (*SetLifecycleReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{71}
+}
+
+func (m *SetLifecycleReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLifecycleReply.Unmarshal(m, b)
+}
+func (m *SetLifecycleReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLifecycleReply.Marshal(b, m, deterministic)
+}
+func (m *SetLifecycleReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLifecycleReply.Merge(m, src)
+}
+func (m *SetLifecycleReply) XXX_Size() int {
+	return xxx_messageInfo_SetLifecycleReply.Size(m)
+}
+func (m *SetLifecycleReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLifecycleReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLifecycleReply proto.InternalMessageInfo
+
+func (m *SetLifecycleReply) GetLifecycle() *Lifecycle {
+	if m != nil {
+		return m.Lifecycle
+	}
+	return nil
+}
+
+type GetLifecycleRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLifecycleRequest) Reset()         { *m = GetLifecycleRequest{} }
+func (m *GetLifecycleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLifecycleRequest) ProtoMessage()    {}
+func (*GetLifecycleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{72}
+}
+
+func (m *GetLifecycleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLifecycleRequest.Unmarshal(m, b)
+}
+func (m *GetLifecycleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLifecycleRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLifecycleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLifecycleRequest.Merge(m, src)
+}
+func (m *GetLifecycleRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLifecycleRequest.Size(m)
+}
+func (m *GetLifecycleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLifecycleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLifecycleRequest proto.InternalMessageInfo
+
+func (m *GetLifecycleRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type GetLifecycleReply struct {
+	Lifecycle            *Lifecycle `protobuf:"bytes,1,opt,name=lifecycle,proto3" json:"lifecycle,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetLifecycleReply) Reset()         { *m = GetLifecycleReply{} }
+func (m *GetLifecycleReply) String() string { return proto.CompactTextString(m) }
+func (*GetLifecycleReply) ProtoMessage()    {}
+func (*GetLifecycleReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{73}
+}
+
+func (m *GetLifecycleReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLifecycleReply.Unmarshal(m, b)
+}
+func (m *GetLifecycleReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLifecycleReply.Marshal(b, m, deterministic)
+}
+func (m *GetLifecycleReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLifecycleReply.Merge(m, src)
+}
+func (m *GetLifecycleReply) XXX_Size() int {
+	return xxx_messageInfo_GetLifecycleReply.Size(m)
+}
+func (m *GetLifecycleReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLifecycleReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLifecycleReply proto.InternalMessageInfo
+
+func (m *GetLifecycleReply) GetLifecycle() *Lifecycle {
+	if m != nil {
+		return m.Lifecycle
+	}
+	return nil
+}
+
+type Website struct {
+	IndexDocument        string              `protobuf:"bytes,1,opt,name=indexDocument,proto3" json:"indexDocument,omitempty"`
+	ErrorDocument        string              `protobuf:"bytes,2,opt,name=errorDocument,proto3" json:"errorDocument,omitempty"`
+	Redirects            []*Website_Redirect `protobuf:"bytes,3,rep,name=redirects,proto3" json:"redirects,omitempty"`
+	Headers              []*Website_Header   `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *Website) Reset()         { *m = Website{} }
+func (m *Website) String() string { return proto.CompactTextString(m) }
+func (*Website) ProtoMessage()    {}
+func (*Website) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{74}
+}
+
+func (m *Website) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Website.Unmarshal(m, b)
+}
+func (m *Website) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Website.Marshal(b, m, deterministic)
+}
+func (m *Website) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Website.Merge(m, src)
+}
+func (m *Website) XXX_Size() int {
+	return xxx_messageInfo_Website.Size(m)
+}
+func (m *Website) XXX_DiscardUnknown() {
+	xxx_messageInfo_Website.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Website proto.InternalMessageInfo
+
+func (m *Website) GetIndexDocument() string {
+	if m != nil {
+		return m.IndexDocument
+	}
+	return ""
+}
+
+func (m *Website) GetErrorDocument() string {
+	if m != nil {
+		return m.ErrorDocument
+	}
+	return ""
+}
+
+func (m *Website) GetRedirects() []*Website_Redirect {
+	if m != nil {
+		return m.Redirects
+	}
+	return nil
+}
+
+func (m *Website) GetHeaders() []*Website_Header {
+	if m != nil {
+		return m.Headers
+	}
+	return nil
+}
+
+type Website_Redirect struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Status               int32    `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	Force                bool     `protobuf:"varint,4,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Website_Redirect) Reset()         { *m = Website_Redirect{} }
+func (m *Website_Redirect) String() string { return proto.CompactTextString(m) }
+func (*Website_Redirect) ProtoMessage()    {}
+func (*Website_Redirect) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{74, 0}
+}
+
+func (m *Website_Redirect) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Website_Redirect.Unmarshal(m, b)
+}
+func (m *Website_Redirect) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Website_Redirect.Marshal(b, m, deterministic)
+}
+func (m *Website_Redirect) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Website_Redirect.Merge(m, src)
+}
+func (m *Website_Redirect) XXX_Size() int {
+	return xxx_messageInfo_Website_Redirect.Size(m)
+}
+func (m *Website_Redirect) XXX_DiscardUnknown() {
+	xxx_messageInfo_Website_Redirect.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Website_Redirect proto.InternalMessageInfo
+
+func (m *Website_Redirect) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *Website_Redirect) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *Website_Redirect) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Website_Redirect) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type Website_Header struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Website_Header) Reset()         { *m = Website_Header{} }
+func (m *Website_Header) String() string { return proto.CompactTextString(m) }
+func (*Website_Header) ProtoMessage()    {}
+func (*Website_Header) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{74, 1}
+}
+
+func (m *Website_Header) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Website_Header.Unmarshal(m, b)
+}
+func (m *Website_Header) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Website_Header.Marshal(b, m, deterministic)
+}
+func (m *Website_Header) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Website_Header.Merge(m, src)
+}
+func (m *Website_Header) XXX_Size() int {
+	return xxx_messageInfo_Website_Header.Size(m)
+}
+func (m *Website_Header) XXX_DiscardUnknown() {
+	xxx_messageInfo_Website_Header.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Website_Header proto.InternalMessageInfo
+
+func (m *Website_Header) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *Website_Header) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Website_Header) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type SetWebsiteRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Website              *Website `protobuf:"bytes,2,opt,name=website,proto3" json:"website,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetWebsiteRequest) Reset()         { *m = SetWebsiteRequest{} }
+func (m *SetWebsiteRequest) String() string { return proto.CompactTextString(m) }
+func (*SetWebsiteRequest) ProtoMessage()    {}
+func (*SetWebsiteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{75}
+}
+
+func (m *SetWebsiteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetWebsiteRequest.Unmarshal(m, b)
+}
+func (m *SetWebsiteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetWebsiteRequest.Marshal(b, m, deterministic)
+}
+func (m *SetWebsiteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetWebsiteRequest.Merge(m, src)
+}
+func (m *SetWebsiteRequest) XXX_Size() int {
+	return xxx_messageInfo_SetWebsiteRequest.Size(m)
+}
+func (m *SetWebsiteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetWebsiteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetWebsiteRequest proto.InternalMessageInfo
+
+func (m *SetWebsiteRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetWebsiteRequest) GetWebsite() *Website {
+	if m != nil {
+		return m.Website
+	}
+	return nil
+}
+
+type SetWebsiteReply struct {
+	Website              *Website `protobuf:"bytes,1,opt,name=website,proto3" json:"website,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetWebsiteReply) Reset()         { *m = SetWebsiteReply{} }
+func (m *SetWebsiteReply) String() string { return proto.CompactTextString(m) }
+func (*SetWebsiteReply) ProtoMessage()    {}
+func (*SetWebsiteReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{76}
+}
+
+func (m *SetWebsiteReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetWebsiteReply.Unmarshal(m, b)
+}
+func (m *SetWebsiteReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetWebsiteReply.Marshal(b, m, deterministic)
+}
+func (m *SetWebsiteReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetWebsiteReply.Merge(m, src)
+}
+func (m *SetWebsiteReply) XXX_Size() int {
+	return xxx_messageInfo_SetWebsiteReply.Size(m)
+}
+func (m *SetWebsiteReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetWebsiteReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetWebsiteReply proto.InternalMessageInfo
+
+func (m *SetWebsiteReply) GetWebsite() *Website {
+	if m != nil {
+		return m.Website
+	}
+	return nil
+}
+
+type GetWebsiteRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetWebsiteRequest) Reset()         { *m = GetWebsiteRequest{} }
+func (m *GetWebsiteRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWebsiteRequest) ProtoMessage()    {}
+func (*GetWebsiteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{77}
+}
+
+func (m *GetWebsiteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWebsiteRequest.Unmarshal(m, b)
+}
+func (m *GetWebsiteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWebsiteRequest.Marshal(b, m, deterministic)
+}
+func (m *GetWebsiteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWebsiteRequest.Merge(m, src)
+}
+func (m *GetWebsiteRequest) XXX_Size() int {
+	return xxx_messageInfo_GetWebsiteRequest.Size(m)
+}
+func (m *GetWebsiteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWebsiteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWebsiteRequest proto.InternalMessageInfo
+
+func (m *GetWebsiteRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type GetWebsiteReply struct {
+	Website              *Website `protobuf:"bytes,1,opt,name=website,proto3" json:"website,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetWebsiteReply) Reset()         { *m = GetWebsiteReply{} }
+func (m *GetWebsiteReply) String() string { return proto.CompactTextString(m) }
+func (*GetWebsiteReply) ProtoMessage()    {}
+func (*GetWebsiteReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{78}
+}
+
+func (m *GetWebsiteReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWebsiteReply.Unmarshal(m, b)
+}
+func (m *GetWebsiteReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWebsiteReply.Marshal(b, m, deterministic)
+}
+func (m *GetWebsiteReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWebsiteReply.Merge(m, src)
+}
+func (m *GetWebsiteReply) XXX_Size() int {
+	return xxx_messageInfo_GetWebsiteReply.Size(m)
+}
+func (m *GetWebsiteReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWebsiteReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWebsiteReply proto.InternalMessageInfo
+
+func (m *GetWebsiteReply) GetWebsite() *Website {
+	if m != nil {
+		return m.Website
+	}
+	return nil
+}
+
+type SetConflictStrategyRequest struct {
+	Key                  string                              `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Strategy             SetConflictStrategyRequest_Strategy `protobuf:"varint,2,opt,name=strategy,proto3,enum=buckets.pb.SetConflictStrategyRequest_Strategy" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *SetConflictStrategyRequest) Reset()         { *m = SetConflictStrategyRequest{} }
+func (m *SetConflictStrategyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetConflictStrategyRequest) ProtoMessage()    {}
+func (*SetConflictStrategyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{79}
+}
+
+func (m *SetConflictStrategyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetConflictStrategyRequest.Unmarshal(m, b)
+}
+func (m *SetConflictStrategyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetConflictStrategyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetConflictStrategyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetConflictStrategyRequest.Merge(m, src)
+}
+func (m *SetConflictStrategyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetConflictStrategyRequest.Size(m)
+}
+func (m *SetConflictStrategyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetConflictStrategyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetConflictStrategyRequest proto.InternalMessageInfo
+
+func (m *SetConflictStrategyRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetConflictStrategyRequest) GetStrategy() SetConflictStrategyRequest_Strategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return SetConflictStrategyRequest_LastWriterWins
+}
+
+type SetConflictStrategyReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetConflictStrategyReply) Reset()         { *m = SetConflictStrategyReply{} }
+func (m *SetConflictStrategyReply) String() string { return proto.CompactTextString(m) }
+func (*SetConflictStrategyReply) ProtoMessage()    {}
+func (*SetConflictStrategyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{80}
+}
+
+func (m *SetConflictStrategyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetConflictStrategyReply.Unmarshal(m, b)
+}
+func (m *SetConflictStrategyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetConflictStrategyReply.Marshal(b, m, deterministic)
+}
+func (m *SetConflictStrategyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetConflictStrategyReply.Merge(m, src)
+}
+func (m *SetConflictStrategyReply) XXX_Size() int {
+	return xxx_messageInfo_SetConflictStrategyReply.Size(m)
+}
+func (m *SetConflictStrategyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetConflictStrategyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetConflictStrategyReply proto.InternalMessageInfo
+
+type GetConflictStrategyRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetConflictStrategyRequest) Reset()         { *m = GetConflictStrategyRequest{} }
+func (m *GetConflictStrategyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetConflictStrategyRequest) ProtoMessage()    {}
+func (*GetConflictStrategyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{81}
+}
+
+func (m *GetConflictStrategyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetConflictStrategyRequest.Unmarshal(m, b)
+}
+func (m *GetConflictStrategyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetConflictStrategyRequest.Marshal(b, m, deterministic)
+}
+func (m *GetConflictStrategyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConflictStrategyRequest.Merge(m, src)
+}
+func (m *GetConflictStrategyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetConflictStrategyRequest.Size(m)
+}
+func (m *GetConflictStrategyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConflictStrategyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetConflictStrategyRequest proto.InternalMessageInfo
+
+func (m *GetConflictStrategyRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type GetConflictStrategyReply struct {
+	Strategy             SetConflictStrategyRequest_Strategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=buckets.pb.SetConflictStrategyRequest_Strategy" json:"strategy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
+}
+
+func (m *GetConflictStrategyReply) Reset()         { *m = GetConflictStrategyReply{} }
+func (m *GetConflictStrategyReply) String() string { return proto.CompactTextString(m) }
+func (*GetConflictStrategyReply) ProtoMessage()    {}
+func (*GetConflictStrategyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{82}
+}
+
+func (m *GetConflictStrategyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetConflictStrategyReply.Unmarshal(m, b)
+}
+func (m *GetConflictStrategyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetConflictStrategyReply.Marshal(b, m, deterministic)
+}
+func (m *GetConflictStrategyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetConflictStrategyReply.Merge(m, src)
+}
+func (m *GetConflictStrategyReply) XXX_Size() int {
+	return xxx_messageInfo_GetConflictStrategyReply.Size(m)
+}
+func (m *GetConflictStrategyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetConflictStrategyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetConflictStrategyReply proto.InternalMessageInfo
+
+func (m *GetConflictStrategyReply) GetStrategy() SetConflictStrategyRequest_Strategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return SetConflictStrategyRequest_LastWriterWins
+}
+
+type ReplicationTarget struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Thread               string   `protobuf:"bytes,3,opt,name=thread,proto3" json:"thread,omitempty"`
+	Key                  string   `protobuf:"bytes,4,opt,name=key,proto3" json:"key,omitempty"`
+	Pending              bool     `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	LastRoot             string   `protobuf:"bytes,6,opt,name=lastRoot,proto3" json:"lastRoot,omitempty"`
+	LastSyncedAt         int64    `protobuf:"varint,7,opt,name=lastSyncedAt,proto3" json:"lastSyncedAt,omitempty"`
+	LastError            string   `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Insecure             bool     `protobuf:"varint,10,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplicationTarget) Reset()         { *m = ReplicationTarget{} }
+func (m *ReplicationTarget) String() string { return proto.CompactTextString(m) }
+func (*ReplicationTarget) ProtoMessage()    {}
+func (*ReplicationTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{83}
+}
+
+func (m *ReplicationTarget) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplicationTarget.Unmarshal(m, b)
+}
+func (m *ReplicationTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplicationTarget.Marshal(b, m, deterministic)
+}
+func (m *ReplicationTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplicationTarget.Merge(m, src)
+}
+func (m *ReplicationTarget) XXX_Size() int {
+	return xxx_messageInfo_ReplicationTarget.Size(m)
+}
+func (m *ReplicationTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplicationTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplicationTarget proto.InternalMessageInfo
+
+func (m *ReplicationTarget) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReplicationTarget) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ReplicationTarget) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *ReplicationTarget) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ReplicationTarget) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *ReplicationTarget) GetLastRoot() string {
+	if m != nil {
+		return m.LastRoot
+	}
+	return ""
+}
+
+func (m *ReplicationTarget) GetLastSyncedAt() int64 {
+	if m != nil {
+		return m.LastSyncedAt
+	}
+	return 0
+}
+
+func (m *ReplicationTarget) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *ReplicationTarget) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ReplicationTarget) GetInsecure() bool {
+	if m != nil {
+		return m.Insecure
+	}
+	return false
+}
+
+type AddReplicationTargetRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Address              string   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	ApiKey               string   `protobuf:"bytes,3,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
+	ApiSecret            string   `protobuf:"bytes,4,opt,name=apiSecret,proto3" json:"apiSecret,omitempty"`
+	Thread               string   `protobuf:"bytes,5,opt,name=thread,proto3" json:"thread,omitempty"`
+	Token                string   `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	RemoteKey            string   `protobuf:"bytes,7,opt,name=remoteKey,proto3" json:"remoteKey,omitempty"`
+	Insecure             bool     `protobuf:"varint,8,opt,name=insecure,proto3" json:"insecure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddReplicationTargetRequest) Reset()         { *m = AddReplicationTargetRequest{} }
+func (m *AddReplicationTargetRequest) String() string { return proto.CompactTextString(m) }
+func (*AddReplicationTargetRequest) ProtoMessage()    {}
+func (*AddReplicationTargetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{84}
+}
+
+func (m *AddReplicationTargetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddReplicationTargetRequest.Unmarshal(m, b)
+}
+func (m *AddReplicationTargetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddReplicationTargetRequest.Marshal(b, m, deterministic)
+}
+func (m *AddReplicationTargetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddReplicationTargetRequest.Merge(m, src)
+}
+func (m *AddReplicationTargetRequest) XXX_Size() int {
+	return xxx_messageInfo_AddReplicationTargetRequest.Size(m)
+}
+func (m *AddReplicationTargetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddReplicationTargetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddReplicationTargetRequest proto.InternalMessageInfo
+
+func (m *AddReplicationTargetRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AddReplicationTargetRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AddReplicationTargetRequest) GetApiKey() string {
+	if m != nil {
+		return m.ApiKey
+	}
+	return ""
+}
+
+func (m *AddReplicationTargetRequest) GetApiSecret() string {
+	if m != nil {
+		return m.ApiSecret
+	}
+	return ""
+}
+
+func (m *AddReplicationTargetRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *AddReplicationTargetRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *AddReplicationTargetRequest) GetRemoteKey() string {
+	if m != nil {
+		return m.RemoteKey
+	}
+	return ""
+}
+
+func (m *AddReplicationTargetRequest) GetInsecure() bool {
+	if m != nil {
+		return m.Insecure
+	}
+	return false
+}
+
+type AddReplicationTargetReply struct {
+	Target               *ReplicationTarget `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AddReplicationTargetReply) Reset()         { *m = AddReplicationTargetReply{} }
+func (m *AddReplicationTargetReply) String() string { return proto.CompactTextString(m) }
+func (*AddReplicationTargetReply) ProtoMessage()    {}
+func (*AddReplicationTargetReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{85}
+}
+
+func (m *AddReplicationTargetReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddReplicationTargetReply.Unmarshal(m, b)
+}
+func (m *AddReplicationTargetReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddReplicationTargetReply.Marshal(b, m, deterministic)
+}
+func (m *AddReplicationTargetReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddReplicationTargetReply.Merge(m, src)
+}
+func (m *AddReplicationTargetReply) XXX_Size() int {
+	return xxx_messageInfo_AddReplicationTargetReply.Size(m)
+}
+func (m *AddReplicationTargetReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddReplicationTargetReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddReplicationTargetReply proto.InternalMessageInfo
+
+func (m *AddReplicationTargetReply) GetTarget() *ReplicationTarget {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+type ListReplicationTargetsRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListReplicationTargetsRequest) Reset()         { *m = ListReplicationTargetsRequest{} }
+func (m *ListReplicationTargetsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListReplicationTargetsRequest) ProtoMessage()    {}
+func (*ListReplicationTargetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{86}
+}
+
+func (m *ListReplicationTargetsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReplicationTargetsRequest.Unmarshal(m, b)
+}
+func (m *ListReplicationTargetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReplicationTargetsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListReplicationTargetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReplicationTargetsRequest.Merge(m, src)
+}
+func (m *ListReplicationTargetsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListReplicationTargetsRequest.Size(m)
+}
+func (m *ListReplicationTargetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReplicationTargetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReplicationTargetsRequest proto.InternalMessageInfo
+
+func (m *ListReplicationTargetsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListReplicationTargetsReply struct {
+	Targets              []*ReplicationTarget `protobuf:"bytes,1,rep,name=targets,proto3" json:"targets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListReplicationTargetsReply) Reset()         { *m = ListReplicationTargetsReply{} }
+func (m *ListReplicationTargetsReply) String() string { return proto.CompactTextString(m) }
+func (*ListReplicationTargetsReply) ProtoMessage()    {}
+func (*ListReplicationTargetsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{87}
+}
+
+func (m *ListReplicationTargetsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListReplicationTargetsReply.Unmarshal(m, b)
+}
+func (m *ListReplicationTargetsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListReplicationTargetsReply.Marshal(b, m, deterministic)
+}
+func (m *ListReplicationTargetsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListReplicationTargetsReply.Merge(m, src)
+}
+func (m *ListReplicationTargetsReply) XXX_Size() int {
+	return xxx_messageInfo_ListReplicationTargetsReply.Size(m)
+}
+func (m *ListReplicationTargetsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListReplicationTargetsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListReplicationTargetsReply proto.InternalMessageInfo
+
+func (m *ListReplicationTargetsReply) GetTargets() []*ReplicationTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+type RemoveReplicationTargetRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveReplicationTargetRequest) Reset()         { *m = RemoveReplicationTargetRequest{} }
+func (m *RemoveReplicationTargetRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveReplicationTargetRequest) ProtoMessage()    {}
+func (*RemoveReplicationTargetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{88}
+}
+
+func (m *RemoveReplicationTargetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveReplicationTargetRequest.Unmarshal(m, b)
+}
+func (m *RemoveReplicationTargetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveReplicationTargetRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveReplicationTargetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveReplicationTargetRequest.Merge(m, src)
+}
+func (m *RemoveReplicationTargetRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveReplicationTargetRequest.Size(m)
+}
+func (m *RemoveReplicationTargetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveReplicationTargetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveReplicationTargetRequest proto.InternalMessageInfo
+
+func (m *RemoveReplicationTargetRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RemoveReplicationTargetRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RemoveReplicationTargetReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveReplicationTargetReply) Reset()         { *m = RemoveReplicationTargetReply{} }
+func (m *RemoveReplicationTargetReply) String() string { return proto.CompactTextString(m) }
+func (*RemoveReplicationTargetReply) ProtoMessage()    {}
+func (*RemoveReplicationTargetReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{89}
+}
+
+func (m *RemoveReplicationTargetReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveReplicationTargetReply.Unmarshal(m, b)
+}
+func (m *RemoveReplicationTargetReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveReplicationTargetReply.Marshal(b, m, deterministic)
+}
+func (m *RemoveReplicationTargetReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveReplicationTargetReply.Merge(m, src)
+}
+func (m *RemoveReplicationTargetReply) XXX_Size() int {
+	return xxx_messageInfo_RemoveReplicationTargetReply.Size(m)
+}
+func (m *RemoveReplicationTargetReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveReplicationTargetReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveReplicationTargetReply proto.InternalMessageInfo
+
+type PinMirror struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Endpoint             string   `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Pending              bool     `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	RequestId            string   `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	Status               string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	LastRoot             string   `protobuf:"bytes,6,opt,name=lastRoot,proto3" json:"lastRoot,omitempty"`
+	LastSyncedAt         int64    `protobuf:"varint,7,opt,name=lastSyncedAt,proto3" json:"lastSyncedAt,omitempty"`
+	LastError            string   `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PinMirror) Reset()         { *m = PinMirror{} }
+func (m *PinMirror) String() string { return proto.CompactTextString(m) }
+func (*PinMirror) ProtoMessage()    {}
+func (*PinMirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{90}
+}
+
+func (m *PinMirror) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PinMirror.Unmarshal(m, b)
+}
+func (m *PinMirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PinMirror.Marshal(b, m, deterministic)
+}
+func (m *PinMirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinMirror.Merge(m, src)
+}
+func (m *PinMirror) XXX_Size() int {
+	return xxx_messageInfo_PinMirror.Size(m)
+}
+func (m *PinMirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinMirror.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinMirror proto.InternalMessageInfo
+
+func (m *PinMirror) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PinMirror) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *PinMirror) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *PinMirror) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *PinMirror) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *PinMirror) GetLastRoot() string {
+	if m != nil {
+		return m.LastRoot
+	}
+	return ""
+}
+
+func (m *PinMirror) GetLastSyncedAt() int64 {
+	if m != nil {
+		return m.LastSyncedAt
+	}
+	return 0
+}
+
+func (m *PinMirror) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *PinMirror) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type AddPinMirrorRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Endpoint             string   `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Token                string   `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddPinMirrorRequest) Reset()         { *m = AddPinMirrorRequest{} }
+func (m *AddPinMirrorRequest) String() string { return proto.CompactTextString(m) }
+func (*AddPinMirrorRequest) ProtoMessage()    {}
+func (*AddPinMirrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{91}
+}
+
+func (m *AddPinMirrorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddPinMirrorRequest.Unmarshal(m, b)
+}
+func (m *AddPinMirrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddPinMirrorRequest.Marshal(b, m, deterministic)
+}
+func (m *AddPinMirrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddPinMirrorRequest.Merge(m, src)
+}
+func (m *AddPinMirrorRequest) XXX_Size() int {
+	return xxx_messageInfo_AddPinMirrorRequest.Size(m)
+}
+func (m *AddPinMirrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddPinMirrorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddPinMirrorRequest proto.InternalMessageInfo
+
+func (m *AddPinMirrorRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AddPinMirrorRequest) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *AddPinMirrorRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type AddPinMirrorReply struct {
+	Mirror               *PinMirror `protobuf:"bytes,1,opt,name=mirror,proto3" json:"mirror,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *AddPinMirrorReply) Reset()         { *m = AddPinMirrorReply{} }
+func (m *AddPinMirrorReply) String() string { return proto.CompactTextString(m) }
+func (*AddPinMirrorReply) ProtoMessage()    {}
+func (*AddPinMirrorReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{92}
+}
+
+func (m *AddPinMirrorReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddPinMirrorReply.Unmarshal(m, b)
+}
+func (m *AddPinMirrorReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddPinMirrorReply.Marshal(b, m, deterministic)
+}
+func (m *AddPinMirrorReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddPinMirrorReply.Merge(m, src)
+}
+func (m *AddPinMirrorReply) XXX_Size() int {
+	return xxx_messageInfo_AddPinMirrorReply.Size(m)
+}
+func (m *AddPinMirrorReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddPinMirrorReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddPinMirrorReply proto.InternalMessageInfo
+
+func (m *AddPinMirrorReply) GetMirror() *PinMirror {
+	if m != nil {
+		return m.Mirror
+	}
+	return nil
+}
+
+type ListPinMirrorsRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPinMirrorsRequest) Reset()         { *m = ListPinMirrorsRequest{} }
+func (m *ListPinMirrorsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPinMirrorsRequest) ProtoMessage()    {}
+func (*ListPinMirrorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{93}
+}
+
+func (m *ListPinMirrorsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPinMirrorsRequest.Unmarshal(m, b)
+}
+func (m *ListPinMirrorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPinMirrorsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPinMirrorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPinMirrorsRequest.Merge(m, src)
+}
+func (m *ListPinMirrorsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPinMirrorsRequest.Size(m)
+}
+func (m *ListPinMirrorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPinMirrorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPinMirrorsRequest proto.InternalMessageInfo
+
+func (m *ListPinMirrorsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListPinMirrorsReply struct {
+	Mirrors              []*PinMirror `protobuf:"bytes,1,rep,name=mirrors,proto3" json:"mirrors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListPinMirrorsReply) Reset()         { *m = ListPinMirrorsReply{} }
+func (m *ListPinMirrorsReply) String() string { return proto.CompactTextString(m) }
+func (*ListPinMirrorsReply) ProtoMessage()    {}
+func (*ListPinMirrorsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{94}
+}
+
+func (m *ListPinMirrorsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPinMirrorsReply.Unmarshal(m, b)
+}
+func (m *ListPinMirrorsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPinMirrorsReply.Marshal(b, m, deterministic)
+}
+func (m *ListPinMirrorsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPinMirrorsReply.Merge(m, src)
+}
+func (m *ListPinMirrorsReply) XXX_Size() int {
+	return xxx_messageInfo_ListPinMirrorsReply.Size(m)
+}
+func (m *ListPinMirrorsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPinMirrorsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPinMirrorsReply proto.InternalMessageInfo
+
+func (m *ListPinMirrorsReply) GetMirrors() []*PinMirror {
+	if m != nil {
+		return m.Mirrors
+	}
+	return nil
+}
+
+type RemovePinMirrorRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePinMirrorRequest) Reset()         { *m = RemovePinMirrorRequest{} }
+func (m *RemovePinMirrorRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePinMirrorRequest) ProtoMessage()    {}
+func (*RemovePinMirrorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{95}
+}
+
+func (m *RemovePinMirrorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePinMirrorRequest.Unmarshal(m, b)
+}
+func (m *RemovePinMirrorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePinMirrorRequest.Marshal(b, m, deterministic)
+}
+func (m *RemovePinMirrorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePinMirrorRequest.Merge(m, src)
+}
+func (m *RemovePinMirrorRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePinMirrorRequest.Size(m)
+}
+func (m *RemovePinMirrorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePinMirrorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePinMirrorRequest proto.InternalMessageInfo
+
+func (m *RemovePinMirrorRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RemovePinMirrorRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RemovePinMirrorReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePinMirrorReply) Reset()         { *m = RemovePinMirrorReply{} }
+func (m *RemovePinMirrorReply) String() string { return proto.CompactTextString(m) }
+func (*RemovePinMirrorReply) ProtoMessage()    {}
+func (*RemovePinMirrorReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{96}
+}
+
+func (m *RemovePinMirrorReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePinMirrorReply.Unmarshal(m, b)
+}
+func (m *RemovePinMirrorReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePinMirrorReply.Marshal(b, m, deterministic)
+}
+func (m *RemovePinMirrorReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePinMirrorReply.Merge(m, src)
+}
+func (m *RemovePinMirrorReply) XXX_Size() int {
+	return xxx_messageInfo_RemovePinMirrorReply.Size(m)
+}
+func (m *RemovePinMirrorReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePinMirrorReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePinMirrorReply proto.InternalMessageInfo
+
+type Domain struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Provider             string   `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	ChallengeName        string   `protobuf:"bytes,4,opt,name=challengeName,proto3" json:"challengeName,omitempty"`
+	ChallengeValue       string   `protobuf:"bytes,5,opt,name=challengeValue,proto3" json:"challengeValue,omitempty"`
+	Verified             bool     `protobuf:"varint,6,opt,name=verified,proto3" json:"verified,omitempty"`
+	Pending              bool     `protobuf:"varint,7,opt,name=pending,proto3" json:"pending,omitempty"`
+	LastRoot             string   `protobuf:"bytes,8,opt,name=lastRoot,proto3" json:"lastRoot,omitempty"`
+	LastSyncedAt         int64    `protobuf:"varint,9,opt,name=lastSyncedAt,proto3" json:"lastSyncedAt,omitempty"`
+	LastError            string   `protobuf:"bytes,10,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Domain) Reset()         { *m = Domain{} }
+func (m *Domain) String() string { return proto.CompactTextString(m) }
+func (*Domain) ProtoMessage()    {}
+func (*Domain) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{97}
+}
+
+func (m *Domain) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Domain.Unmarshal(m, b)
+}
+func (m *Domain) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Domain.Marshal(b, m, deterministic)
+}
+func (m *Domain) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Domain.Merge(m, src)
+}
+func (m *Domain) XXX_Size() int {
+	return xxx_messageInfo_Domain.Size(m)
+}
+func (m *Domain) XXX_DiscardUnknown() {
+	xxx_messageInfo_Domain.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Domain proto.InternalMessageInfo
+
+func (m *Domain) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Domain) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Domain) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *Domain) GetChallengeName() string {
+	if m != nil {
+		return m.ChallengeName
+	}
+	return ""
+}
+
+func (m *Domain) GetChallengeValue() string {
+	if m != nil {
+		return m.ChallengeValue
+	}
+	return ""
+}
+
+func (m *Domain) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func (m *Domain) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *Domain) GetLastRoot() string {
+	if m != nil {
+		return m.LastRoot
+	}
+	return ""
+}
+
+func (m *Domain) GetLastSyncedAt() int64 {
+	if m != nil {
+		return m.LastSyncedAt
+	}
+	return 0
+}
+
+func (m *Domain) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *Domain) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type AddDomainRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Provider             string   `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
+	ZoneId               string   `protobuf:"bytes,4,opt,name=zoneId,proto3" json:"zoneId,omitempty"`
+	Token                string   `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	AccessKey            string   `protobuf:"bytes,6,opt,name=accessKey,proto3" json:"accessKey,omitempty"`
+	SecretKey            string   `protobuf:"bytes,7,opt,name=secretKey,proto3" json:"secretKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddDomainRequest) Reset()         { *m = AddDomainRequest{} }
+func (m *AddDomainRequest) String() string { return proto.CompactTextString(m) }
+func (*AddDomainRequest) ProtoMessage()    {}
+func (*AddDomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{98}
+}
+
+func (m *AddDomainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddDomainRequest.Unmarshal(m, b)
+}
+func (m *AddDomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddDomainRequest.Marshal(b, m, deterministic)
+}
+func (m *AddDomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDomainRequest.Merge(m, src)
+}
+func (m *AddDomainRequest) XXX_Size() int {
+	return xxx_messageInfo_AddDomainRequest.Size(m)
+}
+func (m *AddDomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDomainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddDomainRequest proto.InternalMessageInfo
+
+func (m *AddDomainRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AddDomainRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddDomainRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *AddDomainRequest) GetZoneId() string {
+	if m != nil {
+		return m.ZoneId
+	}
+	return ""
+}
+
+func (m *AddDomainRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *AddDomainRequest) GetAccessKey() string {
+	if m != nil {
+		return m.AccessKey
+	}
+	return ""
+}
+
+func (m *AddDomainRequest) GetSecretKey() string {
+	if m != nil {
+		return m.SecretKey
+	}
+	return ""
+}
+
+type AddDomainReply struct {
+	Domain               *Domain  `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddDomainReply) Reset()         { *m = AddDomainReply{} }
+func (m *AddDomainReply) String() string { return proto.CompactTextString(m) }
+func (*AddDomainReply) ProtoMessage()    {}
+func (*AddDomainReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{99}
+}
+
+func (m *AddDomainReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddDomainReply.Unmarshal(m, b)
+}
+func (m *AddDomainReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddDomainReply.Marshal(b, m, deterministic)
+}
+func (m *AddDomainReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddDomainReply.Merge(m, src)
+}
+func (m *AddDomainReply) XXX_Size() int {
+	return xxx_messageInfo_AddDomainReply.Size(m)
+}
+func (m *AddDomainReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddDomainReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddDomainReply proto.InternalMessageInfo
+
+func (m *AddDomainReply) GetDomain() *Domain {
+	if m != nil {
+		return m.Domain
+	}
+	return nil
+}
+
+type ListDomainsRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListDomainsRequest) Reset()         { *m = ListDomainsRequest{} }
+func (m *ListDomainsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDomainsRequest) ProtoMessage()    {}
+func (*ListDomainsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{100}
+}
+
+func (m *ListDomainsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDomainsRequest.Unmarshal(m, b)
+}
+func (m *ListDomainsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDomainsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListDomainsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDomainsRequest.Merge(m, src)
+}
+func (m *ListDomainsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDomainsRequest.Size(m)
+}
+func (m *ListDomainsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDomainsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDomainsRequest proto.InternalMessageInfo
+
+func (m *ListDomainsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListDomainsReply struct {
+	Domains              []*Domain `protobuf:"bytes,1,rep,name=domains,proto3" json:"domains,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListDomainsReply) Reset()         { *m = ListDomainsReply{} }
+func (m *ListDomainsReply) String() string { return proto.CompactTextString(m) }
+func (*ListDomainsReply) ProtoMessage()    {}
+func (*ListDomainsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{101}
+}
+
+func (m *ListDomainsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDomainsReply.Unmarshal(m, b)
+}
+func (m *ListDomainsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDomainsReply.Marshal(b, m, deterministic)
+}
+func (m *ListDomainsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDomainsReply.Merge(m, src)
+}
+func (m *ListDomainsReply) XXX_Size() int {
+	return xxx_messageInfo_ListDomainsReply.Size(m)
+}
+func (m *ListDomainsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDomainsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListDomainsReply proto.InternalMessageInfo
+
+func (m *ListDomainsReply) GetDomains() []*Domain {
+	if m != nil {
+		return m.Domains
+	}
+	return nil
+}
+
+type VerifyDomainRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyDomainRequest) Reset()         { *m = VerifyDomainRequest{} }
+func (m *VerifyDomainRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyDomainRequest) ProtoMessage()    {}
+func (*VerifyDomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{102}
+}
+
+func (m *VerifyDomainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyDomainRequest.Unmarshal(m, b)
+}
+func (m *VerifyDomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyDomainRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyDomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyDomainRequest.Merge(m, src)
+}
+func (m *VerifyDomainRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyDomainRequest.Size(m)
+}
+func (m *VerifyDomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyDomainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyDomainRequest proto.InternalMessageInfo
+
+func (m *VerifyDomainRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *VerifyDomainRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type VerifyDomainReply struct {
+	Domain               *Domain  `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyDomainReply) Reset()         { *m = VerifyDomainReply{} }
+func (m *VerifyDomainReply) String() string { return proto.CompactTextString(m) }
+func (*VerifyDomainReply) ProtoMessage()    {}
+func (*VerifyDomainReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{103}
+}
+
+func (m *VerifyDomainReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyDomainReply.Unmarshal(m, b)
+}
+func (m *VerifyDomainReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyDomainReply.Marshal(b, m, deterministic)
+}
+func (m *VerifyDomainReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyDomainReply.Merge(m, src)
+}
+func (m *VerifyDomainReply) XXX_Size() int {
+	return xxx_messageInfo_VerifyDomainReply.Size(m)
+}
+func (m *VerifyDomainReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyDomainReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyDomainReply proto.InternalMessageInfo
+
+func (m *VerifyDomainReply) GetDomain() *Domain {
+	if m != nil {
+		return m.Domain
+	}
+	return nil
+}
+
+type RemoveDomainRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveDomainRequest) Reset()         { *m = RemoveDomainRequest{} }
+func (m *RemoveDomainRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveDomainRequest) ProtoMessage()    {}
+func (*RemoveDomainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{104}
+}
+
+func (m *RemoveDomainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveDomainRequest.Unmarshal(m, b)
+}
+func (m *RemoveDomainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveDomainRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveDomainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDomainRequest.Merge(m, src)
+}
+func (m *RemoveDomainRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveDomainRequest.Size(m)
+}
+func (m *RemoveDomainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDomainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveDomainRequest proto.InternalMessageInfo
+
+func (m *RemoveDomainRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RemoveDomainRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RemoveDomainReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveDomainReply) Reset()         { *m = RemoveDomainReply{} }
+func (m *RemoveDomainReply) String() string { return proto.CompactTextString(m) }
+func (*RemoveDomainReply) ProtoMessage()    {}
+func (*RemoveDomainReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{105}
+}
+
+func (m *RemoveDomainReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveDomainReply.Unmarshal(m, b)
+}
+func (m *RemoveDomainReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveDomainReply.Marshal(b, m, deterministic)
+}
+func (m *RemoveDomainReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDomainReply.Merge(m, src)
+}
+func (m *RemoveDomainReply) XXX_Size() int {
+	return xxx_messageInfo_RemoveDomainReply.Size(m)
+}
+func (m *RemoveDomainReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDomainReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveDomainReply proto.InternalMessageInfo
+
+type ShareLink struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Url                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Protected            bool     `protobuf:"varint,6,opt,name=protected,proto3" json:"protected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShareLink) Reset()         { *m = ShareLink{} }
+func (m *ShareLink) String() string { return proto.CompactTextString(m) }
+func (*ShareLink) ProtoMessage()    {}
+func (*ShareLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{106}
+}
+
+func (m *ShareLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShareLink.Unmarshal(m, b)
+}
+func (m *ShareLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShareLink.Marshal(b, m, deterministic)
+}
+func (m *ShareLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareLink.Merge(m, src)
+}
+func (m *ShareLink) XXX_Size() int {
+	return xxx_messageInfo_ShareLink.Size(m)
+}
+func (m *ShareLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareLink proto.InternalMessageInfo
+
+func (m *ShareLink) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ShareLink) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ShareLink) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ShareLink) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *ShareLink) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ShareLink) GetProtected() bool {
+	if m != nil {
+		return m.Protected
+	}
+	return false
+}
+
+type CreateShareLinkRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Password             string   `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateShareLinkRequest) Reset()         { *m = CreateShareLinkRequest{} }
+func (m *CreateShareLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateShareLinkRequest) ProtoMessage()    {}
+func (*CreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{107}
+}
+
+func (m *CreateShareLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateShareLinkRequest.Unmarshal(m, b)
+}
+func (m *CreateShareLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateShareLinkRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateShareLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateShareLinkRequest.Merge(m, src)
+}
+func (m *CreateShareLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateShareLinkRequest.Size(m)
+}
+func (m *CreateShareLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateShareLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateShareLinkRequest proto.InternalMessageInfo
+
+func (m *CreateShareLinkRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CreateShareLinkRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *CreateShareLinkRequest) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+func (m *CreateShareLinkRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type CreateShareLinkReply struct {
+	Link                 *ShareLink `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CreateShareLinkReply) Reset()         { *m = CreateShareLinkReply{} }
+func (m *CreateShareLinkReply) String() string { return proto.CompactTextString(m) }
+func (*CreateShareLinkReply) ProtoMessage()    {}
+func (*CreateShareLinkReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{108}
+}
+
+func (m *CreateShareLinkReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateShareLinkReply.Unmarshal(m, b)
+}
+func (m *CreateShareLinkReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateShareLinkReply.Marshal(b, m, deterministic)
+}
+func (m *CreateShareLinkReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateShareLinkReply.Merge(m, src)
+}
+func (m *CreateShareLinkReply) XXX_Size() int {
+	return xxx_messageInfo_CreateShareLinkReply.Size(m)
+}
+func (m *CreateShareLinkReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateShareLinkReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateShareLinkReply proto.InternalMessageInfo
+
+func (m *CreateShareLinkReply) GetLink() *ShareLink {
+	if m != nil {
+		return m.Link
+	}
+	return nil
+}
+
+type ListShareLinksRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListShareLinksRequest) Reset()         { *m = ListShareLinksRequest{} }
+func (m *ListShareLinksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListShareLinksRequest) ProtoMessage()    {}
+func (*ListShareLinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{109}
+}
+
+func (m *ListShareLinksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListShareLinksRequest.Unmarshal(m, b)
+}
+func (m *ListShareLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListShareLinksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListShareLinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListShareLinksRequest.Merge(m, src)
+}
+func (m *ListShareLinksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListShareLinksRequest.Size(m)
+}
+func (m *ListShareLinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListShareLinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListShareLinksRequest proto.InternalMessageInfo
+
+func (m *ListShareLinksRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListShareLinksReply struct {
+	Links                []*ShareLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListShareLinksReply) Reset()         { *m = ListShareLinksReply{} }
+func (m *ListShareLinksReply) String() string { return proto.CompactTextString(m) }
+func (*ListShareLinksReply) ProtoMessage()    {}
+func (*ListShareLinksReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{110}
+}
+
+func (m *ListShareLinksReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListShareLinksReply.Unmarshal(m, b)
+}
+func (m *ListShareLinksReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListShareLinksReply.Marshal(b, m, deterministic)
+}
+func (m *ListShareLinksReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListShareLinksReply.Merge(m, src)
+}
+func (m *ListShareLinksReply) XXX_Size() int {
+	return xxx_messageInfo_ListShareLinksReply.Size(m)
+}
+func (m *ListShareLinksReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListShareLinksReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListShareLinksReply proto.InternalMessageInfo
+
+func (m *ListShareLinksReply) GetLinks() []*ShareLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+type RevokeShareLinkRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeShareLinkRequest) Reset()         { *m = RevokeShareLinkRequest{} }
+func (m *RevokeShareLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeShareLinkRequest) ProtoMessage()    {}
+func (*RevokeShareLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{111}
+}
+
+func (m *RevokeShareLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeShareLinkRequest.Unmarshal(m, b)
+}
+func (m *RevokeShareLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeShareLinkRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeShareLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeShareLinkRequest.Merge(m, src)
+}
+func (m *RevokeShareLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeShareLinkRequest.Size(m)
+}
+func (m *RevokeShareLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeShareLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeShareLinkRequest proto.InternalMessageInfo
+
+func (m *RevokeShareLinkRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RevokeShareLinkRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RevokeShareLinkReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeShareLinkReply) Reset()         { *m = RevokeShareLinkReply{} }
+func (m *RevokeShareLinkReply) String() string { return proto.CompactTextString(m) }
+func (*RevokeShareLinkReply) ProtoMessage()    {}
+func (*RevokeShareLinkReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{112}
+}
+
+func (m *RevokeShareLinkReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeShareLinkReply.Unmarshal(m, b)
+}
+func (m *RevokeShareLinkReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeShareLinkReply.Marshal(b, m, deterministic)
+}
+func (m *RevokeShareLinkReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeShareLinkReply.Merge(m, src)
+}
+func (m *RevokeShareLinkReply) XXX_Size() int {
+	return xxx_messageInfo_RevokeShareLinkReply.Size(m)
+}
+func (m *RevokeShareLinkReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeShareLinkReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeShareLinkReply proto.InternalMessageInfo
+
+type Webhook struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events               []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{113}
+}
+
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Webhook.Unmarshal(m, b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+}
+func (m *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(m, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return xxx_messageInfo_Webhook.Size(m)
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func (m *Webhook) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Webhook) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Webhook) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type AddWebhookRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Events               []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddWebhookRequest) Reset()         { *m = AddWebhookRequest{} }
+func (m *AddWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*AddWebhookRequest) ProtoMessage()    {}
+func (*AddWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{114}
+}
+
+func (m *AddWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddWebhookRequest.Unmarshal(m, b)
+}
+func (m *AddWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddWebhookRequest.Marshal(b, m, deterministic)
+}
+func (m *AddWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWebhookRequest.Merge(m, src)
+}
+func (m *AddWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_AddWebhookRequest.Size(m)
+}
+func (m *AddWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddWebhookRequest proto.InternalMessageInfo
+
+func (m *AddWebhookRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AddWebhookRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *AddWebhookRequest) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type AddWebhookReply struct {
+	Webhook              *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Secret               string   `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddWebhookReply) Reset()         { *m = AddWebhookReply{} }
+func (m *AddWebhookReply) String() string { return proto.CompactTextString(m) }
+func (*AddWebhookReply) ProtoMessage()    {}
+func (*AddWebhookReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{115}
+}
+
+func (m *AddWebhookReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddWebhookReply.Unmarshal(m, b)
+}
+func (m *AddWebhookReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddWebhookReply.Marshal(b, m, deterministic)
+}
+func (m *AddWebhookReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddWebhookReply.Merge(m, src)
+}
+func (m *AddWebhookReply) XXX_Size() int {
+	return xxx_messageInfo_AddWebhookReply.Size(m)
+}
+func (m *AddWebhookReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddWebhookReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddWebhookReply proto.InternalMessageInfo
+
+func (m *AddWebhookReply) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+func (m *AddWebhookReply) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+type ListWebhooksRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWebhooksRequest) Reset()         { *m = ListWebhooksRequest{} }
+func (m *ListWebhooksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWebhooksRequest) ProtoMessage()    {}
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{116}
+}
+
+func (m *ListWebhooksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhooksRequest.Unmarshal(m, b)
+}
+func (m *ListWebhooksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhooksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListWebhooksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhooksRequest.Merge(m, src)
+}
+func (m *ListWebhooksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListWebhooksRequest.Size(m)
+}
+func (m *ListWebhooksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhooksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhooksRequest proto.InternalMessageInfo
+
+func (m *ListWebhooksRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListWebhooksReply struct {
+	Webhooks             []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListWebhooksReply) Reset()         { *m = ListWebhooksReply{} }
+func (m *ListWebhooksReply) String() string { return proto.CompactTextString(m) }
+func (*ListWebhooksReply) ProtoMessage()    {}
+func (*ListWebhooksReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{117}
+}
+
+func (m *ListWebhooksReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhooksReply.Unmarshal(m, b)
+}
+func (m *ListWebhooksReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhooksReply.Marshal(b, m, deterministic)
+}
+func (m *ListWebhooksReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhooksReply.Merge(m, src)
+}
+func (m *ListWebhooksReply) XXX_Size() int {
+	return xxx_messageInfo_ListWebhooksReply.Size(m)
+}
+func (m *ListWebhooksReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhooksReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhooksReply proto.InternalMessageInfo
+
+func (m *ListWebhooksReply) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+type RemoveWebhookRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveWebhookRequest) Reset()         { *m = RemoveWebhookRequest{} }
+func (m *RemoveWebhookRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveWebhookRequest) ProtoMessage()    {}
+func (*RemoveWebhookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{118}
+}
+
+func (m *RemoveWebhookRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveWebhookRequest.Unmarshal(m, b)
+}
+func (m *RemoveWebhookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveWebhookRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveWebhookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveWebhookRequest.Merge(m, src)
+}
+func (m *RemoveWebhookRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveWebhookRequest.Size(m)
+}
+func (m *RemoveWebhookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveWebhookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveWebhookRequest proto.InternalMessageInfo
+
+func (m *RemoveWebhookRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RemoveWebhookRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type RemoveWebhookReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveWebhookReply) Reset()         { *m = RemoveWebhookReply{} }
+func (m *RemoveWebhookReply) String() string { return proto.CompactTextString(m) }
+func (*RemoveWebhookReply) ProtoMessage()    {}
+func (*RemoveWebhookReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{119}
+}
+
+func (m *RemoveWebhookReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveWebhookReply.Unmarshal(m, b)
+}
+func (m *RemoveWebhookReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveWebhookReply.Marshal(b, m, deterministic)
+}
+func (m *RemoveWebhookReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveWebhookReply.Merge(m, src)
+}
+func (m *RemoveWebhookReply) XXX_Size() int {
+	return xxx_messageInfo_RemoveWebhookReply.Size(m)
+}
+func (m *RemoveWebhookReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveWebhookReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveWebhookReply proto.InternalMessageInfo
+
+type WebhookFailure struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookID            string   `protobuf:"bytes,2,opt,name=webhookID,proto3" json:"webhookID,omitempty"`
+	Url                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Event                string   `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	Payload              []byte   `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	Attempts             int32    `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	LastError            string   `protobuf:"bytes,7,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhookFailure) Reset()         { *m = WebhookFailure{} }
+func (m *WebhookFailure) String() string { return proto.CompactTextString(m) }
+func (*WebhookFailure) ProtoMessage()    {}
+func (*WebhookFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{120}
+}
+
+func (m *WebhookFailure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookFailure.Unmarshal(m, b)
+}
+func (m *WebhookFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookFailure.Marshal(b, m, deterministic)
+}
+func (m *WebhookFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookFailure.Merge(m, src)
+}
+func (m *WebhookFailure) XXX_Size() int {
+	return xxx_messageInfo_WebhookFailure.Size(m)
+}
+func (m *WebhookFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookFailure proto.InternalMessageInfo
+
+func (m *WebhookFailure) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *WebhookFailure) GetWebhookID() string {
+	if m != nil {
+		return m.WebhookID
+	}
+	return ""
+}
+
+func (m *WebhookFailure) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *WebhookFailure) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *WebhookFailure) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *WebhookFailure) GetAttempts() int32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *WebhookFailure) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *WebhookFailure) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type ListWebhookFailuresRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListWebhookFailuresRequest) Reset()         { *m = ListWebhookFailuresRequest{} }
+func (m *ListWebhookFailuresRequest) String() string { return proto.CompactTextString(m) }
+func (*ListWebhookFailuresRequest) ProtoMessage()    {}
+func (*ListWebhookFailuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{121}
+}
+
+func (m *ListWebhookFailuresRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhookFailuresRequest.Unmarshal(m, b)
+}
+func (m *ListWebhookFailuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhookFailuresRequest.Marshal(b, m, deterministic)
+}
+func (m *ListWebhookFailuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhookFailuresRequest.Merge(m, src)
+}
+func (m *ListWebhookFailuresRequest) XXX_Size() int {
+	return xxx_messageInfo_ListWebhookFailuresRequest.Size(m)
+}
+func (m *ListWebhookFailuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhookFailuresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhookFailuresRequest proto.InternalMessageInfo
+
+func (m *ListWebhookFailuresRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListWebhookFailuresReply struct {
+	Failures             []*WebhookFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListWebhookFailuresReply) Reset()         { *m = ListWebhookFailuresReply{} }
+func (m *ListWebhookFailuresReply) String() string { return proto.CompactTextString(m) }
+func (*ListWebhookFailuresReply) ProtoMessage()    {}
+func (*ListWebhookFailuresReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{122}
+}
+
+func (m *ListWebhookFailuresReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListWebhookFailuresReply.Unmarshal(m, b)
+}
+func (m *ListWebhookFailuresReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListWebhookFailuresReply.Marshal(b, m, deterministic)
+}
+func (m *ListWebhookFailuresReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListWebhookFailuresReply.Merge(m, src)
+}
+func (m *ListWebhookFailuresReply) XXX_Size() int {
+	return xxx_messageInfo_ListWebhookFailuresReply.Size(m)
+}
+func (m *ListWebhookFailuresReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListWebhookFailuresReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListWebhookFailuresReply proto.InternalMessageInfo
+
+func (m *ListWebhookFailuresReply) GetFailures() []*WebhookFailure {
+	if m != nil {
+		return m.Failures
+	}
+	return nil
+}
+
+type SearchPathRequest struct {
+	Key                  string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Query                string                 `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	Mode                 SearchPathRequest_Mode `protobuf:"varint,3,opt,name=mode,proto3,enum=buckets.pb.SearchPathRequest_Mode" json:"mode,omitempty"`
+	Path                 string                 `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	Limit                int64                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *SearchPathRequest) Reset()         { *m = SearchPathRequest{} }
+func (m *SearchPathRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchPathRequest) ProtoMessage()    {}
+func (*SearchPathRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{123}
+}
+
+func (m *SearchPathRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchPathRequest.Unmarshal(m, b)
+}
+func (m *SearchPathRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchPathRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchPathRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchPathRequest.Merge(m, src)
+}
+func (m *SearchPathRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchPathRequest.Size(m)
+}
+func (m *SearchPathRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchPathRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchPathRequest proto.InternalMessageInfo
+
+func (m *SearchPathRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SearchPathRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchPathRequest) GetMode() SearchPathRequest_Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return SearchPathRequest_Substring
+}
+
+func (m *SearchPathRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SearchPathRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type SearchPathReply struct {
+	Items                []*ListPathItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Root                 string          `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	Pending              bool            `protobuf:"varint,3,opt,name=pending,proto3" json:"pending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SearchPathReply) Reset()         { *m = SearchPathReply{} }
+func (m *SearchPathReply) String() string { return proto.CompactTextString(m) }
+func (*SearchPathReply) ProtoMessage()    {}
+func (*SearchPathReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{124}
+}
+
+func (m *SearchPathReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchPathReply.Unmarshal(m, b)
+}
+func (m *SearchPathReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchPathReply.Marshal(b, m, deterministic)
+}
+func (m *SearchPathReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchPathReply.Merge(m, src)
+}
+func (m *SearchPathReply) XXX_Size() int {
+	return xxx_messageInfo_SearchPathReply.Size(m)
+}
+func (m *SearchPathReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchPathReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchPathReply proto.InternalMessageInfo
+
+func (m *SearchPathReply) GetItems() []*ListPathItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *SearchPathReply) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *SearchPathReply) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+type RenameBucketRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenameBucketRequest) Reset()         { *m = RenameBucketRequest{} }
+func (m *RenameBucketRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameBucketRequest) ProtoMessage()    {}
+func (*RenameBucketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{125}
+}
+
+func (m *RenameBucketRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameBucketRequest.Unmarshal(m, b)
+}
+func (m *RenameBucketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameBucketRequest.Marshal(b, m, deterministic)
+}
+func (m *RenameBucketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameBucketRequest.Merge(m, src)
+}
+func (m *RenameBucketRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameBucketRequest.Size(m)
+}
+func (m *RenameBucketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameBucketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameBucketRequest proto.InternalMessageInfo
+
+func (m *RenameBucketRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RenameBucketRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RenameBucketReply struct {
+	Root                 *Root    `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenameBucketReply) Reset()         { *m = RenameBucketReply{} }
+func (m *RenameBucketReply) String() string { return proto.CompactTextString(m) }
+func (*RenameBucketReply) ProtoMessage()    {}
+func (*RenameBucketReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{126}
+}
+
+func (m *RenameBucketReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameBucketReply.Unmarshal(m, b)
+}
+func (m *RenameBucketReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameBucketReply.Marshal(b, m, deterministic)
+}
+func (m *RenameBucketReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameBucketReply.Merge(m, src)
+}
+func (m *RenameBucketReply) XXX_Size() int {
+	return xxx_messageInfo_RenameBucketReply.Size(m)
+}
+func (m *RenameBucketReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameBucketReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenameBucketReply proto.InternalMessageInfo
+
+func (m *RenameBucketReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type CloneBucketRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Thread               string   `protobuf:"bytes,3,opt,name=thread,proto3" json:"thread,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CloneBucketRequest) Reset()         { *m = CloneBucketRequest{} }
+func (m *CloneBucketRequest) String() string { return proto.CompactTextString(m) }
+func (*CloneBucketRequest) ProtoMessage()    {}
+func (*CloneBucketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{127}
+}
+
+func (m *CloneBucketRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneBucketRequest.Unmarshal(m, b)
+}
+func (m *CloneBucketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneBucketRequest.Marshal(b, m, deterministic)
+}
+func (m *CloneBucketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneBucketRequest.Merge(m, src)
+}
+func (m *CloneBucketRequest) XXX_Size() int {
+	return xxx_messageInfo_CloneBucketRequest.Size(m)
+}
+func (m *CloneBucketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneBucketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneBucketRequest proto.InternalMessageInfo
+
+func (m *CloneBucketRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *CloneBucketRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CloneBucketRequest) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+type CloneBucketReply struct {
+	Root                 *Root       `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	Links                *LinksReply `protobuf:"bytes,2,opt,name=links,proto3" json:"links,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CloneBucketReply) Reset()         { *m = CloneBucketReply{} }
+func (m *CloneBucketReply) String() string { return proto.CompactTextString(m) }
+func (*CloneBucketReply) ProtoMessage()    {}
+func (*CloneBucketReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{128}
+}
+
+func (m *CloneBucketReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneBucketReply.Unmarshal(m, b)
+}
+func (m *CloneBucketReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneBucketReply.Marshal(b, m, deterministic)
+}
+func (m *CloneBucketReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneBucketReply.Merge(m, src)
+}
+func (m *CloneBucketReply) XXX_Size() int {
+	return xxx_messageInfo_CloneBucketReply.Size(m)
+}
+func (m *CloneBucketReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneBucketReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneBucketReply proto.InternalMessageInfo
+
+func (m *CloneBucketReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *CloneBucketReply) GetLinks() *LinksReply {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+type SetPathMetadataRequest struct {
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path                 string            `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	ContentType          string            `protobuf:"bytes,3,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	Attributes           map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetPathMetadataRequest) Reset()         { *m = SetPathMetadataRequest{} }
+func (m *SetPathMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPathMetadataRequest) ProtoMessage()    {}
+func (*SetPathMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{129}
+}
+
+func (m *SetPathMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPathMetadataRequest.Unmarshal(m, b)
+}
+func (m *SetPathMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPathMetadataRequest.Marshal(b, m, deterministic)
+}
+func (m *SetPathMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPathMetadataRequest.Merge(m, src)
+}
+func (m *SetPathMetadataRequest) XXX_Size() int {
+	return xxx_messageInfo_SetPathMetadataRequest.Size(m)
+}
+func (m *SetPathMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPathMetadataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPathMetadataRequest proto.InternalMessageInfo
+
+func (m *SetPathMetadataRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetPathMetadataRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SetPathMetadataRequest) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *SetPathMetadataRequest) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+type SetPathMetadataReply struct {
+	Metadata             *Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Root                 *Root     `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SetPathMetadataReply) Reset()         { *m = SetPathMetadataReply{} }
+func (m *SetPathMetadataReply) String() string { return proto.CompactTextString(m) }
+func (*SetPathMetadataReply) ProtoMessage()    {}
+func (*SetPathMetadataReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{130}
+}
+
+func (m *SetPathMetadataReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPathMetadataReply.Unmarshal(m, b)
+}
+func (m *SetPathMetadataReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPathMetadataReply.Marshal(b, m, deterministic)
+}
+func (m *SetPathMetadataReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPathMetadataReply.Merge(m, src)
+}
+func (m *SetPathMetadataReply) XXX_Size() int {
+	return xxx_messageInfo_SetPathMetadataReply.Size(m)
+}
+func (m *SetPathMetadataReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPathMetadataReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPathMetadataReply proto.InternalMessageInfo
+
+func (m *SetPathMetadataReply) GetMetadata() *Metadata {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+func (m *SetPathMetadataReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type SetTagsRequest struct {
+	Key                  string            `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetTagsRequest) Reset()         { *m = SetTagsRequest{} }
+func (m *SetTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTagsRequest) ProtoMessage()    {}
+func (*SetTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{131}
+}
+
+func (m *SetTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTagsRequest.Unmarshal(m, b)
+}
+func (m *SetTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTagsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTagsRequest.Merge(m, src)
+}
+func (m *SetTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetTagsRequest.Size(m)
+}
+func (m *SetTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTagsRequest proto.InternalMessageInfo
+
+func (m *SetTagsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetTagsRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type SetTagsReply struct {
+	Root                 *Root    `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTagsReply) Reset()         { *m = SetTagsReply{} }
+func (m *SetTagsReply) String() string { return proto.CompactTextString(m) }
+func (*SetTagsReply) ProtoMessage()    {}
+func (*SetTagsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{132}
+}
+
+func (m *SetTagsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTagsReply.Unmarshal(m, b)
+}
+func (m *SetTagsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTagsReply.Marshal(b, m, deterministic)
+}
+func (m *SetTagsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTagsReply.Merge(m, src)
+}
+func (m *SetTagsReply) XXX_Size() int {
+	return xxx_messageInfo_SetTagsReply.Size(m)
+}
+func (m *SetTagsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTagsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTagsReply proto.InternalMessageInfo
+
+func (m *SetTagsReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type LegalHold struct {
+	Actor                string   `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LegalHold) Reset()         { *m = LegalHold{} }
+func (m *LegalHold) String() string { return proto.CompactTextString(m) }
+func (*LegalHold) ProtoMessage()    {}
+func (*LegalHold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{133}
+}
+
+func (m *LegalHold) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LegalHold.Unmarshal(m, b)
+}
+func (m *LegalHold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LegalHold.Marshal(b, m, deterministic)
+}
+func (m *LegalHold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LegalHold.Merge(m, src)
+}
+func (m *LegalHold) XXX_Size() int {
+	return xxx_messageInfo_LegalHold.Size(m)
+}
+func (m *LegalHold) XXX_DiscardUnknown() {
+	xxx_messageInfo_LegalHold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LegalHold proto.InternalMessageInfo
+
+func (m *LegalHold) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *LegalHold) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *LegalHold) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type SetLegalHoldRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Hold                 bool     `protobuf:"varint,2,opt,name=hold,proto3" json:"hold,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLegalHoldRequest) Reset()         { *m = SetLegalHoldRequest{} }
+func (m *SetLegalHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLegalHoldRequest) ProtoMessage()    {}
+func (*SetLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{134}
+}
+
+func (m *SetLegalHoldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLegalHoldRequest.Unmarshal(m, b)
+}
+func (m *SetLegalHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLegalHoldRequest.Marshal(b, m, deterministic)
+}
+func (m *SetLegalHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLegalHoldRequest.Merge(m, src)
+}
+func (m *SetLegalHoldRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLegalHoldRequest.Size(m)
+}
+func (m *SetLegalHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLegalHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLegalHoldRequest proto.InternalMessageInfo
+
+func (m *SetLegalHoldRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetLegalHoldRequest) GetHold() bool {
+	if m != nil {
+		return m.Hold
+	}
+	return false
+}
+
+func (m *SetLegalHoldRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type SetLegalHoldReply struct {
+	Hold                 *LegalHold `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetLegalHoldReply) Reset()         { *m = SetLegalHoldReply{} }
+func (m *SetLegalHoldReply) String() string { return proto.CompactTextString(m) }
+func (*SetLegalHoldReply) ProtoMessage()    {}
+func (*SetLegalHoldReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{135}
+}
+
+func (m *SetLegalHoldReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLegalHoldReply.Unmarshal(m, b)
+}
+func (m *SetLegalHoldReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLegalHoldReply.Marshal(b, m, deterministic)
+}
+func (m *SetLegalHoldReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLegalHoldReply.Merge(m, src)
+}
+func (m *SetLegalHoldReply) XXX_Size() int {
+	return xxx_messageInfo_SetLegalHoldReply.Size(m)
+}
+func (m *SetLegalHoldReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLegalHoldReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLegalHoldReply proto.InternalMessageInfo
+
+func (m *SetLegalHoldReply) GetHold() *LegalHold {
+	if m != nil {
+		return m.Hold
+	}
+	return nil
+}
+
+type GetLegalHoldRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLegalHoldRequest) Reset()         { *m = GetLegalHoldRequest{} }
+func (m *GetLegalHoldRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLegalHoldRequest) ProtoMessage()    {}
+func (*GetLegalHoldRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{136}
+}
+
+func (m *GetLegalHoldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLegalHoldRequest.Unmarshal(m, b)
+}
+func (m *GetLegalHoldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLegalHoldRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLegalHoldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLegalHoldRequest.Merge(m, src)
+}
+func (m *GetLegalHoldRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLegalHoldRequest.Size(m)
+}
+func (m *GetLegalHoldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLegalHoldRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLegalHoldRequest proto.InternalMessageInfo
+
+func (m *GetLegalHoldRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type GetLegalHoldReply struct {
+	Hold                 *LegalHold `protobuf:"bytes,1,opt,name=hold,proto3" json:"hold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetLegalHoldReply) Reset()         { *m = GetLegalHoldReply{} }
+func (m *GetLegalHoldReply) String() string { return proto.CompactTextString(m) }
+func (*GetLegalHoldReply) ProtoMessage()    {}
+func (*GetLegalHoldReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{137}
+}
+
+func (m *GetLegalHoldReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLegalHoldReply.Unmarshal(m, b)
+}
+func (m *GetLegalHoldReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLegalHoldReply.Marshal(b, m, deterministic)
+}
+func (m *GetLegalHoldReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLegalHoldReply.Merge(m, src)
+}
+func (m *GetLegalHoldReply) XXX_Size() int {
+	return xxx_messageInfo_GetLegalHoldReply.Size(m)
+}
+func (m *GetLegalHoldReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLegalHoldReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLegalHoldReply proto.InternalMessageInfo
+
+func (m *GetLegalHoldReply) GetHold() *LegalHold {
+	if m != nil {
+		return m.Hold
+	}
+	return nil
+}
+
+type License struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	License              string   `protobuf:"bytes,2,opt,name=license,proto3" json:"license,omitempty"`
+	URL                  string   `protobuf:"bytes,3,opt,name=URL,proto3" json:"URL,omitempty"`
+	Attribution          string   `protobuf:"bytes,4,opt,name=attribution,proto3" json:"attribution,omitempty"`
+	UpdatedAt            int64    `protobuf:"varint,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *License) Reset()         { *m = License{} }
+func (m *License) String() string { return proto.CompactTextString(m) }
+func (*License) ProtoMessage()    {}
+func (*License) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{138}
+}
+
+func (m *License) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_License.Unmarshal(m, b)
+}
+func (m *License) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_License.Marshal(b, m, deterministic)
+}
+func (m *License) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_License.Merge(m, src)
+}
+func (m *License) XXX_Size() int {
+	return xxx_messageInfo_License.Size(m)
+}
+func (m *License) XXX_DiscardUnknown() {
+	xxx_messageInfo_License.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_License proto.InternalMessageInfo
+
+func (m *License) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *License) GetLicense() string {
+	if m != nil {
+		return m.License
+	}
+	return ""
+}
+
+func (m *License) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *License) GetAttribution() string {
+	if m != nil {
+		return m.Attribution
+	}
+	return ""
+}
+
+func (m *License) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type SetLicenseRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	License              string   `protobuf:"bytes,3,opt,name=license,proto3" json:"license,omitempty"`
+	URL                  string   `protobuf:"bytes,4,opt,name=URL,proto3" json:"URL,omitempty"`
+	Attribution          string   `protobuf:"bytes,5,opt,name=attribution,proto3" json:"attribution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLicenseRequest) Reset()         { *m = SetLicenseRequest{} }
+func (m *SetLicenseRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLicenseRequest) ProtoMessage()    {}
+func (*SetLicenseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{139}
+}
+
+func (m *SetLicenseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLicenseRequest.Unmarshal(m, b)
+}
+func (m *SetLicenseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLicenseRequest.Marshal(b, m, deterministic)
+}
+func (m *SetLicenseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLicenseRequest.Merge(m, src)
+}
+func (m *SetLicenseRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLicenseRequest.Size(m)
+}
+func (m *SetLicenseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLicenseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLicenseRequest proto.InternalMessageInfo
+
+func (m *SetLicenseRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetLicenseRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *SetLicenseRequest) GetLicense() string {
+	if m != nil {
+		return m.License
+	}
+	return ""
+}
+
+func (m *SetLicenseRequest) GetURL() string {
+	if m != nil {
+		return m.URL
+	}
+	return ""
+}
+
+func (m *SetLicenseRequest) GetAttribution() string {
+	if m != nil {
+		return m.Attribution
+	}
+	return ""
+}
+
+type SetLicenseReply struct {
+	License              *License `protobuf:"bytes,1,opt,name=license,proto3" json:"license,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLicenseReply) Reset()         { *m = SetLicenseReply{} }
+func (m *SetLicenseReply) String() string { return proto.CompactTextString(m) }
+func (*SetLicenseReply) ProtoMessage()    {}
+func (*SetLicenseReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{140}
+}
+
+func (m *SetLicenseReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLicenseReply.Unmarshal(m, b)
+}
+func (m *SetLicenseReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLicenseReply.Marshal(b, m, deterministic)
+}
+func (m *SetLicenseReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLicenseReply.Merge(m, src)
+}
+func (m *SetLicenseReply) XXX_Size() int {
+	return xxx_messageInfo_SetLicenseReply.Size(m)
+}
+func (m *SetLicenseReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLicenseReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLicenseReply proto.InternalMessageInfo
+
+func (m *SetLicenseReply) GetLicense() *License {
+	if m != nil {
+		return m.License
+	}
+	return nil
+}
+
+type GetLicenseRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLicenseRequest) Reset()         { *m = GetLicenseRequest{} }
+func (m *GetLicenseRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLicenseRequest) ProtoMessage()    {}
+func (*GetLicenseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{141}
+}
+
+func (m *GetLicenseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLicenseRequest.Unmarshal(m, b)
+}
+func (m *GetLicenseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLicenseRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLicenseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLicenseRequest.Merge(m, src)
+}
+func (m *GetLicenseRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLicenseRequest.Size(m)
+}
+func (m *GetLicenseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLicenseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLicenseRequest proto.InternalMessageInfo
+
+func (m *GetLicenseRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *GetLicenseRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type GetLicenseReply struct {
+	License              *License `protobuf:"bytes,1,opt,name=license,proto3" json:"license,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLicenseReply) Reset()         { *m = GetLicenseReply{} }
+func (m *GetLicenseReply) String() string { return proto.CompactTextString(m) }
+func (*GetLicenseReply) ProtoMessage()    {}
+func (*GetLicenseReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{142}
+}
+
+func (m *GetLicenseReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLicenseReply.Unmarshal(m, b)
+}
+func (m *GetLicenseReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLicenseReply.Marshal(b, m, deterministic)
+}
+func (m *GetLicenseReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLicenseReply.Merge(m, src)
+}
+func (m *GetLicenseReply) XXX_Size() int {
+	return xxx_messageInfo_GetLicenseReply.Size(m)
+}
+func (m *GetLicenseReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLicenseReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLicenseReply proto.InternalMessageInfo
+
+func (m *GetLicenseReply) GetLicense() *License {
+	if m != nil {
+		return m.License
+	}
+	return nil
+}
+
+type ListLicensesRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListLicensesRequest) Reset()         { *m = ListLicensesRequest{} }
+func (m *ListLicensesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListLicensesRequest) ProtoMessage()    {}
+func (*ListLicensesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{143}
+}
+
+func (m *ListLicensesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLicensesRequest.Unmarshal(m, b)
+}
+func (m *ListLicensesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLicensesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListLicensesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLicensesRequest.Merge(m, src)
+}
+func (m *ListLicensesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListLicensesRequest.Size(m)
+}
+func (m *ListLicensesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLicensesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLicensesRequest proto.InternalMessageInfo
+
+func (m *ListLicensesRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListLicensesReply struct {
+	Licenses             []*License `protobuf:"bytes,1,rep,name=licenses,proto3" json:"licenses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListLicensesReply) Reset()         { *m = ListLicensesReply{} }
+func (m *ListLicensesReply) String() string { return proto.CompactTextString(m) }
+func (*ListLicensesReply) ProtoMessage()    {}
+func (*ListLicensesReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{144}
+}
+
+func (m *ListLicensesReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListLicensesReply.Unmarshal(m, b)
+}
+func (m *ListLicensesReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListLicensesReply.Marshal(b, m, deterministic)
+}
+func (m *ListLicensesReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListLicensesReply.Merge(m, src)
+}
+func (m *ListLicensesReply) XXX_Size() int {
+	return xxx_messageInfo_ListLicensesReply.Size(m)
+}
+func (m *ListLicensesReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListLicensesReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListLicensesReply proto.InternalMessageInfo
+
+func (m *ListLicensesReply) GetLicenses() []*License {
+	if m != nil {
+		return m.Licenses
+	}
+	return nil
+}
+
+type RemoveLicenseRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveLicenseRequest) Reset()         { *m = RemoveLicenseRequest{} }
+func (m *RemoveLicenseRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveLicenseRequest) ProtoMessage()    {}
+func (*RemoveLicenseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{145}
+}
+
+func (m *RemoveLicenseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveLicenseRequest.Unmarshal(m, b)
+}
+func (m *RemoveLicenseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveLicenseRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveLicenseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveLicenseRequest.Merge(m, src)
+}
+func (m *RemoveLicenseRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveLicenseRequest.Size(m)
+}
+func (m *RemoveLicenseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveLicenseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveLicenseRequest proto.InternalMessageInfo
+
+func (m *RemoveLicenseRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RemoveLicenseRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type RemoveLicenseReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveLicenseReply) Reset()         { *m = RemoveLicenseReply{} }
+func (m *RemoveLicenseReply) String() string { return proto.CompactTextString(m) }
+func (*RemoveLicenseReply) ProtoMessage()    {}
+func (*RemoveLicenseReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{146}
+}
+
+func (m *RemoveLicenseReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveLicenseReply.Unmarshal(m, b)
+}
+func (m *RemoveLicenseReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveLicenseReply.Marshal(b, m, deterministic)
+}
+func (m *RemoveLicenseReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveLicenseReply.Merge(m, src)
+}
+func (m *RemoveLicenseReply) XXX_Size() int {
+	return xxx_messageInfo_RemoveLicenseReply.Size(m)
+}
+func (m *RemoveLicenseReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveLicenseReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveLicenseReply proto.InternalMessageInfo
+
+type Version struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Author               string   `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Version) Reset()         { *m = Version{} }
+func (m *Version) String() string { return proto.CompactTextString(m) }
+func (*Version) ProtoMessage()    {}
+func (*Version) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{147}
+}
+
+func (m *Version) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Version.Unmarshal(m, b)
+}
+func (m *Version) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Version.Marshal(b, m, deterministic)
+}
+func (m *Version) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Version.Merge(m, src)
+}
+func (m *Version) XXX_Size() int {
+	return xxx_messageInfo_Version.Size(m)
+}
+func (m *Version) XXX_DiscardUnknown() {
+	xxx_messageInfo_Version.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Version proto.InternalMessageInfo
+
+func (m *Version) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *Version) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *Version) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *Version) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Version) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type ListVersionsRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListVersionsRequest) Reset()         { *m = ListVersionsRequest{} }
+func (m *ListVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListVersionsRequest) ProtoMessage()    {}
+func (*ListVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{148}
+}
+
+func (m *ListVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListVersionsRequest.Unmarshal(m, b)
+}
+func (m *ListVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListVersionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListVersionsRequest.Merge(m, src)
+}
+func (m *ListVersionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListVersionsRequest.Size(m)
+}
+func (m *ListVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListVersionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListVersionsRequest proto.InternalMessageInfo
+
+func (m *ListVersionsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ListVersionsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListVersionsReply struct {
+	Versions             []*Version `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListVersionsReply) Reset()         { *m = ListVersionsReply{} }
+func (m *ListVersionsReply) String() string { return proto.CompactTextString(m) }
+func (*ListVersionsReply) ProtoMessage()    {}
+func (*ListVersionsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{149}
+}
+
+func (m *ListVersionsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListVersionsReply.Unmarshal(m, b)
+}
+func (m *ListVersionsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListVersionsReply.Marshal(b, m, deterministic)
+}
+func (m *ListVersionsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListVersionsReply.Merge(m, src)
+}
+func (m *ListVersionsReply) XXX_Size() int {
+	return xxx_messageInfo_ListVersionsReply.Size(m)
+}
+func (m *ListVersionsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListVersionsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListVersionsReply proto.InternalMessageInfo
+
+func (m *ListVersionsReply) GetVersions() []*Version {
+	if m != nil {
+		return m.Versions
+	}
+	return nil
+}
+
+type RestoreVersionRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	ID                   string   `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Root                 string   `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreVersionRequest) Reset()         { *m = RestoreVersionRequest{} }
+func (m *RestoreVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreVersionRequest) ProtoMessage()    {}
+func (*RestoreVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{150}
+}
+
+func (m *RestoreVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreVersionRequest.Unmarshal(m, b)
+}
+func (m *RestoreVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreVersionRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreVersionRequest.Merge(m, src)
+}
+func (m *RestoreVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreVersionRequest.Size(m)
+}
+func (m *RestoreVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreVersionRequest proto.InternalMessageInfo
+
+func (m *RestoreVersionRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RestoreVersionRequest) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *RestoreVersionRequest) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+type RestoreVersionReply struct {
+	Root                 *Root    `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreVersionReply) Reset()         { *m = RestoreVersionReply{} }
+func (m *RestoreVersionReply) String() string { return proto.CompactTextString(m) }
+func (*RestoreVersionReply) ProtoMessage()    {}
+func (*RestoreVersionReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{151}
+}
+
+func (m *RestoreVersionReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreVersionReply.Unmarshal(m, b)
+}
+func (m *RestoreVersionReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreVersionReply.Marshal(b, m, deterministic)
+}
+func (m *RestoreVersionReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreVersionReply.Merge(m, src)
+}
+func (m *RestoreVersionReply) XXX_Size() int {
+	return xxx_messageInfo_RestoreVersionReply.Size(m)
+}
+func (m *RestoreVersionReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreVersionReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreVersionReply proto.InternalMessageInfo
+
+func (m *RestoreVersionReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type ListHistoryRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListHistoryRequest) Reset()         { *m = ListHistoryRequest{} }
+func (m *ListHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ListHistoryRequest) ProtoMessage()    {}
+func (*ListHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{152}
+}
+
+func (m *ListHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHistoryRequest.Unmarshal(m, b)
+}
+func (m *ListHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ListHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHistoryRequest.Merge(m, src)
+}
+func (m *ListHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ListHistoryRequest.Size(m)
+}
+func (m *ListHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHistoryRequest proto.InternalMessageInfo
+
+func (m *ListHistoryRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ListHistoryRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListHistoryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListHistoryReply struct {
+	Entries              []*ListHistoryReply_Entry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor           string                    `protobuf:"bytes,2,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ListHistoryReply) Reset()         { *m = ListHistoryReply{} }
+func (m *ListHistoryReply) String() string { return proto.CompactTextString(m) }
+func (*ListHistoryReply) ProtoMessage()    {}
+func (*ListHistoryReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{153}
+}
+
+func (m *ListHistoryReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHistoryReply.Unmarshal(m, b)
+}
+func (m *ListHistoryReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHistoryReply.Marshal(b, m, deterministic)
+}
+func (m *ListHistoryReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHistoryReply.Merge(m, src)
+}
+func (m *ListHistoryReply) XXX_Size() int {
+	return xxx_messageInfo_ListHistoryReply.Size(m)
+}
+func (m *ListHistoryReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHistoryReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHistoryReply proto.InternalMessageInfo
+
+func (m *ListHistoryReply) GetEntries() []*ListHistoryReply_Entry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *ListHistoryReply) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type ListHistoryReply_Entry struct {
+	VersionID            string   `protobuf:"bytes,1,opt,name=versionID,proto3" json:"versionID,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Author               string   `protobuf:"bytes,3,opt,name=author,proto3" json:"author,omitempty"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Snapshots            []string `protobuf:"bytes,6,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListHistoryReply_Entry) Reset()         { *m = ListHistoryReply_Entry{} }
+func (m *ListHistoryReply_Entry) String() string { return proto.CompactTextString(m) }
+func (*ListHistoryReply_Entry) ProtoMessage()    {}
+func (*ListHistoryReply_Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{153, 0}
+}
+
+func (m *ListHistoryReply_Entry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListHistoryReply_Entry.Unmarshal(m, b)
+}
+func (m *ListHistoryReply_Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListHistoryReply_Entry.Marshal(b, m, deterministic)
+}
+func (m *ListHistoryReply_Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListHistoryReply_Entry.Merge(m, src)
+}
+func (m *ListHistoryReply_Entry) XXX_Size() int {
+	return xxx_messageInfo_ListHistoryReply_Entry.Size(m)
+}
+func (m *ListHistoryReply_Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListHistoryReply_Entry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListHistoryReply_Entry proto.InternalMessageInfo
+
+func (m *ListHistoryReply_Entry) GetVersionID() string {
+	if m != nil {
+		return m.VersionID
+	}
+	return ""
+}
+
+func (m *ListHistoryReply_Entry) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ListHistoryReply_Entry) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *ListHistoryReply_Entry) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ListHistoryReply_Entry) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ListHistoryReply_Entry) GetSnapshots() []string {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+type Snapshot struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Author               string   `protobuf:"bytes,4,opt,name=author,proto3" json:"author,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Snapshot) Reset()         { *m = Snapshot{} }
+func (m *Snapshot) String() string { return proto.CompactTextString(m) }
+func (*Snapshot) ProtoMessage()    {}
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{154}
+}
+
+func (m *Snapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Snapshot.Unmarshal(m, b)
+}
+func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Snapshot.Marshal(b, m, deterministic)
+}
+func (m *Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Snapshot.Merge(m, src)
+}
+func (m *Snapshot) XXX_Size() int {
+	return xxx_messageInfo_Snapshot.Size(m)
+}
+func (m *Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Snapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Snapshot proto.InternalMessageInfo
+
+func (m *Snapshot) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Snapshot) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Snapshot) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *Snapshot) GetAuthor() string {
+	if m != nil {
+		return m.Author
+	}
+	return ""
+}
+
+func (m *Snapshot) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type SnapshotBucketRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotBucketRequest) Reset()         { *m = SnapshotBucketRequest{} }
+func (m *SnapshotBucketRequest) String() string { return proto.CompactTextString(m) }
+func (*SnapshotBucketRequest) ProtoMessage()    {}
+func (*SnapshotBucketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{155}
+}
+
+func (m *SnapshotBucketRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnapshotBucketRequest.Unmarshal(m, b)
+}
+func (m *SnapshotBucketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnapshotBucketRequest.Marshal(b, m, deterministic)
+}
+func (m *SnapshotBucketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotBucketRequest.Merge(m, src)
+}
+func (m *SnapshotBucketRequest) XXX_Size() int {
+	return xxx_messageInfo_SnapshotBucketRequest.Size(m)
+}
+func (m *SnapshotBucketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotBucketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotBucketRequest proto.InternalMessageInfo
+
+func (m *SnapshotBucketRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SnapshotBucketRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SnapshotBucketRequest) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type SnapshotBucketReply struct {
+	Snapshot             *Snapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SnapshotBucketReply) Reset()         { *m = SnapshotBucketReply{} }
+func (m *SnapshotBucketReply) String() string { return proto.CompactTextString(m) }
+func (*SnapshotBucketReply) ProtoMessage()    {}
+func (*SnapshotBucketReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{156}
+}
+
+func (m *SnapshotBucketReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnapshotBucketReply.Unmarshal(m, b)
+}
+func (m *SnapshotBucketReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnapshotBucketReply.Marshal(b, m, deterministic)
+}
+func (m *SnapshotBucketReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotBucketReply.Merge(m, src)
+}
+func (m *SnapshotBucketReply) XXX_Size() int {
+	return xxx_messageInfo_SnapshotBucketReply.Size(m)
+}
+func (m *SnapshotBucketReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotBucketReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotBucketReply proto.InternalMessageInfo
+
+func (m *SnapshotBucketReply) GetSnapshot() *Snapshot {
+	if m != nil {
+		return m.Snapshot
+	}
+	return nil
+}
+
+type ListSnapshotsRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSnapshotsRequest) Reset()         { *m = ListSnapshotsRequest{} }
+func (m *ListSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSnapshotsRequest) ProtoMessage()    {}
+func (*ListSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{157}
+}
+
+func (m *ListSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSnapshotsRequest.Unmarshal(m, b)
+}
+func (m *ListSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSnapshotsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSnapshotsRequest.Merge(m, src)
+}
+func (m *ListSnapshotsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSnapshotsRequest.Size(m)
+}
+func (m *ListSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSnapshotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSnapshotsRequest proto.InternalMessageInfo
+
+func (m *ListSnapshotsRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListSnapshotsReply struct {
+	Snapshots            []*Snapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListSnapshotsReply) Reset()         { *m = ListSnapshotsReply{} }
+func (m *ListSnapshotsReply) String() string { return proto.CompactTextString(m) }
+func (*ListSnapshotsReply) ProtoMessage()    {}
+func (*ListSnapshotsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{158}
+}
+
+func (m *ListSnapshotsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSnapshotsReply.Unmarshal(m, b)
+}
+func (m *ListSnapshotsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSnapshotsReply.Marshal(b, m, deterministic)
+}
+func (m *ListSnapshotsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSnapshotsReply.Merge(m, src)
+}
+func (m *ListSnapshotsReply) XXX_Size() int {
+	return xxx_messageInfo_ListSnapshotsReply.Size(m)
+}
+func (m *ListSnapshotsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSnapshotsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSnapshotsReply proto.InternalMessageInfo
+
+func (m *ListSnapshotsReply) GetSnapshots() []*Snapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+type RestoreSnapshotRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Root                 string   `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreSnapshotRequest) Reset()         { *m = RestoreSnapshotRequest{} }
+func (m *RestoreSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreSnapshotRequest) ProtoMessage()    {}
+func (*RestoreSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{159}
+}
+
+func (m *RestoreSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreSnapshotRequest.Unmarshal(m, b)
+}
+func (m *RestoreSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreSnapshotRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreSnapshotRequest.Merge(m, src)
+}
+func (m *RestoreSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreSnapshotRequest.Size(m)
+}
+func (m *RestoreSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreSnapshotRequest proto.InternalMessageInfo
+
+func (m *RestoreSnapshotRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RestoreSnapshotRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RestoreSnapshotRequest) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+type RestoreSnapshotReply struct {
+	Root                 *Root    `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreSnapshotReply) Reset()         { *m = RestoreSnapshotReply{} }
+func (m *RestoreSnapshotReply) String() string { return proto.CompactTextString(m) }
+func (*RestoreSnapshotReply) ProtoMessage()    {}
+func (*RestoreSnapshotReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{160}
+}
+
+func (m *RestoreSnapshotReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreSnapshotReply.Unmarshal(m, b)
+}
+func (m *RestoreSnapshotReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreSnapshotReply.Marshal(b, m, deterministic)
+}
+func (m *RestoreSnapshotReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreSnapshotReply.Merge(m, src)
+}
+func (m *RestoreSnapshotReply) XXX_Size() int {
+	return xxx_messageInfo_RestoreSnapshotReply.Size(m)
+}
+func (m *RestoreSnapshotReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreSnapshotReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreSnapshotReply proto.InternalMessageInfo
+
+func (m *RestoreSnapshotReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type RemoveSnapshotRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveSnapshotRequest) Reset()         { *m = RemoveSnapshotRequest{} }
+func (m *RemoveSnapshotRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveSnapshotRequest) ProtoMessage()    {}
+func (*RemoveSnapshotRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{161}
+}
+
+func (m *RemoveSnapshotRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveSnapshotRequest.Unmarshal(m, b)
+}
+func (m *RemoveSnapshotRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveSnapshotRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveSnapshotRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSnapshotRequest.Merge(m, src)
+}
+func (m *RemoveSnapshotRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveSnapshotRequest.Size(m)
+}
+func (m *RemoveSnapshotRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSnapshotRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveSnapshotRequest proto.InternalMessageInfo
+
+func (m *RemoveSnapshotRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RemoveSnapshotRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RemoveSnapshotReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveSnapshotReply) Reset()         { *m = RemoveSnapshotReply{} }
+func (m *RemoveSnapshotReply) String() string { return proto.CompactTextString(m) }
+func (*RemoveSnapshotReply) ProtoMessage()    {}
+func (*RemoveSnapshotReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{162}
+}
+
+func (m *RemoveSnapshotReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveSnapshotReply.Unmarshal(m, b)
+}
+func (m *RemoveSnapshotReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveSnapshotReply.Marshal(b, m, deterministic)
+}
+func (m *RemoveSnapshotReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSnapshotReply.Merge(m, src)
+}
+func (m *RemoveSnapshotReply) XXX_Size() int {
+	return xxx_messageInfo_RemoveSnapshotReply.Size(m)
+}
+func (m *RemoveSnapshotReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSnapshotReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveSnapshotReply proto.InternalMessageInfo
+
+type ArchiveRequest struct {
+	Key                  string          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Options              *ArchiveOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ArchiveRequest) Reset()         { *m = ArchiveRequest{} }
+func (m *ArchiveRequest) String() string { return proto.CompactTextString(m) }
+func (*ArchiveRequest) ProtoMessage()    {}
+func (*ArchiveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{163}
+}
+
+func (m *ArchiveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveRequest.Unmarshal(m, b)
+}
+func (m *ArchiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveRequest.Marshal(b, m, deterministic)
+}
+func (m *ArchiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveRequest.Merge(m, src)
+}
+func (m *ArchiveRequest) XXX_Size() int {
+	return xxx_messageInfo_ArchiveRequest.Size(m)
+}
+func (m *ArchiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveRequest proto.InternalMessageInfo
+
+func (m *ArchiveRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ArchiveRequest) GetOptions() *ArchiveOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type ArchiveOptions struct {
+	TrustedMiners        []string `protobuf:"bytes,1,rep,name=trustedMiners,proto3" json:"trustedMiners,omitempty"`
+	ExcludedMiners       []string `protobuf:"bytes,2,rep,name=excludedMiners,proto3" json:"excludedMiners,omitempty"`
+	CountryCodes         []string `protobuf:"bytes,3,rep,name=countryCodes,proto3" json:"countryCodes,omitempty"`
+	MaxPrice             uint64   `protobuf:"varint,4,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	RepFactor            int32    `protobuf:"varint,5,opt,name=repFactor,proto3" json:"repFactor,omitempty"`
+	DealMinDuration      int64    `protobuf:"varint,6,opt,name=dealMinDuration,proto3" json:"dealMinDuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveOptions) Reset()         { *m = ArchiveOptions{} }
+func (m *ArchiveOptions) String() string { return proto.CompactTextString(m) }
+func (*ArchiveOptions) ProtoMessage()    {}
+func (*ArchiveOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{164}
+}
+
+func (m *ArchiveOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveOptions.Unmarshal(m, b)
+}
+func (m *ArchiveOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveOptions.Marshal(b, m, deterministic)
+}
+func (m *ArchiveOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveOptions.Merge(m, src)
+}
+func (m *ArchiveOptions) XXX_Size() int {
+	return xxx_messageInfo_ArchiveOptions.Size(m)
+}
+func (m *ArchiveOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveOptions proto.InternalMessageInfo
+
+func (m *ArchiveOptions) GetTrustedMiners() []string {
+	if m != nil {
+		return m.TrustedMiners
+	}
+	return nil
+}
+
+func (m *ArchiveOptions) GetExcludedMiners() []string {
+	if m != nil {
+		return m.ExcludedMiners
+	}
+	return nil
+}
+
+func (m *ArchiveOptions) GetCountryCodes() []string {
+	if m != nil {
+		return m.CountryCodes
+	}
+	return nil
+}
+
+func (m *ArchiveOptions) GetMaxPrice() uint64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *ArchiveOptions) GetRepFactor() int32 {
+	if m != nil {
+		return m.RepFactor
+	}
+	return 0
+}
+
+func (m *ArchiveOptions) GetDealMinDuration() int64 {
+	if m != nil {
+		return m.DealMinDuration
+	}
+	return 0
+}
+
+type ArchiveReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveReply) Reset()         { *m = ArchiveReply{} }
+func (m *ArchiveReply) String() string { return proto.CompactTextString(m) }
+func (*ArchiveReply) ProtoMessage()    {}
+func (*ArchiveReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{165}
+}
+
+func (m *ArchiveReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveReply.Unmarshal(m, b)
+}
+func (m *ArchiveReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveReply.Marshal(b, m, deterministic)
+}
+func (m *ArchiveReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveReply.Merge(m, src)
+}
+func (m *ArchiveReply) XXX_Size() int {
+	return xxx_messageInfo_ArchiveReply.Size(m)
+}
+func (m *ArchiveReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveReply proto.InternalMessageInfo
+
+type ArchiveStatusRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveStatusRequest) Reset()         { *m = ArchiveStatusRequest{} }
+func (m *ArchiveStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*ArchiveStatusRequest) ProtoMessage()    {}
+func (*ArchiveStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{166}
+}
+
+func (m *ArchiveStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveStatusRequest.Unmarshal(m, b)
+}
+func (m *ArchiveStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *ArchiveStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveStatusRequest.Merge(m, src)
+}
+func (m *ArchiveStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_ArchiveStatusRequest.Size(m)
+}
+func (m *ArchiveStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveStatusRequest proto.InternalMessageInfo
+
+func (m *ArchiveStatusRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ArchiveStatusReply struct {
+	Key                  string                    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Status               ArchiveStatusReply_Status `protobuf:"varint,2,opt,name=status,proto3,enum=buckets.pb.ArchiveStatusReply_Status" json:"status,omitempty"`
+	FailedMsg            string                    `protobuf:"bytes,3,opt,name=failedMsg,proto3" json:"failedMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ArchiveStatusReply) Reset()         { *m = ArchiveStatusReply{} }
+func (m *ArchiveStatusReply) String() string { return proto.CompactTextString(m) }
+func (*ArchiveStatusReply) ProtoMessage()    {}
+func (*ArchiveStatusReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{167}
+}
+
+func (m *ArchiveStatusReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveStatusReply.Unmarshal(m, b)
+}
+func (m *ArchiveStatusReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveStatusReply.Marshal(b, m, deterministic)
+}
+func (m *ArchiveStatusReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveStatusReply.Merge(m, src)
+}
+func (m *ArchiveStatusReply) XXX_Size() int {
+	return xxx_messageInfo_ArchiveStatusReply.Size(m)
+}
+func (m *ArchiveStatusReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveStatusReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveStatusReply proto.InternalMessageInfo
+
+func (m *ArchiveStatusReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ArchiveStatusReply) GetStatus() ArchiveStatusReply_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ArchiveStatusReply_Executing
+}
+
+func (m *ArchiveStatusReply) GetFailedMsg() string {
+	if m != nil {
+		return m.FailedMsg
+	}
+	return ""
+}
+
+type ArchiveInfoRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveInfoRequest) Reset()         { *m = ArchiveInfoRequest{} }
+func (m *ArchiveInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*ArchiveInfoRequest) ProtoMessage()    {}
+func (*ArchiveInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{168}
+}
+
+func (m *ArchiveInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveInfoRequest.Unmarshal(m, b)
+}
+func (m *ArchiveInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *ArchiveInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveInfoRequest.Merge(m, src)
+}
+func (m *ArchiveInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_ArchiveInfoRequest.Size(m)
+}
+func (m *ArchiveInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveInfoRequest proto.InternalMessageInfo
+
+func (m *ArchiveInfoRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ArchiveInfoReply struct {
+	Key                  string                    `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Archive              *ArchiveInfoReply_Archive `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"`
+	Schedule             *ArchiveSchedule          `protobuf:"bytes,3,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Renewal              *ArchiveRenewal           `protobuf:"bytes,4,opt,name=renewal,proto3" json:"renewal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ArchiveInfoReply) Reset()         { *m = ArchiveInfoReply{} }
+func (m *ArchiveInfoReply) String() string { return proto.CompactTextString(m) }
+func (*ArchiveInfoReply) ProtoMessage()    {}
+func (*ArchiveInfoReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{169}
+}
+
+func (m *ArchiveInfoReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveInfoReply.Unmarshal(m, b)
+}
+func (m *ArchiveInfoReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveInfoReply.Marshal(b, m, deterministic)
+}
+func (m *ArchiveInfoReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveInfoReply.Merge(m, src)
+}
+func (m *ArchiveInfoReply) XXX_Size() int {
+	return xxx_messageInfo_ArchiveInfoReply.Size(m)
+}
+func (m *ArchiveInfoReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveInfoReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveInfoReply proto.InternalMessageInfo
+
+func (m *ArchiveInfoReply) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ArchiveInfoReply) GetArchive() *ArchiveInfoReply_Archive {
+	if m != nil {
+		return m.Archive
+	}
+	return nil
+}
+
+func (m *ArchiveInfoReply) GetSchedule() *ArchiveSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *ArchiveInfoReply) GetRenewal() *ArchiveRenewal {
+	if m != nil {
+		return m.Renewal
+	}
+	return nil
+}
+
+type ArchiveInfoReply_Archive struct {
+	Cid                  string                           `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Deals                []*ArchiveInfoReply_Archive_Deal `protobuf:"bytes,2,rep,name=deals,proto3" json:"deals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ArchiveInfoReply_Archive) Reset()         { *m = ArchiveInfoReply_Archive{} }
+func (m *ArchiveInfoReply_Archive) String() string { return proto.CompactTextString(m) }
+func (*ArchiveInfoReply_Archive) ProtoMessage()    {}
+func (*ArchiveInfoReply_Archive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{169, 0}
+}
+
+func (m *ArchiveInfoReply_Archive) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveInfoReply_Archive.Unmarshal(m, b)
+}
+func (m *ArchiveInfoReply_Archive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveInfoReply_Archive.Marshal(b, m, deterministic)
+}
+func (m *ArchiveInfoReply_Archive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveInfoReply_Archive.Merge(m, src)
+}
+func (m *ArchiveInfoReply_Archive) XXX_Size() int {
+	return xxx_messageInfo_ArchiveInfoReply_Archive.Size(m)
+}
+func (m *ArchiveInfoReply_Archive) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveInfoReply_Archive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveInfoReply_Archive proto.InternalMessageInfo
+
+func (m *ArchiveInfoReply_Archive) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *ArchiveInfoReply_Archive) GetDeals() []*ArchiveInfoReply_Archive_Deal {
+	if m != nil {
+		return m.Deals
+	}
+	return nil
+}
+
+type ArchiveInfoReply_Archive_Deal struct {
+	ProposalCid          string   `protobuf:"bytes,1,opt,name=proposalCid,proto3" json:"proposalCid,omitempty"`
+	Miner                string   `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveInfoReply_Archive_Deal) Reset()         { *m = ArchiveInfoReply_Archive_Deal{} }
+func (m *ArchiveInfoReply_Archive_Deal) String() string { return proto.CompactTextString(m) }
+func (*ArchiveInfoReply_Archive_Deal) ProtoMessage()    {}
+func (*ArchiveInfoReply_Archive_Deal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{169, 0, 0}
+}
+
+func (m *ArchiveInfoReply_Archive_Deal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveInfoReply_Archive_Deal.Unmarshal(m, b)
+}
+func (m *ArchiveInfoReply_Archive_Deal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveInfoReply_Archive_Deal.Marshal(b, m, deterministic)
+}
+func (m *ArchiveInfoReply_Archive_Deal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveInfoReply_Archive_Deal.Merge(m, src)
+}
+func (m *ArchiveInfoReply_Archive_Deal) XXX_Size() int {
+	return xxx_messageInfo_ArchiveInfoReply_Archive_Deal.Size(m)
+}
+func (m *ArchiveInfoReply_Archive_Deal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveInfoReply_Archive_Deal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveInfoReply_Archive_Deal proto.InternalMessageInfo
+
+func (m *ArchiveInfoReply_Archive_Deal) GetProposalCid() string {
+	if m != nil {
+		return m.ProposalCid
+	}
+	return ""
+}
+
+func (m *ArchiveInfoReply_Archive_Deal) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+type ArchiveSchedule struct {
+	Interval             int64                  `protobuf:"varint,1,opt,name=interval,proto3" json:"interval,omitempty"`
+	EveryChanges         int64                  `protobuf:"varint,2,opt,name=everyChanges,proto3" json:"everyChanges,omitempty"`
+	Changes              int64                  `protobuf:"varint,3,opt,name=changes,proto3" json:"changes,omitempty"`
+	NextRunAt            int64                  `protobuf:"varint,4,opt,name=nextRunAt,proto3" json:"nextRunAt,omitempty"`
+	History              []*ArchiveSchedule_Run `protobuf:"bytes,5,rep,name=history,proto3" json:"history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *ArchiveSchedule) Reset()         { *m = ArchiveSchedule{} }
+func (m *ArchiveSchedule) String() string { return proto.CompactTextString(m) }
+func (*ArchiveSchedule) ProtoMessage()    {}
+func (*ArchiveSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{170}
+}
+
+func (m *ArchiveSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveSchedule.Unmarshal(m, b)
+}
+func (m *ArchiveSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveSchedule.Marshal(b, m, deterministic)
+}
+func (m *ArchiveSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveSchedule.Merge(m, src)
+}
+func (m *ArchiveSchedule) XXX_Size() int {
+	return xxx_messageInfo_ArchiveSchedule.Size(m)
+}
+func (m *ArchiveSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveSchedule proto.InternalMessageInfo
+
+func (m *ArchiveSchedule) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *ArchiveSchedule) GetEveryChanges() int64 {
+	if m != nil {
+		return m.EveryChanges
+	}
+	return 0
+}
+
+func (m *ArchiveSchedule) GetChanges() int64 {
+	if m != nil {
+		return m.Changes
+	}
+	return 0
+}
+
+func (m *ArchiveSchedule) GetNextRunAt() int64 {
+	if m != nil {
+		return m.NextRunAt
+	}
+	return 0
+}
+
+func (m *ArchiveSchedule) GetHistory() []*ArchiveSchedule_Run {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+type ArchiveSchedule_Run struct {
+	RanAt                int64    `protobuf:"varint,1,opt,name=ranAt,proto3" json:"ranAt,omitempty"`
+	Cid                  string   `protobuf:"bytes,2,opt,name=cid,proto3" json:"cid,omitempty"`
+	JobId                string   `protobuf:"bytes,3,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Skipped              bool     `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveSchedule_Run) Reset()         { *m = ArchiveSchedule_Run{} }
+func (m *ArchiveSchedule_Run) String() string { return proto.CompactTextString(m) }
+func (*ArchiveSchedule_Run) ProtoMessage()    {}
+func (*ArchiveSchedule_Run) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{170, 0}
+}
+
+func (m *ArchiveSchedule_Run) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveSchedule_Run.Unmarshal(m, b)
+}
+func (m *ArchiveSchedule_Run) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveSchedule_Run.Marshal(b, m, deterministic)
+}
+func (m *ArchiveSchedule_Run) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveSchedule_Run.Merge(m, src)
+}
+func (m *ArchiveSchedule_Run) XXX_Size() int {
+	return xxx_messageInfo_ArchiveSchedule_Run.Size(m)
+}
+func (m *ArchiveSchedule_Run) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveSchedule_Run.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveSchedule_Run proto.InternalMessageInfo
+
+func (m *ArchiveSchedule_Run) GetRanAt() int64 {
+	if m != nil {
+		return m.RanAt
+	}
+	return 0
+}
+
+func (m *ArchiveSchedule_Run) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *ArchiveSchedule_Run) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *ArchiveSchedule_Run) GetSkipped() bool {
+	if m != nil {
+		return m.Skipped
+	}
+	return false
+}
+
+func (m *ArchiveSchedule_Run) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type SetArchiveScheduleRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Interval             int64    `protobuf:"varint,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	EveryChanges         int64    `protobuf:"varint,3,opt,name=everyChanges,proto3" json:"everyChanges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetArchiveScheduleRequest) Reset()         { *m = SetArchiveScheduleRequest{} }
+func (m *SetArchiveScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetArchiveScheduleRequest) ProtoMessage()    {}
+func (*SetArchiveScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{171}
+}
+
+func (m *SetArchiveScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetArchiveScheduleRequest.Unmarshal(m, b)
+}
+func (m *SetArchiveScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetArchiveScheduleRequest.Marshal(b, m, deterministic)
+}
+func (m *SetArchiveScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetArchiveScheduleRequest.Merge(m, src)
+}
+func (m *SetArchiveScheduleRequest) XXX_Size() int {
+	return xxx_messageInfo_SetArchiveScheduleRequest.Size(m)
+}
+func (m *SetArchiveScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetArchiveScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetArchiveScheduleRequest proto.InternalMessageInfo
+
+func (m *SetArchiveScheduleRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetArchiveScheduleRequest) GetInterval() int64 {
+	if m != nil {
+		return m.Interval
+	}
+	return 0
+}
+
+func (m *SetArchiveScheduleRequest) GetEveryChanges() int64 {
+	if m != nil {
+		return m.EveryChanges
+	}
+	return 0
+}
+
+type SetArchiveScheduleReply struct {
+	Schedule             *ArchiveSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetArchiveScheduleReply) Reset()         { *m = SetArchiveScheduleReply{} }
+func (m *SetArchiveScheduleReply) String() string { return proto.CompactTextString(m) }
+func (*SetArchiveScheduleReply) ProtoMessage()    {}
+func (*SetArchiveScheduleReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{172}
+}
+
+func (m *SetArchiveScheduleReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetArchiveScheduleReply.Unmarshal(m, b)
+}
+func (m *SetArchiveScheduleReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetArchiveScheduleReply.Marshal(b, m, deterministic)
+}
+func (m *SetArchiveScheduleReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetArchiveScheduleReply.Merge(m, src)
+}
+func (m *SetArchiveScheduleReply) XXX_Size() int {
+	return xxx_messageInfo_SetArchiveScheduleReply.Size(m)
+}
+func (m *SetArchiveScheduleReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetArchiveScheduleReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetArchiveScheduleReply proto.InternalMessageInfo
+
+func (m *SetArchiveScheduleReply) GetSchedule() *ArchiveSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+type ArchiveRenewal struct {
+	Threshold            int64    `protobuf:"varint,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ExpiryEpoch          uint64   `protobuf:"varint,2,opt,name=expiryEpoch,proto3" json:"expiryEpoch,omitempty"`
+	Unfunded             bool     `protobuf:"varint,3,opt,name=unfunded,proto3" json:"unfunded,omitempty"`
+	LastCheckedAt        int64    `protobuf:"varint,4,opt,name=lastCheckedAt,proto3" json:"lastCheckedAt,omitempty"`
+	NextCheckAt          int64    `protobuf:"varint,5,opt,name=nextCheckAt,proto3" json:"nextCheckAt,omitempty"`
+	LastError            string   `protobuf:"bytes,6,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveRenewal) Reset()         { *m = ArchiveRenewal{} }
+func (m *ArchiveRenewal) String() string { return proto.CompactTextString(m) }
+func (*ArchiveRenewal) ProtoMessage()    {}
+func (*ArchiveRenewal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{173}
+}
+
+func (m *ArchiveRenewal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveRenewal.Unmarshal(m, b)
+}
+func (m *ArchiveRenewal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveRenewal.Marshal(b, m, deterministic)
+}
+func (m *ArchiveRenewal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveRenewal.Merge(m, src)
+}
+func (m *ArchiveRenewal) XXX_Size() int {
+	return xxx_messageInfo_ArchiveRenewal.Size(m)
+}
+func (m *ArchiveRenewal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveRenewal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveRenewal proto.InternalMessageInfo
+
+func (m *ArchiveRenewal) GetThreshold() int64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ArchiveRenewal) GetExpiryEpoch() uint64 {
+	if m != nil {
+		return m.ExpiryEpoch
+	}
+	return 0
+}
+
+func (m *ArchiveRenewal) GetUnfunded() bool {
+	if m != nil {
+		return m.Unfunded
+	}
+	return false
+}
+
+func (m *ArchiveRenewal) GetLastCheckedAt() int64 {
+	if m != nil {
+		return m.LastCheckedAt
+	}
+	return 0
+}
+
+func (m *ArchiveRenewal) GetNextCheckAt() int64 {
+	if m != nil {
+		return m.NextCheckAt
+	}
+	return 0
+}
+
+func (m *ArchiveRenewal) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type SetArchiveRenewalRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Threshold            int64    `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetArchiveRenewalRequest) Reset()         { *m = SetArchiveRenewalRequest{} }
+func (m *SetArchiveRenewalRequest) String() string { return proto.CompactTextString(m) }
+func (*SetArchiveRenewalRequest) ProtoMessage()    {}
+func (*SetArchiveRenewalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{174}
+}
+
+func (m *SetArchiveRenewalRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetArchiveRenewalRequest.Unmarshal(m, b)
+}
+func (m *SetArchiveRenewalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetArchiveRenewalRequest.Marshal(b, m, deterministic)
+}
+func (m *SetArchiveRenewalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetArchiveRenewalRequest.Merge(m, src)
+}
+func (m *SetArchiveRenewalRequest) XXX_Size() int {
+	return xxx_messageInfo_SetArchiveRenewalRequest.Size(m)
+}
+func (m *SetArchiveRenewalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetArchiveRenewalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetArchiveRenewalRequest proto.InternalMessageInfo
+
+func (m *SetArchiveRenewalRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetArchiveRenewalRequest) GetThreshold() int64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+type SetArchiveRenewalReply struct {
+	Renewal              *ArchiveRenewal `protobuf:"bytes,1,opt,name=renewal,proto3" json:"renewal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SetArchiveRenewalReply) Reset()         { *m = SetArchiveRenewalReply{} }
+func (m *SetArchiveRenewalReply) String() string { return proto.CompactTextString(m) }
+func (*SetArchiveRenewalReply) ProtoMessage()    {}
+func (*SetArchiveRenewalReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{175}
+}
+
+func (m *SetArchiveRenewalReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetArchiveRenewalReply.Unmarshal(m, b)
+}
+func (m *SetArchiveRenewalReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetArchiveRenewalReply.Marshal(b, m, deterministic)
+}
+func (m *SetArchiveRenewalReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetArchiveRenewalReply.Merge(m, src)
+}
+func (m *SetArchiveRenewalReply) XXX_Size() int {
+	return xxx_messageInfo_SetArchiveRenewalReply.Size(m)
+}
+func (m *SetArchiveRenewalReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetArchiveRenewalReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetArchiveRenewalReply proto.InternalMessageInfo
+
+func (m *SetArchiveRenewalReply) GetRenewal() *ArchiveRenewal {
+	if m != nil {
+		return m.Renewal
+	}
+	return nil
+}
+
+type ArchiveListRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveListRequest) Reset()         { *m = ArchiveListRequest{} }
+func (m *ArchiveListRequest) String() string { return proto.CompactTextString(m) }
+func (*ArchiveListRequest) ProtoMessage()    {}
+func (*ArchiveListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{176}
+}
+
+func (m *ArchiveListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveListRequest.Unmarshal(m, b)
+}
+func (m *ArchiveListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveListRequest.Marshal(b, m, deterministic)
+}
+func (m *ArchiveListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveListRequest.Merge(m, src)
+}
+func (m *ArchiveListRequest) XXX_Size() int {
+	return xxx_messageInfo_ArchiveListRequest.Size(m)
+}
+func (m *ArchiveListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveListRequest proto.InternalMessageInfo
+
+func (m *ArchiveListRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ArchiveListReply struct {
+	Archives             []*ArchiveListReply_Archive `protobuf:"bytes,1,rep,name=archives,proto3" json:"archives,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *ArchiveListReply) Reset()         { *m = ArchiveListReply{} }
+func (m *ArchiveListReply) String() string { return proto.CompactTextString(m) }
+func (*ArchiveListReply) ProtoMessage()    {}
+func (*ArchiveListReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{177}
+}
+
+func (m *ArchiveListReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveListReply.Unmarshal(m, b)
+}
+func (m *ArchiveListReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveListReply.Marshal(b, m, deterministic)
+}
+func (m *ArchiveListReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveListReply.Merge(m, src)
+}
+func (m *ArchiveListReply) XXX_Size() int {
+	return xxx_messageInfo_ArchiveListReply.Size(m)
+}
+func (m *ArchiveListReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveListReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveListReply proto.InternalMessageInfo
+
+func (m *ArchiveListReply) GetArchives() []*ArchiveListReply_Archive {
+	if m != nil {
+		return m.Archives
+	}
+	return nil
+}
+
+type ArchiveListReply_Archive struct {
+	Cid                  string                           `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	JobId                string                           `protobuf:"bytes,2,opt,name=jobId,proto3" json:"jobId,omitempty"`
+	Status               ArchiveStatusReply_Status        `protobuf:"varint,3,opt,name=status,proto3,enum=buckets.pb.ArchiveStatusReply_Status" json:"status,omitempty"`
+	FailedMsg            string                           `protobuf:"bytes,4,opt,name=failedMsg,proto3" json:"failedMsg,omitempty"`
+	Aborted              bool                             `protobuf:"varint,5,opt,name=aborted,proto3" json:"aborted,omitempty"`
+	AbortedMsg           string                           `protobuf:"bytes,6,opt,name=abortedMsg,proto3" json:"abortedMsg,omitempty"`
+	CreatedAt            int64                            `protobuf:"varint,7,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	Current              bool                             `protobuf:"varint,8,opt,name=current,proto3" json:"current,omitempty"`
+	Deals                []*ArchiveListReply_Archive_Deal `protobuf:"bytes,9,rep,name=deals,proto3" json:"deals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *ArchiveListReply_Archive) Reset()         { *m = ArchiveListReply_Archive{} }
+func (m *ArchiveListReply_Archive) String() string { return proto.CompactTextString(m) }
+func (*ArchiveListReply_Archive) ProtoMessage()    {}
+func (*ArchiveListReply_Archive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{177, 0}
+}
+
+func (m *ArchiveListReply_Archive) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveListReply_Archive.Unmarshal(m, b)
+}
+func (m *ArchiveListReply_Archive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveListReply_Archive.Marshal(b, m, deterministic)
+}
+func (m *ArchiveListReply_Archive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveListReply_Archive.Merge(m, src)
+}
+func (m *ArchiveListReply_Archive) XXX_Size() int {
+	return xxx_messageInfo_ArchiveListReply_Archive.Size(m)
+}
+func (m *ArchiveListReply_Archive) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveListReply_Archive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveListReply_Archive proto.InternalMessageInfo
+
+func (m *ArchiveListReply_Archive) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+func (m *ArchiveListReply_Archive) GetJobId() string {
+	if m != nil {
+		return m.JobId
+	}
+	return ""
+}
+
+func (m *ArchiveListReply_Archive) GetStatus() ArchiveStatusReply_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ArchiveStatusReply_Executing
+}
+
+func (m *ArchiveListReply_Archive) GetFailedMsg() string {
+	if m != nil {
+		return m.FailedMsg
+	}
+	return ""
+}
+
+func (m *ArchiveListReply_Archive) GetAborted() bool {
+	if m != nil {
+		return m.Aborted
+	}
+	return false
+}
+
+func (m *ArchiveListReply_Archive) GetAbortedMsg() string {
+	if m != nil {
+		return m.AbortedMsg
+	}
+	return ""
+}
+
+func (m *ArchiveListReply_Archive) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ArchiveListReply_Archive) GetCurrent() bool {
+	if m != nil {
+		return m.Current
+	}
+	return false
+}
+
+func (m *ArchiveListReply_Archive) GetDeals() []*ArchiveListReply_Archive_Deal {
+	if m != nil {
+		return m.Deals
+	}
+	return nil
+}
+
+type ArchiveListReply_Archive_Deal struct {
+	ProposalCid          string   `protobuf:"bytes,1,opt,name=proposalCid,proto3" json:"proposalCid,omitempty"`
+	Miner                string   `protobuf:"bytes,2,opt,name=miner,proto3" json:"miner,omitempty"`
+	DealId               uint64   `protobuf:"varint,3,opt,name=dealId,proto3" json:"dealId,omitempty"`
+	State                string   `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Pending              bool     `protobuf:"varint,5,opt,name=pending,proto3" json:"pending,omitempty"`
+	PricePerEpoch        uint64   `protobuf:"varint,6,opt,name=pricePerEpoch,proto3" json:"pricePerEpoch,omitempty"`
+	StartEpoch           uint64   `protobuf:"varint,7,opt,name=startEpoch,proto3" json:"startEpoch,omitempty"`
+	Duration             uint64   `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
+	ActivationEpoch      int64    `protobuf:"varint,9,opt,name=activationEpoch,proto3" json:"activationEpoch,omitempty"`
+	ExpiryEpoch          uint64   `protobuf:"varint,10,opt,name=expiryEpoch,proto3" json:"expiryEpoch,omitempty"`
+	Message              string   `protobuf:"bytes,11,opt,name=message,proto3" json:"message,omitempty"`
+	UpdatedAt            int64    `protobuf:"varint,12,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveListReply_Archive_Deal) Reset()         { *m = ArchiveListReply_Archive_Deal{} }
+func (m *ArchiveListReply_Archive_Deal) String() string { return proto.CompactTextString(m) }
+func (*ArchiveListReply_Archive_Deal) ProtoMessage()    {}
+func (*ArchiveListReply_Archive_Deal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{177, 0, 0}
+}
+
+func (m *ArchiveListReply_Archive_Deal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveListReply_Archive_Deal.Unmarshal(m, b)
+}
+func (m *ArchiveListReply_Archive_Deal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveListReply_Archive_Deal.Marshal(b, m, deterministic)
+}
+func (m *ArchiveListReply_Archive_Deal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveListReply_Archive_Deal.Merge(m, src)
+}
+func (m *ArchiveListReply_Archive_Deal) XXX_Size() int {
+	return xxx_messageInfo_ArchiveListReply_Archive_Deal.Size(m)
+}
+func (m *ArchiveListReply_Archive_Deal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveListReply_Archive_Deal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveListReply_Archive_Deal proto.InternalMessageInfo
+
+func (m *ArchiveListReply_Archive_Deal) GetProposalCid() string {
+	if m != nil {
+		return m.ProposalCid
+	}
+	return ""
+}
+
+func (m *ArchiveListReply_Archive_Deal) GetMiner() string {
+	if m != nil {
+		return m.Miner
+	}
+	return ""
+}
+
+func (m *ArchiveListReply_Archive_Deal) GetDealId() uint64 {
+	if m != nil {
+		return m.DealId
+	}
+	return 0
+}
+
+func (m *ArchiveListReply_Archive_Deal) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ArchiveListReply_Archive_Deal) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+func (m *ArchiveListReply_Archive_Deal) GetPricePerEpoch() uint64 {
+	if m != nil {
+		return m.PricePerEpoch
+	}
+	return 0
+}
+
+func (m *ArchiveListReply_Archive_Deal) GetStartEpoch() uint64 {
+	if m != nil {
+		return m.StartEpoch
+	}
+	return 0
+}
+
+func (m *ArchiveListReply_Archive_Deal) GetDuration() uint64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ArchiveListReply_Archive_Deal) GetActivationEpoch() int64 {
+	if m != nil {
+		return m.ActivationEpoch
+	}
+	return 0
+}
+
+func (m *ArchiveListReply_Archive_Deal) GetExpiryEpoch() uint64 {
+	if m != nil {
+		return m.ExpiryEpoch
+	}
+	return 0
+}
+
+func (m *ArchiveListReply_Archive_Deal) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ArchiveListReply_Archive_Deal) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type RestoreArchiveRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	NewBucket            bool     `protobuf:"varint,2,opt,name=newBucket,proto3" json:"newBucket,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreArchiveRequest) Reset()         { *m = RestoreArchiveRequest{} }
+func (m *RestoreArchiveRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreArchiveRequest) ProtoMessage()    {}
+func (*RestoreArchiveRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{178}
+}
+
+func (m *RestoreArchiveRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreArchiveRequest.Unmarshal(m, b)
+}
+func (m *RestoreArchiveRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreArchiveRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreArchiveRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreArchiveRequest.Merge(m, src)
+}
+func (m *RestoreArchiveRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreArchiveRequest.Size(m)
+}
+func (m *RestoreArchiveRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreArchiveRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreArchiveRequest proto.InternalMessageInfo
+
+func (m *RestoreArchiveRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *RestoreArchiveRequest) GetNewBucket() bool {
+	if m != nil {
+		return m.NewBucket
+	}
+	return false
+}
+
+func (m *RestoreArchiveRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type RestoreArchiveReply struct {
+	Msg                  string   `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	Root                 *Root    `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreArchiveReply) Reset()         { *m = RestoreArchiveReply{} }
+func (m *RestoreArchiveReply) String() string { return proto.CompactTextString(m) }
+func (*RestoreArchiveReply) ProtoMessage()    {}
+func (*RestoreArchiveReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{179}
+}
+
+func (m *RestoreArchiveReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreArchiveReply.Unmarshal(m, b)
+}
+func (m *RestoreArchiveReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreArchiveReply.Marshal(b, m, deterministic)
+}
+func (m *RestoreArchiveReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreArchiveReply.Merge(m, src)
+}
+func (m *RestoreArchiveReply) XXX_Size() int {
+	return xxx_messageInfo_RestoreArchiveReply.Size(m)
+}
+func (m *RestoreArchiveReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreArchiveReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreArchiveReply proto.InternalMessageInfo
+
+func (m *RestoreArchiveReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *RestoreArchiveReply) GetRoot() *Root {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+type ArchiveWatchRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveWatchRequest) Reset()         { *m = ArchiveWatchRequest{} }
+func (m *ArchiveWatchRequest) String() string { return proto.CompactTextString(m) }
+func (*ArchiveWatchRequest) ProtoMessage()    {}
+func (*ArchiveWatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{180}
+}
+
+func (m *ArchiveWatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveWatchRequest.Unmarshal(m, b)
+}
+func (m *ArchiveWatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveWatchRequest.Marshal(b, m, deterministic)
+}
+func (m *ArchiveWatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveWatchRequest.Merge(m, src)
+}
+func (m *ArchiveWatchRequest) XXX_Size() int {
+	return xxx_messageInfo_ArchiveWatchRequest.Size(m)
+}
+func (m *ArchiveWatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveWatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveWatchRequest proto.InternalMessageInfo
+
+func (m *ArchiveWatchRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ArchiveWatchReply struct {
+	Msg                  string   `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveWatchReply) Reset()         { *m = ArchiveWatchReply{} }
+func (m *ArchiveWatchReply) String() string { return proto.CompactTextString(m) }
+func (*ArchiveWatchReply) ProtoMessage()    {}
+func (*ArchiveWatchReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{181}
+}
+
+func (m *ArchiveWatchReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveWatchReply.Unmarshal(m, b)
+}
+func (m *ArchiveWatchReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveWatchReply.Marshal(b, m, deterministic)
+}
+func (m *ArchiveWatchReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveWatchReply.Merge(m, src)
+}
+func (m *ArchiveWatchReply) XXX_Size() int {
+	return xxx_messageInfo_ArchiveWatchReply.Size(m)
+}
+func (m *ArchiveWatchReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveWatchReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveWatchReply proto.InternalMessageInfo
+
+func (m *ArchiveWatchReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type PushRejection struct {
+	Violations           []*PushRejection_Violation `protobuf:"bytes,1,rep,name=violations,proto3" json:"violations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *PushRejection) Reset()         { *m = PushRejection{} }
+func (m *PushRejection) String() string { return proto.CompactTextString(m) }
+func (*PushRejection) ProtoMessage()    {}
+func (*PushRejection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{182}
+}
+
+func (m *PushRejection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushRejection.Unmarshal(m, b)
+}
+func (m *PushRejection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushRejection.Marshal(b, m, deterministic)
+}
+func (m *PushRejection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushRejection.Merge(m, src)
+}
+func (m *PushRejection) XXX_Size() int {
+	return xxx_messageInfo_PushRejection.Size(m)
+}
+func (m *PushRejection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushRejection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushRejection proto.InternalMessageInfo
+
+func (m *PushRejection) GetViolations() []*PushRejection_Violation {
+	if m != nil {
+		return m.Violations
+	}
+	return nil
+}
+
+type PushRejection_Violation struct {
+	Rule                 string   `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Detail               string   `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushRejection_Violation) Reset()         { *m = PushRejection_Violation{} }
+func (m *PushRejection_Violation) String() string { return proto.CompactTextString(m) }
+func (*PushRejection_Violation) ProtoMessage()    {}
+func (*PushRejection_Violation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95035767e889ecda, []int{182, 0}
+}
+
+func (m *PushRejection_Violation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushRejection_Violation.Unmarshal(m, b)
+}
+func (m *PushRejection_Violation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushRejection_Violation.Marshal(b, m, deterministic)
+}
+func (m *PushRejection_Violation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushRejection_Violation.Merge(m, src)
+}
+func (m *PushRejection_Violation) XXX_Size() int {
+	return xxx_messageInfo_PushRejection_Violation.Size(m)
+}
+func (m *PushRejection_Violation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushRejection_Violation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushRejection_Violation proto.InternalMessageInfo
+
+func (m *PushRejection_Violation) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *PushRejection_Violation) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *PushRejection_Violation) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("buckets.pb.PushPathReply_Event_Stage", PushPathReply_Event_Stage_name, PushPathReply_Event_Stage_value)
+	proto.RegisterEnum("buckets.pb.DiffReply_Change_Type", DiffReply_Change_Type_name, DiffReply_Change_Type_value)
+	proto.RegisterEnum("buckets.pb.BucketImport_Status", BucketImport_Status_name, BucketImport_Status_value)
+	proto.RegisterEnum("buckets.pb.SetConflictStrategyRequest_Strategy", SetConflictStrategyRequest_Strategy_name, SetConflictStrategyRequest_Strategy_value)
+	proto.RegisterEnum("buckets.pb.SearchPathRequest_Mode", SearchPathRequest_Mode_name, SearchPathRequest_Mode_value)
+	proto.RegisterEnum("buckets.pb.ArchiveStatusReply_Status", ArchiveStatusReply_Status_name, ArchiveStatusReply_Status_value)
+	proto.RegisterType((*Root)(nil), "buckets.pb.Root")
+	proto.RegisterMapType((map[string]string)(nil), "buckets.pb.Root.TagsEntry")
+	proto.RegisterType((*ListRequest)(nil), "buckets.pb.ListRequest")
+	proto.RegisterMapType((map[string]string)(nil), "buckets.pb.ListRequest.TagsEntry")
+	proto.RegisterType((*ListReply)(nil), "buckets.pb.ListReply")
+	proto.RegisterType((*InitRequest)(nil), "buckets.pb.InitRequest")
+	proto.RegisterType((*InitReply)(nil), "buckets.pb.InitReply")
+	proto.RegisterType((*RootRequest)(nil), "buckets.pb.RootRequest")
+	proto.RegisterType((*RootReply)(nil), "buckets.pb.RootReply")
+	proto.RegisterType((*LinksRequest)(nil), "buckets.pb.LinksRequest")
+	proto.RegisterType((*LinksReply)(nil), "buckets.pb.LinksReply")
+	proto.RegisterType((*ListPathRequest)(nil), "buckets.pb.ListPathRequest")
+	proto.RegisterType((*ListPathReply)(nil), "buckets.pb.ListPathReply")
+	proto.RegisterType((*ListPathItem)(nil), "buckets.pb.ListPathItem")
+	proto.RegisterType((*Metadata)(nil), "buckets.pb.Metadata")
+	proto.RegisterMapType((map[string]string)(nil), "buckets.pb.Metadata.AttributesEntry")
+	proto.RegisterType((*ListIpfsPathRequest)(nil), "buckets.pb.ListIpfsPathRequest")
+	proto.RegisterType((*ListIpfsPathReply)(nil), "buckets.pb.ListIpfsPathReply")
+	proto.RegisterType((*PushPathRequest)(nil), "buckets.pb.PushPathRequest")
+	proto.RegisterType((*PushPathRequest_Header)(nil), "buckets.pb.PushPathRequest.Header")
+	proto.RegisterMapType((map[string]string)(nil), "buckets.pb.PushPathRequest.Header.AttributesEntry")
+	proto.RegisterType((*PushPathReply)(nil), "buckets.pb.PushPathReply")
+	proto.RegisterType((*PushPathReply_Event)(nil), "buckets.pb.PushPathReply.Event")
+	proto.RegisterType((*PushPathsRequest)(nil), "buckets.pb.PushPathsRequest")
+	proto.RegisterType((*PushPathsRequest_Header)(nil), "buckets.pb.PushPathsRequest.Header")
+	proto.RegisterType((*PushPathsRequest_Chunk)(nil), "buckets.pb.PushPathsRequest.Chunk")
+	proto.RegisterMapType((map[string]string)(nil), "buckets.pb.PushPathsRequest.Chunk.AttributesEntry")
+	proto.RegisterType((*PushPathsReply)(nil), "buckets.pb.PushPathsReply")
+	proto.RegisterType((*StartUploadRequest)(nil), "buckets.pb.StartUploadRequest")
+	proto.RegisterType((*StartUploadReply)(nil), "buckets.pb.StartUploadReply")
+	proto.RegisterType((*UploadStatusRequest)(nil), "buckets.pb.UploadStatusRequest")
+	proto.RegisterType((*UploadStatusReply)(nil), "buckets.pb.UploadStatusReply")
+	proto.RegisterType((*PushUploadRequest)(nil), "buckets.pb.PushUploadRequest")
+	proto.RegisterType((*PushUploadRequest_Header)(nil), "buckets.pb.PushUploadRequest.Header")
+	proto.RegisterType((*PushUploadReply)(nil), "buckets.pb.PushUploadReply")
+	proto.RegisterType((*CompleteUploadRequest)(nil), "buckets.pb.CompleteUploadRequest")
+	proto.RegisterType((*CompleteUploadReply)(nil), "buckets.pb.CompleteUploadReply")
+	proto.RegisterType((*CancelUploadRequest)(nil), "buckets.pb.CancelUploadRequest")
+	proto.RegisterType((*CancelUploadReply)(nil), "buckets.pb.CancelUploadReply")
+	proto.RegisterType((*PullPathRequest)(nil), "buckets.pb.PullPathRequest")
+	proto.RegisterType((*PullPathReply)(nil), "buckets.pb.PullPathReply")
+	proto.RegisterType((*PullIpfsPathRequest)(nil), "buckets.pb.PullIpfsPathRequest")
+	proto.RegisterType((*PullIpfsPathReply)(nil), "buckets.pb.PullIpfsPathReply")
+	proto.RegisterType((*DiffRequest)(nil), "buckets.pb.DiffRequest")
+	proto.RegisterType((*DiffReply)(nil), "buckets.pb.DiffReply")
+	proto.RegisterType((*DiffReply_Change)(nil), "buckets.pb.DiffReply.Change")
+	proto.RegisterType((*GetBlockRequest)(nil), "buckets.pb.GetBlockRequest")
+	proto.RegisterType((*GetBlockReply)(nil), "buckets.pb.GetBlockReply")
+	proto.RegisterType((*HasBlockRequest)(nil), "buckets.pb.HasBlockRequest")
+	proto.RegisterType((*HasBlockReply)(nil), "buckets.pb.HasBlockReply")
+	proto.RegisterType((*PutBlockRequest)(nil), "buckets.pb.PutBlockRequest")
+	proto.RegisterType((*PutBlockReply)(nil), "buckets.pb.PutBlockReply")
+	proto.RegisterType((*ExportBucketRequest)(nil), "buckets.pb.ExportBucketRequest")
+	proto.RegisterType((*ExportBucketReply)(nil), "buckets.pb.ExportBucketReply")
+	proto.RegisterType((*ImportBucketRequest)(nil), "buckets.pb.ImportBucketRequest")
+	proto.RegisterType((*ImportBucketRequest_Header)(nil), "buckets.pb.ImportBucketRequest.Header")
+	proto.RegisterType((*ImportBucketReply)(nil), "buckets.pb.ImportBucketReply")
+	proto.RegisterType((*BucketImport)(nil), "buckets.pb.BucketImport")
+	proto.RegisterType((*StartS3ImportRequest)(nil), "buckets.pb.StartS3ImportRequest")
+	proto.RegisterType((*StartS3ImportReply)(nil), "buckets.pb.StartS3ImportReply")
+	proto.RegisterType((*ListImportsRequest)(nil), "buckets.pb.ListImportsRequest")
+	proto.RegisterType((*ListImportsReply)(nil), "buckets.pb.ListImportsReply")
+	proto.RegisterType((*CancelImportRequest)(nil), "buckets.pb.CancelImportRequest")
+	proto.RegisterType((*CancelImportReply)(nil), "buckets.pb.CancelImportReply")
+	proto.RegisterType((*ImportBucketIPNSKeyRequest)(nil), "buckets.pb.ImportBucketIPNSKeyRequest")
+	proto.RegisterType((*ImportBucketIPNSKeyReply)(nil), "buckets.pb.ImportBucketIPNSKeyReply")
+	proto.RegisterType((*SetPathRequest)(nil), "buckets.pb.SetPathRequest")
+	proto.RegisterType((*SetPathReply)(nil), "buckets.pb.SetPathReply")
+	proto.RegisterType((*RemoveRequest)(nil), "buckets.pb.RemoveRequest")
+	proto.RegisterType((*RemoveReply)(nil), "buckets.pb.RemoveReply")
+	proto.RegisterType((*RemovePathRequest)(nil), "buckets.pb.RemovePathRequest")
+	proto.RegisterType((*RemovePathReply)(nil), "buckets.pb.RemovePathReply")
+	proto.RegisterType((*MovePathRequest)(nil), "buckets.pb.MovePathRequest")
+	proto.RegisterType((*MovePathReply)(nil), "buckets.pb.MovePathReply")
+	proto.RegisterType((*Quota)(nil), "buckets.pb.Quota")
+	proto.RegisterType((*QuotaExceeded)(nil), "buckets.pb.QuotaExceeded")
+	proto.RegisterType((*SetQuotaRequest)(nil), "buckets.pb.SetQuotaRequest")
+	proto.RegisterType((*SetQuotaReply)(nil), "buckets.pb.SetQuotaReply")
+	proto.RegisterType((*GetQuotaRequest)(nil), "buckets.pb.GetQuotaRequest")
+	proto.RegisterType((*GetQuotaReply)(nil), "buckets.pb.GetQuotaReply")
+	proto.RegisterType((*LifecycleRule)(nil), "buckets.pb.LifecycleRule")
+	proto.RegisterType((*LifecycleRule_Status)(nil), "buckets.pb.LifecycleRule.Status")
+	proto.RegisterType((*Lifecycle)(nil), "buckets.pb.Lifecycle")
+	proto.RegisterType((*SetLifecycleRequest)(nil), "buckets.pb.SetLifecycleRequest")
+	proto.RegisterType((*SetLifecycleReply)(nil), "buckets.pb.SetLifecycleReply")
+	proto.RegisterType((*GetLifecycleRequest)(nil), "buckets.pb.GetLifecycleRequest")
+	proto.RegisterType((*GetLifecycleReply)(nil), "buckets.pb.GetLifecycleReply")
+	proto.RegisterType((*Website)(nil), "buckets.pb.Website")
+	proto.RegisterType((*Website_Redirect)(nil), "buckets.pb.Website.Redirect")
+	proto.RegisterType((*Website_Header)(nil), "buckets.pb.Website.Header")
+	proto.RegisterType((*SetWebsiteRequest)(nil), "buckets.pb.SetWebsiteRequest")
+	proto.RegisterType((*SetWebsiteReply)(nil), "buckets.pb.SetWebsiteReply")
+	proto.RegisterType((*GetWebsiteRequest)(nil), "buckets.pb.GetWebsiteRequest")
+	proto.RegisterType((*GetWebsiteReply)(nil), "buckets.pb.GetWebsiteReply")
+	proto.RegisterType((*SetConflictStrategyRequest)(nil), "buckets.pb.SetConflictStrategyRequest")
+	proto.RegisterType((*SetConflictStrategyReply)(nil), "buckets.pb.SetConflictStrategyReply")
+	proto.RegisterType((*GetConflictStrategyRequest)(nil), "buckets.pb.GetConflictStrategyRequest")
+	proto.RegisterType((*GetConflictStrategyReply)(nil), "buckets.pb.GetConflictStrategyReply")
+	proto.RegisterType((*ReplicationTarget)(nil), "buckets.pb.ReplicationTarget")
+	proto.RegisterType((*AddReplicationTargetRequest)(nil), "buckets.pb.AddReplicationTargetRequest")
+	proto.RegisterType((*AddReplicationTargetReply)(nil), "buckets.pb.AddReplicationTargetReply")
+	proto.RegisterType((*ListReplicationTargetsRequest)(nil), "buckets.pb.ListReplicationTargetsRequest")
+	proto.RegisterType((*ListReplicationTargetsReply)(nil), "buckets.pb.ListReplicationTargetsReply")
+	proto.RegisterType((*RemoveReplicationTargetRequest)(nil), "buckets.pb.RemoveReplicationTargetRequest")
+	proto.RegisterType((*RemoveReplicationTargetReply)(nil), "buckets.pb.RemoveReplicationTargetReply")
+	proto.RegisterType((*PinMirror)(nil), "buckets.pb.PinMirror")
+	proto.RegisterType((*AddPinMirrorRequest)(nil), "buckets.pb.AddPinMirrorRequest")
+	proto.RegisterType((*AddPinMirrorReply)(nil), "buckets.pb.AddPinMirrorReply")
+	proto.RegisterType((*ListPinMirrorsRequest)(nil), "buckets.pb.ListPinMirrorsRequest")
+	proto.RegisterType((*ListPinMirrorsReply)(nil), "buckets.pb.ListPinMirrorsReply")
+	proto.RegisterType((*RemovePinMirrorRequest)(nil), "buckets.pb.RemovePinMirrorRequest")
+	proto.RegisterType((*RemovePinMirrorReply)(nil), "buckets.pb.RemovePinMirrorReply")
+	proto.RegisterType((*Domain)(nil), "buckets.pb.Domain")
+	proto.RegisterType((*AddDomainRequest)(nil), "buckets.pb.AddDomainRequest")
+	proto.RegisterType((*AddDomainReply)(nil), "buckets.pb.AddDomainReply")
+	proto.RegisterType((*ListDomainsRequest)(nil), "buckets.pb.ListDomainsRequest")
+	proto.RegisterType((*ListDomainsReply)(nil), "buckets.pb.ListDomainsReply")
+	proto.RegisterType((*VerifyDomainRequest)(nil), "buckets.pb.VerifyDomainRequest")
+	proto.RegisterType((*VerifyDomainReply)(nil), "buckets.pb.VerifyDomainReply")
+	proto.RegisterType((*RemoveDomainRequest)(nil), "buckets.pb.RemoveDomainRequest")
+	proto.RegisterType((*RemoveDomainReply)(nil), "buckets.pb.RemoveDomainReply")
+	proto.RegisterType((*ShareLink)(nil), "buckets.pb.ShareLink")
+	proto.RegisterType((*CreateShareLinkRequest)(nil), "buckets.pb.CreateShareLinkRequest")
+	proto.RegisterType((*CreateShareLinkReply)(nil), "buckets.pb.CreateShareLinkReply")
+	proto.RegisterType((*ListShareLinksRequest)(nil), "buckets.pb.ListShareLinksRequest")
+	proto.RegisterType((*ListShareLinksReply)(nil), "buckets.pb.ListShareLinksReply")
+	proto.RegisterType((*RevokeShareLinkRequest)(nil), "buckets.pb.RevokeShareLinkRequest")
+	proto.RegisterType((*RevokeShareLinkReply)(nil), "buckets.pb.RevokeShareLinkReply")
+	proto.RegisterType((*Webhook)(nil), "buckets.pb.Webhook")
+	proto.RegisterType((*AddWebhookRequest)(nil), "buckets.pb.AddWebhookRequest")
+	proto.RegisterType((*AddWebhookReply)(nil), "buckets.pb.AddWebhookReply")
+	proto.RegisterType((*ListWebhooksRequest)(nil), "buckets.pb.ListWebhooksRequest")
+	proto.RegisterType((*ListWebhooksReply)(nil), "buckets.pb.ListWebhooksReply")
+	proto.RegisterType((*RemoveWebhookRequest)(nil), "buckets.pb.RemoveWebhookRequest")
+	proto.RegisterType((*RemoveWebhookReply)(nil), "buckets.pb.RemoveWebhookReply")
+	proto.RegisterType((*WebhookFailure)(nil), "buckets.pb.WebhookFailure")
+	proto.RegisterType((*ListWebhookFailuresRequest)(nil), "buckets.pb.ListWebhookFailuresRequest")
+	proto.RegisterType((*ListWebhookFailuresReply)(nil), "buckets.pb.ListWebhookFailuresReply")
+	proto.RegisterType((*SearchPathRequest)(nil), "buckets.pb.SearchPathRequest")
+	proto.RegisterType((*SearchPathReply)(nil), "buckets.pb.SearchPathReply")
+	proto.RegisterType((*RenameBucketRequest)(nil), "buckets.pb.RenameBucketRequest")
+	proto.RegisterType((*RenameBucketReply)(nil), "buckets.pb.RenameBucketReply")
+	proto.RegisterType((*CloneBucketRequest)(nil), "buckets.pb.CloneBucketRequest")
+	proto.RegisterType((*CloneBucketReply)(nil), "buckets.pb.CloneBucketReply")
+	proto.RegisterType((*SetPathMetadataRequest)(nil), "buckets.pb.SetPathMetadataRequest")
+	proto.RegisterMapType((map[string]string)(nil), "buckets.pb.SetPathMetadataRequest.AttributesEntry")
+	proto.RegisterType((*SetPathMetadataReply)(nil), "buckets.pb.SetPathMetadataReply")
+	proto.RegisterType((*SetTagsRequest)(nil), "buckets.pb.SetTagsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "buckets.pb.SetTagsRequest.TagsEntry")
+	proto.RegisterType((*SetTagsReply)(nil), "buckets.pb.SetTagsReply")
+	proto.RegisterType((*LegalHold)(nil), "buckets.pb.LegalHold")
+	proto.RegisterType((*SetLegalHoldRequest)(nil), "buckets.pb.SetLegalHoldRequest")
+	proto.RegisterType((*SetLegalHoldReply)(nil), "buckets.pb.SetLegalHoldReply")
+	proto.RegisterType((*GetLegalHoldRequest)(nil), "buckets.pb.GetLegalHoldRequest")
+	proto.RegisterType((*GetLegalHoldReply)(nil), "buckets.pb.GetLegalHoldReply")
+	proto.RegisterType((*License)(nil), "buckets.pb.License")
+	proto.RegisterType((*SetLicenseRequest)(nil), "buckets.pb.SetLicenseRequest")
+	proto.RegisterType((*SetLicenseReply)(nil), "buckets.pb.SetLicenseReply")
+	proto.RegisterType((*GetLicenseRequest)(nil), "buckets.pb.GetLicenseRequest")
+	proto.RegisterType((*GetLicenseReply)(nil), "buckets.pb.GetLicenseReply")
+	proto.RegisterType((*ListLicensesRequest)(nil), "buckets.pb.ListLicensesRequest")
+	proto.RegisterType((*ListLicensesReply)(nil), "buckets.pb.ListLicensesReply")
+	proto.RegisterType((*RemoveLicenseRequest)(nil), "buckets.pb.RemoveLicenseRequest")
+	proto.RegisterType((*RemoveLicenseReply)(nil), "buckets.pb.RemoveLicenseReply")
+	proto.RegisterType((*Version)(nil), "buckets.pb.Version")
+	proto.RegisterType((*ListVersionsRequest)(nil), "buckets.pb.ListVersionsRequest")
+	proto.RegisterType((*ListVersionsReply)(nil), "buckets.pb.ListVersionsReply")
+	proto.RegisterType((*RestoreVersionRequest)(nil), "buckets.pb.RestoreVersionRequest")
+	proto.RegisterType((*RestoreVersionReply)(nil), "buckets.pb.RestoreVersionReply")
+	proto.RegisterType((*ListHistoryRequest)(nil), "buckets.pb.ListHistoryRequest")
+	proto.RegisterType((*ListHistoryReply)(nil), "buckets.pb.ListHistoryReply")
+	proto.RegisterType((*ListHistoryReply_Entry)(nil), "buckets.pb.ListHistoryReply.Entry")
+	proto.RegisterType((*Snapshot)(nil), "buckets.pb.Snapshot")
+	proto.RegisterType((*SnapshotBucketRequest)(nil), "buckets.pb.SnapshotBucketRequest")
+	proto.RegisterType((*SnapshotBucketReply)(nil), "buckets.pb.SnapshotBucketReply")
+	proto.RegisterType((*ListSnapshotsRequest)(nil), "buckets.pb.ListSnapshotsRequest")
+	proto.RegisterType((*ListSnapshotsReply)(nil), "buckets.pb.ListSnapshotsReply")
+	proto.RegisterType((*RestoreSnapshotRequest)(nil), "buckets.pb.RestoreSnapshotRequest")
+	proto.RegisterType((*RestoreSnapshotReply)(nil), "buckets.pb.RestoreSnapshotReply")
+	proto.RegisterType((*RemoveSnapshotRequest)(nil), "buckets.pb.RemoveSnapshotRequest")
+	proto.RegisterType((*RemoveSnapshotReply)(nil), "buckets.pb.RemoveSnapshotReply")
+	proto.RegisterType((*ArchiveRequest)(nil), "buckets.pb.ArchiveRequest")
+	proto.RegisterType((*ArchiveOptions)(nil), "buckets.pb.ArchiveOptions")
+	proto.RegisterType((*ArchiveReply)(nil), "buckets.pb.ArchiveReply")
+	proto.RegisterType((*ArchiveStatusRequest)(nil), "buckets.pb.ArchiveStatusRequest")
+	proto.RegisterType((*ArchiveStatusReply)(nil), "buckets.pb.ArchiveStatusReply")
+	proto.RegisterType((*ArchiveInfoRequest)(nil), "buckets.pb.ArchiveInfoRequest")
+	proto.RegisterType((*ArchiveInfoReply)(nil), "buckets.pb.ArchiveInfoReply")
+	proto.RegisterType((*ArchiveInfoReply_Archive)(nil), "buckets.pb.ArchiveInfoReply.Archive")
+	proto.RegisterType((*ArchiveInfoReply_Archive_Deal)(nil), "buckets.pb.ArchiveInfoReply.Archive.Deal")
+	proto.RegisterType((*ArchiveSchedule)(nil), "buckets.pb.ArchiveSchedule")
+	proto.RegisterType((*ArchiveSchedule_Run)(nil), "buckets.pb.ArchiveSchedule.Run")
+	proto.RegisterType((*SetArchiveScheduleRequest)(nil), "buckets.pb.SetArchiveScheduleRequest")
+	proto.RegisterType((*SetArchiveScheduleReply)(nil), "buckets.pb.SetArchiveScheduleReply")
+	proto.RegisterType((*ArchiveRenewal)(nil), "buckets.pb.ArchiveRenewal")
+	proto.RegisterType((*SetArchiveRenewalRequest)(nil), "buckets.pb.SetArchiveRenewalRequest")
+	proto.RegisterType((*SetArchiveRenewalReply)(nil), "buckets.pb.SetArchiveRenewalReply")
+	proto.RegisterType((*ArchiveListRequest)(nil), "buckets.pb.ArchiveListRequest")
+	proto.RegisterType((*ArchiveListReply)(nil), "buckets.pb.ArchiveListReply")
+	proto.RegisterType((*ArchiveListReply_Archive)(nil), "buckets.pb.ArchiveListReply.Archive")
+	proto.RegisterType((*ArchiveListReply_Archive_Deal)(nil), "buckets.pb.ArchiveListReply.Archive.Deal")
+	proto.RegisterType((*RestoreArchiveRequest)(nil), "buckets.pb.RestoreArchiveRequest")
+	proto.RegisterType((*RestoreArchiveReply)(nil), "buckets.pb.RestoreArchiveReply")
+	proto.RegisterType((*ArchiveWatchRequest)(nil), "buckets.pb.ArchiveWatchRequest")
+	proto.RegisterType((*ArchiveWatchReply)(nil), "buckets.pb.ArchiveWatchReply")
+	proto.RegisterType((*PushRejection)(nil), "buckets.pb.PushRejection")
+	proto.RegisterType((*PushRejection_Violation)(nil), "buckets.pb.PushRejection.Violation")
+}
+
+func init() { proto.RegisterFile("buckets.proto", fileDescriptor_95035767e889ecda) }
+
+var fileDescriptor_95035767e889ecda = []byte{
+	// 6511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x7d, 0x4d, 0x6c, 0x1d, 0xc9,
+	0x71, 0xb0, 0xe6, 0xfd, 0xbf, 0xe2, 0x8f, 0xc8, 0x21, 0xc5, 0xa5, 0x46, 0xa2, 0xc4, 0x9d, 0xd5,
+	0xae, 0x24, 0x7f, 0xfe, 0xe8, 0x8d, 0xd6, 0x6b, 0xc9, 0xbb, 0xab, 0xb5, 0x29, 0x52, 0x4b, 0xd1,
+	0x5a, 0xca, 0xf2, 0x50, 0x2b, 0xad, 0xe3, 0x20, 0x8b, 0xe1, 0x7b, 0x4d, 0x72, 0xac, 0xc7, 0x99,
+	0xb7, 0x33, 0xf3, 0xb8, 0xa4, 0x11, 0x9f, 0x8c, 0xc0, 0x48, 0x80, 0x04, 0xb9, 0xe4, 0xe0, 0x24,
+	0x97, 0xf8, 0x92, 0x6b, 0x4e, 0x0e, 0x72, 0x09, 0x7c, 0x8b, 0x73, 0x4d, 0x7c, 0xc8, 0x21, 0x40,
+	0x6e, 0x09, 0x02, 0x38, 0x17, 0xe7, 0x90, 0x04, 0x41, 0x80, 0xa0, 0xfa, 0x6f, 0xba, 0x67, 0x7a,
+	0xe6, 0x3d, 0x4a, 0xeb, 0xe4, 0xc4, 0xd7, 0xdd, 0xd5, 0x55, 0xdd, 0xd5, 0x55, 0xd5, 0xd5, 0xd5,
+	0xd5, 0x43, 0x98, 0xd9, 0x1b, 0xf5, 0x9e, 0x93, 0x34, 0x59, 0x1b, 0xc6, 0x51, 0x1a, 0xd9, 0x20,
+	0x8b, 0x7b, 0xee, 0x7f, 0x5b, 0xd0, 0xf0, 0xa2, 0x28, 0xb5, 0xe7, 0xa0, 0xfe, 0x9c, 0x9c, 0x2e,
+	0x5b, 0xab, 0xd6, 0x8d, 0xae, 0x87, 0x3f, 0x6d, 0x1b, 0x1a, 0xa1, 0x7f, 0x44, 0x96, 0x6b, 0xb4,
+	0x8a, 0xfe, 0xc6, 0xba, 0xa1, 0x9f, 0x1e, 0x2e, 0xd7, 0x59, 0x1d, 0xfe, 0xb6, 0x2f, 0x43, 0xb7,
+	0x17, 0x13, 0x3f, 0x25, 0xfd, 0xf5, 0x74, 0xb9, 0xb1, 0x6a, 0xdd, 0xa8, 0x7b, 0x59, 0x05, 0xb6,
+	0x8e, 0x86, 0x7d, 0xde, 0xda, 0x64, 0xad, 0xb2, 0xc2, 0x5e, 0x82, 0x56, 0x7a, 0x18, 0x13, 0xbf,
+	0xbf, 0xdc, 0xa2, 0x18, 0x79, 0xc9, 0x5e, 0x83, 0x46, 0xea, 0x1f, 0x24, 0xcb, 0xed, 0xd5, 0xfa,
+	0x8d, 0xa9, 0x5b, 0xce, 0x5a, 0x36, 0xe2, 0x35, 0x1c, 0xed, 0xda, 0x13, 0xff, 0x20, 0xb9, 0x1f,
+	0xa6, 0xf1, 0xa9, 0x47, 0xe1, 0x9c, 0xdb, 0xd0, 0x95, 0x55, 0x86, 0xa9, 0x2c, 0x42, 0xf3, 0xd8,
+	0x1f, 0x8c, 0xc4, 0x5c, 0x58, 0xe1, 0x9d, 0xda, 0x1d, 0xcb, 0xfd, 0x3e, 0x4c, 0x7d, 0x18, 0x24,
+	0xa9, 0x47, 0x3e, 0x1d, 0x91, 0x24, 0xb5, 0xdf, 0xe6, 0x74, 0x2d, 0x4a, 0xf7, 0x55, 0x95, 0xae,
+	0x02, 0xf6, 0xf9, 0x91, 0x7f, 0x0b, 0xba, 0x0c, 0xef, 0x70, 0x70, 0x6a, 0xbf, 0x01, 0xcd, 0x38,
+	0x8a, 0x52, 0x41, 0x7d, 0x2e, 0x3f, 0x6b, 0x8f, 0x35, 0xbb, 0x9f, 0xc2, 0xd4, 0x76, 0x18, 0xc8,
+	0x31, 0x8b, 0x75, 0xb2, 0x94, 0x75, 0x72, 0x61, 0x7a, 0x0f, 0x61, 0xd3, 0xd8, 0x1f, 0x6e, 0x04,
+	0x7d, 0x4e, 0x58, 0xab, 0xb3, 0x97, 0xa1, 0x3d, 0x8c, 0x83, 0x63, 0x3f, 0x25, 0x74, 0x39, 0x3b,
+	0x9e, 0x28, 0x8a, 0x19, 0xe0, 0x5a, 0x4e, 0xd3, 0x19, 0xb8, 0xbf, 0x67, 0x41, 0x97, 0xd1, 0xc4,
+	0x81, 0x5e, 0x83, 0x06, 0x8e, 0x84, 0x52, 0x34, 0x8d, 0x93, 0xb6, 0xda, 0x5f, 0x84, 0xe6, 0x20,
+	0x08, 0x9f, 0x27, 0x94, 0xf8, 0xd4, 0xad, 0x25, 0x9d, 0x99, 0xe1, 0xf3, 0x84, 0x22, 0xf3, 0x18,
+	0x10, 0xce, 0x22, 0x21, 0xa4, 0x4f, 0x87, 0x32, 0xed, 0xd1, 0xdf, 0x38, 0x42, 0xfc, 0x8b, 0x13,
+	0x68, 0xd0, 0x09, 0x88, 0xa2, 0x7b, 0x15, 0xa6, 0x28, 0x25, 0xce, 0x82, 0x02, 0xcb, 0xdd, 0x3f,
+	0xb0, 0xa0, 0xcb, 0x20, 0x26, 0x1f, 0xf0, 0x97, 0xa0, 0x7d, 0x14, 0xc4, 0x71, 0x14, 0xe3, 0x90,
+	0x71, 0x05, 0x2e, 0xa8, 0x80, 0x8f, 0x83, 0x70, 0x87, 0xb6, 0x7a, 0x02, 0xca, 0xfe, 0x22, 0xb4,
+	0xfb, 0xd1, 0x91, 0x1f, 0x84, 0xc9, 0x72, 0x9d, 0x76, 0xb0, 0xd5, 0x0e, 0x9b, 0xb4, 0xc9, 0x13,
+	0x20, 0xee, 0x2a, 0x4c, 0xf3, 0x69, 0x97, 0x0d, 0x7a, 0x13, 0x20, 0x63, 0x0c, 0xb6, 0x7f, 0xe4,
+	0x7d, 0x28, 0xda, 0x3f, 0xf2, 0x3e, 0xc4, 0x9a, 0x67, 0xcf, 0x9e, 0xf1, 0xc5, 0xc4, 0x9f, 0xc8,
+	0xb5, 0xed, 0xc7, 0x8f, 0x76, 0x85, 0x3e, 0xe2, 0x6f, 0xf7, 0xaf, 0x2d, 0x38, 0x8f, 0x42, 0xf5,
+	0xd8, 0x4f, 0x0f, 0x4b, 0x69, 0x49, 0x4d, 0xae, 0x29, 0x9a, 0xbc, 0x88, 0x2b, 0x76, 0x14, 0xa4,
+	0x14, 0x5d, 0xdd, 0x63, 0x05, 0xd4, 0xd1, 0xde, 0x28, 0x4e, 0xa2, 0x98, 0x2f, 0x02, 0x2f, 0xa1,
+	0x66, 0xc7, 0x04, 0x7f, 0x07, 0xc7, 0x84, 0x6a, 0x76, 0xc7, 0xcb, 0x2a, 0x6c, 0x07, 0x3a, 0x47,
+	0xfe, 0xc9, 0x26, 0x19, 0xa6, 0x87, 0x54, 0xb7, 0x9b, 0x9e, 0x2c, 0x23, 0xed, 0x83, 0x41, 0xb4,
+	0xb7, 0xdc, 0x66, 0xb4, 0xf1, 0x37, 0xd6, 0xd1, 0x25, 0xea, 0xb0, 0x3a, 0xfc, 0xed, 0xfe, 0xc0,
+	0x82, 0x99, 0x6c, 0x26, 0xc8, 0x93, 0x2f, 0x42, 0x23, 0x48, 0xc9, 0x11, 0x5f, 0xc8, 0xe5, 0xbc,
+	0x7e, 0x22, 0xe0, 0x76, 0x4a, 0x8e, 0x3c, 0x0a, 0x25, 0x97, 0xbd, 0x56, 0xb9, 0xec, 0x57, 0x00,
+	0x42, 0x72, 0x92, 0x6e, 0xb0, 0x39, 0x32, 0x4e, 0x2a, 0x35, 0xee, 0xcf, 0x2d, 0x98, 0x56, 0x91,
+	0x23, 0x33, 0x7b, 0x41, 0x5f, 0x30, 0xb3, 0x17, 0xf4, 0x27, 0x36, 0x95, 0x28, 0xe4, 0xc1, 0xf7,
+	0x08, 0xb7, 0x92, 0xf4, 0x37, 0x32, 0x3d, 0x48, 0x36, 0x83, 0x98, 0xb3, 0x90, 0x15, 0xec, 0x35,
+	0x68, 0xe2, 0x14, 0x92, 0xe5, 0xd6, 0x6a, 0xbd, 0x72, 0xa6, 0x0c, 0xcc, 0x7e, 0x13, 0x3a, 0x47,
+	0x24, 0xf5, 0xfb, 0x7e, 0xea, 0x53, 0xb6, 0x4e, 0xdd, 0x5a, 0x54, 0xbb, 0xec, 0xf0, 0x36, 0x4f,
+	0x42, 0xb9, 0xff, 0x69, 0x41, 0x47, 0x54, 0xdb, 0xab, 0x30, 0xd5, 0x8b, 0xc2, 0x94, 0x84, 0xe9,
+	0x93, 0xd3, 0xa1, 0x30, 0x25, 0x6a, 0x95, 0xbd, 0x09, 0xe0, 0xa7, 0x69, 0x1c, 0xec, 0x8d, 0x52,
+	0x22, 0xf4, 0xe3, 0x9a, 0x89, 0xc4, 0xda, 0xba, 0x04, 0x63, 0x26, 0x52, 0xe9, 0xa7, 0xef, 0x06,
+	0xf5, 0xfc, 0x6e, 0x70, 0x03, 0xce, 0x73, 0x92, 0xf7, 0xc3, 0x5e, 0xd4, 0x0f, 0xc2, 0x03, 0x2e,
+	0x72, 0xf9, 0x6a, 0xe7, 0x2e, 0x9c, 0xcf, 0x91, 0x39, 0x93, 0xd9, 0xbd, 0x09, 0x0b, 0xc8, 0xc4,
+	0xed, 0xe1, 0x7e, 0xa2, 0x6a, 0x89, 0x58, 0x32, 0x2b, 0x5b, 0x32, 0x77, 0x1d, 0xe6, 0x75, 0xd0,
+	0x33, 0x8b, 0xa1, 0xfb, 0xb3, 0x3a, 0x9c, 0x7f, 0x3c, 0x4a, 0x0e, 0x55, 0x52, 0xef, 0x41, 0xeb,
+	0x90, 0xf8, 0x7d, 0x12, 0x73, 0x1c, 0xae, 0x66, 0x6a, 0x74, 0xe0, 0xb5, 0x07, 0x14, 0xf2, 0xc1,
+	0x39, 0x8f, 0xf7, 0xb1, 0x97, 0xa0, 0xd9, 0x3b, 0x1c, 0x85, 0xcf, 0xe9, 0xcc, 0xa6, 0x1f, 0x9c,
+	0xf3, 0x58, 0xd1, 0xf9, 0xbb, 0x1a, 0xb4, 0x18, 0xf0, 0x84, 0x1a, 0x2f, 0xb4, 0xae, 0x9e, 0x69,
+	0x1d, 0x5a, 0xdd, 0x23, 0x92, 0x24, 0xfe, 0x01, 0x11, 0x56, 0x97, 0x17, 0xf3, 0x52, 0xd2, 0x2c,
+	0x4a, 0x89, 0xa7, 0x49, 0x09, 0x93, 0xdd, 0x5b, 0xe3, 0xa7, 0x56, 0x29, 0x33, 0x0e, 0x74, 0x7a,
+	0xd1, 0xd1, 0x30, 0x26, 0x49, 0x42, 0x45, 0xbb, 0xe3, 0xc9, 0xb2, 0x7d, 0x0d, 0x66, 0xfa, 0x24,
+	0x25, 0xf1, 0x51, 0x10, 0x06, 0x49, 0x1a, 0xf4, 0xa8, 0xf9, 0xe8, 0x78, 0x7a, 0xe5, 0x4b, 0x4a,
+	0xcb, 0xbd, 0x2e, 0xb4, 0x87, 0xfe, 0xe9, 0x20, 0xf2, 0xfb, 0xee, 0xbf, 0xd4, 0x61, 0x26, 0x9b,
+	0x02, 0x8a, 0xc2, 0x6d, 0x68, 0x92, 0x63, 0x12, 0x8a, 0xbd, 0xe5, 0xaa, 0x79, 0xb2, 0xc3, 0xc1,
+	0xe9, 0xda, 0x7d, 0x04, 0xc3, 0xb5, 0xa2, 0xf0, 0xb8, 0x86, 0x04, 0xb7, 0x11, 0x46, 0x8f, 0xd6,
+	0x63, 0xd1, 0xf9, 0xaf, 0x1a, 0x34, 0x29, 0xa8, 0x71, 0x63, 0x2f, 0x31, 0xdb, 0x7b, 0xa7, 0xc8,
+	0x6f, 0x6e, 0xb6, 0x69, 0x41, 0xb3, 0x35, 0x5d, 0x6e, 0x6b, 0x84, 0x41, 0x6c, 0x56, 0x1a, 0xc4,
+	0xeb, 0xd0, 0xfc, 0x74, 0x14, 0xa5, 0x3e, 0xb5, 0xdb, 0x53, 0xb7, 0xe6, 0x55, 0xb0, 0x6f, 0x61,
+	0x83, 0xc7, 0xda, 0xed, 0x77, 0xa1, 0x99, 0xa4, 0x28, 0x27, 0xb8, 0x2c, 0xb3, 0xb7, 0x5e, 0x1f,
+	0x33, 0xf7, 0xb5, 0x5d, 0x04, 0xf6, 0x58, 0x1f, 0x5c, 0xd6, 0x98, 0xf4, 0x48, 0x70, 0x4c, 0xfa,
+	0x74, 0xd5, 0xea, 0x9e, 0x2c, 0xa3, 0xfb, 0xd2, 0x8b, 0xc2, 0xfd, 0x41, 0xd0, 0xa3, 0xba, 0xb4,
+	0xdc, 0x65, 0xee, 0x8b, 0x5a, 0xa7, 0x08, 0x23, 0x0e, 0x7d, 0x19, 0x34, 0x61, 0xc4, 0x2a, 0xf7,
+	0x16, 0x34, 0x29, 0x45, 0x1b, 0xa0, 0xb5, 0xde, 0x47, 0xbb, 0x31, 0x77, 0xce, 0x9e, 0x82, 0xf6,
+	0xe3, 0x20, 0x0c, 0xb1, 0x60, 0xd9, 0x73, 0x30, 0xfd, 0x11, 0x5a, 0x9f, 0x20, 0x3c, 0xc0, 0x1e,
+	0x73, 0x35, 0x75, 0xad, 0xff, 0xb1, 0x0e, 0x73, 0x62, 0x16, 0x72, 0xd3, 0xbe, 0x9b, 0xd3, 0xdb,
+	0xd7, 0x4c, 0x73, 0x4e, 0x4a, 0x15, 0xf7, 0x1d, 0x55, 0x71, 0x4b, 0xb4, 0x5e, 0xf6, 0xde, 0x40,
+	0xc8, 0x4c, 0xb9, 0xc3, 0x6a, 0xdd, 0x96, 0x3b, 0x9d, 0x41, 0x8f, 0xeb, 0xba, 0x1e, 0x17, 0xb4,
+	0xa6, 0x61, 0xd2, 0x9a, 0x9f, 0x5b, 0xd0, 0xa4, 0x43, 0x30, 0xd9, 0x45, 0xac, 0xa3, 0x9b, 0x4d,
+	0x8d, 0xf9, 0x6b, 0xf8, 0x1b, 0xc7, 0x45, 0xa2, 0x7d, 0xee, 0x4d, 0xe2, 0xcf, 0x9c, 0x3d, 0x68,
+	0x94, 0xdb, 0x03, 0x7d, 0xd2, 0x55, 0xf6, 0xe0, 0x73, 0xd4, 0xe6, 0x9f, 0x58, 0x30, 0xab, 0x0c,
+	0x00, 0xd5, 0xd9, 0x34, 0x55, 0xbe, 0xdf, 0xd7, 0xb4, 0xfd, 0x9e, 0xea, 0x56, 0x5d, 0xd9, 0xc7,
+	0x85, 0x6e, 0x35, 0x2a, 0x75, 0x2b, 0x2f, 0xd9, 0xcd, 0xf1, 0x92, 0xdd, 0x2a, 0x4a, 0xf6, 0x6f,
+	0x81, 0xbd, 0x9b, 0xfa, 0x71, 0xfa, 0xd1, 0x10, 0xe7, 0x71, 0x36, 0x27, 0xef, 0x6c, 0x26, 0x5f,
+	0xcc, 0xb4, 0x99, 0xcd, 0xd4, 0x7d, 0x04, 0x73, 0x1a, 0x75, 0xe4, 0xdb, 0x65, 0xe8, 0x26, 0x24,
+	0x49, 0x82, 0x28, 0xdc, 0xde, 0xe4, 0x23, 0xc8, 0x2a, 0xb0, 0x95, 0x9c, 0x0c, 0x83, 0x98, 0x24,
+	0xeb, 0x4c, 0x46, 0xeb, 0x5e, 0x56, 0xe1, 0xbe, 0x05, 0x0b, 0x0c, 0xd5, 0x6e, 0xea, 0xa7, 0x23,
+	0xa9, 0x6a, 0x95, 0x28, 0xd1, 0x37, 0x9c, 0xd7, 0x7b, 0x71, 0x9f, 0x79, 0x02, 0x16, 0x2c, 0x41,
+	0x2b, 0xda, 0xdf, 0x4f, 0x88, 0x70, 0x41, 0x78, 0xc9, 0xe8, 0x9e, 0x69, 0x43, 0x6f, 0xe6, 0x87,
+	0xfe, 0x13, 0x0b, 0xe6, 0x51, 0x82, 0xf4, 0x85, 0x78, 0x3f, 0x67, 0x24, 0xae, 0xe5, 0x25, 0x5e,
+	0x03, 0x9f, 0x7c, 0x7b, 0x7f, 0x5f, 0x5a, 0x80, 0x6a, 0x76, 0x67, 0xf3, 0xab, 0xa9, 0xf3, 0x53,
+	0x45, 0xff, 0x26, 0x9c, 0x57, 0x07, 0x82, 0xbc, 0xcb, 0x7a, 0x59, 0x6a, 0x2f, 0xf7, 0x6d, 0xb8,
+	0xb0, 0x11, 0x1d, 0x0d, 0x07, 0x24, 0x25, 0xfa, 0x34, 0xab, 0x17, 0x28, 0x81, 0x85, 0x7c, 0xb7,
+	0x32, 0x05, 0x9b, 0xcc, 0x4f, 0xcf, 0xab, 0x4e, 0xbd, 0xa8, 0x3a, 0x28, 0x4a, 0x1b, 0x7e, 0xd8,
+	0x23, 0x83, 0xb3, 0x8c, 0x74, 0x01, 0xe6, 0xf5, 0x4e, 0xc3, 0xc1, 0xa9, 0xfb, 0x17, 0x16, 0x72,
+	0x68, 0x30, 0x38, 0xfb, 0x29, 0x6a, 0x15, 0xa6, 0x82, 0xfd, 0x47, 0x51, 0x48, 0x76, 0xfc, 0xb4,
+	0x27, 0x86, 0xa9, 0x56, 0x29, 0x9c, 0x6e, 0x68, 0xf2, 0xb7, 0x04, 0xad, 0x01, 0x09, 0x0f, 0xb8,
+	0x59, 0xa8, 0x7b, 0xbc, 0x84, 0xea, 0x49, 0xd0, 0xf3, 0x25, 0x2c, 0x4c, 0xd2, 0xf1, 0x44, 0x51,
+	0x2a, 0x73, 0x5b, 0x39, 0x35, 0xfd, 0xc8, 0x82, 0x99, 0x6c, 0xe4, 0xc8, 0xf3, 0x45, 0x21, 0x4f,
+	0x16, 0x35, 0xd6, 0xac, 0x80, 0x7d, 0x49, 0xea, 0x1f, 0x88, 0xb1, 0xe3, 0x6f, 0x1c, 0x7b, 0x18,
+	0xa5, 0x3b, 0x51, 0x3f, 0xd8, 0x0f, 0xf8, 0x61, 0xbc, 0xe3, 0xa9, 0x55, 0x46, 0x1d, 0x31, 0xf8,
+	0xed, 0x4d, 0xa3, 0xdf, 0x8e, 0x8e, 0x37, 0x0e, 0x6d, 0x12, 0xc7, 0xfb, 0x26, 0xcc, 0xeb, 0xa0,
+	0xa5, 0x33, 0x71, 0xdf, 0x82, 0xa9, 0xcd, 0x60, 0x7f, 0xbf, 0x72, 0x99, 0xf2, 0xdb, 0xa3, 0xfb,
+	0xfb, 0x35, 0xe8, 0xb2, 0x5e, 0x88, 0xf8, 0x2b, 0xd0, 0xee, 0x1d, 0xfa, 0xe1, 0x01, 0x11, 0xd1,
+	0x97, 0xcb, 0xda, 0x51, 0x5e, 0xc0, 0xad, 0x6d, 0x50, 0x20, 0x4f, 0x00, 0x4f, 0x26, 0xba, 0xce,
+	0x8f, 0x2d, 0x68, 0xb1, 0x9e, 0x34, 0xc2, 0x24, 0x8e, 0x58, 0xb3, 0xb7, 0x5e, 0xad, 0xa2, 0xb2,
+	0x86, 0x2e, 0xb5, 0x47, 0xc1, 0x8d, 0x82, 0xc6, 0xf7, 0xa5, 0x7a, 0x71, 0x5f, 0x52, 0x16, 0xc7,
+	0xbd, 0x0e, 0x0d, 0xc4, 0x63, 0xb7, 0xa1, 0xbe, 0xde, 0xef, 0xcf, 0x9d, 0x43, 0x6f, 0x88, 0xae,
+	0xe6, 0xe9, 0x9c, 0x85, 0xbf, 0x3d, 0x72, 0x14, 0x1d, 0x93, 0xb9, 0x9a, 0xbb, 0x0d, 0xe7, 0xb7,
+	0x48, 0x7a, 0x6f, 0x10, 0xf5, 0x9e, 0x97, 0x73, 0xd2, 0xb8, 0x17, 0xe6, 0xcf, 0xb9, 0xee, 0x6b,
+	0x30, 0x93, 0xa1, 0xe2, 0x5a, 0x4f, 0xbd, 0x05, 0x2b, 0xf3, 0x16, 0x90, 0xde, 0x03, 0x3f, 0xf9,
+	0x5c, 0xe8, 0xbd, 0x0a, 0x33, 0x19, 0x2a, 0xbe, 0x0f, 0x1c, 0xfa, 0x09, 0x45, 0xd4, 0xf1, 0xf0,
+	0xa7, 0xeb, 0xa3, 0x3a, 0x8f, 0x9b, 0x9d, 0xc9, 0xa9, 0x59, 0x82, 0xd6, 0x7e, 0x14, 0x1f, 0xf9,
+	0x62, 0xc7, 0xe4, 0x25, 0x31, 0xb2, 0x86, 0x1c, 0x19, 0x8e, 0x22, 0x23, 0xc1, 0x47, 0xa1, 0x07,
+	0x0a, 0xdc, 0xeb, 0xb0, 0x70, 0xff, 0x64, 0x18, 0xc5, 0xe9, 0x3d, 0xba, 0xec, 0xe5, 0xa1, 0xa0,
+	0x9b, 0x30, 0xaf, 0x03, 0x96, 0x4b, 0xff, 0x2f, 0x2d, 0x58, 0xd8, 0x3e, 0x2a, 0x22, 0xfd, 0x7a,
+	0x6e, 0x17, 0x7a, 0x43, 0x95, 0x35, 0x43, 0x87, 0xc9, 0xf7, 0xa1, 0xe3, 0x33, 0x7a, 0xa2, 0xe2,
+	0x20, 0x53, 0x57, 0x0e, 0x32, 0x4a, 0xf4, 0xb1, 0xa1, 0x47, 0x1f, 0x15, 0x67, 0xa4, 0xa9, 0x39,
+	0x23, 0xea, 0xfe, 0xf5, 0x2d, 0x98, 0xdf, 0x3e, 0xca, 0xf3, 0x67, 0xb2, 0x30, 0xdf, 0x12, 0xb4,
+	0xf6, 0x70, 0x8d, 0x12, 0xb1, 0x3b, 0xb2, 0x92, 0xfb, 0x8b, 0x1a, 0x4c, 0x33, 0x6c, 0x0c, 0xb3,
+	0x3d, 0x0b, 0x35, 0xb9, 0x7a, 0xb5, 0xa0, 0x8f, 0x1d, 0x93, 0x68, 0x14, 0xf7, 0x84, 0x53, 0xc9,
+	0x4b, 0xc6, 0x48, 0xcf, 0x6d, 0x68, 0x25, 0xd4, 0x2f, 0xa1, 0xb3, 0x9b, 0xd5, 0xcf, 0x85, 0x2a,
+	0x95, 0x35, 0xee, 0xbe, 0x70, 0x70, 0x9c, 0x7d, 0xb4, 0xf7, 0x5d, 0xd2, 0x4b, 0x13, 0xbe, 0x09,
+	0x88, 0x62, 0x76, 0xcc, 0x6b, 0xa9, 0xc7, 0xbc, 0x2c, 0x3a, 0xd7, 0xce, 0x47, 0xe7, 0x06, 0x7e,
+	0x92, 0xde, 0xa7, 0x47, 0x4c, 0x16, 0x54, 0xcb, 0x2a, 0xf4, 0x98, 0x7d, 0xb7, 0x32, 0x66, 0x0f,
+	0xb9, 0x28, 0x8d, 0x7b, 0x1f, 0x5a, 0x6c, 0xcc, 0x68, 0x3d, 0xbe, 0x35, 0x22, 0x23, 0xd2, 0x67,
+	0xe7, 0x2a, 0x6f, 0x24, 0xce, 0x55, 0x1d, 0x68, 0x6c, 0x46, 0x21, 0x99, 0xab, 0x21, 0xc8, 0x07,
+	0x7e, 0x30, 0x20, 0xfd, 0xb9, 0xba, 0x3d, 0x0d, 0x1d, 0xb6, 0xcf, 0x92, 0xfe, 0x5c, 0xc3, 0xfd,
+	0x07, 0x0b, 0x16, 0xa9, 0x1b, 0xb9, 0xfb, 0x16, 0xe3, 0xc4, 0xd9, 0x76, 0x59, 0x07, 0x3a, 0x24,
+	0xec, 0x0f, 0xa3, 0x20, 0x14, 0x8a, 0x29, 0xcb, 0xc8, 0x93, 0x98, 0x1c, 0x04, 0x51, 0x28, 0x22,
+	0x96, 0xac, 0x44, 0x57, 0x9e, 0xb2, 0x9e, 0x0b, 0x16, 0x2f, 0x61, 0xfd, 0x30, 0x26, 0xfb, 0xc1,
+	0x89, 0xb8, 0x85, 0x60, 0x25, 0xe4, 0x83, 0xdf, 0xeb, 0x91, 0x24, 0x79, 0x48, 0x4e, 0x39, 0x7b,
+	0xb3, 0x0a, 0xe6, 0x54, 0xf4, 0x62, 0x92, 0x62, 0x6b, 0x47, 0x38, 0x15, 0xbc, 0xc2, 0xfd, 0x00,
+	0xec, 0xdc, 0xec, 0x50, 0x42, 0xdf, 0x84, 0x56, 0x40, 0x8b, 0xa6, 0xd0, 0x91, 0x2a, 0x16, 0x1e,
+	0x87, 0x73, 0xdf, 0x00, 0x9b, 0xc6, 0x9f, 0x68, 0xa9, 0x22, 0x76, 0xfc, 0x01, 0xcc, 0x69, 0x70,
+	0x48, 0xed, 0x16, 0xb4, 0x19, 0x16, 0xb1, 0xa9, 0x95, 0x93, 0x13, 0x80, 0xee, 0x6d, 0xe1, 0x41,
+	0x8d, 0x5b, 0x14, 0xa6, 0x1d, 0x35, 0xa1, 0x1d, 0x99, 0x17, 0xa5, 0xcc, 0xd7, 0x7d, 0x04, 0x8e,
+	0xaa, 0xa6, 0x18, 0x9f, 0x7e, 0x48, 0x4e, 0xcb, 0x91, 0x5e, 0x01, 0xe0, 0x66, 0x00, 0x99, 0xca,
+	0xcc, 0xb0, 0x52, 0xe3, 0x3e, 0x82, 0x65, 0x23, 0x3e, 0xbe, 0xc7, 0x14, 0xc2, 0x25, 0xe3, 0xf0,
+	0xed, 0xc1, 0xec, 0x2e, 0x79, 0x81, 0x48, 0x79, 0x71, 0xeb, 0x2d, 0x3d, 0x42, 0xb9, 0xb3, 0x30,
+	0x2d, 0x69, 0x20, 0x4f, 0x5e, 0x85, 0x19, 0xb6, 0xe7, 0x96, 0x2f, 0xe6, 0x0c, 0x4c, 0x09, 0x10,
+	0xec, 0x71, 0x00, 0xf3, 0xac, 0x78, 0xf6, 0x81, 0x9e, 0xe9, 0xb4, 0xe7, 0xde, 0x86, 0xf3, 0x2a,
+	0xa1, 0x89, 0x6d, 0xaa, 0xfb, 0xdb, 0x16, 0x9c, 0xdf, 0x19, 0x3b, 0x40, 0x07, 0x3a, 0xfb, 0x71,
+	0x74, 0xf4, 0x38, 0x1b, 0xa4, 0x2c, 0xd3, 0x9b, 0xc0, 0x48, 0xf1, 0xeb, 0x79, 0x49, 0x4e, 0xa0,
+	0x61, 0x9e, 0x80, 0xbe, 0x43, 0xb8, 0x6f, 0xc3, 0xcc, 0xce, 0x0b, 0x0c, 0x7f, 0x17, 0x9a, 0x34,
+	0xb0, 0x45, 0x31, 0xfb, 0x27, 0xbb, 0xe8, 0x43, 0xb1, 0x43, 0x90, 0x28, 0x4a, 0xd7, 0xaa, 0xa6,
+	0x9f, 0x0d, 0x63, 0x82, 0x97, 0x3b, 0xe8, 0xf1, 0xf2, 0x68, 0xb6, 0xac, 0x70, 0xbf, 0x03, 0x33,
+	0x14, 0xe9, 0xfd, 0x93, 0x1e, 0x21, 0x7d, 0xc5, 0x75, 0xb6, 0x14, 0x14, 0x0a, 0xc1, 0x9a, 0x4e,
+	0xb0, 0x1a, 0xf9, 0x5d, 0x38, 0xbf, 0x4b, 0x52, 0x8a, 0xbf, 0x9c, 0xdf, 0xa5, 0xc8, 0xdd, 0xdf,
+	0x84, 0x99, 0xac, 0x3b, 0xf2, 0x49, 0xc6, 0xfc, 0xac, 0x31, 0x31, 0xbf, 0x89, 0x1c, 0x5e, 0xf7,
+	0x35, 0xea, 0x4b, 0x56, 0x0f, 0xcf, 0xbd, 0x03, 0x33, 0x19, 0xd0, 0x59, 0x06, 0xe1, 0xfe, 0x3b,
+	0xbd, 0x18, 0xda, 0x27, 0xbd, 0xd3, 0xde, 0x80, 0x78, 0xa3, 0x01, 0x31, 0xed, 0xd5, 0x7e, 0x2f,
+	0xc5, 0x2d, 0x80, 0xef, 0xd5, 0xac, 0xa4, 0x98, 0xfa, 0xba, 0x66, 0xea, 0xa9, 0xe7, 0x77, 0xca,
+	0x76, 0xeb, 0xa6, 0x47, 0x7f, 0xdb, 0x77, 0xe4, 0x1e, 0xce, 0xe2, 0xa5, 0xab, 0x7a, 0x9c, 0x5f,
+	0x21, 0x9f, 0xdb, 0xc4, 0x9d, 0x8f, 0xe5, 0x16, 0xc9, 0xb7, 0x61, 0x6f, 0x14, 0xae, 0x8b, 0x73,
+	0x75, 0x56, 0x81, 0x0a, 0xe1, 0xef, 0xef, 0x93, 0x5e, 0x4a, 0xfa, 0x7c, 0x85, 0x64, 0x19, 0xb7,
+	0x7b, 0x16, 0x1f, 0x66, 0x03, 0x65, 0x05, 0xf7, 0xd7, 0xa1, 0x2b, 0x29, 0xdb, 0x5f, 0x82, 0x66,
+	0x3c, 0x1a, 0xc8, 0x23, 0xcb, 0xc5, 0xd2, 0xf1, 0x79, 0x0c, 0x0e, 0x47, 0x83, 0x17, 0x5b, 0x6c,
+	0x34, 0x8c, 0x60, 0x56, 0xe1, 0x7e, 0x0c, 0x0b, 0xbb, 0x24, 0xcd, 0x3a, 0x96, 0xca, 0x95, 0xa4,
+	0x5b, 0x9b, 0x8c, 0xae, 0xfb, 0x00, 0xe6, 0x75, 0xcc, 0xb8, 0xda, 0x6f, 0x41, 0x77, 0x20, 0x6a,
+	0xf8, 0x8a, 0x5f, 0x30, 0x63, 0xca, 0xe0, 0xd0, 0x81, 0xde, 0x9a, 0x64, 0x8c, 0x48, 0x72, 0xeb,
+	0xf3, 0x21, 0xf9, 0xaf, 0x35, 0x68, 0x3f, 0x23, 0x7b, 0x49, 0x90, 0xd2, 0xc8, 0x69, 0x10, 0xf6,
+	0xc9, 0xc9, 0x66, 0xd4, 0x1b, 0x1d, 0x89, 0xa8, 0x7f, 0xd7, 0xd3, 0x2b, 0x11, 0x8a, 0xae, 0x96,
+	0x84, 0x62, 0x32, 0xa8, 0x57, 0xda, 0xef, 0xa0, 0x82, 0xf7, 0x83, 0x98, 0xfa, 0x7a, 0xf5, 0xe2,
+	0xa1, 0x93, 0xd3, 0x5c, 0xf3, 0x38, 0x90, 0x97, 0x81, 0xdb, 0x5f, 0x86, 0x36, 0xf3, 0xd1, 0x45,
+	0x50, 0xd5, 0x31, 0xf5, 0x64, 0x4e, 0xba, 0x27, 0x40, 0x9d, 0xdf, 0x80, 0x8e, 0x40, 0x86, 0x02,
+	0x8f, 0xb6, 0x57, 0xec, 0x96, 0xf8, 0x1b, 0x95, 0x28, 0x8d, 0xc4, 0x96, 0x9e, 0x46, 0xd4, 0xe1,
+	0x65, 0x0a, 0x50, 0xa7, 0x6a, 0xc1, 0x4b, 0x28, 0x9a, 0xfb, 0x11, 0xfa, 0xc1, 0xcc, 0x73, 0x67,
+	0x05, 0xe7, 0x03, 0x79, 0x2a, 0x28, 0x89, 0x17, 0x17, 0xae, 0x48, 0x65, 0x90, 0xb6, 0xae, 0x04,
+	0x69, 0xdd, 0x27, 0x54, 0x58, 0xf8, 0x1c, 0xca, 0x85, 0xf0, 0xff, 0x43, 0xfb, 0x33, 0x06, 0xc3,
+	0x6d, 0xd1, 0x82, 0x81, 0x05, 0x9e, 0x80, 0x71, 0xbf, 0x4e, 0x0d, 0xa6, 0xc4, 0x3a, 0x1c, 0x68,
+	0x18, 0xac, 0x09, 0x30, 0xbc, 0x4e, 0x25, 0x6a, 0xdc, 0xb8, 0x90, 0xd0, 0xd6, 0xcb, 0x11, 0xfa,
+	0xa9, 0x05, 0xce, 0x2e, 0x49, 0x37, 0x78, 0x60, 0x6b, 0x37, 0x8d, 0xfd, 0x94, 0x1c, 0x54, 0x78,
+	0x4d, 0x0f, 0xa1, 0x93, 0x70, 0x20, 0xca, 0x8b, 0xd9, 0x5b, 0x5f, 0x52, 0x09, 0x94, 0xe3, 0x5a,
+	0x93, 0x65, 0x89, 0xc0, 0xdd, 0x80, 0x8e, 0xa8, 0xb5, 0x6d, 0x98, 0xfd, 0xd0, 0x4f, 0xd2, 0x67,
+	0x71, 0x90, 0x92, 0xf8, 0x59, 0x10, 0x26, 0x2c, 0x7c, 0xe0, 0x11, 0x3c, 0x91, 0xcc, 0x59, 0xe8,
+	0xd1, 0x3f, 0x24, 0x64, 0x78, 0x2f, 0x4a, 0x0f, 0xe7, 0x6a, 0x76, 0x17, 0x9a, 0x3b, 0x24, 0x3e,
+	0x20, 0x73, 0x75, 0xd7, 0x81, 0x65, 0x23, 0x55, 0xf4, 0x66, 0xd6, 0xc0, 0xd9, 0x3a, 0xc3, 0xec,
+	0xdc, 0x03, 0x58, 0xde, 0x2a, 0xc1, 0xa5, 0xcd, 0xdc, 0x7a, 0xd9, 0x99, 0xff, 0xa8, 0x86, 0x7e,
+	0xd6, 0x70, 0x10, 0xf4, 0x7c, 0xdc, 0x2b, 0x9e, 0xf8, 0xf1, 0x01, 0x29, 0x9e, 0x02, 0x97, 0xa1,
+	0xed, 0xf7, 0xfb, 0xf4, 0x36, 0x92, 0xc9, 0xb2, 0x28, 0x2a, 0xc9, 0x4c, 0x75, 0x2d, 0x99, 0x49,
+	0x49, 0xa7, 0xc9, 0x36, 0xe6, 0x21, 0x09, 0x65, 0xa0, 0xac, 0xe3, 0x89, 0x22, 0xee, 0x08, 0x74,
+	0x7b, 0xc8, 0x02, 0xff, 0xb2, 0x8c, 0x01, 0x50, 0xfc, 0xbd, 0x7b, 0x1a, 0xf6, 0xe8, 0xc9, 0xac,
+	0x4d, 0x0d, 0xb8, 0x56, 0xf7, 0x52, 0xc7, 0x3e, 0x07, 0x3a, 0x41, 0x98, 0x90, 0xde, 0x28, 0x26,
+	0xf4, 0xd4, 0xd7, 0xf1, 0x64, 0xd9, 0xfd, 0x67, 0x0b, 0x2e, 0xad, 0xf7, 0xfb, 0x05, 0xf6, 0x54,
+	0x3a, 0x1f, 0xe5, 0x7c, 0xf2, 0x87, 0x01, 0x3a, 0xe4, 0x9c, 0x4f, 0xac, 0x44, 0x8f, 0x5b, 0xc3,
+	0x60, 0x97, 0x1e, 0xa1, 0x38, 0xb7, 0xb2, 0x0a, 0x85, 0xbb, 0x4d, 0x8d, 0xbb, 0x8b, 0xd0, 0x4c,
+	0xa3, 0xe7, 0x24, 0xe4, 0xec, 0x62, 0x05, 0xee, 0x3d, 0x45, 0xcc, 0xef, 0xe7, 0x47, 0x37, 0x59,
+	0xa1, 0xcd, 0xb4, 0x93, 0x9b, 0xa9, 0x07, 0x17, 0xcd, 0x13, 0x45, 0x79, 0x7b, 0x1b, 0x5a, 0x29,
+	0x2d, 0x72, 0x45, 0x5e, 0xd1, 0xfc, 0x9f, 0x42, 0x1f, 0x0e, 0xec, 0xfe, 0x1a, 0xac, 0x88, 0x34,
+	0x2f, 0x0d, 0xa0, 0xe2, 0x3c, 0xf7, 0x14, 0x2e, 0x95, 0x75, 0x61, 0xd7, 0xce, 0x6d, 0x86, 0x5b,
+	0x6c, 0xfe, 0x63, 0x46, 0x22, 0xa0, 0xdd, 0x7b, 0x70, 0x25, 0x3b, 0x5a, 0x4c, 0xb8, 0x94, 0xf9,
+	0xa3, 0xde, 0x15, 0xb8, 0x5c, 0x8a, 0x03, 0x35, 0xfc, 0x07, 0x35, 0xe8, 0xca, 0x74, 0xa9, 0x82,
+	0x02, 0xa9, 0x27, 0xf7, 0x5a, 0xee, 0xe4, 0xae, 0x28, 0x46, 0x5d, 0x57, 0x0c, 0xba, 0xa0, 0x74,
+	0x80, 0xdb, 0x22, 0xe8, 0x96, 0x55, 0x28, 0x3b, 0x15, 0x17, 0x0e, 0x56, 0xfa, 0xbf, 0x54, 0x27,
+	0xf7, 0xdb, 0xb0, 0xb0, 0xde, 0xef, 0x4b, 0x3e, 0x54, 0x1e, 0x8b, 0x4a, 0x19, 0x22, 0xa5, 0xbb,
+	0xae, 0x48, 0xb7, 0x7b, 0x0f, 0xe6, 0x75, 0xd4, 0x6c, 0x97, 0x69, 0xb1, 0xc4, 0x34, 0x93, 0x67,
+	0x93, 0xc1, 0x72, 0x20, 0xf7, 0x26, 0x5c, 0xa0, 0xb9, 0x2a, 0xa2, 0xa1, 0x32, 0xb6, 0xb0, 0x90,
+	0x07, 0x45, 0x82, 0x4a, 0xbe, 0x9c, 0x35, 0x49, 0xbe, 0x9c, 0xfb, 0x0e, 0x2c, 0xf1, 0xe3, 0xe5,
+	0x78, 0xa6, 0xe4, 0x65, 0x6e, 0x09, 0x16, 0x0b, 0x7d, 0x51, 0xd6, 0x7e, 0x56, 0x83, 0x16, 0xcb,
+	0xb4, 0x2b, 0x08, 0x9a, 0xc9, 0xe5, 0x70, 0xa0, 0x33, 0x8c, 0xa3, 0xe3, 0x00, 0xc3, 0xa2, 0x3c,
+	0x6c, 0x24, 0xca, 0xe8, 0xb6, 0xf5, 0x0e, 0xfd, 0x01, 0x5e, 0xba, 0x90, 0x47, 0xd8, 0x91, 0x89,
+	0x99, 0x5e, 0x69, 0xbf, 0x01, 0xb3, 0xb2, 0xe2, 0x29, 0xf5, 0x5e, 0x98, 0xc8, 0xe5, 0x6a, 0x91,
+	0xd2, 0x31, 0x89, 0xd9, 0x3d, 0x0a, 0xbb, 0xb5, 0x91, 0x65, 0x55, 0xcc, 0xdb, 0xe5, 0xf6, 0xbf,
+	0x33, 0x46, 0x60, 0xbb, 0xe3, 0x04, 0x16, 0x2a, 0x05, 0x76, 0x2a, 0x2f, 0xb0, 0x7f, 0x65, 0xc1,
+	0xdc, 0x7a, 0xbf, 0xcf, 0xb8, 0x59, 0x19, 0x66, 0x38, 0x13, 0x5b, 0x97, 0xa0, 0xf5, 0xbd, 0x28,
+	0x24, 0x52, 0x6d, 0x79, 0x29, 0x13, 0xed, 0x66, 0xce, 0x70, 0x67, 0x31, 0xb7, 0x56, 0x65, 0xcc,
+	0xad, 0x9d, 0x8f, 0xb9, 0xbd, 0x07, 0xb3, 0xca, 0xf8, 0x51, 0x44, 0xbf, 0x00, 0x2d, 0x96, 0x7e,
+	0xc9, 0x75, 0xc2, 0x94, 0xa0, 0xc9, 0x21, 0x44, 0xa4, 0x8d, 0xd5, 0x26, 0x55, 0x0e, 0xde, 0x9c,
+	0x06, 0xc7, 0x12, 0xc2, 0x64, 0x26, 0xa8, 0x35, 0x3e, 0x13, 0xf4, 0x36, 0x2c, 0x3c, 0x45, 0x51,
+	0x38, 0x1d, 0xc7, 0xea, 0xbc, 0x12, 0x7c, 0x0d, 0xe6, 0xf5, 0x8e, 0x67, 0x9d, 0xe3, 0x6d, 0x58,
+	0x60, 0x5a, 0x74, 0x56, 0xca, 0x0b, 0x30, 0xaf, 0x77, 0x44, 0xdd, 0xfb, 0x63, 0x0b, 0xba, 0xbb,
+	0x87, 0x7e, 0x4c, 0x30, 0x6b, 0xd5, 0xa4, 0x7e, 0xa6, 0xb8, 0xd9, 0x28, 0x1e, 0x88, 0xb8, 0xd9,
+	0x28, 0x1e, 0xe8, 0xf7, 0xeb, 0x8d, 0xdc, 0xfd, 0xba, 0x2e, 0xb0, 0x4d, 0x43, 0x9c, 0x7a, 0x18,
+	0x47, 0x29, 0x3b, 0x3f, 0x33, 0x1d, 0xcb, 0x2a, 0xdc, 0x13, 0x58, 0xda, 0xa0, 0xa0, 0x72, 0x88,
+	0x67, 0x0b, 0x9d, 0x69, 0x23, 0xab, 0xe7, 0x47, 0x86, 0x12, 0xef, 0x27, 0xc9, 0x67, 0x51, 0x2c,
+	0xe4, 0x5a, 0x96, 0xdd, 0x75, 0x58, 0x2c, 0x50, 0xc6, 0x95, 0xba, 0x09, 0x0d, 0x4c, 0x76, 0x36,
+	0xd9, 0xe7, 0x0c, 0x92, 0x82, 0x08, 0xeb, 0x2c, 0xab, 0x2b, 0xe4, 0xf1, 0x1e, 0x2c, 0xe4, 0x41,
+	0x91, 0xd8, 0xff, 0x13, 0xe9, 0xd7, 0x06, 0xdb, 0x9c, 0x51, 0x63, 0x30, 0xcc, 0x32, 0x1f, 0x47,
+	0xcf, 0x27, 0xe1, 0x95, 0xd1, 0x32, 0xe7, 0xfa, 0xa2, 0x74, 0xf8, 0xf4, 0xd8, 0x7c, 0x18, 0x45,
+	0x45, 0xd1, 0xe0, 0x62, 0x50, 0xcb, 0xc4, 0x60, 0x09, 0x5a, 0x34, 0x2d, 0x8e, 0x9d, 0x84, 0xbb,
+	0x1e, 0x2f, 0x55, 0x3f, 0x2e, 0x70, 0xbf, 0x49, 0xf7, 0x41, 0x4e, 0xa5, 0xf2, 0x12, 0x71, 0x32,
+	0x72, 0xee, 0xc7, 0x70, 0x5e, 0x45, 0x98, 0x1d, 0xde, 0xb0, 0x5c, 0x72, 0x78, 0xa3, 0xa0, 0x02,
+	0x06, 0x31, 0x33, 0x83, 0x24, 0x2f, 0x89, 0x68, 0xc9, 0xbd, 0xce, 0x56, 0x89, 0xc3, 0x57, 0x26,
+	0x81, 0xcf, 0xeb, 0x80, 0x6c, 0xab, 0xed, 0x70, 0x02, 0x62, 0x3d, 0x8d, 0xa3, 0x90, 0x40, 0xee,
+	0x1d, 0xb1, 0x5d, 0x8e, 0x65, 0x4e, 0x7e, 0x39, 0x17, 0xc1, 0xce, 0xf5, 0xc4, 0xc5, 0xfc, 0x7b,
+	0x0b, 0x66, 0x79, 0x05, 0xde, 0xe7, 0x8c, 0xe2, 0x62, 0xc8, 0xed, 0x32, 0x74, 0x39, 0xf9, 0xed,
+	0x4d, 0x8e, 0x2f, 0xab, 0x30, 0x68, 0xfe, 0xa2, 0xc8, 0x9c, 0x6c, 0xf0, 0x00, 0x17, 0x16, 0xec,
+	0x65, 0x79, 0xc7, 0x47, 0xf5, 0x7d, 0xda, 0x13, 0x45, 0x1a, 0x2c, 0x4b, 0x53, 0x72, 0x34, 0x4c,
+	0x13, 0x91, 0x51, 0x2e, 0xca, 0xfa, 0xb6, 0xd7, 0xae, 0xdc, 0xf6, 0x3a, 0x79, 0x21, 0x5a, 0x03,
+	0x47, 0x61, 0x38, 0x9f, 0x5d, 0xc5, 0x02, 0x79, 0xb0, 0x6c, 0x84, 0x67, 0x69, 0x04, 0x9d, 0x7d,
+	0x5e, 0xb1, 0x6c, 0x19, 0x03, 0x33, 0x4a, 0x1f, 0x4f, 0xc2, 0xba, 0x7f, 0x63, 0x61, 0xd0, 0xc3,
+	0x8f, 0x7b, 0x87, 0xd5, 0x11, 0xf4, 0x45, 0x8c, 0x90, 0x92, 0xf8, 0x54, 0xa4, 0xb5, 0xd1, 0x82,
+	0xfd, 0x15, 0x68, 0x1c, 0x45, 0x7d, 0x16, 0x46, 0x99, 0xd5, 0x93, 0x0a, 0x0b, 0x48, 0xd7, 0x76,
+	0xa2, 0x3e, 0xf1, 0x28, 0xbc, 0xb4, 0x7a, 0x0d, 0xd3, 0x1b, 0x80, 0xa6, 0xf2, 0x06, 0xc0, 0xfd,
+	0x02, 0x34, 0xb0, 0x9f, 0x3d, 0x03, 0xdd, 0xdd, 0xd1, 0x5e, 0x92, 0xc6, 0x2c, 0x99, 0xb2, 0x03,
+	0x8d, 0xad, 0x41, 0xb4, 0x37, 0x67, 0xe1, 0xd9, 0xdf, 0x23, 0x07, 0xe4, 0x64, 0xae, 0xe6, 0x46,
+	0x70, 0x5e, 0xa5, 0x8a, 0x6c, 0x91, 0xd9, 0xec, 0xd6, 0x64, 0xd9, 0xec, 0x25, 0xe9, 0x8c, 0xe6,
+	0xa3, 0x81, 0xfb, 0x2e, 0x6e, 0x6a, 0xe8, 0x86, 0x8c, 0xb9, 0x54, 0x37, 0x79, 0x2e, 0xee, 0x57,
+	0x71, 0x63, 0x53, 0x3b, 0x4f, 0x7e, 0x6b, 0xe0, 0x81, 0xbd, 0x31, 0x88, 0xc2, 0x17, 0x21, 0x5b,
+	0x16, 0x2b, 0x70, 0xf7, 0x61, 0x4e, 0xc3, 0xf9, 0x2b, 0x7a, 0x6e, 0xe3, 0xfe, 0x9b, 0x05, 0x4b,
+	0xfc, 0x56, 0x4a, 0xbe, 0x0d, 0x38, 0x6b, 0x96, 0x93, 0x9a, 0x0b, 0x5e, 0x1f, 0x97, 0x0b, 0x6e,
+	0xc8, 0xfd, 0x34, 0xd3, 0xff, 0x15, 0xe6, 0x7e, 0xba, 0x21, 0x2c, 0x16, 0x88, 0xb2, 0x6b, 0xd9,
+	0xec, 0xf5, 0x84, 0x35, 0xc9, 0xeb, 0x89, 0x09, 0xaf, 0x41, 0xfe, 0xd0, 0xa2, 0xf7, 0x8b, 0xf8,
+	0x36, 0xac, 0x9c, 0xbb, 0x77, 0xf8, 0x9b, 0x33, 0xc3, 0x9b, 0x0a, 0xbd, 0xef, 0xe7, 0xf7, 0xec,
+	0xec, 0xcb, 0xf4, 0x4a, 0x92, 0xa1, 0x9e, 0x5c, 0xde, 0x9f, 0x41, 0xf7, 0x43, 0x72, 0xe0, 0x0f,
+	0x1e, 0x44, 0x03, 0xea, 0xbd, 0xfb, 0xbd, 0x94, 0x1f, 0x36, 0xbb, 0x1e, 0x2b, 0xb0, 0x9b, 0x77,
+	0x3f, 0xc9, 0xae, 0x5d, 0x58, 0x49, 0xb7, 0xc0, 0xf5, 0xbc, 0x05, 0xde, 0x65, 0x17, 0x0f, 0x02,
+	0x77, 0xa5, 0x20, 0x1e, 0x46, 0x03, 0xb6, 0x5b, 0x75, 0x3c, 0xfa, 0x5b, 0x21, 0x59, 0x57, 0x49,
+	0xba, 0xef, 0xc3, 0xbc, 0x8e, 0x94, 0x7b, 0x60, 0x14, 0x81, 0x29, 0xf6, 0x2f, 0x21, 0x29, 0x88,
+	0xb8, 0x69, 0x18, 0x3b, 0x28, 0x24, 0xb4, 0xf5, 0x32, 0x84, 0x7e, 0xc7, 0x82, 0xf6, 0x87, 0x41,
+	0x8f, 0x84, 0x09, 0x31, 0x46, 0xce, 0x97, 0xa1, 0x3d, 0x60, 0xcd, 0x22, 0x90, 0xc6, 0x8b, 0xe2,
+	0x85, 0x58, 0x3d, 0x7b, 0x21, 0xb6, 0x0a, 0x53, 0x42, 0x5b, 0xb2, 0xf4, 0x07, 0xb5, 0xaa, 0xfa,
+	0x3d, 0xa6, 0xfb, 0x43, 0x8b, 0xdf, 0xd4, 0x50, 0x02, 0x67, 0xb3, 0x08, 0xca, 0x38, 0xeb, 0xc6,
+	0x71, 0x36, 0x4a, 0xc7, 0xd9, 0x2c, 0x8c, 0x93, 0xc7, 0xeb, 0xe5, 0x40, 0xb8, 0x27, 0x26, 0x08,
+	0x18, 0x3c, 0x31, 0x01, 0x2a, 0x60, 0xdc, 0xaf, 0xb2, 0x75, 0x79, 0x81, 0xa9, 0xf0, 0x18, 0xfe,
+	0xcb, 0x10, 0xe7, 0xee, 0x1e, 0xaf, 0x1f, 0xef, 0xee, 0x65, 0x80, 0xdc, 0xdd, 0xe3, 0x88, 0x8c,
+	0xee, 0x9e, 0xa0, 0x26, 0x81, 0xdc, 0xf7, 0x84, 0xbb, 0xf7, 0x42, 0xd3, 0x95, 0x2e, 0x9f, 0x3a,
+	0x63, 0xf7, 0xfb, 0xd0, 0x7e, 0x4a, 0x62, 0xcc, 0x91, 0x45, 0x57, 0x4f, 0x26, 0xce, 0xd6, 0xb6,
+	0x37, 0xcb, 0x92, 0xaa, 0xfd, 0x51, 0x7a, 0x28, 0x2f, 0x2c, 0x79, 0xa9, 0x22, 0xb7, 0xbc, 0xf2,
+	0x70, 0xe7, 0xde, 0x65, 0x1c, 0xe4, 0x43, 0x48, 0x2a, 0x7d, 0x22, 0xe6, 0xb1, 0xd4, 0x54, 0x8f,
+	0x85, 0xf3, 0x35, 0xeb, 0xce, 0xf9, 0x7a, 0xcc, 0x2b, 0x4c, 0x7c, 0xe5, 0xc0, 0x9e, 0x04, 0x72,
+	0x77, 0xe0, 0x82, 0x47, 0x92, 0x34, 0x8a, 0x89, 0x68, 0xab, 0xf2, 0xa3, 0xa5, 0xdf, 0xcb, 0x79,
+	0x94, 0xcf, 0xbc, 0x60, 0x9e, 0x8a, 0x8e, 0x6e, 0x72, 0xf3, 0xfb, 0x84, 0xc5, 0x27, 0x1e, 0x04,
+	0x88, 0xa0, 0xe2, 0x36, 0x28, 0xcb, 0x08, 0xab, 0x69, 0x19, 0x61, 0xc6, 0xd7, 0x9d, 0xee, 0x1f,
+	0xd5, 0x60, 0x4e, 0x43, 0x8b, 0x03, 0x7a, 0x0f, 0x13, 0x8e, 0xd3, 0x38, 0x90, 0xe2, 0xe7, 0xe6,
+	0x3d, 0x36, 0x15, 0x7c, 0x8d, 0xed, 0x49, 0xa2, 0x4b, 0xee, 0x41, 0x65, 0x2d, 0xff, 0xa0, 0xd2,
+	0xf9, 0x33, 0x0b, 0x9a, 0xb4, 0x0b, 0x4a, 0x00, 0x67, 0x75, 0x96, 0x97, 0x2d, 0x2b, 0xfe, 0x37,
+	0xa4, 0x0c, 0x5b, 0x93, 0xd0, 0x1f, 0x26, 0x87, 0x51, 0xca, 0xde, 0xab, 0x75, 0xbd, 0xac, 0xc2,
+	0xfd, 0x5d, 0x0b, 0x3a, 0xbb, 0xbc, 0x64, 0xcc, 0x2f, 0x5a, 0x85, 0xa9, 0x3e, 0x49, 0x7a, 0x71,
+	0x30, 0x54, 0x72, 0x0d, 0xd4, 0x2a, 0x63, 0x72, 0x60, 0x36, 0x89, 0x86, 0x36, 0x89, 0x6a, 0x85,
+	0xf8, 0x04, 0x2e, 0x88, 0xb1, 0xbc, 0x88, 0xc7, 0x99, 0x1b, 0x6a, 0xbd, 0x30, 0x54, 0x77, 0x0b,
+	0x16, 0xf2, 0x04, 0xb8, 0x73, 0x24, 0x38, 0x62, 0x72, 0x8e, 0x44, 0x17, 0x4f, 0x42, 0xb9, 0x37,
+	0x60, 0x91, 0x46, 0x24, 0x04, 0x1f, 0xab, 0x6e, 0xe9, 0xed, 0x1c, 0x24, 0xcb, 0x5b, 0x53, 0x16,
+	0x85, 0x09, 0xa0, 0x99, 0xa4, 0xb2, 0x54, 0x1e, 0x46, 0x30, 0xa8, 0x6a, 0xc9, 0xd6, 0x33, 0xb1,
+	0xc7, 0xa4, 0xae, 0xd4, 0xaa, 0xe6, 0x70, 0x4e, 0xae, 0xaf, 0x77, 0xe1, 0x02, 0xb3, 0xaa, 0x2f,
+	0x34, 0x20, 0xf7, 0x02, 0x2c, 0xe4, 0xbb, 0xa3, 0x55, 0xfe, 0x18, 0x66, 0xd7, 0xe3, 0xde, 0x61,
+	0x50, 0x91, 0x3e, 0x86, 0xd9, 0x01, 0x11, 0x5d, 0x52, 0x71, 0x18, 0xd0, 0x0e, 0xa1, 0xbc, 0xfb,
+	0x37, 0x19, 0x84, 0x27, 0x40, 0xdd, 0x7f, 0xb2, 0x60, 0x56, 0x6f, 0xc3, 0x88, 0x78, 0x1a, 0x8f,
+	0x92, 0x94, 0xf4, 0x77, 0x82, 0x90, 0xf0, 0x38, 0x7f, 0xd7, 0xd3, 0x2b, 0x31, 0x22, 0x4e, 0x4e,
+	0x7a, 0x83, 0x51, 0x5f, 0x82, 0xd5, 0x28, 0x58, 0xae, 0x96, 0x3d, 0xe0, 0x18, 0xa1, 0xe2, 0x6f,
+	0x44, 0x7d, 0x22, 0x42, 0x2f, 0x5a, 0x1d, 0x7f, 0x36, 0xfe, 0x38, 0x0e, 0x78, 0x76, 0x41, 0xc3,
+	0x93, 0x65, 0x76, 0x05, 0x34, 0xfc, 0x80, 0xb9, 0x9d, 0x4d, 0x1a, 0x01, 0xc8, 0x2a, 0xf0, 0x11,
+	0x42, 0x9f, 0xf8, 0x83, 0x9d, 0x20, 0xdc, 0x1c, 0xc5, 0xf4, 0x4a, 0x8a, 0x27, 0xca, 0xe6, 0xab,
+	0x31, 0x21, 0x4f, 0xb2, 0x10, 0x59, 0x7a, 0x03, 0x16, 0x79, 0x59, 0x7f, 0x80, 0x54, 0x14, 0xd7,
+	0x9f, 0x5a, 0x60, 0xe7, 0x40, 0xcd, 0xaf, 0x8e, 0xee, 0xca, 0xfb, 0xa8, 0x5a, 0xf1, 0x69, 0x64,
+	0x11, 0x43, 0x3e, 0x09, 0xf8, 0x32, 0x74, 0xf7, 0x69, 0xd6, 0xec, 0x4e, 0x72, 0xc0, 0x25, 0x32,
+	0xab, 0x70, 0xdf, 0x95, 0xd9, 0x45, 0x33, 0xd0, 0xbd, 0x7f, 0x42, 0x7a, 0xa3, 0x94, 0x1d, 0xc7,
+	0xb3, 0x64, 0x5b, 0x35, 0x05, 0x57, 0x4d, 0xbb, 0xad, 0x63, 0x94, 0x9b, 0xd3, 0xdf, 0x0e, 0xf7,
+	0xa3, 0xf2, 0xa9, 0xfe, 0xb2, 0x06, 0x73, 0x1a, 0xa0, 0x79, 0xa2, 0xef, 0x43, 0xdb, 0x67, 0x50,
+	0x5c, 0xd4, 0xae, 0x19, 0x66, 0x2a, 0x11, 0x88, 0x0a, 0x4f, 0x74, 0xb2, 0x6f, 0x43, 0x27, 0xe9,
+	0x1d, 0x92, 0xfe, 0x68, 0xc0, 0xbc, 0xc6, 0xa9, 0x5b, 0x97, 0x4c, 0xac, 0xe2, 0x20, 0x9e, 0x04,
+	0x46, 0x19, 0x8f, 0x49, 0x48, 0x3e, 0xf3, 0x07, 0xcb, 0x8d, 0x52, 0x19, 0xf7, 0x18, 0x84, 0x27,
+	0x40, 0x9d, 0x3f, 0xb1, 0xa0, 0xcd, 0xdb, 0x0c, 0xcf, 0xf8, 0xbf, 0x06, 0x4d, 0x94, 0x15, 0x71,
+	0x14, 0xbb, 0x39, 0xc9, 0x54, 0xd6, 0x36, 0x89, 0x3f, 0xf0, 0x58, 0x3f, 0xe7, 0x7d, 0x68, 0x60,
+	0x11, 0x6d, 0xed, 0x30, 0x8e, 0x86, 0x51, 0xe2, 0x0f, 0x36, 0x24, 0x09, 0xb5, 0x0a, 0x37, 0xe3,
+	0x23, 0xd4, 0x0a, 0x71, 0x36, 0xa3, 0x05, 0xf7, 0x2f, 0x6b, 0x70, 0x3e, 0x37, 0x65, 0x76, 0x57,
+	0x9d, 0x92, 0xf8, 0xd8, 0x1f, 0xf0, 0x04, 0x32, 0x59, 0x46, 0x8d, 0x22, 0xc7, 0x24, 0x3e, 0xdd,
+	0xe0, 0x4f, 0x57, 0x98, 0x07, 0xa4, 0xd5, 0xe1, 0xce, 0x28, 0x5e, 0xb6, 0xb0, 0x8d, 0x5f, 0x14,
+	0xf5, 0x6c, 0xb0, 0x46, 0x2e, 0x1b, 0xcc, 0xfe, 0x2a, 0xb4, 0x0f, 0xd9, 0x26, 0xbf, 0xdc, 0xa4,
+	0xec, 0xb8, 0x5a, 0xb1, 0x30, 0x6b, 0xde, 0x28, 0xf4, 0x04, 0xbc, 0x93, 0x40, 0xdd, 0x1b, 0x85,
+	0x38, 0xc7, 0xd8, 0xcf, 0xf2, 0xde, 0x58, 0xc1, 0xf0, 0xa2, 0x63, 0x11, 0x9a, 0xdf, 0x8d, 0xf6,
+	0xb6, 0x45, 0x28, 0x84, 0x15, 0x70, 0xdc, 0xc9, 0xf3, 0x60, 0x38, 0x24, 0x7d, 0xf1, 0x40, 0x80,
+	0x17, 0xb3, 0xcc, 0xb8, 0xa6, 0x9a, 0x19, 0x77, 0x04, 0x17, 0x77, 0x49, 0x9a, 0x17, 0x98, 0xaa,
+	0x4b, 0x57, 0xc9, 0xd6, 0xda, 0x18, 0xb6, 0xd6, 0x8b, 0x6c, 0x75, 0x3d, 0x78, 0xc5, 0x44, 0x8e,
+	0xdd, 0xcd, 0x67, 0x32, 0x6d, 0x9d, 0x41, 0xa6, 0xdd, 0xbf, 0xb5, 0x14, 0xe3, 0x4e, 0x05, 0x16,
+	0xd7, 0x28, 0x3d, 0x8c, 0x49, 0x22, 0x0f, 0x93, 0x75, 0x2f, 0xab, 0x40, 0x39, 0xa3, 0x37, 0x12,
+	0xa7, 0xf7, 0x87, 0x51, 0x8f, 0x39, 0x4a, 0x0d, 0x4f, 0xad, 0xc2, 0x69, 0x8e, 0xc2, 0xfd, 0x51,
+	0xd8, 0x97, 0xaf, 0xb9, 0x64, 0x19, 0xad, 0x3b, 0xc6, 0x48, 0x37, 0x0e, 0x49, 0xef, 0xb9, 0x12,
+	0x5f, 0xd7, 0x2b, 0x91, 0x06, 0xf5, 0xdd, 0xb0, 0x42, 0xba, 0x25, 0x6a, 0x95, 0x1e, 0x7c, 0x6d,
+	0xe5, 0x82, 0xaf, 0xee, 0x37, 0x68, 0x2a, 0x50, 0x4e, 0x21, 0x4b, 0x97, 0x45, 0x9b, 0x6f, 0x2d,
+	0x37, 0x5f, 0xf7, 0x11, 0x2c, 0x19, 0x70, 0x21, 0xcf, 0x15, 0x73, 0x60, 0x4d, 0x6c, 0x0e, 0x14,
+	0x63, 0xa8, 0x7e, 0x04, 0xa8, 0x68, 0x0c, 0x7f, 0xd8, 0x82, 0x39, 0x0d, 0x10, 0x49, 0x7e, 0x1d,
+	0x3a, 0xdc, 0x8a, 0x09, 0x27, 0xc5, 0x64, 0xfb, 0x24, 0xbc, 0x1c, 0x84, 0xec, 0xe5, 0xfc, 0x79,
+	0xb3, 0xca, 0x1a, 0x49, 0xb5, 0xa8, 0xa9, 0x6a, 0x71, 0x57, 0xcb, 0xc9, 0x7b, 0xb9, 0x9d, 0xa5,
+	0x91, 0xdb, 0x59, 0x68, 0xce, 0xce, 0x5e, 0x14, 0xe3, 0x75, 0x1a, 0xcf, 0x4b, 0xe2, 0x45, 0xf4,
+	0xe9, 0xf9, 0x4f, 0xec, 0xc8, 0x16, 0x59, 0xa9, 0xd1, 0x5d, 0xd7, 0x76, 0xde, 0xcb, 0x46, 0x1b,
+	0x34, 0x8a, 0x63, 0x12, 0xb2, 0xf0, 0x7b, 0xc7, 0x13, 0xc5, 0xcc, 0xe4, 0x76, 0x4b, 0x4d, 0x6e,
+	0x81, 0x83, 0x9a, 0xc9, 0xfd, 0x45, 0xed, 0xe5, 0x6c, 0x2e, 0x3a, 0xe3, 0x88, 0x89, 0x9b, 0x9f,
+	0x86, 0xc7, 0x4b, 0x08, 0x8d, 0x3c, 0x13, 0xe7, 0x09, 0x56, 0xa8, 0xc8, 0xdc, 0xba, 0x06, 0x33,
+	0x43, 0x74, 0x53, 0x1e, 0x93, 0x98, 0x69, 0x63, 0x8b, 0xa2, 0xd3, 0x2b, 0x91, 0x8f, 0x49, 0xea,
+	0xc7, 0x29, 0x03, 0x69, 0x53, 0x10, 0xa5, 0x06, 0xf5, 0xb5, 0x2f, 0xdc, 0x97, 0x0e, 0xf3, 0x7f,
+	0x44, 0x19, 0x3d, 0x1c, 0xbf, 0x97, 0xe2, 0xdb, 0x85, 0x20, 0x0a, 0x19, 0x02, 0x96, 0x02, 0x90,
+	0xaf, 0xce, 0xdb, 0x05, 0x28, 0xda, 0x05, 0xe5, 0xbc, 0x34, 0x55, 0x38, 0x2f, 0x65, 0x01, 0xa2,
+	0xe9, 0x7c, 0x80, 0xe8, 0x3b, 0xf2, 0x40, 0x3c, 0xd6, 0x0b, 0xa5, 0xdb, 0xcb, 0x67, 0xec, 0x24,
+	0xc1, 0x23, 0x76, 0x59, 0x85, 0xe9, 0x4d, 0x98, 0xbb, 0x03, 0x0b, 0x79, 0xe4, 0xdc, 0xeb, 0x38,
+	0x4a, 0x0e, 0x04, 0xea, 0xa3, 0xe4, 0x60, 0xc2, 0xe8, 0xeb, 0x75, 0x58, 0xe0, 0x78, 0x9e, 0xe1,
+	0xb3, 0xdb, 0x72, 0xf5, 0x7e, 0x1d, 0xe6, 0x75, 0x40, 0x23, 0x55, 0xf7, 0x4f, 0x2d, 0xf6, 0xf1,
+	0x0f, 0x96, 0xfe, 0x88, 0x2b, 0xb2, 0x01, 0x70, 0x1c, 0x44, 0x03, 0x3f, 0x55, 0x22, 0x0a, 0x85,
+	0x2f, 0x42, 0x48, 0xf0, 0xb5, 0xa7, 0x02, 0xd6, 0x53, 0xba, 0x39, 0x0f, 0xa1, 0x2b, 0x1b, 0xe8,
+	0x31, 0x44, 0xec, 0x1b, 0x78, 0x0c, 0x41, 0x0f, 0xa0, 0xe4, 0x1c, 0xdc, 0x27, 0xa9, 0x1f, 0x88,
+	0x1b, 0x35, 0x5e, 0xba, 0xf5, 0x1f, 0xb7, 0xa0, 0xbe, 0xfe, 0x78, 0x1b, 0x83, 0xca, 0xa8, 0x37,
+	0xf6, 0x2b, 0x25, 0x9f, 0x30, 0x73, 0x2e, 0x14, 0x1b, 0xd0, 0x17, 0x3e, 0x87, 0x3d, 0xf1, 0x4b,
+	0x5f, 0x7a, 0x4f, 0xe5, 0x7b, 0x63, 0xce, 0x85, 0x62, 0x83, 0xec, 0x89, 0xdc, 0xd7, 0x7b, 0x2a,
+	0x9f, 0xe9, 0x72, 0x2e, 0x14, 0x1b, 0x58, 0xcf, 0x77, 0xa1, 0x49, 0xaf, 0x28, 0xec, 0x65, 0xc3,
+	0xad, 0x05, 0xeb, 0x5b, 0x72, 0x9f, 0xe1, 0x9e, 0xb3, 0x37, 0xa1, 0x23, 0xee, 0x90, 0xec, 0x4b,
+	0xa6, 0x9b, 0x25, 0x81, 0xe2, 0xa2, 0xb9, 0x91, 0x61, 0x79, 0xcc, 0x3e, 0xf2, 0x24, 0x9e, 0x1b,
+	0xdb, 0x57, 0xf3, 0xc0, 0xb9, 0x37, 0xcb, 0xce, 0x4a, 0x39, 0x00, 0xc3, 0xf8, 0x00, 0x3a, 0xe2,
+	0xe3, 0x12, 0xfa, 0xb8, 0x72, 0xdf, 0xc0, 0x71, 0x2e, 0x9a, 0x1b, 0x29, 0x96, 0x1b, 0xd6, 0x9b,
+	0x96, 0xfd, 0x10, 0xba, 0xa2, 0x3a, 0xb1, 0x2f, 0x57, 0x7d, 0x3e, 0xc3, 0x71, 0x4a, 0x5a, 0x33,
+	0x64, 0x3b, 0x30, 0xa5, 0x7c, 0xbd, 0xc1, 0xbe, 0xa2, 0x1d, 0xac, 0x0b, 0x1f, 0x95, 0x70, 0x2e,
+	0x97, 0xb6, 0x4b, 0xbe, 0xa9, 0x9f, 0x61, 0xd0, 0xf9, 0x66, 0xf8, 0xac, 0x83, 0xb3, 0x52, 0x0e,
+	0xc0, 0x30, 0x3e, 0x02, 0xc8, 0x3e, 0x4d, 0x60, 0xaf, 0x54, 0x7e, 0x3b, 0xc1, 0xb9, 0x54, 0xd6,
+	0x9c, 0x4d, 0xf8, 0x29, 0xcc, 0xea, 0x1f, 0x22, 0xb0, 0xb5, 0x57, 0xd7, 0xc6, 0x6f, 0x1b, 0x38,
+	0x57, 0xab, 0x40, 0xe4, 0xcc, 0xd5, 0xcf, 0x06, 0xe8, 0x33, 0x37, 0x7c, 0x85, 0xc0, 0x59, 0x29,
+	0x07, 0x60, 0x18, 0x3f, 0x80, 0x8e, 0x78, 0xb8, 0x9f, 0x97, 0x98, 0xc1, 0xa0, 0x42, 0x62, 0x94,
+	0xb7, 0xfe, 0xee, 0xb9, 0x37, 0x2d, 0xdb, 0x83, 0x69, 0xf5, 0xe9, 0xbc, 0x7d, 0x35, 0x0f, 0x5e,
+	0x29, 0xcb, 0x85, 0x57, 0xf7, 0x14, 0xe7, 0x1d, 0x68, 0xe0, 0xfb, 0x74, 0x5d, 0xb9, 0x95, 0x57,
+	0xf7, 0xce, 0x85, 0x62, 0x83, 0xd4, 0x4f, 0xf1, 0x18, 0x5c, 0x9f, 0x55, 0xee, 0xb5, 0xb9, 0x73,
+	0xd1, 0xdc, 0x28, 0xb1, 0x88, 0x27, 0xde, 0x3a, 0x96, 0xdc, 0x1b, 0x72, 0xe7, 0xa2, 0xb9, 0x51,
+	0x62, 0x11, 0x4f, 0xb4, 0xf3, 0x1c, 0xae, 0x18, 0x8b, 0xf6, 0xaa, 0xdb, 0x3d, 0x87, 0xfc, 0x55,
+	0x1f, 0x67, 0xeb, 0xfc, 0x35, 0xbc, 0xef, 0x76, 0x56, 0xca, 0x01, 0x94, 0x35, 0xdb, 0x3e, 0x2a,
+	0xc3, 0xb9, 0x7d, 0x34, 0x06, 0x67, 0xe1, 0x2d, 0x34, 0xca, 0xbe, 0xbd, 0x0b, 0x33, 0xda, 0x1b,
+	0x54, 0x7b, 0xb5, 0xa0, 0xcc, 0xb9, 0xc7, 0xb7, 0xce, 0x95, 0x0a, 0x08, 0x36, 0xf9, 0x1d, 0xf6,
+	0xc5, 0x4c, 0x56, 0x99, 0xe8, 0xf6, 0xa3, 0xf8, 0x52, 0xd5, 0xb9, 0x5c, 0xda, 0x9e, 0xd3, 0x22,
+	0x3e, 0x44, 0x83, 0x16, 0xe9, 0x23, 0x5c, 0x29, 0x07, 0x60, 0x18, 0x09, 0x2c, 0x18, 0xde, 0x88,
+	0xda, 0xa5, 0xcf, 0xdf, 0xf5, 0x47, 0xa9, 0xce, 0xb5, 0xb1, 0x70, 0x8c, 0xcc, 0x3a, 0xb4, 0xf9,
+	0x5d, 0xb2, 0xed, 0x18, 0x6e, 0xb5, 0x05, 0xba, 0x65, 0x63, 0x1b, 0x43, 0xf1, 0xbe, 0xf8, 0xfa,
+	0x82, 0xad, 0x89, 0x9b, 0xf6, 0x3a, 0xd4, 0x79, 0xc5, 0xd4, 0xc4, 0xfa, 0x7f, 0x03, 0x20, 0x7b,
+	0xae, 0x69, 0xaf, 0x14, 0x01, 0xd5, 0x81, 0x5c, 0x2a, 0x6b, 0x96, 0x9a, 0x21, 0x5e, 0x4e, 0xea,
+	0x9a, 0x91, 0x7b, 0xd6, 0xe9, 0x5c, 0x34, 0x37, 0x4a, 0x2c, 0xe2, 0x5d, 0xa1, 0x8e, 0x25, 0xf7,
+	0x58, 0xd1, 0xb9, 0x68, 0x6e, 0x54, 0x2d, 0x86, 0x01, 0xcb, 0x56, 0x15, 0x96, 0xad, 0x1c, 0x96,
+	0xc7, 0xf4, 0x92, 0x3b, 0x7b, 0x2d, 0x77, 0x35, 0x47, 0x32, 0xff, 0x88, 0xcc, 0x59, 0x29, 0x07,
+	0x90, 0x18, 0xb7, 0x4a, 0x31, 0x6e, 0x8d, 0xc3, 0xb8, 0x65, 0xc0, 0xf8, 0x0d, 0x80, 0xec, 0x55,
+	0x92, 0x9d, 0x1f, 0x80, 0xfe, 0xd6, 0xc8, 0xb9, 0x54, 0xd6, 0x2c, 0x71, 0x6d, 0x95, 0xe0, 0xda,
+	0xaa, 0xc6, 0xb5, 0x55, 0xc0, 0x45, 0x60, 0xc1, 0xf0, 0x76, 0x46, 0xd7, 0xa1, 0xf2, 0xc7, 0x35,
+	0xce, 0xb5, 0xb1, 0x70, 0x92, 0xcc, 0xd6, 0x38, 0x32, 0x5b, 0x13, 0x92, 0xd9, 0x2a, 0x27, 0x73,
+	0x08, 0x8b, 0xa6, 0x27, 0x1d, 0xf6, 0x75, 0xed, 0xb4, 0x59, 0xfe, 0xba, 0xc5, 0x79, 0x7d, 0x3c,
+	0x20, 0xa3, 0x14, 0xc2, 0x92, 0xf9, 0xd5, 0x86, 0x7d, 0xd3, 0xe4, 0x6f, 0x1b, 0x1f, 0x83, 0x38,
+	0xd7, 0x27, 0x01, 0x65, 0xf4, 0x3e, 0x85, 0x57, 0x4a, 0x5e, 0x62, 0xd8, 0x5f, 0x30, 0xdb, 0x0d,
+	0xe3, 0xfc, 0x6e, 0x4c, 0x04, 0x2b, 0x95, 0x40, 0x7d, 0x7b, 0xa0, 0x2b, 0x81, 0xe1, 0xc1, 0x83,
+	0xb3, 0x52, 0x0e, 0xc0, 0x30, 0x3e, 0x85, 0x59, 0xfd, 0x79, 0x81, 0x5d, 0xf8, 0xf0, 0x72, 0xe1,
+	0x95, 0x82, 0x73, 0xb5, 0x0a, 0x84, 0xe1, 0xfd, 0xb6, 0x7c, 0xcd, 0x2e, 0x07, 0xeb, 0x1a, 0x8c,
+	0x60, 0x7e, 0xbc, 0xab, 0x95, 0x30, 0x0c, 0xf5, 0x16, 0x74, 0x65, 0xa6, 0xb9, 0xee, 0x91, 0xe7,
+	0x13, 0xe8, 0x1d, 0xa7, 0xa4, 0x55, 0xdb, 0x4d, 0x59, 0xa5, 0x61, 0x37, 0xd5, 0xb3, 0xd1, 0x9d,
+	0xcb, 0xa5, 0xed, 0x72, 0x71, 0xd4, 0x04, 0x71, 0x7d, 0x71, 0x0c, 0x39, 0xe7, 0xce, 0x4a, 0x39,
+	0x80, 0xc4, 0xa8, 0x26, 0x7e, 0xeb, 0x18, 0x0d, 0xb9, 0xe4, 0xce, 0x4a, 0x39, 0x80, 0x5c, 0x96,
+	0x5c, 0x76, 0xb4, 0xbe, 0x2c, 0xe6, 0xa4, 0x6d, 0x67, 0xb5, 0x12, 0x46, 0x93, 0x24, 0x59, 0x6f,
+	0x90, 0xa4, 0x42, 0x46, 0xb5, 0x73, 0xb5, 0x0a, 0x44, 0x91, 0x24, 0x2d, 0xc5, 0x39, 0x2f, 0x49,
+	0xa6, 0xdc, 0x69, 0x67, 0xb5, 0x12, 0x46, 0x5a, 0xed, 0x2c, 0xe3, 0xd8, 0xce, 0xeb, 0x8a, 0x9e,
+	0xbd, 0xeb, 0x5c, 0x2a, 0x6b, 0xd6, 0xce, 0xb0, 0xbc, 0x36, 0x29, 0x9e, 0x61, 0x73, 0xd9, 0xc7,
+	0xce, 0x4a, 0x39, 0x00, 0xc3, 0xb8, 0x2b, 0xbe, 0x55, 0x21, 0x06, 0x68, 0x50, 0x8e, 0xdc, 0x18,
+	0xaf, 0x54, 0x40, 0x48, 0xab, 0x6f, 0x48, 0xa0, 0xd5, 0xad, 0x7e, 0x79, 0x46, 0xae, 0x73, 0x6d,
+	0x2c, 0x9c, 0xb2, 0xb7, 0x8a, 0x3c, 0xd4, 0xfc, 0xde, 0x9a, 0xcb, 0x8a, 0x75, 0x2e, 0x95, 0x35,
+	0x2b, 0x5a, 0x90, 0x65, 0x89, 0xe6, 0xb5, 0xa0, 0x90, 0x7c, 0xea, 0xac, 0x94, 0x03, 0x48, 0xc5,
+	0x57, 0x12, 0x3d, 0x75, 0xc5, 0x2f, 0x66, 0x95, 0x3a, 0x97, 0x4b, 0xdb, 0xa5, 0x84, 0xe6, 0x32,
+	0x1b, 0x6d, 0x77, 0x7c, 0xae, 0xa5, 0xb3, 0x5a, 0x09, 0xa3, 0x3a, 0xba, 0x98, 0x2c, 0x58, 0x70,
+	0x74, 0x95, 0xe4, 0x44, 0x67, 0xd9, 0xd8, 0xa6, 0xb9, 0x62, 0x32, 0x79, 0xb0, 0xe0, 0x8a, 0xe5,
+	0xb2, 0xec, 0x9c, 0x95, 0x72, 0x00, 0xcd, 0x15, 0x33, 0x63, 0xdc, 0x1a, 0x87, 0x71, 0xcb, 0x80,
+	0x91, 0xb9, 0x62, 0x22, 0x11, 0xaf, 0xe8, 0x0b, 0xaa, 0x79, 0x55, 0xce, 0xa5, 0xb2, 0x66, 0xd5,
+	0x15, 0x33, 0xe2, 0xda, 0xaa, 0xc6, 0xb5, 0x55, 0xc0, 0xc5, 0x95, 0x9a, 0xd7, 0x1a, 0x94, 0x3a,
+	0x97, 0x63, 0xe6, 0xac, 0x94, 0x03, 0xe4, 0x94, 0x5a, 0x0c, 0xd0, 0xa0, 0xd4, 0xb9, 0x31, 0x5e,
+	0xa9, 0x80, 0xd0, 0x86, 0x29, 0xf2, 0xad, 0x8a, 0xc3, 0xcc, 0x25, 0x72, 0x39, 0x2b, 0xe5, 0x00,
+	0xd2, 0x98, 0xeb, 0xc9, 0x52, 0xba, 0x31, 0x37, 0xe6, 0x65, 0x39, 0x57, 0xab, 0x40, 0xb4, 0x2d,
+	0x97, 0x67, 0x30, 0x15, 0xb7, 0x5c, 0x3d, 0xc1, 0xca, 0xb9, 0x5c, 0xda, 0x2e, 0x87, 0xa9, 0x67,
+	0xcd, 0xe8, 0xc3, 0x34, 0xa6, 0xec, 0x38, 0x57, 0xab, 0x40, 0xe4, 0x2a, 0x69, 0xa9, 0x31, 0xf6,
+	0x6a, 0x61, 0x9f, 0xca, 0xe5, 0xd7, 0x38, 0x57, 0x2a, 0x20, 0x94, 0x8d, 0x4c, 0xcb, 0x68, 0xc9,
+	0x6f, 0x64, 0xa6, 0x14, 0x1a, 0x67, 0xb5, 0x12, 0x46, 0x59, 0x2e, 0x35, 0x5f, 0x25, 0xbf, 0x5c,
+	0x86, 0x54, 0x18, 0xe7, 0x6a, 0x15, 0x88, 0x34, 0x3f, 0xe2, 0x8e, 0xcc, 0x7c, 0xa7, 0x67, 0x30,
+	0x3f, 0x5a, 0x7a, 0x07, 0x65, 0xa5, 0x76, 0x33, 0xa6, 0xb3, 0xd2, 0x94, 0xfb, 0xe1, 0x5c, 0xa9,
+	0x80, 0x90, 0x62, 0xa4, 0xe4, 0x04, 0xd8, 0x57, 0x4a, 0x93, 0x05, 0x0c, 0x62, 0x94, 0x4f, 0x26,
+	0xd0, 0xd0, 0xd1, 0xb8, 0xfd, 0x95, 0xd2, 0x8b, 0xb0, 0x72, 0x74, 0x6a, 0x14, 0xdf, 0x83, 0x69,
+	0xf5, 0x4a, 0xc3, 0x36, 0x5d, 0xde, 0xab, 0xb7, 0x22, 0xce, 0x4a, 0x39, 0x80, 0x08, 0x51, 0xed,
+	0x81, 0x5d, 0xbc, 0xf2, 0xb6, 0x5f, 0xcf, 0x99, 0x42, 0xf3, 0x0d, 0xbc, 0xf3, 0xda, 0x38, 0x30,
+	0x36, 0xee, 0x4f, 0x60, 0x3e, 0x6b, 0x14, 0x97, 0xe0, 0xd7, 0xcc, 0x7d, 0xf5, 0xcb, 0x64, 0xc7,
+	0x1d, 0x03, 0xc5, 0x08, 0x7c, 0x2c, 0xad, 0x8a, 0x90, 0x2a, 0x93, 0x55, 0xc9, 0x09, 0xd7, 0xd5,
+	0x2a, 0x10, 0xce, 0x9e, 0x7b, 0x77, 0xe0, 0x95, 0x20, 0x5a, 0x4b, 0xc9, 0x49, 0x1a, 0x0c, 0x88,
+	0xe8, 0xf0, 0xc9, 0x41, 0x3c, 0xec, 0xdd, 0x9b, 0x7d, 0xc2, 0x6a, 0x99, 0x86, 0x27, 0x8f, 0xad,
+	0x1f, 0xd7, 0xe0, 0xc9, 0x93, 0x4f, 0xee, 0x7d, 0xb4, 0xf1, 0xf0, 0xfe, 0x93, 0xdd, 0xbd, 0x16,
+	0xfd, 0xbf, 0x3c, 0x6f, 0xfd, 0xcf, 0x00, 0x55, 0x1c, 0xf5, 0xb7, 0xa8, 0x67, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIClient is the client API for API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIClient interface {
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListReply, error)
+	Init(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitReply, error)
+	Root(ctx context.Context, in *RootRequest, opts ...grpc.CallOption) (*RootReply, error)
+	Links(ctx context.Context, in *LinksRequest, opts ...grpc.CallOption) (*LinksReply, error)
+	ListPath(ctx context.Context, in *ListPathRequest, opts ...grpc.CallOption) (*ListPathReply, error)
+	ListIpfsPath(ctx context.Context, in *ListIpfsPathRequest, opts ...grpc.CallOption) (*ListIpfsPathReply, error)
+	PushPath(ctx context.Context, opts ...grpc.CallOption) (API_PushPathClient, error)
+	PushPaths(ctx context.Context, opts ...grpc.CallOption) (API_PushPathsClient, error)
+	StartUpload(ctx context.Context, in *StartUploadRequest, opts ...grpc.CallOption) (*StartUploadReply, error)
+	UploadStatus(ctx context.Context, in *UploadStatusRequest, opts ...grpc.CallOption) (*UploadStatusReply, error)
+	PushUpload(ctx context.Context, opts ...grpc.CallOption) (API_PushUploadClient, error)
+	CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadReply, error)
+	CancelUpload(ctx context.Context, in *CancelUploadRequest, opts ...grpc.CallOption) (*CancelUploadReply, error)
+	PullPath(ctx context.Context, in *PullPathRequest, opts ...grpc.CallOption) (API_PullPathClient, error)
+	PullIpfsPath(ctx context.Context, in *PullIpfsPathRequest, opts ...grpc.CallOption) (API_PullIpfsPathClient, error)
+	Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffReply, error)
+	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockReply, error)
+	HasBlock(ctx context.Context, in *HasBlockRequest, opts ...grpc.CallOption) (*HasBlockReply, error)
+	PutBlock(ctx context.Context, in *PutBlockRequest, opts ...grpc.CallOption) (*PutBlockReply, error)
+	ExportBucket(ctx context.Context, in *ExportBucketRequest, opts ...grpc.CallOption) (API_ExportBucketClient, error)
+	ImportBucket(ctx context.Context, opts ...grpc.CallOption) (API_ImportBucketClient, error)
+	StartS3Import(ctx context.Context, in *StartS3ImportRequest, opts ...grpc.CallOption) (*StartS3ImportReply, error)
+	ListImports(ctx context.Context, in *ListImportsRequest, opts ...grpc.CallOption) (*ListImportsReply, error)
+	CancelImport(ctx context.Context, in *CancelImportRequest, opts ...grpc.CallOption) (*CancelImportReply, error)
+	ImportBucketIPNSKey(ctx context.Context, in *ImportBucketIPNSKeyRequest, opts ...grpc.CallOption) (*ImportBucketIPNSKeyReply, error)
+	SetPath(ctx context.Context, in *SetPathRequest, opts ...grpc.CallOption) (*SetPathReply, error)
+	Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveReply, error)
+	RemovePath(ctx context.Context, in *RemovePathRequest, opts ...grpc.CallOption) (*RemovePathReply, error)
+	MovePath(ctx context.Context, in *MovePathRequest, opts ...grpc.CallOption) (*MovePathReply, error)
+	SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*SetQuotaReply, error)
+	GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*GetQuotaReply, error)
+	SetLifecycle(ctx context.Context, in *SetLifecycleRequest, opts ...grpc.CallOption) (*SetLifecycleReply, error)
+	GetLifecycle(ctx context.Context, in *GetLifecycleRequest, opts ...grpc.CallOption) (*GetLifecycleReply, error)
+	SetWebsite(ctx context.Context, in *SetWebsiteRequest, opts ...grpc.CallOption) (*SetWebsiteReply, error)
+	GetWebsite(ctx context.Context, in *GetWebsiteRequest, opts ...grpc.CallOption) (*GetWebsiteReply, error)
+	SetConflictStrategy(ctx context.Context, in *SetConflictStrategyRequest, opts ...grpc.CallOption) (*SetConflictStrategyReply, error)
+	GetConflictStrategy(ctx context.Context, in *GetConflictStrategyRequest, opts ...grpc.CallOption) (*GetConflictStrategyReply, error)
+	AddReplicationTarget(ctx context.Context, in *AddReplicationTargetRequest, opts ...grpc.CallOption) (*AddReplicationTargetReply, error)
+	ListReplicationTargets(ctx context.Context, in *ListReplicationTargetsRequest, opts ...grpc.CallOption) (*ListReplicationTargetsReply, error)
+	RemoveReplicationTarget(ctx context.Context, in *RemoveReplicationTargetRequest, opts ...grpc.CallOption) (*RemoveReplicationTargetReply, error)
+	AddPinMirror(ctx context.Context, in *AddPinMirrorRequest, opts ...grpc.CallOption) (*AddPinMirrorReply, error)
+	ListPinMirrors(ctx context.Context, in *ListPinMirrorsRequest, opts ...grpc.CallOption) (*ListPinMirrorsReply, error)
+	RemovePinMirror(ctx context.Context, in *RemovePinMirrorRequest, opts ...grpc.CallOption) (*RemovePinMirrorReply, error)
+	AddDomain(ctx context.Context, in *AddDomainRequest, opts ...grpc.CallOption) (*AddDomainReply, error)
+	ListDomains(ctx context.Context, in *ListDomainsRequest, opts ...grpc.CallOption) (*ListDomainsReply, error)
+	VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainReply, error)
+	RemoveDomain(ctx context.Context, in *RemoveDomainRequest, opts ...grpc.CallOption) (*RemoveDomainReply, error)
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkReply, error)
+	ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksReply, error)
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkReply, error)
+	AddWebhook(ctx context.Context, in *AddWebhookRequest, opts ...grpc.CallOption) (*AddWebhookReply, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksReply, error)
+	RemoveWebhook(ctx context.Context, in *RemoveWebhookRequest, opts ...grpc.CallOption) (*RemoveWebhookReply, error)
+	ListWebhookFailures(ctx context.Context, in *ListWebhookFailuresRequest, opts ...grpc.CallOption) (*ListWebhookFailuresReply, error)
+	SearchPath(ctx context.Context, in *SearchPathRequest, opts ...grpc.CallOption) (*SearchPathReply, error)
+	RenameBucket(ctx context.Context, in *RenameBucketRequest, opts ...grpc.CallOption) (*RenameBucketReply, error)
+	CloneBucket(ctx context.Context, in *CloneBucketRequest, opts ...grpc.CallOption) (*CloneBucketReply, error)
+	SetPathMetadata(ctx context.Context, in *SetPathMetadataRequest, opts ...grpc.CallOption) (*SetPathMetadataReply, error)
+	SetTags(ctx context.Context, in *SetTagsRequest, opts ...grpc.CallOption) (*SetTagsReply, error)
+	SetLegalHold(ctx context.Context, in *SetLegalHoldRequest, opts ...grpc.CallOption) (*SetLegalHoldReply, error)
+	GetLegalHold(ctx context.Context, in *GetLegalHoldRequest, opts ...grpc.CallOption) (*GetLegalHoldReply, error)
+	SetLicense(ctx context.Context, in *SetLicenseRequest, opts ...grpc.CallOption) (*SetLicenseReply, error)
+	GetLicense(ctx context.Context, in *GetLicenseRequest, opts ...grpc.CallOption) (*GetLicenseReply, error)
+	ListLicenses(ctx context.Context, in *ListLicensesRequest, opts ...grpc.CallOption) (*ListLicensesReply, error)
+	RemoveLicense(ctx context.Context, in *RemoveLicenseRequest, opts ...grpc.CallOption) (*RemoveLicenseReply, error)
+	ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsReply, error)
+	RestoreVersion(ctx context.Context, in *RestoreVersionRequest, opts ...grpc.CallOption) (*RestoreVersionReply, error)
+	ListHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryReply, error)
+	SnapshotBucket(ctx context.Context, in *SnapshotBucketRequest, opts ...grpc.CallOption) (*SnapshotBucketReply, error)
+	ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsReply, error)
+	RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotReply, error)
+	RemoveSnapshot(ctx context.Context, in *RemoveSnapshotRequest, opts ...grpc.CallOption) (*RemoveSnapshotReply, error)
+	// Archive
+	Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (*ArchiveReply, error)
+	ArchiveStatus(ctx context.Context, in *ArchiveStatusRequest, opts ...grpc.CallOption) (*ArchiveStatusReply, error)
+	ArchiveInfo(ctx context.Context, in *ArchiveInfoRequest, opts ...grpc.CallOption) (*ArchiveInfoReply, error)
+	ArchiveList(ctx context.Context, in *ArchiveListRequest, opts ...grpc.CallOption) (*ArchiveListReply, error)
+	ArchiveWatch(ctx context.Context, in *ArchiveWatchRequest, opts ...grpc.CallOption) (API_ArchiveWatchClient, error)
+	SetArchiveSchedule(ctx context.Context, in *SetArchiveScheduleRequest, opts ...grpc.CallOption) (*SetArchiveScheduleReply, error)
+	SetArchiveRenewal(ctx context.Context, in *SetArchiveRenewalRequest, opts ...grpc.CallOption) (*SetArchiveRenewalReply, error)
+	RestoreArchive(ctx context.Context, in *RestoreArchiveRequest, opts ...grpc.CallOption) (API_RestoreArchiveClient, error)
+}
+
+type aPIClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIClient(cc *grpc.ClientConn) APIClient {
+	return &aPIClient{cc}
+}
+
+func (c *aPIClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListReply, error) {
+	out := new(ListReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Init(ctx context.Context, in *InitRequest, opts ...grpc.CallOption) (*InitReply, error) {
+	out := new(InitReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/Init", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Root(ctx context.Context, in *RootRequest, opts ...grpc.CallOption) (*RootReply, error) {
+	out := new(RootReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/Root", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Links(ctx context.Context, in *LinksRequest, opts ...grpc.CallOption) (*LinksReply, error) {
+	out := new(LinksReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/Links", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListPath(ctx context.Context, in *ListPathRequest, opts ...grpc.CallOption) (*ListPathReply, error) {
+	out := new(ListPathReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListIpfsPath(ctx context.Context, in *ListIpfsPathRequest, opts ...grpc.CallOption) (*ListIpfsPathReply, error) {
+	out := new(ListIpfsPathReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListIpfsPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PushPath(ctx context.Context, opts ...grpc.CallOption) (API_PushPathClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/buckets.pb.API/PushPath", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIPushPathClient{stream}
+	return x, nil
+}
+
+type API_PushPathClient interface {
+	Send(*PushPathRequest) error
+	Recv() (*PushPathReply, error)
+	grpc.ClientStream
+}
+
+type aPIPushPathClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIPushPathClient) Send(m *PushPathRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIPushPathClient) Recv() (*PushPathReply, error) {
+	m := new(PushPathReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) PushPaths(ctx context.Context, opts ...grpc.CallOption) (API_PushPathsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[1], "/buckets.pb.API/PushPaths", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIPushPathsClient{stream}
+	return x, nil
+}
+
+type API_PushPathsClient interface {
+	Send(*PushPathsRequest) error
+	Recv() (*PushPathsReply, error)
+	grpc.ClientStream
+}
+
+type aPIPushPathsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIPushPathsClient) Send(m *PushPathsRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIPushPathsClient) Recv() (*PushPathsReply, error) {
+	m := new(PushPathsReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) StartUpload(ctx context.Context, in *StartUploadRequest, opts ...grpc.CallOption) (*StartUploadReply, error) {
+	out := new(StartUploadReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/StartUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UploadStatus(ctx context.Context, in *UploadStatusRequest, opts ...grpc.CallOption) (*UploadStatusReply, error) {
+	out := new(UploadStatusReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/UploadStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PushUpload(ctx context.Context, opts ...grpc.CallOption) (API_PushUploadClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[2], "/buckets.pb.API/PushUpload", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIPushUploadClient{stream}
+	return x, nil
+}
+
+type API_PushUploadClient interface {
+	Send(*PushUploadRequest) error
+	Recv() (*PushUploadReply, error)
+	grpc.ClientStream
+}
+
+type aPIPushUploadClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIPushUploadClient) Send(m *PushUploadRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIPushUploadClient) Recv() (*PushUploadReply, error) {
+	m := new(PushUploadReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) CompleteUpload(ctx context.Context, in *CompleteUploadRequest, opts ...grpc.CallOption) (*CompleteUploadReply, error) {
+	out := new(CompleteUploadReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/CompleteUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CancelUpload(ctx context.Context, in *CancelUploadRequest, opts ...grpc.CallOption) (*CancelUploadReply, error) {
+	out := new(CancelUploadReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/CancelUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PullPath(ctx context.Context, in *PullPathRequest, opts ...grpc.CallOption) (API_PullPathClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/buckets.pb.API/PullPath", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIPullPathClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_PullPathClient interface {
+	Recv() (*PullPathReply, error)
+	grpc.ClientStream
+}
+
+type aPIPullPathClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIPullPathClient) Recv() (*PullPathReply, error) {
+	m := new(PullPathReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) PullIpfsPath(ctx context.Context, in *PullIpfsPathRequest, opts ...grpc.CallOption) (API_PullIpfsPathClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[4], "/buckets.pb.API/PullIpfsPath", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIPullIpfsPathClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_PullIpfsPathClient interface {
+	Recv() (*PullIpfsPathReply, error)
+	grpc.ClientStream
+}
+
+type aPIPullIpfsPathClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIPullIpfsPathClient) Recv() (*PullIpfsPathReply, error) {
+	m := new(PullIpfsPathReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) Diff(ctx context.Context, in *DiffRequest, opts ...grpc.CallOption) (*DiffReply, error) {
+	out := new(DiffReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/Diff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockReply, error) {
+	out := new(GetBlockReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/GetBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) HasBlock(ctx context.Context, in *HasBlockRequest, opts ...grpc.CallOption) (*HasBlockReply, error) {
+	out := new(HasBlockReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/HasBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PutBlock(ctx context.Context, in *PutBlockRequest, opts ...grpc.CallOption) (*PutBlockReply, error) {
+	out := new(PutBlockReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/PutBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ExportBucket(ctx context.Context, in *ExportBucketRequest, opts ...grpc.CallOption) (API_ExportBucketClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[5], "/buckets.pb.API/ExportBucket", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIExportBucketClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ExportBucketClient interface {
+	Recv() (*ExportBucketReply, error)
+	grpc.ClientStream
+}
+
+type aPIExportBucketClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIExportBucketClient) Recv() (*ExportBucketReply, error) {
+	m := new(ExportBucketReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) ImportBucket(ctx context.Context, opts ...grpc.CallOption) (API_ImportBucketClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[6], "/buckets.pb.API/ImportBucket", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIImportBucketClient{stream}
+	return x, nil
+}
+
+type API_ImportBucketClient interface {
+	Send(*ImportBucketRequest) error
+	CloseAndRecv() (*ImportBucketReply, error)
+	grpc.ClientStream
+}
+
+type aPIImportBucketClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIImportBucketClient) Send(m *ImportBucketRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *aPIImportBucketClient) CloseAndRecv() (*ImportBucketReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportBucketReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) StartS3Import(ctx context.Context, in *StartS3ImportRequest, opts ...grpc.CallOption) (*StartS3ImportReply, error) {
+	out := new(StartS3ImportReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/StartS3Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListImports(ctx context.Context, in *ListImportsRequest, opts ...grpc.CallOption) (*ListImportsReply, error) {
+	out := new(ListImportsReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListImports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CancelImport(ctx context.Context, in *CancelImportRequest, opts ...grpc.CallOption) (*CancelImportReply, error) {
+	out := new(CancelImportReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/CancelImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ImportBucketIPNSKey(ctx context.Context, in *ImportBucketIPNSKeyRequest, opts ...grpc.CallOption) (*ImportBucketIPNSKeyReply, error) {
+	out := new(ImportBucketIPNSKeyReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ImportBucketIPNSKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetPath(ctx context.Context, in *SetPathRequest, opts ...grpc.CallOption) (*SetPathReply, error) {
+	out := new(SetPathReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Remove(ctx context.Context, in *RemoveRequest, opts ...grpc.CallOption) (*RemoveReply, error) {
+	out := new(RemoveReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/Remove", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemovePath(ctx context.Context, in *RemovePathRequest, opts ...grpc.CallOption) (*RemovePathReply, error) {
+	out := new(RemovePathReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/RemovePath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) MovePath(ctx context.Context, in *MovePathRequest, opts ...grpc.CallOption) (*MovePathReply, error) {
+	out := new(MovePathReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/MovePath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*SetQuotaReply, error) {
+	out := new(SetQuotaReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*GetQuotaReply, error) {
+	out := new(GetQuotaReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/GetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetLifecycle(ctx context.Context, in *SetLifecycleRequest, opts ...grpc.CallOption) (*SetLifecycleReply, error) {
+	out := new(SetLifecycleReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetLifecycle(ctx context.Context, in *GetLifecycleRequest, opts ...grpc.CallOption) (*GetLifecycleReply, error) {
+	out := new(GetLifecycleReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/GetLifecycle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetWebsite(ctx context.Context, in *SetWebsiteRequest, opts ...grpc.CallOption) (*SetWebsiteReply, error) {
+	out := new(SetWebsiteReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetWebsite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetWebsite(ctx context.Context, in *GetWebsiteRequest, opts ...grpc.CallOption) (*GetWebsiteReply, error) {
+	out := new(GetWebsiteReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/GetWebsite", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetConflictStrategy(ctx context.Context, in *SetConflictStrategyRequest, opts ...grpc.CallOption) (*SetConflictStrategyReply, error) {
+	out := new(SetConflictStrategyReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetConflictStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetConflictStrategy(ctx context.Context, in *GetConflictStrategyRequest, opts ...grpc.CallOption) (*GetConflictStrategyReply, error) {
+	out := new(GetConflictStrategyReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/GetConflictStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) AddReplicationTarget(ctx context.Context, in *AddReplicationTargetRequest, opts ...grpc.CallOption) (*AddReplicationTargetReply, error) {
+	out := new(AddReplicationTargetReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/AddReplicationTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListReplicationTargets(ctx context.Context, in *ListReplicationTargetsRequest, opts ...grpc.CallOption) (*ListReplicationTargetsReply, error) {
+	out := new(ListReplicationTargetsReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListReplicationTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemoveReplicationTarget(ctx context.Context, in *RemoveReplicationTargetRequest, opts ...grpc.CallOption) (*RemoveReplicationTargetReply, error) {
+	out := new(RemoveReplicationTargetReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/RemoveReplicationTarget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) AddPinMirror(ctx context.Context, in *AddPinMirrorRequest, opts ...grpc.CallOption) (*AddPinMirrorReply, error) {
+	out := new(AddPinMirrorReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/AddPinMirror", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListPinMirrors(ctx context.Context, in *ListPinMirrorsRequest, opts ...grpc.CallOption) (*ListPinMirrorsReply, error) {
+	out := new(ListPinMirrorsReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListPinMirrors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemovePinMirror(ctx context.Context, in *RemovePinMirrorRequest, opts ...grpc.CallOption) (*RemovePinMirrorReply, error) {
+	out := new(RemovePinMirrorReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/RemovePinMirror", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) AddDomain(ctx context.Context, in *AddDomainRequest, opts ...grpc.CallOption) (*AddDomainReply, error) {
+	out := new(AddDomainReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/AddDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListDomains(ctx context.Context, in *ListDomainsRequest, opts ...grpc.CallOption) (*ListDomainsReply, error) {
+	out := new(ListDomainsReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListDomains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) VerifyDomain(ctx context.Context, in *VerifyDomainRequest, opts ...grpc.CallOption) (*VerifyDomainReply, error) {
+	out := new(VerifyDomainReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/VerifyDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemoveDomain(ctx context.Context, in *RemoveDomainRequest, opts ...grpc.CallOption) (*RemoveDomainReply, error) {
+	out := new(RemoveDomainReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/RemoveDomain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkReply, error) {
+	out := new(CreateShareLinkReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/CreateShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksReply, error) {
+	out := new(ListShareLinksReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListShareLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkReply, error) {
+	out := new(RevokeShareLinkReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/RevokeShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) AddWebhook(ctx context.Context, in *AddWebhookRequest, opts ...grpc.CallOption) (*AddWebhookReply, error) {
+	out := new(AddWebhookReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/AddWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksReply, error) {
+	out := new(ListWebhooksReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListWebhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemoveWebhook(ctx context.Context, in *RemoveWebhookRequest, opts ...grpc.CallOption) (*RemoveWebhookReply, error) {
+	out := new(RemoveWebhookReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/RemoveWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListWebhookFailures(ctx context.Context, in *ListWebhookFailuresRequest, opts ...grpc.CallOption) (*ListWebhookFailuresReply, error) {
+	out := new(ListWebhookFailuresReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListWebhookFailures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SearchPath(ctx context.Context, in *SearchPathRequest, opts ...grpc.CallOption) (*SearchPathReply, error) {
+	out := new(SearchPathReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SearchPath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RenameBucket(ctx context.Context, in *RenameBucketRequest, opts ...grpc.CallOption) (*RenameBucketReply, error) {
+	out := new(RenameBucketReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/RenameBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) CloneBucket(ctx context.Context, in *CloneBucketRequest, opts ...grpc.CallOption) (*CloneBucketReply, error) {
+	out := new(CloneBucketReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/CloneBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetPathMetadata(ctx context.Context, in *SetPathMetadataRequest, opts ...grpc.CallOption) (*SetPathMetadataReply, error) {
+	out := new(SetPathMetadataReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetPathMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetTags(ctx context.Context, in *SetTagsRequest, opts ...grpc.CallOption) (*SetTagsReply, error) {
+	out := new(SetTagsReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetLegalHold(ctx context.Context, in *SetLegalHoldRequest, opts ...grpc.CallOption) (*SetLegalHoldReply, error) {
+	out := new(SetLegalHoldReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetLegalHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetLegalHold(ctx context.Context, in *GetLegalHoldRequest, opts ...grpc.CallOption) (*GetLegalHoldReply, error) {
+	out := new(GetLegalHoldReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/GetLegalHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetLicense(ctx context.Context, in *SetLicenseRequest, opts ...grpc.CallOption) (*SetLicenseReply, error) {
+	out := new(SetLicenseReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetLicense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetLicense(ctx context.Context, in *GetLicenseRequest, opts ...grpc.CallOption) (*GetLicenseReply, error) {
+	out := new(GetLicenseReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/GetLicense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListLicenses(ctx context.Context, in *ListLicensesRequest, opts ...grpc.CallOption) (*ListLicensesReply, error) {
+	out := new(ListLicensesReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListLicenses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemoveLicense(ctx context.Context, in *RemoveLicenseRequest, opts ...grpc.CallOption) (*RemoveLicenseReply, error) {
+	out := new(RemoveLicenseReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/RemoveLicense", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListVersions(ctx context.Context, in *ListVersionsRequest, opts ...grpc.CallOption) (*ListVersionsReply, error) {
+	out := new(ListVersionsReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RestoreVersion(ctx context.Context, in *RestoreVersionRequest, opts ...grpc.CallOption) (*RestoreVersionReply, error) {
+	out := new(RestoreVersionReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/RestoreVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListHistory(ctx context.Context, in *ListHistoryRequest, opts ...grpc.CallOption) (*ListHistoryReply, error) {
+	out := new(ListHistoryReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SnapshotBucket(ctx context.Context, in *SnapshotBucketRequest, opts ...grpc.CallOption) (*SnapshotBucketReply, error) {
+	out := new(SnapshotBucketReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SnapshotBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListSnapshots(ctx context.Context, in *ListSnapshotsRequest, opts ...grpc.CallOption) (*ListSnapshotsReply, error) {
+	out := new(ListSnapshotsReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ListSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RestoreSnapshot(ctx context.Context, in *RestoreSnapshotRequest, opts ...grpc.CallOption) (*RestoreSnapshotReply, error) {
+	out := new(RestoreSnapshotReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/RestoreSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RemoveSnapshot(ctx context.Context, in *RemoveSnapshotRequest, opts ...grpc.CallOption) (*RemoveSnapshotReply, error) {
+	out := new(RemoveSnapshotReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/RemoveSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) Archive(ctx context.Context, in *ArchiveRequest, opts ...grpc.CallOption) (*ArchiveReply, error) {
+	out := new(ArchiveReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/Archive", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ArchiveStatus(ctx context.Context, in *ArchiveStatusRequest, opts ...grpc.CallOption) (*ArchiveStatusReply, error) {
+	out := new(ArchiveStatusReply)
 	err := c.cc.Invoke(ctx, "/buckets.pb.API/ArchiveStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *aPIClient) ArchiveInfo(ctx context.Context, in *ArchiveInfoRequest, opts ...grpc.CallOption) (*ArchiveInfoReply, error) {
+	out := new(ArchiveInfoReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ArchiveInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ArchiveList(ctx context.Context, in *ArchiveListRequest, opts ...grpc.CallOption) (*ArchiveListReply, error) {
+	out := new(ArchiveListReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/ArchiveList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ArchiveWatch(ctx context.Context, in *ArchiveWatchRequest, opts ...grpc.CallOption) (API_ArchiveWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[7], "/buckets.pb.API/ArchiveWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIArchiveWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_ArchiveWatchClient interface {
+	Recv() (*ArchiveWatchReply, error)
+	grpc.ClientStream
+}
+
+type aPIArchiveWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIArchiveWatchClient) Recv() (*ArchiveWatchReply, error) {
+	m := new(ArchiveWatchReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) SetArchiveSchedule(ctx context.Context, in *SetArchiveScheduleRequest, opts ...grpc.CallOption) (*SetArchiveScheduleReply, error) {
+	out := new(SetArchiveScheduleReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetArchiveSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetArchiveRenewal(ctx context.Context, in *SetArchiveRenewalRequest, opts ...grpc.CallOption) (*SetArchiveRenewalReply, error) {
+	out := new(SetArchiveRenewalReply)
+	err := c.cc.Invoke(ctx, "/buckets.pb.API/SetArchiveRenewal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RestoreArchive(ctx context.Context, in *RestoreArchiveRequest, opts ...grpc.CallOption) (API_RestoreArchiveClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[8], "/buckets.pb.API/RestoreArchive", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIRestoreArchiveClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_RestoreArchiveClient interface {
+	Recv() (*RestoreArchiveReply, error)
+	grpc.ClientStream
+}
+
+type aPIRestoreArchiveClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIRestoreArchiveClient) Recv() (*RestoreArchiveReply, error) {
+	m := new(RestoreArchiveReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// APIServer is the server API for API service.
+type APIServer interface {
+	List(context.Context, *ListRequest) (*ListReply, error)
+	Init(context.Context, *InitRequest) (*InitReply, error)
+	Root(context.Context, *RootRequest) (*RootReply, error)
+	Links(context.Context, *LinksRequest) (*LinksReply, error)
+	ListPath(context.Context, *ListPathRequest) (*ListPathReply, error)
+	ListIpfsPath(context.Context, *ListIpfsPathRequest) (*ListIpfsPathReply, error)
+	PushPath(API_PushPathServer) error
+	PushPaths(API_PushPathsServer) error
+	StartUpload(context.Context, *StartUploadRequest) (*StartUploadReply, error)
+	UploadStatus(context.Context, *UploadStatusRequest) (*UploadStatusReply, error)
+	PushUpload(API_PushUploadServer) error
+	CompleteUpload(context.Context, *CompleteUploadRequest) (*CompleteUploadReply, error)
+	CancelUpload(context.Context, *CancelUploadRequest) (*CancelUploadReply, error)
+	PullPath(*PullPathRequest, API_PullPathServer) error
+	PullIpfsPath(*PullIpfsPathRequest, API_PullIpfsPathServer) error
+	Diff(context.Context, *DiffRequest) (*DiffReply, error)
+	GetBlock(context.Context, *GetBlockRequest) (*GetBlockReply, error)
+	HasBlock(context.Context, *HasBlockRequest) (*HasBlockReply, error)
+	PutBlock(context.Context, *PutBlockRequest) (*PutBlockReply, error)
+	ExportBucket(*ExportBucketRequest, API_ExportBucketServer) error
+	ImportBucket(API_ImportBucketServer) error
+	StartS3Import(context.Context, *StartS3ImportRequest) (*StartS3ImportReply, error)
+	ListImports(context.Context, *ListImportsRequest) (*ListImportsReply, error)
+	CancelImport(context.Context, *CancelImportRequest) (*CancelImportReply, error)
+	ImportBucketIPNSKey(context.Context, *ImportBucketIPNSKeyRequest) (*ImportBucketIPNSKeyReply, error)
+	SetPath(context.Context, *SetPathRequest) (*SetPathReply, error)
+	Remove(context.Context, *RemoveRequest) (*RemoveReply, error)
+	RemovePath(context.Context, *RemovePathRequest) (*RemovePathReply, error)
+	MovePath(context.Context, *MovePathRequest) (*MovePathReply, error)
+	SetQuota(context.Context, *SetQuotaRequest) (*SetQuotaReply, error)
+	GetQuota(context.Context, *GetQuotaRequest) (*GetQuotaReply, error)
+	SetLifecycle(context.Context, *SetLifecycleRequest) (*SetLifecycleReply, error)
+	GetLifecycle(context.Context, *GetLifecycleRequest) (*GetLifecycleReply, error)
+	SetWebsite(context.Context, *SetWebsiteRequest) (*SetWebsiteReply, error)
+	GetWebsite(context.Context, *GetWebsiteRequest) (*GetWebsiteReply, error)
+	SetConflictStrategy(context.Context, *SetConflictStrategyRequest) (*SetConflictStrategyReply, error)
+	GetConflictStrategy(context.Context, *GetConflictStrategyRequest) (*GetConflictStrategyReply, error)
+	AddReplicationTarget(context.Context, *AddReplicationTargetRequest) (*AddReplicationTargetReply, error)
+	ListReplicationTargets(context.Context, *ListReplicationTargetsRequest) (*ListReplicationTargetsReply, error)
+	RemoveReplicationTarget(context.Context, *RemoveReplicationTargetRequest) (*RemoveReplicationTargetReply, error)
+	AddPinMirror(context.Context, *AddPinMirrorRequest) (*AddPinMirrorReply, error)
+	ListPinMirrors(context.Context, *ListPinMirrorsRequest) (*ListPinMirrorsReply, error)
+	RemovePinMirror(context.Context, *RemovePinMirrorRequest) (*RemovePinMirrorReply, error)
+	AddDomain(context.Context, *AddDomainRequest) (*AddDomainReply, error)
+	ListDomains(context.Context, *ListDomainsRequest) (*ListDomainsReply, error)
+	VerifyDomain(context.Context, *VerifyDomainRequest) (*VerifyDomainReply, error)
+	RemoveDomain(context.Context, *RemoveDomainRequest) (*RemoveDomainReply, error)
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkReply, error)
+	ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksReply, error)
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkReply, error)
+	AddWebhook(context.Context, *AddWebhookRequest) (*AddWebhookReply, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksReply, error)
+	RemoveWebhook(context.Context, *RemoveWebhookRequest) (*RemoveWebhookReply, error)
+	ListWebhookFailures(context.Context, *ListWebhookFailuresRequest) (*ListWebhookFailuresReply, error)
+	SearchPath(context.Context, *SearchPathRequest) (*SearchPathReply, error)
+	RenameBucket(context.Context, *RenameBucketRequest) (*RenameBucketReply, error)
+	CloneBucket(context.Context, *CloneBucketRequest) (*CloneBucketReply, error)
+	SetPathMetadata(context.Context, *SetPathMetadataRequest) (*SetPathMetadataReply, error)
+	SetTags(context.Context, *SetTagsRequest) (*SetTagsReply, error)
+	SetLegalHold(context.Context, *SetLegalHoldRequest) (*SetLegalHoldReply, error)
+	GetLegalHold(context.Context, *GetLegalHoldRequest) (*GetLegalHoldReply, error)
+	SetLicense(context.Context, *SetLicenseRequest) (*SetLicenseReply, error)
+	GetLicense(context.Context, *GetLicenseRequest) (*GetLicenseReply, error)
+	ListLicenses(context.Context, *ListLicensesRequest) (*ListLicensesReply, error)
+	RemoveLicense(context.Context, *RemoveLicenseRequest) (*RemoveLicenseReply, error)
+	ListVersions(context.Context, *ListVersionsRequest) (*ListVersionsReply, error)
+	RestoreVersion(context.Context, *RestoreVersionRequest) (*RestoreVersionReply, error)
+	ListHistory(context.Context, *ListHistoryRequest) (*ListHistoryReply, error)
+	SnapshotBucket(context.Context, *SnapshotBucketRequest) (*SnapshotBucketReply, error)
+	ListSnapshots(context.Context, *ListSnapshotsRequest) (*ListSnapshotsReply, error)
+	RestoreSnapshot(context.Context, *RestoreSnapshotRequest) (*RestoreSnapshotReply, error)
+	RemoveSnapshot(context.Context, *RemoveSnapshotRequest) (*RemoveSnapshotReply, error)
+	// Archive
+	Archive(context.Context, *ArchiveRequest) (*ArchiveReply, error)
+	ArchiveStatus(context.Context, *ArchiveStatusRequest) (*ArchiveStatusReply, error)
+	ArchiveInfo(context.Context, *ArchiveInfoRequest) (*ArchiveInfoReply, error)
+	ArchiveList(context.Context, *ArchiveListRequest) (*ArchiveListReply, error)
+	ArchiveWatch(*ArchiveWatchRequest, API_ArchiveWatchServer) error
+	SetArchiveSchedule(context.Context, *SetArchiveScheduleRequest) (*SetArchiveScheduleReply, error)
+	SetArchiveRenewal(context.Context, *SetArchiveRenewalRequest) (*SetArchiveRenewalReply, error)
+	RestoreArchive(*RestoreArchiveRequest, API_RestoreArchiveServer) error
+}
+
+// UnimplementedAPIServer can be embedded to have forward compatible implementations.
+type UnimplementedAPIServer struct {
+}
+
+func (*UnimplementedAPIServer) List(ctx context.Context, req *ListRequest) (*ListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedAPIServer) Init(ctx context.Context, req *InitRequest) (*InitReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
+}
+func (*UnimplementedAPIServer) Root(ctx context.Context, req *RootRequest) (*RootReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Root not implemented")
+}
+func (*UnimplementedAPIServer) Links(ctx context.Context, req *LinksRequest) (*LinksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Links not implemented")
+}
+func (*UnimplementedAPIServer) ListPath(ctx context.Context, req *ListPathRequest) (*ListPathReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPath not implemented")
+}
+func (*UnimplementedAPIServer) ListIpfsPath(ctx context.Context, req *ListIpfsPathRequest) (*ListIpfsPathReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIpfsPath not implemented")
+}
+func (*UnimplementedAPIServer) PushPath(srv API_PushPathServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushPath not implemented")
+}
+func (*UnimplementedAPIServer) PushPaths(srv API_PushPathsServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushPaths not implemented")
+}
+func (*UnimplementedAPIServer) StartUpload(ctx context.Context, req *StartUploadRequest) (*StartUploadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartUpload not implemented")
+}
+func (*UnimplementedAPIServer) UploadStatus(ctx context.Context, req *UploadStatusRequest) (*UploadStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadStatus not implemented")
+}
+func (*UnimplementedAPIServer) PushUpload(srv API_PushUploadServer) error {
+	return status.Errorf(codes.Unimplemented, "method PushUpload not implemented")
+}
+func (*UnimplementedAPIServer) CompleteUpload(ctx context.Context, req *CompleteUploadRequest) (*CompleteUploadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteUpload not implemented")
+}
+func (*UnimplementedAPIServer) CancelUpload(ctx context.Context, req *CancelUploadRequest) (*CancelUploadReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelUpload not implemented")
+}
+func (*UnimplementedAPIServer) PullPath(req *PullPathRequest, srv API_PullPathServer) error {
+	return status.Errorf(codes.Unimplemented, "method PullPath not implemented")
+}
+func (*UnimplementedAPIServer) PullIpfsPath(req *PullIpfsPathRequest, srv API_PullIpfsPathServer) error {
+	return status.Errorf(codes.Unimplemented, "method PullIpfsPath not implemented")
+}
+func (*UnimplementedAPIServer) Diff(ctx context.Context, req *DiffRequest) (*DiffReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Diff not implemented")
+}
+func (*UnimplementedAPIServer) GetBlock(ctx context.Context, req *GetBlockRequest) (*GetBlockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlock not implemented")
+}
+func (*UnimplementedAPIServer) HasBlock(ctx context.Context, req *HasBlockRequest) (*HasBlockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasBlock not implemented")
+}
+func (*UnimplementedAPIServer) PutBlock(ctx context.Context, req *PutBlockRequest) (*PutBlockReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutBlock not implemented")
+}
+func (*UnimplementedAPIServer) ExportBucket(req *ExportBucketRequest, srv API_ExportBucketServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportBucket not implemented")
+}
+func (*UnimplementedAPIServer) ImportBucket(srv API_ImportBucketServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportBucket not implemented")
+}
+func (*UnimplementedAPIServer) StartS3Import(ctx context.Context, req *StartS3ImportRequest) (*StartS3ImportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartS3Import not implemented")
+}
+func (*UnimplementedAPIServer) ListImports(ctx context.Context, req *ListImportsRequest) (*ListImportsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListImports not implemented")
+}
+func (*UnimplementedAPIServer) CancelImport(ctx context.Context, req *CancelImportRequest) (*CancelImportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelImport not implemented")
+}
+func (*UnimplementedAPIServer) ImportBucketIPNSKey(ctx context.Context, req *ImportBucketIPNSKeyRequest) (*ImportBucketIPNSKeyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportBucketIPNSKey not implemented")
+}
+func (*UnimplementedAPIServer) SetPath(ctx context.Context, req *SetPathRequest) (*SetPathReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPath not implemented")
+}
+func (*UnimplementedAPIServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+}
+func (*UnimplementedAPIServer) RemovePath(ctx context.Context, req *RemovePathRequest) (*RemovePathReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePath not implemented")
+}
+func (*UnimplementedAPIServer) MovePath(ctx context.Context, req *MovePathRequest) (*MovePathReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePath not implemented")
+}
+func (*UnimplementedAPIServer) SetQuota(ctx context.Context, req *SetQuotaRequest) (*SetQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuota not implemented")
+}
+func (*UnimplementedAPIServer) GetQuota(ctx context.Context, req *GetQuotaRequest) (*GetQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (*UnimplementedAPIServer) SetLifecycle(ctx context.Context, req *SetLifecycleRequest) (*SetLifecycleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLifecycle not implemented")
+}
+func (*UnimplementedAPIServer) GetLifecycle(ctx context.Context, req *GetLifecycleRequest) (*GetLifecycleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLifecycle not implemented")
+}
+func (*UnimplementedAPIServer) SetWebsite(ctx context.Context, req *SetWebsiteRequest) (*SetWebsiteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWebsite not implemented")
+}
+func (*UnimplementedAPIServer) GetWebsite(ctx context.Context, req *GetWebsiteRequest) (*GetWebsiteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebsite not implemented")
+}
+func (*UnimplementedAPIServer) SetConflictStrategy(ctx context.Context, req *SetConflictStrategyRequest) (*SetConflictStrategyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConflictStrategy not implemented")
+}
+func (*UnimplementedAPIServer) GetConflictStrategy(ctx context.Context, req *GetConflictStrategyRequest) (*GetConflictStrategyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConflictStrategy not implemented")
+}
+func (*UnimplementedAPIServer) AddReplicationTarget(ctx context.Context, req *AddReplicationTargetRequest) (*AddReplicationTargetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddReplicationTarget not implemented")
+}
+func (*UnimplementedAPIServer) ListReplicationTargets(ctx context.Context, req *ListReplicationTargetsRequest) (*ListReplicationTargetsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReplicationTargets not implemented")
+}
+func (*UnimplementedAPIServer) RemoveReplicationTarget(ctx context.Context, req *RemoveReplicationTargetRequest) (*RemoveReplicationTargetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveReplicationTarget not implemented")
+}
+func (*UnimplementedAPIServer) AddPinMirror(ctx context.Context, req *AddPinMirrorRequest) (*AddPinMirrorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPinMirror not implemented")
+}
+func (*UnimplementedAPIServer) ListPinMirrors(ctx context.Context, req *ListPinMirrorsRequest) (*ListPinMirrorsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPinMirrors not implemented")
+}
+func (*UnimplementedAPIServer) RemovePinMirror(ctx context.Context, req *RemovePinMirrorRequest) (*RemovePinMirrorReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePinMirror not implemented")
+}
+func (*UnimplementedAPIServer) AddDomain(ctx context.Context, req *AddDomainRequest) (*AddDomainReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDomain not implemented")
+}
+func (*UnimplementedAPIServer) ListDomains(ctx context.Context, req *ListDomainsRequest) (*ListDomainsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDomains not implemented")
+}
+func (*UnimplementedAPIServer) VerifyDomain(ctx context.Context, req *VerifyDomainRequest) (*VerifyDomainReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyDomain not implemented")
+}
+func (*UnimplementedAPIServer) RemoveDomain(ctx context.Context, req *RemoveDomainRequest) (*RemoveDomainReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDomain not implemented")
+}
+func (*UnimplementedAPIServer) CreateShareLink(ctx context.Context, req *CreateShareLinkRequest) (*CreateShareLinkReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShareLink not implemented")
+}
+func (*UnimplementedAPIServer) ListShareLinks(ctx context.Context, req *ListShareLinksRequest) (*ListShareLinksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShareLinks not implemented")
+}
+func (*UnimplementedAPIServer) RevokeShareLink(ctx context.Context, req *RevokeShareLinkRequest) (*RevokeShareLinkReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeShareLink not implemented")
+}
+func (*UnimplementedAPIServer) AddWebhook(ctx context.Context, req *AddWebhookRequest) (*AddWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWebhook not implemented")
+}
+func (*UnimplementedAPIServer) ListWebhooks(ctx context.Context, req *ListWebhooksRequest) (*ListWebhooksReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (*UnimplementedAPIServer) RemoveWebhook(ctx context.Context, req *RemoveWebhookRequest) (*RemoveWebhookReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebhook not implemented")
+}
+func (*UnimplementedAPIServer) ListWebhookFailures(ctx context.Context, req *ListWebhookFailuresRequest) (*ListWebhookFailuresReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhookFailures not implemented")
+}
+func (*UnimplementedAPIServer) SearchPath(ctx context.Context, req *SearchPathRequest) (*SearchPathReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchPath not implemented")
+}
+func (*UnimplementedAPIServer) RenameBucket(ctx context.Context, req *RenameBucketRequest) (*RenameBucketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameBucket not implemented")
+}
+func (*UnimplementedAPIServer) CloneBucket(ctx context.Context, req *CloneBucketRequest) (*CloneBucketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneBucket not implemented")
+}
+func (*UnimplementedAPIServer) SetPathMetadata(ctx context.Context, req *SetPathMetadataRequest) (*SetPathMetadataReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPathMetadata not implemented")
+}
+func (*UnimplementedAPIServer) SetTags(ctx context.Context, req *SetTagsRequest) (*SetTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTags not implemented")
+}
+func (*UnimplementedAPIServer) SetLegalHold(ctx context.Context, req *SetLegalHoldRequest) (*SetLegalHoldReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLegalHold not implemented")
+}
+func (*UnimplementedAPIServer) GetLegalHold(ctx context.Context, req *GetLegalHoldRequest) (*GetLegalHoldReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLegalHold not implemented")
+}
+func (*UnimplementedAPIServer) SetLicense(ctx context.Context, req *SetLicenseRequest) (*SetLicenseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLicense not implemented")
+}
+func (*UnimplementedAPIServer) GetLicense(ctx context.Context, req *GetLicenseRequest) (*GetLicenseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLicense not implemented")
+}
+func (*UnimplementedAPIServer) ListLicenses(ctx context.Context, req *ListLicensesRequest) (*ListLicensesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLicenses not implemented")
+}
+func (*UnimplementedAPIServer) RemoveLicense(ctx context.Context, req *RemoveLicenseRequest) (*RemoveLicenseReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveLicense not implemented")
+}
+func (*UnimplementedAPIServer) ListVersions(ctx context.Context, req *ListVersionsRequest) (*ListVersionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
+}
+func (*UnimplementedAPIServer) RestoreVersion(ctx context.Context, req *RestoreVersionRequest) (*RestoreVersionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVersion not implemented")
+}
+func (*UnimplementedAPIServer) ListHistory(ctx context.Context, req *ListHistoryRequest) (*ListHistoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListHistory not implemented")
+}
+func (*UnimplementedAPIServer) SnapshotBucket(ctx context.Context, req *SnapshotBucketRequest) (*SnapshotBucketReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotBucket not implemented")
+}
+func (*UnimplementedAPIServer) ListSnapshots(ctx context.Context, req *ListSnapshotsRequest) (*ListSnapshotsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSnapshots not implemented")
+}
+func (*UnimplementedAPIServer) RestoreSnapshot(ctx context.Context, req *RestoreSnapshotRequest) (*RestoreSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSnapshot not implemented")
+}
+func (*UnimplementedAPIServer) RemoveSnapshot(ctx context.Context, req *RemoveSnapshotRequest) (*RemoveSnapshotReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSnapshot not implemented")
+}
+func (*UnimplementedAPIServer) Archive(ctx context.Context, req *ArchiveRequest) (*ArchiveReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Archive not implemented")
+}
+func (*UnimplementedAPIServer) ArchiveStatus(ctx context.Context, req *ArchiveStatusRequest) (*ArchiveStatusReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveStatus not implemented")
+}
+func (*UnimplementedAPIServer) ArchiveInfo(ctx context.Context, req *ArchiveInfoRequest) (*ArchiveInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveInfo not implemented")
+}
+func (*UnimplementedAPIServer) ArchiveList(ctx context.Context, req *ArchiveListRequest) (*ArchiveListReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveList not implemented")
+}
+func (*UnimplementedAPIServer) ArchiveWatch(req *ArchiveWatchRequest, srv API_ArchiveWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method ArchiveWatch not implemented")
+}
+func (*UnimplementedAPIServer) SetArchiveSchedule(ctx context.Context, req *SetArchiveScheduleRequest) (*SetArchiveScheduleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetArchiveSchedule not implemented")
+}
+func (*UnimplementedAPIServer) SetArchiveRenewal(ctx context.Context, req *SetArchiveRenewalRequest) (*SetArchiveRenewalReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetArchiveRenewal not implemented")
+}
+func (*UnimplementedAPIServer) RestoreArchive(req *RestoreArchiveRequest, srv API_RestoreArchiveServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreArchive not implemented")
+}
+
+func RegisterAPIServer(s *grpc.Server, srv APIServer) {
+	s.RegisterService(&_API_serviceDesc, srv)
+}
+
+func _API_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Init(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/Init",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Init(ctx, req.(*InitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Root_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Root(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/Root",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Root(ctx, req.(*RootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Links_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Links(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/Links",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Links(ctx, req.(*LinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ListPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListPath(ctx, req.(*ListPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListIpfsPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIpfsPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListIpfsPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ListIpfsPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListIpfsPath(ctx, req.(*ListIpfsPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PushPath_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).PushPath(&aPIPushPathServer{stream})
+}
+
+type API_PushPathServer interface {
+	Send(*PushPathReply) error
+	Recv() (*PushPathRequest, error)
+	grpc.ServerStream
+}
+
+type aPIPushPathServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIPushPathServer) Send(m *PushPathReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIPushPathServer) Recv() (*PushPathRequest, error) {
+	m := new(PushPathRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _API_PushPaths_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).PushPaths(&aPIPushPathsServer{stream})
+}
+
+type API_PushPathsServer interface {
+	Send(*PushPathsReply) error
+	Recv() (*PushPathsRequest, error)
+	grpc.ServerStream
+}
+
+type aPIPushPathsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIPushPathsServer) Send(m *PushPathsReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIPushPathsServer) Recv() (*PushPathsRequest, error) {
+	m := new(PushPathsRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _API_StartUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).StartUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/StartUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StartUpload(ctx, req.(*StartUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UploadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/UploadStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UploadStatus(ctx, req.(*UploadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PushUpload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).PushUpload(&aPIPushUploadServer{stream})
+}
+
+type API_PushUploadServer interface {
+	Send(*PushUploadReply) error
+	Recv() (*PushUploadRequest, error)
+	grpc.ServerStream
+}
+
+type aPIPushUploadServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIPushUploadServer) Send(m *PushUploadReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIPushUploadServer) Recv() (*PushUploadRequest, error) {
+	m := new(PushUploadRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _API_CompleteUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CompleteUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/CompleteUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CompleteUpload(ctx, req.(*CompleteUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CancelUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CancelUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/CancelUpload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CancelUpload(ctx, req.(*CancelUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PullPath_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PullPathRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).PullPath(m, &aPIPullPathServer{stream})
+}
+
+type API_PullPathServer interface {
+	Send(*PullPathReply) error
+	grpc.ServerStream
+}
+
+type aPIPullPathServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIPullPathServer) Send(m *PullPathReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_PullIpfsPath_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PullIpfsPathRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).PullIpfsPath(m, &aPIPullIpfsPathServer{stream})
+}
+
+type API_PullIpfsPathServer interface {
+	Send(*PullIpfsPathReply) error
+	grpc.ServerStream
+}
+
+type aPIPullIpfsPathServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIPullIpfsPathServer) Send(m *PullIpfsPathReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_Diff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Diff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/Diff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Diff(ctx, req.(*DiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/GetBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetBlock(ctx, req.(*GetBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_HasBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).HasBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/HasBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).HasBlock(ctx, req.(*HasBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PutBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PutBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/PutBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PutBlock(ctx, req.(*PutBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ExportBucket_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportBucketRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).ExportBucket(m, &aPIExportBucketServer{stream})
+}
+
+type API_ExportBucketServer interface {
+	Send(*ExportBucketReply) error
+	grpc.ServerStream
+}
+
+type aPIExportBucketServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIExportBucketServer) Send(m *ExportBucketReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_ImportBucket_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(APIServer).ImportBucket(&aPIImportBucketServer{stream})
+}
+
+type API_ImportBucketServer interface {
+	SendAndClose(*ImportBucketReply) error
+	Recv() (*ImportBucketRequest, error)
+	grpc.ServerStream
+}
+
+type aPIImportBucketServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIImportBucketServer) SendAndClose(m *ImportBucketReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *aPIImportBucketServer) Recv() (*ImportBucketRequest, error) {
+	m := new(ImportBucketRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _API_StartS3Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartS3ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).StartS3Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/StartS3Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).StartS3Import(ctx, req.(*StartS3ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListImports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListImportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListImports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ListImports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListImports(ctx, req.(*ListImportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_CancelImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CancelImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/CancelImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CancelImport(ctx, req.(*CancelImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ImportBucketIPNSKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportBucketIPNSKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ImportBucketIPNSKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ImportBucketIPNSKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ImportBucketIPNSKey(ctx, req.(*ImportBucketIPNSKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetPath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SetPath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetPath(ctx, req.(*SetPathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).Remove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/Remove",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).Remove(ctx, req.(*RemoveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *aPIClient) ArchiveInfo(ctx context.Context, in *ArchiveInfoRequest, opts ...grpc.CallOption) (*ArchiveInfoReply, error) {
-	out := new(ArchiveInfoReply)
-	err := c.cc.Invoke(ctx, "/buckets.pb.API/ArchiveInfo", in, out, opts...)
-	if err != nil {
+func _API_RemovePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePathRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(APIServer).RemovePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/RemovePath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RemovePath(ctx, req.(*RemovePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *aPIClient) ArchiveWatch(ctx context.Context, in *ArchiveWatchRequest, opts ...grpc.CallOption) (API_ArchiveWatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[3], "/buckets.pb.API/ArchiveWatch", opts...)
-	if err != nil {
+func _API_MovePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePathRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	x := &aPIArchiveWatchClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	if interceptor == nil {
+		return srv.(APIServer).MovePath(ctx, in)
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/MovePath",
 	}
-	return x, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).MovePath(ctx, req.(*MovePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type API_ArchiveWatchClient interface {
-	Recv() (*ArchiveWatchReply, error)
-	grpc.ClientStream
+func _API_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetQuota(ctx, req.(*SetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type aPIArchiveWatchClient struct {
-	grpc.ClientStream
+func _API_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/GetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetQuota(ctx, req.(*GetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *aPIArchiveWatchClient) Recv() (*ArchiveWatchReply, error) {
-	m := new(ArchiveWatchReply)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func _API_SetLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLifecycleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(APIServer).SetLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SetLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetLifecycle(ctx, req.(*SetLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// APIServer is the server API for API service.
-type APIServer interface {
-	List(context.Context, *ListRequest) (*ListReply, error)
-	Init(context.Context, *InitRequest) (*InitReply, error)
-	Root(context.Context, *RootRequest) (*RootReply, error)
-	Links(context.Context, *LinksRequest) (*LinksReply, error)
-	ListPath(context.Context, *ListPathRequest) (*ListPathReply, error)
-	ListIpfsPath(context.Context, *ListIpfsPathRequest) (*ListIpfsPathReply, error)
-	PushPath(API_PushPathServer) error
-	PullPath(*PullPathRequest, API_PullPathServer) error
-	PullIpfsPath(*PullIpfsPathRequest, API_PullIpfsPathServer) error
-	SetPath(context.Context, *SetPathRequest) (*SetPathReply, error)
-	Remove(context.Context, *RemoveRequest) (*RemoveReply, error)
-	RemovePath(context.Context, *RemovePathRequest) (*RemovePathReply, error)
-	// Archive
-	Archive(context.Context, *ArchiveRequest) (*ArchiveReply, error)
-	ArchiveStatus(context.Context, *ArchiveStatusRequest) (*ArchiveStatusReply, error)
-	ArchiveInfo(context.Context, *ArchiveInfoRequest) (*ArchiveInfoReply, error)
-	ArchiveWatch(*ArchiveWatchRequest, API_ArchiveWatchServer) error
+func _API_GetLifecycle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLifecycleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetLifecycle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/GetLifecycle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetLifecycle(ctx, req.(*GetLifecycleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// UnimplementedAPIServer can be embedded to have forward compatible implementations.
-type UnimplementedAPIServer struct {
+func _API_SetWebsite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetWebsiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetWebsite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SetWebsite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetWebsite(ctx, req.(*SetWebsiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (*UnimplementedAPIServer) List(ctx context.Context, req *ListRequest) (*ListReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
-}
-func (*UnimplementedAPIServer) Init(ctx context.Context, req *InitRequest) (*InitReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Init not implemented")
-}
-func (*UnimplementedAPIServer) Root(ctx context.Context, req *RootRequest) (*RootReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Root not implemented")
+func _API_GetWebsite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebsiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetWebsite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/GetWebsite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetWebsite(ctx, req.(*GetWebsiteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) Links(ctx context.Context, req *LinksRequest) (*LinksReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Links not implemented")
+
+func _API_SetConflictStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConflictStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetConflictStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SetConflictStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetConflictStrategy(ctx, req.(*SetConflictStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) ListPath(ctx context.Context, req *ListPathRequest) (*ListPathReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListPath not implemented")
+
+func _API_GetConflictStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConflictStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetConflictStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/GetConflictStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetConflictStrategy(ctx, req.(*GetConflictStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) ListIpfsPath(ctx context.Context, req *ListIpfsPathRequest) (*ListIpfsPathReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListIpfsPath not implemented")
+
+func _API_AddReplicationTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddReplicationTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).AddReplicationTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/AddReplicationTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AddReplicationTarget(ctx, req.(*AddReplicationTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) PushPath(srv API_PushPathServer) error {
-	return status.Errorf(codes.Unimplemented, "method PushPath not implemented")
+
+func _API_ListReplicationTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReplicationTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListReplicationTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ListReplicationTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListReplicationTargets(ctx, req.(*ListReplicationTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) PullPath(req *PullPathRequest, srv API_PullPathServer) error {
-	return status.Errorf(codes.Unimplemented, "method PullPath not implemented")
+
+func _API_RemoveReplicationTarget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveReplicationTargetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RemoveReplicationTarget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/RemoveReplicationTarget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RemoveReplicationTarget(ctx, req.(*RemoveReplicationTargetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) PullIpfsPath(req *PullIpfsPathRequest, srv API_PullIpfsPathServer) error {
-	return status.Errorf(codes.Unimplemented, "method PullIpfsPath not implemented")
+
+func _API_AddPinMirror_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPinMirrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).AddPinMirror(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/AddPinMirror",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AddPinMirror(ctx, req.(*AddPinMirrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) SetPath(ctx context.Context, req *SetPathRequest) (*SetPathReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetPath not implemented")
+
+func _API_ListPinMirrors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPinMirrorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListPinMirrors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ListPinMirrors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListPinMirrors(ctx, req.(*ListPinMirrorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) Remove(ctx context.Context, req *RemoveRequest) (*RemoveReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Remove not implemented")
+
+func _API_RemovePinMirror_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePinMirrorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RemovePinMirror(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/RemovePinMirror",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RemovePinMirror(ctx, req.(*RemovePinMirrorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) RemovePath(ctx context.Context, req *RemovePathRequest) (*RemovePathReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemovePath not implemented")
+
+func _API_AddDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).AddDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/AddDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).AddDomain(ctx, req.(*AddDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) Archive(ctx context.Context, req *ArchiveRequest) (*ArchiveReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Archive not implemented")
+
+func _API_ListDomains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDomainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListDomains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ListDomains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListDomains(ctx, req.(*ListDomainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) ArchiveStatus(ctx context.Context, req *ArchiveStatusRequest) (*ArchiveStatusReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ArchiveStatus not implemented")
+
+func _API_VerifyDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).VerifyDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/VerifyDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).VerifyDomain(ctx, req.(*VerifyDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) ArchiveInfo(ctx context.Context, req *ArchiveInfoRequest) (*ArchiveInfoReply, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ArchiveInfo not implemented")
+
+func _API_RemoveDomain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDomainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RemoveDomain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/RemoveDomain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RemoveDomain(ctx, req.(*RemoveDomainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedAPIServer) ArchiveWatch(req *ArchiveWatchRequest, srv API_ArchiveWatchServer) error {
-	return status.Errorf(codes.Unimplemented, "method ArchiveWatch not implemented")
+
+func _API_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CreateShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/CreateShareLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterAPIServer(s *grpc.Server, srv APIServer) {
-	s.RegisterService(&_API_serviceDesc, srv)
+func _API_ListShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShareLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListShareLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ListShareLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListShareLinks(ctx, req.(*ListShareLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _API_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListRequest)
+func _API_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).List(ctx, in)
+		return srv.(APIServer).RevokeShareLink(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/buckets.pb.API/List",
+		FullMethod: "/buckets.pb.API/RevokeShareLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).List(ctx, req.(*ListRequest))
+		return srv.(APIServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_Init_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(InitRequest)
+func _API_AddWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).Init(ctx, in)
+		return srv.(APIServer).AddWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/buckets.pb.API/Init",
+		FullMethod: "/buckets.pb.API/AddWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).Init(ctx, req.(*InitRequest))
+		return srv.(APIServer).AddWebhook(ctx, req.(*AddWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_Root_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RootRequest)
+func _API_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).Root(ctx, in)
+		return srv.(APIServer).ListWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/buckets.pb.API/Root",
+		FullMethod: "/buckets.pb.API/ListWebhooks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).Root(ctx, req.(*RootRequest))
+		return srv.(APIServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_Links_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LinksRequest)
+func _API_RemoveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).Links(ctx, in)
+		return srv.(APIServer).RemoveWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/buckets.pb.API/Links",
+		FullMethod: "/buckets.pb.API/RemoveWebhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).Links(ctx, req.(*LinksRequest))
+		return srv.(APIServer).RemoveWebhook(ctx, req.(*RemoveWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ListPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPathRequest)
+func _API_ListWebhookFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhookFailuresRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).ListPath(ctx, in)
+		return srv.(APIServer).ListWebhookFailures(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/buckets.pb.API/ListPath",
+		FullMethod: "/buckets.pb.API/ListWebhookFailures",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListPath(ctx, req.(*ListPathRequest))
+		return srv.(APIServer).ListWebhookFailures(ctx, req.(*ListWebhookFailuresRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_ListIpfsPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListIpfsPathRequest)
+func _API_SearchPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPathRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).ListIpfsPath(ctx, in)
+		return srv.(APIServer).SearchPath(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/buckets.pb.API/ListIpfsPath",
+		FullMethod: "/buckets.pb.API/SearchPath",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).ListIpfsPath(ctx, req.(*ListIpfsPathRequest))
+		return srv.(APIServer).SearchPath(ctx, req.(*SearchPathRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_PushPath_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(APIServer).PushPath(&aPIPushPathServer{stream})
+func _API_RenameBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RenameBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/RenameBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RenameBucket(ctx, req.(*RenameBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type API_PushPathServer interface {
-	Send(*PushPathReply) error
-	Recv() (*PushPathRequest, error)
-	grpc.ServerStream
+func _API_CloneBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).CloneBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/CloneBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).CloneBucket(ctx, req.(*CloneBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type aPIPushPathServer struct {
-	grpc.ServerStream
+func _API_SetPathMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPathMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetPathMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SetPathMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetPathMetadata(ctx, req.(*SetPathMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *aPIPushPathServer) Send(m *PushPathReply) error {
-	return x.ServerStream.SendMsg(m)
+func _API_SetTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SetTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetTags(ctx, req.(*SetTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *aPIPushPathServer) Recv() (*PushPathRequest, error) {
-	m := new(PushPathRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _API_SetLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLegalHoldRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(APIServer).SetLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SetLegalHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetLegalHold(ctx, req.(*SetLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _API_PullPath_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(PullPathRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _API_GetLegalHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLegalHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).PullPath(m, &aPIPullPathServer{stream})
+	if interceptor == nil {
+		return srv.(APIServer).GetLegalHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/GetLegalHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetLegalHold(ctx, req.(*GetLegalHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type API_PullPathServer interface {
-	Send(*PullPathReply) error
-	grpc.ServerStream
+func _API_SetLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SetLicense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetLicense(ctx, req.(*SetLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type aPIPullPathServer struct {
-	grpc.ServerStream
+func _API_GetLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/GetLicense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetLicense(ctx, req.(*GetLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *aPIPullPathServer) Send(m *PullPathReply) error {
-	return x.ServerStream.SendMsg(m)
+func _API_ListLicenses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLicensesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListLicenses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ListLicenses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListLicenses(ctx, req.(*ListLicensesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _API_PullIpfsPath_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(PullIpfsPathRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _API_RemoveLicense_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveLicenseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RemoveLicense(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/RemoveLicense",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RemoveLicense(ctx, req.(*RemoveLicenseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(APIServer).PullIpfsPath(m, &aPIPullIpfsPathServer{stream})
+	if interceptor == nil {
+		return srv.(APIServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ListVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListVersions(ctx, req.(*ListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type API_PullIpfsPathServer interface {
-	Send(*PullIpfsPathReply) error
-	grpc.ServerStream
+func _API_RestoreVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RestoreVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/RestoreVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RestoreVersion(ctx, req.(*RestoreVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type aPIPullIpfsPathServer struct {
-	grpc.ServerStream
+func _API_ListHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ListHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListHistory(ctx, req.(*ListHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *aPIPullIpfsPathServer) Send(m *PullIpfsPathReply) error {
-	return x.ServerStream.SendMsg(m)
+func _API_SnapshotBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SnapshotBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SnapshotBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SnapshotBucket(ctx, req.(*SnapshotBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _API_SetPath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetPathRequest)
+func _API_ListSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSnapshotsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).SetPath(ctx, in)
+		return srv.(APIServer).ListSnapshots(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/buckets.pb.API/SetPath",
+		FullMethod: "/buckets.pb.API/ListSnapshots",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).SetPath(ctx, req.(*SetPathRequest))
+		return srv.(APIServer).ListSnapshots(ctx, req.(*ListSnapshotsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_Remove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveRequest)
+func _API_RestoreSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).Remove(ctx, in)
+		return srv.(APIServer).RestoreSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/buckets.pb.API/Remove",
+		FullMethod: "/buckets.pb.API/RestoreSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).Remove(ctx, req.(*RemoveRequest))
+		return srv.(APIServer).RestoreSnapshot(ctx, req.(*RestoreSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _API_RemovePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemovePathRequest)
+func _API_RemoveSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSnapshotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(APIServer).RemovePath(ctx, in)
+		return srv.(APIServer).RemoveSnapshot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/buckets.pb.API/RemovePath",
+		FullMethod: "/buckets.pb.API/RemoveSnapshot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(APIServer).RemovePath(ctx, req.(*RemovePathRequest))
+		return srv.(APIServer).RemoveSnapshot(ctx, req.(*RemoveSnapshotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2630,6 +14112,24 @@ func _API_ArchiveInfo_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_ArchiveList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ArchiveList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/ArchiveList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ArchiveList(ctx, req.(*ArchiveListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_ArchiveWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ArchiveWatchRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -2651,6 +14151,63 @@ func (x *aPIArchiveWatchServer) Send(m *ArchiveWatchReply) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _API_SetArchiveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetArchiveScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetArchiveSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SetArchiveSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetArchiveSchedule(ctx, req.(*SetArchiveScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetArchiveRenewal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetArchiveRenewalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetArchiveRenewal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/buckets.pb.API/SetArchiveRenewal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetArchiveRenewal(ctx, req.(*SetArchiveRenewalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RestoreArchive_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RestoreArchiveRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).RestoreArchive(m, &aPIRestoreArchiveServer{stream})
+}
+
+type API_RestoreArchiveServer interface {
+	Send(*RestoreArchiveReply) error
+	grpc.ServerStream
+}
+
+type aPIRestoreArchiveServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIRestoreArchiveServer) Send(m *RestoreArchiveReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _API_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "buckets.pb.API",
 	HandlerType: (*APIServer)(nil),
@@ -2679,6 +14236,54 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListIpfsPath",
 			Handler:    _API_ListIpfsPath_Handler,
 		},
+		{
+			MethodName: "StartUpload",
+			Handler:    _API_StartUpload_Handler,
+		},
+		{
+			MethodName: "UploadStatus",
+			Handler:    _API_UploadStatus_Handler,
+		},
+		{
+			MethodName: "CompleteUpload",
+			Handler:    _API_CompleteUpload_Handler,
+		},
+		{
+			MethodName: "CancelUpload",
+			Handler:    _API_CancelUpload_Handler,
+		},
+		{
+			MethodName: "Diff",
+			Handler:    _API_Diff_Handler,
+		},
+		{
+			MethodName: "GetBlock",
+			Handler:    _API_GetBlock_Handler,
+		},
+		{
+			MethodName: "HasBlock",
+			Handler:    _API_HasBlock_Handler,
+		},
+		{
+			MethodName: "PutBlock",
+			Handler:    _API_PutBlock_Handler,
+		},
+		{
+			MethodName: "StartS3Import",
+			Handler:    _API_StartS3Import_Handler,
+		},
+		{
+			MethodName: "ListImports",
+			Handler:    _API_ListImports_Handler,
+		},
+		{
+			MethodName: "CancelImport",
+			Handler:    _API_CancelImport_Handler,
+		},
+		{
+			MethodName: "ImportBucketIPNSKey",
+			Handler:    _API_ImportBucketIPNSKey_Handler,
+		},
 		{
 			MethodName: "SetPath",
 			Handler:    _API_SetPath_Handler,
@@ -2691,6 +14296,182 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemovePath",
 			Handler:    _API_RemovePath_Handler,
 		},
+		{
+			MethodName: "MovePath",
+			Handler:    _API_MovePath_Handler,
+		},
+		{
+			MethodName: "SetQuota",
+			Handler:    _API_SetQuota_Handler,
+		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _API_GetQuota_Handler,
+		},
+		{
+			MethodName: "SetLifecycle",
+			Handler:    _API_SetLifecycle_Handler,
+		},
+		{
+			MethodName: "GetLifecycle",
+			Handler:    _API_GetLifecycle_Handler,
+		},
+		{
+			MethodName: "SetWebsite",
+			Handler:    _API_SetWebsite_Handler,
+		},
+		{
+			MethodName: "GetWebsite",
+			Handler:    _API_GetWebsite_Handler,
+		},
+		{
+			MethodName: "SetConflictStrategy",
+			Handler:    _API_SetConflictStrategy_Handler,
+		},
+		{
+			MethodName: "GetConflictStrategy",
+			Handler:    _API_GetConflictStrategy_Handler,
+		},
+		{
+			MethodName: "AddReplicationTarget",
+			Handler:    _API_AddReplicationTarget_Handler,
+		},
+		{
+			MethodName: "ListReplicationTargets",
+			Handler:    _API_ListReplicationTargets_Handler,
+		},
+		{
+			MethodName: "RemoveReplicationTarget",
+			Handler:    _API_RemoveReplicationTarget_Handler,
+		},
+		{
+			MethodName: "AddPinMirror",
+			Handler:    _API_AddPinMirror_Handler,
+		},
+		{
+			MethodName: "ListPinMirrors",
+			Handler:    _API_ListPinMirrors_Handler,
+		},
+		{
+			MethodName: "RemovePinMirror",
+			Handler:    _API_RemovePinMirror_Handler,
+		},
+		{
+			MethodName: "AddDomain",
+			Handler:    _API_AddDomain_Handler,
+		},
+		{
+			MethodName: "ListDomains",
+			Handler:    _API_ListDomains_Handler,
+		},
+		{
+			MethodName: "VerifyDomain",
+			Handler:    _API_VerifyDomain_Handler,
+		},
+		{
+			MethodName: "RemoveDomain",
+			Handler:    _API_RemoveDomain_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _API_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "ListShareLinks",
+			Handler:    _API_ListShareLinks_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _API_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "AddWebhook",
+			Handler:    _API_AddWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _API_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "RemoveWebhook",
+			Handler:    _API_RemoveWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhookFailures",
+			Handler:    _API_ListWebhookFailures_Handler,
+		},
+		{
+			MethodName: "SearchPath",
+			Handler:    _API_SearchPath_Handler,
+		},
+		{
+			MethodName: "RenameBucket",
+			Handler:    _API_RenameBucket_Handler,
+		},
+		{
+			MethodName: "CloneBucket",
+			Handler:    _API_CloneBucket_Handler,
+		},
+		{
+			MethodName: "SetPathMetadata",
+			Handler:    _API_SetPathMetadata_Handler,
+		},
+		{
+			MethodName: "SetTags",
+			Handler:    _API_SetTags_Handler,
+		},
+		{
+			MethodName: "SetLegalHold",
+			Handler:    _API_SetLegalHold_Handler,
+		},
+		{
+			MethodName: "GetLegalHold",
+			Handler:    _API_GetLegalHold_Handler,
+		},
+		{
+			MethodName: "SetLicense",
+			Handler:    _API_SetLicense_Handler,
+		},
+		{
+			MethodName: "GetLicense",
+			Handler:    _API_GetLicense_Handler,
+		},
+		{
+			MethodName: "ListLicenses",
+			Handler:    _API_ListLicenses_Handler,
+		},
+		{
+			MethodName: "RemoveLicense",
+			Handler:    _API_RemoveLicense_Handler,
+		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _API_ListVersions_Handler,
+		},
+		{
+			MethodName: "RestoreVersion",
+			Handler:    _API_RestoreVersion_Handler,
+		},
+		{
+			MethodName: "ListHistory",
+			Handler:    _API_ListHistory_Handler,
+		},
+		{
+			MethodName: "SnapshotBucket",
+			Handler:    _API_SnapshotBucket_Handler,
+		},
+		{
+			MethodName: "ListSnapshots",
+			Handler:    _API_ListSnapshots_Handler,
+		},
+		{
+			MethodName: "RestoreSnapshot",
+			Handler:    _API_RestoreSnapshot_Handler,
+		},
+		{
+			MethodName: "RemoveSnapshot",
+			Handler:    _API_RemoveSnapshot_Handler,
+		},
 		{
 			MethodName: "Archive",
 			Handler:    _API_Archive_Handler,
@@ -2703,6 +14484,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ArchiveInfo",
 			Handler:    _API_ArchiveInfo_Handler,
 		},
+		{
+			MethodName: "ArchiveList",
+			Handler:    _API_ArchiveList_Handler,
+		},
+		{
+			MethodName: "SetArchiveSchedule",
+			Handler:    _API_SetArchiveSchedule_Handler,
+		},
+		{
+			MethodName: "SetArchiveRenewal",
+			Handler:    _API_SetArchiveRenewal_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2711,6 +14504,18 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "PushPaths",
+			Handler:       _API_PushPaths_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "PushUpload",
+			Handler:       _API_PushUpload_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "PullPath",
 			Handler:       _API_PullPath_Handler,
@@ -2721,11 +14526,26 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			Handler:       _API_PullIpfsPath_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportBucket",
+			Handler:       _API_ExportBucket_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportBucket",
+			Handler:       _API_ImportBucket_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "ArchiveWatch",
 			Handler:       _API_ArchiveWatch_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "RestoreArchive",
+			Handler:       _API_RestoreArchive_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "buckets.proto",
 }
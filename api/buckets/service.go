@@ -1,16 +1,28 @@
 package buckets
 
 import (
+	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/rand"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
 	gopath "path"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ipfs/go-cid"
@@ -22,28 +34,61 @@ import (
 	iface "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	mh "github.com/multiformats/go-multihash"
 	"github.com/textileio/dcrypto"
+	"github.com/textileio/go-threads/broadcast"
+	lstore "github.com/textileio/go-threads/core/logstore"
 	"github.com/textileio/go-threads/core/thread"
 	"github.com/textileio/go-threads/db"
 	powc "github.com/textileio/powergate/api/client"
 	"github.com/textileio/powergate/ffs"
+	pgrpc "github.com/textileio/powergate/ffs/rpc"
+	bc "github.com/textileio/textile/api/buckets/client"
 	pb "github.com/textileio/textile/api/buckets/pb"
 	"github.com/textileio/textile/api/common"
 	"github.com/textileio/textile/buckets"
 	"github.com/textileio/textile/buckets/archive"
+	"github.com/textileio/textile/buckets/pinning"
+	"github.com/textileio/textile/buckets/s3"
+	"github.com/textileio/textile/buckets/webhooks"
 	"github.com/textileio/textile/dns"
+	"github.com/textileio/textile/email"
 	"github.com/textileio/textile/ipns"
 	mdb "github.com/textileio/textile/mongodb"
 	tdb "github.com/textileio/textile/threaddb"
 	"github.com/textileio/textile/util"
+	"go.mongodb.org/mongo-driver/mongo"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/status"
 )
 
 var (
 	log = logging.Logger("bucketsapi")
 
+	// LifecycleInterval is how often the lifecycle rules of a bucket are applied.
+	LifecycleInterval = time.Hour
+
+	// ArchiveScheduleRetryInterval is how long a scheduled archive that failed waits before it's retried.
+	ArchiveScheduleRetryInterval = time.Hour
+
+	// ArchiveRenewalCheckInterval is how often the deals of a bucket archive with a renewal policy are checked.
+	ArchiveRenewalCheckInterval = time.Hour * 6
+
+	// PinMirrorCheckInterval is how often the status of a remote pin that is not settled yet is checked.
+	PinMirrorCheckInterval = time.Minute
+
+	// DomainVerifyInterval is how often the verification record of an unverified domain is checked.
+	DomainVerifyInterval = time.Minute * 10
+	// DomainVerifyTimeout is how long a domain can stay unverified before it's removed.
+	DomainVerifyTimeout = time.Hour * 24 * 7
+
+	// PushProgressInterval is the min time between progress events sent while a file is added with PushPath.
+	PushProgressInterval = time.Millisecond * 500
+
 	// ErrArchivingFeatureDisabled indicates an archive was requested with archiving disabled.
 	ErrArchivingFeatureDisabled = errors.New("archiving feature is disabled")
 
@@ -57,6 +102,12 @@ var (
 	// ErrTooManyBucketsInThread indicates that there is the maximum number of buckets in a thread.
 	ErrTooManyBucketsInThread = errors.New("number of buckets in thread exceeds quota")
 
+	// ErrUploadInProgress indicates that another request is using an upload session.
+	ErrUploadInProgress = errors.New("upload session is in use")
+
+	// ErrBlockNotFound indicates a block is not part of a bucket.
+	ErrBlockNotFound = errors.New("block not found in bucket")
+
 	// errInvalidNodeType indicates a node with type other than raw of proto was encountered.
 	errInvalidNodeType = errors.New("invalid node type")
 )
@@ -66,6 +117,32 @@ const (
 	chunkSize = 1024 * 32
 	// pinNotRecursiveMsg is used to match an IPFS "recursively pinned already" error.
 	pinNotRecursiveMsg = "'from' cid was not recursively pinned already"
+	// notPinnedMsg is used to match an IPFS "not pinned or pinned indirectly" error.
+	notPinnedMsg = "not pinned"
+	// defaultHistoryPageSize is the number of history entries returned when no limit is given.
+	defaultHistoryPageSize = 20
+	// maxHistoryPageSize is the max number of history entries returned in one page.
+	maxHistoryPageSize = 100
+	// maxBlockSize is the max size of a block that can be put with PutBlock.
+	maxBlockSize = 1024 * 1024 * 2
+	// maxOpenPushPaths is the max number of files that can be open at once in a PushPaths stream.
+	maxOpenPushPaths = 100
+	// defaultSearchResults is the number of search results returned when no limit is given.
+	defaultSearchResults = 100
+	// maxSearchResults is the max number of search results returned.
+	maxSearchResults = 1000
+	// maxSearchQueryLength is the max length of a search query.
+	maxSearchQueryLength = 256
+	// encKeySize is the size of a bucket encryption key, which is an AES key followed by an HMAC key.
+	encKeySize = 64
+	// searchIndexGenLen is the length of the random IDs of search index generations.
+	searchIndexGenLen = 16
+	// minArchiveScheduleInterval is the min interval of an archive schedule in seconds.
+	minArchiveScheduleInterval = 60 * 60
+	// importBatchSize is the max number of objects imported into a bucket at once.
+	importBatchSize = 100
+	// importLeaseDuration is how long a running import waits for a batch before it can be resumed.
+	importLeaseDuration = time.Minute * 30
 )
 
 // Service is a gRPC service for buckets.
@@ -81,9 +158,23 @@ type Service struct {
 	DNSManager                *dns.Manager
 	PGClient                  *powc.Client
 	ArchiveTracker            *archive.Tracker
+	AccountEventBus           *broadcast.Broadcaster
+	// EmailClient sends archive renewal notices to bucket owners. Email notices are disabled if nil.
+	EmailClient *email.Client
+	// UploadsDir is where data from resumable uploads is staged.
+	// Resumable uploads are disabled if empty.
+	UploadsDir string
+	// Thumbnails enables generating thumbnails of images in public buckets.
+	Thumbnails bool
+	// AllowPrivateEndpoints allows webhooks, pin mirrors, and S3 imports to reach non-public addresses.
+	AllowPrivateEndpoints bool
+	// SearchIndex enables maintaining bucket search indexes, which SearchPath requires.
+	SearchIndex bool
+
+	activeUploads sync.Map
 }
 
-func (s *Service) List(ctx context.Context, _ *pb.ListRequest) (*pb.ListReply, error) {
+func (s *Service) List(ctx context.Context, req *pb.ListRequest) (*pb.ListReply, error) {
 	log.Debugf("received list request")
 
 	dbID, ok := common.ThreadIDFromContext(ctx)
@@ -96,21 +187,50 @@ func (s *Service) List(ctx context.Context, _ *pb.ListRequest) (*pb.ListReply, e
 	if err != nil {
 		return nil, err
 	}
+	threadTags, err := s.Collections.Tags.GetMap(ctx, mdb.ThreadResource, dbID.String())
+	if err != nil {
+		return nil, err
+	}
 	bucks := list.([]*tdb.Bucket)
-	roots := make([]*pb.Root, len(bucks))
-	for i, buck := range bucks {
-		roots[i] = &pb.Root{
+	var roots []*pb.Root
+	for _, buck := range bucks {
+		tags, err := s.getTags(ctx, threadTags, buck.Key)
+		if err != nil {
+			return nil, err
+		}
+		if !mdb.MatchTags(tags, req.Tags) {
+			continue
+		}
+		roots = append(roots, &pb.Root{
 			Key:       buck.Key,
 			Name:      buck.Name,
 			Path:      buck.Path,
 			Thread:    dbID.String(),
 			CreatedAt: buck.CreatedAt,
 			UpdatedAt: buck.UpdatedAt,
-		}
+			Tags:      tags,
+		})
 	}
 	return &pb.ListReply{Roots: roots}, nil
 }
 
+// getTags returns the effective tags of a bucket.
+// Bucket tags take precedence over the tags of its thread.
+func (s *Service) getTags(ctx context.Context, threadTags map[string]string, key string) (map[string]string, error) {
+	buckTags, err := s.Collections.Tags.GetMap(ctx, mdb.BucketResource, key)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]string)
+	for k, v := range threadTags {
+		tags[k] = v
+	}
+	for k, v := range buckTags {
+		tags[k] = v
+	}
+	return tags, nil
+}
+
 func (s *Service) Init(ctx context.Context, req *pb.InitRequest) (*pb.InitReply, error) {
 	log.Debugf("received init request")
 
@@ -132,7 +252,15 @@ func (s *Service) Init(ctx context.Context, req *pb.InitRequest) (*pb.InitReply,
 	}
 
 	var key []byte
-	if req.Private {
+	if len(req.Key) > 0 {
+		if !req.Private {
+			return nil, status.Error(codes.InvalidArgument, "Encryption key requires a private bucket")
+		}
+		if len(req.Key) != encKeySize {
+			return nil, status.Errorf(codes.InvalidArgument, "Encryption key must be %d bytes", encKeySize)
+		}
+		key = req.Key
+	} else if req.Private {
 		var err error
 		key, err = dcrypto.NewKey()
 		if err != nil {
@@ -145,11 +273,27 @@ func (s *Service) Init(ctx context.Context, req *pb.InitRequest) (*pb.InitReply,
 		if err != nil {
 			return nil, fmt.Errorf("invalid bootstrap cid: %s", err)
 		}
+		policy, err := s.getPushPolicy(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if v := s.checkRequiredPaths(ctx, policy, path.IpfsPath(bootCid), nil); len(v) > 0 {
+			return nil, pushRejected(v)
+		}
 	}
 	buck, seed, err := s.createBucket(ctx, dbID, dbToken, req.Name, key, bootCid)
 	if err != nil {
 		return nil, err
 	}
+	if bootCid.Defined() {
+		s.compileRedirects(ctx, buck)
+	}
+	s.recordVersion(ctx, buck, "")
+	if account := accountFromContext(ctx); account != nil {
+		common.PublishAccountEvent(s.AccountEventBus, account.Key, common.BucketCreated, buck.Key, buck.Name)
+	} else if user := userFromContext(ctx); user != nil {
+		common.PublishAccountEvent(s.AccountEventBus, user.Key, common.BucketCreated, buck.Key, buck.Name)
+	}
 	var seedData []byte
 	if key != nil {
 		seedData, err = decryptData(seed.RawData(), key)
@@ -169,7 +313,7 @@ func (s *Service) Init(ctx context.Context, req *pb.InitRequest) (*pb.InitReply,
 			CreatedAt: buck.CreatedAt,
 			UpdatedAt: buck.UpdatedAt,
 		},
-		Links:   s.createLinks(dbID, buck),
+		Links:   s.createLinks(dbID, buck, buck.Key),
 		Seed:    seedData,
 		SeedCid: seed.Cid().String(),
 	}, nil
@@ -203,11 +347,32 @@ func (s *Service) createBucket(ctx context.Context, dbID thread.ID, dbToken thre
 		return
 	}
 
+	// Track the root pin before the bucket exists, so the pin is collected if the bucket isn't created
+	if key == nil {
+		if err = s.Collections.RootPins.Track(ctx, bkey, pth.String(), dbID, dbToken); err != nil {
+			return
+		}
+	}
+
 	// Create the bucket, using the IPNS key as instance ID
-	buck, err = s.Buckets.New(ctx, dbID, bkey, pth, tdb.WithNewBucketName(name), tdb.WithNewBucketKey(key), tdb.WithNewBucketToken(dbToken))
+	storageConfig, err := s.orgStorageConfig(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting org archive config: %s", err)
+	}
+	buck, err = s.Buckets.New(
+		ctx,
+		dbID,
+		bkey,
+		pth,
+		tdb.WithNewBucketName(name),
+		tdb.WithNewBucketKey(key),
+		tdb.WithNewBucketToken(dbToken),
+		tdb.WithNewBucketStorageConfig(storageConfig),
+	)
 	if err != nil {
 		return
 	}
+	s.markIndexPending(ctx, dbID, dbToken, buck.Key)
 
 	// Finally, publish the new bucket's address to the name system
 	go s.IPNSManager.Publish(pth, buck.Key)
@@ -274,7 +439,7 @@ func (s *Service) createBootstrappedPath(ctx context.Context, seed ipld.Node, bo
 	if err != nil {
 		return nil, fmt.Errorf("getting current buckets total size: %s", err)
 	}
-	if s.BucketsTotalMaxSize > 0 && currentBucketsSize+int64(bootStatn.CumulativeSize) > s.BucketsTotalMaxSize {
+	if maxSize := s.getBucketsTotalMaxSize(ctx); maxSize > 0 && currentBucketsSize+int64(bootStatn.CumulativeSize) > maxSize {
 		return nil, ErrBucketsTotalSizeExceedsMaxSize
 	}
 
@@ -339,6 +504,10 @@ func (s *Service) newDirFromExistingPath(ctx context.Context, pth path.Path, key
 	if key == nil {
 		nodes := []ipld.Node{top}
 		if add != nil {
+			// Replace an existing link, e.g., the seed of a bucket root
+			if err := top.RemoveNodeLink(addName); err != nil && !errors.Is(err, dag.ErrLinkNotFound) {
+				return nil, nil, err
+			}
 			if err := top.AddNodeLink(addName, add); err != nil {
 				return nil, nil, err
 			}
@@ -558,6 +727,22 @@ func (s *Service) Root(ctx context.Context, req *pb.RootRequest) (*pb.RootReply,
 	if err != nil {
 		return nil, err
 	}
+	threadTags, err := s.Collections.Tags.GetMap(ctx, mdb.ThreadResource, dbID.String())
+	if err != nil {
+		return nil, err
+	}
+	tags, err := s.getTags(ctx, threadTags, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	mirrors, err := s.listPinMirrors(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	domains, err := s.listDomains(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
 	return &pb.RootReply{
 		Root: &pb.Root{
 			Key:       buck.Key,
@@ -566,12 +751,18 @@ func (s *Service) Root(ctx context.Context, req *pb.RootRequest) (*pb.RootReply,
 			Thread:    dbID.String(),
 			CreatedAt: buck.CreatedAt,
 			UpdatedAt: buck.UpdatedAt,
+			Tags:      tags,
 		},
+		Mirrors: mirrors,
+		Domains: domains,
 	}, nil
 }
 
-func (s *Service) Links(ctx context.Context, req *pb.LinksRequest) (*pb.LinksReply, error) {
-	log.Debugf("received lists request")
+// SetLifecycle replaces the lifecycle rules of a bucket.
+// The status of existing rules is kept for rules with the same ID.
+// Setting no rules removes the bucket from the lifecycle schedule.
+func (s *Service) SetLifecycle(ctx context.Context, req *pb.SetLifecycleRequest) (*pb.SetLifecycleReply, error) {
+	log.Debugf("received set lifecycle request")
 
 	dbID, ok := common.ThreadIDFromContext(ctx)
 	if !ok {
@@ -584,30 +775,57 @@ func (s *Service) Links(ctx context.Context, req *pb.LinksRequest) (*pb.LinksRep
 	if err != nil {
 		return nil, err
 	}
-	return s.createLinks(dbID, buck), nil
-}
+	existing := make(map[string]tdb.LifecycleStatus)
+	if buck.Lifecycle != nil {
+		for _, r := range buck.Lifecycle.Rules {
+			existing[r.ID] = r.Status
+		}
+	}
+	rules := make([]tdb.LifecycleRule, len(req.Rules))
+	ids := make(map[string]struct{}, len(req.Rules))
+	for i, r := range req.Rules {
+		rule, err := s.lifecycleRuleFromPb(r, i)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if _, ok := ids[rule.ID]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Duplicate rule id %s", rule.ID)
+		}
+		ids[rule.ID] = struct{}{}
+		rule.Status = existing[rule.ID]
+		rules[i] = rule
+	}
 
-func (s *Service) createLinks(dbID thread.ID, buck *tdb.Bucket) *pb.LinksReply {
-	var threadLink, wwwLink, ipnsLink string
-	threadLink = fmt.Sprintf("%s/thread/%s/%s/%s", s.GatewayURL, dbID, buckets.CollectionName, buck.Key)
-	if s.DNSManager != nil && s.DNSManager.Domain != "" {
-		parts := strings.Split(s.GatewayURL, "://")
-		if len(parts) < 2 {
-			return nil
+	if len(rules) == 0 {
+		buck.Lifecycle = nil
+	} else {
+		buck.Lifecycle = &tdb.Lifecycle{Rules: rules}
+	}
+	buck.UpdatedAt = time.Now().UnixNano()
+	if err = s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if len(rules) == 0 {
+		err = s.Collections.BucketLifecycles.Delete(ctx, buck.Key)
+		if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, err
+		}
+	} else {
+		err = s.Collections.BucketLifecycles.Schedule(ctx, dbID, dbToken, buck.Key, contentOwner(ctx), time.Now())
+		if err != nil {
+			return nil, err
 		}
-		scheme := parts[0]
-		wwwLink = fmt.Sprintf("%s://%s.%s", scheme, buck.Key, s.DNSManager.Domain)
 	}
-	ipnsLink = fmt.Sprintf("%s/ipns/%s", s.GatewayURL, buck.Key)
-	return &pb.LinksReply{
-		URL:  threadLink,
-		WWW:  wwwLink,
-		IPNS: ipnsLink,
+	lifecycle, err := s.lifecycleToPb(ctx, buck)
+	if err != nil {
+		return nil, err
 	}
+	return &pb.SetLifecycleReply{Lifecycle: lifecycle}, nil
 }
 
-func (s *Service) SetPath(ctx context.Context, req *pb.SetPathRequest) (*pb.SetPathReply, error) {
-	log.Debugf("received set path request")
+// GetLifecycle returns the lifecycle rules of a bucket, including the status of their last run.
+func (s *Service) GetLifecycle(ctx context.Context, req *pb.GetLifecycleRequest) (*pb.GetLifecycleReply, error) {
+	log.Debugf("received get lifecycle request")
 
 	dbID, ok := common.ThreadIDFromContext(ctx)
 	if !ok {
@@ -618,81 +836,122 @@ func (s *Service) SetPath(ctx context.Context, req *pb.SetPathRequest) (*pb.SetP
 	buck := &tdb.Bucket{}
 	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
 	if err != nil {
-		return nil, fmt.Errorf("get bucket: %s", err)
+		return nil, err
 	}
-	buckPath := path.New(buck.Path)
-
-	remoteCid, err := cid.Decode(req.Cid)
+	lifecycle, err := s.lifecycleToPb(ctx, buck)
 	if err != nil {
-		return nil, fmt.Errorf("invalid remote cid: %s", err)
+		return nil, err
 	}
-	remotePath := path.IpfsPath(remoteCid)
+	return &pb.GetLifecycleReply{Lifecycle: lifecycle}, nil
+}
 
-	encKey := buck.GetEncKey()
-	var dirpth path.Resolved
-	if req.Path == "" {
-		sn, err := makeSeed(encKey)
-		if err != nil {
-			return nil, fmt.Errorf("generating new seed: %s", err)
-		}
-		dirpth, err = s.createBootstrappedPath(ctx, sn, remoteCid, encKey)
-		if err != nil {
-			return nil, fmt.Errorf("generating bucket new root: %s", err)
-		}
-		if encKey != nil {
-			buckPathResolved, err := s.IPFSClient.ResolvePath(ctx, buckPath)
-			if err != nil {
-				return nil, fmt.Errorf("resolving path: %s", err)
-			}
-			if err = s.unpinNodeAndBranch(ctx, buckPathResolved, encKey); err != nil {
-				return nil, fmt.Errorf("unpinning pinned root: %s", err)
-			}
-		} else {
-			if err = s.unpinPath(ctx, buckPath); err != nil {
-				return nil, fmt.Errorf("updating pinned root: %s", err)
-			}
+// SetWebsite replaces the website config of a bucket, which the gateway uses to render the bucket as a static site.
+// Setting an empty config removes it.
+func (s *Service) SetWebsite(ctx context.Context, req *pb.SetWebsiteRequest) (*pb.SetWebsiteReply, error) {
+	log.Debugf("received set website request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	website := websiteFromPb(req.Website)
+	if website != nil {
+		if err := website.Validate(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
-	} else {
-		if encKey != nil {
-			n, nodes, err := s.newDirFromExistingPath(ctx, remotePath, encKey, nil, "")
-			if err != nil {
-				return nil, fmt.Errorf("resolving remote path: %s", err)
-			}
-			dirpth, err = s.insertNodeAtPath(ctx, n, path.Join(buckPath, req.Path), encKey)
-			if err != nil {
-				return nil, fmt.Errorf("updating pinned root: %s", err)
-			}
-			if err := s.addAndPinNodes(ctx, nodes); err != nil {
-				return nil, err
-			}
-		} else {
-			dirpth, err = s.IPFSClient.Object().AddLink(ctx, buckPath, req.Path, remotePath, options.Object.Create(true))
-			if err != nil {
-				return nil, fmt.Errorf("adding folder: %s", err)
-			}
-			if err = s.updateOrAddPin(ctx, buckPath, dirpth); err != nil {
-				return nil, fmt.Errorf("updating pinned root: %s", err)
-			}
+	}
+	buck.Website = website
+	buck.UpdatedAt = time.Now().UnixNano()
+	if err := s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if buck.GetEncKey() == nil {
+		if err := s.Collections.WebConfigs.SetWebsite(ctx, buck.Key, website); err != nil {
+			return nil, err
 		}
 	}
+	return &pb.SetWebsiteReply{Website: websiteToPb(website)}, nil
+}
+
+// GetWebsite returns the website config of a bucket.
+func (s *Service) GetWebsite(ctx context.Context, req *pb.GetWebsiteRequest) (*pb.GetWebsiteReply, error) {
+	log.Debugf("received get website request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
 
-	buck.Path = dirpth.String()
-	buck.UpdatedAt = time.Now().UnixNano()
-	if err = s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
-		return nil, fmt.Errorf("saving new bucket state: %s", err)
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
 	}
-	return &pb.SetPathReply{}, nil
+	return &pb.GetWebsiteReply{Website: websiteToPb(buck.Website)}, nil
 }
 
-func (s *Service) addAndPinNodes(ctx context.Context, nodes []ipld.Node) error {
-	if err := s.IPFSClient.Dag().AddMany(ctx, nodes); err != nil {
-		return err
+// websiteFromPb returns nil if w is empty.
+func websiteFromPb(w *pb.Website) *buckets.Website {
+	if w == nil || (w.IndexDocument == "" && w.ErrorDocument == "" && len(w.Redirects) == 0 && len(w.Headers) == 0) {
+		return nil
 	}
-	return s.pinBlocks(ctx, nodes)
+	website := &buckets.Website{
+		IndexDocument: w.IndexDocument,
+		ErrorDocument: w.ErrorDocument,
+	}
+	for _, r := range w.Redirects {
+		website.Redirects = append(website.Redirects, buckets.Redirect{
+			From:   r.From,
+			To:     r.To,
+			Status: int(r.Status),
+			Force:  r.Force,
+		})
+	}
+	for _, h := range w.Headers {
+		website.Headers = append(website.Headers, buckets.Header{
+			Path:  h.Path,
+			Name:  h.Name,
+			Value: h.Value,
+		})
+	}
+	return website
 }
 
-func (s *Service) ListPath(ctx context.Context, req *pb.ListPathRequest) (*pb.ListPathReply, error) {
-	log.Debugf("received list path request")
+func websiteToPb(w *buckets.Website) *pb.Website {
+	if w == nil {
+		return &pb.Website{}
+	}
+	pw := &pb.Website{
+		IndexDocument: w.IndexDocument,
+		ErrorDocument: w.ErrorDocument,
+	}
+	for _, r := range w.Redirects {
+		pw.Redirects = append(pw.Redirects, &pb.Website_Redirect{
+			From:   r.From,
+			To:     r.To,
+			Status: int32(r.Status),
+			Force:  r.Force,
+		})
+	}
+	for _, h := range w.Headers {
+		pw.Headers = append(pw.Headers, &pb.Website_Header{
+			Path:  h.Path,
+			Name:  h.Name,
+			Value: h.Value,
+		})
+	}
+	return pw
+}
+
+// SetConflictStrategy sets how a bucket handles path updates that are based on a stale root.
+func (s *Service) SetConflictStrategy(ctx context.Context, req *pb.SetConflictStrategyRequest) (*pb.SetConflictStrategyReply, error) {
+	log.Debugf("received set conflict strategy request")
 
 	dbID, ok := common.ThreadIDFromContext(ctx)
 	if !ok {
@@ -700,363 +959,6238 @@ func (s *Service) ListPath(ctx context.Context, req *pb.ListPathRequest) (*pb.Li
 	}
 	dbToken, _ := thread.TokenFromContext(ctx)
 
-	buck, pth, err := s.getBucketPath(ctx, dbID, req.Key, req.Path, dbToken)
-	if err != nil {
-		return nil, err
+	strategy, ok := conflictStrategies[req.Strategy]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "Invalid conflict strategy")
 	}
-	rep, err := s.pathToPb(ctx, dbID, buck, pth, true)
-	if err != nil {
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
 		return nil, err
 	}
-	if pth.String() == buck.Path {
-		rep.Item.Name = buck.Name
+	buck.ConflictStrategy = strategy
+	buck.UpdatedAt = time.Now().UnixNano()
+	if err := s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
 	}
-	return rep, nil
+	return &pb.SetConflictStrategyReply{}, nil
 }
 
-func (s *Service) ListIpfsPath(ctx context.Context, req *pb.ListIpfsPathRequest) (*pb.ListIpfsPathReply, error) {
-	log.Debugf("received list ipfs path request")
+// GetConflictStrategy returns the conflict strategy of a bucket.
+func (s *Service) GetConflictStrategy(ctx context.Context, req *pb.GetConflictStrategyRequest) (*pb.GetConflictStrategyReply, error) {
+	log.Debugf("received get conflict strategy request")
 
-	pth := path.New(req.Path)
-	item, err := s.pathToItem(ctx, pth, true, nil)
-	if err != nil {
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
 		return nil, err
 	}
-	return &pb.ListIpfsPathReply{Item: item}, nil
+	for ps, strategy := range conflictStrategies {
+		if strategy == buck.ConflictStrategy {
+			return &pb.GetConflictStrategyReply{Strategy: ps}, nil
+		}
+	}
+	return &pb.GetConflictStrategyReply{}, nil
 }
 
-// pathToItem returns items at path, optionally including one level down of links.
-// If key is not nil, the items will be decrypted.
-func (s *Service) pathToItem(ctx context.Context, pth path.Path, includeNextLevel bool, key []byte) (*pb.ListPathItem, error) {
-	var n ipld.Node
-	if key != nil {
-		rp, fp, err := util.ParsePath(pth)
-		if err != nil {
-			return nil, err
+var conflictStrategies = map[pb.SetConflictStrategyRequest_Strategy]buckets.ConflictStrategy{
+	pb.SetConflictStrategyRequest_LastWriterWins: buckets.ConflictLastWriterWins,
+	pb.SetConflictStrategyRequest_Reject:         buckets.ConflictReject,
+	pb.SetConflictStrategyRequest_KeepBoth:       buckets.ConflictKeepBoth,
+	pb.SetConflictStrategyRequest_Merge:          buckets.ConflictMerge,
+}
+
+// ApplyLifecycle applies the lifecycle rules of a scheduled bucket and reschedules the next run.
+// The outcome of each rule is saved as the rule's status.
+func (s *Service) ApplyLifecycle(ctx context.Context, sl mdb.ScheduledLifecycle) error {
+	ctx = common.NewThreadIDContext(ctx, sl.DbID)
+	ctx = thread.NewTokenContext(ctx, sl.DbToken)
+	ctx = s.ownerContext(ctx, sl.Owner)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, sl.DbID, sl.BucketKey, buck, tdb.WithToken(sl.DbToken)); err != nil {
+		if strings.Contains(err.Error(), db.ErrInstanceNotFound.Error()) {
+			return s.Collections.BucketLifecycles.Delete(ctx, sl.BucketKey)
 		}
-		np, r, err := s.getNodesToPath(ctx, rp, fp, key)
-		if err != nil {
-			return nil, err
+		return err
+	}
+	if buck.Lifecycle == nil || len(buck.Lifecycle.Rules) == 0 {
+		return s.Collections.BucketLifecycles.Delete(ctx, sl.BucketKey)
+	}
+
+	statuses := make(map[string]tdb.LifecycleStatus, len(buck.Lifecycle.Rules))
+	for _, r := range buck.Lifecycle.Rules {
+		var affected int64
+		var err error
+		switch r.Action {
+		case tdb.LifecycleArchive:
+			affected, err = s.applyArchiveRule(ctx, buck, r)
+		case tdb.LifecycleExpire:
+			affected, err = s.applyExpireRule(ctx, sl.DbID, sl.DbToken, buck, r)
+		default:
+			err = fmt.Errorf("unknown action %s", r.Action)
 		}
-		if r != "" {
-			return nil, fmt.Errorf("could not resolve path: %s", pth)
+		st := tdb.LifecycleStatus{
+			LastRunAt: time.Now().UnixNano(),
+			Affected:  affected,
 		}
-		n = np[len(np)-1].new
-	} else {
-		rp, err := s.IPFSClient.ResolvePath(ctx, pth)
 		if err != nil {
-			return nil, err
+			st.Error = err.Error()
+			log.Errorf("applying lifecycle rule %s of bucket %s: %v", r.ID, buck.Key, err)
 		}
-		n, err = s.IPFSClient.Dag().Get(ctx, rp.Cid())
-		if err != nil {
-			return nil, err
+		statuses[r.ID] = st
+
+		// Rules may change the bucket, reload before applying the next one.
+		if err := s.Buckets.Get(ctx, sl.DbID, sl.BucketKey, buck, tdb.WithToken(sl.DbToken)); err != nil {
+			return err
+		}
+		if buck.Lifecycle == nil {
+			return nil
 		}
 	}
-	return s.nodeToItem(ctx, n, pth.String(), key, false, includeNextLevel)
-}
-
-// getNodeAtPath returns the decrypted node at path.
-func (s *Service) getNodeAtPath(ctx context.Context, pth path.Resolved, key []byte) (ipld.Node, error) {
-	cn, err := s.IPFSClient.ResolveNode(ctx, pth)
+	for i, r := range buck.Lifecycle.Rules {
+		if st, ok := statuses[r.ID]; ok {
+			buck.Lifecycle.Rules[i].Status = st
+		}
+	}
+	if err := s.Buckets.SaveSafe(ctx, sl.DbID, buck, tdb.WithToken(sl.DbToken)); err != nil {
+		return err
+	}
+	return s.Collections.BucketLifecycles.Reschedule(ctx, sl.BucketKey, time.Now().Add(LifecycleInterval))
+}
+
+// applyArchiveRule archives buck if it hasn't changed for the number of days in r.
+// Buckets that are already archived at the current root are skipped.
+func (s *Service) applyArchiveRule(ctx context.Context, buck *tdb.Bucket, r tdb.LifecycleRule) (int64, error) {
+	if !s.Buckets.IsArchivingEnabled() {
+		return 0, ErrArchivingFeatureDisabled
+	}
+	if time.Since(time.Unix(0, buck.UpdatedAt)) < lifecycleDays(r.Days) {
+		return 0, nil
+	}
+	root, err := util.NewResolvedPath(buck.Path)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
-	return decryptNode(cn, key)
+	ffsi, err := s.Collections.FFSInstances.Get(ctx, buck.Key)
+	if err != nil {
+		return 0, err
+	}
+	if archiveUpToDate(ffsi, root.Cid()) {
+		return 0, nil
+	}
+	if _, err = s.Archive(ctx, &pb.ArchiveRequest{Key: buck.Key}); err != nil {
+		return 0, err
+	}
+	return 1, nil
 }
 
-// decryptNode returns a decrypted version of node.
-func decryptNode(cn ipld.Node, key []byte) (ipld.Node, error) {
-	switch cn := cn.(type) {
-	case *dag.RawNode:
-		return cn, nil // All raw nodes will be leaves
-	case *dag.ProtoNode:
-		if key == nil {
-			return cn, nil
-		}
-		fn, err := unixfs.FSNodeFromBytes(cn.Data())
-		if err != nil {
-			return nil, err
-		}
-		if fn.Data() == nil {
-			return cn, nil // This node is a raw file wrapper
-		}
-		plain, err := decryptData(fn.Data(), key)
-		if err != nil {
-			return nil, err
-		}
-		n, err := dag.DecodeProtobuf(plain)
-		if err != nil {
-			return dag.NewRawNode(plain), nil
-		}
-		n.SetCidBuilder(dag.V1CidPrefix())
-		return n, nil
+// archiveUpToDate returns whether the current archive of ffsi is of root and hasn't failed or been aborted.
+func archiveUpToDate(ffsi *mdb.FFSInstance, root cid.Cid) bool {
+	current := ffsi.Archives.Current
+	if current.JobID == "" || current.Aborted {
+		return false
+	}
+	c, err := cid.Cast(current.Cid)
+	if err != nil || !c.Equals(root) {
+		return false
+	}
+	switch ffs.JobStatus(current.JobStatus) {
+	case ffs.Failed, ffs.Canceled:
+		return false
 	default:
-		return nil, errInvalidNodeType
+		return true
 	}
 }
 
-func decryptData(data, key []byte) ([]byte, error) {
-	r, err := dcrypto.NewDecrypter(bytes.NewReader(data), key)
+// applyExpireRule removes the files under the prefix in r that were added more than the number of days in r ago.
+// Files are aged from their metadata. Files without metadata are aged from the first time they're seen by the rule.
+func (s *Service) applyExpireRule(ctx context.Context, dbID thread.ID, dbToken thread.Token, buck *tdb.Bucket, r tdb.LifecycleRule) (int64, error) {
+	prefix := strings.Trim(r.Prefix, "/")
+	if _, err := s.pathToItem(ctx, path.New(gopath.Join(buck.Path, prefix)), false, buck.GetEncKey()); err != nil {
+		return 0, nil // Nothing to expire
+	}
+	files, err := s.listFiles(ctx, buck, prefix)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+	cutoff := time.Now().Add(-lifecycleDays(r.Days)).UnixNano()
+	var expired []string
+	var seen bool
+	for _, f := range files {
+		md, ok := buck.Metadata[f]
+		if !ok {
+			buck.SetMetadataAtPath(f, tdb.Metadata{ContentType: detectContentType(f, nil)})
+			seen = true
+			continue
+		}
+		if md.UpdatedAt < cutoff {
+			expired = append(expired, f)
+		}
 	}
-	defer r.Close()
-	return ioutil.ReadAll(r)
-}
-
-func (s *Service) nodeToItem(ctx context.Context, node ipld.Node, pth string, key []byte, decrypt, includeNextLevel bool) (*pb.ListPathItem, error) {
-	if decrypt && key != nil {
-		var err error
-		node, err = decryptNode(node, key)
-		if err != nil {
-			return nil, err
+	if seen {
+		if err := s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
+			return 0, err
 		}
 	}
-	stat, err := node.Stat()
+	var affected int64
+	for _, f := range expired {
+		if _, err := s.RemovePath(ctx, &pb.RemovePathRequest{
+			Key:     buck.Key,
+			Path:    f,
+			Message: fmt.Sprintf("Expired by lifecycle rule %s", r.ID),
+		}); err != nil {
+			return affected, err
+		}
+		affected++
+	}
+	return affected, nil
+}
+
+// listFiles returns the paths of all files under filePath, relative to the bucket root.
+func (s *Service) listFiles(ctx context.Context, buck *tdb.Bucket, filePath string) ([]string, error) {
+	item, err := s.pathToItem(ctx, path.New(gopath.Join(buck.Path, filePath)), true, buck.GetEncKey())
 	if err != nil {
 		return nil, err
 	}
-	item := &pb.ListPathItem{
-		Cid:  node.Cid().String(),
-		Name: gopath.Base(pth),
-		Path: pth,
-		Size: int64(stat.CumulativeSize),
+	if !item.IsDir {
+		return []string{filePath}, nil
 	}
-	for _, l := range node.Links() {
-		if l.Name == "" {
-			break
+	var files []string
+	for _, i := range item.Items {
+		if i.Name == buckets.SeedName {
+			continue
 		}
-		item.IsDir = true
-		i := &pb.ListPathItem{}
-		if includeNextLevel {
-			p := gopath.Join(pth, l.Name)
-			n, err := l.GetNode(ctx, s.IPFSClient.Dag())
+		p := gopath.Join(filePath, i.Name)
+		if i.IsDir {
+			list, err := s.listFiles(ctx, buck, p)
 			if err != nil {
 				return nil, err
 			}
-			i, err = s.nodeToItem(ctx, n, p, key, true, false)
-			if err != nil {
-				return nil, err
+			files = append(files, list...)
+		} else {
+			files = append(files, p)
+		}
+	}
+	return files, nil
+}
+
+// ownerContext adds the account or user with key to ctx.
+// Usage of the bucket owner is then accounted for as if the owner made the request.
+func (s *Service) ownerContext(ctx context.Context, key crypto.PubKey) context.Context {
+	if key == nil {
+		return ctx
+	}
+	if s.Collections.Accounts != nil {
+		if a, err := s.Collections.Accounts.Get(ctx, key); err == nil {
+			if a.Type == mdb.Org {
+				return mdb.NewOrgContext(ctx, a)
 			}
+			return mdb.NewDevContext(ctx, a)
+		}
+	}
+	if s.Collections.Users != nil {
+		if u, err := s.Collections.Users.Get(ctx, key); err == nil {
+			return mdb.NewUserContext(ctx, u)
 		}
-		item.Items = append(item.Items, i)
 	}
-	return item, nil
+	return ctx
 }
 
-func parsePath(pth string) (fpth string, err error) {
-	if strings.Contains(pth, buckets.SeedName) {
-		err = fmt.Errorf("paths containing %s are not allowed", buckets.SeedName)
-		return
+func (s *Service) lifecycleRuleFromPb(r *pb.LifecycleRule, i int) (tdb.LifecycleRule, error) {
+	rule := tdb.LifecycleRule{
+		ID:     r.Id,
+		Action: r.Action,
+		Prefix: strings.Trim(r.Prefix, "/"),
+		Days:   int(r.Days),
 	}
-	fpth = strings.TrimPrefix(pth, "/")
-	return
+	if rule.ID == "" {
+		rule.ID = fmt.Sprintf("%s-%d", rule.Action, i)
+	}
+	if rule.Days < 1 {
+		return rule, fmt.Errorf("rule %s must apply after at least one day", rule.ID)
+	}
+	switch rule.Action {
+	case tdb.LifecycleArchive:
+		if !s.Buckets.IsArchivingEnabled() {
+			return rule, ErrArchivingFeatureDisabled
+		}
+		if rule.Prefix != "" {
+			return rule, fmt.Errorf("archive rule %s does not support a prefix", rule.ID)
+		}
+	case tdb.LifecycleExpire:
+		if rule.Prefix == "" {
+			return rule, fmt.Errorf("expire rule %s requires a prefix", rule.ID)
+		}
+		if _, err := parsePath(rule.Prefix); err != nil {
+			return rule, err
+		}
+	default:
+		return rule, fmt.Errorf("rule %s has unknown action %s", rule.ID, rule.Action)
+	}
+	return rule, nil
 }
 
-func (s *Service) getBucketPath(ctx context.Context, dbID thread.ID, key, pth string, token thread.Token) (*tdb.Bucket, path.Path, error) {
-	filePath := strings.TrimPrefix(pth, "/")
-	buck := &tdb.Bucket{}
-	err := s.Buckets.Get(ctx, dbID, key, buck, tdb.WithToken(token))
-	if err != nil {
-		return nil, nil, err
+func (s *Service) lifecycleToPb(ctx context.Context, buck *tdb.Bucket) (*pb.Lifecycle, error) {
+	lifecycle := &pb.Lifecycle{}
+	if buck.Lifecycle == nil {
+		return lifecycle, nil
+	}
+	for _, r := range buck.Lifecycle.Rules {
+		lifecycle.Rules = append(lifecycle.Rules, &pb.LifecycleRule{
+			Id:     r.ID,
+			Action: r.Action,
+			Prefix: r.Prefix,
+			Days:   int32(r.Days),
+			Status: &pb.LifecycleRule_Status{
+				LastRunAt: r.Status.LastRunAt,
+				Affected:  r.Status.Affected,
+				Error:     r.Status.Error,
+			},
+		})
 	}
-	npth, err := inflateFilePath(buck, filePath)
-	return buck, npth, err
+	sl, err := s.Collections.BucketLifecycles.Get(ctx, buck.Key)
+	if err == nil {
+		lifecycle.NextRunAt = sl.ReadyAt.UnixNano()
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	return lifecycle, nil
 }
 
-func inflateFilePath(buck *tdb.Bucket, filePath string) (path.Path, error) {
-	npth := path.New(gopath.Join(buck.Path, filePath))
-	if err := npth.IsValid(); err != nil {
+func lifecycleDays(days int) time.Duration {
+	return time.Duration(days) * 24 * time.Hour
+}
+
+// AddReplicationTarget adds a bucket on a remote hub that mirrors a bucket.
+// The remote bucket must already exist. Its contents are replaced each time the bucket changes.
+func (s *Service) AddReplicationTarget(ctx context.Context, req *pb.AddReplicationTargetRequest) (*pb.AddReplicationTargetReply, error) {
+	log.Debugf("received add replication target request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
 		return nil, err
 	}
-	return npth, nil
+	if buck.GetEncKey() != nil {
+		return nil, status.Error(codes.FailedPrecondition, "Replication of private buckets is not supported")
+	}
+	if req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "Address is required")
+	}
+	host, _, err := net.SplitHostPort(req.Address)
+	if err != nil || host == "" {
+		return nil, status.Error(codes.InvalidArgument, "Address must be of the form host:port")
+	}
+	if !s.AllowPrivateEndpoints {
+		if req.Insecure {
+			return nil, status.Error(codes.InvalidArgument, "Insecure replication targets are not allowed")
+		}
+		if err := util.CheckPublicHost(ctx, host); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Address host must resolve to a public address: %v", err)
+		}
+	}
+	if req.RemoteKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "Remote key is required")
+	}
+	remoteThread, err := thread.Decode(req.Thread)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid remote thread: %v", err)
+	}
+	t, err := s.Collections.ReplicationTargets.Create(ctx, mdb.ReplicationTarget{
+		BucketKey:    buck.Key,
+		DbID:         dbID,
+		DbToken:      dbToken,
+		Address:      req.Address,
+		Insecure:     req.Insecure,
+		APIKey:       req.ApiKey,
+		APISecret:    req.ApiSecret,
+		RemoteThread: remoteThread,
+		RemoteToken:  thread.Token(req.Token),
+		RemoteKey:    req.RemoteKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddReplicationTargetReply{Target: replicationTargetToPb(*t)}, nil
 }
 
-func (s *Service) pathToPb(ctx context.Context, id thread.ID, buck *tdb.Bucket, pth path.Path, includeNextLevel bool) (*pb.ListPathReply, error) {
-	item, err := s.pathToItem(ctx, pth, includeNextLevel, buck.GetEncKey())
+// ListReplicationTargets returns the replication targets of a bucket, including the status of their last replication.
+func (s *Service) ListReplicationTargets(ctx context.Context, req *pb.ListReplicationTargetsRequest) (*pb.ListReplicationTargetsReply, error) {
+	log.Debugf("received list replication targets request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	list, err := s.Collections.ReplicationTargets.List(ctx, buck.Key)
 	if err != nil {
 		return nil, err
 	}
-	return &pb.ListPathReply{
-		Item: item,
-		Root: &pb.Root{
-			Key:       buck.Key,
-			Name:      buck.Name,
-			Path:      buck.Path,
-			Thread:    id.String(),
-			CreatedAt: buck.CreatedAt,
-			UpdatedAt: buck.UpdatedAt,
-		},
-	}, nil
+	targets := make([]*pb.ReplicationTarget, len(list))
+	for i, t := range list {
+		targets[i] = replicationTargetToPb(t)
+	}
+	return &pb.ListReplicationTargetsReply{Targets: targets}, nil
 }
 
-func (s *Service) PushPath(server pb.API_PushPathServer) error {
-	log.Debugf("received push path request")
+// RemoveReplicationTarget stops replicating a bucket to a target.
+// The remote bucket is left as is.
+func (s *Service) RemoveReplicationTarget(ctx context.Context, req *pb.RemoveReplicationTargetRequest) (*pb.RemoveReplicationTargetReply, error) {
+	log.Debugf("received remove replication target request")
 
-	dbID, ok := common.ThreadIDFromContext(server.Context())
+	dbID, ok := common.ThreadIDFromContext(ctx)
 	if !ok {
-		return fmt.Errorf("db required")
+		return nil, fmt.Errorf("db required")
 	}
-	dbToken, _ := thread.TokenFromContext(server.Context())
+	dbToken, _ := thread.TokenFromContext(ctx)
 
-	req, err := server.Recv()
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	t, err := s.Collections.ReplicationTargets.Get(ctx, req.Id)
+	if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && t.BucketKey != buck.Key) {
+		return nil, status.Error(codes.NotFound, "Replication target not found")
+	} else if err != nil {
+		return nil, err
+	}
+	if err = s.Collections.ReplicationTargets.Delete(ctx, t.ID); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveReplicationTargetReply{}, nil
+}
+
+// ReplicateBucket mirrors the current root of a bucket to a replication target.
+// Only blocks that are not already part of the remote bucket are sent.
+// The remote bucket root is then set to the replicated DAG.
+func (s *Service) ReplicateBucket(ctx context.Context, t mdb.ReplicationTarget) error {
+	rctx, err := replicationContext(ctx, t)
 	if err != nil {
 		return err
 	}
-	var key, headerPath, root string
-	switch payload := req.Payload.(type) {
-	case *pb.PushPathRequest_Header_:
-		key = payload.Header.Key
-		headerPath = payload.Header.Path
-		root = payload.Header.Root
-	default:
-		return fmt.Errorf("push bucket path header is required")
+	ctx = common.NewThreadIDContext(ctx, t.DbID)
+	ctx = thread.NewTokenContext(ctx, t.DbToken)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, t.DbID, t.BucketKey, buck, tdb.WithToken(t.DbToken)); err != nil {
+		if strings.Contains(err.Error(), db.ErrInstanceNotFound.Error()) {
+			return s.Collections.ReplicationTargets.DeleteByBucket(ctx, t.BucketKey)
+		}
+		return err
 	}
-	filePath, err := parsePath(headerPath)
+	if buck.GetEncKey() != nil {
+		return fmt.Errorf("replication of private buckets is not supported")
+	}
+	root, err := util.NewResolvedPath(buck.Path)
 	if err != nil {
 		return err
 	}
-	buck := &tdb.Bucket{}
-	err = s.Buckets.Get(server.Context(), dbID, key, buck, tdb.WithToken(dbToken))
+	if root.String() == t.LastRoot {
+		return s.Collections.ReplicationTargets.SetSynced(ctx, t.ID, t.Seq, t.LastRoot)
+	}
+
+	opts, err := s.replicationDialOptions(t)
 	if err != nil {
 		return err
 	}
-	if root != "" && root != buck.Path {
-		return status.Error(codes.FailedPrecondition, buckets.ErrNonFastForward.Error())
+	client, err := bc.NewClient(t.Address, opts...)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	if err = s.replicateNode(ctx, rctx, client, t.RemoteKey, root.Cid()); err != nil {
+		return fmt.Errorf("replicating blocks: %v", err)
+	}
+	if _, err = client.SetPath(rctx, t.RemoteKey, "", root.Cid(), bc.WithMessage(fmt.Sprintf("Replicated from %s", buck.Key))); err != nil {
+		return fmt.Errorf("setting remote root: %v", err)
 	}
+	return s.Collections.ReplicationTargets.SetSynced(ctx, t.ID, t.Seq, root.String())
+}
 
-	sendEvent := func(event *pb.PushPathReply_Event) error {
-		return server.Send(&pb.PushPathReply{
-			Payload: &pb.PushPathReply_Event_{
-				Event: event,
-			},
-		})
+// replicateNode sends the DAG under c to the remote bucket with key.
+// Nodes that are already part of the remote bucket are skipped along with their links.
+func (s *Service) replicateNode(ctx, rctx context.Context, client *bc.Client, key string, c cid.Cid) error {
+	has, err := client.HasBlock(rctx, key, c)
+	if err != nil {
+		return err
+	}
+	if has {
+		return nil
+	}
+	n, err := s.IPFSClient.Dag().Get(ctx, c)
+	if err != nil {
+		return err
+	}
+	for _, l := range n.Links() {
+		if err := s.replicateNode(ctx, rctx, client, key, l.Cid); err != nil {
+			return err
+		}
 	}
+	format := "v0"
+	if c.Version() > 0 {
+		format = cid.CodecToStr[c.Type()]
+	}
+	_, err = client.PutBlock(rctx, key, n.RawData(), bc.WithBlockFormat(format), bc.WithBlockCid(c))
+	return err
+}
 
-	sendErr := func(err error) {
-		if err2 := server.Send(&pb.PushPathReply{
-			Payload: &pb.PushPathReply_Error{
-				Error: err.Error(),
-			},
-		}); err2 != nil {
-			log.Errorf("error sending error: %v (%v)", err, err2)
+// replicationContext returns a context that authenticates with the remote hub of t.
+func replicationContext(ctx context.Context, t mdb.ReplicationTarget) (context.Context, error) {
+	ctx = common.NewAPIKeyContext(ctx, t.APIKey)
+	if t.APISecret != "" {
+		var err error
+		ctx, err = common.CreateAPISigContext(ctx, time.Now().Add(time.Hour), t.APISecret)
+		if err != nil {
+			return nil, err
 		}
 	}
+	if t.RemoteToken != "" {
+		ctx = thread.NewTokenContext(ctx, t.RemoteToken)
+	}
+	return common.NewThreadIDContext(ctx, t.RemoteThread), nil
+}
 
-	buckPath := path.New(buck.Path)
-	stat, err := s.IPFSClient.Object().Stat(server.Context(), buckPath)
-	if err != nil {
-		return fmt.Errorf("get stat of current bucket: %s", err)
+// replicationDialOptions returns dial options for the remote hub of t.
+// Connections use TLS unless the target is insecure, which is only allowed along with private endpoints.
+// The remote hub must resolve to a public address unless private endpoints are allowed.
+func (s *Service) replicationDialOptions(t mdb.ReplicationTarget) ([]grpc.DialOption, error) {
+	if t.Insecure && !s.AllowPrivateEndpoints {
+		return nil, fmt.Errorf("insecure replication targets are not allowed")
+	}
+	dial := util.PublicDialer(s.AllowPrivateEndpoints)
+	opts := []grpc.DialOption{
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return dial(ctx, "tcp", addr)
+		}),
+	}
+	if t.Insecure {
+		return append(opts, grpc.WithInsecure(), grpc.WithPerRPCCredentials(common.Credentials{})), nil
+	}
+	return append(opts,
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{})),
+		grpc.WithPerRPCCredentials(common.Credentials{Secure: true})), nil
+}
+
+// markReplicationPending queues a bucket for replication to its targets.
+func (s *Service) markReplicationPending(ctx context.Context, key string) {
+	if err := s.Collections.ReplicationTargets.MarkPending(ctx, key); err != nil {
+		log.Errorf("marking replication targets of bucket %s pending: %v", key, err)
 	}
-	currentSize := int64(stat.CumulativeSize)
-	reader, writer := io.Pipe()
-	waitCh := make(chan struct{})
-	go func() {
-		defer close(waitCh)
-		for {
-			var cummSize int64
-			req, err := server.Recv()
-			if err == io.EOF {
-				_ = writer.Close()
-				return
-			} else if err != nil {
-				sendErr(fmt.Errorf("error on receive: %v", err))
-				_ = writer.CloseWithError(err)
-				return
+}
+
+// AddPinMirror pins the root of a bucket on a remote pinning service.
+// The remote pin is replaced each time the bucket root changes.
+func (s *Service) AddPinMirror(ctx context.Context, req *pb.AddPinMirrorRequest) (*pb.AddPinMirrorReply, error) {
+	log.Debugf("received add pin mirror request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if req.Endpoint == "" {
+		return nil, status.Error(codes.InvalidArgument, "Endpoint is required")
+	}
+	if _, err := pinning.NewClient(req.Endpoint, req.Token, s.AllowPrivateEndpoints); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid endpoint: %v", err)
+	}
+	if !s.AllowPrivateEndpoints {
+		u, _ := url.Parse(req.Endpoint)
+		if err := util.CheckPublicHost(ctx, u.Hostname()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Endpoint host must resolve to a public address: %v", err)
+		}
+	}
+	m, err := s.Collections.PinMirrors.Create(ctx, mdb.PinMirror{
+		BucketKey: buck.Key,
+		DbID:      dbID,
+		DbToken:   dbToken,
+		Endpoint:  req.Endpoint,
+		Token:     req.Token,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddPinMirrorReply{Mirror: pinMirrorToPb(*m)}, nil
+}
+
+// ListPinMirrors returns the pin mirrors of a bucket, including the status of their remote pins.
+func (s *Service) ListPinMirrors(ctx context.Context, req *pb.ListPinMirrorsRequest) (*pb.ListPinMirrorsReply, error) {
+	log.Debugf("received list pin mirrors request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	mirrors, err := s.listPinMirrors(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListPinMirrorsReply{Mirrors: mirrors}, nil
+}
+
+// RemovePinMirror stops mirroring a bucket to a pinning service.
+// The remote pin is removed on a best-effort basis.
+func (s *Service) RemovePinMirror(ctx context.Context, req *pb.RemovePinMirrorRequest) (*pb.RemovePinMirrorReply, error) {
+	log.Debugf("received remove pin mirror request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	m, err := s.Collections.PinMirrors.Get(ctx, req.Id)
+	if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && m.BucketKey != buck.Key) {
+		return nil, status.Error(codes.NotFound, "Pin mirror not found")
+	} else if err != nil {
+		return nil, err
+	}
+	if err = s.Collections.PinMirrors.Delete(ctx, m.ID); err != nil {
+		return nil, err
+	}
+	if m.RequestID != "" {
+		client, err := pinning.NewClient(m.Endpoint, m.Token, s.AllowPrivateEndpoints)
+		if err == nil {
+			err = client.Remove(ctx, m.RequestID)
+		}
+		if err != nil && !pinning.IsNotFound(err) {
+			log.Errorf("removing remote pin %s of bucket %s: %v", m.RequestID, m.BucketKey, err)
+		}
+	}
+	return &pb.RemovePinMirrorReply{}, nil
+}
+
+// MirrorBucketPin pins the current root of a bucket on a pinning service.
+// An existing remote pin is replaced so that the service only keeps the latest root.
+// Pins that are not settled yet are checked again after PinMirrorCheckInterval.
+func (s *Service) MirrorBucketPin(ctx context.Context, m mdb.PinMirror) error {
+	ctx = common.NewThreadIDContext(ctx, m.DbID)
+	ctx = thread.NewTokenContext(ctx, m.DbToken)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, m.DbID, m.BucketKey, buck, tdb.WithToken(m.DbToken)); err != nil {
+		if strings.Contains(err.Error(), db.ErrInstanceNotFound.Error()) {
+			return s.Collections.PinMirrors.DeleteByBucket(ctx, m.BucketKey)
+		}
+		return err
+	}
+	root, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return err
+	}
+	client, err := pinning.NewClient(m.Endpoint, m.Token, s.AllowPrivateEndpoints)
+	if err != nil {
+		return err
+	}
+
+	var st *pinning.PinStatus
+	if m.RequestID != "" && root.String() == m.LastRoot {
+		st, err = client.Get(ctx, m.RequestID)
+	} else {
+		pin := pinning.Pin{
+			Cid:     root.Cid().String(),
+			Name:    buck.Key,
+			Origins: s.pinOrigins(ctx),
+		}
+		if m.RequestID != "" {
+			st, err = client.Replace(ctx, m.RequestID, pin)
+		}
+		if m.RequestID == "" || pinning.IsNotFound(err) {
+			st, err = client.Add(ctx, pin)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	settled := st.Status == pinning.Pinned || st.Status == pinning.Failed
+	return s.Collections.PinMirrors.SetSynced(ctx, m.ID, m.Seq, root.String(), st.RequestID, string(st.Status), settled, time.Now().Add(PinMirrorCheckInterval))
+}
+
+// pinOrigins returns the addresses of the IPFS node that pinning services can fetch bucket data from.
+func (s *Service) pinOrigins(ctx context.Context) []string {
+	self, err := s.IPFSClient.Key().Self(ctx)
+	if err != nil {
+		log.Errorf("getting ipfs node id: %v", err)
+		return nil
+	}
+	addrs, err := s.IPFSClient.Swarm().LocalAddrs(ctx)
+	if err != nil {
+		log.Errorf("getting ipfs node addresses: %v", err)
+		return nil
+	}
+	origins := make([]string, len(addrs))
+	for i, a := range addrs {
+		origins[i] = fmt.Sprintf("%s/p2p/%s", a, self.ID())
+	}
+	return origins
+}
+
+// listPinMirrors returns the pin mirrors of the bucket with key.
+func (s *Service) listPinMirrors(ctx context.Context, key string) ([]*pb.PinMirror, error) {
+	list, err := s.Collections.PinMirrors.List(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	mirrors := make([]*pb.PinMirror, len(list))
+	for i, m := range list {
+		mirrors[i] = pinMirrorToPb(m)
+	}
+	return mirrors, nil
+}
+
+// markPinMirrorsPending queues the new root of a bucket for pinning on its pin mirrors.
+func (s *Service) markPinMirrorsPending(ctx context.Context, key string) {
+	if err := s.Collections.PinMirrors.MarkPending(ctx, key); err != nil {
+		log.Errorf("marking pin mirrors of bucket %s pending: %v", key, err)
+	}
+}
+
+func pinMirrorToPb(m mdb.PinMirror) *pb.PinMirror {
+	pm := &pb.PinMirror{
+		Id:        m.ID,
+		Endpoint:  m.Endpoint,
+		Pending:   m.Pending,
+		RequestId: m.RequestID,
+		Status:    m.Status,
+		LastRoot:  m.LastRoot,
+		LastError: m.LastError,
+		CreatedAt: m.CreatedAt.UnixNano(),
+	}
+	if !m.LastSyncedAt.IsZero() {
+		pm.LastSyncedAt = m.LastSyncedAt.UnixNano()
+	}
+	return pm
+}
+
+// AddDomain points the DNSLink record of a custom domain at the root of a bucket.
+// The domain must be verified with a TXT record before its DNSLink record is managed.
+// Other buckets can claim the same domain until it's verified, and unverified domains are
+// removed after DomainVerifyTimeout.
+func (s *Service) AddDomain(ctx context.Context, req *pb.AddDomainRequest) (*pb.AddDomainReply, error) {
+	log.Debugf("received add domain request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	name, err := dns.NormalizeDomain(req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid domain: %v", err)
+	}
+	if _, err := dns.NewProvider(dns.ProviderConfig{
+		Name:      req.Provider,
+		ZoneID:    req.ZoneId,
+		Token:     req.Token,
+		AccessKey: req.AccessKey,
+		SecretKey: req.SecretKey,
+	}); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid provider: %v", err)
+	}
+	d, err := s.Collections.Domains.Create(ctx, mdb.Domain{
+		BucketKey: buck.Key,
+		DbID:      dbID,
+		DbToken:   dbToken,
+		Name:      name,
+		Challenge: "textile-verification=" + util.MakeToken(16),
+		Provider:  req.Provider,
+		ZoneID:    req.ZoneId,
+		Token:     req.Token,
+		AccessKey: req.AccessKey,
+		SecretKey: req.SecretKey,
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), mdb.DuplicateErrMsg) {
+			return nil, status.Error(codes.AlreadyExists, "Domain is already in use")
+		}
+		return nil, err
+	}
+	return &pb.AddDomainReply{Domain: domainToPb(*d)}, nil
+}
+
+// ListDomains returns the custom domains of a bucket.
+func (s *Service) ListDomains(ctx context.Context, req *pb.ListDomainsRequest) (*pb.ListDomainsReply, error) {
+	log.Debugf("received list domains request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	domains, err := s.listDomains(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListDomainsReply{Domains: domains}, nil
+}
+
+// VerifyDomain checks the verification record of a custom domain right away.
+// Unverified domains are also checked in the background every DomainVerifyInterval.
+func (s *Service) VerifyDomain(ctx context.Context, req *pb.VerifyDomainRequest) (*pb.VerifyDomainReply, error) {
+	log.Debugf("received verify domain request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	d, err := s.Collections.Domains.Get(ctx, req.Id)
+	if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && d.BucketKey != buck.Key) {
+		return nil, status.Error(codes.NotFound, "Domain not found")
+	} else if err != nil {
+		return nil, err
+	}
+	if !d.Verified {
+		verified, err := dns.VerifyChallenge(ctx, d.Name, d.Challenge)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "Looking up verification record: %v", err)
+		}
+		if !verified {
+			return nil, status.Errorf(codes.FailedPrecondition, "Verification record %s not found", dns.ChallengeName(d.Name))
+		}
+		if err := s.Collections.Domains.SetVerified(ctx, d.ID); err != nil {
+			if strings.Contains(err.Error(), mdb.DuplicateErrMsg) {
+				return nil, status.Error(codes.AlreadyExists, "Domain is already verified by another bucket")
+			}
+			return nil, err
+		}
+		s.markDomainsPending(ctx, buck.Key)
+		if d, err = s.Collections.Domains.Get(ctx, d.ID); err != nil {
+			return nil, err
+		}
+	}
+	return &pb.VerifyDomainReply{Domain: domainToPb(*d)}, nil
+}
+
+// RemoveDomain stops managing the DNSLink record of a custom domain.
+// The record itself is left in place.
+func (s *Service) RemoveDomain(ctx context.Context, req *pb.RemoveDomainRequest) (*pb.RemoveDomainReply, error) {
+	log.Debugf("received remove domain request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	d, err := s.Collections.Domains.Get(ctx, req.Id)
+	if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && d.BucketKey != buck.Key) {
+		return nil, status.Error(codes.NotFound, "Domain not found")
+	} else if err != nil {
+		return nil, err
+	}
+	if err = s.Collections.Domains.Delete(ctx, d.ID); err != nil {
+		return nil, err
+	}
+	return &pb.RemoveDomainReply{}, nil
+}
+
+// SyncDomain points the DNSLink record of a custom domain at the current root of its bucket.
+// Unverified domains are only synced once their verification record is found.
+func (s *Service) SyncDomain(ctx context.Context, d mdb.Domain) error {
+	ctx = common.NewThreadIDContext(ctx, d.DbID)
+	ctx = thread.NewTokenContext(ctx, d.DbToken)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, d.DbID, d.BucketKey, buck, tdb.WithToken(d.DbToken)); err != nil {
+		if strings.Contains(err.Error(), db.ErrInstanceNotFound.Error()) {
+			return s.Collections.Domains.DeleteByBucket(ctx, d.BucketKey)
+		}
+		return err
+	}
+	if !d.Verified {
+		verified, err := dns.VerifyChallenge(ctx, d.Name, d.Challenge)
+		if err != nil {
+			return err
+		}
+		if !verified {
+			return s.Collections.Domains.SetUnverified(ctx, d.ID, time.Now().Add(DomainVerifyInterval))
+		}
+		if err := s.Collections.Domains.SetVerified(ctx, d.ID); err != nil {
+			return err
+		}
+	}
+	root, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return err
+	}
+	provider, err := dns.NewProvider(dns.ProviderConfig{
+		Name:      d.Provider,
+		ZoneID:    d.ZoneID,
+		Token:     d.Token,
+		AccessKey: d.AccessKey,
+		SecretKey: d.SecretKey,
+	})
+	if err != nil {
+		return err
+	}
+	if root.String() != d.LastRoot {
+		if err := provider.SetDNSLink(ctx, d.Name, root.Cid().String()); err != nil {
+			return err
+		}
+	}
+	return s.Collections.Domains.SetSynced(ctx, d.ID, d.Seq, root.String())
+}
+
+// listDomains returns the custom domains of the bucket with key.
+func (s *Service) listDomains(ctx context.Context, key string) ([]*pb.Domain, error) {
+	list, err := s.Collections.Domains.List(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	domains := make([]*pb.Domain, len(list))
+	for i, d := range list {
+		domains[i] = domainToPb(d)
+	}
+	return domains, nil
+}
+
+// markDomainsPending queues the new root of a bucket for its custom domains' DNSLink records.
+func (s *Service) markDomainsPending(ctx context.Context, key string) {
+	if err := s.Collections.Domains.MarkPending(ctx, key); err != nil {
+		log.Errorf("marking domains of bucket %s pending: %v", key, err)
+	}
+}
+
+func domainToPb(d mdb.Domain) *pb.Domain {
+	pd := &pb.Domain{
+		Id:             d.ID,
+		Name:           d.Name,
+		Provider:       d.Provider,
+		ChallengeName:  dns.ChallengeName(d.Name),
+		ChallengeValue: d.Challenge,
+		Verified:       d.Verified,
+		Pending:        d.Pending,
+		LastRoot:       d.LastRoot,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt.UnixNano(),
+	}
+	if !d.LastSyncedAt.IsZero() {
+		pd.LastSyncedAt = d.LastSyncedAt.UnixNano()
+	}
+	return pd
+}
+
+// markIndexPending schedules the search index of a bucket to be rebuilt.
+func (s *Service) markIndexPending(ctx context.Context, dbID thread.ID, dbToken thread.Token, key string) {
+	if err := s.Collections.SearchIndexStates.MarkPending(ctx, key, dbID, dbToken); err != nil {
+		log.Errorf("marking search index of bucket %s pending: %v", key, err)
+	}
+}
+
+// IndexBucket rebuilds the search index of a bucket from its current root.
+// The previous index is searchable until the new one is complete. Private buckets are not indexed.
+func (s *Service) IndexBucket(ctx context.Context, st mdb.SearchIndexState) error {
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, st.DbID, st.BucketKey, buck, tdb.WithToken(st.DbToken)); err != nil {
+		if strings.Contains(err.Error(), db.ErrInstanceNotFound.Error()) {
+			if err := s.Collections.SearchIndex.DeleteByBucket(ctx, st.BucketKey); err != nil {
+				return err
+			}
+			return s.Collections.SearchIndexStates.Delete(ctx, st.BucketKey)
+		}
+		return err
+	}
+	if buck.GetEncKey() != nil {
+		// Private bucket paths are not stored outside the bucket.
+		if err := s.Collections.SearchIndex.DeleteByBucket(ctx, buck.Key); err != nil {
+			return err
+		}
+		return s.Collections.SearchIndexStates.SetIndexed(ctx, buck.Key, st.Seq, "", buck.Path)
+	}
+	item, _, err := s.listPathItem(ctx, path.New(buck.Path), nil, listOptions{depth: -1})
+	if err != nil {
+		return err
+	}
+	entries := searchEntries(buck, item.Items, nil)
+	gen := util.MakeToken(searchIndexGenLen)
+	if err := s.Collections.SearchIndex.Insert(ctx, buck.Key, gen, entries); err != nil {
+		return err
+	}
+	if err := s.Collections.SearchIndexStates.SetIndexed(ctx, buck.Key, st.Seq, gen, buck.Path); err != nil {
+		return err
+	}
+	return s.Collections.SearchIndex.DeleteStale(ctx, buck.Key, gen)
+}
+
+// searchEntries appends search index entries for items and their descendants to entries.
+func searchEntries(buck *tdb.Bucket, items []*pb.ListPathItem, entries []mdb.SearchEntry) []mdb.SearchEntry {
+	for _, i := range items {
+		rel := strings.TrimPrefix(strings.TrimPrefix(i.Path, buck.Path), "/")
+		if rel == buckets.SeedName {
+			continue
+		}
+		e := mdb.SearchEntry{
+			Path:  rel,
+			Name:  i.Name,
+			Cid:   i.Cid,
+			Size:  i.Size,
+			IsDir: i.IsDir,
+		}
+		if md, ok := buck.Metadata[rel]; ok {
+			e.ContentType = md.ContentType
+			e.Attributes = md.Attributes
+		}
+		entries = append(searchEntries(buck, i.Items, entries), e)
+	}
+	return entries
+}
+
+// markThumbnailsPending schedules the thumbnails of a bucket's images to be generated.
+func (s *Service) markThumbnailsPending(ctx context.Context, dbID thread.ID, dbToken thread.Token, key string) {
+	if !s.Thumbnails {
+		return
+	}
+	if err := s.Collections.ThumbnailStates.MarkPending(ctx, key, dbID, dbToken); err != nil {
+		log.Errorf("marking thumbnails of bucket %s pending: %v", key, err)
+	}
+}
+
+// GenerateThumbnails generates thumbnails of the images of a bucket that changed since they were last generated.
+// Thumbnails of images that were removed are deleted. Private buckets have no thumbnails.
+func (s *Service) GenerateThumbnails(ctx context.Context, st mdb.ThumbnailState) error {
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, st.DbID, st.BucketKey, buck, tdb.WithToken(st.DbToken)); err != nil {
+		if strings.Contains(err.Error(), db.ErrInstanceNotFound.Error()) {
+			if err := s.Collections.Thumbnails.DeleteByBucket(ctx, st.BucketKey); err != nil {
+				return err
+			}
+			return s.Collections.ThumbnailStates.Delete(ctx, st.BucketKey)
+		}
+		return err
+	}
+	if buck.GetEncKey() != nil {
+		// Private bucket content is not stored outside the bucket.
+		if err := s.Collections.Thumbnails.DeleteByBucket(ctx, buck.Key); err != nil {
+			return err
+		}
+		return s.Collections.ThumbnailStates.SetGenerated(ctx, buck.Key, st.Seq, buck.Path)
+	}
+	item, _, err := s.listPathItem(ctx, path.New(buck.Path), nil, listOptions{depth: -1})
+	if err != nil {
+		return err
+	}
+	existing, err := s.Collections.Thumbnails.ListCids(ctx, buck.Key)
+	if err != nil {
+		return err
+	}
+	for _, e := range searchEntries(buck, item.Items, nil) {
+		if e.IsDir {
+			continue
+		}
+		ct := e.ContentType
+		if ct == "" {
+			ct = detectContentType(e.Path, nil)
+		}
+		if !buckets.Thumbnailable(ct) || buck.Metadata[e.Path].ContentEncoding != "" {
+			continue
+		}
+		c, ok := existing[e.Path]
+		delete(existing, e.Path)
+		if ok && c == e.Cid {
+			continue
+		}
+		if err := s.generateThumbnail(ctx, buck.Key, e.Path, e.Cid); err != nil {
+			return err
+		}
+	}
+	for p := range existing {
+		if err := s.Collections.Thumbnails.Delete(ctx, buck.Key, p); err != nil {
+			return err
+		}
+	}
+	return s.Collections.ThumbnailStates.SetGenerated(ctx, buck.Key, st.Seq, buck.Path)
+}
+
+// generateThumbnail generates and saves the thumbnails of the image with CID c at filePath.
+// Images that can't be decoded are recorded with an error so they aren't retried until they change.
+func (s *Service) generateThumbnail(ctx context.Context, key, filePath, c string) error {
+	id, err := cid.Decode(c)
+	if err != nil {
+		return err
+	}
+	n, err := s.IPFSClient.Unixfs().Get(ctx, path.IpfsPath(id))
+	if err != nil {
+		return err
+	}
+	defer n.Close()
+	file := ipfsfiles.ToFile(n)
+	if file == nil {
+		return fmt.Errorf("node is a directory")
+	}
+	thumb := mdb.Thumbnail{BucketKey: key, Path: filePath, Cid: c}
+	thumb.Images, thumb.ContentType, err = buckets.Thumbnails(file)
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		thumb.Error = err.Error()
+	}
+	return s.Collections.Thumbnails.Put(ctx, thumb)
+}
+
+// SearchPath returns bucket items whose name or metadata match a query.
+// Results come from an index that is rebuilt in the background after each bucket change,
+// so they may briefly lag behind the bucket.
+func (s *Service) SearchPath(ctx context.Context, req *pb.SearchPathRequest) (*pb.SearchPathReply, error) {
+	log.Debugf("received search path request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	if !s.SearchIndex {
+		return nil, status.Error(codes.Unimplemented, "Search is not enabled on this hub")
+	}
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Limit must not be negative")
+	}
+	if len(req.Query) > maxSearchQueryLength {
+		return nil, status.Errorf(codes.InvalidArgument, "Query must not be longer than %d characters", maxSearchQueryLength)
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultSearchResults
+	} else if limit > maxSearchResults {
+		limit = maxSearchResults
+	}
+	prefix := strings.Trim(req.Path, "/")
+	q := mdb.SearchQuery{Prefix: prefix, Limit: limit}
+	switch req.Mode {
+	case pb.SearchPathRequest_Substring:
+		if req.Query != "" {
+			q.Pattern = regexp.MustCompile("(?i)" + regexp.QuoteMeta(req.Query))
+		}
+	case pb.SearchPathRequest_Regex:
+		re, err := regexp.Compile(req.Query)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid regex: %v", err)
+		}
+		q.Pattern = re
+	case pb.SearchPathRequest_Glob:
+		if err := buckets.ValidGlob(req.Query); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid glob: %v", err)
+		}
+		q.Filter = func(e mdb.SearchEntry) bool {
+			if !strings.Contains(req.Query, "/") {
+				return buckets.MatchGlob(req.Query, e.Name)
+			}
+			rel := e.Path
+			if prefix != "" {
+				rel = strings.TrimPrefix(strings.TrimPrefix(rel, prefix), "/")
+			}
+			return rel != "" && buckets.MatchGlob(req.Query, rel)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "Unknown search mode")
+	}
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if buck.GetEncKey() != nil {
+		return nil, status.Error(codes.FailedPrecondition, "Search is not supported for private buckets")
+	}
+	st, err := s.Collections.SearchIndexStates.Get(ctx, buck.Key)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		s.markIndexPending(ctx, dbID, dbToken, buck.Key)
+		return nil, status.Error(codes.Unavailable, "Search index is being built, try again shortly")
+	} else if err != nil {
+		return nil, err
+	}
+	if st.Gen == "" {
+		return nil, status.Error(codes.Unavailable, "Search index is being built, try again shortly")
+	}
+	list, err := s.Collections.SearchIndex.Search(ctx, buck.Key, st.Gen, q)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*pb.ListPathItem, len(list))
+	for i, e := range list {
+		items[i] = &pb.ListPathItem{
+			Cid:   e.Cid,
+			Name:  e.Name,
+			Path:  gopath.Join(st.Root, e.Path),
+			Size:  e.Size,
+			IsDir: e.IsDir,
+		}
+		if e.ContentType != "" || len(e.Attributes) > 0 {
+			items[i].Metadata = &pb.Metadata{
+				ContentType: e.ContentType,
+				Attributes:  e.Attributes,
+			}
+		}
+	}
+	return &pb.SearchPathReply{
+		Items:   items,
+		Root:    st.Root,
+		Pending: st.Pending,
+	}, nil
+}
+
+func replicationTargetToPb(t mdb.ReplicationTarget) *pb.ReplicationTarget {
+	rt := &pb.ReplicationTarget{
+		Id:        t.ID,
+		Address:   t.Address,
+		Insecure:  t.Insecure,
+		Thread:    t.RemoteThread.String(),
+		Key:       t.RemoteKey,
+		Pending:   t.Pending,
+		LastRoot:  t.LastRoot,
+		LastError: t.LastError,
+		CreatedAt: t.CreatedAt.UnixNano(),
+	}
+	if !t.LastSyncedAt.IsZero() {
+		rt.LastSyncedAt = t.LastSyncedAt.UnixNano()
+	}
+	return rt
+}
+
+// CreateShareLink creates a gateway link that gives read-only access to a bucket path until it expires.
+// Viewers of the link don't need a key or token, but must enter the password if one is set.
+func (s *Service) CreateShareLink(ctx context.Context, req *pb.CreateShareLinkRequest) (*pb.CreateShareLinkReply, error) {
+	log.Debugf("received create share link request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	filePath, err := parsePath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Unix(0, req.ExpiresAt)
+	if !expiresAt.After(time.Now()) {
+		return nil, status.Error(codes.InvalidArgument, "Expiration must be in the future")
+	}
+	buck, pth, err := s.getBucketPath(ctx, dbID, req.Key, filePath, dbToken)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.pathToItem(ctx, pth, false, buck.GetEncKey()); err != nil {
+		return nil, status.Errorf(codes.NotFound, "Path %s not found", filePath)
+	}
+	link := mdb.ShareLink{
+		BucketKey: buck.Key,
+		DbID:      dbID,
+		DbToken:   dbToken,
+		Path:      filePath,
+		Author:    authorFromContext(ctx),
+		ExpiresAt: expiresAt,
+	}
+	link.SetPassword(req.Password)
+	created, err := s.Collections.ShareLinks.Create(ctx, link)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("created share link for %s in bucket %s", filePath, buck.Key)
+	return &pb.CreateShareLinkReply{Link: s.shareLinkToPb(created)}, nil
+}
+
+// ListShareLinks returns the share links of a bucket that have not expired.
+func (s *Service) ListShareLinks(ctx context.Context, req *pb.ListShareLinksRequest) (*pb.ListShareLinksReply, error) {
+	log.Debugf("received list share links request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	list, err := s.Collections.ShareLinks.List(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	links := make([]*pb.ShareLink, len(list))
+	for i, link := range list {
+		links[i] = s.shareLinkToPb(&link)
+	}
+	return &pb.ListShareLinksReply{Links: links}, nil
+}
+
+// RevokeShareLink removes a share link.
+// The gateway stops serving the link immediately.
+func (s *Service) RevokeShareLink(ctx context.Context, req *pb.RevokeShareLinkRequest) (*pb.RevokeShareLinkReply, error) {
+	log.Debugf("received revoke share link request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if err := s.Collections.ShareLinks.Delete(ctx, buck.Key, req.Id); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Share link not found")
+		}
+		return nil, err
+	}
+
+	log.Debugf("revoked share link of bucket %s", buck.Key)
+	return &pb.RevokeShareLinkReply{}, nil
+}
+
+func (s *Service) shareLinkToPb(link *mdb.ShareLink) *pb.ShareLink {
+	return &pb.ShareLink{
+		Id:        link.Token,
+		Path:      link.Path,
+		Url:       fmt.Sprintf("%s/share/%s", s.GatewayURL, link.Token),
+		ExpiresAt: link.ExpiresAt.UnixNano(),
+		CreatedAt: link.CreatedAt.UnixNano(),
+		Protected: link.Protected(),
+	}
+}
+
+// AddWebhook registers a URL that receives signed callbacks for bucket events.
+// The signing secret is only returned here.
+func (s *Service) AddWebhook(ctx context.Context, req *pb.AddWebhookRequest) (*pb.AddWebhookReply, error) {
+	log.Debugf("received add webhook request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	u, err := url.Parse(req.Url)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, status.Error(codes.InvalidArgument, "URL must be an absolute http or https URL")
+	}
+	if !s.AllowPrivateEndpoints {
+		if err := util.CheckPublicHost(ctx, u.Hostname()); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "URL host must resolve to a public address: %v", err)
+		}
+	}
+	for _, e := range req.Events {
+		if !webhooks.Valid(e) {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown event %s", e)
+		}
+	}
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	hook, err := s.Collections.Webhooks.Create(ctx, mdb.Webhook{
+		BucketKey: buck.Key,
+		URL:       u.String(),
+		Events:    req.Events,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("added webhook %s to bucket %s", hook.ID, buck.Key)
+	return &pb.AddWebhookReply{Webhook: webhookToPb(*hook), Secret: hook.Secret}, nil
+}
+
+// ListWebhooks returns the webhooks of a bucket.
+func (s *Service) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksReply, error) {
+	log.Debugf("received list webhooks request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	list, err := s.Collections.Webhooks.List(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	hooks := make([]*pb.Webhook, len(list))
+	for i, h := range list {
+		hooks[i] = webhookToPb(h)
+	}
+	return &pb.ListWebhooksReply{Webhooks: hooks}, nil
+}
+
+// RemoveWebhook removes a webhook and its queued deliveries.
+func (s *Service) RemoveWebhook(ctx context.Context, req *pb.RemoveWebhookRequest) (*pb.RemoveWebhookReply, error) {
+	log.Debugf("received remove webhook request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if err := s.Collections.Webhooks.Delete(ctx, buck.Key, req.Id); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Webhook not found")
+		}
+		return nil, err
+	}
+	if err := s.Collections.WebhookDeliveries.DeleteByWebhook(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("removed webhook %s from bucket %s", req.Id, buck.Key)
+	return &pb.RemoveWebhookReply{}, nil
+}
+
+// ListWebhookFailures returns the webhook deliveries of a bucket that were given up on, newest first.
+func (s *Service) ListWebhookFailures(ctx context.Context, req *pb.ListWebhookFailuresRequest) (*pb.ListWebhookFailuresReply, error) {
+	log.Debugf("received list webhook failures request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	list, err := s.Collections.WebhookDeliveries.ListDead(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	failures := make([]*pb.WebhookFailure, len(list))
+	for i, d := range list {
+		failures[i] = &pb.WebhookFailure{
+			Id:        d.ID,
+			WebhookID: d.WebhookID,
+			Url:       d.URL,
+			Event:     d.Event,
+			Payload:   d.Payload,
+			Attempts:  int32(d.Attempts),
+			LastError: d.LastError,
+			CreatedAt: d.CreatedAt.UnixNano(),
+		}
+	}
+	return &pb.ListWebhookFailuresReply{Failures: failures}, nil
+}
+
+func webhookToPb(h mdb.Webhook) *pb.Webhook {
+	return &pb.Webhook{
+		Id:        h.ID,
+		Url:       h.URL,
+		Events:    h.Events,
+		CreatedAt: h.CreatedAt.UnixNano(),
+	}
+}
+
+// publishPathPushed queues webhook deliveries for a file pushed to filePath.
+func (s *Service) publishPathPushed(ctx context.Context, dbID thread.ID, buck *tdb.Bucket, filePath string, pth path.Resolved, message string) {
+	s.publishEvent(ctx, webhooks.Event{
+		Type:      webhooks.PathPushed,
+		BucketKey: buck.Key,
+		Thread:    dbID.String(),
+		Path:      filePath,
+		Cid:       pth.Cid().String(),
+		Root:      buck.Path,
+		Message:   message,
+	})
+}
+
+// publishRootChanged queues webhook deliveries for a new bucket root.
+func (s *Service) publishRootChanged(ctx context.Context, dbID thread.ID, buck *tdb.Bucket, message string) {
+	s.publishEvent(ctx, webhooks.Event{
+		Type:      webhooks.RootChanged,
+		BucketKey: buck.Key,
+		Thread:    dbID.String(),
+		Root:      buck.Path,
+		Message:   message,
+	})
+}
+
+// publishEvent queues webhook deliveries for a bucket event.
+// The actor is taken from ctx.
+func (s *Service) publishEvent(ctx context.Context, ev webhooks.Event) {
+	ev.Actor = authorFromContext(ctx)
+	if err := webhooks.Publish(ctx, s.Collections, ev); err != nil {
+		log.Errorf("publishing %s event of bucket %s: %v", ev.Type, ev.BucketKey, err)
+	}
+}
+
+// SetQuota sets the max size of a bucket.
+// A max size of zero removes the quota. The hub's max bucket size always applies.
+func (s *Service) SetQuota(ctx context.Context, req *pb.SetQuotaRequest) (*pb.SetQuotaReply, error) {
+	log.Debugf("received set quota request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	if req.MaxSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Max size must not be negative")
+	}
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	buck.MaxSize = req.MaxSize
+	buck.UpdatedAt = time.Now().UnixNano()
+	if err = s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	quota, err := s.bucketQuota(ctx, buck)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetQuotaReply{
+		Quota: quota,
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+	}, nil
+}
+
+// GetQuota returns the max size, current size, and remaining capacity of a bucket.
+func (s *Service) GetQuota(ctx context.Context, req *pb.GetQuotaRequest) (*pb.GetQuotaReply, error) {
+	log.Debugf("received get quota request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	quota, err := s.bucketQuota(ctx, buck)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetQuotaReply{Quota: quota}, nil
+}
+
+// bucketMaxSize returns the effective max size of buck, which is the smaller of
+// the bucket's own max size and the hub's max bucket size. Zero means no limit.
+func (s *Service) bucketMaxSize(buck *tdb.Bucket) int64 {
+	max := s.BucketsMaxSize
+	if buck.MaxSize > 0 && (max <= 0 || buck.MaxSize < max) {
+		max = buck.MaxSize
+	}
+	return max
+}
+
+// bucketQuota returns the quota of buck.
+// Remaining is zero if the bucket has no max size.
+func (s *Service) bucketQuota(ctx context.Context, buck *tdb.Bucket) (*pb.Quota, error) {
+	size, err := s.dagSize(ctx, path.New(buck.Path))
+	if err != nil {
+		return nil, err
+	}
+	quota := &pb.Quota{
+		MaxSize: s.bucketMaxSize(buck),
+		Size:    size,
+	}
+	if quota.MaxSize > 0 && quota.MaxSize > size {
+		quota.Remaining = quota.MaxSize - size
+	}
+	return quota, nil
+}
+
+// checkBucketSize returns a quota exceeded error if a change that grows buck from current to size
+// exceeds the bucket's max size.
+func (s *Service) checkBucketSize(buck *tdb.Bucket, current, size int64) error {
+	max := s.bucketMaxSize(buck)
+	if max <= 0 || size <= max {
+		return nil
+	}
+	qerr := &buckets.QuotaExceededError{
+		Size:    size,
+		MaxSize: max,
+	}
+	if current < max {
+		qerr.Remaining = max - current
+	}
+	st := status.New(codes.ResourceExhausted, qerr.Error())
+	if dst, err := st.WithDetails(&pb.QuotaExceeded{
+		Size:      qerr.Size,
+		MaxSize:   qerr.MaxSize,
+		Remaining: qerr.Remaining,
+	}); err == nil {
+		return dst.Err()
+	}
+	return st.Err()
+}
+
+// checkSetPathSize checks that replacing the item at filePath with remote keeps buck within its max size.
+func (s *Service) checkSetPathSize(ctx context.Context, buck *tdb.Bucket, filePath string, remote path.Path) error {
+	if s.bucketMaxSize(buck) <= 0 {
+		return nil
+	}
+	current, err := s.dagSize(ctx, path.New(buck.Path))
+	if err != nil {
+		return err
+	}
+	added, err := s.dagSize(ctx, remote)
+	if err != nil {
+		return err
+	}
+	var replaced int64
+	if filePath == "" {
+		replaced = current
+	} else if old := s.existingPath(ctx, buck, filePath); old != nil {
+		if replaced, err = s.dagSize(ctx, old); err != nil {
+			return err
+		}
+	}
+	return s.checkBucketSize(buck, current, current-replaced+added)
+}
+
+// RenameBucket sets the name of a bucket.
+func (s *Service) RenameBucket(ctx context.Context, req *pb.RenameBucketRequest) (*pb.RenameBucketReply, error) {
+	log.Debugf("received rename bucket request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "Name is required")
+	}
+	buck, err := s.Buckets.Rename(ctx, dbID, req.Key, name, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("renamed bucket %s to %s", buck.Key, buck.Name)
+	return &pb.RenameBucketReply{
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+	}, nil
+}
+
+// CloneBucket creates a new bucket with the root of an existing bucket.
+// The data is shared with the source bucket, so cloning doesn't depend on the bucket size.
+func (s *Service) CloneBucket(ctx context.Context, req *pb.CloneBucketRequest) (*pb.CloneBucketReply, error) {
+	log.Debugf("received clone bucket request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if buck.GetEncKey() != nil {
+		return nil, status.Error(codes.FailedPrecondition, "Private buckets cannot be cloned")
+	}
+	root, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		name = buck.Name
+	}
+	if req.Thread != "" {
+		id, err := thread.Decode(req.Thread)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid thread: %v", err)
+		}
+		if !id.Equals(dbID) {
+			ctx, err = s.cloneContext(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	rep, err := s.Init(ctx, &pb.InitRequest{Name: name, BootstrapCid: root.Cid().String()})
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debugf("cloned bucket %s to %s", buck.Key, rep.Root.Key)
+	return &pb.CloneBucketReply{
+		Root:  rep.Root,
+		Links: rep.Links,
+	}, nil
+}
+
+// cloneContext returns a context for creating a bucket in the thread with id.
+// The thread must be owned by the account or user in the context,
+// or by an org the developer in the context is a member of.
+func (s *Service) cloneContext(ctx context.Context, id thread.ID) (context.Context, error) {
+	ctx = common.NewThreadIDContext(ctx, id)
+	if s.Collections.Threads == nil {
+		return ctx, nil
+	}
+	owner, err := s.Collections.Threads.GetOwner(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Thread not found")
+		}
+		return nil, err
+	}
+	if account := accountFromContext(ctx); account != nil && account.Key.Equals(owner) {
+		return ctx, nil
+	} else if user := userFromContext(ctx); user != nil && user.Key.Equals(owner) {
+		return ctx, nil
+	}
+	dev, ok := mdb.DevFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "User does not own thread")
+	}
+	org, err := s.Collections.Accounts.Get(ctx, owner)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	if org == nil || org.Type != mdb.Org {
+		return nil, status.Error(codes.PermissionDenied, "User does not own thread")
+	}
+	isMember, err := s.Collections.Accounts.IsMember(ctx, org.Username, dev.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, status.Error(codes.PermissionDenied, "User is not an org member")
+	}
+	ctx = mdb.NewOrgContext(ctx, org)
+	return thread.NewTokenContext(ctx, org.Token), nil
+}
+
+// SetPathMetadata sets the content type and attributes of an existing bucket path.
+func (s *Service) SetPathMetadata(ctx context.Context, req *pb.SetPathMetadataRequest) (*pb.SetPathMetadataReply, error) {
+	log.Debugf("received set path metadata request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	filePath, err := parsePath(req.Path)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if filePath == "" {
+		return nil, status.Error(codes.InvalidArgument, "Path is required")
+	}
+	if req.ContentType != "" {
+		if _, _, err := mime.ParseMediaType(req.ContentType); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid content type: %v", err)
+		}
+	}
+	buck, pth, err := s.getBucketPath(ctx, dbID, req.Key, filePath, dbToken)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.pathToItem(ctx, pth, false, buck.GetEncKey()); err != nil {
+		return nil, status.Error(codes.NotFound, "Path not found")
+	}
+	md := setPathMetadata(buck, filePath, req.ContentType, req.Attributes)
+	buck.UpdatedAt = time.Now().UnixNano()
+	if err = s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	s.markIndexPending(ctx, dbID, dbToken, buck.Key)
+	s.markThumbnailsPending(ctx, dbID, dbToken, buck.Key)
+	return &pb.SetPathMetadataReply{
+		Metadata: metadataToPb(md),
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+	}, nil
+}
+
+func (s *Service) SetTags(ctx context.Context, req *pb.SetTagsRequest) (*pb.SetTagsReply, error) {
+	log.Debugf("received set tags request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	if err := mdb.ValidateTags(req.Tags); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	var owner crypto.PubKey
+	if account := accountFromContext(ctx); account != nil {
+		owner = account.Key
+	} else if user := userFromContext(ctx); user != nil {
+		owner = user.Key
+	}
+	if err := s.Collections.Tags.Set(ctx, mdb.BucketResource, buck.Key, owner, req.Tags); err != nil {
+		return nil, err
+	}
+	threadTags, err := s.Collections.Tags.GetMap(ctx, mdb.ThreadResource, dbID.String())
+	if err != nil {
+		return nil, err
+	}
+	tags, err := s.getTags(ctx, threadTags, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetTagsReply{
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+			Tags:      tags,
+		},
+	}, nil
+}
+
+func (s *Service) SetLegalHold(ctx context.Context, req *pb.SetLegalHoldRequest) (*pb.SetLegalHoldReply, error) {
+	log.Debugf("received set legal hold request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	org, ok := mdb.OrgFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "Legal holds are only available to orgs")
+	}
+	dev, ok := mdb.DevFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "User must be an org owner")
+	}
+	isOwner, err := s.Collections.Accounts.IsOwner(ctx, org.Username, dev.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !isOwner {
+		return nil, status.Error(codes.PermissionDenied, "User must be an org owner")
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "Reason required")
+	}
+
+	buck := &tdb.Bucket{}
+	err = s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.SetLegalHoldReply{}
+	action := "legal_hold.release"
+	if req.Hold {
+		hold, err := s.Collections.LegalHolds.Create(ctx, buck.Key, dev.Username, req.Reason)
+		if err != nil {
+			return nil, err
+		}
+		reply.Hold = legalHoldToPb(hold)
+		action = "legal_hold.set"
+	} else {
+		if err := s.Collections.LegalHolds.Delete(ctx, buck.Key); err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, status.Error(codes.NotFound, "Legal hold not found")
+			}
+			return nil, err
+		}
+	}
+	if _, err := s.Collections.AuditLogs.Create(ctx, org.Username, dev.Username, action, "bucket/"+buck.Key, req.Reason); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func (s *Service) GetLegalHold(ctx context.Context, req *pb.GetLegalHoldRequest) (*pb.GetLegalHoldReply, error) {
+	log.Debugf("received get legal hold request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	hold, err := s.Collections.LegalHolds.Get(ctx, buck.Key)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &pb.GetLegalHoldReply{}, nil
+		}
+		return nil, err
+	}
+	return &pb.GetLegalHoldReply{Hold: legalHoldToPb(hold)}, nil
+}
+
+func legalHoldToPb(hold *mdb.LegalHold) *pb.LegalHold {
+	return &pb.LegalHold{
+		Actor:     hold.Actor,
+		Reason:    hold.Reason,
+		CreatedAt: hold.CreatedAt.UnixNano(),
+	}
+}
+
+func (s *Service) SetLicense(ctx context.Context, req *pb.SetLicenseRequest) (*pb.SetLicenseReply, error) {
+	log.Debugf("received set license request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	if strings.TrimSpace(req.License) == "" {
+		return nil, status.Error(codes.InvalidArgument, "License is required")
+	}
+	pth, err := parsePath(req.Path)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	buck := &tdb.Bucket{}
+	err = s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	license, err := s.Collections.BucketLicenses.Set(ctx, mdb.BucketLicense{
+		BucketKey:   buck.Key,
+		Path:        pth,
+		License:     strings.TrimSpace(req.License),
+		URL:         strings.TrimSpace(req.URL),
+		Attribution: strings.TrimSpace(req.Attribution),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetLicenseReply{License: licenseToPb(license)}, nil
+}
+
+// GetLicense returns the license that applies to a bucket path,
+// which may be inherited from a parent path or the bucket itself.
+func (s *Service) GetLicense(ctx context.Context, req *pb.GetLicenseRequest) (*pb.GetLicenseReply, error) {
+	log.Debugf("received get license request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	license, err := s.Collections.BucketLicenses.Resolve(ctx, buck.Key, req.Path)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &pb.GetLicenseReply{}, nil
+		}
+		return nil, err
+	}
+	return &pb.GetLicenseReply{License: licenseToPb(license)}, nil
+}
+
+func (s *Service) ListLicenses(ctx context.Context, req *pb.ListLicensesRequest) (*pb.ListLicensesReply, error) {
+	log.Debugf("received list licenses request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	list, err := s.Collections.BucketLicenses.List(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	licenses := make([]*pb.License, len(list))
+	for i, l := range list {
+		licenses[i] = licenseToPb(&l)
+	}
+	return &pb.ListLicensesReply{Licenses: licenses}, nil
+}
+
+func (s *Service) RemoveLicense(ctx context.Context, req *pb.RemoveLicenseRequest) (*pb.RemoveLicenseReply, error) {
+	log.Debugf("received remove license request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Collections.BucketLicenses.Delete(ctx, buck.Key, req.Path); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "License not found")
+		}
+		return nil, err
+	}
+	return &pb.RemoveLicenseReply{}, nil
+}
+
+func licenseToPb(license *mdb.BucketLicense) *pb.License {
+	return &pb.License{
+		Path:        license.Path,
+		License:     license.License,
+		URL:         license.URL,
+		Attribution: license.Attribution,
+		UpdatedAt:   license.UpdatedAt.UnixNano(),
+	}
+}
+
+// checkLegalHold returns an error if the bucket is under legal hold.
+func (s *Service) checkLegalHold(ctx context.Context, key string) error {
+	held, err := s.Collections.LegalHolds.IsHeld(ctx, key)
+	if err != nil {
+		return err
+	}
+	if held {
+		return status.Error(codes.FailedPrecondition, buckets.ErrLegalHold.Error())
+	}
+	return nil
+}
+
+// checkLegalHoldAtPath returns an error if the bucket is under legal hold
+// and there is existing data at path.
+func (s *Service) checkLegalHoldAtPath(ctx context.Context, buck *tdb.Bucket, pth string) error {
+	held, err := s.Collections.LegalHolds.IsHeld(ctx, buck.Key)
+	if err != nil {
+		return err
+	}
+	if !held {
+		return nil
+	}
+	base, err := s.IPFSClient.ResolvePath(ctx, path.New(buck.Path))
+	if err != nil {
+		return err
+	}
+	_, remainder, err := s.getNodesToPath(ctx, base, pth, buck.GetEncKey())
+	if err != nil {
+		return err
+	}
+	if remainder == "" {
+		return status.Error(codes.FailedPrecondition, buckets.ErrLegalHold.Error())
+	}
+	return nil
+}
+
+// loadRedirects parses the redirects file at the top level of root.
+// No rules are returned if root does not contain a redirects file.
+func (s *Service) loadRedirects(ctx context.Context, root path.Path) ([]buckets.Redirect, error) {
+	rn, err := s.IPFSClient.ResolveNode(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	l := getLink(rn.Links(), buckets.RedirectsName)
+	if l == nil {
+		return nil, nil
+	}
+	n, err := s.IPFSClient.Unixfs().Get(ctx, path.IpfsPath(l.Cid))
+	if err != nil {
+		return nil, err
+	}
+	defer n.Close()
+	f, ok := n.(ipfsfiles.File)
+	if !ok {
+		return nil, fmt.Errorf("%s must be a file", buckets.RedirectsName)
+	}
+	return buckets.ParseRedirects(f)
+}
+
+// compileRedirects saves the redirects file at the bucket root to the bucket's web config.
+// Rules are cleared if the file does not exist or is not valid.
+// Private buckets are not served by the gateway and are skipped.
+func (s *Service) compileRedirects(ctx context.Context, buck *tdb.Bucket) {
+	if buck.GetEncKey() != nil {
+		return
+	}
+	rules, err := s.loadRedirects(ctx, path.New(buck.Path))
+	if err != nil {
+		log.Errorf("loading redirects for bucket %s: %v", buck.Key, err)
+	}
+	if err := s.Collections.WebConfigs.SetRedirects(ctx, buck.Key, rules); err != nil {
+		log.Errorf("saving redirects for bucket %s: %v", buck.Key, err)
+	}
+}
+
+// getPushPolicy returns the push policy of the org in the context.
+// A nil policy is returned if there is no org or the org has not set a policy.
+func (s *Service) getPushPolicy(ctx context.Context) (*mdb.PushPolicy, error) {
+	if s.Collections.PushPolicies == nil {
+		return nil, nil
+	}
+	org, ok := mdb.OrgFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	policy, err := s.Collections.PushPolicies.Get(ctx, org.Username)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	}
+	return policy, err
+}
+
+// orgStorageConfig returns the hub default storage config with the archive config of the org in the context applied.
+// A nil config is returned if archiving is disabled, there is no org, or the org has not set an archive config.
+func (s *Service) orgStorageConfig(ctx context.Context) (*ffs.StorageConfig, error) {
+	if s.Collections.ArchiveConfigs == nil || !s.Buckets.IsArchivingEnabled() {
+		return nil, nil
+	}
+	org, ok := mdb.OrgFromContext(ctx)
+	if !ok {
+		return nil, nil
+	}
+	conf, err := s.Collections.ArchiveConfigs.Get(ctx, org.Username)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	sc := s.Buckets.DefaultStorageConfig()
+	sc.Hot.Enabled = conf.HotEnabled
+	sc.Cold.Enabled = conf.ColdEnabled
+	if conf.RepFactor > 0 {
+		sc.Cold.Filecoin.RepFactor = conf.RepFactor
+	}
+	if conf.DealMinDuration > 0 {
+		sc.Cold.Filecoin.DealMinDuration = conf.DealMinDuration
+	}
+	if len(conf.TrustedMiners) > 0 {
+		sc.Cold.Filecoin.TrustedMiners = conf.TrustedMiners
+	}
+	if len(conf.ExcludedMiners) > 0 {
+		sc.Cold.Filecoin.ExcludedMiners = conf.ExcludedMiners
+	}
+	return &sc, nil
+}
+
+// checkForbiddenExtension returns a violation if pth has an extension forbidden by policy.
+func checkForbiddenExtension(policy *mdb.PushPolicy, pth string) []buckets.PolicyViolation {
+	if policy == nil {
+		return nil
+	}
+	ext := strings.ToLower(gopath.Ext(pth))
+	if ext == "" {
+		return nil
+	}
+	for _, e := range policy.ForbiddenExtensions {
+		if e == ext {
+			return []buckets.PolicyViolation{{
+				Rule:   buckets.RuleForbiddenExtension,
+				Path:   pth,
+				Detail: fmt.Sprintf("files with extension %s are not allowed", ext),
+			}}
+		}
+	}
+	return nil
+}
+
+// checkRequiredPathsRemoved returns violations for each path required by policy
+// that would be removed along with pth.
+func checkRequiredPathsRemoved(policy *mdb.PushPolicy, pth string) (violations []buckets.PolicyViolation) {
+	if policy == nil {
+		return nil
+	}
+	for _, r := range policy.RequiredPaths {
+		if r == pth || strings.HasPrefix(r, pth+"/") {
+			violations = append(violations, buckets.PolicyViolation{
+				Rule:   buckets.RuleRequiredPath,
+				Path:   r,
+				Detail: "required path cannot be removed",
+			})
+		}
+	}
+	return violations
+}
+
+// checkRequiredPaths returns violations for each path required by policy that
+// does not exist under root.
+// Key will be required if root is encrypted.
+func (s *Service) checkRequiredPaths(ctx context.Context, policy *mdb.PushPolicy, root path.Path, key []byte) (violations []buckets.PolicyViolation) {
+	if policy == nil || len(policy.RequiredPaths) == 0 {
+		return nil
+	}
+	base, err := s.IPFSClient.ResolvePath(ctx, root)
+	if err != nil {
+		for _, r := range policy.RequiredPaths {
+			violations = append(violations, buckets.PolicyViolation{
+				Rule:   buckets.RuleRequiredPath,
+				Path:   r,
+				Detail: "required path is missing",
+			})
+		}
+		return violations
+	}
+	for _, r := range policy.RequiredPaths {
+		if _, remainder, err := s.getNodesToPath(ctx, base, r, key); err != nil || remainder != "" {
+			violations = append(violations, buckets.PolicyViolation{
+				Rule:   buckets.RuleRequiredPath,
+				Path:   r,
+				Detail: "required path is missing",
+			})
+		}
+	}
+	return violations
+}
+
+// checkLicense returns a violation if policy requires a license and none applies to pth.
+func (s *Service) checkLicense(ctx context.Context, policy *mdb.PushPolicy, key, pth string) ([]buckets.PolicyViolation, error) {
+	if policy == nil || !policy.RequireLicense {
+		return nil, nil
+	}
+	if _, err := s.Collections.BucketLicenses.Resolve(ctx, key, pth); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return []buckets.PolicyViolation{{
+				Rule:   buckets.RuleLicenseRequired,
+				Path:   strings.Trim(pth, "/"),
+				Detail: "no license applies to path",
+			}}, nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// pushRejected returns a status error listing violations in its details.
+func pushRejected(violations []buckets.PolicyViolation) error {
+	err := &buckets.PushRejectedError{Violations: violations}
+	st := status.New(codes.FailedPrecondition, err.Error())
+	rejection := &pb.PushRejection{
+		Violations: make([]*pb.PushRejection_Violation, len(violations)),
+	}
+	for i, v := range violations {
+		rejection.Violations[i] = &pb.PushRejection_Violation{
+			Rule:   v.Rule,
+			Path:   v.Path,
+			Detail: v.Detail,
+		}
+	}
+	if dst, derr := st.WithDetails(rejection); derr == nil {
+		return dst.Err()
+	}
+	return st.Err()
+}
+
+func (s *Service) Links(ctx context.Context, req *pb.LinksRequest) (*pb.LinksReply, error) {
+	log.Debugf("received lists request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	ipnsName, err := s.IPNSManager.Name(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	return s.createLinks(dbID, buck, ipnsName), nil
+}
+
+// createLinks returns the gateway links of buck.
+// The IPNS link points at ipnsName, which differs from the bucket key if the bucket has an imported IPNS key.
+func (s *Service) createLinks(dbID thread.ID, buck *tdb.Bucket, ipnsName string) *pb.LinksReply {
+	var threadLink, wwwLink, ipnsLink string
+	threadLink = fmt.Sprintf("%s/thread/%s/%s/%s", s.GatewayURL, dbID, buckets.CollectionName, buck.Key)
+	if s.DNSManager != nil && s.DNSManager.Domain != "" {
+		parts := strings.Split(s.GatewayURL, "://")
+		if len(parts) < 2 {
+			return nil
+		}
+		scheme := parts[0]
+		wwwLink = fmt.Sprintf("%s://%s.%s", scheme, buck.Key, s.DNSManager.Domain)
+	}
+	ipnsLink = fmt.Sprintf("%s/ipns/%s", s.GatewayURL, ipnsName)
+	return &pb.LinksReply{
+		URL:  threadLink,
+		WWW:  wwwLink,
+		IPNS: ipnsLink,
+	}
+}
+
+// ImportBucketIPNSKey replaces the hub-managed IPNS key of a bucket with the private key in the request.
+// If no key is given, a new key is generated and returned so that it can be imported on another hub.
+// The bucket key does not change, but the bucket root is published under the IPNS name of the new key.
+func (s *Service) ImportBucketIPNSKey(ctx context.Context, req *pb.ImportBucketIPNSKeyRequest) (*pb.ImportBucketIPNSKeyReply, error) {
+	log.Debugf("received import bucket ipns key request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	reply := &pb.ImportBucketIPNSKeyReply{}
+	var key crypto.PrivKey
+	var err error
+	if len(req.PrivateKey) == 0 {
+		key, _, err = crypto.GenerateEd25519Key(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		reply.PrivateKey, err = crypto.MarshalPrivateKey(key)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		key, err = crypto.UnmarshalPrivateKey(req.PrivateKey)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid private key: %v", err)
+		}
+	}
+	reply.Name, err = s.IPNSManager.ImportKey(ctx, buck.Key, key)
+	if errors.Is(err, ipns.ErrKeyImportDisabled) {
+		return nil, status.Error(codes.Unimplemented, "IPNS key import is disabled")
+	} else if errors.Is(err, ipns.ErrKeyInUse) {
+		return nil, status.Error(codes.AlreadyExists, "IPNS key is already used by a bucket")
+	} else if err != nil {
+		return nil, err
+	}
+
+	go s.IPNSManager.Publish(path.New(buck.Path), buck.Key)
+	return reply, nil
+}
+
+func (s *Service) SetPath(ctx context.Context, req *pb.SetPathRequest) (*pb.SetPathReply, error) {
+	log.Debugf("received set path request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, fmt.Errorf("get bucket: %s", err)
+	}
+	if err = s.checkLegalHoldAtPath(ctx, buck, strings.Trim(req.Path, "/")); err != nil {
+		return nil, err
+	}
+	buckPath := path.New(buck.Path)
+
+	remoteCid, err := cid.Decode(req.Cid)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote cid: %s", err)
+	}
+	remotePath := path.IpfsPath(remoteCid)
+
+	policy, err := s.getPushPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var violations []buckets.PolicyViolation
+	if req.Path == "" {
+		violations = s.checkRequiredPaths(ctx, policy, remotePath, nil)
+	} else {
+		violations = checkForbiddenExtension(policy, strings.Trim(req.Path, "/"))
+	}
+	lv, err := s.checkLicense(ctx, policy, buck.Key, req.Path)
+	if err != nil {
+		return nil, err
+	}
+	if violations = append(violations, lv...); len(violations) > 0 {
+		return nil, pushRejected(violations)
+	}
+	if err = s.checkSetPathSize(ctx, buck, strings.Trim(req.Path, "/"), remotePath); err != nil {
+		return nil, err
+	}
+
+	encKey := buck.GetEncKey()
+	var dirpth path.Resolved
+	if req.Path == "" {
+		sn, err := makeSeed(encKey)
+		if err != nil {
+			return nil, fmt.Errorf("generating new seed: %s", err)
+		}
+		dirpth, err = s.createBootstrappedPath(ctx, sn, remoteCid, encKey)
+		if err != nil {
+			return nil, fmt.Errorf("generating bucket new root: %s", err)
+		}
+		if encKey != nil {
+			buckPathResolved, err := s.IPFSClient.ResolvePath(ctx, buckPath)
+			if err != nil {
+				return nil, fmt.Errorf("resolving path: %s", err)
+			}
+			if err = s.unpinNodeAndBranch(ctx, buckPathResolved, encKey); err != nil {
+				return nil, fmt.Errorf("unpinning pinned root: %s", err)
+			}
+		} else {
+			if err = s.unpinPath(ctx, buckPath); err != nil {
+				return nil, fmt.Errorf("updating pinned root: %s", err)
+			}
+		}
+	} else {
+		if encKey != nil {
+			n, nodes, err := s.newDirFromExistingPath(ctx, remotePath, encKey, nil, "")
+			if err != nil {
+				return nil, fmt.Errorf("resolving remote path: %s", err)
+			}
+			dirpth, err = s.insertNodeAtPath(ctx, n, path.Join(buckPath, req.Path), encKey)
+			if err != nil {
+				return nil, fmt.Errorf("updating pinned root: %s", err)
+			}
+			if err := s.addAndPinNodes(ctx, nodes); err != nil {
+				return nil, err
+			}
+		} else {
+			dirpth, err = s.IPFSClient.Object().AddLink(ctx, buckPath, req.Path, remotePath, options.Object.Create(true))
+			if err != nil {
+				return nil, fmt.Errorf("adding folder: %s", err)
+			}
+			if err = s.updateOrAddPin(ctx, buckPath, dirpth); err != nil {
+				return nil, fmt.Errorf("updating pinned root: %s", err)
+			}
+		}
+	}
+
+	buck.UnsetMetadataWithPrefix(strings.Trim(req.Path, "/"))
+	buck.Path = dirpth.String()
+	buck.UpdatedAt = time.Now().UnixNano()
+	if err = s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, fmt.Errorf("saving new bucket state: %s", err)
+	}
+	s.trackRootPin(ctx, dbID, dbToken, buck, buckPath.String())
+	if p := strings.Trim(req.Path, "/"); p == "" || p == buckets.RedirectsName {
+		s.compileRedirects(ctx, buck)
+	}
+	s.recordVersion(ctx, buck, req.Message)
+	s.markReplicationPending(ctx, buck.Key)
+	s.markPinMirrorsPending(ctx, buck.Key)
+	s.markDomainsPending(ctx, buck.Key)
+	s.markIndexPending(ctx, dbID, dbToken, buck.Key)
+	s.markThumbnailsPending(ctx, dbID, dbToken, buck.Key)
+	s.countArchiveChange(ctx, buck.Key)
+	s.publishEvent(ctx, webhooks.Event{
+		Type:      webhooks.PathPushed,
+		BucketKey: buck.Key,
+		Thread:    dbID.String(),
+		Path:      strings.Trim(req.Path, "/"),
+		Cid:       req.Cid,
+		Root:      buck.Path,
+		Message:   req.Message,
+	})
+	s.publishRootChanged(ctx, dbID, buck, req.Message)
+	return &pb.SetPathReply{}, nil
+}
+
+func (s *Service) addAndPinNodes(ctx context.Context, nodes []ipld.Node) error {
+	if err := s.IPFSClient.Dag().AddMany(ctx, nodes); err != nil {
+		return err
+	}
+	return s.pinBlocks(ctx, nodes)
+}
+
+func (s *Service) ListPath(ctx context.Context, req *pb.ListPathRequest) (*pb.ListPathReply, error) {
+	log.Debugf("received list path request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Limit must not be negative")
+	}
+	if req.MaxDepth < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Max depth must not be negative")
+	}
+	if req.Glob != "" {
+		if err := buckets.ValidGlob(req.Glob); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid glob: %v", err)
+		}
+	}
+	opts := listOptions{
+		cursor: req.Cursor,
+		limit:  int(req.Limit),
+		depth:  1,
+		glob:   req.Glob,
+	}
+	if req.Recursive {
+		opts.depth = int(req.MaxDepth)
+		if opts.depth == 0 {
+			opts.depth = -1
+		}
+	}
+	buck, pth, err := s.getBucketPath(ctx, dbID, req.Key, req.Path, dbToken)
+	if err != nil {
+		return nil, err
+	}
+	if req.Root != "" {
+		if pth, err = atRoot(buck, req.Root, req.Path); err != nil {
+			return nil, err
+		}
+	}
+	rep, err := s.pathToPb(ctx, dbID, buck, pth, opts)
+	if err != nil {
+		return nil, err
+	}
+	if pth.String() == buck.Path {
+		rep.Item.Name = buck.Name
+	}
+	return rep, nil
+}
+
+func (s *Service) ListIpfsPath(ctx context.Context, req *pb.ListIpfsPathRequest) (*pb.ListIpfsPathReply, error) {
+	log.Debugf("received list ipfs path request")
+
+	pth := path.New(req.Path)
+	item, err := s.pathToItem(ctx, pth, true, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListIpfsPathReply{Item: item}, nil
+}
+
+// listOptions selects the directory links included in a listing.
+type listOptions struct {
+	// cursor and limit select a page of top-level links.
+	// Links are listed in name order, starting after cursor. A zero limit selects all links.
+	cursor string
+	limit  int
+	// depth is the number of levels of links to include. A negative depth includes all levels.
+	depth int
+	// glob filters items by their path relative to the listed path.
+	// Directories containing matching items are always included.
+	glob string
+}
+
+// pathToItem returns items at path, optionally including one level down of links.
+// If key is not nil, the items will be decrypted.
+func (s *Service) pathToItem(ctx context.Context, pth path.Path, includeNextLevel bool, key []byte) (*pb.ListPathItem, error) {
+	var opts listOptions
+	if includeNextLevel {
+		opts.depth = 1
+	}
+	item, _, err := s.listPathItem(ctx, pth, key, opts)
+	return item, err
+}
+
+// listPathItem returns items at path, including links selected by opts.
+// The returned cursor selects the next page, and is empty if there are no more links.
+func (s *Service) listPathItem(ctx context.Context, pth path.Path, key []byte, opts listOptions) (*pb.ListPathItem, string, error) {
+	var n ipld.Node
+	if key != nil {
+		rp, fp, err := util.ParsePath(pth)
+		if err != nil {
+			return nil, "", err
+		}
+		np, r, err := s.getNodesToPath(ctx, rp, fp, key)
+		if err != nil {
+			return nil, "", err
+		}
+		if r != "" {
+			return nil, "", fmt.Errorf("could not resolve path: %s", pth)
+		}
+		n = np[len(np)-1].new
+	} else {
+		rp, err := s.IPFSClient.ResolvePath(ctx, pth)
+		if err != nil {
+			return nil, "", err
+		}
+		n, err = s.IPFSClient.Dag().Get(ctx, rp.Cid())
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	item, next, _, err := s.nodeToItem(ctx, n, pth.String(), "", key, false, opts)
+	return item, next, err
+}
+
+// getNodeAtPath returns the decrypted node at path.
+func (s *Service) getNodeAtPath(ctx context.Context, pth path.Resolved, key []byte) (ipld.Node, error) {
+	cn, err := s.IPFSClient.ResolveNode(ctx, pth)
+	if err != nil {
+		return nil, err
+	}
+	return decryptNode(cn, key)
+}
+
+// decryptNode returns a decrypted version of node.
+func decryptNode(cn ipld.Node, key []byte) (ipld.Node, error) {
+	switch cn := cn.(type) {
+	case *dag.RawNode:
+		return cn, nil // All raw nodes will be leaves
+	case *dag.ProtoNode:
+		if key == nil {
+			return cn, nil
+		}
+		fn, err := unixfs.FSNodeFromBytes(cn.Data())
+		if err != nil {
+			return nil, err
+		}
+		if fn.Data() == nil {
+			return cn, nil // This node is a raw file wrapper
+		}
+		plain, err := decryptData(fn.Data(), key)
+		if err != nil {
+			return nil, err
+		}
+		n, err := dag.DecodeProtobuf(plain)
+		if err != nil {
+			return dag.NewRawNode(plain), nil
+		}
+		n.SetCidBuilder(dag.V1CidPrefix())
+		return n, nil
+	default:
+		return nil, errInvalidNodeType
+	}
+}
+
+func decryptData(data, key []byte) ([]byte, error) {
+	r, err := dcrypto.NewDecrypter(bytes.NewReader(data), key)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+// nodeToItem returns the item for node at pth, including links selected by opts.
+// rel is the path of node relative to the listed path. Only included links are fetched.
+// The returned bool reports whether the item matches opts.glob or includes an item that does.
+func (s *Service) nodeToItem(ctx context.Context, node ipld.Node, pth, rel string, key []byte, decrypt bool, opts listOptions) (*pb.ListPathItem, string, bool, error) {
+	if decrypt && key != nil {
+		var err error
+		node, err = decryptNode(node, key)
+		if err != nil {
+			return nil, "", false, err
+		}
+	}
+	stat, err := node.Stat()
+	if err != nil {
+		return nil, "", false, err
+	}
+	item := &pb.ListPathItem{
+		Cid:  node.Cid().String(),
+		Name: gopath.Base(pth),
+		Path: pth,
+		Size: int64(stat.CumulativeSize),
+	}
+	matched := opts.glob == "" || (rel != "" && buckets.MatchGlob(opts.glob, rel))
+	child := listOptions{depth: opts.depth - 1, glob: opts.glob}
+	if opts.depth < 0 {
+		child.depth = opts.depth
+	}
+	var last, next string
+	for _, l := range node.Links() {
+		if l.Name == "" {
+			break
+		}
+		item.IsDir = true
+		if opts.cursor != "" && l.Name <= opts.cursor {
+			continue
+		}
+		if opts.limit > 0 && len(item.Items) == opts.limit {
+			next = last
+			break
+		}
+		last = l.Name
+		if opts.depth == 0 {
+			item.Items = append(item.Items, &pb.ListPathItem{})
+			continue
+		}
+		n, err := l.GetNode(ctx, s.IPFSClient.Dag())
+		if err != nil {
+			return nil, "", false, err
+		}
+		i, _, ok, err := s.nodeToItem(ctx, n, gopath.Join(pth, l.Name), gopath.Join(rel, l.Name), key, true, child)
+		if err != nil {
+			return nil, "", false, err
+		}
+		if !ok {
+			continue
+		}
+		matched = true
+		item.Items = append(item.Items, i)
+	}
+	return item, next, matched, nil
+}
+
+func parsePath(pth string) (fpth string, err error) {
+	if strings.Contains(pth, buckets.SeedName) {
+		err = fmt.Errorf("paths containing %s are not allowed", buckets.SeedName)
+		return
+	}
+	fpth = strings.TrimPrefix(pth, "/")
+	return
+}
+
+func (s *Service) getBucketPath(ctx context.Context, dbID thread.ID, key, pth string, token thread.Token) (*tdb.Bucket, path.Path, error) {
+	filePath := strings.TrimPrefix(pth, "/")
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, key, buck, tdb.WithToken(token))
+	if err != nil {
+		return nil, nil, err
+	}
+	npth, err := inflateFilePath(buck, filePath)
+	return buck, npth, err
+}
+
+// atRoot points buck at root, a previous root path of the bucket, and returns pth inflated against it.
+// The root of a private bucket is decrypted with the bucket key.
+func atRoot(buck *tdb.Bucket, root, pth string) (path.Path, error) {
+	rp, err := util.NewResolvedPath(root)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid root: %v", err)
+	}
+	buck.Path = rp.String()
+	return inflateFilePath(buck, strings.TrimPrefix(pth, "/"))
+}
+
+func inflateFilePath(buck *tdb.Bucket, filePath string) (path.Path, error) {
+	npth := path.New(gopath.Join(buck.Path, filePath))
+	if err := npth.IsValid(); err != nil {
+		return nil, err
+	}
+	return npth, nil
+}
+
+func (s *Service) pathToPb(ctx context.Context, id thread.ID, buck *tdb.Bucket, pth path.Path, opts listOptions) (*pb.ListPathReply, error) {
+	item, next, err := s.listPathItem(ctx, pth, buck.GetEncKey(), opts)
+	if err != nil {
+		return nil, err
+	}
+	setItemMetadata(buck, item)
+	return &pb.ListPathReply{
+		Item: item,
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    id.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+		NextCursor: next,
+	}, nil
+}
+
+// setItemMetadata adds bucket metadata to item and its listed items.
+func setItemMetadata(buck *tdb.Bucket, item *pb.ListPathItem) {
+	if len(buck.Metadata) == 0 {
+		return
+	}
+	if item.Path != "" {
+		rel := strings.TrimPrefix(strings.TrimPrefix(item.Path, buck.Path), "/")
+		if md, ok := buck.Metadata[rel]; ok {
+			item.Metadata = metadataToPb(md)
+		}
+	}
+	for _, i := range item.Items {
+		setItemMetadata(buck, i)
+	}
+}
+
+// setPathEncoding records the content encoding of the item at filePath after its content was replaced.
+// Uncompressed items have no encoding.
+func setPathEncoding(buck *tdb.Bucket, filePath, encoding string) {
+	md := buck.Metadata[filePath]
+	if md.ContentEncoding == encoding {
+		return
+	}
+	md.ContentEncoding = encoding
+	buck.SetMetadataAtPath(filePath, md)
+}
+
+// gzipReader returns a reader of the gzip compressed content of r.
+// The reader must be closed to release the compressing goroutine.
+func gzipReader(r io.Reader) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		zw := gzip.NewWriter(pw)
+		if _, err := io.Copy(zw, r); err != nil {
+			_ = pw.CloseWithError(err)
+			return
+		}
+		_ = pw.CloseWithError(zw.Close())
+	}()
+	return pr
+}
+
+func metadataToPb(md tdb.Metadata) *pb.Metadata {
+	return &pb.Metadata{
+		ContentType:     md.ContentType,
+		ContentEncoding: md.ContentEncoding,
+		Attributes:      md.Attributes,
+		UpdatedAt:       md.UpdatedAt,
+	}
+}
+
+// sniffLen is the max number of leading file bytes used to detect content types.
+const sniffLen = 512
+
+// sniffHead appends data to head until it holds sniffLen bytes.
+func sniffHead(head, data []byte) []byte {
+	if n := sniffLen - len(head); n > 0 {
+		if len(data) > n {
+			data = data[:n]
+		}
+		head = append(head, data...)
+	}
+	return head
+}
+
+// detectContentType returns the content type of the file at filePath.
+// The file extension takes precedence over the leading file bytes in head.
+func detectContentType(filePath string, head []byte) string {
+	if ct := mime.TypeByExtension(gopath.Ext(filePath)); ct != "" {
+		return ct
+	}
+	if len(head) == 0 {
+		return ""
+	}
+	return http.DetectContentType(head)
+}
+
+// setPathMetadata updates the metadata of the item at filePath.
+// The content type is only replaced if not empty. Attributes are merged into
+// existing attributes, and attributes with an empty value are removed.
+func setPathMetadata(buck *tdb.Bucket, filePath, contentType string, attrs map[string]string) tdb.Metadata {
+	md := buck.Metadata[filePath]
+	if contentType != "" {
+		md.ContentType = contentType
+	}
+	if len(attrs) > 0 {
+		merged := make(map[string]string, len(md.Attributes)+len(attrs))
+		for k, v := range md.Attributes {
+			merged[k] = v
+		}
+		for k, v := range attrs {
+			if v == "" {
+				delete(merged, k)
+			} else {
+				merged[k] = v
+			}
+		}
+		md.Attributes = merged
+	}
+	buck.SetMetadataAtPath(filePath, md)
+	return buck.Metadata[filePath]
+}
+
+func (s *Service) PushPath(server pb.API_PushPathServer) error {
+	log.Debugf("received push path request")
+
+	dbID, ok := common.ThreadIDFromContext(server.Context())
+	if !ok {
+		return fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(server.Context())
+
+	req, err := server.Recv()
+	if err != nil {
+		return err
+	}
+	var key, headerPath, root, message, contentType string
+	var attrs map[string]string
+	var compress, deterministic bool
+	switch payload := req.Payload.(type) {
+	case *pb.PushPathRequest_Header_:
+		key = payload.Header.Key
+		headerPath = payload.Header.Path
+		root = payload.Header.Root
+		message = payload.Header.Message
+		contentType = payload.Header.ContentType
+		attrs = payload.Header.Attributes
+		compress = payload.Header.Compress
+		deterministic = payload.Header.Deterministic
+	default:
+		return fmt.Errorf("push bucket path header is required")
+	}
+	if deterministic && compress {
+		return status.Error(codes.InvalidArgument, "Compression can't be used with deterministic pushes")
+	}
+	filePath, err := parsePath(headerPath)
+	if err != nil {
+		return err
+	}
+	buck := &tdb.Bucket{}
+	err = s.Buckets.Get(server.Context(), dbID, key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return err
+	}
+	if deterministic {
+		if err = checkDeterministic(buck); err != nil {
+			return err
+		}
+	}
+	if err = checkBaseRoot(buck, root); err != nil {
+		return err
+	}
+	policy, err := s.checkPushPath(server.Context(), buck, filePath)
+	if err != nil {
+		return err
+	}
+	buckRoot, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return err
+	}
+	destPath, err := s.resolveConflict(server.Context(), buck, buckRoot, root, filePath)
+	if err != nil {
+		return err
+	}
+	var conflictPath string
+	if destPath != filePath {
+		conflictPath, filePath = destPath, destPath
+	}
+
+	sendEvent := func(event *pb.PushPathReply_Event) error {
+		return server.Send(&pb.PushPathReply{
+			Payload: &pb.PushPathReply_Event_{
+				Event: event,
+			},
+		})
+	}
+
+	sendErr := func(err error) {
+		if err2 := server.Send(&pb.PushPathReply{
+			Payload: &pb.PushPathReply_Error{
+				Error: err.Error(),
+			},
+		}); err2 != nil {
+			log.Errorf("error sending error: %v (%v)", err, err2)
+		}
+	}
+
+	buckPath := path.New(buck.Path)
+	stat, err := s.IPFSClient.Object().Stat(server.Context(), buckPath)
+	if err != nil {
+		return fmt.Errorf("get stat of current bucket: %s", err)
+	}
+	currentSize := int64(stat.CumulativeSize)
+	reader, writer := io.Pipe()
+	waitCh := make(chan struct{})
+	rejectCh := make(chan error, 1)
+	var fileSize int64
+	var head []byte
+	go func() {
+		defer close(waitCh)
+		for {
+			req, err := server.Recv()
+			if err == io.EOF {
+				_ = writer.Close()
+				return
+			} else if err != nil {
+				sendErr(fmt.Errorf("error on receive: %v", err))
+				_ = writer.CloseWithError(err)
+				return
+			}
+			switch payload := req.Payload.(type) {
+			case *pb.PushPathRequest_Chunk:
+				if qerr := s.checkBucketSize(buck, currentSize, currentSize+fileSize+int64(len(payload.Chunk))); qerr != nil {
+					rejectCh <- qerr
+					_ = writer.CloseWithError(qerr)
+					return
+				}
+				head = sniffHead(head, payload.Chunk)
+				n, err := writer.Write(payload.Chunk)
+				if err != nil {
+					sendErr(fmt.Errorf("error writing chunk: %v", err))
+					return
+				}
+				atomic.AddInt64(&fileSize, int64(n))
+				if v := checkMaxFileSize(policy, filePath, fileSize); len(v) > 0 {
+					rerr := pushRejected(v)
+					rejectCh <- rerr
+					_ = writer.CloseWithError(rerr)
+					return
+				}
+			default:
+				sendErr(fmt.Errorf("invalid request"))
+				return
+			}
+		}
+	}()
+
+	var added int64
+	sendStage := func(stage pb.PushPathReply_Event_Stage) {
+		if err := sendEvent(&pb.PushPathReply_Event{
+			Name:     filePath,
+			Bytes:    atomic.LoadInt64(&added),
+			Stage:    stage,
+			Received: atomic.LoadInt64(&fileSize),
+		}); err != nil {
+			log.Errorf("error sending event: %v", err)
+		}
+	}
+
+	eventCh := make(chan interface{})
+	defer close(eventCh)
+	chSize := make(chan string)
+	go func() {
+		var last time.Time
+		for e := range eventCh {
+			event, ok := e.(*iface.AddEvent)
+			if !ok {
+				log.Error("unexpected event type")
+				continue
+			}
+			if event.Path == nil { // This is a progress event
+				atomic.StoreInt64(&added, event.Bytes)
+				if time.Since(last) < PushProgressInterval {
+					continue
+				}
+				last = time.Now()
+				sendStage(pb.PushPathReply_Event_Adding)
+			} else {
+				chSize <- event.Size // Save size for use in the final response
+			}
+		}
+	}()
+
+	var r io.Reader = reader
+	var encoding string
+	if compress {
+		// Text-like content is compressed before it's encrypted
+		br := bufio.NewReaderSize(reader, sniffLen)
+		peek, _ := br.Peek(sniffLen)
+		ct := contentType
+		if ct == "" {
+			ct = detectContentType(filePath, peek)
+		}
+		if buckets.Compressible(ct) {
+			zr := gzipReader(br)
+			defer zr.Close()
+			r, encoding = zr, buckets.EncodingGzip
+		} else {
+			r = br
+		}
+	}
+	encKey := buck.GetEncKey()
+	if encKey != nil {
+		r, err = dcrypto.NewEncrypter(r, encKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	addOpts := []options.UnixfsAddOption{
+		options.Unixfs.CidVersion(1),
+		options.Unixfs.Pin(false),
+		options.Unixfs.Progress(true),
+		options.Unixfs.Events(eventCh),
+	}
+	if deterministic {
+		addOpts = append(addOpts, deterministicAddOptions()...)
+	}
+	pth, err := s.IPFSClient.Unixfs().Add(server.Context(), ipfsfiles.NewReaderFile(r), addOpts...)
+	if err != nil {
+		select {
+		case rerr := <-rejectCh:
+			return rerr
+		default:
+			return err
+		}
+	}
+	if contentType == "" {
+		contentType = detectContentType(filePath, head)
+	}
+	setPathMetadata(buck, filePath, contentType, attrs)
+	setPathEncoding(buck, filePath, encoding)
+	sendStage(pb.PushPathReply_Event_Pinning)
+	dirpth, err := s.addFileAtPath(server.Context(), dbID, dbToken, buck, filePath, pth, message, sendStage)
+	if err != nil {
+		return err
+	}
+
+	size := <-chSize
+	quota, err := s.bucketQuota(server.Context(), buck)
+	if err != nil {
+		return err
+	}
+	var contentRoot string
+	if deterministic {
+		if contentRoot, err = s.contentRoot(server.Context(), dirpth); err != nil {
+			return err
+		}
+	}
+	if err = sendEvent(&pb.PushPathReply_Event{
+		Path: pth.String(),
+		Size: size,
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+		Quota:        quota,
+		ConflictPath: conflictPath,
+		ContentRoot:  contentRoot,
+	}); err != nil {
+		return err
+	}
+
+	go s.IPNSManager.Publish(dirpth, buck.Key)
+
+	log.Debugf("pushed %s to bucket: %s", filePath, buck.Key)
+	return nil
+}
+
+// PushPaths adds many files to a bucket in a single stream.
+// Chunks of different files may be interleaved. A file ends with an eof chunk or when the stream ends.
+// All files are committed to the bucket as a single update once the stream ends.
+func (s *Service) PushPaths(server pb.API_PushPathsServer) error {
+	log.Debugf("received push paths request")
+
+	ctx := server.Context()
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	req, err := server.Recv()
+	if err != nil {
+		return err
+	}
+	var header *pb.PushPathsRequest_Header
+	switch payload := req.Payload.(type) {
+	case *pb.PushPathsRequest_Header_:
+		header = payload.Header
+	default:
+		return fmt.Errorf("push bucket paths header is required")
+	}
+	buck := &tdb.Bucket{}
+	err = s.Buckets.Get(ctx, dbID, header.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return err
+	}
+	if err = checkBaseRoot(buck, header.Root); err != nil {
+		return err
+	}
+	if header.Deterministic {
+		if err = checkDeterministic(buck); err != nil {
+			return err
+		}
+	}
+	policy, err := s.getPushPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	stat, err := s.IPFSClient.Object().Stat(ctx, path.New(buck.Path))
+	if err != nil {
+		return fmt.Errorf("get stat of current bucket: %s", err)
+	}
+	currentSize := int64(stat.CumulativeSize)
+	encKey := buck.GetEncKey()
+
+	type pushedFile struct {
+		path   string
+		dest   string
+		size   int64
+		head   []byte
+		attrs  map[string]string
+		writer *io.PipeWriter
+		result path.Resolved
+	}
+	files := make(map[string]*pushedFile)
+	var (
+		order    []*pushedFile
+		open     int
+		pushed   int64
+		eg, gctx = errgroup.WithContext(ctx)
+	)
+	closeAll := func(err error) {
+		for _, f := range order {
+			if f.writer != nil {
+				_ = f.writer.CloseWithError(err)
+				f.writer = nil
+			}
+		}
+	}
+	fail := func(err error) error {
+		closeAll(err)
+		_ = eg.Wait()
+		return err
+	}
+	for {
+		req, err := server.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return fail(err)
+		}
+		payload, ok := req.Payload.(*pb.PushPathsRequest_Chunk_)
+		if !ok {
+			return fail(fmt.Errorf("invalid request"))
+		}
+		chunk := payload.Chunk
+		filePath, err := parsePath(chunk.Path)
+		if err != nil {
+			return fail(status.Error(codes.InvalidArgument, err.Error()))
+		}
+		if filePath == "" {
+			return fail(status.Error(codes.InvalidArgument, "Path is required"))
+		}
+		f, ok := files[filePath]
+		if !ok {
+			if open >= maxOpenPushPaths {
+				return fail(status.Errorf(codes.ResourceExhausted, "Number of open files exceeds max of %d", maxOpenPushPaths))
+			}
+			if _, err := s.checkPushPath(ctx, buck, filePath); err != nil {
+				return fail(err)
+			}
+			reader, writer := io.Pipe()
+			f = &pushedFile{path: filePath, writer: writer}
+			files[filePath] = f
+			order = append(order, f)
+			open++
+			eg.Go(func() error {
+				var r io.Reader = reader
+				if encKey != nil {
+					var err error
+					r, err = dcrypto.NewEncrypter(reader, encKey)
+					if err != nil {
+						_ = reader.CloseWithError(err)
+						return err
+					}
+				}
+				addOpts := []options.UnixfsAddOption{
+					options.Unixfs.CidVersion(1),
+					options.Unixfs.Pin(false),
+				}
+				if header.Deterministic {
+					addOpts = append(addOpts, deterministicAddOptions()...)
+				}
+				pth, err := s.IPFSClient.Unixfs().Add(gctx, ipfsfiles.NewReaderFile(r), addOpts...)
+				if err != nil {
+					_ = reader.CloseWithError(err)
+					return err
+				}
+				f.result = pth
+				return nil
+			})
+		} else if f.writer == nil {
+			return fail(status.Errorf(codes.InvalidArgument, "Path %s was already pushed", filePath))
+		}
+		if len(chunk.Attributes) > 0 {
+			f.attrs = chunk.Attributes
+		}
+		if len(chunk.Data) > 0 {
+			f.head = sniffHead(f.head, chunk.Data)
+			f.size += int64(len(chunk.Data))
+			pushed += int64(len(chunk.Data))
+			if v := checkMaxFileSize(policy, filePath, f.size); len(v) > 0 {
+				return fail(pushRejected(v))
+			}
+			if err := s.checkBucketSize(buck, currentSize, currentSize+pushed); err != nil {
+				return fail(err)
+			}
+			if _, err := f.writer.Write(chunk.Data); err != nil {
+				return fail(err)
+			}
+		}
+		if chunk.Eof {
+			_ = f.writer.Close()
+			f.writer = nil
+			open--
+		}
+	}
+	for _, f := range order {
+		if f.writer != nil {
+			_ = f.writer.Close()
+			f.writer = nil
+		}
+	}
+	if err = eg.Wait(); err != nil {
+		return err
+	}
+	if len(order) == 0 {
+		return status.Error(codes.InvalidArgument, "No files were pushed")
+	}
+
+	root, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return err
+	}
+	var (
+		redirects    bool
+		added, freed []path.Resolved
+	)
+	for _, f := range order {
+		f.dest, err = s.resolveConflict(ctx, buck, root, header.Root, f.path)
+		if err != nil {
+			return err
+		}
+		freed = append(freed, s.existingPath(ctx, buck, f.dest))
+		root, err = s.linkFileAtPath(ctx, root, f.dest, f.result, encKey)
+		if err != nil {
+			return err
+		}
+		added = append(added, f.result)
+		redirects = redirects || f.dest == buckets.RedirectsName
+		setPathMetadata(buck, f.dest, detectContentType(f.dest, f.head), f.attrs)
+		setPathEncoding(buck, f.dest, "")
+	}
+	if err = s.commitRoot(ctx, dbID, dbToken, buck, root, redirects, header.Message, nil); err != nil {
+		return err
+	}
+	if encKey == nil {
+		s.updateContentRefs(ctx, added, freed)
+	}
+	for _, f := range order {
+		s.publishPathPushed(ctx, dbID, buck, f.dest, f.result, header.Message)
+	}
+	for _, f := range order {
+		var conflictPath string
+		if f.dest != f.path {
+			conflictPath = f.dest
+		}
+		if err = server.Send(&pb.PushPathsReply{
+			Path:         f.path,
+			Cid:          f.result.Cid().String(),
+			Size:         f.size,
+			ConflictPath: conflictPath,
+		}); err != nil {
+			return err
+		}
+	}
+	var contentRoot string
+	if header.Deterministic {
+		if contentRoot, err = s.contentRoot(ctx, root); err != nil {
+			return err
+		}
+	}
+	if err = server.Send(&pb.PushPathsReply{
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+		ContentRoot: contentRoot,
+	}); err != nil {
+		return err
+	}
+
+	go s.IPNSManager.Publish(root, buck.Key)
+
+	log.Debugf("pushed %d paths to bucket: %s", len(order), buck.Key)
+	return nil
+}
+
+// StartUpload creates a resumable upload session for a bucket path.
+// Data is sent with PushUpload and added to the bucket with CompleteUpload.
+func (s *Service) StartUpload(ctx context.Context, req *pb.StartUploadRequest) (*pb.StartUploadReply, error) {
+	log.Debugf("received start upload request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	if s.UploadsDir == "" {
+		return nil, status.Error(codes.Unimplemented, "Resumable uploads are not enabled")
+	}
+	if req.Size < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Size must not be negative")
+	}
+	filePath, err := parsePath(req.Path)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if filePath == "" {
+		return nil, status.Error(codes.InvalidArgument, "Path is required")
+	}
+	buck := &tdb.Bucket{}
+	err = s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	if err = checkBaseRoot(buck, req.Root); err != nil {
+		return nil, err
+	}
+	policy, err := s.checkPushPath(ctx, buck, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if v := checkMaxFileSize(policy, filePath, req.Size); len(v) > 0 {
+		return nil, pushRejected(v)
+	}
+
+	s.removeExpiredUploads(ctx)
+	session, err := s.Collections.UploadSessions.Create(ctx, mdb.UploadSession{
+		Thread:    dbID.String(),
+		BucketKey: buck.Key,
+		Path:      filePath,
+		Root:      req.Root,
+		Message:   req.Message,
+		Size:      req.Size,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err = os.MkdirAll(s.UploadsDir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(s.uploadFile(session.ID), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if err = file.Close(); err != nil {
+		return nil, err
+	}
+	return &pb.StartUploadReply{
+		SessionID: session.ID,
+		ExpiresAt: session.ExpiresAt.UnixNano(),
+	}, nil
+}
+
+// UploadStatus returns the number of bytes received for an upload session.
+// Clients use the offset to resume an interrupted upload.
+func (s *Service) UploadStatus(ctx context.Context, req *pb.UploadStatusRequest) (*pb.UploadStatusReply, error) {
+	log.Debugf("received upload status request")
+
+	session, _, err := s.getUpload(ctx, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	offset, err := s.uploadOffset(session.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UploadStatusReply{
+		Key:       session.BucketKey,
+		Path:      session.Path,
+		Offset:    offset,
+		Size:      session.Size,
+		ExpiresAt: session.ExpiresAt.UnixNano(),
+	}, nil
+}
+
+// PushUpload appends data to an upload session, starting at the offset given in the header.
+// Received data before the offset is kept and data after it is discarded.
+// The new offset is acknowledged after each chunk is written.
+func (s *Service) PushUpload(server pb.API_PushUploadServer) error {
+	log.Debugf("received push upload request")
+
+	req, err := server.Recv()
+	if err != nil {
+		return err
+	}
+	var header *pb.PushUploadRequest_Header
+	switch payload := req.Payload.(type) {
+	case *pb.PushUploadRequest_Header_:
+		header = payload.Header
+	default:
+		return fmt.Errorf("push upload header is required")
+	}
+	session, buck, err := s.getUpload(server.Context(), header.SessionID)
+	if err != nil {
+		return err
+	}
+	if _, loaded := s.activeUploads.LoadOrStore(session.ID, struct{}{}); loaded {
+		return status.Error(codes.Aborted, ErrUploadInProgress.Error())
+	}
+	defer s.activeUploads.Delete(session.ID)
+
+	file, err := os.OpenFile(s.uploadFile(session.ID), os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+	if header.Offset < 0 || header.Offset > info.Size() {
+		return status.Errorf(codes.OutOfRange, "Offset must be between 0 and %d", info.Size())
+	}
+	if err = file.Truncate(header.Offset); err != nil {
+		return err
+	}
+	if _, err = file.Seek(header.Offset, io.SeekStart); err != nil {
+		return err
+	}
+	offset := header.Offset
+
+	policy, err := s.getPushPolicy(server.Context())
+	if err != nil {
+		return err
+	}
+	stat, err := s.IPFSClient.Object().Stat(server.Context(), path.New(buck.Path))
+	if err != nil {
+		return fmt.Errorf("get stat of current bucket: %s", err)
+	}
+	currentSize := int64(stat.CumulativeSize)
+	for {
+		req, err := server.Recv()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+		chunk, ok := req.Payload.(*pb.PushUploadRequest_Chunk)
+		if !ok {
+			return fmt.Errorf("invalid request")
+		}
+		next := offset + int64(len(chunk.Chunk))
+		if session.Size > 0 && next > session.Size {
+			return status.Errorf(codes.InvalidArgument, "Upload exceeds declared size of %d bytes", session.Size)
+		}
+		if v := checkMaxFileSize(policy, session.Path, next); len(v) > 0 {
+			return pushRejected(v)
+		}
+		if err := s.checkBucketSize(buck, currentSize, currentSize+next); err != nil {
+			return err
+		}
+		n, err := file.Write(chunk.Chunk)
+		offset += int64(n)
+		if err != nil {
+			return err
+		}
+		if err = server.Send(&pb.PushUploadReply{Offset: offset}); err != nil {
+			return err
+		}
+	}
+	return file.Sync()
+}
+
+// CompleteUpload adds the data of an upload session to the bucket and ends the session.
+func (s *Service) CompleteUpload(ctx context.Context, req *pb.CompleteUploadRequest) (*pb.CompleteUploadReply, error) {
+	log.Debugf("received complete upload request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	session, buck, err := s.getUpload(ctx, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if _, loaded := s.activeUploads.LoadOrStore(session.ID, struct{}{}); loaded {
+		return nil, status.Error(codes.Aborted, ErrUploadInProgress.Error())
+	}
+	defer s.activeUploads.Delete(session.ID)
+
+	offset, err := s.uploadOffset(session.ID)
+	if err != nil {
+		return nil, err
+	}
+	if session.Size > 0 && offset != session.Size {
+		return nil, status.Errorf(codes.FailedPrecondition, "Upload is incomplete (%d of %d bytes)", offset, session.Size)
+	}
+	// Bucket state may have changed since the session was started.
+	if err = checkBaseRoot(buck, session.Root); err != nil {
+		return nil, err
+	}
+	if _, err = s.checkPushPath(ctx, buck, session.Path); err != nil {
+		return nil, err
+	}
+	buckRoot, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return nil, err
+	}
+	filePath, err := s.resolveConflict(ctx, buck, buckRoot, session.Root, session.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(s.uploadFile(session.ID))
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return nil, err
+	}
+	if _, err = file.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	setPathMetadata(buck, filePath, detectContentType(filePath, head[:n]), nil)
+	setPathEncoding(buck, filePath, "")
+	var r io.Reader
+	if encKey := buck.GetEncKey(); encKey != nil {
+		r, err = dcrypto.NewEncrypter(file, encKey)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		r = file
+	}
+	pth, err := s.IPFSClient.Unixfs().Add(
+		ctx,
+		ipfsfiles.NewReaderFile(r),
+		options.Unixfs.CidVersion(1),
+		options.Unixfs.Pin(false))
+	if err != nil {
+		return nil, err
+	}
+	dirpth, err := s.addFileAtPath(ctx, dbID, dbToken, buck, filePath, pth, session.Message, nil)
+	if err != nil {
+		return nil, err
+	}
+	s.removeUpload(ctx, session.ID)
+
+	go s.IPNSManager.Publish(dirpth, buck.Key)
+
+	log.Debugf("completed upload of %s to bucket: %s", filePath, buck.Key)
+	var conflictPath string
+	if filePath != session.Path {
+		conflictPath = filePath
+	}
+	return &pb.CompleteUploadReply{
+		Path:         pth.String(),
+		ConflictPath: conflictPath,
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+	}, nil
+}
+
+// CancelUpload ends an upload session and discards its data.
+func (s *Service) CancelUpload(ctx context.Context, req *pb.CancelUploadRequest) (*pb.CancelUploadReply, error) {
+	log.Debugf("received cancel upload request")
+
+	session, _, err := s.getUpload(ctx, req.SessionID)
+	if err != nil {
+		return nil, err
+	}
+	if _, loaded := s.activeUploads.LoadOrStore(session.ID, struct{}{}); loaded {
+		return nil, status.Error(codes.Aborted, ErrUploadInProgress.Error())
+	}
+	defer s.activeUploads.Delete(session.ID)
+	s.removeUpload(ctx, session.ID)
+	return &pb.CancelUploadReply{}, nil
+}
+
+// getUpload returns an upload session and its bucket.
+// Sessions are only visible from the thread they were started in.
+func (s *Service) getUpload(ctx context.Context, id string) (*mdb.UploadSession, *tdb.Bucket, error) {
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	if s.UploadsDir == "" {
+		return nil, nil, status.Error(codes.Unimplemented, "Resumable uploads are not enabled")
+	}
+	session, err := s.Collections.UploadSessions.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil, status.Error(codes.NotFound, "Upload session not found")
+		}
+		return nil, nil, err
+	}
+	if session.Thread != dbID.String() {
+		return nil, nil, status.Error(codes.NotFound, "Upload session not found")
+	}
+	buck := &tdb.Bucket{}
+	if err = s.Buckets.Get(ctx, dbID, session.BucketKey, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, nil, err
+	}
+	return session, buck, nil
+}
+
+// uploadFile returns the name of the file that stages data for an upload session.
+func (s *Service) uploadFile(id string) string {
+	return filepath.Join(s.UploadsDir, id)
+}
+
+// uploadOffset returns the number of bytes staged for an upload session.
+func (s *Service) uploadOffset(id string) (int64, error) {
+	info, err := os.Stat(s.uploadFile(id))
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// removeUpload deletes an upload session along with its staged data.
+func (s *Service) removeUpload(ctx context.Context, id string) {
+	if err := s.Collections.UploadSessions.Delete(ctx, id); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		log.Errorf("deleting upload session %s: %v", id, err)
+	}
+	if err := os.Remove(s.uploadFile(id)); err != nil && !os.IsNotExist(err) {
+		log.Errorf("removing upload file %s: %v", id, err)
+	}
+}
+
+// removeExpiredUploads deletes expired upload sessions along with their staged data.
+func (s *Service) removeExpiredUploads(ctx context.Context) {
+	ids, err := s.Collections.UploadSessions.DeleteExpired(ctx)
+	if err != nil {
+		log.Errorf("deleting expired upload sessions: %v", err)
+		return
+	}
+	for _, id := range ids {
+		if err := os.Remove(s.uploadFile(id)); err != nil && !os.IsNotExist(err) {
+			log.Errorf("removing upload file %s: %v", id, err)
+		}
+	}
+}
+
+// checkMaxFileSize returns a violation if size exceeds the max file size allowed by policy.
+func checkMaxFileSize(policy *mdb.PushPolicy, pth string, size int64) []buckets.PolicyViolation {
+	if policy == nil || policy.MaxFileSize <= 0 || size <= policy.MaxFileSize {
+		return nil
+	}
+	return []buckets.PolicyViolation{{
+		Rule:   buckets.RuleMaxFileSize,
+		Path:   pth,
+		Detail: fmt.Sprintf("file exceeds max size of %d bytes", policy.MaxFileSize),
+	}}
+}
+
+// checkPushPath returns an error if a file cannot be pushed to filePath.
+// The org push policy, if any, is returned for checks that depend on file content.
+func (s *Service) checkPushPath(ctx context.Context, buck *tdb.Bucket, filePath string) (*mdb.PushPolicy, error) {
+	if err := s.checkLegalHoldAtPath(ctx, buck, filePath); err != nil {
+		return nil, err
+	}
+	policy, err := s.getPushPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	violations := checkForbiddenExtension(policy, filePath)
+	lv, err := s.checkLicense(ctx, policy, buck.Key, filePath)
+	if err != nil {
+		return nil, err
+	}
+	if violations = append(violations, lv...); len(violations) > 0 {
+		return nil, pushRejected(violations)
+	}
+	return policy, nil
+}
+
+// checkBaseRoot returns an error if the bucket's conflict strategy rejects an update that is based on root.
+// An empty root means the update is not based on a particular root.
+func checkBaseRoot(buck *tdb.Bucket, root string) error {
+	if root == "" {
+		if buck.ConflictStrategy == buckets.ConflictReject {
+			return status.Error(codes.FailedPrecondition, buckets.ErrRootRequired.Error())
+		}
+		return nil
+	}
+	if root != buck.Path && !buck.ConflictStrategy.AppliesStale() {
+		return status.Error(codes.FailedPrecondition, buckets.ErrNonFastForward.Error())
+	}
+	return nil
+}
+
+// resolveConflict returns the path in root that an update to filePath based on base is applied at.
+// If filePath was changed since base, the update is rejected, or if the bucket keeps both changes,
+// it's moved to the next free conflict path.
+func (s *Service) resolveConflict(ctx context.Context, buck *tdb.Bucket, root path.Resolved, base, filePath string) (string, error) {
+	changed, err := s.changedSince(ctx, buck, root, base, filePath)
+	if err != nil {
+		return "", err
+	}
+	if !changed {
+		return filePath, nil
+	}
+	if buck.ConflictStrategy != buckets.ConflictKeepBoth {
+		return "", status.Error(codes.FailedPrecondition, buckets.ErrPathConflict.Error())
+	}
+	key := buck.GetEncKey()
+	for n := 1; ; n++ {
+		pth := buckets.ConflictPath(filePath, n)
+		c, err := s.itemCidAtPath(ctx, root, pth, key)
+		if err != nil {
+			return "", err
+		}
+		if !c.Defined() {
+			return pth, nil
+		}
+	}
+}
+
+// checkRemoveConflict returns an error if filePath was changed since base.
+// Changed paths are never removed, even if the bucket keeps both changes.
+func (s *Service) checkRemoveConflict(ctx context.Context, buck *tdb.Bucket, base, filePath string) error {
+	root, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return err
+	}
+	changed, err := s.changedSince(ctx, buck, root, base, filePath)
+	if err != nil {
+		return err
+	}
+	if changed {
+		return status.Error(codes.FailedPrecondition, buckets.ErrPathConflict.Error())
+	}
+	return nil
+}
+
+// changedSince returns whether or not the item at filePath in root differs from the item at filePath in base.
+// Only updates based on a stale root that the bucket applies to the current root are checked.
+func (s *Service) changedSince(ctx context.Context, buck *tdb.Bucket, root path.Resolved, base, filePath string) (bool, error) {
+	if base == "" || base == buck.Path || !buck.ConflictStrategy.AppliesStale() {
+		return false, nil
+	}
+	baseRoot, err := util.NewResolvedPath(base)
+	if err != nil {
+		return false, status.Error(codes.InvalidArgument, err.Error())
+	}
+	key := buck.GetEncKey()
+	was, err := s.itemCidAtPath(ctx, baseRoot, filePath, key)
+	if err != nil {
+		return false, err
+	}
+	is, err := s.itemCidAtPath(ctx, root, filePath, key)
+	if err != nil {
+		return false, err
+	}
+	return !was.Equals(is), nil
+}
+
+// itemCidAtPath returns the cid of the item at pth in root, or cid.Undef if there's no item at pth.
+func (s *Service) itemCidAtPath(ctx context.Context, root path.Resolved, pth string, key []byte) (cid.Cid, error) {
+	nodes, remainder, err := s.getNodesToPath(ctx, root, pth, key)
+	if err != nil {
+		return cid.Undef, err
+	}
+	if remainder != "" {
+		return cid.Undef, nil
+	}
+	return nodes[len(nodes)-1].old.Cid(), nil
+}
+
+// addFileAtPath links the added file at pth into the bucket at filePath and saves the new bucket root.
+// If the bucket is private, the file must already be encrypted.
+// If onStage is not nil, it's called before the new bucket root is saved.
+func (s *Service) addFileAtPath(ctx context.Context, dbID thread.ID, dbToken thread.Token, buck *tdb.Bucket, filePath string, pth path.Resolved, message string, onStage func(pb.PushPathReply_Event_Stage)) (path.Resolved, error) {
+	old := s.existingPath(ctx, buck, filePath)
+	dirpth, err := s.linkFileAtPath(ctx, path.New(buck.Path), filePath, pth, buck.GetEncKey())
+	if err != nil {
+		return nil, err
+	}
+	if err = s.commitRoot(ctx, dbID, dbToken, buck, dirpth, filePath == buckets.RedirectsName, message, onStage); err != nil {
+		return nil, err
+	}
+	if buck.GetEncKey() == nil {
+		s.updateContentRefs(ctx, []path.Resolved{pth}, []path.Resolved{old})
+	}
+	s.publishPathPushed(ctx, dbID, buck, filePath, pth, message)
+	return dirpth, nil
+}
+
+// linkFileAtPath links the added file at pth into root at filePath, returning the new root.
+// Encrypted roots are pinned as they change. Unencrypted roots are pinned by commitRoot.
+func (s *Service) linkFileAtPath(ctx context.Context, root path.Path, filePath string, pth path.Resolved, key []byte) (path.Resolved, error) {
+	if key != nil {
+		fn, err := s.IPFSClient.ResolveNode(ctx, pth)
+		if err != nil {
+			return nil, err
+		}
+		return s.insertNodeAtPath(ctx, fn, path.Join(root, filePath), key)
+	}
+	return s.IPFSClient.Object().AddLink(ctx, root, filePath, pth, options.Object.Create(true))
+}
+
+// commitRoot saves root as the new bucket root.
+// If redirects is true, the bucket's redirect rules are reloaded from root.
+// If onStage is not nil, it's called once root is pinned.
+func (s *Service) commitRoot(ctx context.Context, dbID thread.ID, dbToken thread.Token, buck *tdb.Bucket, root path.Resolved, redirects bool, message string, onStage func(pb.PushPathReply_Event_Stage)) error {
+	old := buck.Path
+	encKey := buck.GetEncKey()
+	if encKey == nil {
+		if err := s.updateOrAddPin(ctx, path.New(old), root); err != nil {
+			return err
+		}
+	}
+	if onStage != nil {
+		onStage(pb.PushPathReply_Event_UpdatingRoot)
+	}
+
+	var rules []buckets.Redirect
+	redirects = redirects && encKey == nil
+	if redirects {
+		var err error
+		rules, err = s.loadRedirects(ctx, root)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	buck.Path = root.String()
+	buck.UpdatedAt = time.Now().UnixNano()
+	if err := s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
+		return err
+	}
+	s.trackRootPin(ctx, dbID, dbToken, buck, old)
+	if redirects {
+		if err := s.Collections.WebConfigs.SetRedirects(ctx, buck.Key, rules); err != nil {
+			return err
+		}
+	}
+	s.recordVersion(ctx, buck, message)
+	s.markReplicationPending(ctx, buck.Key)
+	s.markPinMirrorsPending(ctx, buck.Key)
+	s.markDomainsPending(ctx, buck.Key)
+	s.markIndexPending(ctx, dbID, dbToken, buck.Key)
+	s.countArchiveChange(ctx, buck.Key)
+	s.publishRootChanged(ctx, dbID, buck, message)
+	return nil
+}
+
+// insertNodeAtPath inserts a node at the location of path.
+// Key will be required if the path is encrypted.
+func (s *Service) insertNodeAtPath(ctx context.Context, child ipld.Node, pth path.Path, key []byte) (path.Resolved, error) {
+	// The first step here is find a resolvable list of nodes that point to path.
+	rp, fp, err := util.ParsePath(pth)
+	if err != nil {
+		return nil, err
+	}
+	fd, fn := gopath.Split(fp)
+	fd = strings.TrimSuffix(fd, "/")
+	np, r, err := s.getNodesToPath(ctx, rp, fd, key)
+	if err != nil {
+		return nil, err
+	}
+	r = gopath.Join(r, fn)
+
+	// If the remaining path segment is not empty, we need to create each one
+	// starting at the other end and walking back up to the deepest node
+	// in the node path.
+	parts := strings.Split(r, "/")
+	news := make([]ipld.Node, len(parts)-1)
+	cn := child
+	for i := len(parts) - 1; i > 0; i-- {
+		n, err := newDirWithNode(cn, parts[i], key)
+		if err != nil {
+			return nil, err
+		}
+		news[i-1] = cn
+		cn = n
+	}
+	np = append(np, pathNode{new: cn, name: parts[0], isJoint: true})
+
+	// Now, we have a full list of nodes to the insert location,
+	// but the existing nodes need to be updated and re-encrypted.
+	change := make([]ipld.Node, len(np))
+	for i := len(np) - 1; i >= 0; i-- {
+		change[i] = np[i].new
+		if i > 0 {
+			p, ok := np[i-1].new.(*dag.ProtoNode)
+			if !ok {
+				return nil, dag.ErrNotProtobuf
+			}
+			if np[i].isJoint {
+				xn, err := p.GetLinkedNode(ctx, s.IPFSClient.Dag(), np[i].name)
+				if err != nil && !errors.Is(err, dag.ErrLinkNotFound) {
+					return nil, err
+				}
+				if xn != nil {
+					np[i].old = path.IpfsPath(xn.Cid())
+					if err := p.RemoveNodeLink(np[i].name); err != nil {
+						return nil, err
+					}
+				}
+			} else {
+				xl, err := p.GetNodeLink(np[i].name)
+				if err != nil && !errors.Is(err, dag.ErrLinkNotFound) {
+					return nil, err
+				}
+				if xl != nil {
+					if err := p.RemoveNodeLink(np[i].name); err != nil {
+						return nil, err
+					}
+				}
+			}
+			if err := p.AddNodeLink(np[i].name, np[i].new); err != nil {
+				return nil, err
+			}
+			np[i-1].new, err = encryptNode(p, key)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Add all the _new_ nodes, which is the sum of the brand new ones
+	// from the missing path segment, and the changed ones from
+	// the existing path.
+	allNews := append(news, change...)
+	if err := s.IPFSClient.Dag().AddMany(ctx, allNews); err != nil {
+		return nil, err
+	}
+	// Pin brand new nodes
+	if err := s.pinBlocks(ctx, news); err != nil {
+		return nil, err
+	}
+
+	// Update changed node pins
+	for _, n := range np {
+		if n.old != nil && n.isJoint {
+			if err := s.unpinBranch(ctx, n.old, key); err != nil {
+				return nil, err
+			}
+		}
+		if err = s.updateOrAddPin(ctx, n.old, path.IpfsPath(n.new.Cid())); err != nil {
+			return nil, err
+		}
+	}
+	return path.IpfsPath(np[0].new.Cid()), nil
+}
+
+// unpinBranch walks a the node at path, decrypting (if needed) and unpinning all nodes
+func (s *Service) unpinBranch(ctx context.Context, p path.Resolved, key []byte) error {
+	n, err := s.getNodeAtPath(ctx, p, key)
+	if err != nil {
+		return err
+	}
+	for _, l := range n.Links() {
+		if l.Name == "" {
+			continue // Data nodes will never be pinned directly
+		}
+		lp := path.IpfsPath(l.Cid)
+		if err := s.unpinPath(ctx, lp); err != nil {
+			return err
+		}
+		if err = s.unpinBranch(ctx, lp, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type pathNode struct {
+	old     path.Resolved
+	new     ipld.Node
+	name    string
+	isJoint bool
+}
+
+// getNodesToPath returns a list of pathNodes that point to the path,
+// The remaining path segment that was not resolvable is also returned.
+func (s *Service) getNodesToPath(ctx context.Context, base path.Resolved, pth string, key []byte) (nodes []pathNode, remainder string, err error) {
+	top, err := s.getNodeAtPath(ctx, base, key)
+	if err != nil {
+		return
+	}
+	nodes = append(nodes, pathNode{old: base, new: top})
+	remainder = pth
+	if remainder == "" {
+		return
+	}
+	parts := strings.Split(pth, "/")
+	for i := 0; i < len(parts); i++ {
+		l := getLink(top.Links(), parts[i])
+		if l != nil {
+			p := path.IpfsPath(l.Cid)
+			top, err = s.getNodeAtPath(ctx, p, key)
+			if err != nil {
+				return
+			}
+			nodes = append(nodes, pathNode{old: p, new: top, name: parts[i]})
+		} else {
+			remainder = strings.Join(parts[i:], "/")
+			return nodes, remainder, nil
+		}
+	}
+	return nodes, "", nil
+}
+
+func getLink(lnks []*ipld.Link, name string) *ipld.Link {
+	for _, l := range lnks {
+		if l.Name == name {
+			return l
+		}
+	}
+	return nil
+}
+
+// updateOrAddPin moves the pin at from to to.
+// If from is nil, a new pin as placed at to.
+func (s *Service) updateOrAddPin(ctx context.Context, from, to path.Path) error {
+	toSize, err := s.dagSize(ctx, to)
+	if err != nil {
+		return fmt.Errorf("getting size of destination dag: %s", err)
+	}
+	if s.BucketsMaxSize > 0 && toSize > s.BucketsMaxSize {
+		return fmt.Errorf("bucket size is greater than max allowed size")
+	}
+
+	fromSize, err := s.dagSize(ctx, from)
+	if err != nil {
+		return fmt.Errorf("getting size of current dag: %s", err)
+	}
+
+	// Calculate if adding or moving the pin leads to a violation of
+	// total buckets size allowed in configuration.
+	currentBucketsSize, err := s.getBucketsTotalSize(ctx)
+	if err != nil {
+		return fmt.Errorf("getting current buckets total size: %s", err)
+	}
+	deltaSize := -fromSize + toSize
+	if maxSize := s.getBucketsTotalMaxSize(ctx); maxSize > 0 && currentBucketsSize+deltaSize > maxSize {
+		return ErrBucketsTotalSizeExceedsMaxSize
+	}
+
+	if from == nil {
+		if err := s.IPFSClient.Pin().Add(ctx, to); err != nil {
+			return err
+		}
+	} else {
+		if err := s.IPFSClient.Pin().Update(ctx, from, to); err != nil {
+			if err.Error() == pinNotRecursiveMsg {
+				return s.IPFSClient.Pin().Add(ctx, to)
+			}
+			return err
+		}
+	}
+
+	if err := s.sumBytesPinned(ctx, deltaSize); err != nil {
+		return fmt.Errorf("updating new buckets total size: %s", err)
+	}
+	return nil
+}
+
+// dagSize returns the cummulative size of root. If root is nil, it returns 0.
+func (s *Service) dagSize(ctx context.Context, root path.Path) (int64, error) {
+	if root == nil {
+		return 0, nil
+	}
+	stat, err := s.IPFSClient.Object().Stat(ctx, root)
+	if err != nil {
+		return 0, fmt.Errorf("get stats of pin destination: %s", err)
+	}
+	return int64(stat.CumulativeSize), nil
+}
+
+func (s *Service) PullPath(req *pb.PullPathRequest, server pb.API_PullPathServer) error {
+	log.Debugf("received pull path request")
+
+	dbID, ok := common.ThreadIDFromContext(server.Context())
+	if !ok {
+		return fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(server.Context())
+
+	if req.Offset < 0 || req.Length < 0 {
+		return status.Error(codes.InvalidArgument, "Offset and length must not be negative")
+	}
+	buck, pth, err := s.getBucketPath(server.Context(), dbID, req.Key, req.Path, dbToken)
+	if err != nil {
+		return err
+	}
+	if req.Root != "" {
+		if pth, err = atRoot(buck, req.Root, req.Path); err != nil {
+			return err
+		}
+	}
+
+	var fpth path.Resolved
+	encKey := buck.GetEncKey()
+	if encKey != nil {
+		buckPath, err := util.NewResolvedPath(buck.Path)
+		if err != nil {
+			return err
+		}
+		np, r, err := s.getNodesToPath(server.Context(), buckPath, req.Path, encKey)
+		if err != nil {
+			return err
+		}
+		if r != "" {
+			return fmt.Errorf("could not resolve path: %s", pth)
+		}
+		fn := np[len(np)-1]
+		fpth = path.IpfsPath(fn.new.Cid())
+	} else {
+		fpth, err = s.IPFSClient.ResolvePath(server.Context(), pth)
+		if err != nil {
+			return err
+		}
+	}
+
+	node, err := s.IPFSClient.Unixfs().Get(server.Context(), fpth)
+	if err != nil {
+		return err
+	}
+	defer node.Close()
+	file := ipfsfiles.ToFile(node)
+	if file == nil {
+		return fmt.Errorf("node is a directory")
+	}
+
+	// Compressed files are decompressed unless the client asked for the content as stored.
+	var encoding string
+	if filePath, err := parsePath(req.Path); err == nil {
+		encoding = buck.Metadata[filePath].ContentEncoding
+	}
+	decode := encoding != "" && !req.Encoded
+	if decode {
+		encoding = ""
+	}
+	seekable := encKey == nil && !decode
+
+	// The first reply carries the ETag so clients can cache the file,
+	// and the file size so clients can describe ranged reads.
+	// The size of encrypted or compressed files is not known until they're decoded.
+	etag := buckets.ETag(fpth.Cid().String())
+	if buckets.MatchETag(req.IfNoneMatch, etag) {
+		return server.Send(&pb.PullPathReply{Etag: etag, NotModified: true})
+	}
+	var size int64
+	if seekable {
+		if size, err = file.Size(); err != nil {
+			return err
+		}
+	}
+	if err := server.Send(&pb.PullPathReply{
+		Etag:            etag,
+		Size:            size,
+		ContentEncoding: encoding,
+	}); err != nil {
+		return err
+	}
+	if seekable && req.Offset > size {
+		return status.Errorf(codes.OutOfRange, "Offset %d exceeds file size %d", req.Offset, size)
+	}
+
+	var reader io.Reader
+	if encKey != nil {
+		r, err := dcrypto.NewDecrypter(file, encKey)
+		if err != nil {
+			return err
+		}
+		defer r.Close()
+		reader = r
+	} else {
+		reader = file
+	}
+	if decode {
+		zr, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		reader = zr
+	}
+	if req.Offset > 0 {
+		if seekable {
+			if _, err := file.Seek(req.Offset, io.SeekStart); err != nil {
+				return err
+			}
+		} else if _, err := io.CopyN(ioutil.Discard, reader, req.Offset); err == io.EOF {
+			// Encrypted and compressed files can't be seeked, so skip to the offset
+			return status.Error(codes.OutOfRange, "Offset exceeds file size")
+		} else if err != nil {
+			return err
+		}
+	}
+	if req.Length > 0 {
+		reader = io.LimitReader(reader, req.Length)
+	}
+
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := reader.Read(buf)
+		if n > 0 {
+			if err := server.Send(&pb.PullPathReply{
+				Chunk: buf[:n],
+			}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) PullIpfsPath(req *pb.PullIpfsPathRequest, server pb.API_PullIpfsPathServer) error {
+	log.Debugf("received ipfs pull path request")
+
+	pth := path.New(req.Path)
+	node, err := s.IPFSClient.Unixfs().Get(server.Context(), pth)
+	if err != nil {
+		return err
+	}
+	defer node.Close()
+
+	file := ipfsfiles.ToFile(node)
+	if file == nil {
+		return fmt.Errorf("node is a directory")
+	}
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := file.Read(buf)
+		if n > 0 {
+			if err := server.Send(&pb.PullIpfsPathReply{
+				Chunk: buf[:n],
+			}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBlock returns the raw data of a block in a bucket's DAG.
+// Blocks that are not reachable from the bucket root are not returned.
+func (s *Service) GetBlock(ctx context.Context, req *pb.GetBlockRequest) (*pb.GetBlockReply, error) {
+	log.Debugf("received get block request")
+
+	c, err := s.findBlock(ctx, req.Key, req.Cid, req.Path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.IPFSClient.Block().Get(ctx, path.IpfsPath(c))
+	if err != nil {
+		return nil, err
+	}
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetBlockReply{Data: data}, nil
+}
+
+// HasBlock returns whether or not a block is reachable from a bucket's root.
+func (s *Service) HasBlock(ctx context.Context, req *pb.HasBlockRequest) (*pb.HasBlockReply, error) {
+	log.Debugf("received has block request")
+
+	if _, err := s.findBlock(ctx, req.Key, req.Cid, req.Path); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return &pb.HasBlockReply{}, nil
+		}
+		return nil, err
+	}
+	return &pb.HasBlockReply{Has: true}, nil
+}
+
+// PutBlock adds a block to the network, counting it against the bucket's size quotas.
+// Blocks are not pinned until they are linked into the bucket, e.g., with SetPath.
+func (s *Service) PutBlock(ctx context.Context, req *pb.PutBlockRequest) (*pb.PutBlockReply, error) {
+	log.Debugf("received put block request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	if len(req.Data) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Block data is required")
+	}
+	if len(req.Data) > maxBlockSize {
+		return nil, status.Errorf(codes.InvalidArgument, "Block size exceeds max of %d bytes", maxBlockSize)
+	}
+	var expected cid.Cid
+	if req.Cid != "" {
+		var err error
+		expected, err = cid.Decode(req.Cid)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid cid: %v", err)
+		}
+	}
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+
+	size := int64(len(req.Data))
+	if s.bucketMaxSize(buck) > 0 {
+		bsize, err := s.dagSize(ctx, path.New(buck.Path))
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkBucketSize(buck, bsize, bsize+size); err != nil {
+			return nil, err
+		}
+	}
+	total, err := s.getBucketsTotalSize(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting current buckets total size: %s", err)
+	}
+	if maxSize := s.getBucketsTotalMaxSize(ctx); maxSize > 0 && total+size > maxSize {
+		return nil, ErrBucketsTotalSizeExceedsMaxSize
+	}
+
+	opts := []options.BlockPutOption{options.Block.Pin(false)}
+	if req.Format != "" {
+		opts = append(opts, options.Block.Format(req.Format))
+	}
+	stat, err := s.IPFSClient.Block().Put(ctx, bytes.NewReader(req.Data), opts...)
+	if err != nil {
+		return nil, err
+	}
+	if expected.Defined() && !expected.Equals(stat.Path().Cid()) {
+		return nil, status.Errorf(codes.InvalidArgument, "Block cid %s does not match %s", stat.Path().Cid(), expected)
+	}
+	return &pb.PutBlockReply{Cid: stat.Path().Cid().String()}, nil
+}
+
+// ExportBucket streams the bucket root DAG as a CARv1 file.
+// The blocks of private buckets are exported encrypted.
+func (s *Service) ExportBucket(req *pb.ExportBucketRequest, server pb.API_ExportBucketServer) error {
+	log.Debugf("received export bucket request")
+
+	ctx := server.Context()
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return err
+	}
+	root, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return err
+	}
+
+	var stack []cid.Cid
+	if key := buck.GetEncKey(); key != nil {
+		// Links between encrypted directories are hidden in node data
+		nodes, err := s.getBranch(ctx, root, key)
+		if err != nil {
+			return err
+		}
+		for i := len(nodes) - 1; i >= 0; i-- {
+			stack = append(stack, nodes[i].Cid())
+		}
+	} else {
+		stack = append(stack, root.Cid())
+	}
+
+	w := bufio.NewWriterSize(&exportWriter{server: server}, chunkSize)
+	cw, err := buckets.NewCarWriter(w, root.Cid())
+	if err != nil {
+		return err
+	}
+	seen := cid.NewSet()
+	for len(stack) > 0 {
+		next := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if !seen.Visit(next) {
+			continue
+		}
+		n, err := s.IPFSClient.Dag().Get(ctx, next)
+		if err != nil {
+			return err
+		}
+		if err := cw.Put(n.Cid(), n.RawData()); err != nil {
+			return err
+		}
+		links := n.Links()
+		for i := len(links) - 1; i >= 0; i-- {
+			stack = append(stack, links[i].Cid)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	log.Debugf("exported %d blocks of bucket %s", seen.Len(), buck.Key)
+	return nil
+}
+
+// ImportBucket creates a bucket or replaces a bucket root from a CARv1 file.
+// The CAR root must be a UnixFS directory. Replacing a root requires a bucket directory with a seed,
+// which for private buckets must be encrypted with the bucket key, e.g., an export of the same bucket.
+func (s *Service) ImportBucket(server pb.API_ImportBucketServer) error {
+	log.Debugf("received import bucket request")
+
+	ctx := server.Context()
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	req, err := server.Recv()
+	if err != nil {
+		return err
+	}
+	payload, ok := req.Payload.(*pb.ImportBucketRequest_Header_)
+	if !ok {
+		return fmt.Errorf("import bucket header is required")
+	}
+	header := payload.Header
+
+	buck := &tdb.Bucket{}
+	var currentSize int64
+	if header.Key != "" {
+		if err := s.Buckets.Get(ctx, dbID, header.Key, buck, tdb.WithToken(dbToken)); err != nil {
+			return err
+		}
+		if header.Root != "" && header.Root != buck.Path {
+			return status.Error(codes.FailedPrecondition, buckets.ErrNonFastForward.Error())
+		}
+		if err := s.checkLegalHold(ctx, buck.Key); err != nil {
+			return err
+		}
+		if currentSize, err = s.dagSize(ctx, path.New(buck.Path)); err != nil {
+			return err
+		}
+	}
+
+	reader, writer := io.Pipe()
+	defer reader.Close()
+	go func() {
+		var received int64
+		for {
+			req, err := server.Recv()
+			if err == io.EOF {
+				_ = writer.Close()
+				return
+			} else if err != nil {
+				_ = writer.CloseWithError(err)
+				return
+			}
+			payload, ok := req.Payload.(*pb.ImportBucketRequest_Chunk)
+			if !ok {
+				_ = writer.CloseWithError(fmt.Errorf("invalid request"))
+				return
+			}
+			received += int64(len(payload.Chunk))
+			if err := s.checkBucketSize(buck, currentSize, received); err != nil {
+				_ = writer.CloseWithError(err)
+				return
+			}
+			if _, err := writer.Write(payload.Chunk); err != nil {
+				return
+			}
+		}
+	}()
+	root, blocks, err := s.importCar(ctx, reader)
+	if err != nil {
+		return err
+	}
+	rootPath := path.IpfsPath(root)
+
+	if header.Key == "" {
+		boot, err := s.unseededCid(ctx, rootPath)
+		if err != nil {
+			return err
+		}
+		rep, err := s.Init(ctx, &pb.InitRequest{
+			Name:         header.Name,
+			Private:      header.Private,
+			BootstrapCid: boot.String(),
+		})
+		if err != nil {
+			return err
+		}
+		log.Debugf("imported %d blocks to new bucket %s", blocks, rep.Root.Key)
+		return server.SendAndClose(&pb.ImportBucketReply{Root: rep.Root, Blocks: blocks})
+	}
+
+	dn, err := s.getNodeAtPath(ctx, rootPath, buck.GetEncKey())
+	if err != nil || !isBucketDir(dn) {
+		return status.Error(codes.InvalidArgument, "Import root is not a directory of this bucket")
+	}
+	message := header.Message
+	if message == "" {
+		message = fmt.Sprintf("import %s", root)
+	}
+	if err := s.restoreRoot(ctx, dbID, dbToken, buck, rootPath.String(), message); err != nil {
+		return err
+	}
+	log.Debugf("imported %d blocks to bucket %s", blocks, buck.Key)
+	return server.SendAndClose(&pb.ImportBucketReply{
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+		Blocks: blocks,
+	})
+}
+
+// importCar adds the blocks of a CAR file from r without pinning them.
+// It returns the CAR root and the number of blocks added.
+func (s *Service) importCar(ctx context.Context, r io.Reader) (cid.Cid, int64, error) {
+	invalid := func(err error) error {
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.InvalidArgument, "Invalid CAR file: %v", err)
+	}
+	cr, err := buckets.NewCarReader(r)
+	if err != nil {
+		return cid.Undef, 0, invalid(err)
+	}
+	if len(cr.Roots) != 1 {
+		return cid.Undef, 0, status.Error(codes.InvalidArgument, "CAR file must have exactly one root")
+	}
+	root := cr.Roots[0]
+
+	var count int64
+	var hasRoot bool
+	for {
+		c, data, err := cr.Next()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return cid.Undef, 0, invalid(err)
+		}
+		count++
+		if c.Equals(root) {
+			hasRoot = true
+		}
+		prefix := c.Prefix()
+		if prefix.MhType == mh.IDENTITY {
+			continue // The data is in the cid
+		}
+		format := "v0"
+		if prefix.Version != 0 {
+			format = cid.CodecToStr[prefix.Codec]
+		}
+		stat, err := s.IPFSClient.Block().Put(
+			ctx,
+			bytes.NewReader(data),
+			options.Block.Format(format),
+			options.Block.Hash(prefix.MhType, prefix.MhLength),
+			options.Block.Pin(false),
+		)
+		if err != nil {
+			return cid.Undef, 0, fmt.Errorf("adding block %s: %v", c, err)
+		}
+		if !stat.Path().Cid().Equals(c) {
+			return cid.Undef, 0, status.Errorf(codes.InvalidArgument, "Block %s is not supported", c)
+		}
+	}
+	if !hasRoot {
+		return cid.Undef, 0, status.Errorf(codes.InvalidArgument, "CAR file does not contain root %s", root)
+	}
+	return root, count, nil
+}
+
+// unseededCid returns the cid of the directory at pth without its seed.
+// Imported directories are bootstrapped without their seed, which is replaced by a new one.
+func (s *Service) unseededCid(ctx context.Context, pth path.Resolved) (cid.Cid, error) {
+	n, err := s.IPFSClient.Dag().Get(ctx, pth.Cid())
+	if err != nil {
+		return cid.Undef, err
+	}
+	dir, ok := n.(*dag.ProtoNode)
+	if !ok || !isDir(dir) {
+		return cid.Undef, status.Error(codes.InvalidArgument, "Import root is not a directory")
+	}
+	if _, err := dir.GetNodeLink(buckets.SeedName); err != nil {
+		return dir.Cid(), nil
+	}
+	dir = dir.Copy().(*dag.ProtoNode)
+	if err := dir.RemoveNodeLink(buckets.SeedName); err != nil {
+		return cid.Undef, err
+	}
+	if err := s.IPFSClient.Dag().Add(ctx, dir); err != nil {
+		return cid.Undef, err
+	}
+	return dir.Cid(), nil
+}
+
+// checkDeterministic returns an error if files can't be pushed to buck deterministically.
+// Private bucket files are encrypted with a random nonce, so their CIDs are never reproducible.
+func checkDeterministic(buck *tdb.Bucket) error {
+	if buck.GetEncKey() != nil {
+		return status.Error(codes.FailedPrecondition, "Deterministic pushes are not supported for private buckets")
+	}
+	return nil
+}
+
+// deterministicAddOptions returns the UnixFS add options that fix all parameters affecting file CIDs,
+// instead of relying on the defaults of the IPFS node. They match the parameters used by local buckets.
+func deterministicAddOptions() []options.UnixfsAddOption {
+	return []options.UnixfsAddOption{
+		options.Unixfs.CidVersion(buckets.DeterministicCidVersion),
+		options.Unixfs.Hash(buckets.DeterministicHash),
+		options.Unixfs.Chunker(buckets.DeterministicChunker),
+		options.Unixfs.Layout(options.BalancedLayout),
+		options.Unixfs.RawLeaves(true),
+		options.Unixfs.Inline(false),
+		options.Unixfs.Nocopy(false),
+	}
+}
+
+// contentRoot returns the cid of the bucket root at pth without the bucket's random seed.
+// Unlike the bucket root, the content root only depends on the bucket's files,
+// so pushing the same files deterministically to any bucket results in the same content root.
+func (s *Service) contentRoot(ctx context.Context, pth path.Resolved) (string, error) {
+	c, err := s.unseededCid(ctx, pth)
+	if err != nil {
+		return "", err
+	}
+	return c.String(), nil
+}
+
+// isBucketDir returns whether or not n is a UnixFS directory with a bucket seed.
+func isBucketDir(n ipld.Node) bool {
+	dir, ok := n.(*dag.ProtoNode)
+	if !ok || !isDir(dir) {
+		return false
+	}
+	_, err := dir.GetNodeLink(buckets.SeedName)
+	return err == nil
+}
+
+func isDir(n *dag.ProtoNode) bool {
+	fn, err := unixfs.FSNodeFromBytes(n.Data())
+	return err == nil && fn.IsDir()
+}
+
+// StartS3Import queues an import of the objects under an S3 bucket prefix into a bucket path.
+// Objects are imported in the background in key order. Interrupted imports resume after the last imported object.
+func (s *Service) StartS3Import(ctx context.Context, req *pb.StartS3ImportRequest) (*pb.StartS3ImportReply, error) {
+	log.Debugf("received start s3 import request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if req.Bucket == "" {
+		return nil, status.Error(codes.InvalidArgument, "Source bucket is required")
+	}
+	dst, err := parsePath(req.Path)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.checkLegalHoldAtPath(ctx, buck, dst); err != nil {
+		return nil, err
+	}
+	src := mdb.S3Source{
+		Endpoint:  req.Endpoint,
+		Region:    req.Region,
+		Bucket:    req.Bucket,
+		Prefix:    req.Prefix,
+		AccessKey: req.AccessKey,
+		SecretKey: req.SecretKey,
+	}
+	client, err := s.newS3Client(src)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, _, err := client.ListObjects(ctx, src.Prefix, "", 1); err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "Listing source objects: %v", err)
+	}
+
+	imp := mdb.BucketImport{
+		BucketKey: buck.Key,
+		DbID:      dbID,
+		DbToken:   dbToken,
+		Source:    src,
+		Path:      dst,
+	}
+	if owner := contentOwner(ctx); owner != nil {
+		ownerID, err := crypto.MarshalPublicKey(owner)
+		if err != nil {
+			return nil, err
+		}
+		imp.Owner = ownerID
+	}
+	created, err := s.Collections.BucketImports.Create(ctx, imp)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.StartS3ImportReply{Import: bucketImportToPb(*created)}, nil
+}
+
+// ListImports returns the imports of a bucket, newest first.
+func (s *Service) ListImports(ctx context.Context, req *pb.ListImportsRequest) (*pb.ListImportsReply, error) {
+	log.Debugf("received list imports request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	list, err := s.Collections.BucketImports.List(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	imports := make([]*pb.BucketImport, len(list))
+	for i, imp := range list {
+		imports[i] = bucketImportToPb(imp)
+	}
+	return &pb.ListImportsReply{Imports: imports}, nil
+}
+
+// CancelImport stops a queued or running import.
+// Objects that were already imported are kept.
+func (s *Service) CancelImport(ctx context.Context, req *pb.CancelImportRequest) (*pb.CancelImportReply, error) {
+	log.Debugf("received cancel import request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	imp, err := s.Collections.BucketImports.Get(ctx, req.Id)
+	if errors.Is(err, mongo.ErrNoDocuments) || (err == nil && imp.BucketKey != buck.Key) {
+		return nil, status.Error(codes.NotFound, "Import not found")
+	} else if err != nil {
+		return nil, err
+	}
+	if err = s.Collections.BucketImports.Cancel(ctx, imp.ID); errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, status.Error(codes.FailedPrecondition, "Import is not queued or running")
+	} else if err != nil {
+		return nil, err
+	}
+	return &pb.CancelImportReply{}, nil
+}
+
+// RunBucketImport imports the objects of a bucket import in batches, starting after its cursor.
+// Each batch is committed as a new bucket root before the cursor is advanced.
+// Imports that violate the bucket's quota or push policy are stopped.
+func (s *Service) RunBucketImport(ctx context.Context, imp mdb.BucketImport) error {
+	ctx = common.NewThreadIDContext(ctx, imp.DbID)
+	ctx = thread.NewTokenContext(ctx, imp.DbToken)
+	if len(imp.Owner) > 0 {
+		owner, err := crypto.UnmarshalPublicKey(imp.Owner)
+		if err != nil {
+			return err
+		}
+		ctx = s.ownerContext(ctx, owner)
+	}
+	client, err := s.newS3Client(imp.Source)
+	if err != nil {
+		return err
+	}
+
+	cursor := imp.Cursor
+	for {
+		// Leasing the import also detects if it was canceled.
+		err := s.Collections.BucketImports.SetProgress(ctx, imp.ID, cursor, 0, 0, time.Now().Add(importLeaseDuration))
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		objs, more, err := client.ListObjects(ctx, imp.Source.Prefix, cursor, importBatchSize)
+		if errors.Is(err, util.ErrNonPublicAddress) {
+			// The endpoint was rebound to an internal address after the import was started.
+			return s.Collections.BucketImports.SetFailed(ctx, imp.ID, err.Error(), time.Now(), 1)
+		} else if err != nil {
+			return fmt.Errorf("listing source objects: %v", err)
+		}
+		if len(objs) > 0 {
+			n, size, err := s.importS3Batch(ctx, client, imp, objs)
+			if err != nil {
+				if strings.Contains(err.Error(), db.ErrInstanceNotFound.Error()) {
+					return s.Collections.BucketImports.DeleteByBucket(ctx, imp.BucketKey)
+				}
+				if st, ok := status.FromError(err); ok && isImportRejected(st.Code()) {
+					return s.Collections.BucketImports.SetFailed(ctx, imp.ID, st.Message(), time.Now(), 1)
+				}
+				return err
+			}
+			cursor = objs[len(objs)-1].Key
+			err = s.Collections.BucketImports.SetProgress(ctx, imp.ID, cursor, n, size, time.Now().Add(importLeaseDuration))
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+		if !more {
+			if err := s.Collections.BucketImports.SetDone(ctx, imp.ID); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+				return err
+			}
+			return nil
+		}
+	}
+}
+
+// importS3Batch adds objs to the bucket of imp and commits the new bucket root.
+// Returns the number of imported objects and their total size.
+func (s *Service) importS3Batch(ctx context.Context, client *s3.Client, imp mdb.BucketImport, objs []s3.Object) (int64, int64, error) {
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, imp.DbID, imp.BucketKey, buck, tdb.WithToken(imp.DbToken)); err != nil {
+		return 0, 0, err
+	}
+	stat, err := s.IPFSClient.Object().Stat(ctx, path.New(buck.Path))
+	if err != nil {
+		return 0, 0, fmt.Errorf("get stat of current bucket: %s", err)
+	}
+	currentSize := int64(stat.CumulativeSize)
+	encKey := buck.GetEncKey()
+
+	root := path.New(buck.Path)
+	var dirpth path.Resolved
+	var n, size int64
+	var added, freed []path.Resolved
+	var redirects bool
+	files := make(map[string]path.Resolved)
+	for _, obj := range objs {
+		name := strings.TrimPrefix(obj.Key, imp.Source.Prefix)
+		if name == "" || strings.HasSuffix(name, "/") {
+			continue // Skip directory placeholders
+		}
+		filePath, err := parsePath(gopath.Join(imp.Path, name))
+		if err != nil {
+			return 0, 0, status.Error(codes.InvalidArgument, err.Error())
+		}
+		policy, err := s.checkPushPath(ctx, buck, filePath)
+		if err != nil {
+			return 0, 0, err
+		}
+		if v := checkMaxFileSize(policy, filePath, obj.Size); len(v) > 0 {
+			return 0, 0, pushRejected(v)
+		}
+		if err := s.checkBucketSize(buck, currentSize, currentSize+size+obj.Size); err != nil {
+			return 0, 0, err
+		}
+
+		pth, contentType, err := s.addS3Object(ctx, client, obj.Key, encKey)
+		if err != nil {
+			return 0, 0, fmt.Errorf("importing %s: %v", obj.Key, err)
+		}
+		freed = append(freed, s.existingPath(ctx, buck, filePath))
+		dirpth, err = s.linkFileAtPath(ctx, root, filePath, pth, encKey)
+		if err != nil {
+			return 0, 0, err
+		}
+		root = dirpth
+		if contentType == "" || contentType == "binary/octet-stream" {
+			contentType = detectContentType(filePath, nil)
+		}
+		setPathMetadata(buck, filePath, contentType, nil)
+		setPathEncoding(buck, filePath, "")
+		added = append(added, pth)
+		files[filePath] = pth
+		redirects = redirects || filePath == buckets.RedirectsName
+		n++
+		size += obj.Size
+	}
+	if dirpth == nil {
+		return 0, 0, nil
+	}
+
+	msg := fmt.Sprintf("Imported %d objects from %s", n, s3SourceURL(imp.Source))
+	if err := s.commitRoot(ctx, imp.DbID, imp.DbToken, buck, dirpth, redirects, msg, nil); err != nil {
+		return 0, 0, err
+	}
+	if encKey == nil {
+		s.updateContentRefs(ctx, added, freed)
+	}
+	for filePath, pth := range files {
+		s.publishPathPushed(ctx, imp.DbID, buck, filePath, pth, msg)
+	}
+	go s.IPNSManager.Publish(dirpth, buck.Key)
+	return n, size, nil
+}
+
+// addS3Object adds the S3 object with key to IPFS, encrypting it with encKey if not nil.
+func (s *Service) addS3Object(ctx context.Context, client *s3.Client, key string, encKey []byte) (path.Resolved, string, error) {
+	body, contentType, err := client.GetObject(ctx, key)
+	if err != nil {
+		return nil, "", err
+	}
+	defer body.Close()
+	var r io.Reader = body
+	if encKey != nil {
+		r, err = dcrypto.NewEncrypter(body, encKey)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	pth, err := s.IPFSClient.Unixfs().Add(
+		ctx,
+		ipfsfiles.NewReaderFile(r),
+		options.Unixfs.CidVersion(1),
+		options.Unixfs.Pin(false))
+	if err != nil {
+		return nil, "", err
+	}
+	return pth, contentType, nil
+}
+
+// isImportRejected returns whether or not an import error with code won't go away by retrying.
+func isImportRejected(code codes.Code) bool {
+	switch code {
+	case codes.ResourceExhausted, codes.FailedPrecondition, codes.PermissionDenied, codes.InvalidArgument:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *Service) newS3Client(src mdb.S3Source) (*s3.Client, error) {
+	return s3.NewClient(s3.Config{
+		Endpoint:     src.Endpoint,
+		Region:       src.Region,
+		Bucket:       src.Bucket,
+		AccessKey:    src.AccessKey,
+		SecretKey:    src.SecretKey,
+		AllowPrivate: s.AllowPrivateEndpoints,
+	})
+}
+
+func s3SourceURL(src mdb.S3Source) string {
+	return fmt.Sprintf("s3://%s/%s", src.Bucket, src.Prefix)
+}
+
+func bucketImportToPb(imp mdb.BucketImport) *pb.BucketImport {
+	return &pb.BucketImport{
+		Id:        imp.ID,
+		Source:    s3SourceURL(imp.Source),
+		Path:      imp.Path,
+		Status:    pb.BucketImport_Status(imp.Status),
+		Objects:   imp.Objects,
+		Bytes:     imp.Bytes,
+		Cursor:    imp.Cursor,
+		LastError: imp.LastError,
+		CreatedAt: imp.CreatedAt.UnixNano(),
+		UpdatedAt: imp.UpdatedAt.UnixNano(),
+	}
+}
+
+// exportWriter sends the bytes written to it as export chunks.
+type exportWriter struct {
+	server pb.API_ExportBucketServer
+}
+
+func (w *exportWriter) Write(p []byte) (int, error) {
+	if err := w.server.Send(&pb.ExportBucketReply{Chunk: p}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// findBlock returns the cid of a block if it's reachable from the bucket root.
+// If pth is not empty, only the part of the bucket at pth is searched.
+func (s *Service) findBlock(ctx context.Context, key, cidStr, pth string) (cid.Cid, error) {
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return cid.Undef, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	c, err := cid.Decode(cidStr)
+	if err != nil {
+		return cid.Undef, status.Errorf(codes.InvalidArgument, "Invalid cid: %v", err)
+	}
+	buck := &tdb.Bucket{}
+	if err = s.Buckets.Get(ctx, dbID, key, buck, tdb.WithToken(dbToken)); err != nil {
+		return cid.Undef, err
+	}
+	base, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return cid.Undef, err
+	}
+	encKey := buck.GetEncKey()
+	if pth = strings.Trim(pth, "/"); pth != "" {
+		np, remainder, err := s.getNodesToPath(ctx, base, pth, encKey)
+		if err != nil {
+			return cid.Undef, err
+		}
+		if remainder != "" {
+			return cid.Undef, status.Error(codes.NotFound, ErrBlockNotFound.Error())
+		}
+		base = np[len(np)-1].old
+	}
+	found, err := s.dagContains(ctx, base, encKey, c)
+	if err != nil {
+		return cid.Undef, err
+	}
+	if !found {
+		return cid.Undef, status.Error(codes.NotFound, ErrBlockNotFound.Error())
+	}
+	return c, nil
+}
+
+// dagContains returns whether or not c is reachable from root.
+// Key will be required if root is encrypted.
+func (s *Service) dagContains(ctx context.Context, root path.Resolved, key []byte, c cid.Cid) (bool, error) {
+	var stack []cid.Cid
+	if key != nil {
+		// Links between encrypted directories are hidden in node data
+		nodes, err := s.getBranch(ctx, root, key)
+		if err != nil {
+			return false, err
+		}
+		for _, n := range nodes {
+			stack = append(stack, n.Cid())
+		}
+	} else {
+		stack = append(stack, root.Cid())
+	}
+	seen := cid.NewSet()
+	for len(stack) > 0 {
+		next := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if next.Equals(c) {
+			return true, nil
+		}
+		if !seen.Visit(next) || next.Prefix().Codec == cid.Raw {
+			continue
+		}
+		n, err := s.IPFSClient.Dag().Get(ctx, next)
+		if err != nil {
+			return false, err
+		}
+		for _, l := range n.Links() {
+			stack = append(stack, l.Cid)
+		}
+	}
+	return false, nil
+}
+
+// Diff returns the files that were added, modified, or removed since root.
+// Branches with matching cids are skipped, so only the changed parts of the bucket are traversed.
+func (s *Service) Diff(ctx context.Context, req *pb.DiffRequest) (*pb.DiffReply, error) {
+	log.Debugf("received diff request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	encKey := buck.GetEncKey()
+	to, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return nil, err
+	}
+	toNode, err := s.getNodeAtPath(ctx, to, encKey)
+	if err != nil {
+		return nil, err
+	}
+	var fromNode ipld.Node
+	if req.Root != "" {
+		from, err := util.NewResolvedPath(req.Root)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid root: %v", err)
+		}
+		fromNode, err = s.getNodeAtPath(ctx, from, encKey)
+		if err != nil {
+			return nil, status.Errorf(codes.NotFound, "Root data is not available: %v", err)
+		}
+	}
+	changes, err := s.diffNodes(ctx, "", fromNode, toNode, encKey)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DiffReply{
+		Changes: changes,
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+	}, nil
+}
+
+// diffNodes returns file-level changes between two decrypted nodes at pth.
+// Either node may be nil, in which case all files on the other side are added or removed.
+func (s *Service) diffNodes(ctx context.Context, pth string, from, to ipld.Node, key []byte) (changes []*pb.DiffReply_Change, err error) {
+	fromDir := from != nil && isDirNode(from)
+	toDir := to != nil && isDirNode(to)
+	if from != nil && !fromDir && (to == nil || toDir) {
+		changes = append(changes, &pb.DiffReply_Change{
+			Type: pb.DiffReply_Change_Remove,
+			Path: pth,
+			Cid:  from.Cid().String(),
+		})
+	}
+	if to != nil && !toDir {
+		change, err := fileChange(pth, to)
+		if err != nil {
+			return nil, err
+		}
+		if from != nil && !fromDir {
+			change.Type = pb.DiffReply_Change_Modify
+		}
+		changes = append(changes, change)
+	}
+	if !fromDir && !toDir {
+		return changes, nil
+	}
+
+	type linkPair struct {
+		name     string
+		from, to *ipld.Link
+	}
+	var pairs []*linkPair
+	names := make(map[string]*linkPair)
+	if toDir {
+		for _, l := range to.Links() {
+			if l.Name == "" {
+				break
+			}
+			lp := &linkPair{name: l.Name, to: l}
+			names[l.Name] = lp
+			pairs = append(pairs, lp)
+		}
+	}
+	if fromDir {
+		for _, l := range from.Links() {
+			if l.Name == "" {
+				break
+			}
+			if lp, ok := names[l.Name]; ok {
+				lp.from = l
+			} else {
+				pairs = append(pairs, &linkPair{name: l.Name, from: l})
+			}
+		}
+	}
+	for _, lp := range pairs {
+		p := gopath.Join(pth, lp.name)
+		if p == buckets.SeedName {
+			continue
+		}
+		if lp.from != nil && lp.to != nil && lp.from.Cid.Equals(lp.to.Cid) {
+			continue // Unchanged branch
+		}
+		var fn, tn ipld.Node
+		if lp.from != nil {
+			if fn, err = s.getNodeAtPath(ctx, path.IpfsPath(lp.from.Cid), key); err != nil {
+				return nil, err
+			}
+		}
+		if lp.to != nil {
+			if tn, err = s.getNodeAtPath(ctx, path.IpfsPath(lp.to.Cid), key); err != nil {
+				return nil, err
+			}
+		}
+		c, err := s.diffNodes(ctx, p, fn, tn, key)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, c...)
+	}
+	return changes, nil
+}
+
+// isDirNode returns whether or not the node has named links.
+func isDirNode(n ipld.Node) bool {
+	lnks := n.Links()
+	return len(lnks) > 0 && lnks[0].Name != ""
+}
+
+func fileChange(pth string, n ipld.Node) (*pb.DiffReply_Change, error) {
+	stat, err := n.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DiffReply_Change{
+		Type: pb.DiffReply_Change_Add,
+		Path: pth,
+		Cid:  n.Cid().String(),
+		Size: int64(stat.CumulativeSize),
+	}, nil
+}
+
+func (s *Service) Remove(ctx context.Context, req *pb.RemoveRequest) (*pb.RemoveReply, error) {
+	log.Debugf("received remove request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkLegalHold(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	buckPath, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return nil, err
+	}
+	encKey := buck.GetEncKey()
+	if encKey != nil {
+		if err = s.unpinNodeAndBranch(ctx, buckPath, encKey); err != nil {
+			return nil, err
+		}
+	} else {
+		if err = s.unpinPath(ctx, buckPath); err != nil {
+			return nil, err
+		}
+		s.updateContentRefs(ctx, nil, []path.Resolved{buckPath})
+		if err = s.Collections.RootPins.Untrack(ctx, buck.Key, buckPath.String()); err != nil {
+			return nil, err
+		}
+	}
+	if err = s.Buckets.Delete(ctx, dbID, buck.Key, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if err = s.IPNSManager.RemoveKey(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.Tags.Delete(ctx, mdb.BucketResource, buck.Key); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	if err = s.Collections.WebConfigs.Delete(ctx, buck.Key); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	if err = s.Collections.BucketVersions.DeleteByBucket(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.removeSnapshots(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.BucketLicenses.DeleteByBucket(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.BucketLifecycles.Delete(ctx, buck.Key); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	if err = s.Collections.ReplicationTargets.DeleteByBucket(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.BucketImports.DeleteByBucket(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.PinMirrors.DeleteByBucket(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.Domains.DeleteByBucket(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.ShareLinks.DeleteByBucket(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.Webhooks.DeleteByBucket(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.WebhookDeliveries.DeleteByBucket(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.SearchIndex.DeleteByBucket(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.SearchIndexStates.Delete(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.Thumbnails.DeleteByBucket(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.ThumbnailStates.Delete(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("removed bucket: %s", buck.Key)
+	return &pb.RemoveReply{}, nil
+}
+
+func (s *Service) unpinNodeAndBranch(ctx context.Context, pth path.Resolved, key []byte) error {
+	if err := s.unpinBranch(ctx, pth, key); err != nil {
+		return err
+	}
+	return s.unpinPath(ctx, pth)
+}
+
+func (s *Service) RemovePath(ctx context.Context, req *pb.RemovePathRequest) (*pb.RemovePathReply, error) {
+	log.Debugf("received remove path request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	filePath, err := parsePath(req.Path)
+	if err != nil {
+		return nil, err
+	}
+	buck := &tdb.Bucket{}
+	err = s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	if err = checkBaseRoot(buck, req.Root); err != nil {
+		return nil, err
+	}
+	if err = s.checkLegalHold(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.checkRemoveConflict(ctx, buck, req.Root, filePath); err != nil {
+		return nil, err
+	}
+	policy, err := s.getPushPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if v := checkRequiredPathsRemoved(policy, filePath); len(v) > 0 {
+		return nil, pushRejected(v)
+	}
+
+	buckPath := path.New(buck.Path)
+	encKey := buck.GetEncKey()
+	var dirpth, old path.Resolved
+	if encKey != nil {
+		dirpth, err = s.removeNodeAtPath(ctx, path.Join(path.New(buck.Path), filePath), encKey)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		old = s.existingPath(ctx, buck, filePath)
+		dirpth, err = s.IPFSClient.Object().RmLink(ctx, buckPath, filePath)
+		if err != nil {
+			return nil, err
+		}
+		if err = s.updateOrAddPin(ctx, buckPath, dirpth); err != nil {
+			return nil, err
+		}
+	}
+
+	buck.UnsetMetadataWithPrefix(filePath)
+	buck.Path = dirpth.String()
+	buck.UpdatedAt = time.Now().UnixNano()
+	if err = s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	s.trackRootPin(ctx, dbID, dbToken, buck, buckPath.String())
+	if filePath == buckets.RedirectsName {
+		s.compileRedirects(ctx, buck)
+	}
+	s.recordVersion(ctx, buck, req.Message)
+	s.markReplicationPending(ctx, buck.Key)
+	s.markPinMirrorsPending(ctx, buck.Key)
+	s.markDomainsPending(ctx, buck.Key)
+	s.markIndexPending(ctx, dbID, dbToken, buck.Key)
+	s.countArchiveChange(ctx, buck.Key)
+	s.publishEvent(ctx, webhooks.Event{
+		Type:      webhooks.PathRemoved,
+		BucketKey: buck.Key,
+		Thread:    dbID.String(),
+		Path:      filePath,
+		Root:      buck.Path,
+		Message:   req.Message,
+	})
+	s.publishRootChanged(ctx, dbID, buck, req.Message)
+	if old != nil {
+		s.updateContentRefs(ctx, nil, []path.Resolved{old})
+	}
+
+	go s.IPNSManager.Publish(dirpth, buck.Key)
+
+	log.Debugf("removed %s from bucket: %s", filePath, buck.Key)
+	return &pb.RemovePathReply{
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+	}, nil
+}
+
+func (s *Service) MovePath(ctx context.Context, req *pb.MovePathRequest) (*pb.MovePathReply, error) {
+	log.Debugf("received move path request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	fromPath, err := parsePath(req.FromPath)
+	if err != nil {
+		return nil, err
+	}
+	toPath, err := parsePath(req.ToPath)
+	if err != nil {
+		return nil, err
+	}
+	fromPath, toPath = strings.TrimSuffix(fromPath, "/"), strings.TrimSuffix(toPath, "/")
+	if fromPath == "" || toPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "From and to paths are required")
+	}
+	if fromPath == toPath || strings.HasPrefix(toPath, fromPath+"/") {
+		return nil, status.Error(codes.InvalidArgument, "Cannot move a path to itself or below itself")
+	}
+	buck := &tdb.Bucket{}
+	err = s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	if err = checkBaseRoot(buck, req.Root); err != nil {
+		return nil, err
+	}
+	if err = s.checkLegalHold(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	if err = s.checkRemoveConflict(ctx, buck, req.Root, fromPath); err != nil {
+		return nil, err
+	}
+	policy, err := s.getPushPolicy(ctx)
+	if err != nil {
+		return nil, err
+	}
+	violations := checkRequiredPathsRemoved(policy, fromPath)
+	violations = append(violations, checkForbiddenExtension(policy, toPath)...)
+	if len(violations) > 0 {
+		return nil, pushRejected(violations)
+	}
+
+	buckPath := path.New(buck.Path)
+	base, err := s.IPFSClient.ResolvePath(ctx, buckPath)
+	if err != nil {
+		return nil, err
+	}
+	encKey := buck.GetEncKey()
+	np, r, err := s.getNodesToPath(ctx, base, fromPath, encKey)
+	if err != nil {
+		return nil, err
+	}
+	if r != "" {
+		return nil, status.Errorf(codes.NotFound, "Path %s not found", fromPath)
+	}
+	child := np[len(np)-1].old
+	if _, r, err = s.getNodesToPath(ctx, base, toPath, encKey); err != nil {
+		return nil, err
+	}
+	if r == "" {
+		return nil, status.Errorf(codes.AlreadyExists, "Path %s already exists", toPath)
+	}
+
+	var dirpth path.Resolved
+	if encKey != nil {
+		// The encrypted nodes are moved as-is. Removing the source path unpins
+		// the whole branch, so the nodes below the moved node are re-pinned.
+		cn, err := s.IPFSClient.Dag().Get(ctx, child.Cid())
+		if err != nil {
+			return nil, err
+		}
+		branch, err := s.getBranchNodes(ctx, child, encKey)
+		if err != nil {
+			return nil, err
+		}
+		dirpth, err = s.removeNodeAtPath(ctx, path.Join(buckPath, fromPath), encKey)
+		if err != nil {
+			return nil, err
+		}
+		dirpth, err = s.insertNodeAtPath(ctx, cn, path.Join(dirpth, toPath), encKey)
+		if err != nil {
+			return nil, err
+		}
+		if err = s.pinBlocks(ctx, branch); err != nil {
+			return nil, err
+		}
+	} else {
+		dirpth, err = s.IPFSClient.Object().RmLink(ctx, buckPath, fromPath)
+		if err != nil {
+			return nil, err
+		}
+		dirpth, err = s.IPFSClient.Object().AddLink(ctx, dirpth, toPath, child, options.Object.Create(true))
+		if err != nil {
+			return nil, err
+		}
+		if err = s.updateOrAddPin(ctx, buckPath, dirpth); err != nil {
+			return nil, err
+		}
+	}
+
+	buck.MoveMetadataWithPrefix(fromPath, toPath)
+	buck.Path = dirpth.String()
+	buck.UpdatedAt = time.Now().UnixNano()
+	if err = s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	s.trackRootPin(ctx, dbID, dbToken, buck, buckPath.String())
+	if fromPath == buckets.RedirectsName || toPath == buckets.RedirectsName {
+		s.compileRedirects(ctx, buck)
+	}
+	s.recordVersion(ctx, buck, req.Message)
+	s.markReplicationPending(ctx, buck.Key)
+	s.markPinMirrorsPending(ctx, buck.Key)
+	s.markDomainsPending(ctx, buck.Key)
+	s.markIndexPending(ctx, dbID, dbToken, buck.Key)
+	s.countArchiveChange(ctx, buck.Key)
+	s.publishEvent(ctx, webhooks.Event{
+		Type:      webhooks.PathMoved,
+		BucketKey: buck.Key,
+		Thread:    dbID.String(),
+		Path:      toPath,
+		From:      fromPath,
+		Cid:       child.Cid().String(),
+		Root:      buck.Path,
+		Message:   req.Message,
+	})
+	s.publishRootChanged(ctx, dbID, buck, req.Message)
+
+	go s.IPNSManager.Publish(dirpth, buck.Key)
+
+	log.Debugf("moved %s to %s in bucket: %s", fromPath, toPath, buck.Key)
+	return &pb.MovePathReply{
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+	}, nil
+}
+
+// getBranchNodes returns the named nodes below the node at path, decrypting (if needed) to walk the branch.
+// The returned nodes are not decrypted.
+func (s *Service) getBranchNodes(ctx context.Context, p path.Resolved, key []byte) ([]ipld.Node, error) {
+	n, err := s.getNodeAtPath(ctx, p, key)
+	if err != nil {
+		return nil, err
+	}
+	var nodes []ipld.Node
+	for _, l := range n.Links() {
+		if l.Name == "" {
+			continue // Data nodes will never be pinned directly
+		}
+		ln, err := s.IPFSClient.Dag().Get(ctx, l.Cid)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, ln)
+		branch, err := s.getBranchNodes(ctx, path.IpfsPath(l.Cid), key)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, branch...)
+	}
+	return nodes, nil
+}
+
+// removeNodeAtPath removes node at the location of path.
+// Key will be required if the path is encrypted.
+func (s *Service) removeNodeAtPath(ctx context.Context, pth path.Path, key []byte) (path.Resolved, error) {
+	// The first step here is find a resolvable list of nodes that point to path.
+	rp, fp, err := util.ParsePath(pth)
+	if err != nil {
+		return nil, err
+	}
+	np, r, err := s.getNodesToPath(ctx, rp, fp, key)
+	if err != nil {
+		return nil, err
+	}
+	// If the remaining path segment is not empty, then we conclude that
+	// the node cannot be resolved.
+	if r != "" {
+		return nil, fmt.Errorf("could not resolve path: %s", pth)
+	}
+	np[len(np)-1].isJoint = true
+
+	// Now, we have a full list of nodes to the insert location,
+	// but the existing nodes need to be updated and re-encrypted.
+	change := make([]ipld.Node, len(np)-1)
+	for i := len(np) - 1; i >= 0; i-- {
+		if i < len(np)-1 {
+			change[i] = np[i].new
+		}
+		if i > 0 {
+			p, ok := np[i-1].new.(*dag.ProtoNode)
+			if !ok {
+				return nil, dag.ErrNotProtobuf
 			}
-			switch payload := req.Payload.(type) {
-			case *pb.PushPathRequest_Chunk:
-				n, err := writer.Write(payload.Chunk)
-				if err != nil {
-					sendErr(fmt.Errorf("error writing chunk: %v", err))
-					return
-				}
-				cummSize += int64(n)
-				if s.BucketsMaxSize > 0 && currentSize+cummSize > s.BucketsMaxSize {
-					sendErr(ErrBucketExceedsMaxSize)
+			if err := p.RemoveNodeLink(np[i].name); err != nil {
+				return nil, err
+			}
+			if !np[i].isJoint {
+				if err := p.AddNodeLink(np[i].name, np[i].new); err != nil {
+					return nil, err
 				}
-			default:
-				sendErr(fmt.Errorf("invalid request"))
-				return
+			}
+			np[i-1].new, err = encryptNode(p, key)
+			if err != nil {
+				return nil, err
 			}
 		}
-	}()
+	}
 
-	eventCh := make(chan interface{})
-	defer close(eventCh)
-	chSize := make(chan string)
-	go func() {
-		for e := range eventCh {
-			event, ok := e.(*iface.AddEvent)
-			if !ok {
-				log.Error("unexpected event type")
-				continue
+	// Add all the changed nodes
+	if err := s.IPFSClient.Dag().AddMany(ctx, change); err != nil {
+		return nil, err
+	}
+	// Update / remove node pins
+	for _, n := range np {
+		if n.isJoint {
+			if err = s.unpinNodeAndBranch(ctx, n.old, key); err != nil {
+				return nil, err
 			}
-			if event.Path == nil { // This is a progress event
-				if err := sendEvent(&pb.PushPathReply_Event{
-					Name:  event.Name,
-					Bytes: event.Bytes,
-				}); err != nil {
-					log.Errorf("error sending event: %v", err)
-				}
-			} else {
-				chSize <- event.Size // Save size for use in the final response
+		} else {
+			if err = s.updateOrAddPin(ctx, n.old, path.IpfsPath(n.new.Cid())); err != nil {
+				return nil, err
 			}
 		}
-	}()
+	}
+	return path.IpfsPath(np[0].new.Cid()), nil
+}
 
-	var r io.Reader
-	encKey := buck.GetEncKey()
-	if encKey != nil {
-		r, err = dcrypto.NewEncrypter(reader, encKey)
+// recordVersion adds the current root of the bucket to its version history.
+func (s *Service) recordVersion(ctx context.Context, buck *tdb.Bucket, message string) {
+	if _, err := s.Collections.BucketVersions.Create(ctx, buck.Key, buck.Path, authorFromContext(ctx), message); err != nil {
+		log.Errorf("recording version of bucket %s: %v", buck.Key, err)
+	}
+}
+
+// trackRootPin records that the current root of the bucket is pinned.
+// The pin of the previous root old was moved to the current root, so old is no longer tracked.
+// Nodes of private buckets are pinned individually, so their roots are not tracked.
+func (s *Service) trackRootPin(ctx context.Context, dbID thread.ID, dbToken thread.Token, buck *tdb.Bucket, old string) {
+	if buck.GetEncKey() != nil {
+		return
+	}
+	if err := s.Collections.RootPins.Track(ctx, buck.Key, buck.Path, dbID, dbToken); err != nil {
+		log.Errorf("tracking root pin of bucket %s: %v", buck.Key, err)
+	}
+	if old == "" || old == buck.Path {
+		return
+	}
+	if err := s.Collections.RootPins.Untrack(ctx, buck.Key, old); err != nil {
+		log.Errorf("untracking root pin of bucket %s: %v", buck.Key, err)
+	}
+}
+
+// CollectPins unpins tracked bucket roots that were pinned before t and are no longer referenced.
+// A root is referenced if it's the current root of its bucket or the root of one of the bucket's
+// versions, snapshots, or archives. Roots of removed buckets are never referenced.
+// If dryRun is true, unreferenced roots are logged but not unpinned.
+// The returned paths are the unreferenced roots.
+func (s *Service) CollectPins(ctx context.Context, t time.Time, dryRun bool) ([]string, error) {
+	list, err := s.Collections.RootPins.ListBefore(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+	var collected []string
+	for _, p := range list {
+		if ctx.Err() != nil {
+			return collected, ctx.Err()
+		}
+		refs, err := s.rootPinRefs(ctx, p)
 		if err != nil {
-			return err
+			log.Errorf("counting references of root %s of bucket %s: %v", p.Path, p.BucketKey, err)
+			continue
 		}
-	} else {
-		r = reader
+		if refs > 0 {
+			continue
+		}
+		collected = append(collected, p.Path)
+		if dryRun {
+			log.Infof("pin gc dry run: root %s of bucket %s is unreferenced", p.Path, p.BucketKey)
+			continue
+		}
+		if err := s.IPFSClient.Pin().Rm(ctx, path.New(p.Path)); err != nil && !strings.Contains(err.Error(), notPinnedMsg) {
+			return collected, fmt.Errorf("unpinning root %s of bucket %s: %v", p.Path, p.BucketKey, err)
+		}
+		if err := s.Collections.RootPins.Untrack(ctx, p.BucketKey, p.Path); err != nil {
+			return collected, err
+		}
+		log.Infof("pin gc: unpinned root %s of bucket %s", p.Path, p.BucketKey)
 	}
+	return collected, nil
+}
 
-	pth, err := s.IPFSClient.Unixfs().Add(
-		server.Context(),
-		ipfsfiles.NewReaderFile(r),
-		options.Unixfs.CidVersion(1),
-		options.Unixfs.Pin(false),
-		options.Unixfs.Progress(true),
-		options.Unixfs.Events(eventCh))
+// rootPinRefs returns the number of references to a tracked bucket root.
+func (s *Service) rootPinRefs(ctx context.Context, p mdb.RootPin) (int, error) {
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, p.DbID, p.BucketKey, buck, tdb.WithToken(p.DbToken)); err != nil {
+		msg := err.Error()
+		if strings.Contains(msg, db.ErrInstanceNotFound.Error()) ||
+			strings.Contains(msg, db.ErrDBNotFound.Error()) ||
+			strings.Contains(msg, lstore.ErrThreadNotFound.Error()) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	var refs int
+	if buck.Path == p.Path {
+		refs++
+	}
+	versions, err := s.Collections.BucketVersions.List(ctx, p.BucketKey, 0)
+	if err != nil {
+		return 0, err
+	}
+	for _, v := range versions {
+		if v.Path == p.Path {
+			refs++
+		}
+	}
+	snapshots, err := s.Collections.BucketSnapshots.List(ctx, p.BucketKey)
+	if err != nil {
+		return 0, err
+	}
+	for _, snapshot := range snapshots {
+		if snapshot.Path == p.Path {
+			refs++
+		}
+	}
+	ffsi, err := s.Collections.FFSInstances.Get(ctx, p.BucketKey)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return refs, nil
+	} else if err != nil {
+		return 0, err
+	}
+	root, err := util.NewResolvedPath(p.Path)
+	if err != nil {
+		return 0, err
+	}
+	for _, a := range append(ffsi.Archives.History, ffsi.Archives.Current) {
+		if c, err := cid.Cast(a.Cid); err == nil && c.Equals(root.Cid()) {
+			refs++
+		}
+	}
+	return refs, nil
+}
+
+func (s *Service) ListVersions(ctx context.Context, req *pb.ListVersionsRequest) (*pb.ListVersionsReply, error) {
+	log.Debugf("received list versions request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if req.Limit < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Limit must not be negative")
+	}
+	list, err := s.Collections.BucketVersions.List(ctx, buck.Key, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]*pb.Version, len(list))
+	for i, v := range list {
+		versions[i] = &pb.Version{
+			ID:        v.ID,
+			Path:      v.Path,
+			Author:    v.Author,
+			Message:   v.Message,
+			CreatedAt: v.CreatedAt.UnixNano(),
+		}
+	}
+	return &pb.ListVersionsReply{Versions: versions}, nil
+}
+
+func (s *Service) RestoreVersion(ctx context.Context, req *pb.RestoreVersionRequest) (*pb.RestoreVersionReply, error) {
+	log.Debugf("received restore version request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if req.Root != "" && req.Root != buck.Path {
+		return nil, status.Error(codes.FailedPrecondition, buckets.ErrNonFastForward.Error())
+	}
+	if err := s.checkLegalHold(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	version, err := s.Collections.BucketVersions.Get(ctx, buck.Key, req.ID)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Version not found")
+		}
+		return nil, err
+	}
+	if err = s.restoreRoot(ctx, dbID, dbToken, buck, version.Path, fmt.Sprintf("restore version %s", version.ID)); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("restored bucket %s to version %s", buck.Key, version.ID)
+	return &pb.RestoreVersionReply{
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+	}, nil
+}
+
+func (s *Service) ListHistory(ctx context.Context, req *pb.ListHistoryRequest) (*pb.ListHistoryReply, error) {
+	log.Debugf("received list history request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultHistoryPageSize
+	} else if limit > maxHistoryPageSize {
+		limit = maxHistoryPageSize
+	}
+	// Fetch one extra version to find out if there's another page.
+	list, err := s.Collections.BucketVersions.ListBefore(ctx, buck.Key, req.Cursor, limit+1)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "Invalid cursor")
+	}
+	snapshots, err := s.Collections.BucketSnapshots.List(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string][]string)
+	for _, snapshot := range snapshots {
+		names[snapshot.Path] = append(names[snapshot.Path], snapshot.Name)
+	}
+	reply := &pb.ListHistoryReply{}
+	if int64(len(list)) > limit {
+		list = list[:limit]
+		reply.NextCursor = list[limit-1].ID
+	}
+	reply.Entries = make([]*pb.ListHistoryReply_Entry, len(list))
+	for i, v := range list {
+		reply.Entries[i] = &pb.ListHistoryReply_Entry{
+			VersionID: v.ID,
+			Path:      v.Path,
+			Author:    v.Author,
+			Message:   v.Message,
+			CreatedAt: v.CreatedAt.UnixNano(),
+			Snapshots: names[v.Path],
+		}
+	}
+	return reply, nil
+}
+
+// restoreRoot sets the root of the bucket to pth and records the change as a new version.
+func (s *Service) restoreRoot(ctx context.Context, dbID thread.ID, dbToken thread.Token, buck *tdb.Bucket, pth, message string) error {
+	if pth == buck.Path {
+		return nil
+	}
+	if err := s.setRoot(ctx, buck, path.New(pth)); err != nil {
+		return err
+	}
+	old := buck.Path
+	buck.Path = pth
+	buck.UpdatedAt = time.Now().UnixNano()
+	if err := s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
+		return err
+	}
+	s.trackRootPin(ctx, dbID, dbToken, buck, old)
+	s.compileRedirects(ctx, buck)
+	s.recordVersion(ctx, buck, message)
+	s.markReplicationPending(ctx, buck.Key)
+	s.markPinMirrorsPending(ctx, buck.Key)
+	s.markDomainsPending(ctx, buck.Key)
+	s.markIndexPending(ctx, dbID, dbToken, buck.Key)
+	s.countArchiveChange(ctx, buck.Key)
+	s.publishRootChanged(ctx, dbID, buck, message)
+
+	if root, err := util.NewResolvedPath(buck.Path); err == nil {
+		go s.IPNSManager.Publish(root, buck.Key)
+	}
+	return nil
+}
+
+func (s *Service) SnapshotBucket(ctx context.Context, req *pb.SnapshotBucketRequest) (*pb.SnapshotBucketReply, error) {
+	log.Debugf("received snapshot bucket request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "Snapshot name is required")
+	}
+	if _, err := s.Collections.BucketSnapshots.Get(ctx, buck.Key, name); err == nil {
+		return nil, status.Errorf(codes.AlreadyExists, "Snapshot %s already exists", name)
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	pinPath, size, err := s.pinSnapshot(ctx, buck)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	fn, err := s.IPFSClient.ResolveNode(server.Context(), pth)
+	snapshot, err := s.Collections.BucketSnapshots.Create(ctx, mdb.BucketSnapshot{
+		BucketKey:   buck.Key,
+		Name:        name,
+		Description: req.Description,
+		Path:        buck.Path,
+		PinPath:     pinPath.String(),
+		Size:        size,
+		Author:      authorFromContext(ctx),
+	})
 	if err != nil {
-		return err
+		if err := s.unpinPath(ctx, pinPath); err != nil {
+			log.Errorf("unpinning snapshot %s of bucket %s: %v", name, buck.Key, err)
+		}
+		if strings.Contains(err.Error(), mdb.DuplicateErrMsg) {
+			return nil, status.Errorf(codes.AlreadyExists, "Snapshot %s already exists", name)
+		}
+		return nil, err
 	}
 
-	var dirpth path.Resolved
-	if encKey != nil {
-		dirpth, err = s.insertNodeAtPath(server.Context(), fn, path.Join(buckPath, filePath), encKey)
+	log.Debugf("created snapshot %s of bucket %s", name, buck.Key)
+	return &pb.SnapshotBucketReply{Snapshot: snapshotToPb(snapshot)}, nil
+}
+
+// pinSnapshot pins the data under the root of the bucket with a new node,
+// which keeps the data available after the bucket changes.
+// The returned size is added to the buckets total size of the account or user.
+func (s *Service) pinSnapshot(ctx context.Context, buck *tdb.Bucket) (path.Resolved, int64, error) {
+	root, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return nil, 0, err
+	}
+	var nodes []ipld.Node
+	if encKey := buck.GetEncKey(); encKey != nil {
+		// Links in encrypted nodes are not visible, so each node in the branch is linked.
+		nodes, err = s.getBranch(ctx, root, encKey)
 		if err != nil {
-			return err
+			return nil, 0, err
 		}
 	} else {
-		dirpth, err = s.IPFSClient.Object().AddLink(server.Context(), buckPath, filePath, pth, options.Object.Create(true))
+		n, err := s.IPFSClient.Dag().Get(ctx, root.Cid())
 		if err != nil {
-			return err
+			return nil, 0, err
 		}
-		if err = s.updateOrAddPin(server.Context(), buckPath, dirpth); err != nil {
-			return err
+		nodes = []ipld.Node{n}
+	}
+	pn := unixfs.EmptyDirNode()
+	for i, n := range nodes {
+		if err := pn.AddNodeLink(strconv.Itoa(i), n); err != nil {
+			return nil, 0, err
 		}
 	}
+	if err := s.pinBlocks(ctx, []ipld.Node{pn}); err != nil {
+		return nil, 0, err
+	}
+	stat, err := pn.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+	return path.IpfsPath(pn.Cid()), int64(stat.CumulativeSize), nil
+}
 
-	buck.Path = dirpth.String()
-	buck.UpdatedAt = time.Now().UnixNano()
-	if err = s.Buckets.SaveSafe(server.Context(), dbID, buck, tdb.WithToken(dbToken)); err != nil {
-		return err
+func (s *Service) ListSnapshots(ctx context.Context, req *pb.ListSnapshotsRequest) (*pb.ListSnapshotsReply, error) {
+	log.Debugf("received list snapshots request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	list, err := s.Collections.BucketSnapshots.List(ctx, buck.Key)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]*pb.Snapshot, len(list))
+	for i, snapshot := range list {
+		snapshots[i] = snapshotToPb(&snapshot)
 	}
+	return &pb.ListSnapshotsReply{Snapshots: snapshots}, nil
+}
 
-	size := <-chSize
-	if err = sendEvent(&pb.PushPathReply_Event{
-		Path: pth.String(),
-		Size: size,
+func (s *Service) RestoreSnapshot(ctx context.Context, req *pb.RestoreSnapshotRequest) (*pb.RestoreSnapshotReply, error) {
+	log.Debugf("received restore snapshot request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if req.Root != "" && req.Root != buck.Path {
+		return nil, status.Error(codes.FailedPrecondition, buckets.ErrNonFastForward.Error())
+	}
+	if err := s.checkLegalHold(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	snapshot, err := s.Collections.BucketSnapshots.Get(ctx, buck.Key, req.Name)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Snapshot not found")
+		}
+		return nil, err
+	}
+	if err = s.restoreRoot(ctx, dbID, dbToken, buck, snapshot.Path, fmt.Sprintf("restore snapshot %s", snapshot.Name)); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("restored bucket %s to snapshot %s", buck.Key, snapshot.Name)
+	return &pb.RestoreSnapshotReply{
 		Root: &pb.Root{
 			Key:       buck.Key,
 			Name:      buck.Name,
@@ -1065,354 +7199,679 @@ func (s *Service) PushPath(server pb.API_PushPathServer) error {
 			CreatedAt: buck.CreatedAt,
 			UpdatedAt: buck.UpdatedAt,
 		},
-	}); err != nil {
+	}, nil
+}
+
+func (s *Service) RemoveSnapshot(ctx context.Context, req *pb.RemoveSnapshotRequest) (*pb.RemoveSnapshotReply, error) {
+	log.Debugf("received remove snapshot request")
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	if err := s.checkLegalHold(ctx, buck.Key); err != nil {
+		return nil, err
+	}
+	snapshot, err := s.Collections.BucketSnapshots.Get(ctx, buck.Key, req.Name)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Snapshot not found")
+		}
+		return nil, err
+	}
+	if err = s.unpinPath(ctx, path.New(snapshot.PinPath)); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.BucketSnapshots.Delete(ctx, buck.Key, snapshot.Name); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("removed snapshot %s of bucket %s", snapshot.Name, buck.Key)
+	return &pb.RemoveSnapshotReply{}, nil
+}
+
+// removeSnapshots unpins and deletes all snapshots of a bucket.
+func (s *Service) removeSnapshots(ctx context.Context, key string) error {
+	list, err := s.Collections.BucketSnapshots.List(ctx, key)
+	if err != nil {
 		return err
 	}
+	for _, snapshot := range list {
+		if err = s.unpinPath(ctx, path.New(snapshot.PinPath)); err != nil {
+			return err
+		}
+	}
+	return s.Collections.BucketSnapshots.DeleteByBucket(ctx, key)
+}
 
-	go s.IPNSManager.Publish(dirpth, buck.Key)
+func snapshotToPb(snapshot *mdb.BucketSnapshot) *pb.Snapshot {
+	return &pb.Snapshot{
+		Name:        snapshot.Name,
+		Description: snapshot.Description,
+		Path:        snapshot.Path,
+		Author:      snapshot.Author,
+		CreatedAt:   snapshot.CreatedAt.UnixNano(),
+	}
+}
 
-	log.Debugf("pushed %s to bucket: %s", filePath, buck.Key)
-	return nil
+// setRoot moves the pins of the bucket from its current root to root.
+// The data at root must still be available to the IPFS node.
+// Changes that break the org's push policy are rejected.
+func (s *Service) setRoot(ctx context.Context, buck *tdb.Bucket, root path.Path) error {
+	encKey := buck.GetEncKey()
+	policy, err := s.getPushPolicy(ctx)
+	if err != nil {
+		return err
+	}
+	if v := s.checkRequiredPaths(ctx, policy, root, encKey); len(v) > 0 {
+		return pushRejected(v)
+	}
+	rootResolved, err := s.IPFSClient.ResolvePath(ctx, root)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "Version data is not available: %v", err)
+	}
+	buckPath := path.New(buck.Path)
+	if encKey == nil {
+		return s.updateOrAddPin(ctx, buckPath, rootResolved)
+	}
+	nodes, err := s.getBranch(ctx, rootResolved, encKey)
+	if err != nil {
+		return status.Errorf(codes.NotFound, "Version data is not available: %v", err)
+	}
+	buckPathResolved, err := s.IPFSClient.ResolvePath(ctx, buckPath)
+	if err != nil {
+		return err
+	}
+	if err = s.unpinNodeAndBranch(ctx, buckPathResolved, encKey); err != nil {
+		return err
+	}
+	return s.pinBlocks(ctx, nodes)
 }
 
-// insertNodeAtPath inserts a node at the location of path.
-// Key will be required if the path is encrypted.
-func (s *Service) insertNodeAtPath(ctx context.Context, child ipld.Node, pth path.Path, key []byte) (path.Resolved, error) {
-	// The first step here is find a resolvable list of nodes that point to path.
-	rp, fp, err := util.ParsePath(pth)
+// getBranch returns the encrypted node at p along with all named nodes in its branch.
+func (s *Service) getBranch(ctx context.Context, p path.Resolved, key []byte) ([]ipld.Node, error) {
+	n, err := s.IPFSClient.Dag().Get(ctx, p.Cid())
 	if err != nil {
 		return nil, err
 	}
-	fd, fn := gopath.Split(fp)
-	fd = strings.TrimSuffix(fd, "/")
-	np, r, err := s.getNodesToPath(ctx, rp, fd, key)
+	nodes := []ipld.Node{n}
+	dn, err := s.getNodeAtPath(ctx, p, key)
 	if err != nil {
 		return nil, err
 	}
-	r = gopath.Join(r, fn)
+	for _, l := range dn.Links() {
+		if l.Name == "" {
+			continue // Data nodes will never be pinned directly
+		}
+		branch, err := s.getBranch(ctx, path.IpfsPath(l.Cid), key)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, branch...)
+	}
+	return nodes, nil
+}
 
-	// If the remaining path segment is not empty, we need to create each one
-	// starting at the other end and walking back up to the deepest node
-	// in the node path.
-	parts := strings.Split(r, "/")
-	news := make([]ipld.Node, len(parts)-1)
-	cn := child
-	for i := len(parts) - 1; i > 0; i-- {
-		n, err := newDirWithNode(cn, parts[i], key)
+func (s *Service) Archive(ctx context.Context, req *pb.ArchiveRequest) (*pb.ArchiveReply, error) {
+	log.Debug("received archive request")
+
+	if !s.Buckets.IsArchivingEnabled() {
+		return nil, ErrArchivingFeatureDisabled
+	}
+
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
+	if err != nil {
+		return nil, err
+	}
+	p, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return nil, fmt.Errorf("parsing cid path: %s", err)
+	}
+
+	ffsi, err := s.Collections.FFSInstances.Get(ctx, req.GetKey())
+	if err != nil {
+		return nil, fmt.Errorf("getting ffs instance data: %s", err)
+	}
+
+	ctxFFS := context.WithValue(ctx, powc.AuthKey, ffsi.FFSToken)
+
+	pushOpts := []powc.PushStorageConfigOption{powc.WithOverride(true)}
+	if req.Options != nil {
+		conf, err := s.archiveStorageConfig(ctxFFS, req.Options)
 		if err != nil {
 			return nil, err
 		}
-		news[i-1] = cn
-		cn = n
+		pushOpts = append(pushOpts, powc.WithStorageConfig(conf))
 	}
-	np = append(np, pathNode{new: cn, name: parts[0], isJoint: true})
 
-	// Now, we have a full list of nodes to the insert location,
-	// but the existing nodes need to be updated and re-encrypted.
-	change := make([]ipld.Node, len(np))
-	for i := len(np) - 1; i >= 0; i-- {
-		change[i] = np[i].new
-		if i > 0 {
-			p, ok := np[i-1].new.(*dag.ProtoNode)
-			if !ok {
-				return nil, dag.ErrNotProtobuf
-			}
-			if np[i].isJoint {
-				xn, err := p.GetLinkedNode(ctx, s.IPFSClient.Dag(), np[i].name)
-				if err != nil && !errors.Is(err, dag.ErrLinkNotFound) {
-					return nil, err
-				}
-				if xn != nil {
-					np[i].old = path.IpfsPath(xn.Cid())
-					if err := p.RemoveNodeLink(np[i].name); err != nil {
-						return nil, err
-					}
-				}
-			} else {
-				xl, err := p.GetNodeLink(np[i].name)
-				if err != nil && !errors.Is(err, dag.ErrLinkNotFound) {
-					return nil, err
-				}
-				if xl != nil {
-					if err := p.RemoveNodeLink(np[i].name); err != nil {
-						return nil, err
-					}
+	// Check that FFS wallet addr balance is > 0, if not, fail fast.
+	bal, err := s.PGClient.Wallet.Balance(ctx, ffsi.WalletAddr)
+	if err != nil {
+		return nil, fmt.Errorf("getting ffs wallet address balance: %s", err)
+	}
+	if bal == 0 {
+		return nil, buckets.ErrZeroBalance
+	}
+
+	var jid ffs.JobID
+	firstTimeArchive := ffsi.Archives.Current.JobID == ""
+	if firstTimeArchive || ffsi.Archives.Current.Aborted { // Case 0.
+		// On the first archive, we simply push the Cid with the default CidConfig
+		// configured at bucket creation, or the config from the request options.
+		jid, err = s.PGClient.FFS.PushStorageConfig(ctxFFS, p.Cid(), pushOpts...)
+		if err != nil {
+			return nil, fmt.Errorf("pushing config: %s", err)
+		}
+	} else {
+		oldCid, err := cid.Cast(ffsi.Archives.Current.Cid)
+		if err != nil {
+			return nil, fmt.Errorf("parsing old Cid archive: %s", err)
+		}
+
+		// Archive pushes the current root Cid to the corresponding FFS instance of the bucket.
+		// The behaviour changes depending on different cases, depending on a previous archive.
+		// 0. No previous archive or last one aborted: simply pushes the Cid to the FFS instance.
+		// 1. Last archive exists with the same Cid:
+		//   a. Last archive Successful: fails, there's nothing to do.
+		//   b. Last archive Executing/Queued: fails, that work already starting and is in progress.
+		//   c. Last archive Failed/Canceled: work to do, push again with override flag to try again.
+		// 2. Archiving on new Cid: work to do, it will call Replace(,) in the FFS instance,
+		//    or push the new Cid if the request has options.
+		if oldCid.Equals(p.Cid()) { // Case 1.
+			switch ffs.JobStatus(ffsi.Archives.Current.JobStatus) {
+			// Case 1.a.
+			case ffs.Success:
+				return nil, fmt.Errorf("the same bucket cid is already archived successfully")
+			// Case 1.b.
+			case ffs.Executing, ffs.Queued:
+				return nil, fmt.Errorf("there is an in progress archive")
+			// Case 1.c.
+			case ffs.Failed, ffs.Canceled:
+				jid, err = s.PGClient.FFS.PushStorageConfig(ctxFFS, p.Cid(), pushOpts...)
+				if err != nil {
+					return nil, fmt.Errorf("pushing config: %s", err)
 				}
+			default:
+				return nil, fmt.Errorf("unexpected current archive status: %d", ffsi.Archives.Current.JobStatus)
 			}
-			if err := p.AddNodeLink(np[i].name, np[i].new); err != nil {
-				return nil, err
+		} else if req.Options == nil { // Case 2.
+			jid, err = s.PGClient.FFS.Replace(ctxFFS, oldCid, p.Cid())
+			if err != nil {
+				return nil, fmt.Errorf("replacing cid: %s", err)
 			}
-			np[i-1].new, err = encryptNode(p, key)
+		} else { // Case 2 with options.
+			// Replace reuses the config of the old Cid, so the new config is pushed instead.
+			// The old Cid stays tracked by the FFS instance with its own config.
+			jid, err = s.PGClient.FFS.PushStorageConfig(ctxFFS, p.Cid(), pushOpts...)
 			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("pushing config: %s", err)
 			}
 		}
+
+		// Include the existing archive in history,
+		// since we're going to set a new _current_ archive.
+		ffsi.Archives.History = append(ffsi.Archives.History, ffsi.Archives.Current)
+	}
+	ffsi.Archives.Current = mdb.Archive{
+		Cid:       p.Cid().Bytes(),
+		CreatedAt: time.Now().Unix(),
+		JobID:     jid.String(),
+		JobStatus: int(ffs.Queued),
+	}
+	if err := s.Collections.FFSInstances.Replace(ctx, ffsi); err != nil {
+		return nil, fmt.Errorf("updating ffs instance data: %s", err)
 	}
 
-	// Add all the _new_ nodes, which is the sum of the brand new ones
-	// from the missing path segment, and the changed ones from
-	// the existing path.
-	allNews := append(news, change...)
-	if err := s.IPFSClient.Dag().AddMany(ctx, allNews); err != nil {
-		return nil, err
+	if err := s.ArchiveTracker.Track(ctx, dbID, dbToken, req.GetKey(), jid, p.Cid()); err != nil {
+		return nil, fmt.Errorf("scheduling archive tracking: %s", err)
 	}
-	// Pin brand new nodes
-	if err := s.pinBlocks(ctx, news); err != nil {
-		return nil, err
+
+	log.Debug("archived bucket")
+	return &pb.ArchiveReply{}, nil
+}
+
+// RestoreArchive retrieves the current archive of a bucket from Filecoin and sets it as the bucket root.
+// This recovers buckets whose data was lost from IPFS. If NewBucket is set, the archive is restored into a
+// new bucket instead. Progress messages are streamed until the bucket is restored.
+func (s *Service) RestoreArchive(req *pb.RestoreArchiveRequest, server pb.API_RestoreArchiveServer) error {
+	log.Debug("received restore archive request")
+
+	if !s.Buckets.IsArchivingEnabled() {
+		return ErrArchivingFeatureDisabled
 	}
 
-	// Update changed node pins
-	for _, n := range np {
-		if n.old != nil && n.isJoint {
-			if err := s.unpinBranch(ctx, n.old, key); err != nil {
-				return nil, err
-			}
-		}
-		if err = s.updateOrAddPin(ctx, n.old, path.IpfsPath(n.new.Cid())); err != nil {
-			return nil, err
+	ctx := server.Context()
+	dbID, ok := common.ThreadIDFromContext(ctx)
+	if !ok {
+		return fmt.Errorf("db required")
+	}
+	dbToken, _ := thread.TokenFromContext(ctx)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return err
+	}
+	if req.NewBucket && buck.GetEncKey() != nil {
+		return status.Error(codes.FailedPrecondition, "Private buckets can only be restored in place")
+	}
+	if !req.NewBucket {
+		if err := s.checkLegalHold(ctx, buck.Key); err != nil {
+			return err
 		}
 	}
-	return path.IpfsPath(np[0].new.Cid()), nil
-}
+	ffsi, err := s.Collections.FFSInstances.Get(ctx, buck.Key)
+	if err != nil {
+		return fmt.Errorf("getting ffs instance data: %s", err)
+	}
+	current := ffsi.Archives.Current
+	if current.JobID == "" {
+		return buckets.ErrNoCurrentArchive
+	}
+	if ffs.JobStatus(current.JobStatus) != ffs.Success {
+		return status.Error(codes.FailedPrecondition, "The current archive is not complete")
+	}
+	archived, err := cid.Cast(current.Cid)
+	if err != nil {
+		return fmt.Errorf("parsing current archive cid: %s", err)
+	}
 
-// unpinBranch walks a the node at path, decrypting (if needed) and unpinning all nodes
-func (s *Service) unpinBranch(ctx context.Context, p path.Resolved, key []byte) error {
-	n, err := s.getNodeAtPath(ctx, p, key)
+	ctxFFS := context.WithValue(ctx, powc.AuthKey, ffsi.FFSToken)
+	res, err := s.PGClient.FFS.GetStorageConfig(ctxFFS, archived)
 	if err != nil {
+		return fmt.Errorf("getting archive storage config: %s", err)
+	}
+	conf := storageConfigFromPb(res.Config)
+	hotConf := conf
+	hotConf.Hot.Enabled = true
+	hotConf.Hot.AllowUnfreeze = true
+
+	send := func(format string, args ...interface{}) error {
+		return server.Send(&pb.RestoreArchiveReply{Msg: fmt.Sprintf(format, args...)})
+	}
+	if err := send("Retrieving archive %s", archived); err != nil {
 		return err
 	}
-	for _, l := range n.Links() {
-		if l.Name == "" {
-			continue // Data nodes will never be pinned directly
+	jid, err := s.PGClient.FFS.PushStorageConfig(ctxFFS, archived, powc.WithStorageConfig(hotConf), powc.WithOverride(true))
+	if err != nil {
+		return fmt.Errorf("pushing config: %s", err)
+	}
+	if err := s.watchRetrieval(ctxFFS, archived, jid, send); err != nil {
+		return err
+	}
+
+	// The bucket pins its own copy, so the archive is moved back to cold storage only.
+	defer func() {
+		if _, err := s.PGClient.FFS.PushStorageConfig(ctxFFS, archived, powc.WithStorageConfig(conf), powc.WithOverride(true)); err != nil {
+			log.Errorf("resetting storage config of archive %s: %v", archived, err)
 		}
-		lp := path.IpfsPath(l.Cid)
-		if err := s.unpinPath(ctx, lp); err != nil {
+	}()
+
+	pth := path.IpfsPath(archived)
+	if req.NewBucket {
+		if err := send("Creating bucket from archive"); err != nil {
 			return err
 		}
-		if err = s.unpinBranch(ctx, lp, key); err != nil {
+		rep, err := s.Init(ctx, &pb.InitRequest{Name: req.Name, BootstrapCid: archived.String()})
+		if err != nil {
 			return err
 		}
+		log.Debug("restored archive to new bucket")
+		return server.Send(&pb.RestoreArchiveReply{Msg: "Restored archive", Root: rep.Root})
 	}
-	return nil
-}
 
-type pathNode struct {
-	old     path.Resolved
-	new     ipld.Node
-	name    string
-	isJoint bool
+	if err := send("Restoring bucket root"); err != nil {
+		return err
+	}
+	if pth.String() == buck.Path {
+		// Pinning again fetches blocks that are missing locally.
+		if err := s.IPFSClient.Pin().Add(ctx, pth); err != nil {
+			return fmt.Errorf("pinning restored root: %s", err)
+		}
+	} else {
+		msg := fmt.Sprintf("Restored from archive %s", archived)
+		if err := s.restoreRoot(ctx, dbID, dbToken, buck, pth.String(), msg); err != nil {
+			return err
+		}
+	}
+	log.Debug("restored archive")
+	return server.Send(&pb.RestoreArchiveReply{
+		Msg: "Restored archive",
+		Root: &pb.Root{
+			Key:       buck.Key,
+			Name:      buck.Name,
+			Path:      buck.Path,
+			Thread:    dbID.String(),
+			CreatedAt: buck.CreatedAt,
+			UpdatedAt: buck.UpdatedAt,
+		},
+	})
 }
 
-// getNodesToPath returns a list of pathNodes that point to the path,
-// The remaining path segment that was not resolvable is also returned.
-func (s *Service) getNodesToPath(ctx context.Context, base path.Resolved, pth string, key []byte) (nodes []pathNode, remainder string, err error) {
-	top, err := s.getNodeAtPath(ctx, base, key)
-	if err != nil {
-		return
-	}
-	nodes = append(nodes, pathNode{old: base, new: top})
-	remainder = pth
-	if remainder == "" {
-		return
+// watchRetrieval sends log messages of the Powergate job jid for c until the job is done.
+// An error is returned if the job fails or is canceled.
+func (s *Service) watchRetrieval(ctx context.Context, c cid.Cid, jid ffs.JobID, send func(string, ...interface{}) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	jobs := make(chan powc.JobEvent)
+	if err := s.PGClient.FFS.WatchJobs(ctx, jobs, jid); err != nil {
+		return fmt.Errorf("watching retrieval job: %s", err)
+	}
+	logs := make(chan powc.LogEvent)
+	if err := s.PGClient.FFS.WatchLogs(ctx, logs, c, powc.WithJidFilter(jid)); err != nil {
+		cancel()
+		for range jobs {
+		}
+		return fmt.Errorf("watching retrieval logs: %s", err)
 	}
-	parts := strings.Split(pth, "/")
-	for i := 0; i < len(parts); i++ {
-		l := getLink(top.Links(), parts[i])
-		if l != nil {
-			p := path.IpfsPath(l.Cid)
-			top, err = s.getNodeAtPath(ctx, p, key)
-			if err != nil {
-				return
+	// The watches close their channels once canceled, drain them so they can exit.
+	defer func() {
+		cancel()
+		if jobs != nil {
+			for range jobs {
+			}
+		}
+		if logs != nil {
+			for range logs {
+			}
+		}
+	}()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case le, ok := <-logs:
+			if !ok {
+				logs = nil
+				continue
+			}
+			if le.Err == nil {
+				if err := send("%s", le.LogEntry.Msg); err != nil {
+					return err
+				}
+			}
+		case je, ok := <-jobs:
+			if !ok {
+				jobs = nil
+				return fmt.Errorf("retrieval job watch ended unexpectedly")
+			}
+			if je.Err != nil {
+				return fmt.Errorf("watching retrieval job: %s", je.Err)
+			}
+			switch je.Job.Status {
+			case ffs.Success:
+				return nil
+			case ffs.Failed, ffs.Canceled:
+				return status.Errorf(codes.Aborted, "Retrieving archive failed: %s", je.Job.ErrCause)
 			}
-			nodes = append(nodes, pathNode{old: p, new: top, name: parts[i]})
-		} else {
-			remainder = strings.Join(parts[i:], "/")
-			return nodes, remainder, nil
 		}
 	}
-	return nodes, "", nil
 }
 
-func getLink(lnks []*ipld.Link, name string) *ipld.Link {
-	for _, l := range lnks {
-		if l.Name == name {
-			return l
+// storageConfigFromPb returns the Powergate storage config in conf.
+func storageConfigFromPb(conf *pgrpc.StorageConfig) ffs.StorageConfig {
+	var sc ffs.StorageConfig
+	if conf == nil {
+		return sc
+	}
+	sc.Repairable = conf.Repairable
+	if conf.Hot != nil {
+		sc.Hot.Enabled = conf.Hot.Enabled
+		sc.Hot.AllowUnfreeze = conf.Hot.AllowUnfreeze
+		if conf.Hot.Ipfs != nil {
+			sc.Hot.Ipfs.AddTimeout = int(conf.Hot.Ipfs.AddTimeout)
 		}
 	}
-	return nil
+	if conf.Cold != nil {
+		sc.Cold.Enabled = conf.Cold.Enabled
+		if fil := conf.Cold.Filecoin; fil != nil {
+			sc.Cold.Filecoin = ffs.FilConfig{
+				RepFactor:       int(fil.RepFactor),
+				DealMinDuration: fil.DealMinDuration,
+				ExcludedMiners:  fil.ExcludedMiners,
+				TrustedMiners:   fil.TrustedMiners,
+				CountryCodes:    fil.CountryCodes,
+				Addr:            fil.Addr,
+				MaxPrice:        fil.MaxPrice,
+			}
+			if fil.Renew != nil {
+				sc.Cold.Filecoin.Renew = ffs.FilRenew{
+					Enabled:   fil.Renew.Enabled,
+					Threshold: int(fil.Renew.Threshold),
+				}
+			}
+		}
+	}
+	return sc
 }
 
-// updateOrAddPin moves the pin at from to to.
-// If from is nil, a new pin as placed at to.
-func (s *Service) updateOrAddPin(ctx context.Context, from, to path.Path) error {
-	toSize, err := s.dagSize(ctx, to)
-	if err != nil {
-		return fmt.Errorf("getting size of destination dag: %s", err)
+// archiveStorageConfig returns the default storage config of the FFS instance in ctx
+// with the deal options in opts applied.
+func (s *Service) archiveStorageConfig(ctx context.Context, opts *pb.ArchiveOptions) (ffs.StorageConfig, error) {
+	if opts.RepFactor < 0 || opts.DealMinDuration < 0 {
+		return ffs.StorageConfig{}, status.Error(codes.InvalidArgument, "Rep factor and deal duration must not be negative")
 	}
-	if s.BucketsMaxSize > 0 && toSize > s.BucketsMaxSize {
-		return fmt.Errorf("bucket size is greater than max allowed size")
+	excluded := make(map[string]struct{}, len(opts.ExcludedMiners))
+	for _, m := range opts.ExcludedMiners {
+		excluded[m] = struct{}{}
 	}
-
-	fromSize, err := s.dagSize(ctx, from)
-	if err != nil {
-		return fmt.Errorf("getting size of current dag: %s", err)
+	for _, m := range opts.TrustedMiners {
+		if _, ok := excluded[m]; ok {
+			return ffs.StorageConfig{}, status.Errorf(codes.InvalidArgument, "Miner %s is both trusted and excluded", m)
+		}
 	}
 
-	// Calculate if adding or moving the pin leads to a violation of
-	// total buckets size allowed in configuration.
-	currentBucketsSize, err := s.getBucketsTotalSize(ctx)
+	conf, err := s.PGClient.FFS.DefaultStorageConfig(ctx)
 	if err != nil {
-		return fmt.Errorf("getting current buckets total size: %s", err)
+		return conf, fmt.Errorf("getting default storage config: %s", err)
 	}
-	deltaSize := -fromSize + toSize
-	if s.BucketsTotalMaxSize > 0 && currentBucketsSize+deltaSize > s.BucketsTotalMaxSize {
-		return ErrBucketsTotalSizeExceedsMaxSize
+	fil := &conf.Cold.Filecoin
+	if len(opts.TrustedMiners) > 0 {
+		fil.TrustedMiners = opts.TrustedMiners
+	}
+	if len(opts.ExcludedMiners) > 0 {
+		fil.ExcludedMiners = opts.ExcludedMiners
+	}
+	if len(opts.CountryCodes) > 0 {
+		fil.CountryCodes = opts.CountryCodes
+	}
+	if opts.MaxPrice > 0 {
+		fil.MaxPrice = opts.MaxPrice
+	}
+	if opts.RepFactor > 0 {
+		fil.RepFactor = int(opts.RepFactor)
+	}
+	if opts.DealMinDuration > 0 {
+		fil.DealMinDuration = opts.DealMinDuration
 	}
+	if err := fil.Validate(); err != nil {
+		return conf, status.Errorf(codes.InvalidArgument, "Invalid archive options: %s", err)
+	}
+	return conf, nil
+}
 
-	if from == nil {
-		if err := s.IPFSClient.Pin().Add(ctx, to); err != nil {
-			return err
-		}
-	} else {
-		if err := s.IPFSClient.Pin().Update(ctx, from, to); err != nil {
-			if err.Error() == pinNotRecursiveMsg {
-				return s.IPFSClient.Pin().Add(ctx, to)
-			}
+func (s *Service) ArchiveWatch(req *pb.ArchiveWatchRequest, server pb.API_ArchiveWatchServer) error {
+	log.Debug("received archive watch")
+
+	if !s.Buckets.IsArchivingEnabled() {
+		return ErrArchivingFeatureDisabled
+	}
+
+	var err error
+	ctx, cancel := context.WithCancel(server.Context())
+	defer cancel()
+	ch := make(chan string)
+	go func() {
+		err = s.Buckets.ArchiveWatch(ctx, req.GetKey(), ch)
+		close(ch)
+	}()
+	for s := range ch {
+		if err := server.Send(&pb.ArchiveWatchReply{Msg: s}); err != nil {
 			return err
 		}
 	}
-
-	if err := s.sumBytesPinned(ctx, deltaSize); err != nil {
-		return fmt.Errorf("updating new buckets total size: %s", err)
+	if err != nil {
+		return fmt.Errorf("watching cid logs: %s", err)
 	}
 	return nil
 }
 
-// dagSize returns the cummulative size of root. If root is nil, it returns 0.
-func (s *Service) dagSize(ctx context.Context, root path.Path) (int64, error) {
-	if root == nil {
-		return 0, nil
+func (s *Service) ArchiveStatus(ctx context.Context, req *pb.ArchiveStatusRequest) (*pb.ArchiveStatusReply, error) {
+	log.Debug("received archive status")
+
+	if !s.Buckets.IsArchivingEnabled() {
+		return nil, ErrArchivingFeatureDisabled
 	}
-	stat, err := s.IPFSClient.Object().Stat(ctx, root)
+
+	jstatus, failedMsg, err := s.Buckets.ArchiveStatus(ctx, req.Key)
 	if err != nil {
-		return 0, fmt.Errorf("get stats of pin destination: %s", err)
+		return nil, fmt.Errorf("getting status from last archive: %s", err)
 	}
-	return int64(stat.CumulativeSize), nil
+	st, err := archiveStatusToPb(jstatus)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Debug("finished archive status")
+	return &pb.ArchiveStatusReply{
+		Key:       req.Key,
+		Status:    st,
+		FailedMsg: failedMsg,
+	}, nil
 }
 
-func (s *Service) PullPath(req *pb.PullPathRequest, server pb.API_PullPathServer) error {
-	log.Debugf("received pull path request")
+func archiveStatusToPb(jstatus ffs.JobStatus) (pb.ArchiveStatusReply_Status, error) {
+	switch jstatus {
+	case ffs.Success:
+		return pb.ArchiveStatusReply_Done, nil
+	case ffs.Queued, ffs.Executing:
+		return pb.ArchiveStatusReply_Executing, nil
+	case ffs.Failed:
+		return pb.ArchiveStatusReply_Failed, nil
+	case ffs.Canceled:
+		return pb.ArchiveStatusReply_Canceled, nil
+	default:
+		return 0, fmt.Errorf("unknown job status %d", jstatus)
+	}
+}
+
+// ArchiveList returns all archives of a bucket, newest first, with the state of their deals from Powergate.
+// Deals are matched to archives by cid.
+func (s *Service) ArchiveList(ctx context.Context, req *pb.ArchiveListRequest) (*pb.ArchiveListReply, error) {
+	log.Debug("received archive list")
+
+	if !s.Buckets.IsArchivingEnabled() {
+		return nil, ErrArchivingFeatureDisabled
+	}
 
-	dbID, ok := common.ThreadIDFromContext(server.Context())
+	dbID, ok := common.ThreadIDFromContext(ctx)
 	if !ok {
-		return fmt.Errorf("db required")
+		return nil, fmt.Errorf("db required")
 	}
-	dbToken, _ := thread.TokenFromContext(server.Context())
+	dbToken, _ := thread.TokenFromContext(ctx)
 
-	buck, pth, err := s.getBucketPath(server.Context(), dbID, req.Key, req.Path, dbToken)
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
+		return nil, err
+	}
+	ffsi, err := s.Collections.FFSInstances.Get(ctx, buck.Key)
 	if err != nil {
-		return err
+		return nil, fmt.Errorf("getting ffs instance data: %s", err)
 	}
-
-	var fpth path.Resolved
-	encKey := buck.GetEncKey()
-	if encKey != nil {
-		buckPath, err := util.NewResolvedPath(buck.Path)
-		if err != nil {
-			return err
-		}
-		np, r, err := s.getNodesToPath(server.Context(), buckPath, req.Path, encKey)
-		if err != nil {
-			return err
-		}
-		if r != "" {
-			return fmt.Errorf("could not resolve path: %s", pth)
-		}
-		fn := np[len(np)-1]
-		fpth = path.IpfsPath(fn.new.Cid())
-	} else {
-		fpth, err = s.IPFSClient.ResolvePath(server.Context(), pth)
-		if err != nil {
-			return err
-		}
+	var archives []mdb.Archive
+	if ffsi.Archives.Current.JobID != "" {
+		archives = append(archives, ffsi.Archives.Current)
 	}
-
-	node, err := s.IPFSClient.Unixfs().Get(server.Context(), fpth)
-	if err != nil {
-		return err
+	for i := len(ffsi.Archives.History) - 1; i >= 0; i-- {
+		archives = append(archives, ffsi.Archives.History[i])
 	}
-	defer node.Close()
-	file := ipfsfiles.ToFile(node)
-	if file == nil {
-		return fmt.Errorf("node is a directory")
+	if len(archives) == 0 {
+		return &pb.ArchiveListReply{}, nil
 	}
 
-	var reader io.Reader
-	if encKey != nil {
-		r, err := dcrypto.NewDecrypter(file, encKey)
+	cids := make([]string, len(archives))
+	for i, a := range archives {
+		c, err := cid.Cast(a.Cid)
 		if err != nil {
-			return err
+			return nil, fmt.Errorf("parsing archive cid: %s", err)
 		}
-		defer r.Close()
-		reader = r
-	} else {
-		reader = file
+		cids[i] = c.String()
 	}
-
-	buf := make([]byte, chunkSize)
-	for {
-		n, err := reader.Read(buf)
-		if n > 0 {
-			if err := server.Send(&pb.PullPathReply{
-				Chunk: buf[:n],
-			}); err != nil {
-				return err
-			}
+	ctxFFS := context.WithValue(ctx, powc.AuthKey, ffsi.FFSToken)
+	records, err := s.PGClient.FFS.ListStorageDealRecords(
+		ctxFFS,
+		powc.WithDataCids(cids...),
+		powc.WithIncludePending(true),
+		powc.WithIncludeFinal(true),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("listing deal records: %s", err)
+	}
+	deals := make(map[string][]*pb.ArchiveListReply_Archive_Deal)
+	for _, r := range records {
+		d := r.DealInfo
+		deal := &pb.ArchiveListReply_Archive_Deal{
+			Miner:           d.Miner,
+			DealId:          d.DealID,
+			State:           d.StateName,
+			Pending:         r.Pending,
+			PricePerEpoch:   d.PricePerEpoch,
+			StartEpoch:      d.StartEpoch,
+			Duration:        d.Duration,
+			ActivationEpoch: d.ActivationEpoch,
+			Message:         d.Message,
+			UpdatedAt:       r.Time,
 		}
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return err
+		if d.ProposalCid.Defined() {
+			deal.ProposalCid = d.ProposalCid.String()
 		}
+		if d.StartEpoch > 0 {
+			deal.ExpiryEpoch = d.StartEpoch + d.Duration
+		}
+		key := r.RootCid.String()
+		deals[key] = append(deals[key], deal)
 	}
-	return nil
-}
-
-func (s *Service) PullIpfsPath(req *pb.PullIpfsPathRequest, server pb.API_PullIpfsPathServer) error {
-	log.Debugf("received ipfs pull path request")
-
-	pth := path.New(req.Path)
-	node, err := s.IPFSClient.Unixfs().Get(server.Context(), pth)
-	if err != nil {
-		return err
-	}
-	defer node.Close()
 
-	file := ipfsfiles.ToFile(node)
-	if file == nil {
-		return fmt.Errorf("node is a directory")
-	}
-	buf := make([]byte, chunkSize)
-	for {
-		n, err := file.Read(buf)
-		if n > 0 {
-			if err := server.Send(&pb.PullIpfsPathReply{
-				Chunk: buf[:n],
-			}); err != nil {
-				return err
-			}
+	list := make([]*pb.ArchiveListReply_Archive, len(archives))
+	for i, a := range archives {
+		st, err := archiveStatusToPb(ffs.JobStatus(a.JobStatus))
+		if err != nil {
+			return nil, err
 		}
-		if err == io.EOF {
-			break
-		} else if err != nil {
-			return err
+		list[i] = &pb.ArchiveListReply_Archive{
+			Cid:        cids[i],
+			JobId:      a.JobID,
+			Status:     st,
+			FailedMsg:  a.FailureMsg,
+			Aborted:    a.Aborted,
+			AbortedMsg: a.AbortedMsg,
+			CreatedAt:  a.CreatedAt,
+			Current:    i == 0 && ffsi.Archives.Current.JobID != "",
+			Deals:      deals[cids[i]],
 		}
 	}
-	return nil
+	log.Debug("finished archive list")
+	return &pb.ArchiveListReply{Archives: list}, nil
 }
 
-func (s *Service) Remove(ctx context.Context, req *pb.RemoveRequest) (*pb.RemoveReply, error) {
-	log.Debugf("received remove request")
+func (s *Service) ArchiveInfo(ctx context.Context, req *pb.ArchiveInfoRequest) (*pb.ArchiveInfoReply, error) {
+	log.Debug("received archive info")
+
+	if !s.Buckets.IsArchivingEnabled() {
+		return nil, ErrArchivingFeatureDisabled
+	}
 
 	dbID, ok := common.ThreadIDFromContext(ctx)
 	if !ok {
@@ -1425,40 +7884,51 @@ func (s *Service) Remove(ctx context.Context, req *pb.RemoveRequest) (*pb.Remove
 	if err != nil {
 		return nil, err
 	}
-	buckPath, err := util.NewResolvedPath(buck.Path)
-	if err != nil {
-		return nil, err
+	var schedule *mdb.ArchiveSchedule
+	var renewal *mdb.ArchiveRenewal
+	ffsi, err := s.Collections.FFSInstances.Get(ctx, buck.Key)
+	if err == nil {
+		schedule = ffsi.Schedule
+		renewal = ffsi.Renewal
+	} else if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, fmt.Errorf("getting ffs instance data: %s", err)
 	}
-	encKey := buck.GetEncKey()
-	if encKey != nil {
-		if err = s.unpinNodeAndBranch(ctx, buckPath, encKey); err != nil {
-			return nil, err
-		}
-	} else {
-		if err = s.unpinPath(ctx, buckPath); err != nil {
-			return nil, err
-		}
+	currentArchive := buck.Archives.Current
+	if currentArchive.Cid == "" && schedule == nil {
+		return nil, buckets.ErrNoCurrentArchive
 	}
-	if err = s.Buckets.Delete(ctx, dbID, buck.Key, tdb.WithToken(dbToken)); err != nil {
-		return nil, err
+
+	reply := &pb.ArchiveInfoReply{
+		Key:      req.Key,
+		Schedule: archiveScheduleToPb(schedule),
+		Renewal:  archiveRenewalToPb(renewal),
 	}
-	if err = s.IPNSManager.RemoveKey(ctx, buck.Key); err != nil {
-		return nil, err
+	if currentArchive.Cid != "" {
+		deals := make([]*pb.ArchiveInfoReply_Archive_Deal, len(currentArchive.Deals))
+		for i, d := range currentArchive.Deals {
+			deals[i] = &pb.ArchiveInfoReply_Archive_Deal{
+				ProposalCid: d.ProposalCid,
+				Miner:       d.Miner,
+			}
+		}
+		reply.Archive = &pb.ArchiveInfoReply_Archive{
+			Cid:   currentArchive.Cid,
+			Deals: deals,
+		}
 	}
-
-	log.Debugf("removed bucket: %s", buck.Key)
-	return &pb.RemoveReply{}, nil
+	log.Debug("finished archive info")
+	return reply, nil
 }
 
-func (s *Service) unpinNodeAndBranch(ctx context.Context, pth path.Resolved, key []byte) error {
-	if err := s.unpinBranch(ctx, pth, key); err != nil {
-		return err
-	}
-	return s.unpinPath(ctx, pth)
-}
+// SetArchiveSchedule sets the policy for archiving a bucket automatically.
+// A bucket is archived when the interval has passed or its root changed the given number of times,
+// whichever comes first. A zero interval and change count removes the schedule.
+func (s *Service) SetArchiveSchedule(ctx context.Context, req *pb.SetArchiveScheduleRequest) (*pb.SetArchiveScheduleReply, error) {
+	log.Debug("received set archive schedule")
 
-func (s *Service) RemovePath(ctx context.Context, req *pb.RemovePathRequest) (*pb.RemovePathReply, error) {
-	log.Debugf("received remove path request")
+	if !s.Buckets.IsArchivingEnabled() {
+		return nil, ErrArchivingFeatureDisabled
+	}
 
 	dbID, ok := common.ThreadIDFromContext(ctx)
 	if !ok {
@@ -1466,125 +7936,144 @@ func (s *Service) RemovePath(ctx context.Context, req *pb.RemovePathRequest) (*p
 	}
 	dbToken, _ := thread.TokenFromContext(ctx)
 
-	filePath, err := parsePath(req.Path)
-	if err != nil {
-		return nil, err
+	if req.Interval < 0 || req.EveryChanges < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Interval and change count must not be negative")
+	}
+	if req.Interval > 0 && req.Interval < minArchiveScheduleInterval {
+		return nil, status.Errorf(codes.InvalidArgument, "Interval must be at least %d seconds", minArchiveScheduleInterval)
 	}
 	buck := &tdb.Bucket{}
-	err = s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
-	if err != nil {
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
 		return nil, err
 	}
-	if req.Root != "" && req.Root != buck.Path {
-		return nil, status.Error(codes.FailedPrecondition, buckets.ErrNonFastForward.Error())
-	}
 
-	buckPath := path.New(buck.Path)
-	encKey := buck.GetEncKey()
-	var dirpth path.Resolved
-	if encKey != nil {
-		dirpth, err = s.removeNodeAtPath(ctx, path.Join(path.New(buck.Path), filePath), encKey)
-		if err != nil {
-			return nil, err
-		}
-	} else {
-		dirpth, err = s.IPFSClient.Object().RmLink(ctx, buckPath, filePath)
-		if err != nil {
-			return nil, err
+	var schedule *mdb.ArchiveSchedule
+	if req.Interval > 0 || req.EveryChanges > 0 {
+		schedule = &mdb.ArchiveSchedule{
+			DbID:         dbID,
+			DbToken:      dbToken,
+			Interval:     req.Interval,
+			EveryChanges: req.EveryChanges,
 		}
-		if err = s.updateOrAddPin(ctx, buckPath, dirpth); err != nil {
-			return nil, err
+		if owner := contentOwner(ctx); owner != nil {
+			ownerID, err := crypto.MarshalPublicKey(owner)
+			if err != nil {
+				return nil, err
+			}
+			schedule.Owner = ownerID
 		}
 	}
-
-	buck.Path = dirpth.String()
-	buck.UpdatedAt = time.Now().UnixNano()
-	if err = s.Buckets.SaveSafe(ctx, dbID, buck, tdb.WithToken(dbToken)); err != nil {
-		return nil, err
+	if err := s.Collections.FFSInstances.SetSchedule(ctx, buck.Key, schedule); err != nil {
+		return nil, fmt.Errorf("setting archive schedule: %s", err)
 	}
-
-	go s.IPNSManager.Publish(dirpth, buck.Key)
-
-	log.Debugf("removed %s from bucket: %s", filePath, buck.Key)
-	return &pb.RemovePathReply{
-		Root: &pb.Root{
-			Key:       buck.Key,
-			Name:      buck.Name,
-			Path:      buck.Path,
-			Thread:    dbID.String(),
-			CreatedAt: buck.CreatedAt,
-			UpdatedAt: buck.UpdatedAt,
-		},
-	}, nil
+	ffsi, err := s.Collections.FFSInstances.Get(ctx, buck.Key)
+	if err != nil {
+		return nil, fmt.Errorf("getting ffs instance data: %s", err)
+	}
+	log.Debug("set archive schedule")
+	return &pb.SetArchiveScheduleReply{Schedule: archiveScheduleToPb(ffsi.Schedule)}, nil
 }
 
-// removeNodeAtPath removes node at the location of path.
-// Key will be required if the path is encrypted.
-func (s *Service) removeNodeAtPath(ctx context.Context, pth path.Path, key []byte) (path.Resolved, error) {
-	// The first step here is find a resolvable list of nodes that point to path.
-	rp, fp, err := util.ParsePath(pth)
-	if err != nil {
-		return nil, err
+// RunArchiveSchedule archives the bucket of a due archive schedule and schedules the next run.
+// Runs are skipped if the bucket root is already archived. Failed archives are retried after
+// ArchiveScheduleRetryInterval.
+func (s *Service) RunArchiveSchedule(ctx context.Context, ffsi mdb.FFSInstance) error {
+	sched := ffsi.Schedule
+	if sched == nil {
+		return nil
 	}
-	np, r, err := s.getNodesToPath(ctx, rp, fp, key)
-	if err != nil {
-		return nil, err
+	ctx = common.NewThreadIDContext(ctx, sched.DbID)
+	ctx = thread.NewTokenContext(ctx, sched.DbToken)
+	if len(sched.Owner) > 0 {
+		owner, err := crypto.UnmarshalPublicKey(sched.Owner)
+		if err != nil {
+			return err
+		}
+		ctx = s.ownerContext(ctx, owner)
 	}
-	// If the remaining path segment is not empty, then we conclude that
-	// the node cannot be resolved.
-	if r != "" {
-		return nil, fmt.Errorf("could not resolve path: %s", pth)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, sched.DbID, ffsi.BucketKey, buck, tdb.WithToken(sched.DbToken)); err != nil {
+		if strings.Contains(err.Error(), db.ErrInstanceNotFound.Error()) {
+			return s.Collections.FFSInstances.SetSchedule(ctx, ffsi.BucketKey, nil)
+		}
+		return err
+	}
+	root, err := util.NewResolvedPath(buck.Path)
+	if err != nil {
+		return err
 	}
-	np[len(np)-1].isJoint = true
 
-	// Now, we have a full list of nodes to the insert location,
-	// but the existing nodes need to be updated and re-encrypted.
-	change := make([]ipld.Node, len(np)-1)
-	for i := len(np) - 1; i >= 0; i-- {
-		if i < len(np)-1 {
-			change[i] = np[i].new
+	now := time.Now()
+	run := mdb.ArchiveScheduleRun{
+		RanAt: now.UnixNano(),
+		Cid:   root.Cid().Bytes(),
+	}
+	if archiveUpToDate(&ffsi, root.Cid()) {
+		run.Skipped = true
+	} else if _, err := s.Archive(ctx, &pb.ArchiveRequest{Key: buck.Key}); err != nil {
+		run.Error = err.Error()
+	} else {
+		current, err := s.Collections.FFSInstances.Get(ctx, buck.Key)
+		if err != nil {
+			return err
 		}
-		if i > 0 {
-			p, ok := np[i-1].new.(*dag.ProtoNode)
-			if !ok {
-				return nil, dag.ErrNotProtobuf
-			}
-			if err := p.RemoveNodeLink(np[i].name); err != nil {
-				return nil, err
-			}
-			if !np[i].isJoint {
-				if err := p.AddNodeLink(np[i].name, np[i].new); err != nil {
-					return nil, err
-				}
-			}
-			np[i-1].new, err = encryptNode(p, key)
-			if err != nil {
-				return nil, err
-			}
+		run.JobID = current.Archives.Current.JobID
+	}
+
+	var next int64
+	if sched.Interval > 0 {
+		next = now.Add(time.Duration(sched.Interval) * time.Second).UnixNano()
+	}
+	if run.Error != "" {
+		retry := now.Add(ArchiveScheduleRetryInterval).UnixNano()
+		if next == 0 || retry < next {
+			next = retry
 		}
 	}
+	return s.Collections.FFSInstances.AddScheduleRun(ctx, buck.Key, run, next)
+}
+
+// countArchiveChange counts a root change toward the archive schedule of a bucket.
+func (s *Service) countArchiveChange(ctx context.Context, key string) {
+	if !s.Buckets.IsArchivingEnabled() {
+		return
+	}
+	if err := s.Collections.FFSInstances.AddScheduleChange(ctx, key); err != nil {
+		log.Errorf("counting archive schedule change of bucket %s: %v", key, err)
+	}
+}
 
-	// Add all the changed nodes
-	if err := s.IPFSClient.Dag().AddMany(ctx, change); err != nil {
-		return nil, err
+func archiveScheduleToPb(sched *mdb.ArchiveSchedule) *pb.ArchiveSchedule {
+	if sched == nil {
+		return nil
 	}
-	// Update / remove node pins
-	for _, n := range np {
-		if n.isJoint {
-			if err = s.unpinNodeAndBranch(ctx, n.old, key); err != nil {
-				return nil, err
-			}
-		} else {
-			if err = s.updateOrAddPin(ctx, n.old, path.IpfsPath(n.new.Cid())); err != nil {
-				return nil, err
-			}
+	history := make([]*pb.ArchiveSchedule_Run, len(sched.History))
+	for i, r := range sched.History {
+		run := &pb.ArchiveSchedule_Run{
+			RanAt:   r.RanAt,
+			JobId:   r.JobID,
+			Skipped: r.Skipped,
+			Error:   r.Error,
+		}
+		if c, err := cid.Cast(r.Cid); err == nil {
+			run.Cid = c.String()
 		}
+		history[i] = run
+	}
+	return &pb.ArchiveSchedule{
+		Interval:     sched.Interval,
+		EveryChanges: sched.EveryChanges,
+		Changes:      sched.Changes,
+		NextRunAt:    sched.NextRunAt,
+		History:      history,
 	}
-	return path.IpfsPath(np[0].new.Cid()), nil
 }
 
-func (s *Service) Archive(ctx context.Context, req *pb.ArchiveRequest) (*pb.ArchiveReply, error) {
-	log.Debug("received archive request")
+// SetArchiveRenewal sets the policy for renewing the deals of a bucket's archives.
+// Deals are renewed when they are within threshold epochs of expiring. A zero threshold removes the policy.
+func (s *Service) SetArchiveRenewal(ctx context.Context, req *pb.SetArchiveRenewalRequest) (*pb.SetArchiveRenewalReply, error) {
+	log.Debug("received set archive renewal")
 
 	if !s.Buckets.IsArchivingEnabled() {
 		return nil, ErrArchivingFeatureDisabled
@@ -1596,198 +8085,237 @@ func (s *Service) Archive(ctx context.Context, req *pb.ArchiveRequest) (*pb.Arch
 	}
 	dbToken, _ := thread.TokenFromContext(ctx)
 
+	if req.Threshold < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Threshold must not be negative")
+	}
 	buck := &tdb.Bucket{}
-	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
-	if err != nil {
+	if err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken)); err != nil {
 		return nil, err
 	}
-	p, err := util.NewResolvedPath(buck.Path)
-	if err != nil {
-		return nil, fmt.Errorf("parsing cid path: %s", err)
-	}
-
-	ffsi, err := s.Collections.FFSInstances.Get(ctx, req.GetKey())
+	ffsi, err := s.Collections.FFSInstances.Get(ctx, buck.Key)
 	if err != nil {
 		return nil, fmt.Errorf("getting ffs instance data: %s", err)
 	}
 
+	// New archives pick up the policy from the default config,
+	// the current archive is updated by the renewal watcher.
 	ctxFFS := context.WithValue(ctx, powc.AuthKey, ffsi.FFSToken)
-
-	// Check that FFS wallet addr balance is > 0, if not, fail fast.
-	bal, err := s.PGClient.Wallet.Balance(ctx, ffsi.WalletAddr)
+	conf, err := s.PGClient.FFS.DefaultStorageConfig(ctxFFS)
 	if err != nil {
-		return nil, fmt.Errorf("getting ffs wallet address balance: %s", err)
+		return nil, fmt.Errorf("getting default storage config: %s", err)
 	}
-	if bal == 0 {
-		return nil, buckets.ErrZeroBalance
+	conf.Cold.Filecoin.Renew = archiveRenew(req.Threshold)
+	if err := s.PGClient.FFS.SetDefaultStorageConfig(ctxFFS, conf); err != nil {
+		return nil, fmt.Errorf("setting default storage config: %s", err)
 	}
 
-	var jid ffs.JobID
-	firstTimeArchive := ffsi.Archives.Current.JobID == ""
-	if firstTimeArchive || ffsi.Archives.Current.Aborted { // Case 0.
-		// On the first archive, we simply push the Cid with
-		// the default CidConfig configured at bucket creation.
-		jid, err = s.PGClient.FFS.PushStorageConfig(ctxFFS, p.Cid(), powc.WithOverride(true))
-		if err != nil {
-			return nil, fmt.Errorf("pushing config: %s", err)
-		}
-	} else {
-		oldCid, err := cid.Cast(ffsi.Archives.Current.Cid)
-		if err != nil {
-			return nil, fmt.Errorf("parsing old Cid archive: %s", err)
+	var renewal *mdb.ArchiveRenewal
+	if req.Threshold > 0 {
+		renewal = &mdb.ArchiveRenewal{
+			DbID:      dbID,
+			DbToken:   dbToken,
+			Threshold: req.Threshold,
 		}
-
-		// Archive pushes the current root Cid to the corresponding FFS instance of the bucket.
-		// The behaviour changes depending on different cases, depending on a previous archive.
-		// 0. No previous archive or last one aborted: simply pushes the Cid to the FFS instance.
-		// 1. Last archive exists with the same Cid:
-		//   a. Last archive Successful: fails, there's nothing to do.
-		//   b. Last archive Executing/Queued: fails, that work already starting and is in progress.
-		//   c. Last archive Failed/Canceled: work to do, push again with override flag to try again.
-		// 2. Archiving on new Cid: work to do, it will always call Replace(,) in the FFS instance.
-		if oldCid.Equals(p.Cid()) { // Case 1.
-			switch ffs.JobStatus(ffsi.Archives.Current.JobStatus) {
-			// Case 1.a.
-			case ffs.Success:
-				return nil, fmt.Errorf("the same bucket cid is already archived successfully")
-			// Case 1.b.
-			case ffs.Executing, ffs.Queued:
-				return nil, fmt.Errorf("there is an in progress archive")
-			// Case 1.c.
-			case ffs.Failed, ffs.Canceled:
-				jid, err = s.PGClient.FFS.PushStorageConfig(ctxFFS, p.Cid(), powc.WithOverride(true))
-				if err != nil {
-					return nil, fmt.Errorf("pushing config: %s", err)
-				}
-			default:
-				return nil, fmt.Errorf("unexpected current archive status: %d", ffsi.Archives.Current.JobStatus)
-			}
-		} else { // Case 2.
-			jid, err = s.PGClient.FFS.Replace(ctxFFS, oldCid, p.Cid())
+		if owner := contentOwner(ctx); owner != nil {
+			ownerID, err := crypto.MarshalPublicKey(owner)
 			if err != nil {
-				return nil, fmt.Errorf("replacing cid: %s", err)
+				return nil, err
 			}
+			renewal.Owner = ownerID
 		}
+	} else if err := s.setCurrentArchiveRenew(ctxFFS, *ffsi, ffs.FilRenew{}); err != nil {
+		return nil, err
+	}
+	if err := s.Collections.FFSInstances.SetRenewal(ctx, buck.Key, renewal); err != nil {
+		return nil, fmt.Errorf("setting archive renewal: %s", err)
+	}
+	ffsi, err = s.Collections.FFSInstances.Get(ctx, buck.Key)
+	if err != nil {
+		return nil, fmt.Errorf("getting ffs instance data: %s", err)
+	}
+	log.Debug("set archive renewal")
+	return &pb.SetArchiveRenewalReply{Renewal: archiveRenewalToPb(ffsi.Renewal)}, nil
+}
 
-		// Include the existing archive in history,
-		// since we're going to set a new _current_ archive.
-		ffsi.Archives.History = append(ffsi.Archives.History, ffsi.Archives.Current)
+// CheckArchiveRenewal checks the deals of the current archive of a bucket with a renewal policy.
+// Powergate renews the deals before they expire. The owner is notified when the deals were renewed,
+// or when they are due for renewal but the archive wallet has no funds.
+func (s *Service) CheckArchiveRenewal(ctx context.Context, ffsi mdb.FFSInstance) error {
+	rn := ffsi.Renewal
+	if rn == nil {
+		return nil
 	}
-	ffsi.Archives.Current = mdb.Archive{
-		Cid:       p.Cid().Bytes(),
-		CreatedAt: time.Now().Unix(),
-		JobID:     jid.String(),
-		JobStatus: int(ffs.Queued),
+	ctx = common.NewThreadIDContext(ctx, rn.DbID)
+	ctx = thread.NewTokenContext(ctx, rn.DbToken)
+	var owner crypto.PubKey
+	if len(rn.Owner) > 0 {
+		var err error
+		owner, err = crypto.UnmarshalPublicKey(rn.Owner)
+		if err != nil {
+			return err
+		}
+		ctx = s.ownerContext(ctx, owner)
 	}
-	if err := s.Collections.FFSInstances.Replace(ctx, ffsi); err != nil {
-		return nil, fmt.Errorf("updating ffs instance data: %s", err)
+
+	buck := &tdb.Bucket{}
+	if err := s.Buckets.Get(ctx, rn.DbID, ffsi.BucketKey, buck, tdb.WithToken(rn.DbToken)); err != nil {
+		if strings.Contains(err.Error(), db.ErrInstanceNotFound.Error()) {
+			return s.Collections.FFSInstances.SetRenewal(ctx, ffsi.BucketKey, nil)
+		}
+		return err
 	}
 
-	if err := s.ArchiveTracker.Track(ctx, dbID, dbToken, req.GetKey(), jid, p.Cid()); err != nil {
-		return nil, fmt.Errorf("scheduling archive tracking: %s", err)
+	next := time.Now().Add(ArchiveRenewalCheckInterval).UnixNano()
+	current := ffsi.Archives.Current
+	if current.JobID == "" || current.Aborted || ffs.JobStatus(current.JobStatus) != ffs.Success {
+		return s.Collections.FFSInstances.SetRenewalChecked(ctx, buck.Key, rn.Cid, rn.ExpiryEpoch, rn.Unfunded, "", next)
+	}
+	archived, err := cid.Cast(current.Cid)
+	if err != nil {
+		return fmt.Errorf("parsing current archive cid: %s", err)
 	}
 
-	log.Debug("archived bucket")
-	return &pb.ArchiveReply{}, nil
+	expiry, unfunded, err := s.checkArchiveDeals(ctx, ffsi, archived)
+	if err != nil {
+		return s.Collections.FFSInstances.SetRenewalChecked(ctx, buck.Key, rn.Cid, rn.ExpiryEpoch, rn.Unfunded, err.Error(), next)
+	}
+	if bytes.Equal(rn.Cid, current.Cid) && rn.ExpiryEpoch > 0 && expiry > rn.ExpiryEpoch {
+		s.notifyArchiveRenewal(ctx, rn.DbID, buck, owner, webhooks.ArchiveRenewed, archived, ffsi.WalletAddr, expiry)
+	} else if unfunded && !rn.Unfunded {
+		s.notifyArchiveRenewal(ctx, rn.DbID, buck, owner, webhooks.ArchiveUnfunded, archived, ffsi.WalletAddr, expiry)
+	}
+	return s.Collections.FFSInstances.SetRenewalChecked(ctx, buck.Key, current.Cid, expiry, unfunded, "", next)
 }
 
-func (s *Service) ArchiveWatch(req *pb.ArchiveWatchRequest, server pb.API_ArchiveWatchServer) error {
-	log.Debug("received archive watch")
-
-	if !s.Buckets.IsArchivingEnabled() {
-		return ErrArchivingFeatureDisabled
+// checkArchiveDeals makes sure Powergate renews the deals of archived and returns their latest expiry epoch.
+// unfunded is true if the deals are due for renewal and the archive wallet has no funds.
+func (s *Service) checkArchiveDeals(ctx context.Context, ffsi mdb.FFSInstance, archived cid.Cid) (expiry uint64, unfunded bool, err error) {
+	ctxFFS := context.WithValue(ctx, powc.AuthKey, ffsi.FFSToken)
+	renew := archiveRenew(ffsi.Renewal.Threshold)
+	if err := s.setCurrentArchiveRenew(ctxFFS, ffsi, renew); err != nil {
+		return 0, false, err
 	}
 
-	var err error
-	ctx, cancel := context.WithCancel(server.Context())
-	defer cancel()
-	ch := make(chan string)
-	go func() {
-		err = s.Buckets.ArchiveWatch(ctx, req.GetKey(), ch)
-		close(ch)
-	}()
-	for s := range ch {
-		if err := server.Send(&pb.ArchiveWatchReply{Msg: s}); err != nil {
-			return err
+	records, err := s.PGClient.FFS.ListStorageDealRecords(
+		ctxFFS,
+		powc.WithDataCids(archived.String()),
+		powc.WithIncludeFinal(true),
+	)
+	if err != nil {
+		return 0, false, fmt.Errorf("listing deal records: %s", err)
+	}
+	for _, r := range records {
+		if e := r.DealInfo.StartEpoch + r.DealInfo.Duration; e > expiry {
+			expiry = e
 		}
 	}
+	if expiry == 0 {
+		return 0, false, nil
+	}
+
+	index, err := s.PGClient.Miners.Get(ctx)
 	if err != nil {
-		return fmt.Errorf("watching cid logs: %s", err)
+		return 0, false, fmt.Errorf("getting chain height: %s", err)
 	}
-	return nil
+	if uint64(index.OnChain.LastUpdated)+uint64(renew.Threshold) < expiry {
+		return expiry, false, nil
+	}
+	bal, err := s.PGClient.Wallet.Balance(ctx, ffsi.WalletAddr)
+	if err != nil {
+		return 0, false, fmt.Errorf("getting ffs wallet address balance: %s", err)
+	}
+	return expiry, bal == 0, nil
 }
 
-func (s *Service) ArchiveStatus(ctx context.Context, req *pb.ArchiveStatusRequest) (*pb.ArchiveStatusReply, error) {
-	log.Debug("received archive status")
-
-	if !s.Buckets.IsArchivingEnabled() {
-		return nil, ErrArchivingFeatureDisabled
+// setCurrentArchiveRenew updates the renewal config of the current archive of ffsi if it's complete.
+func (s *Service) setCurrentArchiveRenew(ctx context.Context, ffsi mdb.FFSInstance, renew ffs.FilRenew) error {
+	current := ffsi.Archives.Current
+	if current.JobID == "" || current.Aborted || ffs.JobStatus(current.JobStatus) != ffs.Success {
+		return nil
 	}
-
-	jstatus, failedMsg, err := s.Buckets.ArchiveStatus(ctx, req.Key)
+	archived, err := cid.Cast(current.Cid)
 	if err != nil {
-		return nil, fmt.Errorf("getting status from last archive: %s", err)
+		return fmt.Errorf("parsing current archive cid: %s", err)
 	}
-	var st pb.ArchiveStatusReply_Status
-	switch jstatus {
-	case ffs.Success:
-		st = pb.ArchiveStatusReply_Done
-	case ffs.Queued, ffs.Executing:
-		st = pb.ArchiveStatusReply_Executing
-	case ffs.Failed:
-		st = pb.ArchiveStatusReply_Failed
-	case ffs.Canceled:
-		st = pb.ArchiveStatusReply_Canceled
-	default:
-		return nil, fmt.Errorf("unknown job status %d", jstatus)
+	res, err := s.PGClient.FFS.GetStorageConfig(ctx, archived)
+	if err != nil {
+		return fmt.Errorf("getting archive storage config: %s", err)
 	}
-
-	log.Debug("finished archive status")
-	return &pb.ArchiveStatusReply{
-		Key:       req.Key,
-		Status:    st,
-		FailedMsg: failedMsg,
-	}, nil
+	conf := storageConfigFromPb(res.Config)
+	if conf.Cold.Filecoin.Renew == renew {
+		return nil
+	}
+	conf.Cold.Filecoin.Renew = renew
+	if _, err := s.PGClient.FFS.PushStorageConfig(ctx, archived, powc.WithStorageConfig(conf), powc.WithOverride(true)); err != nil {
+		return fmt.Errorf("pushing config: %s", err)
+	}
+	return nil
 }
 
-func (s *Service) ArchiveInfo(ctx context.Context, req *pb.ArchiveInfoRequest) (*pb.ArchiveInfoReply, error) {
-	log.Debug("received archive info")
-
-	if !s.Buckets.IsArchivingEnabled() {
-		return nil, ErrArchivingFeatureDisabled
+// notifyArchiveRenewal publishes a renewal event of buck and emails the owner if they have an address.
+func (s *Service) notifyArchiveRenewal(
+	ctx context.Context,
+	dbID thread.ID,
+	buck *tdb.Bucket,
+	owner crypto.PubKey,
+	event string,
+	archived cid.Cid,
+	wallet string,
+	expiry uint64,
+) {
+	msg := fmt.Sprintf("deals expire at epoch %d", expiry)
+	if event == webhooks.ArchiveUnfunded {
+		msg = fmt.Sprintf("deals expire at epoch %d and wallet %s has no funds", expiry, wallet)
+	}
+	s.publishEvent(ctx, webhooks.Event{
+		Type:      event,
+		BucketKey: buck.Key,
+		Thread:    dbID.String(),
+		Cid:       archived.String(),
+		Root:      buck.Path,
+		Message:   msg,
+	})
+
+	if s.EmailClient == nil || owner == nil || s.Collections.Accounts == nil {
+		return
 	}
-
-	dbID, ok := common.ThreadIDFromContext(ctx)
-	if !ok {
-		return nil, fmt.Errorf("db required")
+	a, err := s.Collections.Accounts.Get(ctx, owner)
+	if err != nil || a.Email == "" {
+		return
+	}
+	name := buck.Name
+	if name == "" {
+		name = buck.Key
+	}
+	if event == webhooks.ArchiveUnfunded {
+		err = s.EmailClient.ArchiveUnfunded(ctx, a.Email, name, archived.String(), wallet, expiry)
+	} else {
+		err = s.EmailClient.ArchiveRenewed(ctx, a.Email, name, archived.String(), expiry)
 	}
-	dbToken, _ := thread.TokenFromContext(ctx)
-
-	buck := &tdb.Bucket{}
-	err := s.Buckets.Get(ctx, dbID, req.Key, buck, tdb.WithToken(dbToken))
 	if err != nil {
-		return nil, err
+		log.Errorf("sending %s email for bucket %s: %v", event, buck.Key, err)
 	}
-	currentArchive := buck.Archives.Current
-	if currentArchive.Cid == "" {
-		return nil, buckets.ErrNoCurrentArchive
+}
+
+func archiveRenew(threshold int64) ffs.FilRenew {
+	return ffs.FilRenew{
+		Enabled:   threshold > 0,
+		Threshold: int(threshold),
 	}
+}
 
-	deals := make([]*pb.ArchiveInfoReply_Archive_Deal, len(currentArchive.Deals))
-	for i, d := range currentArchive.Deals {
-		deals[i] = &pb.ArchiveInfoReply_Archive_Deal{
-			ProposalCid: d.ProposalCid,
-			Miner:       d.Miner,
-		}
+func archiveRenewalToPb(rn *mdb.ArchiveRenewal) *pb.ArchiveRenewal {
+	if rn == nil {
+		return nil
+	}
+	return &pb.ArchiveRenewal{
+		Threshold:     rn.Threshold,
+		ExpiryEpoch:   rn.ExpiryEpoch,
+		Unfunded:      rn.Unfunded,
+		LastCheckedAt: rn.LastCheckedAt,
+		NextCheckAt:   rn.NextCheckAt,
+		LastError:     rn.LastError,
 	}
-	log.Debug("finished archive info")
-	return &pb.ArchiveInfoReply{
-		Key: req.Key,
-		Archive: &pb.ArchiveInfoReply_Archive{
-			Cid:   currentArchive.Cid,
-			Deals: deals,
-		},
-	}, nil
 }
 
 func (s *Service) getGatewayHost() (host string, ok bool) {
@@ -1828,7 +8356,7 @@ func (s *Service) pinBlocks(ctx context.Context, nodes []ipld.Node) error {
 		return fmt.Errorf("getting current buckets total size: %s", err)
 	}
 
-	if s.BucketsTotalMaxSize > 0 && currentBucketsSize+totalAddedSize > s.BucketsTotalMaxSize {
+	if maxSize := s.getBucketsTotalMaxSize(ctx); maxSize > 0 && currentBucketsSize+totalAddedSize > maxSize {
 		return ErrBucketsTotalSizeExceedsMaxSize
 	}
 
@@ -1878,6 +8406,120 @@ func (s *Service) getBucketsTotalSize(ctx context.Context) (int64, error) {
 	return u.BucketsTotalSize, nil
 }
 
+// getBucketsTotalMaxSize returns the buckets total size quota of the account/user logged in the context.
+// Users of a user key with a per-user cap are limited by the smaller of the cap and the hub's quota.
+func (s *Service) getBucketsTotalMaxSize(ctx context.Context) int64 {
+	max := s.BucketsTotalMaxSize
+	if userFromContext(ctx) == nil {
+		return max
+	}
+	key, ok := mdb.APIKeyFromContext(ctx)
+	if !ok || key.Type != mdb.UserKey || key.UserBucketsMaxSize <= 0 {
+		return max
+	}
+	if max <= 0 || key.UserBucketsMaxSize < max {
+		return key.UserBucketsMaxSize
+	}
+	return max
+}
+
+// contentOwner returns the key of the account or user whose buckets total size is affected by ctx.
+func contentOwner(ctx context.Context) crypto.PubKey {
+	if a := accountFromContext(ctx); a != nil {
+		return a.Key
+	}
+	if u := userFromContext(ctx); u != nil {
+		return u.Key
+	}
+	return nil
+}
+
+// existingPath returns the resolved path of filePath in buck, or nil if it doesn't exist.
+func (s *Service) existingPath(ctx context.Context, buck *tdb.Bucket, filePath string) path.Resolved {
+	pth, err := s.IPFSClient.ResolvePath(ctx, path.Join(path.New(buck.Path), filePath))
+	if err != nil {
+		return nil
+	}
+	return pth
+}
+
+// updateContentRefs references added files and dereferences files under the freed paths.
+// Identical files are only stored once by the IPFS node, but bucket DAG sizes count them for every path.
+// The owner's buckets total size is corrected so that deduplicated files are counted once.
+// Only public bucket files are referenced since encrypted files never share content.
+func (s *Service) updateContentRefs(ctx context.Context, added, freed []path.Resolved) {
+	owner := contentOwner(ctx)
+	if owner == nil {
+		return
+	}
+	var delta int64
+	for _, pth := range freed {
+		if pth == nil {
+			continue
+		}
+		n, err := s.IPFSClient.ResolveNode(ctx, pth)
+		if err != nil {
+			log.Errorf("resolving freed content %s: %v", pth, err)
+			continue
+		}
+		if err := s.walkFiles(ctx, n, func(c cid.Cid) error {
+			refs, size, err := s.Collections.ContentRefs.Remove(ctx, owner, c)
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil
+			} else if err != nil {
+				return err
+			}
+			if refs > 0 {
+				delta += size
+			}
+			return nil
+		}); err != nil {
+			log.Errorf("dereferencing freed content %s: %v", pth, err)
+		}
+	}
+	for _, pth := range added {
+		size, err := s.dagSize(ctx, pth)
+		if err != nil {
+			log.Errorf("getting size of added content %s: %v", pth, err)
+			continue
+		}
+		refs, err := s.Collections.ContentRefs.Add(ctx, owner, pth.Cid(), size)
+		if err != nil {
+			log.Errorf("referencing added content %s: %v", pth, err)
+			continue
+		}
+		if refs > 1 {
+			delta -= size
+		}
+	}
+	if delta != 0 {
+		if err := s.sumBytesPinned(ctx, delta); err != nil {
+			log.Errorf("updating deduplicated buckets total size: %v", err)
+		}
+	}
+}
+
+// walkFiles calls fn with the cid of every file under n.
+// Bucket seed files are skipped.
+func (s *Service) walkFiles(ctx context.Context, n ipld.Node, fn func(c cid.Cid) error) error {
+	if !isDirNode(n) {
+		return fn(n.Cid())
+	}
+	for _, l := range n.Links() {
+		if l.Name == buckets.SeedName {
+			continue
+		}
+		ln, err := l.GetNode(ctx, s.IPFSClient.Dag())
+		if err != nil {
+			return err
+		}
+		if err := s.walkFiles(ctx, ln, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func accountFromContext(ctx context.Context) *mdb.Account {
 	if org, ok := mdb.OrgFromContext(ctx); ok {
 		return org
@@ -1888,6 +8530,20 @@ func accountFromContext(ctx context.Context) *mdb.Account {
 	return nil
 }
 
+// authorFromContext returns the name of the developer, org, or user making a request.
+func authorFromContext(ctx context.Context) string {
+	if dev, ok := mdb.DevFromContext(ctx); ok {
+		return dev.Username
+	}
+	if account := accountFromContext(ctx); account != nil {
+		return account.Username
+	}
+	if user := userFromContext(ctx); user != nil {
+		return thread.NewLibp2pPubKey(user.Key).String()
+	}
+	return ""
+}
+
 func userFromContext(ctx context.Context) *mdb.User {
 	if user, ok := mdb.UserFromContext(ctx); ok {
 		return user
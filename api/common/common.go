@@ -82,6 +82,29 @@ func APIKeyFromMD(ctx context.Context) (key string, ok bool) {
 	return
 }
 
+// NewAdminTokenContext adds an admin API token to a context.
+func NewAdminTokenContext(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ctxKey("adminToken"), token)
+}
+
+// AdminTokenFromContext returns an admin API token from a context.
+func AdminTokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(ctxKey("adminToken")).(string)
+	return token, ok
+}
+
+// AdminTokenFromMD returns an admin API token from context metadata.
+func AdminTokenFromMD(ctx context.Context) (token string, ok bool) {
+	token = metautils.ExtractIncoming(ctx).Get("x-textile-admin-token")
+	if token != "" {
+		ok = true
+	}
+	return
+}
+
 // CreateAPISigContext creates an HMAC signature and adds it to a context,
 // with secret as the key and SHA256 as the hash algorithm.
 // An RFC 3339 date string is used as the message.
@@ -242,6 +265,10 @@ func (c Credentials) GetRequestMetadata(ctx context.Context, _ ...string) (map[s
 	if ok {
 		md["x-textile-api-key"] = apiKey
 	}
+	adminToken, ok := AdminTokenFromContext(ctx)
+	if ok {
+		md["x-textile-admin-token"] = adminToken
+	}
 	apiSigMsg, apiSig, ok := APISigFromContext(ctx)
 	if ok {
 		var err error
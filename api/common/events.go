@@ -0,0 +1,42 @@
+package common
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/textileio/go-threads/broadcast"
+)
+
+// AccountEventType is the type of an account event.
+type AccountEventType int
+
+const (
+	KeyCreated AccountEventType = iota
+	OrgJoined
+	BucketCreated
+	ArchiveFinished
+)
+
+// AccountEvent describes a change to one of an account's resources.
+type AccountEvent struct {
+	Account crypto.PubKey
+	Type    AccountEventType
+	ID      string
+	Detail  string
+	Time    time.Time
+}
+
+// PublishAccountEvent sends an account event to bus.
+// Events are dropped if bus or account is nil, or if a listener is not ready to receive.
+func PublishAccountEvent(bus *broadcast.Broadcaster, account crypto.PubKey, typ AccountEventType, id, detail string) {
+	if bus == nil || account == nil {
+		return
+	}
+	_ = bus.Send(AccountEvent{
+		Account: account,
+		Type:    typ,
+		ID:      id,
+		Detail:  detail,
+		Time:    time.Now(),
+	})
+}
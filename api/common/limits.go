@@ -0,0 +1,23 @@
+package common
+
+import "fmt"
+
+// Names of limits reported by LimitExceededError.
+const (
+	// LimitThreadsPerOwner limits the number of threads owned by an account or user.
+	LimitThreadsPerOwner = "threads per owner"
+	// LimitThreadsPerKey limits the number of threads created with an API key.
+	LimitThreadsPerKey = "threads per key"
+)
+
+// LimitExceededError indicates a request was rejected because it would exceed a limit.
+// Current is the usage at the time of the request and Max is the configured limit.
+type LimitExceededError struct {
+	Limit   string
+	Current int64
+	Max     int64
+}
+
+func (e *LimitExceededError) Error() string {
+	return fmt.Sprintf("number of %s exceeds quota (current %d, limit %d)", e.Limit, e.Current, e.Max)
+}
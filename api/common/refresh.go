@@ -0,0 +1,107 @@
+package common
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SessionProvider returns a new session, e.g., by signing in again with stored credentials.
+type SessionProvider func(ctx context.Context) (session string, err error)
+
+// WithSessionRefresh returns a dial option that transparently re-authenticates
+// when a unary call fails because its session has expired or is no longer valid.
+// The provider is called to obtain a new session and the call is retried once with it.
+// Calls made later with the stale session are sent with the new session instead,
+// so the provider is only called once per expired session.
+// Streaming calls are not retried, but are sent with the new session if one is known.
+// Calls that do not carry a session in their context are not affected.
+func WithSessionRefresh(provider SessionProvider) []grpc.DialOption {
+	r := &sessionRefresher{provider: provider, sessions: make(map[string]string)}
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(r.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(r.streamInterceptor),
+	}
+}
+
+type sessionRefresher struct {
+	provider SessionProvider
+	sessions map[string]string // stale -> fresh
+	lk       sync.Mutex
+}
+
+func (r *sessionRefresher) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	session, ok := SessionFromContext(ctx)
+	if !ok {
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+	session = r.current(session)
+	err := invoker(NewSessionContext(ctx, session), method, req, reply, cc, opts...)
+	if !isSessionError(err) {
+		return err
+	}
+	fresh, rerr := r.refresh(ctx, session)
+	if rerr != nil {
+		return err
+	}
+	return invoker(NewSessionContext(ctx, fresh), method, req, reply, cc, opts...)
+}
+
+func (r *sessionRefresher) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	if session, ok := SessionFromContext(ctx); ok {
+		ctx = NewSessionContext(ctx, r.current(session))
+	}
+	return streamer(ctx, desc, cc, method, opts...)
+}
+
+// current follows the chain of refreshed sessions starting at session.
+func (r *sessionRefresher) current(session string) string {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	return r.currentLocked(session)
+}
+
+func (r *sessionRefresher) currentLocked(session string) string {
+	for {
+		fresh, ok := r.sessions[session]
+		if !ok {
+			return session
+		}
+		session = fresh
+	}
+}
+
+// refresh returns a new session to replace stale.
+// If another call already replaced stale, that session is returned.
+func (r *sessionRefresher) refresh(ctx context.Context, stale string) (string, error) {
+	r.lk.Lock()
+	defer r.lk.Unlock()
+	if fresh := r.currentLocked(stale); fresh != stale {
+		return fresh, nil
+	}
+	fresh, err := r.provider(ctx)
+	if err != nil {
+		return "", err
+	}
+	if fresh != stale {
+		r.sessions[stale] = fresh
+	}
+	return fresh, nil
+}
+
+// isSessionError returns true if err indicates the call's session has expired or is not valid.
+func isSessionError(err error) bool {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.Unauthenticated {
+		return false
+	}
+	switch st.Message() {
+	case "Expired session", "Invalid session":
+		return true
+	default:
+		return false
+	}
+}
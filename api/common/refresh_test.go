@@ -0,0 +1,80 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSessionRefresher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("refreshes expired session", func(t *testing.T) {
+		var refreshes int
+		r := &sessionRefresher{
+			provider: func(context.Context) (string, error) {
+				refreshes++
+				return "fresh", nil
+			},
+			sessions: make(map[string]string),
+		}
+		var sent []string
+		invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+			session, _ := SessionFromContext(ctx)
+			sent = append(sent, session)
+			if session == "stale" {
+				return status.Error(codes.Unauthenticated, "Expired session")
+			}
+			return nil
+		}
+		ctx := NewSessionContext(context.Background(), "stale")
+		err := r.unaryInterceptor(ctx, "/test", nil, nil, nil, invoker)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"stale", "fresh"}, sent)
+
+		// Later calls with the stale session use the fresh one.
+		err = r.unaryInterceptor(ctx, "/test", nil, nil, nil, invoker)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"stale", "fresh", "fresh"}, sent)
+		assert.Equal(t, 1, refreshes)
+	})
+
+	t.Run("ignores other errors", func(t *testing.T) {
+		r := &sessionRefresher{
+			provider: func(context.Context) (string, error) {
+				t.Fatal("provider should not be called")
+				return "", nil
+			},
+			sessions: make(map[string]string),
+		}
+		var calls int
+		invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+			calls++
+			return status.Error(codes.Unauthenticated, "Bad API key signature")
+		}
+		ctx := NewSessionContext(context.Background(), "session")
+		err := r.unaryInterceptor(ctx, "/test", nil, nil, nil, invoker)
+		require.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("returns original error if refresh fails", func(t *testing.T) {
+		r := &sessionRefresher{
+			provider: func(context.Context) (string, error) {
+				return "", status.Error(codes.Unavailable, "unavailable")
+			},
+			sessions: make(map[string]string),
+		}
+		invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+			return status.Error(codes.Unauthenticated, "Expired session")
+		}
+		ctx := NewSessionContext(context.Background(), "stale")
+		err := r.unaryInterceptor(ctx, "/test", nil, nil, nil, invoker)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
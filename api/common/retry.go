@@ -0,0 +1,130 @@
+package common
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMaxRetries     = 3
+	defaultInitialBackoff = time.Millisecond * 100
+	defaultMaxBackoff     = time.Second * 5
+)
+
+// defaultRetryCodes are gRPC status codes that usually indicate a transient failure.
+var defaultRetryCodes = []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.Aborted}
+
+type retryOptions struct {
+	max            int
+	codes          []codes.Code
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+type RetryOption func(*retryOptions)
+
+// WithMaxRetries sets the max number of times a call is retried.
+func WithMaxRetries(max int) RetryOption {
+	return func(args *retryOptions) {
+		args.max = max
+	}
+}
+
+// WithRetryCodes sets the gRPC status codes that trigger a retry.
+// The default codes are Unavailable, ResourceExhausted, and Aborted.
+func WithRetryCodes(codes ...codes.Code) RetryOption {
+	return func(args *retryOptions) {
+		args.codes = codes
+	}
+}
+
+// WithBackoff sets the initial and max wait between retries.
+// The wait doubles after each attempt, up to max.
+func WithBackoff(initial, max time.Duration) RetryOption {
+	return func(args *retryOptions) {
+		args.initialBackoff = initial
+		args.maxBackoff = max
+	}
+}
+
+// WithRetry returns a dial option that retries unary calls that fail with a transient status code,
+// waiting with exponential backoff between attempts.
+// Streaming calls are not retried.
+// Note: Retried calls may be applied more than once by the remote if the failure
+// occurred after the request was handled.
+func WithRetry(opts ...RetryOption) grpc.DialOption {
+	args := &retryOptions{
+		max:            defaultMaxRetries,
+		codes:          defaultRetryCodes,
+		initialBackoff: defaultInitialBackoff,
+		maxBackoff:     defaultMaxBackoff,
+	}
+	for _, opt := range opts {
+		opt(args)
+	}
+	return grpc.WithChainUnaryInterceptor(retryInterceptor(args))
+}
+
+func retryInterceptor(args *retryOptions) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var err error
+		for attempt := 0; ; attempt++ {
+			err = invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || attempt >= args.max || !isRetryable(err, args.codes) {
+				return err
+			}
+			timer := time.NewTimer(backoff(attempt, args.initialBackoff, args.maxBackoff))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+		}
+	}
+}
+
+func isRetryable(err error, retryCodes []codes.Code) bool {
+	code := status.Code(err)
+	for _, c := range retryCodes {
+		if code == c {
+			return true
+		}
+	}
+	return false
+}
+
+// backoff returns the wait before the next attempt.
+// Jitter is added to avoid many clients retrying in lockstep.
+func backoff(attempt int, initial, max time.Duration) time.Duration {
+	wait := initial << uint(attempt)
+	if wait > max || wait <= 0 {
+		wait = max
+	}
+	half := int64(wait / 2)
+	if half <= 0 {
+		return wait
+	}
+	return time.Duration(half + rand.Int63n(half))
+}
+
+// WithTimeout returns a dial option that applies a default timeout to unary calls.
+// The timeout is not applied if the call context already has a deadline.
+// When combined with WithRetry, pass WithTimeout first to bound the total time
+// across all attempts, or after to bound each attempt.
+func WithTimeout(timeout time.Duration) grpc.DialOption {
+	return grpc.WithChainUnaryInterceptor(
+		func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+			if _, ok := ctx.Deadline(); !ok && timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+}
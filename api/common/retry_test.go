@@ -0,0 +1,70 @@
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRetryInterceptor(t *testing.T) {
+	t.Parallel()
+	args := &retryOptions{
+		max:            3,
+		codes:          defaultRetryCodes,
+		initialBackoff: time.Millisecond,
+		maxBackoff:     time.Millisecond * 10,
+	}
+	interceptor := retryInterceptor(args)
+
+	t.Run("retries transient errors", func(t *testing.T) {
+		var calls int
+		invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+			calls++
+			if calls < 3 {
+				return status.Error(codes.Unavailable, "unavailable")
+			}
+			return nil
+		}
+		err := interceptor(context.Background(), "/test", nil, nil, nil, invoker)
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("gives up after max retries", func(t *testing.T) {
+		var calls int
+		invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+			calls++
+			return status.Error(codes.Unavailable, "unavailable")
+		}
+		err := interceptor(context.Background(), "/test", nil, nil, nil, invoker)
+		require.Error(t, err)
+		assert.Equal(t, 4, calls)
+	})
+
+	t.Run("does not retry other errors", func(t *testing.T) {
+		var calls int
+		invoker := func(context.Context, string, interface{}, interface{}, *grpc.ClientConn, ...grpc.CallOption) error {
+			calls++
+			return status.Error(codes.NotFound, "not found")
+		}
+		err := interceptor(context.Background(), "/test", nil, nil, nil, invoker)
+		require.Error(t, err)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+	for i := 0; i < 10; i++ {
+		wait := backoff(i, time.Millisecond*100, time.Second)
+		assert.True(t, wait > 0)
+		assert.True(t, wait <= time.Second)
+	}
+	assert.True(t, backoff(100, time.Millisecond*100, time.Second) <= time.Second)
+}
@@ -0,0 +1,250 @@
+package hub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/textileio/textile/api/common"
+	pb "github.com/textileio/textile/api/hub/pb"
+	mdb "github.com/textileio/textile/mongodb"
+	"github.com/textileio/textile/util"
+	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Service) PlanSpec(ctx context.Context, req *pb.ApplySpecRequest) (*pb.ApplySpecReply, error) {
+	log.Debugf("received plan spec request")
+
+	dev, _ := mdb.DevFromContext(ctx)
+	changes, err := s.reconcile(ctx, dev, req.Spec, req.Prune, false)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApplySpecReply{Changes: changes}, nil
+}
+
+func (s *Service) ApplySpec(ctx context.Context, req *pb.ApplySpecRequest) (*pb.ApplySpecReply, error) {
+	log.Debugf("received apply spec request")
+
+	dev, _ := mdb.DevFromContext(ctx)
+	changes, err := s.reconcile(ctx, dev, req.Spec, req.Prune, true)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApplySpecReply{Changes: changes}, nil
+}
+
+// reconcile computes the changes needed to bring the dev's keys and orgs in line with spec.
+// Changes are only made if apply is true.
+// If prune is true, keys and org members not in spec are invalidated and removed.
+// Orgs are never removed.
+func (s *Service) reconcile(ctx context.Context, dev *mdb.Account, spec *pb.Spec, prune, apply bool) ([]*pb.ApplySpecReply_Change, error) {
+	if spec == nil {
+		return nil, status.Error(codes.InvalidArgument, "Spec required")
+	}
+	changes, err := s.reconcileKeys(ctx, dev.Key, "", spec.Keys, prune, apply)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]struct{})
+	for _, o := range spec.Orgs {
+		slg, ok := util.ToValidName(o.Name)
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Org name '%s' is not valid", o.Name)
+		}
+		if _, ok := seen[slg]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "Org '%s' is declared more than once", o.Name)
+		}
+		seen[slg] = struct{}{}
+		oc, err := s.reconcileOrg(ctx, dev, slg, o, prune, apply)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, oc...)
+	}
+	return changes, nil
+}
+
+func (s *Service) reconcileOrg(ctx context.Context, dev *mdb.Account, slg string, spec *pb.Spec_Org, prune, apply bool) ([]*pb.ApplySpecReply_Change, error) {
+	var changes []*pb.ApplySpecReply_Change
+	org, err := s.Collections.Accounts.GetByUsername(ctx, slg)
+	if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	if org != nil {
+		if org.Type != mdb.Org {
+			return nil, status.Errorf(codes.FailedPrecondition, "Name '%s' is already taken", spec.Name)
+		}
+		isOwner, err := s.Collections.Accounts.IsOwner(ctx, slg, dev.Key)
+		if err != nil {
+			return nil, err
+		}
+		if !isOwner {
+			return nil, status.Errorf(codes.PermissionDenied, "User must be an owner of org '%s'", spec.Name)
+		}
+	} else {
+		changes = append(changes, &pb.ApplySpecReply_Change{
+			Action:   pb.ApplySpecReply_Change_CREATE,
+			Resource: "org",
+			ID:       slg,
+			Org:      slg,
+		})
+		if apply {
+			org, err = s.createOrg(ctx, dev, spec.Name)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Invite missing members.
+	keep := map[string]struct{}{dev.Username: {}}
+	for _, m := range spec.Members {
+		if acc, err := s.Collections.Accounts.GetByUsernameOrEmail(ctx, m); err == nil {
+			keep[acc.Username] = struct{}{}
+		} else if !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, err
+		}
+		email, err := s.resolveInvitee(ctx, org, m)
+		if err != nil {
+			if err == errAlreadyMember {
+				continue
+			}
+			return nil, status.Errorf(codes.InvalidArgument, "Member '%s' of org '%s' is not valid: %v", m, spec.Name, err)
+		}
+		pending, err := s.hasPendingInvite(ctx, slg, email)
+		if err != nil {
+			return nil, err
+		}
+		if pending {
+			continue
+		}
+		changes = append(changes, &pb.ApplySpecReply_Change{
+			Action:   pb.ApplySpecReply_Change_INVITE,
+			Resource: "member",
+			ID:       m,
+			Org:      slg,
+		})
+		if apply {
+			if _, err := s.inviteToOrg(ctx, dev, org, email); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Remove undeclared members. Owners are never removed.
+	if prune && org != nil {
+		for _, m := range org.Members {
+			if _, ok := keep[m.Username]; ok || m.Role == mdb.OrgOwner {
+				continue
+			}
+			changes = append(changes, &pb.ApplySpecReply_Change{
+				Action:   pb.ApplySpecReply_Change_REMOVE,
+				Resource: "member",
+				ID:       m.Username,
+				Org:      slg,
+			})
+			if apply {
+				if err := s.Collections.Accounts.RemoveMember(ctx, slg, m.Key); err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	var owner crypto.PubKey
+	if org != nil {
+		owner = org.Key
+	}
+	kc, err := s.reconcileKeys(ctx, owner, slg, spec.Keys, prune, apply)
+	if err != nil {
+		return nil, err
+	}
+	return append(changes, kc...), nil
+}
+
+// reconcileKeys matches valid keys of owner against spec by type and security.
+// A nil owner indicates the owner does not exist yet, in which case all keys are created.
+func (s *Service) reconcileKeys(ctx context.Context, owner crypto.PubKey, org string, spec []*pb.Spec_Key, prune, apply bool) ([]*pb.ApplySpecReply_Change, error) {
+	type keyClass struct {
+		typ    mdb.APIKeyType
+		secure bool
+	}
+	existing := make(map[keyClass][]string)
+	if owner != nil {
+		keys, err := s.Collections.APIKeys.ListByOwner(ctx, owner)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			if k.Valid {
+				c := keyClass{typ: k.Type, secure: k.Secure}
+				existing[c] = append(existing[c], k.Key)
+			}
+		}
+	}
+
+	var changes []*pb.ApplySpecReply_Change
+	for _, k := range spec {
+		c := keyClass{typ: mdb.APIKeyType(k.Type), secure: k.Secure}
+		if len(existing[c]) > 0 {
+			existing[c] = existing[c][1:]
+			continue
+		}
+		change := &pb.ApplySpecReply_Change{
+			Action:   pb.ApplySpecReply_Change_CREATE,
+			Resource: "key",
+			Org:      org,
+			Detail:   keyDetail(k.Type, k.Secure),
+		}
+		if apply {
+			key, err := s.Collections.APIKeys.Create(ctx, owner, c.typ, c.secure)
+			if err != nil {
+				return nil, err
+			}
+			common.PublishAccountEvent(s.AccountEventBus, owner, common.KeyCreated, key.Key, change.Detail)
+			change.ID = key.Key
+		}
+		changes = append(changes, change)
+	}
+	if prune {
+		for c, keys := range existing {
+			for _, k := range keys {
+				changes = append(changes, &pb.ApplySpecReply_Change{
+					Action:   pb.ApplySpecReply_Change_INVALIDATE,
+					Resource: "key",
+					ID:       k,
+					Org:      org,
+					Detail:   keyDetail(pb.KeyType(c.typ), c.secure),
+				})
+				if apply {
+					if err := s.Collections.APIKeys.Invalidate(ctx, k); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+	return changes, nil
+}
+
+func (s *Service) hasPendingInvite(ctx context.Context, org, email string) (bool, error) {
+	invites, err := s.Collections.Invites.ListByEmail(ctx, email)
+	if err != nil {
+		return false, err
+	}
+	for _, i := range invites {
+		if i.Org == org && !i.Accepted && i.ExpiresAt.After(time.Now()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func keyDetail(typ pb.KeyType, secure bool) string {
+	return fmt.Sprintf("type=%s secure=%t", typ, secure)
+}
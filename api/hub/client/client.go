@@ -2,9 +2,12 @@ package client
 
 import (
 	"context"
+	"io"
 
 	pb "github.com/textileio/textile/api/hub/pb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Client provides the client api.
@@ -14,6 +17,8 @@ type Client struct {
 }
 
 // NewClient starts the client.
+// Use common.WithRetry and common.WithTimeout to retry transient failures and set default call timeouts.
+// Use common.WithSessionRefresh to re-authenticate automatically when a session expires.
 func NewClient(target string, opts ...grpc.DialOption) (*Client, error) {
 	conn, err := grpc.Dial(target, opts...)
 	if err != nil {
@@ -73,6 +78,16 @@ func (c *Client) InvalidateKey(ctx context.Context, key string) error {
 	return err
 }
 
+// SetKeyUserQuota caps the buckets total size of each user of a user group key.
+// Use zero to remove the cap.
+func (c *Client) SetKeyUserQuota(ctx context.Context, key string, bucketsMaxSize int64) error {
+	_, err := c.c.SetKeyUserQuota(ctx, &pb.SetKeyUserQuotaRequest{
+		Key:            key,
+		BucketsMaxSize: bucketsMaxSize,
+	})
+	return err
+}
+
 // ListKeys returns a list of keys for the current session.
 func (c *Client) ListKeys(ctx context.Context) (*pb.ListKeysReply, error) {
 	return c.c.ListKeys(ctx, &pb.ListKeysRequest{})
@@ -106,6 +121,14 @@ func (c *Client) InviteToOrg(ctx context.Context, email string) (*pb.InviteToOrg
 	})
 }
 
+// InviteManyToOrg invites the given emails or usernames to an org.
+// The reply contains a result for each invitee.
+func (c *Client) InviteManyToOrg(ctx context.Context, invitees []string) (*pb.InviteManyToOrgReply, error) {
+	return c.c.InviteManyToOrg(ctx, &pb.InviteManyToOrgRequest{
+		Invitees: invitees,
+	})
+}
+
 // LeaveOrg removes the current session dev from an org.
 func (c *Client) LeaveOrg(ctx context.Context) error {
 	_, err := c.c.LeaveOrg(ctx, &pb.LeaveOrgRequest{})
@@ -127,6 +150,101 @@ func (c *Client) IsOrgNameAvailable(ctx context.Context, name string) (*pb.IsOrg
 	})
 }
 
+// GetUsageReport returns a storage usage report for all threads and buckets of the account.
+// Use groupBy to aggregate bucket usage by the value of a tag key.
+func (c *Client) GetUsageReport(ctx context.Context, groupBy string) (*pb.GetUsageReportReply, error) {
+	return c.c.GetUsageReport(ctx, &pb.GetUsageReportRequest{
+		GroupBy: groupBy,
+	})
+}
+
+// PlanSpec returns the changes needed to reconcile the account's keys and orgs with spec.
+// No changes are made.
+// If prune is true, the plan includes invalidating keys and removing org members not declared in spec.
+func (c *Client) PlanSpec(ctx context.Context, spec *pb.Spec, prune bool) (*pb.ApplySpecReply, error) {
+	return c.c.PlanSpec(ctx, &pb.ApplySpecRequest{
+		Spec:  spec,
+		Prune: prune,
+	})
+}
+
+// ApplySpec reconciles the account's keys and orgs with spec and returns the changes made.
+// Applying the same spec more than once is idempotent.
+// If prune is true, keys and org members not declared in spec are invalidated and removed.
+func (c *Client) ApplySpec(ctx context.Context, spec *pb.Spec, prune bool) (*pb.ApplySpecReply, error) {
+	return c.c.ApplySpec(ctx, &pb.ApplySpecRequest{
+		Spec:  spec,
+		Prune: prune,
+	})
+}
+
+// ListAuditLogs returns audit logs for the account, newest first.
+// Use resource to only list logs for a single resource, e.g., "bucket/<key>".
+func (c *Client) ListAuditLogs(ctx context.Context, resource string) (*pb.ListAuditLogsReply, error) {
+	return c.c.ListAuditLogs(ctx, &pb.ListAuditLogsRequest{
+		Resource: resource,
+	})
+}
+
+// WatchAccount sends account events to ch until ctx is canceled.
+// Events include created keys, joined orgs, created buckets, and finished archives.
+func (c *Client) WatchAccount(ctx context.Context, ch chan<- *pb.WatchAccountReply_Event) error {
+	stream, err := c.c.WatchAccount(ctx, &pb.WatchAccountRequest{})
+	if err != nil {
+		return err
+	}
+	for {
+		reply, err := stream.Recv()
+		if err == io.EOF || status.Code(err) == codes.Canceled {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		ch <- reply.Event
+	}
+	return nil
+}
+
+// SetPushPolicy replaces the push policy of the org in the context.
+// Changes to the org's buckets that break the policy are rejected.
+// Only org owners can set the policy.
+func (c *Client) SetPushPolicy(ctx context.Context, policy *pb.PushPolicy) error {
+	_, err := c.c.SetPushPolicy(ctx, &pb.SetPushPolicyRequest{
+		Policy: policy,
+	})
+	return err
+}
+
+// GetPushPolicy returns the push policy of the org in the context.
+func (c *Client) GetPushPolicy(ctx context.Context) (*pb.PushPolicy, error) {
+	rep, err := c.c.GetPushPolicy(ctx, &pb.GetPushPolicyRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return rep.Policy, nil
+}
+
+// SetArchiveConfig replaces the default Filecoin archive config of the org in the context.
+// The config is applied to the org's buckets when they are created.
+// Only org owners can set the config.
+func (c *Client) SetArchiveConfig(ctx context.Context, config *pb.ArchiveConfig) error {
+	_, err := c.c.SetArchiveConfig(ctx, &pb.SetArchiveConfigRequest{
+		Config: config,
+	})
+	return err
+}
+
+// GetArchiveConfig returns the default Filecoin archive config of the org in the context.
+// An empty config is returned if the org uses the hub default.
+func (c *Client) GetArchiveConfig(ctx context.Context) (*pb.ArchiveConfig, error) {
+	rep, err := c.c.GetArchiveConfig(ctx, &pb.GetArchiveConfigRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return rep.Config, nil
+}
+
 // DestroyAccount completely deletes an account and all associated data.
 func (c *Client) DestroyAccount(ctx context.Context) error {
 	_, err := c.c.DestroyAccount(ctx, &pb.DestroyAccountRequest{})
@@ -6,10 +6,12 @@ import (
 	"net/http"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tc "github.com/textileio/go-threads/api/client"
+	"github.com/textileio/go-threads/core/thread"
 	tutil "github.com/textileio/go-threads/util"
 	"github.com/textileio/textile/api/apitest"
 	"github.com/textileio/textile/api/common"
@@ -288,6 +290,29 @@ func TestClient_InviteToOrg(t *testing.T) {
 	})
 }
 
+func TestClient_InviteManyToOrg(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t)
+
+	name := apitest.NewUsername()
+	username := apitest.NewUsername()
+	user := apitest.Signup(t, client, conf, username, apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+	org, err := client.CreateOrg(ctx, name)
+	require.NoError(t, err)
+	ctx = common.NewOrgSlugContext(ctx, org.Name)
+
+	email := apitest.NewEmail()
+	res, err := client.InviteManyToOrg(ctx, []string{email, "jane", username, email})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(res.Results))
+	assert.Equal(t, pb.InviteManyToOrgReply_Result_SENT, res.Results[0].Status)
+	assert.NotEmpty(t, res.Results[0].Token)
+	assert.Equal(t, pb.InviteManyToOrgReply_Result_INVALID, res.Results[1].Status)
+	assert.Equal(t, pb.InviteManyToOrgReply_Result_ALREADY_MEMBER, res.Results[2].Status)
+	assert.Equal(t, pb.InviteManyToOrgReply_Result_INVALID, res.Results[3].Status)
+}
+
 func TestClient_LeaveOrg(t *testing.T) {
 	t.Parallel()
 	conf, client, _ := setup(t)
@@ -358,6 +383,221 @@ func TestClient_IsOrgNameAvailable(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestClient_GetUsageReport(t *testing.T) {
+	t.Parallel()
+	conf, client, threadsclient := setup(t)
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+
+	t.Run("empty", func(t *testing.T) {
+		res, err := client.GetUsageReport(ctx, "")
+		require.NoError(t, err)
+		assert.Empty(t, res.Resources)
+		assert.Equal(t, int64(0), res.TotalSize)
+		require.NotNil(t, res.Dedup)
+		assert.Equal(t, int64(0), res.Dedup.SavedSize)
+	})
+
+	err := threadsclient.NewDB(ctx, thread.NewIDV1(thread.Raw, 32))
+	require.NoError(t, err)
+
+	t.Run("not empty", func(t *testing.T) {
+		res, err := client.GetUsageReport(ctx, "team")
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(res.Resources))
+		assert.Equal(t, "thread", res.Resources[0].Type)
+	})
+}
+
+func TestClient_WatchAccount(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t)
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+
+	wctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ch := make(chan *pb.WatchAccountReply_Event)
+	go func() {
+		_ = client.WatchAccount(wctx, ch)
+	}()
+	time.Sleep(time.Second)
+
+	key, err := client.CreateKey(ctx, pb.KeyType_ACCOUNT, true)
+	require.NoError(t, err)
+
+	select {
+	case e := <-ch:
+		assert.Equal(t, pb.WatchAccountReply_Event_KEY_CREATED, e.Type)
+		assert.Equal(t, key.Key, e.ID)
+	case <-time.After(time.Second * 10):
+		t.Fatal("timed out waiting for event")
+	}
+}
+
+func TestClient_PushPolicy(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t)
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+	org, err := client.CreateOrg(ctx, apitest.NewUsername())
+	require.NoError(t, err)
+	octx := common.NewOrgSlugContext(ctx, org.Name)
+
+	t.Run("no org", func(t *testing.T) {
+		err := client.SetPushPolicy(ctx, &pb.PushPolicy{MaxFileSize: 1024})
+		require.Error(t, err)
+	})
+
+	user2 := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx2 := common.NewSessionContext(context.Background(), user2.Session)
+
+	t.Run("not owner", func(t *testing.T) {
+		err := client.SetPushPolicy(common.NewOrgSlugContext(ctx2, org.Name), &pb.PushPolicy{MaxFileSize: 1024})
+		require.Error(t, err)
+	})
+
+	t.Run("set and get", func(t *testing.T) {
+		policy, err := client.GetPushPolicy(octx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), policy.MaxFileSize)
+
+		err = client.SetPushPolicy(octx, &pb.PushPolicy{
+			MaxFileSize:         1024,
+			ForbiddenExtensions: []string{"EXE", ".dll"},
+			RequiredPaths:       []string{"/LICENSE"},
+			RequireLicense:      true,
+		})
+		require.NoError(t, err)
+		policy, err = client.GetPushPolicy(octx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1024), policy.MaxFileSize)
+		assert.Equal(t, []string{".exe", ".dll"}, policy.ForbiddenExtensions)
+		assert.Equal(t, []string{"LICENSE"}, policy.RequiredPaths)
+		assert.True(t, policy.RequireLicense)
+
+		logs, err := client.ListAuditLogs(octx, "org/"+org.Name)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(logs.List))
+		assert.Equal(t, "push_policy.set", logs.List[0].Action)
+	})
+}
+
+func TestClient_ArchiveConfig(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t)
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+	org, err := client.CreateOrg(ctx, apitest.NewUsername())
+	require.NoError(t, err)
+	octx := common.NewOrgSlugContext(ctx, org.Name)
+
+	t.Run("no org", func(t *testing.T) {
+		err := client.SetArchiveConfig(ctx, &pb.ArchiveConfig{ColdEnabled: true})
+		require.Error(t, err)
+	})
+
+	user2 := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx2 := common.NewSessionContext(context.Background(), user2.Session)
+
+	t.Run("not owner", func(t *testing.T) {
+		err := client.SetArchiveConfig(common.NewOrgSlugContext(ctx2, org.Name), &pb.ArchiveConfig{ColdEnabled: true})
+		require.Error(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		err := client.SetArchiveConfig(octx, &pb.ArchiveConfig{})
+		require.Error(t, err)
+		err = client.SetArchiveConfig(octx, &pb.ArchiveConfig{ColdEnabled: true, RepFactor: -1})
+		require.Error(t, err)
+		err = client.SetArchiveConfig(octx, &pb.ArchiveConfig{ColdEnabled: true, DealMinDuration: 1})
+		require.Error(t, err)
+		err = client.SetArchiveConfig(octx, &pb.ArchiveConfig{
+			ColdEnabled:    true,
+			TrustedMiners:  []string{"f01000"},
+			ExcludedMiners: []string{"f01000"},
+		})
+		require.Error(t, err)
+	})
+
+	t.Run("set and get", func(t *testing.T) {
+		config, err := client.GetArchiveConfig(octx)
+		require.NoError(t, err)
+		assert.False(t, config.ColdEnabled)
+
+		err = client.SetArchiveConfig(octx, &pb.ArchiveConfig{
+			HotEnabled:    true,
+			ColdEnabled:   true,
+			RepFactor:     2,
+			TrustedMiners: []string{" f01000", "f01000", "f01001"},
+		})
+		require.NoError(t, err)
+		config, err = client.GetArchiveConfig(octx)
+		require.NoError(t, err)
+		assert.True(t, config.HotEnabled)
+		assert.True(t, config.ColdEnabled)
+		assert.Equal(t, int64(2), config.RepFactor)
+		assert.Equal(t, []string{"f01000", "f01001"}, config.TrustedMiners)
+
+		logs, err := client.ListAuditLogs(octx, "org/"+org.Name)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(logs.List))
+		assert.Equal(t, "archive_config.set", logs.List[0].Action)
+	})
+}
+
+func TestClient_ApplySpec(t *testing.T) {
+	t.Parallel()
+	conf, client, _ := setup(t)
+
+	user := apitest.Signup(t, client, conf, apitest.NewUsername(), apitest.NewEmail())
+	ctx := common.NewSessionContext(context.Background(), user.Session)
+
+	spec := &pb.Spec{
+		Keys: []*pb.Spec_Key{{Type: pb.KeyType_ACCOUNT, Secure: true}},
+		Orgs: []*pb.Spec_Org{{
+			Name:    apitest.NewUsername(),
+			Members: []string{apitest.NewEmail()},
+			Keys:    []*pb.Spec_Key{{Type: pb.KeyType_USER}},
+		}},
+	}
+
+	t.Run("plan", func(t *testing.T) {
+		res, err := client.PlanSpec(ctx, spec, false)
+		require.NoError(t, err)
+		assert.Equal(t, 4, len(res.Changes))
+		keys, err := client.ListKeys(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(keys.List))
+	})
+
+	t.Run("apply", func(t *testing.T) {
+		res, err := client.ApplySpec(ctx, spec, false)
+		require.NoError(t, err)
+		assert.Equal(t, 4, len(res.Changes))
+		keys, err := client.ListKeys(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(keys.List))
+	})
+
+	t.Run("idempotent", func(t *testing.T) {
+		res, err := client.ApplySpec(ctx, spec, false)
+		require.NoError(t, err)
+		assert.Equal(t, 0, len(res.Changes))
+	})
+
+	t.Run("prune", func(t *testing.T) {
+		res, err := client.PlanSpec(ctx, &pb.Spec{Orgs: spec.Orgs}, true)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(res.Changes))
+		assert.Equal(t, pb.ApplySpecReply_Change_INVALIDATE, res.Changes[0].Action)
+	})
+}
+
 func TestClient_DestroyAccount(t *testing.T) {
 	t.Parallel()
 	conf, client, _ := setup(t)
@@ -49,6 +49,102 @@ func (KeyType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_b3103f8d3056b01c, []int{0}
 }
 
+type InviteManyToOrgReply_Result_Status int32
+
+const (
+	InviteManyToOrgReply_Result_UNSPECIFIED    InviteManyToOrgReply_Result_Status = 0
+	InviteManyToOrgReply_Result_SENT           InviteManyToOrgReply_Result_Status = 1
+	InviteManyToOrgReply_Result_ALREADY_MEMBER InviteManyToOrgReply_Result_Status = 2
+	InviteManyToOrgReply_Result_INVALID        InviteManyToOrgReply_Result_Status = 3
+	InviteManyToOrgReply_Result_FAILED         InviteManyToOrgReply_Result_Status = 4
+)
+
+var InviteManyToOrgReply_Result_Status_name = map[int32]string{
+	0: "UNSPECIFIED",
+	1: "SENT",
+	2: "ALREADY_MEMBER",
+	3: "INVALID",
+	4: "FAILED",
+}
+
+var InviteManyToOrgReply_Result_Status_value = map[string]int32{
+	"UNSPECIFIED":    0,
+	"SENT":           1,
+	"ALREADY_MEMBER": 2,
+	"INVALID":        3,
+	"FAILED":         4,
+}
+
+func (x InviteManyToOrgReply_Result_Status) String() string {
+	return proto.EnumName(InviteManyToOrgReply_Result_Status_name, int32(x))
+}
+
+func (InviteManyToOrgReply_Result_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{26, 0, 0}
+}
+
+type ApplySpecReply_Change_Action int32
+
+const (
+	ApplySpecReply_Change_CREATE     ApplySpecReply_Change_Action = 0
+	ApplySpecReply_Change_INVITE     ApplySpecReply_Change_Action = 1
+	ApplySpecReply_Change_REMOVE     ApplySpecReply_Change_Action = 2
+	ApplySpecReply_Change_INVALIDATE ApplySpecReply_Change_Action = 3
+)
+
+var ApplySpecReply_Change_Action_name = map[int32]string{
+	0: "CREATE",
+	1: "INVITE",
+	2: "REMOVE",
+	3: "INVALIDATE",
+}
+
+var ApplySpecReply_Change_Action_value = map[string]int32{
+	"CREATE":     0,
+	"INVITE":     1,
+	"REMOVE":     2,
+	"INVALIDATE": 3,
+}
+
+func (x ApplySpecReply_Change_Action) String() string {
+	return proto.EnumName(ApplySpecReply_Change_Action_name, int32(x))
+}
+
+func (ApplySpecReply_Change_Action) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{37, 0, 0}
+}
+
+type WatchAccountReply_Event_Type int32
+
+const (
+	WatchAccountReply_Event_KEY_CREATED      WatchAccountReply_Event_Type = 0
+	WatchAccountReply_Event_ORG_JOINED       WatchAccountReply_Event_Type = 1
+	WatchAccountReply_Event_BUCKET_CREATED   WatchAccountReply_Event_Type = 2
+	WatchAccountReply_Event_ARCHIVE_FINISHED WatchAccountReply_Event_Type = 3
+)
+
+var WatchAccountReply_Event_Type_name = map[int32]string{
+	0: "KEY_CREATED",
+	1: "ORG_JOINED",
+	2: "BUCKET_CREATED",
+	3: "ARCHIVE_FINISHED",
+}
+
+var WatchAccountReply_Event_Type_value = map[string]int32{
+	"KEY_CREATED":      0,
+	"ORG_JOINED":       1,
+	"BUCKET_CREATED":   2,
+	"ARCHIVE_FINISHED": 3,
+}
+
+func (x WatchAccountReply_Event_Type) String() string {
+	return proto.EnumName(WatchAccountReply_Event_Type_name, int32(x))
+}
+
+func (WatchAccountReply_Event_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{41, 0, 0}
+}
+
 type SignupRequest struct {
 	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	Email                string   `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
@@ -431,6 +527,7 @@ type GetKeyReply struct {
 	Valid                bool     `protobuf:"varint,4,opt,name=valid,proto3" json:"valid,omitempty"`
 	Threads              int32    `protobuf:"varint,5,opt,name=threads,proto3" json:"threads,omitempty"`
 	Secure               bool     `protobuf:"varint,6,opt,name=secure,proto3" json:"secure,omitempty"`
+	UserBucketsMaxSize   int64    `protobuf:"varint,7,opt,name=userBucketsMaxSize,proto3" json:"userBucketsMaxSize,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -503,6 +600,13 @@ func (m *GetKeyReply) GetSecure() bool {
 	return false
 }
 
+func (m *GetKeyReply) GetUserBucketsMaxSize() int64 {
+	if m != nil {
+		return m.UserBucketsMaxSize
+	}
+	return 0
+}
+
 type InvalidateKeyRequest struct {
 	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -573,6 +677,84 @@ func (m *InvalidateKeyReply) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InvalidateKeyReply proto.InternalMessageInfo
 
+type SetKeyUserQuotaRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	BucketsMaxSize       int64    `protobuf:"varint,2,opt,name=bucketsMaxSize,proto3" json:"bucketsMaxSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetKeyUserQuotaRequest) Reset()         { *m = SetKeyUserQuotaRequest{} }
+func (m *SetKeyUserQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SetKeyUserQuotaRequest) ProtoMessage()    {}
+func (*SetKeyUserQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{12}
+}
+
+func (m *SetKeyUserQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetKeyUserQuotaRequest.Unmarshal(m, b)
+}
+func (m *SetKeyUserQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetKeyUserQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *SetKeyUserQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetKeyUserQuotaRequest.Merge(m, src)
+}
+func (m *SetKeyUserQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_SetKeyUserQuotaRequest.Size(m)
+}
+func (m *SetKeyUserQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetKeyUserQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetKeyUserQuotaRequest proto.InternalMessageInfo
+
+func (m *SetKeyUserQuotaRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *SetKeyUserQuotaRequest) GetBucketsMaxSize() int64 {
+	if m != nil {
+		return m.BucketsMaxSize
+	}
+	return 0
+}
+
+type SetKeyUserQuotaReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetKeyUserQuotaReply) Reset()         { *m = SetKeyUserQuotaReply{} }
+func (m *SetKeyUserQuotaReply) String() string { return proto.CompactTextString(m) }
+func (*SetKeyUserQuotaReply) ProtoMessage()    {}
+func (*SetKeyUserQuotaReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{13}
+}
+
+func (m *SetKeyUserQuotaReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetKeyUserQuotaReply.Unmarshal(m, b)
+}
+func (m *SetKeyUserQuotaReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetKeyUserQuotaReply.Marshal(b, m, deterministic)
+}
+func (m *SetKeyUserQuotaReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetKeyUserQuotaReply.Merge(m, src)
+}
+func (m *SetKeyUserQuotaReply) XXX_Size() int {
+	return xxx_messageInfo_SetKeyUserQuotaReply.Size(m)
+}
+func (m *SetKeyUserQuotaReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetKeyUserQuotaReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetKeyUserQuotaReply proto.InternalMessageInfo
+
 type ListKeysRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -583,7 +765,7 @@ func (m *ListKeysRequest) Reset()         { *m = ListKeysRequest{} }
 func (m *ListKeysRequest) String() string { return proto.CompactTextString(m) }
 func (*ListKeysRequest) ProtoMessage()    {}
 func (*ListKeysRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{12}
+	return fileDescriptor_b3103f8d3056b01c, []int{14}
 }
 
 func (m *ListKeysRequest) XXX_Unmarshal(b []byte) error {
@@ -615,7 +797,7 @@ func (m *ListKeysReply) Reset()         { *m = ListKeysReply{} }
 func (m *ListKeysReply) String() string { return proto.CompactTextString(m) }
 func (*ListKeysReply) ProtoMessage()    {}
 func (*ListKeysReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{13}
+	return fileDescriptor_b3103f8d3056b01c, []int{15}
 }
 
 func (m *ListKeysReply) XXX_Unmarshal(b []byte) error {
@@ -654,7 +836,7 @@ func (m *CreateOrgRequest) Reset()         { *m = CreateOrgRequest{} }
 func (m *CreateOrgRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateOrgRequest) ProtoMessage()    {}
 func (*CreateOrgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{14}
+	return fileDescriptor_b3103f8d3056b01c, []int{16}
 }
 
 func (m *CreateOrgRequest) XXX_Unmarshal(b []byte) error {
@@ -692,7 +874,7 @@ func (m *GetOrgRequest) Reset()         { *m = GetOrgRequest{} }
 func (m *GetOrgRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOrgRequest) ProtoMessage()    {}
 func (*GetOrgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{15}
+	return fileDescriptor_b3103f8d3056b01c, []int{17}
 }
 
 func (m *GetOrgRequest) XXX_Unmarshal(b []byte) error {
@@ -729,7 +911,7 @@ func (m *GetOrgReply) Reset()         { *m = GetOrgReply{} }
 func (m *GetOrgReply) String() string { return proto.CompactTextString(m) }
 func (*GetOrgReply) ProtoMessage()    {}
 func (*GetOrgReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{16}
+	return fileDescriptor_b3103f8d3056b01c, []int{18}
 }
 
 func (m *GetOrgReply) XXX_Unmarshal(b []byte) error {
@@ -805,7 +987,7 @@ func (m *GetOrgReply_Member) Reset()         { *m = GetOrgReply_Member{} }
 func (m *GetOrgReply_Member) String() string { return proto.CompactTextString(m) }
 func (*GetOrgReply_Member) ProtoMessage()    {}
 func (*GetOrgReply_Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{16, 0}
+	return fileDescriptor_b3103f8d3056b01c, []int{18, 0}
 }
 
 func (m *GetOrgReply_Member) XXX_Unmarshal(b []byte) error {
@@ -857,7 +1039,7 @@ func (m *ListOrgsRequest) Reset()         { *m = ListOrgsRequest{} }
 func (m *ListOrgsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListOrgsRequest) ProtoMessage()    {}
 func (*ListOrgsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{17}
+	return fileDescriptor_b3103f8d3056b01c, []int{19}
 }
 
 func (m *ListOrgsRequest) XXX_Unmarshal(b []byte) error {
@@ -889,7 +1071,7 @@ func (m *ListOrgsReply) Reset()         { *m = ListOrgsReply{} }
 func (m *ListOrgsReply) String() string { return proto.CompactTextString(m) }
 func (*ListOrgsReply) ProtoMessage()    {}
 func (*ListOrgsReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{18}
+	return fileDescriptor_b3103f8d3056b01c, []int{20}
 }
 
 func (m *ListOrgsReply) XXX_Unmarshal(b []byte) error {
@@ -927,7 +1109,7 @@ func (m *RemoveOrgRequest) Reset()         { *m = RemoveOrgRequest{} }
 func (m *RemoveOrgRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveOrgRequest) ProtoMessage()    {}
 func (*RemoveOrgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{19}
+	return fileDescriptor_b3103f8d3056b01c, []int{21}
 }
 
 func (m *RemoveOrgRequest) XXX_Unmarshal(b []byte) error {
@@ -958,7 +1140,7 @@ func (m *RemoveOrgReply) Reset()         { *m = RemoveOrgReply{} }
 func (m *RemoveOrgReply) String() string { return proto.CompactTextString(m) }
 func (*RemoveOrgReply) ProtoMessage()    {}
 func (*RemoveOrgReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{20}
+	return fileDescriptor_b3103f8d3056b01c, []int{22}
 }
 
 func (m *RemoveOrgReply) XXX_Unmarshal(b []byte) error {
@@ -990,7 +1172,7 @@ func (m *InviteToOrgRequest) Reset()         { *m = InviteToOrgRequest{} }
 func (m *InviteToOrgRequest) String() string { return proto.CompactTextString(m) }
 func (*InviteToOrgRequest) ProtoMessage()    {}
 func (*InviteToOrgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{21}
+	return fileDescriptor_b3103f8d3056b01c, []int{23}
 }
 
 func (m *InviteToOrgRequest) XXX_Unmarshal(b []byte) error {
@@ -1029,7 +1211,7 @@ func (m *InviteToOrgReply) Reset()         { *m = InviteToOrgReply{} }
 func (m *InviteToOrgReply) String() string { return proto.CompactTextString(m) }
 func (*InviteToOrgReply) ProtoMessage()    {}
 func (*InviteToOrgReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{22}
+	return fileDescriptor_b3103f8d3056b01c, []int{24}
 }
 
 func (m *InviteToOrgReply) XXX_Unmarshal(b []byte) error {
@@ -1057,6 +1239,147 @@ func (m *InviteToOrgReply) GetToken() string {
 	return ""
 }
 
+type InviteManyToOrgRequest struct {
+	Invitees             []string `protobuf:"bytes,1,rep,name=invitees,proto3" json:"invitees,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InviteManyToOrgRequest) Reset()         { *m = InviteManyToOrgRequest{} }
+func (m *InviteManyToOrgRequest) String() string { return proto.CompactTextString(m) }
+func (*InviteManyToOrgRequest) ProtoMessage()    {}
+func (*InviteManyToOrgRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{25}
+}
+
+func (m *InviteManyToOrgRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteManyToOrgRequest.Unmarshal(m, b)
+}
+func (m *InviteManyToOrgRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteManyToOrgRequest.Marshal(b, m, deterministic)
+}
+func (m *InviteManyToOrgRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteManyToOrgRequest.Merge(m, src)
+}
+func (m *InviteManyToOrgRequest) XXX_Size() int {
+	return xxx_messageInfo_InviteManyToOrgRequest.Size(m)
+}
+func (m *InviteManyToOrgRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteManyToOrgRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteManyToOrgRequest proto.InternalMessageInfo
+
+func (m *InviteManyToOrgRequest) GetInvitees() []string {
+	if m != nil {
+		return m.Invitees
+	}
+	return nil
+}
+
+type InviteManyToOrgReply struct {
+	Results              []*InviteManyToOrgReply_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *InviteManyToOrgReply) Reset()         { *m = InviteManyToOrgReply{} }
+func (m *InviteManyToOrgReply) String() string { return proto.CompactTextString(m) }
+func (*InviteManyToOrgReply) ProtoMessage()    {}
+func (*InviteManyToOrgReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{26}
+}
+
+func (m *InviteManyToOrgReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteManyToOrgReply.Unmarshal(m, b)
+}
+func (m *InviteManyToOrgReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteManyToOrgReply.Marshal(b, m, deterministic)
+}
+func (m *InviteManyToOrgReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteManyToOrgReply.Merge(m, src)
+}
+func (m *InviteManyToOrgReply) XXX_Size() int {
+	return xxx_messageInfo_InviteManyToOrgReply.Size(m)
+}
+func (m *InviteManyToOrgReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteManyToOrgReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteManyToOrgReply proto.InternalMessageInfo
+
+func (m *InviteManyToOrgReply) GetResults() []*InviteManyToOrgReply_Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type InviteManyToOrgReply_Result struct {
+	Invitee              string                             `protobuf:"bytes,1,opt,name=invitee,proto3" json:"invitee,omitempty"`
+	Status               InviteManyToOrgReply_Result_Status `protobuf:"varint,2,opt,name=status,proto3,enum=hub.pb.InviteManyToOrgReply_Result_Status" json:"status,omitempty"`
+	Token                string                             `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	Message              string                             `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
+}
+
+func (m *InviteManyToOrgReply_Result) Reset()         { *m = InviteManyToOrgReply_Result{} }
+func (m *InviteManyToOrgReply_Result) String() string { return proto.CompactTextString(m) }
+func (*InviteManyToOrgReply_Result) ProtoMessage()    {}
+func (*InviteManyToOrgReply_Result) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{26, 0}
+}
+
+func (m *InviteManyToOrgReply_Result) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InviteManyToOrgReply_Result.Unmarshal(m, b)
+}
+func (m *InviteManyToOrgReply_Result) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InviteManyToOrgReply_Result.Marshal(b, m, deterministic)
+}
+func (m *InviteManyToOrgReply_Result) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InviteManyToOrgReply_Result.Merge(m, src)
+}
+func (m *InviteManyToOrgReply_Result) XXX_Size() int {
+	return xxx_messageInfo_InviteManyToOrgReply_Result.Size(m)
+}
+func (m *InviteManyToOrgReply_Result) XXX_DiscardUnknown() {
+	xxx_messageInfo_InviteManyToOrgReply_Result.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InviteManyToOrgReply_Result proto.InternalMessageInfo
+
+func (m *InviteManyToOrgReply_Result) GetInvitee() string {
+	if m != nil {
+		return m.Invitee
+	}
+	return ""
+}
+
+func (m *InviteManyToOrgReply_Result) GetStatus() InviteManyToOrgReply_Result_Status {
+	if m != nil {
+		return m.Status
+	}
+	return InviteManyToOrgReply_Result_UNSPECIFIED
+}
+
+func (m *InviteManyToOrgReply_Result) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *InviteManyToOrgReply_Result) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
 type LeaveOrgRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -1067,7 +1390,7 @@ func (m *LeaveOrgRequest) Reset()         { *m = LeaveOrgRequest{} }
 func (m *LeaveOrgRequest) String() string { return proto.CompactTextString(m) }
 func (*LeaveOrgRequest) ProtoMessage()    {}
 func (*LeaveOrgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{23}
+	return fileDescriptor_b3103f8d3056b01c, []int{27}
 }
 
 func (m *LeaveOrgRequest) XXX_Unmarshal(b []byte) error {
@@ -1098,7 +1421,7 @@ func (m *LeaveOrgReply) Reset()         { *m = LeaveOrgReply{} }
 func (m *LeaveOrgReply) String() string { return proto.CompactTextString(m) }
 func (*LeaveOrgReply) ProtoMessage()    {}
 func (*LeaveOrgReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{24}
+	return fileDescriptor_b3103f8d3056b01c, []int{28}
 }
 
 func (m *LeaveOrgReply) XXX_Unmarshal(b []byte) error {
@@ -1130,7 +1453,7 @@ func (m *IsUsernameAvailableRequest) Reset()         { *m = IsUsernameAvailableR
 func (m *IsUsernameAvailableRequest) String() string { return proto.CompactTextString(m) }
 func (*IsUsernameAvailableRequest) ProtoMessage()    {}
 func (*IsUsernameAvailableRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{25}
+	return fileDescriptor_b3103f8d3056b01c, []int{29}
 }
 
 func (m *IsUsernameAvailableRequest) XXX_Unmarshal(b []byte) error {
@@ -1168,7 +1491,7 @@ func (m *IsUsernameAvailableReply) Reset()         { *m = IsUsernameAvailableRep
 func (m *IsUsernameAvailableReply) String() string { return proto.CompactTextString(m) }
 func (*IsUsernameAvailableReply) ProtoMessage()    {}
 func (*IsUsernameAvailableReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{26}
+	return fileDescriptor_b3103f8d3056b01c, []int{30}
 }
 
 func (m *IsUsernameAvailableReply) XXX_Unmarshal(b []byte) error {
@@ -1200,7 +1523,7 @@ func (m *IsOrgNameAvailableRequest) Reset()         { *m = IsOrgNameAvailableReq
 func (m *IsOrgNameAvailableRequest) String() string { return proto.CompactTextString(m) }
 func (*IsOrgNameAvailableRequest) ProtoMessage()    {}
 func (*IsOrgNameAvailableRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{27}
+	return fileDescriptor_b3103f8d3056b01c, []int{31}
 }
 
 func (m *IsOrgNameAvailableRequest) XXX_Unmarshal(b []byte) error {
@@ -1240,7 +1563,7 @@ func (m *IsOrgNameAvailableReply) Reset()         { *m = IsOrgNameAvailableReply
 func (m *IsOrgNameAvailableReply) String() string { return proto.CompactTextString(m) }
 func (*IsOrgNameAvailableReply) ProtoMessage()    {}
 func (*IsOrgNameAvailableReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{28}
+	return fileDescriptor_b3103f8d3056b01c, []int{32}
 }
 
 func (m *IsOrgNameAvailableReply) XXX_Unmarshal(b []byte) error {
@@ -1275,100 +1598,1453 @@ func (m *IsOrgNameAvailableReply) GetHost() string {
 	return ""
 }
 
-type DestroyAccountRequest struct {
+type GetUsageReportRequest struct {
+	GroupBy              string   `protobuf:"bytes,1,opt,name=groupBy,proto3" json:"groupBy,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DestroyAccountRequest) Reset()         { *m = DestroyAccountRequest{} }
-func (m *DestroyAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*DestroyAccountRequest) ProtoMessage()    {}
-func (*DestroyAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{29}
+func (m *GetUsageReportRequest) Reset()         { *m = GetUsageReportRequest{} }
+func (m *GetUsageReportRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUsageReportRequest) ProtoMessage()    {}
+func (*GetUsageReportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{33}
 }
 
-func (m *DestroyAccountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DestroyAccountRequest.Unmarshal(m, b)
+func (m *GetUsageReportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUsageReportRequest.Unmarshal(m, b)
 }
-func (m *DestroyAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DestroyAccountRequest.Marshal(b, m, deterministic)
+func (m *GetUsageReportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUsageReportRequest.Marshal(b, m, deterministic)
 }
-func (m *DestroyAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DestroyAccountRequest.Merge(m, src)
+func (m *GetUsageReportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsageReportRequest.Merge(m, src)
 }
-func (m *DestroyAccountRequest) XXX_Size() int {
-	return xxx_messageInfo_DestroyAccountRequest.Size(m)
+func (m *GetUsageReportRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUsageReportRequest.Size(m)
 }
-func (m *DestroyAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DestroyAccountRequest.DiscardUnknown(m)
+func (m *GetUsageReportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsageReportRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DestroyAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetUsageReportRequest proto.InternalMessageInfo
 
-type DestroyAccountReply struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetUsageReportRequest) GetGroupBy() string {
+	if m != nil {
+		return m.GroupBy
+	}
+	return ""
 }
 
-func (m *DestroyAccountReply) Reset()         { *m = DestroyAccountReply{} }
-func (m *DestroyAccountReply) String() string { return proto.CompactTextString(m) }
-func (*DestroyAccountReply) ProtoMessage()    {}
-func (*DestroyAccountReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b3103f8d3056b01c, []int{30}
+type GetUsageReportReply struct {
+	Resources            []*GetUsageReportReply_Resource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	Groups               []*GetUsageReportReply_Group    `protobuf:"bytes,2,rep,name=groups,proto3" json:"groups,omitempty"`
+	TotalSize            int64                           `protobuf:"varint,3,opt,name=totalSize,proto3" json:"totalSize,omitempty"`
+	Dedup                *GetUsageReportReply_Dedup      `protobuf:"bytes,4,opt,name=dedup,proto3" json:"dedup,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (m *DestroyAccountReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DestroyAccountReply.Unmarshal(m, b)
+func (m *GetUsageReportReply) Reset()         { *m = GetUsageReportReply{} }
+func (m *GetUsageReportReply) String() string { return proto.CompactTextString(m) }
+func (*GetUsageReportReply) ProtoMessage()    {}
+func (*GetUsageReportReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{34}
 }
-func (m *DestroyAccountReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DestroyAccountReply.Marshal(b, m, deterministic)
+
+func (m *GetUsageReportReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUsageReportReply.Unmarshal(m, b)
 }
-func (m *DestroyAccountReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DestroyAccountReply.Merge(m, src)
+func (m *GetUsageReportReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUsageReportReply.Marshal(b, m, deterministic)
 }
-func (m *DestroyAccountReply) XXX_Size() int {
-	return xxx_messageInfo_DestroyAccountReply.Size(m)
+func (m *GetUsageReportReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsageReportReply.Merge(m, src)
 }
-func (m *DestroyAccountReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_DestroyAccountReply.DiscardUnknown(m)
+func (m *GetUsageReportReply) XXX_Size() int {
+	return xxx_messageInfo_GetUsageReportReply.Size(m)
+}
+func (m *GetUsageReportReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsageReportReply.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DestroyAccountReply proto.InternalMessageInfo
+var xxx_messageInfo_GetUsageReportReply proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("hub.pb.KeyType", KeyType_name, KeyType_value)
-	proto.RegisterType((*SignupRequest)(nil), "hub.pb.SignupRequest")
-	proto.RegisterType((*SignupReply)(nil), "hub.pb.SignupReply")
-	proto.RegisterType((*SigninRequest)(nil), "hub.pb.SigninRequest")
-	proto.RegisterType((*SigninReply)(nil), "hub.pb.SigninReply")
-	proto.RegisterType((*SignoutRequest)(nil), "hub.pb.SignoutRequest")
-	proto.RegisterType((*SignoutReply)(nil), "hub.pb.SignoutReply")
-	proto.RegisterType((*GetSessionInfoRequest)(nil), "hub.pb.GetSessionInfoRequest")
-	proto.RegisterType((*GetSessionInfoReply)(nil), "hub.pb.GetSessionInfoReply")
-	proto.RegisterType((*CreateKeyRequest)(nil), "hub.pb.CreateKeyRequest")
-	proto.RegisterType((*GetKeyReply)(nil), "hub.pb.GetKeyReply")
-	proto.RegisterType((*InvalidateKeyRequest)(nil), "hub.pb.InvalidateKeyRequest")
-	proto.RegisterType((*InvalidateKeyReply)(nil), "hub.pb.InvalidateKeyReply")
-	proto.RegisterType((*ListKeysRequest)(nil), "hub.pb.ListKeysRequest")
-	proto.RegisterType((*ListKeysReply)(nil), "hub.pb.ListKeysReply")
-	proto.RegisterType((*CreateOrgRequest)(nil), "hub.pb.CreateOrgRequest")
-	proto.RegisterType((*GetOrgRequest)(nil), "hub.pb.GetOrgRequest")
-	proto.RegisterType((*GetOrgReply)(nil), "hub.pb.GetOrgReply")
-	proto.RegisterType((*GetOrgReply_Member)(nil), "hub.pb.GetOrgReply.Member")
-	proto.RegisterType((*ListOrgsRequest)(nil), "hub.pb.ListOrgsRequest")
-	proto.RegisterType((*ListOrgsReply)(nil), "hub.pb.ListOrgsReply")
-	proto.RegisterType((*RemoveOrgRequest)(nil), "hub.pb.RemoveOrgRequest")
-	proto.RegisterType((*RemoveOrgReply)(nil), "hub.pb.RemoveOrgReply")
-	proto.RegisterType((*InviteToOrgRequest)(nil), "hub.pb.InviteToOrgRequest")
-	proto.RegisterType((*InviteToOrgReply)(nil), "hub.pb.InviteToOrgReply")
-	proto.RegisterType((*LeaveOrgRequest)(nil), "hub.pb.LeaveOrgRequest")
-	proto.RegisterType((*LeaveOrgReply)(nil), "hub.pb.LeaveOrgReply")
-	proto.RegisterType((*IsUsernameAvailableRequest)(nil), "hub.pb.IsUsernameAvailableRequest")
-	proto.RegisterType((*IsUsernameAvailableReply)(nil), "hub.pb.IsUsernameAvailableReply")
-	proto.RegisterType((*IsOrgNameAvailableRequest)(nil), "hub.pb.IsOrgNameAvailableRequest")
+func (m *GetUsageReportReply) GetResources() []*GetUsageReportReply_Resource {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+func (m *GetUsageReportReply) GetGroups() []*GetUsageReportReply_Group {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
+func (m *GetUsageReportReply) GetTotalSize() int64 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *GetUsageReportReply) GetDedup() *GetUsageReportReply_Dedup {
+	if m != nil {
+		return m.Dedup
+	}
+	return nil
+}
+
+type GetUsageReportReply_Resource struct {
+	Type                 string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	ID                   string            `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string            `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Thread               string            `protobuf:"bytes,4,opt,name=thread,proto3" json:"thread,omitempty"`
+	Size                 int64             `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetUsageReportReply_Resource) Reset()         { *m = GetUsageReportReply_Resource{} }
+func (m *GetUsageReportReply_Resource) String() string { return proto.CompactTextString(m) }
+func (*GetUsageReportReply_Resource) ProtoMessage()    {}
+func (*GetUsageReportReply_Resource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{34, 0}
+}
+
+func (m *GetUsageReportReply_Resource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUsageReportReply_Resource.Unmarshal(m, b)
+}
+func (m *GetUsageReportReply_Resource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUsageReportReply_Resource.Marshal(b, m, deterministic)
+}
+func (m *GetUsageReportReply_Resource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsageReportReply_Resource.Merge(m, src)
+}
+func (m *GetUsageReportReply_Resource) XXX_Size() int {
+	return xxx_messageInfo_GetUsageReportReply_Resource.Size(m)
+}
+func (m *GetUsageReportReply_Resource) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsageReportReply_Resource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUsageReportReply_Resource proto.InternalMessageInfo
+
+func (m *GetUsageReportReply_Resource) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *GetUsageReportReply_Resource) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *GetUsageReportReply_Resource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetUsageReportReply_Resource) GetThread() string {
+	if m != nil {
+		return m.Thread
+	}
+	return ""
+}
+
+func (m *GetUsageReportReply_Resource) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *GetUsageReportReply_Resource) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type GetUsageReportReply_Group struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Size                 int64    `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Count                int64    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUsageReportReply_Group) Reset()         { *m = GetUsageReportReply_Group{} }
+func (m *GetUsageReportReply_Group) String() string { return proto.CompactTextString(m) }
+func (*GetUsageReportReply_Group) ProtoMessage()    {}
+func (*GetUsageReportReply_Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{34, 1}
+}
+
+func (m *GetUsageReportReply_Group) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUsageReportReply_Group.Unmarshal(m, b)
+}
+func (m *GetUsageReportReply_Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUsageReportReply_Group.Marshal(b, m, deterministic)
+}
+func (m *GetUsageReportReply_Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsageReportReply_Group.Merge(m, src)
+}
+func (m *GetUsageReportReply_Group) XXX_Size() int {
+	return xxx_messageInfo_GetUsageReportReply_Group.Size(m)
+}
+func (m *GetUsageReportReply_Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsageReportReply_Group.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUsageReportReply_Group proto.InternalMessageInfo
+
+func (m *GetUsageReportReply_Group) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *GetUsageReportReply_Group) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *GetUsageReportReply_Group) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GetUsageReportReply_Dedup struct {
+	Objects              int64    `protobuf:"varint,1,opt,name=objects,proto3" json:"objects,omitempty"`
+	Refs                 int64    `protobuf:"varint,2,opt,name=refs,proto3" json:"refs,omitempty"`
+	StoredSize           int64    `protobuf:"varint,3,opt,name=storedSize,proto3" json:"storedSize,omitempty"`
+	SavedSize            int64    `protobuf:"varint,4,opt,name=savedSize,proto3" json:"savedSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUsageReportReply_Dedup) Reset()         { *m = GetUsageReportReply_Dedup{} }
+func (m *GetUsageReportReply_Dedup) String() string { return proto.CompactTextString(m) }
+func (*GetUsageReportReply_Dedup) ProtoMessage()    {}
+func (*GetUsageReportReply_Dedup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{34, 2}
+}
+
+func (m *GetUsageReportReply_Dedup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUsageReportReply_Dedup.Unmarshal(m, b)
+}
+func (m *GetUsageReportReply_Dedup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUsageReportReply_Dedup.Marshal(b, m, deterministic)
+}
+func (m *GetUsageReportReply_Dedup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsageReportReply_Dedup.Merge(m, src)
+}
+func (m *GetUsageReportReply_Dedup) XXX_Size() int {
+	return xxx_messageInfo_GetUsageReportReply_Dedup.Size(m)
+}
+func (m *GetUsageReportReply_Dedup) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsageReportReply_Dedup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUsageReportReply_Dedup proto.InternalMessageInfo
+
+func (m *GetUsageReportReply_Dedup) GetObjects() int64 {
+	if m != nil {
+		return m.Objects
+	}
+	return 0
+}
+
+func (m *GetUsageReportReply_Dedup) GetRefs() int64 {
+	if m != nil {
+		return m.Refs
+	}
+	return 0
+}
+
+func (m *GetUsageReportReply_Dedup) GetStoredSize() int64 {
+	if m != nil {
+		return m.StoredSize
+	}
+	return 0
+}
+
+func (m *GetUsageReportReply_Dedup) GetSavedSize() int64 {
+	if m != nil {
+		return m.SavedSize
+	}
+	return 0
+}
+
+type Spec struct {
+	Keys                 []*Spec_Key `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	Orgs                 []*Spec_Org `protobuf:"bytes,2,rep,name=orgs,proto3" json:"orgs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Spec) Reset()         { *m = Spec{} }
+func (m *Spec) String() string { return proto.CompactTextString(m) }
+func (*Spec) ProtoMessage()    {}
+func (*Spec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{35}
+}
+
+func (m *Spec) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Spec.Unmarshal(m, b)
+}
+func (m *Spec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Spec.Marshal(b, m, deterministic)
+}
+func (m *Spec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spec.Merge(m, src)
+}
+func (m *Spec) XXX_Size() int {
+	return xxx_messageInfo_Spec.Size(m)
+}
+func (m *Spec) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Spec proto.InternalMessageInfo
+
+func (m *Spec) GetKeys() []*Spec_Key {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+func (m *Spec) GetOrgs() []*Spec_Org {
+	if m != nil {
+		return m.Orgs
+	}
+	return nil
+}
+
+type Spec_Key struct {
+	Type                 KeyType  `protobuf:"varint,1,opt,name=type,proto3,enum=hub.pb.KeyType" json:"type,omitempty"`
+	Secure               bool     `protobuf:"varint,2,opt,name=secure,proto3" json:"secure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Spec_Key) Reset()         { *m = Spec_Key{} }
+func (m *Spec_Key) String() string { return proto.CompactTextString(m) }
+func (*Spec_Key) ProtoMessage()    {}
+func (*Spec_Key) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{35, 0}
+}
+
+func (m *Spec_Key) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Spec_Key.Unmarshal(m, b)
+}
+func (m *Spec_Key) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Spec_Key.Marshal(b, m, deterministic)
+}
+func (m *Spec_Key) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spec_Key.Merge(m, src)
+}
+func (m *Spec_Key) XXX_Size() int {
+	return xxx_messageInfo_Spec_Key.Size(m)
+}
+func (m *Spec_Key) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spec_Key.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Spec_Key proto.InternalMessageInfo
+
+func (m *Spec_Key) GetType() KeyType {
+	if m != nil {
+		return m.Type
+	}
+	return KeyType_ACCOUNT
+}
+
+func (m *Spec_Key) GetSecure() bool {
+	if m != nil {
+		return m.Secure
+	}
+	return false
+}
+
+type Spec_Org struct {
+	Name                 string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Members              []string    `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	Keys                 []*Spec_Key `protobuf:"bytes,3,rep,name=keys,proto3" json:"keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *Spec_Org) Reset()         { *m = Spec_Org{} }
+func (m *Spec_Org) String() string { return proto.CompactTextString(m) }
+func (*Spec_Org) ProtoMessage()    {}
+func (*Spec_Org) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{35, 1}
+}
+
+func (m *Spec_Org) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Spec_Org.Unmarshal(m, b)
+}
+func (m *Spec_Org) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Spec_Org.Marshal(b, m, deterministic)
+}
+func (m *Spec_Org) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Spec_Org.Merge(m, src)
+}
+func (m *Spec_Org) XXX_Size() int {
+	return xxx_messageInfo_Spec_Org.Size(m)
+}
+func (m *Spec_Org) XXX_DiscardUnknown() {
+	xxx_messageInfo_Spec_Org.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Spec_Org proto.InternalMessageInfo
+
+func (m *Spec_Org) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Spec_Org) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *Spec_Org) GetKeys() []*Spec_Key {
+	if m != nil {
+		return m.Keys
+	}
+	return nil
+}
+
+type ApplySpecRequest struct {
+	Spec                 *Spec    `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	Prune                bool     `protobuf:"varint,2,opt,name=prune,proto3" json:"prune,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplySpecRequest) Reset()         { *m = ApplySpecRequest{} }
+func (m *ApplySpecRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplySpecRequest) ProtoMessage()    {}
+func (*ApplySpecRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{36}
+}
+
+func (m *ApplySpecRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplySpecRequest.Unmarshal(m, b)
+}
+func (m *ApplySpecRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplySpecRequest.Marshal(b, m, deterministic)
+}
+func (m *ApplySpecRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplySpecRequest.Merge(m, src)
+}
+func (m *ApplySpecRequest) XXX_Size() int {
+	return xxx_messageInfo_ApplySpecRequest.Size(m)
+}
+func (m *ApplySpecRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplySpecRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplySpecRequest proto.InternalMessageInfo
+
+func (m *ApplySpecRequest) GetSpec() *Spec {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *ApplySpecRequest) GetPrune() bool {
+	if m != nil {
+		return m.Prune
+	}
+	return false
+}
+
+type ApplySpecReply struct {
+	Changes              []*ApplySpecReply_Change `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ApplySpecReply) Reset()         { *m = ApplySpecReply{} }
+func (m *ApplySpecReply) String() string { return proto.CompactTextString(m) }
+func (*ApplySpecReply) ProtoMessage()    {}
+func (*ApplySpecReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{37}
+}
+
+func (m *ApplySpecReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplySpecReply.Unmarshal(m, b)
+}
+func (m *ApplySpecReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplySpecReply.Marshal(b, m, deterministic)
+}
+func (m *ApplySpecReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplySpecReply.Merge(m, src)
+}
+func (m *ApplySpecReply) XXX_Size() int {
+	return xxx_messageInfo_ApplySpecReply.Size(m)
+}
+func (m *ApplySpecReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplySpecReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplySpecReply proto.InternalMessageInfo
+
+func (m *ApplySpecReply) GetChanges() []*ApplySpecReply_Change {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+type ApplySpecReply_Change struct {
+	Action               ApplySpecReply_Change_Action `protobuf:"varint,1,opt,name=action,proto3,enum=hub.pb.ApplySpecReply_Change_Action" json:"action,omitempty"`
+	Resource             string                       `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	ID                   string                       `protobuf:"bytes,3,opt,name=ID,proto3" json:"ID,omitempty"`
+	Org                  string                       `protobuf:"bytes,4,opt,name=org,proto3" json:"org,omitempty"`
+	Detail               string                       `protobuf:"bytes,5,opt,name=detail,proto3" json:"detail,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *ApplySpecReply_Change) Reset()         { *m = ApplySpecReply_Change{} }
+func (m *ApplySpecReply_Change) String() string { return proto.CompactTextString(m) }
+func (*ApplySpecReply_Change) ProtoMessage()    {}
+func (*ApplySpecReply_Change) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{37, 0}
+}
+
+func (m *ApplySpecReply_Change) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplySpecReply_Change.Unmarshal(m, b)
+}
+func (m *ApplySpecReply_Change) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplySpecReply_Change.Marshal(b, m, deterministic)
+}
+func (m *ApplySpecReply_Change) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplySpecReply_Change.Merge(m, src)
+}
+func (m *ApplySpecReply_Change) XXX_Size() int {
+	return xxx_messageInfo_ApplySpecReply_Change.Size(m)
+}
+func (m *ApplySpecReply_Change) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplySpecReply_Change.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplySpecReply_Change proto.InternalMessageInfo
+
+func (m *ApplySpecReply_Change) GetAction() ApplySpecReply_Change_Action {
+	if m != nil {
+		return m.Action
+	}
+	return ApplySpecReply_Change_CREATE
+}
+
+func (m *ApplySpecReply_Change) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *ApplySpecReply_Change) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *ApplySpecReply_Change) GetOrg() string {
+	if m != nil {
+		return m.Org
+	}
+	return ""
+}
+
+func (m *ApplySpecReply_Change) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+type ListAuditLogsRequest struct {
+	Resource             string   `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuditLogsRequest) Reset()         { *m = ListAuditLogsRequest{} }
+func (m *ListAuditLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAuditLogsRequest) ProtoMessage()    {}
+func (*ListAuditLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{38}
+}
+
+func (m *ListAuditLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditLogsRequest.Unmarshal(m, b)
+}
+func (m *ListAuditLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditLogsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAuditLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditLogsRequest.Merge(m, src)
+}
+func (m *ListAuditLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAuditLogsRequest.Size(m)
+}
+func (m *ListAuditLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditLogsRequest proto.InternalMessageInfo
+
+func (m *ListAuditLogsRequest) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+type ListAuditLogsReply struct {
+	List                 []*ListAuditLogsReply_AuditLog `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *ListAuditLogsReply) Reset()         { *m = ListAuditLogsReply{} }
+func (m *ListAuditLogsReply) String() string { return proto.CompactTextString(m) }
+func (*ListAuditLogsReply) ProtoMessage()    {}
+func (*ListAuditLogsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{39}
+}
+
+func (m *ListAuditLogsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditLogsReply.Unmarshal(m, b)
+}
+func (m *ListAuditLogsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditLogsReply.Marshal(b, m, deterministic)
+}
+func (m *ListAuditLogsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditLogsReply.Merge(m, src)
+}
+func (m *ListAuditLogsReply) XXX_Size() int {
+	return xxx_messageInfo_ListAuditLogsReply.Size(m)
+}
+func (m *ListAuditLogsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditLogsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditLogsReply proto.InternalMessageInfo
+
+func (m *ListAuditLogsReply) GetList() []*ListAuditLogsReply_AuditLog {
+	if m != nil {
+		return m.List
+	}
+	return nil
+}
+
+type ListAuditLogsReply_AuditLog struct {
+	ID                   string   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Actor                string   `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Action               string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Resource             string   `protobuf:"bytes,4,opt,name=resource,proto3" json:"resource,omitempty"`
+	Reason               string   `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAuditLogsReply_AuditLog) Reset()         { *m = ListAuditLogsReply_AuditLog{} }
+func (m *ListAuditLogsReply_AuditLog) String() string { return proto.CompactTextString(m) }
+func (*ListAuditLogsReply_AuditLog) ProtoMessage()    {}
+func (*ListAuditLogsReply_AuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{39, 0}
+}
+
+func (m *ListAuditLogsReply_AuditLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditLogsReply_AuditLog.Unmarshal(m, b)
+}
+func (m *ListAuditLogsReply_AuditLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditLogsReply_AuditLog.Marshal(b, m, deterministic)
+}
+func (m *ListAuditLogsReply_AuditLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditLogsReply_AuditLog.Merge(m, src)
+}
+func (m *ListAuditLogsReply_AuditLog) XXX_Size() int {
+	return xxx_messageInfo_ListAuditLogsReply_AuditLog.Size(m)
+}
+func (m *ListAuditLogsReply_AuditLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditLogsReply_AuditLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAuditLogsReply_AuditLog proto.InternalMessageInfo
+
+func (m *ListAuditLogsReply_AuditLog) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *ListAuditLogsReply_AuditLog) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *ListAuditLogsReply_AuditLog) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *ListAuditLogsReply_AuditLog) GetResource() string {
+	if m != nil {
+		return m.Resource
+	}
+	return ""
+}
+
+func (m *ListAuditLogsReply_AuditLog) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ListAuditLogsReply_AuditLog) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type WatchAccountRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchAccountRequest) Reset()         { *m = WatchAccountRequest{} }
+func (m *WatchAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchAccountRequest) ProtoMessage()    {}
+func (*WatchAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{40}
+}
+
+func (m *WatchAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchAccountRequest.Unmarshal(m, b)
+}
+func (m *WatchAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchAccountRequest.Merge(m, src)
+}
+func (m *WatchAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchAccountRequest.Size(m)
+}
+func (m *WatchAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchAccountRequest proto.InternalMessageInfo
+
+type WatchAccountReply struct {
+	Event                *WatchAccountReply_Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *WatchAccountReply) Reset()         { *m = WatchAccountReply{} }
+func (m *WatchAccountReply) String() string { return proto.CompactTextString(m) }
+func (*WatchAccountReply) ProtoMessage()    {}
+func (*WatchAccountReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{41}
+}
+
+func (m *WatchAccountReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchAccountReply.Unmarshal(m, b)
+}
+func (m *WatchAccountReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchAccountReply.Marshal(b, m, deterministic)
+}
+func (m *WatchAccountReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchAccountReply.Merge(m, src)
+}
+func (m *WatchAccountReply) XXX_Size() int {
+	return xxx_messageInfo_WatchAccountReply.Size(m)
+}
+func (m *WatchAccountReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchAccountReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchAccountReply proto.InternalMessageInfo
+
+func (m *WatchAccountReply) GetEvent() *WatchAccountReply_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+type WatchAccountReply_Event struct {
+	Type                 WatchAccountReply_Event_Type `protobuf:"varint,1,opt,name=type,proto3,enum=hub.pb.WatchAccountReply_Event_Type" json:"type,omitempty"`
+	ID                   string                       `protobuf:"bytes,2,opt,name=ID,proto3" json:"ID,omitempty"`
+	Detail               string                       `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	Time                 int64                        `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *WatchAccountReply_Event) Reset()         { *m = WatchAccountReply_Event{} }
+func (m *WatchAccountReply_Event) String() string { return proto.CompactTextString(m) }
+func (*WatchAccountReply_Event) ProtoMessage()    {}
+func (*WatchAccountReply_Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{41, 0}
+}
+
+func (m *WatchAccountReply_Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchAccountReply_Event.Unmarshal(m, b)
+}
+func (m *WatchAccountReply_Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchAccountReply_Event.Marshal(b, m, deterministic)
+}
+func (m *WatchAccountReply_Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchAccountReply_Event.Merge(m, src)
+}
+func (m *WatchAccountReply_Event) XXX_Size() int {
+	return xxx_messageInfo_WatchAccountReply_Event.Size(m)
+}
+func (m *WatchAccountReply_Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchAccountReply_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchAccountReply_Event proto.InternalMessageInfo
+
+func (m *WatchAccountReply_Event) GetType() WatchAccountReply_Event_Type {
+	if m != nil {
+		return m.Type
+	}
+	return WatchAccountReply_Event_KEY_CREATED
+}
+
+func (m *WatchAccountReply_Event) GetID() string {
+	if m != nil {
+		return m.ID
+	}
+	return ""
+}
+
+func (m *WatchAccountReply_Event) GetDetail() string {
+	if m != nil {
+		return m.Detail
+	}
+	return ""
+}
+
+func (m *WatchAccountReply_Event) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type PushPolicy struct {
+	MaxFileSize          int64    `protobuf:"varint,1,opt,name=maxFileSize,proto3" json:"maxFileSize,omitempty"`
+	ForbiddenExtensions  []string `protobuf:"bytes,2,rep,name=forbiddenExtensions,proto3" json:"forbiddenExtensions,omitempty"`
+	RequiredPaths        []string `protobuf:"bytes,3,rep,name=requiredPaths,proto3" json:"requiredPaths,omitempty"`
+	RequireLicense       bool     `protobuf:"varint,4,opt,name=requireLicense,proto3" json:"requireLicense,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PushPolicy) Reset()         { *m = PushPolicy{} }
+func (m *PushPolicy) String() string { return proto.CompactTextString(m) }
+func (*PushPolicy) ProtoMessage()    {}
+func (*PushPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{42}
+}
+
+func (m *PushPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PushPolicy.Unmarshal(m, b)
+}
+func (m *PushPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PushPolicy.Marshal(b, m, deterministic)
+}
+func (m *PushPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushPolicy.Merge(m, src)
+}
+func (m *PushPolicy) XXX_Size() int {
+	return xxx_messageInfo_PushPolicy.Size(m)
+}
+func (m *PushPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushPolicy proto.InternalMessageInfo
+
+func (m *PushPolicy) GetMaxFileSize() int64 {
+	if m != nil {
+		return m.MaxFileSize
+	}
+	return 0
+}
+
+func (m *PushPolicy) GetForbiddenExtensions() []string {
+	if m != nil {
+		return m.ForbiddenExtensions
+	}
+	return nil
+}
+
+func (m *PushPolicy) GetRequiredPaths() []string {
+	if m != nil {
+		return m.RequiredPaths
+	}
+	return nil
+}
+
+func (m *PushPolicy) GetRequireLicense() bool {
+	if m != nil {
+		return m.RequireLicense
+	}
+	return false
+}
+
+type SetPushPolicyRequest struct {
+	Policy               *PushPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *SetPushPolicyRequest) Reset()         { *m = SetPushPolicyRequest{} }
+func (m *SetPushPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetPushPolicyRequest) ProtoMessage()    {}
+func (*SetPushPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{43}
+}
+
+func (m *SetPushPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPushPolicyRequest.Unmarshal(m, b)
+}
+func (m *SetPushPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPushPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetPushPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPushPolicyRequest.Merge(m, src)
+}
+func (m *SetPushPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetPushPolicyRequest.Size(m)
+}
+func (m *SetPushPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPushPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPushPolicyRequest proto.InternalMessageInfo
+
+func (m *SetPushPolicyRequest) GetPolicy() *PushPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type SetPushPolicyReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetPushPolicyReply) Reset()         { *m = SetPushPolicyReply{} }
+func (m *SetPushPolicyReply) String() string { return proto.CompactTextString(m) }
+func (*SetPushPolicyReply) ProtoMessage()    {}
+func (*SetPushPolicyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{44}
+}
+
+func (m *SetPushPolicyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPushPolicyReply.Unmarshal(m, b)
+}
+func (m *SetPushPolicyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPushPolicyReply.Marshal(b, m, deterministic)
+}
+func (m *SetPushPolicyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPushPolicyReply.Merge(m, src)
+}
+func (m *SetPushPolicyReply) XXX_Size() int {
+	return xxx_messageInfo_SetPushPolicyReply.Size(m)
+}
+func (m *SetPushPolicyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPushPolicyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPushPolicyReply proto.InternalMessageInfo
+
+type GetPushPolicyRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPushPolicyRequest) Reset()         { *m = GetPushPolicyRequest{} }
+func (m *GetPushPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPushPolicyRequest) ProtoMessage()    {}
+func (*GetPushPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{45}
+}
+
+func (m *GetPushPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPushPolicyRequest.Unmarshal(m, b)
+}
+func (m *GetPushPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPushPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPushPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPushPolicyRequest.Merge(m, src)
+}
+func (m *GetPushPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPushPolicyRequest.Size(m)
+}
+func (m *GetPushPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPushPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPushPolicyRequest proto.InternalMessageInfo
+
+type GetPushPolicyReply struct {
+	Policy               *PushPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetPushPolicyReply) Reset()         { *m = GetPushPolicyReply{} }
+func (m *GetPushPolicyReply) String() string { return proto.CompactTextString(m) }
+func (*GetPushPolicyReply) ProtoMessage()    {}
+func (*GetPushPolicyReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{46}
+}
+
+func (m *GetPushPolicyReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPushPolicyReply.Unmarshal(m, b)
+}
+func (m *GetPushPolicyReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPushPolicyReply.Marshal(b, m, deterministic)
+}
+func (m *GetPushPolicyReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPushPolicyReply.Merge(m, src)
+}
+func (m *GetPushPolicyReply) XXX_Size() int {
+	return xxx_messageInfo_GetPushPolicyReply.Size(m)
+}
+func (m *GetPushPolicyReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPushPolicyReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPushPolicyReply proto.InternalMessageInfo
+
+func (m *GetPushPolicyReply) GetPolicy() *PushPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type ArchiveConfig struct {
+	HotEnabled           bool     `protobuf:"varint,1,opt,name=hotEnabled,proto3" json:"hotEnabled,omitempty"`
+	ColdEnabled          bool     `protobuf:"varint,2,opt,name=coldEnabled,proto3" json:"coldEnabled,omitempty"`
+	RepFactor            int64    `protobuf:"varint,3,opt,name=repFactor,proto3" json:"repFactor,omitempty"`
+	DealMinDuration      int64    `protobuf:"varint,4,opt,name=dealMinDuration,proto3" json:"dealMinDuration,omitempty"`
+	TrustedMiners        []string `protobuf:"bytes,5,rep,name=trustedMiners,proto3" json:"trustedMiners,omitempty"`
+	ExcludedMiners       []string `protobuf:"bytes,6,rep,name=excludedMiners,proto3" json:"excludedMiners,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ArchiveConfig) Reset()         { *m = ArchiveConfig{} }
+func (m *ArchiveConfig) String() string { return proto.CompactTextString(m) }
+func (*ArchiveConfig) ProtoMessage()    {}
+func (*ArchiveConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{47}
+}
+
+func (m *ArchiveConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArchiveConfig.Unmarshal(m, b)
+}
+func (m *ArchiveConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArchiveConfig.Marshal(b, m, deterministic)
+}
+func (m *ArchiveConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArchiveConfig.Merge(m, src)
+}
+func (m *ArchiveConfig) XXX_Size() int {
+	return xxx_messageInfo_ArchiveConfig.Size(m)
+}
+func (m *ArchiveConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArchiveConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArchiveConfig proto.InternalMessageInfo
+
+func (m *ArchiveConfig) GetHotEnabled() bool {
+	if m != nil {
+		return m.HotEnabled
+	}
+	return false
+}
+
+func (m *ArchiveConfig) GetColdEnabled() bool {
+	if m != nil {
+		return m.ColdEnabled
+	}
+	return false
+}
+
+func (m *ArchiveConfig) GetRepFactor() int64 {
+	if m != nil {
+		return m.RepFactor
+	}
+	return 0
+}
+
+func (m *ArchiveConfig) GetDealMinDuration() int64 {
+	if m != nil {
+		return m.DealMinDuration
+	}
+	return 0
+}
+
+func (m *ArchiveConfig) GetTrustedMiners() []string {
+	if m != nil {
+		return m.TrustedMiners
+	}
+	return nil
+}
+
+func (m *ArchiveConfig) GetExcludedMiners() []string {
+	if m != nil {
+		return m.ExcludedMiners
+	}
+	return nil
+}
+
+type SetArchiveConfigRequest struct {
+	Config               *ArchiveConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetArchiveConfigRequest) Reset()         { *m = SetArchiveConfigRequest{} }
+func (m *SetArchiveConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*SetArchiveConfigRequest) ProtoMessage()    {}
+func (*SetArchiveConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{48}
+}
+
+func (m *SetArchiveConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetArchiveConfigRequest.Unmarshal(m, b)
+}
+func (m *SetArchiveConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetArchiveConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *SetArchiveConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetArchiveConfigRequest.Merge(m, src)
+}
+func (m *SetArchiveConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_SetArchiveConfigRequest.Size(m)
+}
+func (m *SetArchiveConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetArchiveConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetArchiveConfigRequest proto.InternalMessageInfo
+
+func (m *SetArchiveConfigRequest) GetConfig() *ArchiveConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type SetArchiveConfigReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetArchiveConfigReply) Reset()         { *m = SetArchiveConfigReply{} }
+func (m *SetArchiveConfigReply) String() string { return proto.CompactTextString(m) }
+func (*SetArchiveConfigReply) ProtoMessage()    {}
+func (*SetArchiveConfigReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{49}
+}
+
+func (m *SetArchiveConfigReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetArchiveConfigReply.Unmarshal(m, b)
+}
+func (m *SetArchiveConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetArchiveConfigReply.Marshal(b, m, deterministic)
+}
+func (m *SetArchiveConfigReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetArchiveConfigReply.Merge(m, src)
+}
+func (m *SetArchiveConfigReply) XXX_Size() int {
+	return xxx_messageInfo_SetArchiveConfigReply.Size(m)
+}
+func (m *SetArchiveConfigReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetArchiveConfigReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetArchiveConfigReply proto.InternalMessageInfo
+
+type GetArchiveConfigRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetArchiveConfigRequest) Reset()         { *m = GetArchiveConfigRequest{} }
+func (m *GetArchiveConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetArchiveConfigRequest) ProtoMessage()    {}
+func (*GetArchiveConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{50}
+}
+
+func (m *GetArchiveConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetArchiveConfigRequest.Unmarshal(m, b)
+}
+func (m *GetArchiveConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetArchiveConfigRequest.Marshal(b, m, deterministic)
+}
+func (m *GetArchiveConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArchiveConfigRequest.Merge(m, src)
+}
+func (m *GetArchiveConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_GetArchiveConfigRequest.Size(m)
+}
+func (m *GetArchiveConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArchiveConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetArchiveConfigRequest proto.InternalMessageInfo
+
+type GetArchiveConfigReply struct {
+	Config               *ArchiveConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetArchiveConfigReply) Reset()         { *m = GetArchiveConfigReply{} }
+func (m *GetArchiveConfigReply) String() string { return proto.CompactTextString(m) }
+func (*GetArchiveConfigReply) ProtoMessage()    {}
+func (*GetArchiveConfigReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{51}
+}
+
+func (m *GetArchiveConfigReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetArchiveConfigReply.Unmarshal(m, b)
+}
+func (m *GetArchiveConfigReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetArchiveConfigReply.Marshal(b, m, deterministic)
+}
+func (m *GetArchiveConfigReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArchiveConfigReply.Merge(m, src)
+}
+func (m *GetArchiveConfigReply) XXX_Size() int {
+	return xxx_messageInfo_GetArchiveConfigReply.Size(m)
+}
+func (m *GetArchiveConfigReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArchiveConfigReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetArchiveConfigReply proto.InternalMessageInfo
+
+func (m *GetArchiveConfigReply) GetConfig() *ArchiveConfig {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type DestroyAccountRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DestroyAccountRequest) Reset()         { *m = DestroyAccountRequest{} }
+func (m *DestroyAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*DestroyAccountRequest) ProtoMessage()    {}
+func (*DestroyAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{52}
+}
+
+func (m *DestroyAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestroyAccountRequest.Unmarshal(m, b)
+}
+func (m *DestroyAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestroyAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *DestroyAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestroyAccountRequest.Merge(m, src)
+}
+func (m *DestroyAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_DestroyAccountRequest.Size(m)
+}
+func (m *DestroyAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestroyAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestroyAccountRequest proto.InternalMessageInfo
+
+type DestroyAccountReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DestroyAccountReply) Reset()         { *m = DestroyAccountReply{} }
+func (m *DestroyAccountReply) String() string { return proto.CompactTextString(m) }
+func (*DestroyAccountReply) ProtoMessage()    {}
+func (*DestroyAccountReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b3103f8d3056b01c, []int{53}
+}
+
+func (m *DestroyAccountReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DestroyAccountReply.Unmarshal(m, b)
+}
+func (m *DestroyAccountReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DestroyAccountReply.Marshal(b, m, deterministic)
+}
+func (m *DestroyAccountReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DestroyAccountReply.Merge(m, src)
+}
+func (m *DestroyAccountReply) XXX_Size() int {
+	return xxx_messageInfo_DestroyAccountReply.Size(m)
+}
+func (m *DestroyAccountReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DestroyAccountReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DestroyAccountReply proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("hub.pb.KeyType", KeyType_name, KeyType_value)
+	proto.RegisterEnum("hub.pb.InviteManyToOrgReply_Result_Status", InviteManyToOrgReply_Result_Status_name, InviteManyToOrgReply_Result_Status_value)
+	proto.RegisterEnum("hub.pb.ApplySpecReply_Change_Action", ApplySpecReply_Change_Action_name, ApplySpecReply_Change_Action_value)
+	proto.RegisterEnum("hub.pb.WatchAccountReply_Event_Type", WatchAccountReply_Event_Type_name, WatchAccountReply_Event_Type_value)
+	proto.RegisterType((*SignupRequest)(nil), "hub.pb.SignupRequest")
+	proto.RegisterType((*SignupReply)(nil), "hub.pb.SignupReply")
+	proto.RegisterType((*SigninRequest)(nil), "hub.pb.SigninRequest")
+	proto.RegisterType((*SigninReply)(nil), "hub.pb.SigninReply")
+	proto.RegisterType((*SignoutRequest)(nil), "hub.pb.SignoutRequest")
+	proto.RegisterType((*SignoutReply)(nil), "hub.pb.SignoutReply")
+	proto.RegisterType((*GetSessionInfoRequest)(nil), "hub.pb.GetSessionInfoRequest")
+	proto.RegisterType((*GetSessionInfoReply)(nil), "hub.pb.GetSessionInfoReply")
+	proto.RegisterType((*CreateKeyRequest)(nil), "hub.pb.CreateKeyRequest")
+	proto.RegisterType((*GetKeyReply)(nil), "hub.pb.GetKeyReply")
+	proto.RegisterType((*InvalidateKeyRequest)(nil), "hub.pb.InvalidateKeyRequest")
+	proto.RegisterType((*InvalidateKeyReply)(nil), "hub.pb.InvalidateKeyReply")
+	proto.RegisterType((*SetKeyUserQuotaRequest)(nil), "hub.pb.SetKeyUserQuotaRequest")
+	proto.RegisterType((*SetKeyUserQuotaReply)(nil), "hub.pb.SetKeyUserQuotaReply")
+	proto.RegisterType((*ListKeysRequest)(nil), "hub.pb.ListKeysRequest")
+	proto.RegisterType((*ListKeysReply)(nil), "hub.pb.ListKeysReply")
+	proto.RegisterType((*CreateOrgRequest)(nil), "hub.pb.CreateOrgRequest")
+	proto.RegisterType((*GetOrgRequest)(nil), "hub.pb.GetOrgRequest")
+	proto.RegisterType((*GetOrgReply)(nil), "hub.pb.GetOrgReply")
+	proto.RegisterType((*GetOrgReply_Member)(nil), "hub.pb.GetOrgReply.Member")
+	proto.RegisterType((*ListOrgsRequest)(nil), "hub.pb.ListOrgsRequest")
+	proto.RegisterType((*ListOrgsReply)(nil), "hub.pb.ListOrgsReply")
+	proto.RegisterType((*RemoveOrgRequest)(nil), "hub.pb.RemoveOrgRequest")
+	proto.RegisterType((*RemoveOrgReply)(nil), "hub.pb.RemoveOrgReply")
+	proto.RegisterType((*InviteToOrgRequest)(nil), "hub.pb.InviteToOrgRequest")
+	proto.RegisterType((*InviteToOrgReply)(nil), "hub.pb.InviteToOrgReply")
+	proto.RegisterType((*InviteManyToOrgRequest)(nil), "hub.pb.InviteManyToOrgRequest")
+	proto.RegisterType((*InviteManyToOrgReply)(nil), "hub.pb.InviteManyToOrgReply")
+	proto.RegisterType((*InviteManyToOrgReply_Result)(nil), "hub.pb.InviteManyToOrgReply.Result")
+	proto.RegisterType((*LeaveOrgRequest)(nil), "hub.pb.LeaveOrgRequest")
+	proto.RegisterType((*LeaveOrgReply)(nil), "hub.pb.LeaveOrgReply")
+	proto.RegisterType((*IsUsernameAvailableRequest)(nil), "hub.pb.IsUsernameAvailableRequest")
+	proto.RegisterType((*IsUsernameAvailableReply)(nil), "hub.pb.IsUsernameAvailableReply")
+	proto.RegisterType((*IsOrgNameAvailableRequest)(nil), "hub.pb.IsOrgNameAvailableRequest")
 	proto.RegisterType((*IsOrgNameAvailableReply)(nil), "hub.pb.IsOrgNameAvailableReply")
+	proto.RegisterType((*GetUsageReportRequest)(nil), "hub.pb.GetUsageReportRequest")
+	proto.RegisterType((*GetUsageReportReply)(nil), "hub.pb.GetUsageReportReply")
+	proto.RegisterType((*GetUsageReportReply_Resource)(nil), "hub.pb.GetUsageReportReply.Resource")
+	proto.RegisterMapType((map[string]string)(nil), "hub.pb.GetUsageReportReply.Resource.TagsEntry")
+	proto.RegisterType((*GetUsageReportReply_Group)(nil), "hub.pb.GetUsageReportReply.Group")
+	proto.RegisterType((*GetUsageReportReply_Dedup)(nil), "hub.pb.GetUsageReportReply.Dedup")
+	proto.RegisterType((*Spec)(nil), "hub.pb.Spec")
+	proto.RegisterType((*Spec_Key)(nil), "hub.pb.Spec.Key")
+	proto.RegisterType((*Spec_Org)(nil), "hub.pb.Spec.Org")
+	proto.RegisterType((*ApplySpecRequest)(nil), "hub.pb.ApplySpecRequest")
+	proto.RegisterType((*ApplySpecReply)(nil), "hub.pb.ApplySpecReply")
+	proto.RegisterType((*ApplySpecReply_Change)(nil), "hub.pb.ApplySpecReply.Change")
+	proto.RegisterType((*ListAuditLogsRequest)(nil), "hub.pb.ListAuditLogsRequest")
+	proto.RegisterType((*ListAuditLogsReply)(nil), "hub.pb.ListAuditLogsReply")
+	proto.RegisterType((*ListAuditLogsReply_AuditLog)(nil), "hub.pb.ListAuditLogsReply.AuditLog")
+	proto.RegisterType((*WatchAccountRequest)(nil), "hub.pb.WatchAccountRequest")
+	proto.RegisterType((*WatchAccountReply)(nil), "hub.pb.WatchAccountReply")
+	proto.RegisterType((*WatchAccountReply_Event)(nil), "hub.pb.WatchAccountReply.Event")
+	proto.RegisterType((*PushPolicy)(nil), "hub.pb.PushPolicy")
+	proto.RegisterType((*SetPushPolicyRequest)(nil), "hub.pb.SetPushPolicyRequest")
+	proto.RegisterType((*SetPushPolicyReply)(nil), "hub.pb.SetPushPolicyReply")
+	proto.RegisterType((*GetPushPolicyRequest)(nil), "hub.pb.GetPushPolicyRequest")
+	proto.RegisterType((*GetPushPolicyReply)(nil), "hub.pb.GetPushPolicyReply")
+	proto.RegisterType((*ArchiveConfig)(nil), "hub.pb.ArchiveConfig")
+	proto.RegisterType((*SetArchiveConfigRequest)(nil), "hub.pb.SetArchiveConfigRequest")
+	proto.RegisterType((*SetArchiveConfigReply)(nil), "hub.pb.SetArchiveConfigReply")
+	proto.RegisterType((*GetArchiveConfigRequest)(nil), "hub.pb.GetArchiveConfigRequest")
+	proto.RegisterType((*GetArchiveConfigReply)(nil), "hub.pb.GetArchiveConfigReply")
 	proto.RegisterType((*DestroyAccountRequest)(nil), "hub.pb.DestroyAccountRequest")
 	proto.RegisterType((*DestroyAccountReply)(nil), "hub.pb.DestroyAccountReply")
 }
@@ -1376,67 +3052,153 @@ func init() {
 func init() { proto.RegisterFile("hub.proto", fileDescriptor_b3103f8d3056b01c) }
 
 var fileDescriptor_b3103f8d3056b01c = []byte{
-	// 949 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
-	0x14, 0x8e, 0xf3, 0x9f, 0x93, 0x4d, 0x13, 0x26, 0x69, 0x6b, 0x86, 0x45, 0x04, 0x23, 0x41, 0xb4,
-	0x42, 0x41, 0x2a, 0x08, 0x6d, 0x25, 0x24, 0x94, 0x96, 0xaa, 0x1b, 0x0a, 0xcd, 0xca, 0x4d, 0x2f,
-	0x40, 0x42, 0x2b, 0x27, 0x1d, 0x52, 0x6b, 0x1d, 0xdb, 0xd8, 0xe3, 0x0a, 0xbf, 0x0e, 0xe2, 0x79,
-	0x78, 0x21, 0x6e, 0xd0, 0xcc, 0x78, 0xec, 0xb1, 0xe3, 0x44, 0xf4, 0x6e, 0xe6, 0xcc, 0xf9, 0xce,
-	0xff, 0xf9, 0x6c, 0xe8, 0x3c, 0x46, 0xab, 0xa9, 0x1f, 0x78, 0xd4, 0x43, 0x4d, 0x7e, 0x5c, 0x19,
-	0x33, 0xe8, 0xdd, 0xd9, 0x1b, 0x37, 0xf2, 0x4d, 0xf2, 0x47, 0x44, 0x42, 0x8a, 0x30, 0xb4, 0xa3,
-	0x90, 0x04, 0xae, 0xb5, 0x25, 0xba, 0x36, 0xd6, 0x26, 0x1d, 0x33, 0xbd, 0xa3, 0x11, 0x34, 0xc8,
-	0xd6, 0xb2, 0x1d, 0xbd, 0xca, 0x1f, 0xc4, 0xc5, 0x38, 0x87, 0xae, 0x34, 0xe1, 0x3b, 0x31, 0x1a,
-	0x40, 0xed, 0x3d, 0x89, 0x39, 0xf6, 0x85, 0xc9, 0x8e, 0x48, 0x87, 0x56, 0x48, 0xc2, 0xd0, 0xf6,
-	0xdc, 0x04, 0x28, 0xaf, 0xc6, 0xb9, 0xf0, 0x6e, 0xbb, 0xd2, 0xfb, 0x04, 0xfa, 0xd2, 0xdb, 0x22,
-	0xb8, 0xe2, 0xbe, 0x44, 0x10, 0x45, 0xb1, 0xf4, 0xca, 0xa0, 0xcf, 0xf5, 0x3a, 0x80, 0x23, 0x06,
-	0xf5, 0x22, 0x9a, 0xb8, 0x35, 0x8e, 0xe0, 0x45, 0x2a, 0xf1, 0x9d, 0xd8, 0x38, 0x85, 0xe3, 0x6b,
-	0x42, 0xef, 0x84, 0xfe, 0xdc, 0xfd, 0xdd, 0x93, 0x8a, 0xbf, 0xc0, 0xb0, 0xf8, 0x50, 0xee, 0x5d,
-	0x2d, 0x63, 0x75, 0x5f, 0x19, 0x6b, 0x6a, 0x19, 0x17, 0x30, 0xb8, 0x0c, 0x88, 0x45, 0xc9, 0x0d,
-	0x89, 0x65, 0x39, 0x3e, 0x83, 0x3a, 0x8d, 0x7d, 0xd1, 0x88, 0xa3, 0xb3, 0xfe, 0x54, 0x34, 0x6d,
-	0x7a, 0x43, 0xe2, 0x65, 0xec, 0x13, 0x93, 0x3f, 0xa2, 0x13, 0x68, 0x86, 0x64, 0x1d, 0x05, 0xc2,
-	0x51, 0xdb, 0x4c, 0x6e, 0xc6, 0xdf, 0x1a, 0x74, 0xaf, 0x09, 0xe5, 0xe6, 0x0a, 0x41, 0x76, 0x44,
-	0x90, 0x02, 0x19, 0x10, 0x9a, 0x84, 0x98, 0xdc, 0x52, 0xb7, 0xb5, 0x43, 0x6e, 0x47, 0xd0, 0x78,
-	0xb2, 0x1c, 0xfb, 0x41, 0xaf, 0x73, 0xaf, 0xe2, 0xc2, 0xaa, 0x4e, 0x1f, 0x03, 0x62, 0x3d, 0x84,
-	0x7a, 0x63, 0xac, 0x4d, 0x1a, 0xa6, 0xbc, 0x2a, 0x61, 0x36, 0x73, 0x61, 0x4e, 0x60, 0x34, 0x77,
-	0x39, 0x38, 0x9f, 0xfb, 0x4e, 0xb8, 0xc6, 0x08, 0x50, 0x41, 0x93, 0xf5, 0xea, 0x03, 0xe8, 0xff,
-	0x64, 0x87, 0x2c, 0xcd, 0x50, 0x76, 0xe9, 0x35, 0xf4, 0x32, 0x11, 0x4b, 0xfd, 0x0b, 0xa8, 0x3b,
-	0x76, 0x48, 0x75, 0x6d, 0x5c, 0x9b, 0x74, 0xcf, 0x86, 0x32, 0x21, 0xa5, 0x3a, 0x26, 0x57, 0x30,
-	0x3e, 0x97, 0x4d, 0x58, 0x04, 0x1b, 0x19, 0x08, 0x82, 0xba, 0xb2, 0x0d, 0xfc, 0x6c, 0xf4, 0xa1,
-	0x77, 0x4d, 0x68, 0xa6, 0x64, 0xfc, 0x2b, 0x8a, 0xcd, 0x25, 0xe5, 0x13, 0x21, 0xcd, 0x54, 0x33,
-	0x33, 0x4c, 0x16, 0x3a, 0xd1, 0x26, 0x19, 0x04, 0x7e, 0x66, 0xb2, 0x47, 0x2f, 0xa4, 0xbc, 0xac,
-	0x1d, 0x93, 0x9f, 0xd1, 0x37, 0xd0, 0xda, 0x92, 0xed, 0x8a, 0x04, 0xac, 0xaa, 0x2c, 0x05, 0xac,
-	0xa4, 0x20, 0x7d, 0x4e, 0x7f, 0xe6, 0x2a, 0xa6, 0x54, 0x45, 0x2f, 0xa1, 0xb3, 0xe6, 0xc9, 0x3c,
-	0xcc, 0x28, 0x2f, 0x7a, 0xcd, 0xcc, 0x04, 0xf8, 0x47, 0x68, 0x0a, 0xc0, 0x33, 0xa7, 0x17, 0x41,
-	0x3d, 0xf0, 0x1c, 0x22, 0x63, 0x66, 0x67, 0xd9, 0x83, 0x45, 0xb0, 0x29, 0xf6, 0x40, 0x88, 0x0e,
-	0xf7, 0x40, 0x26, 0x90, 0xf4, 0x00, 0xc1, 0xc0, 0x24, 0x5b, 0xef, 0x49, 0xe9, 0x01, 0x5b, 0x59,
-	0x45, 0xc6, 0xda, 0xfe, 0x8a, 0x0f, 0x83, 0x4d, 0xc9, 0xd2, 0x53, 0x7a, 0x95, 0xae, 0x96, 0xa6,
-	0xae, 0xd6, 0x04, 0x06, 0x39, 0x5d, 0x16, 0xce, 0x08, 0x1a, 0xd4, 0x7b, 0x4f, 0x5c, 0xa9, 0xc9,
-	0x2f, 0x3c, 0x11, 0x62, 0xe5, 0x5c, 0xf7, 0xa1, 0x97, 0x89, 0x98, 0xe7, 0xd7, 0x80, 0xe7, 0xe1,
-	0x7d, 0x52, 0x8e, 0xd9, 0x93, 0x65, 0x3b, 0xd6, 0xca, 0x21, 0xff, 0x83, 0x3f, 0x0d, 0x0c, 0x7a,
-	0x29, 0x92, 0x59, 0xfd, 0x0a, 0x3e, 0x9c, 0x87, 0x8b, 0x60, 0x73, 0x5b, 0x66, 0xb4, 0x6c, 0x04,
-	0x67, 0x70, 0x5a, 0x06, 0x60, 0xb9, 0xc9, 0xb1, 0xd2, 0x4a, 0xc6, 0xaa, 0x9a, 0x8d, 0x15, 0xa3,
-	0xb9, 0x1f, 0x48, 0x48, 0x03, 0x2f, 0x9e, 0xad, 0xd7, 0x5e, 0xe4, 0xa6, 0x7c, 0x78, 0x0c, 0xc3,
-	0xe2, 0x83, 0xef, 0xc4, 0xaf, 0xc6, 0xd0, 0x4a, 0x38, 0x00, 0x75, 0xa1, 0x35, 0xbb, 0xbc, 0x5c,
-	0xdc, 0xdf, 0x2e, 0x07, 0x15, 0xd4, 0x86, 0xfa, 0xfd, 0xdd, 0x95, 0x39, 0xd0, 0xce, 0xfe, 0x69,
-	0x43, 0x6d, 0xf6, 0x76, 0x8e, 0xbe, 0x85, 0xa6, 0xf8, 0x26, 0xa0, 0x63, 0xd9, 0xe8, 0xdc, 0x67,
-	0x06, 0x0f, 0x8b, 0x62, 0x56, 0x83, 0x8a, 0xc4, 0xd9, 0x6e, 0x1e, 0x97, 0x7e, 0x20, 0xf2, 0xb8,
-	0x84, 0xfc, 0x8d, 0x0a, 0x3a, 0x87, 0x56, 0x42, 0xe0, 0xe8, 0x44, 0xd5, 0xc8, 0x38, 0x1e, 0x8f,
-	0x76, 0xe4, 0x02, 0x7a, 0x0b, 0x47, 0x79, 0x4a, 0x47, 0x1f, 0x2b, 0xb3, 0xb9, 0xfb, 0x0d, 0xc0,
-	0x1f, 0xed, 0x7b, 0x16, 0xf6, 0xbe, 0x83, 0x4e, 0xca, 0xe3, 0x48, 0x97, 0xba, 0x45, 0x6a, 0xc7,
-	0x65, 0x24, 0xc4, 0xd1, 0x6d, 0x49, 0x5d, 0xe8, 0x54, 0xaa, 0x14, 0xf8, 0x0d, 0x1f, 0xef, 0x3e,
-	0x08, 0xf4, 0x0d, 0xf4, 0x72, 0x0c, 0x89, 0x5e, 0x4a, 0xcd, 0x32, 0x8a, 0xc5, 0x78, 0xcf, 0x6b,
-	0x21, 0x91, 0x45, 0xb0, 0x29, 0x26, 0x92, 0xed, 0x07, 0x2e, 0xdb, 0x64, 0xd1, 0x49, 0x21, 0xc8,
-	0x3a, 0x99, 0x63, 0xcc, 0x7d, 0xb8, 0xa4, 0x00, 0x8c, 0x37, 0xf2, 0x05, 0x50, 0xc8, 0x25, 0x5f,
-	0x80, 0x94, 0x62, 0x8c, 0x0a, 0xfa, 0x1e, 0x3a, 0x29, 0x4f, 0x64, 0x31, 0x17, 0xe9, 0x04, 0x9f,
-	0x94, 0xbc, 0x08, 0x03, 0x57, 0xd0, 0x55, 0xa8, 0x02, 0xa9, 0x15, 0x2a, 0x70, 0x0d, 0xd6, 0x4b,
-	0xdf, 0xb2, 0x2c, 0x12, 0xd2, 0x50, 0xb2, 0xc8, 0x33, 0x8b, 0x92, 0x45, 0x8e, 0x5f, 0x2a, 0xe8,
-	0x37, 0x18, 0x96, 0xf0, 0x04, 0x32, 0x52, 0x87, 0x7b, 0xe9, 0x07, 0x8f, 0x0f, 0xea, 0x08, 0xf3,
-	0xbf, 0x02, 0xda, 0x65, 0x0e, 0xf4, 0x69, 0x86, 0xdc, 0x43, 0x43, 0xf8, 0x93, 0x43, 0x2a, 0xe9,
-	0x36, 0xe5, 0x99, 0x23, 0xdb, 0xa6, 0x52, 0xaa, 0xc9, 0xb6, 0xa9, 0x84, 0x70, 0x8c, 0xca, 0xc5,
-	0x97, 0x30, 0xb4, 0xbd, 0x29, 0x25, 0x7f, 0x52, 0xdb, 0x21, 0x4c, 0xf5, 0xdd, 0x26, 0xf0, 0xd7,
-	0x17, 0xb0, 0x14, 0x92, 0x37, 0xd1, 0xea, 0xad, 0xf6, 0x57, 0xb5, 0xb9, 0x5c, 0xbe, 0x7b, 0x73,
-	0x7f, 0xb1, 0x6a, 0xf2, 0x9f, 0xdb, 0xaf, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x7c, 0x2e,
-	0x0d, 0xe9, 0x0a, 0x00, 0x00,
+	// 2328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x19, 0xdb, 0x6e, 0xdb, 0xc8,
+	0x35, 0xd4, 0x85, 0xb6, 0x8e, 0x63, 0x59, 0x3b, 0x96, 0x1d, 0x2d, 0x93, 0x6c, 0xbc, 0xdc, 0x60,
+	0x6b, 0x04, 0xad, 0xba, 0x75, 0xb7, 0x4d, 0x52, 0xa4, 0x17, 0xc9, 0xa2, 0x15, 0xc5, 0xd7, 0x50,
+	0x72, 0x8a, 0x14, 0x28, 0x0c, 0x5a, 0x9a, 0xc8, 0x6c, 0x68, 0x52, 0x4b, 0x0e, 0x8d, 0xa8, 0x3f,
+	0x52, 0xa0, 0x40, 0x5f, 0x8a, 0xa2, 0x8f, 0xfd, 0x86, 0x7e, 0x42, 0x1f, 0xfa, 0xd4, 0x1f, 0x68,
+	0xd1, 0x3e, 0xf5, 0xb9, 0x2f, 0xc5, 0xdc, 0xa8, 0x21, 0x45, 0x29, 0x49, 0xfb, 0xc6, 0x39, 0xd7,
+	0x99, 0x73, 0x9b, 0x33, 0x87, 0x50, 0xb9, 0x8a, 0x2f, 0x9b, 0x93, 0x30, 0x20, 0x01, 0xd2, 0xd9,
+	0xe7, 0xa5, 0xd9, 0x82, 0xf5, 0xbe, 0x3b, 0xf6, 0xe3, 0x89, 0x8d, 0xbf, 0x89, 0x71, 0x44, 0x90,
+	0x01, 0xab, 0x71, 0x84, 0x43, 0xdf, 0xb9, 0xc6, 0x0d, 0x6d, 0x47, 0xdb, 0xad, 0xd8, 0xc9, 0x1a,
+	0xd5, 0xa1, 0x8c, 0xaf, 0x1d, 0xd7, 0x6b, 0x14, 0x18, 0x82, 0x2f, 0xcc, 0xa7, 0xb0, 0x26, 0x45,
+	0x4c, 0xbc, 0x29, 0xaa, 0x41, 0xf1, 0x2d, 0x9e, 0x32, 0xde, 0xdb, 0x36, 0xfd, 0x44, 0x0d, 0x58,
+	0x89, 0x70, 0x14, 0xb9, 0x81, 0x2f, 0x18, 0xe5, 0xd2, 0x7c, 0xca, 0xb5, 0xbb, 0xbe, 0xd4, 0xbe,
+	0x0b, 0x1b, 0x52, 0xdb, 0x69, 0x68, 0x31, 0x5d, 0x7c, 0x13, 0x59, 0xb0, 0xd4, 0xea, 0xfa, 0x1f,
+	0xaf, 0xb5, 0x06, 0x55, 0xca, 0x1a, 0xc4, 0x44, 0xa8, 0x35, 0xab, 0x70, 0x3b, 0x81, 0x4c, 0xbc,
+	0xa9, 0x79, 0x07, 0xb6, 0xba, 0x98, 0xf4, 0x39, 0x7d, 0xcf, 0x7f, 0x13, 0x48, 0xc2, 0xd7, 0xb0,
+	0x99, 0x45, 0xe4, 0x6b, 0x57, 0xcd, 0x58, 0x58, 0x64, 0xc6, 0xa2, 0x6a, 0xc6, 0x53, 0xa8, 0xed,
+	0x87, 0xd8, 0x21, 0xf8, 0x10, 0x4f, 0xa5, 0x39, 0xbe, 0x80, 0x12, 0x99, 0x4e, 0xb8, 0x23, 0xaa,
+	0x7b, 0x1b, 0x4d, 0xee, 0xb4, 0xe6, 0x21, 0x9e, 0x0e, 0xa6, 0x13, 0x6c, 0x33, 0x24, 0xda, 0x06,
+	0x3d, 0xc2, 0xc3, 0x38, 0xe4, 0x8a, 0x56, 0x6d, 0xb1, 0x32, 0xff, 0xaa, 0xc1, 0x5a, 0x17, 0x13,
+	0x26, 0x2e, 0xb3, 0xc9, 0x0a, 0xdf, 0x24, 0xe7, 0x0c, 0x31, 0x11, 0x5b, 0x14, 0xab, 0x44, 0x6d,
+	0x71, 0x99, 0xda, 0x3a, 0x94, 0x6f, 0x1c, 0xcf, 0x1d, 0x35, 0x4a, 0x4c, 0x2b, 0x5f, 0x50, 0xab,
+	0x93, 0xab, 0x10, 0x3b, 0xa3, 0xa8, 0x51, 0xde, 0xd1, 0x76, 0xcb, 0xb6, 0x5c, 0x2a, 0xdb, 0xd4,
+	0xd5, 0x6d, 0xa2, 0x26, 0x20, 0x6a, 0x99, 0x76, 0x3c, 0x7c, 0x8b, 0x49, 0x74, 0xec, 0xbc, 0xeb,
+	0xbb, 0xbf, 0xc6, 0x8d, 0x95, 0x1d, 0x6d, 0xb7, 0x68, 0xe7, 0x60, 0xcc, 0x5d, 0xa8, 0xf7, 0x7c,
+	0xa6, 0x2c, 0x6d, 0xab, 0xb9, 0xe3, 0x99, 0x75, 0x40, 0x19, 0x4a, 0xea, 0x5b, 0x1b, 0xb6, 0xfb,
+	0xcc, 0x2a, 0xe7, 0x11, 0x0e, 0x5f, 0xc6, 0x01, 0x71, 0x16, 0x4a, 0x40, 0x5f, 0x42, 0xf5, 0x32,
+	0xbd, 0xaf, 0x02, 0xdb, 0x57, 0x06, 0x6a, 0x6e, 0x43, 0x7d, 0x4e, 0x26, 0xd5, 0xf5, 0x09, 0x6c,
+	0x1c, 0xb9, 0x11, 0x45, 0x44, 0x32, 0x82, 0x9e, 0xc0, 0xfa, 0x0c, 0x44, 0xdd, 0xf2, 0x2d, 0x28,
+	0x79, 0x6e, 0x44, 0x1a, 0xda, 0x4e, 0x71, 0x77, 0x6d, 0x6f, 0x53, 0x1a, 0x5b, 0xf1, 0x9c, 0xcd,
+	0x08, 0xcc, 0x2f, 0x65, 0x80, 0x9c, 0x86, 0x63, 0xb9, 0x65, 0x04, 0x25, 0x25, 0x53, 0xd9, 0xb7,
+	0xb9, 0x01, 0xeb, 0x5d, 0x4c, 0x66, 0x44, 0xe6, 0x7f, 0x78, 0x20, 0x30, 0x48, 0x7e, 0xb4, 0x4a,
+	0x31, 0x85, 0x99, 0x18, 0x0a, 0x8b, 0xbc, 0x78, 0x2c, 0x82, 0x94, 0x7d, 0x53, 0xd8, 0x55, 0x10,
+	0x11, 0xe6, 0xf2, 0x8a, 0xcd, 0xbe, 0xd1, 0xd7, 0xb0, 0x72, 0x8d, 0xaf, 0x2f, 0x71, 0x48, 0x3d,
+	0x4e, 0x8f, 0x60, 0x28, 0x47, 0x90, 0x3a, 0x9b, 0xc7, 0x8c, 0xc4, 0x96, 0xa4, 0xe8, 0x1e, 0x54,
+	0x86, 0xec, 0x30, 0xa3, 0x16, 0x61, 0x01, 0x51, 0xb4, 0x67, 0x00, 0xe3, 0x05, 0xe8, 0x9c, 0xe1,
+	0x23, 0x33, 0x0b, 0x41, 0x29, 0x0c, 0x3c, 0x2c, 0xf7, 0x4c, 0xbf, 0xa5, 0x0f, 0x4e, 0xc3, 0x71,
+	0xd6, 0x07, 0x1c, 0xb4, 0xdc, 0x07, 0xf2, 0x00, 0xc2, 0x07, 0x08, 0x6a, 0x36, 0xbe, 0x0e, 0x6e,
+	0x14, 0x1f, 0xd0, 0x72, 0xa2, 0xc0, 0xa8, 0xdb, 0x1f, 0xb1, 0xc0, 0x73, 0x09, 0x1e, 0x04, 0x8a,
+	0xaf, 0x92, 0xb4, 0xd7, 0xd4, 0xb4, 0xdf, 0x85, 0x5a, 0x8a, 0x96, 0x6e, 0xa7, 0x0e, 0x65, 0x12,
+	0xbc, 0xc5, 0xbe, 0xa4, 0x64, 0x0b, 0xf3, 0x6b, 0xd8, 0xe6, 0x94, 0xc7, 0x8e, 0x3f, 0x4d, 0x49,
+	0x36, 0x60, 0xd5, 0x65, 0x18, 0x1c, 0xb1, 0x23, 0x54, 0xec, 0x64, 0x6d, 0xfe, 0xb9, 0x00, 0xf5,
+	0x39, 0x36, 0xaa, 0xe4, 0xc7, 0xb0, 0x12, 0xe2, 0x28, 0xf6, 0x48, 0x24, 0x8e, 0xfd, 0x85, 0x3c,
+	0x76, 0x1e, 0x79, 0xd3, 0x66, 0xb4, 0xb6, 0xe4, 0x31, 0xfe, 0xa1, 0x81, 0xce, 0x61, 0x34, 0xe7,
+	0x85, 0x3a, 0xb1, 0x61, 0xb9, 0x44, 0x6d, 0xd0, 0x23, 0xe2, 0x90, 0x38, 0x62, 0x9e, 0xaa, 0xee,
+	0x3d, 0xfa, 0x00, 0x15, 0xcd, 0x3e, 0xe3, 0xb0, 0x05, 0xe7, 0xcc, 0x18, 0x45, 0xc5, 0x18, 0x54,
+	0xe7, 0x35, 0x8e, 0x22, 0x67, 0x8c, 0x45, 0x30, 0xca, 0xa5, 0x79, 0x06, 0x3a, 0x97, 0x80, 0x36,
+	0x60, 0xed, 0xfc, 0xa4, 0x7f, 0x66, 0xed, 0xf7, 0x0e, 0x7a, 0x56, 0xa7, 0x76, 0x0b, 0xad, 0x42,
+	0xa9, 0x6f, 0x9d, 0x0c, 0x6a, 0x1a, 0x42, 0x50, 0x6d, 0x1d, 0xd9, 0x56, 0xab, 0xf3, 0xfa, 0xe2,
+	0xd8, 0x3a, 0x6e, 0x5b, 0x76, 0xad, 0x80, 0xd6, 0x60, 0xa5, 0x77, 0xf2, 0xaa, 0x75, 0xd4, 0xeb,
+	0xd4, 0x8a, 0x08, 0x40, 0x3f, 0x68, 0xf5, 0x8e, 0xac, 0x4e, 0xad, 0xc4, 0x22, 0x08, 0x3b, 0x29,
+	0x9f, 0x6f, 0xc0, 0xfa, 0x0c, 0x44, 0x5d, 0xfe, 0x04, 0x8c, 0x5e, 0x74, 0x2e, 0xe2, 0xb0, 0x75,
+	0xe3, 0xb8, 0x9e, 0x73, 0xe9, 0xe1, 0x0f, 0xb8, 0x54, 0x4d, 0x03, 0x1a, 0xb9, 0x9c, 0x54, 0xea,
+	0x77, 0xe1, 0xd3, 0x5e, 0x74, 0x1a, 0x8e, 0x4f, 0xf2, 0x84, 0xe6, 0xe5, 0x7e, 0x0b, 0xee, 0xe4,
+	0x31, 0x50, 0x7f, 0xcb, 0x7c, 0xd6, 0x72, 0xf2, 0xb9, 0x30, 0xcb, 0x67, 0xf3, 0x7b, 0xec, 0xee,
+	0x3b, 0xa7, 0xb6, 0xb4, 0xf1, 0x24, 0x08, 0xe5, 0x25, 0x49, 0x4d, 0x3e, 0x0e, 0x83, 0x78, 0xd2,
+	0x96, 0x25, 0x52, 0x2e, 0xcd, 0xdf, 0x94, 0x61, 0x33, 0xcb, 0x43, 0x55, 0xb6, 0xa1, 0x12, 0xe2,
+	0x28, 0x88, 0xc3, 0x21, 0x96, 0x41, 0xf6, 0x50, 0xc9, 0xad, 0x2c, 0x7d, 0xd3, 0x16, 0xc4, 0xf6,
+	0x8c, 0x0d, 0x3d, 0x05, 0x9d, 0xa9, 0xa1, 0x21, 0x44, 0x05, 0x7c, 0xbe, 0x4c, 0x40, 0x97, 0x52,
+	0xda, 0x82, 0x81, 0xd6, 0x18, 0x12, 0x10, 0xc7, 0x63, 0x85, 0xbb, 0xc8, 0x6b, 0x4c, 0x02, 0x40,
+	0x8f, 0xa1, 0x3c, 0xc2, 0xa3, 0x78, 0xc2, 0xe2, 0xe7, 0x3d, 0x72, 0x3b, 0x94, 0xd0, 0xe6, 0xf4,
+	0xc6, 0xbf, 0x34, 0x58, 0x95, 0x3b, 0xa5, 0x16, 0x4c, 0x6e, 0xe8, 0x8a, 0xb8, 0x19, 0xab, 0x50,
+	0xe8, 0x75, 0x84, 0x4d, 0x0b, 0xbd, 0x4e, 0xe2, 0xa8, 0xa2, 0x52, 0x5d, 0xb7, 0x41, 0xe7, 0x17,
+	0xa3, 0x08, 0x5f, 0xb1, 0x62, 0x5e, 0xa2, 0xdb, 0x2d, 0xb3, 0xed, 0xb2, 0x6f, 0xd4, 0x86, 0x12,
+	0x71, 0xc6, 0x51, 0x43, 0x67, 0x06, 0x68, 0x7e, 0x88, 0x05, 0x9b, 0x03, 0x67, 0x1c, 0x59, 0x3e,
+	0x09, 0xa7, 0x36, 0xe3, 0x35, 0x1e, 0x43, 0x25, 0x01, 0xe5, 0x5c, 0x74, 0xfc, 0x32, 0x8f, 0x65,
+	0x45, 0xe5, 0x8b, 0x1f, 0x15, 0x9e, 0x68, 0x46, 0x17, 0xca, 0xcc, 0xaa, 0x33, 0x12, 0x4d, 0x21,
+	0x49, 0xf6, 0x5b, 0x50, 0xf6, 0x5b, 0x87, 0xf2, 0x30, 0x88, 0x7d, 0x22, 0x6c, 0xce, 0x17, 0x46,
+	0x04, 0x65, 0x66, 0x46, 0x1a, 0x47, 0xc1, 0xe5, 0xaf, 0xf0, 0x90, 0x15, 0x1e, 0x4a, 0x20, 0x97,
+	0xac, 0x7c, 0xe3, 0x37, 0x91, 0x14, 0x46, 0xbf, 0xd1, 0x67, 0x00, 0x11, 0x09, 0x42, 0x3c, 0x52,
+	0xbc, 0xa8, 0x40, 0xa8, 0x93, 0x23, 0xe7, 0x46, 0xa0, 0x4b, 0xdc, 0xc9, 0x09, 0xc0, 0xfc, 0xb7,
+	0x06, 0xa5, 0xfe, 0x04, 0x0f, 0xd1, 0x43, 0x28, 0xbd, 0xc5, 0x53, 0x19, 0x85, 0x35, 0x69, 0x43,
+	0x8a, 0xa3, 0x7d, 0x8d, 0xcd, 0xb0, 0x94, 0x2a, 0x08, 0xc7, 0x32, 0xd4, 0xd2, 0x54, 0x34, 0xd5,
+	0x19, 0xd6, 0x68, 0x43, 0xf1, 0x10, 0x4f, 0xff, 0xaf, 0xe6, 0xcc, 0x78, 0x0d, 0xc5, 0xd3, 0x70,
+	0x9c, 0x97, 0xc3, 0xbc, 0xb4, 0xf1, 0x0b, 0xb5, 0xc0, 0x8a, 0xb9, 0x5c, 0x26, 0x87, 0x28, 0x2e,
+	0x3b, 0x84, 0xf9, 0x02, 0x6a, 0xad, 0xc9, 0xc4, 0x9b, 0x52, 0xb0, 0xcc, 0xdd, 0x1d, 0x28, 0x45,
+	0x13, 0x3c, 0x64, 0x7a, 0xd6, 0xf6, 0x6e, 0xab, 0x9c, 0x36, 0xc3, 0x50, 0xa7, 0x4d, 0xc2, 0xd8,
+	0x97, 0xfb, 0xe4, 0x0b, 0xf3, 0x8f, 0x05, 0xa8, 0x2a, 0xc2, 0x68, 0x52, 0x3f, 0x86, 0x95, 0xe1,
+	0x95, 0xe3, 0x8f, 0x93, 0x94, 0xbe, 0x2f, 0xa5, 0xa5, 0x09, 0x9b, 0xfb, 0x8c, 0xca, 0x96, 0xd4,
+	0xc6, 0xdf, 0x34, 0xd0, 0x39, 0x0c, 0x3d, 0x03, 0xdd, 0x19, 0x12, 0xda, 0x9a, 0x73, 0xe3, 0x3d,
+	0x5c, 0x2a, 0xa2, 0xd9, 0x62, 0xb4, 0xb6, 0xe0, 0xa1, 0xd5, 0x54, 0xd6, 0x07, 0xd9, 0x01, 0xc8,
+	0xb5, 0xc8, 0xbd, 0x62, 0x92, 0x7b, 0x35, 0x28, 0x06, 0xe1, 0x58, 0x24, 0x19, 0xfd, 0xa4, 0x1e,
+	0x19, 0x61, 0x42, 0xef, 0xe1, 0x32, 0xcf, 0x3c, 0xbe, 0x32, 0x9f, 0x81, 0xce, 0xf5, 0xd0, 0xda,
+	0xbf, 0x6f, 0x5b, 0xad, 0x81, 0x55, 0xbb, 0x45, 0xbf, 0x7b, 0x27, 0xaf, 0x7a, 0x03, 0xab, 0xa6,
+	0xd1, 0x6f, 0xdb, 0x3a, 0x3e, 0x7d, 0x65, 0xd5, 0x0a, 0xa8, 0x0a, 0x20, 0x2e, 0x0b, 0x4a, 0x57,
+	0x34, 0xf7, 0xa0, 0x4e, 0x5b, 0x8a, 0x56, 0x3c, 0x72, 0xc9, 0x51, 0x90, 0xb4, 0x1a, 0xa9, 0xbd,
+	0x6a, 0xe9, 0xbd, 0x9a, 0xff, 0xd4, 0x00, 0x65, 0x98, 0xb8, 0x81, 0xd5, 0x66, 0x24, 0xb9, 0x95,
+	0xe7, 0x29, 0x9b, 0x72, 0xc9, 0x9b, 0x13, 0xe3, 0xb7, 0x1a, 0xac, 0x4a, 0x90, 0x30, 0x84, 0x96,
+	0x18, 0xa2, 0x0e, 0x65, 0x67, 0x48, 0x82, 0x50, 0x66, 0x38, 0x5b, 0x50, 0x63, 0x08, 0x47, 0x70,
+	0x93, 0xe5, 0x99, 0xb8, 0x94, 0x31, 0xf1, 0x36, 0xe8, 0x21, 0x76, 0xa2, 0xc0, 0x97, 0x06, 0xe4,
+	0xab, 0xe5, 0x2d, 0x9d, 0xb9, 0x05, 0x9b, 0x3f, 0x77, 0xc8, 0xf0, 0xaa, 0x35, 0x64, 0xe5, 0x40,
+	0x5e, 0xa4, 0xbf, 0x2b, 0xc0, 0x27, 0x69, 0x38, 0x35, 0xc1, 0x0f, 0xa0, 0x8c, 0x6f, 0xb0, 0x4f,
+	0x44, 0xbc, 0x3e, 0x90, 0x36, 0x98, 0xa3, 0x6c, 0x5a, 0x94, 0xcc, 0xe6, 0xd4, 0xc6, 0x5f, 0x34,
+	0x28, 0x33, 0x00, 0x7a, 0x92, 0xca, 0xcd, 0x87, 0xef, 0xe1, 0x6f, 0x2a, 0x09, 0x9b, 0x2d, 0xde,
+	0xb3, 0x70, 0x29, 0xaa, 0xe1, 0xc2, 0x0a, 0xbf, 0x7b, 0x2d, 0x4b, 0x0e, 0xfb, 0x36, 0x5f, 0x42,
+	0x89, 0x4a, 0xa2, 0x8d, 0xc7, 0xa1, 0xf5, 0xfa, 0x82, 0x07, 0x11, 0x6d, 0x3c, 0xaa, 0x00, 0xa7,
+	0x76, 0xf7, 0xe2, 0xc5, 0x69, 0xef, 0xc4, 0xea, 0xf0, 0xf6, 0xa3, 0x7d, 0xbe, 0x7f, 0x68, 0x0d,
+	0x12, 0x9a, 0x02, 0xaa, 0x43, 0xad, 0x65, 0xef, 0x3f, 0xef, 0xbd, 0xb2, 0x2e, 0x0e, 0x7a, 0x27,
+	0xbd, 0xfe, 0x73, 0xab, 0x53, 0x2b, 0x9a, 0x7f, 0xd2, 0x00, 0xce, 0xe2, 0xe8, 0xea, 0x2c, 0xf0,
+	0xdc, 0xe1, 0x14, 0xed, 0xc0, 0xda, 0xb5, 0xf3, 0xee, 0xc0, 0xf5, 0x30, 0xab, 0x77, 0xbc, 0x7e,
+	0xaa, 0x20, 0xf4, 0x15, 0x6c, 0xbe, 0x09, 0xc2, 0x4b, 0x77, 0x34, 0xc2, 0xbe, 0xf5, 0x8e, 0x60,
+	0x9f, 0xbe, 0x54, 0x65, 0x25, 0xc9, 0x43, 0xa1, 0x87, 0xb0, 0x1e, 0xe2, 0x6f, 0x62, 0x37, 0xc4,
+	0xa3, 0x33, 0x87, 0x5c, 0xf1, 0xf2, 0x52, 0xb1, 0xd3, 0x40, 0xfa, 0x14, 0x12, 0x80, 0x23, 0x77,
+	0x88, 0xfd, 0x08, 0x8b, 0x77, 0x5f, 0x06, 0x6a, 0xb6, 0xd9, 0x53, 0x68, 0xb6, 0x65, 0x99, 0x08,
+	0x8f, 0x40, 0x9f, 0x30, 0x80, 0xf0, 0x29, 0x92, 0x3e, 0x51, 0x48, 0x05, 0x05, 0x7d, 0xb8, 0x65,
+	0x64, 0xd0, 0x66, 0x68, 0x1b, 0xea, 0xdd, 0x1c, 0xc9, 0xe6, 0xcf, 0x00, 0x75, 0xe7, 0xa8, 0x3f,
+	0x4a, 0xdf, 0xdf, 0x35, 0x58, 0x6f, 0x85, 0xc3, 0x2b, 0xf7, 0x06, 0xef, 0x07, 0xfe, 0x1b, 0x77,
+	0x4c, 0x6f, 0x9d, 0xab, 0x80, 0x58, 0x3e, 0x6d, 0x9f, 0x46, 0x4c, 0xc2, 0xaa, 0xad, 0x40, 0xa8,
+	0x1f, 0x86, 0x81, 0x37, 0x92, 0x04, 0xbc, 0x66, 0xaa, 0x20, 0x9a, 0x0d, 0x21, 0x9e, 0x1c, 0xf0,
+	0x9c, 0x13, 0xcd, 0x47, 0x02, 0xa0, 0x73, 0x8e, 0x11, 0x76, 0xbc, 0x63, 0xd7, 0xef, 0xc4, 0xa1,
+	0xc3, 0x12, 0x90, 0x07, 0x52, 0x16, 0x4c, 0xbd, 0x43, 0xc2, 0x38, 0x22, 0x78, 0x74, 0xec, 0xfa,
+	0xf2, 0x91, 0x55, 0xb1, 0xd3, 0x40, 0xea, 0x1d, 0xfc, 0x6e, 0xe8, 0xc5, 0xa3, 0x84, 0x4c, 0x67,
+	0x64, 0x19, 0xa8, 0xf9, 0x1c, 0xee, 0xf4, 0x31, 0x49, 0x9d, 0x55, 0x3a, 0xe8, 0x3b, 0xa0, 0x0f,
+	0x19, 0x40, 0x18, 0x6c, 0x2b, 0xa9, 0xc9, 0x29, 0x6a, 0x41, 0x44, 0x47, 0x24, 0xf3, 0x92, 0xa8,
+	0x9b, 0x3e, 0x85, 0x3b, 0xdd, 0x7c, 0x15, 0xe6, 0x01, 0x6c, 0xcd, 0xa3, 0xa8, 0xb3, 0x3e, 0x5e,
+	0x77, 0x07, 0x47, 0x24, 0x0c, 0xa6, 0x99, 0x6a, 0xb2, 0x05, 0x9b, 0x59, 0xc4, 0xc4, 0x9b, 0x3e,
+	0xda, 0x81, 0x15, 0x71, 0x2b, 0xd3, 0x26, 0xbf, 0xb5, 0xbf, 0x7f, 0x7a, 0x7e, 0x32, 0xe0, 0xef,
+	0x81, 0xf3, 0xbe, 0x65, 0xd7, 0xb4, 0xbd, 0x3f, 0x6c, 0x40, 0xb1, 0x75, 0xd6, 0x43, 0x3f, 0x04,
+	0x9d, 0xcf, 0xb2, 0x50, 0xb2, 0x85, 0xd4, 0x78, 0xcc, 0xd8, 0xcc, 0x82, 0xe9, 0x91, 0x6f, 0x49,
+	0x3e, 0xd7, 0x4f, 0xf3, 0xb9, 0x7e, 0x2e, 0x9f, 0x18, 0x5a, 0x99, 0xb7, 0xd0, 0x53, 0x58, 0x11,
+	0x83, 0x27, 0xb4, 0xad, 0x52, 0xcc, 0x66, 0x53, 0x46, 0x7d, 0x0e, 0xce, 0x59, 0x4f, 0xa0, 0x9a,
+	0x1e, 0x45, 0xa1, 0xfb, 0x4a, 0x67, 0x38, 0x3f, 0xbb, 0x32, 0xee, 0x2e, 0x42, 0x73, 0x79, 0xcf,
+	0xa0, 0x92, 0xcc, 0x9f, 0x50, 0x43, 0xd2, 0x66, 0x47, 0x52, 0x46, 0xde, 0x80, 0x82, 0x71, 0xaf,
+	0xca, 0xb1, 0x06, 0xba, 0xa3, 0x5e, 0x59, 0xca, 0xec, 0xc3, 0xd8, 0x9a, 0x47, 0x70, 0xee, 0x43,
+	0x58, 0x4f, 0x4d, 0x6a, 0xd0, 0x3d, 0xe5, 0xa1, 0x38, 0x37, 0xea, 0x31, 0x8c, 0x05, 0x58, 0x2e,
+	0xec, 0x25, 0x6c, 0x64, 0x86, 0x31, 0xe8, 0xb3, 0xc4, 0x86, 0xb9, 0x93, 0x1f, 0xe3, 0xde, 0x42,
+	0x7c, 0xc6, 0x36, 0xb4, 0x67, 0xcb, 0xd8, 0x66, 0xf6, 0x2a, 0x34, 0xf2, 0x06, 0x07, 0x3c, 0x38,
+	0x38, 0x60, 0x16, 0x1c, 0xa9, 0x01, 0xcd, 0x22, 0x3e, 0x61, 0x53, 0x3a, 0xa6, 0x48, 0xdb, 0x54,
+	0x99, 0x65, 0x18, 0x5b, 0xf3, 0x08, 0xce, 0xfd, 0x53, 0xa8, 0x24, 0x63, 0x89, 0xd9, 0x9e, 0xb3,
+	0xd3, 0x0b, 0x63, 0x3b, 0x07, 0xc3, 0x05, 0x58, 0xb0, 0xa6, 0x4c, 0x26, 0x90, 0x91, 0x7e, 0xbb,
+	0xab, 0x03, 0x08, 0xa3, 0x91, 0x8b, 0x4b, 0xdc, 0x91, 0x79, 0xed, 0xcf, 0xdc, 0x91, 0x3f, 0xcf,
+	0x30, 0xee, 0x2d, 0x1b, 0x13, 0x08, 0xc3, 0x88, 0xd7, 0xb7, 0x62, 0x98, 0xf4, 0x13, 0xdd, 0xd8,
+	0x9a, 0x47, 0x70, 0xee, 0x5f, 0xc2, 0x66, 0xce, 0x83, 0x1b, 0x99, 0x89, 0xd2, 0x85, 0xef, 0x78,
+	0x63, 0x67, 0x29, 0x0d, 0x17, 0xff, 0x0b, 0x40, 0xf3, 0x4f, 0x70, 0xf4, 0xf9, 0x8c, 0x73, 0xc1,
+	0x7b, 0xde, 0x78, 0xb0, 0x8c, 0x44, 0xcd, 0x79, 0xe5, 0xd5, 0x97, 0xca, 0xf9, 0xf9, 0x37, 0xbb,
+	0x71, 0x77, 0x11, 0x9a, 0xcb, 0xfb, 0x09, 0xac, 0x9e, 0x79, 0x8e, 0xcf, 0x5e, 0x48, 0x8d, 0x9c,
+	0x1e, 0x3c, 0x13, 0x22, 0xe9, 0xee, 0x9c, 0xc7, 0x58, 0x02, 0xfb, 0x9f, 0x04, 0x1c, 0xf2, 0x49,
+	0x5c, 0xd2, 0xd7, 0xce, 0x12, 0x3f, 0xaf, 0x9b, 0x36, 0x8c, 0x05, 0x58, 0x2e, 0xec, 0x05, 0xdc,
+	0x56, 0x1b, 0x3c, 0x74, 0x37, 0xbf, 0xed, 0xe3, 0xa2, 0x3e, 0x5d, 0xd8, 0x13, 0x9a, 0xb7, 0xbe,
+	0xd2, 0xe8, 0xc6, 0x52, 0x2d, 0x08, 0x52, 0x4b, 0xc4, 0x5c, 0x0f, 0x62, 0x18, 0x0b, 0xb0, 0xc9,
+	0x29, 0xbb, 0xf9, 0xc2, 0xba, 0x4b, 0x85, 0x75, 0xf3, 0x84, 0x0d, 0xa0, 0x96, 0xbd, 0x78, 0xd1,
+	0x03, 0x45, 0x7d, 0xde, 0xcd, 0x6b, 0xdc, 0x5f, 0x4c, 0x90, 0x48, 0xed, 0x2e, 0x94, 0xda, 0x7d,
+	0x9f, 0xd4, 0xee, 0x02, 0xa9, 0x27, 0x50, 0x4d, 0xdf, 0xc7, 0xb3, 0x78, 0xcd, 0xbd, 0xc0, 0x8d,
+	0xbb, 0x8b, 0xd0, 0x4c, 0x5e, 0xfb, 0xdb, 0xb0, 0xe9, 0x06, 0x4d, 0x82, 0xdf, 0x11, 0xd7, 0xc3,
+	0x94, 0xf4, 0x62, 0x1c, 0x4e, 0x86, 0x6d, 0x18, 0x70, 0xc8, 0xf3, 0xf8, 0xf2, 0x4c, 0xfb, 0x7d,
+	0x41, 0x1f, 0x0c, 0x2e, 0x9e, 0x9f, 0xb7, 0x2f, 0x75, 0xf6, 0xab, 0xeb, 0xfb, 0xff, 0x1d, 0x00,
+	0x18, 0x60, 0x50, 0x50, 0xf7, 0x1a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1458,14 +3220,25 @@ type APIClient interface {
 	CreateKey(ctx context.Context, in *CreateKeyRequest, opts ...grpc.CallOption) (*GetKeyReply, error)
 	ListKeys(ctx context.Context, in *ListKeysRequest, opts ...grpc.CallOption) (*ListKeysReply, error)
 	InvalidateKey(ctx context.Context, in *InvalidateKeyRequest, opts ...grpc.CallOption) (*InvalidateKeyReply, error)
+	SetKeyUserQuota(ctx context.Context, in *SetKeyUserQuotaRequest, opts ...grpc.CallOption) (*SetKeyUserQuotaReply, error)
 	CreateOrg(ctx context.Context, in *CreateOrgRequest, opts ...grpc.CallOption) (*GetOrgReply, error)
 	GetOrg(ctx context.Context, in *GetOrgRequest, opts ...grpc.CallOption) (*GetOrgReply, error)
 	ListOrgs(ctx context.Context, in *ListOrgsRequest, opts ...grpc.CallOption) (*ListOrgsReply, error)
 	RemoveOrg(ctx context.Context, in *RemoveOrgRequest, opts ...grpc.CallOption) (*RemoveOrgReply, error)
 	InviteToOrg(ctx context.Context, in *InviteToOrgRequest, opts ...grpc.CallOption) (*InviteToOrgReply, error)
+	InviteManyToOrg(ctx context.Context, in *InviteManyToOrgRequest, opts ...grpc.CallOption) (*InviteManyToOrgReply, error)
 	LeaveOrg(ctx context.Context, in *LeaveOrgRequest, opts ...grpc.CallOption) (*LeaveOrgReply, error)
 	IsUsernameAvailable(ctx context.Context, in *IsUsernameAvailableRequest, opts ...grpc.CallOption) (*IsUsernameAvailableReply, error)
 	IsOrgNameAvailable(ctx context.Context, in *IsOrgNameAvailableRequest, opts ...grpc.CallOption) (*IsOrgNameAvailableReply, error)
+	GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportReply, error)
+	PlanSpec(ctx context.Context, in *ApplySpecRequest, opts ...grpc.CallOption) (*ApplySpecReply, error)
+	ApplySpec(ctx context.Context, in *ApplySpecRequest, opts ...grpc.CallOption) (*ApplySpecReply, error)
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsReply, error)
+	WatchAccount(ctx context.Context, in *WatchAccountRequest, opts ...grpc.CallOption) (API_WatchAccountClient, error)
+	SetPushPolicy(ctx context.Context, in *SetPushPolicyRequest, opts ...grpc.CallOption) (*SetPushPolicyReply, error)
+	GetPushPolicy(ctx context.Context, in *GetPushPolicyRequest, opts ...grpc.CallOption) (*GetPushPolicyReply, error)
+	SetArchiveConfig(ctx context.Context, in *SetArchiveConfigRequest, opts ...grpc.CallOption) (*SetArchiveConfigReply, error)
+	GetArchiveConfig(ctx context.Context, in *GetArchiveConfigRequest, opts ...grpc.CallOption) (*GetArchiveConfigReply, error)
 	DestroyAccount(ctx context.Context, in *DestroyAccountRequest, opts ...grpc.CallOption) (*DestroyAccountReply, error)
 }
 
@@ -1540,6 +3313,15 @@ func (c *aPIClient) InvalidateKey(ctx context.Context, in *InvalidateKeyRequest,
 	return out, nil
 }
 
+func (c *aPIClient) SetKeyUserQuota(ctx context.Context, in *SetKeyUserQuotaRequest, opts ...grpc.CallOption) (*SetKeyUserQuotaReply, error) {
+	out := new(SetKeyUserQuotaReply)
+	err := c.cc.Invoke(ctx, "/hub.pb.API/SetKeyUserQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) CreateOrg(ctx context.Context, in *CreateOrgRequest, opts ...grpc.CallOption) (*GetOrgReply, error) {
 	out := new(GetOrgReply)
 	err := c.cc.Invoke(ctx, "/hub.pb.API/CreateOrg", in, out, opts...)
@@ -1585,6 +3367,15 @@ func (c *aPIClient) InviteToOrg(ctx context.Context, in *InviteToOrgRequest, opt
 	return out, nil
 }
 
+func (c *aPIClient) InviteManyToOrg(ctx context.Context, in *InviteManyToOrgRequest, opts ...grpc.CallOption) (*InviteManyToOrgReply, error) {
+	out := new(InviteManyToOrgReply)
+	err := c.cc.Invoke(ctx, "/hub.pb.API/InviteManyToOrg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) LeaveOrg(ctx context.Context, in *LeaveOrgRequest, opts ...grpc.CallOption) (*LeaveOrgReply, error) {
 	out := new(LeaveOrgReply)
 	err := c.cc.Invoke(ctx, "/hub.pb.API/LeaveOrg", in, out, opts...)
@@ -1612,6 +3403,110 @@ func (c *aPIClient) IsOrgNameAvailable(ctx context.Context, in *IsOrgNameAvailab
 	return out, nil
 }
 
+func (c *aPIClient) GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*GetUsageReportReply, error) {
+	out := new(GetUsageReportReply)
+	err := c.cc.Invoke(ctx, "/hub.pb.API/GetUsageReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) PlanSpec(ctx context.Context, in *ApplySpecRequest, opts ...grpc.CallOption) (*ApplySpecReply, error) {
+	out := new(ApplySpecReply)
+	err := c.cc.Invoke(ctx, "/hub.pb.API/PlanSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ApplySpec(ctx context.Context, in *ApplySpecRequest, opts ...grpc.CallOption) (*ApplySpecReply, error) {
+	out := new(ApplySpecReply)
+	err := c.cc.Invoke(ctx, "/hub.pb.API/ApplySpec", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsRequest, opts ...grpc.CallOption) (*ListAuditLogsReply, error) {
+	out := new(ListAuditLogsReply)
+	err := c.cc.Invoke(ctx, "/hub.pb.API/ListAuditLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) WatchAccount(ctx context.Context, in *WatchAccountRequest, opts ...grpc.CallOption) (API_WatchAccountClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_API_serviceDesc.Streams[0], "/hub.pb.API/WatchAccount", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIWatchAccountClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type API_WatchAccountClient interface {
+	Recv() (*WatchAccountReply, error)
+	grpc.ClientStream
+}
+
+type aPIWatchAccountClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIWatchAccountClient) Recv() (*WatchAccountReply, error) {
+	m := new(WatchAccountReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIClient) SetPushPolicy(ctx context.Context, in *SetPushPolicyRequest, opts ...grpc.CallOption) (*SetPushPolicyReply, error) {
+	out := new(SetPushPolicyReply)
+	err := c.cc.Invoke(ctx, "/hub.pb.API/SetPushPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetPushPolicy(ctx context.Context, in *GetPushPolicyRequest, opts ...grpc.CallOption) (*GetPushPolicyReply, error) {
+	out := new(GetPushPolicyReply)
+	err := c.cc.Invoke(ctx, "/hub.pb.API/GetPushPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) SetArchiveConfig(ctx context.Context, in *SetArchiveConfigRequest, opts ...grpc.CallOption) (*SetArchiveConfigReply, error) {
+	out := new(SetArchiveConfigReply)
+	err := c.cc.Invoke(ctx, "/hub.pb.API/SetArchiveConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetArchiveConfig(ctx context.Context, in *GetArchiveConfigRequest, opts ...grpc.CallOption) (*GetArchiveConfigReply, error) {
+	out := new(GetArchiveConfigReply)
+	err := c.cc.Invoke(ctx, "/hub.pb.API/GetArchiveConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) DestroyAccount(ctx context.Context, in *DestroyAccountRequest, opts ...grpc.CallOption) (*DestroyAccountReply, error) {
 	out := new(DestroyAccountReply)
 	err := c.cc.Invoke(ctx, "/hub.pb.API/DestroyAccount", in, out, opts...)
@@ -1630,14 +3525,25 @@ type APIServer interface {
 	CreateKey(context.Context, *CreateKeyRequest) (*GetKeyReply, error)
 	ListKeys(context.Context, *ListKeysRequest) (*ListKeysReply, error)
 	InvalidateKey(context.Context, *InvalidateKeyRequest) (*InvalidateKeyReply, error)
+	SetKeyUserQuota(context.Context, *SetKeyUserQuotaRequest) (*SetKeyUserQuotaReply, error)
 	CreateOrg(context.Context, *CreateOrgRequest) (*GetOrgReply, error)
 	GetOrg(context.Context, *GetOrgRequest) (*GetOrgReply, error)
 	ListOrgs(context.Context, *ListOrgsRequest) (*ListOrgsReply, error)
 	RemoveOrg(context.Context, *RemoveOrgRequest) (*RemoveOrgReply, error)
 	InviteToOrg(context.Context, *InviteToOrgRequest) (*InviteToOrgReply, error)
+	InviteManyToOrg(context.Context, *InviteManyToOrgRequest) (*InviteManyToOrgReply, error)
 	LeaveOrg(context.Context, *LeaveOrgRequest) (*LeaveOrgReply, error)
 	IsUsernameAvailable(context.Context, *IsUsernameAvailableRequest) (*IsUsernameAvailableReply, error)
 	IsOrgNameAvailable(context.Context, *IsOrgNameAvailableRequest) (*IsOrgNameAvailableReply, error)
+	GetUsageReport(context.Context, *GetUsageReportRequest) (*GetUsageReportReply, error)
+	PlanSpec(context.Context, *ApplySpecRequest) (*ApplySpecReply, error)
+	ApplySpec(context.Context, *ApplySpecRequest) (*ApplySpecReply, error)
+	ListAuditLogs(context.Context, *ListAuditLogsRequest) (*ListAuditLogsReply, error)
+	WatchAccount(*WatchAccountRequest, API_WatchAccountServer) error
+	SetPushPolicy(context.Context, *SetPushPolicyRequest) (*SetPushPolicyReply, error)
+	GetPushPolicy(context.Context, *GetPushPolicyRequest) (*GetPushPolicyReply, error)
+	SetArchiveConfig(context.Context, *SetArchiveConfigRequest) (*SetArchiveConfigReply, error)
+	GetArchiveConfig(context.Context, *GetArchiveConfigRequest) (*GetArchiveConfigReply, error)
 	DestroyAccount(context.Context, *DestroyAccountRequest) (*DestroyAccountReply, error)
 }
 
@@ -1666,6 +3572,9 @@ func (*UnimplementedAPIServer) ListKeys(ctx context.Context, req *ListKeysReques
 func (*UnimplementedAPIServer) InvalidateKey(ctx context.Context, req *InvalidateKeyRequest) (*InvalidateKeyReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InvalidateKey not implemented")
 }
+func (*UnimplementedAPIServer) SetKeyUserQuota(ctx context.Context, req *SetKeyUserQuotaRequest) (*SetKeyUserQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetKeyUserQuota not implemented")
+}
 func (*UnimplementedAPIServer) CreateOrg(ctx context.Context, req *CreateOrgRequest) (*GetOrgReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrg not implemented")
 }
@@ -1681,6 +3590,9 @@ func (*UnimplementedAPIServer) RemoveOrg(ctx context.Context, req *RemoveOrgRequ
 func (*UnimplementedAPIServer) InviteToOrg(ctx context.Context, req *InviteToOrgRequest) (*InviteToOrgReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InviteToOrg not implemented")
 }
+func (*UnimplementedAPIServer) InviteManyToOrg(ctx context.Context, req *InviteManyToOrgRequest) (*InviteManyToOrgReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InviteManyToOrg not implemented")
+}
 func (*UnimplementedAPIServer) LeaveOrg(ctx context.Context, req *LeaveOrgRequest) (*LeaveOrgReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LeaveOrg not implemented")
 }
@@ -1690,6 +3602,33 @@ func (*UnimplementedAPIServer) IsUsernameAvailable(ctx context.Context, req *IsU
 func (*UnimplementedAPIServer) IsOrgNameAvailable(ctx context.Context, req *IsOrgNameAvailableRequest) (*IsOrgNameAvailableReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsOrgNameAvailable not implemented")
 }
+func (*UnimplementedAPIServer) GetUsageReport(ctx context.Context, req *GetUsageReportRequest) (*GetUsageReportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageReport not implemented")
+}
+func (*UnimplementedAPIServer) PlanSpec(ctx context.Context, req *ApplySpecRequest) (*ApplySpecReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanSpec not implemented")
+}
+func (*UnimplementedAPIServer) ApplySpec(ctx context.Context, req *ApplySpecRequest) (*ApplySpecReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplySpec not implemented")
+}
+func (*UnimplementedAPIServer) ListAuditLogs(ctx context.Context, req *ListAuditLogsRequest) (*ListAuditLogsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
+func (*UnimplementedAPIServer) WatchAccount(req *WatchAccountRequest, srv API_WatchAccountServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAccount not implemented")
+}
+func (*UnimplementedAPIServer) SetPushPolicy(ctx context.Context, req *SetPushPolicyRequest) (*SetPushPolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPushPolicy not implemented")
+}
+func (*UnimplementedAPIServer) GetPushPolicy(ctx context.Context, req *GetPushPolicyRequest) (*GetPushPolicyReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPushPolicy not implemented")
+}
+func (*UnimplementedAPIServer) SetArchiveConfig(ctx context.Context, req *SetArchiveConfigRequest) (*SetArchiveConfigReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetArchiveConfig not implemented")
+}
+func (*UnimplementedAPIServer) GetArchiveConfig(ctx context.Context, req *GetArchiveConfigRequest) (*GetArchiveConfigReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArchiveConfig not implemented")
+}
 func (*UnimplementedAPIServer) DestroyAccount(ctx context.Context, req *DestroyAccountRequest) (*DestroyAccountReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyAccount not implemented")
 }
@@ -1824,6 +3763,24 @@ func _API_InvalidateKey_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_SetKeyUserQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetKeyUserQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetKeyUserQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.pb.API/SetKeyUserQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetKeyUserQuota(ctx, req.(*SetKeyUserQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_CreateOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateOrgRequest)
 	if err := dec(in); err != nil {
@@ -1914,6 +3871,24 @@ func _API_InviteToOrg_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_InviteManyToOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InviteManyToOrgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).InviteManyToOrg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.pb.API/InviteManyToOrg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).InviteManyToOrg(ctx, req.(*InviteManyToOrgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_LeaveOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LeaveOrgRequest)
 	if err := dec(in); err != nil {
@@ -1968,6 +3943,171 @@ func _API_IsOrgNameAvailable_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_GetUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.pb.API/GetUsageReport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUsageReport(ctx, req.(*GetUsageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_PlanSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).PlanSpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.pb.API/PlanSpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).PlanSpec(ctx, req.(*ApplySpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ApplySpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplySpecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ApplySpec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.pb.API/ApplySpec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ApplySpec(ctx, req.(*ApplySpecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListAuditLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.pb.API/ListAuditLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListAuditLogs(ctx, req.(*ListAuditLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_WatchAccount_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAccountRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServer).WatchAccount(m, &aPIWatchAccountServer{stream})
+}
+
+type API_WatchAccountServer interface {
+	Send(*WatchAccountReply) error
+	grpc.ServerStream
+}
+
+type aPIWatchAccountServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIWatchAccountServer) Send(m *WatchAccountReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _API_SetPushPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPushPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetPushPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.pb.API/SetPushPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetPushPolicy(ctx, req.(*SetPushPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetPushPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPushPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetPushPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.pb.API/GetPushPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetPushPolicy(ctx, req.(*GetPushPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_SetArchiveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetArchiveConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetArchiveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.pb.API/SetArchiveConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetArchiveConfig(ctx, req.(*SetArchiveConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetArchiveConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArchiveConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetArchiveConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/hub.pb.API/GetArchiveConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetArchiveConfig(ctx, req.(*GetArchiveConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_DestroyAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DestroyAccountRequest)
 	if err := dec(in); err != nil {
@@ -2018,6 +4158,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InvalidateKey",
 			Handler:    _API_InvalidateKey_Handler,
 		},
+		{
+			MethodName: "SetKeyUserQuota",
+			Handler:    _API_SetKeyUserQuota_Handler,
+		},
 		{
 			MethodName: "CreateOrg",
 			Handler:    _API_CreateOrg_Handler,
@@ -2038,6 +4182,10 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InviteToOrg",
 			Handler:    _API_InviteToOrg_Handler,
 		},
+		{
+			MethodName: "InviteManyToOrg",
+			Handler:    _API_InviteManyToOrg_Handler,
+		},
 		{
 			MethodName: "LeaveOrg",
 			Handler:    _API_LeaveOrg_Handler,
@@ -2050,11 +4198,49 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "IsOrgNameAvailable",
 			Handler:    _API_IsOrgNameAvailable_Handler,
 		},
+		{
+			MethodName: "GetUsageReport",
+			Handler:    _API_GetUsageReport_Handler,
+		},
+		{
+			MethodName: "PlanSpec",
+			Handler:    _API_PlanSpec_Handler,
+		},
+		{
+			MethodName: "ApplySpec",
+			Handler:    _API_ApplySpec_Handler,
+		},
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _API_ListAuditLogs_Handler,
+		},
+		{
+			MethodName: "SetPushPolicy",
+			Handler:    _API_SetPushPolicy_Handler,
+		},
+		{
+			MethodName: "GetPushPolicy",
+			Handler:    _API_GetPushPolicy_Handler,
+		},
+		{
+			MethodName: "SetArchiveConfig",
+			Handler:    _API_SetArchiveConfig_Handler,
+		},
+		{
+			MethodName: "GetArchiveConfig",
+			Handler:    _API_GetArchiveConfig_Handler,
+		},
 		{
 			MethodName: "DestroyAccount",
 			Handler:    _API_DestroyAccount_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAccount",
+			Handler:       _API_WatchAccount_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "hub.proto",
 }
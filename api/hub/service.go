@@ -2,8 +2,10 @@ package hub
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/mail"
+	"strings"
 	"time"
 
 	logging "github.com/ipfs/go-log"
@@ -16,6 +18,7 @@ import (
 	"github.com/textileio/go-threads/core/thread"
 	"github.com/textileio/go-threads/db"
 	netclient "github.com/textileio/go-threads/net/api/client"
+	powutil "github.com/textileio/powergate/util"
 	"github.com/textileio/textile/api/common"
 	pb "github.com/textileio/textile/api/hub/pb"
 	"github.com/textileio/textile/buckets"
@@ -35,6 +38,11 @@ var (
 
 	loginTimeout = time.Minute * 3
 	emailTimeout = time.Second * 10
+
+	// maxInvitees is the max number of invitees accepted by InviteManyToOrg.
+	maxInvitees = 100
+
+	errAlreadyMember = errors.New("already a member")
 )
 
 type Service struct {
@@ -48,6 +56,7 @@ type Service struct {
 	IPFSClient         iface.CoreAPI
 	IPNSManager        *ipns.Manager
 	DNSManager         *dns.Manager
+	AccountEventBus    *broadcast.Broadcaster
 }
 
 func (s *Service) Signup(ctx context.Context, req *pb.SignupRequest) (*pb.SignupReply, error) {
@@ -106,6 +115,8 @@ func (s *Service) Signup(ctx context.Context, req *pb.SignupRequest) (*pb.Signup
 				} else {
 					return nil, err
 				}
+			} else {
+				common.PublishAccountEvent(s.AccountEventBus, dev.Key, common.OrgJoined, invite.Org, "")
 			}
 			if err := s.Collections.Invites.Delete(ctx, invite.Token); err != nil {
 				return nil, err
@@ -226,6 +237,7 @@ func (s *Service) CreateKey(ctx context.Context, req *pb.CreateKeyRequest) (*pb.
 	if err != nil {
 		return nil, err
 	}
+	common.PublishAccountEvent(s.AccountEventBus, owner, common.KeyCreated, key.Key, keyDetail(req.Type, req.Secure))
 	return &pb.GetKeyReply{
 		Key:     key.Key,
 		Secret:  key.Secret,
@@ -253,6 +265,34 @@ func (s *Service) InvalidateKey(ctx context.Context, req *pb.InvalidateKeyReques
 	return &pb.InvalidateKeyReply{}, nil
 }
 
+// SetKeyUserQuota sets the buckets total size cap of each user of a user key.
+// A zero size removes the cap, leaving users limited by the hub's buckets total size quota.
+func (s *Service) SetKeyUserQuota(ctx context.Context, req *pb.SetKeyUserQuotaRequest) (*pb.SetKeyUserQuotaReply, error) {
+	log.Debugf("received set key user quota request")
+
+	key, err := s.Collections.APIKeys.Get(ctx, req.Key)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Key not found")
+		}
+		return nil, err
+	}
+	owner := ownerFromContext(ctx)
+	if !owner.Equals(key.Owner) {
+		return nil, status.Error(codes.PermissionDenied, "User does not own key")
+	}
+	if key.Type != mdb.UserKey {
+		return nil, status.Error(codes.FailedPrecondition, "Quotas can only be set on user group keys")
+	}
+	if req.BucketsMaxSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Buckets max size must not be negative")
+	}
+	if err := s.Collections.APIKeys.SetUserBucketsMaxSize(ctx, req.Key, req.BucketsMaxSize); err != nil {
+		return nil, err
+	}
+	return &pb.SetKeyUserQuotaReply{}, nil
+}
+
 func (s *Service) ListKeys(ctx context.Context, _ *pb.ListKeysRequest) (*pb.ListKeysReply, error) {
 	log.Debugf("received list keys request")
 
@@ -268,12 +308,13 @@ func (s *Service) ListKeys(ctx context.Context, _ *pb.ListKeysRequest) (*pb.List
 			return nil, err
 		}
 		list[i] = &pb.GetKeyReply{
-			Key:     key.Key,
-			Secret:  key.Secret,
-			Type:    pb.KeyType(key.Type),
-			Valid:   key.Valid,
-			Threads: int32(len(ts)),
-			Secure:  key.Secure,
+			Key:                key.Key,
+			Secret:             key.Secret,
+			Type:               pb.KeyType(key.Type),
+			Valid:              key.Valid,
+			Threads:            int32(len(ts)),
+			Secure:             key.Secure,
+			UserBucketsMaxSize: key.UserBucketsMaxSize,
 		}
 	}
 	return &pb.ListKeysReply{List: list}, nil
@@ -283,7 +324,15 @@ func (s *Service) CreateOrg(ctx context.Context, req *pb.CreateOrgRequest) (*pb.
 	log.Debugf("received create org request")
 
 	dev, _ := mdb.DevFromContext(ctx)
-	org, err := s.Collections.Accounts.CreateOrg(ctx, req.Name, []mdb.Member{{
+	org, err := s.createOrg(ctx, dev, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return s.orgToPbOrg(org)
+}
+
+func (s *Service) createOrg(ctx context.Context, dev *mdb.Account, name string) (*mdb.Account, error) {
+	org, err := s.Collections.Accounts.CreateOrg(ctx, name, []mdb.Member{{
 		Key:      dev.Key,
 		Username: dev.Username,
 		Role:     mdb.OrgOwner,
@@ -298,7 +347,9 @@ func (s *Service) CreateOrg(ctx context.Context, req *pb.CreateOrgRequest) (*pb.
 	if err := s.Collections.Accounts.SetToken(ctx, org.Key, tok); err != nil {
 		return nil, err
 	}
-	return s.orgToPbOrg(org)
+	org.Token = tok
+	common.PublishAccountEvent(s.AccountEventBus, dev.Key, common.OrgJoined, org.Username, "")
+	return org, nil
 }
 
 func (s *Service) GetOrg(ctx context.Context, _ *pb.GetOrgRequest) (*pb.GetOrgReply, error) {
@@ -389,18 +440,112 @@ func (s *Service) InviteToOrg(ctx context.Context, req *pb.InviteToOrgRequest) (
 	if _, err := mail.ParseAddress(req.Email); err != nil {
 		return nil, status.Error(codes.FailedPrecondition, "Email address in not valid")
 	}
-	invite, err := s.Collections.Invites.Create(ctx, dev.Key, org.Username, req.Email)
+	token, err := s.inviteToOrg(ctx, dev, org, req.Email)
 	if err != nil {
 		return nil, err
 	}
+	return &pb.InviteToOrgReply{Token: token}, nil
+}
+
+func (s *Service) InviteManyToOrg(ctx context.Context, req *pb.InviteManyToOrgRequest) (*pb.InviteManyToOrgReply, error) {
+	log.Debugf("received invite many to org request")
+
+	dev, _ := mdb.DevFromContext(ctx)
+	org, ok := mdb.OrgFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("org required")
+	}
+	if len(req.Invitees) > maxInvitees {
+		return nil, status.Errorf(codes.InvalidArgument, "Too many invitees (max %d)", maxInvitees)
+	}
+
+	reply := &pb.InviteManyToOrgReply{
+		Results: make([]*pb.InviteManyToOrgReply_Result, len(req.Invitees)),
+	}
+	seen := make(map[string]struct{})
+	for i, invitee := range req.Invitees {
+		res := &pb.InviteManyToOrgReply_Result{Invitee: invitee}
+		reply.Results[i] = res
+
+		email, err := s.resolveInvitee(ctx, org, invitee)
+		if err != nil {
+			if err == errAlreadyMember {
+				res.Status = pb.InviteManyToOrgReply_Result_ALREADY_MEMBER
+			} else {
+				res.Status = pb.InviteManyToOrgReply_Result_INVALID
+			}
+			res.Message = err.Error()
+			continue
+		}
+		if _, ok := seen[email]; ok {
+			res.Status = pb.InviteManyToOrgReply_Result_INVALID
+			res.Message = "duplicate invitee"
+			continue
+		}
+		seen[email] = struct{}{}
+
+		res.Token, err = s.inviteToOrg(ctx, dev, org, email)
+		if err != nil {
+			res.Status = pb.InviteManyToOrgReply_Result_FAILED
+			res.Message = err.Error()
+			continue
+		}
+		res.Status = pb.InviteManyToOrgReply_Result_SENT
+	}
+	return reply, nil
+}
+
+// resolveInvitee returns the email address for an invitee, which may be an email address
+// or the username of an existing dev account.
+// Membership is not checked if org is nil.
+func (s *Service) resolveInvitee(ctx context.Context, org *mdb.Account, invitee string) (string, error) {
+	var email string
+	if strings.Contains(invitee, "@") {
+		addr, err := mail.ParseAddress(invitee)
+		if err != nil {
+			return "", fmt.Errorf("email address is not valid")
+		}
+		email = addr.Address
+	}
+	acc, err := s.Collections.Accounts.GetByUsernameOrEmail(ctx, invitee)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return "", err
+		}
+		if email == "" {
+			return "", fmt.Errorf("username not found")
+		}
+		return email, nil
+	}
+	if acc.Type != mdb.Dev {
+		return "", fmt.Errorf("account is not a developer")
+	}
+	if org == nil {
+		return acc.Email, nil
+	}
+	isMember, err := s.Collections.Accounts.IsMember(ctx, org.Username, acc.Key)
+	if err != nil {
+		return "", err
+	}
+	if isMember {
+		return "", errAlreadyMember
+	}
+	return acc.Email, nil
+}
+
+func (s *Service) inviteToOrg(ctx context.Context, dev, org *mdb.Account, email string) (string, error) {
+	invite, err := s.Collections.Invites.Create(ctx, dev.Key, org.Username, email)
+	if err != nil {
+		return "", err
+	}
 
 	ectx, cancel := context.WithTimeout(ctx, emailTimeout)
 	defer cancel()
 	if err = s.EmailClient.InviteAddress(
-		ectx, org.Name, dev.Email, req.Email, s.GatewayURL, invite.Token); err != nil {
-		return nil, err
+		ectx, org.Name, dev.Email, email, s.GatewayURL, invite.Token); err != nil {
+		return "", err
 	}
-	return &pb.InviteToOrgReply{Token: invite.Token}, nil
+	return invite.Token, nil
 }
 
 func (s *Service) LeaveOrg(ctx context.Context, _ *pb.LeaveOrgRequest) (*pb.LeaveOrgReply, error) {
@@ -442,6 +587,345 @@ func (s *Service) IsOrgNameAvailable(ctx context.Context, req *pb.IsOrgNameAvail
 	}, nil
 }
 
+func (s *Service) GetUsageReport(ctx context.Context, req *pb.GetUsageReportRequest) (*pb.GetUsageReportReply, error) {
+	log.Debugf("received get usage report request")
+
+	owner := ownerFromContext(ctx)
+	token, _ := thread.TokenFromContext(ctx)
+	ts, err := s.threadsForOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.GetUsageReportReply{}
+	groups := make(map[string]*pb.GetUsageReportReply_Group)
+	for _, t := range ts {
+		threadTags, err := s.Collections.Tags.GetMap(ctx, mdb.ThreadResource, t.ID.String())
+		if err != nil {
+			return nil, err
+		}
+		tres := &pb.GetUsageReportReply_Resource{
+			Type: mdb.ThreadResource.String(),
+			ID:   t.ID.String(),
+			Name: t.Name,
+			Tags: threadTags,
+		}
+		reply.Resources = append(reply.Resources, tres)
+		if !t.IsDB {
+			continue
+		}
+		bres, err := s.Threads.Find(ctx, t.ID, buckets.CollectionName, &db.Query{}, &tdb.Bucket{}, db.WithTxnToken(token))
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range bres.([]*tdb.Bucket) {
+			stat, err := s.IPFSClient.Object().Stat(ctx, path.New(b.Path))
+			if err != nil {
+				return nil, err
+			}
+			size := int64(stat.CumulativeSize)
+			tags := make(map[string]string)
+			for k, v := range threadTags {
+				tags[k] = v
+			}
+			buckTags, err := s.Collections.Tags.GetMap(ctx, mdb.BucketResource, b.Key)
+			if err != nil {
+				return nil, err
+			}
+			for k, v := range buckTags {
+				tags[k] = v
+			}
+			reply.Resources = append(reply.Resources, &pb.GetUsageReportReply_Resource{
+				Type:   mdb.BucketResource.String(),
+				ID:     b.Key,
+				Name:   b.Name,
+				Thread: t.ID.String(),
+				Size:   size,
+				Tags:   tags,
+			})
+			tres.Size += size
+			reply.TotalSize += size
+
+			if req.GroupBy != "" {
+				value := tags[req.GroupBy]
+				g, ok := groups[value]
+				if !ok {
+					g = &pb.GetUsageReportReply_Group{Value: value}
+					groups[value] = g
+					reply.Groups = append(reply.Groups, g)
+				}
+				g.Size += size
+				g.Count++
+			}
+		}
+	}
+	stats, err := s.Collections.ContentRefs.Stats(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	reply.Dedup = &pb.GetUsageReportReply_Dedup{
+		Objects:    stats.Objects,
+		Refs:       stats.Refs,
+		StoredSize: stats.StoredSize,
+		SavedSize:  stats.SavedSize(),
+	}
+	return reply, nil
+}
+
+// threadsForOwner returns threads owned directly or via an API key.
+func (s *Service) threadsForOwner(ctx context.Context, owner crypto.PubKey) ([]mdb.Thread, error) {
+	ts, err := s.Collections.Threads.ListByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := s.Collections.APIKeys.ListByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		kts, err := s.Collections.Threads.ListByKey(ctx, k.Key)
+		if err != nil {
+			return nil, err
+		}
+		ts = append(ts, kts...)
+	}
+	return ts, nil
+}
+
+func (s *Service) ListAuditLogs(ctx context.Context, req *pb.ListAuditLogsRequest) (*pb.ListAuditLogsReply, error) {
+	log.Debugf("received list audit logs request")
+
+	account := accountFromContext(ctx)
+	logs, err := s.Collections.AuditLogs.ListByAccount(ctx, account.Username, req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]*pb.ListAuditLogsReply_AuditLog, len(logs))
+	for i, l := range logs {
+		list[i] = &pb.ListAuditLogsReply_AuditLog{
+			ID:        l.ID,
+			Actor:     l.Actor,
+			Action:    l.Action,
+			Resource:  l.Resource,
+			Reason:    l.Reason,
+			CreatedAt: l.CreatedAt.UnixNano(),
+		}
+	}
+	return &pb.ListAuditLogsReply{List: list}, nil
+}
+
+func (s *Service) WatchAccount(_ *pb.WatchAccountRequest, server pb.API_WatchAccountServer) error {
+	log.Debugf("received watch account request")
+
+	if s.AccountEventBus == nil {
+		return status.Error(codes.Unimplemented, "Account events are not enabled")
+	}
+	account := accountFromContext(server.Context())
+	listen := s.AccountEventBus.Listen()
+	defer listen.Discard()
+	for {
+		select {
+		case <-server.Context().Done():
+			return nil
+		case i, ok := <-listen.Channel():
+			if !ok {
+				return nil
+			}
+			e, ok := i.(common.AccountEvent)
+			if !ok || !e.Account.Equals(account.Key) {
+				continue
+			}
+			if err := server.Send(&pb.WatchAccountReply{
+				Event: &pb.WatchAccountReply_Event{
+					Type:   pb.WatchAccountReply_Event_Type(e.Type),
+					ID:     e.ID,
+					Detail: e.Detail,
+					Time:   e.Time.UnixNano(),
+				},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *Service) SetPushPolicy(ctx context.Context, req *pb.SetPushPolicyRequest) (*pb.SetPushPolicyReply, error) {
+	log.Debugf("received set push policy request")
+
+	dev, _ := mdb.DevFromContext(ctx)
+	org, ok := mdb.OrgFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("org required")
+	}
+	isOwner, err := s.Collections.Accounts.IsOwner(ctx, org.Username, dev.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !isOwner {
+		return nil, status.Error(codes.PermissionDenied, "User must be an org owner")
+	}
+	if req.Policy == nil {
+		return nil, status.Error(codes.InvalidArgument, "Policy is required")
+	}
+	if req.Policy.MaxFileSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Max file size must not be negative")
+	}
+	exts := make([]string, 0, len(req.Policy.ForbiddenExtensions))
+	for _, e := range req.Policy.ForbiddenExtensions {
+		e = strings.ToLower(strings.TrimSpace(e))
+		if e == "" {
+			continue
+		}
+		if !strings.HasPrefix(e, ".") {
+			e = "." + e
+		}
+		exts = append(exts, e)
+	}
+	paths := make([]string, 0, len(req.Policy.RequiredPaths))
+	for _, p := range req.Policy.RequiredPaths {
+		p = strings.Trim(strings.TrimSpace(p), "/")
+		if p == "" {
+			continue
+		}
+		paths = append(paths, p)
+	}
+	if _, err := s.Collections.PushPolicies.Set(ctx, mdb.PushPolicy{
+		Org:                 org.Username,
+		MaxFileSize:         req.Policy.MaxFileSize,
+		ForbiddenExtensions: exts,
+		RequiredPaths:       paths,
+		RequireLicense:      req.Policy.RequireLicense,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := s.Collections.AuditLogs.Create(ctx, org.Username, dev.Username, "push_policy.set", "org/"+org.Username, ""); err != nil {
+		return nil, err
+	}
+	return &pb.SetPushPolicyReply{}, nil
+}
+
+func (s *Service) GetPushPolicy(ctx context.Context, _ *pb.GetPushPolicyRequest) (*pb.GetPushPolicyReply, error) {
+	log.Debugf("received get push policy request")
+
+	org, ok := mdb.OrgFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("org required")
+	}
+	policy, err := s.Collections.PushPolicies.Get(ctx, org.Username)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &pb.GetPushPolicyReply{Policy: &pb.PushPolicy{}}, nil
+		}
+		return nil, err
+	}
+	return &pb.GetPushPolicyReply{
+		Policy: &pb.PushPolicy{
+			MaxFileSize:         policy.MaxFileSize,
+			ForbiddenExtensions: policy.ForbiddenExtensions,
+			RequiredPaths:       policy.RequiredPaths,
+			RequireLicense:      policy.RequireLicense,
+		},
+	}, nil
+}
+
+func (s *Service) SetArchiveConfig(ctx context.Context, req *pb.SetArchiveConfigRequest) (*pb.SetArchiveConfigReply, error) {
+	log.Debugf("received set archive config request")
+
+	dev, _ := mdb.DevFromContext(ctx)
+	org, ok := mdb.OrgFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("org required")
+	}
+	isOwner, err := s.Collections.Accounts.IsOwner(ctx, org.Username, dev.Key)
+	if err != nil {
+		return nil, err
+	}
+	if !isOwner {
+		return nil, status.Error(codes.PermissionDenied, "User must be an org owner")
+	}
+	conf := req.Config
+	if conf == nil {
+		return nil, status.Error(codes.InvalidArgument, "Config is required")
+	}
+	if !conf.HotEnabled && !conf.ColdEnabled {
+		return nil, status.Error(codes.InvalidArgument, "Hot or cold storage must be enabled")
+	}
+	if conf.RepFactor < 0 {
+		return nil, status.Error(codes.InvalidArgument, "Rep factor must not be negative")
+	}
+	if conf.DealMinDuration != 0 && conf.DealMinDuration < powutil.MinDealDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "Deal duration must be at least %d epochs", powutil.MinDealDuration)
+	}
+	trusted := normalizeMiners(conf.TrustedMiners)
+	excluded := normalizeMiners(conf.ExcludedMiners)
+	for _, m := range excluded {
+		for _, t := range trusted {
+			if m == t {
+				return nil, status.Errorf(codes.InvalidArgument, "Miner %s is both trusted and excluded", m)
+			}
+		}
+	}
+	if _, err := s.Collections.ArchiveConfigs.Set(ctx, mdb.ArchiveConfig{
+		Org:             org.Username,
+		HotEnabled:      conf.HotEnabled,
+		ColdEnabled:     conf.ColdEnabled,
+		RepFactor:       int(conf.RepFactor),
+		DealMinDuration: conf.DealMinDuration,
+		TrustedMiners:   trusted,
+		ExcludedMiners:  excluded,
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := s.Collections.AuditLogs.Create(ctx, org.Username, dev.Username, "archive_config.set", "org/"+org.Username, ""); err != nil {
+		return nil, err
+	}
+	return &pb.SetArchiveConfigReply{}, nil
+}
+
+func (s *Service) GetArchiveConfig(ctx context.Context, _ *pb.GetArchiveConfigRequest) (*pb.GetArchiveConfigReply, error) {
+	log.Debugf("received get archive config request")
+
+	org, ok := mdb.OrgFromContext(ctx)
+	if !ok {
+		return nil, fmt.Errorf("org required")
+	}
+	conf, err := s.Collections.ArchiveConfigs.Get(ctx, org.Username)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return &pb.GetArchiveConfigReply{Config: &pb.ArchiveConfig{}}, nil
+		}
+		return nil, err
+	}
+	return &pb.GetArchiveConfigReply{
+		Config: &pb.ArchiveConfig{
+			HotEnabled:      conf.HotEnabled,
+			ColdEnabled:     conf.ColdEnabled,
+			RepFactor:       int64(conf.RepFactor),
+			DealMinDuration: conf.DealMinDuration,
+			TrustedMiners:   conf.TrustedMiners,
+			ExcludedMiners:  conf.ExcludedMiners,
+		},
+	}, nil
+}
+
+// normalizeMiners returns the trimmed, non-empty, and unique miner addresses of list.
+func normalizeMiners(list []string) []string {
+	miners := make([]string, 0, len(list))
+	seen := make(map[string]struct{})
+	for _, m := range list {
+		m = strings.TrimSpace(m)
+		if m == "" {
+			continue
+		}
+		if _, ok := seen[m]; ok {
+			continue
+		}
+		seen[m] = struct{}{}
+		miners = append(miners, m)
+	}
+	return miners
+}
+
 func (s *Service) DestroyAccount(ctx context.Context, _ *pb.DestroyAccountRequest) (*pb.DestroyAccountReply, error) {
 	log.Debugf("received destroy account request")
 
@@ -453,12 +937,16 @@ func (s *Service) DestroyAccount(ctx context.Context, _ *pb.DestroyAccountReques
 }
 
 func ownerFromContext(ctx context.Context) crypto.PubKey {
+	return accountFromContext(ctx).Key
+}
+
+func accountFromContext(ctx context.Context) *mdb.Account {
 	org, ok := mdb.OrgFromContext(ctx)
 	if !ok {
 		dev, _ := mdb.DevFromContext(ctx)
-		return dev.Key
+		return dev
 	}
-	return org.Key
+	return org
 }
 
 func (s *Service) destroyAccount(ctx context.Context, a *mdb.Account) error {
@@ -474,30 +962,37 @@ func (s *Service) destroyAccount(ctx context.Context, a *mdb.Account) error {
 	}
 
 	// Collect threads owned directly or via an API key
-	ts, err := s.Collections.Threads.ListByOwner(ctx, a.Key)
-	if err != nil {
-		return err
-	}
-	keys, err := s.Collections.APIKeys.ListByOwner(ctx, a.Key)
+	ts, err := s.threadsForOwner(ctx, a.Key)
 	if err != nil {
 		return err
 	}
-	for _, k := range keys {
-		kts, err := s.Collections.Threads.ListByKey(ctx, k.Key)
+
+	// Ensure that no buckets are under legal hold.
+	bucks := make(map[thread.ID][]*tdb.Bucket)
+	for _, t := range ts {
+		if !t.IsDB {
+			continue
+		}
+		bres, err := s.Threads.Find(ctx, t.ID, buckets.CollectionName, &db.Query{}, &tdb.Bucket{}, db.WithTxnToken(a.Token))
 		if err != nil {
 			return err
 		}
-		ts = append(ts, kts...)
+		bucks[t.ID] = bres.([]*tdb.Bucket)
+		for _, b := range bucks[t.ID] {
+			held, err := s.Collections.LegalHolds.IsHeld(ctx, b.Key)
+			if err != nil {
+				return err
+			}
+			if held {
+				return status.Errorf(codes.FailedPrecondition, "Bucket %s is under legal hold", b.Key)
+			}
+		}
 	}
 
 	for _, t := range ts {
 		if t.IsDB {
 			// Clean up bucket pins, keys, and dns records.
-			bres, err := s.Threads.Find(ctx, t.ID, buckets.CollectionName, &db.Query{}, &tdb.Bucket{}, db.WithTxnToken(a.Token))
-			if err != nil {
-				return err
-			}
-			for _, b := range bres.([]*tdb.Bucket) {
+			for _, b := range bucks[t.ID] {
 				if err = s.IPFSClient.Pin().Rm(ctx, path.New(b.Path)); err != nil {
 					return err
 				}
@@ -509,6 +1004,30 @@ func (s *Service) destroyAccount(ctx context.Context, a *mdb.Account) error {
 						return err
 					}
 				}
+				if err = s.Collections.Tags.Delete(ctx, mdb.BucketResource, b.Key); err != nil && err != mongo.ErrNoDocuments {
+					return err
+				}
+				if err = s.Collections.WebConfigs.Delete(ctx, b.Key); err != nil && err != mongo.ErrNoDocuments {
+					return err
+				}
+				if err = s.Collections.BucketVersions.DeleteByBucket(ctx, b.Key); err != nil {
+					return err
+				}
+				snapshots, err := s.Collections.BucketSnapshots.List(ctx, b.Key)
+				if err != nil {
+					return err
+				}
+				for _, snapshot := range snapshots {
+					if err = s.IPFSClient.Pin().Rm(ctx, path.New(snapshot.PinPath)); err != nil {
+						return err
+					}
+				}
+				if err = s.Collections.BucketSnapshots.DeleteByBucket(ctx, b.Key); err != nil {
+					return err
+				}
+				if err = s.Collections.BucketLicenses.DeleteByBucket(ctx, b.Key); err != nil {
+					return err
+				}
 			}
 			// Delete the entire DB.
 			if err := s.Threads.DeleteDB(ctx, t.ID, db.WithManagedToken(a.Token)); err != nil {
@@ -521,22 +1040,42 @@ func (s *Service) destroyAccount(ctx context.Context, a *mdb.Account) error {
 			}
 		}
 	}
+	for _, t := range ts {
+		if err = s.Collections.Tags.Delete(ctx, mdb.ThreadResource, t.ID.String()); err != nil && err != mongo.ErrNoDocuments {
+			return err
+		}
+	}
 	// Stop tracking the deleted threads.
 	if err = s.Collections.Threads.DeleteByOwner(ctx, a.Key); err != nil {
 		return err
 	}
 
 	// Clean up other associated objects.
+	if err = s.Collections.Tags.DeleteByOwner(ctx, a.Key); err != nil {
+		return err
+	}
+	if err = s.Collections.AuditLogs.DeleteByAccount(ctx, a.Username); err != nil {
+		return err
+	}
 	if err = s.Collections.APIKeys.DeleteByOwner(ctx, a.Key); err != nil {
 		return err
 	}
 	if err = s.Collections.Sessions.DeleteByOwner(ctx, a.Key); err != nil {
 		return err
 	}
+	if err = s.Collections.ContentRefs.DeleteByOwner(ctx, a.Key); err != nil {
+		return err
+	}
 	if a.Type == mdb.Org {
 		if err = s.Collections.Invites.DeleteByOrg(ctx, a.Username); err != nil {
 			return err
 		}
+		if err = s.Collections.PushPolicies.Delete(ctx, a.Username); err != nil && err != mongo.ErrNoDocuments {
+			return err
+		}
+		if err = s.Collections.ArchiveConfigs.Delete(ctx, a.Username); err != nil && err != mongo.ErrNoDocuments {
+			return err
+		}
 	} else {
 		if err = s.Collections.Invites.DeleteByFrom(ctx, a.Key); err != nil {
 			return err
@@ -7,10 +7,13 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/api/common"
 	pb "github.com/textileio/textile/api/users/pb"
 	"github.com/textileio/textile/threaddb"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 )
 
 // Client provides the client api.
@@ -45,8 +48,131 @@ func (c *Client) GetThread(ctx context.Context, name string) (*pb.GetThreadReply
 
 // ListThreads returns a list of threads.
 // Threads can be created using the threads or threads network client.
-func (c *Client) ListThreads(ctx context.Context) (*pb.ListThreadsReply, error) {
-	return c.c.ListThreads(ctx, &pb.ListThreadsRequest{})
+// Use WithTagFilter to only list threads with matching tags.
+// Use WithThreadSeek and WithThreadLimit to page through threads by creation time,
+// and WithThreadDescending to list the newest threads first.
+func (c *Client) ListThreads(ctx context.Context, opts ...ListThreadsOption) (*pb.ListThreadsReply, error) {
+	args := &listThreadsOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	var seek []byte
+	if args.seek.Defined() {
+		seek = args.seek.Bytes()
+	}
+	return c.c.ListThreads(ctx, &pb.ListThreadsRequest{
+		Tags:       args.tags,
+		Seek:       seek,
+		Limit:      int64(args.limit),
+		Descending: args.descending,
+	})
+}
+
+// SetThreadTags replaces the key/value tags for a thread.
+// Setting empty tags removes all tags from the thread.
+func (c *Client) SetThreadTags(ctx context.Context, id thread.ID, tags map[string]string) error {
+	_, err := c.c.SetThreadTags(ctx, &pb.SetThreadTagsRequest{
+		ID:   id.Bytes(),
+		Tags: tags,
+	})
+	return err
+}
+
+// GetThreadLimits returns the number of threads that can still be created by the owner
+// and with the API key in the context.
+func (c *Client) GetThreadLimits(ctx context.Context) (*pb.GetThreadLimitsReply, error) {
+	return c.c.GetThreadLimits(ctx, &pb.GetThreadLimitsRequest{})
+}
+
+// GetUsage returns the stored bytes, bucket count, and thread count of the user.
+// If the context has an API key, only threads created with the key are counted.
+func (c *Client) GetUsage(ctx context.Context) (*pb.GetUsageReply, error) {
+	return c.c.GetUsage(ctx, &pb.GetUsageRequest{})
+}
+
+// ListBuckets returns the buckets in all of the threads owned by the user in the context.
+// With an API key, only threads created with one of the key account's keys are included.
+// Use WithBucketSeek and WithBucketLimit to page through buckets by creation time,
+// and WithBucketDescending to list the newest buckets first.
+func (c *Client) ListBuckets(ctx context.Context, opts ...ListBucketsOption) (*pb.ListBucketsReply, error) {
+	args := &listBucketsOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	return c.c.ListBuckets(ctx, &pb.ListBucketsRequest{
+		Seek:       args.seek,
+		Limit:      int64(args.limit),
+		Descending: args.descending,
+	})
+}
+
+// RenewToken returns a newly issued thread token for the user in the context.
+// The context must contain a user API key and the user's current token.
+// Long-lived sessions can use the renewed token instead of getting a new one with the identity challenge.
+func (c *Client) RenewToken(ctx context.Context) (thread.Token, error) {
+	res, err := c.c.RenewToken(ctx, &pb.RenewTokenRequest{})
+	if err != nil {
+		return "", err
+	}
+	return thread.Token(res.Token), nil
+}
+
+// DeleteUser deletes the user in the context and revokes the user's thread tokens.
+// Use WithUserKey to delete another user with an account API key.
+// The user's threads and buckets are deleted unless WithTransferData is used.
+func (c *Client) DeleteUser(ctx context.Context, opts ...DeleteUserOption) error {
+	args := &deleteUserOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	req := &pb.DeleteUserRequest{}
+	if args.key != nil {
+		key, err := crypto.MarshalPublicKey(args.key)
+		if err != nil {
+			return err
+		}
+		req.Key = key
+	}
+	if args.transfer {
+		req.Data = pb.DeleteUserRequest_TRANSFER
+	}
+	_, err := c.c.DeleteUser(ctx, req)
+	return err
+}
+
+// RegisterDevice registers a device token that receives push notifications for the user in the context.
+// Platform must be one of notify.Platforms, e.g., "fcm" or "apns".
+func (c *Client) RegisterDevice(ctx context.Context, token, platform string) error {
+	_, err := c.c.RegisterDevice(ctx, &pb.RegisterDeviceRequest{
+		Token:    token,
+		Platform: platform,
+	})
+	return err
+}
+
+// UnregisterDevice stops push notifications to a device token of the user in the context.
+func (c *Client) UnregisterDevice(ctx context.Context, token string) error {
+	_, err := c.c.UnregisterDevice(ctx, &pb.UnregisterDeviceRequest{Token: token})
+	return err
+}
+
+// LimitExceeded returns the limit that caused err.
+// The second return value is false if err was not caused by an exceeded limit.
+func LimitExceeded(err error) (*common.LimitExceededError, bool) {
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	for _, d := range st.Details() {
+		if l, ok := d.(*pb.LimitExceeded); ok {
+			return &common.LimitExceededError{
+				Limit:   l.Limit,
+				Current: l.Current,
+				Max:     l.Max,
+			}, true
+		}
+	}
+	return nil, false
 }
 
 // SetupMailbox creates inbox and sentbox threads needed user mail.
@@ -68,7 +194,11 @@ type Message struct {
 	Body      []byte        `json:"body"`
 	Signature []byte        `json:"signature"`
 	CreatedAt time.Time     `json:"created_at"`
-	ReadAt    time.Time     `json:"read_at,omitempty"`
+	// DeliveredAt is the time the message reached the recipient's inbox.
+	DeliveredAt time.Time `json:"delivered_at,omitempty"`
+	// ReadAt is the time the recipient read the message.
+	// Sentbox messages get a read time when the recipient marks the inbox message as read.
+	ReadAt time.Time `json:"read_at,omitempty"`
 }
 
 // Open decrypts the message body with identity.
@@ -76,6 +206,11 @@ func (m Message) Open(ctx context.Context, id thread.Identity) ([]byte, error) {
 	return id.Decrypt(ctx, m.Body)
 }
 
+// IsDelivered returns whether or not the message has reached the recipient's inbox.
+func (m Message) IsDelivered() bool {
+	return !m.DeliveredAt.IsZero()
+}
+
 // IsRead returns whether or not the message has been read.
 func (m Message) IsRead() bool {
 	return !m.ReadAt.IsZero()
@@ -83,7 +218,7 @@ func (m Message) IsRead() bool {
 
 // UnmarshalInstance unmarshals the message from its ThreadDB instance data.
 // This will return an error if the message signature fails verification.
-func (m Message) UnmarshalInstance(data []byte) error {
+func (m *Message) UnmarshalInstance(data []byte) error {
 	// InboxMessage works for both inbox and sentbox messages (it contains a superset of SentboxMessage fields)
 	var tm threaddb.InboxMessage
 	if err := json.Unmarshal(data, &tm); err != nil {
@@ -109,17 +244,14 @@ func (m Message) UnmarshalInstance(data []byte) error {
 	if err := to.UnmarshalString(tm.To); err != nil {
 		return fmt.Errorf("to public key is invalid")
 	}
-	readAt := time.Time{}
-	if tm.ReadAt > 0 {
-		readAt = time.Unix(0, tm.ReadAt)
-	}
 	m.ID = tm.ID
 	m.From = from
 	m.To = to
 	m.Body = body
 	m.Signature = sig
 	m.CreatedAt = time.Unix(0, tm.CreatedAt)
-	m.ReadAt = readAt
+	m.DeliveredAt = unixNanoTime(tm.DeliveredAt)
+	m.ReadAt = unixNanoTime(tm.ReadAt)
 	return nil
 }
 
@@ -152,12 +284,13 @@ func (c *Client) SendMessage(ctx context.Context, from thread.Identity, to threa
 		return msg, err
 	}
 	return Message{
-		ID:        res.ID,
-		From:      from.GetPublic(),
-		To:        to,
-		Body:      fromBody,
-		Signature: fromSig,
-		CreatedAt: time.Unix(0, res.CreatedAt),
+		ID:          res.ID,
+		From:        from.GetPublic(),
+		To:          to,
+		Body:        fromBody,
+		Signature:   fromSig,
+		CreatedAt:   time.Unix(0, res.CreatedAt),
+		DeliveredAt: time.Unix(0, res.CreatedAt),
 	}, nil
 }
 
@@ -226,21 +359,26 @@ func messageFromPb(m *pb.Message) (msg Message, err error) {
 	if err := to.UnmarshalString(m.To); err != nil {
 		return msg, fmt.Errorf("to public key is invalid")
 	}
-	readAt := time.Time{}
-	if m.ReadAt > 0 {
-		readAt = time.Unix(0, m.ReadAt)
-	}
 	return Message{
-		ID:        m.ID,
-		From:      from,
-		To:        to,
-		Body:      m.Body,
-		Signature: m.Signature,
-		CreatedAt: time.Unix(0, m.CreatedAt),
-		ReadAt:    readAt,
+		ID:          m.ID,
+		From:        from,
+		To:          to,
+		Body:        m.Body,
+		Signature:   m.Signature,
+		CreatedAt:   time.Unix(0, m.CreatedAt),
+		DeliveredAt: unixNanoTime(m.DeliveredAt),
+		ReadAt:      unixNanoTime(m.ReadAt),
 	}, nil
 }
 
+// unixNanoTime returns the time of nanoseconds since the epoch, or the zero time if ns isn't set.
+func unixNanoTime(ns int64) time.Time {
+	if ns <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, ns)
+}
+
 // ReadInboxMessage marks a message as read by ID.
 func (c *Client) ReadInboxMessage(ctx context.Context, id string) error {
 	_, err := c.c.ReadInboxMessage(ctx, &pb.ReadInboxMessageRequest{
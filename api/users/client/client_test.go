@@ -21,6 +21,8 @@ import (
 	hubpb "github.com/textileio/textile/api/hub/pb"
 	c "github.com/textileio/textile/api/users/client"
 	"github.com/textileio/textile/core"
+	mdb "github.com/textileio/textile/mongodb"
+	"github.com/textileio/textile/notify"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -148,6 +150,143 @@ func TestClient_CreateThreadsLimit(t *testing.T) {
 	_, err = net.CreateThread(ctx, thread.NewIDV1(thread.Raw, 32))
 	require.Error(t, err)
 	require.Contains(t, err.Error(), core.ErrTooManyThreadsPerOwner.Error())
+	lerr, ok := c.LimitExceeded(err)
+	require.True(t, ok)
+	assert.Equal(t, common.LimitThreadsPerOwner, lerr.Limit)
+	assert.Equal(t, int64(1), lerr.Current)
+	assert.Equal(t, int64(1), lerr.Max)
+}
+
+func TestClient_CreateThreadsPerKeyLimit(t *testing.T) {
+	t.Parallel()
+	conf := apitest.DefaultTextileConfig(t)
+	conf.ThreadsMaxNumberPerKey = 1
+	conf, users, hub, _, net, _ := setupWithConf(t, conf)
+
+	dev := apitest.Signup(t, hub, conf, apitest.NewUsername(), apitest.NewEmail())
+
+	ctx := context.Background()
+	key, err := hub.CreateKey(common.NewSessionContext(ctx, dev.Session), hubpb.KeyType_ACCOUNT, true)
+	require.NoError(t, err)
+	ctx = common.NewAPIKeyContext(ctx, key.Key)
+	ctx, err = common.CreateAPISigContext(ctx, time.Now().Add(time.Minute), key.Secret)
+	require.NoError(t, err)
+
+	limits, err := users.GetThreadLimits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), limits.PerKey.Remaining)
+	assert.Equal(t, int64(-1), limits.PerOwner.Remaining)
+
+	_, err = net.CreateThread(ctx, thread.NewIDV1(thread.Raw, 32))
+	require.NoError(t, err)
+
+	limits, err = users.GetThreadLimits(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), limits.PerKey.Current)
+	assert.Equal(t, int64(0), limits.PerKey.Remaining)
+
+	_, err = net.CreateThread(ctx, thread.NewIDV1(thread.Raw, 32))
+	require.Error(t, err)
+	lerr, ok := c.LimitExceeded(err)
+	require.True(t, ok)
+	assert.Equal(t, common.LimitThreadsPerKey, lerr.Limit)
+	assert.Equal(t, int64(1), lerr.Max)
+}
+
+func TestClient_RenewToken(t *testing.T) {
+	t.Parallel()
+	conf, client, hub, threads, _, _ := setup(t)
+	ctx := context.Background()
+
+	dev := apitest.Signup(t, hub, conf, apitest.NewUsername(), apitest.NewEmail())
+	key, err := hub.CreateKey(common.NewSessionContext(ctx, dev.Session), hubpb.KeyType_USER, true)
+	require.NoError(t, err)
+	ctx = common.NewAPIKeyContext(ctx, key.Key)
+	ctx, err = common.CreateAPISigContext(ctx, time.Now().Add(time.Minute), key.Secret)
+	require.NoError(t, err)
+
+	// No token
+	_, err = client.RenewToken(ctx)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	tok, err := threads.GetToken(ctx, thread.NewLibp2pIdentity(sk))
+	require.NoError(t, err)
+	renewed, err := client.RenewToken(thread.NewTokenContext(ctx, tok))
+	require.NoError(t, err)
+	assert.True(t, renewed.Defined())
+
+	// The renewed token works like the original
+	ctx = thread.NewTokenContext(ctx, renewed)
+	_, err = client.ListThreads(ctx)
+	require.NoError(t, err)
+	_, err = client.RenewToken(ctx)
+	require.NoError(t, err)
+}
+
+func TestClient_DeleteUser(t *testing.T) {
+	t.Parallel()
+	conf, client, hub, threads, _, _ := setup(t)
+	ctx := context.Background()
+
+	dev := apitest.Signup(t, hub, conf, apitest.NewUsername(), apitest.NewEmail())
+	devCtx := common.NewSessionContext(ctx, dev.Session)
+	key, err := hub.CreateKey(devCtx, hubpb.KeyType_USER, true)
+	require.NoError(t, err)
+	ctx = common.NewAPIKeyContext(ctx, key.Key)
+	ctx, err = common.CreateAPISigContext(ctx, time.Now().Add(time.Minute), key.Secret)
+	require.NoError(t, err)
+
+	newUser := func(t *testing.T) (crypto.PubKey, context.Context) {
+		sk, pk, err := crypto.GenerateEd25519Key(rand.Reader)
+		require.NoError(t, err)
+		tok, err := threads.GetToken(ctx, thread.NewLibp2pIdentity(sk))
+		require.NoError(t, err)
+		ctx := thread.NewTokenContext(ctx, tok)
+		err = threads.NewDB(common.NewThreadNameContext(ctx, "foo"), thread.NewIDV1(thread.Raw, 32))
+		require.NoError(t, err)
+		return pk, ctx
+	}
+
+	t.Run("self", func(t *testing.T) {
+		_, ctx := newUser(t)
+		err := client.DeleteUser(ctx)
+		require.NoError(t, err)
+
+		// The token is revoked
+		_, err = client.ListThreads(ctx)
+		require.Error(t, err)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("other user", func(t *testing.T) {
+		pk, _ := newUser(t)
+		_, ctx := newUser(t)
+		err := client.DeleteUser(ctx, c.WithUserKey(pk))
+		require.Error(t, err)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("parent account", func(t *testing.T) {
+		pk, _ := newUser(t)
+		key, err := hub.CreateKey(devCtx, hubpb.KeyType_ACCOUNT, true)
+		require.NoError(t, err)
+		actx := common.NewAPIKeyContext(context.Background(), key.Key)
+		actx, err = common.CreateAPISigContext(actx, time.Now().Add(time.Minute), key.Secret)
+		require.NoError(t, err)
+		before, err := client.ListThreads(actx)
+		require.NoError(t, err)
+
+		err = client.DeleteUser(actx, c.WithUserKey(pk), c.WithTransferData(true))
+		require.NoError(t, err)
+
+		// The user's thread now belongs to the account
+		after, err := client.ListThreads(actx)
+		require.NoError(t, err)
+		assert.Equal(t, len(before.List)+1, len(after.List))
+	})
 }
 
 func TestClient_ListThreads(t *testing.T) {
@@ -250,6 +389,24 @@ func TestClient_ListThreads(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 2, len(res.List))
 		assert.False(t, res.List[1].IsDB)
+		assert.True(t, res.List[0].CreatedAt <= res.List[1].CreatedAt)
+		assert.False(t, res.List[1].HasBuckets)
+
+		// Paged
+		page, err := client.ListThreads(ctx, c.WithThreadLimit(1))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(page.List))
+		assert.Equal(t, res.List[0].ID, page.List[0].ID)
+		first, err := thread.Cast(page.List[0].ID)
+		require.NoError(t, err)
+		page, err = client.ListThreads(ctx, c.WithThreadSeek(first), c.WithThreadLimit(1))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(page.List))
+		assert.Equal(t, res.List[1].ID, page.List[0].ID)
+		page, err = client.ListThreads(ctx, c.WithThreadDescending(true), c.WithThreadLimit(1))
+		require.NoError(t, err)
+		require.Equal(t, 1, len(page.List))
+		assert.Equal(t, res.List[1].ID, page.List[0].ID)
 	})
 }
 
@@ -296,6 +453,48 @@ func TestClient_SendMessage(t *testing.T) {
 	assert.NotEmpty(t, res.CreatedAt)
 }
 
+func TestClient_RegisterDevice(t *testing.T) {
+	t.Parallel()
+	conf := apitest.DefaultTextileConfig(t)
+	notified := make(chan mdb.Device, 1)
+	conf.Notifier = notify.NotifierFunc(func(_ context.Context, d mdb.Device, n notify.Notification) error {
+		if n.Event == notify.MessageReceived {
+			notified <- d
+		}
+		return nil
+	})
+	conf, client, hub, threads, _, _ := setupWithConf(t, conf)
+
+	dev := apitest.Signup(t, hub, conf, apitest.NewUsername(), apitest.NewEmail())
+	key, err := hub.CreateKey(common.NewSessionContext(context.Background(), dev.Session), hubpb.KeyType_USER, false)
+	require.NoError(t, err)
+
+	from, fctx := setupUserMail(t, client, threads, key.Key)
+	to, tctx := setupUserMail(t, client, threads, key.Key)
+
+	err = client.RegisterDevice(tctx, "token", "pager")
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = client.RegisterDevice(tctx, "token", notify.FCM)
+	require.NoError(t, err)
+
+	_, err = client.SendMessage(fctx, from, to.GetPublic(), []byte("howdy"))
+	require.NoError(t, err)
+	select {
+	case d := <-notified:
+		assert.Equal(t, "token", d.Token)
+		assert.Equal(t, notify.FCM, d.Platform)
+	case <-time.After(time.Second * 10):
+		t.Fatal("device was not notified")
+	}
+
+	err = client.UnregisterDevice(tctx, "token")
+	require.NoError(t, err)
+	err = client.UnregisterDevice(tctx, "token")
+	require.Error(t, err)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
 func TestClient_ListInboxMessages(t *testing.T) {
 	t.Parallel()
 	conf, client, hub, threads, _, _ := setup(t)
@@ -429,6 +628,13 @@ func TestClient_ReadInboxMessage(t *testing.T) {
 	list, err := client.ListInboxMessages(tctx)
 	require.NoError(t, err)
 	assert.False(t, list[0].ReadAt.IsZero())
+	assert.True(t, list[0].IsDelivered())
+
+	// The sender gets a read receipt
+	sent, err := client.ListSentboxMessages(fctx)
+	require.NoError(t, err)
+	assert.True(t, sent[0].IsDelivered())
+	assert.Equal(t, list[0].ReadAt.UnixNano(), sent[0].ReadAt.UnixNano())
 }
 
 func TestClient_DeleteInboxMessage(t *testing.T) {
@@ -577,6 +783,23 @@ func TestUserBuckets(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, 1, len(keys.List))
 	assert.Equal(t, 1, int(keys.List[0].Threads))
+
+	// The user should see one thread with one bucket
+	usage, err := users.GetUsage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), usage.ThreadCount)
+	assert.Equal(t, int64(1), usage.BucketCount)
+
+	// The user should be able to list the bucket without the thread ID
+	list, err := users.ListBuckets(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list.Buckets))
+	assert.Equal(t, buck.Root.Key, list.Buckets[0].Key)
+	assert.Equal(t, dbID.Bytes(), list.Buckets[0].ThreadID)
+	assert.Equal(t, "my-buckets", list.Buckets[0].ThreadName)
+	list, err = users.ListBuckets(ctx, c.WithBucketSeek(buck.Root.Key))
+	require.NoError(t, err)
+	assert.Empty(t, list.Buckets)
 }
 
 func setup(t *testing.T) (core.Config, *c.Client, *hc.Client, *tc.Client, *nc.Client, *bc.Client) {
@@ -608,3 +831,49 @@ func setupWithConf(t *testing.T, conf core.Config) (core.Config, *c.Client, *hc.
 	})
 	return conf, client, hubclient, threadsclient, threadsnetclient, bucketsclient
 }
+
+func TestUserBucketsQuota(t *testing.T) {
+	t.Parallel()
+	conf, _, hub, threads, _, buckets := setup(t)
+	ctx := context.Background()
+
+	// Create a user key with a quota that's smaller than the test file
+	dev := apitest.Signup(t, hub, conf, apitest.NewUsername(), apitest.NewEmail())
+	devCtx := common.NewSessionContext(ctx, dev.Session)
+	key, err := hub.CreateKey(devCtx, hubpb.KeyType_USER, true)
+	require.NoError(t, err)
+	err = hub.SetKeyUserQuota(devCtx, key.Key, 1024)
+	require.NoError(t, err)
+	keys, err := hub.ListKeys(devCtx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024), keys.List[0].UserBucketsMaxSize)
+
+	ctx = common.NewAPIKeyContext(ctx, key.Key)
+	ctx, err = common.CreateAPISigContext(ctx, time.Now().Add(time.Minute), key.Secret)
+	require.NoError(t, err)
+	sk, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	tok, err := threads.GetToken(ctx, thread.NewLibp2pIdentity(sk))
+	require.NoError(t, err)
+	ctx = thread.NewTokenContext(ctx, tok)
+	dbID := thread.NewIDV1(thread.Raw, 32)
+	err = threads.NewDB(common.NewThreadNameContext(ctx, "my-buckets"), dbID)
+	require.NoError(t, err)
+	ctx = common.NewThreadIDContext(ctx, dbID)
+	buck, err := buckets.Init(ctx)
+	require.NoError(t, err)
+
+	file, err := os.Open("testdata/file1.jpg")
+	require.NoError(t, err)
+	defer file.Close()
+	_, _, err = buckets.PushPath(ctx, buck.Root.Key, "file1.jpg", file)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "total size of buckets exceeds quota")
+
+	// Only user group keys have per-user quotas
+	akey, err := hub.CreateKey(devCtx, hubpb.KeyType_ACCOUNT, true)
+	require.NoError(t, err)
+	err = hub.SetKeyUserQuota(devCtx, akey.Key, 1024)
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -1,5 +1,10 @@
 package client
 
+import (
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/textileio/go-threads/core/thread"
+)
+
 type listOptions struct {
 	seek      string
 	limit     int
@@ -49,3 +54,93 @@ func WithStatus(s Status) ListOption {
 		args.status = s
 	}
 }
+
+type listThreadsOptions struct {
+	tags       map[string]string
+	seek       thread.ID
+	limit      int
+	descending bool
+}
+
+type ListThreadsOption func(*listThreadsOptions)
+
+// WithTagFilter only lists threads that have all of the given key/value tags.
+func WithTagFilter(tags map[string]string) ListThreadsOption {
+	return func(args *listThreadsOptions) {
+		args.tags = tags
+	}
+}
+
+// WithThreadSeek starts listing threads after the thread with the given ID.
+// Threads are listed by ascending creation time.
+func WithThreadSeek(id thread.ID) ListThreadsOption {
+	return func(args *listThreadsOptions) {
+		args.seek = id
+	}
+}
+
+// WithThreadLimit limits the number of listed threads.
+func WithThreadLimit(limit int) ListThreadsOption {
+	return func(args *listThreadsOptions) {
+		args.limit = limit
+	}
+}
+
+// WithThreadDescending lists threads by descending creation time.
+func WithThreadDescending(desc bool) ListThreadsOption {
+	return func(args *listThreadsOptions) {
+		args.descending = desc
+	}
+}
+
+type listBucketsOptions struct {
+	seek       string
+	limit      int
+	descending bool
+}
+
+type ListBucketsOption func(*listBucketsOptions)
+
+// WithBucketSeek starts listing buckets after the bucket with the given key.
+// Buckets are listed by ascending creation time.
+func WithBucketSeek(key string) ListBucketsOption {
+	return func(args *listBucketsOptions) {
+		args.seek = key
+	}
+}
+
+// WithBucketLimit limits the number of listed buckets.
+func WithBucketLimit(limit int) ListBucketsOption {
+	return func(args *listBucketsOptions) {
+		args.limit = limit
+	}
+}
+
+// WithBucketDescending lists buckets by descending creation time.
+func WithBucketDescending(desc bool) ListBucketsOption {
+	return func(args *listBucketsOptions) {
+		args.descending = desc
+	}
+}
+
+type deleteUserOptions struct {
+	key      crypto.PubKey
+	transfer bool
+}
+
+type DeleteUserOption func(*deleteUserOptions)
+
+// WithUserKey deletes the user with the given public key.
+// Only accounts can delete other users. Users are always able to delete themselves.
+func WithUserKey(key crypto.PubKey) DeleteUserOption {
+	return func(args *deleteUserOptions) {
+		args.key = key
+	}
+}
+
+// WithTransferData transfers the user's threads and buckets to the parent account instead of deleting them.
+func WithTransferData(transfer bool) DeleteUserOption {
+	return func(args *deleteUserOptions) {
+		args.transfer = transfer
+	}
+}
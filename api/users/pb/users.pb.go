@@ -24,6 +24,31 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type DeleteUserRequest_DataPolicy int32
+
+const (
+	DeleteUserRequest_DELETE   DeleteUserRequest_DataPolicy = 0
+	DeleteUserRequest_TRANSFER DeleteUserRequest_DataPolicy = 1
+)
+
+var DeleteUserRequest_DataPolicy_name = map[int32]string{
+	0: "DELETE",
+	1: "TRANSFER",
+}
+
+var DeleteUserRequest_DataPolicy_value = map[string]int32{
+	"DELETE":   0,
+	"TRANSFER": 1,
+}
+
+func (x DeleteUserRequest_DataPolicy) String() string {
+	return proto.EnumName(DeleteUserRequest_DataPolicy_name, int32(x))
+}
+
+func (DeleteUserRequest_DataPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{15, 0}
+}
+
 type ListInboxMessagesRequest_Status int32
 
 const (
@@ -49,13 +74,17 @@ func (x ListInboxMessagesRequest_Status) String() string {
 }
 
 func (ListInboxMessagesRequest_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{9, 0}
+	return fileDescriptor_030765f334c86cea, []int{26, 0}
 }
 
 type ListThreadsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Tags                 map[string]string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Seek                 []byte            `protobuf:"bytes,2,opt,name=seek,proto3" json:"seek,omitempty"`
+	Limit                int64             `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	Descending           bool              `protobuf:"varint,4,opt,name=descending,proto3" json:"descending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *ListThreadsRequest) Reset()         { *m = ListThreadsRequest{} }
@@ -83,6 +112,34 @@ func (m *ListThreadsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ListThreadsRequest proto.InternalMessageInfo
 
+func (m *ListThreadsRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *ListThreadsRequest) GetSeek() []byte {
+	if m != nil {
+		return m.Seek
+	}
+	return nil
+}
+
+func (m *ListThreadsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListThreadsRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
 type ListThreadsReply struct {
 	List                 []*GetThreadReply `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
@@ -122,99 +179,953 @@ func (m *ListThreadsReply) GetList() []*GetThreadReply {
 	return nil
 }
 
-type GetThreadRequest struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+type GetThreadRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetThreadRequest) Reset()         { *m = GetThreadRequest{} }
+func (m *GetThreadRequest) String() string { return proto.CompactTextString(m) }
+func (*GetThreadRequest) ProtoMessage()    {}
+func (*GetThreadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{2}
+}
+
+func (m *GetThreadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThreadRequest.Unmarshal(m, b)
+}
+func (m *GetThreadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThreadRequest.Marshal(b, m, deterministic)
+}
+func (m *GetThreadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThreadRequest.Merge(m, src)
+}
+func (m *GetThreadRequest) XXX_Size() int {
+	return xxx_messageInfo_GetThreadRequest.Size(m)
+}
+func (m *GetThreadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThreadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThreadRequest proto.InternalMessageInfo
+
+func (m *GetThreadRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetThreadReply struct {
+	ID                   []byte            `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string            `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	IsDB                 bool              `protobuf:"varint,3,opt,name=isDB,proto3" json:"isDB,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	HasBuckets           bool              `protobuf:"varint,5,opt,name=hasBuckets,proto3" json:"hasBuckets,omitempty"`
+	CreatedAt            int64             `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetThreadReply) Reset()         { *m = GetThreadReply{} }
+func (m *GetThreadReply) String() string { return proto.CompactTextString(m) }
+func (*GetThreadReply) ProtoMessage()    {}
+func (*GetThreadReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{3}
+}
+
+func (m *GetThreadReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThreadReply.Unmarshal(m, b)
+}
+func (m *GetThreadReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThreadReply.Marshal(b, m, deterministic)
+}
+func (m *GetThreadReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThreadReply.Merge(m, src)
+}
+func (m *GetThreadReply) XXX_Size() int {
+	return xxx_messageInfo_GetThreadReply.Size(m)
+}
+func (m *GetThreadReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThreadReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThreadReply proto.InternalMessageInfo
+
+func (m *GetThreadReply) GetID() []byte {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+func (m *GetThreadReply) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetThreadReply) GetIsDB() bool {
+	if m != nil {
+		return m.IsDB
+	}
+	return false
+}
+
+func (m *GetThreadReply) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *GetThreadReply) GetHasBuckets() bool {
+	if m != nil {
+		return m.HasBuckets
+	}
+	return false
+}
+
+func (m *GetThreadReply) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type SetThreadTagsRequest struct {
+	ID                   []byte            `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Tags                 map[string]string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SetThreadTagsRequest) Reset()         { *m = SetThreadTagsRequest{} }
+func (m *SetThreadTagsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetThreadTagsRequest) ProtoMessage()    {}
+func (*SetThreadTagsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{4}
+}
+
+func (m *SetThreadTagsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetThreadTagsRequest.Unmarshal(m, b)
+}
+func (m *SetThreadTagsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetThreadTagsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetThreadTagsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetThreadTagsRequest.Merge(m, src)
+}
+func (m *SetThreadTagsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetThreadTagsRequest.Size(m)
+}
+func (m *SetThreadTagsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetThreadTagsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetThreadTagsRequest proto.InternalMessageInfo
+
+func (m *SetThreadTagsRequest) GetID() []byte {
+	if m != nil {
+		return m.ID
+	}
+	return nil
+}
+
+func (m *SetThreadTagsRequest) GetTags() map[string]string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+type SetThreadTagsReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetThreadTagsReply) Reset()         { *m = SetThreadTagsReply{} }
+func (m *SetThreadTagsReply) String() string { return proto.CompactTextString(m) }
+func (*SetThreadTagsReply) ProtoMessage()    {}
+func (*SetThreadTagsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{5}
+}
+
+func (m *SetThreadTagsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetThreadTagsReply.Unmarshal(m, b)
+}
+func (m *SetThreadTagsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetThreadTagsReply.Marshal(b, m, deterministic)
+}
+func (m *SetThreadTagsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetThreadTagsReply.Merge(m, src)
+}
+func (m *SetThreadTagsReply) XXX_Size() int {
+	return xxx_messageInfo_SetThreadTagsReply.Size(m)
+}
+func (m *SetThreadTagsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetThreadTagsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetThreadTagsReply proto.InternalMessageInfo
+
+type GetThreadLimitsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetThreadLimitsRequest) Reset()         { *m = GetThreadLimitsRequest{} }
+func (m *GetThreadLimitsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetThreadLimitsRequest) ProtoMessage()    {}
+func (*GetThreadLimitsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{6}
+}
+
+func (m *GetThreadLimitsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThreadLimitsRequest.Unmarshal(m, b)
+}
+func (m *GetThreadLimitsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThreadLimitsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetThreadLimitsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThreadLimitsRequest.Merge(m, src)
+}
+func (m *GetThreadLimitsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetThreadLimitsRequest.Size(m)
+}
+func (m *GetThreadLimitsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThreadLimitsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThreadLimitsRequest proto.InternalMessageInfo
+
+type GetThreadLimitsReply struct {
+	PerOwner             *GetThreadLimitsReply_Limit `protobuf:"bytes,1,opt,name=perOwner,proto3" json:"perOwner,omitempty"`
+	PerKey               *GetThreadLimitsReply_Limit `protobuf:"bytes,2,opt,name=perKey,proto3" json:"perKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *GetThreadLimitsReply) Reset()         { *m = GetThreadLimitsReply{} }
+func (m *GetThreadLimitsReply) String() string { return proto.CompactTextString(m) }
+func (*GetThreadLimitsReply) ProtoMessage()    {}
+func (*GetThreadLimitsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{7}
+}
+
+func (m *GetThreadLimitsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThreadLimitsReply.Unmarshal(m, b)
+}
+func (m *GetThreadLimitsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThreadLimitsReply.Marshal(b, m, deterministic)
+}
+func (m *GetThreadLimitsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThreadLimitsReply.Merge(m, src)
+}
+func (m *GetThreadLimitsReply) XXX_Size() int {
+	return xxx_messageInfo_GetThreadLimitsReply.Size(m)
+}
+func (m *GetThreadLimitsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThreadLimitsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThreadLimitsReply proto.InternalMessageInfo
+
+func (m *GetThreadLimitsReply) GetPerOwner() *GetThreadLimitsReply_Limit {
+	if m != nil {
+		return m.PerOwner
+	}
+	return nil
+}
+
+func (m *GetThreadLimitsReply) GetPerKey() *GetThreadLimitsReply_Limit {
+	if m != nil {
+		return m.PerKey
+	}
+	return nil
+}
+
+// A max of zero means there is no limit, in which case remaining is -1.
+type GetThreadLimitsReply_Limit struct {
+	Current              int64    `protobuf:"varint,1,opt,name=current,proto3" json:"current,omitempty"`
+	Max                  int64    `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+	Remaining            int64    `protobuf:"varint,3,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetThreadLimitsReply_Limit) Reset()         { *m = GetThreadLimitsReply_Limit{} }
+func (m *GetThreadLimitsReply_Limit) String() string { return proto.CompactTextString(m) }
+func (*GetThreadLimitsReply_Limit) ProtoMessage()    {}
+func (*GetThreadLimitsReply_Limit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{7, 0}
+}
+
+func (m *GetThreadLimitsReply_Limit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetThreadLimitsReply_Limit.Unmarshal(m, b)
+}
+func (m *GetThreadLimitsReply_Limit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetThreadLimitsReply_Limit.Marshal(b, m, deterministic)
+}
+func (m *GetThreadLimitsReply_Limit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetThreadLimitsReply_Limit.Merge(m, src)
+}
+func (m *GetThreadLimitsReply_Limit) XXX_Size() int {
+	return xxx_messageInfo_GetThreadLimitsReply_Limit.Size(m)
+}
+func (m *GetThreadLimitsReply_Limit) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetThreadLimitsReply_Limit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetThreadLimitsReply_Limit proto.InternalMessageInfo
+
+func (m *GetThreadLimitsReply_Limit) GetCurrent() int64 {
+	if m != nil {
+		return m.Current
+	}
+	return 0
+}
+
+func (m *GetThreadLimitsReply_Limit) GetMax() int64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *GetThreadLimitsReply_Limit) GetRemaining() int64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+type LimitExceeded struct {
+	Limit                string   `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	Current              int64    `protobuf:"varint,2,opt,name=current,proto3" json:"current,omitempty"`
+	Max                  int64    `protobuf:"varint,3,opt,name=max,proto3" json:"max,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LimitExceeded) Reset()         { *m = LimitExceeded{} }
+func (m *LimitExceeded) String() string { return proto.CompactTextString(m) }
+func (*LimitExceeded) ProtoMessage()    {}
+func (*LimitExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{8}
+}
+
+func (m *LimitExceeded) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LimitExceeded.Unmarshal(m, b)
+}
+func (m *LimitExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LimitExceeded.Marshal(b, m, deterministic)
+}
+func (m *LimitExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitExceeded.Merge(m, src)
+}
+func (m *LimitExceeded) XXX_Size() int {
+	return xxx_messageInfo_LimitExceeded.Size(m)
+}
+func (m *LimitExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitExceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitExceeded proto.InternalMessageInfo
+
+func (m *LimitExceeded) GetLimit() string {
+	if m != nil {
+		return m.Limit
+	}
+	return ""
+}
+
+func (m *LimitExceeded) GetCurrent() int64 {
+	if m != nil {
+		return m.Current
+	}
+	return 0
+}
+
+func (m *LimitExceeded) GetMax() int64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+type GetUsageRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUsageRequest) Reset()         { *m = GetUsageRequest{} }
+func (m *GetUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUsageRequest) ProtoMessage()    {}
+func (*GetUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{9}
+}
+
+func (m *GetUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUsageRequest.Unmarshal(m, b)
+}
+func (m *GetUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUsageRequest.Marshal(b, m, deterministic)
+}
+func (m *GetUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsageRequest.Merge(m, src)
+}
+func (m *GetUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUsageRequest.Size(m)
+}
+func (m *GetUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUsageRequest proto.InternalMessageInfo
+
+type GetUsageReply struct {
+	StoredSize           int64    `protobuf:"varint,1,opt,name=storedSize,proto3" json:"storedSize,omitempty"`
+	BucketCount          int64    `protobuf:"varint,2,opt,name=bucketCount,proto3" json:"bucketCount,omitempty"`
+	ThreadCount          int64    `protobuf:"varint,3,opt,name=threadCount,proto3" json:"threadCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUsageReply) Reset()         { *m = GetUsageReply{} }
+func (m *GetUsageReply) String() string { return proto.CompactTextString(m) }
+func (*GetUsageReply) ProtoMessage()    {}
+func (*GetUsageReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{10}
+}
+
+func (m *GetUsageReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUsageReply.Unmarshal(m, b)
+}
+func (m *GetUsageReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUsageReply.Marshal(b, m, deterministic)
+}
+func (m *GetUsageReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUsageReply.Merge(m, src)
+}
+func (m *GetUsageReply) XXX_Size() int {
+	return xxx_messageInfo_GetUsageReply.Size(m)
+}
+func (m *GetUsageReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUsageReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUsageReply proto.InternalMessageInfo
+
+func (m *GetUsageReply) GetStoredSize() int64 {
+	if m != nil {
+		return m.StoredSize
+	}
+	return 0
+}
+
+func (m *GetUsageReply) GetBucketCount() int64 {
+	if m != nil {
+		return m.BucketCount
+	}
+	return 0
+}
+
+func (m *GetUsageReply) GetThreadCount() int64 {
+	if m != nil {
+		return m.ThreadCount
+	}
+	return 0
+}
+
+type ListBucketsRequest struct {
+	Seek                 string   `protobuf:"bytes,1,opt,name=seek,proto3" json:"seek,omitempty"`
+	Limit                int64    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Descending           bool     `protobuf:"varint,3,opt,name=descending,proto3" json:"descending,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBucketsRequest) Reset()         { *m = ListBucketsRequest{} }
+func (m *ListBucketsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListBucketsRequest) ProtoMessage()    {}
+func (*ListBucketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{11}
+}
+
+func (m *ListBucketsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBucketsRequest.Unmarshal(m, b)
+}
+func (m *ListBucketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBucketsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListBucketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBucketsRequest.Merge(m, src)
+}
+func (m *ListBucketsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListBucketsRequest.Size(m)
+}
+func (m *ListBucketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBucketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBucketsRequest proto.InternalMessageInfo
+
+func (m *ListBucketsRequest) GetSeek() string {
+	if m != nil {
+		return m.Seek
+	}
+	return ""
+}
+
+func (m *ListBucketsRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListBucketsRequest) GetDescending() bool {
+	if m != nil {
+		return m.Descending
+	}
+	return false
+}
+
+type ListBucketsReply struct {
+	Buckets              []*ListBucketsReply_Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *ListBucketsReply) Reset()         { *m = ListBucketsReply{} }
+func (m *ListBucketsReply) String() string { return proto.CompactTextString(m) }
+func (*ListBucketsReply) ProtoMessage()    {}
+func (*ListBucketsReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{12}
+}
+
+func (m *ListBucketsReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBucketsReply.Unmarshal(m, b)
+}
+func (m *ListBucketsReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBucketsReply.Marshal(b, m, deterministic)
+}
+func (m *ListBucketsReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBucketsReply.Merge(m, src)
+}
+func (m *ListBucketsReply) XXX_Size() int {
+	return xxx_messageInfo_ListBucketsReply.Size(m)
+}
+func (m *ListBucketsReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBucketsReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBucketsReply proto.InternalMessageInfo
+
+func (m *ListBucketsReply) GetBuckets() []*ListBucketsReply_Bucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type ListBucketsReply_Bucket struct {
+	ThreadID             []byte   `protobuf:"bytes,1,opt,name=threadID,proto3" json:"threadID,omitempty"`
+	ThreadName           string   `protobuf:"bytes,2,opt,name=threadName,proto3" json:"threadName,omitempty"`
+	Key                  string   `protobuf:"bytes,3,opt,name=key,proto3" json:"key,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Path                 string   `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt            int64    `protobuf:"varint,7,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListBucketsReply_Bucket) Reset()         { *m = ListBucketsReply_Bucket{} }
+func (m *ListBucketsReply_Bucket) String() string { return proto.CompactTextString(m) }
+func (*ListBucketsReply_Bucket) ProtoMessage()    {}
+func (*ListBucketsReply_Bucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{12, 0}
+}
+
+func (m *ListBucketsReply_Bucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListBucketsReply_Bucket.Unmarshal(m, b)
+}
+func (m *ListBucketsReply_Bucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListBucketsReply_Bucket.Marshal(b, m, deterministic)
+}
+func (m *ListBucketsReply_Bucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListBucketsReply_Bucket.Merge(m, src)
+}
+func (m *ListBucketsReply_Bucket) XXX_Size() int {
+	return xxx_messageInfo_ListBucketsReply_Bucket.Size(m)
+}
+func (m *ListBucketsReply_Bucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListBucketsReply_Bucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListBucketsReply_Bucket proto.InternalMessageInfo
+
+func (m *ListBucketsReply_Bucket) GetThreadID() []byte {
+	if m != nil {
+		return m.ThreadID
+	}
+	return nil
+}
+
+func (m *ListBucketsReply_Bucket) GetThreadName() string {
+	if m != nil {
+		return m.ThreadName
+	}
+	return ""
+}
+
+func (m *ListBucketsReply_Bucket) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ListBucketsReply_Bucket) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ListBucketsReply_Bucket) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *ListBucketsReply_Bucket) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ListBucketsReply_Bucket) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+type RenewTokenRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenewTokenRequest) Reset()         { *m = RenewTokenRequest{} }
+func (m *RenewTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RenewTokenRequest) ProtoMessage()    {}
+func (*RenewTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{13}
+}
+
+func (m *RenewTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenewTokenRequest.Unmarshal(m, b)
+}
+func (m *RenewTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenewTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RenewTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewTokenRequest.Merge(m, src)
+}
+func (m *RenewTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RenewTokenRequest.Size(m)
+}
+func (m *RenewTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenewTokenRequest proto.InternalMessageInfo
+
+type RenewTokenReply struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RenewTokenReply) Reset()         { *m = RenewTokenReply{} }
+func (m *RenewTokenReply) String() string { return proto.CompactTextString(m) }
+func (*RenewTokenReply) ProtoMessage()    {}
+func (*RenewTokenReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{14}
+}
+
+func (m *RenewTokenReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenewTokenReply.Unmarshal(m, b)
+}
+func (m *RenewTokenReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenewTokenReply.Marshal(b, m, deterministic)
+}
+func (m *RenewTokenReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenewTokenReply.Merge(m, src)
+}
+func (m *RenewTokenReply) XXX_Size() int {
+	return xxx_messageInfo_RenewTokenReply.Size(m)
+}
+func (m *RenewTokenReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenewTokenReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RenewTokenReply proto.InternalMessageInfo
+
+func (m *RenewTokenReply) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type DeleteUserRequest struct {
+	Key                  []byte                       `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Data                 DeleteUserRequest_DataPolicy `protobuf:"varint,2,opt,name=data,proto3,enum=users.pb.DeleteUserRequest_DataPolicy" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *DeleteUserRequest) Reset()         { *m = DeleteUserRequest{} }
+func (m *DeleteUserRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserRequest) ProtoMessage()    {}
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{15}
+}
+
+func (m *DeleteUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserRequest.Unmarshal(m, b)
+}
+func (m *DeleteUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserRequest.Merge(m, src)
+}
+func (m *DeleteUserRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserRequest.Size(m)
+}
+func (m *DeleteUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserRequest proto.InternalMessageInfo
+
+func (m *DeleteUserRequest) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *DeleteUserRequest) GetData() DeleteUserRequest_DataPolicy {
+	if m != nil {
+		return m.Data
+	}
+	return DeleteUserRequest_DELETE
+}
+
+type DeleteUserReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserReply) Reset()         { *m = DeleteUserReply{} }
+func (m *DeleteUserReply) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserReply) ProtoMessage()    {}
+func (*DeleteUserReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{16}
+}
+
+func (m *DeleteUserReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserReply.Unmarshal(m, b)
+}
+func (m *DeleteUserReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserReply.Marshal(b, m, deterministic)
+}
+func (m *DeleteUserReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserReply.Merge(m, src)
+}
+func (m *DeleteUserReply) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserReply.Size(m)
+}
+func (m *DeleteUserReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserReply proto.InternalMessageInfo
+
+type RegisterDeviceRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Platform             string   `protobuf:"bytes,2,opt,name=platform,proto3" json:"platform,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterDeviceRequest) Reset()         { *m = RegisterDeviceRequest{} }
+func (m *RegisterDeviceRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterDeviceRequest) ProtoMessage()    {}
+func (*RegisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{17}
+}
+
+func (m *RegisterDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterDeviceRequest.Unmarshal(m, b)
+}
+func (m *RegisterDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterDeviceRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterDeviceRequest.Merge(m, src)
+}
+func (m *RegisterDeviceRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterDeviceRequest.Size(m)
+}
+func (m *RegisterDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterDeviceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterDeviceRequest proto.InternalMessageInfo
+
+func (m *RegisterDeviceRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *RegisterDeviceRequest) GetPlatform() string {
+	if m != nil {
+		return m.Platform
+	}
+	return ""
+}
+
+type RegisterDeviceReply struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterDeviceReply) Reset()         { *m = RegisterDeviceReply{} }
+func (m *RegisterDeviceReply) String() string { return proto.CompactTextString(m) }
+func (*RegisterDeviceReply) ProtoMessage()    {}
+func (*RegisterDeviceReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{18}
+}
+
+func (m *RegisterDeviceReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterDeviceReply.Unmarshal(m, b)
+}
+func (m *RegisterDeviceReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterDeviceReply.Marshal(b, m, deterministic)
+}
+func (m *RegisterDeviceReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterDeviceReply.Merge(m, src)
+}
+func (m *RegisterDeviceReply) XXX_Size() int {
+	return xxx_messageInfo_RegisterDeviceReply.Size(m)
+}
+func (m *RegisterDeviceReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterDeviceReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterDeviceReply proto.InternalMessageInfo
+
+type UnregisterDeviceRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetThreadRequest) Reset()         { *m = GetThreadRequest{} }
-func (m *GetThreadRequest) String() string { return proto.CompactTextString(m) }
-func (*GetThreadRequest) ProtoMessage()    {}
-func (*GetThreadRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{2}
+func (m *UnregisterDeviceRequest) Reset()         { *m = UnregisterDeviceRequest{} }
+func (m *UnregisterDeviceRequest) String() string { return proto.CompactTextString(m) }
+func (*UnregisterDeviceRequest) ProtoMessage()    {}
+func (*UnregisterDeviceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{19}
 }
 
-func (m *GetThreadRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetThreadRequest.Unmarshal(m, b)
+func (m *UnregisterDeviceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnregisterDeviceRequest.Unmarshal(m, b)
 }
-func (m *GetThreadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetThreadRequest.Marshal(b, m, deterministic)
+func (m *UnregisterDeviceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnregisterDeviceRequest.Marshal(b, m, deterministic)
 }
-func (m *GetThreadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetThreadRequest.Merge(m, src)
+func (m *UnregisterDeviceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterDeviceRequest.Merge(m, src)
 }
-func (m *GetThreadRequest) XXX_Size() int {
-	return xxx_messageInfo_GetThreadRequest.Size(m)
+func (m *UnregisterDeviceRequest) XXX_Size() int {
+	return xxx_messageInfo_UnregisterDeviceRequest.Size(m)
 }
-func (m *GetThreadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetThreadRequest.DiscardUnknown(m)
+func (m *UnregisterDeviceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterDeviceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetThreadRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnregisterDeviceRequest proto.InternalMessageInfo
 
-func (m *GetThreadRequest) GetName() string {
+func (m *UnregisterDeviceRequest) GetToken() string {
 	if m != nil {
-		return m.Name
+		return m.Token
 	}
 	return ""
 }
 
-type GetThreadReply struct {
-	ID                   []byte   `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	IsDB                 bool     `protobuf:"varint,3,opt,name=isDB,proto3" json:"isDB,omitempty"`
+type UnregisterDeviceReply struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetThreadReply) Reset()         { *m = GetThreadReply{} }
-func (m *GetThreadReply) String() string { return proto.CompactTextString(m) }
-func (*GetThreadReply) ProtoMessage()    {}
-func (*GetThreadReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{3}
+func (m *UnregisterDeviceReply) Reset()         { *m = UnregisterDeviceReply{} }
+func (m *UnregisterDeviceReply) String() string { return proto.CompactTextString(m) }
+func (*UnregisterDeviceReply) ProtoMessage()    {}
+func (*UnregisterDeviceReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_030765f334c86cea, []int{20}
 }
 
-func (m *GetThreadReply) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetThreadReply.Unmarshal(m, b)
-}
-func (m *GetThreadReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetThreadReply.Marshal(b, m, deterministic)
+func (m *UnregisterDeviceReply) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnregisterDeviceReply.Unmarshal(m, b)
 }
-func (m *GetThreadReply) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetThreadReply.Merge(m, src)
-}
-func (m *GetThreadReply) XXX_Size() int {
-	return xxx_messageInfo_GetThreadReply.Size(m)
+func (m *UnregisterDeviceReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnregisterDeviceReply.Marshal(b, m, deterministic)
 }
-func (m *GetThreadReply) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetThreadReply.DiscardUnknown(m)
+func (m *UnregisterDeviceReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnregisterDeviceReply.Merge(m, src)
 }
-
-var xxx_messageInfo_GetThreadReply proto.InternalMessageInfo
-
-func (m *GetThreadReply) GetID() []byte {
-	if m != nil {
-		return m.ID
-	}
-	return nil
+func (m *UnregisterDeviceReply) XXX_Size() int {
+	return xxx_messageInfo_UnregisterDeviceReply.Size(m)
 }
-
-func (m *GetThreadReply) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
+func (m *UnregisterDeviceReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnregisterDeviceReply.DiscardUnknown(m)
 }
 
-func (m *GetThreadReply) GetIsDB() bool {
-	if m != nil {
-		return m.IsDB
-	}
-	return false
-}
+var xxx_messageInfo_UnregisterDeviceReply proto.InternalMessageInfo
 
 type SetupMailboxRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -226,7 +1137,7 @@ func (m *SetupMailboxRequest) Reset()         { *m = SetupMailboxRequest{} }
 func (m *SetupMailboxRequest) String() string { return proto.CompactTextString(m) }
 func (*SetupMailboxRequest) ProtoMessage()    {}
 func (*SetupMailboxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{4}
+	return fileDescriptor_030765f334c86cea, []int{21}
 }
 
 func (m *SetupMailboxRequest) XXX_Unmarshal(b []byte) error {
@@ -258,7 +1169,7 @@ func (m *SetupMailboxReply) Reset()         { *m = SetupMailboxReply{} }
 func (m *SetupMailboxReply) String() string { return proto.CompactTextString(m) }
 func (*SetupMailboxReply) ProtoMessage()    {}
 func (*SetupMailboxReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{5}
+	return fileDescriptor_030765f334c86cea, []int{22}
 }
 
 func (m *SetupMailboxReply) XXX_Unmarshal(b []byte) error {
@@ -294,6 +1205,7 @@ type Message struct {
 	Signature            []byte   `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
 	CreatedAt            int64    `protobuf:"varint,6,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
 	ReadAt               int64    `protobuf:"varint,7,opt,name=readAt,proto3" json:"readAt,omitempty"`
+	DeliveredAt          int64    `protobuf:"varint,8,opt,name=deliveredAt,proto3" json:"deliveredAt,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -303,7 +1215,7 @@ func (m *Message) Reset()         { *m = Message{} }
 func (m *Message) String() string { return proto.CompactTextString(m) }
 func (*Message) ProtoMessage()    {}
 func (*Message) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{6}
+	return fileDescriptor_030765f334c86cea, []int{23}
 }
 
 func (m *Message) XXX_Unmarshal(b []byte) error {
@@ -373,6 +1285,13 @@ func (m *Message) GetReadAt() int64 {
 	return 0
 }
 
+func (m *Message) GetDeliveredAt() int64 {
+	if m != nil {
+		return m.DeliveredAt
+	}
+	return 0
+}
+
 type SendMessageRequest struct {
 	To                   string   `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
 	ToBody               []byte   `protobuf:"bytes,2,opt,name=toBody,proto3" json:"toBody,omitempty"`
@@ -388,7 +1307,7 @@ func (m *SendMessageRequest) Reset()         { *m = SendMessageRequest{} }
 func (m *SendMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*SendMessageRequest) ProtoMessage()    {}
 func (*SendMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{7}
+	return fileDescriptor_030765f334c86cea, []int{24}
 }
 
 func (m *SendMessageRequest) XXX_Unmarshal(b []byte) error {
@@ -456,7 +1375,7 @@ func (m *SendMessageReply) Reset()         { *m = SendMessageReply{} }
 func (m *SendMessageReply) String() string { return proto.CompactTextString(m) }
 func (*SendMessageReply) ProtoMessage()    {}
 func (*SendMessageReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{8}
+	return fileDescriptor_030765f334c86cea, []int{25}
 }
 
 func (m *SendMessageReply) XXX_Unmarshal(b []byte) error {
@@ -505,7 +1424,7 @@ func (m *ListInboxMessagesRequest) Reset()         { *m = ListInboxMessagesReque
 func (m *ListInboxMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListInboxMessagesRequest) ProtoMessage()    {}
 func (*ListInboxMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{9}
+	return fileDescriptor_030765f334c86cea, []int{26}
 }
 
 func (m *ListInboxMessagesRequest) XXX_Unmarshal(b []byte) error {
@@ -567,7 +1486,7 @@ func (m *ListSentboxMessagesRequest) Reset()         { *m = ListSentboxMessagesR
 func (m *ListSentboxMessagesRequest) String() string { return proto.CompactTextString(m) }
 func (*ListSentboxMessagesRequest) ProtoMessage()    {}
 func (*ListSentboxMessagesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{10}
+	return fileDescriptor_030765f334c86cea, []int{27}
 }
 
 func (m *ListSentboxMessagesRequest) XXX_Unmarshal(b []byte) error {
@@ -620,7 +1539,7 @@ func (m *ListMessagesReply) Reset()         { *m = ListMessagesReply{} }
 func (m *ListMessagesReply) String() string { return proto.CompactTextString(m) }
 func (*ListMessagesReply) ProtoMessage()    {}
 func (*ListMessagesReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{11}
+	return fileDescriptor_030765f334c86cea, []int{28}
 }
 
 func (m *ListMessagesReply) XXX_Unmarshal(b []byte) error {
@@ -659,7 +1578,7 @@ func (m *ReadInboxMessageRequest) Reset()         { *m = ReadInboxMessageRequest
 func (m *ReadInboxMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*ReadInboxMessageRequest) ProtoMessage()    {}
 func (*ReadInboxMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{12}
+	return fileDescriptor_030765f334c86cea, []int{29}
 }
 
 func (m *ReadInboxMessageRequest) XXX_Unmarshal(b []byte) error {
@@ -698,7 +1617,7 @@ func (m *ReadInboxMessageReply) Reset()         { *m = ReadInboxMessageReply{} }
 func (m *ReadInboxMessageReply) String() string { return proto.CompactTextString(m) }
 func (*ReadInboxMessageReply) ProtoMessage()    {}
 func (*ReadInboxMessageReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{13}
+	return fileDescriptor_030765f334c86cea, []int{30}
 }
 
 func (m *ReadInboxMessageReply) XXX_Unmarshal(b []byte) error {
@@ -737,7 +1656,7 @@ func (m *DeleteMessageRequest) Reset()         { *m = DeleteMessageRequest{} }
 func (m *DeleteMessageRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteMessageRequest) ProtoMessage()    {}
 func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{14}
+	return fileDescriptor_030765f334c86cea, []int{31}
 }
 
 func (m *DeleteMessageRequest) XXX_Unmarshal(b []byte) error {
@@ -775,7 +1694,7 @@ func (m *DeleteMessageReply) Reset()         { *m = DeleteMessageReply{} }
 func (m *DeleteMessageReply) String() string { return proto.CompactTextString(m) }
 func (*DeleteMessageReply) ProtoMessage()    {}
 func (*DeleteMessageReply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_030765f334c86cea, []int{15}
+	return fileDescriptor_030765f334c86cea, []int{32}
 }
 
 func (m *DeleteMessageReply) XXX_Unmarshal(b []byte) error {
@@ -797,11 +1716,34 @@ func (m *DeleteMessageReply) XXX_DiscardUnknown() {
 var xxx_messageInfo_DeleteMessageReply proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterEnum("users.pb.DeleteUserRequest_DataPolicy", DeleteUserRequest_DataPolicy_name, DeleteUserRequest_DataPolicy_value)
 	proto.RegisterEnum("users.pb.ListInboxMessagesRequest_Status", ListInboxMessagesRequest_Status_name, ListInboxMessagesRequest_Status_value)
 	proto.RegisterType((*ListThreadsRequest)(nil), "users.pb.ListThreadsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "users.pb.ListThreadsRequest.TagsEntry")
 	proto.RegisterType((*ListThreadsReply)(nil), "users.pb.ListThreadsReply")
 	proto.RegisterType((*GetThreadRequest)(nil), "users.pb.GetThreadRequest")
 	proto.RegisterType((*GetThreadReply)(nil), "users.pb.GetThreadReply")
+	proto.RegisterMapType((map[string]string)(nil), "users.pb.GetThreadReply.TagsEntry")
+	proto.RegisterType((*SetThreadTagsRequest)(nil), "users.pb.SetThreadTagsRequest")
+	proto.RegisterMapType((map[string]string)(nil), "users.pb.SetThreadTagsRequest.TagsEntry")
+	proto.RegisterType((*SetThreadTagsReply)(nil), "users.pb.SetThreadTagsReply")
+	proto.RegisterType((*GetThreadLimitsRequest)(nil), "users.pb.GetThreadLimitsRequest")
+	proto.RegisterType((*GetThreadLimitsReply)(nil), "users.pb.GetThreadLimitsReply")
+	proto.RegisterType((*GetThreadLimitsReply_Limit)(nil), "users.pb.GetThreadLimitsReply.Limit")
+	proto.RegisterType((*LimitExceeded)(nil), "users.pb.LimitExceeded")
+	proto.RegisterType((*GetUsageRequest)(nil), "users.pb.GetUsageRequest")
+	proto.RegisterType((*GetUsageReply)(nil), "users.pb.GetUsageReply")
+	proto.RegisterType((*ListBucketsRequest)(nil), "users.pb.ListBucketsRequest")
+	proto.RegisterType((*ListBucketsReply)(nil), "users.pb.ListBucketsReply")
+	proto.RegisterType((*ListBucketsReply_Bucket)(nil), "users.pb.ListBucketsReply.Bucket")
+	proto.RegisterType((*RenewTokenRequest)(nil), "users.pb.RenewTokenRequest")
+	proto.RegisterType((*RenewTokenReply)(nil), "users.pb.RenewTokenReply")
+	proto.RegisterType((*DeleteUserRequest)(nil), "users.pb.DeleteUserRequest")
+	proto.RegisterType((*DeleteUserReply)(nil), "users.pb.DeleteUserReply")
+	proto.RegisterType((*RegisterDeviceRequest)(nil), "users.pb.RegisterDeviceRequest")
+	proto.RegisterType((*RegisterDeviceReply)(nil), "users.pb.RegisterDeviceReply")
+	proto.RegisterType((*UnregisterDeviceRequest)(nil), "users.pb.UnregisterDeviceRequest")
+	proto.RegisterType((*UnregisterDeviceReply)(nil), "users.pb.UnregisterDeviceReply")
 	proto.RegisterType((*SetupMailboxRequest)(nil), "users.pb.SetupMailboxRequest")
 	proto.RegisterType((*SetupMailboxReply)(nil), "users.pb.SetupMailboxReply")
 	proto.RegisterType((*Message)(nil), "users.pb.Message")
@@ -819,53 +1761,99 @@ func init() {
 func init() { proto.RegisterFile("users.proto", fileDescriptor_030765f334c86cea) }
 
 var fileDescriptor_030765f334c86cea = []byte{
-	// 729 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xed, 0x6e, 0xd3, 0x4a,
-	0x10, 0x8d, 0x9d, 0x34, 0x4d, 0x26, 0xbd, 0x55, 0x3a, 0x4d, 0x7b, 0x2d, 0xdf, 0x5e, 0x08, 0xab,
-	0xaa, 0xa4, 0x12, 0x04, 0x11, 0x5e, 0x80, 0x84, 0x54, 0x10, 0x29, 0x45, 0x95, 0x9d, 0x22, 0xc4,
-	0x9f, 0xca, 0xa9, 0x97, 0x60, 0xe1, 0xd8, 0xc1, 0xbb, 0x91, 0xda, 0xb7, 0x41, 0x48, 0xbc, 0x0e,
-	0x0f, 0xc2, 0x53, 0xa0, 0x5d, 0x7f, 0x6e, 0x9a, 0xd0, 0x1f, 0x88, 0x7f, 0xbb, 0x67, 0x67, 0xce,
-	0x9c, 0x59, 0x9f, 0x1d, 0x43, 0x63, 0xc9, 0x68, 0xc4, 0xba, 0x8b, 0x28, 0xe4, 0x21, 0xd6, 0x92,
-	0xcd, 0x94, 0xb4, 0x00, 0xc7, 0x1e, 0xe3, 0x93, 0x4f, 0x11, 0x75, 0x5c, 0x66, 0xd1, 0x2f, 0x4b,
-	0xca, 0x38, 0x79, 0x09, 0x4d, 0x05, 0x5d, 0xf8, 0xb7, 0xf8, 0x04, 0x2a, 0xbe, 0xc7, 0xb8, 0xa1,
-	0xb5, 0xcb, 0x9d, 0x46, 0xcf, 0xe8, 0xa6, 0x14, 0xdd, 0xd7, 0x34, 0x09, 0x94, 0x71, 0x96, 0x8c,
-	0x22, 0x27, 0xd0, 0x2c, 0xe0, 0x92, 0x15, 0x11, 0x2a, 0x81, 0x33, 0xa7, 0x86, 0xd6, 0xd6, 0x3a,
-	0x75, 0x4b, 0xae, 0xc9, 0x1b, 0xd8, 0x55, 0xf3, 0x71, 0x17, 0xf4, 0xd1, 0x50, 0xc6, 0xec, 0x58,
-	0xfa, 0x68, 0x98, 0x65, 0xe9, 0x79, 0x96, 0xc0, 0x3c, 0x36, 0x1c, 0x18, 0xe5, 0xb6, 0xd6, 0xa9,
-	0x59, 0x72, 0x4d, 0x0e, 0x60, 0xdf, 0xa6, 0x7c, 0xb9, 0x38, 0x77, 0x3c, 0x7f, 0x1a, 0xde, 0xa4,
-	0xad, 0x3c, 0x87, 0x3d, 0x15, 0x16, 0x35, 0x8e, 0xa0, 0x3e, 0x8f, 0xf7, 0x59, 0xa9, 0x1c, 0x20,
-	0xdf, 0x35, 0xd8, 0x3e, 0xa7, 0x8c, 0x39, 0x33, 0x5a, 0x50, 0x53, 0x4f, 0xd5, 0x7c, 0x8c, 0xc2,
-	0x79, 0xaa, 0x46, 0xac, 0x45, 0x0c, 0x0f, 0xa5, 0x96, 0xba, 0xa5, 0xf3, 0x50, 0xc4, 0x4c, 0x43,
-	0xf7, 0xd6, 0xa8, 0x48, 0x62, 0xb9, 0x16, 0x15, 0x99, 0x37, 0x0b, 0x1c, 0xbe, 0x8c, 0xa8, 0xb1,
-	0x15, 0x57, 0xcc, 0x00, 0x71, 0x7a, 0x1d, 0x51, 0x87, 0x53, 0xb7, 0xcf, 0x8d, 0x6a, 0x5b, 0xeb,
-	0x94, 0xad, 0x1c, 0xc0, 0x43, 0xa8, 0x8a, 0xeb, 0xe9, 0x73, 0x63, 0x5b, 0x1e, 0x25, 0x3b, 0xf2,
-	0x55, 0x03, 0xb4, 0x69, 0xe0, 0x26, 0x5a, 0xd3, 0x6b, 0x8e, 0xe5, 0x68, 0x99, 0x9c, 0x43, 0xa8,
-	0xf2, 0x70, 0x20, 0x04, 0xe9, 0xb2, 0x6e, 0xb2, 0xc3, 0x36, 0x34, 0x78, 0x68, 0x67, 0xa2, 0xca,
-	0xf2, 0xb0, 0x08, 0xa1, 0x09, 0x35, 0xd1, 0xe0, 0x20, 0x6f, 0x26, 0xdb, 0xe3, 0x31, 0xfc, 0x23,
-	0xd6, 0xf6, 0x4a, 0x53, 0x2a, 0x28, 0x8c, 0xa4, 0x28, 0x54, 0x3f, 0x70, 0x7c, 0xa5, 0x4a, 0xf3,
-	0xfa, 0x4a, 0xf3, 0xe4, 0x87, 0x06, 0x86, 0xf0, 0xe2, 0x28, 0x98, 0x86, 0x37, 0x09, 0x0f, 0x2b,
-	0x38, 0x8a, 0x51, 0xfa, 0x39, 0x75, 0x94, 0x58, 0x63, 0x0b, 0xb6, 0x7c, 0x6f, 0xee, 0xa5, 0x54,
-	0xf1, 0x46, 0x14, 0x71, 0xd8, 0x35, 0x0d, 0x5c, 0x2f, 0x98, 0x25, 0xb6, 0xc9, 0x01, 0xec, 0x43,
-	0x95, 0x71, 0x87, 0x2f, 0x99, 0x6c, 0x73, 0xb7, 0x77, 0x9a, 0xbb, 0x7b, 0x53, 0xed, 0xae, 0x2d,
-	0x13, 0xac, 0x24, 0x91, 0x3c, 0x86, 0x6a, 0x8c, 0xe0, 0x36, 0x94, 0xfb, 0xe3, 0x71, 0xb3, 0x84,
-	0x35, 0xa8, 0x58, 0x67, 0xfd, 0x61, 0x53, 0x43, 0x80, 0xea, 0xe5, 0x5b, 0xb9, 0xd6, 0x89, 0x0b,
-	0xa6, 0xe0, 0xb4, 0x69, 0xc0, 0xff, 0x5e, 0x47, 0x64, 0x00, 0x7b, 0xa2, 0x4a, 0x4e, 0x2f, 0x6e,
-	0xfe, 0x29, 0xd4, 0xe6, 0x09, 0x90, 0x3c, 0xe3, 0xbd, 0xbc, 0xd1, 0xf4, 0x1b, 0x65, 0x21, 0xe4,
-	0x14, 0xfe, 0xb5, 0xa8, 0xe3, 0x16, 0xbb, 0x2f, 0x78, 0xac, 0xf8, 0x0d, 0xc9, 0x33, 0x38, 0xb8,
-	0x1b, 0x2a, 0x4a, 0xe6, 0xde, 0xd5, 0x14, 0xef, 0x9e, 0x40, 0x6b, 0x48, 0x7d, 0xca, 0xe9, 0x3d,
-	0xc4, 0x2d, 0xc0, 0x95, 0xb8, 0x85, 0x7f, 0xdb, 0xfb, 0xb9, 0x05, 0xe5, 0xfe, 0xc5, 0x08, 0x5f,
-	0x41, 0x3d, 0x9b, 0x1e, 0x68, 0xae, 0x1d, 0x49, 0x92, 0xd6, 0xdc, 0x38, 0xae, 0x48, 0x09, 0x47,
-	0xd0, 0x28, 0x0c, 0x3b, 0x3c, 0x52, 0xbf, 0xbd, 0x3a, 0x19, 0x4d, 0x73, 0xc3, 0x69, 0x4c, 0x35,
-	0x86, 0x9d, 0xe2, 0xb0, 0xc1, 0xff, 0xf3, 0xe8, 0x35, 0xb3, 0xc9, 0xfc, 0x6f, 0xd3, 0x71, 0x26,
-	0xac, 0xf0, 0x78, 0x8a, 0xc2, 0xee, 0xbe, 0xfa, 0xa2, 0xb0, 0xd5, 0x17, 0x47, 0x4a, 0xf8, 0x2e,
-	0xb6, 0x83, 0x62, 0x64, 0x24, 0xf7, 0xbb, 0xbc, 0x28, 0xf1, 0x8e, 0x9f, 0x48, 0x09, 0x3f, 0xc0,
-	0xfe, 0x1a, 0x33, 0xe3, 0xb1, 0x9a, 0xb5, 0xde, 0xeb, 0xf7, 0x71, 0xbf, 0x87, 0xe6, 0xaa, 0xa7,
-	0xf0, 0x51, 0x9e, 0xb2, 0xc1, 0x9a, 0xe6, 0xc3, 0xdf, 0x85, 0xc4, 0xcc, 0x93, 0xd4, 0x54, 0x0a,
-	0xf7, 0x83, 0x3c, 0x71, 0x9d, 0x35, 0xcd, 0xa3, 0x8d, 0xe7, 0xe9, 0x1d, 0x27, 0x96, 0x56, 0xdb,
-	0xfd, 0x53, 0xde, 0x41, 0x0f, 0x0e, 0xbc, 0xb0, 0xcb, 0xe9, 0x0d, 0xf7, 0x7c, 0x1a, 0xc7, 0x5e,
-	0xcd, 0xa2, 0xc5, 0xf5, 0x60, 0x67, 0x12, 0x63, 0x97, 0x02, 0xba, 0xd0, 0xbe, 0xe9, 0xb5, 0xc9,
-	0xe4, 0xea, 0xd2, 0x3e, 0xb3, 0xec, 0x69, 0x55, 0xfe, 0xe7, 0x5f, 0xfc, 0x0a, 0x00, 0x00, 0xff,
-	0xff, 0x3f, 0xdd, 0x2f, 0x26, 0xf6, 0x07, 0x00, 0x00,
+	// 1457 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x29, 0x59, 0x96, 0xc6, 0xb2, 0x23, 0xaf, 0xe5, 0x58, 0x61, 0x1c, 0x47, 0x5d, 0x04,
+	0x8e, 0x03, 0xb4, 0x0a, 0xea, 0x02, 0x6d, 0x91, 0xe6, 0x10, 0x2b, 0x52, 0x02, 0xa3, 0xce, 0xdf,
+	0x4a, 0x2e, 0x82, 0x1e, 0x1a, 0x50, 0xe2, 0x46, 0x21, 0x4c, 0x91, 0x2a, 0xb9, 0x4a, 0xac, 0xbe,
+	0x41, 0x81, 0xbe, 0x42, 0x81, 0xa2, 0x8f, 0xd1, 0x17, 0xe8, 0xad, 0x97, 0x9e, 0xfb, 0x00, 0x7d,
+	0x82, 0x5e, 0x8b, 0xdd, 0xe5, 0xcf, 0x92, 0xa2, 0xe4, 0x36, 0x45, 0x6f, 0xbb, 0xb3, 0x33, 0xdf,
+	0x70, 0x66, 0x67, 0xbf, 0x19, 0x09, 0xd6, 0xa7, 0x01, 0xf5, 0x83, 0xd6, 0xc4, 0xf7, 0x98, 0x87,
+	0xca, 0xe1, 0x66, 0x80, 0x7f, 0xd7, 0x00, 0x9d, 0xda, 0x01, 0xeb, 0xbf, 0xf1, 0xa9, 0x69, 0x05,
+	0x84, 0x7e, 0x3b, 0xa5, 0x01, 0x43, 0xf7, 0xa0, 0xc8, 0xcc, 0x51, 0xd0, 0xd0, 0x9a, 0x85, 0xc3,
+	0xf5, 0xa3, 0x83, 0x56, 0xa4, 0xdf, 0x9a, 0xd7, 0x6d, 0xf5, 0xcd, 0x51, 0xd0, 0x75, 0x99, 0x3f,
+	0x23, 0xc2, 0x06, 0x21, 0x28, 0x06, 0x94, 0x9e, 0x37, 0xf4, 0xa6, 0x76, 0x58, 0x25, 0x62, 0x8d,
+	0xea, 0xb0, 0xea, 0xd8, 0x63, 0x9b, 0x35, 0x0a, 0x4d, 0xed, 0xb0, 0x40, 0xe4, 0x06, 0xed, 0x03,
+	0x58, 0x34, 0x18, 0x52, 0xd7, 0xb2, 0xdd, 0x51, 0xa3, 0xd8, 0xd4, 0x0e, 0xcb, 0x44, 0x91, 0x18,
+	0x9f, 0x41, 0x25, 0x06, 0x47, 0x35, 0x28, 0x9c, 0xd3, 0x59, 0x43, 0x6b, 0x6a, 0x87, 0x15, 0xc2,
+	0x97, 0x1c, 0xf4, 0xad, 0xe9, 0x4c, 0xa9, 0xf0, 0x54, 0x21, 0x72, 0x73, 0x4f, 0xff, 0x5c, 0xc3,
+	0x0f, 0xa0, 0x96, 0xfa, 0xd0, 0x89, 0x33, 0x43, 0x1f, 0x42, 0xd1, 0xb1, 0x03, 0x16, 0x86, 0xd4,
+	0x48, 0x42, 0x7a, 0x4c, 0x43, 0x45, 0xa1, 0x47, 0x84, 0x16, 0x3e, 0x80, 0x9a, 0x22, 0x97, 0x49,
+	0x41, 0x50, 0x74, 0xcd, 0x31, 0x0d, 0x3f, 0x41, 0xac, 0xf1, 0x5f, 0x1a, 0x6c, 0xa6, 0x01, 0xd0,
+	0x26, 0xe8, 0x27, 0x1d, 0xa1, 0x54, 0x25, 0xfa, 0x49, 0x27, 0x36, 0xd3, 0x13, 0x33, 0x2e, 0xb3,
+	0x83, 0x4e, 0x5b, 0xa4, 0xa3, 0x4c, 0xc4, 0x1a, 0x7d, 0x1a, 0xe6, 0xbc, 0x28, 0x3e, 0x10, 0x2f,
+	0xfa, 0xc0, 0xb9, 0x7c, 0xef, 0x03, 0xbc, 0x31, 0x83, 0xf6, 0x74, 0x78, 0x4e, 0x59, 0xd0, 0x58,
+	0x95, 0x59, 0x4c, 0x24, 0x68, 0x0f, 0x2a, 0x43, 0x9f, 0x9a, 0x8c, 0x5a, 0xc7, 0xac, 0x51, 0x12,
+	0xf9, 0x4f, 0x04, 0xef, 0x9f, 0xe3, 0x1f, 0x35, 0xa8, 0xf7, 0xa2, 0x2f, 0xe3, 0x10, 0x51, 0x9a,
+	0xb2, 0xf1, 0xdf, 0x0f, 0xe3, 0xd2, 0x45, 0x5c, 0x87, 0x49, 0x5c, 0x79, 0xd6, 0xd9, 0xe8, 0xde,
+	0xff, 0xfb, 0xea, 0x80, 0x32, 0x0e, 0x26, 0xce, 0x0c, 0x37, 0xe0, 0x6a, 0x9c, 0xce, 0x53, 0x5e,
+	0x84, 0x91, 0x63, 0xfc, 0xa7, 0x06, 0xf5, 0xb9, 0x23, 0x7e, 0x9f, 0x0f, 0xa0, 0x3c, 0xa1, 0xfe,
+	0xb3, 0x77, 0x2e, 0xf5, 0x85, 0xe7, 0xf5, 0xa3, 0x5b, 0x39, 0x77, 0xa3, 0x58, 0xb4, 0xc4, 0x9a,
+	0xc4, 0x56, 0xe8, 0x3e, 0x94, 0x26, 0xd4, 0xff, 0x92, 0xce, 0x1a, 0xfa, 0xbf, 0xb0, 0x0f, 0x6d,
+	0x8c, 0x17, 0xb0, 0x2a, 0x04, 0xa8, 0x01, 0x6b, 0xc3, 0xa9, 0xef, 0x53, 0x97, 0x89, 0xef, 0x28,
+	0x90, 0x68, 0xcb, 0xf3, 0x32, 0x36, 0x2f, 0x04, 0x7a, 0x81, 0xf0, 0x25, 0xbf, 0x74, 0x9f, 0x8e,
+	0x4d, 0xdb, 0xe5, 0x2f, 0x4b, 0x3e, 0xba, 0x44, 0x80, 0x5f, 0xc0, 0x86, 0x80, 0xec, 0x5e, 0x0c,
+	0x29, 0xb5, 0xa8, 0x95, 0xbc, 0x4f, 0x99, 0xda, 0x55, 0x27, 0xeb, 0x50, 0xcf, 0x75, 0x58, 0x88,
+	0x1d, 0xe2, 0x2d, 0xb8, 0xf2, 0x98, 0xb2, 0xb3, 0xc0, 0x1c, 0xd1, 0x28, 0xa3, 0x01, 0x6c, 0x24,
+	0x22, 0x9e, 0xc9, 0x7d, 0x80, 0x80, 0x79, 0x3e, 0xb5, 0x7a, 0xf6, 0x77, 0x34, 0x8c, 0x41, 0x91,
+	0xa0, 0x26, 0xac, 0x0f, 0x44, 0xd1, 0x3e, 0xf4, 0xa6, 0xb1, 0x4f, 0x55, 0xc4, 0x35, 0x98, 0x48,
+	0x97, 0xd4, 0x90, 0xfe, 0x55, 0x11, 0xfe, 0x46, 0xf2, 0x59, 0x58, 0xfc, 0xca, 0xd3, 0x15, 0x9c,
+	0x14, 0x3e, 0xdd, 0x34, 0x27, 0xe9, 0x8b, 0x39, 0xa9, 0x90, 0xe5, 0x24, 0xfc, 0x83, 0x0e, 0xb5,
+	0x94, 0x03, 0x1e, 0xd8, 0x17, 0xb0, 0x36, 0x08, 0xdf, 0x9f, 0xa4, 0x97, 0x0f, 0xd2, 0x8c, 0xa9,
+	0x2a, 0xb7, 0xe4, 0x86, 0x44, 0x16, 0xc6, 0x2f, 0x1a, 0x94, 0xa4, 0x0c, 0x19, 0x50, 0x96, 0xb1,
+	0xc4, 0x0f, 0x28, 0xde, 0xf3, 0x0f, 0x93, 0xeb, 0xa7, 0x09, 0x99, 0x28, 0x92, 0xe8, 0x6d, 0x14,
+	0x92, 0xb7, 0x11, 0x11, 0x4f, 0x31, 0x4d, 0x3c, 0x13, 0x93, 0xbd, 0x11, 0x34, 0x51, 0x21, 0x62,
+	0xbd, 0x9c, 0x20, 0xf8, 0xe9, 0x74, 0x62, 0x85, 0xa7, 0x6b, 0xf2, 0x34, 0x16, 0xe0, 0x6d, 0xd8,
+	0x22, 0xd4, 0xa5, 0xef, 0xfa, 0xde, 0x39, 0x75, 0xa3, 0x8b, 0xbf, 0x0d, 0x57, 0x54, 0x21, 0xcf,
+	0x50, 0x1d, 0x56, 0x19, 0xdf, 0x45, 0x05, 0x26, 0x36, 0xf8, 0x7b, 0x0d, 0xb6, 0x3a, 0xd4, 0xa1,
+	0x8c, 0x9e, 0x05, 0xd4, 0x8f, 0x2e, 0x4b, 0x79, 0xe5, 0x55, 0x19, 0xc9, 0x3d, 0x28, 0x5a, 0x26,
+	0x33, 0x45, 0xd4, 0x9b, 0x6a, 0x3b, 0x9a, 0x33, 0x6e, 0x75, 0x4c, 0x66, 0x3e, 0xf7, 0x1c, 0x7b,
+	0x38, 0x23, 0xc2, 0x06, 0x1f, 0x00, 0x24, 0x32, 0x04, 0x50, 0xea, 0x74, 0x4f, 0xbb, 0xfd, 0x6e,
+	0x6d, 0x05, 0x55, 0xa1, 0xdc, 0x27, 0xc7, 0x4f, 0x7b, 0x8f, 0xba, 0xa4, 0xa6, 0xf1, 0x02, 0x56,
+	0xd1, 0x38, 0x59, 0x9c, 0xc0, 0x0e, 0xa1, 0x23, 0x3b, 0x60, 0xd4, 0xef, 0xd0, 0xb7, 0xf6, 0x30,
+	0xaa, 0xec, 0xfc, 0x68, 0xf8, 0xed, 0x4d, 0x1c, 0x93, 0xbd, 0xf6, 0xfc, 0x71, 0x78, 0x3f, 0xf1,
+	0x1e, 0xef, 0xc0, 0x76, 0x16, 0x8a, 0x7b, 0xb8, 0x0b, 0xbb, 0x67, 0xae, 0xff, 0xcf, 0x7d, 0xe0,
+	0x5d, 0xd8, 0x99, 0x37, 0xe0, 0x48, 0x3b, 0xb0, 0xdd, 0xa3, 0x6c, 0x3a, 0x79, 0x62, 0xda, 0xce,
+	0xc0, 0xbb, 0x88, 0xae, 0xe2, 0x63, 0xd8, 0x4a, 0x8b, 0xf9, 0x65, 0xec, 0x41, 0x65, 0x2c, 0xf7,
+	0x71, 0x9d, 0x25, 0x02, 0xfc, 0xab, 0x06, 0x6b, 0x4f, 0x68, 0xc0, 0x9f, 0xad, 0xc2, 0xe5, 0x95,
+	0xa8, 0x97, 0xbd, 0xf6, 0xbd, 0x28, 0x3c, 0xb1, 0xe6, 0x3a, 0xcc, 0x0b, 0xeb, 0x4e, 0x67, 0x1e,
+	0xd7, 0x19, 0x78, 0xd6, 0x4c, 0x94, 0x5d, 0x95, 0x88, 0x35, 0xf7, 0x18, 0xd8, 0x23, 0xd7, 0x64,
+	0x53, 0x9f, 0x8a, 0xda, 0xab, 0x92, 0x44, 0x70, 0x49, 0x01, 0x5e, 0x85, 0x12, 0x2f, 0xf2, 0xb8,
+	0xfa, 0xc2, 0x1d, 0xe7, 0x02, 0x8b, 0x3a, 0xf6, 0x5b, 0xea, 0x0b, 0xbb, 0xb2, 0xe4, 0x02, 0x45,
+	0x84, 0x7f, 0xd2, 0x78, 0x0f, 0x70, 0xad, 0x30, 0x1a, 0xa5, 0x41, 0x31, 0x2f, 0x0a, 0x8a, 0x79,
+	0xdc, 0x01, 0xf3, 0xda, 0xfc, 0x93, 0xe5, 0xc8, 0x12, 0xee, 0xb8, 0x03, 0xe6, 0xf5, 0xe2, 0xcf,
+	0x2e, 0x88, 0x43, 0x55, 0xc4, 0x6f, 0x9c, 0xa7, 0xa0, 0x9d, 0x84, 0x1b, 0xef, 0xd1, 0x2d, 0xd8,
+	0xe0, 0xeb, 0x5e, 0x26, 0xec, 0xb4, 0x90, 0x4f, 0x2a, 0xa9, 0x2f, 0x4c, 0x0f, 0x10, 0x32, 0xe9,
+	0xa9, 0xf4, 0xe8, 0x99, 0xf4, 0xe0, 0xdf, 0x34, 0x68, 0x70, 0x8e, 0x39, 0x71, 0x07, 0xde, 0x45,
+	0x88, 0xf3, 0x1e, 0xbc, 0xb7, 0x07, 0x15, 0x33, 0x43, 0x7b, 0x89, 0x00, 0x1d, 0x43, 0x29, 0x60,
+	0x26, 0x9b, 0x06, 0x22, 0xcc, 0xcd, 0xa3, 0x3b, 0x69, 0x7e, 0xcb, 0xf3, 0xdd, 0xea, 0x09, 0x03,
+	0x12, 0x1a, 0xe2, 0xdb, 0x50, 0x92, 0x12, 0xb4, 0x06, 0x85, 0xe3, 0xd3, 0xd3, 0xda, 0x0a, 0x2a,
+	0x43, 0x91, 0x74, 0x8f, 0x3b, 0x35, 0x8d, 0x3f, 0xcb, 0xb3, 0xa7, 0x62, 0xad, 0x63, 0x0b, 0x0c,
+	0x8e, 0xd9, 0xa3, 0x2e, 0xfb, 0xff, 0x22, 0xc2, 0x6d, 0xd8, 0xe2, 0x5e, 0x12, 0x78, 0x9e, 0xf9,
+	0x8f, 0xa0, 0x3c, 0x0e, 0x05, 0x21, 0x91, 0x6f, 0x25, 0x81, 0x46, 0x77, 0x14, 0xab, 0xe0, 0x3b,
+	0xb0, 0x4b, 0x38, 0x39, 0x2b, 0xd1, 0xcf, 0x0f, 0x41, 0xe2, 0x0e, 0xf1, 0x5d, 0xd8, 0x99, 0x57,
+	0xe5, 0x2e, 0x93, 0xea, 0xd6, 0xd4, 0xea, 0xc6, 0x07, 0x50, 0x97, 0x74, 0x74, 0x09, 0x70, 0x1d,
+	0x50, 0x46, 0x6f, 0xe2, 0xcc, 0x8e, 0xfe, 0x00, 0x28, 0x1c, 0x3f, 0x3f, 0x41, 0x0f, 0xa1, 0x12,
+	0x4f, 0x18, 0xc8, 0xc8, 0x1d, 0x29, 0x05, 0xac, 0xb1, 0x70, 0x1e, 0xc6, 0x2b, 0xe8, 0x04, 0xd6,
+	0x95, 0x69, 0x1a, 0xed, 0x2d, 0xfb, 0x35, 0x60, 0x18, 0x0b, 0x4e, 0x25, 0xd4, 0x33, 0xd8, 0x48,
+	0x0d, 0x65, 0x68, 0x7f, 0xf9, 0x38, 0x68, 0xec, 0x2d, 0x3c, 0x97, 0x80, 0x67, 0x62, 0xec, 0x50,
+	0x47, 0x28, 0xd4, 0x5c, 0x32, 0x5d, 0x49, 0xd0, 0xfd, 0xe5, 0xf3, 0x17, 0x5e, 0xe1, 0x33, 0x5f,
+	0x34, 0xba, 0xa0, 0x6b, 0x29, 0x6d, 0x75, 0xc2, 0x31, 0x76, 0xf3, 0x8e, 0x52, 0x49, 0x8b, 0x87,
+	0xf0, 0x05, 0x03, 0x41, 0x6e, 0xd2, 0xd4, 0x71, 0x01, 0xaf, 0xa0, 0x47, 0x00, 0x49, 0x3b, 0x45,
+	0xd7, 0x13, 0xdd, 0xb9, 0xce, 0x6b, 0x5c, 0xcb, 0x3f, 0x8c, 0x71, 0x92, 0x0e, 0xa7, 0xe2, 0xcc,
+	0x75, 0x51, 0xe3, 0x5a, 0xfe, 0xa1, 0xc4, 0x21, 0xb0, 0x99, 0xee, 0x65, 0xe8, 0xa6, 0xea, 0x36,
+	0xa7, 0x99, 0x19, 0x37, 0x16, 0x2b, 0x48, 0xcc, 0x97, 0x50, 0xcb, 0xf6, 0x35, 0xa4, 0x0c, 0x51,
+	0x0b, 0x9a, 0xa4, 0x71, 0x73, 0x99, 0x8a, 0x44, 0x3e, 0x85, 0xaa, 0xda, 0x01, 0xd1, 0x8d, 0x54,
+	0x45, 0x65, 0x1b, 0xa6, 0x71, 0x7d, 0xd1, 0x71, 0x7c, 0xad, 0x0a, 0x5f, 0xa3, 0x54, 0x79, 0x66,
+	0x1b, 0x8d, 0x61, 0x2c, 0x38, 0x95, 0x50, 0x5f, 0x49, 0x06, 0x4a, 0x71, 0x27, 0xc2, 0x97, 0x13,
+	0xab, 0x71, 0x3d, 0xad, 0x93, 0xa2, 0x30, 0xbc, 0x82, 0xbe, 0x86, 0xed, 0x1c, 0xfe, 0x44, 0xb7,
+	0xd2, 0x56, 0xf9, 0xf4, 0x7a, 0x19, 0xf6, 0x4b, 0xa8, 0x65, 0x69, 0x4c, 0xbd, 0xa6, 0x05, 0x6c,
+	0x68, 0xdc, 0x5c, 0xa6, 0x22, 0x91, 0xfb, 0x11, 0x8f, 0xa5, 0xb0, 0xf7, 0xb3, 0x75, 0x98, 0x01,
+	0xde, 0x5b, 0x78, 0x1e, 0xe5, 0x38, 0x64, 0xd1, 0x74, 0xb8, 0xff, 0x15, 0xb7, 0x7d, 0x04, 0x3b,
+	0xb6, 0xd7, 0x62, 0xf4, 0x82, 0xd9, 0x0e, 0x95, 0xba, 0xaf, 0x46, 0xfe, 0x64, 0xd8, 0xae, 0xf6,
+	0xa5, 0x8c, 0xbf, 0x97, 0xe0, 0xb9, 0xf6, 0xb3, 0x5e, 0xee, 0xf7, 0x5f, 0x9d, 0xf5, 0xba, 0xa4,
+	0x37, 0x28, 0x89, 0xff, 0x5e, 0x3e, 0xf9, 0x7b, 0x00, 0xf5, 0x12, 0x91, 0xbc, 0x8a, 0x11, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -882,6 +1870,14 @@ const _ = grpc.SupportPackageIsVersion4
 type APIClient interface {
 	GetThread(ctx context.Context, in *GetThreadRequest, opts ...grpc.CallOption) (*GetThreadReply, error)
 	ListThreads(ctx context.Context, in *ListThreadsRequest, opts ...grpc.CallOption) (*ListThreadsReply, error)
+	SetThreadTags(ctx context.Context, in *SetThreadTagsRequest, opts ...grpc.CallOption) (*SetThreadTagsReply, error)
+	GetThreadLimits(ctx context.Context, in *GetThreadLimitsRequest, opts ...grpc.CallOption) (*GetThreadLimitsReply, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageReply, error)
+	ListBuckets(ctx context.Context, in *ListBucketsRequest, opts ...grpc.CallOption) (*ListBucketsReply, error)
+	RenewToken(ctx context.Context, in *RenewTokenRequest, opts ...grpc.CallOption) (*RenewTokenReply, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserReply, error)
+	RegisterDevice(ctx context.Context, in *RegisterDeviceRequest, opts ...grpc.CallOption) (*RegisterDeviceReply, error)
+	UnregisterDevice(ctx context.Context, in *UnregisterDeviceRequest, opts ...grpc.CallOption) (*UnregisterDeviceReply, error)
 	SetupMailbox(ctx context.Context, in *SetupMailboxRequest, opts ...grpc.CallOption) (*SetupMailboxReply, error)
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageReply, error)
 	ListInboxMessages(ctx context.Context, in *ListInboxMessagesRequest, opts ...grpc.CallOption) (*ListMessagesReply, error)
@@ -917,6 +1913,78 @@ func (c *aPIClient) ListThreads(ctx context.Context, in *ListThreadsRequest, opt
 	return out, nil
 }
 
+func (c *aPIClient) SetThreadTags(ctx context.Context, in *SetThreadTagsRequest, opts ...grpc.CallOption) (*SetThreadTagsReply, error) {
+	out := new(SetThreadTagsReply)
+	err := c.cc.Invoke(ctx, "/users.pb.API/SetThreadTags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetThreadLimits(ctx context.Context, in *GetThreadLimitsRequest, opts ...grpc.CallOption) (*GetThreadLimitsReply, error) {
+	out := new(GetThreadLimitsReply)
+	err := c.cc.Invoke(ctx, "/users.pb.API/GetThreadLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageReply, error) {
+	out := new(GetUsageReply)
+	err := c.cc.Invoke(ctx, "/users.pb.API/GetUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) ListBuckets(ctx context.Context, in *ListBucketsRequest, opts ...grpc.CallOption) (*ListBucketsReply, error) {
+	out := new(ListBucketsReply)
+	err := c.cc.Invoke(ctx, "/users.pb.API/ListBuckets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RenewToken(ctx context.Context, in *RenewTokenRequest, opts ...grpc.CallOption) (*RenewTokenReply, error) {
+	out := new(RenewTokenReply)
+	err := c.cc.Invoke(ctx, "/users.pb.API/RenewToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserReply, error) {
+	out := new(DeleteUserReply)
+	err := c.cc.Invoke(ctx, "/users.pb.API/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) RegisterDevice(ctx context.Context, in *RegisterDeviceRequest, opts ...grpc.CallOption) (*RegisterDeviceReply, error) {
+	out := new(RegisterDeviceReply)
+	err := c.cc.Invoke(ctx, "/users.pb.API/RegisterDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIClient) UnregisterDevice(ctx context.Context, in *UnregisterDeviceRequest, opts ...grpc.CallOption) (*UnregisterDeviceReply, error) {
+	out := new(UnregisterDeviceReply)
+	err := c.cc.Invoke(ctx, "/users.pb.API/UnregisterDevice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aPIClient) SetupMailbox(ctx context.Context, in *SetupMailboxRequest, opts ...grpc.CallOption) (*SetupMailboxReply, error) {
 	out := new(SetupMailboxReply)
 	err := c.cc.Invoke(ctx, "/users.pb.API/SetupMailbox", in, out, opts...)
@@ -984,6 +2052,14 @@ func (c *aPIClient) DeleteSentboxMessage(ctx context.Context, in *DeleteMessageR
 type APIServer interface {
 	GetThread(context.Context, *GetThreadRequest) (*GetThreadReply, error)
 	ListThreads(context.Context, *ListThreadsRequest) (*ListThreadsReply, error)
+	SetThreadTags(context.Context, *SetThreadTagsRequest) (*SetThreadTagsReply, error)
+	GetThreadLimits(context.Context, *GetThreadLimitsRequest) (*GetThreadLimitsReply, error)
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageReply, error)
+	ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsReply, error)
+	RenewToken(context.Context, *RenewTokenRequest) (*RenewTokenReply, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserReply, error)
+	RegisterDevice(context.Context, *RegisterDeviceRequest) (*RegisterDeviceReply, error)
+	UnregisterDevice(context.Context, *UnregisterDeviceRequest) (*UnregisterDeviceReply, error)
 	SetupMailbox(context.Context, *SetupMailboxRequest) (*SetupMailboxReply, error)
 	SendMessage(context.Context, *SendMessageRequest) (*SendMessageReply, error)
 	ListInboxMessages(context.Context, *ListInboxMessagesRequest) (*ListMessagesReply, error)
@@ -1003,6 +2079,30 @@ func (*UnimplementedAPIServer) GetThread(ctx context.Context, req *GetThreadRequ
 func (*UnimplementedAPIServer) ListThreads(ctx context.Context, req *ListThreadsRequest) (*ListThreadsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListThreads not implemented")
 }
+func (*UnimplementedAPIServer) SetThreadTags(ctx context.Context, req *SetThreadTagsRequest) (*SetThreadTagsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetThreadTags not implemented")
+}
+func (*UnimplementedAPIServer) GetThreadLimits(ctx context.Context, req *GetThreadLimitsRequest) (*GetThreadLimitsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetThreadLimits not implemented")
+}
+func (*UnimplementedAPIServer) GetUsage(ctx context.Context, req *GetUsageRequest) (*GetUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (*UnimplementedAPIServer) ListBuckets(ctx context.Context, req *ListBucketsRequest) (*ListBucketsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBuckets not implemented")
+}
+func (*UnimplementedAPIServer) RenewToken(ctx context.Context, req *RenewTokenRequest) (*RenewTokenReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenewToken not implemented")
+}
+func (*UnimplementedAPIServer) DeleteUser(ctx context.Context, req *DeleteUserRequest) (*DeleteUserReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (*UnimplementedAPIServer) RegisterDevice(ctx context.Context, req *RegisterDeviceRequest) (*RegisterDeviceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterDevice not implemented")
+}
+func (*UnimplementedAPIServer) UnregisterDevice(ctx context.Context, req *UnregisterDeviceRequest) (*UnregisterDeviceReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnregisterDevice not implemented")
+}
 func (*UnimplementedAPIServer) SetupMailbox(ctx context.Context, req *SetupMailboxRequest) (*SetupMailboxReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetupMailbox not implemented")
 }
@@ -1065,6 +2165,150 @@ func _API_ListThreads_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _API_SetThreadTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetThreadTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).SetThreadTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.pb.API/SetThreadTags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).SetThreadTags(ctx, req.(*SetThreadTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetThreadLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetThreadLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetThreadLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.pb.API/GetThreadLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetThreadLimits(ctx, req.(*GetThreadLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.pb.API/GetUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_ListBuckets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBucketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).ListBuckets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.pb.API/ListBuckets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).ListBuckets(ctx, req.(*ListBucketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RenewToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenewTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RenewToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.pb.API/RenewToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RenewToken(ctx, req.(*RenewTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.pb.API/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_RegisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).RegisterDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.pb.API/RegisterDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).RegisterDevice(ctx, req.(*RegisterDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _API_UnregisterDevice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnregisterDeviceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServer).UnregisterDevice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/users.pb.API/UnregisterDevice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServer).UnregisterDevice(ctx, req.(*UnregisterDeviceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _API_SetupMailbox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetupMailboxRequest)
 	if err := dec(in); err != nil {
@@ -1203,6 +2447,38 @@ var _API_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListThreads",
 			Handler:    _API_ListThreads_Handler,
 		},
+		{
+			MethodName: "SetThreadTags",
+			Handler:    _API_SetThreadTags_Handler,
+		},
+		{
+			MethodName: "GetThreadLimits",
+			Handler:    _API_GetThreadLimits_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _API_GetUsage_Handler,
+		},
+		{
+			MethodName: "ListBuckets",
+			Handler:    _API_ListBuckets_Handler,
+		},
+		{
+			MethodName: "RenewToken",
+			Handler:    _API_RenewToken_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _API_DeleteUser_Handler,
+		},
+		{
+			MethodName: "RegisterDevice",
+			Handler:    _API_RegisterDevice_Handler,
+		},
+		{
+			MethodName: "UnregisterDevice",
+			Handler:    _API_UnregisterDevice_Handler,
+		},
 		{
 			MethodName: "SetupMailbox",
 			Handler:    _API_SetupMailbox_Handler,
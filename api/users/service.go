@@ -6,17 +6,27 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	logging "github.com/ipfs/go-log"
+	iface "github.com/ipfs/interface-go-ipfs-core"
+	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/libp2p/go-libp2p-core/crypto"
 	ulid "github.com/oklog/ulid/v2"
+	threads "github.com/textileio/go-threads/api/client"
 	coredb "github.com/textileio/go-threads/core/db"
+	net "github.com/textileio/go-threads/core/net"
 	"github.com/textileio/go-threads/core/thread"
 	"github.com/textileio/go-threads/db"
+	netclient "github.com/textileio/go-threads/net/api/client"
 	pb "github.com/textileio/textile/api/users/pb"
+	"github.com/textileio/textile/buckets"
+	"github.com/textileio/textile/ipns"
 	"github.com/textileio/textile/mail"
 	mdb "github.com/textileio/textile/mongodb"
+	"github.com/textileio/textile/notify"
 	tdb "github.com/textileio/textile/threaddb"
 	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc/codes"
@@ -26,8 +36,16 @@ import (
 var log = logging.Logger("usersapi")
 
 type Service struct {
-	Collections *mdb.Collections
-	Mail        *tdb.Mail
+	Collections              *mdb.Collections
+	Threads                  *threads.Client
+	Mail                     *tdb.Mail
+	ThreadsNet               *netclient.Client
+	TokenIssuer              crypto.PrivKey
+	IPFSClient               iface.CoreAPI
+	IPNSManager              *ipns.Manager
+	Notifications            *notify.Dispatcher
+	ThreadsMaxNumberPerOwner int
+	ThreadsMaxNumberPerKey   int
 }
 
 func (s *Service) GetThread(ctx context.Context, req *pb.GetThreadRequest) (*pb.GetThreadReply, error) {
@@ -44,14 +62,26 @@ func (s *Service) GetThread(ctx context.Context, req *pb.GetThreadRequest) (*pb.
 		}
 		return nil, err
 	}
+	tags, err := s.Collections.Tags.GetMap(ctx, mdb.ThreadResource, thrd.ID.String())
+	if err != nil {
+		return nil, err
+	}
+	token, _ := thread.TokenFromContext(ctx)
+	count, err := s.countBuckets(ctx, *thrd, token)
+	if err != nil {
+		return nil, err
+	}
 	return &pb.GetThreadReply{
-		ID:   thrd.ID.Bytes(),
-		Name: thrd.Name,
-		IsDB: thrd.IsDB,
+		ID:         thrd.ID.Bytes(),
+		Name:       thrd.Name,
+		IsDB:       thrd.IsDB,
+		Tags:       tags,
+		HasBuckets: count > 0,
+		CreatedAt:  thrd.CreatedAt.UnixNano(),
 	}, nil
 }
 
-func (s *Service) ListThreads(ctx context.Context, _ *pb.ListThreadsRequest) (*pb.ListThreadsReply, error) {
+func (s *Service) ListThreads(ctx context.Context, req *pb.ListThreadsRequest) (*pb.ListThreadsReply, error) {
 	log.Debugf("received list threads request")
 
 	user, ok := mdb.UserFromContext(ctx)
@@ -62,19 +92,507 @@ func (s *Service) ListThreads(ctx context.Context, _ *pb.ListThreadsRequest) (*p
 	if err != nil {
 		return nil, err
 	}
-	reply := &pb.ListThreadsReply{
-		List: make([]*pb.GetThreadReply, len(list)),
+	tagged, err := s.Collections.Tags.ListByOwner(ctx, mdb.ThreadResource, user.Key, nil)
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]map[string]string)
+	for _, t := range tagged {
+		tags[t.ID] = t.Tags
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if req.Descending {
+			i, j = j, i
+		}
+		if list[i].CreatedAt.Equal(list[j].CreatedAt) {
+			return list[i].ID.String() < list[j].ID.String()
+		}
+		return list[i].CreatedAt.Before(list[j].CreatedAt)
+	})
+	var seek thread.ID
+	if len(req.Seek) > 0 {
+		seek, err = thread.Cast(req.Seek)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "Invalid seek thread ID")
+		}
+	}
+	token, _ := thread.TokenFromContext(ctx)
+	reply := &pb.ListThreadsReply{}
+	for _, t := range list {
+		if seek.Defined() {
+			// Threads are listed after the seek thread
+			if t.ID.Equals(seek) {
+				seek = thread.Undef
+			}
+			continue
+		}
+		if req.Limit > 0 && int64(len(reply.List)) >= req.Limit {
+			break
+		}
+		ttags := tags[t.ID.String()]
+		if !mdb.MatchTags(ttags, req.Tags) {
+			continue
+		}
+		count, err := s.countBuckets(ctx, t, token)
+		if err != nil {
+			return nil, err
+		}
+		reply.List = append(reply.List, &pb.GetThreadReply{
+			ID:         t.ID.Bytes(),
+			Name:       t.Name,
+			IsDB:       t.IsDB,
+			Tags:       ttags,
+			HasBuckets: count > 0,
+			CreatedAt:  t.CreatedAt.UnixNano(),
+		})
+	}
+	return reply, nil
+}
+
+func (s *Service) SetThreadTags(ctx context.Context, req *pb.SetThreadTagsRequest) (*pb.SetThreadTagsReply, error) {
+	log.Debugf("received set thread tags request")
+
+	owner := ownerFromContext(ctx)
+	if owner == nil {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	id, err := thread.Cast(req.ID)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, err := s.Collections.Threads.Get(ctx, id, owner); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Thread not found")
+		}
+		return nil, err
+	}
+	if err := mdb.ValidateTags(req.Tags); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.Collections.Tags.Set(ctx, mdb.ThreadResource, id.String(), owner, req.Tags); err != nil {
+		return nil, err
+	}
+	return &pb.SetThreadTagsReply{}, nil
+}
+
+func (s *Service) GetThreadLimits(ctx context.Context, _ *pb.GetThreadLimitsRequest) (*pb.GetThreadLimitsReply, error) {
+	log.Debugf("received get thread limits request")
+
+	owner := ownerFromContext(ctx)
+	if owner == nil {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	owned, err := s.Collections.Threads.ListByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.GetThreadLimitsReply{
+		PerOwner: threadLimit(len(owned), s.ThreadsMaxNumberPerOwner),
+		PerKey:   threadLimit(0, s.ThreadsMaxNumberPerKey),
+	}
+	if key, ok := mdb.APIKeyFromContext(ctx); ok {
+		created, err := s.Collections.Threads.ListByKey(ctx, key.Key)
+		if err != nil {
+			return nil, err
+		}
+		reply.PerKey = threadLimit(len(created), s.ThreadsMaxNumberPerKey)
+	}
+	return reply, nil
+}
+
+// GetUsage returns the stored bytes, bucket count, and thread count of the calling user.
+// If the request is made with an API key, only threads created with the key are counted.
+func (s *Service) GetUsage(ctx context.Context, _ *pb.GetUsageRequest) (*pb.GetUsageReply, error) {
+	log.Debugf("received get usage request")
+
+	user, ok := mdb.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	// The user in the context may be stale if buckets were changed since the request was authorized
+	current, err := s.Collections.Users.Get(ctx, user.Key)
+	if err != nil {
+		return nil, err
+	}
+	owned, err := s.Collections.Threads.ListByOwner(ctx, user.Key)
+	if err != nil {
+		return nil, err
 	}
-	for i, t := range list {
-		reply.List[i] = &pb.GetThreadReply{
-			ID:   t.ID.Bytes(),
-			Name: t.Name,
-			IsDB: t.IsDB,
+	key, hasKey := mdb.APIKeyFromContext(ctx)
+	token, _ := thread.TokenFromContext(ctx)
+	reply := &pb.GetUsageReply{StoredSize: current.BucketsTotalSize}
+	for _, t := range owned {
+		if hasKey && t.Key != key.Key {
+			continue
 		}
+		reply.ThreadCount++
+		count, err := s.countBuckets(ctx, t, token)
+		if err != nil {
+			return nil, err
+		}
+		reply.BucketCount += int64(count)
 	}
 	return reply, nil
 }
 
+// ListBuckets returns the buckets in all of the threads owned by the caller, ordered by creation time.
+// If the request is made with an API key, only threads created with a key of the key's account are included.
+func (s *Service) ListBuckets(ctx context.Context, req *pb.ListBucketsRequest) (*pb.ListBucketsReply, error) {
+	log.Debugf("received list buckets request")
+
+	owner := ownerFromContext(ctx)
+	if owner == nil {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	owned, err := s.Collections.Threads.ListByOwner(ctx, owner)
+	if err != nil {
+		return nil, err
+	}
+	key, hasKey := mdb.APIKeyFromContext(ctx)
+	token, _ := thread.TokenFromContext(ctx)
+	accountKeys := make(map[string]bool)
+	var all []*pb.ListBucketsReply_Bucket
+	for _, t := range owned {
+		if hasKey && t.Key != key.Key {
+			ok, checked := accountKeys[t.Key]
+			if !checked {
+				ok, err = s.isAccountKey(ctx, t.Key, key.Owner)
+				if err != nil {
+					return nil, err
+				}
+				accountKeys[t.Key] = ok
+			}
+			if !ok {
+				continue
+			}
+		}
+		list, err := s.listBuckets(ctx, t, token)
+		if err != nil {
+			return nil, err
+		}
+		for _, b := range list {
+			all = append(all, &pb.ListBucketsReply_Bucket{
+				ThreadID:   t.ID.Bytes(),
+				ThreadName: t.Name,
+				Key:        b.Key,
+				Name:       b.Name,
+				Path:       b.Path,
+				CreatedAt:  b.CreatedAt,
+				UpdatedAt:  b.UpdatedAt,
+			})
+		}
+	}
+
+	sort.Slice(all, func(i, j int) bool {
+		if req.Descending {
+			i, j = j, i
+		}
+		if all[i].CreatedAt == all[j].CreatedAt {
+			return all[i].Key < all[j].Key
+		}
+		return all[i].CreatedAt < all[j].CreatedAt
+	})
+	start := 0
+	if req.Seek != "" {
+		// Buckets are listed after the seek bucket
+		start = len(all)
+		for i, b := range all {
+			if b.Key == req.Seek {
+				start = i + 1
+				break
+			}
+		}
+	}
+	end := len(all)
+	if req.Limit > 0 && int64(end-start) > req.Limit {
+		end = start + int(req.Limit)
+	}
+	return &pb.ListBucketsReply{Buckets: all[start:end]}, nil
+}
+
+// isAccountKey returns whether or not the API key was created by account.
+func (s *Service) isAccountKey(ctx context.Context, key string, account crypto.PubKey) (bool, error) {
+	if key == "" {
+		return false, nil
+	}
+	k, err := s.Collections.APIKeys.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return false, nil
+		}
+		return false, err
+	}
+	return k.Owner.Equals(account), nil
+}
+
+// RenewToken re-issues the thread token of the user in the context.
+// The current token must be valid and the request must be made with a user API key.
+func (s *Service) RenewToken(ctx context.Context, _ *pb.RenewTokenRequest) (*pb.RenewTokenReply, error) {
+	log.Debugf("received renew token request")
+
+	user, ok := mdb.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	key, ok := mdb.APIKeyFromContext(ctx)
+	if !ok || key.Type != mdb.UserKey {
+		return nil, status.Error(codes.PermissionDenied, "User API key required")
+	}
+	if s.TokenIssuer == nil {
+		return nil, status.Error(codes.Unimplemented, "Token renewal is not enabled")
+	}
+	// The auth interceptor doesn't verify the token signature, so verify it here before re-issuing
+	token, _ := thread.TokenFromContext(ctx)
+	pk, err := token.Validate(s.TokenIssuer)
+	if err != nil || pk == nil {
+		return nil, status.Error(codes.Unauthenticated, "Invalid token")
+	}
+	if pk.String() != thread.NewLibp2pPubKey(user.Key).String() {
+		return nil, status.Error(codes.PermissionDenied, "Token does not belong to user")
+	}
+	renewed, err := thread.NewToken(s.TokenIssuer, pk)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RenewTokenReply{Token: string(renewed)}, nil
+}
+
+// DeleteUser deletes a user and revokes all of the user's thread tokens.
+// Users can delete themselves with a user API key and token.
+// Accounts can delete a user by public key if the user created threads with one of the account's API keys.
+// The user's threads and buckets are deleted, unless the TRANSFER data policy is used,
+// in which case they are transferred to the account that owns the API key.
+func (s *Service) DeleteUser(ctx context.Context, req *pb.DeleteUserRequest) (*pb.DeleteUserReply, error) {
+	log.Debugf("received delete user request")
+
+	if s.TokenIssuer == nil {
+		return nil, status.Error(codes.Unimplemented, "User deletion is not enabled")
+	}
+	user, parent, err := s.userToDelete(ctx, req.Key)
+	if err != nil {
+		return nil, err
+	}
+	owned, err := s.Collections.Threads.ListByOwner(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Data == pb.DeleteUserRequest_TRANSFER {
+		for _, t := range owned {
+			tags, err := s.Collections.Tags.GetMap(ctx, mdb.ThreadResource, t.ID.String())
+			if err != nil {
+				return nil, err
+			}
+			if err = s.Collections.Threads.Transfer(ctx, t.ID, user, parent); err != nil {
+				return nil, err
+			}
+			if len(tags) > 0 {
+				if err = s.Collections.Tags.Set(ctx, mdb.ThreadResource, t.ID.String(), parent, tags); err != nil {
+					return nil, err
+				}
+			}
+		}
+	} else {
+		// The user may not be the caller, so issue a token that can manage the user's threads
+		token, err := thread.NewToken(s.TokenIssuer, thread.NewLibp2pPubKey(user))
+		if err != nil {
+			return nil, err
+		}
+		if err = s.deleteThreads(ctx, owned, token); err != nil {
+			return nil, err
+		}
+		if err = s.Collections.Threads.DeleteByOwner(ctx, user); err != nil {
+			return nil, err
+		}
+		if err = s.Collections.Tags.DeleteByOwner(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = s.Collections.Devices.DeleteByOwner(ctx, user); err != nil {
+		return nil, err
+	}
+	if err = s.Collections.Users.Delete(ctx, user); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+	if err = s.Collections.RevokedTokens.Revoke(ctx, user); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteUserReply{}, nil
+}
+
+// userToDelete returns the key of the user to delete and the key of the user's parent account.
+// Users can only delete themselves.
+// Accounts can only delete users that created threads with one of the account's API keys.
+func (s *Service) userToDelete(ctx context.Context, key []byte) (user, parent crypto.PubKey, err error) {
+	apiKey, hasKey := mdb.APIKeyFromContext(ctx)
+	if u, ok := mdb.UserFromContext(ctx); ok {
+		if len(key) > 0 {
+			pk, err := crypto.UnmarshalPublicKey(key)
+			if err != nil || !pk.Equals(u.Key) {
+				return nil, nil, status.Error(codes.PermissionDenied, "Users can only delete themselves")
+			}
+		}
+		if !hasKey {
+			return nil, nil, status.Error(codes.PermissionDenied, "User API key required")
+		}
+		return u.Key, apiKey.Owner, nil
+	}
+
+	parent = ownerFromContext(ctx)
+	if parent == nil {
+		return nil, nil, status.Error(codes.NotFound, "User not found")
+	}
+	if len(key) == 0 {
+		return nil, nil, status.Error(codes.InvalidArgument, "User key required")
+	}
+	user, err = crypto.UnmarshalPublicKey(key)
+	if err != nil {
+		return nil, nil, status.Error(codes.InvalidArgument, "Invalid user key")
+	}
+	owned, err := s.Collections.Threads.ListByOwner(ctx, user)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, t := range owned {
+		ok, err := s.isAccountKey(ctx, t.Key, parent)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			return user, parent, nil
+		}
+	}
+	return nil, nil, status.Error(codes.NotFound, "User not found")
+}
+
+// deleteThreads deletes threads and cleans up the buckets in them.
+// Nothing is deleted if any of the buckets is under legal hold.
+func (s *Service) deleteThreads(ctx context.Context, ts []mdb.Thread, token thread.Token) error {
+	bucks := make(map[thread.ID][]*tdb.Bucket)
+	for _, t := range ts {
+		list, err := s.listBuckets(ctx, t, token)
+		if err != nil {
+			return err
+		}
+		bucks[t.ID] = list
+		for _, b := range bucks[t.ID] {
+			held, err := s.Collections.LegalHolds.IsHeld(ctx, b.Key)
+			if err != nil {
+				return err
+			}
+			if held {
+				return status.Errorf(codes.FailedPrecondition, "Bucket %s is under legal hold", b.Key)
+			}
+		}
+	}
+
+	for _, t := range ts {
+		if t.IsDB {
+			for _, b := range bucks[t.ID] {
+				if err := s.deleteBucketData(ctx, b); err != nil {
+					return err
+				}
+			}
+			if err := s.Threads.DeleteDB(ctx, t.ID, db.WithManagedToken(token)); err != nil {
+				return err
+			}
+		} else {
+			if err := s.ThreadsNet.DeleteThread(ctx, t.ID, net.WithThreadToken(token)); err != nil {
+				return err
+			}
+		}
+		if err := s.Collections.Tags.Delete(ctx, mdb.ThreadResource, t.ID.String()); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteBucketData cleans up the pins, keys, and tracked objects of bucket b.
+func (s *Service) deleteBucketData(ctx context.Context, b *tdb.Bucket) error {
+	if err := s.IPFSClient.Pin().Rm(ctx, path.New(b.Path)); err != nil {
+		return err
+	}
+	if err := s.IPNSManager.RemoveKey(ctx, b.Key); err != nil {
+		return err
+	}
+	if err := s.Collections.Tags.Delete(ctx, mdb.BucketResource, b.Key); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+	if err := s.Collections.WebConfigs.Delete(ctx, b.Key); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+		return err
+	}
+	if err := s.Collections.BucketVersions.DeleteByBucket(ctx, b.Key); err != nil {
+		return err
+	}
+	snapshots, err := s.Collections.BucketSnapshots.List(ctx, b.Key)
+	if err != nil {
+		return err
+	}
+	for _, snapshot := range snapshots {
+		if err := s.IPFSClient.Pin().Rm(ctx, path.New(snapshot.PinPath)); err != nil {
+			return err
+		}
+	}
+	if err := s.Collections.BucketSnapshots.DeleteByBucket(ctx, b.Key); err != nil {
+		return err
+	}
+	return s.Collections.BucketLicenses.DeleteByBucket(ctx, b.Key)
+}
+
+// countBuckets returns the number of buckets in thread t.
+func (s *Service) countBuckets(ctx context.Context, t mdb.Thread, token thread.Token) (int, error) {
+	list, err := s.listBuckets(ctx, t, token)
+	return len(list), err
+}
+
+// listBuckets returns the buckets in thread t.
+func (s *Service) listBuckets(ctx context.Context, t mdb.Thread, token thread.Token) ([]*tdb.Bucket, error) {
+	if !t.IsDB || s.Threads == nil {
+		return nil, nil
+	}
+	res, err := s.Threads.Find(ctx, t.ID, buckets.CollectionName, &db.Query{}, &tdb.Bucket{}, db.WithTxnToken(token))
+	if err != nil {
+		// Threads without buckets, e.g., mailboxes, don't have a buckets collection
+		if strings.Contains(err.Error(), "collection not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return res.([]*tdb.Bucket), nil
+}
+
+func threadLimit(current, max int) *pb.GetThreadLimitsReply_Limit {
+	remaining := int64(-1)
+	if max > 0 {
+		remaining = int64(max - current)
+		if remaining < 0 {
+			remaining = 0
+		}
+	}
+	return &pb.GetThreadLimitsReply_Limit{
+		Current:   int64(current),
+		Max:       int64(max),
+		Remaining: remaining,
+	}
+}
+
+// ownerFromContext returns the owner of threads for the context,
+// which is either an org, dev, or user.
+func ownerFromContext(ctx context.Context) crypto.PubKey {
+	if org, ok := mdb.OrgFromContext(ctx); ok {
+		return org.Key
+	}
+	if dev, ok := mdb.DevFromContext(ctx); ok {
+		return dev.Key
+	}
+	if user, ok := mdb.UserFromContext(ctx); ok {
+		return user.Key
+	}
+	return nil
+}
+
 const (
 	defaultMessagePageSize = 100
 	maxMessagePageSize     = 10000
@@ -139,33 +657,79 @@ func (s *Service) SendMessage(ctx context.Context, req *pb.SendMessageRequest) (
 	now := time.Now().UnixNano()
 	from := thread.NewLibp2pPubKey(user.Key)
 	toMsg := tdb.InboxMessage{
-		ID:        msgID,
-		From:      from.String(),
-		To:        to.String(),
-		Body:      base64.StdEncoding.EncodeToString(req.ToBody),
-		Signature: base64.StdEncoding.EncodeToString(req.ToSignature),
-		CreatedAt: now,
+		ID:          msgID,
+		From:        from.String(),
+		To:          to.String(),
+		Body:        base64.StdEncoding.EncodeToString(req.ToBody),
+		Signature:   base64.StdEncoding.EncodeToString(req.ToSignature),
+		CreatedAt:   now,
+		DeliveredAt: now,
 	}
 	if _, err := s.Mail.Inbox.Create(ctx, inbox, toMsg, tdb.WithToken(dbToken)); err != nil {
 		return nil, err
 	}
 	fromMsg := tdb.SentboxMessage{
-		ID:        msgID,
-		From:      from.String(),
-		To:        to.String(),
-		Body:      base64.StdEncoding.EncodeToString(req.FromBody),
-		Signature: base64.StdEncoding.EncodeToString(req.FromSignature),
-		CreatedAt: now,
+		ID:          msgID,
+		From:        from.String(),
+		To:          to.String(),
+		Body:        base64.StdEncoding.EncodeToString(req.FromBody),
+		Signature:   base64.StdEncoding.EncodeToString(req.FromSignature),
+		CreatedAt:   now,
+		DeliveredAt: now, // The inbox message was created above
 	}
 	if _, err := s.Mail.Sentbox.Create(ctx, sentbox, fromMsg, tdb.WithToken(dbToken)); err != nil {
 		return nil, err
 	}
+	// Message bodies are encrypted for the recipient, so the notification only says who it's from
+	s.Notifications.NotifyUser(to.PubKey, notify.Notification{
+		Event: notify.MessageReceived,
+		Title: "New message",
+		Data:  map[string]string{"id": msgID, "from": from.String()},
+	})
 	return &pb.SendMessageReply{
 		ID:        msgID,
 		CreatedAt: now,
 	}, nil
 }
 
+// RegisterDevice registers a device token that receives push notifications for the user in the context,
+// e.g., when a message is delivered to the user's inbox.
+func (s *Service) RegisterDevice(ctx context.Context, req *pb.RegisterDeviceRequest) (*pb.RegisterDeviceReply, error) {
+	log.Debugf("received register device request")
+
+	user, ok := mdb.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	if req.Token == "" {
+		return nil, status.Error(codes.InvalidArgument, "Device token required")
+	}
+	if !notify.ValidPlatform(req.Platform) {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid device platform: %s", req.Platform)
+	}
+	if _, err := s.Collections.Devices.Register(ctx, req.Token, req.Platform, user.Key); err != nil {
+		return nil, err
+	}
+	return &pb.RegisterDeviceReply{}, nil
+}
+
+// UnregisterDevice stops push notifications to a device token of the user in the context.
+func (s *Service) UnregisterDevice(ctx context.Context, req *pb.UnregisterDeviceRequest) (*pb.UnregisterDeviceReply, error) {
+	log.Debugf("received unregister device request")
+
+	user, ok := mdb.UserFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.NotFound, "User not found")
+	}
+	if err := s.Collections.Devices.Delete(ctx, req.Token, user.Key); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, status.Error(codes.NotFound, "Device not found")
+		}
+		return nil, err
+	}
+	return &pb.UnregisterDeviceReply{}, nil
+}
+
 func (s *Service) ListInboxMessages(ctx context.Context, req *pb.ListInboxMessagesRequest) (*pb.ListMessagesReply, error) {
 	log.Debugf("received list inbox messages request")
 
@@ -240,13 +804,14 @@ func inboxMessageToPb(m *tdb.InboxMessage) (*pb.Message, error) {
 		return nil, err
 	}
 	return &pb.Message{
-		ID:        m.ID,
-		From:      m.From,
-		To:        m.To,
-		Body:      body,
-		Signature: sig,
-		CreatedAt: m.CreatedAt,
-		ReadAt:    m.ReadAt,
+		ID:          m.ID,
+		From:        m.From,
+		To:          m.To,
+		Body:        body,
+		Signature:   sig,
+		CreatedAt:   m.CreatedAt,
+		DeliveredAt: m.DeliveredAt,
+		ReadAt:      m.ReadAt,
 	}, nil
 }
 
@@ -260,12 +825,14 @@ func sentboxMessageToPb(m *tdb.SentboxMessage) (*pb.Message, error) {
 		return nil, err
 	}
 	return &pb.Message{
-		ID:        m.ID,
-		From:      m.From,
-		To:        m.To,
-		Body:      body,
-		Signature: sig,
-		CreatedAt: m.CreatedAt,
+		ID:          m.ID,
+		From:        m.From,
+		To:          m.To,
+		Body:        body,
+		Signature:   sig,
+		CreatedAt:   m.CreatedAt,
+		DeliveredAt: m.DeliveredAt,
+		ReadAt:      m.ReadAt,
 	}, nil
 }
 
@@ -323,11 +890,33 @@ func (s *Service) ReadInboxMessage(ctx context.Context, req *pb.ReadInboxMessage
 	if err := s.Mail.Inbox.Save(ctx, box, msg, tdb.WithToken(dbToken)); err != nil {
 		return nil, err
 	}
+	// The read receipt is best-effort, e.g., the sender may have deleted the message or mailbox
+	if err := s.saveReadReceipt(ctx, msg, dbToken); err != nil {
+		log.Debugf("saving read receipt for message %s: %v", msg.ID, err)
+	}
 	return &pb.ReadInboxMessageReply{
 		ReadAt: msg.ReadAt,
 	}, nil
 }
 
+// saveReadReceipt sets the read time of inbox message msg on the sender's sentbox message.
+func (s *Service) saveReadReceipt(ctx context.Context, msg *tdb.InboxMessage, token thread.Token) error {
+	from := &thread.Libp2pPubKey{}
+	if err := from.UnmarshalString(msg.From); err != nil {
+		return err
+	}
+	box, err := s.getMailbox(ctx, from)
+	if err != nil {
+		return err
+	}
+	sent := &tdb.SentboxMessage{}
+	if err := s.Mail.Sentbox.Get(ctx, box, msg.ID, sent, tdb.WithToken(token)); err != nil {
+		return err
+	}
+	sent.ReadAt = msg.ReadAt
+	return s.Mail.Sentbox.Save(ctx, box, sent, tdb.WithToken(token))
+}
+
 func (s *Service) DeleteInboxMessage(ctx context.Context, req *pb.DeleteMessageRequest) (*pb.DeleteMessageReply, error) {
 	log.Debugf("received delete inbox message request")
 
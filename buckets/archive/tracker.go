@@ -9,10 +9,12 @@ import (
 
 	"github.com/ipfs/go-cid"
 	logger "github.com/ipfs/go-log"
+	"github.com/textileio/go-threads/broadcast"
 	"github.com/textileio/go-threads/core/thread"
 	powc "github.com/textileio/powergate/api/client"
 	"github.com/textileio/powergate/ffs"
 	"github.com/textileio/textile/api/common"
+	"github.com/textileio/textile/buckets/webhooks"
 	mdb "github.com/textileio/textile/mongodb"
 	tdb "github.com/textileio/textile/threaddb"
 )
@@ -38,9 +40,10 @@ type Tracker struct {
 	colls           *mdb.Collections
 	buckets         *tdb.Buckets
 	pgClient        *powc.Client
+	accountEventBus *broadcast.Broadcaster
 }
 
-func New(colls *mdb.Collections, buckets *tdb.Buckets, pgClient *powc.Client, internalSession string) (*Tracker, error) {
+func New(colls *mdb.Collections, buckets *tdb.Buckets, pgClient *powc.Client, internalSession string, accountEventBus *broadcast.Broadcaster) (*Tracker, error) {
 	ctx, cancel := context.WithCancel(context.Background())
 	t := &Tracker{
 		ctx:    ctx,
@@ -51,6 +54,7 @@ func New(colls *mdb.Collections, buckets *tdb.Buckets, pgClient *powc.Client, in
 		colls:           colls,
 		buckets:         buckets,
 		pgClient:        pgClient,
+		accountEventBus: accountEventBus,
 	}
 	go t.run()
 	return t, nil
@@ -94,6 +98,9 @@ func (t *Tracker) run() {
 								cause = err.Error()
 							}
 							log.Infof("tracking archive finalized with cause: %s", cause)
+							if err == nil {
+								t.publishFinished(ctx, a.DbID, a.BucketKey, cause)
+							}
 							if err := t.colls.ArchiveTracking.Finalize(ctx, a.JID, cause); err != nil {
 								log.Errorf("finalizing errored/rescheduled archive tracking: %s", err)
 							}
@@ -119,6 +126,38 @@ func (t *Tracker) Track(ctx context.Context, dbID thread.ID, dbToken thread.Toke
 	return nil
 }
 
+// publishFinished notifies the owner of dbID that the archive of bucketKey reached a final status.
+func (t *Tracker) publishFinished(ctx context.Context, dbID thread.ID, bucketKey, msg string) {
+	if t.accountEventBus == nil {
+		return
+	}
+	owner, err := t.colls.Threads.GetOwner(ctx, dbID)
+	if err != nil {
+		log.Errorf("getting owner of thread %s: %s", dbID, err)
+		return
+	}
+	common.PublishAccountEvent(t.accountEventBus, owner, common.ArchiveFinished, bucketKey, msg)
+}
+
+// publishWebhook queues webhook deliveries for the final status of an archive.
+func (t *Tracker) publishWebhook(ctx context.Context, bucketKey string, dbID thread.ID, bucketRoot cid.Cid, job ffs.Job, msg string) {
+	ev := webhooks.Event{
+		Type:      webhooks.ArchiveFailed,
+		BucketKey: bucketKey,
+		Thread:    dbID.String(),
+		Cid:       bucketRoot.String(),
+		Message:   msg,
+	}
+	if job.Status == ffs.Success {
+		ev.Type = webhooks.ArchiveCompleted
+	} else if job.ErrCause != "" {
+		ev.Message = job.ErrCause
+	}
+	if err := webhooks.Publish(ctx, t.colls, ev); err != nil {
+		log.Errorf("publishing archive webhook of bucket %s: %s", bucketKey, err)
+	}
+}
+
 // trackArchiveProgress queries the current archive status.
 // If a fatal error in tracking happens, it will return an error, which indicates the archive should be untracked.
 // If the archive didn't reach a final status yet, or a possibly recoverable error (by retrying) happens, it will return (true, "retry cause", nil).
@@ -178,6 +217,7 @@ func (t *Tracker) trackArchiveProgress(ctx context.Context, buckKey string, dbID
 	if aborted {
 		msg = "aborted with reason " + abortMsg
 	}
+	t.publishWebhook(ctx, buckKey, dbID, bucketRoot, job, msg)
 
 	return false, msg, nil
 }
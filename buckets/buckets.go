@@ -23,4 +23,22 @@ var (
 	// ErrZeroBalance is returned when archiving a bucket which
 	// underlying FFS instance balance is zero.
 	ErrZeroBalance = errors.New("bucket FIL balance is zero, if recently created wait 30s")
+
+	// ErrLegalHold is returned when deleting or overwriting data in a bucket
+	// that is under legal hold.
+	ErrLegalHold = errors.New("bucket is under legal hold")
 )
+
+// QuotaExceededError is returned when a change to a bucket would exceed its max size.
+type QuotaExceededError struct {
+	// Size is the bucket size that would have resulted from the change.
+	Size int64
+	// MaxSize is the max size of the bucket.
+	MaxSize int64
+	// Remaining is the number of bytes that can still be added to the bucket.
+	Remaining int64
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("bucket size exceeds quota (size %d, max %d, remaining %d)", e.Size, e.MaxSize, e.Remaining)
+}
@@ -0,0 +1,146 @@
+package buckets
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/ipfs/go-cid"
+	cbor "github.com/ipfs/go-ipld-cbor"
+)
+
+const (
+	// CarVersion is the version of the CAR (content addressable archive) files read and written.
+	CarVersion = 1
+
+	// MaxCarSectionSize is the max size of a header or block section in a CAR file.
+	MaxCarSectionSize = 4 << 20
+)
+
+// carHeader is the DAG-CBOR encoded header of a CARv1 file.
+type carHeader struct {
+	Roots   []cid.Cid
+	Version uint64
+}
+
+func init() {
+	cbor.RegisterCborType(carHeader{})
+}
+
+// CarWriter writes blocks to a CARv1 file.
+// Each section of the file is prefixed with its varint encoded length,
+// see https://ipld.io/specs/transport/car/carv1.
+type CarWriter struct {
+	w   io.Writer
+	buf [binary.MaxVarintLen64]byte
+}
+
+// NewCarWriter writes the header of a CAR file with roots to w.
+func NewCarWriter(w io.Writer, roots ...cid.Cid) (*CarWriter, error) {
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("car file requires at least one root")
+	}
+	header, err := cbor.DumpObject(&carHeader{Roots: roots, Version: CarVersion})
+	if err != nil {
+		return nil, fmt.Errorf("encoding car header: %v", err)
+	}
+	cw := &CarWriter{w: w}
+	if err := cw.writeSection(header); err != nil {
+		return nil, err
+	}
+	return cw, nil
+}
+
+// Put writes the block c with data to the CAR file.
+func (cw *CarWriter) Put(c cid.Cid, data []byte) error {
+	return cw.writeSection(c.Bytes(), data)
+}
+
+func (cw *CarWriter) writeSection(parts ...[]byte) error {
+	var size int
+	for _, p := range parts {
+		size += len(p)
+	}
+	n := binary.PutUvarint(cw.buf[:], uint64(size))
+	if _, err := cw.w.Write(cw.buf[:n]); err != nil {
+		return err
+	}
+	for _, p := range parts {
+		if _, err := cw.w.Write(p); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CarReader reads blocks from a CARv1 file.
+type CarReader struct {
+	Roots []cid.Cid
+
+	r *bufio.Reader
+}
+
+// NewCarReader reads the header of a CAR file from r.
+func NewCarReader(r io.Reader) (*CarReader, error) {
+	cr := &CarReader{r: bufio.NewReader(r)}
+	data, err := cr.readSection()
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, fmt.Errorf("reading car header: %v", err)
+	}
+	var header carHeader
+	if err := cbor.DecodeInto(data, &header); err != nil {
+		return nil, fmt.Errorf("decoding car header: %v", err)
+	}
+	if header.Version != CarVersion {
+		return nil, fmt.Errorf("unsupported car version %d", header.Version)
+	}
+	if len(header.Roots) == 0 {
+		return nil, fmt.Errorf("car file has no roots")
+	}
+	cr.Roots = header.Roots
+	return cr, nil
+}
+
+// Next returns the next block of the CAR file.
+// The block data is verified against its cid. Returns io.EOF when there are no more blocks.
+func (cr *CarReader) Next() (cid.Cid, []byte, error) {
+	data, err := cr.readSection()
+	if err != nil {
+		return cid.Undef, nil, err
+	}
+	n, c, err := cid.CidFromBytes(data)
+	if err != nil {
+		return cid.Undef, nil, fmt.Errorf("reading block cid: %v", err)
+	}
+	data = data[n:]
+	sum, err := c.Prefix().Sum(data)
+	if err != nil {
+		return cid.Undef, nil, fmt.Errorf("hashing block %s: %v", c, err)
+	}
+	if !sum.Equals(c) {
+		return cid.Undef, nil, fmt.Errorf("block data does not match cid %s", c)
+	}
+	return c, data, nil
+}
+
+func (cr *CarReader) readSection() ([]byte, error) {
+	size, err := binary.ReadUvarint(cr.r)
+	if err != nil {
+		return nil, err
+	}
+	if size == 0 || size > MaxCarSectionSize {
+		return nil, fmt.Errorf("invalid section size %d", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(cr.r, data); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+	return data, nil
+}
@@ -0,0 +1,96 @@
+package buckets
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCarWriter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no roots", func(t *testing.T) {
+		_, err := NewCarWriter(&bytes.Buffer{})
+		require.Error(t, err)
+	})
+
+	t.Run("blocks", func(t *testing.T) {
+		c, err := cid.Decode("bafkqaaa") // Identity cid of empty raw data
+		require.NoError(t, err)
+		var buf bytes.Buffer
+		cw, err := NewCarWriter(&buf, c)
+		require.NoError(t, err)
+		require.NoError(t, cw.Put(c, []byte("data")))
+
+		// {"roots": [c], "version": 1}
+		header := "a265726f6f747381d82a4500015500006776657273696f6e01"
+		block := "0801550000" + hex.EncodeToString([]byte("data"))
+		assert.Equal(t, "19"+header+block, hex.EncodeToString(buf.Bytes()))
+	})
+}
+
+func TestCarReader(t *testing.T) {
+	t.Parallel()
+
+	c1, err := cid.Prefix{Version: 1, Codec: cid.Raw, MhType: mh.SHA2_256, MhLength: -1}.Sum([]byte("block1"))
+	require.NoError(t, err)
+	c2, err := cid.Prefix{Version: 1, Codec: cid.Raw, MhType: mh.SHA2_256, MhLength: -1}.Sum([]byte("block2"))
+	require.NoError(t, err)
+
+	t.Run("roundtrip", func(t *testing.T) {
+		var buf bytes.Buffer
+		cw, err := NewCarWriter(&buf, c1)
+		require.NoError(t, err)
+		require.NoError(t, cw.Put(c1, []byte("block1")))
+		require.NoError(t, cw.Put(c2, []byte("block2")))
+
+		cr, err := NewCarReader(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, []cid.Cid{c1}, cr.Roots)
+		c, data, err := cr.Next()
+		require.NoError(t, err)
+		assert.True(t, c.Equals(c1))
+		assert.Equal(t, []byte("block1"), data)
+		c, data, err = cr.Next()
+		require.NoError(t, err)
+		assert.True(t, c.Equals(c2))
+		assert.Equal(t, []byte("block2"), data)
+		_, _, err = cr.Next()
+		assert.Equal(t, io.EOF, err)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, err := NewCarReader(&bytes.Buffer{})
+		require.Error(t, err)
+	})
+
+	t.Run("mismatched block", func(t *testing.T) {
+		var buf bytes.Buffer
+		cw, err := NewCarWriter(&buf, c1)
+		require.NoError(t, err)
+		require.NoError(t, cw.Put(c1, []byte("block2")))
+
+		cr, err := NewCarReader(&buf)
+		require.NoError(t, err)
+		_, _, err = cr.Next()
+		require.Error(t, err)
+	})
+
+	t.Run("truncated block", func(t *testing.T) {
+		var buf bytes.Buffer
+		cw, err := NewCarWriter(&buf, c1)
+		require.NoError(t, err)
+		require.NoError(t, cw.Put(c1, []byte("block1")))
+
+		cr, err := NewCarReader(bytes.NewReader(buf.Bytes()[:buf.Len()-2]))
+		require.NoError(t, err)
+		_, _, err = cr.Next()
+		assert.Equal(t, io.ErrUnexpectedEOF, err)
+	})
+}
@@ -0,0 +1,33 @@
+package buckets
+
+import (
+	"mime"
+	"strings"
+)
+
+// EncodingGzip is the content encoding of bucket items that are compressed with gzip at rest.
+const EncodingGzip = "gzip"
+
+// compressibleTypes are non-text content types that compress well.
+var compressibleTypes = map[string]struct{}{
+	"application/javascript": {},
+	"application/json":       {},
+	"application/x-ndjson":   {},
+	"application/x-yaml":     {},
+	"application/xml":        {},
+	"application/yaml":       {},
+	"image/svg+xml":          {},
+}
+
+// Compressible returns whether or not content with contentType is text-like and worth compressing.
+func Compressible(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	if strings.HasPrefix(mt, "text/") || strings.HasSuffix(mt, "+json") || strings.HasSuffix(mt, "+xml") {
+		return true
+	}
+	_, ok := compressibleTypes[mt]
+	return ok
+}
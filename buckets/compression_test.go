@@ -0,0 +1,19 @@
+package buckets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompressible(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, Compressible("text/plain; charset=utf-8"))
+	assert.True(t, Compressible("application/json"))
+	assert.True(t, Compressible("application/ld+json"))
+	assert.True(t, Compressible("image/svg+xml"))
+	assert.False(t, Compressible("image/jpeg"))
+	assert.False(t, Compressible("application/octet-stream"))
+	assert.False(t, Compressible(""))
+}
@@ -0,0 +1,62 @@
+package buckets
+
+import (
+	"errors"
+	"fmt"
+	gopath "path"
+	"strings"
+)
+
+// ConflictStrategy describes how a bucket handles updates that are based on a stale root.
+type ConflictStrategy string
+
+const (
+	// ConflictLastWriterWins rejects updates based on a stale root,
+	// but allows updates without a root to overwrite any path.
+	// This is the default strategy.
+	ConflictLastWriterWins ConflictStrategy = ""
+	// ConflictReject rejects updates based on a stale root and updates without a root.
+	ConflictReject ConflictStrategy = "reject"
+	// ConflictKeepBoth applies updates based on a stale root to the current root.
+	// Files that were changed since the stale root are kept, and the update is renamed next to them.
+	ConflictKeepBoth ConflictStrategy = "keep_both"
+	// ConflictMerge applies updates based on a stale root to the current root,
+	// merging directories, unless a path was changed since the stale root.
+	ConflictMerge ConflictStrategy = "merge"
+)
+
+var (
+	// ErrRootRequired is returned when an update without a root is pushed to a bucket
+	// with the reject conflict strategy.
+	ErrRootRequired = errors.New("bucket conflict strategy requires updates to include a root")
+
+	// ErrPathConflict is returned when an update is based on a stale root and
+	// the updated path was changed since.
+	ErrPathConflict = errors.New("path was changed by another update")
+)
+
+// Valid returns whether or not the strategy is known.
+func (s ConflictStrategy) Valid() bool {
+	switch s {
+	case ConflictLastWriterWins, ConflictReject, ConflictKeepBoth, ConflictMerge:
+		return true
+	default:
+		return false
+	}
+}
+
+// AppliesStale returns whether or not updates based on a stale root are applied to the current root.
+func (s ConflictStrategy) AppliesStale() bool {
+	return s == ConflictKeepBoth || s == ConflictMerge
+}
+
+// ConflictPath returns the n-th path a file at pth is renamed to when it's kept next to a conflicting change,
+// e.g., "dir/file-conflict-1.txt".
+func ConflictPath(pth string, n int) string {
+	dir, name := gopath.Split(pth)
+	ext := gopath.Ext(name)
+	if ext == name {
+		ext = "" // Dot files like ".env" have no extension
+	}
+	return fmt.Sprintf("%s%s-conflict-%d%s", dir, strings.TrimSuffix(name, ext), n, ext)
+}
@@ -0,0 +1,25 @@
+package buckets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConflictPath(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "file-conflict-1.txt", ConflictPath("file.txt", 1))
+	assert.Equal(t, "dir/file.tar-conflict-2.gz", ConflictPath("dir/file.tar.gz", 2))
+	assert.Equal(t, "dir/sub/README-conflict-1", ConflictPath("dir/sub/README", 1))
+	assert.Equal(t, ".env-conflict-3", ConflictPath(".env", 3))
+}
+
+func TestConflictStrategy_Valid(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []ConflictStrategy{ConflictLastWriterWins, ConflictReject, ConflictKeepBoth, ConflictMerge} {
+		assert.True(t, s.Valid())
+	}
+	assert.False(t, ConflictStrategy("newest").Valid())
+}
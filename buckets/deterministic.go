@@ -0,0 +1,15 @@
+package buckets
+
+import mh "github.com/multiformats/go-multihash"
+
+// Parameters of deterministic pushes.
+// Files added with these parameters always have the same CID, no matter which IPFS node adds them.
+// Local buckets use the same parameters to compute file CIDs.
+const (
+	// DeterministicCidVersion is the CID version of deterministic files.
+	DeterministicCidVersion = 1
+	// DeterministicHash is the multihash function of deterministic files.
+	DeterministicHash = mh.SHA2_256
+	// DeterministicChunker splits deterministic files into fixed size chunks of 256 KiB.
+	DeterministicChunker = "size-262144"
+)
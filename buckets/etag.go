@@ -0,0 +1,31 @@
+package buckets
+
+import "strings"
+
+// ETag returns the strong entity tag of a bucket item with CID c.
+// Items are content addressed, so the CID changes if and only if the content changes.
+func ETag(c string) string {
+	if c == "" {
+		return ""
+	}
+	return `"` + c + `"`
+}
+
+// MatchETag returns whether or not an If-None-Match header value matches etag.
+// Weak comparison is used as required for If-None-Match, see RFC 7232 section 3.2.
+func MatchETag(ifNoneMatch, etag string) bool {
+	ifNoneMatch = strings.TrimSpace(ifNoneMatch)
+	if ifNoneMatch == "" || etag == "" {
+		return false
+	}
+	if ifNoneMatch == "*" {
+		return true
+	}
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, t := range strings.Split(ifNoneMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(t), "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,24 @@
+package buckets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchETag(t *testing.T) {
+	t.Parallel()
+
+	c := "bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"
+	etag := ETag(c)
+	assert.Equal(t, `"bafybeigdyrzt5sfp7udm7hu76uh7y26nf3efuylqabf3oclgtqy55fbzdi"`, etag)
+
+	assert.True(t, MatchETag(etag, etag))
+	assert.True(t, MatchETag("*", etag))
+	assert.True(t, MatchETag(`"other", `+etag, etag))
+	assert.True(t, MatchETag("W/"+etag, etag))
+	assert.False(t, MatchETag(`"other"`, etag))
+	assert.False(t, MatchETag("", etag))
+	assert.False(t, MatchETag(c, etag))
+	assert.Empty(t, ETag(""))
+}
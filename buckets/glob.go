@@ -0,0 +1,56 @@
+package buckets
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// ValidGlob returns an error if pattern is not a valid path glob.
+// Patterns use path.Match syntax for each slash-separated segment.
+// A "**" segment matches zero or more segments.
+func ValidGlob(pattern string) error {
+	if pattern == "" {
+		return fmt.Errorf("glob must not be empty")
+	}
+	for _, seg := range strings.Split(pattern, "/") {
+		if seg == "**" {
+			continue
+		}
+		if strings.Contains(seg, "**") {
+			return fmt.Errorf("'**' must be a whole path segment")
+		}
+		if _, err := path.Match(seg, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MatchGlob returns whether or not pth matches pattern.
+// Both are slash-separated relative paths. Invalid patterns never match.
+func MatchGlob(pattern, pth string) bool {
+	return matchGlobSegments(strings.Split(pattern, "/"), strings.Split(strings.Trim(pth, "/"), "/"))
+}
+
+func matchGlobSegments(pattern, parts []string) bool {
+	for len(pattern) > 0 {
+		if pattern[0] == "**" {
+			for i := 0; i <= len(parts); i++ {
+				if matchGlobSegments(pattern[1:], parts[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(parts) == 0 {
+			return false
+		}
+		if ok, err := path.Match(pattern[0], parts[0]); err != nil || !ok {
+			return false
+		}
+		pattern = pattern[1:]
+		parts = parts[1:]
+	}
+	return len(parts) == 0
+}
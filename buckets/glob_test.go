@@ -0,0 +1,44 @@
+package buckets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidGlob(t *testing.T) {
+	t.Parallel()
+
+	for _, p := range []string{"*.jpg", "**/*.jpg", "dir/**", "a/?/[bc]"} {
+		assert.NoError(t, ValidGlob(p), p)
+	}
+	for _, p := range []string{"", "a**/b", "[", "dir/[a-"} {
+		assert.Error(t, ValidGlob(p), p)
+	}
+}
+
+func TestMatchGlob(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		pattern string
+		pth     string
+		match   bool
+	}{
+		{"*.jpg", "a.jpg", true},
+		{"*.jpg", "dir/a.jpg", false},
+		{"**/*.jpg", "a.jpg", true},
+		{"**/*.jpg", "dir/sub/a.jpg", true},
+		{"**/*.jpg", "dir/a.png", false},
+		{"dir/**", "dir", true},
+		{"dir/**", "dir/sub/a.jpg", true},
+		{"dir/**", "other/a.jpg", false},
+		{"dir/**/a.jpg", "dir/a.jpg", true},
+		{"dir/**/a.jpg", "dir/x/y/a.jpg", true},
+		{"dir/?.jpg", "dir/a.jpg", true},
+		{"dir/?.jpg", "dir/ab.jpg", false},
+	}
+	for _, c := range cases {
+		assert.Equal(t, c.match, MatchGlob(c.pattern, c.pth), "%s %s", c.pattern, c.pth)
+	}
+}
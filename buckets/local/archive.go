@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/ipfs/go-cid"
+	"github.com/textileio/textile/api/buckets/client"
 	pb "github.com/textileio/textile/api/buckets/pb"
 )
 
@@ -13,19 +14,32 @@ import (
 var ArchiveStatusTimeout = time.Second * 5
 
 // ArchiveRemote requests an archive of the current remote bucket.
-func (b *Bucket) ArchiveRemote(ctx context.Context) error {
+// Use opts to override the default deal config of the archive.
+func (b *Bucket) ArchiveRemote(ctx context.Context, opts ...client.ArchiveOption) error {
 	b.Lock()
 	defer b.Unlock()
 	ctx, err := b.context(ctx)
 	if err != nil {
 		return err
 	}
-	if _, err := b.clients.Buckets.Archive(ctx, b.Key()); err != nil {
+	if _, err := b.clients.Buckets.Archive(ctx, b.Key(), opts...); err != nil {
 		return err
 	}
 	return nil
 }
 
+// RestoreArchiveRemote restores the remote bucket from its current Filecoin archive.
+// Progress messages are sent to ch. Use client.WithRestoreNewBucket to restore into a new bucket.
+func (b *Bucket) RestoreArchiveRemote(ctx context.Context, ch chan<- string, opts ...client.RestoreArchiveOption) (*pb.Root, error) {
+	b.Lock()
+	defer b.Unlock()
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.clients.Buckets.RestoreArchive(ctx, b.Key(), ch, opts...)
+}
+
 // ArchiveStatusMessage is used to wrap an archive status message.
 type ArchiveStatusMessage struct {
 	Type    ArchiveMessageType
@@ -139,8 +153,10 @@ func isJobStatusFinal(status pb.ArchiveStatusReply_Status) (bool, error) {
 
 // ArchiveInfo wraps info about an archive.
 type ArchiveInfo struct {
-	Key     string  `json:"key"`
-	Archive Archive `json:"archive"`
+	Key      string           `json:"key"`
+	Archive  Archive          `json:"archive"`
+	Schedule *ArchiveSchedule `json:"schedule,omitempty"`
+	Renewal  *ArchiveRenewal  `json:"renewal,omitempty"`
 }
 
 // Archive describes the state of an archive.
@@ -155,6 +171,138 @@ type ArchiveDeal struct {
 	Miner       string  `json:"miner"`
 }
 
+// ArchiveSchedule describes the policy for archiving a bucket automatically.
+type ArchiveSchedule struct {
+	Interval     time.Duration        `json:"interval"`
+	EveryChanges int64                `json:"every_changes"`
+	Changes      int64                `json:"changes"`
+	NextRunAt    time.Time            `json:"next_run_at"`
+	History      []ArchiveScheduleRun `json:"history"`
+}
+
+// ArchiveScheduleRun describes a run of an archive schedule.
+type ArchiveScheduleRun struct {
+	RanAt   time.Time `json:"ran_at"`
+	Cid     cid.Cid   `json:"cid"`
+	JobID   string    `json:"job_id"`
+	Skipped bool      `json:"skipped"`
+	Error   string    `json:"error"`
+}
+
+// SetArchiveSchedule sets the policy for archiving the remote bucket automatically.
+// A zero interval and change count removes the schedule.
+func (b *Bucket) SetArchiveSchedule(ctx context.Context, interval time.Duration, everyChanges int64) (*ArchiveSchedule, error) {
+	b.Lock()
+	defer b.Unlock()
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sched, err := b.clients.Buckets.SetArchiveSchedule(ctx, b.Key(), interval, everyChanges)
+	if err != nil {
+		return nil, err
+	}
+	return pbArchiveScheduleToArchiveSchedule(sched), nil
+}
+
+// ArchiveRenewal describes the policy for renewing the deals of a bucket's archives.
+type ArchiveRenewal struct {
+	Threshold     int64     `json:"threshold"`
+	ExpiryEpoch   uint64    `json:"expiry_epoch"`
+	Unfunded      bool      `json:"unfunded"`
+	LastCheckedAt time.Time `json:"last_checked_at"`
+	NextCheckAt   time.Time `json:"next_check_at"`
+	LastError     string    `json:"last_error,omitempty"`
+}
+
+// SetArchiveRenewal sets the policy for renewing the deals of the remote bucket's archives.
+// A zero threshold removes the policy.
+func (b *Bucket) SetArchiveRenewal(ctx context.Context, threshold int64) (*ArchiveRenewal, error) {
+	b.Lock()
+	defer b.Unlock()
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rn, err := b.clients.Buckets.SetArchiveRenewal(ctx, b.Key(), threshold)
+	if err != nil {
+		return nil, err
+	}
+	return pbArchiveRenewalToArchiveRenewal(rn), nil
+}
+
+// ArchiveRecord describes an archive in a bucket's archive history.
+type ArchiveRecord struct {
+	Cid        cid.Cid             `json:"cid"`
+	JobID      string              `json:"job_id"`
+	Status     string              `json:"status"`
+	FailedMsg  string              `json:"failed_msg,omitempty"`
+	Aborted    bool                `json:"aborted"`
+	AbortedMsg string              `json:"aborted_msg,omitempty"`
+	CreatedAt  time.Time           `json:"created_at"`
+	Current    bool                `json:"current"`
+	Deals      []ArchiveDealRecord `json:"deals"`
+}
+
+// ArchiveDealRecord describes the state of an archive deal.
+type ArchiveDealRecord struct {
+	ProposalCid     cid.Cid `json:"proposal_cid"`
+	Miner           string  `json:"miner"`
+	DealID          uint64  `json:"deal_id"`
+	State           string  `json:"state"`
+	Pending         bool    `json:"pending"`
+	ActivationEpoch int64   `json:"activation_epoch"`
+	ExpiryEpoch     uint64  `json:"expiry_epoch"`
+}
+
+// ArchiveList returns all archives of the remote bucket, newest first.
+func (b *Bucket) ArchiveList(ctx context.Context) ([]ArchiveRecord, error) {
+	b.Lock()
+	defer b.Unlock()
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rep, err := b.clients.Buckets.ArchiveList(ctx, b.Key())
+	if err != nil {
+		return nil, err
+	}
+	list := make([]ArchiveRecord, len(rep.Archives))
+	for i, a := range rep.Archives {
+		c, err := cid.Decode(a.Cid)
+		if err != nil {
+			return nil, err
+		}
+		list[i] = ArchiveRecord{
+			Cid:        c,
+			JobID:      a.JobId,
+			Status:     a.Status.String(),
+			FailedMsg:  a.FailedMsg,
+			Aborted:    a.Aborted,
+			AbortedMsg: a.AbortedMsg,
+			CreatedAt:  time.Unix(a.CreatedAt, 0),
+			Current:    a.Current,
+			Deals:      make([]ArchiveDealRecord, len(a.Deals)),
+		}
+		for j, d := range a.Deals {
+			list[i].Deals[j] = ArchiveDealRecord{
+				Miner:           d.Miner,
+				DealID:          d.DealId,
+				State:           d.State,
+				Pending:         d.Pending,
+				ActivationEpoch: d.ActivationEpoch,
+				ExpiryEpoch:     d.ExpiryEpoch,
+			}
+			if d.ProposalCid != "" {
+				if pc, err := cid.Decode(d.ProposalCid); err == nil {
+					list[i].Deals[j].ProposalCid = pc
+				}
+			}
+		}
+	}
+	return list, nil
+}
+
 // ArchiveInfo returns information about the current archvie.
 func (b *Bucket) ArchiveInfo(ctx context.Context) (info ArchiveInfo, err error) {
 	b.Lock()
@@ -172,6 +320,8 @@ func (b *Bucket) ArchiveInfo(ctx context.Context) (info ArchiveInfo, err error)
 
 func pbArchiveInfoToArchiveInfo(pi *pb.ArchiveInfoReply) (info ArchiveInfo, err error) {
 	info.Key = pi.Key
+	info.Schedule = pbArchiveScheduleToArchiveSchedule(pi.Schedule)
+	info.Renewal = pbArchiveRenewalToArchiveRenewal(pi.Renewal)
 	if pi.Archive != nil {
 		info.Archive.Cid, err = cid.Decode(pi.Archive.Cid)
 		if err != nil {
@@ -188,3 +338,49 @@ func pbArchiveInfoToArchiveInfo(pi *pb.ArchiveInfoReply) (info ArchiveInfo, err
 	}
 	return info, err
 }
+
+func pbArchiveRenewalToArchiveRenewal(pr *pb.ArchiveRenewal) *ArchiveRenewal {
+	if pr == nil {
+		return nil
+	}
+	rn := &ArchiveRenewal{
+		Threshold:   pr.Threshold,
+		ExpiryEpoch: pr.ExpiryEpoch,
+		Unfunded:    pr.Unfunded,
+		LastError:   pr.LastError,
+	}
+	if pr.LastCheckedAt > 0 {
+		rn.LastCheckedAt = time.Unix(0, pr.LastCheckedAt)
+	}
+	if pr.NextCheckAt > 0 {
+		rn.NextCheckAt = time.Unix(0, pr.NextCheckAt)
+	}
+	return rn
+}
+
+func pbArchiveScheduleToArchiveSchedule(ps *pb.ArchiveSchedule) *ArchiveSchedule {
+	if ps == nil {
+		return nil
+	}
+	sched := &ArchiveSchedule{
+		Interval:     time.Duration(ps.Interval) * time.Second,
+		EveryChanges: ps.EveryChanges,
+		Changes:      ps.Changes,
+		History:      make([]ArchiveScheduleRun, len(ps.History)),
+	}
+	if ps.NextRunAt > 0 {
+		sched.NextRunAt = time.Unix(0, ps.NextRunAt)
+	}
+	for i, r := range ps.History {
+		sched.History[i] = ArchiveScheduleRun{
+			RanAt:   time.Unix(0, r.RanAt),
+			JobID:   r.JobId,
+			Skipped: r.Skipped,
+			Error:   r.Error,
+		}
+		if c, err := cid.Decode(r.Cid); err == nil {
+			sched.History[i].Cid = c
+		}
+	}
+	return sched
+}
@@ -43,6 +43,10 @@ type PathEvent struct {
 	Size int64
 	// Progress of the event if known (useful for upload/download progress).
 	Progress int64
+	// Attempt is the number of failed attempts (FileRetry only).
+	Attempt int
+	// Err is the error that caused the retry (FileRetry only).
+	Err error
 }
 
 // PathEventType is the type of path event.
@@ -61,8 +65,37 @@ const (
 	FileComplete
 	// FileRemoved indicates a file has been removed.
 	FileRemoved
+	// FileConflict indicates a local file change conflicted with a remote change.
+	// The local version is kept next to the remote version at Path.
+	FileConflict
+	// FileRetry indicates a file transfer failed with a transient error and will be retried.
+	// Progress starts over with the next FileProgress event. See WithRetries.
+	FileRetry
 )
 
+func (t PathEventType) String() string {
+	switch t {
+	case PathStart:
+		return "path_start"
+	case PathComplete:
+		return "path_complete"
+	case FileStart:
+		return "file_start"
+	case FileProgress:
+		return "file_progress"
+	case FileComplete:
+		return "file_complete"
+	case FileRemoved:
+		return "file_removed"
+	case FileConflict:
+		return "file_conflict"
+	case FileRetry:
+		return "file_retry"
+	default:
+		return "unknown"
+	}
+}
+
 // Bucket is a local-first object storage and synchronization model built
 // on ThreadDB, IPFS, and Filecoin.
 // A bucket represents a dynamic Unixfs directory with auto-updating
@@ -116,11 +149,21 @@ func (b *Bucket) LocalSize() (int64, error) {
 	if err != nil {
 		return 0, err
 	}
+	ig, err := loadIgnorer(bp)
+	if err != nil {
+		return 0, err
+	}
 	var size int64
 	err = filepath.Walk(bp, func(n string, info os.FileInfo, err error) error {
 		if err != nil {
 			return fmt.Errorf("getting fileinfo of %s: %s", n, err)
 		}
+		if b.skipPath(ig, bp, n, info.IsDir()) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
 		if !info.IsDir() {
 			f := strings.TrimPrefix(n, bp+"/")
 			if Ignore(n) || (strings.HasPrefix(f, b.conf.Dir) && f != buckets.SeedName) {
@@ -141,6 +184,12 @@ type BucketInfo struct {
 	Thread    thread.ID     `json:"id"`
 	CreatedAt time.Time     `json:"created_at"`
 	UpdatedAt time.Time     `json:"updated_at"`
+	// Mirrors are the remote pinning services that pin the bucket root.
+	Mirrors []PinMirror `json:"mirrors,omitempty"`
+	// Domains are the custom domains whose DNSLink records point at the bucket root.
+	Domains []Domain `json:"domains,omitempty"`
+	// Tags are the key/value tags of the bucket, including tags inherited from its thread.
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // Info returns info about a bucket from the remote.
@@ -153,7 +202,17 @@ func (b *Bucket) Info(ctx context.Context) (info BucketInfo, err error) {
 	if err != nil {
 		return
 	}
-	return pbRootToInfo(rep.Root)
+	info, err = pbRootToInfo(rep.Root)
+	if err != nil {
+		return
+	}
+	for _, m := range rep.Mirrors {
+		info.Mirrors = append(info.Mirrors, *pbPinMirrorToPinMirror(m))
+	}
+	for _, d := range rep.Domains {
+		info.Domains = append(info.Domains, *pbDomainToDomain(d))
+	}
+	return info, nil
 }
 
 func pbRootToInfo(r *pb.Root) (info BucketInfo, err error) {
@@ -176,6 +235,7 @@ func pbRootToInfo(r *pb.Root) (info BucketInfo, err error) {
 		Thread:    id,
 		CreatedAt: time.Unix(0, r.CreatedAt),
 		UpdatedAt: time.Unix(0, r.UpdatedAt),
+		Tags:      r.Tags,
 	}, nil
 }
 
@@ -252,6 +312,82 @@ func (b *Bucket) CatRemotePath(ctx context.Context, pth string, w io.Writer) err
 	return b.clients.Buckets.PullPath(ctx, b.Key(), pth, w)
 }
 
+// ExportRemote writes the remote bucket root DAG to w as a CAR file.
+func (b *Bucket) ExportRemote(ctx context.Context, w io.Writer) error {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	return b.clients.Buckets.ExportBucket(ctx, b.Key(), w)
+}
+
+// ImportRemote replaces the remote bucket root with the root of the CAR file in r.
+// The local bucket is not changed, use PullRemote to update it.
+func (b *Bucket) ImportRemote(ctx context.Context, r io.Reader) (*pb.Root, error) {
+	b.Lock()
+	defer b.Unlock()
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.clients.Buckets.ImportBucket(ctx, b.Key(), r)
+}
+
+// Quota describes the max size and remaining capacity of a bucket.
+type Quota struct {
+	MaxSize   int64 `json:"max_size"`
+	Size      int64 `json:"size"`
+	Remaining int64 `json:"remaining"`
+}
+
+// Quota returns the quota of the remote bucket.
+func (b *Bucket) Quota(ctx context.Context) (quota Quota, err error) {
+	ctx, err = b.context(ctx)
+	if err != nil {
+		return
+	}
+	q, err := b.clients.Buckets.GetQuota(ctx, b.Key())
+	if err != nil {
+		return
+	}
+	return Quota{MaxSize: q.MaxSize, Size: q.Size, Remaining: q.Remaining}, nil
+}
+
+// SetQuota sets the max size of the remote bucket in bytes.
+// A max size of zero removes the quota.
+func (b *Bucket) SetQuota(ctx context.Context, maxSize int64) error {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = b.clients.Buckets.SetQuota(ctx, b.Key(), maxSize)
+	return err
+}
+
+// Rename sets the name of the remote bucket.
+func (b *Bucket) Rename(ctx context.Context, name string) error {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = b.clients.Buckets.RenameBucket(ctx, b.Key(), name)
+	return err
+}
+
+// SetTags replaces the key/value tags of the remote bucket and returns the resulting tags.
+// Empty tags remove all tags from the bucket.
+func (b *Bucket) SetTags(ctx context.Context, tags map[string]string) (map[string]string, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rep, err := b.clients.Buckets.SetTags(ctx, b.Key(), tags)
+	if err != nil {
+		return nil, err
+	}
+	return rep.Root.Tags, nil
+}
+
 // Destroy completely deletes the local and remote bucket.
 func (b *Bucket) Destroy(ctx context.Context) error {
 	b.Lock()
@@ -277,6 +413,7 @@ func (b *Bucket) loadLocalRepo(ctx context.Context, pth, name string, setCidVers
 	if err != nil {
 		return err
 	}
+	r.SetSparsePaths(b.conf.Viper.GetStringSlice("sparse"))
 	b.repo = r
 	if setCidVersion {
 		if err = b.setRepoCidVersion(ctx); err != nil {
@@ -6,7 +6,11 @@ import (
 	"crypto/rand"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"sync"
@@ -145,6 +149,107 @@ func TestBucket_PushLocal(t *testing.T) {
 	assert.True(t, errors.Is(err, ErrUpToDate))
 }
 
+func TestBucket_PushLocalConcurrency(t *testing.T) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
+	require.NoError(t, err)
+
+	for i := 0; i < 4; i++ {
+		addRandomFile(t, buck, fmt.Sprintf("file%d", i), 64*1024)
+	}
+	start := time.Now()
+	roots, err := buck.PushLocal(context.Background(), WithConcurrency(4), WithRateLimit(512*1024))
+	require.NoError(t, err)
+	assert.True(t, roots.Remote.Defined())
+	assert.True(t, time.Since(start) > 250*time.Millisecond)
+
+	diff, err := buck.DiffRemote(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, diff, 0)
+}
+
+func TestBucket_PushLocalStaged(t *testing.T) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
+	require.NoError(t, err)
+
+	addRandomFile(t, buck, "staged/file1", 1024)
+	addRandomFile(t, buck, "staged/file2", 1024)
+	addRandomFile(t, buck, "unstaged", 1024)
+	err = buck.Stage("staged")
+	require.NoError(t, err)
+	staged, err := buck.StagedPaths()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"staged"}, staged)
+
+	diff, err := buck.DiffLocal()
+	require.NoError(t, err)
+	assert.Len(t, diff, 3)
+	for _, c := range diff {
+		assert.Equal(t, c.Path != "unstaged", c.Staged, c.Path)
+	}
+
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	// Unstaged changes remain and everything is unstaged
+	diff, err = buck.DiffLocal()
+	require.NoError(t, err)
+	require.Len(t, diff, 1)
+	assert.Equal(t, "unstaged", diff[0].Path)
+	staged, err = buck.StagedPaths()
+	require.NoError(t, err)
+	assert.Empty(t, staged)
+
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+	diff, err = buck.DiffLocal()
+	require.NoError(t, err)
+	assert.Len(t, diff, 0)
+}
+
+func TestBucket_PushPullFilter(t *testing.T) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
+	require.NoError(t, err)
+
+	addRandomFile(t, buck, "dist/app.js", 1024)
+	addRandomFile(t, buck, "dist/app.js.map", 1024)
+	raw := addRandomFile(t, buck, "raw/data", 1024)
+
+	_, err = buck.PushLocal(context.Background(), WithInclude("dist/"), WithExclude("*.map"))
+	require.NoError(t, err)
+
+	// Unselected changes remain
+	diff, err := buck.DiffLocal()
+	require.NoError(t, err)
+	var paths []string
+	for _, c := range diff {
+		paths = append(paths, c.Path)
+	}
+	assert.ElementsMatch(t, []string{"dist/app.js.map", "raw/data"}, paths)
+
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	// Excluded paths are not pulled
+	err = os.RemoveAll(raw)
+	require.NoError(t, err)
+	_, err = buck.PullRemote(context.Background(), WithHard(true), WithExclude("raw/"))
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrUpToDate))
+	_, err = os.Stat(raw)
+	assert.True(t, os.IsNotExist(err))
+
+	_, err = buck.PullRemote(context.Background(), WithHard(true), WithInclude("raw/"))
+	require.NoError(t, err)
+	_, err = os.Stat(raw)
+	require.NoError(t, err)
+	diff, err = buck.DiffLocal()
+	require.NoError(t, err)
+	assert.Len(t, diff, 0)
+}
+
 func TestBucket_PullRemote(t *testing.T) {
 	buckets := setup(t)
 	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
@@ -220,6 +325,185 @@ func TestBucket_PullRemote(t *testing.T) {
 	ec.check(t, 0, 1)
 }
 
+func TestBucket_PullRemoteFileInfo(t *testing.T) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
+	require.NoError(t, err)
+
+	fpth := addRandomFile(t, buck, "bin/run", 512)
+	err = os.Chmod(fpth, 0750)
+	require.NoError(t, err)
+	mtime := time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+	err = os.Chtimes(fpth, mtime, mtime)
+	require.NoError(t, err)
+	_, err = buck.PushLocal(context.Background(), WithFileInfo(true))
+	require.NoError(t, err)
+
+	// Pull into a fresh copy of the bucket
+	tid, err := buck.Thread()
+	require.NoError(t, err)
+	buck2, err := buckets.NewBucket(context.Background(), Config{
+		Path:   newDir(t),
+		Key:    buck.Key(),
+		Thread: tid,
+	})
+	require.NoError(t, err)
+	bp, err := buck2.Path()
+	require.NoError(t, err)
+	_, err = buck2.PullRemote(context.Background(), WithForce(true), WithFileInfo(true))
+	require.NoError(t, err)
+
+	info, err := os.Stat(filepath.Join(bp, "bin/run"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0750), info.Mode().Perm())
+	assert.True(t, mtime.Equal(info.ModTime()))
+}
+
+func TestBucket_PullRemoteConflicts(t *testing.T) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
+	require.NoError(t, err)
+	addRandomFile(t, buck, "ours", 1024)
+	addRandomFile(t, buck, "theirs", 1024)
+	addRandomFile(t, buck, "both", 1024)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	conf2 := Config{Path: newDir(t)}
+	conf2.Key = buck.Key()
+	conf2.Thread, err = buck.Thread()
+	require.NoError(t, err)
+	buck2, err := buckets.NewBucket(context.Background(), conf2)
+	require.NoError(t, err)
+
+	// Change the same files in both buckets.
+	// Each file is named after the resolution used for it.
+	for _, f := range []string{"ours", "theirs", "both"} {
+		addRandomFile(t, buck, f, 1024)
+	}
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+	local := make(map[string]string)
+	for _, f := range []string{"ours", "theirs", "both"} {
+		fp := addRandomFile(t, buck2, f, 1024)
+		data, err := ioutil.ReadFile(fp)
+		require.NoError(t, err)
+		local[f] = string(data)
+	}
+
+	var conflicts []string
+	resolver := ConflictResolverFunc(func(c Conflict) (ConflictResolution, error) {
+		conflicts = append(conflicts, c.Path)
+		assert.True(t, c.Local.Defined())
+		assert.True(t, c.Remote.Defined())
+		return ConflictResolution(c.Path), nil
+	})
+	_, err = buck2.PullRemote(context.Background(), WithConflictResolver(resolver))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ours", "theirs", "both"}, conflicts)
+
+	bp, err := buck2.Path()
+	require.NoError(t, err)
+	data, err := ioutil.ReadFile(filepath.Join(bp, "ours"))
+	require.NoError(t, err)
+	assert.Equal(t, local["ours"], string(data))
+	data, err = ioutil.ReadFile(filepath.Join(bp, "theirs"))
+	require.NoError(t, err)
+	assert.NotEqual(t, local["theirs"], string(data))
+	data, err = ioutil.ReadFile(filepath.Join(bp, "both"))
+	require.NoError(t, err)
+	assert.NotEqual(t, local["both"], string(data))
+	data, err = ioutil.ReadFile(bucks.ConflictPath(filepath.Join(bp, "both"), 1))
+	require.NoError(t, err)
+	assert.Equal(t, local["both"], string(data))
+
+	// Only the kept local versions are left as changes
+	diff, err := buck2.DiffLocal()
+	require.NoError(t, err)
+	assert.Len(t, diff, 2)
+}
+
+func TestBucket_DryRun(t *testing.T) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
+	require.NoError(t, err)
+
+	fpth := addRandomFile(t, buck, "dir/file", 1024)
+	var changes []Change
+	dryRun := WithDryRun(func(diff []Change) {
+		changes = diff
+	})
+	r1, err := buck.PushLocal(context.Background(), dryRun)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, dagutils.Add, changes[0].Type)
+	assert.Equal(t, "dir/file", changes[0].Path)
+
+	// Nothing should have been pushed
+	r2, err := buck.Roots(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, r1.Remote, r2.Remote)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	// Delete the file locally, which a hard pull would restore
+	err = os.RemoveAll(fpth)
+	require.NoError(t, err)
+	changes = nil
+	_, err = buck.PullRemote(context.Background(), WithHard(true), dryRun)
+	require.NoError(t, err)
+	require.Len(t, changes, 1)
+	assert.Equal(t, dagutils.Add, changes[0].Type)
+	_, err = os.Stat(fpth)
+	assert.True(t, os.IsNotExist(err))
+
+	// A regular pull keeps the local removal
+	_, err = buck.PullRemote(context.Background(), dryRun)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrUpToDate))
+}
+
+func TestBucket_Checkout(t *testing.T) {
+	buckets := setup(t)
+	conf := getConf(t, buckets)
+	buck, err := buckets.NewBucket(context.Background(), conf)
+	require.NoError(t, err)
+
+	fpth := addRandomFile(t, buck, "file1", 256)
+	roots1, err := buck.PushLocal(context.Background())
+	require.NoError(t, err)
+	data1, err := ioutil.ReadFile(fpth)
+	require.NoError(t, err)
+	addRandomFile(t, buck, "file1", 256)
+	addRandomFile(t, buck, "file2", 256)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	root, err := buck.Checkout(context.Background(), roots1.Remote.String())
+	require.NoError(t, err)
+	assert.Equal(t, roots1.Remote, root.Cid())
+	data, err := ioutil.ReadFile(fpth)
+	require.NoError(t, err)
+	assert.Equal(t, data1, data)
+	_, err = os.Stat(filepath.Join(conf.Path, "file2"))
+	assert.True(t, os.IsNotExist(err))
+	_, ok := buck.Detached()
+	assert.True(t, ok)
+
+	addRandomFile(t, buck, "file3", 256)
+	_, err = buck.PushLocal(context.Background())
+	assert.True(t, errors.Is(err, ErrDetached))
+	_, err = buck.Checkout(context.Background(), "missing")
+	assert.True(t, errors.Is(err, ErrCheckoutChanges))
+
+	_, err = buck.PullRemote(context.Background())
+	require.NoError(t, err)
+	_, ok = buck.Detached()
+	assert.False(t, ok)
+	_, err = os.Stat(filepath.Join(conf.Path, "file2"))
+	require.NoError(t, err)
+}
+
 func TestBucket_AddRemoteCid(t *testing.T) {
 	buckets := setup(t)
 	conf := getConf(t, buckets)
@@ -261,6 +545,18 @@ func TestBucket_RemotePaths(t *testing.T) {
 		assert.Len(t, items, 1)
 	})
 
+	t.Run("ListRemotePathPages", func(t *testing.T) {
+		var pages, count int
+		err := buck.ListRemotePathPages(context.Background(), "", 1, func(items []BucketItem) error {
+			pages++
+			count += len(items)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, pages)
+		assert.Equal(t, 2, count)
+	})
+
 	t.Run("CatRemotePath", func(t *testing.T) {
 		var buf bytes.Buffer
 		err = buck.CatRemotePath(context.Background(), "dir/file", &buf)
@@ -346,6 +642,203 @@ func TestBucket_DiffLocal(t *testing.T) {
 	assert.Len(t, diff, 0)
 }
 
+func TestBucket_Doctor(t *testing.T) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
+	require.NoError(t, err)
+
+	addRandomFile(t, buck, "file1", 1024)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	problems, err := buck.Doctor(context.Background(), false)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+
+	// Stage a file, then remove it without pushing, which orphans the staged path
+	fpth := addRandomFile(t, buck, "file2", 1024)
+	err = buck.Stage(fpth)
+	require.NoError(t, err)
+	err = os.Remove(fpth)
+	require.NoError(t, err)
+
+	problems, err = buck.Doctor(context.Background(), false)
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.False(t, problems[0].Fixed)
+
+	problems, err = buck.Doctor(context.Background(), true)
+	require.NoError(t, err)
+	require.Len(t, problems, 1)
+	assert.True(t, problems[0].Fixed)
+	staged, err := buck.StagedPaths()
+	require.NoError(t, err)
+	assert.Empty(t, staged)
+}
+
+func TestBucket_Verify(t *testing.T) {
+	buckets := setup(t)
+	conf := getConf(t, buckets)
+	buck, err := buckets.NewBucket(context.Background(), conf)
+	require.NoError(t, err)
+
+	addRandomFile(t, buck, "file1", 256)
+	fpth := addRandomFile(t, buck, "folder/file2", 256)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	drift, err := buck.Verify(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, drift)
+
+	addRandomFile(t, buck, "file1", 256)
+	addRandomFile(t, buck, "file3", 256)
+	err = os.Remove(fpth)
+	require.NoError(t, err)
+	drift, err = buck.Verify(context.Background())
+	require.NoError(t, err)
+	require.Len(t, drift, 3)
+	assert.Equal(t, "file1", drift[0].Path)
+	assert.Equal(t, dagutils.Mod, drift[0].Type)
+	assert.Equal(t, "file3", drift[1].Path)
+	assert.Equal(t, dagutils.Add, drift[1].Type)
+	assert.Equal(t, "folder/file2", drift[2].Path)
+	assert.Equal(t, dagutils.Remove, drift[2].Type)
+}
+
+func TestBucket_DiffRemote(t *testing.T) {
+	buckets := setup(t)
+	buck, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
+	require.NoError(t, err)
+
+	diff, err := buck.DiffRemote(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, diff, 0)
+
+	addRandomFile(t, buck, "file1", 256)
+	fpth := addRandomFile(t, buck, "folder/file2", 256)
+	diff, err = buck.DiffRemote(context.Background())
+	require.NoError(t, err)
+	require.Len(t, diff, 2)
+	assert.Equal(t, RemoteAdded, diff[0].Type)
+	assert.Equal(t, "file1", diff[0].Path)
+	assert.Equal(t, int64(256), diff[0].Size)
+	assert.True(t, diff[0].Cid.Defined())
+
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+	diff, err = buck.DiffRemote(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, diff, 0)
+
+	addRandomFile(t, buck, "file1", 512)
+	err = os.RemoveAll(fpth)
+	require.NoError(t, err)
+	diff, err = buck.DiffRemote(context.Background())
+	require.NoError(t, err)
+	require.Len(t, diff, 2)
+	assert.Equal(t, RemoteModified, diff[0].Type)
+	assert.Equal(t, int64(512), diff[0].Size)
+	assert.Equal(t, int64(256), diff[0].RemoteSize)
+	assert.True(t, diff[0].RemoteCid.Defined())
+	assert.Equal(t, RemoteDeleted, diff[1].Type)
+	assert.Equal(t, "folder/file2", diff[1].Path)
+}
+
+func TestBucket_SetSparsePaths(t *testing.T) {
+	buckets := setup(t)
+	conf := getConf(t, buckets)
+	buck, err := buckets.NewBucket(context.Background(), conf)
+	require.NoError(t, err)
+
+	addRandomFile(t, buck, "a/file1", 256)
+	addRandomFile(t, buck, "b/file2", 256)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	err = buck.SetSparsePaths(context.Background(), []string{"a"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, buck.SparsePaths())
+	_, err = os.Stat(filepath.Join(conf.Path, "a", "file1"))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(conf.Path, "b", "file2"))
+	assert.True(t, os.IsNotExist(err))
+	diff, err := buck.DiffLocal()
+	require.NoError(t, err)
+	assert.Len(t, diff, 0)
+
+	// Changes are only pushed for the sparse paths, remote files outside of them are kept
+	addRandomFile(t, buck, "a/file3", 256)
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+	items, err := buck.ListRemotePath(context.Background(), "b")
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+
+	addRandomFile(t, buck, "a/file4", 256)
+	err = buck.SetSparsePaths(context.Background(), nil)
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrSparseChanges))
+	_, err = buck.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	// Clearing the sparse paths pulls the rest of the bucket
+	err = buck.SetSparsePaths(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, buck.SparsePaths())
+	_, err = os.Stat(filepath.Join(conf.Path, "b", "file2"))
+	require.NoError(t, err)
+}
+
+func TestBucket_PreviewHandler(t *testing.T) {
+	buckets := setup(t)
+	conf := getConf(t, buckets)
+	buck, err := buckets.NewBucket(context.Background(), conf)
+	require.NoError(t, err)
+
+	files := map[string]string{
+		"index.html":        "home",
+		"docs/index.html":   "docs",
+		"404.html":          "missing",
+		"secret.txt":        "secret",
+		bucks.RedirectsName: "/old /docs/ 301\n/app/* /index.html 200\n",
+		IgnoreFile:          "secret.txt\n",
+	}
+	for n, data := range files {
+		err = os.MkdirAll(filepath.Dir(filepath.Join(conf.Path, n)), os.ModePerm)
+		require.NoError(t, err)
+		err = ioutil.WriteFile(filepath.Join(conf.Path, n), []byte(data), 0644)
+		require.NoError(t, err)
+	}
+	handler, err := buck.PreviewHandler(&bucks.Website{ErrorDocument: "404.html"})
+	require.NoError(t, err)
+
+	get := func(pth string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, pth, nil))
+		return rec
+	}
+	rec := get("/")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "home", rec.Body.String())
+	rec = get("/docs")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "docs", rec.Body.String())
+	rec = get("/old")
+	assert.Equal(t, http.StatusMovedPermanently, rec.Code)
+	assert.Equal(t, "/docs/", rec.Header().Get("Location"))
+	rec = get("/app/settings")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "home", rec.Body.String())
+
+	// Ignored files aren't pushed, so they aren't served
+	for _, pth := range []string{"/secret.txt", "/nothing", "/.textile/config.yml"} {
+		rec = get(pth)
+		assert.Equal(t, http.StatusNotFound, rec.Code, pth)
+		assert.Equal(t, "missing", rec.Body.String(), pth)
+	}
+}
+
 func TestBucket_Watch(t *testing.T) {
 	tconf := apitest.DefaultTextileConfig(t)
 	tconf.Hub = false
@@ -459,6 +952,51 @@ func TestBucket_Watch(t *testing.T) {
 	assert.Greater(t, offlineStateCount, 0) // At least one, but could be more as watch retries
 }
 
+func TestBucket_Subscribe(t *testing.T) {
+	buckets := setup(t)
+	buck1, err := buckets.NewBucket(context.Background(), getConf(t, buckets))
+	require.NoError(t, err)
+	tid, err := buck1.Thread()
+	require.NoError(t, err)
+	buck2, err := buckets.NewBucket(context.Background(), Config{
+		Path:   newDir(t),
+		Key:    buck1.Key(),
+		Thread: tid,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	changes := make(chan RootChange, 10)
+	state, err := buck2.Subscribe(ctx, WithRootChange(func(rc RootChange) {
+		changes <- rc
+	}))
+	require.NoError(t, err)
+	s := <-state
+	require.Equal(t, cmd.Online, s.State)
+
+	// Push a file from the first bucket
+	addRandomFile(t, buck1, "file1", 512)
+	_, err = buck1.PushLocal(context.Background())
+	require.NoError(t, err)
+
+	// Subscribe should have pulled the remote change
+	select {
+	case rc := <-changes:
+		assert.True(t, rc.Pulled)
+		assert.True(t, rc.Root.Defined())
+	case <-time.After(time.Second * 10):
+		t.Fatal("timed out waiting for remote change")
+	}
+	bp, err := buck2.Path()
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(bp, "file1"))
+	require.NoError(t, err)
+	_, err = buck2.PullRemote(context.Background())
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrUpToDate))
+}
+
 func addRandomFile(t *testing.T, buck *Bucket, pth string, size int64) string {
 	bp, err := buck.Path()
 	require.NoError(t, err)
@@ -148,13 +148,40 @@ func (b *Buckets) NewBucket(ctx context.Context, conf Config, opts ...NewOption)
 		return nil, err
 	}
 
+	if args.car != nil && conf.Key == "" {
+		if args.passphrase != "" {
+			return nil, errors.New("passphrase keys can not be used with a CAR import")
+		}
+		root, err := b.clients.Buckets.ImportNewBucket(
+			ctx,
+			args.car,
+			client.WithName(args.name),
+			client.WithPrivate(args.private))
+		if err != nil {
+			return nil, err
+		}
+		// The imported bucket already has contents, so it's initialized like an existing bucket
+		conf.Key = root.Key
+		buck.conf.Viper.Set("key", root.Key)
+	}
+
 	initRemote := conf.Key == ""
 	if initRemote {
-		rep, err := b.clients.Buckets.Init(
-			ctx,
+		initOpts := []client.InitOption{
 			client.WithName(args.name),
 			client.WithPrivate(args.private),
-			client.WithCid(args.fromCid))
+			client.WithCid(args.fromCid),
+		}
+		if args.passphrase != "" {
+			params, err := NewKeyParams()
+			if err != nil {
+				return nil, err
+			}
+			key := DeriveKey(args.passphrase, params)
+			initOpts = append(initOpts, client.WithKey(key))
+			buck.setKeyParams(params, key)
+		}
+		rep, err := b.clients.Buckets.Init(ctx, initOpts...)
 		if err != nil {
 			return nil, err
 		}
@@ -228,13 +255,27 @@ func (b *Buckets) NewBucket(ctx context.Context, conf Config, opts ...NewOption)
 
 	// Pull remote bucket contents
 	if !initRemote || args.fromCid.Defined() {
-		if _, err := buck.getPath(ctx, "", cwd, nil, false, args.events); err != nil {
+		if _, err := buck.getPath(ctx, "", cwd, nil, &pathOptions{cache: args.cache, events: args.events}); err != nil {
 			return nil, err
 		}
 		if err = buck.repo.Save(ctx); err != nil {
 			return nil, err
 		}
 	}
+
+	// Push archive contents
+	if initRemote && args.archive != nil {
+		if err = extractArchive(args.archive, cwd, buck.conf.Dir); err != nil {
+			return nil, err
+		}
+		var opts []PathOption
+		if args.events != nil {
+			opts = append(opts, WithPathEvents(args.events))
+		}
+		if _, err = buck.PushLocal(ctx, opts...); err != nil && !errors.Is(err, ErrUpToDate) {
+			return nil, err
+		}
+	}
 	return buck, nil
 }
 
@@ -276,8 +317,12 @@ func (b *Buckets) GetLocalBucket(ctx context.Context, pth string) (*Bucket, erro
 // RemoteBuckets lists all existing remote buckets in the thread.
 // If id is not defined, this will return buckets from all threads.
 // In a hub context, this will only list buckets that the context
-// has access to.
-func (b *Buckets) RemoteBuckets(ctx context.Context, id thread.ID) (list []BucketInfo, err error) {
+// has access to. Use WithTagFilter to only list buckets with certain tags.
+func (b *Buckets) RemoteBuckets(ctx context.Context, id thread.ID, opts ...ListOption) (list []BucketInfo, err error) {
+	args := &listOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
 	ctx = b.Context(ctx)
 	var threads []cmd.Thread
 	if id.Defined() {
@@ -287,7 +332,7 @@ func (b *Buckets) RemoteBuckets(ctx context.Context, id thread.ID) (list []Bucke
 	}
 	for _, t := range threads {
 		ctx = common.NewThreadIDContext(ctx, t.ID)
-		res, err := b.clients.Buckets.List(ctx)
+		res, err := b.clients.Buckets.List(ctx, client.WithTagFilter(args.tags))
 		if err != nil {
 			return nil, err
 		}
@@ -1,6 +1,8 @@
 package local_test
 
 import (
+	"archive/tar"
+	"bytes"
 	"context"
 	"io/ioutil"
 	"os"
@@ -70,6 +72,51 @@ func TestBuckets_NewBucket(t *testing.T) {
 		assert.NotEmpty(t, reloaded)
 	})
 
+	t.Run("new passphrase bucket", func(t *testing.T) {
+		conf := getConf(t, buckets)
+		buck, err := buckets.NewBucket(context.Background(), conf, WithPrivate(true), WithPassphrase("secret"))
+		require.NoError(t, err)
+		assert.NotEmpty(t, buck)
+
+		reloaded, err := buckets.GetLocalBucket(context.Background(), conf.Path)
+		require.NoError(t, err)
+		key, err := reloaded.UnlockKey("secret")
+		require.NoError(t, err)
+		params, err := reloaded.KeyParams()
+		require.NoError(t, err)
+		assert.Equal(t, DeriveKey("secret", params), key)
+		_, err = reloaded.UnlockKey("wrong")
+		assert.Equal(t, ErrWrongPassphrase, err)
+	})
+
+	t.Run("new bucket from archive", func(t *testing.T) {
+		conf := getConf(t, buckets)
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		files := map[string]string{
+			"index.html":          "home",
+			"docs/index.html":     "docs",
+			".textile/config.yml": "skipped",
+		}
+		for n, data := range files {
+			err := tw.WriteHeader(&tar.Header{Name: n, Mode: 0644, Size: int64(len(data)), Typeflag: tar.TypeReg})
+			require.NoError(t, err)
+			_, err = tw.Write([]byte(data))
+			require.NoError(t, err)
+		}
+		require.NoError(t, tw.Close())
+		buck, err := buckets.NewBucket(context.Background(), conf, WithArchive(&buf))
+		require.NoError(t, err)
+
+		items, err := buck.ListRemotePath(context.Background(), "")
+		require.NoError(t, err)
+		assert.Len(t, items, 3)
+
+		diff, err := buck.DiffLocal()
+		require.NoError(t, err)
+		assert.Empty(t, diff)
+	})
+
 	t.Run("new bootstrapped bucket", func(t *testing.T) {
 		conf := getConf(t, buckets)
 		pth := createIpfsFolder(t)
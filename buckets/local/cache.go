@@ -0,0 +1,85 @@
+package local
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	cid "github.com/ipfs/go-cid"
+)
+
+// Cache is a content-addressed store of pulled files that is shared between local buckets.
+// Files are keyed by their remote cid, so a file that is already cached is copied
+// instead of downloaded when it's pulled again, e.g., by a fresh checkout of the same bucket.
+type Cache struct {
+	dir string
+}
+
+// NewCache returns a cache that stores files in dir, creating it if needed.
+func NewCache(dir string) (*Cache, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return &Cache{dir: dir}, nil
+}
+
+// path returns the cache file name for cid.
+// Files are sharded by the last two characters of the cid, like the local repo's flatfs datastore.
+func (c *Cache) path(id cid.Cid) string {
+	s := id.String()
+	return filepath.Join(c.dir, s[len(s)-2:], s)
+}
+
+// Get copies the cached file with cid to w.
+// Returns false if the file is not cached.
+func (c *Cache) Get(id cid.Cid, w io.Writer) (bool, error) {
+	file, err := os.Open(c.path(id))
+	if os.IsNotExist(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	defer file.Close()
+	if _, err := io.Copy(w, file); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Has returns whether or not the file with cid is cached.
+func (c *Cache) Has(id cid.Cid) bool {
+	_, err := os.Stat(c.path(id))
+	return err == nil
+}
+
+// Put copies the file name into the cache under cid.
+// Files are written to a temporary file first, so a partially written file is never cached.
+func (c *Cache) Put(id cid.Cid, name string) error {
+	if c.Has(id) {
+		return nil
+	}
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst := c.path(id)
+	if err := os.MkdirAll(filepath.Dir(dst), os.ModePerm); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(dst), ".tmp-")
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(tmp, src); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), dst)
+}
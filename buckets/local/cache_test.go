@@ -0,0 +1,43 @@
+package local_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/buckets/local"
+)
+
+func TestCache(t *testing.T) {
+	dir := newDir(t)
+	cache, err := NewCache(filepath.Join(dir, "cache"))
+	require.NoError(t, err)
+
+	data := []byte("hello cache")
+	hash, err := mh.Sum(data, mh.SHA2_256, -1)
+	require.NoError(t, err)
+	c := cid.NewCidV1(cid.Raw, hash)
+
+	assert.False(t, cache.Has(c))
+	var buf bytes.Buffer
+	ok, err := cache.Get(c, &buf)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	name := filepath.Join(dir, "file")
+	err = ioutil.WriteFile(name, data, 0644)
+	require.NoError(t, err)
+	err = cache.Put(c, name)
+	require.NoError(t, err)
+	assert.True(t, cache.Has(c))
+
+	ok, err = cache.Get(c, &buf)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, data, buf.Bytes())
+}
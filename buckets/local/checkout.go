@@ -0,0 +1,123 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	cid "github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/textile/util"
+)
+
+var (
+	// ErrDetached indicates the local bucket is checked out at a previous root, which can't be pushed to.
+	ErrDetached = errors.New("bucket is checked out at a previous root, pull to return to the latest root")
+
+	// ErrCheckoutChanges indicates there are local changes that must be pushed before checking out a root.
+	ErrCheckoutChanges = errors.New("local changes must be pushed before checking out a root")
+
+	// ErrRefNotFound indicates a ref doesn't match a root, snapshot, or version of the bucket.
+	ErrRefNotFound = errors.New("ref does not match a root, snapshot, or version")
+)
+
+// Checkout pulls the bucket as it was at ref into the local bucket path.
+// ref is a remote root CID or path, the name of a snapshot, or the ID of a version.
+// The local bucket is left detached at the root, so pushes fail with ErrDetached
+// until PullRemote returns the local bucket to the latest remote root.
+// Only files that differ from the root are downloaded.
+// Returns ErrCheckoutChanges if there are unpushed local changes.
+func (b *Bucket) Checkout(ctx context.Context, ref string, opts ...PathOption) (root path.Resolved, err error) {
+	b.Lock()
+	defer b.Unlock()
+	ctx, err = b.context(ctx)
+	if err != nil {
+		return
+	}
+	args := &pathOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+
+	diff, err := b.DiffLocal()
+	if err != nil {
+		return
+	}
+	if len(diff) > 0 {
+		return nil, ErrCheckoutChanges
+	}
+	root, err = b.resolveRef(ctx, ref)
+	if err != nil {
+		return
+	}
+	bp, err := b.Path()
+	if err != nil {
+		return
+	}
+
+	args.root = root
+	if _, err = b.getPath(ctx, "", bp, nil, args); err != nil {
+		return
+	}
+	if err = b.repo.Save(ctx); err != nil {
+		return
+	}
+	// Tracking the checked out root lets the next pull fast-forward from it
+	if err = b.repo.SetRemotePath("", root.Cid()); err != nil {
+		return
+	}
+	return root, b.setDetached(root)
+}
+
+// Detached returns the remote root the local bucket is checked out at, see Checkout.
+// ok is false if the local bucket follows the latest remote root.
+func (b *Bucket) Detached() (root path.Resolved, ok bool) {
+	root, err := util.NewResolvedPath(b.conf.Viper.GetString("detached"))
+	if err != nil {
+		return nil, false
+	}
+	return root, true
+}
+
+// setDetached saves the checked out root to the bucket config.
+// A nil root attaches the local bucket to the latest remote root.
+func (b *Bucket) setDetached(root path.Resolved) error {
+	var s string
+	if root != nil {
+		s = root.String()
+	} else if _, ok := b.Detached(); !ok {
+		return nil
+	}
+	b.conf.Viper.Set("detached", s)
+	return b.conf.Viper.WriteConfig()
+}
+
+// resolveRef returns the remote root for ref, see Checkout.
+func (b *Bucket) resolveRef(ctx context.Context, ref string) (path.Resolved, error) {
+	if strings.HasPrefix(ref, "/ipfs/") {
+		return util.NewResolvedPath(ref)
+	}
+	if c, err := cid.Decode(ref); err == nil {
+		return path.IpfsPath(c), nil
+	}
+	key := b.Key()
+	snaps, err := b.clients.Buckets.ListSnapshots(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range snaps.Snapshots {
+		if s.Name == ref {
+			return util.NewResolvedPath(s.Path)
+		}
+	}
+	versions, err := b.clients.Buckets.ListVersions(ctx, key, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions.Versions {
+		if v.ID == ref {
+			return util.NewResolvedPath(v.Path)
+		}
+	}
+	return nil, ErrRefNotFound
+}
@@ -0,0 +1,47 @@
+package local
+
+import (
+	"context"
+	"fmt"
+
+	pb "github.com/textileio/textile/api/buckets/pb"
+	"github.com/textileio/textile/buckets"
+)
+
+var conflictStrategies = map[buckets.ConflictStrategy]pb.SetConflictStrategyRequest_Strategy{
+	buckets.ConflictLastWriterWins: pb.SetConflictStrategyRequest_LastWriterWins,
+	buckets.ConflictReject:         pb.SetConflictStrategyRequest_Reject,
+	buckets.ConflictKeepBoth:       pb.SetConflictStrategyRequest_KeepBoth,
+	buckets.ConflictMerge:          pb.SetConflictStrategyRequest_Merge,
+}
+
+// SetConflictStrategy sets how the remote bucket handles updates that are based on a stale root.
+func (b *Bucket) SetConflictStrategy(ctx context.Context, strategy buckets.ConflictStrategy) error {
+	ps, ok := conflictStrategies[strategy]
+	if !ok {
+		return fmt.Errorf("invalid conflict strategy: %s", strategy)
+	}
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	return b.clients.Buckets.SetConflictStrategy(ctx, b.Key(), ps)
+}
+
+// ConflictStrategy returns the conflict strategy of the remote bucket.
+func (b *Bucket) ConflictStrategy(ctx context.Context) (buckets.ConflictStrategy, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return "", err
+	}
+	ps, err := b.clients.Buckets.GetConflictStrategy(ctx, b.Key())
+	if err != nil {
+		return "", err
+	}
+	for strategy, s := range conflictStrategies {
+		if s == ps {
+			return strategy, nil
+		}
+	}
+	return buckets.ConflictLastWriterWins, nil
+}
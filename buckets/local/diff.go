@@ -4,8 +4,10 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
+	cid "github.com/ipfs/go-cid"
 	"github.com/ipfs/go-merkledag/dagutils"
 	"github.com/logrusorgru/aurora"
 	"github.com/textileio/textile/buckets"
@@ -18,6 +20,9 @@ type Change struct {
 	Name string // Absolute file name
 	Path string // File name relative to the bucket root
 	Rel  string // File name relative to the bucket current working directory
+
+	// Staged is true if the change is under a path staged for the next push, see Bucket.Stage.
+	Staged bool
 }
 
 // ChangeType returns a string representation of a change type.
@@ -64,6 +69,10 @@ func (b *Bucket) DiffLocal() ([]Change, error) {
 	if len(diff) == 0 {
 		return all, nil
 	}
+	staged, err := b.repo.StagedPaths()
+	if err != nil {
+		return nil, err
+	}
 	for _, c := range diff {
 		fp := filepath.Join(bp, c.Path)
 		switch c.Type {
@@ -78,24 +87,139 @@ func (b *Bucket) DiffLocal() ([]Change, error) {
 				if err != nil {
 					return nil, err
 				}
-				all = append(all, Change{Type: c.Type, Name: n, Path: p, Rel: r})
+				all = append(all, Change{Type: c.Type, Name: n, Path: p, Rel: r, Staged: isStaged(staged, p)})
 			}
 		case dagutils.Remove:
 			r, err := filepath.Rel(b.cwd, fp)
 			if err != nil {
 				return nil, err
 			}
-			all = append(all, Change{Type: c.Type, Name: fp, Path: c.Path, Rel: r})
+			all = append(all, Change{Type: c.Type, Name: fp, Path: c.Path, Rel: r, Staged: isStaged(staged, c.Path)})
 		}
 	}
 	return all, nil
 }
 
+// RemoteChangeType describes how a path differs between the local bucket and the remote.
+type RemoteChangeType string
+
+const (
+	// RemoteAdded indicates a path only exists locally.
+	RemoteAdded RemoteChangeType = "added"
+	// RemoteModified indicates a path exists locally and on the remote with different content.
+	RemoteModified RemoteChangeType = "modified"
+	// RemoteDeleted indicates a path only exists on the remote.
+	RemoteDeleted RemoteChangeType = "deleted"
+)
+
+// RemoteChange describes a difference between a local bucket file and the remote.
+type RemoteChange struct {
+	Type RemoteChangeType `json:"type"`
+	// Path relative to the bucket root.
+	Path string `json:"path"`
+	// Size of the local file, or of the remote file if it was deleted locally.
+	Size int64 `json:"size"`
+	// Cid of the local file, or of the remote file if it was deleted locally.
+	// Note that local and remote cids differ unless files are pushed deterministically.
+	Cid cid.Cid `json:"cid"`
+	// RemoteSize of a modified file.
+	RemoteSize int64 `json:"remote_size,omitempty"`
+	// RemoteCid of a modified file.
+	RemoteCid cid.Cid `json:"remote_cid,omitempty"`
+}
+
+// DiffRemote returns the files that differ between the local bucket and the remote, sorted by path.
+// Unlike DiffLocal, this compares against the current remote bucket, including changes that have not been pulled.
+func (b *Bucket) DiffRemote(ctx context.Context) ([]RemoteChange, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bp, err := b.Path()
+	if err != nil {
+		return nil, err
+	}
+	all, _, err := b.listPath(ctx, b.Key(), "", bp, &pathOptions{force: true})
+	if err != nil {
+		return nil, err
+	}
+	ig, err := loadIgnorer(bp)
+	if err != nil {
+		return nil, err
+	}
+	remote := make(map[string]object)
+	for _, o := range all {
+		// Ignored paths are never pushed, so they don't count as local deletions
+		if o.path == buckets.SeedName || ig.ignored(o.path, false) {
+			continue
+		}
+		remote[o.path] = o
+	}
+	names, err := b.walkPath(bp)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []RemoteChange
+	for _, n := range names {
+		p := strings.TrimPrefix(n, bp+"/")
+		info, err := os.Stat(n)
+		if err != nil {
+			return nil, err
+		}
+		lc, err := b.repo.HashFile(n)
+		if err != nil {
+			return nil, err
+		}
+		o, ok := remote[p]
+		if !ok {
+			changes = append(changes, RemoteChange{Type: RemoteAdded, Path: p, Size: info.Size(), Cid: lc})
+			continue
+		}
+		delete(remote, p)
+		synced, err := b.isSynced(o)
+		if err != nil {
+			return nil, err
+		}
+		if !synced {
+			changes = append(changes, RemoteChange{
+				Type:       RemoteModified,
+				Path:       p,
+				Size:       info.Size(),
+				Cid:        lc,
+				RemoteSize: o.size,
+				RemoteCid:  o.cid,
+			})
+		}
+	}
+	for p, o := range remote {
+		changes = append(changes, RemoteChange{Type: RemoteDeleted, Path: p, Size: o.size, Cid: o.cid})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
 func (b *Bucket) walkPath(pth string) (names []string, err error) {
+	bp, err := b.Path()
+	if err != nil {
+		return
+	}
+	ig, err := loadIgnorer(bp)
+	if err != nil {
+		return
+	}
 	err = filepath.Walk(pth, func(n string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
+		if b.skipPath(ig, bp, n, info.IsDir()) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
 		if !info.IsDir() {
 			f := strings.TrimPrefix(n, pth+"/")
 			if Ignore(n) || f == buckets.SeedName || strings.HasPrefix(f, b.conf.Dir) || strings.HasSuffix(f, patchExt) {
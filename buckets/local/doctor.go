@@ -0,0 +1,205 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/api/common"
+	"github.com/textileio/textile/buckets"
+)
+
+// Problem describes a problem with the local state of a bucket, see Doctor.
+type Problem struct {
+	// Desc describes the problem.
+	Desc string `json:"desc"`
+	// Fixed is true if the problem was repaired.
+	Fixed bool `json:"fixed"`
+}
+
+// Doctor checks the local state of the bucket for problems left behind by interrupted commands
+// or edits to the bucket config, i.e., missing config fields, a stale root pointer, and orphaned staged paths.
+// If repair is true, problems are fixed where possible by re-syncing local state from the remote,
+// which avoids having to re-clone the bucket.
+// A repaired root pointer only tracks local files that match the remote, so other local files show up as changes.
+func (b *Bucket) Doctor(ctx context.Context, repair bool) (problems []Problem, err error) {
+	b.Lock()
+	defer b.Unlock()
+	report := func(fix func() (bool, error), format string, args ...interface{}) error {
+		p := Problem{Desc: fmt.Sprintf(format, args...)}
+		if repair && fix != nil {
+			fixed, err := fix()
+			if err != nil {
+				return err
+			}
+			p.Fixed = fixed
+		}
+		problems = append(problems, p)
+		return nil
+	}
+
+	// Without a key, the bucket can't be found on the remote
+	key := b.Key()
+	if key == "" {
+		return problems, report(nil, "config is missing the bucket key")
+	}
+	if id, err := b.Thread(); err != nil || !id.Defined() {
+		if err := report(func() (bool, error) {
+			id, err := b.findThread(ctx, key)
+			if err != nil || !id.Defined() {
+				return false, err
+			}
+			b.conf.Viper.Set("thread", id.String())
+			return true, b.conf.Viper.WriteConfig()
+		}, "config is missing a valid thread ID"); err != nil {
+			return problems, err
+		}
+		if id, err := b.Thread(); err != nil || !id.Defined() {
+			return problems, nil
+		}
+	}
+	if v := b.conf.Viper.GetString("detached"); v != "" {
+		if _, ok := b.Detached(); !ok {
+			if err := report(func() (bool, error) {
+				b.conf.Viper.Set("detached", "")
+				return true, b.conf.Viper.WriteConfig()
+			}, "config has an invalid detached root: %s", v); err != nil {
+				return problems, err
+			}
+		}
+	}
+
+	ctx, err = b.context(ctx)
+	if err != nil {
+		return
+	}
+	lc, rc, err := b.repo.Root()
+	if err != nil {
+		return
+	}
+	// The saved tree can't be diffed with a stale root pointer until it's fixed
+	diffable := true
+	stale := func(format string, args ...interface{}) error {
+		diffable = repair
+		return report(func() (bool, error) {
+			return true, b.resync(ctx)
+		}, format, args...)
+	}
+	if lc.Defined() {
+		if _, err := b.repo.GetNode(ctx, lc); err != nil {
+			if err := stale("local root %s is missing from the repo", lc); err != nil {
+				return problems, err
+			}
+		} else if !rc.Defined() {
+			if err := stale("repo is missing the remote root"); err != nil {
+				return problems, err
+			}
+		}
+	}
+	if !diffable {
+		return problems, nil
+	}
+
+	staged, err := b.repo.StagedPaths()
+	if err != nil {
+		return
+	}
+	if len(staged) == 0 {
+		return problems, nil
+	}
+	diff, err := b.DiffLocal()
+	if err != nil {
+		return
+	}
+	var keep []string
+	for _, s := range staged {
+		orphaned := true
+		for _, c := range diff {
+			if isStaged([]string{s}, c.Path) {
+				orphaned = false
+				break
+			}
+		}
+		if !orphaned {
+			keep = append(keep, s)
+			continue
+		}
+		if err := report(func() (bool, error) {
+			return true, nil
+		}, "staged path %s has no changes", s); err != nil {
+			return problems, err
+		}
+	}
+	if repair && len(keep) < len(staged) {
+		if err = b.repo.SetStagedPaths(keep); err != nil {
+			return
+		}
+	}
+	return problems, nil
+}
+
+// findThread returns the ID of the thread that contains the bucket with key.
+// An undefined ID is returned if the bucket isn't found in any thread.
+func (b *Bucket) findThread(ctx context.Context, key string) (thread.ID, error) {
+	if b.auth != nil {
+		ctx = b.auth(ctx)
+	}
+	for _, t := range b.clients.ListThreads(ctx, true) {
+		res, err := b.clients.Buckets.List(common.NewThreadIDContext(ctx, t.ID))
+		if err != nil {
+			return thread.Undef, err
+		}
+		for _, r := range res.Roots {
+			if r.Key == key {
+				return t.ID, nil
+			}
+		}
+	}
+	return thread.Undef, nil
+}
+
+// resync rebuilds the saved tree and remote root pointer from the remote bucket.
+// Only local files that match the remote are saved, so all other local files show up as changes.
+func (b *Bucket) resync(ctx context.Context) error {
+	bp, err := b.Path()
+	if err != nil {
+		return err
+	}
+	all, _, err := b.listPath(ctx, b.Key(), "", bp, &pathOptions{force: true})
+	if err != nil {
+		return err
+	}
+	var synced []string
+	if _, err := os.Stat(filepath.Join(bp, buckets.SeedName)); err == nil {
+		synced = append(synced, buckets.SeedName)
+	}
+	for _, o := range all {
+		if o.path == buckets.SeedName {
+			continue
+		}
+		ok, err := b.isSynced(o)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if err := b.repo.SetRemotePath(o.path, o.cid); err != nil {
+			return err
+		}
+		synced = append(synced, o.path)
+	}
+	rc, err := b.getRemoteRoot(ctx)
+	if err != nil {
+		return err
+	}
+	if err := b.repo.ResetTree(ctx); err != nil {
+		return err
+	}
+	if err := b.repo.SavePaths(ctx, synced); err != nil {
+		return err
+	}
+	return b.repo.SetRemotePath("", rc)
+}
@@ -0,0 +1,95 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	"github.com/textileio/textile/api/buckets/client"
+	pb "github.com/textileio/textile/api/buckets/pb"
+)
+
+// Domain describes a custom domain whose DNSLink record points at the bucket root.
+type Domain struct {
+	ID             string    `json:"id"`
+	Name           string    `json:"name"`
+	Provider       string    `json:"provider"`
+	ChallengeName  string    `json:"challenge_name"`
+	ChallengeValue string    `json:"challenge_value"`
+	Verified       bool      `json:"verified"`
+	Pending        bool      `json:"pending"`
+	LastRoot       string    `json:"last_root,omitempty"`
+	LastSyncedAt   time.Time `json:"last_synced_at"`
+	LastError      string    `json:"last_error,omitempty"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+// AddDomain points the DNSLink record of the custom domain name at the remote bucket root.
+func (b *Bucket) AddDomain(ctx context.Context, name string, provider client.DomainProvider) (*Domain, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d, err := b.clients.Buckets.AddDomain(ctx, b.Key(), name, provider)
+	if err != nil {
+		return nil, err
+	}
+	return pbDomainToDomain(d), nil
+}
+
+// ListDomains returns the custom domains of the remote bucket.
+func (b *Bucket) ListDomains(ctx context.Context) ([]Domain, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := b.clients.Buckets.ListDomains(ctx, b.Key())
+	if err != nil {
+		return nil, err
+	}
+	domains := make([]Domain, len(list))
+	for i, d := range list {
+		domains[i] = *pbDomainToDomain(d)
+	}
+	return domains, nil
+}
+
+// VerifyDomain checks the verification record of the custom domain with id.
+func (b *Bucket) VerifyDomain(ctx context.Context, id string) (*Domain, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	d, err := b.clients.Buckets.VerifyDomain(ctx, b.Key(), id)
+	if err != nil {
+		return nil, err
+	}
+	return pbDomainToDomain(d), nil
+}
+
+// RemoveDomain stops managing the DNSLink record of the custom domain with id.
+func (b *Bucket) RemoveDomain(ctx context.Context, id string) error {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	return b.clients.Buckets.RemoveDomain(ctx, b.Key(), id)
+}
+
+func pbDomainToDomain(d *pb.Domain) *Domain {
+	dom := &Domain{
+		ID:             d.Id,
+		Name:           d.Name,
+		Provider:       d.Provider,
+		ChallengeName:  d.ChallengeName,
+		ChallengeValue: d.ChallengeValue,
+		Verified:       d.Verified,
+		Pending:        d.Pending,
+		LastRoot:       d.LastRoot,
+		LastError:      d.LastError,
+		CreatedAt:      time.Unix(0, d.CreatedAt),
+	}
+	if d.LastSyncedAt > 0 {
+		dom.LastSyncedAt = time.Unix(0, d.LastSyncedAt)
+	}
+	return dom
+}
@@ -0,0 +1,124 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-merkledag/dagutils"
+	"github.com/textileio/textile/buckets"
+)
+
+// dryRunPush returns the changes in diff that a push would send to the remote.
+// Files that were accepted by the remote before a previous push was interrupted are skipped, like PushLocal does.
+func (b *Bucket) dryRunPush(diff []Change) ([]Change, error) {
+	var changes []Change
+	for _, c := range diff {
+		switch c.Type {
+		case dagutils.Mod, dagutils.Add:
+			lc, err := b.repo.HashFile(c.Name)
+			if err != nil {
+				return nil, err
+			}
+			pushed, err := b.repo.IsPushed(c.Path, lc)
+			if err != nil {
+				return nil, err
+			}
+			if pushed {
+				continue
+			}
+		}
+		changes = append(changes, c)
+	}
+	return changes, nil
+}
+
+// dryRunPull returns the changes a pull would make to local files, given the local diff.
+// Unless pulling hard, local changes are kept if the remote file didn't change since the last pull.
+func (b *Bucket) dryRunPull(ctx context.Context, diff []Change, args *pathOptions) ([]Change, error) {
+	bp, err := b.Path()
+	if err != nil {
+		return nil, err
+	}
+	all, _, err := b.listPath(ctx, b.Key(), "", bp, &pathOptions{force: true, filter: args.filter})
+	if err != nil {
+		return nil, err
+	}
+	localChanges := make(map[string]Change)
+	for _, c := range diff {
+		localChanges[c.Path] = c
+	}
+
+	var changes []Change
+	change := func(t dagutils.ChangeType, n string) error {
+		r, err := filepath.Rel(b.cwd, n)
+		if err != nil {
+			return err
+		}
+		p := strings.TrimPrefix(n, bp+"/")
+		changes = append(changes, Change{Type: t, Name: n, Path: p, Rel: r})
+		return nil
+	}
+	remote := make(map[string]struct{})
+	for _, o := range all {
+		if o.path == buckets.SeedName {
+			continue
+		}
+		remote[o.name] = struct{}{}
+		if _, ok := localChanges[o.path]; ok && !args.hard {
+			_, rc, err := b.repo.GetPathMap(o.path)
+			if err != nil && !errors.Is(err, ds.ErrNotFound) {
+				return nil, err
+			}
+			if rc.Defined() && rc.Equals(o.cid) { // Only changed locally
+				continue
+			}
+		} else if !args.force {
+			synced, err := b.isSynced(o)
+			if err != nil {
+				return nil, err
+			}
+			if synced {
+				continue
+			}
+		}
+		t := dagutils.Mod
+		if _, err := os.Stat(o.name); os.IsNotExist(err) {
+			t = dagutils.Add
+		} else if err != nil {
+			return nil, err
+		}
+		if err := change(t, o.name); err != nil {
+			return nil, err
+		}
+	}
+
+	// Local files that don't exist on the remote are removed, except for local additions when not pulling hard
+	names, err := b.walkPath(bp)
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range names {
+		if _, ok := remote[n]; ok {
+			continue
+		}
+		p := strings.TrimPrefix(n, bp+"/")
+		if !args.filter.selected(p, false) {
+			continue
+		}
+		if c, ok := localChanges[p]; ok && c.Type == dagutils.Add && !args.hard {
+			continue
+		}
+		if err := change(dagutils.Remove, n); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
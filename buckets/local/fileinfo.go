@@ -0,0 +1,42 @@
+package local
+
+import (
+	"os"
+	"strconv"
+	"time"
+)
+
+const (
+	// AttrMtime is the metadata attribute that holds a pushed file's modification time, see WithFileInfo.
+	AttrMtime = "mtime"
+	// AttrMode is the metadata attribute that holds a pushed file's permission bits in octal, see WithFileInfo.
+	AttrMode = "mode"
+)
+
+// fileInfoAttributes returns the metadata attributes that preserve the mtime and permission bits in info.
+func fileInfoAttributes(info os.FileInfo) map[string]string {
+	return map[string]string{
+		AttrMtime: info.ModTime().UTC().Format(time.RFC3339Nano),
+		AttrMode:  strconv.FormatUint(uint64(info.Mode().Perm()), 8),
+	}
+}
+
+// restoreFileInfo sets the mtime and permission bits of the file name from metadata attributes.
+// Missing or malformed attributes are ignored, e.g., if the file was pushed without WithFileInfo.
+func restoreFileInfo(name string, attrs map[string]string) error {
+	if v, ok := attrs[AttrMode]; ok {
+		if mode, err := strconv.ParseUint(v, 8, 32); err == nil {
+			if err := os.Chmod(name, os.FileMode(mode).Perm()); err != nil {
+				return err
+			}
+		}
+	}
+	if v, ok := attrs[AttrMtime]; ok {
+		if mtime, err := time.Parse(time.RFC3339Nano, v); err == nil {
+			if err := os.Chtimes(name, mtime, mtime); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
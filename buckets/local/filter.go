@@ -0,0 +1,76 @@
+package local
+
+import (
+	"strings"
+)
+
+// pathFilter selects bucket paths with include and exclude patterns, see WithInclude and WithExclude.
+// Patterns use the same syntax as the ignore file, see IgnoreFile.
+// The zero value selects everything.
+type pathFilter struct {
+	include *ignorer
+	exclude *ignorer
+}
+
+// active returns whether or not the filter has any patterns.
+func (f pathFilter) active() bool {
+	return f.include != nil || f.exclude != nil
+}
+
+// selected returns whether or not the path, relative to the bucket root, is selected.
+// Excluded paths are never selected. If there are include patterns, a path must also match one of them,
+// or be inside a matching directory. Directories only need to not be excluded so they can be walked.
+func (f pathFilter) selected(pth string, isDir bool) bool {
+	if f.exclude.ignored(pth, isDir) {
+		return false
+	}
+	if isDir || f.include == nil {
+		return true
+	}
+	return f.include.ignored(pth, false)
+}
+
+// changes returns the changes in diff with selected paths.
+func (f pathFilter) changes(diff []Change) []Change {
+	if !f.active() {
+		return diff
+	}
+	var filtered []Change
+	for _, c := range diff {
+		if f.selected(c.Path, false) {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// addPatterns appends patterns to i, returning a new ignorer if i is nil.
+func addPatterns(i *ignorer, patterns []string) *ignorer {
+	for _, p := range patterns {
+		rule, ok := parseIgnoreRule(strings.TrimSpace(p))
+		if !ok {
+			continue
+		}
+		if i == nil {
+			i = &ignorer{}
+		}
+		i.rules = append(i.rules, rule)
+	}
+	return i
+}
+
+// selectedPaths returns the paths, relative to the bucket root, of local files in bp that are selected by f.
+func (b *Bucket) selectedPaths(bp string, f pathFilter) ([]string, error) {
+	names, err := b.walkPath(bp)
+	if err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, n := range names {
+		p := strings.TrimPrefix(n, bp+"/")
+		if f.selected(p, false) {
+			paths = append(paths, p)
+		}
+	}
+	return paths, nil
+}
@@ -0,0 +1,53 @@
+package local
+
+import (
+	"context"
+	"time"
+)
+
+// LegalHold describes a legal hold placed on a bucket.
+type LegalHold struct {
+	Actor     string    `json:"actor"`
+	Reason    string    `json:"reason"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// SetLegalHold places a legal hold on the remote bucket.
+func (b *Bucket) SetLegalHold(ctx context.Context, reason string) error {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = b.clients.Buckets.SetLegalHold(ctx, b.Key(), reason)
+	return err
+}
+
+// ReleaseLegalHold releases a legal hold on the remote bucket.
+func (b *Bucket) ReleaseLegalHold(ctx context.Context, reason string) error {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	return b.clients.Buckets.ReleaseLegalHold(ctx, b.Key(), reason)
+}
+
+// LegalHold returns the legal hold on the remote bucket.
+// The returned hold is nil if the bucket is not under legal hold.
+func (b *Bucket) LegalHold(ctx context.Context) (*LegalHold, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	rep, err := b.clients.Buckets.GetLegalHold(ctx, b.Key())
+	if err != nil {
+		return nil, err
+	}
+	if rep.Hold == nil {
+		return nil, nil
+	}
+	return &LegalHold{
+		Actor:     rep.Hold.Actor,
+		Reason:    rep.Hold.Reason,
+		CreatedAt: time.Unix(0, rep.Hold.CreatedAt),
+	}, nil
+}
@@ -0,0 +1,139 @@
+package local
+
+import (
+	"bufio"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IgnoreFile is the name of the file at the bucket root that lists paths which should not be pushed.
+// Patterns follow gitignore semantics: blank lines and lines starting with "#" are skipped,
+// a leading "!" re-includes a previously ignored path, a trailing "/" only matches directories,
+// a pattern containing a "/" is relative to the bucket root, and "**" matches any number of directories.
+const IgnoreFile = ".buckignore"
+
+// ignoreRule is a single parsed ignore pattern.
+type ignoreRule struct {
+	segs    []string
+	negate  bool
+	dirOnly bool
+}
+
+// ignorer matches bucket paths against ignore rules.
+// The zero value ignores nothing.
+type ignorer struct {
+	rules []ignoreRule
+}
+
+// loadIgnorer reads the ignore file at root.
+// A missing ignore file results in an ignorer that ignores nothing.
+func loadIgnorer(root string) (*ignorer, error) {
+	f, err := os.Open(filepath.Join(root, IgnoreFile))
+	if os.IsNotExist(err) {
+		return &ignorer{}, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return parseIgnorer(f)
+}
+
+// parseIgnorer parses gitignore style patterns from r.
+func parseIgnorer(r io.Reader) (*ignorer, error) {
+	i := &ignorer{}
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		if rule, ok := parseIgnoreRule(s.Text()); ok {
+			i.rules = append(i.rules, rule)
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func parseIgnoreRule(line string) (rule ignoreRule, ok bool) {
+	line = strings.TrimRight(line, "\r")
+	if !strings.HasSuffix(line, `\ `) {
+		line = strings.TrimRight(line, " ")
+	}
+	if line == "" || strings.HasPrefix(line, "#") {
+		return rule, false
+	}
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return rule, false
+	}
+	// Patterns without a slash match at any depth
+	anchored := strings.Contains(line, "/")
+	rule.segs = strings.Split(strings.TrimPrefix(line, "/"), "/")
+	if !anchored {
+		rule.segs = append([]string{"**"}, rule.segs...)
+	}
+	return rule, true
+}
+
+// ignored returns whether or not the path, relative to the bucket root, is ignored.
+// As with git, a path can't be re-included if one of its parent directories is ignored.
+func (i *ignorer) ignored(pth string, isDir bool) bool {
+	if i == nil || len(i.rules) == 0 {
+		return false
+	}
+	parts := strings.Split(filepath.ToSlash(pth), "/")
+	for n := 1; n < len(parts); n++ {
+		if i.match(parts[:n], true) {
+			return true
+		}
+	}
+	return i.match(parts, isDir)
+}
+
+// match returns the result of the last rule that matches parts.
+func (i *ignorer) match(parts []string, isDir bool) (ignored bool) {
+	for _, r := range i.rules {
+		if r.dirOnly && !isDir {
+			continue
+		}
+		if matchSegments(r.segs, parts) {
+			ignored = !r.negate
+		}
+	}
+	return ignored
+}
+
+func matchSegments(pattern, parts []string) bool {
+	if len(pattern) == 0 {
+		return len(parts) == 0
+	}
+	if pattern[0] == "**" {
+		if len(pattern) == 1 { // A trailing "**" matches everything inside
+			return len(parts) > 0
+		}
+		for n := 0; n <= len(parts); n++ {
+			if matchSegments(pattern[1:], parts[n:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(parts) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pattern[0], parts[0]); !ok {
+		return false
+	}
+	return matchSegments(pattern[1:], parts[1:])
+}
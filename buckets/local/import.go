@@ -0,0 +1,78 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	"github.com/textileio/textile/api/buckets/client"
+	pb "github.com/textileio/textile/api/buckets/pb"
+)
+
+// Import describes a server-side import into a bucket.
+type Import struct {
+	ID        string    `json:"id"`
+	Source    string    `json:"source"`
+	Path      string    `json:"path"`
+	Status    string    `json:"status"`
+	Objects   int64     `json:"objects"`
+	Bytes     int64     `json:"bytes"`
+	Cursor    string    `json:"cursor,omitempty"`
+	LastError string    `json:"last_error,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ImportS3 starts importing the objects of the S3 bucket named bucket into the remote bucket.
+// The import runs on the hub, use ListImports to follow its progress.
+func (b *Bucket) ImportS3(ctx context.Context, bucket string, opts ...client.S3ImportOption) (*Import, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	imp, err := b.clients.Buckets.StartS3Import(ctx, b.Key(), bucket, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return pbImportToImport(imp), nil
+}
+
+// ListImports returns the imports of the remote bucket, newest first.
+func (b *Bucket) ListImports(ctx context.Context) ([]Import, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := b.clients.Buckets.ListImports(ctx, b.Key())
+	if err != nil {
+		return nil, err
+	}
+	imports := make([]Import, len(list))
+	for i, imp := range list {
+		imports[i] = *pbImportToImport(imp)
+	}
+	return imports, nil
+}
+
+// CancelImport stops the import with id.
+func (b *Bucket) CancelImport(ctx context.Context, id string) error {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	return b.clients.Buckets.CancelImport(ctx, b.Key(), id)
+}
+
+func pbImportToImport(imp *pb.BucketImport) *Import {
+	return &Import{
+		ID:        imp.Id,
+		Source:    imp.Source,
+		Path:      imp.Path,
+		Status:    imp.Status.String(),
+		Objects:   imp.Objects,
+		Bytes:     imp.Bytes,
+		Cursor:    imp.Cursor,
+		LastError: imp.LastError,
+		CreatedAt: time.Unix(0, imp.CreatedAt),
+		UpdatedAt: time.Unix(0, imp.UpdatedAt),
+	}
+}
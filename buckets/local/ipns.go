@@ -0,0 +1,27 @@
+package local
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+)
+
+// ImportIPNSKey replaces the IPNS key of the remote bucket with privKey.
+// Returns the IPNS name the bucket is published under.
+func (b *Bucket) ImportIPNSKey(ctx context.Context, privKey crypto.PrivKey) (string, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return "", err
+	}
+	return b.clients.Buckets.ImportBucketIPNSKey(ctx, b.Key(), privKey)
+}
+
+// GenerateIPNSKey replaces the IPNS key of the remote bucket with a new key generated by the hub.
+// Returns the IPNS name the bucket is published under and the new key.
+func (b *Bucket) GenerateIPNSKey(ctx context.Context) (string, crypto.PrivKey, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	return b.clients.Buckets.GenerateBucketIPNSKey(ctx, b.Key())
+}
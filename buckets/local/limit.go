@@ -0,0 +1,86 @@
+package local
+
+import (
+	"context"
+	"io"
+
+	"golang.org/x/time/rate"
+)
+
+// maxRateLimitBurst is the max number of bytes a rate limited transfer reads or writes at once.
+const maxRateLimitBurst = 64 * 1024
+
+// newRateLimiter returns a limiter that allows bps bytes per second,
+// or nil if bps is not positive.
+func newRateLimiter(bps int64) *rate.Limiter {
+	if bps <= 0 {
+		return nil
+	}
+	burst := maxRateLimitBurst
+	if bps < int64(burst) {
+		burst = int(bps)
+	}
+	return rate.NewLimiter(rate.Limit(bps), burst)
+}
+
+type limitedReader struct {
+	ctx context.Context
+	r   io.Reader
+	lim *rate.Limiter
+}
+
+func (r *limitedReader) Read(p []byte) (int, error) {
+	if len(p) > r.lim.Burst() {
+		p = p[:r.lim.Burst()]
+	}
+	n, err := r.r.Read(p)
+	if n > 0 {
+		if werr := r.lim.WaitN(r.ctx, n); werr != nil {
+			return n, werr
+		}
+	}
+	return n, err
+}
+
+// limitReader returns a reader that reads no faster than lim allows.
+// If lim is nil, r is returned as is.
+func limitReader(ctx context.Context, r io.Reader, lim *rate.Limiter) io.Reader {
+	if lim == nil {
+		return r
+	}
+	return &limitedReader{ctx: ctx, r: r, lim: lim}
+}
+
+type limitedWriter struct {
+	ctx context.Context
+	w   io.Writer
+	lim *rate.Limiter
+}
+
+func (w *limitedWriter) Write(p []byte) (n int, err error) {
+	for len(p) > 0 {
+		c := len(p)
+		if c > w.lim.Burst() {
+			c = w.lim.Burst()
+		}
+		if err = w.lim.WaitN(w.ctx, c); err != nil {
+			return n, err
+		}
+		m, err := w.w.Write(p[:c])
+		n += m
+		if err != nil {
+			return n, err
+		}
+		p = p[c:]
+	}
+	return n, nil
+}
+
+// limitWriter returns a writer that writes no faster than lim allows.
+// If lim is nil, w is returned as is.
+func limitWriter(ctx context.Context, w io.Writer, lim *rate.Limiter) io.Writer {
+	if lim == nil {
+		return w
+	}
+	return &limitedWriter{ctx: ctx, w: w, lim: lim}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/ipfs/go-cid"
+	"github.com/textileio/textile/api/buckets/client"
 	pb "github.com/textileio/textile/api/buckets/pb"
 )
 
@@ -35,6 +36,40 @@ func (b *Bucket) ListRemotePath(ctx context.Context, pth string) (items []Bucket
 	if err != nil {
 		return
 	}
+	return pbReplyToItems(rep)
+}
+
+// ListRemotePathPages calls fn with each page of up to pageSize bucket items under path.
+// Directory items are listed in name order. Listing stops if fn returns an error.
+func (b *Bucket) ListRemotePathPages(ctx context.Context, pth string, pageSize int64, fn func(items []BucketItem) error) (err error) {
+	if pth == "." || pth == "/" || pth == "./" {
+		pth = ""
+	}
+	ctx, err = b.context(ctx)
+	if err != nil {
+		return
+	}
+	var cursor string
+	for {
+		rep, err := b.clients.Buckets.ListPath(ctx, b.Key(), pth, client.WithListLimit(pageSize), client.WithListCursor(cursor))
+		if err != nil {
+			return err
+		}
+		items, err := pbReplyToItems(rep)
+		if err != nil {
+			return err
+		}
+		if err := fn(items); err != nil {
+			return err
+		}
+		if rep.NextCursor == "" {
+			return nil
+		}
+		cursor = rep.NextCursor
+	}
+}
+
+func pbReplyToItems(rep *pb.ListPathReply) (items []BucketItem, err error) {
 	if len(rep.Item.Items) > 0 {
 		items = make([]BucketItem, len(rep.Item.Items))
 		for j, k := range rep.Item.Items {
@@ -0,0 +1,77 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/textileio/textile/api/buckets/pb"
+)
+
+// PinMirror describes a remote pinning service that pins the bucket root.
+type PinMirror struct {
+	ID           string    `json:"id"`
+	Endpoint     string    `json:"endpoint"`
+	Pending      bool      `json:"pending"`
+	RequestID    string    `json:"request_id,omitempty"`
+	Status       string    `json:"status,omitempty"`
+	LastRoot     string    `json:"last_root,omitempty"`
+	LastSyncedAt time.Time `json:"last_synced_at"`
+	LastError    string    `json:"last_error,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// AddPinMirror pins the remote bucket root on the pinning service at endpoint.
+func (b *Bucket) AddPinMirror(ctx context.Context, endpoint, token string) (*PinMirror, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	m, err := b.clients.Buckets.AddPinMirror(ctx, b.Key(), endpoint, token)
+	if err != nil {
+		return nil, err
+	}
+	return pbPinMirrorToPinMirror(m), nil
+}
+
+// ListPinMirrors returns the pin mirrors of the remote bucket.
+func (b *Bucket) ListPinMirrors(ctx context.Context) ([]PinMirror, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := b.clients.Buckets.ListPinMirrors(ctx, b.Key())
+	if err != nil {
+		return nil, err
+	}
+	mirrors := make([]PinMirror, len(list))
+	for i, m := range list {
+		mirrors[i] = *pbPinMirrorToPinMirror(m)
+	}
+	return mirrors, nil
+}
+
+// RemovePinMirror stops mirroring the remote bucket to the pinning service with id.
+func (b *Bucket) RemovePinMirror(ctx context.Context, id string) error {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	return b.clients.Buckets.RemovePinMirror(ctx, b.Key(), id)
+}
+
+func pbPinMirrorToPinMirror(m *pb.PinMirror) *PinMirror {
+	pm := &PinMirror{
+		ID:        m.Id,
+		Endpoint:  m.Endpoint,
+		Pending:   m.Pending,
+		RequestID: m.RequestId,
+		Status:    m.Status,
+		LastRoot:  m.LastRoot,
+		LastError: m.LastError,
+		CreatedAt: time.Unix(0, m.CreatedAt),
+	}
+	if m.LastSyncedAt > 0 {
+		pm.LastSyncedAt = time.Unix(0, m.LastSyncedAt)
+	}
+	return pm
+}
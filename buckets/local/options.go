@@ -1,14 +1,23 @@
 package local
 
 import (
+	"io"
+	"time"
+
 	cid "github.com/ipfs/go-cid"
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"golang.org/x/time/rate"
 )
 
 type newOptions struct {
-	name    string
-	private bool
-	fromCid cid.Cid
-	events  chan<- PathEvent
+	name       string
+	private    bool
+	passphrase string
+	fromCid    cid.Cid
+	car        io.Reader
+	archive    io.Reader
+	cache      *Cache
+	events     chan<- PathEvent
 }
 
 // NewOption is used when creating a new bucket.
@@ -28,6 +37,15 @@ func WithPrivate(private bool) NewOption {
 	}
 }
 
+// WithPassphrase specifies that the encryption key of a new private bucket is derived from passphrase.
+// The key derivation parameters and a key-check are saved in the local bucket config, see UnlockKey.
+// Ignored when the bucket already exists remotely.
+func WithPassphrase(passphrase string) NewOption {
+	return func(args *newOptions) {
+		args.passphrase = passphrase
+	}
+}
+
 // WithCid indicates that an inited bucket should be boostraped
 // with a particular UnixFS DAG.
 func WithCid(c cid.Cid) NewOption {
@@ -36,6 +54,23 @@ func WithCid(c cid.Cid) NewOption {
 	}
 }
 
+// WithCAR indicates that an inited bucket should be imported from the CARv1 file in r.
+// The CAR root must be a UnixFS directory. WithPassphrase is not supported.
+func WithCAR(r io.Reader) NewOption {
+	return func(args *newOptions) {
+		args.car = r
+	}
+}
+
+// WithArchive indicates that an inited bucket should start with the files
+// in the tarball r, which may be gzip compressed.
+// The files are extracted to the bucket path and pushed.
+func WithArchive(r io.Reader) NewOption {
+	return func(args *newOptions) {
+		args.archive = r
+	}
+}
+
 // WithExistingPathEvents allows the caller to receive path events when pulling
 // files from an existing bucket on initialization.
 func WithExistingPathEvents(ch chan<- PathEvent) NewOption {
@@ -44,11 +79,31 @@ func WithExistingPathEvents(ch chan<- PathEvent) NewOption {
 	}
 }
 
+// WithExistingCache allows the caller to use a cache of pulled files when pulling
+// files from an existing bucket on initialization. See WithCache.
+func WithExistingCache(c *Cache) NewOption {
+	return func(args *newOptions) {
+		args.cache = c
+	}
+}
+
 type pathOptions struct {
-	confirm ConfirmDiffFunc
-	force   bool
-	hard    bool
-	events  chan<- PathEvent
+	confirm       ConfirmDiffFunc
+	dryRun        DryRunFunc
+	force         bool
+	hard          bool
+	deterministic bool
+	fileInfo      bool
+	resolver      ConflictResolver
+	concurrency   int
+	retries       int
+	limiter       *rate.Limiter
+	cache         *Cache
+	filter        pathFilter
+	events        chan<- PathEvent
+
+	// root is a previous remote root to pull from instead of the latest, see Bucket.Checkout.
+	root path.Resolved
 }
 
 // PathOption is used when pushing or pulling bucket paths.
@@ -64,6 +119,33 @@ func WithConfirm(f ConfirmDiffFunc) PathOption {
 	}
 }
 
+// DryRunFunc is a caller-provided function which receives the changes a push or pull would make.
+type DryRunFunc func([]Change)
+
+// WithDryRun reports the changes a push or pull would make to f without making them.
+// For a push, removals are remote deletions. For a pull, changes are to local files.
+func WithDryRun(f DryRunFunc) PathOption {
+	return func(args *pathOptions) {
+		args.dryRun = f
+	}
+}
+
+// WithInclude only pushes or pulls paths that match one of patterns, or are inside a matching directory.
+// Patterns use the same syntax as the ignore file, see IgnoreFile.
+func WithInclude(patterns ...string) PathOption {
+	return func(args *pathOptions) {
+		args.filter.include = addPatterns(args.filter.include, patterns)
+	}
+}
+
+// WithExclude skips paths that match one of patterns, or are inside a matching directory, when pushing or pulling.
+// Patterns use the same syntax as the ignore file, see IgnoreFile.
+func WithExclude(patterns ...string) PathOption {
+	return func(args *pathOptions) {
+		args.filter.exclude = addPatterns(args.filter.exclude, patterns)
+	}
+}
+
 // WithForce indicates that all remote files should be pulled even if they already exist.
 func WithForce(b bool) PathOption {
 	return func(args *pathOptions) {
@@ -78,6 +160,77 @@ func WithHard(b bool) PathOption {
 	}
 }
 
+// WithDeterministic indicates that files should be pushed with fixed UnixFS parameters,
+// so that the remote file CIDs match the local ones.
+func WithDeterministic(b bool) PathOption {
+	return func(args *pathOptions) {
+		args.deterministic = b
+	}
+}
+
+// WithFileInfo indicates that file mtimes and permission bits should be preserved.
+// Pushed files carry them as metadata attributes, see AttrMtime and AttrMode,
+// which are restored to pulled files.
+func WithFileInfo(b bool) PathOption {
+	return func(args *pathOptions) {
+		args.fileInfo = b
+	}
+}
+
+// WithKeepBoth indicates that local changes to files that were also changed on the remote
+// should be kept next to the remote version instead of overwriting it when pulling.
+// This is the same as using KeepBoth as the conflict resolver.
+func WithKeepBoth(b bool) PathOption {
+	return func(args *pathOptions) {
+		if b {
+			args.resolver = KeepBoth
+		} else {
+			args.resolver = nil
+		}
+	}
+}
+
+// WithConflictResolver sets how local changes to files that were also changed on the remote
+// are handled when pulling. A ConflictResolution can be used as a fixed policy.
+// By default, local changes are kept (KeepOurs).
+func WithConflictResolver(r ConflictResolver) PathOption {
+	return func(args *pathOptions) {
+		args.resolver = r
+	}
+}
+
+// WithConcurrency sets the max number of files that are transferred at the same time.
+// By default, pushed files are sent one at a time and all missing files are pulled at once.
+func WithConcurrency(n int) PathOption {
+	return func(args *pathOptions) {
+		args.concurrency = n
+	}
+}
+
+// WithRetries sets the max number of times a file transfer is retried after a transient error.
+// A FileRetry event is sent before each retry. By default, transfers are not retried.
+func WithRetries(n int) PathOption {
+	return func(args *pathOptions) {
+		args.retries = n
+	}
+}
+
+// WithRateLimit limits the combined transfer rate of all files to bps bytes per second.
+// A value of zero means no limit.
+func WithRateLimit(bps int64) PathOption {
+	return func(args *pathOptions) {
+		args.limiter = newRateLimiter(bps)
+	}
+}
+
+// WithCache copies pulled files from c when they are cached, instead of downloading them.
+// Downloaded files are added to c.
+func WithCache(c *Cache) PathOption {
+	return func(args *pathOptions) {
+		args.cache = c
+	}
+}
+
 // WithPathEvents allows the caller to receive path events when pushing or pulling files.
 func WithPathEvents(ch chan<- PathEvent) PathOption {
 	return func(args *pathOptions) {
@@ -123,8 +276,15 @@ func WithAddEvents(ch chan<- PathEvent) AddOption {
 }
 
 type watchOptions struct {
-	offline bool
-	events  chan<- PathEvent
+	offline    bool
+	debounce   time.Duration
+	events     chan<- PathEvent
+	notifyOnly bool
+	onChange   RootChangeFunc
+	pullOpts   []PathOption
+
+	// lastRoot is the last remote root seen by Subscribe.
+	lastRoot cid.Cid
 }
 
 // WatchOption is used when watching a bucket for changes.
@@ -137,9 +297,53 @@ func WithOffline(offline bool) WatchOption {
 	}
 }
 
+// WithDebounce sets the amount of time local changes must settle before they are pushed.
+// Defaults to 500ms.
+func WithDebounce(d time.Duration) WatchOption {
+	return func(args *watchOptions) {
+		args.debounce = d
+	}
+}
+
 // WithWatchEvents allows the caller to receive path events when watching a bucket for changes.
 func WithWatchEvents(ch chan<- PathEvent) WatchOption {
 	return func(args *watchOptions) {
 		args.events = ch
 	}
 }
+
+// WithNotifyOnly leaves the local bucket untouched when subscribing to remote changes.
+// Use WithRootChange to receive the changes.
+func WithNotifyOnly(b bool) WatchOption {
+	return func(args *watchOptions) {
+		args.notifyOnly = b
+	}
+}
+
+// WithRootChange allows the caller to receive remote root changes when subscribing to remote changes.
+func WithRootChange(f RootChangeFunc) WatchOption {
+	return func(args *watchOptions) {
+		args.onChange = f
+	}
+}
+
+// WithPullOptions sets the options used to pull remote changes when subscribing to remote changes.
+func WithPullOptions(opts ...PathOption) WatchOption {
+	return func(args *watchOptions) {
+		args.pullOpts = opts
+	}
+}
+
+type listOptions struct {
+	tags map[string]string
+}
+
+// ListOption is used when listing remote buckets.
+type ListOption func(*listOptions)
+
+// WithTagFilter only lists buckets that have all of tags.
+func WithTagFilter(tags map[string]string) ListOption {
+	return func(args *listOptions) {
+		args.tags = tags
+	}
+}
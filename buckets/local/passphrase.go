@@ -0,0 +1,109 @@
+package local
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+
+	"golang.org/x/crypto/argon2"
+)
+
+var (
+	// ErrWrongPassphrase indicates a passphrase doesn't match the key-check of a bucket.
+	ErrWrongPassphrase = errors.New("wrong passphrase")
+
+	// ErrNoPassphrase indicates a bucket's encryption key was not derived from a passphrase.
+	ErrNoPassphrase = errors.New("bucket key was not derived from a passphrase")
+)
+
+const (
+	// keySize is the size of a bucket encryption key, which is an AES key followed by an HMAC key.
+	keySize = 64
+	// saltSize is the size of the random salt used to derive a key.
+	saltSize = 16
+	// keyCheckMsg is signed with a derived key to produce the key-check.
+	keyCheckMsg = "textile bucket key check"
+)
+
+// KeyParams are the argon2id parameters used to derive a bucket encryption key from a passphrase.
+// Together with the key-check, they're saved in the local bucket config so the key can be derived again.
+type KeyParams struct {
+	Salt    []byte
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+}
+
+// NewKeyParams returns parameters with a random salt and the argon2id defaults recommended by RFC 9106.
+func NewKeyParams() (KeyParams, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return KeyParams{}, err
+	}
+	return KeyParams{
+		Salt:    salt,
+		Time:    1,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}, nil
+}
+
+// DeriveKey derives a bucket encryption key from passphrase using argon2id.
+func DeriveKey(passphrase string, params KeyParams) []byte {
+	return argon2.IDKey([]byte(passphrase), params.Salt, params.Time, params.Memory, params.Threads, keySize)
+}
+
+// keyCheck returns a value that identifies key without revealing it.
+func keyCheck(key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(keyCheckMsg))
+	return mac.Sum(nil)
+}
+
+// setKeyParams saves the key derivation parameters and the key-check to the bucket config.
+func (b *Bucket) setKeyParams(params KeyParams, key []byte) {
+	b.conf.Viper.Set("encryption.salt", base64.StdEncoding.EncodeToString(params.Salt))
+	b.conf.Viper.Set("encryption.time", params.Time)
+	b.conf.Viper.Set("encryption.memory", params.Memory)
+	b.conf.Viper.Set("encryption.threads", params.Threads)
+	b.conf.Viper.Set("encryption.check", base64.StdEncoding.EncodeToString(keyCheck(key)))
+}
+
+// KeyParams returns the parameters used to derive the bucket encryption key from a passphrase.
+// Returns ErrNoPassphrase if the bucket was not created with WithPassphrase.
+func (b *Bucket) KeyParams() (params KeyParams, err error) {
+	salt := b.conf.Viper.GetString("encryption.salt")
+	if salt == "" {
+		return params, ErrNoPassphrase
+	}
+	params.Salt, err = base64.StdEncoding.DecodeString(salt)
+	if err != nil {
+		return
+	}
+	params.Time = b.conf.Viper.GetUint32("encryption.time")
+	params.Memory = b.conf.Viper.GetUint32("encryption.memory")
+	params.Threads = uint8(b.conf.Viper.GetUint("encryption.threads"))
+	return params, nil
+}
+
+// UnlockKey derives the bucket encryption key from passphrase.
+// The derived key is compared with the saved key-check, so a wrong passphrase
+// returns ErrWrongPassphrase instead of a key that can't decrypt anything.
+// Returns ErrNoPassphrase if the bucket was not created with WithPassphrase.
+func (b *Bucket) UnlockKey(passphrase string) ([]byte, error) {
+	params, err := b.KeyParams()
+	if err != nil {
+		return nil, err
+	}
+	check, err := base64.StdEncoding.DecodeString(b.conf.Viper.GetString("encryption.check"))
+	if err != nil {
+		return nil, err
+	}
+	key := DeriveKey(passphrase, params)
+	if !hmac.Equal(keyCheck(key), check) {
+		return nil, ErrWrongPassphrase
+	}
+	return key, nil
+}
@@ -0,0 +1,185 @@
+package local
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/textileio/textile/buckets"
+)
+
+// PreviewHandler returns an HTTP handler that serves the local bucket files as a static website.
+// Index documents, the error document, redirects, and custom headers follow the same rules as the
+// remote gateway, so a site can be previewed before it's pushed.
+// The redirects file is read on each request, so edits show up right away.
+// website may be nil, in which case the gateway defaults are used.
+func (b *Bucket) PreviewHandler(website *buckets.Website) (http.Handler, error) {
+	bp, err := b.Path()
+	if err != nil {
+		return nil, err
+	}
+	return &previewHandler{buck: b, root: bp, website: website}, nil
+}
+
+type previewHandler struct {
+	buck    *Bucket
+	root    string
+	website *buckets.Website
+}
+
+func (h *previewHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	pth := path.Clean("/" + r.URL.Path)
+	ig, err := loadIgnorer(h.root)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	rules, err := h.redirects()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	index := h.website.Index()
+	exists, target := h.exists(ig, pth, index)
+	found := exists || target != "" || pth == "/"
+	if rule, to, ok := buckets.MatchRedirect(rules, pth, found); ok {
+		h.serveRedirect(w, r, ig, rule, to)
+		return
+	}
+	if exists {
+		h.serveFile(w, r, pth, http.StatusOK)
+	} else if target != "" {
+		h.serveFile(w, r, path.Join(pth, target), http.StatusOK)
+	} else if pth == "/" {
+		http.Error(w, fmt.Sprintf("an %s file was not found in this bucket", index), http.StatusNotFound)
+	} else if h.website != nil && h.website.ErrorDocument != "" {
+		doc := "/" + strings.Trim(h.website.ErrorDocument, "/")
+		if ok, _ := h.exists(ig, doc, ""); ok {
+			h.serveFile(w, r, doc, http.StatusNotFound)
+		} else {
+			http.NotFound(w, r)
+		}
+	} else {
+		http.NotFound(w, r)
+	}
+}
+
+// redirects returns the rules of the local redirects file followed by the website redirects,
+// which is the order the gateway applies them in.
+func (h *previewHandler) redirects() ([]buckets.Redirect, error) {
+	var rules []buckets.Redirect
+	f, err := os.Open(filepath.Join(h.root, buckets.RedirectsName))
+	if err == nil {
+		defer f.Close()
+		if rules, err = buckets.ParseRedirects(f); err != nil {
+			return nil, err
+		}
+	} else if !os.IsNotExist(err) {
+		return nil, err
+	}
+	if h.website != nil {
+		rules = append(rules, h.website.Redirects...)
+	}
+	return rules, nil
+}
+
+// exists returns whether or not a file that would be pushed exists at pth.
+// If pth is a directory containing the file index, the index name is returned.
+func (h *previewHandler) exists(ig *ignorer, pth, index string) (ok bool, name string) {
+	if pth == "/" && index == "" {
+		return
+	}
+	info, err := os.Stat(h.name(pth))
+	if err != nil || h.hidden(ig, pth, info.IsDir()) {
+		return
+	}
+	if info.IsDir() {
+		if index == "" {
+			return
+		}
+		if ok, _ := h.exists(ig, path.Join(pth, index), ""); ok {
+			return false, index
+		}
+		return
+	}
+	return true, ""
+}
+
+// hidden returns whether or not pth is left out of the remote bucket.
+func (h *previewHandler) hidden(ig *ignorer, pth string, isDir bool) bool {
+	rel := strings.TrimPrefix(pth, "/")
+	if rel == "" {
+		return false
+	}
+	if Ignore(rel) || strings.HasPrefix(rel, h.buck.conf.Dir) || strings.HasSuffix(rel, patchExt) {
+		return true
+	}
+	return h.buck.skipPath(ig, h.root, h.name(pth), isDir)
+}
+
+func (h *previewHandler) name(pth string) string {
+	return filepath.Join(h.root, filepath.FromSlash(pth))
+}
+
+// serveFile writes the file at pth with status and the website headers matching the request path.
+func (h *previewHandler) serveFile(w http.ResponseWriter, r *http.Request, pth string, status int) {
+	f, err := os.Open(h.name(pth))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if h.website != nil {
+		for _, hdr := range buckets.MatchHeaders(h.website.Headers, r.URL.Path) {
+			w.Header().Add(hdr.Name, hdr.Value)
+		}
+	}
+	ctype := mime.TypeByExtension(filepath.Ext(pth))
+	if ctype == "" {
+		ctype = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", ctype)
+	if status == http.StatusOK {
+		http.ServeContent(w, r, pth, info.ModTime(), f)
+		return
+	}
+	w.WriteHeader(status)
+	_, _ = io.Copy(w, f)
+}
+
+// serveRedirect applies a matched redirect rule.
+// Redirect statuses send the client to target. Other statuses serve target from the bucket.
+func (h *previewHandler) serveRedirect(w http.ResponseWriter, r *http.Request, ig *ignorer, rule buckets.Redirect, target string) {
+	switch rule.Status {
+	case http.StatusOK, http.StatusNotFound, http.StatusGone:
+		exists, index := h.exists(ig, target, h.website.Index())
+		if !exists && index == "" {
+			if rule.Status == http.StatusOK {
+				http.NotFound(w, r)
+			} else {
+				http.Error(w, http.StatusText(rule.Status), rule.Status)
+			}
+			return
+		}
+		if index != "" {
+			target = path.Join(target, index)
+		}
+		h.serveFile(w, r, target, rule.Status)
+	default:
+		if q := r.URL.RawQuery; q != "" && !strings.Contains(target, "?") {
+			target += "?" + q
+		}
+		http.Redirect(w, r, target, rule.Status)
+	}
+}
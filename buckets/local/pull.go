@@ -3,6 +3,7 @@ package local
 import (
 	"context"
 	"errors"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -10,12 +11,24 @@ import (
 	cid "github.com/ipfs/go-cid"
 	ds "github.com/ipfs/go-datastore"
 	"github.com/ipfs/go-merkledag/dagutils"
+	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/textile/api/buckets/client"
+	pb "github.com/textileio/textile/api/buckets/pb"
+	"github.com/textileio/textile/util"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // PullRemote pulls remote files.
 // By default, only missing files are pulled. See PathOption for more info.
+// Local changes to files that were also changed on the remote are kept unless
+// a different resolution is chosen with WithConflictResolver.
+// A local bucket that's checked out at a previous root returns to the latest remote root, see Checkout.
+// Use WithInclude and WithExclude to only pull some of the remote paths.
+// Since the rest of the bucket isn't pulled, the local bucket doesn't move to the latest remote root,
+// so a push may require a full pull first.
+// Use WithDryRun to report the changes without pulling them.
 func (b *Bucket) PullRemote(ctx context.Context, opts ...PathOption) (roots Roots, err error) {
 	b.Lock()
 	defer b.Unlock()
@@ -32,6 +45,18 @@ func (b *Bucket) PullRemote(ctx context.Context, opts ...PathOption) (roots Root
 	if err != nil {
 		return
 	}
+	diff = args.filter.changes(diff)
+	if args.dryRun != nil {
+		changes, err := b.dryRunPull(ctx, diff, args)
+		if err != nil {
+			return roots, err
+		}
+		if len(changes) == 0 {
+			return roots, ErrUpToDate
+		}
+		args.dryRun(changes)
+		return b.Roots(ctx)
+	}
 	if args.confirm != nil && args.hard && len(diff) > 0 {
 		if ok := args.confirm(diff); !ok {
 			return roots, ErrAborted
@@ -39,11 +64,16 @@ func (b *Bucket) PullRemote(ctx context.Context, opts ...PathOption) (roots Root
 	}
 
 	// Tmp move local modifications and additions if not pulling hard
+	var bases map[string]cid.Cid
 	if !args.hard {
+		bases, err = b.baseCids(diff)
+		if err != nil {
+			return
+		}
 		for _, c := range diff {
 			switch c.Type {
 			case dagutils.Mod, dagutils.Add:
-				if err := os.Rename(c.Name, c.Name+".buckpatch"); err != nil {
+				if err := os.Rename(c.Name, c.Name+patchExt); err != nil {
 					return roots, err
 				}
 			}
@@ -54,47 +84,94 @@ func (b *Bucket) PullRemote(ctx context.Context, opts ...PathOption) (roots Root
 	if err != nil {
 		return
 	}
-	count, err := b.getPath(ctx, "", bp, diff, args.force, args.events)
-	if err != nil {
-		return
+	var before []string
+	if args.filter.active() {
+		before, err = b.selectedPaths(bp, args.filter)
+		if err != nil {
+			return
+		}
+	}
+	var (
+		count int
+		rc    cid.Cid
+		ok    bool
+	)
+	// Local changes must be re-examined against the remote when pulling hard,
+	// which requires a full listing. So does a filtered pull, since it doesn't track the remote root.
+	if !args.force && !args.filter.active() && (!args.hard || len(diff) == 0) {
+		count, rc, ok, err = b.getDiff(ctx, bp, args)
+		if err != nil {
+			return
+		}
+	}
+	if !ok {
+		count, err = b.getPath(ctx, "", bp, diff, args)
+		if err != nil {
+			return
+		}
 	}
 	if count == 0 {
+		// Nothing was pulled, so local changes can't conflict
+		if !args.hard {
+			for _, c := range diff {
+				if err := applyOurs(c); err != nil {
+					return roots, err
+				}
+			}
+		}
+		if !args.filter.active() {
+			if err = b.setDetached(nil); err != nil {
+				return
+			}
+		}
 		return roots, ErrUpToDate
 	}
 
-	if err = b.repo.Save(ctx); err != nil {
-		return
-	}
-	rc, err := b.getRemoteRoot(ctx)
-	if err != nil {
-		return
-	}
-	if err = b.repo.SetRemotePath("", rc); err != nil {
-		return
+	if args.filter.active() {
+		// Only the selected paths are saved. The remote root isn't tracked since the rest of the bucket wasn't pulled.
+		after, err := b.selectedPaths(bp, args.filter)
+		if err != nil {
+			return roots, err
+		}
+		paths := append(before, after...)
+		for _, c := range diff {
+			paths = append(paths, c.Path)
+		}
+		if err = b.repo.SavePaths(ctx, paths); err != nil {
+			return roots, err
+		}
+	} else {
+		if err = b.repo.Save(ctx); err != nil {
+			return
+		}
+		if !rc.Defined() {
+			rc, err = b.getRemoteRoot(ctx)
+			if err != nil {
+				return
+			}
+		}
+		if err = b.repo.SetRemotePath("", rc); err != nil {
+			return
+		}
+		if err = b.setDetached(nil); err != nil {
+			return
+		}
 	}
 
 	// Re-apply local changes if not pulling hard
 	if !args.hard {
 		for _, c := range diff {
-			switch c.Type {
-			case dagutils.Mod, dagutils.Add:
-				if err := os.Rename(c.Name+".buckpatch", c.Name); err != nil {
-					return roots, err
-				}
-			case dagutils.Remove:
-				// If the file was also deleted on the remote,
-				// the local deletion will already have been handled by getPath.
-				// So, we just ignore the error here.
-				_ = os.RemoveAll(c.Name)
+			if err := b.applyChange(c, bases[c.Path], args); err != nil {
+				return roots, err
 			}
 		}
 	}
 	return b.Roots(ctx)
 }
 
-func (b *Bucket) getPath(ctx context.Context, pth, dest string, diff []Change, force bool, events chan<- PathEvent) (count int, err error) {
+func (b *Bucket) getPath(ctx context.Context, pth, dest string, diff []Change, args *pathOptions) (count int, err error) {
 	key := b.Key()
-	all, missing, err := b.listPath(ctx, key, pth, dest, force)
+	all, missing, err := b.listPath(ctx, key, pth, dest, args)
 	if err != nil {
 		return
 	}
@@ -112,6 +189,9 @@ loop:
 			}
 		}
 		p := strings.TrimPrefix(n, dest+"/")
+		if !args.filter.selected(p, false) {
+			continue
+		}
 		rm[p] = n
 	}
 looop:
@@ -129,7 +209,93 @@ looop:
 	if count == 0 {
 		return
 	}
+	names := make([]string, 0, len(rm))
+	for _, r := range rm {
+		names = append(names, r)
+	}
+	return count, b.syncObjects(ctx, key, pth, missing, names, args)
+}
+
+// getDiff pulls only the files that changed on the remote since the last known remote root.
+// ok is false if the remote is unable to compute a diff, in which case a full listing is required.
+func (b *Bucket) getDiff(ctx context.Context, dest string, args *pathOptions) (count int, root cid.Cid, ok bool, err error) {
+	_, rc, err := b.repo.Root()
+	if err != nil {
+		return
+	}
+	if !rc.Defined() {
+		return
+	}
+	key := b.Key()
+	rep, err := b.clients.Buckets.Diff(ctx, key, path.IpfsPath(rc))
+	if err != nil {
+		switch status.Code(err) {
+		case codes.NotFound, codes.Unimplemented:
+			return 0, root, false, nil
+		default:
+			return
+		}
+	}
+	rp, err := util.NewResolvedPath(rep.Root.Path)
+	if err != nil {
+		return
+	}
+	var (
+		missing []object
+		rm      []string
+	)
+	for _, c := range rep.Changes {
+		if !b.repo.sparse.included(c.Path, false) {
+			continue
+		}
+		name := filepath.Join(dest, c.Path)
+		if c.Type == pb.DiffReply_Change_Remove {
+			rm = append(rm, name)
+			continue
+		}
+		cc, err := cid.Decode(c.Cid)
+		if err != nil {
+			return 0, root, false, err
+		}
+		o := object{path: c.Path, name: name, size: c.Size, cid: cc}
+		synced, err := b.isSynced(o)
+		if err != nil {
+			return 0, root, false, err
+		}
+		if !synced {
+			missing = append(missing, o)
+		}
+	}
+	count = len(missing) + len(rm)
+	if count == 0 {
+		return count, rp.Cid(), true, nil
+	}
+	if err = b.syncObjects(ctx, key, "", missing, rm, args); err != nil {
+		return
+	}
+	return count, rp.Cid(), true, nil
+}
 
+// syncObjects removes the files in rm and downloads missing objects.
+// Removals come first so that paths which changed between file and directory can be replaced.
+// Downloads run in parallel, limited by the concurrency option if set.
+func (b *Bucket) syncObjects(ctx context.Context, key, pth string, missing []object, rm []string, args *pathOptions) error {
+	events := args.events
+	for _, r := range rm {
+		// The file may have been modified locally, in which case it will have been moved to a patch.
+		// So, we just ignore the error here.
+		_ = os.RemoveAll(r)
+		if events != nil {
+			rel, err := filepath.Rel(b.cwd, r)
+			if err != nil {
+				return err
+			}
+			events <- PathEvent{
+				Path: rel,
+				Type: FileRemoved,
+			}
+		}
+	}
 	if len(missing) > 0 {
 		if events != nil {
 			events <- PathEvent{
@@ -137,38 +303,28 @@ looop:
 				Type: PathStart,
 			}
 		}
+		concurrency := args.concurrency
+		if concurrency < 1 {
+			concurrency = len(missing)
+		}
+		sem := make(chan struct{}, concurrency)
 		eg, gctx := errgroup.WithContext(context.Background())
 		for _, o := range missing {
 			o := o
+			sem <- struct{}{}
 			eg.Go(func() error {
+				defer func() { <-sem }()
 				if gctx.Err() != nil {
 					return nil
 				}
-				if err := b.getFile(ctx, key, o, events); err != nil {
+				if err := b.getFile(ctx, key, o, args); err != nil {
 					return err
 				}
 				return b.repo.SetRemotePath(o.path, o.cid)
 			})
 		}
 		if err := eg.Wait(); err != nil {
-			return count, err
-		}
-	}
-	if len(rm) > 0 {
-		for _, r := range rm {
-			// The file may have been modified locally, in which case it will have been moved to a patch.
-			// So, we just ignore the error here.
-			_ = os.RemoveAll(r)
-			if events != nil {
-				rel, err := filepath.Rel(b.cwd, r)
-				if err != nil {
-					return count, err
-				}
-				events <- PathEvent{
-					Path: rel,
-					Type: FileRemoved,
-				}
-			}
+			return err
 		}
 	}
 	if events != nil {
@@ -177,24 +333,33 @@ looop:
 			Type: PathComplete,
 		}
 	}
-	return count, nil
+	return nil
 }
 
 type object struct {
-	path string
-	name string
-	cid  cid.Cid
-	size int64
+	path  string
+	name  string
+	cid   cid.Cid
+	size  int64
+	attrs map[string]string
 }
 
-func (b *Bucket) listPath(ctx context.Context, key, pth, dest string, force bool) (all, missing []object, err error) {
-	rep, err := b.clients.Buckets.ListPath(ctx, key, pth)
+func (b *Bucket) listPath(ctx context.Context, key, pth, dest string, args *pathOptions) (all, missing []object, err error) {
+	var opts []client.ListPathOption
+	if args.root != nil {
+		opts = append(opts, client.WithListRoot(args.root))
+	}
+	rep, err := b.clients.Buckets.ListPath(ctx, key, pth, opts...)
 	if err != nil {
 		return
 	}
 	if rep.Item.IsDir {
 		for _, i := range rep.Item.Items {
-			a, m, err := b.listPath(ctx, key, filepath.Join(pth, filepath.Base(i.Path)), dest, force)
+			p := filepath.Join(pth, filepath.Base(i.Path))
+			if !b.repo.sparse.included(p, i.IsDir) || !args.filter.selected(p, i.IsDir) {
+				continue
+			}
+			a, m, err := b.listPath(ctx, key, p, dest, args)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -207,25 +372,15 @@ func (b *Bucket) listPath(ctx context.Context, key, pth, dest string, force bool
 		if err != nil {
 			return nil, nil, err
 		}
-		o := object{path: pth, name: name, size: rep.Item.Size, cid: c}
+		o := object{path: pth, name: name, size: rep.Item.Size, cid: c, attrs: rep.Item.Metadata.GetAttributes()}
 		all = append(all, o)
-		if !force {
-			c, err := cid.Decode(rep.Item.Cid)
+		if !args.force {
+			synced, err := b.isSynced(o)
 			if err != nil {
 				return nil, nil, err
 			}
-			lc, err := b.repo.HashFile(name)
-			if err == nil && lc.Equals(c) { // File exists, skip it
+			if synced { // File exists, skip it
 				return all, missing, nil
-			} else {
-				match, err := b.repo.MatchPath(pth, lc, c)
-				if err != nil {
-					if !errors.Is(err, ds.ErrNotFound) {
-						return nil, nil, err
-					}
-				} else if match { // File exists, skip it
-					return all, missing, nil
-				}
 			}
 		}
 		missing = append(missing, o)
@@ -233,7 +388,24 @@ func (b *Bucket) listPath(ctx context.Context, key, pth, dest string, force bool
 	return all, missing, nil
 }
 
-func (b *Bucket) getFile(ctx context.Context, key string, o object, events chan<- PathEvent) error {
+// isSynced returns whether or not the local file matches the remote object.
+func (b *Bucket) isSynced(o object) (bool, error) {
+	lc, err := b.repo.HashFile(o.name)
+	if err == nil && lc.Equals(o.cid) {
+		return true, nil
+	}
+	match, err := b.repo.MatchPath(o.path, lc, o.cid)
+	if err != nil {
+		if !errors.Is(err, ds.ErrNotFound) {
+			return false, err
+		}
+		return false, nil
+	}
+	return match, nil
+}
+
+func (b *Bucket) getFile(ctx context.Context, key string, o object, args *pathOptions) (err error) {
+	events := args.events
 	if err := os.MkdirAll(filepath.Dir(o.name), os.ModePerm); err != nil {
 		return err
 	}
@@ -241,6 +413,14 @@ func (b *Bucket) getFile(ctx context.Context, key string, o object, events chan<
 	if err != nil {
 		return err
 	}
+	if args.fileInfo {
+		// Deferred before the file is closed so the restored mtime isn't changed by a pending write
+		defer func() {
+			if err == nil {
+				err = restoreFileInfo(o.name, o.attrs)
+			}
+		}()
+	}
 	defer file.Close()
 
 	rel, err := filepath.Rel(b.cwd, o.name)
@@ -257,23 +437,60 @@ func (b *Bucket) getFile(ctx context.Context, key string, o object, events chan<
 		}
 	}
 
-	progress := make(chan int64)
-	go func() {
-		for up := range progress {
+	if args.cache != nil {
+		cached, err := args.cache.Get(o.cid, file)
+		if err != nil {
+			return err
+		}
+		if cached {
 			if events != nil {
 				events <- PathEvent{
 					Path:     rel,
 					Cid:      o.cid,
-					Type:     FileProgress,
+					Type:     FileComplete,
 					Size:     o.size,
-					Progress: up,
+					Progress: o.size,
+				}
+			}
+			return nil
+		}
+	}
+
+	err = withRetries(ctx, args, []string{rel}, func() error {
+		if err := file.Truncate(0); err != nil {
+			return err
+		}
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		progress := make(chan int64)
+		go func() {
+			for up := range progress {
+				if events != nil {
+					events <- PathEvent{
+						Path:     rel,
+						Cid:      o.cid,
+						Type:     FileProgress,
+						Size:     o.size,
+						Progress: up,
+					}
 				}
 			}
+		}()
+		opts := []client.Option{client.WithProgress(progress)}
+		if args.root != nil {
+			opts = append(opts, client.WithPullRoot(args.root))
 		}
-	}()
-	if err := b.clients.Buckets.PullPath(ctx, key, o.path, file, client.WithProgress(progress)); err != nil {
+		return b.clients.Buckets.PullPath(ctx, key, o.path, limitWriter(ctx, file, args.limiter), opts...)
+	})
+	if err != nil {
 		return err
 	}
+	if args.cache != nil {
+		if err := args.cache.Put(o.cid, o.name); err != nil {
+			return err
+		}
+	}
 	if events != nil {
 		events <- PathEvent{
 			Path:     rel,
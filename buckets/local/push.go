@@ -2,10 +2,12 @@ package local
 
 import (
 	"context"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
+	cid "github.com/ipfs/go-cid"
 	"github.com/ipfs/go-merkledag/dagutils"
 	"github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/textile/api/buckets/client"
@@ -13,6 +15,10 @@ import (
 
 // PushRemote pushes local files.
 // By default, only staged changes are pushed. See PathOption for more info.
+// If paths are staged, see Stage, only changes under them are pushed and the paths are unstaged afterwards.
+// Files that were accepted by the remote before a previous push was interrupted are skipped.
+// Use WithInclude and WithExclude to only push some of the changes.
+// Use WithDryRun to report the changes without pushing them.
 func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots, err error) {
 	b.Lock()
 	defer b.Unlock()
@@ -24,6 +30,9 @@ func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots
 	for _, opt := range opts {
 		opt(args)
 	}
+	if _, ok := b.Detached(); ok {
+		return roots, ErrDetached
+	}
 
 	diff, err := b.DiffLocal()
 	if err != nil {
@@ -58,9 +67,34 @@ func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots
 			diff = append(diff, c)
 		}
 	}
+	staged, err := b.repo.StagedPaths()
+	if err != nil {
+		return roots, err
+	}
+	if len(staged) > 0 {
+		var filtered []Change
+		for _, c := range diff {
+			if isStaged(staged, c.Path) {
+				filtered = append(filtered, c)
+			}
+		}
+		diff = filtered
+	}
+	diff = args.filter.changes(diff)
 	if len(diff) == 0 {
 		return roots, ErrUpToDate
 	}
+	if args.dryRun != nil {
+		changes, err := b.dryRunPush(diff)
+		if err != nil {
+			return roots, err
+		}
+		if len(changes) == 0 {
+			return roots, ErrUpToDate
+		}
+		args.dryRun(changes)
+		return b.Roots(ctx)
+	}
 	if args.confirm != nil {
 		if ok := args.confirm(diff); !ok {
 			return roots, ErrAborted
@@ -80,22 +114,54 @@ func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots
 		}
 	}
 	key := b.Key()
+	var adds []Change
+	hashes := make(map[string]cid.Cid)
 	for _, c := range diff {
 		switch c.Type {
 		case dagutils.Mod, dagutils.Add:
-			var added path.Resolved
-			var err error
-			added, xr, err = b.addFile(ctx, key, xr, c, args.force, args.events)
+			lc, err := b.repo.HashFile(c.Name)
 			if err != nil {
 				return roots, err
 			}
-			if err := b.repo.SetRemotePath(c.Path, added.Cid()); err != nil {
+			// Skip files that were accepted by the remote before a previous push was interrupted
+			pushed, err := b.repo.IsPushed(c.Path, lc)
+			if err != nil {
 				return roots, err
 			}
+			if pushed {
+				continue
+			}
+			hashes[c.Path] = lc
+			adds = append(adds, c)
 		case dagutils.Remove:
 			rm = append(rm, c)
 		}
 	}
+	if args.concurrency > 1 && len(adds) > 1 {
+		xr, err = b.addFiles(ctx, key, xr, adds, hashes, args)
+		if err != nil {
+			return roots, err
+		}
+		if err := b.repo.SetRemotePath("", xr.Cid()); err != nil {
+			return roots, err
+		}
+	} else {
+		for _, c := range adds {
+			var added path.Resolved
+			var err error
+			added, xr, err = b.addFile(ctx, key, xr, c, args)
+			if err != nil {
+				return roots, err
+			}
+			if err := b.repo.SetPushedPath(c.Path, hashes[c.Path], added.Cid()); err != nil {
+				return roots, err
+			}
+			// Track the new remote root so that a rerun can fast-forward from it if the push is interrupted
+			if err := b.repo.SetRemotePath("", xr.Cid()); err != nil {
+				return roots, err
+			}
+		}
+	}
 	if len(rm) > 0 {
 		for _, c := range rm {
 			var err error
@@ -106,6 +172,9 @@ func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots
 			if err := b.repo.RemovePath(ctx, c.Name); err != nil {
 				return roots, err
 			}
+			if err := b.repo.SetRemotePath("", xr.Cid()); err != nil {
+				return roots, err
+			}
 		}
 	}
 	if args.events != nil {
@@ -115,7 +184,21 @@ func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots
 		}
 	}
 
-	if err = b.repo.Save(ctx); err != nil {
+	if len(staged) > 0 || args.filter.active() {
+		// Only the pushed changes are saved, leaving unstaged or unselected changes in the local diff
+		pushed := make([]string, len(diff))
+		for i, c := range diff {
+			pushed[i] = c.Path
+		}
+		if err = b.repo.SavePaths(ctx, pushed); err != nil {
+			return
+		}
+		if len(staged) > 0 {
+			if err = b.repo.SetStagedPaths(nil); err != nil {
+				return
+			}
+		}
+	} else if err = b.repo.Save(ctx); err != nil {
 		return
 	}
 	rc, err := b.getRemoteRoot(ctx)
@@ -128,7 +211,7 @@ func (b *Bucket) PushLocal(ctx context.Context, opts ...PathOption) (roots Roots
 	return b.Roots(ctx)
 }
 
-func (b *Bucket) addFile(ctx context.Context, key string, xroot path.Resolved, c Change, force bool, events chan<- PathEvent) (added path.Resolved, root path.Resolved, err error) {
+func (b *Bucket) addFile(ctx context.Context, key string, xroot path.Resolved, c Change, args *pathOptions) (added path.Resolved, root path.Resolved, err error) {
 	file, err := os.Open(c.Name)
 	if err != nil {
 		return
@@ -140,43 +223,56 @@ func (b *Bucket) addFile(ctx context.Context, key string, xroot path.Resolved, c
 	}
 	size := info.Size()
 
-	if events != nil {
-		events <- PathEvent{
+	if args.events != nil {
+		args.events <- PathEvent{
 			Path: c.Rel,
 			Type: FileStart,
 			Size: size,
 		}
 	}
 
-	progress := make(chan int64)
-	go func() {
-		for up := range progress {
-			var u int64
-			if up > size {
-				u = size
-			} else {
-				u = up
-			}
-			if events != nil {
-				events <- PathEvent{
-					Path:     c.Rel,
-					Type:     FileProgress,
-					Size:     size,
-					Progress: u,
+	err = withRetries(ctx, args, []string{c.Rel}, func() error {
+		if _, err := file.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		progress := make(chan int64)
+		go func() {
+			for up := range progress {
+				var u int64
+				if up > size {
+					u = size
+				} else {
+					u = up
+				}
+				if args.events != nil {
+					args.events <- PathEvent{
+						Path:     c.Rel,
+						Type:     FileProgress,
+						Size:     size,
+						Progress: u,
+					}
 				}
 			}
-		}
-	}()
+		}()
 
-	opts := []client.Option{client.WithProgress(progress)}
-	if !force {
-		opts = append(opts, client.WithFastForwardOnly(xroot))
-	}
-	added, root, err = b.clients.Buckets.PushPath(ctx, key, c.Path, file, opts...)
+		opts := []client.Option{client.WithProgress(progress)}
+		if !args.force {
+			opts = append(opts, client.WithFastForwardOnly(xroot))
+		}
+		if args.deterministic {
+			opts = append(opts, client.WithDeterministic())
+		}
+		if args.fileInfo {
+			opts = append(opts, client.WithAttributes(fileInfoAttributes(info)))
+		}
+		var err error
+		added, root, err = b.clients.Buckets.PushPath(ctx, key, c.Path, limitReader(ctx, file, args.limiter), opts...)
+		return err
+	})
 	if err != nil {
 		return
-	} else if events != nil {
-		events <- PathEvent{
+	} else if args.events != nil {
+		args.events <- PathEvent{
 			Path:     c.Rel,
 			Cid:      added.Cid(),
 			Type:     FileComplete,
@@ -187,6 +283,114 @@ func (b *Bucket) addFile(ctx context.Context, key string, xroot path.Resolved, c
 	return added, root, nil
 }
 
+// addFiles pushes files as a single bucket update, sending up to args.concurrency files at the same time.
+func (b *Bucket) addFiles(ctx context.Context, key string, xroot path.Resolved, changes []Change, hashes map[string]cid.Cid, args *pathOptions) (root path.Resolved, err error) {
+	files := make([]client.PushPathsFile, len(changes))
+	sizes := make(map[string]int64)
+	for i, c := range changes {
+		c := c
+		info, err := os.Stat(c.Name)
+		if err != nil {
+			return nil, err
+		}
+		size := info.Size()
+		sizes[c.Path] = size
+		files[i] = client.PushPathsFile{
+			Path: c.Path,
+			Open: func() (io.ReadCloser, error) {
+				file, err := os.Open(c.Name)
+				if err != nil {
+					return nil, err
+				}
+				if args.events != nil {
+					args.events <- PathEvent{
+						Path: c.Rel,
+						Type: FileStart,
+						Size: size,
+					}
+				}
+				return &progressReader{
+					Reader: limitReader(ctx, file, args.limiter),
+					Closer: file,
+					rel:    c.Rel,
+					size:   size,
+					events: args.events,
+				}, nil
+			},
+		}
+		if args.fileInfo {
+			files[i].Attributes = fileInfoAttributes(info)
+		}
+	}
+
+	opts := []client.Option{client.WithConcurrency(args.concurrency)}
+	if !args.force {
+		opts = append(opts, client.WithFastForwardOnly(xroot))
+	}
+	if args.deterministic {
+		opts = append(opts, client.WithDeterministic())
+	}
+	rels := make([]string, len(changes))
+	for i, c := range changes {
+		rels[i] = c.Rel
+	}
+	var results map[string]path.Resolved
+	err = withRetries(ctx, args, rels, func() error {
+		var err error
+		results, root, err = b.clients.Buckets.PushPaths(ctx, key, files, opts...)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range changes {
+		added, ok := results[c.Path]
+		if !ok { // The remote kept the file next to a conflicting change, which will be pulled later
+			continue
+		}
+		if err := b.repo.SetPushedPath(c.Path, hashes[c.Path], added.Cid()); err != nil {
+			return nil, err
+		}
+		if args.events != nil {
+			args.events <- PathEvent{
+				Path:     c.Rel,
+				Cid:      added.Cid(),
+				Type:     FileComplete,
+				Size:     sizes[c.Path],
+				Progress: sizes[c.Path],
+			}
+		}
+	}
+	return root, nil
+}
+
+// progressReader sends file progress events as a file is read.
+type progressReader struct {
+	io.Reader
+	io.Closer
+	rel    string
+	size   int64
+	read   int64
+	events chan<- PathEvent
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	if n > 0 && r.events != nil {
+		r.read += int64(n)
+		if r.read > r.size {
+			r.read = r.size
+		}
+		r.events <- PathEvent{
+			Path:     r.rel,
+			Type:     FileProgress,
+			Size:     r.size,
+			Progress: r.read,
+		}
+	}
+	return n, err
+}
+
 func (b *Bucket) rmFile(ctx context.Context, key string, xroot path.Resolved, c Change, force bool, events chan<- PathEvent) (path.Resolved, error) {
 	var opts []client.Option
 	if !force {
@@ -197,6 +401,8 @@ func (b *Bucket) rmFile(ctx context.Context, key string, xroot path.Resolved, c
 		if !strings.HasSuffix(err.Error(), "no link by that name") {
 			return nil, err
 		}
+		// The path may have been removed before a previous push was interrupted
+		root = xroot
 	}
 	if events != nil {
 		events <- PathEvent{
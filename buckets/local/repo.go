@@ -30,6 +30,7 @@ import (
 	"github.com/ipfs/go-unixfs/importer/trickle"
 	options "github.com/ipfs/interface-go-ipfs-core/options"
 	mh "github.com/multiformats/go-multihash"
+	"github.com/textileio/textile/buckets"
 )
 
 func init() {
@@ -44,6 +45,9 @@ var (
 	// patchExt is used to ignore tmp files during a pull.
 	patchExt = ".buckpatch"
 
+	// stagedKey is the datastore key of the paths staged for the next push.
+	stagedKey = ds.NewKey("/STAGED_PATHS")
+
 	// ignoredFilenames is a list of default ignored file names.
 	ignoredFilenames = []string{
 		".DS_Store",
@@ -54,6 +58,11 @@ var (
 type pathMap struct {
 	Local  cid.Cid
 	Remote cid.Cid
+
+	// Pushed is the local cid of the file when it was last accepted by the remote as PushedRemote.
+	// It's used to skip files that were already pushed when an interrupted push is rerun.
+	Pushed       cid.Cid
+	PushedRemote cid.Cid
 }
 
 // Repo tracks a local bucket tree structure.
@@ -65,6 +74,7 @@ type Repo struct {
 	dag    ipld.DAGService
 	layout options.Layout
 	cidver int
+	sparse sparseFilter
 }
 
 // NewRepo creates a new bucket with the given path.
@@ -157,6 +167,12 @@ func (b *Repo) SetCidVersion(v int) {
 	b.cidver = v
 }
 
+// SetSparsePaths restricts the repo to the given subpaths.
+// Files outside of the paths are left out of the tree.
+func (b *Repo) SetSparsePaths(paths []string) {
+	b.sparse = newSparseFilter(paths)
+}
+
 // Save saves the bucket as a node describing the file tree at the current path.
 func (b *Repo) Save(ctx context.Context) error {
 	_, maps, err := b.recursiveAddPath(ctx, b.path, b.dag)
@@ -213,10 +229,20 @@ func (b *Repo) recursiveAddPath(ctx context.Context, pth string, dag ipld.DAGSer
 	if err != nil {
 		return nil, nil, err
 	}
+	ig, err := loadIgnorer(abs)
+	if err != nil {
+		return nil, nil, err
+	}
 	if err = filepath.Walk(abs, func(n string, info os.FileInfo, err error) error {
 		if err != nil {
 			return err
 		}
+		if rel := strings.TrimPrefix(n, abs+"/"); n != abs && (ig.ignored(rel, info.IsDir()) || !b.sparse.included(rel, info.IsDir())) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
 		if !info.IsDir() {
 			if Ignore(n) {
 				return nil
@@ -312,6 +338,143 @@ func (b *Repo) SaveFile(ctx context.Context, pth string, name string) error {
 	return b.setLocalPath("", n.Cid())
 }
 
+// SavePaths updates the saved bucket tree with the current state of the given paths only.
+// Paths that no longer exist locally are removed from the tree.
+func (b *Repo) SavePaths(ctx context.Context, paths []string) error {
+	lc, _, err := b.Root()
+	if err != nil {
+		return err
+	}
+	if !lc.Defined() {
+		return b.Save(ctx)
+	}
+	nd, err := b.dag.Get(ctx, lc)
+	if err != nil {
+		return err
+	}
+	root, ok := nd.(*md.ProtoNode)
+	if !ok {
+		return md.ErrNotProtobuf
+	}
+	prefix, err := md.PrefixForCidVersion(b.cidver)
+	if err != nil {
+		return err
+	}
+	editor := du.NewDagEditor(root, b.dag)
+	maps := make(map[string]cid.Cid)
+	for _, p := range paths {
+		p = filepath.ToSlash(p)
+		info, err := os.Stat(filepath.Join(b.path, p))
+		if os.IsNotExist(err) {
+			if err := editor.RmLink(ctx, p); err != nil && !errors.Is(err, md.ErrLinkNotFound) {
+				return err
+			}
+			// Directories left empty aren't part of a saved tree
+			for dir := path.Dir(p); dir != "." && dir != "/"; dir = path.Dir(dir) {
+				dn, err := linkedNode(ctx, editor.GetNode(), dir, editor.GetDagService(), b.dag)
+				if err != nil || len(dn.Links()) > 0 {
+					break
+				}
+				if err := editor.RmLink(ctx, dir); err != nil {
+					return err
+				}
+			}
+			continue
+		} else if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			continue
+		}
+		file, err := os.Open(filepath.Join(b.path, p))
+		if err != nil {
+			return err
+		}
+		fn, err := addFile(editor.GetDagService(), b.layout, prefix, file)
+		file.Close()
+		if err != nil {
+			return err
+		}
+		if err = editor.InsertNodeAtPath(ctx, p, fn, unixfs.EmptyDirNode); err != nil {
+			return err
+		}
+		maps[p] = fn.Cid()
+	}
+	en := editor.GetNode()
+	if err := copyLinks(ctx, en, editor.GetDagService(), b.dag); err != nil {
+		return err
+	}
+	for p, c := range maps {
+		if err := b.setLocalPath(p, c); err != nil {
+			return err
+		}
+	}
+	return b.setLocalPath("", en.Cid())
+}
+
+// ResetTree replaces the saved bucket tree with an empty directory.
+// Path maps are kept, so files can be saved again with SavePaths.
+func (b *Repo) ResetTree(ctx context.Context) error {
+	root := unixfs.EmptyDirNode()
+	prefix, err := md.PrefixForCidVersion(b.cidver)
+	if err != nil {
+		return err
+	}
+	root.SetCidBuilder(prefix)
+	if err := b.dag.Add(ctx, root); err != nil {
+		return err
+	}
+	return b.setLocalPath("", root.Cid())
+}
+
+// linkedNode returns the node at pth under root, looking for nodes in each of dags.
+func linkedNode(ctx context.Context, root *md.ProtoNode, pth string, dags ...ipld.DAGService) (nd *md.ProtoNode, err error) {
+	nd = root
+	for _, name := range strings.Split(pth, "/") {
+		var next *md.ProtoNode
+		for _, d := range dags {
+			if next, err = nd.GetLinkedProtoNode(ctx, d, name); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		nd = next
+	}
+	return nd, nil
+}
+
+// StagedPaths returns the paths that are staged for the next push.
+func (b *Repo) StagedPaths() ([]string, error) {
+	v, err := b.ds.Get(stagedKey)
+	if errors.Is(err, ds.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	var paths []string
+	if err := gob.NewDecoder(bytes.NewReader(v)).Decode(&paths); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+// SetStagedPaths replaces the paths that are staged for the next push.
+func (b *Repo) SetStagedPaths(paths []string) error {
+	if len(paths) == 0 {
+		if err := b.ds.Delete(stagedKey); err != nil && !errors.Is(err, ds.ErrNotFound) {
+			return err
+		}
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(paths); err != nil {
+		return err
+	}
+	return b.ds.Put(stagedKey, buf.Bytes())
+}
+
 // HashFile returns the cid of the file at path.
 // This method does not alter the bucket.
 func (b *Repo) HashFile(pth string) (cid.Cid, error) {
@@ -382,6 +545,39 @@ func (b *Repo) SetRemotePath(pth string, remote cid.Cid) error {
 	return b.putPathMap(k, xm)
 }
 
+// SetPushedPath records that the remote accepted the local cid of a path as the remote cid.
+// The remote cid of the path is updated as with SetRemotePath.
+func (b *Repo) SetPushedPath(pth string, local, remote cid.Cid) error {
+	k, err := getPathKey(pth)
+	if err != nil {
+		return err
+	}
+	xm, err := b.getPathMap(k)
+	if err != nil && !errors.Is(err, ds.ErrNotFound) {
+		return err
+	}
+	xm.Remote = remote
+	xm.Pushed = local
+	xm.PushedRemote = remote
+	return b.putPathMap(k, xm)
+}
+
+// IsPushed returns whether or not the local cid of a path was already accepted by the remote,
+// and the remote cid of the path hasn't changed since.
+func (b *Repo) IsPushed(pth string, local cid.Cid) (bool, error) {
+	k, err := getPathKey(pth)
+	if err != nil {
+		return false, err
+	}
+	m, err := b.getPathMap(k)
+	if errors.Is(err, ds.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return m.Pushed.Defined() && m.Pushed.Equals(local) && m.PushedRemote.Equals(m.Remote), nil
+}
+
 // MatchPath returns whether or not the path exists and has matching local and remote cids.
 func (b *Repo) MatchPath(pth string, local, remote cid.Cid) (bool, error) {
 	k, err := getPathKey(pth)
@@ -450,7 +646,7 @@ func addFile(dag ipld.DAGService, layout options.Layout, prefix cid.Prefix, r io
 		NoCopy:     false,
 		CidBuilder: prefix,
 	}
-	chnk, err := chunker.FromString(r, "default")
+	chnk, err := chunker.FromString(r, buckets.DeterministicChunker)
 	if err != nil {
 		return nil, err
 	}
@@ -217,6 +217,157 @@ func TestRepo_Diff(t *testing.T) {
 	})
 }
 
+func TestRepo_SaveIgnored(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	files := []string{
+		"foo.txt",
+		"debug.log",
+		"logs/keep.log",
+		"build/out.bin",
+		"build/keep.txt",
+		"src/build",
+		"src/node_modules/dep/index.js",
+		"docs/a/b/tmp.md",
+		"docs/readme.md",
+	}
+	for _, f := range files {
+		err = os.MkdirAll(filepath.Join(dir, filepath.Dir(f)), os.ModePerm)
+		require.NoError(t, err)
+		err = ioutil.WriteFile(filepath.Join(dir, f), []byte(f), 0644)
+		require.NoError(t, err)
+	}
+	ignore := `# Build artifacts
+/build/
+!build/keep.txt
+node_modules
+*.log
+!logs/keep.log
+docs/**/tmp.md
+`
+	err = ioutil.WriteFile(filepath.Join(dir, IgnoreFile), []byte(ignore), 0644)
+	require.NoError(t, err)
+
+	repo := makeRepo(t, dir, options.BalancedLayout)
+	defer repo.Close()
+	err = repo.Save(context.Background())
+	require.NoError(t, err)
+
+	saved := []string{IgnoreFile, "foo.txt", "logs/keep.log", "src/build", "docs/readme.md"}
+	for _, f := range saved {
+		lc, _, err := repo.GetPathMap(f)
+		require.NoError(t, err, f)
+		assert.True(t, lc.Defined(), f)
+	}
+	ignored := []string{"debug.log", "build/out.bin", "build/keep.txt", "src/node_modules/dep/index.js", "docs/a/b/tmp.md"}
+	for _, f := range ignored {
+		_, _, err := repo.GetPathMap(f)
+		assert.Error(t, err, f)
+	}
+}
+
+func TestRepo_SetSparsePaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	files := []string{"foo.txt", "app/main.go", "app/web/index.html", "apps/other.go", "lib/util.go"}
+	for _, f := range files {
+		err = os.MkdirAll(filepath.Join(dir, filepath.Dir(f)), os.ModePerm)
+		require.NoError(t, err)
+		err = ioutil.WriteFile(filepath.Join(dir, f), []byte(f), 0644)
+		require.NoError(t, err)
+	}
+
+	repo := makeRepo(t, dir, options.BalancedLayout)
+	defer repo.Close()
+	repo.SetSparsePaths([]string{"/app/", "lib/util.go"})
+	err = repo.Save(context.Background())
+	require.NoError(t, err)
+
+	for _, f := range []string{"app/main.go", "app/web/index.html", "lib/util.go"} {
+		lc, _, err := repo.GetPathMap(f)
+		require.NoError(t, err, f)
+		assert.True(t, lc.Defined(), f)
+	}
+	for _, f := range []string{"foo.txt", "apps/other.go"} {
+		_, _, err := repo.GetPathMap(f)
+		assert.Error(t, err, f)
+	}
+
+	// Files outside of the sparse paths don't show up in a diff
+	err = ioutil.WriteFile(filepath.Join(dir, "foo.txt"), []byte("changed"), 0644)
+	require.NoError(t, err)
+	diff, err := repo.Diff(context.Background(), dir)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+func TestRepo_SavePaths(t *testing.T) {
+	dir, err := ioutil.TempDir("", "")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+	})
+	for _, f := range []string{"foo.txt", "one/bar.txt", "one/two/baz.txt"} {
+		err = os.MkdirAll(filepath.Join(dir, filepath.Dir(f)), os.ModePerm)
+		require.NoError(t, err)
+		err = ioutil.WriteFile(filepath.Join(dir, f), []byte(f), 0644)
+		require.NoError(t, err)
+	}
+
+	repo := makeRepo(t, dir, options.BalancedLayout)
+	defer repo.Close()
+	err = repo.Save(context.Background())
+	require.NoError(t, err)
+
+	err = ioutil.WriteFile(filepath.Join(dir, "foo.txt"), []byte("changed"), 0644)
+	require.NoError(t, err)
+	err = ioutil.WriteFile(filepath.Join(dir, "new.txt"), []byte("new"), 0644)
+	require.NoError(t, err)
+	err = os.RemoveAll(filepath.Join(dir, "one", "two"))
+	require.NoError(t, err)
+
+	// Only the given paths are saved
+	err = repo.SavePaths(context.Background(), []string{"foo.txt", "one/two/baz.txt"})
+	require.NoError(t, err)
+	diff, err := repo.Diff(context.Background(), dir)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(diff))
+	assert.Equal(t, "new.txt", diff[0].Path)
+	assert.Equal(t, du.Add, diff[0].Type)
+	hash, err := repo.HashFile(filepath.Join(dir, "foo.txt"))
+	require.NoError(t, err)
+	lc, _, err := repo.GetPathMap("foo.txt")
+	require.NoError(t, err)
+	assert.True(t, lc.Equals(hash))
+}
+
+func TestRepo_SetStagedPaths(t *testing.T) {
+	repo := makeRepo(t, "testdata/a", options.BalancedLayout)
+	defer repo.Close()
+
+	staged, err := repo.StagedPaths()
+	require.NoError(t, err)
+	assert.Empty(t, staged)
+
+	err = repo.SetStagedPaths([]string{"foo.txt", "one"})
+	require.NoError(t, err)
+	staged, err = repo.StagedPaths()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo.txt", "one"}, staged)
+
+	err = repo.SetStagedPaths(nil)
+	require.NoError(t, err)
+	staged, err = repo.StagedPaths()
+	require.NoError(t, err)
+	assert.Empty(t, staged)
+}
+
 func TestRepo_Get(t *testing.T) {
 	repo := makeRepo(t, "testdata/a", options.BalancedLayout)
 	defer repo.Close()
@@ -246,6 +397,38 @@ func TestRepo_SetRemotePath(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRepo_SetPushedPath(t *testing.T) {
+	repo := makeRepo(t, "testdata/a", options.BalancedLayout)
+	defer repo.Close()
+
+	local := makeCid(t, "local")
+	remote := makeCid(t, "remote")
+	pushed, err := repo.IsPushed("foo.txt", local)
+	require.NoError(t, err)
+	assert.False(t, pushed)
+
+	err = repo.SetPushedPath("foo.txt", local, remote)
+	require.NoError(t, err)
+	pushed, err = repo.IsPushed("foo.txt", local)
+	require.NoError(t, err)
+	assert.True(t, pushed)
+	_, rc, err := repo.GetPathMap("foo.txt")
+	require.NoError(t, err)
+	assert.True(t, rc.Equals(remote))
+
+	// Local content changed since the push
+	pushed, err = repo.IsPushed("foo.txt", makeCid(t, "changed"))
+	require.NoError(t, err)
+	assert.False(t, pushed)
+
+	// Remote changed since the push
+	err = repo.SetRemotePath("foo.txt", makeCid(t, "remote2"))
+	require.NoError(t, err)
+	pushed, err = repo.IsPushed("foo.txt", local)
+	require.NoError(t, err)
+	assert.False(t, pushed)
+}
+
 func TestRepo_MatchPath(t *testing.T) {
 	repo := makeRepo(t, "testdata/a", options.BalancedLayout)
 	defer repo.Close()
@@ -0,0 +1,182 @@
+package local
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	cid "github.com/ipfs/go-cid"
+	ds "github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-merkledag/dagutils"
+	"github.com/textileio/textile/buckets"
+)
+
+// ConflictResolution describes how a file that was changed both locally and on the remote is handled when pulling.
+type ConflictResolution string
+
+const (
+	// KeepOurs keeps the local version of the file. This is the default.
+	KeepOurs ConflictResolution = "ours"
+	// KeepTheirs discards the local change in favor of the remote version.
+	KeepTheirs ConflictResolution = "theirs"
+	// KeepBoth keeps the remote version and moves the local version next to it.
+	// See buckets.ConflictPath for how the local version is named.
+	KeepBoth ConflictResolution = "both"
+)
+
+// Resolve returns r for every conflict, which allows a resolution to be used as a fixed policy.
+func (r ConflictResolution) Resolve(Conflict) (ConflictResolution, error) {
+	return r, nil
+}
+
+// Conflict describes a file that was changed both locally and on the remote.
+type Conflict struct {
+	// Type is the type of the local change.
+	Type dagutils.ChangeType
+	// Path is the file path relative to the bucket root.
+	Path string
+	// Rel is the file path relative to the bucket current working directory.
+	Rel string
+	// Local is the cid of the local version. It's undefined if the file was removed locally.
+	Local cid.Cid
+	// Remote is the cid of the remote version as it was saved locally.
+	Remote cid.Cid
+}
+
+// ConflictResolver decides how conflicting changes are resolved when pulling.
+type ConflictResolver interface {
+	// Resolve returns the resolution for a single conflict.
+	Resolve(Conflict) (ConflictResolution, error)
+}
+
+// ConflictResolverFunc is an adapter that allows a function to be used as a ConflictResolver.
+type ConflictResolverFunc func(Conflict) (ConflictResolution, error)
+
+// Resolve calls f(c).
+func (f ConflictResolverFunc) Resolve(c Conflict) (ConflictResolution, error) {
+	return f(c)
+}
+
+// applyChange re-applies a local change that was set aside while pulling.
+// If the file was also changed on the remote, the resolver in args decides which version is kept.
+// base is the local cid of the file as of the last save, which is used to tell whether the remote changed it.
+func (b *Bucket) applyChange(c Change, base cid.Cid, args *pathOptions) error {
+	patch := c.Name + patchExt
+	info, err := os.Stat(c.Name)
+	if os.IsNotExist(err) { // Not changed on the remote
+		return applyOurs(c)
+	} else if err != nil {
+		return err
+	}
+	if info.IsDir() { // The remote replaced the file with a directory, which can't be merged
+		return applyOurs(c)
+	}
+	rc, err := b.repo.HashFile(c.Name)
+	if err != nil {
+		return err
+	}
+	if base.Defined() && base.Equals(rc) { // The pull only restored the file
+		return applyOurs(c)
+	}
+	var lc cid.Cid
+	if c.Type != dagutils.Remove {
+		lc, err = b.repo.HashFile(patch)
+		if err != nil {
+			return err
+		}
+		if lc.Equals(rc) { // Both sides made the same change
+			return os.Remove(patch)
+		}
+	}
+
+	res := KeepOurs
+	if args.resolver != nil {
+		rel, err := filepath.Rel(b.cwd, c.Name)
+		if err != nil {
+			return err
+		}
+		res, err = args.resolver.Resolve(Conflict{
+			Type:   c.Type,
+			Path:   c.Path,
+			Rel:    rel,
+			Local:  lc,
+			Remote: rc,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	switch res {
+	case KeepOurs:
+		return applyOurs(c)
+	case KeepTheirs:
+		if c.Type == dagutils.Remove {
+			return nil
+		}
+		return os.Remove(patch)
+	case KeepBoth:
+		if c.Type == dagutils.Remove { // There's nothing left to keep locally
+			return nil
+		}
+		return b.keepBoth(c.Name, lc, args.events)
+	default:
+		return fmt.Errorf("invalid conflict resolution: %s", res)
+	}
+}
+
+// applyOurs re-applies a local change over the remote version.
+func applyOurs(c Change) error {
+	if c.Type == dagutils.Remove {
+		// If the file was also deleted on the remote,
+		// the local deletion will already have been handled by getPath.
+		// So, we just ignore the error here.
+		_ = os.RemoveAll(c.Name)
+		return nil
+	}
+	return os.Rename(c.Name+patchExt, c.Name)
+}
+
+// keepBoth moves the local version of name, which was set aside while pulling,
+// next to the remote version.
+func (b *Bucket) keepBoth(name string, lc cid.Cid, events chan<- PathEvent) error {
+	var cp string
+	for n := 1; ; n++ {
+		cp = buckets.ConflictPath(name, n)
+		if _, err := os.Stat(cp); os.IsNotExist(err) {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(name+patchExt, cp); err != nil {
+		return err
+	}
+	if events != nil {
+		rel, err := filepath.Rel(b.cwd, cp)
+		if err != nil {
+			return err
+		}
+		events <- PathEvent{
+			Path: rel,
+			Cid:  lc,
+			Type: FileConflict,
+		}
+	}
+	return nil
+}
+
+// baseCids returns the local cids, as of the last save, of the files in diff.
+func (b *Bucket) baseCids(diff []Change) (map[string]cid.Cid, error) {
+	bases := make(map[string]cid.Cid)
+	for _, c := range diff {
+		lc, _, err := b.repo.GetPathMap(c.Path)
+		if errors.Is(err, ds.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		bases[c.Path] = lc
+	}
+	return bases, nil
+}
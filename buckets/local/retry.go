@@ -0,0 +1,62 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// retryInitialBackoff is the wait before the first file transfer retry.
+	retryInitialBackoff = time.Millisecond * 500
+	// retryMaxBackoff is the max wait between file transfer retries.
+	retryMaxBackoff = time.Second * 10
+)
+
+// retryCodes are gRPC status codes that usually indicate a transient transfer failure.
+var retryCodes = []codes.Code{codes.Unavailable, codes.ResourceExhausted, codes.Aborted}
+
+// withRetries calls f until it succeeds or fails with a non-transient error,
+// retrying up to args.retries times with exponential backoff.
+// A FileRetry event is sent for each of names before each retry.
+func withRetries(ctx context.Context, args *pathOptions, names []string, f func() error) error {
+	wait := retryInitialBackoff
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || attempt > args.retries || !isTransient(err) {
+			return err
+		}
+		if args.events != nil {
+			for _, n := range names {
+				args.events <- PathEvent{
+					Path:    n,
+					Type:    FileRetry,
+					Attempt: attempt,
+					Err:     err,
+				}
+			}
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+		if wait *= 2; wait > retryMaxBackoff {
+			wait = retryMaxBackoff
+		}
+	}
+}
+
+func isTransient(err error) bool {
+	code := status.Code(err)
+	for _, c := range retryCodes {
+		if code == c {
+			return true
+		}
+	}
+	return false
+}
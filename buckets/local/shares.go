@@ -0,0 +1,77 @@
+package local
+
+import (
+	"context"
+	"time"
+
+	"github.com/textileio/textile/api/buckets/client"
+	pb "github.com/textileio/textile/api/buckets/pb"
+)
+
+// ShareLink describes a gateway URL that gives read-only access to a bucket path.
+type ShareLink struct {
+	ID        string    `json:"id"`
+	Path      string    `json:"path"`
+	URL       string    `json:"url"`
+	Protected bool      `json:"protected"`
+	ExpiresAt time.Time `json:"expires_at"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateShareLink returns a link that gives read-only access to the remote path pth for the duration of expires.
+// If password is not empty, viewers of the link must enter it.
+func (b *Bucket) CreateShareLink(ctx context.Context, pth string, expires time.Duration, password string) (*ShareLink, error) {
+	if pth == "." || pth == "/" || pth == "./" {
+		pth = ""
+	}
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var opts []client.ShareLinkOption
+	if password != "" {
+		opts = append(opts, client.WithSharePassword(password))
+	}
+	l, err := b.clients.Buckets.CreateShareLink(ctx, b.Key(), pth, time.Now().Add(expires), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return pbShareLinkToShareLink(l), nil
+}
+
+// ListShareLinks returns the share links of the remote bucket that have not expired.
+func (b *Bucket) ListShareLinks(ctx context.Context) ([]ShareLink, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := b.clients.Buckets.ListShareLinks(ctx, b.Key())
+	if err != nil {
+		return nil, err
+	}
+	links := make([]ShareLink, len(list))
+	for i, l := range list {
+		links[i] = *pbShareLinkToShareLink(l)
+	}
+	return links, nil
+}
+
+// RevokeShareLink removes the share link with id.
+func (b *Bucket) RevokeShareLink(ctx context.Context, id string) error {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	return b.clients.Buckets.RevokeShareLink(ctx, b.Key(), id)
+}
+
+func pbShareLinkToShareLink(l *pb.ShareLink) *ShareLink {
+	return &ShareLink{
+		ID:        l.Id,
+		Path:      l.Path,
+		URL:       l.Url,
+		Protected: l.Protected,
+		ExpiresAt: time.Unix(0, l.ExpiresAt),
+		CreatedAt: time.Unix(0, l.CreatedAt),
+	}
+}
@@ -0,0 +1,142 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/textileio/textile/buckets"
+)
+
+// ErrSparseChanges indicates there are local changes that must be pushed before changing sparse paths.
+var ErrSparseChanges = errors.New("local changes must be pushed before changing sparse paths")
+
+// sparseFilter restricts a local bucket to a set of remote subpaths.
+// The zero value includes everything.
+type sparseFilter []string
+
+// newSparseFilter cleans and dedupes paths.
+// If any of the paths is the bucket root, the filter includes everything.
+func newSparseFilter(paths []string) sparseFilter {
+	seen := make(map[string]struct{})
+	var f sparseFilter
+	for _, p := range paths {
+		p = strings.Trim(path.Clean("/"+filepath.ToSlash(p)), "/")
+		if p == "" {
+			return nil
+		}
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		f = append(f, p)
+	}
+	sort.Strings(f)
+	return f
+}
+
+// included returns whether or not the path, relative to the bucket root, is synced.
+// Directories that contain a sparse path are included so they can be walked.
+func (f sparseFilter) included(pth string, isDir bool) bool {
+	if len(f) == 0 {
+		return true
+	}
+	pth = filepath.ToSlash(pth)
+	if pth == buckets.SeedName {
+		return true
+	}
+	for _, p := range f {
+		if pth == p || strings.HasPrefix(pth, p+"/") {
+			return true
+		}
+		if isDir && strings.HasPrefix(p, pth+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// SparsePaths returns the remote subpaths that are synced locally.
+// An empty list means the entire bucket is synced.
+func (b *Bucket) SparsePaths() []string {
+	return newSparseFilter(b.conf.Viper.GetStringSlice("sparse"))
+}
+
+// SetSparsePaths restricts the local bucket to the given remote subpaths.
+// Local files outside of the paths are removed and files inside the paths are pulled.
+// Pass no paths to sync the entire bucket again.
+// Returns ErrSparseChanges if there are unpushed local changes.
+func (b *Bucket) SetSparsePaths(ctx context.Context, paths []string, opts ...PathOption) error {
+	b.Lock()
+	defer b.Unlock()
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return err
+	}
+	args := &pathOptions{}
+	for _, opt := range opts {
+		opt(args)
+	}
+	bp, err := b.Path()
+	if err != nil {
+		return err
+	}
+
+	diff, err := b.DiffLocal()
+	if err != nil {
+		return err
+	}
+	if len(diff) > 0 {
+		return ErrSparseChanges
+	}
+	filter := newSparseFilter(paths)
+	names, err := b.walkPath(bp)
+	if err != nil {
+		return err
+	}
+
+	b.conf.Viper.Set("sparse", []string(filter))
+	if err := b.conf.Viper.WriteConfig(); err != nil {
+		return err
+	}
+	b.repo.SetSparsePaths(filter)
+
+	for _, n := range names {
+		p := strings.TrimPrefix(n, bp+"/")
+		if filter.included(p, false) {
+			continue
+		}
+		if err := os.Remove(n); err != nil {
+			return err
+		}
+		if err := b.repo.RemovePath(ctx, p); err != nil {
+			return err
+		}
+		// Clean up directories left empty
+		for d := filepath.Dir(n); d != bp && strings.HasPrefix(d, bp); d = filepath.Dir(d) {
+			if err := os.Remove(d); err != nil {
+				break
+			}
+		}
+	}
+
+	// Newly included paths may not show up in a remote diff, so a full listing is needed
+	if _, err = b.getPath(ctx, "", bp, nil, args); err != nil {
+		return err
+	}
+	return b.repo.Save(ctx)
+}
+
+// skipPath returns whether or not the local file name n should be left out of syncing,
+// either because it's ignored or because it's outside of the sparse paths.
+func (b *Bucket) skipPath(ig *ignorer, bp, n string, isDir bool) bool {
+	if n == bp {
+		return false
+	}
+	rel := strings.TrimPrefix(n, bp+"/")
+	return ig.ignored(rel, isDir) || !b.repo.sparse.included(rel, isDir)
+}
@@ -0,0 +1,97 @@
+package local
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Stage marks local paths so that the next push only includes changes under them.
+// Paths are relative to the bucket's cwd, or absolute. Directories stage all the changes they contain.
+// Until something is staged, a push includes all changes.
+func (b *Bucket) Stage(paths ...string) error {
+	b.Lock()
+	defer b.Unlock()
+	staged, err := b.repo.StagedPaths()
+	if err != nil {
+		return err
+	}
+	for _, p := range paths {
+		rel, err := b.bucketPath(p)
+		if err != nil {
+			return err
+		}
+		if !isStaged(staged, rel) {
+			staged = append(staged, rel)
+		}
+	}
+	sort.Strings(staged)
+	return b.repo.SetStagedPaths(staged)
+}
+
+// Unstage removes local paths, and any staged paths they contain, from the next push.
+// Calling Unstage without paths clears everything that is staged.
+func (b *Bucket) Unstage(paths ...string) error {
+	b.Lock()
+	defer b.Unlock()
+	if len(paths) == 0 {
+		return b.repo.SetStagedPaths(nil)
+	}
+	staged, err := b.repo.StagedPaths()
+	if err != nil {
+		return err
+	}
+	for _, p := range paths {
+		rel, err := b.bucketPath(p)
+		if err != nil {
+			return err
+		}
+		keep := staged[:0]
+		for _, s := range staged {
+			if !isStaged([]string{rel}, s) {
+				keep = append(keep, s)
+			}
+		}
+		staged = keep
+	}
+	return b.repo.SetStagedPaths(staged)
+}
+
+// StagedPaths returns the paths, relative to the bucket root, that are staged for the next push.
+func (b *Bucket) StagedPaths() ([]string, error) {
+	return b.repo.StagedPaths()
+}
+
+// bucketPath returns pth relative to the bucket root.
+// An empty string is returned for the bucket root itself.
+func (b *Bucket) bucketPath(pth string) (string, error) {
+	bp, err := b.Path()
+	if err != nil {
+		return "", err
+	}
+	if !filepath.IsAbs(pth) {
+		pth = filepath.Join(b.cwd, pth)
+	}
+	rel, err := filepath.Rel(bp, pth)
+	if err != nil {
+		return "", err
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("path %s is outside of the bucket", pth)
+	}
+	if rel == "." {
+		return "", nil
+	}
+	return filepath.ToSlash(rel), nil
+}
+
+// isStaged returns whether or not the path, relative to the bucket root, is in or under one of the staged paths.
+func isStaged(staged []string, pth string) bool {
+	for _, s := range staged {
+		if s == "" || pth == s || strings.HasPrefix(pth, s+"/") {
+			return true
+		}
+	}
+	return false
+}
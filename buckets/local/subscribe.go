@@ -0,0 +1,157 @@
+package local
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	cid "github.com/ipfs/go-cid"
+	"github.com/textileio/go-threads/api/client"
+	"github.com/textileio/textile/cmd"
+)
+
+// RootChange describes a change to the remote bucket root, see Subscribe.
+type RootChange struct {
+	// Root is the new remote root.
+	Root cid.Cid
+	// Previous is the remote root the local bucket was at before the change.
+	Previous cid.Cid
+	// Pulled is true if the change was pulled into the local bucket.
+	Pulled bool
+}
+
+// RootChangeFunc is a caller-provided function which receives remote root changes.
+type RootChangeFunc func(RootChange)
+
+// Subscribe listens for remote root changes and auto-pulls them into the local bucket once they settle.
+// Use WithNotifyOnly to only receive changes with WithRootChange and leave the local bucket untouched.
+// Unlike Watch, local changes are never pushed.
+// Use the WithOffline option to keep subscribing during network interruptions.
+// Returns a channel of subscription connectivity states.
+// Cancel context to stop subscribing.
+func (b *Bucket) Subscribe(ctx context.Context, opts ...WatchOption) (<-chan cmd.WatchState, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	args := &watchOptions{
+		debounce: watchDebounceInterval,
+	}
+	for _, opt := range opts {
+		opt(args)
+	}
+	if !args.offline {
+		return b.subscribeWhileConnected(ctx, args)
+	}
+	return cmd.Watch(ctx, func(ctx context.Context) (<-chan cmd.WatchState, error) {
+		return b.subscribeWhileConnected(ctx, args)
+	}, reconnectInterval)
+}
+
+// subscribeWhileConnected will subscribe until context is canceled or an error occurs.
+func (b *Bucket) subscribeWhileConnected(ctx context.Context, args *watchOptions) (<-chan cmd.WatchState, error) {
+	id, err := b.Thread()
+	if err != nil {
+		return nil, err
+	}
+
+	state := make(chan cmd.WatchState)
+	go func() {
+		defer close(state)
+
+		events, err := b.clients.Threads.Listen(ctx, id, []client.ListenOption{{
+			Type:       client.ListenAll,
+			InstanceID: b.Key(),
+		}})
+		if err != nil {
+			state <- cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)}
+			return
+		}
+
+		// Manually sync once on startup to catch changes made while not subscribed
+		if err := b.subscribeSync(ctx, args); err != nil {
+			state <- cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)}
+			return
+		}
+
+		errs := make(chan error)
+		go func() {
+			// A single remote update can result in a burst of events,
+			// so the remote root is only checked once no new events arrive for the debounce interval.
+			timer := time.NewTimer(args.debounce)
+			timer.Stop()
+			defer timer.Stop()
+			for {
+				select {
+				case e, ok := <-events:
+					if !ok {
+						return
+					}
+					if e.Err != nil {
+						errs <- e.Err // events will close on error
+						return
+					}
+					if !timer.Stop() {
+						select {
+						case <-timer.C:
+						default:
+						}
+					}
+					timer.Reset(args.debounce)
+				case <-timer.C:
+					if err := b.subscribeSync(ctx, args); err != nil {
+						errs <- err
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+
+		// If we made it here, we must be online
+		state <- cmd.WatchState{State: cmd.Online}
+
+		for {
+			select {
+			case err := <-errs:
+				state <- cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)}
+				return
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return state, nil
+}
+
+// subscribeSync compares the remote root with the last known remote root,
+// pulling and reporting a change if they differ.
+func (b *Bucket) subscribeSync(ctx context.Context, args *watchOptions) error {
+	rc, err := b.getRemoteRoot(ctx)
+	if err != nil {
+		return err
+	}
+	_, prev, err := b.repo.Root()
+	if err != nil {
+		return err
+	}
+	// The last seen root is remembered so the same change isn't reported again,
+	// e.g., if it was not pulled or only touched paths outside of the sparse paths
+	if rc.Equals(prev) || rc.Equals(args.lastRoot) {
+		return nil
+	}
+	args.lastRoot = rc
+	change := RootChange{Root: rc, Previous: prev}
+	if !args.notifyOnly {
+		opts := append([]PathOption{WithPathEvents(args.events)}, args.pullOpts...)
+		if _, err := b.PullRemote(ctx, opts...); err != nil && !errors.Is(err, ErrUpToDate) {
+			return err
+		}
+		change.Pulled = true
+	}
+	if args.onChange != nil {
+		args.onChange(change)
+	}
+	return nil
+}
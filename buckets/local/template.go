@@ -0,0 +1,94 @@
+package local
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	gopath "path"
+	"path/filepath"
+	"strings"
+
+	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/api/common"
+	"github.com/textileio/textile/buckets"
+	"github.com/textileio/textile/util"
+)
+
+// gzipMagic is the header of gzip compressed data.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// RemoteBucketPath returns the root path of the remote bucket with key in thread id.
+// The path of a public bucket can be used with WithCid to start a new bucket from its contents.
+func (b *Buckets) RemoteBucketPath(ctx context.Context, id thread.ID, key string) (path.Resolved, error) {
+	ctx = common.NewThreadIDContext(b.Context(ctx), id)
+	rep, err := b.clients.Buckets.Root(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return util.NewResolvedPath(rep.Root.Path)
+}
+
+// extractArchive writes the files in the tarball r to dir.
+// Gzip compressed tarballs are detected automatically.
+// Entry names are confined to dir, and entries for the seed file, the config directory confDir,
+// and ignored files are skipped.
+func extractArchive(r io.Reader, dir, confDir string) error {
+	br := bufio.NewReader(r)
+	if magic, err := br.Peek(len(gzipMagic)); err == nil && bytes.Equal(magic, gzipMagic) {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		r = zr
+	} else {
+		r = br
+	}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		name := strings.TrimPrefix(gopath.Clean("/"+hdr.Name), "/")
+		if name == "" {
+			continue
+		}
+		top := strings.Split(name, "/")[0]
+		if top == buckets.SeedName || top == confDir || Ignore(name) {
+			continue
+		}
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, os.ModePerm); err != nil {
+				return err
+			}
+		case tar.TypeReg, tar.TypeRegA:
+			if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+				return err
+			}
+			file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, hdr.FileInfo().Mode().Perm())
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(file, tr); err != nil {
+				file.Close()
+				return err
+			}
+			if err := file.Close(); err != nil {
+				return err
+			}
+		default: // Links and special files have no place in a bucket
+			continue
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package local
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/ipfs/go-merkledag/dagutils"
+	"github.com/textileio/textile/buckets"
+)
+
+// Verify compares local files with the remote bucket without transferring any file contents.
+// Local files are hashed and checked against the cids of the remote items.
+// Drift is returned as changes, where an addition is a file that only exists locally,
+// a modification is a file that doesn't match the remote, and a removal is a remote file that's missing locally.
+// Ignored files and files outside of the sparse paths are not verified.
+func (b *Bucket) Verify(ctx context.Context) ([]Change, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	bp, err := b.Path()
+	if err != nil {
+		return nil, err
+	}
+	remote, _, err := b.listPath(ctx, b.Key(), "", bp, &pathOptions{force: true})
+	if err != nil {
+		return nil, err
+	}
+	local, err := b.walkPath(bp)
+	if err != nil {
+		return nil, err
+	}
+
+	var drift []Change
+	change := func(t dagutils.ChangeType, n string) error {
+		r, err := filepath.Rel(b.cwd, n)
+		if err != nil {
+			return err
+		}
+		p := strings.TrimPrefix(n, bp+"/")
+		drift = append(drift, Change{Type: t, Name: n, Path: p, Rel: r})
+		return nil
+	}
+	seen := make(map[string]struct{})
+	for _, o := range remote {
+		if o.path == buckets.SeedName {
+			continue
+		}
+		seen[o.name] = struct{}{}
+		if _, err := os.Stat(o.name); os.IsNotExist(err) {
+			if err := change(dagutils.Remove, o.name); err != nil {
+				return nil, err
+			}
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+		synced, err := b.isSynced(o)
+		if err != nil {
+			return nil, err
+		}
+		if !synced {
+			if err := change(dagutils.Mod, o.name); err != nil {
+				return nil, err
+			}
+		}
+	}
+	for _, n := range local {
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		if err := change(dagutils.Add, n); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(drift, func(i, j int) bool {
+		return drift[i].Path < drift[j].Path
+	})
+	return drift, nil
+}
@@ -3,6 +3,7 @@ package local
 import (
 	"context"
 	"errors"
+	"os"
 	"time"
 
 	"github.com/radovskyb/watcher"
@@ -14,10 +15,15 @@ import (
 const (
 	fileSystemWatchInterval = time.Millisecond * 100
 	reconnectInterval       = time.Second * 5
+
+	// watchDebounceInterval is the default amount of time local changes must settle before they are pushed.
+	watchDebounceInterval = time.Millisecond * 500
 )
 
-// Watch watches for and auto-pushes local bucket changes at an interval,
+// Watch watches for and auto-pushes local bucket changes once they settle,
 // and listens for and auto-pulls remote changes as they arrive.
+// Local changes to files that were also changed on the remote are kept next to the remote version,
+// see buckets.ConflictPath.
 // Use the WithOffline option to keep watching during network interruptions.
 // Returns a channel of watch connectivity states.
 // Cancel context to stop watching.
@@ -26,20 +32,22 @@ func (b *Bucket) Watch(ctx context.Context, opts ...WatchOption) (<-chan cmd.Wat
 	if err != nil {
 		return nil, err
 	}
-	args := &watchOptions{}
+	args := &watchOptions{
+		debounce: watchDebounceInterval,
+	}
 	for _, opt := range opts {
 		opt(args)
 	}
 	if !args.offline {
-		return b.watchWhileConnected(ctx, args.events)
+		return b.watchWhileConnected(ctx, args.debounce, args.events)
 	}
 	return cmd.Watch(ctx, func(ctx context.Context) (<-chan cmd.WatchState, error) {
-		return b.watchWhileConnected(ctx, args.events)
+		return b.watchWhileConnected(ctx, args.debounce, args.events)
 	}, reconnectInterval)
 }
 
 // watchWhileConnected will watch until context is canceled or an error occurs.
-func (b *Bucket) watchWhileConnected(ctx context.Context, pevents chan<- PathEvent) (<-chan cmd.WatchState, error) {
+func (b *Bucket) watchWhileConnected(ctx context.Context, debounce time.Duration, pevents chan<- PathEvent) (<-chan cmd.WatchState, error) {
 	id, err := b.Thread()
 	if err != nil {
 		return nil, err
@@ -55,6 +63,19 @@ func (b *Bucket) watchWhileConnected(ctx context.Context, pevents chan<- PathEve
 		w := watcher.New()
 		defer w.Close()
 		w.SetMaxEvents(1)
+		// Changes to ignored paths or paths outside of the sparse paths don't need to trigger a push.
+		// Note that the ignore file is only read once when watching starts.
+		ig, err := loadIgnorer(bp)
+		if err != nil {
+			state <- cmd.WatchState{Err: err, Aborted: true}
+			return
+		}
+		w.AddFilterHook(func(info os.FileInfo, n string) error {
+			if b.skipPath(ig, bp, n, info.IsDir()) {
+				return watcher.ErrSkip
+			}
+			return nil
+		})
 		if err := w.AddRecursive(bp); err != nil {
 			state <- cmd.WatchState{Err: err, Aborted: true}
 			return
@@ -88,9 +109,22 @@ func (b *Bucket) watchWhileConnected(ctx context.Context, pevents chan<- PathEve
 			}
 		}()
 		go func() {
+			// Changes are pushed once no new events arrive for the debounce interval,
+			// so that a burst of writes results in a single push.
+			timer := time.NewTimer(debounce)
+			timer.Stop()
+			defer timer.Stop()
 			for {
 				select {
 				case <-w.Event:
+					if !timer.Stop() {
+						select {
+						case <-timer.C:
+						default:
+						}
+					}
+					timer.Reset(debounce)
+				case <-timer.C:
 					if err := b.watchPush(ctx, pevents); err != nil {
 						errs <- err
 					}
@@ -133,7 +167,7 @@ func (b *Bucket) watchPush(ctx context.Context, events chan<- PathEvent) error {
 		return nil
 	} else if errors.Is(err, buckets.ErrNonFastForward) {
 		// Pull remote changes
-		if _, err = b.PullRemote(ctx, WithPathEvents(events)); err != nil {
+		if _, err = b.PullRemote(ctx, WithKeepBoth(true), WithPathEvents(events)); err != nil {
 			return err
 		}
 		// Now try pushing again
@@ -149,7 +183,7 @@ func (b *Bucket) watchPush(ctx context.Context, events chan<- PathEvent) error {
 func (b *Bucket) watchPull(ctx context.Context, events chan<- PathEvent) error {
 	select {
 	case b.pushBlock <- struct{}{}:
-		if _, err := b.PullRemote(ctx, WithPathEvents(events)); !errors.Is(err, ErrUpToDate) {
+		if _, err := b.PullRemote(ctx, WithKeepBoth(true), WithPathEvents(events)); !errors.Is(err, ErrUpToDate) {
 			<-b.pushBlock
 			return err
 		}
@@ -0,0 +1,84 @@
+package local
+
+import (
+	"context"
+
+	pb "github.com/textileio/textile/api/buckets/pb"
+	"github.com/textileio/textile/buckets"
+)
+
+// SetWebsite replaces the website config of the remote bucket.
+// A nil config removes it.
+func (b *Bucket) SetWebsite(ctx context.Context, website *buckets.Website) (*buckets.Website, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	w, err := b.clients.Buckets.SetWebsite(ctx, b.Key(), websiteToPb(website))
+	if err != nil {
+		return nil, err
+	}
+	return pbWebsiteToWebsite(w), nil
+}
+
+// Website returns the website config of the remote bucket.
+func (b *Bucket) Website(ctx context.Context) (*buckets.Website, error) {
+	ctx, err := b.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+	w, err := b.clients.Buckets.GetWebsite(ctx, b.Key())
+	if err != nil {
+		return nil, err
+	}
+	return pbWebsiteToWebsite(w), nil
+}
+
+func websiteToPb(w *buckets.Website) *pb.Website {
+	if w == nil {
+		return &pb.Website{}
+	}
+	pw := &pb.Website{
+		IndexDocument: w.IndexDocument,
+		ErrorDocument: w.ErrorDocument,
+	}
+	for _, r := range w.Redirects {
+		pw.Redirects = append(pw.Redirects, &pb.Website_Redirect{
+			From:   r.From,
+			To:     r.To,
+			Status: int32(r.Status),
+			Force:  r.Force,
+		})
+	}
+	for _, h := range w.Headers {
+		pw.Headers = append(pw.Headers, &pb.Website_Header{
+			Path:  h.Path,
+			Name:  h.Name,
+			Value: h.Value,
+		})
+	}
+	return pw
+}
+
+func pbWebsiteToWebsite(pw *pb.Website) *buckets.Website {
+	w := &buckets.Website{
+		IndexDocument: pw.IndexDocument,
+		ErrorDocument: pw.ErrorDocument,
+	}
+	for _, r := range pw.Redirects {
+		w.Redirects = append(w.Redirects, buckets.Redirect{
+			From:   r.From,
+			To:     r.To,
+			Status: int(r.Status),
+			Force:  r.Force,
+		})
+	}
+	for _, h := range pw.Headers {
+		w.Headers = append(w.Headers, buckets.Header{
+			Path:  h.Path,
+			Name:  h.Name,
+			Value: h.Value,
+		})
+	}
+	return w
+}
@@ -0,0 +1,164 @@
+// Package pinning is a client for remote pinning services that implement the IPFS Pinning Service API,
+// see https://ipfs.github.io/pinning-services-api-spec.
+package pinning
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/textileio/textile/util"
+)
+
+// Status is the state of a pin request.
+type Status string
+
+const (
+	// Queued pins are waiting to be processed by the service.
+	Queued Status = "queued"
+	// Pinning pins are being retrieved by the service.
+	Pinning Status = "pinning"
+	// Pinned pins are stored by the service.
+	Pinned Status = "pinned"
+	// Failed pins could not be retrieved by the service.
+	Failed Status = "failed"
+)
+
+// Pin describes content that should be pinned.
+type Pin struct {
+	Cid     string            `json:"cid"`
+	Name    string            `json:"name,omitempty"`
+	Origins []string          `json:"origins,omitempty"`
+	Meta    map[string]string `json:"meta,omitempty"`
+}
+
+// PinStatus is the state of a pin request.
+type PinStatus struct {
+	RequestID string    `json:"requestid"`
+	Status    Status    `json:"status"`
+	Created   time.Time `json:"created"`
+	Pin       Pin       `json:"pin"`
+	Delegates []string  `json:"delegates"`
+}
+
+// Error is an error returned by the pinning service.
+type Error struct {
+	StatusCode int
+	Reason     string `json:"reason"`
+	Details    string `json:"details"`
+}
+
+func (e *Error) Error() string {
+	if e.Reason == "" {
+		return fmt.Sprintf("pin request failed with status %d", e.StatusCode)
+	}
+	if e.Details == "" {
+		return fmt.Sprintf("pin request failed with status %d: %s", e.StatusCode, e.Reason)
+	}
+	return fmt.Sprintf("pin request failed with status %d: %s: %s", e.StatusCode, e.Reason, e.Details)
+}
+
+// IsNotFound returns whether or not err indicates that a pin request does not exist.
+func IsNotFound(err error) bool {
+	perr, ok := err.(*Error)
+	return ok && perr.StatusCode == http.StatusNotFound
+}
+
+// Client manages pins of a remote pinning service.
+type Client struct {
+	endpoint string
+	token    string
+	hc       *http.Client
+}
+
+// NewClient returns a client for the pinning service at endpoint, e.g., "https://api.pinata.cloud/psa".
+// Requests are authenticated with the access token.
+// The client refuses redirects and non-public addresses unless allowPrivate is true,
+// which is only meant for development and tests.
+func NewClient(endpoint, token string, allowPrivate bool) (*Client, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parsing endpoint: %v", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("endpoint must be an http or https url")
+	}
+	return &Client{
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		token:    token,
+		hc:       util.NewPublicHTTPClient(time.Minute, allowPrivate),
+	}, nil
+}
+
+// Add requests pin to be pinned.
+func (c *Client) Add(ctx context.Context, pin Pin) (*PinStatus, error) {
+	return c.do(ctx, http.MethodPost, "/pins", pin)
+}
+
+// Get returns the status of the pin request with id.
+func (c *Client) Get(ctx context.Context, id string) (*PinStatus, error) {
+	return c.do(ctx, http.MethodGet, "/pins/"+url.PathEscape(id), nil)
+}
+
+// Replace replaces the pin request with id with pin.
+// The service removes the old pin once the new one is pinned.
+func (c *Client) Replace(ctx context.Context, id string, pin Pin) (*PinStatus, error) {
+	return c.do(ctx, http.MethodPost, "/pins/"+url.PathEscape(id), pin)
+}
+
+// Remove removes the pin request with id.
+func (c *Client) Remove(ctx context.Context, id string) error {
+	_, err := c.do(ctx, http.MethodDelete, "/pins/"+url.PathEscape(id), nil)
+	return err
+}
+
+func (c *Client) do(ctx context.Context, method, pth string, body interface{}) (*PinStatus, error) {
+	var r io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+pth, r)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	res, err := c.hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		perr := &Error{StatusCode: res.StatusCode}
+		data, _ := ioutil.ReadAll(io.LimitReader(res.Body, 64*1024))
+		var failure struct {
+			Error *Error `json:"error"`
+		}
+		failure.Error = perr
+		_ = json.Unmarshal(data, &failure)
+		return nil, perr
+	}
+	if method == http.MethodDelete {
+		return nil, nil
+	}
+	var st PinStatus
+	if err := json.NewDecoder(res.Body).Decode(&st); err != nil {
+		return nil, fmt.Errorf("decoding pin status: %v", err)
+	}
+	return &st, nil
+}
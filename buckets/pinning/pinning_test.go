@@ -0,0 +1,127 @@
+package pinning
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/util"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	pins := make(map[string]PinStatus)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"reason":"UNAUTHORIZED","details":"Invalid token"}}`))
+			return
+		}
+		id := r.URL.Path[len("/pins"):]
+		if len(id) > 0 {
+			id = id[1:]
+		}
+		switch r.Method {
+		case http.MethodPost:
+			if id != "" {
+				if _, ok := pins[id]; !ok {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				delete(pins, id)
+			}
+			var pin Pin
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&pin))
+			st := PinStatus{RequestID: pin.Cid, Status: Queued, Pin: pin}
+			pins[st.RequestID] = st
+			w.WriteHeader(http.StatusAccepted)
+			_ = json.NewEncoder(w).Encode(st)
+		case http.MethodGet:
+			st, ok := pins[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			st.Status = Pinned
+			_ = json.NewEncoder(w).Encode(st)
+		case http.MethodDelete:
+			delete(pins, id)
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	c, err := NewClient(server.URL+"/", "token", true)
+	require.NoError(t, err)
+	st, err := c.Add(ctx, Pin{Cid: "cid1", Name: "buck", Origins: []string{"/ip4/127.0.0.1/tcp/4001"}})
+	require.NoError(t, err)
+	assert.Equal(t, "cid1", st.RequestID)
+	assert.Equal(t, Queued, st.Status)
+	assert.Equal(t, "buck", st.Pin.Name)
+
+	st, err = c.Get(ctx, st.RequestID)
+	require.NoError(t, err)
+	assert.Equal(t, Pinned, st.Status)
+
+	st, err = c.Replace(ctx, st.RequestID, Pin{Cid: "cid2"})
+	require.NoError(t, err)
+	assert.Equal(t, "cid2", st.RequestID)
+	_, err = c.Get(ctx, "cid1")
+	assert.True(t, IsNotFound(err))
+
+	require.NoError(t, c.Remove(ctx, "cid2"))
+	_, err = c.Replace(ctx, "cid2", Pin{Cid: "cid3"})
+	assert.True(t, IsNotFound(err))
+
+	anon, err := NewClient(server.URL, "", true)
+	require.NoError(t, err)
+	_, err = anon.Add(ctx, Pin{Cid: "cid1"})
+	require.Error(t, err)
+	perr, ok := err.(*Error)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusUnauthorized, perr.StatusCode)
+	assert.Equal(t, "UNAUTHORIZED", perr.Reason)
+
+	_, err = NewClient("ftp://pins", "", false)
+	require.Error(t, err)
+}
+
+func TestClient_NonPublic(t *testing.T) {
+	t.Parallel()
+
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	for _, endpoint := range []string{server.URL, "http://169.254.169.254"} {
+		c, err := NewClient(endpoint, "token", false)
+		require.NoError(t, err)
+		_, err = c.Add(ctx, Pin{Cid: "cid1"})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, util.ErrNonPublicAddress), err)
+	}
+	assert.Zero(t, hits)
+
+	redirect := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, server.URL+r.URL.Path, http.StatusTemporaryRedirect)
+	}))
+	defer redirect.Close()
+	c, err := NewClient(redirect.URL, "token", true)
+	require.NoError(t, err)
+	_, err = c.Add(ctx, Pin{Cid: "cid1"})
+	require.Error(t, err)
+	perr, ok := err.(*Error)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusTemporaryRedirect, perr.StatusCode)
+	assert.Zero(t, hits)
+}
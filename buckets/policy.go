@@ -0,0 +1,35 @@
+package buckets
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Push policy rule names.
+const (
+	RuleMaxFileSize        = "max_file_size"
+	RuleForbiddenExtension = "forbidden_extension"
+	RuleRequiredPath       = "required_path"
+	RuleLicenseRequired    = "license_required"
+)
+
+// PolicyViolation describes a change that breaks a push policy rule.
+type PolicyViolation struct {
+	Rule   string
+	Path   string
+	Detail string
+}
+
+// PushRejectedError is returned when a change to a bucket breaks one or more
+// rules of the owning org's push policy.
+type PushRejectedError struct {
+	Violations []PolicyViolation
+}
+
+func (e *PushRejectedError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = fmt.Sprintf("%s: %s (%s)", v.Rule, v.Path, v.Detail)
+	}
+	return "push rejected by org policy: " + strings.Join(msgs, "; ")
+}
@@ -0,0 +1,176 @@
+package buckets
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const (
+	// RedirectsName is the file name at the bucket root used to configure website redirects.
+	RedirectsName = "_redirects"
+
+	// MaxRedirectsSize is the max size of a redirects file.
+	MaxRedirectsSize = 64 * 1024
+	// MaxRedirects is the max number of rules in a redirects file.
+	MaxRedirects = 1000
+)
+
+// Redirect is a single redirect rule, e.g., "/blog/* /posts/:splat 301".
+// A status of 200 rewrites the request to the target path without redirecting.
+// Forced rules apply even if the requested path exists.
+type Redirect struct {
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Status int    `json:"status"`
+	Force  bool   `json:"force,omitempty"`
+}
+
+// ParseRedirects parses Netlify-style redirect rules from r.
+// Each line contains a source path, a target path or URL, and an optional status code,
+// which may be suffixed with "!" to force the rule. Blank lines and lines starting with
+// "#" are ignored.
+func ParseRedirects(r io.Reader) ([]Redirect, error) {
+	var rules []Redirect
+	scanner := bufio.NewScanner(io.LimitReader(r, MaxRedirectsSize+1))
+	var size, num int
+	for scanner.Scan() {
+		num++
+		line := scanner.Text()
+		size += len(line) + 1
+		if size > MaxRedirectsSize {
+			return nil, fmt.Errorf("%s exceeds max size of %d bytes", RedirectsName, MaxRedirectsSize)
+		}
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		rule, err := parseRedirect(fields)
+		if err != nil {
+			return nil, fmt.Errorf("%s line %d: %v", RedirectsName, num, err)
+		}
+		rules = append(rules, rule)
+		if len(rules) > MaxRedirects {
+			return nil, fmt.Errorf("%s exceeds max of %d rules", RedirectsName, MaxRedirects)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func parseRedirect(fields []string) (rule Redirect, err error) {
+	if len(fields) < 2 {
+		return rule, fmt.Errorf("a source and target are required")
+	}
+	if len(fields) > 3 {
+		return rule, fmt.Errorf("query and header conditions are not supported")
+	}
+	rule.From = fields[0]
+	rule.To = fields[1]
+	rule.Status = http.StatusMovedPermanently
+	if len(fields) == 3 {
+		code := fields[2]
+		if strings.HasSuffix(code, "!") {
+			rule.Force = true
+			code = strings.TrimSuffix(code, "!")
+		}
+		rule.Status, err = strconv.Atoi(code)
+		if err != nil {
+			return rule, fmt.Errorf("invalid status %s", fields[2])
+		}
+	}
+	return rule, ValidRedirect(rule)
+}
+
+// ValidRedirect returns an error if rule is not a valid redirect rule.
+func ValidRedirect(rule Redirect) error {
+	switch rule.Status {
+	case http.StatusOK, http.StatusNotFound, http.StatusGone:
+		if !strings.HasPrefix(rule.To, "/") {
+			return fmt.Errorf("status %d requires a target path in the bucket", rule.Status)
+		}
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther,
+		http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+	default:
+		return fmt.Errorf("unsupported status %d", rule.Status)
+	}
+	if !strings.HasPrefix(rule.From, "/") {
+		return fmt.Errorf("source must be a path starting with '/'")
+	}
+	if i := strings.Index(rule.From, "*"); i >= 0 && i != len(rule.From)-1 {
+		return fmt.Errorf("a splat is only allowed at the end of the source")
+	}
+	if !strings.HasPrefix(rule.To, "/") {
+		u, err := url.Parse(rule.To)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("target must be a path starting with '/' or an absolute URL")
+		}
+	}
+	return nil
+}
+
+// MatchRedirect returns the first rule in rules matching pth along with its
+// target, in which placeholders have been replaced with values from pth.
+// Only forced rules are considered if exists is true.
+func MatchRedirect(rules []Redirect, pth string, exists bool) (rule Redirect, target string, ok bool) {
+	for _, r := range rules {
+		if exists && !r.Force {
+			continue
+		}
+		params, match := matchRedirectPath(r.From, pth)
+		if !match {
+			continue
+		}
+		target = r.To
+		for k, v := range params {
+			target = strings.ReplaceAll(target, ":"+k, v)
+		}
+		return r, target, true
+	}
+	return rule, "", false
+}
+
+func matchRedirectPath(pattern, pth string) (map[string]string, bool) {
+	params := make(map[string]string)
+	pattern = strings.TrimSuffix(pattern, "/")
+	pth = strings.TrimSuffix(pth, "/")
+	if strings.HasSuffix(pattern, "*") {
+		prefix := strings.TrimSuffix(pattern, "*")
+		trimmed := strings.TrimSuffix(prefix, "/")
+		if pth == trimmed {
+			params["splat"] = ""
+			prefix = trimmed
+		} else if !strings.HasPrefix(pth, prefix) {
+			return nil, false
+		} else {
+			params["splat"] = strings.TrimPrefix(pth, prefix)
+		}
+		pattern = prefix
+		pth = pth[:len(prefix)]
+	}
+	pparts := strings.Split(pattern, "/")
+	parts := strings.Split(pth, "/")
+	if len(pparts) != len(parts) {
+		return nil, false
+	}
+	for i, p := range pparts {
+		if strings.HasPrefix(p, ":") && len(p) > 1 {
+			if parts[i] == "" {
+				return nil, false
+			}
+			params[p[1:]] = parts[i]
+		} else if p != parts[i] {
+			return nil, false
+		}
+	}
+	return params, true
+}
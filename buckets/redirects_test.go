@@ -0,0 +1,84 @@
+package buckets
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRedirects(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		rules, err := ParseRedirects(strings.NewReader(`
+# Comments and blank lines are ignored
+
+/old          /new
+/blog/*       /posts/:splat  302
+/app/*        /index.html    200!
+/docs         https://docs.example.com 308 # trailing comment
+`))
+		require.NoError(t, err)
+		assert.Equal(t, []Redirect{
+			{From: "/old", To: "/new", Status: 301},
+			{From: "/blog/*", To: "/posts/:splat", Status: 302},
+			{From: "/app/*", To: "/index.html", Status: 200, Force: true},
+			{From: "/docs", To: "https://docs.example.com", Status: 308},
+		}, rules)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, line := range []string{
+			"/only-source",
+			"/a /b 500",
+			"/a /b abc",
+			"relative /b",
+			"/a/*/b /c",
+			"/a https://example.com 200",
+			"/a ftp://example.com",
+			"/a /b 301 Country=us",
+		} {
+			_, err := ParseRedirects(strings.NewReader(line))
+			assert.Error(t, err, line)
+		}
+	})
+
+	t.Run("too large", func(t *testing.T) {
+		_, err := ParseRedirects(strings.NewReader(strings.Repeat("/a /b\n", MaxRedirects+1)))
+		assert.Error(t, err)
+	})
+}
+
+func TestMatchRedirect(t *testing.T) {
+	t.Parallel()
+	rules := []Redirect{
+		{From: "/news/:year/:month", To: "/archive/:year-:month", Status: 301},
+		{From: "/blog/*", To: "/posts/:splat", Status: 302},
+		{From: "/app/*", To: "/index.html", Status: 200, Force: true},
+	}
+
+	_, to, ok := MatchRedirect(rules, "/news/2020/08", false)
+	require.True(t, ok)
+	assert.Equal(t, "/archive/2020-08", to)
+
+	_, _, ok = MatchRedirect(rules, "/news/2020", false)
+	assert.False(t, ok)
+
+	rule, to, ok := MatchRedirect(rules, "/blog/a/b.html", false)
+	require.True(t, ok)
+	assert.Equal(t, 302, rule.Status)
+	assert.Equal(t, "/posts/a/b.html", to)
+
+	_, to, ok = MatchRedirect(rules, "/blog", false)
+	require.True(t, ok)
+	assert.Equal(t, "/posts/", to)
+
+	_, _, ok = MatchRedirect(rules, "/blog/a", true)
+	assert.False(t, ok, "unforced rules should not shadow existing paths")
+
+	_, to, ok = MatchRedirect(rules, "/app/settings", true)
+	require.True(t, ok)
+	assert.Equal(t, "/index.html", to)
+}
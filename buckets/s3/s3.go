@@ -0,0 +1,268 @@
+// Package s3 is a minimal client for listing and reading objects from S3 compatible storage.
+package s3
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/textileio/textile/util"
+)
+
+const (
+	// DefaultRegion is used if no region is given.
+	DefaultRegion = "us-east-1"
+
+	// emptyHash is the hex encoded SHA256 hash of an empty payload.
+	emptyHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+	timeFormat = "20060102T150405Z"
+	dateFormat = "20060102"
+)
+
+// Config describes how to reach an S3 bucket.
+type Config struct {
+	// Endpoint is the base URL of an S3 compatible service, e.g., "https://storage.example.com".
+	// Objects are addressed with path-style URLs. If empty, the AWS endpoint of the region is
+	// used with virtual-hosted-style URLs.
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	// AllowPrivate allows Endpoint to resolve to loopback, private, and link-local addresses.
+	// The endpoint is usually user supplied, so this is only meant for development and tests.
+	AllowPrivate bool
+}
+
+// Object describes an object in an S3 bucket.
+type Object struct {
+	Key          string    `xml:"Key"`
+	Size         int64     `xml:"Size"`
+	ETag         string    `xml:"ETag"`
+	LastModified time.Time `xml:"LastModified"`
+}
+
+// Error is an error returned by the S3 service.
+type Error struct {
+	StatusCode int
+	Code       string `xml:"Code"`
+	Message    string `xml:"Message"`
+}
+
+func (e *Error) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("s3 request failed with status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("s3 request failed with status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// Client lists and reads objects of an S3 bucket.
+type Client struct {
+	conf Config
+	base *url.URL
+	hc   *http.Client
+
+	now func() time.Time
+}
+
+// NewClient returns a client for the bucket in conf.
+func NewClient(conf Config) (*Client, error) {
+	if conf.Bucket == "" {
+		return nil, fmt.Errorf("bucket is required")
+	}
+	if conf.Region == "" {
+		conf.Region = DefaultRegion
+	}
+	var base *url.URL
+	var err error
+	if conf.Endpoint == "" {
+		base, err = url.Parse(fmt.Sprintf("https://%s.s3.%s.amazonaws.com/", conf.Bucket, conf.Region))
+	} else {
+		base, err = url.Parse(strings.TrimSuffix(conf.Endpoint, "/") + "/" + conf.Bucket + "/")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("parsing endpoint: %v", err)
+	}
+	if base.Scheme != "http" && base.Scheme != "https" {
+		return nil, fmt.Errorf("endpoint must be an http or https url")
+	}
+	return &Client{
+		conf: conf,
+		base: base,
+		hc:   util.NewPublicHTTPClient(0, conf.AllowPrivate),
+		now:  time.Now,
+	}, nil
+}
+
+type listResult struct {
+	Contents    []Object `xml:"Contents"`
+	IsTruncated bool     `xml:"IsTruncated"`
+}
+
+// ListObjects returns up to max objects with prefix in key order, starting after the key startAfter.
+// The second return value is true if there are more objects.
+func (c *Client) ListObjects(ctx context.Context, prefix, startAfter string, max int) ([]Object, bool, error) {
+	q := url.Values{}
+	q.Set("list-type", "2")
+	if prefix != "" {
+		q.Set("prefix", prefix)
+	}
+	if startAfter != "" {
+		q.Set("start-after", startAfter)
+	}
+	if max > 0 {
+		q.Set("max-keys", strconv.Itoa(max))
+	}
+	res, err := c.do(ctx, "", q)
+	if err != nil {
+		return nil, false, err
+	}
+	defer res.Body.Close()
+	var result listResult
+	if err := xml.NewDecoder(res.Body).Decode(&result); err != nil {
+		return nil, false, fmt.Errorf("decoding object list: %v", err)
+	}
+	return result.Contents, result.IsTruncated, nil
+}
+
+// GetObject returns the content of the object with key and its content type.
+// The caller must close the returned reader.
+func (c *Client) GetObject(ctx context.Context, key string) (io.ReadCloser, string, error) {
+	res, err := c.do(ctx, key, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	return res.Body, res.Header.Get("Content-Type"), nil
+}
+
+func (c *Client) do(ctx context.Context, key string, query url.Values) (*http.Response, error) {
+	u := *c.base
+	u.Path += key
+	u.RawPath = c.base.EscapedPath() + escapeKey(key)
+	u.RawQuery = query.Encode()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	c.sign(req)
+	res, err := c.hc.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode != http.StatusOK {
+		defer res.Body.Close()
+		serr := &Error{StatusCode: res.StatusCode}
+		body, _ := ioutil.ReadAll(io.LimitReader(res.Body, 64*1024))
+		_ = xml.Unmarshal(body, serr)
+		return nil, serr
+	}
+	return res, nil
+}
+
+// sign adds an AWS Signature Version 4 authorization header to the bodiless request req,
+// see https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html.
+// Requests are sent anonymously if the client has no access key.
+func (c *Client) sign(req *http.Request) {
+	if c.conf.AccessKey == "" {
+		return
+	}
+	now := c.now().UTC()
+	req.Header.Set("x-amz-date", now.Format(timeFormat))
+	req.Header.Set("x-amz-content-sha256", emptyHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		emptyHash,
+	}, "\n")
+	scope := strings.Join([]string{now.Format(dateFormat), c.conf.Region, "s3", "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		now.Format(timeFormat),
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.conf.SecretKey), now.Format(dateFormat))
+	key = hmacSHA256(key, c.conf.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		c.conf.AccessKey, scope, signedHeaders, signature))
+}
+
+// canonicalQuery returns the query sorted by key with values escaped as required by SigV4.
+func canonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, k := range keys {
+		vals := q[k]
+		sort.Strings(vals)
+		for _, v := range vals {
+			parts = append(parts, escape(k)+"="+escape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// escape percent-encodes everything except unreserved characters.
+func escape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+// escapeKey escapes each segment of an object key.
+func escapeKey(key string) string {
+	parts := strings.Split(key, "/")
+	for i, p := range parts {
+		parts[i] = escape(p)
+	}
+	return strings.Join(parts, "/")
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
@@ -0,0 +1,138 @@
+package buckets
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif" // Register the GIF decoder
+	"image/jpeg"
+	"image/png"
+	"io"
+	"io/ioutil"
+	"mime"
+)
+
+// ThumbnailSizes maps thumbnail size names to the max width and height of the thumbnail in pixels.
+var ThumbnailSizes = map[string]int{
+	"small":  128,
+	"medium": 256,
+	"large":  512,
+}
+
+const (
+	// MaxThumbnailSourceSize is the max size in bytes of images that thumbnails are generated for.
+	MaxThumbnailSourceSize = 32 << 20
+	// maxThumbnailSourcePixels is the max number of pixels of images that thumbnails are generated for.
+	maxThumbnailSourcePixels = 50 << 20
+	// thumbnailQuality is the quality of JPEG thumbnails.
+	thumbnailQuality = 80
+)
+
+// ErrThumbnailSourceTooLarge is returned when an image is too large to generate thumbnails for.
+var ErrThumbnailSourceTooLarge = errors.New("image is too large for thumbnails")
+
+// Thumbnailable returns whether or not thumbnails can be generated for content with contentType.
+func Thumbnailable(contentType string) bool {
+	mt, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	switch mt {
+	case "image/jpeg", "image/png", "image/gif":
+		return true
+	default:
+		return false
+	}
+}
+
+// Thumbnails decodes the image read from r and returns a thumbnail for each of ThumbnailSizes,
+// along with the content type of the thumbnails.
+// JPEG images have JPEG thumbnails, other images have PNG thumbnails.
+// Images are only scaled down, so thumbnails of small images have the same dimensions as the image.
+func Thumbnails(r io.Reader) (map[string][]byte, string, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(r, MaxThumbnailSourceSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > MaxThumbnailSourceSize {
+		return nil, "", ErrThumbnailSourceTooLarge
+	}
+	conf, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("decoding image config: %v", err)
+	}
+	if conf.Width*conf.Height > maxThumbnailSourcePixels {
+		return nil, "", ErrThumbnailSourceTooLarge
+	}
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, "", fmt.Errorf("decoding image: %v", err)
+	}
+	contentType := "image/png"
+	if format == "jpeg" {
+		contentType = "image/jpeg"
+	}
+	thumbs := make(map[string][]byte, len(ThumbnailSizes))
+	for name, max := range ThumbnailSizes {
+		var buf bytes.Buffer
+		thumb := scaleImage(img, max)
+		if contentType == "image/jpeg" {
+			err = jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: thumbnailQuality})
+		} else {
+			err = png.Encode(&buf, thumb)
+		}
+		if err != nil {
+			return nil, "", fmt.Errorf("encoding thumbnail: %v", err)
+		}
+		thumbs[name] = buf.Bytes()
+	}
+	return thumbs, contentType, nil
+}
+
+// scaleImage scales img down to fit within max by max pixels, preserving its aspect ratio.
+// Each pixel of the result is the average of the pixels it covers in img.
+func scaleImage(img image.Image, max int) image.Image {
+	b := img.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	dw, dh := sw, sh
+	if sw > max || sh > max {
+		if sw >= sh {
+			dw, dh = max, sh*max/sw
+		} else {
+			dw, dh = sw*max/sh, max
+		}
+		if dw < 1 {
+			dw = 1
+		}
+		if dh < 1 {
+			dh = 1
+		}
+	}
+	dst := image.NewNRGBA(image.Rect(0, 0, dw, dh))
+	for dy := 0; dy < dh; dy++ {
+		y0, y1 := b.Min.Y+dy*sh/dh, b.Min.Y+(dy+1)*sh/dh
+		for dx := 0; dx < dw; dx++ {
+			x0, x1 := b.Min.X+dx*sw/dw, b.Min.X+(dx+1)*sw/dw
+			var r, g, bl, a, n uint64
+			for y := y0; y < y1; y++ {
+				for x := x0; x < x1; x++ {
+					c := color.NRGBA64Model.Convert(img.At(x, y)).(color.NRGBA64)
+					r += uint64(c.R)
+					g += uint64(c.G)
+					bl += uint64(c.B)
+					a += uint64(c.A)
+					n++
+				}
+			}
+			dst.Set(dx, dy, color.NRGBA64{
+				R: uint16(r / n),
+				G: uint16(g / n),
+				B: uint16(bl / n),
+				A: uint16(a / n),
+			})
+		}
+	}
+	return dst
+}
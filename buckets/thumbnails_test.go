@@ -0,0 +1,81 @@
+package buckets
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThumbnailable(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, Thumbnailable("image/jpeg"))
+	assert.True(t, Thumbnailable("image/png"))
+	assert.True(t, Thumbnailable("image/gif"))
+	assert.False(t, Thumbnailable("image/svg+xml"))
+	assert.False(t, Thumbnailable("text/plain"))
+	assert.False(t, Thumbnailable(""))
+}
+
+func TestThumbnails(t *testing.T) {
+	t.Parallel()
+
+	t.Run("jpeg", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		err := jpeg.Encode(&buf, testImage(1024, 512), nil)
+		require.NoError(t, err)
+
+		thumbs, ct, err := Thumbnails(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, "image/jpeg", ct)
+		require.Len(t, thumbs, len(ThumbnailSizes))
+		for name, max := range ThumbnailSizes {
+			img, err := jpeg.Decode(bytes.NewReader(thumbs[name]))
+			require.NoError(t, err)
+			assert.Equal(t, max, img.Bounds().Dx())
+			assert.Equal(t, max/2, img.Bounds().Dy())
+		}
+	})
+
+	t.Run("small png", func(t *testing.T) {
+		t.Parallel()
+		var buf bytes.Buffer
+		err := png.Encode(&buf, testImage(100, 200))
+		require.NoError(t, err)
+
+		thumbs, ct, err := Thumbnails(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, "image/png", ct)
+		img, err := png.Decode(bytes.NewReader(thumbs["large"]))
+		require.NoError(t, err)
+		assert.Equal(t, 100, img.Bounds().Dx())
+		assert.Equal(t, 200, img.Bounds().Dy())
+		img, err = png.Decode(bytes.NewReader(thumbs["small"]))
+		require.NoError(t, err)
+		assert.Equal(t, 64, img.Bounds().Dx())
+		assert.Equal(t, 128, img.Bounds().Dy())
+	})
+
+	t.Run("not an image", func(t *testing.T) {
+		t.Parallel()
+		_, _, err := Thumbnails(bytes.NewReader([]byte("hello")))
+		assert.Error(t, err)
+	})
+}
+
+func testImage(w, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	return img
+}
@@ -0,0 +1,139 @@
+// Package webhooks emits signed HTTP callbacks for bucket events.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	mdb "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// PathPushed is emitted when a file is pushed to a bucket path.
+	PathPushed = "path.pushed"
+	// PathRemoved is emitted when a bucket path is removed.
+	PathRemoved = "path.removed"
+	// PathMoved is emitted when a bucket path is moved to a new path.
+	PathMoved = "path.moved"
+	// RootChanged is emitted when a bucket root changes.
+	RootChanged = "root.changed"
+	// ArchiveCompleted is emitted when a bucket archive succeeds.
+	ArchiveCompleted = "archive.completed"
+	// ArchiveFailed is emitted when a bucket archive fails.
+	ArchiveFailed = "archive.failed"
+	// ArchiveRenewed is emitted when the deals of a bucket archive are renewed.
+	ArchiveRenewed = "archive.renewed"
+	// ArchiveUnfunded is emitted when the deals of a bucket archive are due for renewal
+	// but the bucket wallet has no funds.
+	ArchiveUnfunded = "archive.unfunded"
+
+	// SignatureHeader holds the hex encoded HMAC-SHA256 of the request body keyed by the webhook secret.
+	SignatureHeader = "X-Textile-Signature"
+	// EventHeader holds the event type.
+	EventHeader = "X-Textile-Event"
+	// DeliveryHeader holds the delivery ID, which is stable across retries.
+	DeliveryHeader = "X-Textile-Delivery"
+)
+
+// Events are the valid event types.
+var Events = []string{PathPushed, PathRemoved, PathMoved, RootChanged, ArchiveCompleted, ArchiveFailed, ArchiveRenewed, ArchiveUnfunded}
+
+// Event is the JSON body of a webhook callback.
+type Event struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	BucketKey string    `json:"bucket_key"`
+	Thread    string    `json:"thread,omitempty"`
+	Path      string    `json:"path,omitempty"`
+	From      string    `json:"from,omitempty"`
+	Cid       string    `json:"cid,omitempty"`
+	Root      string    `json:"root,omitempty"`
+	Actor     string    `json:"actor,omitempty"`
+	Message   string    `json:"message,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Valid returns whether or not event is a known event type.
+func Valid(event string) bool {
+	for _, e := range Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// Publish queues a delivery of ev to each webhook of the event's bucket that receives it.
+func Publish(ctx context.Context, colls *mdb.Collections, ev Event) error {
+	hooks, err := colls.Webhooks.List(ctx, ev.BucketKey)
+	if err != nil {
+		return err
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+	ev.ID = primitive.NewObjectID().Hex()
+	if ev.CreatedAt.IsZero() {
+		ev.CreatedAt = time.Now()
+	}
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	for _, h := range hooks {
+		if !h.Matches(ev.Type) {
+			continue
+		}
+		if err := colls.WebhookDeliveries.Enqueue(ctx, h, ev.Type, payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Sign returns the signature header value of payload.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify returns whether or not sig is a valid signature header value of payload.
+func Verify(secret string, payload []byte, sig string) bool {
+	return hmac.Equal([]byte(Sign(secret, payload)), []byte(sig))
+}
+
+// Deliver posts d to its webhook URL.
+// Any response status other than 2xx is an error.
+// Webhook URLs are user supplied, so client should be from util.NewPublicHTTPClient,
+// which refuses non-public addresses and redirects.
+func Deliver(ctx context.Context, client *http.Client, d mdb.WebhookDelivery) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.URL, bytes.NewReader(d.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, d.Event)
+	req.Header.Set(DeliveryHeader, d.ID)
+	req.Header.Set(SignatureHeader, Sign(d.Secret, d.Payload))
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(res.Body, 1<<16))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+	return nil
+}
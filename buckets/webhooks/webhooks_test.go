@@ -0,0 +1,86 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	mdb "github.com/textileio/textile/mongodb"
+	"github.com/textileio/textile/util"
+)
+
+func TestDeliver(t *testing.T) {
+	var (
+		body  []byte
+		sig   string
+		event string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = ioutil.ReadAll(r.Body)
+		sig = r.Header.Get(SignatureHeader)
+		event = r.Header.Get(EventHeader)
+	}))
+	defer srv.Close()
+
+	d := mdb.WebhookDelivery{
+		ID:      "delivery",
+		URL:     srv.URL,
+		Secret:  "secret",
+		Event:   PathPushed,
+		Payload: []byte(`{"type":"path.pushed"}`),
+	}
+	err := Deliver(context.Background(), srv.Client(), d)
+	require.NoError(t, err)
+	assert.Equal(t, d.Payload, body)
+	assert.Equal(t, PathPushed, event)
+	assert.True(t, Verify("secret", body, sig))
+	assert.False(t, Verify("other", body, sig))
+}
+
+func TestDeliver_Status(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer srv.Close()
+
+	err := Deliver(context.Background(), srv.Client(), mdb.WebhookDelivery{URL: srv.URL})
+	require.Error(t, err)
+}
+
+func TestDeliver_NonPublic(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer srv.Close()
+
+	client := util.NewPublicHTTPClient(time.Second, false)
+	for _, u := range []string{srv.URL, "http://169.254.169.254/latest/meta-data/", "http://[::1]:1/"} {
+		err := Deliver(context.Background(), client, mdb.WebhookDelivery{URL: u})
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, util.ErrNonPublicAddress), err)
+	}
+	assert.Zero(t, hits)
+}
+
+func TestDeliver_Redirect(t *testing.T) {
+	var redirected bool
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	}))
+	defer target.Close()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer srv.Close()
+
+	err := Deliver(context.Background(), util.NewPublicHTTPClient(time.Second, true), mdb.WebhookDelivery{URL: srv.URL})
+	require.Error(t, err)
+	assert.False(t, redirected)
+}
@@ -0,0 +1,117 @@
+package buckets
+
+import (
+	"fmt"
+	"net/textproto"
+	"strings"
+)
+
+const (
+	// DefaultIndexDocument is the file served for directories if a website has no index document.
+	DefaultIndexDocument = "index.html"
+
+	// MaxWebsiteHeaders is the max number of custom headers in a website config.
+	MaxWebsiteHeaders = 100
+)
+
+// reservedHeaders can't be set by website configs because the gateway manages them.
+var reservedHeaders = map[string]struct{}{
+	"Connection":        {},
+	"Content-Length":    {},
+	"Content-Type":      {},
+	"Keep-Alive":        {},
+	"Location":          {},
+	"Set-Cookie":        {},
+	"Trailer":           {},
+	"Transfer-Encoding": {},
+	"Upgrade":           {},
+}
+
+// Website configures how the gateway renders a bucket as a static website.
+type Website struct {
+	// IndexDocument is the file served for directories, e.g., "index.html".
+	IndexDocument string `json:"index_document,omitempty"`
+	// ErrorDocument is the bucket path of the file served with status 404 for missing paths.
+	ErrorDocument string `json:"error_document,omitempty"`
+	// Redirects are applied after the rules in the bucket's redirects file.
+	Redirects []Redirect `json:"redirects,omitempty"`
+	// Headers are added to responses for matching paths.
+	Headers []Header `json:"headers,omitempty"`
+}
+
+// Header is a custom response header for paths matching a glob, e.g., "assets/**".
+type Header struct {
+	Path  string `json:"path"`
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Index returns the index document, or DefaultIndexDocument if it's not set.
+func (w *Website) Index() string {
+	if w == nil || w.IndexDocument == "" {
+		return DefaultIndexDocument
+	}
+	return w.IndexDocument
+}
+
+// Validate returns an error if the website config is not valid.
+// Header names are canonicalized.
+func (w *Website) Validate() error {
+	if strings.Contains(w.IndexDocument, "/") {
+		return fmt.Errorf("index document must be a file name")
+	}
+	if w.ErrorDocument != "" && strings.Trim(w.ErrorDocument, "/") == "" {
+		return fmt.Errorf("error document must be a file path")
+	}
+	if len(w.Redirects) > MaxRedirects {
+		return fmt.Errorf("website exceeds max of %d redirects", MaxRedirects)
+	}
+	for i, r := range w.Redirects {
+		if err := ValidRedirect(r); err != nil {
+			return fmt.Errorf("redirect %d: %v", i+1, err)
+		}
+	}
+	if len(w.Headers) > MaxWebsiteHeaders {
+		return fmt.Errorf("website exceeds max of %d headers", MaxWebsiteHeaders)
+	}
+	for i, h := range w.Headers {
+		if err := ValidGlob(h.Path); err != nil {
+			return fmt.Errorf("header %d: invalid path: %v", i+1, err)
+		}
+		if !validHeaderName(h.Name) {
+			return fmt.Errorf("header %d: invalid name %q", i+1, h.Name)
+		}
+		w.Headers[i].Name = textproto.CanonicalMIMEHeaderKey(h.Name)
+		if _, ok := reservedHeaders[w.Headers[i].Name]; ok {
+			return fmt.Errorf("header %d: %s can't be set", i+1, w.Headers[i].Name)
+		}
+		if strings.ContainsAny(h.Value, "\r\n") {
+			return fmt.Errorf("header %d: value must be a single line", i+1)
+		}
+	}
+	return nil
+}
+
+// MatchHeaders returns the headers that apply to pth in order.
+func MatchHeaders(headers []Header, pth string) []Header {
+	var matched []Header
+	for _, h := range headers {
+		if MatchGlob(strings.Trim(h.Path, "/"), pth) {
+			matched = append(matched, h)
+		}
+	}
+	return matched
+}
+
+// validHeaderName returns whether or not name is a valid HTTP header field name.
+func validHeaderName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for _, c := range name {
+		if c > '~' || c <= ' ' || strings.ContainsRune("\"(),/:;<=>?@[\\]{}", c) {
+			return false
+		}
+	}
+	return true
+}
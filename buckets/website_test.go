@@ -0,0 +1,52 @@
+package buckets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebsite_Validate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		w := &Website{
+			IndexDocument: "home.html",
+			ErrorDocument: "errors/404.html",
+			Redirects:     []Redirect{{From: "/app/*", To: "/index.html", Status: 200}},
+			Headers: []Header{
+				{Path: "assets/**", Name: "cache-control", Value: "max-age=31536000"},
+			},
+		}
+		require.NoError(t, w.Validate())
+		assert.Equal(t, "Cache-Control", w.Headers[0].Name)
+		assert.Equal(t, "home.html", w.Index())
+		assert.Equal(t, DefaultIndexDocument, (&Website{}).Index())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, w := range []Website{
+			{IndexDocument: "dir/index.html"},
+			{ErrorDocument: "/"},
+			{Redirects: []Redirect{{From: "/a", To: "/b", Status: 500}}},
+			{Headers: []Header{{Path: "a/**b", Name: "X-A"}}},
+			{Headers: []Header{{Path: "**", Name: "Bad Name"}}},
+			{Headers: []Header{{Path: "**", Name: "content-length", Value: "1"}}},
+			{Headers: []Header{{Path: "**", Name: "X-A", Value: "a\r\nX-B: b"}}},
+		} {
+			assert.Error(t, w.Validate(), w)
+		}
+	})
+}
+
+func TestMatchHeaders(t *testing.T) {
+	t.Parallel()
+
+	headers := []Header{
+		{Path: "/**", Name: "X-Frame-Options", Value: "DENY"},
+		{Path: "assets/*.js", Name: "Cache-Control", Value: "max-age=60"},
+	}
+	assert.Equal(t, headers, MatchHeaders(headers, "/assets/app.js"))
+	assert.Equal(t, headers[:1], MatchHeaders(headers, "/index.html"))
+}
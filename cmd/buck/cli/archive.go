@@ -2,9 +2,14 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
+	"github.com/textileio/textile/api/buckets/client"
 	"github.com/textileio/textile/buckets/local"
 	"github.com/textileio/textile/cmd"
 )
@@ -12,8 +17,12 @@ import (
 var archiveCmd = &cobra.Command{
 	Use:   "archive",
 	Short: "Create a Filecoin archive",
-	Long:  `Creates a Filecoin archive from the remote bucket root.`,
+	Long: `Creates a Filecoin archive from the remote bucket root.
+
+Deal flags override the default storage config of the bucket for this archive.`,
 	Run: func(c *cobra.Command, args []string) {
+		opts, err := archiveOptions(c)
+		cmd.ErrCheck(err)
 		cmd.Warn("Archives are currently saved on an experimental test network. They may be lost at any time.")
 		prompt := promptui.Prompt{
 			Label:     "Proceed",
@@ -26,12 +35,60 @@ var archiveCmd = &cobra.Command{
 		defer cancel()
 		buck, err := bucks.GetLocalBucket(ctx, ".")
 		cmd.ErrCheck(err)
-		err = buck.ArchiveRemote(ctx)
+		err = buck.ArchiveRemote(ctx, opts...)
 		cmd.ErrCheck(err)
 		cmd.Success("Archive queued successfully")
 	},
 }
 
+// archiveOptions returns the archive deal options from the flags of c.
+func archiveOptions(c *cobra.Command) (opts []client.ArchiveOption, err error) {
+	flags := c.Flags()
+	if flags.Changed("trusted-miners") {
+		miners, err := flags.GetStringSlice("trusted-miners")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, client.WithTrustedMiners(miners...))
+	}
+	if flags.Changed("excluded-miners") {
+		miners, err := flags.GetStringSlice("excluded-miners")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, client.WithExcludedMiners(miners...))
+	}
+	if flags.Changed("country-codes") {
+		codes, err := flags.GetStringSlice("country-codes")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, client.WithCountryCodes(codes...))
+	}
+	if flags.Changed("max-price") {
+		price, err := flags.GetUint64("max-price")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, client.WithMaxPrice(price))
+	}
+	if flags.Changed("rep-factor") {
+		n, err := flags.GetInt32("rep-factor")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, client.WithRepFactor(n))
+	}
+	if flags.Changed("deal-duration") {
+		epochs, err := flags.GetInt64("deal-duration")
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, client.WithDealDuration(epochs))
+	}
+	return opts, nil
+}
+
 var archiveStatusCmd = &cobra.Command{
 	Use:   "status",
 	Short: "Show status of the latest archive",
@@ -46,6 +103,10 @@ var archiveStatusCmd = &cobra.Command{
 		msgs, err := buck.ArchiveStatus(ctx, watch)
 		cmd.ErrCheck(err)
 		for m := range msgs {
+			if cmd.JSONOutput() && m.Type != local.ArchiveError {
+				cmd.JSON(archiveStatusMessage{Type: archiveMessageTypes[m.Type], Message: strings.TrimSpace(m.Message)})
+				continue
+			}
 			switch m.Type {
 			case local.ArchiveMessage:
 				cmd.Message(m.Message)
@@ -60,6 +121,18 @@ var archiveStatusCmd = &cobra.Command{
 	},
 }
 
+// archiveStatusMessage is the JSON output of an archive status message.
+type archiveStatusMessage struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+var archiveMessageTypes = map[local.ArchiveMessageType]string{
+	local.ArchiveMessage: "message",
+	local.ArchiveWarning: "warning",
+	local.ArchiveSuccess: "success",
+}
+
 var archiveInfoCmd = &cobra.Command{
 	Use:   "info",
 	Short: "Show info about the current archive",
@@ -71,11 +144,206 @@ var archiveInfoCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		info, err := buck.ArchiveInfo(ctx)
 		cmd.ErrCheck(err)
-		cmd.Message("Archive of cid %s has %d deals:\n", info.Archive.Cid, len(info.Archive.Deals))
+		if cmd.JSONOutput() {
+			cmd.JSON(info)
+			return
+		}
+		if info.Archive.Cid.Defined() {
+			cmd.Message("Archive of cid %s has %d deals:\n", info.Archive.Cid, len(info.Archive.Deals))
+			var data [][]string
+			for _, d := range info.Archive.Deals {
+				data = append(data, []string{d.ProposalCid.String(), d.Miner})
+			}
+			cmd.RenderTable([]string{"proposal cid", "miner"}, data)
+		}
+		if info.Schedule != nil {
+			renderArchiveSchedule(info.Schedule)
+		}
+		if info.Renewal != nil {
+			renderArchiveRenewal(info.Renewal)
+		}
+	},
+}
+
+var archiveLsCmd = &cobra.Command{
+	Use: "ls",
+	Aliases: []string{
+		"list",
+	},
+	Short: "List all archives",
+	Long:  `Lists all archives of the remote bucket, newest first, with the state of their deals.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		list, err := buck.ArchiveList(ctx)
+		cmd.ErrCheck(err)
+		if len(list) == 0 {
+			cmd.End("No archives")
+		}
 		var data [][]string
-		for _, d := range info.Archive.Deals {
-			data = append(data, []string{d.ProposalCid.String(), d.Miner})
+		for _, a := range list {
+			status := a.Status
+			if a.Current {
+				status += " (current)"
+			}
+			if len(a.Deals) == 0 {
+				data = append(data, []string{a.Cid.String(), a.CreatedAt.Format(time.RFC3339), status, "", "", "", ""})
+			}
+			for _, d := range a.Deals {
+				var expiry string
+				if d.ExpiryEpoch > 0 {
+					expiry = strconv.FormatUint(d.ExpiryEpoch, 10)
+				}
+				data = append(data, []string{
+					a.Cid.String(),
+					a.CreatedAt.Format(time.RFC3339),
+					status,
+					d.Miner,
+					strconv.FormatUint(d.DealID, 10),
+					d.State,
+					expiry,
+				})
+			}
+		}
+		cmd.RenderTable([]string{"cid", "created", "status", "miner", "deal id", "deal state", "expiry epoch"}, data)
+	},
+}
+
+var archiveRestoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "Restore the remote bucket from its archive",
+	Long: `Retrieves the current Filecoin archive and restores the remote bucket root from it.
+
+Use this when the bucket data is no longer available on IPFS. Use --new to restore into a new bucket instead.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		var opts []client.RestoreArchiveOption
+		if c.Flags().Changed("new") {
+			name, err := c.Flags().GetString("new")
+			cmd.ErrCheck(err)
+			opts = append(opts, client.WithRestoreNewBucket(name))
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.ArchiveWatchTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		msgs := make(chan string)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			for m := range msgs {
+				cmd.Message("%s", m)
+			}
+		}()
+		root, err := buck.RestoreArchiveRemote(ctx, msgs, opts...)
+		close(msgs)
+		<-done
+		cmd.ErrCheck(err)
+		if len(opts) > 0 {
+			cmd.Success("Restored archive to new bucket %s", root.Key)
+		} else {
+			cmd.Success("Restored remote bucket root to %s, use 'buck pull' to update the local bucket", root.Path)
 		}
-		cmd.RenderTable([]string{"proposal cid", "miner"}, data)
 	},
 }
+
+var archiveScheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Schedule recurring archives",
+	Long: `Schedules recurring Filecoin archives of the remote bucket root.
+
+An archive is created when the interval has passed or the bucket root changed the given number of times, whichever comes first.
+Setting neither flag removes the schedule.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		interval, err := c.Flags().GetDuration("interval")
+		cmd.ErrCheck(err)
+		changes, err := c.Flags().GetInt64("changes")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		sched, err := buck.SetArchiveSchedule(ctx, interval, changes)
+		cmd.ErrCheck(err)
+		if sched == nil {
+			cmd.Success("Removed archive schedule")
+			return
+		}
+		renderArchiveSchedule(sched)
+	},
+}
+
+var archiveRenewCmd = &cobra.Command{
+	Use:   "renew",
+	Short: "Renew archive deals automatically",
+	Long: `Renews the Filecoin deals of the remote bucket's archives before they expire.
+
+Deals are renewed when they are within the threshold number of epochs of expiring.
+The bucket owner is notified by email and webhook when deals are renewed or the archive wallet has no funds for renewal.
+A zero threshold removes the renewal policy.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		threshold, err := c.Flags().GetInt64("threshold")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		rn, err := buck.SetArchiveRenewal(ctx, threshold)
+		cmd.ErrCheck(err)
+		if rn == nil {
+			cmd.Success("Removed archive renewal policy")
+			return
+		}
+		renderArchiveRenewal(rn)
+	},
+}
+
+func renderArchiveRenewal(rn *local.ArchiveRenewal) {
+	cmd.Message("Archive deals are renewed %d epochs before they expire", rn.Threshold)
+	if rn.ExpiryEpoch > 0 {
+		cmd.Message("Current deals expire at epoch %d", rn.ExpiryEpoch)
+	}
+	if rn.Unfunded {
+		cmd.Warn("The archive wallet has no funds to renew deals")
+	}
+	if rn.LastError != "" {
+		cmd.Warn("Last renewal check failed: %s", rn.LastError)
+	}
+}
+
+func renderArchiveSchedule(sched *local.ArchiveSchedule) {
+	var policy []string
+	if sched.Interval > 0 {
+		policy = append(policy, fmt.Sprintf("every %s", sched.Interval))
+	}
+	if sched.EveryChanges > 0 {
+		policy = append(policy, fmt.Sprintf("every %d root changes (%d so far)", sched.EveryChanges, sched.Changes))
+	}
+	cmd.Message("Archives are scheduled %s", strings.Join(policy, " or "))
+	if !sched.NextRunAt.IsZero() {
+		cmd.Message("Next archive runs at %s", sched.NextRunAt.Format(time.RFC3339))
+	}
+	if len(sched.History) == 0 {
+		return
+	}
+	var data [][]string
+	for _, r := range sched.History {
+		result := "queued job " + r.JobID
+		if r.Skipped {
+			result = "skipped, already archived"
+		} else if r.Error != "" {
+			result = "failed: " + r.Error
+		}
+		var c string
+		if r.Cid.Defined() {
+			c = r.Cid.String()
+		}
+		data = append(data, []string{r.RanAt.Format(time.RFC3339), c, result})
+	}
+	cmd.RenderTable([]string{"ran at", "cid", "result"}, data)
+}
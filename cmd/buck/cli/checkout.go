@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"context"
+	"errors"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/buckets/local"
+	"github.com/textileio/textile/cmd"
+	"github.com/textileio/uiprogress"
+)
+
+var checkoutCmd = &cobra.Command{
+	Use:   "checkout [ref]",
+	Short: "Pull the bucket at a previous root",
+	Long: `Pulls the bucket as it was at ref, which is a remote root CID, a snapshot name, or a version ID.
+
+Only files that differ from the root are downloaded.
+The local bucket is left detached at the root, so pushes are rejected.
+Use "buck pull" to return to the latest remote root.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		concurrency, err := c.Flags().GetInt("concurrency")
+		cmd.ErrCheck(err)
+		fileInfo, err := c.Flags().GetBool("file-info")
+		cmd.ErrCheck(err)
+		cache, err := getCache(c)
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		events := make(chan local.PathEvent)
+		defer close(events)
+		progress := uiprogress.New()
+		progress.Start()
+		go handleProgressBars(progress, events)
+		root, err := buck.Checkout(
+			ctx,
+			args[0],
+			local.WithConcurrency(concurrency),
+			local.WithCache(cache),
+			local.WithFileInfo(fileInfo),
+			local.WithPathEvents(events))
+		progress.Stop()
+		if errors.Is(err, local.ErrCheckoutChanges) {
+			cmd.Fatal(errors.New("push or discard local changes before checking out a root"))
+		}
+		cmd.ErrCheck(err)
+		if cmd.JSONOutput() {
+			cmd.JSON(map[string]string{"root": root.String()})
+			return
+		}
+		cmd.Success("Checked out %s", aurora.White(root.Cid()).Bold())
+	},
+}
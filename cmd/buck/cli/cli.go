@@ -4,6 +4,7 @@ import (
 	"context"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/logrusorgru/aurora"
 	"github.com/manifoldco/promptui"
@@ -15,6 +16,12 @@ import (
 
 const Name = "buck"
 
+// lsPageSize is the default number of objects listed per request.
+const lsPageSize = 1000
+
+// watchDebounce is the default amount of time local changes must settle before they are pushed.
+const watchDebounce = time.Millisecond * 500
+
 var bucks *local.Buckets
 
 func init() {
@@ -23,35 +30,120 @@ func init() {
 }
 
 func Init(baseCmd *cobra.Command) {
-	baseCmd.AddCommand(initCmd, linksCmd, rootCmd, statusCmd, lsCmd, pushCmd, pullCmd, addCmd, watchCmd, catCmd, destroyCmd, encryptCmd, decryptCmd, archiveCmd)
-	archiveCmd.AddCommand(archiveStatusCmd, archiveInfoCmd)
+	baseCmd.AddCommand(initCmd, linksCmd, rootCmd, statusCmd, diffCmd, renameCmd, lsCmd, pushCmd, pullCmd, addCmd, watchCmd, catCmd, exportCmd, importCmd, destroyCmd, encryptCmd, decryptCmd, archiveCmd, holdCmd, quotaCmd, mirrorCmd, ipnsCmd, domainCmd, websiteCmd, conflictsCmd, tagsCmd, sparseCmd, stageCmd, resetCmd, shareCmd, serveCmd, verifyCmd, checkoutCmd, subscribeCmd, doctorCmd)
+	archiveCmd.AddCommand(archiveStatusCmd, archiveInfoCmd, archiveLsCmd, archiveScheduleCmd, archiveRenewCmd, archiveRestoreCmd)
+	holdCmd.AddCommand(holdReleaseCmd, holdStatusCmd)
+	quotaCmd.AddCommand(quotaSetCmd)
+	importCmd.AddCommand(importS3Cmd, importLsCmd, importCancelCmd)
+	mirrorCmd.AddCommand(mirrorAddCmd, mirrorLsCmd, mirrorRmCmd)
+	ipnsCmd.AddCommand(ipnsImportCmd, ipnsGenerateCmd)
+	domainCmd.AddCommand(domainAddCmd, domainLsCmd, domainVerifyCmd, domainRmCmd)
+	websiteCmd.AddCommand(websiteSetCmd, websiteClearCmd)
+	conflictsCmd.AddCommand(conflictsSetCmd)
+	tagsCmd.AddCommand(tagsSetCmd, tagsLsCmd)
+	sparseCmd.AddCommand(sparseSetCmd)
+	shareCmd.AddCommand(shareLsCmd, shareRevokeCmd)
 
 	initCmd.PersistentFlags().String("key", "", "Bucket key")
 	initCmd.PersistentFlags().String("thread", "", "Thread ID")
 
 	initCmd.Flags().StringP("name", "n", "", "Bucket name")
 	initCmd.Flags().BoolP("private", "p", false, "Obfuscates files and folders with encryption")
+	initCmd.Flags().Bool("passphrase", false, "Derives the encryption key from a passphrase (implies --private)")
 	initCmd.Flags().String("cid", "", "Bootstrap the bucket with a UnixFS Cid from the IPFS network")
+	initCmd.Flags().String("template", "", "Starts the bucket with the contents of a public bucket, Cid, CAR file or tarball")
+	initCmd.Flags().String("cache-dir", os.Getenv("BUCK_CACHE_DIR"), "Copies pulled files from a shared cache in this directory when possible (env BUCK_CACHE_DIR)")
 	initCmd.Flags().BoolP("existing", "e", false, "Initializes from an existing remote bucket if true")
 
 	pushCmd.Flags().BoolP("force", "f", false, "Allows non-fast-forward updates if true")
 	pushCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
+	pushCmd.Flags().StringSlice("include", nil, "Only pushes paths that match these patterns (uses .buckignore syntax)")
+	pushCmd.Flags().StringSlice("exclude", nil, "Skips paths that match these patterns (uses .buckignore syntax)")
+	pushCmd.Flags().Bool("dry-run", false, "Shows the changes that would be pushed, including remote deletions, without pushing them")
 	pushCmd.Flags().Int64("maxsize", buckMaxSizeMiB, "Max bucket size in MiB")
+	pushCmd.Flags().Bool("deterministic", false, "Pushes files with fixed UnixFS parameters so their CIDs are reproducible")
+	pushCmd.Flags().Bool("file-info", false, "Pushes file mtimes and permission bits as metadata attributes if true")
+	pushCmd.Flags().Int("concurrency", 1, "Max number of files pushed at the same time")
+	pushCmd.Flags().String("rate-limit", "", "Max upload rate per second, e.g., 500KiB or 2MB (no limit by default)")
+	pushCmd.Flags().Int("retries", 0, "Max number of times a file transfer is retried after a transient error")
+
+	watchCmd.Flags().Duration("debounce", watchDebounce, "Time local changes must settle before they are pushed")
+
+	subscribeCmd.Flags().Duration("debounce", watchDebounce, "Time remote changes must settle before they are pulled")
+	subscribeCmd.Flags().Bool("notify-only", false, "Reports remote changes without pulling them if true")
+	subscribeCmd.Flags().String("exec", "", "Shell command to run after each remote change")
+	subscribeCmd.Flags().String("cache-dir", os.Getenv("BUCK_CACHE_DIR"), "Copies pulled files from a shared cache in this directory when possible (env BUCK_CACHE_DIR)")
+
+	lsCmd.Flags().Int64("page-size", lsPageSize, "Max number of objects listed per request, 0 lists all at once")
 
 	pullCmd.Flags().BoolP("force", "f", false, "Force pull all remote files if true")
 	pullCmd.Flags().Bool("hard", false, "Pulls and prunes local changes if true")
 	pullCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
+	pullCmd.Flags().StringSlice("include", nil, "Only pulls paths that match these patterns (uses .buckignore syntax)")
+	pullCmd.Flags().StringSlice("exclude", nil, "Skips paths that match these patterns (uses .buckignore syntax)")
+	pullCmd.Flags().Bool("dry-run", false, "Shows the changes that would be made to local files without pulling them")
+	pullCmd.Flags().Int("concurrency", 0, "Max number of files pulled at the same time (no limit by default)")
+	pullCmd.Flags().String("rate-limit", "", "Max download rate per second, e.g., 500KiB or 2MB (no limit by default)")
+	pullCmd.Flags().Int("retries", 0, "Max number of times a file transfer is retried after a transient error")
+	pullCmd.Flags().Bool("file-info", false, "Restores pushed file mtimes and permission bits if true")
+	pullCmd.Flags().String("cache-dir", os.Getenv("BUCK_CACHE_DIR"), "Copies pulled files from a shared cache in this directory when possible (env BUCK_CACHE_DIR)")
+	pullCmd.Flags().String("strategy", "", "How to handle files changed locally and remotely: ours, theirs, both or prompt")
+
+	doctorCmd.Flags().Bool("repair", false, "Fixes problems by re-syncing local state from the remote if true")
+
+	checkoutCmd.Flags().Int("concurrency", 0, "Max number of files pulled at the same time (no limit by default)")
+	checkoutCmd.Flags().Bool("file-info", false, "Restores pushed file mtimes and permission bits if true")
+	checkoutCmd.Flags().String("cache-dir", os.Getenv("BUCK_CACHE_DIR"), "Copies pulled files from a shared cache in this directory when possible (env BUCK_CACHE_DIR)")
+
+	importCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
+
+	destroyCmd.Flags().Bool("dry-run", false, "Shows the bucket that would be destroyed without destroying it")
+	importS3Cmd.Flags().String("path", "", "Bucket path to import objects to")
+	importS3Cmd.Flags().String("endpoint", "", "Base URL of an S3 compatible service (defaults to AWS)")
+	importS3Cmd.Flags().String("region", "", "Region of the S3 bucket")
+	importS3Cmd.Flags().String("prefix", "", "Only import objects with this key prefix")
+	importS3Cmd.Flags().String("access-key", "", "S3 access key ID")
+	importS3Cmd.Flags().String("secret-key", "", "S3 secret access key")
+
+	tagsLsCmd.Flags().String("thread", "", "Only list buckets in this thread")
+
+	mirrorAddCmd.Flags().String("token", "", "Pinning service access token")
+
+	domainAddCmd.Flags().String("provider", "cloudflare", "DNS provider hosting the domain's zone (cloudflare or route53)")
+	domainAddCmd.Flags().String("zone", "", "Provider's ID of the zone containing the domain")
+	domainAddCmd.Flags().String("token", "", "Cloudflare API token")
+	domainAddCmd.Flags().String("access-key", "", "AWS access key ID")
+	domainAddCmd.Flags().String("secret-key", "", "AWS secret access key")
+
+	shareCmd.Flags().Duration("expires", shareExpires, "Amount of time the link is valid")
+	shareCmd.Flags().StringP("password", "p", "", "Password required to view the link")
+
+	serveCmd.Flags().String("addr", serveAddr, "Address the preview server listens on")
+	serveCmd.Flags().String("config", "", "JSON website config file used instead of the remote config")
 
 	addCmd.Flags().BoolP("yes", "y", false, "Skips confirmations prompts to always overwrite files and merge folders")
 
 	encryptCmd.Flags().StringP("password", "p", "", "Encryption password")
 	decryptCmd.Flags().StringP("password", "p", "", "Decryption password")
 
+	archiveCmd.Flags().StringSlice("trusted-miners", nil, "Only make deals with these miners")
+	archiveCmd.Flags().StringSlice("excluded-miners", nil, "Never make deals with these miners")
+	archiveCmd.Flags().StringSlice("country-codes", nil, "Only make deals with miners in these countries")
+	archiveCmd.Flags().Uint64("max-price", 0, "Max deal price in attoFIL per GiB per epoch")
+	archiveCmd.Flags().Int32("rep-factor", 0, "Number of miners that store the archive")
+	archiveCmd.Flags().Int64("deal-duration", 0, "Min deal duration in epochs")
 	archiveStatusCmd.Flags().BoolP("watch", "w", false, "Watch execution log")
+	archiveRestoreCmd.Flags().String("new", "", "Restore into a new bucket with this name instead of replacing the remote root")
+	archiveScheduleCmd.Flags().Duration("interval", 0, "Time between archives, e.g., 168h (min 1h)")
+	archiveScheduleCmd.Flags().Int64("changes", 0, "Number of root changes between archives")
+	archiveRenewCmd.Flags().Int64("threshold", 0, "Number of epochs before expiry to renew deals")
 }
 
 func SetBucks(b *local.Buckets) {
 	bucks = b
+	if cmd.JSONOutput() { // Keep stdout free for results
+		uiprogress.Out = os.Stderr
+	}
 }
 
 var statusCmd = &cobra.Command{
@@ -60,8 +152,9 @@ var statusCmd = &cobra.Command{
 		"st",
 	},
 	Short: "Show bucket object changes",
-	Long:  `Displays paths that have been added to and paths that have been removed or differ from the local bucket root.`,
-	Args:  cobra.ExactArgs(0),
+	Long: `Displays paths that have been added to and paths that have been removed or differ from the local bucket root.
+If paths are staged, changes staged for the next push are listed separately.`,
+	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
 		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
 		defer cancel()
@@ -69,16 +162,55 @@ var statusCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		diff, err := buck.DiffLocal()
 		cmd.ErrCheck(err)
+		if cmd.JSONOutput() {
+			changes := make([]statusChange, len(diff))
+			for i, c := range diff {
+				changes[i] = statusChange{Type: local.ChangeType(c.Type), Path: c.Path, Staged: c.Staged}
+			}
+			cmd.JSON(changes)
+			return
+		}
+		if root, ok := buck.Detached(); ok {
+			cmd.Message("Detached at %s", aurora.White(root.Cid()).Bold())
+		}
 		if len(diff) == 0 {
 			cmd.End("Everything up-to-date")
 		}
+		var staged, unstaged []local.Change
 		for _, c := range diff {
-			cf := local.ChangeColor(c.Type)
-			cmd.Message("%s  %s", cf(local.ChangeType(c.Type)), cf(c.Rel))
+			if c.Staged {
+				staged = append(staged, c)
+			} else {
+				unstaged = append(unstaged, c)
+			}
+		}
+		if len(staged) == 0 {
+			printChanges(diff)
+			return
+		}
+		cmd.Message("Changes staged for push:")
+		printChanges(staged)
+		if len(unstaged) > 0 {
+			cmd.Message("Changes not staged for push:")
+			printChanges(unstaged)
 		}
 	},
 }
 
+// statusChange is the JSON output of a local change.
+type statusChange struct {
+	Type   string `json:"type"`
+	Path   string `json:"path"`
+	Staged bool   `json:"staged"`
+}
+
+func printChanges(diff []local.Change) {
+	for _, c := range diff {
+		cf := local.ChangeColor(c.Type)
+		cmd.Message("%s  %s", cf(local.ChangeType(c.Type)), cf(c.Rel))
+	}
+}
+
 var rootCmd = &cobra.Command{
 	Use:   "root",
 	Short: "Show bucket root CIDs",
@@ -91,11 +223,31 @@ var rootCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		r, err := buck.Roots(ctx)
 		cmd.ErrCheck(err)
+		if cmd.JSONOutput() {
+			cmd.JSON(r)
+			return
+		}
 		cmd.Message("%s (local)", aurora.White(r.Local).Bold())
 		cmd.Message("%s (remote)", aurora.White(r.Remote).Bold())
 	},
 }
 
+var renameCmd = &cobra.Command{
+	Use:   "rename [name]",
+	Short: "Rename the bucket",
+	Long:  `Sets the name of the remote bucket. Bucket links do not change because they are derived from the bucket key.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		err = buck.Rename(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Renamed bucket to %s", aurora.White(args[0]).Bold())
+	},
+}
+
 var linksCmd = &cobra.Command{
 	Use: "links",
 	Aliases: []string{
@@ -116,6 +268,10 @@ var linksCmd = &cobra.Command{
 }
 
 func printLinks(reply local.Links) {
+	if cmd.JSONOutput() {
+		cmd.JSON(reply)
+		return
+	}
 	cmd.Message("Your bucket links:")
 	cmd.Message("%s Thread link", aurora.White(reply.URL).Bold())
 	cmd.Message("%s IPNS link (propagation can be slow)", aurora.White(reply.IPNS).Bold())
@@ -141,10 +297,16 @@ var lsCmd = &cobra.Command{
 		if len(args) > 0 {
 			pth = args[0]
 		}
-		items, err := buck.ListRemotePath(ctx, pth)
+		pageSize, err := c.Flags().GetInt64("page-size")
 		cmd.ErrCheck(err)
-		var data [][]string
-		if len(items) > 0 {
+		var count int
+		all := []local.BucketItem{}
+		err = buck.ListRemotePathPages(ctx, pth, pageSize, func(items []local.BucketItem) error {
+			if cmd.JSONOutput() { // Pages are printed together as a single list
+				all = append(all, items...)
+				return nil
+			}
+			var data [][]string
 			for _, item := range items {
 				var links string
 				if item.IsDir {
@@ -160,11 +322,18 @@ var lsCmd = &cobra.Command{
 					item.Cid.String(),
 				})
 			}
+			if len(data) > 0 {
+				cmd.RenderTable([]string{"name", "size", "dir", "objects", "cid"}, data)
+			}
+			count += len(data)
+			return nil
+		})
+		cmd.ErrCheck(err)
+		if cmd.JSONOutput() {
+			cmd.JSON(all)
+			return
 		}
-		if len(data) > 0 {
-			cmd.RenderTable([]string{"name", "size", "dir", "objects", "cid"}, data)
-		}
-		cmd.Message("Found %d objects", aurora.White(len(data)).Bold())
+		cmd.Message("Found %d objects", aurora.White(count).Bold())
 	},
 }
 
@@ -183,6 +352,71 @@ var catCmd = &cobra.Command{
 	},
 }
 
+var exportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Export the bucket to a CAR file",
+	Long: `Exports the remote bucket root DAG to a CAR (content addressable archive) file.
+
+The CAR file can be imported into any IPFS node, e.g., with 'ipfs dag import'. Private buckets are exported encrypted.
+Writes to stdout if no file is given.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		if len(args) == 0 {
+			err = buck.ExportRemote(ctx, os.Stdout)
+			cmd.ErrCheck(err)
+			return
+		}
+		file, err := os.Create(args[0])
+		cmd.ErrCheck(err)
+		err = buck.ExportRemote(ctx, file)
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			_ = os.Remove(args[0])
+			cmd.Fatal(err)
+		}
+		cmd.Success("Exported bucket to %s", aurora.White(args[0]).Bold())
+	},
+}
+
+var importCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import a CAR file into the bucket",
+	Long: `Replaces the remote bucket root with the root of a CAR (content addressable archive) file.
+
+The CAR root must be a directory of this bucket, e.g., from 'buck export'.
+The local bucket is not changed, use 'buck pull' to update it.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		yes, err := c.Flags().GetBool("yes")
+		cmd.ErrCheck(err)
+		if !yes {
+			prompt := promptui.Prompt{
+				Label:     "Replace the remote bucket root",
+				IsConfirm: true,
+			}
+			if _, err := prompt.Run(); err != nil {
+				cmd.End("")
+			}
+		}
+		file, err := os.Open(args[0])
+		cmd.ErrCheck(err)
+		defer file.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PushTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		root, err := buck.ImportRemote(ctx, file)
+		cmd.ErrCheck(err)
+		cmd.Success("Imported remote bucket root %s, use 'buck pull' to update the local bucket", aurora.White(root.Path).Bold())
+	},
+}
+
 var encryptCmd = &cobra.Command{
 	Use:   "encrypt [file] [password]",
 	Short: "Encrypt file with a password",
@@ -216,13 +450,34 @@ var decryptCmd = &cobra.Command{
 var destroyCmd = &cobra.Command{
 	Use:   "destroy",
 	Short: "Destroy bucket and all objects",
-	Long:  `Destroys the bucket and all objects.`,
-	Args:  cobra.ExactArgs(0),
+	Long: `Destroys the bucket and all objects.
+
+Use --dry-run to show the bucket that would be destroyed without destroying it.`,
+	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
+		dryRun, err := c.Flags().GetBool("dry-run")
+		cmd.ErrCheck(err)
 		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
 		defer cancel()
 		buck, err := bucks.GetLocalBucket(ctx, ".")
 		cmd.ErrCheck(err)
+		if dryRun {
+			bp, err := buck.Path()
+			cmd.ErrCheck(err)
+			r, err := buck.Roots(ctx)
+			cmd.ErrCheck(err)
+			if cmd.JSONOutput() {
+				cmd.JSON(map[string]string{
+					"key":  buck.Key(),
+					"root": r.Remote.String(),
+					"path": bp,
+				})
+				return
+			}
+			cmd.Message("Would destroy bucket %s at remote root %s", aurora.White(buck.Key()).Bold(), aurora.White(r.Remote).Bold())
+			cmd.Message("Would remove the bucket config from %s (local files are kept)", aurora.White(bp).Bold())
+			return
+		}
 		cmd.Warn("%s", aurora.Red("This action cannot be undone. The bucket and all associated data will be permanently deleted."))
 		prompt := promptui.Prompt{
 			Label:     "Are you absolutely sure",
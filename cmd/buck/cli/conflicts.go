@@ -0,0 +1,63 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/buckets"
+	"github.com/textileio/textile/cmd"
+)
+
+var conflictsCmd = &cobra.Command{
+	Use:   "conflicts",
+	Short: "Show the bucket conflict strategy",
+	Long: `Shows how the bucket handles pushes and removals that are based on a stale root.
+
+Strategies:
+
+  last_writer_wins  Stale updates are rejected, but forced updates overwrite any path (default)
+  reject            Stale updates and forced updates are rejected
+  keep_both         Stale updates are applied; changed files are kept and the update is renamed next to them
+  merge             Stale updates are applied unless a path was changed since`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		strategy, err := buck.ConflictStrategy(ctx)
+		cmd.ErrCheck(err)
+		cmd.Message("Conflict strategy: %s", aurora.White(conflictStrategyName(strategy)).Bold())
+	},
+}
+
+var conflictsSetCmd = &cobra.Command{
+	Use:   "set [strategy]",
+	Short: "Set the bucket conflict strategy",
+	Long:  `Sets how the bucket handles pushes and removals that are based on a stale root (last_writer_wins, reject, keep_both, or merge).`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		strategy := buckets.ConflictStrategy(args[0])
+		if args[0] == conflictStrategyName(buckets.ConflictLastWriterWins) {
+			strategy = buckets.ConflictLastWriterWins
+		}
+		if !strategy.Valid() {
+			cmd.Fatal(fmt.Errorf("unsupported conflict strategy %q", args[0]))
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		cmd.ErrCheck(buck.SetConflictStrategy(ctx, strategy))
+		cmd.Success("Set conflict strategy to %s", aurora.White(args[0]).Bold())
+	},
+}
+
+func conflictStrategyName(strategy buckets.ConflictStrategy) string {
+	if strategy == buckets.ConflictLastWriterWins {
+		return "last_writer_wins"
+	}
+	return string(strategy)
+}
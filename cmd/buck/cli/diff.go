@@ -0,0 +1,51 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/buckets/local"
+	"github.com/textileio/textile/cmd"
+)
+
+var diffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show differences between the local bucket and the remote",
+	Long: `Shows files that were added, modified, or deleted locally compared to the remote bucket.
+
+Unlike status, this compares against the current remote, so it also shows remote changes that have not been pulled.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		diff, err := buck.DiffRemote(ctx)
+		cmd.ErrCheck(err)
+		if cmd.JSONOutput() {
+			if diff == nil {
+				diff = []local.RemoteChange{}
+			}
+			cmd.JSON(diff)
+			return
+		}
+		if len(diff) == 0 {
+			cmd.End("Everything up-to-date")
+		}
+		data := make([][]string, len(diff))
+		for i, d := range diff {
+			var cf func(interface{}) aurora.Value
+			switch d.Type {
+			case local.RemoteAdded:
+				cf = aurora.Green
+			case local.RemoteModified:
+				cf = aurora.Yellow
+			default:
+				cf = aurora.Red
+			}
+			data[i] = []string{cf(string(d.Type)).String(), d.Path, formatBytes(d.Size, true), d.Cid.String()}
+		}
+		cmd.RenderTable([]string{"change", "path", "size", "cid"}, data)
+	},
+}
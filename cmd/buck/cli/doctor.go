@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"context"
+	"os"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check and repair the local bucket state",
+	Long: `Checks the local bucket state for problems left behind by interrupted commands or config edits.
+
+Missing config fields, a stale root pointer, and orphaned staged paths are reported.
+Use --repair to fix problems by re-syncing local state from the remote instead of re-cloning the bucket.
+A repaired root pointer only tracks local files that match the remote, so other local files show up as changes.
+Exits with a non-zero status if there are unfixed problems.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		repair, err := c.Flags().GetBool("repair")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		problems, err := buck.Doctor(ctx, repair)
+		cmd.ErrCheck(err)
+		var unfixed int
+		for _, p := range problems {
+			if !p.Fixed {
+				unfixed++
+			}
+		}
+		if cmd.JSONOutput() {
+			cmd.JSON(problems)
+		} else if len(problems) == 0 {
+			cmd.Success("No problems found")
+		} else {
+			for _, p := range problems {
+				if p.Fixed {
+					cmd.Message("%s %s", aurora.Green("fixed:  "), p.Desc)
+				} else {
+					cmd.Message("%s %s", aurora.Red("problem:"), p.Desc)
+				}
+			}
+			if unfixed > 0 && !repair {
+				cmd.Message("Run %s to fix problems", aurora.Cyan("buck doctor --repair"))
+			}
+		}
+		if unfixed > 0 {
+			os.Exit(1)
+		}
+	},
+}
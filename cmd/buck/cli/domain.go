@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/api/buckets/client"
+	"github.com/textileio/textile/cmd"
+)
+
+var domainCmd = &cobra.Command{
+	Use:   "domain",
+	Short: "Manage bucket custom domains",
+	Long: `Manages custom domains whose DNSLink records point at the bucket root.
+
+Once a domain is verified, the hub keeps its _dnslink TXT record up-to-date with the
+DNS provider API each time the bucket root changes.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		domainLsCmd.Run(c, args)
+	},
+}
+
+var domainAddCmd = &cobra.Command{
+	Use:   "add [domain]",
+	Short: "Add a custom domain",
+	Long: `Adds a custom domain to the bucket.
+
+Verify the domain by adding a TXT record with the printed name and value.
+Cloudflare zones need an API token with DNS edit permission, which defaults to the
+CLOUDFLARE_API_TOKEN environment variable. Route53 zones need AWS credentials, which
+default to the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		name, err := c.Flags().GetString("provider")
+		cmd.ErrCheck(err)
+		zone, err := c.Flags().GetString("zone")
+		cmd.ErrCheck(err)
+		var provider client.DomainProvider
+		switch name {
+		case "cloudflare":
+			token, err := c.Flags().GetString("token")
+			cmd.ErrCheck(err)
+			if token == "" {
+				token = os.Getenv("CLOUDFLARE_API_TOKEN")
+			}
+			provider = client.WithCloudflare(zone, token)
+		case "route53":
+			accessKey, err := c.Flags().GetString("access-key")
+			cmd.ErrCheck(err)
+			if accessKey == "" {
+				accessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+			}
+			secretKey, err := c.Flags().GetString("secret-key")
+			cmd.ErrCheck(err)
+			if secretKey == "" {
+				secretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+			}
+			provider = client.WithRoute53(zone, accessKey, secretKey)
+		default:
+			cmd.Fatal(fmt.Errorf("unsupported provider %q", name))
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		d, err := buck.AddDomain(ctx, args[0], provider)
+		cmd.ErrCheck(err)
+		cmd.Message("Add the following TXT record to verify %s:", aurora.White(d.Name).Bold())
+		cmd.RenderTable([]string{"name", "value"}, [][]string{{d.ChallengeName, d.ChallengeValue}})
+		cmd.Success("Added domain %s", aurora.White(d.ID).Bold())
+	},
+}
+
+var domainLsCmd = &cobra.Command{
+	Use: "ls",
+	Aliases: []string{
+		"list",
+	},
+	Short: "List bucket custom domains",
+	Long:  `Lists the custom domains of the remote bucket and the status of their DNSLink records.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		list, err := buck.ListDomains(ctx)
+		cmd.ErrCheck(err)
+		if len(list) == 0 {
+			cmd.End("No domains found")
+		}
+		var data [][]string
+		for _, d := range list {
+			verified := "no"
+			if d.Verified {
+				verified = "yes"
+			}
+			synced := ""
+			if !d.LastSyncedAt.IsZero() {
+				synced = d.LastSyncedAt.Format(time.RFC3339)
+			}
+			data = append(data, []string{d.ID, d.Name, d.Provider, verified, d.LastRoot, synced, d.LastError})
+		}
+		cmd.RenderTable([]string{"id", "domain", "provider", "verified", "root", "synced", "error"}, data)
+	},
+}
+
+var domainVerifyCmd = &cobra.Command{
+	Use:   "verify [id]",
+	Short: "Verify a custom domain",
+	Long:  `Checks the verification TXT record of a custom domain. Unverified domains are also checked periodically.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		d, err := buck.VerifyDomain(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Verified domain %s", aurora.White(d.Name).Bold())
+	},
+}
+
+var domainRmCmd = &cobra.Command{
+	Use: "rm [id]",
+	Aliases: []string{
+		"remove",
+	},
+	Short: "Remove a custom domain",
+	Long:  `Stops managing the DNSLink record of a custom domain. The record itself is left in place.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		err = buck.RemoveDomain(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Removed domain %s", aurora.White(args[0]).Bold())
+	},
+}
@@ -0,0 +1,66 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var holdCmd = &cobra.Command{
+	Use:   "hold [reason]",
+	Short: "Place a legal hold on the bucket",
+	Long: `Places a legal hold on the remote bucket. A legal hold blocks all deletions and overwrites until released.
+
+Only org owners can place legal holds. A reason is required and is recorded in the org audit log.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		err = buck.SetLegalHold(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Placed legal hold on bucket")
+	},
+}
+
+var holdReleaseCmd = &cobra.Command{
+	Use:   "release [reason]",
+	Short: "Release the legal hold on the bucket",
+	Long: `Releases the legal hold on the remote bucket.
+
+Only org owners can release legal holds. A reason is required and is recorded in the org audit log.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		err = buck.ReleaseLegalHold(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Released legal hold on bucket")
+	},
+}
+
+var holdStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show the legal hold status of the bucket",
+	Long:  `Shows whether or not the remote bucket is under legal hold.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		hold, err := buck.LegalHold(ctx)
+		cmd.ErrCheck(err)
+		if hold == nil {
+			cmd.End("Bucket is not under legal hold")
+		}
+		cmd.Message("Bucket is under legal hold placed by %s on %s", aurora.White(hold.Actor).Bold(),
+			aurora.White(hold.CreatedAt.Format("2006-01-02 15:04:05")).Bold())
+		cmd.Message("Reason: %s", hold.Reason)
+	},
+}
@@ -27,6 +27,9 @@ Existing configs will not be overwritten.
 
 Use the '--existing' flag to initialize from an existing remote bucket.
 Use the '--cid' flag to initialize from an existing UnixFS DAG.
+Use the '--template' flag to start with the contents of a public bucket (<thread>/<key> or a gateway URL),
+a UnixFS Cid, or a CAR file or tarball, given as a local path or URL.
+Use the '--passphrase' flag to derive the encryption key of a new private bucket from a passphrase.
 `,
 	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
@@ -50,8 +53,18 @@ Use the '--cid' flag to initialize from an existing UnixFS DAG.
 		if (existing || chooseExisting) && xcid.Defined() {
 			cmd.Fatal(errors.New("--cid can not be used with an existing bucket"))
 		}
+		template, err := c.Flags().GetString("template")
+		cmd.ErrCheck(err)
+		if template != "" {
+			if existing || chooseExisting {
+				cmd.Fatal(errors.New("--template can not be used with an existing bucket"))
+			}
+			if xcid.Defined() {
+				cmd.Fatal(errors.New("--template can not be used with --cid"))
+			}
+		}
 
-		var name string
+		var name, passphrase string
 		var private bool
 		if !existing && !chooseExisting {
 			if c.Flags().Changed("name") {
@@ -78,6 +91,12 @@ Use the '--cid' flag to initialize from an existing UnixFS DAG.
 					private = true
 				}
 			}
+			usePassphrase, err := c.Flags().GetBool("passphrase")
+			cmd.ErrCheck(err)
+			if usePassphrase {
+				private = true
+				passphrase = promptPassphrase()
+			}
 		}
 
 		if chooseExisting {
@@ -137,18 +156,29 @@ Use the '--cid' flag to initialize from an existing UnixFS DAG.
 
 		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
 		defer cancel()
+		opts := []local.NewOption{
+			local.WithName(name),
+			local.WithPrivate(private),
+			local.WithPassphrase(passphrase),
+			local.WithCid(xcid),
+		}
+		cache, err := getCache(c)
+		cmd.ErrCheck(err)
+		if cache != nil {
+			opts = append(opts, local.WithExistingCache(cache))
+		}
+		if template != "" {
+			opt, closeTemplate, err := templateOption(ctx, template)
+			cmd.ErrCheck(err)
+			defer closeTemplate()
+			opts = append(opts, opt)
+		}
 		events := make(chan local.PathEvent)
 		defer close(events)
 		progress := uiprogress.New()
 		progress.Start()
 		go handleProgressBars(progress, events)
-		buck, err := bucks.NewBucket(
-			ctx,
-			conf,
-			local.WithName(name),
-			local.WithPrivate(private),
-			local.WithCid(xcid),
-			local.WithExistingPathEvents(events))
+		buck, err := bucks.NewBucket(ctx, conf, append(opts, local.WithExistingPathEvents(events))...)
 		progress.Stop()
 		cmd.ErrCheck(err)
 
@@ -161,6 +191,8 @@ Use the '--cid' flag to initialize from an existing UnixFS DAG.
 			msg = "Initialized %s as a new empty bucket"
 			if xcid.Defined() {
 				msg = "Initialized %s as a new bootstrapped bucket"
+			} else if template != "" {
+				msg = "Initialized %s as a new bucket from a template"
 			}
 		} else {
 			msg = "Initialized %s from an existing bucket"
@@ -171,3 +203,33 @@ Use the '--cid' flag to initialize from an existing UnixFS DAG.
 		cmd.Success(msg, aurora.White(bp).Bold())
 	},
 }
+
+// promptPassphrase asks for a new passphrase twice, exiting if the entries don't match.
+func promptPassphrase() string {
+	prompt := promptui.Prompt{
+		Label: "Enter a passphrase for the bucket encryption key",
+		Mask:  '*',
+		Validate: func(s string) error {
+			if s == "" {
+				return errors.New("passphrase is required")
+			}
+			return nil
+		},
+	}
+	passphrase, err := prompt.Run()
+	if err != nil {
+		cmd.End("")
+	}
+	confirm := promptui.Prompt{
+		Label: "Confirm passphrase",
+		Mask:  '*',
+	}
+	again, err := confirm.Run()
+	if err != nil {
+		cmd.End("")
+	}
+	if passphrase != again {
+		cmd.Fatal(errors.New("passphrases do not match"))
+	}
+	return passphrase
+}
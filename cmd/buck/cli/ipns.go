@@ -0,0 +1,73 @@
+package cli
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var ipnsCmd = &cobra.Command{
+	Use:   "ipns",
+	Short: "Manage the bucket IPNS key",
+	Long: `Manages the IPNS key the bucket is published with.
+
+By default, buckets are published with a key managed by the hub. Supplying your own key keeps the
+bucket's IPNS name when moving the bucket to another hub, e.g., with 'buck export' and 'buck import'.`,
+	Args: cobra.ExactArgs(0),
+}
+
+var ipnsImportCmd = &cobra.Command{
+	Use:   "import [keyfile]",
+	Short: "Import an IPNS key",
+	Long:  `Replaces the bucket IPNS key with the private key in keyfile, e.g., from 'buck ipns generate'.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		data, err := ioutil.ReadFile(args[0])
+		cmd.ErrCheck(err)
+		key, err := crypto.UnmarshalPrivateKey(data)
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		name, err := buck.ImportIPNSKey(ctx, key)
+		cmd.ErrCheck(err)
+		cmd.Success("Imported IPNS key, the bucket is now published at /ipns/%s", aurora.White(name).Bold())
+	},
+}
+
+var ipnsGenerateCmd = &cobra.Command{
+	Use:   "generate [keyfile]",
+	Short: "Generate an IPNS key",
+	Long: `Replaces the bucket IPNS key with a new key and saves the private key to keyfile.
+
+Keep the key file safe. Anyone with the key can publish to the bucket's IPNS name.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		file, err := os.OpenFile(args[0], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		cmd.ErrCheck(err)
+		defer file.Close()
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		if err != nil {
+			_ = os.Remove(args[0])
+			cmd.Fatal(err)
+		}
+		name, key, err := buck.GenerateIPNSKey(ctx)
+		if err != nil {
+			_ = os.Remove(args[0])
+			cmd.Fatal(err)
+		}
+		data, err := crypto.MarshalPrivateKey(key)
+		cmd.ErrCheck(err)
+		_, err = file.Write(data)
+		cmd.ErrCheck(err)
+		cmd.Success("Generated IPNS key %s, the bucket is now published at /ipns/%s", aurora.White(args[0]).Bold(), aurora.White(name).Bold())
+	},
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var mirrorCmd = &cobra.Command{
+	Use:   "mirror",
+	Short: "Manage bucket pin mirrors",
+	Long: `Manages remote pinning services that pin the bucket root.
+
+Any service that implements the IPFS Pinning Service API can be used, e.g., Pinata or web3.storage.
+The hub replaces the remote pin each time the bucket root changes.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		mirrorLsCmd.Run(c, args)
+	},
+}
+
+var mirrorAddCmd = &cobra.Command{
+	Use:   "add [endpoint]",
+	Short: "Mirror the bucket root to a pinning service",
+	Long: `Pins the bucket root on the pinning service at endpoint, e.g., https://api.pinata.cloud/psa.
+
+The access token defaults to the PINNING_SERVICE_TOKEN environment variable.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		token, err := c.Flags().GetString("token")
+		cmd.ErrCheck(err)
+		if token == "" {
+			token = os.Getenv("PINNING_SERVICE_TOKEN")
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		m, err := buck.AddPinMirror(ctx, args[0], token)
+		cmd.ErrCheck(err)
+		cmd.Success("Added pin mirror %s", aurora.White(m.ID).Bold())
+	},
+}
+
+var mirrorLsCmd = &cobra.Command{
+	Use: "ls",
+	Aliases: []string{
+		"list",
+	},
+	Short: "List bucket pin mirrors",
+	Long:  `Lists the pin mirrors of the remote bucket and the status of their remote pins.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		list, err := buck.ListPinMirrors(ctx)
+		cmd.ErrCheck(err)
+		if len(list) == 0 {
+			cmd.End("No pin mirrors found")
+		}
+		var data [][]string
+		for _, m := range list {
+			synced := ""
+			if !m.LastSyncedAt.IsZero() {
+				synced = m.LastSyncedAt.Format(time.RFC3339)
+			}
+			data = append(data, []string{m.ID, m.Endpoint, m.Status, m.LastRoot, synced, m.LastError})
+		}
+		cmd.RenderTable([]string{"id", "endpoint", "status", "root", "synced", "error"}, data)
+	},
+}
+
+var mirrorRmCmd = &cobra.Command{
+	Use: "rm [id]",
+	Aliases: []string{
+		"remove",
+	},
+	Short: "Remove a bucket pin mirror",
+	Long:  `Stops mirroring the bucket root to a pinning service. The remote pin is removed.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		err = buck.RemovePinMirror(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Removed pin mirror %s", aurora.White(args[0]).Bold())
+	},
+}
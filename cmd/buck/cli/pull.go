@@ -3,8 +3,10 @@ package cli
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/logrusorgru/aurora"
+	"github.com/manifoldco/promptui"
 	"github.com/spf13/cobra"
 	"github.com/textileio/textile/buckets/local"
 	"github.com/textileio/textile/cmd"
@@ -14,8 +16,16 @@ import (
 var pullCmd = &cobra.Command{
 	Use:   "pull",
 	Short: "Pull bucket object changes",
-	Long:  `Pulls paths that have been added to and paths that have been removed or differ from the remote bucket root.`,
-	Args:  cobra.ExactArgs(0),
+	Long: `Pulls paths that have been added to and paths that have been removed or differ from the remote bucket root.
+
+Use --strategy to choose how files that were changed both locally and remotely are handled:
+- ours: Keep the local version
+- theirs: Keep the remote version
+- both: Keep the remote version and move the local version next to it
+- prompt: Ask for each file (default, or "ours" with --yes)
+
+Use --include and --exclude to only pull some of the remote paths, e.g., "--exclude raw/".`,
+	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
 		force, err := c.Flags().GetBool("force")
 		cmd.ErrCheck(err)
@@ -23,6 +33,26 @@ var pullCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		yes, err := c.Flags().GetBool("yes")
 		cmd.ErrCheck(err)
+		dryRun, err := c.Flags().GetBool("dry-run")
+		cmd.ErrCheck(err)
+		include, err := c.Flags().GetStringSlice("include")
+		cmd.ErrCheck(err)
+		exclude, err := c.Flags().GetStringSlice("exclude")
+		cmd.ErrCheck(err)
+		concurrency, err := c.Flags().GetInt("concurrency")
+		cmd.ErrCheck(err)
+		rateLimit, err := getRateLimit(c)
+		cmd.ErrCheck(err)
+		retries, err := c.Flags().GetInt("retries")
+		cmd.ErrCheck(err)
+		fileInfo, err := c.Flags().GetBool("file-info")
+		cmd.ErrCheck(err)
+		cache, err := getCache(c)
+		cmd.ErrCheck(err)
+		strategy, err := c.Flags().GetString("strategy")
+		cmd.ErrCheck(err)
+		resolver, err := getConflictResolver(strategy, yes)
+		cmd.ErrCheck(err)
 		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
 		defer cancel()
 		buck, err := bucks.GetLocalBucket(ctx, ".")
@@ -32,11 +62,21 @@ var pullCmd = &cobra.Command{
 		progress := uiprogress.New()
 		progress.Start()
 		go handleProgressBars(progress, events)
+		var changes []local.Change
 		roots, err := buck.PullRemote(
 			ctx,
 			local.WithConfirm(getConfirm("Discard %d local changes", yes)),
+			local.WithDryRun(getDryRun(dryRun, &changes)),
+			local.WithInclude(include...),
+			local.WithExclude(exclude...),
 			local.WithForce(force),
 			local.WithHard(hard),
+			local.WithConcurrency(concurrency),
+			local.WithRateLimit(rateLimit),
+			local.WithRetries(retries),
+			local.WithCache(cache),
+			local.WithFileInfo(fileInfo),
+			local.WithConflictResolver(resolver),
 			local.WithPathEvents(events))
 		progress.Stop()
 		if errors.Is(err, local.ErrAborted) {
@@ -46,6 +86,48 @@ var pullCmd = &cobra.Command{
 		} else if err != nil {
 			cmd.Fatal(err)
 		}
+		if dryRun {
+			printDryRun("%d changes would be pulled", changes)
+			return
+		}
+		if cmd.JSONOutput() {
+			cmd.JSON(roots)
+			return
+		}
 		cmd.Message("%s", aurora.White(roots.Remote).Bold())
 	},
 }
+
+func getConflictResolver(strategy string, auto bool) (local.ConflictResolver, error) {
+	switch strategy {
+	case "":
+		if auto {
+			return local.KeepOurs, nil
+		}
+		return promptConflictResolver(), nil
+	case "prompt":
+		return promptConflictResolver(), nil
+	case string(local.KeepOurs), string(local.KeepTheirs), string(local.KeepBoth):
+		return local.ConflictResolution(strategy), nil
+	default:
+		return nil, fmt.Errorf("invalid strategy: %s", strategy)
+	}
+}
+
+func promptConflictResolver() local.ConflictResolver {
+	return local.ConflictResolverFunc(func(c local.Conflict) (local.ConflictResolution, error) {
+		label := fmt.Sprintf("%s was changed locally and remotely", c.Rel)
+		if !c.Local.Defined() {
+			label = fmt.Sprintf("%s was removed locally and changed remotely", c.Rel)
+		}
+		prompt := promptui.Select{
+			Label: label,
+			Items: []local.ConflictResolution{local.KeepOurs, local.KeepTheirs, local.KeepBoth},
+		}
+		_, res, err := prompt.Run()
+		if err != nil {
+			return "", err
+		}
+		return local.ConflictResolution(res), nil
+	})
+}
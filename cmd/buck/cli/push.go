@@ -23,13 +23,32 @@ const nonFastForwardMsg = "the root of your bucket is behind (try `%s` before pu
 var pushCmd = &cobra.Command{
 	Use:   "push",
 	Short: "Push bucket object changes",
-	Long:  `Pushes paths that have been added to and paths that have been removed or differ from the local bucket root.`,
-	Args:  cobra.ExactArgs(0),
+	Long: `Pushes paths that have been added to and paths that have been removed or differ from the local bucket root.
+
+Paths matching the patterns in a .buckignore file at the bucket root are skipped (uses gitignore syntax).
+Use --include and --exclude to only push some of the changes, e.g., "--include dist/".`,
+	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
 		force, err := c.Flags().GetBool("force")
 		cmd.ErrCheck(err)
 		yes, err := c.Flags().GetBool("yes")
 		cmd.ErrCheck(err)
+		dryRun, err := c.Flags().GetBool("dry-run")
+		cmd.ErrCheck(err)
+		include, err := c.Flags().GetStringSlice("include")
+		cmd.ErrCheck(err)
+		exclude, err := c.Flags().GetStringSlice("exclude")
+		cmd.ErrCheck(err)
+		deterministic, err := c.Flags().GetBool("deterministic")
+		cmd.ErrCheck(err)
+		fileInfo, err := c.Flags().GetBool("file-info")
+		cmd.ErrCheck(err)
+		concurrency, err := c.Flags().GetInt("concurrency")
+		cmd.ErrCheck(err)
+		rateLimit, err := getRateLimit(c)
+		cmd.ErrCheck(err)
+		retries, err := c.Flags().GetInt("retries")
+		cmd.ErrCheck(err)
 		maxSize, err := c.Flags().GetInt64("maxsize")
 		if err != nil {
 			cmd.Fatal(err)
@@ -51,10 +70,19 @@ var pushCmd = &cobra.Command{
 		progress := uiprogress.New()
 		progress.Start()
 		go handleProgressBars(progress, events)
+		var changes []local.Change
 		roots, err := buck.PushLocal(
 			ctx,
 			local.WithConfirm(getConfirm("Push %d changes", yes)),
+			local.WithDryRun(getDryRun(dryRun, &changes)),
+			local.WithInclude(include...),
+			local.WithExclude(exclude...),
 			local.WithForce(force),
+			local.WithDeterministic(deterministic),
+			local.WithFileInfo(fileInfo),
+			local.WithConcurrency(concurrency),
+			local.WithRateLimit(rateLimit),
+			local.WithRetries(retries),
 			local.WithPathEvents(events))
 		progress.Stop()
 		if errors.Is(err, local.ErrAborted) {
@@ -66,6 +94,14 @@ var pushCmd = &cobra.Command{
 		} else if err != nil {
 			cmd.Fatal(err)
 		}
+		if dryRun {
+			printDryRun("%d changes would be pushed", changes)
+			return
+		}
+		if cmd.JSONOutput() {
+			cmd.JSON(roots)
+			return
+		}
 		cmd.Message("%s", aurora.White(roots.Remote).Bold())
 	},
 }
@@ -0,0 +1,53 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var quotaCmd = &cobra.Command{
+	Use:   "quota",
+	Short: "Show the bucket quota",
+	Long:  `Shows the max size, current size, and remaining capacity of the remote bucket in bytes.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		quota, err := buck.Quota(ctx)
+		cmd.ErrCheck(err)
+		cmd.Message("Size: %d bytes", aurora.White(quota.Size).Bold())
+		if quota.MaxSize == 0 {
+			cmd.End("Bucket has no max size")
+		}
+		cmd.Message("Max size: %d bytes", aurora.White(quota.MaxSize).Bold())
+		cmd.Message("Remaining: %d bytes", aurora.White(quota.Remaining).Bold())
+	},
+}
+
+var quotaSetCmd = &cobra.Command{
+	Use:   "set [bytes]",
+	Short: "Set the bucket max size",
+	Long: `Sets the max size of the remote bucket in bytes. Pushes that would exceed the max size are rejected.
+
+A max size of 0 removes the bucket quota. The hub's max bucket size always applies.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		maxSize, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			cmd.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		err = buck.SetQuota(ctx, maxSize)
+		cmd.ErrCheck(err)
+		cmd.Success("Set bucket max size to %d bytes", aurora.White(maxSize).Bold())
+	},
+}
@@ -0,0 +1,110 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/api/buckets/client"
+	"github.com/textileio/textile/cmd"
+)
+
+var importS3Cmd = &cobra.Command{
+	Use:   "s3 [bucket]",
+	Short: "Import objects from an S3 bucket",
+	Long: `Imports the objects of an S3 bucket into the remote bucket.
+
+The import runs on the hub, so objects are not downloaded to this machine. Object keys are used as bucket paths,
+without the prefix. Interrupted imports resume after the last imported object.
+Use 'buck import ls' to follow the progress of the import.
+
+Credentials default to the AWS_ACCESS_KEY_ID and AWS_SECRET_ACCESS_KEY environment variables.
+Public buckets can be imported without credentials.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		var opts []client.S3ImportOption
+		pth, err := c.Flags().GetString("path")
+		cmd.ErrCheck(err)
+		opts = append(opts, client.WithImportPath(pth))
+		endpoint, err := c.Flags().GetString("endpoint")
+		cmd.ErrCheck(err)
+		opts = append(opts, client.WithS3Endpoint(endpoint))
+		region, err := c.Flags().GetString("region")
+		cmd.ErrCheck(err)
+		opts = append(opts, client.WithS3Region(region))
+		prefix, err := c.Flags().GetString("prefix")
+		cmd.ErrCheck(err)
+		opts = append(opts, client.WithS3Prefix(prefix))
+		accessKey, err := c.Flags().GetString("access-key")
+		cmd.ErrCheck(err)
+		secretKey, err := c.Flags().GetString("secret-key")
+		cmd.ErrCheck(err)
+		if accessKey == "" {
+			accessKey = os.Getenv("AWS_ACCESS_KEY_ID")
+			secretKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+		}
+		opts = append(opts, client.WithS3Credentials(accessKey, secretKey))
+
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		imp, err := buck.ImportS3(ctx, args[0], opts...)
+		cmd.ErrCheck(err)
+		cmd.Success("Started import %s from %s, use 'buck import ls' to follow its progress", aurora.White(imp.ID).Bold(), imp.Source)
+	},
+}
+
+var importLsCmd = &cobra.Command{
+	Use: "ls",
+	Aliases: []string{
+		"list",
+	},
+	Short: "List bucket imports",
+	Long:  `Lists the imports of the remote bucket and their progress.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		list, err := buck.ListImports(ctx)
+		cmd.ErrCheck(err)
+		if len(list) == 0 {
+			cmd.End("No imports found")
+		}
+		var data [][]string
+		for _, imp := range list {
+			data = append(data, []string{
+				imp.ID,
+				imp.Source,
+				"/" + imp.Path,
+				imp.Status,
+				strconv.FormatInt(imp.Objects, 10),
+				strconv.FormatInt(imp.Bytes, 10),
+				imp.UpdatedAt.Format(time.RFC3339),
+				imp.LastError,
+			})
+		}
+		cmd.RenderTable([]string{"id", "source", "path", "status", "objects", "bytes", "updated", "error"}, data)
+	},
+}
+
+var importCancelCmd = &cobra.Command{
+	Use:   "cancel [id]",
+	Short: "Cancel a bucket import",
+	Long:  `Stops a queued or running import. Objects that were already imported are kept.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		err = buck.CancelImport(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Canceled import %s", aurora.White(args[0]).Bold())
+	},
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/buckets"
+	"github.com/textileio/textile/cmd"
+)
+
+// serveAddr is the default address of the local preview server.
+const serveAddr = "127.0.0.1:8080"
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Preview the local bucket as a website",
+	Long: `Serves the local bucket files as a static website, following the same index document,
+error document, redirect, and header rules as the gateway, so a site can be previewed before it's pushed.
+
+The website config of the remote bucket is used by default. Use --config to preview a JSON config file
+(see "buck website") instead. The local _redirects file is reloaded on each request.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		addr, err := c.Flags().GetString("addr")
+		cmd.ErrCheck(err)
+		conf, err := c.Flags().GetString("config")
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+
+		var website *buckets.Website
+		if conf != "" {
+			data, err := ioutil.ReadFile(conf)
+			cmd.ErrCheck(err)
+			website = &buckets.Website{}
+			err = json.Unmarshal(data, website)
+			cmd.ErrCheck(err)
+			err = website.Validate()
+			cmd.ErrCheck(err)
+		} else {
+			website, err = buck.Website(ctx)
+			if err != nil {
+				cmd.Warn("Unable to get the remote website config, using defaults: %v", err)
+			}
+		}
+		handler, err := buck.PreviewHandler(website)
+		cmd.ErrCheck(err)
+		cmd.Message("Serving the local bucket at %s", aurora.White("http://"+addr).Bold())
+		cmd.ErrCheck(http.ListenAndServe(addr, handler))
+	},
+}
@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+// shareExpires is the default amount of time a share link is valid.
+const shareExpires = time.Hour * 24
+
+var shareCmd = &cobra.Command{
+	Use:   "share [path]",
+	Short: "Share a bucket path with a link",
+	Long: `Creates a gateway link that gives read-only access to a remote bucket path until it expires.
+
+Anyone with the link can view the content without an account. Use --password to require a password.
+Leave out the path to share the whole bucket.`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		expires, err := c.Flags().GetDuration("expires")
+		cmd.ErrCheck(err)
+		password, err := c.Flags().GetString("password")
+		cmd.ErrCheck(err)
+		var pth string
+		if len(args) > 0 {
+			pth = args[0]
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		link, err := buck.CreateShareLink(ctx, pth, expires, password)
+		cmd.ErrCheck(err)
+		if cmd.JSONOutput() {
+			cmd.JSON(link)
+			return
+		}
+		cmd.Message("%s", aurora.White(link.URL).Bold())
+		cmd.Success("Shared until %s, revoke with 'buck share revoke %s'", link.ExpiresAt.Format(time.RFC3339), link.ID)
+	},
+}
+
+var shareLsCmd = &cobra.Command{
+	Use: "ls",
+	Aliases: []string{
+		"list",
+	},
+	Short: "List bucket share links",
+	Long:  `Lists the share links of the remote bucket that have not expired.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		list, err := buck.ListShareLinks(ctx)
+		cmd.ErrCheck(err)
+		if cmd.JSONOutput() {
+			cmd.JSON(list)
+			return
+		}
+		if len(list) == 0 {
+			cmd.End("No share links found")
+		}
+		var data [][]string
+		for _, l := range list {
+			pth := l.Path
+			if pth == "" {
+				pth = "/"
+			}
+			data = append(data, []string{l.ID, pth, l.URL, strconv.FormatBool(l.Protected), l.ExpiresAt.Format(time.RFC3339)})
+		}
+		cmd.RenderTable([]string{"id", "path", "url", "protected", "expires"}, data)
+	},
+}
+
+var shareRevokeCmd = &cobra.Command{
+	Use:   "revoke [id]",
+	Short: "Revoke a share link",
+	Long:  `Revokes a share link so that it can no longer be used to view content.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		err = buck.RevokeShareLink(ctx, args[0])
+		cmd.ErrCheck(err)
+		cmd.Success("Revoked share link %s", aurora.White(args[0]).Bold())
+	},
+}
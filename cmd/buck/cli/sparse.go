@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/buckets/local"
+	"github.com/textileio/textile/cmd"
+	"github.com/textileio/uiprogress"
+)
+
+var sparseCmd = &cobra.Command{
+	Use:   "sparse",
+	Short: "Show the bucket paths synced locally",
+	Long: `Shows the remote bucket paths that are synced locally.
+
+By default, the entire bucket is synced. Use "buck sparse set" to only sync some paths.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		paths := buck.SparsePaths()
+		if len(paths) == 0 {
+			cmd.End("Entire bucket is synced")
+		}
+		cmd.Message("%s", strings.Join(paths, "\n"))
+	},
+}
+
+var sparseSetCmd = &cobra.Command{
+	Use:   "set [paths...]",
+	Short: "Only sync some bucket paths",
+	Long: `Restricts the local bucket to the given remote paths.
+
+Local files outside of the paths are removed and files inside the paths are pulled.
+Only changes inside the paths are pushed. Remote files outside of the paths are left untouched.
+Run without paths to sync the entire bucket again.`,
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.PullTimeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		events := make(chan local.PathEvent)
+		defer close(events)
+		progress := uiprogress.New()
+		progress.Start()
+		go handleProgressBars(progress, events)
+		err = buck.SetSparsePaths(ctx, args, local.WithPathEvents(events))
+		progress.Stop()
+		if errors.Is(err, local.ErrSparseChanges) {
+			cmd.Fatal(errors.New("push or discard local changes before changing sparse paths"))
+		}
+		cmd.ErrCheck(err)
+		if len(args) == 0 {
+			cmd.Success("Syncing the entire bucket")
+		} else {
+			cmd.Success("Syncing %s", aurora.White(strings.Join(buck.SparsePaths(), ", ")).Bold())
+		}
+	},
+}
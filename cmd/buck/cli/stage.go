@@ -0,0 +1,64 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var stageCmd = &cobra.Command{
+	Use:   "stage [paths...]",
+	Short: "Stage paths for the next push",
+	Long: `Stages local paths so that the next push only includes changes under them.
+
+Directories stage all the changes they contain. Until something is staged, a push includes all changes.
+Use "buck reset" to unstage paths.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		err = buck.Stage(args...)
+		cmd.ErrCheck(err)
+		staged, err := buck.StagedPaths()
+		cmd.ErrCheck(err)
+		cmd.Success("Staged %s", aurora.White(strings.Join(stagedNames(staged), ", ")).Bold())
+	},
+}
+
+var resetCmd = &cobra.Command{
+	Use:   "reset [paths...]",
+	Short: "Unstage paths",
+	Long:  `Removes paths from the next push. Run without paths to unstage everything.`,
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		err = buck.Unstage(args...)
+		cmd.ErrCheck(err)
+		staged, err := buck.StagedPaths()
+		cmd.ErrCheck(err)
+		if len(staged) == 0 {
+			cmd.Success("Nothing staged")
+		} else {
+			cmd.Success("Staged %s", aurora.White(strings.Join(stagedNames(staged), ", ")).Bold())
+		}
+	},
+}
+
+// stagedNames replaces the bucket root with a readable name.
+func stagedNames(staged []string) []string {
+	names := make([]string, len(staged))
+	for i, s := range staged {
+		if s == "" {
+			s = "/"
+		}
+		names[i] = s
+	}
+	return names
+}
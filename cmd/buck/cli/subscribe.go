@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"os/exec"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/buckets/local"
+	"github.com/textileio/textile/cmd"
+)
+
+var subscribeCmd = &cobra.Command{
+	Use:   "subscribe",
+	Short: "Subscribe auto-pulls remote changes",
+	Long: `Subscribe auto-pulls remote changes into the local bucket.
+
+Remote changes are pulled once they settle for the debounce interval.
+Local changes are never pushed, which makes subscribe useful for read-only mirrors of a bucket.
+Use --notify-only to leave the local bucket untouched and only report changes.
+Use --exec to run a shell command after each change. The new remote root is available as $BUCK_ROOT.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		debounce, err := c.Flags().GetDuration("debounce")
+		cmd.ErrCheck(err)
+		notifyOnly, err := c.Flags().GetBool("notify-only")
+		cmd.ErrCheck(err)
+		command, err := c.Flags().GetString("exec")
+		cmd.ErrCheck(err)
+		cache, err := getCache(c)
+		cmd.ErrCheck(err)
+		bp, err := buck.Path()
+		cmd.ErrCheck(err)
+
+		onChange := func(rc local.RootChange) {
+			if rc.Pulled {
+				cmd.Success("Pulled remote root %s", aurora.White(rc.Root).Bold())
+			} else {
+				cmd.Message("Remote root changed to %s", aurora.White(rc.Root).Bold())
+			}
+			if command == "" {
+				return
+			}
+			run := exec.CommandContext(ctx, "sh", "-c", command)
+			run.Dir = bp
+			run.Env = append(os.Environ(), "BUCK_ROOT="+rc.Root.String(), "BUCK_PREVIOUS_ROOT="+rc.Previous.String())
+			run.Stdout = os.Stdout
+			run.Stderr = os.Stderr
+			if err := run.Run(); err != nil {
+				cmd.Warn("Command failed: %v", err)
+			}
+		}
+
+		events := make(chan local.PathEvent)
+		defer close(events)
+		go handleWatchEvents(events)
+		opts := []local.WatchOption{
+			local.WithWatchEvents(events),
+			local.WithOffline(true),
+			local.WithDebounce(debounce),
+			local.WithNotifyOnly(notifyOnly),
+			local.WithRootChange(onChange),
+		}
+		if cache != nil {
+			opts = append(opts, local.WithPullOptions(local.WithCache(cache)))
+		}
+		state, err := buck.Subscribe(ctx, opts...)
+		cmd.ErrCheck(err)
+		for s := range state {
+			switch s.State {
+			case cmd.Online:
+				cmd.Success("Subscribed to remote changes of %s...", aurora.White(bp).Bold())
+			case cmd.Offline:
+				if s.Aborted {
+					cmd.Fatal(s.Err)
+				} else {
+					cmd.Message("Not connected. Trying to connect...")
+				}
+			}
+		}
+	},
+}
@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/buckets/local"
+	"github.com/textileio/textile/cmd"
+)
+
+var tagsCmd = &cobra.Command{
+	Use: "tags",
+	Aliases: []string{
+		"tag",
+	},
+	Short: "Show bucket tags",
+	Long:  `Shows the key/value tags of the remote bucket, including tags inherited from its thread.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		info, err := buck.Info(ctx)
+		cmd.ErrCheck(err)
+		if len(info.Tags) == 0 {
+			cmd.End("Bucket has no tags")
+		}
+		cmd.RenderTable([]string{"key", "value"}, tagRows(info.Tags))
+	},
+}
+
+var tagsSetCmd = &cobra.Command{
+	Use:   "set [key=value...]",
+	Short: "Set bucket tags",
+	Long: `Replaces the key/value tags of the remote bucket, e.g., "buck tags set env=prod project=site".
+
+Setting no tags removes all tags from the bucket.`,
+	Run: func(c *cobra.Command, args []string) {
+		tags, err := parseTags(args)
+		cmd.ErrCheck(err)
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		_, err = buck.SetTags(ctx, tags)
+		cmd.ErrCheck(err)
+		if len(tags) == 0 {
+			cmd.Success("Removed bucket tags")
+		} else {
+			cmd.Success("Set %d bucket tags", aurora.White(len(tags)).Bold())
+		}
+	},
+}
+
+var tagsLsCmd = &cobra.Command{
+	Use: "ls [key=value...]",
+	Aliases: []string{
+		"list",
+	},
+	Short: "List remote buckets by tag",
+	Long: `Lists remote buckets that have all of the given key/value tags, e.g., "buck tags ls env=prod".
+
+Buckets from all threads are listed unless a thread is given.`,
+	Run: func(c *cobra.Command, args []string) {
+		tags, err := parseTags(args)
+		cmd.ErrCheck(err)
+		var id thread.ID
+		if t, err := c.Flags().GetString("thread"); err == nil && t != "" {
+			id, err = thread.Decode(t)
+			cmd.ErrCheck(err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		list, err := bucks.RemoteBuckets(ctx, id, local.WithTagFilter(tags))
+		cmd.ErrCheck(err)
+		if len(list) == 0 {
+			cmd.End("No buckets found")
+		}
+		var data [][]string
+		for _, info := range list {
+			var pairs []string
+			for _, row := range tagRows(info.Tags) {
+				pairs = append(pairs, row[0]+"="+row[1])
+			}
+			data = append(data, []string{info.Name, info.Key, info.Thread.String(), strings.Join(pairs, " ")})
+		}
+		cmd.RenderTable([]string{"name", "key", "thread", "tags"}, data)
+	},
+}
+
+// parseTags parses key=value arguments into tags.
+func parseTags(args []string) (map[string]string, error) {
+	tags := make(map[string]string, len(args))
+	for _, a := range args {
+		parts := strings.SplitN(a, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid tag %s, expected key=value", a)
+		}
+		tags[parts[0]] = parts[1]
+	}
+	return tags, nil
+}
+
+// tagRows returns key/value rows of tags sorted by key.
+func tagRows(tags map[string]string) [][]string {
+	rows := make([][]string, 0, len(tags))
+	for k, v := range tags {
+		rows = append(rows, []string{k, v})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		return rows[i][0] < rows[j][0]
+	})
+	return rows
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	cid "github.com/ipfs/go-cid"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/buckets/local"
+)
+
+// templateOption returns the bucket option for a --template source, which is one of:
+// a bucket given as <thread>/<key> or a gateway URL ending in /thread/<thread>/buckets/<key>,
+// a UnixFS Cid, optionally prefixed with /ipfs/ or ipfs://,
+// or a local path or URL of a CAR file (.car) or a tarball.
+// The returned func releases any file or download held by the option.
+func templateOption(ctx context.Context, src string) (local.NewOption, func(), error) {
+	noop := func() {}
+	if id, key, ok := parseTemplateBucket(src); ok {
+		pth, err := bucks.RemoteBucketPath(ctx, id, key)
+		if err != nil {
+			return nil, noop, fmt.Errorf("getting template bucket: %v", err)
+		}
+		return local.WithCid(pth.Cid()), noop, nil
+	}
+	if c, err := cid.Decode(strings.TrimPrefix(strings.TrimPrefix(src, "ipfs://"), "/ipfs/")); err == nil {
+		return local.WithCid(c), noop, nil
+	}
+
+	var r io.ReadCloser
+	name := src
+	if u, err := url.Parse(src); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, src, nil)
+		if err != nil {
+			return nil, noop, err
+		}
+		res, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return nil, noop, fmt.Errorf("downloading template: %v", err)
+		}
+		if res.StatusCode != http.StatusOK {
+			res.Body.Close()
+			return nil, noop, fmt.Errorf("downloading template: %s", res.Status)
+		}
+		r = res.Body
+		name = u.Path
+	} else {
+		file, err := os.Open(src)
+		if err != nil {
+			return nil, noop, err
+		}
+		r = file
+	}
+	release := func() { r.Close() }
+	if strings.HasSuffix(strings.ToLower(name), ".car") {
+		return local.WithCAR(r), release, nil
+	}
+	return local.WithArchive(r), release, nil
+}
+
+// parseTemplateBucket parses a bucket template source into a thread ID and bucket key.
+func parseTemplateBucket(src string) (id thread.ID, key string, ok bool) {
+	if u, err := url.Parse(src); err == nil && u.Host != "" {
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		n := len(parts)
+		if n < 4 || parts[n-4] != "thread" || parts[n-2] != "buckets" {
+			return id, "", false
+		}
+		src = parts[n-3] + "/" + parts[n-1]
+	}
+	parts := strings.Split(src, "/")
+	if len(parts) != 2 || parts[1] == "" {
+		return id, "", false
+	}
+	id, err := thread.Decode(parts[0])
+	if err != nil {
+		return id, "", false
+	}
+	return id, parts[1], true
+}
@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"strconv"
+	"strings"
 
 	"github.com/ipfs/go-cid"
 	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
 	"github.com/textileio/textile/buckets/local"
 	"github.com/textileio/textile/cmd"
 	"github.com/textileio/uiprogress"
@@ -32,12 +35,41 @@ func getConfirm(label string, auto bool) local.ConfirmDiffFunc {
 	}
 }
 
+// getDryRun returns a func that collects the changes of a dry run into changes,
+// or nil if dryRun is false.
+func getDryRun(dryRun bool, changes *[]local.Change) local.DryRunFunc {
+	if !dryRun {
+		return nil
+	}
+	return func(diff []local.Change) {
+		*changes = diff
+	}
+}
+
+// printDryRun prints the changes of a dry run.
+func printDryRun(label string, changes []local.Change) {
+	if cmd.JSONOutput() {
+		cmd.JSON(changes)
+		return
+	}
+	printChanges(changes)
+	cmd.Message(label, len(changes))
+}
+
 func handleProgressBars(p *uiprogress.Progress, events chan local.PathEvent) {
 	bars := make(map[string]*uiprogress.Bar)
 	for e := range events {
 		switch e.Type {
 		case local.FileStart:
-			bars[e.Path] = addBar(p, e.Path, e.Size)
+			if bar, ok := bars[e.Path]; ok { // Restarted after a retry
+				_ = bar.Set(0)
+			} else {
+				bars[e.Path] = addBar(p, e.Path, e.Size)
+			}
+		case local.FileRetry:
+			if bar, ok := bars[e.Path]; ok {
+				_ = bar.Set(0)
+			}
 		case local.FileProgress, local.FileComplete:
 			bar, ok := bars[e.Path]
 			if ok {
@@ -139,3 +171,48 @@ func formatBytes(i int64, useSIPrefix bool) (result string) {
 	}
 	return
 }
+
+// parseBytes parses a byte size like "512KiB", "10MB", or "1024".
+func parseBytes(str string) (int64, error) {
+	s := strings.TrimSpace(str)
+	units := []struct {
+		suffix string
+		size   float64
+	}{
+		{"TiB", _TiB}, {"GiB", _GiB}, {"MiB", _MiB}, {"KiB", _KiB},
+		{"TB", _TB}, {"GB", _GB}, {"MB", _MB}, {"kB", _kB}, {"KB", _kB},
+		{"B", 1},
+	}
+	mult := float64(1)
+	for _, u := range units {
+		if strings.HasSuffix(s, u.suffix) {
+			mult = u.size
+			s = strings.TrimSpace(strings.TrimSuffix(s, u.suffix))
+			break
+		}
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || v < 0 {
+		return 0, fmt.Errorf("invalid byte size: %s", str)
+	}
+	return int64(v * mult), nil
+}
+
+// getRateLimit returns the bytes per second set by the rate-limit flag, or zero if it's not set.
+func getRateLimit(c *cobra.Command) (int64, error) {
+	limit, err := c.Flags().GetString("rate-limit")
+	if err != nil || limit == "" {
+		return 0, err
+	}
+	return parseBytes(limit)
+}
+
+// getCache returns the cache of pulled files in the --cache-dir directory,
+// or nil if the flag is empty.
+func getCache(c *cobra.Command) (*local.Cache, error) {
+	dir, err := c.Flags().GetString("cache-dir")
+	if err != nil || dir == "" {
+		return nil, err
+	}
+	return local.NewCache(dir)
+}
@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/buckets/local"
+	"github.com/textileio/textile/cmd"
+)
+
+var verifyCmd = &cobra.Command{
+	Use:   "verify",
+	Short: "Verify local files against the remote bucket",
+	Long: `Verifies local files against the remote bucket without transferring any file contents.
+
+Local files are hashed and checked against the CIDs of the remote items.
+Files that only exist locally, don't match the remote, or are missing locally are reported.
+Exits with a non-zero status if there is any drift.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		drift, err := buck.Verify(ctx)
+		cmd.ErrCheck(err)
+		if cmd.JSONOutput() {
+			changes := make([]statusChange, len(drift))
+			for i, c := range drift {
+				changes[i] = statusChange{Type: local.ChangeType(c.Type), Path: c.Path}
+			}
+			cmd.JSON(changes)
+		} else if len(drift) == 0 {
+			cmd.Success("Local files match the remote bucket")
+		} else {
+			printChanges(drift)
+		}
+		if len(drift) > 0 {
+			os.Exit(1)
+		}
+	},
+}
@@ -11,20 +11,26 @@ import (
 
 var watchCmd = &cobra.Command{
 	Use:   "watch",
-	Short: "Watch auto-pushes local changes to the remote",
-	Long:  `Watch auto-pushes local changes to the remote.`,
-	Args:  cobra.ExactArgs(0),
+	Short: "Watch auto-pushes local changes to the remote and pulls remote changes",
+	Long: `Watch auto-pushes local changes to the remote and pulls remote changes.
+
+Local changes are pushed once they settle for the debounce interval.
+If a file was changed both locally and on the remote, the local version is kept next to the remote version,
+e.g., "file-conflict-1.txt".`,
+	Args: cobra.ExactArgs(0),
 	Run: func(c *cobra.Command, args []string) {
 		ctx, cancel := context.WithCancel(context.Background())
 		defer cancel()
 		buck, err := bucks.GetLocalBucket(ctx, ".")
 		cmd.ErrCheck(err)
+		debounce, err := c.Flags().GetDuration("debounce")
+		cmd.ErrCheck(err)
 		bp, err := buck.Path()
 		cmd.ErrCheck(err)
 		events := make(chan local.PathEvent)
 		defer close(events)
 		go handleWatchEvents(events)
-		state, err := buck.Watch(ctx, local.WithWatchEvents(events), local.WithOffline(true))
+		state, err := buck.Watch(ctx, local.WithWatchEvents(events), local.WithOffline(true), local.WithDebounce(debounce))
 		cmd.ErrCheck(err)
 		for s := range state {
 			switch s.State {
@@ -48,6 +54,8 @@ func handleWatchEvents(events chan local.PathEvent) {
 			cmd.Message("%s: %s (%s)", aurora.Green("+ "+e.Path), e.Cid, formatBytes(e.Size, true))
 		case local.FileRemoved:
 			cmd.Message("%s", aurora.Red("- "+e.Path))
+		case local.FileConflict:
+			cmd.Warn("Conflict: kept local changes at %s", aurora.Yellow(e.Path))
 		}
 	}
 }
@@ -0,0 +1,77 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/buckets"
+	"github.com/textileio/textile/cmd"
+)
+
+var websiteCmd = &cobra.Command{
+	Use:   "website",
+	Short: "Manage the bucket website config",
+	Long: `Manages how the gateway renders the bucket as a static website.
+
+The config is JSON with the following optional fields:
+
+  {
+    "index_document": "index.html",
+    "error_document": "404.html",
+    "redirects": [{"from": "/app/*", "to": "/index.html", "status": 200}],
+    "headers": [{"path": "assets/**", "name": "Cache-Control", "value": "max-age=31536000"}]
+  }
+
+Redirects are applied after the rules in the bucket's _redirects file.`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		website, err := buck.Website(ctx)
+		cmd.ErrCheck(err)
+		data, err := json.MarshalIndent(website, "", "  ")
+		cmd.ErrCheck(err)
+		cmd.Message("%s", string(data))
+	},
+}
+
+var websiteSetCmd = &cobra.Command{
+	Use:   "set [file]",
+	Short: "Set the bucket website config",
+	Long:  `Replaces the bucket website config with the JSON config in file.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		data, err := ioutil.ReadFile(args[0])
+		cmd.ErrCheck(err)
+		var website buckets.Website
+		cmd.ErrCheck(json.Unmarshal(data, &website))
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		_, err = buck.SetWebsite(ctx, &website)
+		cmd.ErrCheck(err)
+		cmd.Success("Updated website config from %s", aurora.White(args[0]).Bold())
+	},
+}
+
+var websiteClearCmd = &cobra.Command{
+	Use:   "clear",
+	Short: "Remove the bucket website config",
+	Long:  `Removes the bucket website config. The gateway falls back to serving index.html files.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), cmd.Timeout)
+		defer cancel()
+		buck, err := bucks.GetLocalBucket(ctx, ".")
+		cmd.ErrCheck(err)
+		_, err = buck.SetWebsite(ctx, nil)
+		cmd.ErrCheck(err)
+		cmd.Success("Removed website config")
+	},
+}
@@ -15,6 +15,7 @@ func init() {
 	buck.Init(rootCmd)
 
 	rootCmd.PersistentFlags().String("api", defaultTarget, "API target")
+	cmd.AddJSONFlag(rootCmd)
 }
 
 func main() {
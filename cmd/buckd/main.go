@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	logging "github.com/ipfs/go-log"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/textileio/go-threads/util"
@@ -51,6 +52,14 @@ var (
 				Key:      "addr.gateway.host",
 				DefValue: "/ip4/127.0.0.1/tcp/8006",
 			},
+			"addrGatewayTlsHost": {
+				Key:      "addr.gateway.tls_host",
+				DefValue: "",
+			},
+			"addrGatewayMetrics": {
+				Key:      "addr.gateway.metrics",
+				DefValue: "",
+			},
 			"addrGatewayUrl": {
 				Key:      "addr.gateway.url",
 				DefValue: "http://127.0.0.1:8006",
@@ -71,6 +80,74 @@ var (
 				Key:      "gateway.subdomains",
 				DefValue: false,
 			},
+			"gatewayTemplatesDir": {
+				Key:      "gateway.templates_dir",
+				DefValue: "",
+			},
+			"gatewayAcmeEmail": {
+				Key:      "gateway.acme_email",
+				DefValue: "",
+			},
+			"gatewayPreviews": {
+				Key:      "gateway.previews",
+				DefValue: false,
+			},
+			"gatewayAccessLog": {
+				Key:      "gateway.access_log",
+				DefValue: true,
+			},
+			"gatewayCacheSize": {
+				Key:      "gateway.cache_size",
+				DefValue: int64(64 << 20),
+			},
+			"gatewayCacheRedisAddr": {
+				Key:      "gateway.cache_redis_addr",
+				DefValue: "",
+			},
+			"bucketsThumbnails": {
+				Key:      "buckets.thumbnails",
+				DefValue: false,
+			},
+			"bucketsPinGC": {
+				Key:      "buckets.pin_gc",
+				DefValue: false,
+			},
+			"bucketsPinGCDryRun": {
+				Key:      "buckets.pin_gc_dry_run",
+				DefValue: false,
+			},
+			"bucketsLifecycles": {
+				Key:      "buckets.lifecycles",
+				DefValue: false,
+			},
+			"bucketsReplication": {
+				Key:      "buckets.replication",
+				DefValue: false,
+			},
+			"bucketsImports": {
+				Key:      "buckets.imports",
+				DefValue: false,
+			},
+			"bucketsPinMirrors": {
+				Key:      "buckets.pin_mirrors",
+				DefValue: false,
+			},
+			"bucketsDomains": {
+				Key:      "buckets.domains",
+				DefValue: false,
+			},
+			"bucketsWebhooks": {
+				Key:      "buckets.webhooks",
+				DefValue: false,
+			},
+			"bucketsSearchIndex": {
+				Key:      "buckets.search_index",
+				DefValue: false,
+			},
+			"bucketsAllowPrivateEndpoints": {
+				Key:      "buckets.allow_private_endpoints",
+				DefValue: false,
+			},
 			"dnsDomain": {
 				Key:      "dns.domain",
 				DefValue: "",
@@ -130,6 +207,14 @@ func init() {
 		"addrGatewayHost",
 		config.Flags["addrGatewayHost"].DefValue.(string),
 		"Local gateway host address")
+	rootCmd.PersistentFlags().String(
+		"addrGatewayTlsHost",
+		config.Flags["addrGatewayTlsHost"].DefValue.(string),
+		"Local gateway TLS host address for custom domains (requires the gateway host on port 80)")
+	rootCmd.PersistentFlags().String(
+		"addrGatewayMetrics",
+		config.Flags["addrGatewayMetrics"].DefValue.(string),
+		"Local gateway Prometheus metrics address")
 	rootCmd.PersistentFlags().String(
 		"addrGatewayUrl",
 		config.Flags["addrGatewayUrl"].DefValue.(string),
@@ -152,6 +237,76 @@ func init() {
 		"gatewaySubdomains",
 		config.Flags["gatewaySubdomains"].DefValue.(bool),
 		"Enable gateway namespace redirects to subdomains")
+	rootCmd.PersistentFlags().String(
+		"gatewayTemplatesDir",
+		config.Flags["gatewayTemplatesDir"].DefValue.(string),
+		"Directory of HTML templates that override the gateway defaults")
+	rootCmd.PersistentFlags().String(
+		"gatewayAcmeEmail",
+		config.Flags["gatewayAcmeEmail"].DefValue.(string),
+		"Contact email for custom domain TLS certificates")
+	rootCmd.PersistentFlags().Bool(
+		"gatewayPreviews",
+		config.Flags["gatewayPreviews"].DefValue.(bool),
+		"Render READMEs, Markdown, and source files when browsing buckets")
+	rootCmd.PersistentFlags().Bool(
+		"gatewayAccessLog",
+		config.Flags["gatewayAccessLog"].DefValue.(bool),
+		"Enable gateway access logs")
+	rootCmd.PersistentFlags().Int64(
+		"gatewayCacheSize",
+		config.Flags["gatewayCacheSize"].DefValue.(int64),
+		"Max size in bytes of the gateway response cache (0 disables)")
+	rootCmd.PersistentFlags().String(
+		"gatewayCacheRedisAddr",
+		config.Flags["gatewayCacheRedisAddr"].DefValue.(string),
+		"Redis address for the gateway response cache (overrides the in-process cache)")
+
+	// Bucket settings
+	rootCmd.PersistentFlags().Bool(
+		"bucketsThumbnails",
+		config.Flags["bucketsThumbnails"].DefValue.(bool),
+		"Enable generating thumbnails of images in public buckets")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsPinGC",
+		config.Flags["bucketsPinGC"].DefValue.(bool),
+		"Enable unpinning bucket roots that are no longer referenced")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsPinGCDryRun",
+		config.Flags["bucketsPinGCDryRun"].DefValue.(bool),
+		"Only log the bucket roots that pin garbage collection would unpin")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsLifecycles",
+		config.Flags["bucketsLifecycles"].DefValue.(bool),
+		"Enable applying bucket lifecycle rules")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsReplication",
+		config.Flags["bucketsReplication"].DefValue.(bool),
+		"Enable replicating buckets to remote hubs")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsImports",
+		config.Flags["bucketsImports"].DefValue.(bool),
+		"Enable running server-side bucket imports")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsPinMirrors",
+		config.Flags["bucketsPinMirrors"].DefValue.(bool),
+		"Enable pinning bucket roots on remote pinning services")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsDomains",
+		config.Flags["bucketsDomains"].DefValue.(bool),
+		"Enable verifying custom domains and syncing their DNSLink records")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsWebhooks",
+		config.Flags["bucketsWebhooks"].DefValue.(bool),
+		"Enable delivering bucket webhooks")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsSearchIndex",
+		config.Flags["bucketsSearchIndex"].DefValue.(bool),
+		"Enable maintaining bucket search indexes")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsAllowPrivateEndpoints",
+		config.Flags["bucketsAllowPrivateEndpoints"].DefValue.(bool),
+		"Allow webhooks, pin mirrors, and S3 imports to reach private network addresses (development only)")
 
 	// DNS settings
 	rootCmd.PersistentFlags().String(
@@ -204,6 +359,14 @@ var rootCmd = &cobra.Command{
 
 		addrGatewayHost := cmd.AddrFromStr(config.Viper.GetString("addr.gateway.host"))
 		addrGatewayUrl := config.Viper.GetString("addr.gateway.url")
+		var addrGatewayTlsHost ma.Multiaddr
+		if str := config.Viper.GetString("addr.gateway.tls_host"); str != "" {
+			addrGatewayTlsHost = cmd.AddrFromStr(str)
+		}
+		var addrGatewayMetrics ma.Multiaddr
+		if str := config.Viper.GetString("addr.gateway.metrics"); str != "" {
+			addrGatewayMetrics = cmd.AddrFromStr(str)
+		}
 
 		addrMongoUri := config.Viper.GetString("addr.mongo_uri")
 
@@ -221,19 +384,40 @@ var rootCmd = &cobra.Command{
 		textile, err := core.NewTextile(ctx, core.Config{
 			RepoPath: config.Viper.GetString("repo"),
 
-			AddrAPI:          addrApi,
-			AddrAPIProxy:     addrApiProxy,
-			AddrThreadsHost:  addrThreadsHost,
-			AddrIPFSAPI:      addrIpfsApi,
-			AddrGatewayHost:  addrGatewayHost,
-			AddrGatewayURL:   addrGatewayUrl,
-			AddrPowergateAPI: addrPowergateApi,
-			AddrMongoURI:     addrMongoUri,
+			AddrAPI:            addrApi,
+			AddrAPIProxy:       addrApiProxy,
+			AddrThreadsHost:    addrThreadsHost,
+			AddrIPFSAPI:        addrIpfsApi,
+			AddrGatewayHost:    addrGatewayHost,
+			AddrGatewayURL:     addrGatewayUrl,
+			AddrGatewayTLSHost: addrGatewayTlsHost,
+			AddrGatewayMetrics: addrGatewayMetrics,
+			AddrPowergateAPI:   addrPowergateApi,
+			AddrMongoURI:       addrMongoUri,
 
-			UseSubdomains: config.Viper.GetBool("gateway.subdomains"),
+			UseSubdomains:         config.Viper.GetBool("gateway.subdomains"),
+			GatewayTemplatesDir:   config.Viper.GetString("gateway.templates_dir"),
+			GatewayACMEEmail:      config.Viper.GetString("gateway.acme_email"),
+			GatewayPreviews:       config.Viper.GetBool("gateway.previews"),
+			GatewayAccessLog:      config.Viper.GetBool("gateway.access_log"),
+			GatewayCacheSize:      config.Viper.GetInt64("gateway.cache_size"),
+			GatewayCacheRedisAddr: config.Viper.GetString("gateway.cache_redis_addr"),
 
 			MongoName: "buckets",
 
+			BucketsThumbnails:  config.Viper.GetBool("buckets.thumbnails"),
+			BucketsPinGC:       config.Viper.GetBool("buckets.pin_gc"),
+			BucketsPinGCDryRun: config.Viper.GetBool("buckets.pin_gc_dry_run"),
+			BucketsLifecycles:  config.Viper.GetBool("buckets.lifecycles"),
+			BucketsReplication: config.Viper.GetBool("buckets.replication"),
+			BucketsImports:     config.Viper.GetBool("buckets.imports"),
+			BucketsPinMirrors:  config.Viper.GetBool("buckets.pin_mirrors"),
+			BucketsDomains:     config.Viper.GetBool("buckets.domains"),
+			BucketsWebhooks:    config.Viper.GetBool("buckets.webhooks"),
+			BucketsSearchIndex: config.Viper.GetBool("buckets.search_index"),
+
+			BucketsAllowPrivateEndpoints: config.Viper.GetBool("buckets.allow_private_endpoints"),
+
 			DNSDomain: dnsDomain,
 			DNSZoneID: dnsZoneID,
 			DNSToken:  dnsToken,
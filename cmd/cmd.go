@@ -85,17 +85,7 @@ type Clients struct {
 // NewClients returns a new clients object pointing to the target address.
 // If isHub is true, the hub's admin and user clients are also created.
 func NewClients(target string, isHub bool) *Clients {
-	var opts []grpc.DialOption
-	auth := common.Credentials{}
-	if strings.Contains(target, "443") {
-		creds := credentials.NewTLS(&tls.Config{})
-		opts = append(opts, grpc.WithTransportCredentials(creds))
-		auth.Secure = true
-	} else {
-		opts = append(opts, grpc.WithInsecure())
-	}
-	opts = append(opts, grpc.WithPerRPCCredentials(auth))
-
+	opts := DialOptions(target)
 	c := &Clients{}
 	var err error
 	c.Threads, err = tc.NewClient(target, opts...)
@@ -119,6 +109,21 @@ func NewClients(target string, isHub bool) *Clients {
 	return c
 }
 
+// DialOptions returns the gRPC dial options used to reach target.
+// TLS is used if target is on port 443.
+func DialOptions(target string) []grpc.DialOption {
+	var opts []grpc.DialOption
+	auth := common.Credentials{}
+	if strings.Contains(target, "443") {
+		creds := credentials.NewTLS(&tls.Config{})
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+		auth.Secure = true
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	return append(opts, grpc.WithPerRPCCredentials(auth), common.WithRetry())
+}
+
 // Close closes all the clients.
 func (c *Clients) Close() {
 	if c.Threads != nil {
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/logrusorgru/aurora"
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	pb "github.com/textileio/textile/api/hub/pb"
+	"github.com/textileio/textile/cmd"
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply [spec]",
+	Short: "Apply a declarative spec",
+	Long: `Reconciles your API keys, orgs, org members, and org API keys with a JSON spec file.
+
+The changes needed to reconcile are shown before they are applied. Applying the same spec more than once is safe.
+Orgs are never removed. Use the '--prune' flag to also invalidate keys and remove org members not declared in the spec.
+
+Example spec:
+{
+  "keys": [{"type": "ACCOUNT", "secure": true}],
+  "orgs": [{
+    "name": "my-org",
+    "members": ["jane@example.com", "john"],
+    "keys": [{"type": "USER"}]
+  }]
+}
+`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+
+		f, err := os.Open(args[0])
+		cmd.ErrCheck(err)
+		defer f.Close()
+		spec := &pb.Spec{}
+		err = jsonpb.Unmarshal(f, spec)
+		cmd.ErrCheck(err)
+		prune, err := c.Flags().GetBool("prune")
+		cmd.ErrCheck(err)
+
+		plan, err := clients.Hub.PlanSpec(ctx, spec, prune)
+		cmd.ErrCheck(err)
+		if len(plan.Changes) == 0 {
+			cmd.End("Everything is up to date")
+		}
+		renderChanges(plan.Changes)
+
+		yes, err := c.Flags().GetBool("yes")
+		cmd.ErrCheck(err)
+		if !yes {
+			prompt := promptui.Prompt{
+				Label:     "Apply these changes",
+				IsConfirm: true,
+			}
+			if _, err := prompt.Run(); err != nil {
+				cmd.End("")
+			}
+		}
+
+		res, err := clients.Hub.ApplySpec(ctx, spec, prune)
+		cmd.ErrCheck(err)
+		renderChanges(res.Changes)
+		cmd.Success("Applied %d changes", aurora.White(len(res.Changes)).Bold())
+	},
+}
+
+func renderChanges(changes []*pb.ApplySpecReply_Change) {
+	data := make([][]string, len(changes))
+	for i, c := range changes {
+		data[i] = []string{strings.ToLower(c.Action.String()), c.Resource, c.ID, c.Org, c.Detail}
+	}
+	cmd.RenderTable([]string{"action", "resource", "id", "org", "detail"}, data)
+}
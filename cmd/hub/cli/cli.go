@@ -46,13 +46,17 @@ func Init(rootCmd *cobra.Command) {
 	config.Viper.SetConfigType("yaml")
 
 	rootCmd.AddCommand(initCmd, loginCmd, logoutCmd, whoamiCmd, destroyCmd)
-	rootCmd.AddCommand(orgsCmd, keysCmd, threadsCmd)
+	rootCmd.AddCommand(orgsCmd, keysCmd, threadsCmd, usageCmd, applyCmd)
 	orgsCmd.AddCommand(orgsCreateCmd, orgsLsCmd, orgsMembersCmd, orgsInviteCmd, orgsLeaveCmd, orgsDestroyCmd)
-	keysCmd.AddCommand(keysCreateCmd, keysInvalidateCmd, keysLsCmd)
-	threadsCmd.AddCommand(threadsLsCmd)
+	keysCmd.AddCommand(keysCreateCmd, keysInvalidateCmd, keysQuotaCmd, keysLsCmd)
+	threadsCmd.AddCommand(threadsLsCmd, threadsTagCmd)
 	rootCmd.AddCommand(bucketCmd)
 	buck.Init(bucketCmd)
 
+	usageCmd.Flags().String("group-by", "", "Tag key used to group bucket usage")
+	applyCmd.Flags().Bool("prune", false, "Invalidates keys and removes org members not declared in the spec if true")
+	applyCmd.Flags().BoolP("yes", "y", false, "Skips the confirmation prompt if true")
+
 	rootCmd.PersistentFlags().String(
 		"api",
 		config.Flags["api"].DefValue.(string),
@@ -70,6 +74,8 @@ func Init(rootCmd *cobra.Command) {
 		config.Flags["org"].DefValue.(string),
 		"Org username")
 
+	cmd.AddJSONFlag(rootCmd)
+
 	err := cmd.BindFlags(config.Viper, rootCmd, config.Flags)
 	cmd.ErrCheck(err)
 }
@@ -89,6 +89,34 @@ var keysInvalidateCmd = &cobra.Command{
 	},
 }
 
+var keysQuotaCmd = &cobra.Command{
+	Use:   "quota [bytes]",
+	Short: "Set the per-user storage quota of a user group key",
+	Long: `Sets the maximum total size of buckets, in bytes, that each user of a user group key can store.
+
+Users are still limited by the account's storage quota. Use 0 to remove the per-user quota.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		size, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil || size < 0 {
+			cmd.Fatal(fmt.Errorf("invalid size: %s", args[0]))
+		}
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+
+		selected := selectKey(ctx, "Set quota of key", aurora.Sprintf(
+			aurora.BrightBlack("> Setting quota of key {{ .Key | white | bold }}")))
+
+		err = clients.Hub.SetKeyUserQuota(ctx, selected.Key, size)
+		cmd.ErrCheck(err)
+		if size == 0 {
+			cmd.Success("Removed per-user quota of key %s", aurora.White(selected.Key).Bold())
+		} else {
+			cmd.Success("Set per-user quota of key %s to %d bytes", aurora.White(selected.Key).Bold(), size)
+		}
+	},
+}
+
 var keysLsCmd = &cobra.Command{
 	Use: "ls",
 	Aliases: []string{
@@ -103,13 +131,17 @@ var keysLsCmd = &cobra.Command{
 
 		list, err := clients.Hub.ListKeys(ctx)
 		cmd.ErrCheck(err)
-		if len(list.List) > 0 {
+		if len(list.List) > 0 || cmd.JSONOutput() {
 			data := make([][]string, len(list.List))
 			for i, k := range list.List {
 				secure := strconv.FormatBool(k.Secure)
-				data[i] = []string{k.Key, k.Secret, keyTypeToString(k.Type), secure, strconv.FormatBool(k.Valid), strconv.Itoa(int(k.Threads))}
+				quota := "-"
+				if k.UserBucketsMaxSize > 0 {
+					quota = strconv.FormatInt(k.UserBucketsMaxSize, 10)
+				}
+				data[i] = []string{k.Key, k.Secret, keyTypeToString(k.Type), secure, strconv.FormatBool(k.Valid), strconv.Itoa(int(k.Threads)), quota}
 			}
-			cmd.RenderTable([]string{"key", "secret", "type", "secure", "valid", "threads"}, data)
+			cmd.RenderTable([]string{"key", "secret", "type", "secure", "valid", "threads", "user quota"}, data)
 		}
 		cmd.Message("Found %d keys", aurora.White(len(list.List)).Bold())
 	},
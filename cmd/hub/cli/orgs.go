@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"net/mail"
 	"strconv"
+	"strings"
 
 	"github.com/logrusorgru/aurora"
 	"github.com/manifoldco/promptui"
 	mbase "github.com/multiformats/go-multibase"
 	"github.com/spf13/cobra"
 	"github.com/textileio/textile/api/common"
+	pb "github.com/textileio/textile/api/hub/pb"
 	"github.com/textileio/textile/cmd"
 )
 
@@ -81,7 +83,7 @@ var orgsLsCmd = &cobra.Command{
 
 		orgs, err := clients.Hub.ListOrgs(ctx)
 		cmd.ErrCheck(err)
-		if len(orgs.List) > 0 {
+		if len(orgs.List) > 0 || cmd.JSONOutput() {
 			data := make([][]string, len(orgs.List))
 			for i, o := range orgs.List {
 				key, err := mbase.Encode(mbase.Base32, o.Key)
@@ -123,10 +125,13 @@ var orgsMembersCmd = &cobra.Command{
 }
 
 var orgsInviteCmd = &cobra.Command{
-	Use:   "invite",
+	Use:   "invite [email|username]...",
 	Short: "Invite members to an org",
-	Long:  `Invites a new member to an organization.`,
-	Args:  cobra.ExactArgs(0),
+	Long: `Invites new members to an organization.
+
+Multiple members may be invited at once by passing email addresses or usernames as arguments.
+If no arguments are given, you will be prompted for an email address.`,
+	Args: cobra.ArbitraryArgs,
 	Run: func(c *cobra.Command, args []string) {
 		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
 		defer cancel()
@@ -134,6 +139,23 @@ var orgsInviteCmd = &cobra.Command{
 			aurora.BrightBlack("> Selected org {{ .Name | white | bold }}")))
 		ctx = common.NewOrgSlugContext(ctx, selected.Slug)
 
+		if len(args) > 0 {
+			res, err := clients.Hub.InviteManyToOrg(ctx, args)
+			cmd.ErrCheck(err)
+			var sent int
+			data := make([][]string, len(res.Results))
+			for i, r := range res.Results {
+				if r.Status == pb.InviteManyToOrgReply_Result_SENT {
+					sent++
+				}
+				data[i] = []string{r.Invitee, strings.ToLower(r.Status.String()), r.Message}
+			}
+			cmd.RenderTable([]string{"invitee", "status", "message"}, data)
+			cmd.Success("We sent %d invitations to the %s org", aurora.White(sent).Bold(),
+				aurora.White(selected.Name).Bold())
+			return
+		}
+
 		prompt := promptui.Prompt{
 			Label: "Enter email to invite",
 			Validate: func(email string) error {
@@ -2,9 +2,12 @@ package cli
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/logrusorgru/aurora"
 	"github.com/spf13/cobra"
+	"github.com/textileio/go-threads/core/thread"
 	"github.com/textileio/textile/cmd"
 )
 
@@ -31,7 +34,7 @@ var threadsLsCmd = &cobra.Command{
 		defer cancel()
 
 		threads := clients.ListThreads(ctx, false)
-		if len(threads) > 0 {
+		if len(threads) > 0 || cmd.JSONOutput() {
 			data := make([][]string, len(threads))
 			for i, t := range threads {
 				data[i] = []string{t.ID.String(), t.Name, t.Type}
@@ -41,3 +44,37 @@ var threadsLsCmd = &cobra.Command{
 		cmd.Message("Found %d threads", aurora.White(len(threads)).Bold())
 	},
 }
+
+var threadsTagCmd = &cobra.Command{
+	Use:   "tag [thread-id] [key=value]...",
+	Short: "Tag a thread",
+	Long: `Replaces the tags of a thread with the given key/value pairs.
+Omitting all key/value pairs removes the thread's tags.
+
+Thread tags are inherited by the thread's buckets and can be used to group storage usage (see 'hub usage --group-by').`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+
+		id, err := thread.Decode(args[0])
+		cmd.ErrCheck(err)
+		tags, err := parseTags(args[1:])
+		cmd.ErrCheck(err)
+		err = clients.Users.SetThreadTags(ctx, id, tags)
+		cmd.ErrCheck(err)
+		cmd.Success("Tagged thread %s", aurora.White(id).Bold())
+	},
+}
+
+func parseTags(args []string) (map[string]string, error) {
+	tags := make(map[string]string)
+	for _, a := range args {
+		parts := strings.SplitN(a, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid tag %s (use key=value)", a)
+		}
+		tags[parts[0]] = parts[1]
+	}
+	return tags, nil
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var usageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Show storage usage",
+	Long: `Shows storage usage for your threads and buckets.
+
+Use the '--group-by' flag to aggregate bucket usage by the value of a tag key.
+This can be used to allocate storage costs to teams or projects.
+
+Identical files pushed to more than one bucket path are only stored once.
+The bytes saved by deduplication are shown below the total.
+
+Using the '--org' flag will show usage for the Organization's account.
+`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+
+		groupBy, err := c.Flags().GetString("group-by")
+		cmd.ErrCheck(err)
+		report, err := clients.Hub.GetUsageReport(ctx, groupBy)
+		cmd.ErrCheck(err)
+
+		if len(report.Resources) > 0 {
+			data := make([][]string, len(report.Resources))
+			for i, r := range report.Resources {
+				data[i] = []string{r.Type, r.ID, r.Name, strconv.FormatInt(r.Size, 10), formatTags(r.Tags)}
+			}
+			cmd.RenderTable([]string{"type", "id", "name", "size", "tags"}, data)
+		}
+		if len(report.Groups) > 0 {
+			data := make([][]string, len(report.Groups))
+			for i, g := range report.Groups {
+				value := g.Value
+				if value == "" {
+					value = "untagged"
+				}
+				data[i] = []string{value, strconv.FormatInt(g.Count, 10), strconv.FormatInt(g.Size, 10)}
+			}
+			cmd.RenderTable([]string{groupBy, "buckets", "size"}, data)
+		}
+		cmd.Message("Total size: %d bytes", aurora.White(report.TotalSize).Bold())
+		if report.Dedup != nil && report.Dedup.SavedSize > 0 {
+			cmd.Message("Deduplicated: %d bytes saved across %d files",
+				aurora.White(report.Dedup.SavedSize).Bold(), aurora.White(report.Dedup.Refs).Bold())
+		}
+	},
+}
+
+func formatTags(tags map[string]string) string {
+	list := make([]string, 0, len(tags))
+	for k, v := range tags {
+		list = append(list, k+"="+v)
+	}
+	sort.Strings(list)
+	return strings.Join(list, ",")
+}
@@ -21,6 +21,10 @@ var whoamiCmd = &cobra.Command{
 		cmd.ErrCheck(err)
 		key, err := mbase.Encode(mbase.Base32, who.Key)
 		cmd.ErrCheck(err)
+		if cmd.JSONOutput() {
+			cmd.JSON(map[string]string{"username": who.Username, "email": who.Email, "key": key})
+			return
+		}
 		cmd.Message("You are %s", aurora.White(who.Username).Bold())
 		cmd.Message("Your key is %s", aurora.White(key).Bold())
 	},
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var accountsCmd = &cobra.Command{
+	Use:     "accounts",
+	Aliases: []string{"account"},
+	Short:   "Accounts",
+	Long:    `Inspects hub accounts.`,
+	Args:    cobra.ExactArgs(0),
+}
+
+var accountsLargestCmd = &cobra.Command{
+	Use:   "largest",
+	Short: "List largest accounts",
+	Long:  `Lists the accounts with the largest buckets total size.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		limit, err := c.Flags().GetInt64("limit")
+		cmd.ErrCheck(err)
+		res, err := client.ListLargestAccounts(ctx, limit)
+		cmd.ErrCheck(err)
+		if len(res.Accounts) == 0 {
+			cmd.End("No accounts found")
+		}
+		data := make([][]string, len(res.Accounts))
+		for i, a := range res.Accounts {
+			data[i] = []string{a.Username, a.Type, strconv.FormatInt(a.BucketsTotalSize, 10)}
+		}
+		cmd.RenderTable([]string{"username", "type", "buckets total size"}, data)
+	},
+}
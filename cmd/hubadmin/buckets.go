@@ -0,0 +1,37 @@
+package main
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var bucketsCmd = &cobra.Command{
+	Use:     "buckets",
+	Aliases: []string{"bucket"},
+	Short:   "Buckets",
+	Long:    `Manages account buckets.`,
+	Args:    cobra.ExactArgs(0),
+}
+
+var bucketsQuotaCmd = &cobra.Command{
+	Use:   "quota [username] [thread] [key] [bytes]",
+	Short: "Set the max size of a bucket",
+	Long: `Sets the max size of a bucket in bytes. Pushes that would exceed the max size are rejected.
+
+A max size of 0 removes the bucket quota. The hub's max bucket size always applies.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(c *cobra.Command, args []string) {
+		maxSize, err := strconv.ParseInt(args[3], 10, 64)
+		if err != nil {
+			cmd.Fatal(err)
+		}
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		err = client.SetBucketQuota(ctx, args[0], args[1], args[2], maxSize)
+		cmd.ErrCheck(err)
+		cmd.Success("Set max size of bucket %s to %d bytes", args[2], maxSize)
+	},
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	ac "github.com/textileio/textile/api/admin/client"
+	"github.com/textileio/textile/api/common"
+	"github.com/textileio/textile/cmd"
+)
+
+const Name = "hubadmin"
+
+var (
+	config = &cmd.Config{
+		Viper: viper.New(),
+		Dir:   ".textile",
+		Name:  "admin",
+		Flags: map[string]cmd.Flag{
+			"api": {
+				Key:      "api",
+				DefValue: "127.0.0.1:3006",
+			},
+			"token": {
+				Key:      "token",
+				DefValue: "",
+			},
+		},
+		EnvPre: strings.ToUpper(Name),
+		Global: true,
+	}
+
+	client *ac.Client
+)
+
+func init() {
+	cobra.OnInitialize(cmd.InitConfig(config))
+	config.Viper.SetConfigType("yaml")
+
+	rootCmd.AddCommand(migrationsCmd, usageCmd, pinsCmd, accountsCmd, bucketsCmd, tokenCmd)
+	migrationsCmd.AddCommand(migrationsLsCmd, migrationsRunCmd)
+	usageCmd.AddCommand(usageRebuildCmd)
+	pinsCmd.AddCommand(pinsAuditCmd)
+	accountsCmd.AddCommand(accountsLargestCmd)
+	bucketsCmd.AddCommand(bucketsQuotaCmd)
+	tokenCmd.AddCommand(tokenRotateCmd)
+
+	usageRebuildCmd.Flags().String("username", "", "Only rebuild the usage of this account")
+	usageRebuildCmd.Flags().Bool("dry-run", false, "Shows the rebuilt usage without saving it if true")
+	pinsAuditCmd.Flags().String("username", "", "Only audit the buckets of this account")
+	pinsAuditCmd.Flags().Bool("repair", false, "Pins missing public bucket roots if true")
+	accountsLargestCmd.Flags().Int64("limit", 10, "Max number of accounts to list")
+
+	rootCmd.PersistentFlags().String(
+		"api",
+		config.Flags["api"].DefValue.(string),
+		"Hub API target")
+
+	rootCmd.PersistentFlags().StringP(
+		"token",
+		"t",
+		config.Flags["token"].DefValue.(string),
+		"Admin API token")
+
+	err := cmd.BindFlags(config.Viper, rootCmd, config.Flags)
+	cmd.ErrCheck(err)
+}
+
+func main() {
+	cmd.ErrCheck(rootCmd.Execute())
+}
+
+var rootCmd = &cobra.Command{
+	Use:   Name,
+	Short: "Hub Admin Client",
+	Long: `The Hub Admin Client.
+
+Runs administration tasks against a hub deployment's admin API.
+The hub daemon must be started with an admin token (see hubd --adminToken).`,
+	PersistentPreRun: func(c *cobra.Command, args []string) {
+		cmd.ExpandConfigVars(config.Viper, config.Flags)
+
+		if config.Viper.GetString("token") == "" {
+			cmd.Fatal(errors.New("unauthorized! use `%s` to authorize"), aurora.Cyan("--token"))
+		}
+
+		var err error
+		client, err = ac.NewClient(config.Viper.GetString("api"), cmd.DialOptions(config.Viper.GetString("api"))...)
+		cmd.ErrCheck(err)
+	},
+	PersistentPostRun: func(c *cobra.Command, args []string) {
+		cmd.ErrCheck(client.Close())
+	},
+	Args: cobra.ExactArgs(0),
+}
+
+// Auth returns a context with the admin token.
+func Auth(ctx context.Context) context.Context {
+	return common.NewAdminTokenContext(ctx, config.Viper.GetString("token"))
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var migrationsCmd = &cobra.Command{
+	Use:     "migrations",
+	Aliases: []string{"migration"},
+	Short:   "Database migrations",
+	Long:    `Lists and runs database migrations.`,
+	Args:    cobra.ExactArgs(0),
+}
+
+var migrationsLsCmd = &cobra.Command{
+	Use:     "ls",
+	Aliases: []string{"list"},
+	Short:   "List migrations",
+	Long:    `Lists all database migrations and whether or not they've been applied.`,
+	Args:    cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		list, err := client.ListMigrations(ctx)
+		cmd.ErrCheck(err)
+		data := make([][]string, len(list.Migrations))
+		for i, m := range list.Migrations {
+			var at string
+			if m.Applied {
+				at = time.Unix(m.AppliedAt, 0).Format(time.RFC3339)
+			}
+			data[i] = []string{strconv.FormatInt(m.Version, 10), m.Name, strconv.FormatBool(m.Applied), at}
+		}
+		cmd.RenderTable([]string{"version", "name", "applied", "applied at"}, data)
+	},
+}
+
+var migrationsRunCmd = &cobra.Command{
+	Use:   "run",
+	Short: "Run pending migrations",
+	Long:  `Applies all pending database migrations in order.`,
+	Args:  cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		res, err := client.RunMigrations(ctx)
+		cmd.ErrCheck(err)
+		if len(res.Applied) == 0 {
+			cmd.End("Database is up to date")
+		}
+		for _, name := range res.Applied {
+			cmd.Message("Applied %s", aurora.White(name).Bold())
+		}
+		cmd.Success("Applied %d migrations", aurora.White(len(res.Applied)).Bold())
+	},
+}
@@ -0,0 +1,58 @@
+package main
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var pinsCmd = &cobra.Command{
+	Use:     "pins",
+	Aliases: []string{"pin"},
+	Short:   "Bucket pins",
+	Long:    `Audits bucket pins.`,
+	Args:    cobra.ExactArgs(0),
+}
+
+var pinsAuditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Audit bucket pins",
+	Long: `Checks that the root of every bucket is pinned.
+
+Use the '--username' flag to only audit the buckets of a single account.
+Use the '--repair' flag to pin missing public bucket roots.
+Private buckets are reported but must be repaired by pushing to the bucket.
+`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		username, err := c.Flags().GetString("username")
+		cmd.ErrCheck(err)
+		repair, err := c.Flags().GetBool("repair")
+		cmd.ErrCheck(err)
+		res, err := client.AuditPins(ctx, username, repair)
+		cmd.ErrCheck(err)
+
+		if len(res.Unpinned) == 0 {
+			cmd.End("All %d buckets are pinned", aurora.White(res.Checked).Bold())
+		}
+		data := make([][]string, len(res.Unpinned))
+		for i, b := range res.Unpinned {
+			data[i] = []string{
+				b.Username,
+				b.Thread,
+				b.Key,
+				b.Path,
+				strconv.FormatBool(b.Private),
+				strconv.FormatBool(b.Repaired),
+			}
+		}
+		cmd.RenderTable([]string{"username", "thread", "key", "path", "private", "repaired"}, data)
+		cmd.Warn("Found %d unpinned of %d buckets",
+			aurora.White(len(res.Unpinned)).Bold(), aurora.White(res.Checked).Bold())
+	},
+}
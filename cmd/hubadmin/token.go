@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Admin token",
+	Long:  `Manages the admin API token.`,
+	Args:  cobra.ExactArgs(0),
+}
+
+var tokenRotateCmd = &cobra.Command{
+	Use:   "rotate",
+	Short: "Rotate the admin token",
+	Long: `Replaces the admin API token with a new random token.
+
+The old token stops working immediately on all hub daemons, including after restarts.
+Once rotated, the token in the hub daemon's config (see hubd --adminToken) is no longer accepted.
+Other deployment secrets are not rotated; change them in the hub daemon's config.
+`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		token, err := client.RotateToken(ctx)
+		cmd.ErrCheck(err)
+		cmd.Success("Your new admin token is %s", aurora.White(token).Bold())
+	},
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/logrusorgru/aurora"
+	"github.com/spf13/cobra"
+	"github.com/textileio/textile/cmd"
+)
+
+var usageCmd = &cobra.Command{
+	Use:   "usage",
+	Short: "Account storage usage",
+	Long:  `Manages account storage usage aggregates.`,
+	Args:  cobra.ExactArgs(0),
+}
+
+var usageRebuildCmd = &cobra.Command{
+	Use:   "rebuild",
+	Short: "Rebuild usage aggregates",
+	Long: `Recalculates the buckets total size of accounts from their buckets and snapshots.
+
+Use the '--username' flag to only rebuild a single account.
+Use the '--dry-run' flag to show the results without saving them.
+`,
+	Args: cobra.ExactArgs(0),
+	Run: func(c *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(Auth(context.Background()), cmd.Timeout)
+		defer cancel()
+		username, err := c.Flags().GetString("username")
+		cmd.ErrCheck(err)
+		dryRun, err := c.Flags().GetBool("dry-run")
+		cmd.ErrCheck(err)
+		res, err := client.RebuildUsage(ctx, username, dryRun)
+		cmd.ErrCheck(err)
+
+		var changed int
+		data := make([][]string, 0, len(res.Accounts))
+		for _, a := range res.Accounts {
+			if a.Size == a.PreviousSize {
+				continue
+			}
+			changed++
+			data = append(data, []string{
+				a.Username,
+				strconv.FormatInt(a.PreviousSize, 10),
+				strconv.FormatInt(a.Size, 10),
+			})
+		}
+		if len(data) > 0 {
+			cmd.RenderTable([]string{"username", "previous size", "size"}, data)
+		}
+		if dryRun {
+			cmd.Message("Found %d of %d accounts with stale usage (dry run)",
+				aurora.White(changed).Bold(), aurora.White(len(res.Accounts)).Bold())
+		} else {
+			cmd.Success("Updated %d of %d accounts",
+				aurora.White(changed).Bold(), aurora.White(len(res.Accounts)).Bold())
+		}
+	},
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	logging "github.com/ipfs/go-log"
+	ma "github.com/multiformats/go-multiaddr"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/textileio/go-threads/util"
@@ -51,6 +52,14 @@ var (
 				Key:      "addr.gateway.host",
 				DefValue: "/ip4/127.0.0.1/tcp/8006",
 			},
+			"addrGatewayTlsHost": {
+				Key:      "addr.gateway.tls_host",
+				DefValue: "",
+			},
+			"addrGatewayMetrics": {
+				Key:      "addr.gateway.metrics",
+				DefValue: "",
+			},
 			"addrGatewayUrl": {
 				Key:      "addr.gateway.url",
 				DefValue: "http://127.0.0.1:8006",
@@ -71,6 +80,30 @@ var (
 				Key:      "gateway.subdomains",
 				DefValue: false,
 			},
+			"gatewayTemplatesDir": {
+				Key:      "gateway.templates_dir",
+				DefValue: "",
+			},
+			"gatewayAcmeEmail": {
+				Key:      "gateway.acme_email",
+				DefValue: "",
+			},
+			"gatewayPreviews": {
+				Key:      "gateway.previews",
+				DefValue: false,
+			},
+			"gatewayAccessLog": {
+				Key:      "gateway.access_log",
+				DefValue: true,
+			},
+			"gatewayCacheSize": {
+				Key:      "gateway.cache_size",
+				DefValue: int64(64 << 20),
+			},
+			"gatewayCacheRedisAddr": {
+				Key:      "gateway.cache_redis_addr",
+				DefValue: "",
+			},
 			"dnsDomain": {
 				Key:      "dns.domain",
 				DefValue: "",
@@ -99,6 +132,10 @@ var (
 				Key:      "email.session_secret",
 				DefValue: "",
 			},
+			"adminToken": {
+				Key:      "admin.token",
+				DefValue: "",
+			},
 			"bucketsMaxSize": {
 				Key:      "buckets.max_size",
 				DefValue: int64(1073741824),
@@ -111,10 +148,66 @@ var (
 				Key:      "buckets.max_number_per_thread",
 				DefValue: 10000,
 			},
+			"bucketsThumbnails": {
+				Key:      "buckets.thumbnails",
+				DefValue: false,
+			},
+			"bucketsPinGC": {
+				Key:      "buckets.pin_gc",
+				DefValue: false,
+			},
+			"bucketsPinGCDryRun": {
+				Key:      "buckets.pin_gc_dry_run",
+				DefValue: false,
+			},
+			"bucketsArchiveSchedules": {
+				Key:      "buckets.archive_schedules",
+				DefValue: true,
+			},
+			"bucketsArchiveRenewals": {
+				Key:      "buckets.archive_renewals",
+				DefValue: true,
+			},
+			"bucketsLifecycles": {
+				Key:      "buckets.lifecycles",
+				DefValue: true,
+			},
+			"bucketsReplication": {
+				Key:      "buckets.replication",
+				DefValue: true,
+			},
+			"bucketsImports": {
+				Key:      "buckets.imports",
+				DefValue: true,
+			},
+			"bucketsPinMirrors": {
+				Key:      "buckets.pin_mirrors",
+				DefValue: true,
+			},
+			"bucketsDomains": {
+				Key:      "buckets.domains",
+				DefValue: true,
+			},
+			"bucketsWebhooks": {
+				Key:      "buckets.webhooks",
+				DefValue: true,
+			},
+			"bucketsSearchIndex": {
+				Key:      "buckets.search_index",
+				DefValue: true,
+			},
+			"bucketsAllowPrivateEndpoints": {
+				Key:      "buckets.allow_private_endpoints",
+				DefValue: false,
+			},
 			"threadsMaxNumberPerOwner": {
 				Key:      "threads.max_number_per_owner",
 				DefValue: 100,
 			},
+			"threadsMaxNumberPerKey": {
+				Key:      "threads.max_number_per_key",
+				DefValue: 0,
+			},
 		},
 		EnvPre: "HUB",
 		Global: true,
@@ -162,6 +255,14 @@ func init() {
 		"addrGatewayHost",
 		config.Flags["addrGatewayHost"].DefValue.(string),
 		"Local gateway host address")
+	rootCmd.PersistentFlags().String(
+		"addrGatewayTlsHost",
+		config.Flags["addrGatewayTlsHost"].DefValue.(string),
+		"Local gateway TLS host address for custom domains (requires the gateway host on port 80)")
+	rootCmd.PersistentFlags().String(
+		"addrGatewayMetrics",
+		config.Flags["addrGatewayMetrics"].DefValue.(string),
+		"Local gateway Prometheus metrics address")
 	rootCmd.PersistentFlags().String(
 		"addrGatewayUrl",
 		config.Flags["addrGatewayUrl"].DefValue.(string),
@@ -184,6 +285,30 @@ func init() {
 		"gatewaySubdomains",
 		config.Flags["gatewaySubdomains"].DefValue.(bool),
 		"Enable gateway namespace redirects to subdomains")
+	rootCmd.PersistentFlags().String(
+		"gatewayTemplatesDir",
+		config.Flags["gatewayTemplatesDir"].DefValue.(string),
+		"Directory of HTML templates that override the gateway defaults")
+	rootCmd.PersistentFlags().String(
+		"gatewayAcmeEmail",
+		config.Flags["gatewayAcmeEmail"].DefValue.(string),
+		"Contact email for custom domain TLS certificates")
+	rootCmd.PersistentFlags().Bool(
+		"gatewayPreviews",
+		config.Flags["gatewayPreviews"].DefValue.(bool),
+		"Render READMEs, Markdown, and source files when browsing buckets")
+	rootCmd.PersistentFlags().Bool(
+		"gatewayAccessLog",
+		config.Flags["gatewayAccessLog"].DefValue.(bool),
+		"Enable gateway access logs")
+	rootCmd.PersistentFlags().Int64(
+		"gatewayCacheSize",
+		config.Flags["gatewayCacheSize"].DefValue.(int64),
+		"Max size in bytes of the gateway response cache (0 disables)")
+	rootCmd.PersistentFlags().String(
+		"gatewayCacheRedisAddr",
+		config.Flags["gatewayCacheRedisAddr"].DefValue.(string),
+		"Redis address for the gateway response cache (overrides the in-process cache)")
 
 	// DNS settings
 	rootCmd.PersistentFlags().String(
@@ -217,6 +342,12 @@ func init() {
 		config.Flags["emailSessionSecret"].DefValue.(string),
 		"Session secret to use when testing email APIs")
 
+	// Admin settings
+	rootCmd.PersistentFlags().String(
+		"adminToken",
+		config.Flags["adminToken"].DefValue.(string),
+		"Token used to authorize the admin API; the admin API is disabled if empty")
+
 	// Bucket settings
 	rootCmd.PersistentFlags().Int64(
 		"bucketsMaxSize",
@@ -230,12 +361,68 @@ func init() {
 		"bucketsMaxNumberPerThread",
 		config.Flags["bucketsMaxNumberPerThread"].DefValue.(int),
 		"Max number of buckets per thread")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsThumbnails",
+		config.Flags["bucketsThumbnails"].DefValue.(bool),
+		"Enable generating thumbnails of images in public buckets")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsPinGC",
+		config.Flags["bucketsPinGC"].DefValue.(bool),
+		"Enable unpinning bucket roots that are no longer referenced")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsPinGCDryRun",
+		config.Flags["bucketsPinGCDryRun"].DefValue.(bool),
+		"Only log the bucket roots that pin garbage collection would unpin")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsArchiveSchedules",
+		config.Flags["bucketsArchiveSchedules"].DefValue.(bool),
+		"Enable running scheduled bucket archives")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsArchiveRenewals",
+		config.Flags["bucketsArchiveRenewals"].DefValue.(bool),
+		"Enable watching bucket archive deals for renewal")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsLifecycles",
+		config.Flags["bucketsLifecycles"].DefValue.(bool),
+		"Enable applying bucket lifecycle rules")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsReplication",
+		config.Flags["bucketsReplication"].DefValue.(bool),
+		"Enable replicating buckets to remote hubs")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsImports",
+		config.Flags["bucketsImports"].DefValue.(bool),
+		"Enable running server-side bucket imports")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsPinMirrors",
+		config.Flags["bucketsPinMirrors"].DefValue.(bool),
+		"Enable pinning bucket roots on remote pinning services")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsDomains",
+		config.Flags["bucketsDomains"].DefValue.(bool),
+		"Enable verifying custom domains and syncing their DNSLink records")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsWebhooks",
+		config.Flags["bucketsWebhooks"].DefValue.(bool),
+		"Enable delivering bucket webhooks")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsSearchIndex",
+		config.Flags["bucketsSearchIndex"].DefValue.(bool),
+		"Enable maintaining bucket search indexes")
+	rootCmd.PersistentFlags().Bool(
+		"bucketsAllowPrivateEndpoints",
+		config.Flags["bucketsAllowPrivateEndpoints"].DefValue.(bool),
+		"Allow webhooks, pin mirrors, and S3 imports to reach private network addresses (development only)")
 
 	// Thread settings
 	rootCmd.PersistentFlags().Int(
 		"threadsMaxNumberPerOwner",
 		config.Flags["threadsMaxNumberPerOwner"].DefValue.(int),
 		"Max number threads per owner")
+	rootCmd.PersistentFlags().Int(
+		"threadsMaxNumberPerKey",
+		config.Flags["threadsMaxNumberPerKey"].DefValue.(int),
+		"Max number threads created with an API key (0 for no limit)")
 
 	err := cmd.BindFlags(config.Viper, rootCmd, config.Flags)
 	cmd.ErrCheck(err)
@@ -274,6 +461,14 @@ var rootCmd = &cobra.Command{
 
 		addrGatewayHost := cmd.AddrFromStr(config.Viper.GetString("addr.gateway.host"))
 		addrGatewayUrl := config.Viper.GetString("addr.gateway.url")
+		var addrGatewayTlsHost ma.Multiaddr
+		if str := config.Viper.GetString("addr.gateway.tls_host"); str != "" {
+			addrGatewayTlsHost = cmd.AddrFromStr(str)
+		}
+		var addrGatewayMetrics ma.Multiaddr
+		if str := config.Viper.GetString("addr.gateway.metrics"); str != "" {
+			addrGatewayMetrics = cmd.AddrFromStr(str)
+		}
 
 		addrMongoUri := config.Viper.GetString("addr.mongo_uri")
 
@@ -286,11 +481,27 @@ var rootCmd = &cobra.Command{
 		emailApiKey := config.Viper.GetString("email.api_key")
 		emailSessionSecret := config.Viper.GetString("email.session_secret")
 
+		adminToken := config.Viper.GetString("admin.token")
+
 		bucketsMaxSize := config.Viper.GetInt64("buckets.max_size")
 		bucketsTotalMaxSize := config.Viper.GetInt64("buckets.total_max_size")
 		bucketsMaxNumberPerThread := config.Viper.GetInt("buckets.max_number_per_thread")
+		bucketsThumbnails := config.Viper.GetBool("buckets.thumbnails")
+		bucketsPinGC := config.Viper.GetBool("buckets.pin_gc")
+		bucketsPinGCDryRun := config.Viper.GetBool("buckets.pin_gc_dry_run")
+		bucketsArchiveSchedules := config.Viper.GetBool("buckets.archive_schedules")
+		bucketsArchiveRenewals := config.Viper.GetBool("buckets.archive_renewals")
+		bucketsLifecycles := config.Viper.GetBool("buckets.lifecycles")
+		bucketsReplication := config.Viper.GetBool("buckets.replication")
+		bucketsImports := config.Viper.GetBool("buckets.imports")
+		bucketsPinMirrors := config.Viper.GetBool("buckets.pin_mirrors")
+		bucketsDomains := config.Viper.GetBool("buckets.domains")
+		bucketsWebhooks := config.Viper.GetBool("buckets.webhooks")
+		bucketsSearchIndex := config.Viper.GetBool("buckets.search_index")
+		bucketsAllowPrivateEndpoints := config.Viper.GetBool("buckets.allow_private_endpoints")
 
 		threadsMaxNumberPerOwner := config.Viper.GetInt("threads.max_number_per_owner")
+		threadsMaxNumberPerKey := config.Viper.GetInt("threads.max_number_per_key")
 
 		logFile := config.Viper.GetString("log.file")
 		if logFile != "" {
@@ -302,16 +513,24 @@ var rootCmd = &cobra.Command{
 		textile, err := core.NewTextile(ctx, core.Config{
 			RepoPath: config.Viper.GetString("repo"),
 
-			AddrAPI:          addrApi,
-			AddrAPIProxy:     addrApiProxy,
-			AddrThreadsHost:  addrThreadsHost,
-			AddrIPFSAPI:      addrIpfsApi,
-			AddrGatewayHost:  addrGatewayHost,
-			AddrGatewayURL:   addrGatewayUrl,
-			AddrPowergateAPI: addrPowergateApi,
-			AddrMongoURI:     addrMongoUri,
-
-			UseSubdomains: config.Viper.GetBool("gateway.subdomains"),
+			AddrAPI:            addrApi,
+			AddrAPIProxy:       addrApiProxy,
+			AddrThreadsHost:    addrThreadsHost,
+			AddrIPFSAPI:        addrIpfsApi,
+			AddrGatewayHost:    addrGatewayHost,
+			AddrGatewayURL:     addrGatewayUrl,
+			AddrGatewayTLSHost: addrGatewayTlsHost,
+			AddrGatewayMetrics: addrGatewayMetrics,
+			AddrPowergateAPI:   addrPowergateApi,
+			AddrMongoURI:       addrMongoUri,
+
+			UseSubdomains:         config.Viper.GetBool("gateway.subdomains"),
+			GatewayTemplatesDir:   config.Viper.GetString("gateway.templates_dir"),
+			GatewayACMEEmail:      config.Viper.GetString("gateway.acme_email"),
+			GatewayPreviews:       config.Viper.GetBool("gateway.previews"),
+			GatewayAccessLog:      config.Viper.GetBool("gateway.access_log"),
+			GatewayCacheSize:      config.Viper.GetInt64("gateway.cache_size"),
+			GatewayCacheRedisAddr: config.Viper.GetString("gateway.cache_redis_addr"),
 
 			MongoName: "textile",
 
@@ -327,8 +546,25 @@ var rootCmd = &cobra.Command{
 			BucketsMaxSize:            bucketsMaxSize,
 			BucketsTotalMaxSize:       bucketsTotalMaxSize,
 			BucketsMaxNumberPerThread: bucketsMaxNumberPerThread,
+			BucketsThumbnails:         bucketsThumbnails,
+			BucketsPinGC:              bucketsPinGC,
+			BucketsPinGCDryRun:        bucketsPinGCDryRun,
+			BucketsArchiveSchedules:   bucketsArchiveSchedules,
+			BucketsArchiveRenewals:    bucketsArchiveRenewals,
+			BucketsLifecycles:         bucketsLifecycles,
+			BucketsReplication:        bucketsReplication,
+			BucketsImports:            bucketsImports,
+			BucketsPinMirrors:         bucketsPinMirrors,
+			BucketsDomains:            bucketsDomains,
+			BucketsWebhooks:           bucketsWebhooks,
+			BucketsSearchIndex:        bucketsSearchIndex,
+
+			BucketsAllowPrivateEndpoints: bucketsAllowPrivateEndpoints,
 
 			ThreadsMaxNumberPerOwner: threadsMaxNumberPerOwner,
+			ThreadsMaxNumberPerKey:   threadsMaxNumberPerKey,
+
+			AdminToken: adminToken,
 
 			Hub:   true,
 			Debug: config.Viper.GetBool("log.debug"),
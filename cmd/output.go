@@ -1,32 +1,68 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
+	"regexp"
 	"strings"
 
 	"github.com/logrusorgru/aurora"
 	"github.com/olekukonko/tablewriter"
+	"github.com/spf13/cobra"
 	"google.golang.org/grpc/status"
 )
 
+// jsonOutput is set by the global --json flag.
+var jsonOutput bool
+
+// ansiCodes matches terminal color codes, which are stripped from JSON output.
+var ansiCodes = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// AddJSONFlag adds a global --json flag to root.
+// When set, results are printed to stdout as JSON and other messages are printed to stderr.
+func AddJSONFlag(root *cobra.Command) {
+	root.PersistentFlags().BoolVar(&jsonOutput, "json", false, "Prints machine-readable JSON results")
+}
+
+// JSONOutput returns whether or not results should be printed as JSON.
+func JSONOutput() bool {
+	return jsonOutput
+}
+
+// JSON prints v to stdout as indented JSON.
+func JSON(v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	ErrCheck(err)
+	fmt.Println(string(data))
+}
+
+// messageOutput returns where human-readable messages are printed.
+func messageOutput() io.Writer {
+	if jsonOutput {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
 func Message(format string, args ...interface{}) {
 	if format == "" {
 		return
 	}
-	fmt.Println(aurora.Sprintf(aurora.BrightBlack("> "+format), args...))
+	fmt.Fprintln(messageOutput(), aurora.Sprintf(aurora.BrightBlack("> "+format), args...))
 }
 
 func Warn(format string, args ...interface{}) {
 	if format == "" {
 		return
 	}
-	fmt.Println(aurora.Sprintf(aurora.Yellow("> Warning! %s"),
+	fmt.Fprintln(messageOutput(), aurora.Sprintf(aurora.Yellow("> Warning! %s"),
 		aurora.Sprintf(aurora.BrightBlack(format), args...)))
 }
 
 func Success(format string, args ...interface{}) {
-	fmt.Println(aurora.Sprintf(aurora.Cyan("> Success! %s"),
+	fmt.Fprintln(messageOutput(), aurora.Sprintf(aurora.Cyan("> Success! %s"),
 		aurora.Sprintf(aurora.BrightBlack(format), args...)))
 }
 
@@ -46,6 +82,10 @@ func Fatal(err error, args ...interface{}) {
 	words := strings.SplitN(msg, " ", 2)
 	words[0] = strings.Title(words[0])
 	msg = strings.Join(words, " ")
+	if jsonOutput {
+		JSON(map[string]string{"error": fmt.Sprintf(msg, args...)})
+		os.Exit(1)
+	}
 	fmt.Println(aurora.Sprintf(aurora.Red("> Error! %s"),
 		aurora.Sprintf(aurora.BrightBlack(msg), args...)))
 	os.Exit(1)
@@ -57,7 +97,22 @@ func ErrCheck(err error, args ...interface{}) {
 	}
 }
 
+// RenderTable prints rows of data under header.
+// With JSON output, rows are printed as a list of objects keyed by header.
 func RenderTable(header []string, data [][]string) {
+	if jsonOutput {
+		rows := make([]map[string]string, len(data))
+		for i, d := range data {
+			rows[i] = make(map[string]string)
+			for j, h := range header {
+				if j < len(d) {
+					rows[i][strings.ReplaceAll(h, " ", "_")] = ansiCodes.ReplaceAllString(d[j], "")
+				}
+			}
+		}
+		JSON(rows)
+		return
+	}
 	fmt.Println()
 	table := tablewriter.NewWriter(os.Stdout)
 	table.SetHeader(header)
@@ -0,0 +1,57 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/textile/api/buckets"
+	mdb "github.com/textileio/textile/mongodb"
+)
+
+const (
+	// archiveRenewalBatchSize is the max number of due archive renewal checks fetched at once.
+	archiveRenewalBatchSize = 20
+	// archiveRenewalTimeout is the max duration of checking the archive renewal of a bucket.
+	archiveRenewalTimeout = time.Minute
+)
+
+// ArchiveRenewalWatchInterval is how often the watcher looks for bucket archive renewal checks that are due.
+var ArchiveRenewalWatchInterval = time.Minute
+
+// archiveRenewals watches the deal expirations of bucket archives with a renewal policy.
+type archiveRenewals struct {
+	colls   *mdb.Collections
+	buckets *buckets.Service
+}
+
+// checkReady checks all archive renewals that are due.
+// A renewal that can't be checked is retried after the check interval.
+func (r *archiveRenewals) checkReady(ctx context.Context) error {
+	for {
+		list, err := r.colls.FFSInstances.GetRenewalReady(ctx, archiveRenewalBatchSize)
+		if err != nil {
+			return fmt.Errorf("getting ready archive renewals: %v", err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		for _, ffsi := range list {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tctx, cancel := context.WithTimeout(ctx, archiveRenewalTimeout)
+			if err := r.buckets.CheckArchiveRenewal(tctx, ffsi); err != nil {
+				log.Errorf("checking archive renewal of bucket %s: %v", ffsi.BucketKey, err)
+				rn := ffsi.Renewal
+				next := time.Now().Add(buckets.ArchiveRenewalCheckInterval).UnixNano()
+				if err := r.colls.FFSInstances.SetRenewalChecked(
+					tctx, ffsi.BucketKey, rn.Cid, rn.ExpiryEpoch, rn.Unfunded, err.Error(), next); err != nil {
+					cancel()
+					return fmt.Errorf("rescheduling archive renewal check of bucket %s: %v", ffsi.BucketKey, err)
+				}
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/textile/api/buckets"
+	mdb "github.com/textileio/textile/mongodb"
+)
+
+const (
+	// archiveScheduleBatchSize is the max number of due archive schedules fetched at once.
+	archiveScheduleBatchSize = 20
+	// archiveScheduleTimeout is the max duration of running the archive schedule of a bucket.
+	archiveScheduleTimeout = time.Minute * 5
+)
+
+// ArchiveScheduleCheckInterval is how often the scheduler looks for bucket archive schedules that are due.
+var ArchiveScheduleCheckInterval = time.Minute
+
+// archiveScheduler archives buckets when their archive schedules are due.
+type archiveScheduler struct {
+	colls   *mdb.Collections
+	buckets *buckets.Service
+}
+
+// runReady runs all archive schedules that are due.
+// A schedule that can't be run is retried after the retry interval.
+func (s *archiveScheduler) runReady(ctx context.Context) error {
+	for {
+		list, err := s.colls.FFSInstances.GetScheduleReady(ctx, archiveScheduleBatchSize)
+		if err != nil {
+			return fmt.Errorf("getting ready archive schedules: %v", err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		for _, ffsi := range list {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tctx, cancel := context.WithTimeout(ctx, archiveScheduleTimeout)
+			if err := s.buckets.RunArchiveSchedule(tctx, ffsi); err != nil {
+				log.Errorf("running archive schedule of bucket %s: %v", ffsi.BucketKey, err)
+				run := mdb.ArchiveScheduleRun{RanAt: time.Now().UnixNano(), Error: err.Error()}
+				next := time.Now().Add(buckets.ArchiveScheduleRetryInterval).UnixNano()
+				if err := s.colls.FFSInstances.AddScheduleRun(tctx, ffsi.BucketKey, run, next); err != nil {
+					cancel()
+					return fmt.Errorf("rescheduling archive of bucket %s: %v", ffsi.BucketKey, err)
+				}
+			}
+			cancel()
+		}
+	}
+}
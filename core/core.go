@@ -5,6 +5,8 @@ import (
 	"errors"
 	"net"
 	"net/http"
+	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/dgrijalva/jwt-go"
@@ -29,6 +31,8 @@ import (
 	tutil "github.com/textileio/go-threads/util"
 	powc "github.com/textileio/powergate/api/client"
 	"github.com/textileio/powergate/ffs"
+	"github.com/textileio/textile/api/admin"
+	apb "github.com/textileio/textile/api/admin/pb"
 	"github.com/textileio/textile/api/buckets"
 	bpb "github.com/textileio/textile/api/buckets/pb"
 	"github.com/textileio/textile/api/common"
@@ -42,6 +46,7 @@ import (
 	"github.com/textileio/textile/gateway"
 	"github.com/textileio/textile/ipns"
 	mdb "github.com/textileio/textile/mongodb"
+	"github.com/textileio/textile/notify"
 	tdb "github.com/textileio/textile/threaddb"
 	"github.com/textileio/textile/util"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -53,6 +58,9 @@ import (
 var (
 	// ErrTooManyThreadsPerOwner indicates that the maximum amount of threads
 	// are created for an owner.
+	// The error is not returned as is. Clients receive a ResourceExhausted status whose
+	// message starts with this message and whose upb.LimitExceeded details describe the limit,
+	// see the users client's LimitExceeded.
 	ErrTooManyThreadsPerOwner = errors.New("number of threads per owner exceeds quota")
 
 	log = logging.Logger("core")
@@ -69,8 +77,14 @@ var (
 		"/threads.pb.API/ListDBs",
 	}
 
+	// adminMethodPrefix prefixes admin methods, which are authorized by the admin token.
+	adminMethodPrefix = "/admin.pb.API/"
+
 	// WSPingInterval controls the WebSocket keepalive pinging interval. Must be >= 1s.
 	WSPingInterval = time.Second * 5
+
+	// accountEventBufferSize is the number of account events buffered for each watcher.
+	accountEventBufferSize = 100
 )
 
 type Textile struct {
@@ -83,6 +97,7 @@ type Textile struct {
 	mail           *tdb.Mail
 	powc           *powc.Client
 	archiveTracker *archive.Tracker
+	jobs           *jobRunner
 
 	ipnsm *ipns.Manager
 	dnsm  *dns.Manager
@@ -91,8 +106,10 @@ type Textile struct {
 	proxy  *http.Server
 
 	gateway            *gateway.Gateway
+	admin              *admin.Service
 	internalHubSession string
 	emailSessionBus    *broadcast.Broadcaster
+	accountEventBus    *broadcast.Broadcaster
 
 	conf Config
 }
@@ -100,17 +117,32 @@ type Textile struct {
 type Config struct {
 	RepoPath string
 
-	AddrAPI          ma.Multiaddr
-	AddrAPIProxy     ma.Multiaddr
-	AddrThreadsHost  ma.Multiaddr
-	AddrIPFSAPI      ma.Multiaddr
-	AddrGatewayHost  ma.Multiaddr
-	AddrGatewayURL   string
-	AddrPowergateAPI string
-	AddrMongoURI     string
+	AddrAPI            ma.Multiaddr
+	AddrAPIProxy       ma.Multiaddr
+	AddrThreadsHost    ma.Multiaddr
+	AddrIPFSAPI        ma.Multiaddr
+	AddrGatewayHost    ma.Multiaddr
+	AddrGatewayURL     string
+	AddrGatewayTLSHost ma.Multiaddr
+	AddrGatewayMetrics ma.Multiaddr
+	AddrPowergateAPI   string
+	AddrMongoURI       string
 
 	UseSubdomains bool
 
+	// GatewayTemplatesDir optionally overrides the gateway's embedded HTML templates.
+	GatewayTemplatesDir string
+	// GatewayACMEEmail is the contact address used when provisioning custom domain certificates.
+	GatewayACMEEmail string
+	// GatewayPreviews enables rendering of READMEs, Markdown, and source files when browsing buckets.
+	GatewayPreviews bool
+	// GatewayAccessLog enables the gateway's structured access logs.
+	GatewayAccessLog bool
+	// GatewayCacheSize is the max size in bytes of the gateway's in-process response cache.
+	GatewayCacheSize int64
+	// GatewayCacheRedisAddr optionally moves the gateway's response cache to Redis.
+	GatewayCacheRedisAddr string
+
 	MongoName string
 
 	DNSDomain string
@@ -125,14 +157,49 @@ type Config struct {
 	BucketsMaxSize            int64
 	BucketsTotalMaxSize       int64
 	BucketsMaxNumberPerThread int
+	// BucketsThumbnails enables generating thumbnails of images in public buckets.
+	BucketsThumbnails bool
+	// BucketsPinGC enables unpinning bucket roots that are no longer referenced.
+	BucketsPinGC bool
+	// BucketsPinGCDryRun only logs the bucket roots that would be unpinned.
+	BucketsPinGCDryRun bool
+	// BucketsArchiveSchedules enables running scheduled bucket archives.
+	BucketsArchiveSchedules bool
+	// BucketsArchiveRenewals enables watching archive deals for renewal.
+	BucketsArchiveRenewals bool
+	// BucketsLifecycles enables applying bucket lifecycle rules.
+	BucketsLifecycles bool
+	// BucketsReplication enables replicating buckets to remote hubs.
+	BucketsReplication bool
+	// BucketsImports enables running server-side bucket imports.
+	BucketsImports bool
+	// BucketsPinMirrors enables pinning bucket roots on remote pinning services.
+	BucketsPinMirrors bool
+	// BucketsDomains enables verifying custom domains and syncing their DNSLink records.
+	BucketsDomains bool
+	// BucketsWebhooks enables delivering bucket webhooks.
+	BucketsWebhooks bool
+	// BucketsSearchIndex enables maintaining bucket search indexes.
+	BucketsSearchIndex bool
+	// BucketsAllowPrivateEndpoints allows webhooks, pin mirrors, and S3 imports to reach
+	// loopback, private, and link-local addresses. Only enable this for development.
+	BucketsAllowPrivateEndpoints bool
 
 	ThreadsMaxNumberPerOwner int
+	ThreadsMaxNumberPerKey   int
+
+	// AdminToken enables the admin API for the hub.
+	AdminToken string
 
 	Hub   bool
 	Debug bool
 
 	ThreadsConnManager connmgr.ConnManager
 
+	// Notifier sends push notifications for user events to registered devices, e.g., with FCM or APNs.
+	// Notifications are disabled if nil.
+	Notifier notify.Notifier
+
 	FFSDefaultConfig *ffs.StorageConfig
 }
 
@@ -143,6 +210,7 @@ func NewTextile(ctx context.Context, conf Config) (*Textile, error) {
 			"hubapi":      logging.LevelDebug,
 			"bucketsapi":  logging.LevelDebug,
 			"usersapi":    logging.LevelDebug,
+			"notify":      logging.LevelDebug,
 			"pow-archive": logging.LevelDebug,
 		}); err != nil {
 			return nil, err
@@ -174,7 +242,7 @@ func NewTextile(ctx context.Context, conf Config) (*Textile, error) {
 	if err != nil {
 		return nil, err
 	}
-	t.ipnsm, err = ipns.NewManager(t.collections.IPNSKeys, ic.Key(), ic.Name(), conf.Debug)
+	t.ipnsm, err = ipns.NewManager(t.collections.IPNSKeys, ic.Key(), ic.Name(), &ipns.HTTPKeyImporter{API: ic}, conf.Debug)
 	if err != nil {
 		return nil, err
 	}
@@ -236,12 +304,14 @@ func NewTextile(ctx context.Context, conf Config) (*Textile, error) {
 
 	var hs *hub.Service
 	var us *users.Service
+	var ec *email.Client
 	if conf.Hub {
-		ec, err := email.NewClient(conf.EmailFrom, conf.EmailDomain, conf.EmailAPIKey, conf.Debug)
+		ec, err = email.NewClient(conf.EmailFrom, conf.EmailDomain, conf.EmailAPIKey, conf.Debug)
 		if err != nil {
 			return nil, err
 		}
 		t.emailSessionBus = broadcast.NewBroadcaster(0)
+		t.accountEventBus = broadcast.NewBroadcaster(accountEventBufferSize)
 		hs = &hub.Service{
 			Collections:        t.collections,
 			Threads:            t.th,
@@ -249,18 +319,29 @@ func NewTextile(ctx context.Context, conf Config) (*Textile, error) {
 			GatewayURL:         conf.AddrGatewayURL,
 			EmailClient:        ec,
 			EmailSessionBus:    t.emailSessionBus,
+			AccountEventBus:    t.accountEventBus,
 			EmailSessionSecret: conf.EmailSessionSecret,
 			IPFSClient:         ic,
 			IPNSManager:        t.ipnsm,
 			DNSManager:         t.dnsm,
 		}
+		if conf.AdminToken != "" {
+			t.admin = admin.NewService(t.collections, t.th, t.bucks, ic, conf.AdminToken)
+		}
 		us = &users.Service{
-			Collections: t.collections,
-			Mail:        t.mail,
+			Collections:              t.collections,
+			Threads:                  t.th,
+			Mail:                     t.mail,
+			ThreadsNet:               t.thn,
+			TokenIssuer:              t.ts.Host().Peerstore().PrivKey(t.ts.Host().ID()),
+			IPFSClient:               ic,
+			IPNSManager:              t.ipnsm,
+			ThreadsMaxNumberPerOwner: conf.ThreadsMaxNumberPerOwner,
+			ThreadsMaxNumberPerKey:   conf.ThreadsMaxNumberPerKey,
 		}
 	}
 	if conf.Hub {
-		t.archiveTracker, err = archive.New(t.collections, t.bucks, t.powc, t.internalHubSession)
+		t.archiveTracker, err = archive.New(t.collections, t.bucks, t.powc, t.internalHubSession, t.accountEventBus)
 		if err != nil {
 			return nil, err
 		}
@@ -277,6 +358,57 @@ func NewTextile(ctx context.Context, conf Config) (*Textile, error) {
 		DNSManager:                t.dnsm,
 		PGClient:                  t.powc,
 		ArchiveTracker:            t.archiveTracker,
+		AccountEventBus:           t.accountEventBus,
+		EmailClient:               ec,
+		UploadsDir:                filepath.Join(conf.RepoPath, "uploads"),
+		Thumbnails:                conf.BucketsThumbnails,
+		AllowPrivateEndpoints:     conf.BucketsAllowPrivateEndpoints,
+		SearchIndex:               conf.BucketsSearchIndex,
+	}
+	t.jobs = newJobRunner()
+	if t.archiveTracker != nil && conf.BucketsArchiveSchedules {
+		s := &archiveScheduler{colls: t.collections, buckets: bs}
+		t.jobs.Add("archive scheduler", ArchiveScheduleCheckInterval, s.runReady)
+	}
+	if t.archiveTracker != nil && conf.BucketsArchiveRenewals {
+		r := &archiveRenewals{colls: t.collections, buckets: bs}
+		t.jobs.Add("archive renewal watcher", ArchiveRenewalWatchInterval, r.checkReady)
+	}
+	if conf.BucketsLifecycles {
+		s := &lifecycleScheduler{colls: t.collections, buckets: bs}
+		t.jobs.Add("lifecycle scheduler", LifecycleCheckInterval, s.applyReady)
+	}
+	if conf.BucketsReplication {
+		r := &replicator{colls: t.collections, buckets: bs}
+		t.jobs.Add("replicator", ReplicationCheckInterval, r.replicateReady)
+	}
+	if conf.BucketsImports {
+		i := &importer{colls: t.collections, buckets: bs}
+		t.jobs.Add("importer", ImportCheckInterval, i.importReady)
+	}
+	if conf.BucketsPinMirrors {
+		m := &pinMirrorer{colls: t.collections, buckets: bs}
+		t.jobs.Add("pin mirrorer", PinMirrorInterval, m.mirrorReady)
+	}
+	if conf.BucketsDomains {
+		d := &domainSyncer{colls: t.collections, buckets: bs}
+		t.jobs.Add("domain syncer", DomainSyncInterval, d.syncReady)
+	}
+	if conf.BucketsWebhooks {
+		d := &webhookDispatcher{colls: t.collections, client: util.NewPublicHTTPClient(webhookTimeout, conf.BucketsAllowPrivateEndpoints)}
+		t.jobs.Add("webhook dispatcher", WebhookCheckInterval, d.deliverReady)
+	}
+	if conf.BucketsSearchIndex {
+		i := &indexer{colls: t.collections, buckets: bs}
+		t.jobs.Add("indexer", IndexCheckInterval, i.indexReady)
+	}
+	if conf.BucketsThumbnails {
+		th := &thumbnailer{colls: t.collections, buckets: bs}
+		t.jobs.Add("thumbnailer", ThumbnailCheckInterval, th.generateReady)
+	}
+	if conf.BucketsPinGC {
+		c := &pinCollector{buckets: bs, dryRun: conf.BucketsPinGCDryRun}
+		t.jobs.Add("pin collector", PinGCInterval, c.collect)
 	}
 
 	// Start serving
@@ -307,6 +439,9 @@ func NewTextile(ctx context.Context, conf Config) (*Textile, error) {
 		if conf.Hub {
 			hpb.RegisterAPIServer(t.server, hs)
 			upb.RegisterAPIServer(t.server, us)
+			if t.admin != nil {
+				apb.RegisterAPIServer(t.server, t.admin)
+			}
 		}
 		bpb.RegisterAPIServer(t.server, bs)
 		if err := t.server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
@@ -354,8 +489,18 @@ func NewTextile(ctx context.Context, conf Config) (*Textile, error) {
 		Collections:     t.collections,
 		IPFSClient:      ic,
 		EmailSessionBus: t.emailSessionBus,
+		AccountEventBus: t.accountEventBus,
 		Hub:             conf.Hub,
 		Debug:           conf.Debug,
+		TLSAddr:         conf.AddrGatewayTLSHost,
+		TLSCacheDir:     filepath.Join(conf.RepoPath, "autocert"),
+		ACMEEmail:       conf.GatewayACMEEmail,
+		MetricsAddr:     conf.AddrGatewayMetrics,
+		AccessLog:       conf.GatewayAccessLog,
+		Previews:        conf.GatewayPreviews,
+		CacheSize:       conf.GatewayCacheSize,
+		CacheRedisAddr:  conf.GatewayCacheRedisAddr,
+		TemplatesDir:    conf.GatewayTemplatesDir,
 	})
 	if err != nil {
 		return nil, err
@@ -375,6 +520,9 @@ func (t *Textile) Close(force bool) error {
 	if t.emailSessionBus != nil {
 		t.emailSessionBus.Discard()
 	}
+	if t.accountEventBus != nil {
+		t.accountEventBus.Discard()
+	}
 	if err := t.gateway.Stop(); err != nil {
 		return err
 	}
@@ -393,6 +541,9 @@ func (t *Textile) Close(force bool) error {
 			return err
 		}
 	}
+	if err := t.jobs.Close(); err != nil {
+		return err
+	}
 	if err := t.bucks.Close(); err != nil {
 		return err
 	}
@@ -430,6 +581,13 @@ func (t *Textile) authFunc(ctx context.Context) (context.Context, error) {
 			return nil, status.Error(codes.PermissionDenied, "Method is not accessible")
 		}
 	}
+	if strings.HasPrefix(method, adminMethodPrefix) {
+		token, _ := common.AdminTokenFromMD(ctx)
+		if t.admin == nil || !t.admin.ValidToken(ctx, token) {
+			return nil, status.Error(codes.PermissionDenied, "Invalid admin token")
+		}
+		return ctx, nil
+	}
 
 	if threadID, ok := common.ThreadIDFromMD(ctx); ok {
 		ctx = common.NewThreadIDContext(ctx, threadID)
@@ -528,6 +686,13 @@ func (t *Textile) authFunc(ctx context.Context) (context.Context, error) {
 				if err = ukey.UnmarshalString(claims.Subject); err != nil {
 					return nil, err
 				}
+				revoked, err := t.collections.RevokedTokens.IsRevoked(ctx, ukey.PubKey, time.Unix(claims.IssuedAt, 0))
+				if err != nil {
+					return nil, err
+				}
+				if revoked {
+					return nil, status.Error(codes.Unauthenticated, "Revoked token")
+				}
 				user, err := t.collections.Users.Get(ctx, ukey.PubKey)
 				if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
 					return nil, err
@@ -579,6 +744,9 @@ func (t *Textile) threadInterceptor() grpc.UnaryServerInterceptor {
 		if sid, ok := common.SessionFromContext(ctx); ok && sid == t.internalHubSession {
 			return handler(ctx, req)
 		}
+		if strings.HasPrefix(method, adminMethodPrefix) {
+			return handler(ctx, req)
+		}
 
 		var owner crypto.PubKey
 		if org, ok := mdb.OrgFromContext(ctx); ok {
@@ -670,8 +838,17 @@ func (t *Textile) threadInterceptor() grpc.UnaryServerInterceptor {
 			if err != nil {
 				return nil, err
 			}
-			if t.conf.ThreadsMaxNumberPerOwner > 0 && len(thds) >= t.conf.ThreadsMaxNumberPerOwner {
-				return nil, ErrTooManyThreadsPerOwner
+			if max := t.conf.ThreadsMaxNumberPerOwner; max > 0 && len(thds) >= max {
+				return nil, limitExceededError(common.LimitThreadsPerOwner, len(thds), max)
+			}
+			if key, ok := common.APIKeyFromContext(ctx); ok && t.conf.ThreadsMaxNumberPerKey > 0 {
+				kthds, err := t.collections.Threads.ListByKey(ctx, key)
+				if err != nil {
+					return nil, err
+				}
+				if max := t.conf.ThreadsMaxNumberPerKey; len(kthds) >= max {
+					return nil, limitExceededError(common.LimitThreadsPerKey, len(kthds), max)
+				}
 			}
 			if _, err := t.collections.Threads.Create(ctx, newID, owner, isDB); err != nil {
 				return nil, err
@@ -717,7 +894,30 @@ func (t *Textile) threadInterceptor() grpc.UnaryServerInterceptor {
 			if err := t.collections.Threads.Delete(ctx, deleteID, owner); err != nil {
 				return nil, err
 			}
+			if err := t.collections.Tags.Delete(ctx, mdb.ThreadResource, deleteID.String()); err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, err
+			}
 		}
 		return res, nil
 	}
 }
+
+// limitExceededError returns a ResourceExhausted status error that describes an exceeded limit.
+// The limit is attached as upb.LimitExceeded details, which clients read with
+// status.FromError(err).Details().
+func limitExceededError(limit string, current, max int) error {
+	lerr := &common.LimitExceededError{
+		Limit:   limit,
+		Current: int64(current),
+		Max:     int64(max),
+	}
+	st := status.New(codes.ResourceExhausted, lerr.Error())
+	if dst, err := st.WithDetails(&upb.LimitExceeded{
+		Limit:   lerr.Limit,
+		Current: lerr.Current,
+		Max:     lerr.Max,
+	}); err == nil {
+		return dst.Err()
+	}
+	return st.Err()
+}
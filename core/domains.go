@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/textile/api/buckets"
+	mdb "github.com/textileio/textile/mongodb"
+)
+
+const (
+	// domainBatchSize is the max number of domains fetched at once.
+	domainBatchSize = 20
+	// domainSyncTimeout is the max duration of syncing the DNSLink record of a domain.
+	domainSyncTimeout = time.Minute
+)
+
+var (
+	// DomainSyncInterval is how often the domain syncer looks for buckets that changed.
+	DomainSyncInterval = time.Second * 10
+	// DomainRetryInterval is how long the domain syncer waits before retrying a failed sync.
+	DomainRetryInterval = time.Minute * 5
+)
+
+// domainSyncer keeps the DNSLink records of custom domains pointed at bucket roots.
+type domainSyncer struct {
+	colls   *mdb.Collections
+	buckets *buckets.Service
+}
+
+// syncReady verifies pending domains and updates their DNSLink records.
+// A sync that fails is retried after the retry interval.
+// Domains that weren't verified in time are removed first.
+func (s *domainSyncer) syncReady(ctx context.Context) error {
+	if err := s.colls.Domains.DeleteUnverified(ctx, time.Now().Add(-buckets.DomainVerifyTimeout)); err != nil {
+		return fmt.Errorf("deleting expired domains: %v", err)
+	}
+	for {
+		list, err := s.colls.Domains.GetReady(ctx, domainBatchSize)
+		if err != nil {
+			return fmt.Errorf("getting ready domains: %v", err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		for _, d := range list {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tctx, cancel := context.WithTimeout(ctx, domainSyncTimeout)
+			if err := s.buckets.SyncDomain(tctx, d); err != nil {
+				log.Errorf("syncing domain %s of bucket %s: %v", d.Name, d.BucketKey, err)
+				if err := s.colls.Domains.SetFailed(ctx, d.ID, err.Error(), time.Now().Add(DomainRetryInterval)); err != nil {
+					cancel()
+					return fmt.Errorf("recording failed sync of domain %s: %v", d.Name, err)
+				}
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/textileio/textile/api/buckets"
+	mdb "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	// importBatchSize is the max number of bucket imports fetched at once.
+	importBatchSize = 10
+	// importTimeout is the max duration of a bucket import run.
+	// Imports that take longer are resumed on the next check.
+	importTimeout = time.Hour
+	// importMaxAttempts is the number of consecutive failures after which a bucket import is stopped.
+	importMaxAttempts = 5
+)
+
+var (
+	// ImportCheckInterval is how often the importer looks for bucket imports that are ready.
+	ImportCheckInterval = time.Second * 10
+	// ImportRetryInterval is how long the importer waits before retrying a failed bucket import.
+	ImportRetryInterval = time.Minute * 5
+)
+
+// importer runs server-side bucket imports.
+type importer struct {
+	colls   *mdb.Collections
+	buckets *buckets.Service
+}
+
+// importReady runs all bucket imports that are queued or were interrupted.
+// An import that fails is retried after the retry interval, up to importMaxAttempts times in a row.
+func (i *importer) importReady(ctx context.Context) error {
+	for {
+		list, err := i.colls.BucketImports.GetReady(ctx, importBatchSize)
+		if err != nil {
+			return fmt.Errorf("getting ready bucket imports: %v", err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		for _, imp := range list {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tctx, cancel := context.WithTimeout(ctx, importTimeout)
+			if err := i.buckets.RunBucketImport(tctx, imp); err != nil {
+				log.Errorf("importing into bucket %s: %v", imp.BucketKey, err)
+				if ctx.Err() != nil {
+					cancel()
+					return nil
+				}
+				// Canceled imports can't be updated.
+				err := i.colls.BucketImports.SetFailed(ctx, imp.ID, err.Error(), time.Now().Add(ImportRetryInterval), importMaxAttempts)
+				if err != nil && !errors.Is(err, mongo.ErrNoDocuments) {
+					cancel()
+					return fmt.Errorf("recording failed import into bucket %s: %v", imp.BucketKey, err)
+				}
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/textile/api/buckets"
+	mdb "github.com/textileio/textile/mongodb"
+)
+
+const (
+	// indexBatchSize is the max number of pending bucket indexes fetched at once.
+	indexBatchSize = 20
+	// indexTimeout is the max duration of indexing a bucket.
+	indexTimeout = time.Minute * 10
+)
+
+var (
+	// IndexCheckInterval is how often the indexer looks for buckets that changed.
+	IndexCheckInterval = time.Second * 5
+	// IndexRetryInterval is how long the indexer waits before retrying a failed indexing.
+	IndexRetryInterval = time.Minute
+)
+
+// indexer rebuilds the search indexes of buckets that changed.
+type indexer struct {
+	colls   *mdb.Collections
+	buckets *buckets.Service
+}
+
+// indexReady indexes all buckets that changed since they were last indexed.
+// An indexing that fails is retried after the retry interval.
+func (i *indexer) indexReady(ctx context.Context) error {
+	for {
+		list, err := i.colls.SearchIndexStates.GetReady(ctx, indexBatchSize)
+		if err != nil {
+			return fmt.Errorf("getting ready search indexes: %v", err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		for _, st := range list {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tctx, cancel := context.WithTimeout(ctx, indexTimeout)
+			if err := i.buckets.IndexBucket(tctx, st); err != nil {
+				log.Errorf("indexing bucket %s: %v", st.BucketKey, err)
+				if err := i.colls.SearchIndexStates.SetFailed(ctx, st.BucketKey, err.Error(), time.Now().Add(IndexRetryInterval)); err != nil {
+					cancel()
+					return fmt.Errorf("recording failed indexing of bucket %s: %v", st.BucketKey, err)
+				}
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package core
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// jobRunner runs background jobs on fixed intervals until it's closed.
+// Each job runs in its own goroutine, so a slow job never delays the others.
+type jobRunner struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func newJobRunner() *jobRunner {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &jobRunner{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Add starts running fn every interval.
+// The interval is measured from the end of the previous run.
+// Errors are logged and the job runs again after the next interval.
+func (r *jobRunner) Add(name string, interval time.Duration, fn func(ctx context.Context) error) {
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		for {
+			select {
+			case <-r.ctx.Done():
+				log.Infof("shutting down %s", name)
+				return
+			case <-time.After(interval):
+				if err := fn(r.ctx); err != nil && r.ctx.Err() == nil {
+					log.Errorf("running %s: %v", name, err)
+				}
+			}
+		}
+	}()
+}
+
+// Close stops all jobs and waits for running jobs to return.
+func (r *jobRunner) Close() error {
+	r.cancel()
+	r.wg.Wait()
+	return nil
+}
@@ -0,0 +1,54 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/textile/api/buckets"
+	mdb "github.com/textileio/textile/mongodb"
+)
+
+const (
+	// lifecycleBatchSize is the max number of bucket lifecycles fetched at once.
+	lifecycleBatchSize = 20
+	// lifecycleTimeout is the max duration of applying the lifecycle rules of a bucket.
+	lifecycleTimeout = time.Minute * 10
+)
+
+// LifecycleCheckInterval is how often the scheduler looks for bucket lifecycle rules that are due.
+var LifecycleCheckInterval = time.Minute
+
+// lifecycleScheduler applies bucket lifecycle rules when they are due.
+type lifecycleScheduler struct {
+	colls   *mdb.Collections
+	buckets *buckets.Service
+}
+
+// applyReady applies all lifecycles that are due.
+// A lifecycle that fails is retried after the lifecycle interval.
+func (s *lifecycleScheduler) applyReady(ctx context.Context) error {
+	for {
+		list, err := s.colls.BucketLifecycles.GetReady(ctx, lifecycleBatchSize)
+		if err != nil {
+			return fmt.Errorf("getting ready bucket lifecycles: %v", err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		for _, sl := range list {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tctx, cancel := context.WithTimeout(ctx, lifecycleTimeout)
+			if err := s.buckets.ApplyLifecycle(tctx, sl); err != nil {
+				log.Errorf("applying lifecycle of bucket %s: %v", sl.BucketKey, err)
+				if err := s.colls.BucketLifecycles.Reschedule(tctx, sl.BucketKey, time.Now().Add(buckets.LifecycleInterval)); err != nil {
+					cancel()
+					return fmt.Errorf("rescheduling lifecycle of bucket %s: %v", sl.BucketKey, err)
+				}
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/textile/api/buckets"
+)
+
+// pinGCTimeout is the max duration of a pin garbage collection.
+const pinGCTimeout = time.Hour
+
+var (
+	// PinGCInterval is how often the pin garbage collector looks for unreferenced bucket roots.
+	PinGCInterval = time.Hour
+	// PinGCGracePeriod is how long a bucket root is pinned before it can be collected.
+	// This keeps roots of buckets that are still being created or changed from being collected.
+	PinGCGracePeriod = time.Hour
+)
+
+// pinCollector unpins bucket roots that are no longer referenced.
+type pinCollector struct {
+	buckets *buckets.Service
+	dryRun  bool
+}
+
+// collect unpins bucket roots that were pinned before the grace period and are no longer referenced.
+// In dry run mode, unreferenced roots are only logged.
+func (c *pinCollector) collect(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, pinGCTimeout)
+	defer cancel()
+	collected, err := c.buckets.CollectPins(ctx, time.Now().Add(-PinGCGracePeriod), c.dryRun)
+	if c.dryRun {
+		log.Infof("pin gc dry run found %d unreferenced bucket roots", len(collected))
+	} else if len(collected) > 0 {
+		log.Infof("pin gc unpinned %d bucket roots", len(collected))
+	}
+	if err != nil {
+		return fmt.Errorf("collecting pins: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/textile/api/buckets"
+	mdb "github.com/textileio/textile/mongodb"
+)
+
+const (
+	// pinMirrorBatchSize is the max number of pin mirrors fetched at once.
+	pinMirrorBatchSize = 20
+	// pinMirrorTimeout is the max duration of syncing a bucket root with a pinning service.
+	pinMirrorTimeout = time.Minute * 5
+)
+
+var (
+	// PinMirrorInterval is how often the pin mirrorer looks for buckets that changed.
+	PinMirrorInterval = time.Second * 10
+	// PinMirrorRetryInterval is how long the pin mirrorer waits before retrying a failed sync.
+	PinMirrorRetryInterval = time.Minute * 5
+)
+
+// pinMirrorer pins bucket roots on remote pinning services.
+type pinMirrorer struct {
+	colls   *mdb.Collections
+	buckets *buckets.Service
+}
+
+// mirrorReady pins the roots of all buckets that changed since they were last mirrored.
+// A sync that fails is retried after the retry interval.
+func (m *pinMirrorer) mirrorReady(ctx context.Context) error {
+	for {
+		list, err := m.colls.PinMirrors.GetReady(ctx, pinMirrorBatchSize)
+		if err != nil {
+			return fmt.Errorf("getting ready pin mirrors: %v", err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		for _, pm := range list {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tctx, cancel := context.WithTimeout(ctx, pinMirrorTimeout)
+			if err := m.buckets.MirrorBucketPin(tctx, pm); err != nil {
+				log.Errorf("mirroring pin of bucket %s to %s: %v", pm.BucketKey, pm.Endpoint, err)
+				if err := m.colls.PinMirrors.SetFailed(ctx, pm.ID, err.Error(), time.Now().Add(PinMirrorRetryInterval)); err != nil {
+					cancel()
+					return fmt.Errorf("recording failed pin mirror of bucket %s: %v", pm.BucketKey, err)
+				}
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/textile/api/buckets"
+	mdb "github.com/textileio/textile/mongodb"
+)
+
+const (
+	// replicationBatchSize is the max number of replication targets fetched at once.
+	replicationBatchSize = 20
+	// replicationTimeout is the max duration of replicating a bucket to a target.
+	replicationTimeout = time.Hour
+)
+
+var (
+	// ReplicationCheckInterval is how often the replicator looks for buckets that changed.
+	ReplicationCheckInterval = time.Second * 10
+	// ReplicationRetryInterval is how long the replicator waits before retrying a failed replication.
+	ReplicationRetryInterval = time.Minute * 5
+)
+
+// replicator mirrors buckets to their replication targets on remote hubs.
+type replicator struct {
+	colls   *mdb.Collections
+	buckets *buckets.Service
+}
+
+// replicateReady replicates all buckets that changed since they were last replicated.
+// A replication that fails is retried after the retry interval.
+func (r *replicator) replicateReady(ctx context.Context) error {
+	for {
+		list, err := r.colls.ReplicationTargets.GetReady(ctx, replicationBatchSize)
+		if err != nil {
+			return fmt.Errorf("getting ready replication targets: %v", err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		for _, t := range list {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tctx, cancel := context.WithTimeout(ctx, replicationTimeout)
+			if err := r.buckets.ReplicateBucket(tctx, t); err != nil {
+				log.Errorf("replicating bucket %s to %s: %v", t.BucketKey, t.Address, err)
+				if err := r.colls.ReplicationTargets.SetFailed(ctx, t.ID, err.Error(), time.Now().Add(ReplicationRetryInterval)); err != nil {
+					cancel()
+					return fmt.Errorf("recording failed replication of bucket %s: %v", t.BucketKey, err)
+				}
+			}
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/textile/api/buckets"
+	mdb "github.com/textileio/textile/mongodb"
+)
+
+const (
+	// thumbnailBatchSize is the max number of pending bucket thumbnail states fetched at once.
+	thumbnailBatchSize = 20
+	// thumbnailTimeout is the max duration of generating the thumbnails of a bucket.
+	thumbnailTimeout = time.Minute * 30
+)
+
+var (
+	// ThumbnailCheckInterval is how often the thumbnailer looks for buckets that changed.
+	ThumbnailCheckInterval = time.Second * 5
+	// ThumbnailRetryInterval is how long the thumbnailer waits before retrying a failed generation.
+	ThumbnailRetryInterval = time.Minute
+)
+
+// thumbnailer generates the thumbnails of images in buckets that changed.
+type thumbnailer struct {
+	colls   *mdb.Collections
+	buckets *buckets.Service
+}
+
+// generateReady generates thumbnails for all buckets that changed since their thumbnails were last generated.
+// A generation that fails is retried after the retry interval.
+func (t *thumbnailer) generateReady(ctx context.Context) error {
+	for {
+		list, err := t.colls.ThumbnailStates.GetReady(ctx, thumbnailBatchSize)
+		if err != nil {
+			return fmt.Errorf("getting ready thumbnail states: %v", err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		for _, st := range list {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tctx, cancel := context.WithTimeout(ctx, thumbnailTimeout)
+			if err := t.buckets.GenerateThumbnails(tctx, st); err != nil {
+				log.Errorf("generating thumbnails of bucket %s: %v", st.BucketKey, err)
+				if err := t.colls.ThumbnailStates.SetFailed(ctx, st.BucketKey, err.Error(), time.Now().Add(ThumbnailRetryInterval)); err != nil {
+					cancel()
+					return fmt.Errorf("recording failed thumbnails of bucket %s: %v", st.BucketKey, err)
+				}
+			}
+			cancel()
+		}
+	}
+}
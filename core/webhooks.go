@@ -0,0 +1,70 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/textileio/textile/buckets/webhooks"
+	mdb "github.com/textileio/textile/mongodb"
+)
+
+const (
+	// webhookBatchSize is the max number of webhook deliveries fetched at once.
+	webhookBatchSize = 50
+	// webhookTimeout is the max duration of a single webhook delivery attempt.
+	webhookTimeout = time.Second * 15
+)
+
+var (
+	// WebhookCheckInterval is how often the webhook dispatcher looks for ready deliveries.
+	WebhookCheckInterval = time.Second * 2
+	// WebhookRetryInterval is how long the dispatcher waits before the first retry of a failed delivery.
+	// Later retries back off exponentially.
+	WebhookRetryInterval = time.Second * 30
+	// WebhookMaxAttempts is the number of failed attempts after which a delivery is dead.
+	WebhookMaxAttempts = 8
+)
+
+// webhookDispatcher sends queued webhook deliveries.
+// Deliveries that fail WebhookMaxAttempts times are kept as dead letters.
+type webhookDispatcher struct {
+	colls  *mdb.Collections
+	client *http.Client
+}
+
+// deliverReady sends all deliveries that are ready.
+// A delivery that fails is retried with exponential backoff until it's dead.
+func (d *webhookDispatcher) deliverReady(ctx context.Context) error {
+	for {
+		list, err := d.colls.WebhookDeliveries.GetReady(ctx, webhookBatchSize)
+		if err != nil {
+			return fmt.Errorf("getting ready webhook deliveries: %v", err)
+		}
+		if len(list) == 0 {
+			return nil
+		}
+		for _, w := range list {
+			if ctx.Err() != nil {
+				return nil
+			}
+			tctx, cancel := context.WithTimeout(ctx, webhookTimeout)
+			err := webhooks.Deliver(tctx, d.client, w)
+			cancel()
+			if err == nil {
+				if err := d.colls.WebhookDeliveries.Delete(ctx, w.ID); err != nil {
+					return fmt.Errorf("removing webhook delivery %s: %v", w.ID, err)
+				}
+				continue
+			}
+			log.Debugf("delivering %s webhook of bucket %s to %s: %v", w.Event, w.BucketKey, w.URL, err)
+			attempts := w.Attempts + 1
+			dead := attempts >= WebhookMaxAttempts
+			retryAt := time.Now().Add(WebhookRetryInterval << uint(attempts-1))
+			if err := d.colls.WebhookDeliveries.SetFailed(ctx, w.ID, err.Error(), retryAt, dead); err != nil {
+				return fmt.Errorf("recording failed webhook delivery %s: %v", w.ID, err)
+			}
+		}
+	}
+}
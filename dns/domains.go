@@ -0,0 +1,151 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+
+	cf "github.com/cloudflare/cloudflare-go"
+)
+
+const (
+	// ChallengePrefix is prepended to a domain to get the name of its verification TXT record.
+	ChallengePrefix = "_textile-challenge"
+
+	// Cloudflare is the name of the Cloudflare DNS provider.
+	Cloudflare = "cloudflare"
+	// Route53 is the name of the AWS Route53 DNS provider.
+	Route53 = "route53"
+)
+
+// lookupTXT is used to resolve verification records; replaced in tests.
+var lookupTXT = func(ctx context.Context, name string) ([]string, error) {
+	return net.DefaultResolver.LookupTXT(ctx, name)
+}
+
+// Provider updates the DNSLink record of a domain with a DNS provider API.
+type Provider interface {
+	// SetDNSLink creates or updates the _dnslink TXT record of domain so that it resolves to hash.
+	SetDNSLink(ctx context.Context, domain, hash string) error
+}
+
+// ProviderConfig describes how to reach the DNS provider hosting a domain's zone.
+type ProviderConfig struct {
+	// Name is the provider name, one of Cloudflare or Route53.
+	Name string
+	// ZoneID is the provider's ID of the zone containing the domain.
+	ZoneID string
+	// Token is a Cloudflare API token with DNS edit permission.
+	Token string
+	// AccessKey and SecretKey are AWS credentials allowed to change Route53 record sets.
+	AccessKey string
+	SecretKey string
+}
+
+// NewProvider returns the DNS provider described by conf.
+func NewProvider(conf ProviderConfig) (Provider, error) {
+	if conf.ZoneID == "" {
+		return nil, fmt.Errorf("zone id is required")
+	}
+	switch conf.Name {
+	case Cloudflare:
+		if conf.Token == "" {
+			return nil, fmt.Errorf("cloudflare api token is required")
+		}
+		api, err := cf.NewWithAPIToken(conf.Token)
+		if err != nil {
+			return nil, err
+		}
+		return &cloudflareProvider{api: api, zoneID: conf.ZoneID}, nil
+	case Route53:
+		if conf.AccessKey == "" || conf.SecretKey == "" {
+			return nil, fmt.Errorf("aws access key and secret key are required")
+		}
+		return NewRoute53Provider(conf.AccessKey, conf.SecretKey, conf.ZoneID), nil
+	default:
+		return nil, fmt.Errorf("unsupported dns provider %q", conf.Name)
+	}
+}
+
+// ChallengeName returns the name of the TXT record used to verify ownership of domain.
+func ChallengeName(domain string) string {
+	return fmt.Sprintf("%s.%s", ChallengePrefix, domain)
+}
+
+// VerifyChallenge returns whether the verification TXT record of domain contains challenge.
+func VerifyChallenge(ctx context.Context, domain, challenge string) (bool, error) {
+	records, err := lookupTXT(ctx, ChallengeName(domain))
+	if err != nil {
+		if derr, ok := err.(*net.DNSError); ok && derr.IsNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	for _, r := range records {
+		if strings.TrimSpace(r) == challenge {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// NormalizeDomain lower-cases domain and strips a trailing dot.
+// An error is returned if domain is not a valid host name.
+func NormalizeDomain(domain string) (string, error) {
+	domain = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domain)), ".")
+	if len(domain) == 0 || len(domain) > 253 || !strings.Contains(domain, ".") {
+		return "", fmt.Errorf("invalid domain %q", domain)
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if len(label) == 0 || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return "", fmt.Errorf("invalid domain %q", domain)
+		}
+		for _, c := range label {
+			if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+				return "", fmt.Errorf("invalid domain %q", domain)
+			}
+		}
+	}
+	return domain, nil
+}
+
+// cloudflareProvider manages DNSLink records in a Cloudflare zone.
+type cloudflareProvider struct {
+	api    *cf.API
+	zoneID string
+}
+
+func (p *cloudflareProvider) SetDNSLink(_ context.Context, domain, hash string) error {
+	name := CreateDNSLinkName(domain)
+	content := CreateDNSLinkContent(hash)
+	records, err := p.api.DNSRecords(p.zoneID, cf.DNSRecord{Type: "TXT", Name: name})
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		if strings.HasPrefix(r.Content, "dnslink=") {
+			if r.Content == content {
+				return nil
+			}
+			if err := p.api.UpdateDNSRecord(p.zoneID, r.ID, cf.DNSRecord{
+				Type:    "TXT",
+				Name:    name,
+				Content: content,
+			}); err != nil {
+				return err
+			}
+			log.Debugf("updated DNSLink record %s -> %s", name, content)
+			return nil
+		}
+	}
+	if _, err := p.api.CreateDNSRecord(p.zoneID, cf.DNSRecord{
+		Type:    "TXT",
+		Name:    name,
+		Content: content,
+	}); err != nil {
+		return err
+	}
+	log.Debugf("created DNSLink record %s -> %s", name, content)
+	return nil
+}
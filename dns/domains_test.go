@@ -0,0 +1,85 @@
+package dns
+
+import (
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeDomain(t *testing.T) {
+	d, err := NormalizeDomain(" Example.COM. ")
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", d)
+	d, err = NormalizeDomain("my-site.example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "my-site.example.com", d)
+
+	for _, bad := range []string{"", "com", "-a.com", "a..com", "a_b.com", "https://a.com"} {
+		_, err = NormalizeDomain(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestVerifyChallenge(t *testing.T) {
+	lookupTXT = func(_ context.Context, name string) ([]string, error) {
+		if name != "_textile-challenge.example.com" {
+			return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+		}
+		return []string{"other", "token "}, nil
+	}
+	ctx := context.Background()
+
+	ok, err := VerifyChallenge(ctx, "example.com", "token")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = VerifyChallenge(ctx, "example.com", "wrong")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = VerifyChallenge(ctx, "missing.com", "token")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestRoute53Provider(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=key/") {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`<ErrorResponse><Error><Code>AccessDenied</Code><Message>Denied</Message></Error></ErrorResponse>`))
+			return
+		}
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/2013-04-01/hostedzone/Z123/rrset/", r.URL.Path)
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req route53ChangeRequest
+		require.NoError(t, xml.Unmarshal(body, &req))
+		require.Len(t, req.Changes, 1)
+		assert.Equal(t, "UPSERT", req.Changes[0].Action)
+		assert.Equal(t, "_dnslink.example.com", req.Changes[0].Name)
+		assert.Equal(t, "TXT", req.Changes[0].Type)
+		assert.Equal(t, []string{`"dnslink=/ipfs/bafy"`}, req.Changes[0].Values)
+		_, _ = w.Write([]byte(`<ChangeResourceRecordSetsResponse/>`))
+	}))
+	defer server.Close()
+	ctx := context.Background()
+
+	p := NewRoute53Provider("key", "secret", "/hostedzone/Z123")
+	p.endpoint = server.URL
+	require.NoError(t, p.SetDNSLink(ctx, "example.com", "bafy"))
+
+	anon := NewRoute53Provider("", "", "Z123")
+	anon.endpoint = server.URL
+	err := anon.SetDNSLink(ctx, "example.com", "bafy")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AccessDenied")
+}
@@ -0,0 +1,172 @@
+package dns
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// route53Endpoint is the global Route53 API endpoint.
+	route53Endpoint = "https://route53.amazonaws.com"
+	// route53Region is the signing region of the global Route53 API.
+	route53Region = "us-east-1"
+	// dnsLinkTTL is the TTL of DNSLink records in seconds.
+	dnsLinkTTL = 60
+
+	amzTimeFormat = "20060102T150405Z"
+	amzDateFormat = "20060102"
+)
+
+// Route53Provider manages DNSLink records in an AWS Route53 hosted zone.
+type Route53Provider struct {
+	accessKey string
+	secretKey string
+	zoneID    string
+
+	endpoint string
+	hc       *http.Client
+	now      func() time.Time
+}
+
+// NewRoute53Provider returns a provider for the hosted zone with zoneID.
+func NewRoute53Provider(accessKey, secretKey, zoneID string) *Route53Provider {
+	return &Route53Provider{
+		accessKey: accessKey,
+		secretKey: secretKey,
+		zoneID:    strings.TrimPrefix(zoneID, "/hostedzone/"),
+		endpoint:  route53Endpoint,
+		hc:        &http.Client{},
+		now:       time.Now,
+	}
+}
+
+type route53ChangeRequest struct {
+	XMLName xml.Name              `xml:"https://route53.amazonaws.com/doc/2013-04-01/ ChangeResourceRecordSetsRequest"`
+	Changes []route53RecordChange `xml:"ChangeBatch>Changes>Change"`
+}
+
+type route53RecordChange struct {
+	Action string   `xml:"Action"`
+	Name   string   `xml:"ResourceRecordSet>Name"`
+	Type   string   `xml:"ResourceRecordSet>Type"`
+	TTL    int      `xml:"ResourceRecordSet>TTL"`
+	Values []string `xml:"ResourceRecordSet>ResourceRecords>ResourceRecord>Value"`
+}
+
+type route53Error struct {
+	Code    string `xml:"Error>Code"`
+	Message string `xml:"Error>Message"`
+}
+
+// SetDNSLink upserts the _dnslink TXT record of domain.
+func (p *Route53Provider) SetDNSLink(ctx context.Context, domain, hash string) error {
+	name := CreateDNSLinkName(domain)
+	content := CreateDNSLinkContent(hash)
+	body, err := xml.Marshal(route53ChangeRequest{
+		Changes: []route53RecordChange{{
+			Action: "UPSERT",
+			Name:   name,
+			Type:   "TXT",
+			TTL:    dnsLinkTTL,
+			Values: []string{fmt.Sprintf("%q", content)},
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	body = append([]byte(xml.Header), body...)
+	u := fmt.Sprintf("%s/2013-04-01/hostedzone/%s/rrset/", strings.TrimSuffix(p.endpoint, "/"), p.zoneID)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/xml")
+	p.sign(req, body)
+	res, err := p.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		var rerr route53Error
+		data, _ := ioutil.ReadAll(io.LimitReader(res.Body, 64*1024))
+		_ = xml.Unmarshal(data, &rerr)
+		if rerr.Code == "" {
+			return fmt.Errorf("route53 request failed with status %d", res.StatusCode)
+		}
+		return fmt.Errorf("route53 request failed with status %d: %s: %s", res.StatusCode, rerr.Code, rerr.Message)
+	}
+	log.Debugf("upserted DNSLink record %s -> %s", name, content)
+	return nil
+}
+
+// sign adds an AWS Signature Version 4 authorization header to req,
+// see https://docs.aws.amazon.com/general/latest/gr/sigv4_signing.html.
+func (p *Route53Provider) sign(req *http.Request, body []byte) {
+	now := p.now().UTC()
+	payloadHash := hashHex(body)
+	req.Header.Set("x-amz-date", now.Format(amzTimeFormat))
+
+	headers := map[string]string{"host": req.URL.Host}
+	for k, v := range req.Header {
+		headers[strings.ToLower(k)] = strings.TrimSpace(strings.Join(v, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for k := range headers {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, k := range names {
+		canonicalHeaders.WriteString(k + ":" + headers[k] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := strings.Join([]string{now.Format(amzDateFormat), route53Region, "route53", "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		now.Format(amzTimeFormat),
+		scope,
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+p.secretKey), now.Format(amzDateFormat))
+	key = hmacSHA256(key, route53Region)
+	key = hmacSHA256(key, "route53")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		p.accessKey, scope, signedHeaders, signature))
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	_, _ = h.Write([]byte(data))
+	return h.Sum(nil)
+}
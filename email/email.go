@@ -22,6 +22,8 @@ type Client struct {
 	gun             *mailgun.MailgunImpl
 	verificationTmp *template.Template
 	inviteTmp       *template.Template
+	renewedTmp      *template.Template
+	unfundedTmp     *template.Template
 	debug           bool
 }
 
@@ -47,11 +49,21 @@ func NewClient(from, domain, apiKey string, debug bool) (*Client, error) {
 	if err != nil {
 		log.Fatal(err)
 	}
+	rt, err := template.New("renewed").Parse(archiveRenewedMsg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	ut, err := template.New("unfunded").Parse(archiveUnfundedMsg)
+	if err != nil {
+		log.Fatal(err)
+	}
 
 	client := &Client{
 		from:            from,
 		verificationTmp: vt,
 		inviteTmp:       it,
+		renewedTmp:      rt,
+		unfundedTmp:     ut,
 		debug:           debug,
 	}
 
@@ -97,6 +109,43 @@ func (e *Client) InviteAddress(ctx context.Context, org, from, to, url, token st
 	return e.send(ctx, to, "Hub Org Invitation", tpl.String())
 }
 
+type archiveData struct {
+	Bucket      string
+	Cid         string
+	Wallet      string
+	ExpiryEpoch uint64
+}
+
+// ArchiveRenewed notifies a recipient that the deals of a bucket archive were renewed.
+func (e *Client) ArchiveRenewed(ctx context.Context, to, bucket, cid string, expiryEpoch uint64) error {
+	var tpl bytes.Buffer
+	if err := e.renewedTmp.Execute(&tpl, &archiveData{
+		Bucket:      bucket,
+		Cid:         cid,
+		ExpiryEpoch: expiryEpoch,
+	}); err != nil {
+		return err
+	}
+
+	return e.send(ctx, to, "Bucket Archive Renewed", tpl.String())
+}
+
+// ArchiveUnfunded notifies a recipient that the deals of a bucket archive can't be renewed
+// because the bucket wallet has no funds.
+func (e *Client) ArchiveUnfunded(ctx context.Context, to, bucket, cid, wallet string, expiryEpoch uint64) error {
+	var tpl bytes.Buffer
+	if err := e.unfundedTmp.Execute(&tpl, &archiveData{
+		Bucket:      bucket,
+		Cid:         cid,
+		Wallet:      wallet,
+		ExpiryEpoch: expiryEpoch,
+	}); err != nil {
+		return err
+	}
+
+	return e.send(ctx, to, "Bucket Archive Renewal Needs Funds", tpl.String())
+}
+
 // send wraps the MailGun client's send method.
 func (e *Client) send(ctx context.Context, recipient, subject, body string) error {
 	if e.gun == nil {
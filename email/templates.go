@@ -25,3 +25,20 @@ To accept the invitation, follow the link below:
 
 If you don’t want to accept it, simply ignore this email.
 ` + footerMsg
+
+const archiveRenewedMsg = headerMsg + `
+The Filecoin deals of the archive of bucket {{.Bucket}} were renewed.
+
+Archive: {{.Cid}}
+Deals now expire by epoch {{.ExpiryEpoch}}.
+` + footerMsg
+
+const archiveUnfundedMsg = headerMsg + `
+The Filecoin deals of the archive of bucket {{.Bucket}} are due for renewal, but the bucket's wallet has no funds.
+
+Archive: {{.Cid}}
+Wallet: {{.Wallet}}
+Deals expire by epoch {{.ExpiryEpoch}}.
+
+Add funds to the wallet to renew the deals before they expire.
+` + footerMsg
@@ -2,10 +2,13 @@ package gateway
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"html/template"
 	"io"
 	"mime"
 	"net/http"
+	"net/url"
 	"path"
 	"path/filepath"
 	"strconv"
@@ -13,6 +16,7 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	ipfspath "github.com/ipfs/interface-go-ipfs-core/path"
 	assets "github.com/textileio/go-assets"
 	"github.com/textileio/go-threads/core/thread"
 	"github.com/textileio/go-threads/db"
@@ -21,8 +25,19 @@ import (
 	"github.com/textileio/textile/buckets"
 	mdb "github.com/textileio/textile/mongodb"
 	tdb "github.com/textileio/textile/threaddb"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// historyMenuSize is the number of bucket versions listed in the history menu.
+const historyMenuSize = 20
+
+// historyItem is used for the bucket history menu in Unixfs directory templates.
+type historyItem struct {
+	At       string
+	Label    string
+	Selected bool
+}
+
 type fileSystem struct {
 	*assets.FileSystem
 }
@@ -78,28 +93,63 @@ func (g *Gateway) renderBucketPath(c *gin.Context, ctx context.Context, threadID
 		render404(c)
 		return
 	}
+	setBucket(c, buck.Key)
+	var base string
+	if g.subdomains {
+		base = buckets.CollectionName
+	} else {
+		base = path.Join("thread", threadID.String(), buckets.CollectionName)
+	}
+	if at := c.Query("at"); at != "" {
+		g.renderBucketPathAt(c, ctx, &buck, base, pth, at)
+		return
+	}
 	rep, err := g.buckets.ListPath(ctx, buck.Key, pth)
 	if err != nil {
 		render404(c)
 		return
 	}
+	license := g.bucketLicense(ctx, buck.Key, pth)
 	if !rep.Item.IsDir {
-		if err := g.buckets.PullPath(ctx, buck.Key, pth, c.Writer); err != nil {
+		setLicenseHeader(c, license)
+		if size := c.Query("thumb"); size != "" {
+			if _, ok := buckets.ThumbnailSizes[size]; !ok {
+				renderError(c, http.StatusBadRequest, fmt.Errorf("invalid thumbnail size %s", size))
+				return
+			}
+			if g.writeThumbnail(c, ctx, buck.Key, strings.Trim(pth, "/"), rep.Item.Cid, size) {
+				return
+			}
+		}
+		if g.previews && c.Query("preview") != "" && g.renderFilePreview(c, ctx, buck.Key, base, pth, rep) {
+			return
+		}
+		if setETag(c, rep.Item.Cid) {
+			return
+		}
+		var encoding string
+		if md := rep.Item.Metadata; md != nil {
+			if md.ContentType != "" {
+				c.Writer.Header().Set("Content-Type", md.ContentType)
+			}
+			encoding = md.ContentEncoding
+		}
+		key := bucketCacheKey(buck.Key, rep.Item.Cid)
+		if err := writeFile(c, encoding, cachedPull(g.cache, ctx, key, func(w io.Writer, opts ...client.Option) error {
+			return g.buckets.PullPath(ctx, buck.Key, pth, w, opts...)
+		})); err != nil {
 			renderError(c, http.StatusInternalServerError, err)
 		}
 	} else {
-		var base string
-		if g.subdomains {
-			base = buckets.CollectionName
-		} else {
-			base = path.Join("thread", threadID.String(), buckets.CollectionName)
-		}
 		var links []link
 		for _, item := range rep.Item.Items {
-			pth := strings.Replace(item.Path, rep.Root.Path, rep.Root.Key, 1)
+			pth := path.Join(base, strings.Replace(item.Path, rep.Root.Path, rep.Root.Key, 1))
+			if g.previews && isPreviewable(item) {
+				pth += "?preview=true"
+			}
 			links = append(links, link{
 				Name:  item.Name,
-				Path:  path.Join(base, pth),
+				Path:  pth,
 				Size:  byteCountDecimal(item.Size),
 				Links: strconv.Itoa(len(item.Items)),
 			})
@@ -111,7 +161,12 @@ func (g *Gateway) renderBucketPath(c *gin.Context, ctx context.Context, threadID
 			name = rep.Root.Key
 		}
 		root := strings.Replace(rep.Item.Path, rep.Root.Path, name, 1)
-		back := path.Dir(path.Join(base, strings.Replace(rep.Item.Path, rep.Root.Path, rep.Root.Key, 1)))
+		dir := path.Join(base, strings.Replace(rep.Item.Path, rep.Root.Path, rep.Root.Key, 1))
+		back := path.Dir(dir)
+		var readme template.HTML
+		if g.previews {
+			readme = g.renderReadme(ctx, buck.Key, dir, rep)
+		}
 		c.HTML(http.StatusOK, "/public/html/unixfs.gohtml", gin.H{
 			"Title":   "Index of /" + root,
 			"Root":    "/" + root,
@@ -119,68 +174,285 @@ func (g *Gateway) renderBucketPath(c *gin.Context, ctx context.Context, threadID
 			"Updated": time.Unix(0, rep.Root.UpdatedAt).String(),
 			"Back":    back,
 			"Links":   links,
+			"History": g.bucketHistory(ctx, buck.Key, ""),
+			"License": license,
+			"Readme":  readme,
+		})
+	}
+}
+
+// renderBucketPathAt renders a bucket path as it was at the time given by at,
+// which is either unix nanoseconds or an RFC 3339 timestamp.
+func (g *Gateway) renderBucketPathAt(c *gin.Context, ctx context.Context, buck *tdb.Bucket, base, pth, at string) {
+	t, err := parseAt(at)
+	if err != nil {
+		renderError(c, http.StatusBadRequest, err)
+		return
+	}
+	version, err := g.collections.BucketVersions.GetAt(ctx, buck.Key, t)
+	if err != nil {
+		render404(c)
+		return
+	}
+	root := ipfspath.Join(ipfspath.New(version.Path), pth)
+	rep, err := g.buckets.ListIpfsPath(ctx, root)
+	if err != nil {
+		render404(c)
+		return
+	}
+	license := g.bucketLicense(ctx, buck.Key, pth)
+	if !rep.Item.IsDir {
+		// The response for at only stays the same once a later version exists.
+		// Until then, a new version could still be created before at, so redirect to the immutable content path.
+		_, err := g.collections.BucketVersions.GetAfter(ctx, buck.Key, t)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			c.Redirect(http.StatusFound, strings.TrimSuffix(g.url, "/")+root.String())
+			return
+		} else if err != nil {
+			renderError(c, http.StatusInternalServerError, err)
+			return
+		}
+		setLicenseHeader(c, license)
+		setImmutable(c)
+		if setETag(c, rep.Item.Cid) {
+			return
+		}
+		pull := cachedPull(g.cache, ctx, bucketCacheKey(buck.Key, root.String()), func(w io.Writer, _ ...client.Option) error {
+			return g.buckets.PullIpfsPath(ctx, root, w)
+		})
+		if err := pull(c.Writer); err != nil {
+			renderError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+	query := "?at=" + url.QueryEscape(at)
+	var links []link
+	for _, item := range rep.Item.Items {
+		links = append(links, link{
+			Name:  item.Name,
+			Path:  path.Join(base, buck.Key, pth, item.Name) + query,
+			Size:  byteCountDecimal(item.Size),
+			Links: strconv.Itoa(len(item.Items)),
 		})
 	}
+	name := buck.Name
+	if name == "" {
+		name = buck.Key
+	}
+	dir := path.Join(name, pth)
+	back := path.Dir(path.Join(base, buck.Key, pth))
+	if pth != "" {
+		back += query
+	}
+	c.HTML(http.StatusOK, "/public/html/unixfs.gohtml", gin.H{
+		"Title":   "Index of /" + dir,
+		"Root":    "/" + dir,
+		"Path":    root.String(),
+		"Updated": version.CreatedAt.String(),
+		"Back":    back,
+		"Links":   links,
+		"History": g.bucketHistory(ctx, buck.Key, at),
+		"License": license,
+	})
+}
+
+// bucketLicense returns the license that applies to a bucket path.
+// Nil is returned if no license applies.
+func (g *Gateway) bucketLicense(ctx context.Context, key, pth string) *mdb.BucketLicense {
+	license, err := g.collections.BucketLicenses.Resolve(ctx, key, pth)
+	if err != nil {
+		return nil
+	}
+	return license
+}
+
+// setLicenseHeader links a response to its license text.
+func setLicenseHeader(c *gin.Context, license *mdb.BucketLicense) {
+	if license == nil || license.URL == "" {
+		return
+	}
+	c.Writer.Header().Set("Link", fmt.Sprintf("<%s>; rel=\"license\"", license.URL))
+}
+
+// setETag sets the ETag header of a file with CID cid.
+// If the ETag matches the request's If-None-Match header, a 304 response is written and true is returned.
+func setETag(c *gin.Context, cid string) bool {
+	etag := buckets.ETag(cid)
+	if etag == "" {
+		return false
+	}
+	c.Writer.Header().Set("ETag", etag)
+	if buckets.MatchETag(c.GetHeader("If-None-Match"), etag) {
+		c.Writer.WriteHeader(http.StatusNotModified)
+		return true
+	}
+	return false
+}
+
+// bucketHistory returns the latest versions of a bucket for the history menu.
+// The item matching at is selected.
+func (g *Gateway) bucketHistory(ctx context.Context, key, at string) []historyItem {
+	rep, err := g.buckets.ListHistory(ctx, key, "", historyMenuSize)
+	if err != nil {
+		return nil
+	}
+	items := make([]historyItem, len(rep.Entries))
+	for i, e := range rep.Entries {
+		label := time.Unix(0, e.CreatedAt).Format(time.RFC3339)
+		if e.Author != "" {
+			label += " by " + e.Author
+		}
+		if e.Message != "" {
+			label += ": " + e.Message
+		}
+		if len(e.Snapshots) > 0 {
+			label += " [" + strings.Join(e.Snapshots, ", ") + "]"
+		}
+		ts := strconv.FormatInt(e.CreatedAt, 10)
+		items[i] = historyItem{
+			At:       ts,
+			Label:    label,
+			Selected: ts == at,
+		}
+	}
+	return items
+}
+
+func parseAt(at string) (time.Time, error) {
+	if n, err := strconv.ParseInt(at, 10, 64); err == nil {
+		return time.Unix(0, n), nil
+	}
+	t, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return t, fmt.Errorf("invalid time %s", at)
+	}
+	return t, nil
 }
 
 type serveBucketFS interface {
 	GetThread(ctx context.Context, key string) (thread.ID, error)
-	Exists(ctx context.Context, bucket, pth string) (bool, string)
-	Write(ctx context.Context, bucket, pth string, writer io.Writer) error
-	ValidHost() string
+	Exists(ctx context.Context, bucket, pth, index string) (bool, string)
+	Write(ctx context.Context, bucket, pth string, writer io.Writer, opts ...client.Option) error
+	Stat(ctx context.Context, bucket, pth string) (contentType, cid, encoding string)
+	WebConfig(ctx context.Context, bucket string) *mdb.WebConfig
+	DomainBucket(ctx context.Context, host string) (string, bool)
+	Cache() responseCache
+	ValidHosts() []string
 }
 
 type bucketFS struct {
-	client  *client.Client
-	keys    *mdb.IPNSKeys
-	session string
-	host    string
+	client     *client.Client
+	keys       *mdb.IPNSKeys
+	webConfigs *mdb.WebConfigs
+	domains    *domainCache
+	cache      responseCache
+	session    string
+	hosts      []string
 }
 
 func serveBucket(fs serveBucketFS) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		key, err := bucketFromHost(c.Request.Host, fs.ValidHost())
-		if err != nil {
-			return
-		}
-
 		ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
 		defer cancel()
+		key, err := bucketFromHost(c.Request.Host, fs.ValidHosts())
+		if err != nil {
+			var ok bool
+			if key, ok = fs.DomainBucket(ctx, c.Request.Host); !ok {
+				return
+			}
+		}
 		threadID, err := fs.GetThread(ctx, key)
 		if err != nil {
 			return
 		}
+		setBucket(c, key)
 		ctx = common.NewThreadIDContext(ctx, threadID)
 		token := thread.Token(c.Query("token"))
 		if token.Defined() {
 			ctx = thread.NewTokenContext(ctx, token)
 		}
 
-		exists, target := fs.Exists(ctx, key, c.Request.URL.Path)
+		conf := fs.WebConfig(ctx, key)
+		exists, target := fs.Exists(ctx, key, c.Request.URL.Path, conf.Website.Index())
+		found := exists || target != "" || c.Request.URL.Path == "/"
+		if rule, to, ok := buckets.MatchRedirect(conf.AllRedirects(), c.Request.URL.Path, found); ok {
+			serveRedirect(c, ctx, fs, key, conf, rule, to)
+			return
+		}
 		if exists {
-			c.Writer.WriteHeader(http.StatusOK)
-			ctype := mime.TypeByExtension(filepath.Ext(c.Request.URL.Path))
-			if ctype == "" {
-				ctype = "application/octet-stream"
-			}
-			c.Writer.Header().Set("Content-Type", ctype)
-			if err := fs.Write(ctx, key, c.Request.URL.Path, c.Writer); err != nil {
-				renderError(c, http.StatusInternalServerError, err)
-			} else {
-				c.Abort()
-			}
+			serveBucketFile(c, ctx, fs, key, conf, c.Request.URL.Path, http.StatusOK)
 		} else if target != "" {
-			content := path.Join(c.Request.URL.Path, target)
-			ctype := mime.TypeByExtension(filepath.Ext(content))
-			c.Writer.WriteHeader(http.StatusOK)
-			c.Writer.Header().Set("Content-Type", ctype)
-			if err := fs.Write(ctx, key, content, c.Writer); err != nil {
-				renderError(c, http.StatusInternalServerError, err)
+			serveBucketFile(c, ctx, fs, key, conf, path.Join(c.Request.URL.Path, target), http.StatusOK)
+		} else if !found && conf.Website != nil && conf.Website.ErrorDocument != "" {
+			doc := "/" + strings.Trim(conf.Website.ErrorDocument, "/")
+			if ok, _ := fs.Exists(ctx, key, doc, ""); ok {
+				serveBucketFile(c, ctx, fs, key, conf, doc, http.StatusNotFound)
+			}
+		}
+	}
+}
+
+// serveBucketFile writes the file at pth with status and the website headers matching the request path.
+func serveBucketFile(c *gin.Context, ctx context.Context, fs serveBucketFS, key string, conf *mdb.WebConfig, pth string, status int) {
+	ctype, cid, encoding := fs.Stat(ctx, key, pth)
+	setWebsiteHeaders(c, conf.Website, c.Request.URL.Path)
+	if status == http.StatusOK && setETag(c, cid) {
+		c.Abort()
+		return
+	}
+	c.Writer.Header().Set("Content-Type", ctype)
+	var err error
+	if status == http.StatusOK {
+		err = writeFile(c, encoding, cachedPull(fs.Cache(), ctx, bucketCacheKey(key, cid), func(w io.Writer, opts ...client.Option) error {
+			return fs.Write(ctx, key, pth, w, opts...)
+		}))
+	} else {
+		c.Writer.WriteHeader(status)
+		err = fs.Write(ctx, key, pth, c.Writer)
+	}
+	if err != nil {
+		renderError(c, http.StatusInternalServerError, err)
+	} else {
+		c.Abort()
+	}
+}
+
+// setWebsiteHeaders adds the custom headers of website that match the request path pth.
+func setWebsiteHeaders(c *gin.Context, website *buckets.Website, pth string) {
+	if website == nil {
+		return
+	}
+	for _, h := range buckets.MatchHeaders(website.Headers, pth) {
+		c.Writer.Header().Add(h.Name, h.Value)
+	}
+}
+
+// serveRedirect applies a matched redirect rule.
+// Redirect statuses send the client to target. Other statuses serve target from the bucket.
+func serveRedirect(c *gin.Context, ctx context.Context, fs serveBucketFS, key string, conf *mdb.WebConfig, rule buckets.Redirect, target string) {
+	switch rule.Status {
+	case http.StatusOK, http.StatusNotFound, http.StatusGone:
+		exists, index := fs.Exists(ctx, key, target, conf.Website.Index())
+		if !exists && index == "" {
+			if rule.Status == http.StatusOK {
+				render404(c)
 			} else {
-				c.Abort()
+				renderError(c, rule.Status, fmt.Errorf("%s", http.StatusText(rule.Status)))
 			}
+			c.Abort()
+			return
+		}
+		if index != "" {
+			target = path.Join(target, index)
+		}
+		serveBucketFile(c, ctx, fs, key, conf, target, rule.Status)
+	default:
+		if q := c.Request.URL.RawQuery; q != "" && !strings.Contains(target, "?") {
+			target += "?" + q
 		}
+		c.Redirect(rule.Status, target)
 	}
+	c.Abort()
 }
 
 func (f *bucketFS) GetThread(ctx context.Context, bkey string) (id thread.ID, err error) {
@@ -191,7 +463,9 @@ func (f *bucketFS) GetThread(ctx context.Context, bkey string) (id thread.ID, er
 	return key.ThreadID, nil
 }
 
-func (f *bucketFS) Exists(ctx context.Context, key, pth string) (ok bool, name string) {
+// Exists returns whether or not a file exists at pth.
+// If pth is a directory containing the file index, the index name is returned.
+func (f *bucketFS) Exists(ctx context.Context, key, pth, index string) (ok bool, name string) {
 	if key == "" || pth == "/" {
 		return
 	}
@@ -202,7 +476,7 @@ func (f *bucketFS) Exists(ctx context.Context, key, pth string) (ok bool, name s
 	}
 	if rep.Item.IsDir {
 		for _, item := range rep.Item.Items {
-			if item.Name == "index.html" {
+			if index != "" && item.Name == index {
 				return false, item.Name
 			}
 		}
@@ -211,13 +485,57 @@ func (f *bucketFS) Exists(ctx context.Context, key, pth string) (ok bool, name s
 	return true, ""
 }
 
-func (f *bucketFS) Write(ctx context.Context, key, pth string, writer io.Writer) error {
+// Stat returns the content type, CID, and content encoding of the file at pth.
+// The stored content type is used if present, otherwise it's guessed from the file extension.
+func (f *bucketFS) Stat(ctx context.Context, key, pth string) (contentType, cid, encoding string) {
+	ctx = common.NewSessionContext(ctx, f.session)
+	rep, err := f.client.ListPath(ctx, key, pth)
+	if err == nil {
+		cid = rep.Item.Cid
+		if md := rep.Item.Metadata; md != nil {
+			encoding = md.ContentEncoding
+			if md.ContentType != "" {
+				return md.ContentType, cid, encoding
+			}
+		}
+	}
+	if ctype := mime.TypeByExtension(filepath.Ext(pth)); ctype != "" {
+		return ctype, cid, encoding
+	}
+	return "application/octet-stream", cid, encoding
+}
+
+func (f *bucketFS) Write(ctx context.Context, key, pth string, writer io.Writer, opts ...client.Option) error {
 	ctx = common.NewSessionContext(ctx, f.session)
-	return f.client.PullPath(ctx, key, pth, writer)
+	return f.client.PullPath(ctx, key, pth, writer, opts...)
+}
+
+// WebConfig returns the web config of a bucket.
+// An empty config is returned if the bucket has none.
+func (f *bucketFS) WebConfig(ctx context.Context, key string) *mdb.WebConfig {
+	conf, err := f.webConfigs.Get(ctx, key)
+	if err != nil {
+		return &mdb.WebConfig{BucketKey: key}
+	}
+	return conf
+}
+
+// DomainBucket returns the key of the bucket served at a verified custom domain.
+func (f *bucketFS) DomainBucket(ctx context.Context, host string) (string, bool) {
+	if f.domains == nil {
+		return "", false
+	}
+	return f.domains.BucketKey(ctx, host)
 }
 
-func (f *bucketFS) ValidHost() string {
-	return f.host
+// Cache returns the response cache, if enabled.
+func (f *bucketFS) Cache() responseCache {
+	return f.cache
+}
+
+// ValidHosts returns the domains under which buckets are served as subdomains.
+func (f *bucketFS) ValidHosts() []string {
+	return f.hosts
 }
 
 // renderWWWBucket renders a bucket as a website.
@@ -245,31 +563,54 @@ func (g *Gateway) renderWWWBucket(c *gin.Context, key string) {
 		render404(c)
 		return
 	}
+	setBucket(c, buck.Key)
+	var website *buckets.Website
+	if conf, err := g.collections.WebConfigs.Get(ctx, buck.Key); err == nil {
+		website = conf.Website
+	}
 	rep, err := g.buckets.ListPath(ctx, buck.Key, "")
 	if err != nil {
 		renderError(c, http.StatusInternalServerError, err)
 		return
 	}
+	index := website.Index()
 	for _, item := range rep.Item.Items {
-		if item.Name == "index.html" {
-			c.Writer.WriteHeader(http.StatusOK)
-			c.Writer.Header().Set("Content-Type", "text/html")
-			if err := g.buckets.PullPath(ctx, buck.Key, item.Name, c.Writer); err != nil {
+		if item.Name == index {
+			ctype := mime.TypeByExtension(filepath.Ext(index))
+			var encoding string
+			if item.Metadata != nil {
+				encoding = item.Metadata.ContentEncoding
+			}
+			if item.Metadata != nil && item.Metadata.ContentType != "" {
+				ctype = item.Metadata.ContentType
+			} else if ctype == "" {
+				ctype = "text/html"
+			}
+			setWebsiteHeaders(c, website, c.Request.URL.Path)
+			if setETag(c, item.Cid) {
+				return
+			}
+			c.Writer.Header().Set("Content-Type", ctype)
+			if err := writeFile(c, encoding, cachedPull(g.cache, ctx, bucketCacheKey(buck.Key, item.Cid), func(w io.Writer, opts ...client.Option) error {
+				return g.buckets.PullPath(ctx, buck.Key, item.Name, w, opts...)
+			})); err != nil {
 				renderError(c, http.StatusInternalServerError, err)
 			}
 			return
 		}
 	}
-	renderError(c, http.StatusNotFound, fmt.Errorf("an index.html file was not found in this bucket"))
+	renderError(c, http.StatusNotFound, fmt.Errorf("an %s file was not found in this bucket", index))
 }
 
-func bucketFromHost(host, valid string) (key string, err error) {
+// bucketFromHost returns the bucket key of a host that is a subdomain of one of the valid hosts.
+func bucketFromHost(host string, valid []string) (key string, err error) {
 	parts := strings.SplitN(host, ".", 2)
 	hostport := parts[len(parts)-1]
 	hostparts := strings.SplitN(hostport, ":", 2)
-	if hostparts[0] != valid || valid == "" {
-		err = fmt.Errorf("invalid bucket host")
-		return
+	for _, v := range valid {
+		if v != "" && hostparts[0] == v && len(parts) == 2 {
+			return parts[0], nil
+		}
 	}
-	return parts[0], nil
+	return "", fmt.Errorf("invalid bucket host")
 }
@@ -0,0 +1,253 @@
+package gateway
+
+import (
+	"bufio"
+	"bytes"
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/textile/api/buckets/client"
+)
+
+const (
+	// maxCacheEntrySize is the largest response that is cached.
+	maxCacheEntrySize = 1 << 20
+	// immutableCacheControl is used for responses addressed by CID.
+	immutableCacheControl = "public, max-age=29030400, immutable"
+)
+
+// responseCache caches rendered responses by content address.
+type responseCache interface {
+	// Get returns the cached data for key.
+	Get(ctx context.Context, key string) ([]byte, bool)
+	// Set caches data under key.
+	Set(ctx context.Context, key string, data []byte)
+}
+
+// bucketCacheKey returns the cache key of content with id, a CID or IPFS path, in the bucket with key.
+// Bucket content is keyed separately from raw IPFS content because it may have been decrypted.
+func bucketCacheKey(key, id string) string {
+	if id == "" {
+		return ""
+	}
+	return path.Join("/buckets", key, id)
+}
+
+// cachedPull wraps pull with cache.
+// Only full, decoded pulls of entries up to maxCacheEntrySize are cached.
+func cachedPull(cache responseCache, ctx context.Context, key string, pull pullFunc) pullFunc {
+	if cache == nil || key == "" {
+		return pull
+	}
+	return func(w io.Writer, opts ...client.Option) error {
+		if len(opts) > 0 {
+			return pull(w, opts...)
+		}
+		if data, ok := cache.Get(ctx, key); ok {
+			_, err := w.Write(data)
+			return err
+		}
+		cw := &cacheWriter{w: w}
+		if err := pull(cw, opts...); err != nil {
+			return err
+		}
+		if !cw.overflow {
+			cache.Set(ctx, key, cw.buf.Bytes())
+		}
+		return nil
+	}
+}
+
+// cacheWriter writes to w while buffering up to maxCacheEntrySize bytes.
+type cacheWriter struct {
+	w        io.Writer
+	buf      bytes.Buffer
+	overflow bool
+}
+
+func (w *cacheWriter) Write(p []byte) (int, error) {
+	if !w.overflow {
+		if w.buf.Len()+len(p) > maxCacheEntrySize {
+			w.overflow = true
+			w.buf = bytes.Buffer{}
+		} else {
+			w.buf.Write(p)
+		}
+	}
+	return w.w.Write(p)
+}
+
+// setImmutable marks the response as cacheable forever.
+func setImmutable(c *gin.Context) {
+	c.Writer.Header().Set("Cache-Control", immutableCacheControl)
+}
+
+// memoryCache is an in-process LRU cache bounded by the total size of its entries.
+type memoryCache struct {
+	sync.Mutex
+
+	size    int64
+	maxSize int64
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key  string
+	data []byte
+}
+
+func newMemoryCache(maxSize int64) *memoryCache {
+	return &memoryCache{
+		maxSize: maxSize,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (m *memoryCache) Get(_ context.Context, key string) ([]byte, bool) {
+	m.Lock()
+	defer m.Unlock()
+	e, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	m.order.MoveToFront(e)
+	return e.Value.(*memoryEntry).data, true
+}
+
+func (m *memoryCache) Set(_ context.Context, key string, data []byte) {
+	if int64(len(data)) > m.maxSize {
+		return
+	}
+	m.Lock()
+	defer m.Unlock()
+	if e, ok := m.entries[key]; ok {
+		m.order.MoveToFront(e)
+		return
+	}
+	m.entries[key] = m.order.PushFront(&memoryEntry{key: key, data: data})
+	m.size += int64(len(data))
+	for m.size > m.maxSize {
+		e := m.order.Back()
+		entry := e.Value.(*memoryEntry)
+		m.order.Remove(e)
+		delete(m.entries, entry.key)
+		m.size -= int64(len(entry.data))
+	}
+}
+
+// redisCacheTTL is how long entries live in Redis.
+// Entries are content addressed, so the TTL only bounds the cache size.
+const redisCacheTTL = 24 * time.Hour
+
+// redisTimeout bounds each Redis round trip so a slow cache never delays a response for long.
+const redisTimeout = time.Second
+
+// redisCache stores entries in Redis with a TTL.
+// Errors are logged and treated as cache misses.
+type redisCache struct {
+	sync.Mutex
+
+	addr string
+	ttl  time.Duration
+	conn net.Conn
+	r    *bufio.Reader
+}
+
+func newRedisCache(addr string, ttl time.Duration) *redisCache {
+	return &redisCache{addr: addr, ttl: ttl}
+}
+
+func (r *redisCache) Get(_ context.Context, key string) ([]byte, bool) {
+	data, err := r.do([]byte("GET"), []byte(key))
+	if err != nil {
+		log.Debugf("redis get %s: %v", key, err)
+		return nil, false
+	}
+	return data, data != nil
+}
+
+func (r *redisCache) Set(_ context.Context, key string, data []byte) {
+	ms := strconv.FormatInt(r.ttl.Milliseconds(), 10)
+	if _, err := r.do([]byte("SET"), []byte(key), data, []byte("PX"), []byte(ms)); err != nil {
+		log.Debugf("redis set %s: %v", key, err)
+	}
+}
+
+// do sends a command and returns the bulk string reply, if any.
+// The connection is dropped on error and redialed by the next command.
+func (r *redisCache) do(args ...[]byte) ([]byte, error) {
+	r.Lock()
+	defer r.Unlock()
+	if r.conn == nil {
+		conn, err := net.DialTimeout("tcp", r.addr, redisTimeout)
+		if err != nil {
+			return nil, err
+		}
+		r.conn = conn
+		r.r = bufio.NewReader(conn)
+	}
+	data, err := r.roundTrip(args)
+	if err != nil {
+		r.conn.Close()
+		r.conn = nil
+		return nil, err
+	}
+	return data, nil
+}
+
+func (r *redisCache) roundTrip(args [][]byte) ([]byte, error) {
+	if err := r.conn.SetDeadline(time.Now().Add(redisTimeout)); err != nil {
+		return nil, err
+	}
+	var cmd bytes.Buffer
+	fmt.Fprintf(&cmd, "*%d\r\n", len(args))
+	for _, a := range args {
+		fmt.Fprintf(&cmd, "$%d\r\n", len(a))
+		cmd.Write(a)
+		cmd.WriteString("\r\n")
+	}
+	if _, err := r.conn.Write(cmd.Bytes()); err != nil {
+		return nil, err
+	}
+
+	line, err := r.r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 {
+		return nil, errors.New("invalid redis reply")
+	}
+	line = line[:len(line)-2]
+	switch line[0] {
+	case '+', ':':
+		return nil, nil
+	case '-':
+		return nil, errors.New(line[1:])
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		data := make([]byte, n+2)
+		if _, err := io.ReadFull(r.r, data); err != nil {
+			return nil, err
+		}
+		return data[:n], nil
+	default:
+		return nil, fmt.Errorf("unexpected redis reply %q", line)
+	}
+}
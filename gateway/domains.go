@@ -0,0 +1,91 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	mdb "github.com/textileio/textile/mongodb"
+	"golang.org/x/crypto/acme/autocert"
+)
+
+// domainCacheTTL is how long a custom domain lookup is cached.
+const domainCacheTTL = time.Minute
+
+// domainCache maps custom domain names to bucket keys.
+type domainCache struct {
+	sync.Mutex
+
+	domains *mdb.Domains
+	entries map[string]domainEntry
+}
+
+type domainEntry struct {
+	key     string
+	expires time.Time
+}
+
+func newDomainCache(domains *mdb.Domains) *domainCache {
+	return &domainCache{
+		domains: domains,
+		entries: make(map[string]domainEntry),
+	}
+}
+
+// BucketKey returns the key of the bucket served at host.
+// Only verified custom domains are considered. Both hits and misses are cached.
+func (c *domainCache) BucketKey(ctx context.Context, host string) (string, bool) {
+	name := hostName(host)
+	if name == "" || net.ParseIP(name) != nil || !strings.Contains(name, ".") {
+		return "", false
+	}
+	c.Lock()
+	e, ok := c.entries[name]
+	c.Unlock()
+	if ok && time.Now().Before(e.expires) {
+		return e.key, e.key != ""
+	}
+
+	var key string
+	if d, err := c.domains.GetByName(ctx, name); err == nil && d.Verified {
+		key = d.BucketKey
+	}
+	c.Lock()
+	c.entries[name] = domainEntry{key: key, expires: time.Now().Add(domainCacheTTL)}
+	c.Unlock()
+	return key, key != ""
+}
+
+// hostPolicy only allows certificates for verified custom domains.
+func (c *domainCache) hostPolicy(ctx context.Context, host string) error {
+	d, err := c.domains.GetByName(ctx, hostName(host))
+	if err != nil {
+		return fmt.Errorf("unknown domain %s", host)
+	}
+	if !d.Verified {
+		return fmt.Errorf("domain %s is not verified", host)
+	}
+	return nil
+}
+
+// newCertManager returns an ACME certificate manager for custom domains.
+// Certificates are stored in dir.
+func newCertManager(domains *domainCache, dir, email string) *autocert.Manager {
+	return &autocert.Manager{
+		Prompt:     autocert.AcceptTOS,
+		Cache:      autocert.DirCache(dir),
+		HostPolicy: domains.hostPolicy,
+		Email:      email,
+	}
+}
+
+// hostName returns host without its port in lower case.
+func hostName(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	return strings.TrimSuffix(strings.ToLower(host), ".")
+}
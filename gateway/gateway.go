@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"html/template"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/url"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -56,20 +58,34 @@ type Gateway struct {
 	sync.Mutex
 
 	server        *http.Server
+	tlsServer     *http.Server
+	metricsServer *http.Server
 	addr          ma.Multiaddr
+	tlsAddr       ma.Multiaddr
+	metricsAddr   ma.Multiaddr
+	tlsCacheDir   string
+	acmeEmail     string
 	url           string
+	gatewayHost   string
 	subdomains    bool
+	previews      bool
 	bucketsDomain string
 
 	collections *mdb.Collections
+	domains     *domainCache
+	shareLimits *shareLimiter
+	cache       responseCache
+	metrics     *metrics
 	apiSession  string
 	threads     *threadsclient.Client
 	buckets     *bucketsclient.Client
 	hub         bool
 
-	ipfs iface.CoreAPI
+	ipfs      iface.CoreAPI
+	templates *template.Template
 
 	emailSessionBus *broadcast.Broadcaster
+	accountEventBus *broadcast.Broadcaster
 }
 
 // Config defines the gateway configuration.
@@ -83,8 +99,36 @@ type Config struct {
 	Collections     *mdb.Collections
 	IPFSClient      iface.CoreAPI
 	EmailSessionBus *broadcast.Broadcaster
+	AccountEventBus *broadcast.Broadcaster
 	Hub             bool
 	Debug           bool
+
+	// TLSAddr is an optional address that serves verified custom domains over HTTPS.
+	// Certificates are provisioned via ACME HTTP-01 challenges answered on Addr,
+	// which must be reachable on port 80 of each domain.
+	TLSAddr ma.Multiaddr
+	// TLSCacheDir is where ACME certificates are stored.
+	TLSCacheDir string
+	// ACMEEmail is the contact address given to the ACME CA.
+	ACMEEmail string
+
+	// MetricsAddr is an optional address that serves Prometheus metrics at /metrics.
+	MetricsAddr ma.Multiaddr
+	// AccessLog enables structured access logs.
+	AccessLog bool
+
+	// CacheSize is the max size in bytes of the in-process response cache.
+	// Zero disables the cache.
+	CacheSize int64
+	// CacheRedisAddr is an optional Redis address used instead of the in-process cache.
+	CacheRedisAddr string
+
+	// Previews enables rendering of READMEs, Markdown, and source files when browsing buckets.
+	Previews bool
+
+	// TemplatesDir is an optional directory of .gohtml files that override
+	// the embedded HTML templates with the same file name.
+	TemplatesDir string
 }
 
 // NewGateway returns a new gateway.
@@ -96,6 +140,13 @@ func NewGateway(conf Config) (*Gateway, error) {
 			return nil, err
 		}
 	}
+	if conf.AccessLog {
+		if err := tutil.SetLogLevels(map[string]logging.LogLevel{
+			"gateway.access": logging.LevelInfo,
+		}); err != nil {
+			return nil, err
+		}
+	}
 
 	apiTarget, err := tutil.TCPAddrFromMultiAddr(conf.APIAddr)
 	if err != nil {
@@ -113,18 +164,40 @@ func NewGateway(conf Config) (*Gateway, error) {
 	if err != nil {
 		return nil, err
 	}
+	temp, err := loadTemplate(conf.TemplatesDir)
+	if err != nil {
+		return nil, fmt.Errorf("loading templates: %v", err)
+	}
+	var cache responseCache
+	if conf.CacheRedisAddr != "" {
+		cache = newRedisCache(conf.CacheRedisAddr, redisCacheTTL)
+	} else if conf.CacheSize > 0 {
+		cache = newMemoryCache(conf.CacheSize)
+	}
 	return &Gateway{
 		addr:            conf.Addr,
+		tlsAddr:         conf.TLSAddr,
+		metricsAddr:     conf.MetricsAddr,
+		tlsCacheDir:     conf.TLSCacheDir,
+		acmeEmail:       conf.ACMEEmail,
 		url:             conf.URL,
+		gatewayHost:     gatewayHost(conf.URL),
+		previews:        conf.Previews,
 		subdomains:      conf.Subdomains,
 		bucketsDomain:   conf.BucketsDomain,
 		collections:     conf.Collections,
+		domains:         newDomainCache(conf.Collections.Domains),
+		shareLimits:     newShareLimiter(),
+		cache:           cache,
+		metrics:         newMetrics(),
 		apiSession:      conf.APISession,
 		threads:         tc,
 		buckets:         bc,
 		hub:             conf.Hub,
 		ipfs:            conf.IPFSClient,
+		templates:       temp,
 		emailSessionBus: conf.EmailSessionBus,
+		accountEventBus: conf.AccountEventBus,
 	}, nil
 }
 
@@ -134,21 +207,20 @@ func (g *Gateway) Start() {
 	if err != nil {
 		log.Fatal(err)
 	}
-	router := gin.Default()
-
-	temp, err := loadTemplate()
-	if err != nil {
-		log.Fatal(err)
-	}
-	router.SetHTMLTemplate(temp)
+	router := gin.New()
+	router.Use(gin.Recovery(), g.instrument)
+	router.SetHTMLTemplate(g.templates)
 
 	router.Use(location.Default())
 	router.Use(static.Serve("", &fileSystem{Assets}))
 	router.Use(serveBucket(&bucketFS{
-		client:  g.buckets,
-		keys:    g.collections.IPNSKeys,
-		session: g.apiSession,
-		host:    g.bucketsDomain,
+		client:     g.buckets,
+		keys:       g.collections.IPNSKeys,
+		webConfigs: g.collections.WebConfigs,
+		domains:    g.domains,
+		cache:      g.cache,
+		session:    g.apiSession,
+		hosts:      []string{g.bucketsDomain, g.gatewayHost},
 	}))
 	router.Use(gincors.New(cors.Options{}))
 
@@ -168,6 +240,11 @@ func (g *Gateway) Start() {
 	router.GET("/ipld/:root", g.subdomainOptionHandler, g.ipldHandler)
 	router.GET("/ipld/:root/*path", g.subdomainOptionHandler, g.ipldHandler)
 
+	router.GET("/share/:token", g.shareHandler)
+	router.GET("/share/:token/*path", g.shareHandler)
+	router.POST("/share/:token", g.sharePasswordHandler)
+	router.POST("/share/:token/*path", g.sharePasswordHandler)
+
 	if g.hub {
 		router.GET("/dashboard/:username", g.dashboardHandler)
 		router.GET("/confirm/:secret", g.confirmEmail)
@@ -176,9 +253,50 @@ func (g *Gateway) Start() {
 
 	router.NoRoute(g.subdomainHandler)
 
+	if g.metricsAddr != nil {
+		metricsAddr, err := tutil.TCPAddrFromMultiAddr(g.metricsAddr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", g.metrics)
+		g.metricsServer = &http.Server{
+			Addr:    metricsAddr,
+			Handler: mux,
+		}
+		go func() {
+			if err := g.metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("gateway metrics error: %s", err)
+			}
+		}()
+		log.Infof("gateway metrics listening at %s", g.metricsServer.Addr)
+	}
+
+	var handler http.Handler = router
+	if g.tlsAddr != nil {
+		tlsAddr, err := tutil.TCPAddrFromMultiAddr(g.tlsAddr)
+		if err != nil {
+			log.Fatal(err)
+		}
+		m := newCertManager(g.domains, g.tlsCacheDir, g.acmeEmail)
+		handler = m.HTTPHandler(router)
+		g.tlsServer = &http.Server{
+			Addr:      tlsAddr,
+			Handler:   router,
+			TLSConfig: m.TLSConfig(),
+		}
+		go func() {
+			if err := g.tlsServer.ListenAndServeTLS("", ""); err != nil && err != http.ErrServerClosed {
+				log.Fatalf("gateway tls error: %s", err)
+			}
+			log.Info("gateway tls was shutdown")
+		}()
+		log.Infof("gateway tls listening at %s", g.tlsServer.Addr)
+	}
+
 	g.server = &http.Server{
 		Addr:    addr,
-		Handler: router,
+		Handler: handler,
 	}
 	go func() {
 		if err := g.server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -189,9 +307,13 @@ func (g *Gateway) Start() {
 	log.Infof("gateway listening at %s", g.server.Addr)
 }
 
+// templatesPrefix is the asset path of the embedded HTML templates.
+const templatesPrefix = "/public/html/"
+
 // loadTemplate loads HTML templates.
-func loadTemplate() (*template.Template, error) {
-	t := template.New("")
+// Templates in dir, if set, replace embedded templates with the same file name.
+func loadTemplate(dir string) (*template.Template, error) {
+	sources := make(map[string]string)
 	for name, file := range Assets.Files {
 		if file.IsDir() || !strings.HasSuffix(name, ".gohtml") {
 			continue
@@ -200,10 +322,33 @@ func loadTemplate() (*template.Template, error) {
 		if err != nil {
 			return nil, err
 		}
-		t, err = t.New(name).Parse(string(h))
+		sources[name] = string(h)
+	}
+	if dir != "" {
+		entries, err := ioutil.ReadDir(dir)
 		if err != nil {
 			return nil, err
 		}
+		for _, e := range entries {
+			if e.IsDir() || !strings.HasSuffix(e.Name(), ".gohtml") {
+				continue
+			}
+			h, err := ioutil.ReadFile(filepath.Join(dir, e.Name()))
+			if err != nil {
+				return nil, err
+			}
+			sources[templatesPrefix+e.Name()] = string(h)
+			log.Debugf("overriding template %s from %s", e.Name(), dir)
+		}
+	}
+
+	t := template.New("")
+	for name, src := range sources {
+		var err error
+		t, err = t.New(name).Parse(src)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %v", name, err)
+		}
 	}
 	return t, nil
 }
@@ -220,6 +365,16 @@ func (g *Gateway) Stop() error {
 	if err := g.server.Shutdown(ctx); err != nil {
 		return err
 	}
+	if g.tlsServer != nil {
+		if err := g.tlsServer.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+	if g.metricsServer != nil {
+		if err := g.metricsServer.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
 	if err := g.threads.Close(); err != nil {
 		return err
 	}
@@ -312,6 +467,7 @@ func (g *Gateway) consentInvite(c *gin.Context) {
 				}
 				return
 			}
+			common.PublishAccountEvent(g.accountEventBus, dev.Key, common.OrgJoined, invite.Org, "")
 			if err = g.collections.Invites.Delete(ctx, invite.Token); err != nil {
 				renderError(c, http.StatusInternalServerError, err)
 				return
@@ -357,6 +513,10 @@ func (g *Gateway) subdomainHandler(c *gin.Context) {
 		g.renderWWWBucket(c, key)
 		return
 	}
+	if g.gatewayHost != "" && len(parts) > 1 && strings.Join(parts[1:], ".") == g.gatewayHost {
+		g.renderWWWBucket(c, key)
+		return
+	}
 
 	if len(parts) < 3 {
 		render404(c)
@@ -405,6 +565,21 @@ func (g *Gateway) subdomainHandler(c *gin.Context) {
 	}
 }
 
+// gatewayHost returns the host name of the gateway URL u.
+// Buckets are served from subdomains of the host, e.g., <bucket>.<host>, which isolates their origins.
+// An empty string is returned if the host is an IP address, which can't have subdomains.
+func gatewayHost(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return ""
+	}
+	host := parsed.Hostname()
+	if host == "" || net.ParseIP(host) != nil {
+		return ""
+	}
+	return strings.ToLower(host)
+}
+
 // Modified from https://github.com/ipfs/go-ipfs/blob/dbfa7bf2b216bad9bec1ff66b1f3814f4faac31e/core/corehttp/hostname.go#L251
 func isSubdomainNamespace(ns string) bool {
 	switch ns {
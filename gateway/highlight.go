@@ -0,0 +1,207 @@
+package gateway
+
+import (
+	"html"
+	"path/filepath"
+	"strings"
+)
+
+// syntax describes how source code in a language is tokenized for highlighting.
+type syntax struct {
+	lineComment  string
+	blockComment [2]string
+	quotes       string
+	keywords     map[string]bool
+}
+
+func keywords(words string) map[string]bool {
+	m := make(map[string]bool)
+	for _, w := range strings.Fields(words) {
+		m[w] = true
+	}
+	return m
+}
+
+var (
+	goSyntax = &syntax{
+		lineComment:  "//",
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'`",
+		keywords: keywords(`break case chan const continue default defer else fallthrough for func go goto if
+			import interface map package range return select struct switch type var nil true false`),
+	}
+	jsSyntax = &syntax{
+		lineComment:  "//",
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'`",
+		keywords: keywords(`async await break case catch class const continue debugger default delete do else
+			export extends finally for from function if import in instanceof interface let new of return
+			static super switch this throw try type typeof var void while with yield null undefined true false`),
+	}
+	cSyntax = &syntax{
+		lineComment:  "//",
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'",
+		keywords: keywords(`auto break case catch char class const continue default do double else enum extends
+			final float for fn if impl implements import int let long match mod mut namespace new package
+			private protected pub public return self short static struct super switch this throw try
+			trait typedef unsigned use void while null true false`),
+	}
+	pythonSyntax = &syntax{
+		lineComment: "#",
+		quotes:      "\"'",
+		keywords: keywords(`and as assert async await break class continue def del elif else except finally for
+			from global if import in is lambda nonlocal not or pass raise return try while with yield
+			None True False`),
+	}
+	shellSyntax = &syntax{
+		lineComment: "#",
+		quotes:      "\"'",
+		keywords:    keywords(`case do done elif else esac export fi for function if in local return then until while`),
+	}
+	sqlSyntax = &syntax{
+		lineComment:  "--",
+		blockComment: [2]string{"/*", "*/"},
+		quotes:       "\"'",
+		keywords: keywords(`select from where insert into values update set delete create table drop alter
+			index join left right inner outer on and or not null as order by group having limit
+			SELECT FROM WHERE INSERT INTO VALUES UPDATE SET DELETE CREATE TABLE DROP ALTER
+			INDEX JOIN LEFT RIGHT INNER OUTER ON AND OR NOT NULL AS ORDER BY GROUP HAVING LIMIT`),
+	}
+	configSyntax = &syntax{
+		lineComment: "#",
+		quotes:      "\"'",
+		keywords:    keywords(`true false null`),
+	}
+	jsonSyntax = &syntax{
+		quotes:   "\"",
+		keywords: keywords(`true false null`),
+	}
+	textSyntax = &syntax{}
+)
+
+// languages maps language names and file extensions to their syntax.
+var languages = map[string]*syntax{
+	"go":         goSyntax,
+	"js":         jsSyntax,
+	"jsx":        jsSyntax,
+	"mjs":        jsSyntax,
+	"javascript": jsSyntax,
+	"ts":         jsSyntax,
+	"tsx":        jsSyntax,
+	"typescript": jsSyntax,
+	"c":          cSyntax,
+	"h":          cSyntax,
+	"cc":         cSyntax,
+	"cpp":        cSyntax,
+	"hpp":        cSyntax,
+	"java":       cSyntax,
+	"kt":         cSyntax,
+	"rs":         cSyntax,
+	"rust":       cSyntax,
+	"sol":        cSyntax,
+	"swift":      cSyntax,
+	"proto":      cSyntax,
+	"css":        cSyntax,
+	"py":         pythonSyntax,
+	"python":     pythonSyntax,
+	"sh":         shellSyntax,
+	"bash":       shellSyntax,
+	"shell":      shellSyntax,
+	"sql":        sqlSyntax,
+	"yml":        configSyntax,
+	"yaml":       configSyntax,
+	"toml":       configSyntax,
+	"ini":        configSyntax,
+	"json":       jsonSyntax,
+	"txt":        textSyntax,
+	"text":       textSyntax,
+	"csv":        textSyntax,
+	"log":        textSyntax,
+}
+
+// sourceLanguage returns the language of the file name, if it can be highlighted.
+func sourceLanguage(name string) (string, bool) {
+	lower := strings.ToLower(name)
+	switch lower {
+	case "makefile", "dockerfile":
+		return "sh", true
+	}
+	ext := strings.TrimPrefix(filepath.Ext(lower), ".")
+	_, ok := languages[ext]
+	return ext, ok
+}
+
+// highlight renders src as a code block with spans for the comments, strings, numbers, and keywords of lang.
+// Unknown languages are rendered without spans.
+func highlight(src, lang string) string {
+	syn, ok := languages[strings.ToLower(lang)]
+	if !ok {
+		syn = textSyntax
+	}
+	var b strings.Builder
+	b.WriteString(`<pre class="code"><code>`)
+	span := func(class, text string) {
+		b.WriteString(`<span class="` + class + `">` + html.EscapeString(text) + `</span>`)
+	}
+	for i := 0; i < len(src); {
+		rest := src[i:]
+		c := src[i]
+		switch {
+		case syn.blockComment[0] != "" && strings.HasPrefix(rest, syn.blockComment[0]):
+			end := strings.Index(rest[len(syn.blockComment[0]):], syn.blockComment[1])
+			n := len(rest)
+			if end >= 0 {
+				n = len(syn.blockComment[0]) + end + len(syn.blockComment[1])
+			}
+			span("comment", rest[:n])
+			i += n
+		case syn.lineComment != "" && strings.HasPrefix(rest, syn.lineComment):
+			n := strings.IndexByte(rest, '\n')
+			if n < 0 {
+				n = len(rest)
+			}
+			span("comment", rest[:n])
+			i += n
+		case strings.IndexByte(syn.quotes, c) >= 0:
+			n := 1
+			for n < len(rest) && rest[n] != c && (c == '`' || rest[n] != '\n') {
+				if rest[n] == '\\' && c != '`' {
+					n++
+				}
+				n++
+			}
+			if n < len(rest) && rest[n] == c {
+				n++
+			}
+			if n > len(rest) {
+				n = len(rest)
+			}
+			span("string", rest[:n])
+			i += n
+		case c >= '0' && c <= '9' && (i == 0 || !isWordByte(src[i-1])):
+			n := 1
+			for n < len(rest) && (isWordByte(rest[n]) || rest[n] == '.') {
+				n++
+			}
+			span("number", rest[:n])
+			i += n
+		case isWordByte(c):
+			n := 1
+			for n < len(rest) && isWordByte(rest[n]) {
+				n++
+			}
+			if syn.keywords[rest[:n]] {
+				span("keyword", rest[:n])
+			} else {
+				b.WriteString(html.EscapeString(rest[:n]))
+			}
+			i += n
+		default:
+			b.WriteString(html.EscapeString(rest[:1]))
+			i++
+		}
+	}
+	b.WriteString("</code></pre>\n")
+	return b.String()
+}
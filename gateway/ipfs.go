@@ -26,7 +26,7 @@ func (g *Gateway) renderIPFSPath(c *gin.Context, base, pth string) {
 	ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
 	defer cancel()
 	pth = strings.TrimSuffix(pth, "/")
-	data, err := g.openPath(ctx, path.New(pth))
+	data, err := g.openCachedPath(ctx, pth)
 	if err != nil {
 		if err == iface.ErrIsDir {
 			var root, dir, back string
@@ -83,10 +83,30 @@ func (g *Gateway) renderIPFSPath(c *gin.Context, base, pth string) {
 			return
 		}
 	} else {
+		if strings.HasPrefix(base, "ipfs/") {
+			setImmutable(c)
+		}
 		c.Render(200, render.Data{Data: data})
 	}
 }
 
+// openCachedPath returns the file data at the IPFS path pth, using the response cache if enabled.
+func (g *Gateway) openCachedPath(ctx context.Context, pth string) ([]byte, error) {
+	if g.cache != nil {
+		if data, ok := g.cache.Get(ctx, pth); ok {
+			return data, nil
+		}
+	}
+	data, err := g.openPath(ctx, path.New(pth))
+	if err != nil {
+		return nil, err
+	}
+	if g.cache != nil && len(data) <= maxCacheEntrySize {
+		g.cache.Set(ctx, pth, data)
+	}
+	return data, nil
+}
+
 func (g *Gateway) openPath(ctx context.Context, pth path.Path) ([]byte, error) {
 	f, err := g.ipfs.Unixfs().Get(ctx, pth)
 	if err != nil {
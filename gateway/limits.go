@@ -0,0 +1,182 @@
+package gateway
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// shareIPBurst is the number of share link password attempts a client IP can make at once.
+	shareIPBurst = 10
+	// shareIPInterval is how long a client IP waits to regain a password attempt.
+	shareIPInterval = time.Second * 6
+	// shareIPMaxFails is the number of consecutive wrong passwords after which a client IP is locked out.
+	shareIPMaxFails = 10
+
+	// shareLinkBurst is the number of password attempts that can be made on a share link at once.
+	shareLinkBurst = 30
+	// shareLinkInterval is how long a share link waits to regain a password attempt.
+	shareLinkInterval = time.Second * 2
+	// shareLinkMaxFails is the number of consecutive wrong passwords after which a share link is locked out.
+	shareLinkMaxFails = 100
+
+	// shareLockout is how long a locked out client IP or share link can't make password attempts.
+	shareLockout = time.Minute * 15
+	// maxShareHashes is the max number of share link passwords that are hashed at once.
+	// Each hash uses 64 MiB of memory.
+	maxShareHashes = 4
+	// maxLimiterKeys is the number of tracked keys after which idle keys are pruned.
+	maxLimiterKeys = 10000
+)
+
+// attemptLimiter rate limits attempts by key with a token bucket.
+// Keys are locked out after too many consecutive failed attempts.
+// attemptLimiter is not safe for concurrent use.
+type attemptLimiter struct {
+	burst    float64
+	interval time.Duration
+	maxFails int
+	lockout  time.Duration
+	keys     map[string]*attemptState
+}
+
+type attemptState struct {
+	tokens      float64
+	last        time.Time
+	fails       int
+	lockedUntil time.Time
+}
+
+func newAttemptLimiter(burst int, interval time.Duration, maxFails int, lockout time.Duration) *attemptLimiter {
+	return &attemptLimiter{
+		burst:    float64(burst),
+		interval: interval,
+		maxFails: maxFails,
+		lockout:  lockout,
+		keys:     make(map[string]*attemptState),
+	}
+}
+
+// state returns the state of key with tokens refilled up to now.
+func (l *attemptLimiter) state(key string, now time.Time) *attemptState {
+	s, ok := l.keys[key]
+	if !ok {
+		s = &attemptState{tokens: l.burst, last: now}
+		l.keys[key] = s
+		return s
+	}
+	s.tokens = math.Min(l.burst, s.tokens+float64(now.Sub(s.last))/float64(l.interval))
+	s.last = now
+	return s
+}
+
+// retryAfter returns how long key must wait before its next attempt.
+// Zero is returned if an attempt can be made now.
+func (l *attemptLimiter) retryAfter(key string, now time.Time) time.Duration {
+	s := l.state(key, now)
+	if now.Before(s.lockedUntil) {
+		return s.lockedUntil.Sub(now)
+	}
+	if s.tokens < 1 {
+		return time.Duration((1 - s.tokens) * float64(l.interval))
+	}
+	return 0
+}
+
+// take uses an attempt of key.
+func (l *attemptLimiter) take(key string, now time.Time) {
+	l.state(key, now).tokens--
+}
+
+// record records the result of an attempt of key.
+// The key is locked out once it reaches the max consecutive failures.
+func (l *attemptLimiter) record(key string, ok bool, now time.Time) {
+	s := l.state(key, now)
+	if ok {
+		s.fails = 0
+		return
+	}
+	s.fails++
+	if s.fails >= l.maxFails {
+		s.fails = 0
+		s.lockedUntil = now.Add(l.lockout)
+	}
+}
+
+// prune removes keys that are idle, unlocked, and have no failures.
+func (l *attemptLimiter) prune(now time.Time) {
+	for k, s := range l.keys {
+		refilled := s.tokens+float64(now.Sub(s.last))/float64(l.interval) >= l.burst
+		if refilled && s.fails == 0 && !now.Before(s.lockedUntil) {
+			delete(l.keys, k)
+		}
+	}
+}
+
+// shareLimiter guards share link password checks, which are expensive by design.
+// Attempts are limited by client IP and by link, and only maxShareHashes passwords are hashed at once.
+type shareLimiter struct {
+	sync.Mutex
+
+	byIP   *attemptLimiter
+	byLink *attemptLimiter
+	hashes chan struct{}
+}
+
+func newShareLimiter() *shareLimiter {
+	return &shareLimiter{
+		byIP:   newAttemptLimiter(shareIPBurst, shareIPInterval, shareIPMaxFails, shareLockout),
+		byLink: newAttemptLimiter(shareLinkBurst, shareLinkInterval, shareLinkMaxFails, shareLockout),
+		hashes: make(chan struct{}, maxShareHashes),
+	}
+}
+
+// allow uses a password attempt of ip on the link with token.
+// If the attempt isn't allowed, false is returned with how long to wait before retrying.
+func (l *shareLimiter) allow(ip, token string) (time.Duration, bool) {
+	l.Lock()
+	defer l.Unlock()
+	now := time.Now()
+	if len(l.byIP.keys) > maxLimiterKeys {
+		l.byIP.prune(now)
+	}
+	if len(l.byLink.keys) > maxLimiterKeys {
+		l.byLink.prune(now)
+	}
+	wait := l.byIP.retryAfter(ip, now)
+	if w := l.byLink.retryAfter(token, now); w > wait {
+		wait = w
+	}
+	if wait > 0 {
+		return wait, false
+	}
+	l.byIP.take(ip, now)
+	l.byLink.take(token, now)
+	return 0, true
+}
+
+// record records the result of a password attempt of ip on the link with token.
+func (l *shareLimiter) record(ip, token string, ok bool) {
+	l.Lock()
+	defer l.Unlock()
+	now := time.Now()
+	l.byIP.record(ip, ok, now)
+	l.byLink.record(token, ok, now)
+}
+
+// acquireHash reserves one of the concurrent password hashes.
+// False is returned if all are in use.
+func (l *shareLimiter) acquireHash() bool {
+	select {
+	case l.hashes <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// releaseHash releases a hash reserved with acquireHash.
+func (l *shareLimiter) releaseHash() {
+	<-l.hashes
+}
@@ -0,0 +1,63 @@
+package gateway
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttemptLimiter_Burst(t *testing.T) {
+	l := newAttemptLimiter(3, time.Second, 100, time.Minute)
+	now := time.Now()
+	for i := 0; i < 3; i++ {
+		assert.Zero(t, l.retryAfter("key", now))
+		l.take("key", now)
+	}
+	assert.Equal(t, time.Second, l.retryAfter("key", now))
+	assert.Zero(t, l.retryAfter("other", now))
+
+	now = now.Add(time.Second)
+	assert.Zero(t, l.retryAfter("key", now))
+}
+
+func TestAttemptLimiter_Lockout(t *testing.T) {
+	l := newAttemptLimiter(100, time.Second, 3, time.Minute)
+	now := time.Now()
+	l.record("key", false, now)
+	l.record("key", false, now)
+	l.record("key", true, now)
+	l.record("key", false, now)
+	l.record("key", false, now)
+	assert.Zero(t, l.retryAfter("key", now))
+
+	l.record("key", false, now)
+	assert.Equal(t, time.Minute, l.retryAfter("key", now))
+	assert.Equal(t, time.Second, l.retryAfter("key", now.Add(time.Minute-time.Second)))
+	assert.Zero(t, l.retryAfter("key", now.Add(time.Minute)))
+}
+
+func TestAttemptLimiter_Prune(t *testing.T) {
+	l := newAttemptLimiter(2, time.Second, 3, time.Minute)
+	now := time.Now()
+	l.take("idle", now)
+	l.take("failed", now)
+	l.record("failed", false, now)
+	l.take("busy", now.Add(time.Second))
+	l.take("busy", now.Add(time.Second))
+
+	l.prune(now.Add(time.Second * 2))
+	assert.NotContains(t, l.keys, "idle")
+	assert.Contains(t, l.keys, "failed")
+	assert.Contains(t, l.keys, "busy")
+}
+
+func TestShareLimiter_Hashes(t *testing.T) {
+	l := newShareLimiter()
+	for i := 0; i < maxShareHashes; i++ {
+		assert.True(t, l.acquireHash())
+	}
+	assert.False(t, l.acquireHash())
+	l.releaseHash()
+	assert.True(t, l.acquireHash())
+}
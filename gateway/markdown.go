@@ -0,0 +1,291 @@
+package gateway
+
+import (
+	"fmt"
+	"html"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// markdown renders a safe subset of Markdown to HTML.
+// All text is escaped, and links with schemes other than http, https, and mailto are dropped.
+type markdown struct {
+	// base is the path that relative links are resolved against.
+	base string
+}
+
+// renderMarkdown renders src to HTML, resolving relative links against base.
+func renderMarkdown(src, base string) string {
+	m := &markdown{base: base}
+	var b strings.Builder
+	m.blocks(&b, strings.Split(strings.ReplaceAll(src, "\r\n", "\n"), "\n"))
+	return b.String()
+}
+
+func (m *markdown) blocks(b *strings.Builder, lines []string) {
+	for i := 0; i < len(lines); {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "":
+			i++
+		case isFence(trimmed):
+			fence, lang := trimmed[:3], strings.TrimSpace(trimmed[3:])
+			var code []string
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), fence); i++ {
+				code = append(code, lines[i])
+			}
+			i++
+			b.WriteString(highlight(strings.Join(code, "\n"), lang))
+		case headingLevel(trimmed) > 0:
+			level := headingLevel(trimmed)
+			text := strings.TrimRight(strings.TrimSpace(trimmed[level:]), "# ")
+			fmt.Fprintf(b, "<h%d>%s</h%d>\n", level, m.inline(text), level)
+			i++
+		case isRule(trimmed):
+			b.WriteString("<hr>\n")
+			i++
+		case strings.HasPrefix(trimmed, ">"):
+			var quote []string
+			for ; i < len(lines) && strings.HasPrefix(strings.TrimSpace(lines[i]), ">"); i++ {
+				q := strings.TrimPrefix(strings.TrimSpace(lines[i]), ">")
+				quote = append(quote, strings.TrimPrefix(q, " "))
+			}
+			b.WriteString("<blockquote>\n")
+			m.blocks(b, quote)
+			b.WriteString("</blockquote>\n")
+		case listMarker(trimmed) != "":
+			tag := listTag(listMarker(trimmed))
+			b.WriteString("<" + tag + ">\n")
+			for i < len(lines) {
+				t := strings.TrimSpace(lines[i])
+				marker := listMarker(t)
+				if marker == "" || listTag(marker) != tag {
+					break
+				}
+				item := strings.TrimSpace(t[len(marker):])
+				for i++; i < len(lines) && isContinuation(lines[i]); i++ {
+					item += " " + strings.TrimSpace(lines[i])
+				}
+				fmt.Fprintf(b, "<li>%s</li>\n", m.inline(item))
+			}
+			b.WriteString("</" + tag + ">\n")
+		case strings.HasPrefix(line, "    ") || strings.HasPrefix(line, "\t"):
+			var code []string
+			for ; i < len(lines) && (strings.HasPrefix(lines[i], "    ") || strings.HasPrefix(lines[i], "\t") || strings.TrimSpace(lines[i]) == ""); i++ {
+				code = append(code, strings.TrimPrefix(strings.TrimPrefix(lines[i], "\t"), "    "))
+			}
+			b.WriteString(highlight(strings.TrimRight(strings.Join(code, "\n"), "\n"), ""))
+		default:
+			var para []string
+			for ; i < len(lines) && !startsBlock(lines[i]); i++ {
+				para = append(para, strings.TrimSpace(lines[i]))
+			}
+			fmt.Fprintf(b, "<p>%s</p>\n", m.inline(strings.Join(para, "\n")))
+		}
+	}
+}
+
+// inline renders emphasis, code spans, links, and images in s.
+func (m *markdown) inline(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); {
+		next := strings.IndexAny(s[i:], "\\`![<*_")
+		if next < 0 {
+			b.WriteString(html.EscapeString(s[i:]))
+			break
+		}
+		b.WriteString(html.EscapeString(s[i : i+next]))
+		i += next
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 < len(s) && strings.IndexByte("\\`*_{}[]()#+-.!<>", s[i+1]) >= 0 {
+				b.WriteString(html.EscapeString(s[i+1 : i+2]))
+				i += 2
+				continue
+			}
+		case '`':
+			n := 1
+			for i+n < len(s) && s[i+n] == '`' {
+				n++
+			}
+			if end := strings.Index(s[i+n:], s[i:i+n]); end >= 0 {
+				code := strings.TrimSpace(s[i+n : i+n+end])
+				b.WriteString("<code>" + html.EscapeString(code) + "</code>")
+				i += 2*n + end
+				continue
+			}
+			b.WriteString(s[i : i+n])
+			i += n
+			continue
+		case '!':
+			if text, href, n, ok := parseLink(s[i+1:]); ok {
+				if u := m.url(href); u != "" {
+					fmt.Fprintf(&b, `<img src="%s" alt="%s">`, html.EscapeString(u), html.EscapeString(text))
+				} else {
+					b.WriteString(html.EscapeString(text))
+				}
+				i += 1 + n
+				continue
+			}
+		case '[':
+			if text, href, n, ok := parseLink(s[i:]); ok {
+				if u := m.url(href); u != "" {
+					fmt.Fprintf(&b, `<a href="%s">%s</a>`, html.EscapeString(u), m.inline(text))
+				} else {
+					b.WriteString(m.inline(text))
+				}
+				i += n
+				continue
+			}
+		case '<':
+			if end := strings.IndexByte(s[i:], '>'); end > 0 {
+				if target := s[i+1 : i+end]; isAutolink(target) {
+					fmt.Fprintf(&b, `<a href="%s">%s</a>`, html.EscapeString(target), html.EscapeString(target))
+					i += end + 1
+					continue
+				}
+			}
+		case '*', '_':
+			if c == '_' && i > 0 && isWordByte(s[i-1]) {
+				break
+			}
+			delim, tag := s[i:i+1], "em"
+			if strings.HasPrefix(s[i:], strings.Repeat(delim, 2)) {
+				delim, tag = strings.Repeat(delim, 2), "strong"
+			}
+			if end := strings.Index(s[i+len(delim):], delim); end > 0 {
+				inner := s[i+len(delim) : i+len(delim)+end]
+				b.WriteString("<" + tag + ">" + m.inline(inner) + "</" + tag + ">")
+				i += 2*len(delim) + end
+				continue
+			}
+		}
+		b.WriteString(html.EscapeString(s[i : i+1]))
+		i++
+	}
+	return b.String()
+}
+
+// url returns a safe link target for href.
+// An empty string is returned if the link should be dropped.
+func (m *markdown) url(href string) string {
+	if strings.HasPrefix(href, "#") {
+		return href
+	}
+	u, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https", "mailto":
+		return u.String()
+	case "":
+	default:
+		return ""
+	}
+	if u.Host != "" || strings.HasPrefix(u.Path, "/") {
+		return u.String()
+	}
+	u.Path = path.Join("/", m.base, u.Path)
+	return u.String()
+}
+
+// parseLink parses a link of the form [text](href) at the start of s.
+// The number of bytes consumed is returned.
+func parseLink(s string) (text, href string, n int, ok bool) {
+	if !strings.HasPrefix(s, "[") {
+		return
+	}
+	depth := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[':
+			depth++
+		case ']':
+			depth--
+			if depth > 0 {
+				continue
+			}
+			if i+1 >= len(s) || s[i+1] != '(' {
+				return
+			}
+			end := strings.IndexByte(s[i+2:], ')')
+			if end < 0 {
+				return
+			}
+			target := strings.TrimSpace(s[i+2 : i+2+end])
+			if sp := strings.IndexAny(target, " \t"); sp >= 0 {
+				target = target[:sp]
+			}
+			return s[1:i], strings.Trim(target, "<>"), i + 3 + end, true
+		}
+	}
+	return
+}
+
+func isFence(line string) bool {
+	return strings.HasPrefix(line, "```") || strings.HasPrefix(line, "~~~")
+}
+
+func headingLevel(line string) int {
+	n := 0
+	for n < len(line) && line[n] == '#' {
+		n++
+	}
+	if n == 0 || n > 6 || (n < len(line) && line[n] != ' ') {
+		return 0
+	}
+	return n
+}
+
+func isRule(line string) bool {
+	s := strings.ReplaceAll(line, " ", "")
+	if len(s) < 3 {
+		return false
+	}
+	return strings.Count(s, s[:1]) == len(s) && strings.Contains("-*_", s[:1])
+}
+
+// listMarker returns the list item marker at the start of line, including the following space.
+func listMarker(line string) string {
+	if len(line) >= 2 && strings.IndexByte("-*+", line[0]) >= 0 && line[1] == ' ' {
+		return line[:2]
+	}
+	n := 0
+	for n < len(line) && line[n] >= '0' && line[n] <= '9' {
+		n++
+	}
+	if n > 0 && n+1 < len(line) && (line[n] == '.' || line[n] == ')') && line[n+1] == ' ' {
+		return line[:n+2]
+	}
+	return ""
+}
+
+func listTag(marker string) string {
+	if marker[0] >= '0' && marker[0] <= '9' {
+		return "ol"
+	}
+	return "ul"
+}
+
+// isContinuation returns whether or not line continues the previous list item.
+func isContinuation(line string) bool {
+	t := strings.TrimSpace(line)
+	return t != "" && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && listMarker(t) == ""
+}
+
+// startsBlock returns whether or not line ends a paragraph.
+func startsBlock(line string) bool {
+	t := strings.TrimSpace(line)
+	return t == "" || isFence(t) || headingLevel(t) > 0 || isRule(t) || strings.HasPrefix(t, ">") || listMarker(t) != ""
+}
+
+func isAutolink(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+func isWordByte(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
@@ -0,0 +1,155 @@
+package gateway
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{name: "heading", src: "# Title #", want: "<h1>Title</h1>\n"},
+		{name: "emphasis", src: "*a* **b** `c`", want: "<p><em>a</em> <strong>b</strong> <code>c</code></p>\n"},
+		{name: "escaped text", src: `a & b < c > "d"`, want: "<p>a &amp; b &lt; c &gt; &#34;d&#34;</p>\n"},
+		{name: "escaped code span", src: "`<b>&</b>`", want: "<p><code>&lt;b&gt;&amp;&lt;/b&gt;</code></p>\n"},
+		{name: "escaped heading", src: "## <i>x</i>", want: "<h2>&lt;i&gt;x&lt;/i&gt;</h2>\n"},
+		{name: "escaped list", src: "- <u>x</u>", want: "<ul>\n<li>&lt;u&gt;x&lt;/u&gt;</li>\n</ul>\n"},
+		{name: "backslash escape", src: `\*a\*`, want: "<p>*a*</p>\n"},
+		{name: "raw html", src: "<script>alert(1)</script>", want: "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>\n"},
+		{name: "raw html attribute", src: `<img src=x onerror="alert(1)">`, want: "<p>&lt;img src=x onerror=&#34;alert(1)&#34;&gt;</p>\n"},
+		{name: "raw html block", src: "<div>\n<iframe src=\"https://evil\"></iframe>\n</div>", want: "<p>&lt;div&gt;\n&lt;iframe src=&#34;https://evil&#34;&gt;&lt;/iframe&gt;\n&lt;/div&gt;</p>\n"},
+		{name: "html comment", src: "<!-- x -->", want: "<p>&lt;!-- x --&gt;</p>\n"},
+		{name: "autolink", src: "<https://a.b/c>", want: "<p><a href=\"https://a.b/c\">https://a.b/c</a></p>\n"},
+		{name: "javascript autolink", src: "<javascript:alert(1)>", want: "<p>&lt;javascript:alert(1)&gt;</p>\n"},
+		{name: "http link", src: "[a](http://a.b)", want: "<p><a href=\"http://a.b\">a</a></p>\n"},
+		{name: "mailto link", src: "[a](mailto:a@b.c)", want: "<p><a href=\"mailto:a@b.c\">a</a></p>\n"},
+		{name: "fragment link", src: "[a](#b)", want: "<p><a href=\"#b\">a</a></p>\n"},
+		{name: "relative link", src: "[a](docs/b.md)", want: "<p><a href=\"/base/docs/b.md\">a</a></p>\n"},
+		{name: "relative link escapes base", src: "[a](../../b)", want: "<p><a href=\"/b\">a</a></p>\n"},
+		{name: "absolute path link", src: "[a](/b)", want: "<p><a href=\"/b\">a</a></p>\n"},
+		{name: "javascript link", src: "[a](javascript:alert%281%29)", want: "<p>a</p>\n"},
+		{name: "javascript link mixed case", src: "[a](JaVaScRiPt:alert%281%29)", want: "<p>a</p>\n"},
+		{name: "javascript link split by tab", src: "[a](java\tscript:alert%281%29)", want: "<p><a href=\"/base/java\">a</a></p>\n"},
+		{name: "data link", src: "[a](data:text/html;base64,PHNjcmlwdD4=)", want: "<p>a</p>\n"},
+		{name: "vbscript link", src: "[a](vbscript:msgbox)", want: "<p>a</p>\n"},
+		{name: "link text is escaped", src: "[<b>a</b>](http://a.b)", want: "<p><a href=\"http://a.b\">&lt;b&gt;a&lt;/b&gt;</a></p>\n"},
+		{name: "link href is escaped", src: `[a](http://a.b/?q="x")`, want: "<p><a href=\"http://a.b/?q=&#34;x&#34;\">a</a></p>\n"},
+		{name: "image", src: "![a](https://a.b/c.png)", want: "<p><img src=\"https://a.b/c.png\" alt=\"a\"></p>\n"},
+		{name: "image alt is escaped", src: `![a" onerror="x](https://a.b/c.png)`, want: "<p><img src=\"https://a.b/c.png\" alt=\"a&#34; onerror=&#34;x\"></p>\n"},
+		{name: "javascript image", src: "![a](javascript:alert%281%29)", want: "<p>a</p>\n"},
+		{name: "data image", src: "![a](data:image/svg+xml;base64,PHN2Zz4=)", want: "<p>a</p>\n"},
+		{name: "vbscript image", src: "![a](vbscript:msgbox)", want: "<p>a</p>\n"},
+		{name: "fenced code is escaped", src: "```\n<script>\n```", want: "<pre class=\"code\"><code>&lt;script&gt;</code></pre>\n"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, renderMarkdown(tc.src, "base"))
+		})
+	}
+}
+
+func TestRenderMarkdown_Unsafe(t *testing.T) {
+	srcs := []string{
+		"[a](javascript:alert(1))",
+		"[a]( javascript:alert(1) )",
+		"[a](<javascript:alert(1)>)",
+		"[a](JAVASCRIPT:alert(1))",
+		"[a](data:text/html,<script>alert(1)</script>)",
+		"[a](vbscript:msgbox(1))",
+		"![a](javascript:alert(1))",
+		"![a](data:image/png;base64,AAAA)",
+		"![a](vbscript:msgbox(1))",
+		"[[a](javascript:alert(1))](http://a.b)",
+		"*[a](javascript:alert(1))*",
+		"> [a](javascript:alert(1))",
+		"1. [a](javascript:alert(1))",
+		"<a href=\"javascript:alert(1)\">a</a>",
+		"<img src=x onerror=alert(1)>",
+		"<svg onload=alert(1)>",
+	}
+	for _, src := range srcs {
+		out := strings.ToLower(renderMarkdown(src, "base"))
+		for _, bad := range []string{`href="javascript:`, `href="data:`, `href="vbscript:`, `src="javascript:`, `src="data:`, `src="vbscript:`, "<script", "<svg", "<img src=x"} {
+			assert.NotContains(t, out, bad, src)
+		}
+	}
+}
+
+func TestHighlight(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		lang string
+		want string
+	}{
+		{
+			name: "go",
+			src:  "func f() int { return 1 } // x",
+			lang: "go",
+			want: `<span class="keyword">func</span> f() int { <span class="keyword">return</span> <span class="number">1</span> } <span class="comment">// x</span>`,
+		},
+		{
+			name: "escaped text",
+			src:  "<script>alert()</script>",
+			lang: "text",
+			want: "&lt;script&gt;alert()&lt;/script&gt;",
+		},
+		{
+			name: "escaped string",
+			src:  `x = "</code><script>"`,
+			lang: "py",
+			want: `x = <span class="string">&#34;&lt;/code&gt;&lt;script&gt;&#34;</span>`,
+		},
+		{
+			name: "escaped comment",
+			src:  "/* </pre><b> */",
+			lang: "js",
+			want: `<span class="comment">/* &lt;/pre&gt;&lt;b&gt; */</span>`,
+		},
+		{
+			name: "unterminated string",
+			src:  `"<a`,
+			lang: "go",
+			want: `<span class="string">&#34;&lt;a</span>`,
+		},
+		{
+			name: "unknown language",
+			src:  "if <x>",
+			lang: `"><script>`,
+			want: "if &lt;x&gt;",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			want := `<pre class="code"><code>` + tc.want + "</code></pre>\n"
+			assert.Equal(t, want, highlight(tc.src, tc.lang))
+		})
+	}
+}
+
+func TestSourceLanguage(t *testing.T) {
+	tests := []struct {
+		name string
+		lang string
+		ok   bool
+	}{
+		{name: "main.go", lang: "go", ok: true},
+		{name: "App.TSX", lang: "tsx", ok: true},
+		{name: "Makefile", lang: "sh", ok: true},
+		{name: "Dockerfile", lang: "sh", ok: true},
+		{name: "image.png", lang: "png"},
+		{name: "noext"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			lang, ok := sourceLanguage(tc.name)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.lang, lang)
+		})
+	}
+}
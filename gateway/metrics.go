@@ -0,0 +1,148 @@
+package gateway
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	logging "github.com/ipfs/go-log"
+)
+
+var accessLog = logging.Logger("gateway.access")
+
+// bucketContextKey is the gin context key of the bucket a request was served from.
+const bucketContextKey = "bucket"
+
+// durationBuckets are the upper bounds in seconds of the request duration histogram.
+var durationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60}
+
+// setBucket records that the request was served from the bucket with key.
+func setBucket(c *gin.Context, key string) {
+	c.Set(bucketContextKey, key)
+}
+
+// metrics holds request counters exported in the Prometheus text format.
+type metrics struct {
+	sync.Mutex
+
+	requests map[int]uint64
+	bytes    uint64
+
+	durationCounts []uint64
+	durationSum    float64
+	durationCount  uint64
+
+	bucketRequests map[string]uint64
+	bucketBytes    map[string]uint64
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		requests:       make(map[int]uint64),
+		durationCounts: make([]uint64, len(durationBuckets)),
+		bucketRequests: make(map[string]uint64),
+		bucketBytes:    make(map[string]uint64),
+	}
+}
+
+// observe records a served request.
+func (m *metrics) observe(status int, bucket string, size int, d time.Duration) {
+	m.Lock()
+	defer m.Unlock()
+	m.requests[status]++
+	m.bytes += uint64(size)
+	secs := d.Seconds()
+	for i, b := range durationBuckets {
+		if secs <= b {
+			m.durationCounts[i]++
+		}
+	}
+	m.durationSum += secs
+	m.durationCount++
+	if bucket != "" {
+		m.bucketRequests[bucket]++
+		m.bucketBytes[bucket] += uint64(size)
+	}
+}
+
+// write writes the metrics in the Prometheus text format.
+func (m *metrics) write(w io.Writer) {
+	m.Lock()
+	defer m.Unlock()
+
+	fmt.Fprintln(w, "# HELP gateway_requests_total Requests served by status code.")
+	fmt.Fprintln(w, "# TYPE gateway_requests_total counter")
+	codes := make([]int, 0, len(m.requests))
+	for code := range m.requests {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	for _, code := range codes {
+		fmt.Fprintf(w, "gateway_requests_total{code=\"%d\"} %d\n", code, m.requests[code])
+	}
+
+	fmt.Fprintln(w, "# HELP gateway_response_bytes_total Response body bytes written.")
+	fmt.Fprintln(w, "# TYPE gateway_response_bytes_total counter")
+	fmt.Fprintf(w, "gateway_response_bytes_total %d\n", m.bytes)
+
+	fmt.Fprintln(w, "# HELP gateway_request_duration_seconds Request latency.")
+	fmt.Fprintln(w, "# TYPE gateway_request_duration_seconds histogram")
+	for i, b := range durationBuckets {
+		le := strconv.FormatFloat(b, 'f', -1, 64)
+		fmt.Fprintf(w, "gateway_request_duration_seconds_bucket{le=\"%s\"} %d\n", le, m.durationCounts[i])
+	}
+	fmt.Fprintf(w, "gateway_request_duration_seconds_bucket{le=\"+Inf\"} %d\n", m.durationCount)
+	fmt.Fprintf(w, "gateway_request_duration_seconds_sum %g\n", m.durationSum)
+	fmt.Fprintf(w, "gateway_request_duration_seconds_count %d\n", m.durationCount)
+
+	keys := make([]string, 0, len(m.bucketRequests))
+	for key := range m.bucketRequests {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	fmt.Fprintln(w, "# HELP gateway_bucket_requests_total Requests served from each bucket.")
+	fmt.Fprintln(w, "# TYPE gateway_bucket_requests_total counter")
+	for _, key := range keys {
+		fmt.Fprintf(w, "gateway_bucket_requests_total{bucket=%q} %d\n", key, m.bucketRequests[key])
+	}
+	fmt.Fprintln(w, "# HELP gateway_bucket_response_bytes_total Response body bytes written from each bucket.")
+	fmt.Fprintln(w, "# TYPE gateway_bucket_response_bytes_total counter")
+	for _, key := range keys {
+		fmt.Fprintf(w, "gateway_bucket_response_bytes_total{bucket=%q} %d\n", key, m.bucketBytes[key])
+	}
+}
+
+// ServeHTTP serves the metrics endpoint.
+func (m *metrics) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m.write(w)
+}
+
+// instrument records metrics and writes an access log entry for each request.
+func (g *Gateway) instrument(c *gin.Context) {
+	start := time.Now()
+	c.Next()
+	latency := time.Since(start)
+
+	status := c.Writer.Status()
+	size := c.Writer.Size()
+	if size < 0 {
+		size = 0
+	}
+	bucket := c.GetString(bucketContextKey)
+	g.metrics.observe(status, bucket, size, latency)
+	accessLog.Infow("request",
+		"method", c.Request.Method,
+		"host", c.Request.Host,
+		"path", c.Request.URL.Path,
+		"bucket", bucket,
+		"status", status,
+		"bytes", size,
+		"latency", latency,
+		"remote", c.ClientIP())
+}
@@ -0,0 +1,115 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"html/template"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/textile/api/buckets/client"
+	pb "github.com/textileio/textile/api/buckets/pb"
+)
+
+// maxPreviewSize is the largest file that is rendered as a preview.
+const maxPreviewSize = 1 << 20
+
+// readmeNames are the file names rendered below directory listings, in order of preference.
+var readmeNames = []string{"readme.md", "readme.markdown", "readme.txt", "readme"}
+
+// isMarkdown returns whether or not the file name is Markdown.
+func isMarkdown(name string) bool {
+	ext := strings.ToLower(path.Ext(name))
+	return ext == ".md" || ext == ".markdown"
+}
+
+// isPreviewable returns whether or not item can be rendered as a preview.
+func isPreviewable(item *pb.ListPathItem) bool {
+	if item.IsDir || item.Size > maxPreviewSize {
+		return false
+	}
+	if isMarkdown(item.Name) {
+		return true
+	}
+	_, ok := sourceLanguage(item.Name)
+	return ok
+}
+
+// renderPreview renders the content of a Markdown or source file.
+// dir is the link path of the file's directory, which relative Markdown links are resolved against.
+func renderPreview(name, dir string, data []byte) template.HTML {
+	if isMarkdown(name) {
+		return template.HTML(renderMarkdown(string(data), dir))
+	}
+	lang, _ := sourceLanguage(name)
+	return template.HTML(highlight(string(data), lang))
+}
+
+// pullPreview pulls the file at pth in the bucket with key if it's text.
+func (g *Gateway) pullPreview(ctx context.Context, key, pth, cid string) ([]byte, bool) {
+	var buf bytes.Buffer
+	pull := cachedPull(g.cache, ctx, bucketCacheKey(key, cid), func(w io.Writer, opts ...client.Option) error {
+		return g.buckets.PullPath(ctx, key, pth, w, opts...)
+	})
+	if err := pull(&buf); err != nil {
+		log.Debugf("pulling preview of %s: %v", pth, err)
+		return nil, false
+	}
+	if !utf8.Valid(buf.Bytes()) {
+		return nil, false
+	}
+	return buf.Bytes(), true
+}
+
+// renderFilePreview renders a file page for a Markdown or source file in a bucket.
+// False is returned if the file can't be previewed.
+func (g *Gateway) renderFilePreview(c *gin.Context, ctx context.Context, key, base, pth string, rep *pb.ListPathReply) bool {
+	if !isPreviewable(rep.Item) {
+		return false
+	}
+	data, ok := g.pullPreview(ctx, key, pth, rep.Item.Cid)
+	if !ok {
+		return false
+	}
+	var name string
+	if rep.Root.Name != "" {
+		name = rep.Root.Name
+	} else {
+		name = rep.Root.Key
+	}
+	link := path.Join(base, strings.Replace(rep.Item.Path, rep.Root.Path, rep.Root.Key, 1))
+	c.HTML(http.StatusOK, "/public/html/preview.gohtml", gin.H{
+		"Title":   rep.Item.Name,
+		"Root":    "/" + strings.Replace(rep.Item.Path, rep.Root.Path, name, 1),
+		"Back":    path.Dir(link),
+		"Raw":     "/" + link,
+		"Content": renderPreview(rep.Item.Name, path.Dir(link), data),
+	})
+	return true
+}
+
+// renderReadme renders the README of a bucket directory listing, if it has one.
+// dir is the link path of the directory.
+func (g *Gateway) renderReadme(ctx context.Context, key, dir string, rep *pb.ListPathReply) template.HTML {
+	for _, name := range readmeNames {
+		for _, item := range rep.Item.Items {
+			if strings.ToLower(item.Name) != name || item.IsDir || item.Size > maxPreviewSize {
+				continue
+			}
+			pth := strings.TrimPrefix(strings.TrimPrefix(item.Path, rep.Root.Path), "/")
+			data, ok := g.pullPreview(ctx, key, pth, item.Cid)
+			if !ok {
+				return ""
+			}
+			if isMarkdown(item.Name) {
+				return template.HTML(renderMarkdown(string(data), dir))
+			}
+			return template.HTML(highlight(string(data), "text"))
+		}
+	}
+	return ""
+}
@@ -0,0 +1,152 @@
+package gateway
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/textile/api/buckets/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pullFunc pulls a bucket file to w.
+type pullFunc func(w io.Writer, opts ...client.Option) error
+
+// writeFile writes the file pulled by pull to the response.
+// Files stored with a content encoding are written as stored if the client accepts the encoding.
+// Otherwise, a single byte range requested with the Range header is written as partial content.
+// Other ranges are ignored and the entire file is written.
+func writeFile(c *gin.Context, encoding string, pull pullFunc) error {
+	h := c.Writer.Header()
+	if encoding != "" {
+		h.Add("Vary", "Accept-Encoding")
+		if acceptsEncoding(c.GetHeader("Accept-Encoding"), encoding) {
+			h.Set("Content-Encoding", encoding)
+			if etag := h.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+				h.Set("ETag", "W/"+etag) // The encoded representation is not byte-identical
+			}
+			return pull(c.Writer, client.WithEncoded())
+		}
+	}
+	h.Set("Accept-Ranges", "bytes")
+	start, end, ok := parseRange(c.GetHeader("Range"))
+	if !ok {
+		return pull(c.Writer)
+	}
+	w := &rangeWriter{c: c, start: start}
+	if end >= 0 {
+		w.length = end - start + 1
+	}
+	err := pull(w, client.WithRange(w.start, w.length), client.WithFileSize(&w.size))
+	if status.Code(err) == codes.OutOfRange {
+		w.writeUnsatisfiable()
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if !w.wrote {
+		if w.start >= w.size {
+			w.writeUnsatisfiable()
+			return nil
+		}
+		return w.writeHeader()
+	}
+	return nil
+}
+
+// acceptsEncoding returns whether or not an Accept-Encoding header value accepts encoding.
+func acceptsEncoding(header, encoding string) bool {
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(part, ";")
+		name := strings.ToLower(strings.TrimSpace(fields[0]))
+		if name != encoding && name != "*" {
+			continue
+		}
+		for _, f := range fields[1:] {
+			q := strings.TrimSpace(f)
+			if !strings.HasPrefix(q, "q=") {
+				continue
+			}
+			if v, err := strconv.ParseFloat(q[2:], 64); err == nil && v == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// parseRange parses a Range header requesting a single byte range, e.g., "bytes=0-99" or "bytes=100-".
+// End is -1 for ranges that extend to the end of the file.
+// Suffix ranges, e.g., "bytes=-100", and multiple ranges are not supported.
+func parseRange(header string) (start, end int64, ok bool) {
+	spec := strings.TrimPrefix(header, "bytes=")
+	if spec == header || strings.Contains(spec, ",") {
+		return 0, 0, false
+	}
+	parts := strings.SplitN(strings.TrimSpace(spec), "-", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return 0, 0, false
+	}
+	start, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, false
+	}
+	if parts[1] == "" {
+		return start, -1, true
+	}
+	end, err = strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || end < start {
+		return 0, 0, false
+	}
+	if end == math.MaxInt64 {
+		// The range length would overflow, and no file extends that far anyway.
+		return start, -1, true
+	}
+	return start, end, true
+}
+
+// rangeWriter writes the partial content response of a ranged request.
+// Headers are written with the first chunk, once the size of the file is known.
+type rangeWriter struct {
+	c      *gin.Context
+	start  int64
+	length int64
+	size   int64
+	wrote  bool
+}
+
+func (w *rangeWriter) Write(p []byte) (int, error) {
+	if !w.wrote {
+		if err := w.writeHeader(); err != nil {
+			return 0, err
+		}
+	}
+	return w.c.Writer.Write(p)
+}
+
+func (w *rangeWriter) writeHeader() error {
+	if w.size == 0 {
+		return fmt.Errorf("size of ranged file is unknown")
+	}
+	w.wrote = true
+	end := w.size - 1
+	if w.length > 0 && w.start+w.length < w.size {
+		end = w.start + w.length - 1
+	}
+	h := w.c.Writer.Header()
+	h.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", w.start, end, w.size))
+	h.Set("Content-Length", strconv.FormatInt(end-w.start+1, 10))
+	w.c.Writer.WriteHeader(http.StatusPartialContent)
+	return nil
+}
+
+func (w *rangeWriter) writeUnsatisfiable() {
+	w.c.Writer.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", w.size))
+	w.c.Writer.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+}
@@ -0,0 +1,135 @@
+package gateway
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/api/buckets/client"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseRange(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		start  int64
+		end    int64
+		ok     bool
+	}{
+		{name: "closed", header: "bytes=0-99", start: 0, end: 99, ok: true},
+		{name: "single byte", header: "bytes=5-5", start: 5, end: 5, ok: true},
+		{name: "open-ended", header: "bytes=100-", start: 100, end: -1, ok: true},
+		{name: "whitespace", header: "bytes= 10-20 ", start: 10, end: 20, ok: true},
+		{name: "empty", header: ""},
+		{name: "wrong unit", header: "items=0-99"},
+		{name: "suffix", header: "bytes=-100"},
+		{name: "multi-range", header: "bytes=0-9,20-29"},
+		{name: "multi-range open-ended", header: "bytes=0-,10-"},
+		{name: "end before start", header: "bytes=100-99"},
+		{name: "negative start", header: "bytes=--5"},
+		{name: "not a number", header: "bytes=a-b"},
+		{name: "no dash", header: "bytes=100"},
+		{name: "start overflow", header: "bytes=9223372036854775808-"},
+		{name: "end overflow", header: "bytes=0-9223372036854775808"},
+		{name: "max end", header: "bytes=0-9223372036854775807", start: 0, end: -1, ok: true},
+		{name: "max start", header: "bytes=9223372036854775807-9223372036854775807", start: 9223372036854775807, end: -1, ok: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, ok := parseRange(tc.header)
+			require.Equal(t, tc.ok, ok)
+			if ok {
+				assert.Equal(t, tc.start, start)
+				assert.Equal(t, tc.end, end)
+			}
+		})
+	}
+}
+
+func TestRangeWriter(t *testing.T) {
+	tests := []struct {
+		name          string
+		start         int64
+		length        int64
+		size          int64
+		contentRange  string
+		contentLength string
+	}{
+		{name: "closed", start: 0, length: 10, size: 100, contentRange: "bytes 0-9/100", contentLength: "10"},
+		{name: "open-ended", start: 90, length: 0, size: 100, contentRange: "bytes 90-99/100", contentLength: "10"},
+		{name: "past end", start: 90, length: 50, size: 100, contentRange: "bytes 90-99/100", contentLength: "10"},
+		{name: "last byte", start: 99, length: 1, size: 100, contentRange: "bytes 99-99/100", contentLength: "1"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			w := &rangeWriter{c: c, start: tc.start, length: tc.length, size: tc.size}
+			_, err := w.Write([]byte("data"))
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusPartialContent, rec.Code)
+			assert.Equal(t, tc.contentRange, rec.Header().Get("Content-Range"))
+			assert.Equal(t, tc.contentLength, rec.Header().Get("Content-Length"))
+			assert.Equal(t, "data", rec.Body.String())
+		})
+	}
+
+	t.Run("unknown size", func(t *testing.T) {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		w := &rangeWriter{c: c}
+		_, err := w.Write([]byte("data"))
+		require.Error(t, err)
+	})
+}
+
+func TestWriteFile(t *testing.T) {
+	const content = "hello world"
+	full := func(w io.Writer, _ ...client.Option) error {
+		_, err := io.WriteString(w, content)
+		return err
+	}
+	tests := []struct {
+		name   string
+		header string
+		pull   pullFunc
+		code   int
+		body   string
+		cr     string
+	}{
+		{name: "no range", pull: full, code: http.StatusOK, body: content},
+		{name: "suffix range", header: "bytes=-5", pull: full, code: http.StatusOK, body: content},
+		{name: "multi-range", header: "bytes=0-1,3-4", pull: full, code: http.StatusOK, body: content},
+		{name: "invalid range", header: "bytes=5-1", pull: full, code: http.StatusOK, body: content},
+		{
+			name:   "unsatisfiable",
+			header: "bytes=100-",
+			pull: func(io.Writer, ...client.Option) error {
+				return status.Error(codes.OutOfRange, "offset is past the end of the file")
+			},
+			code: http.StatusRequestedRangeNotSatisfiable,
+			cr:   "bytes */0",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rec)
+			c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+			if tc.header != "" {
+				c.Request.Header.Set("Range", tc.header)
+			}
+			require.NoError(t, writeFile(c, "", tc.pull))
+			c.Writer.WriteHeaderNow()
+			assert.Equal(t, tc.code, rec.Code)
+			assert.Equal(t, tc.body, rec.Body.String())
+			assert.Equal(t, "bytes", rec.Header().Get("Accept-Ranges"))
+			assert.Equal(t, tc.cr, rec.Header().Get("Content-Range"))
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package gateway
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/api/buckets/client"
+	"github.com/textileio/textile/api/common"
+	mdb "github.com/textileio/textile/mongodb"
+)
+
+const (
+	// shareCookieName is the name of the cookie that holds access to a password-protected share link.
+	shareCookieName = "share_access"
+	// shareCookieDur is how long access to a password-protected share link lasts.
+	shareCookieDur = time.Hour
+)
+
+// shareHandler renders a bucket path shared with a share link.
+// Only the shared path and its children can be viewed.
+// A password prompt is rendered for password-protected links without an access cookie.
+func (g *Gateway) shareHandler(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
+	defer cancel()
+	link, err := g.collections.ShareLinks.Get(ctx, c.Param("token"))
+	if err != nil || link.Expired() {
+		render404(c)
+		return
+	}
+	if link.Protected() {
+		cookie, err := c.Cookie(shareCookieName)
+		if err != nil || !validShareCookie(link, cookie) {
+			renderSharePrompt(c, http.StatusUnauthorized, "")
+			return
+		}
+	}
+	g.renderSharePath(c, ctx, link)
+}
+
+// sharePasswordHandler verifies the password of a share link.
+// An access cookie is set and the viewer is redirected to the link if the password is correct.
+// Attempts are rate limited by client IP and by link before the password is hashed.
+func (g *Gateway) sharePasswordHandler(c *gin.Context) {
+	ip, token := c.ClientIP(), c.Param("token")
+	if wait, ok := g.shareLimits.allow(ip, token); !ok {
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+		renderSharePrompt(c, http.StatusTooManyRequests, "Too many attempts. Try again later.")
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), handlerTimeout)
+	defer cancel()
+	link, err := g.collections.ShareLinks.Get(ctx, token)
+	if err != nil || link.Expired() {
+		render404(c)
+		return
+	}
+	if !g.shareLimits.acquireHash() {
+		c.Header("Retry-After", "1")
+		renderSharePrompt(c, http.StatusTooManyRequests, "Too many attempts. Try again later.")
+		return
+	}
+	ok := link.CheckPassword(c.PostForm("password"))
+	g.shareLimits.releaseHash()
+	g.shareLimits.record(ip, token, ok)
+	if !ok {
+		renderSharePrompt(c, http.StatusUnauthorized, "Incorrect password.")
+		return
+	}
+	if link.Protected() {
+		expiry := time.Now().Add(shareCookieDur)
+		c.SetCookie(
+			shareCookieName,
+			newShareCookie(link, expiry),
+			int(shareCookieDur.Seconds()),
+			path.Join("/share", link.Token),
+			"",
+			c.Request.TLS != nil,
+			true,
+		)
+	}
+	c.Redirect(http.StatusSeeOther, c.Request.URL.Path)
+}
+
+// renderSharePrompt renders the share link password prompt.
+func renderSharePrompt(c *gin.Context, code int, msg string) {
+	c.HTML(code, "/public/html/share.gohtml", gin.H{
+		"Action": c.Request.URL.Path,
+		"Error":  msg,
+	})
+}
+
+// newShareCookie returns an access cookie value for a password-protected link that is valid until expiry.
+// The value is signed with the link's password hash, so changing or revoking the link invalidates it.
+func newShareCookie(link *mdb.ShareLink, expiry time.Time) string {
+	exp := strconv.FormatInt(expiry.Unix(), 10)
+	return exp + "." + signShareCookie(link, exp)
+}
+
+// validShareCookie returns whether or not value is an unexpired access cookie for link.
+func validShareCookie(link *mdb.ShareLink, value string) bool {
+	parts := strings.SplitN(value, ".", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	exp, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil || time.Now().After(time.Unix(exp, 0)) {
+		return false
+	}
+	return hmac.Equal([]byte(parts[1]), []byte(signShareCookie(link, parts[0])))
+}
+
+func signShareCookie(link *mdb.ShareLink, exp string) string {
+	mac := hmac.New(sha256.New, link.PasswordHash)
+	_, _ = mac.Write([]byte(fmt.Sprintf("%s.%s", link.Token, exp)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// renderSharePath renders the file or directory at the requested path below the shared path.
+func (g *Gateway) renderSharePath(c *gin.Context, ctx context.Context, share *mdb.ShareLink) {
+	setBucket(c, share.BucketKey)
+	ctx = common.NewSessionContext(ctx, g.apiSession)
+	ctx = common.NewThreadIDContext(ctx, share.DbID)
+	if share.DbToken.Defined() {
+		ctx = thread.NewTokenContext(ctx, share.DbToken)
+	}
+	sub := strings.TrimPrefix(path.Clean("/"+c.Param("path")), "/")
+	pth := path.Join(share.Path, sub)
+	rep, err := g.buckets.ListPath(ctx, share.BucketKey, pth)
+	if err != nil {
+		render404(c)
+		return
+	}
+	if !rep.Item.IsDir {
+		if setETag(c, rep.Item.Cid) {
+			return
+		}
+		if md := rep.Item.Metadata; md != nil && md.ContentType != "" {
+			c.Writer.Header().Set("Content-Type", md.ContentType)
+		}
+		pull := cachedPull(g.cache, ctx, bucketCacheKey(share.BucketKey, rep.Item.Cid), func(w io.Writer, _ ...client.Option) error {
+			return g.buckets.PullPath(ctx, share.BucketKey, pth, w)
+		})
+		if err := pull(c.Writer); err != nil {
+			renderError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	base := path.Join("/share", share.Token)
+	var links []link
+	for _, item := range rep.Item.Items {
+		links = append(links, link{
+			Name:  item.Name,
+			Path:  path.Join(base, sub, item.Name),
+			Size:  byteCountDecimal(item.Size),
+			Links: strconv.Itoa(len(item.Items)),
+		})
+	}
+	name := rep.Root.Name
+	if name == "" {
+		name = rep.Root.Key
+	}
+	dir := path.Join(name, pth)
+	var back string
+	if sub != "" {
+		back = path.Dir(path.Join(base, sub))
+	}
+	c.HTML(http.StatusOK, "/public/html/unixfs.gohtml", gin.H{
+		"Title":   "Index of /" + dir,
+		"Root":    "/" + dir,
+		"Path":    rep.Item.Path,
+		"Updated": time.Unix(0, rep.Root.UpdatedAt).String(),
+		"Back":    back,
+		"Links":   links,
+	})
+}
@@ -0,0 +1,34 @@
+package gateway
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+)
+
+// writeThumbnail writes the thumbnail of the given size of the bucket image at pth with CID cid.
+// It returns false without writing anything if the image has no thumbnail that's up to date,
+// in which case the image itself should be written.
+func (g *Gateway) writeThumbnail(c *gin.Context, ctx context.Context, key, pth, cid, size string) bool {
+	thumb, err := g.collections.Thumbnails.Get(ctx, key, pth)
+	if err != nil || thumb.Cid != cid {
+		return false
+	}
+	img, ok := thumb.Images[size]
+	if !ok {
+		return false
+	}
+	if setETag(c, cid+"-"+size) {
+		return true
+	}
+	h := c.Writer.Header()
+	h.Set("Content-Type", thumb.ContentType)
+	h.Set("Content-Length", strconv.Itoa(len(img)))
+	c.Writer.WriteHeader(http.StatusOK)
+	if _, err := c.Writer.Write(img); err != nil {
+		log.Errorf("writing thumbnail: %v", err)
+	}
+	return true
+}
@@ -22,6 +22,7 @@ import (
 	pb "github.com/textileio/textile/api/buckets/pb"
 	"github.com/textileio/textile/api/common"
 	hc "github.com/textileio/textile/api/hub/client"
+	"github.com/textileio/textile/buckets"
 	"github.com/textileio/textile/buckets/archive"
 	"github.com/textileio/textile/core"
 	"github.com/textileio/textile/util"
@@ -161,6 +162,20 @@ func TestArchiveBucketWorkflow(t *testing.T) {
 		deal = archive.Deals[0]
 		require.NotEmpty(t, deal.GetProposalCid())
 		require.NotEmpty(t, deal.GetMiner())
+
+		// Both archives are listed, newest first.
+		list, err := client.ArchiveList(ctx, b.Root.Key)
+		require.NoError(t, err)
+		require.Len(t, list.Archives, 2)
+		require.True(t, list.Archives[0].Current)
+		require.Equal(t, rootCid2, list.Archives[0].Cid)
+		require.Equal(t, rootCid1, list.Archives[1].Cid)
+		for _, a := range list.Archives {
+			require.Equal(t, pb.ArchiveStatusReply_Done, a.Status)
+			require.NotEmpty(t, a.Deals)
+			require.NotEmpty(t, a.Deals[0].Miner)
+			require.NotZero(t, a.Deals[0].DealId)
+		}
 	})
 }
 
@@ -222,6 +237,149 @@ func TestFailingArchive(t *testing.T) {
 	})
 }
 
+func TestArchiveOptions(t *testing.T) {
+	util.RunFlaky(t, func(t *util.FlakyT) {
+		_ = spinup(t)
+		ctx, _, client, shutdown := setup(t)
+		defer shutdown(true)
+
+		b, err := client.Init(ctx)
+		require.NoError(t, err)
+		time.Sleep(4 * time.Second)
+		addDataFileToBucket(ctx, t, client, b.Root.Key, "Data1.txt")
+
+		_, err = client.Archive(ctx, b.Root.Key, c.WithTrustedMiners("t01000"), c.WithExcludedMiners("t01000"))
+		require.Error(t, err)
+		_, err = client.Archive(ctx, b.Root.Key, c.WithRepFactor(-1))
+		require.Error(t, err)
+
+		// Excluding the only miner of the devnet should fail the archive.
+		_, err = client.Archive(ctx, b.Root.Key, c.WithExcludedMiners("t01000"))
+		require.NoError(t, err)
+		require.Eventually(t, archiveFinalState(ctx, t, client, b.Root.Key), 60*time.Second, 2*time.Second)
+		as, err := client.ArchiveStatus(ctx, b.Root.Key)
+		require.NoError(t, err)
+		require.Equal(t, pb.ArchiveStatusReply_Failed, as.GetStatus())
+	})
+}
+
+func TestArchiveRenewal(t *testing.T) {
+	util.RunFlaky(t, func(t *util.FlakyT) {
+		_ = spinup(t)
+		ctx, _, client, shutdown := setup(t)
+		defer shutdown(true)
+
+		b, err := client.Init(ctx)
+		require.NoError(t, err)
+		time.Sleep(4 * time.Second)
+		addDataFileToBucket(ctx, t, client, b.Root.Key, "Data1.txt")
+
+		_, err = client.SetArchiveRenewal(ctx, b.Root.Key, -1)
+		require.Error(t, err)
+		rn, err := client.SetArchiveRenewal(ctx, b.Root.Key, 100)
+		require.NoError(t, err)
+		require.Equal(t, int64(100), rn.Threshold)
+
+		_, err = client.Archive(ctx, b.Root.Key)
+		require.NoError(t, err)
+		require.Eventually(t, archiveFinalState(ctx, t, client, b.Root.Key), 120*time.Second, 2*time.Second)
+
+		info, err := client.ArchiveInfo(ctx, b.Root.Key)
+		require.NoError(t, err)
+		require.NotNil(t, info.Renewal)
+		require.Equal(t, int64(100), info.Renewal.Threshold)
+
+		rn, err = client.SetArchiveRenewal(ctx, b.Root.Key, 0)
+		require.NoError(t, err)
+		require.Nil(t, rn)
+	})
+}
+
+func TestRestoreArchive(t *testing.T) {
+	util.RunFlaky(t, func(t *util.FlakyT) {
+		_ = spinup(t)
+		ctx, _, client, shutdown := setup(t)
+		defer shutdown(true)
+
+		b, err := client.Init(ctx)
+		require.NoError(t, err)
+		time.Sleep(4 * time.Second)
+		addDataFileToBucket(ctx, t, client, b.Root.Key, "Data1.txt")
+
+		_, err = client.Archive(ctx, b.Root.Key)
+		require.NoError(t, err)
+		require.Eventually(t, archiveFinalState(ctx, t, client, b.Root.Key), 120*time.Second, 2*time.Second)
+
+		ch := make(chan string)
+		go func() {
+			for range ch {
+			}
+		}()
+		root, err := client.RestoreArchive(ctx, b.Root.Key, ch, c.WithRestoreNewBucket("restored"))
+		close(ch)
+		require.NoError(t, err)
+		assert.NotEqual(t, b.Root.Key, root.Key)
+		assert.Equal(t, "restored", root.Name)
+
+		rep, err := client.ListPath(ctx, root.Key, "Data1.txt")
+		require.NoError(t, err)
+		assert.False(t, rep.Item.IsDir)
+	})
+}
+
+func TestRestoreArchive_LegalHold(t *testing.T) {
+	util.RunFlaky(t, func(t *util.FlakyT) {
+		_ = spinup(t)
+		ctx, conf, client, shutdown := setup(t)
+		defer shutdown(true)
+
+		target, err := tutil.TCPAddrFromMultiAddr(conf.AddrAPI)
+		require.NoError(t, err)
+		opts := []grpc.DialOption{grpc.WithInsecure(), grpc.WithPerRPCCredentials(common.Credentials{})}
+		hubclient, err := hc.NewClient(target, opts...)
+		require.NoError(t, err)
+		threadsclient, err := tc.NewClient(target, opts...)
+		require.NoError(t, err)
+		org, err := hubclient.CreateOrg(ctx, apitest.NewUsername())
+		require.NoError(t, err)
+		ctx = common.NewOrgSlugContext(ctx, org.Slug)
+		id := thread.NewIDV1(thread.Raw, 32)
+		require.NoError(t, threadsclient.NewDB(ctx, id))
+		ctx = common.NewThreadIDContext(ctx, id)
+
+		b, err := client.Init(ctx)
+		require.NoError(t, err)
+		time.Sleep(4 * time.Second)
+		addDataFileToBucket(ctx, t, client, b.Root.Key, "Data1.txt")
+
+		_, err = client.Archive(ctx, b.Root.Key)
+		require.NoError(t, err)
+		require.Eventually(t, archiveFinalState(ctx, t, client, b.Root.Key), 120*time.Second, 2*time.Second)
+		_, err = client.SetLegalHold(ctx, b.Root.Key, "litigation")
+		require.NoError(t, err)
+
+		ch := make(chan string)
+		go func() {
+			for range ch {
+			}
+		}()
+		_, err = client.RestoreArchive(ctx, b.Root.Key, ch)
+		close(ch)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), buckets.ErrLegalHold.Error())
+
+		ch = make(chan string)
+		go func() {
+			for range ch {
+			}
+		}()
+		root, err := client.RestoreArchive(ctx, b.Root.Key, ch, c.WithRestoreNewBucket("restored"))
+		close(ch)
+		require.NoError(t, err)
+		assert.NotEqual(t, b.Root.Key, root.Key)
+	})
+}
+
 func archiveFinalState(ctx context.Context, t util.TestingTWithCleanup, client *c.Client, bucketKey string) func() bool {
 	return func() bool {
 		as, err := client.ArchiveStatus(ctx, bucketKey)
@@ -1,15 +1,18 @@
 package ipns
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"sync"
 	"time"
 
+	httpapi "github.com/ipfs/go-ipfs-http-client"
 	logging "github.com/ipfs/go-log"
 	iface "github.com/ipfs/interface-go-ipfs-core"
 	"github.com/ipfs/interface-go-ipfs-core/options"
 	"github.com/ipfs/interface-go-ipfs-core/path"
+	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/peer"
 	mbase "github.com/multiformats/go-multibase"
 	"github.com/textileio/go-threads/core/thread"
@@ -18,7 +21,15 @@ import (
 	"github.com/textileio/textile/util"
 )
 
-var log = logging.Logger("ipns")
+var (
+	log = logging.Logger("ipns")
+
+	// ErrKeyImportDisabled indicates a key was imported without a key importer.
+	ErrKeyImportDisabled = errors.New("key import is disabled")
+
+	// ErrKeyInUse indicates an imported key is already used by a bucket.
+	ErrKeyInUse = errors.New("key is already in use")
+)
 
 const (
 	// nameLen is the length of the random IPNS key name.
@@ -29,11 +40,36 @@ const (
 	maxCancelPublishTries = 10
 )
 
+// KeyImporter imports private keys into the keystore of an IPFS node.
+type KeyImporter interface {
+	// ImportKey adds key to the keystore with name.
+	ImportKey(ctx context.Context, name string, key crypto.PrivKey) error
+}
+
+// HTTPKeyImporter imports keys with the HTTP API of an IPFS node.
+type HTTPKeyImporter struct {
+	API *httpapi.HttpApi
+}
+
+// ImportKey adds key to the keystore with name.
+func (i *HTTPKeyImporter) ImportKey(ctx context.Context, name string, key crypto.PrivKey) error {
+	data, err := crypto.MarshalPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	var out struct {
+		Name string
+		ID   string
+	}
+	return i.API.Request("key/import", name).FileBody(bytes.NewReader(data)).Exec(ctx, &out)
+}
+
 // Manager handles bucket name publishing to IPNS.
 type Manager struct {
-	keys    *mdb.IPNSKeys
-	keyAPI  iface.KeyAPI
-	nameAPI iface.NameAPI
+	keys     *mdb.IPNSKeys
+	keyAPI   iface.KeyAPI
+	nameAPI  iface.NameAPI
+	importer KeyImporter
 
 	sync.Mutex
 	keyLocks map[string]chan struct{}
@@ -42,7 +78,8 @@ type Manager struct {
 }
 
 // NewManager returns a new IPNS manager.
-func NewManager(keys *mdb.IPNSKeys, keyAPI iface.KeyAPI, nameAPI iface.NameAPI, debug bool) (*Manager, error) {
+// Keys can only be imported if importer is not nil.
+func NewManager(keys *mdb.IPNSKeys, keyAPI iface.KeyAPI, nameAPI iface.NameAPI, importer KeyImporter, debug bool) (*Manager, error) {
 	if debug {
 		if err := tutil.SetLogLevels(map[string]logging.LogLevel{
 			"ipns": logging.LevelDebug,
@@ -54,6 +91,7 @@ func NewManager(keys *mdb.IPNSKeys, keyAPI iface.KeyAPI, nameAPI iface.NameAPI,
 		keys:     keys,
 		keyAPI:   keyAPI,
 		nameAPI:  nameAPI,
+		importer: importer,
 		ctxs:     make(map[string]context.CancelFunc),
 		keyLocks: make(map[string]chan struct{}),
 	}, nil
@@ -75,6 +113,58 @@ func (m *Manager) CreateKey(ctx context.Context, dbID thread.ID) (keyID string,
 	return keyID, nil
 }
 
+// ImportKey replaces the IPNS key with ID keyID with key, returning the IPNS name of key.
+// The key ID is not changed, but later publishes use key.
+func (m *Manager) ImportKey(ctx context.Context, keyID string, key crypto.PrivKey) (string, error) {
+	if m.importer == nil {
+		return "", ErrKeyImportDisabled
+	}
+	old, err := m.keys.GetByCid(ctx, keyID)
+	if err != nil {
+		return "", err
+	}
+	id, err := peer.IDFromPrivateKey(key)
+	if err != nil {
+		return "", err
+	}
+	ipnsName, err := peer.ToCid(id).StringOfBase(mbase.Base32)
+	if err != nil {
+		return "", err
+	}
+	if used, err := m.keys.HasName(ctx, ipnsName); err != nil {
+		return "", err
+	} else if used {
+		return "", ErrKeyInUse
+	}
+
+	name := util.MakeToken(nameLen)
+	if err := m.importer.ImportKey(ctx, name, key); err != nil {
+		return "", err
+	}
+	if err := m.keys.Replace(ctx, old.Name, name, ipnsName); err != nil {
+		if _, err := m.keyAPI.Remove(ctx, name); err != nil {
+			log.Errorf("removing imported key %s: %v", name, err)
+		}
+		return "", err
+	}
+	if _, err := m.keyAPI.Remove(ctx, old.Name); err != nil {
+		log.Errorf("removing replaced key %s: %v", old.Name, err)
+	}
+	return ipnsName, nil
+}
+
+// Name returns the IPNS name that the key with ID keyID publishes to.
+func (m *Manager) Name(ctx context.Context, keyID string) (string, error) {
+	key, err := m.keys.GetByCid(ctx, keyID)
+	if err != nil {
+		return "", err
+	}
+	if key.IPNSName != "" {
+		return key.IPNSName, nil
+	}
+	return key.Cid, nil
+}
+
 // RemoveKey removes an IPNS key.
 func (m *Manager) RemoveKey(ctx context.Context, keyID string) error {
 	key, err := m.keys.GetByCid(ctx, keyID)
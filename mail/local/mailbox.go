@@ -78,6 +78,7 @@ const (
 	// NewMessage indicates the mailbox has a new message.
 	NewMessage MailboxEventType = iota
 	// MessageRead indicates a message was read in the mailbox.
+	// In the sentbox, this is a read receipt, i.e., the recipient read the message.
 	MessageRead
 	// MessageDeleted indicates a message was deleted from the mailbox.
 	MessageDeleted
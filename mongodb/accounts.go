@@ -377,6 +377,45 @@ func (a *Accounts) ListByOwner(ctx context.Context, owner crypto.PubKey) ([]Acco
 	return docs, nil
 }
 
+// List returns all accounts.
+func (a *Accounts) List(ctx context.Context) ([]Account, error) {
+	return a.find(ctx, bson.M{})
+}
+
+// ListBySize returns the accounts with the largest buckets total size, largest first.
+// A limit of zero returns all accounts.
+func (a *Accounts) ListBySize(ctx context.Context, limit int64) ([]Account, error) {
+	opts := options.Find().SetSort(bson.D{{"buckets_total_size", -1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	return a.find(ctx, bson.M{}, opts)
+}
+
+func (a *Accounts) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]Account, error) {
+	cursor, err := a.col.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []Account
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		doc, err := decodeAccount(raw)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, *doc)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
 func (a *Accounts) ListMembers(ctx context.Context, members []Member) ([]Account, error) {
 	keys := make([][]byte, len(members))
 	var err error
@@ -0,0 +1,60 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// adminTokenID is the ID of the single admin token document.
+const adminTokenID = "current"
+
+// AdminToken is a rotated admin token.
+type AdminToken struct {
+	// Hash is the SHA-256 hash of the token.
+	Hash      []byte
+	RotatedAt time.Time
+}
+
+type adminToken struct {
+	ID        string    `bson:"_id"`
+	Hash      []byte    `bson:"hash"`
+	RotatedAt time.Time `bson:"rotated_at"`
+}
+
+// AdminTokens stores the admin token of the hub once it has been rotated,
+// so that it's shared by all hub instances and survives restarts.
+type AdminTokens struct {
+	col *mongo.Collection
+}
+
+func NewAdminTokens(_ context.Context, db *mongo.Database) (*AdminTokens, error) {
+	return &AdminTokens{col: db.Collection("admintokens")}, nil
+}
+
+// Set replaces the admin token with the token that has hash.
+func (a *AdminTokens) Set(ctx context.Context, hash []byte) error {
+	_, err := a.col.ReplaceOne(ctx, bson.M{"_id": adminTokenID}, adminToken{
+		ID:        adminTokenID,
+		Hash:      hash,
+		RotatedAt: time.Now(),
+	}, options.Replace().SetUpsert(true))
+	return err
+}
+
+// Get returns the admin token.
+// mongo.ErrNoDocuments is returned if the token was never rotated.
+func (a *AdminTokens) Get(ctx context.Context) (*AdminToken, error) {
+	res := a.col.FindOne(ctx, bson.M{"_id": adminTokenID})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc adminToken
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	return &AdminToken{Hash: doc.Hash, RotatedAt: doc.RotatedAt}, nil
+}
@@ -0,0 +1,31 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestAdminTokens_Set(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewAdminTokens(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Get(ctx)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	err = col.Set(ctx, []byte("hash1"))
+	require.NoError(t, err)
+	err = col.Set(ctx, []byte("hash2"))
+	require.NoError(t, err)
+	got, err := col.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hash2"), got.Hash)
+	assert.False(t, got.RotatedAt.IsZero())
+}
@@ -24,13 +24,16 @@ const (
 )
 
 type APIKey struct {
-	Key       string
-	Secret    string
-	Owner     crypto.PubKey
-	Type      APIKeyType
-	Secure    bool
-	Valid     bool
-	CreatedAt time.Time
+	Key    string
+	Secret string
+	Owner  crypto.PubKey
+	Type   APIKeyType
+	Secure bool
+	Valid  bool
+	// UserBucketsMaxSize caps the buckets total size of each user of a user key.
+	// Zero means the user is only limited by the hub's buckets total size quota.
+	UserBucketsMaxSize int64
+	CreatedAt          time.Time
 }
 
 func NewAPIKeyContext(ctx context.Context, key *APIKey) context.Context {
@@ -135,6 +138,18 @@ func (k *APIKeys) Invalidate(ctx context.Context, key string) error {
 	return nil
 }
 
+// SetUserBucketsMaxSize sets the buckets total size cap of each user of key.
+func (k *APIKeys) SetUserBucketsMaxSize(ctx context.Context, key string, size int64) error {
+	res, err := k.col.UpdateOne(ctx, bson.M{"_id": key}, bson.M{"$set": bson.M{"user_buckets_max_size": size}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
 func (k *APIKeys) DeleteByOwner(ctx context.Context, owner crypto.PubKey) error {
 	ownerID, err := crypto.MarshalPublicKey(owner)
 	if err != nil {
@@ -157,13 +172,18 @@ func decodeAPIKey(raw bson.M) (*APIKey, error) {
 	if v, ok := raw["secure"]; ok {
 		secure = v.(bool)
 	}
+	var userMaxSize int64
+	if v, ok := raw["user_buckets_max_size"]; ok {
+		userMaxSize = v.(int64)
+	}
 	return &APIKey{
-		Key:       raw["_id"].(string),
-		Secret:    raw["secret"].(string),
-		Owner:     owner,
-		Type:      APIKeyType(raw["type"].(int32)),
-		Secure:    secure,
-		Valid:     raw["valid"].(bool),
-		CreatedAt: created,
+		Key:                raw["_id"].(string),
+		Secret:             raw["secret"].(string),
+		Owner:              owner,
+		Type:               APIKeyType(raw["type"].(int32)),
+		Secure:             secure,
+		Valid:              raw["valid"].(bool),
+		UserBucketsMaxSize: userMaxSize,
+		CreatedAt:          created,
 	}, nil
 }
@@ -80,6 +80,27 @@ func TestAPIKeys_Invalidate(t *testing.T) {
 	require.False(t, got.Valid)
 }
 
+func TestAPIKeys_SetUserBucketsMaxSize(t *testing.T) {
+	db := newDB(t)
+	col, err := NewAPIKeys(context.Background(), db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.Create(context.Background(), owner, UserKey, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), created.UserBucketsMaxSize)
+
+	err = col.SetUserBucketsMaxSize(context.Background(), created.Key, 100*1024*1024)
+	require.NoError(t, err)
+	got, err := col.Get(context.Background(), created.Key)
+	require.NoError(t, err)
+	require.Equal(t, int64(100*1024*1024), got.UserBucketsMaxSize)
+
+	err = col.SetUserBucketsMaxSize(context.Background(), "missing", 1)
+	require.Error(t, err)
+}
+
 func TestAPIKeys_DeleteByOwner(t *testing.T) {
 	db := newDB(t)
 	col, err := NewAPIKeys(context.Background(), db)
@@ -0,0 +1,106 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ArchiveConfig is the default Filecoin archive configuration of an org.
+// It's applied to the FFS instances of buckets created in the org.
+// Zero rep factor and deal duration values fall back to the hub default.
+type ArchiveConfig struct {
+	Org string
+
+	HotEnabled  bool
+	ColdEnabled bool
+
+	RepFactor       int
+	DealMinDuration int64
+	// TrustedMiners are the only miners deals are made with. An empty list allows all miners.
+	TrustedMiners []string
+	// ExcludedMiners are miners deals are never made with.
+	ExcludedMiners []string
+
+	UpdatedAt time.Time
+}
+
+type archiveConfig struct {
+	Org             string    `bson:"_id"`
+	HotEnabled      bool      `bson:"hot_enabled"`
+	ColdEnabled     bool      `bson:"cold_enabled"`
+	RepFactor       int       `bson:"rep_factor"`
+	DealMinDuration int64     `bson:"deal_min_duration"`
+	TrustedMiners   []string  `bson:"trusted_miners"`
+	ExcludedMiners  []string  `bson:"excluded_miners"`
+	UpdatedAt       time.Time `bson:"updated_at"`
+}
+
+type ArchiveConfigs struct {
+	col *mongo.Collection
+}
+
+func NewArchiveConfigs(_ context.Context, db *mongo.Database) (*ArchiveConfigs, error) {
+	return &ArchiveConfigs{col: db.Collection("archiveconfigs")}, nil
+}
+
+// Set replaces the archive config of an org.
+func (a *ArchiveConfigs) Set(ctx context.Context, conf ArchiveConfig) (*ArchiveConfig, error) {
+	conf.UpdatedAt = time.Now()
+	doc := archiveConfig{
+		Org:             conf.Org,
+		HotEnabled:      conf.HotEnabled,
+		ColdEnabled:     conf.ColdEnabled,
+		RepFactor:       conf.RepFactor,
+		DealMinDuration: conf.DealMinDuration,
+		TrustedMiners:   conf.TrustedMiners,
+		ExcludedMiners:  conf.ExcludedMiners,
+		UpdatedAt:       conf.UpdatedAt,
+	}
+	if _, err := a.col.ReplaceOne(ctx, bson.M{"_id": conf.Org}, doc, options.Replace().SetUpsert(true)); err != nil {
+		return nil, err
+	}
+	return &conf, nil
+}
+
+// Get returns the archive config of an org.
+func (a *ArchiveConfigs) Get(ctx context.Context, org string) (*ArchiveConfig, error) {
+	res := a.col.FindOne(ctx, bson.M{"_id": org})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc archiveConfig
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	conf := castArchiveConfig(doc)
+	return &conf, nil
+}
+
+// Delete removes the archive config of an org.
+func (a *ArchiveConfigs) Delete(ctx context.Context, org string) error {
+	res, err := a.col.DeleteOne(ctx, bson.M{"_id": org})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func castArchiveConfig(doc archiveConfig) ArchiveConfig {
+	return ArchiveConfig{
+		Org:             doc.Org,
+		HotEnabled:      doc.HotEnabled,
+		ColdEnabled:     doc.ColdEnabled,
+		RepFactor:       doc.RepFactor,
+		DealMinDuration: doc.DealMinDuration,
+		TrustedMiners:   doc.TrustedMiners,
+		ExcludedMiners:  doc.ExcludedMiners,
+		UpdatedAt:       doc.UpdatedAt,
+	}
+}
@@ -0,0 +1,65 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestArchiveConfigs_Set(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewArchiveConfigs(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Get(ctx, "org")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+
+	_, err = col.Set(ctx, ArchiveConfig{
+		Org:             "org",
+		HotEnabled:      true,
+		ColdEnabled:     true,
+		RepFactor:       3,
+		DealMinDuration: 600000,
+		TrustedMiners:   []string{"f01"},
+		ExcludedMiners:  []string{"f02"},
+	})
+	require.NoError(t, err)
+
+	got, err := col.Get(ctx, "org")
+	require.NoError(t, err)
+	assert.True(t, got.HotEnabled)
+	assert.True(t, got.ColdEnabled)
+	assert.Equal(t, 3, got.RepFactor)
+	assert.Equal(t, int64(600000), got.DealMinDuration)
+	assert.Equal(t, []string{"f01"}, got.TrustedMiners)
+	assert.Equal(t, []string{"f02"}, got.ExcludedMiners)
+
+	_, err = col.Set(ctx, ArchiveConfig{Org: "org", ColdEnabled: true})
+	require.NoError(t, err)
+	got, err = col.Get(ctx, "org")
+	require.NoError(t, err)
+	assert.False(t, got.HotEnabled)
+	assert.Equal(t, 0, got.RepFactor)
+	assert.Empty(t, got.TrustedMiners)
+}
+
+func TestArchiveConfigs_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewArchiveConfigs(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Set(ctx, ArchiveConfig{Org: "org", ColdEnabled: true})
+	require.NoError(t, err)
+	err = col.Delete(ctx, "org")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, "org")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+	err = col.Delete(ctx, "org")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}
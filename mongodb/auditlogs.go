@@ -0,0 +1,110 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AuditLog records a sensitive action taken by an actor on a resource owned by an account.
+type AuditLog struct {
+	ID        string
+	Account   string
+	Actor     string
+	Action    string
+	Resource  string
+	Reason    string
+	CreatedAt time.Time
+}
+
+type AuditLogs struct {
+	col *mongo.Collection
+}
+
+func NewAuditLogs(ctx context.Context, db *mongo.Database) (*AuditLogs, error) {
+	l := &AuditLogs{col: db.Collection("auditlogs")}
+	_, err := l.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"account", 1}, {"created_at", -1}},
+		},
+		{
+			Keys: bson.D{{"resource", 1}},
+		},
+	})
+	return l, err
+}
+
+func (l *AuditLogs) Create(ctx context.Context, account, actor, action, resource, reason string) (*AuditLog, error) {
+	doc := &AuditLog{
+		Account:   account,
+		Actor:     actor,
+		Action:    action,
+		Resource:  resource,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}
+	res, err := l.col.InsertOne(ctx, bson.M{
+		"account":    doc.Account,
+		"actor":      doc.Actor,
+		"action":     doc.Action,
+		"resource":   doc.Resource,
+		"reason":     doc.Reason,
+		"created_at": doc.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	doc.ID = res.InsertedID.(primitive.ObjectID).Hex()
+	return doc, nil
+}
+
+// ListByAccount returns logs for an account, newest first.
+// Logs can be filtered by resource.
+func (l *AuditLogs) ListByAccount(ctx context.Context, account, resource string) ([]AuditLog, error) {
+	filter := bson.M{"account": account}
+	if resource != "" {
+		filter["resource"] = resource
+	}
+	cursor, err := l.col.Find(ctx, filter, options.Find().SetSort(bson.D{{"created_at", -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []AuditLog
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		docs = append(docs, *decodeAuditLog(raw))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func (l *AuditLogs) DeleteByAccount(ctx context.Context, account string) error {
+	_, err := l.col.DeleteMany(ctx, bson.M{"account": account})
+	return err
+}
+
+func decodeAuditLog(raw bson.M) *AuditLog {
+	var created time.Time
+	if v, ok := raw["created_at"]; ok {
+		created = v.(primitive.DateTime).Time()
+	}
+	return &AuditLog{
+		ID:        raw["_id"].(primitive.ObjectID).Hex(),
+		Account:   raw["account"].(string),
+		Actor:     raw["actor"].(string),
+		Action:    raw["action"].(string),
+		Resource:  raw["resource"].(string),
+		Reason:    raw["reason"].(string),
+		CreatedAt: created,
+	}
+}
@@ -0,0 +1,51 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+)
+
+func TestAuditLogs_Create(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewAuditLogs(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, "org", "jane", "legal_hold.set", "bucket/foo", "litigation")
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, "jane", created.Actor)
+}
+
+func TestAuditLogs_ListByAccount(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewAuditLogs(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Create(ctx, "org", "jane", "legal_hold.set", "bucket/foo", "litigation")
+	require.NoError(t, err)
+	_, err = col.Create(ctx, "org", "jane", "legal_hold.release", "bucket/foo", "settled")
+	require.NoError(t, err)
+	_, err = col.Create(ctx, "org", "jane", "legal_hold.set", "bucket/bar", "litigation")
+	require.NoError(t, err)
+
+	list, err := col.ListByAccount(ctx, "org", "")
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(list))
+
+	list, err = col.ListByAccount(ctx, "org", "bucket/foo")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	assert.Equal(t, "legal_hold.release", list[0].Action)
+
+	err = col.DeleteByAccount(ctx, "org")
+	require.NoError(t, err)
+	list, err = col.ListByAccount(ctx, "org", "")
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
@@ -0,0 +1,283 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BucketImportStatus is the state of a bucket import.
+type BucketImportStatus int
+
+const (
+	// ImportQueued imports have not started yet.
+	ImportQueued BucketImportStatus = iota
+	// ImportRunning imports have imported some objects and will resume from their cursor.
+	ImportRunning
+	// ImportDone imports have imported all objects.
+	ImportDone
+	// ImportFailed imports were stopped after an error.
+	ImportFailed
+	// ImportCanceled imports were stopped by the user.
+	ImportCanceled
+)
+
+// S3Source is an S3 bucket prefix that objects are imported from.
+type S3Source struct {
+	Endpoint  string `bson:"endpoint"`
+	Region    string `bson:"region"`
+	Bucket    string `bson:"bucket"`
+	Prefix    string `bson:"prefix"`
+	AccessKey string `bson:"access_key"`
+	SecretKey string `bson:"secret_key"`
+}
+
+// BucketImport is a server-side import of objects into a bucket.
+type BucketImport struct {
+	ID        string
+	BucketKey string
+	DbID      thread.ID
+	DbToken   thread.Token
+	Owner     []byte
+
+	Source S3Source
+	// Path is the bucket path objects are imported to.
+	Path string
+
+	Status BucketImportStatus
+	// Cursor is the key of the last imported object.
+	Cursor  string
+	Objects int64
+	Bytes   int64
+	// Attempts is the number of consecutive failed attempts.
+	Attempts  int
+	LastError string
+	// ReadyAt is the earliest time the import can be resumed.
+	ReadyAt   time.Time
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// bucketImport is an internal representation for storage.
+type bucketImport struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	BucketKey string             `bson:"bucket_key"`
+	DbID      thread.ID          `bson:"db_id"`
+	DbToken   thread.Token       `bson:"db_token"`
+	Owner     []byte             `bson:"owner"`
+	Source    S3Source           `bson:"source"`
+	Path      string             `bson:"path"`
+	Status    BucketImportStatus `bson:"status"`
+	Cursor    string             `bson:"cursor"`
+	Objects   int64              `bson:"objects"`
+	Bytes     int64              `bson:"bytes"`
+	Attempts  int                `bson:"attempts"`
+	LastError string             `bson:"last_error"`
+	ReadyAt   time.Time          `bson:"ready_at"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+}
+
+type BucketImports struct {
+	col *mongo.Collection
+}
+
+func NewBucketImports(ctx context.Context, db *mongo.Database) (*BucketImports, error) {
+	i := &BucketImports{col: db.Collection("bucketimports")}
+	_, err := i.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"bucket_key", 1}},
+		},
+		{
+			Keys: bson.D{{"status", 1}, {"ready_at", 1}},
+		},
+	})
+	return i, err
+}
+
+// Create adds a queued import that is ready to start.
+func (i *BucketImports) Create(ctx context.Context, imp BucketImport) (*BucketImport, error) {
+	now := time.Now()
+	imp.Status = ImportQueued
+	imp.ReadyAt = now
+	imp.CreatedAt = now
+	imp.UpdatedAt = now
+	doc := bucketImport{
+		BucketKey: imp.BucketKey,
+		DbID:      imp.DbID,
+		DbToken:   imp.DbToken,
+		Owner:     imp.Owner,
+		Source:    imp.Source,
+		Path:      imp.Path,
+		Status:    imp.Status,
+		ReadyAt:   imp.ReadyAt,
+		CreatedAt: imp.CreatedAt,
+		UpdatedAt: imp.UpdatedAt,
+	}
+	res, err := i.col.InsertOne(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	imp.ID = res.InsertedID.(primitive.ObjectID).Hex()
+	return &imp, nil
+}
+
+// Get returns the import with id.
+func (i *BucketImports) Get(ctx context.Context, id string) (*BucketImport, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	res := i.col.FindOne(ctx, bson.M{"_id": oid})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc bucketImport
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	imp := castBucketImport(doc)
+	return &imp, nil
+}
+
+// List returns the imports of the bucket with key, newest first.
+func (i *BucketImports) List(ctx context.Context, key string) ([]BucketImport, error) {
+	return i.find(ctx, bson.M{"bucket_key": key}, options.Find().SetSort(bson.D{{"_id", -1}}))
+}
+
+// GetReady returns up to n queued or running imports that are ready to be resumed.
+func (i *BucketImports) GetReady(ctx context.Context, n int64) ([]BucketImport, error) {
+	opts := options.Find().SetLimit(n).SetSort(bson.D{{"ready_at", 1}})
+	filter := bson.M{
+		"status":   bson.M{"$in": bson.A{ImportQueued, ImportRunning}},
+		"ready_at": bson.M{"$lte": time.Now()},
+	}
+	list, err := i.find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("querying ready bucket imports: %s", err)
+	}
+	return list, nil
+}
+
+// SetProgress records a batch of imported objects up to cursor.
+// The import is leased until leaseUntil, after which it can be resumed if it was interrupted.
+// Returns mongo.ErrNoDocuments if the import is no longer queued or running.
+func (i *BucketImports) SetProgress(ctx context.Context, id, cursor string, objects, bytes int64, leaseUntil time.Time) error {
+	return i.update(ctx, id, bson.M{
+		"$set": bson.M{
+			"status":     ImportRunning,
+			"cursor":     cursor,
+			"attempts":   0,
+			"last_error": "",
+			"ready_at":   leaseUntil,
+			"updated_at": time.Now(),
+		},
+		"$inc": bson.M{"objects": objects, "bytes": bytes},
+	})
+}
+
+// SetDone marks the import with id as done.
+func (i *BucketImports) SetDone(ctx context.Context, id string) error {
+	return i.update(ctx, id, bson.M{"$set": bson.M{
+		"status":     ImportDone,
+		"updated_at": time.Now(),
+	}})
+}
+
+// SetFailed records a failed attempt of the import with id.
+// The import is retried at retryAt, or stopped if it has failed maxAttempts times in a row.
+func (i *BucketImports) SetFailed(ctx context.Context, id, reason string, retryAt time.Time, maxAttempts int) error {
+	imp, err := i.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	update := bson.M{
+		"attempts":   imp.Attempts + 1,
+		"last_error": reason,
+		"ready_at":   retryAt,
+		"updated_at": time.Now(),
+	}
+	if imp.Attempts+1 >= maxAttempts {
+		update["status"] = ImportFailed
+	}
+	return i.update(ctx, id, bson.M{"$set": update})
+}
+
+// Cancel stops the import with id.
+// Returns mongo.ErrNoDocuments if the import is no longer queued or running.
+func (i *BucketImports) Cancel(ctx context.Context, id string) error {
+	return i.update(ctx, id, bson.M{"$set": bson.M{
+		"status":     ImportCanceled,
+		"updated_at": time.Now(),
+	}})
+}
+
+// DeleteByBucket removes all imports of the bucket with key.
+func (i *BucketImports) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := i.col.DeleteMany(ctx, bson.M{"bucket_key": key})
+	return err
+}
+
+// update applies update to the import with id if it's queued or running.
+func (i *BucketImports) update(ctx context.Context, id string, update bson.M) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	filter := bson.M{"_id": oid, "status": bson.M{"$in": bson.A{ImportQueued, ImportRunning}}}
+	res, err := i.col.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (i *BucketImports) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]BucketImport, error) {
+	cursor, err := i.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var list []BucketImport
+	for cursor.Next(ctx) {
+		var doc bucketImport
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		list = append(list, castBucketImport(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func castBucketImport(doc bucketImport) BucketImport {
+	return BucketImport{
+		ID:        doc.ID.Hex(),
+		BucketKey: doc.BucketKey,
+		DbID:      doc.DbID,
+		DbToken:   doc.DbToken,
+		Owner:     doc.Owner,
+		Source:    doc.Source,
+		Path:      doc.Path,
+		Status:    doc.Status,
+		Cursor:    doc.Cursor,
+		Objects:   doc.Objects,
+		Bytes:     doc.Bytes,
+		Attempts:  doc.Attempts,
+		LastError: doc.LastError,
+		ReadyAt:   doc.ReadyAt,
+		CreatedAt: doc.CreatedAt,
+		UpdatedAt: doc.UpdatedAt,
+	}
+}
@@ -0,0 +1,137 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestBucketImports_Create(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketImports(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, BucketImport{
+		BucketKey: "buck",
+		DbID:      thread.NewIDV1(thread.Raw, 16),
+		DbToken:   thread.Token("token"),
+		Source: S3Source{
+			Region: "us-west-2",
+			Bucket: "source",
+			Prefix: "photos/",
+		},
+		Path: "imported",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.Equal(t, ImportQueued, created.Status)
+
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "source", got.Source.Bucket)
+	assert.Equal(t, "photos/", got.Source.Prefix)
+	assert.Equal(t, "imported", got.Path)
+
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, ready, 1)
+	assert.Equal(t, created.ID, ready[0].ID)
+}
+
+func TestBucketImports_SetProgress(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketImports(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, BucketImport{BucketKey: "buck"})
+	require.NoError(t, err)
+
+	err = col.SetProgress(ctx, created.ID, "a.txt", 2, 100, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	err = col.SetProgress(ctx, created.ID, "b.txt", 1, 50, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ImportRunning, got.Status)
+	assert.Equal(t, "b.txt", got.Cursor)
+	assert.Equal(t, int64(3), got.Objects)
+	assert.Equal(t, int64(150), got.Bytes)
+
+	// Leased imports are not ready.
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+
+	err = col.SetDone(ctx, created.ID)
+	require.NoError(t, err)
+	got, err = col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ImportDone, got.Status)
+
+	// Finished imports can't be updated.
+	err = col.SetProgress(ctx, created.ID, "c.txt", 1, 1, time.Now())
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+}
+
+func TestBucketImports_SetFailed(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketImports(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, BucketImport{BucketKey: "buck"})
+	require.NoError(t, err)
+
+	err = col.SetFailed(ctx, created.ID, "boom", time.Now(), 2)
+	require.NoError(t, err)
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ImportQueued, got.Status)
+	assert.Equal(t, 1, got.Attempts)
+	assert.Equal(t, "boom", got.LastError)
+
+	err = col.SetFailed(ctx, created.ID, "boom again", time.Now(), 2)
+	require.NoError(t, err)
+	got, err = col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ImportFailed, got.Status)
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+}
+
+func TestBucketImports_Cancel(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketImports(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, BucketImport{BucketKey: "buck"})
+	require.NoError(t, err)
+	err = col.Cancel(ctx, created.ID)
+	require.NoError(t, err)
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, ImportCanceled, got.Status)
+
+	err = col.Cancel(ctx, created.ID)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	err = col.DeleteByBucket(ctx, "buck")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, created.ID)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+}
@@ -0,0 +1,155 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BucketLicense describes the licensing terms of bucket content.
+// A license with an empty path applies to the whole bucket.
+// License is typically an SPDX identifier, e.g., "CC-BY-4.0".
+type BucketLicense struct {
+	BucketKey   string
+	Path        string
+	License     string
+	URL         string
+	Attribution string
+	UpdatedAt   time.Time
+}
+
+type BucketLicenses struct {
+	col *mongo.Collection
+}
+
+func NewBucketLicenses(_ context.Context, db *mongo.Database) (*BucketLicenses, error) {
+	return &BucketLicenses{col: db.Collection("bucketlicenses")}, nil
+}
+
+// Set replaces the license at a bucket path.
+func (l *BucketLicenses) Set(ctx context.Context, license BucketLicense) (*BucketLicense, error) {
+	license.Path = cleanLicensePath(license.Path)
+	license.UpdatedAt = time.Now()
+	id := bson.D{{"bucket_key", license.BucketKey}, {"path", license.Path}}
+	if _, err := l.col.ReplaceOne(ctx, bson.M{"_id": id}, bson.M{
+		"_id":         id,
+		"license":     license.License,
+		"url":         license.URL,
+		"attribution": license.Attribution,
+		"updated_at":  license.UpdatedAt,
+	}, options.Replace().SetUpsert(true)); err != nil {
+		return nil, err
+	}
+	return &license, nil
+}
+
+// Get returns the license set at exactly the given bucket path.
+func (l *BucketLicenses) Get(ctx context.Context, key, pth string) (*BucketLicense, error) {
+	res := l.col.FindOne(ctx, bson.M{"_id": bson.D{{"bucket_key", key}, {"path", cleanLicensePath(pth)}}})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeBucketLicense(raw), nil
+}
+
+// Resolve returns the license that applies to a bucket path.
+// This is the license set at the path or its closest parent.
+func (l *BucketLicenses) Resolve(ctx context.Context, key, pth string) (*BucketLicense, error) {
+	pth = cleanLicensePath(pth)
+	paths := bson.A{""}
+	if pth != "" {
+		parts := strings.Split(pth, "/")
+		for i := range parts {
+			paths = append(paths, strings.Join(parts[:i+1], "/"))
+		}
+	}
+	cursor, err := l.col.Find(ctx, bson.M{"_id.bucket_key": key, "_id.path": bson.M{"$in": paths}})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var closest *BucketLicense
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		doc := decodeBucketLicense(raw)
+		if closest == nil || len(doc.Path) > len(closest.Path) {
+			closest = doc
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	if closest == nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	return closest, nil
+}
+
+// List returns all licenses set in a bucket, ordered by path.
+func (l *BucketLicenses) List(ctx context.Context, key string) ([]BucketLicense, error) {
+	cursor, err := l.col.Find(ctx, bson.M{"_id.bucket_key": key}, options.Find().SetSort(bson.D{{"_id.path", 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []BucketLicense
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		docs = append(docs, *decodeBucketLicense(raw))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func (l *BucketLicenses) Delete(ctx context.Context, key, pth string) error {
+	res, err := l.col.DeleteOne(ctx, bson.M{"_id": bson.D{{"bucket_key", key}, {"path", cleanLicensePath(pth)}}})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (l *BucketLicenses) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := l.col.DeleteMany(ctx, bson.M{"_id.bucket_key": key})
+	return err
+}
+
+func cleanLicensePath(pth string) string {
+	return strings.Trim(pth, "/")
+}
+
+func decodeBucketLicense(raw bson.M) *BucketLicense {
+	id := raw["_id"].(bson.M)
+	var updated time.Time
+	if v, ok := raw["updated_at"]; ok {
+		updated = v.(primitive.DateTime).Time()
+	}
+	return &BucketLicense{
+		BucketKey:   id["bucket_key"].(string),
+		Path:        id["path"].(string),
+		License:     raw["license"].(string),
+		URL:         raw["url"].(string),
+		Attribution: raw["attribution"].(string),
+		UpdatedAt:   updated,
+	}
+}
@@ -0,0 +1,106 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestBucketLicenses_Set(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketLicenses(ctx, db)
+	require.NoError(t, err)
+
+	set, err := col.Set(ctx, BucketLicense{
+		BucketKey:   "buck",
+		Path:        "/data/",
+		License:     "CC-BY-4.0",
+		URL:         "https://creativecommons.org/licenses/by/4.0/",
+		Attribution: "Jon Doe",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "data", set.Path)
+	assert.False(t, set.UpdatedAt.IsZero())
+
+	_, err = col.Set(ctx, BucketLicense{BucketKey: "buck", Path: "data", License: "MIT"})
+	require.NoError(t, err)
+
+	got, err := col.Get(ctx, "buck", "data")
+	require.NoError(t, err)
+	assert.Equal(t, "MIT", got.License)
+	assert.Equal(t, "", got.Attribution)
+}
+
+func TestBucketLicenses_Resolve(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketLicenses(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Resolve(ctx, "buck", "data/file.csv")
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	_, err = col.Set(ctx, BucketLicense{BucketKey: "buck", License: "MIT"})
+	require.NoError(t, err)
+	_, err = col.Set(ctx, BucketLicense{BucketKey: "buck", Path: "data", License: "CC-BY-4.0"})
+	require.NoError(t, err)
+
+	got, err := col.Resolve(ctx, "buck", "data/file.csv")
+	require.NoError(t, err)
+	assert.Equal(t, "CC-BY-4.0", got.License)
+	got, err = col.Resolve(ctx, "buck", "database/file.csv")
+	require.NoError(t, err)
+	assert.Equal(t, "MIT", got.License)
+	got, err = col.Resolve(ctx, "buck", "")
+	require.NoError(t, err)
+	assert.Equal(t, "MIT", got.License)
+}
+
+func TestBucketLicenses_List(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketLicenses(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Set(ctx, BucketLicense{BucketKey: "buck", Path: "b", License: "MIT"})
+	require.NoError(t, err)
+	_, err = col.Set(ctx, BucketLicense{BucketKey: "buck", Path: "a", License: "MIT"})
+	require.NoError(t, err)
+	_, err = col.Set(ctx, BucketLicense{BucketKey: "other", License: "MIT"})
+	require.NoError(t, err)
+
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	assert.Equal(t, "a", list[0].Path)
+	assert.Equal(t, "b", list[1].Path)
+}
+
+func TestBucketLicenses_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketLicenses(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Set(ctx, BucketLicense{BucketKey: "buck", Path: "a", License: "MIT"})
+	require.NoError(t, err)
+	_, err = col.Set(ctx, BucketLicense{BucketKey: "buck", Path: "b", License: "MIT"})
+	require.NoError(t, err)
+
+	err = col.Delete(ctx, "buck", "a")
+	require.NoError(t, err)
+	err = col.Delete(ctx, "buck", "a")
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	err = col.DeleteByBucket(ctx, "buck")
+	require.NoError(t, err)
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
@@ -0,0 +1,153 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/textileio/go-threads/core/thread"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ScheduledLifecycle schedules the lifecycle rules of a bucket for execution.
+// The rules themselves are stored with the bucket.
+type ScheduledLifecycle struct {
+	BucketKey string
+	DbID      thread.ID
+	DbToken   thread.Token
+	// Owner is the account or user that owns the bucket, if any.
+	Owner     crypto.PubKey
+	ReadyAt   time.Time
+	CreatedAt time.Time
+}
+
+// scheduledLifecycle is an internal representation for storage.
+type scheduledLifecycle struct {
+	BucketKey string       `bson:"_id"`
+	DbID      thread.ID    `bson:"db_id"`
+	DbToken   thread.Token `bson:"db_token"`
+	Owner     []byte       `bson:"owner,omitempty"`
+	ReadyAt   time.Time    `bson:"ready_at"`
+	CreatedAt time.Time    `bson:"created_at"`
+}
+
+type BucketLifecycles struct {
+	col *mongo.Collection
+}
+
+func NewBucketLifecycles(ctx context.Context, db *mongo.Database) (*BucketLifecycles, error) {
+	s := &BucketLifecycles{col: db.Collection("bucketlifecycles")}
+	_, err := s.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"ready_at", 1}},
+	})
+	return s, err
+}
+
+// Schedule schedules the lifecycle rules of the bucket with key to run at readyAt.
+// An existing schedule for the bucket is replaced.
+func (l *BucketLifecycles) Schedule(ctx context.Context, dbID thread.ID, dbToken thread.Token, key string, owner crypto.PubKey, readyAt time.Time) error {
+	doc := scheduledLifecycle{
+		BucketKey: key,
+		DbID:      dbID,
+		DbToken:   dbToken,
+		ReadyAt:   readyAt,
+		CreatedAt: time.Now(),
+	}
+	if owner != nil {
+		ownerID, err := crypto.MarshalPublicKey(owner)
+		if err != nil {
+			return err
+		}
+		doc.Owner = ownerID
+	}
+	_, err := l.col.ReplaceOne(ctx, bson.M{"_id": key}, doc, options.Replace().SetUpsert(true))
+	return err
+}
+
+// Get returns the lifecycle schedule of the bucket with key.
+func (l *BucketLifecycles) Get(ctx context.Context, key string) (*ScheduledLifecycle, error) {
+	res := l.col.FindOne(ctx, bson.M{"_id": key})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc scheduledLifecycle
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	sl, err := castScheduledLifecycle(doc)
+	if err != nil {
+		return nil, err
+	}
+	return &sl, nil
+}
+
+// GetReady returns up to n scheduled lifecycles that are ready to run.
+func (l *BucketLifecycles) GetReady(ctx context.Context, n int64) ([]ScheduledLifecycle, error) {
+	opts := options.Find().SetLimit(n).SetSort(bson.D{{"ready_at", 1}})
+	cursor, err := l.col.Find(ctx, bson.M{"ready_at": bson.M{"$lte": time.Now()}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("querying ready lifecycles: %s", err)
+	}
+	defer cursor.Close(ctx)
+	var list []ScheduledLifecycle
+	for cursor.Next(ctx) {
+		var doc scheduledLifecycle
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		sl, err := castScheduledLifecycle(doc)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, sl)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Reschedule sets the next time the lifecycle rules of the bucket with key run.
+func (l *BucketLifecycles) Reschedule(ctx context.Context, key string, readyAt time.Time) error {
+	res, err := l.col.UpdateOne(ctx, bson.M{"_id": key}, bson.M{"$set": bson.M{"ready_at": readyAt}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// Delete removes the lifecycle schedule of the bucket with key.
+func (l *BucketLifecycles) Delete(ctx context.Context, key string) error {
+	res, err := l.col.DeleteOne(ctx, bson.M{"_id": key})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func castScheduledLifecycle(doc scheduledLifecycle) (ScheduledLifecycle, error) {
+	sl := ScheduledLifecycle{
+		BucketKey: doc.BucketKey,
+		DbID:      doc.DbID,
+		DbToken:   doc.DbToken,
+		ReadyAt:   doc.ReadyAt,
+		CreatedAt: doc.CreatedAt,
+	}
+	if len(doc.Owner) > 0 {
+		owner, err := crypto.UnmarshalPublicKey(doc.Owner)
+		if err != nil {
+			return sl, err
+		}
+		sl.Owner = owner
+	}
+	return sl, nil
+}
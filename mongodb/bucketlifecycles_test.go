@@ -0,0 +1,81 @@
+package mongodb_test
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestBucketLifecycles_Schedule(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketLifecycles(ctx, db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	dbID := thread.NewIDV1(thread.Raw, 16)
+	err = col.Schedule(ctx, dbID, thread.Token("token"), "buck", owner, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+
+	got, err := col.Get(ctx, "buck")
+	require.NoError(t, err)
+	assert.Equal(t, dbID, got.DbID)
+	assert.Equal(t, thread.Token("token"), got.DbToken)
+	assert.True(t, owner.Equals(got.Owner))
+
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+
+	err = col.Schedule(ctx, dbID, thread.Token("token"), "buck", nil, time.Now())
+	require.NoError(t, err)
+	ready, err = col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, ready, 1)
+	assert.Equal(t, "buck", ready[0].BucketKey)
+	assert.Nil(t, ready[0].Owner)
+}
+
+func TestBucketLifecycles_Reschedule(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketLifecycles(ctx, db)
+	require.NoError(t, err)
+
+	err = col.Schedule(ctx, thread.NewIDV1(thread.Raw, 16), thread.Token("token"), "buck", nil, time.Now())
+	require.NoError(t, err)
+	err = col.Reschedule(ctx, "buck", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+
+	err = col.Reschedule(ctx, "missing", time.Now())
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+}
+
+func TestBucketLifecycles_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketLifecycles(ctx, db)
+	require.NoError(t, err)
+
+	err = col.Schedule(ctx, thread.NewIDV1(thread.Raw, 16), thread.Token("token"), "buck", nil, time.Now())
+	require.NoError(t, err)
+	err = col.Delete(ctx, "buck")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, "buck")
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+	err = col.Delete(ctx, "buck")
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+}
@@ -0,0 +1,121 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BucketSnapshot is a named bucket root that stays pinned until the snapshot is removed.
+// PinPath is the path of the node that holds the snapshot's pin.
+type BucketSnapshot struct {
+	BucketKey   string
+	Name        string
+	Description string
+	Path        string
+	PinPath     string
+	Size        int64
+	Author      string
+	CreatedAt   time.Time
+}
+
+type BucketSnapshots struct {
+	col *mongo.Collection
+}
+
+func NewBucketSnapshots(ctx context.Context, db *mongo.Database) (*BucketSnapshots, error) {
+	s := &BucketSnapshots{col: db.Collection("bucketsnapshots")}
+	_, err := s.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"_id.bucket_key", 1}, {"created_at", -1}},
+	})
+	return s, err
+}
+
+// Create adds a snapshot.
+// An error containing DuplicateErrMsg is returned if the bucket has a snapshot with the same name.
+func (s *BucketSnapshots) Create(ctx context.Context, snapshot BucketSnapshot) (*BucketSnapshot, error) {
+	snapshot.CreatedAt = time.Now()
+	if _, err := s.col.InsertOne(ctx, bson.M{
+		"_id":         bson.D{{"bucket_key", snapshot.BucketKey}, {"name", snapshot.Name}},
+		"description": snapshot.Description,
+		"path":        snapshot.Path,
+		"pin_path":    snapshot.PinPath,
+		"size":        snapshot.Size,
+		"author":      snapshot.Author,
+		"created_at":  snapshot.CreatedAt,
+	}); err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+func (s *BucketSnapshots) Get(ctx context.Context, key, name string) (*BucketSnapshot, error) {
+	res := s.col.FindOne(ctx, bson.M{"_id": bson.D{{"bucket_key", key}, {"name", name}}})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeBucketSnapshot(raw), nil
+}
+
+// List returns snapshots of a bucket, newest first.
+func (s *BucketSnapshots) List(ctx context.Context, key string) ([]BucketSnapshot, error) {
+	cursor, err := s.col.Find(ctx, bson.M{"_id.bucket_key": key}, options.Find().SetSort(bson.D{{"created_at", -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []BucketSnapshot
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		docs = append(docs, *decodeBucketSnapshot(raw))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func (s *BucketSnapshots) Delete(ctx context.Context, key, name string) error {
+	res, err := s.col.DeleteOne(ctx, bson.M{"_id": bson.D{{"bucket_key", key}, {"name", name}}})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (s *BucketSnapshots) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := s.col.DeleteMany(ctx, bson.M{"_id.bucket_key": key})
+	return err
+}
+
+func decodeBucketSnapshot(raw bson.M) *BucketSnapshot {
+	id := raw["_id"].(bson.M)
+	var created time.Time
+	if v, ok := raw["created_at"]; ok {
+		created = v.(primitive.DateTime).Time()
+	}
+	return &BucketSnapshot{
+		BucketKey:   id["bucket_key"].(string),
+		Name:        id["name"].(string),
+		Description: raw["description"].(string),
+		Path:        raw["path"].(string),
+		PinPath:     raw["pin_path"].(string),
+		Size:        raw["size"].(int64),
+		Author:      raw["author"].(string),
+		CreatedAt:   created,
+	}
+}
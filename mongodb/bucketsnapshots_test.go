@@ -0,0 +1,78 @@
+package mongodb_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestBucketSnapshots_Create(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketSnapshots(ctx, db)
+	require.NoError(t, err)
+
+	snap := BucketSnapshot{
+		BucketKey:   "buck",
+		Name:        "v1",
+		Description: "first release",
+		Path:        "/ipfs/root",
+		PinPath:     "/ipfs/pin",
+		Size:        10,
+		Author:      "jon",
+	}
+	created, err := col.Create(ctx, snap)
+	require.NoError(t, err)
+	assert.False(t, created.CreatedAt.IsZero())
+
+	_, err = col.Create(ctx, snap)
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), DuplicateErrMsg))
+
+	got, err := col.Get(ctx, "buck", "v1")
+	require.NoError(t, err)
+	assert.Equal(t, "first release", got.Description)
+	assert.Equal(t, "/ipfs/root", got.Path)
+	assert.Equal(t, "/ipfs/pin", got.PinPath)
+	assert.Equal(t, int64(10), got.Size)
+}
+
+func TestBucketSnapshots_List(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketSnapshots(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Create(ctx, BucketSnapshot{BucketKey: "buck", Name: "v1"})
+	require.NoError(t, err)
+	_, err = col.Create(ctx, BucketSnapshot{BucketKey: "buck", Name: "v2"})
+	require.NoError(t, err)
+	_, err = col.Create(ctx, BucketSnapshot{BucketKey: "other", Name: "v1"})
+	require.NoError(t, err)
+
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	assert.Equal(t, "v2", list[0].Name)
+}
+
+func TestBucketSnapshots_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketSnapshots(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Create(ctx, BucketSnapshot{BucketKey: "buck", Name: "v1"})
+	require.NoError(t, err)
+	err = col.Delete(ctx, "buck", "v1")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, "buck", "v1")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+	err = col.Delete(ctx, "buck", "v1")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}
@@ -0,0 +1,164 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// BucketVersion records a transition of a bucket's root path.
+type BucketVersion struct {
+	ID        string
+	BucketKey string
+	Path      string
+	Author    string
+	Message   string
+	CreatedAt time.Time
+}
+
+type BucketVersions struct {
+	col *mongo.Collection
+}
+
+func NewBucketVersions(ctx context.Context, db *mongo.Database) (*BucketVersions, error) {
+	v := &BucketVersions{col: db.Collection("bucketversions")}
+	_, err := v.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"bucket_key", 1}, {"_id", -1}},
+	})
+	return v, err
+}
+
+func (v *BucketVersions) Create(ctx context.Context, key, pth, author, message string) (*BucketVersion, error) {
+	doc := &BucketVersion{
+		BucketKey: key,
+		Path:      pth,
+		Author:    author,
+		Message:   message,
+		CreatedAt: time.Now(),
+	}
+	res, err := v.col.InsertOne(ctx, bson.M{
+		"bucket_key": doc.BucketKey,
+		"path":       doc.Path,
+		"author":     doc.Author,
+		"message":    doc.Message,
+		"created_at": doc.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	doc.ID = res.InsertedID.(primitive.ObjectID).Hex()
+	return doc, nil
+}
+
+func (v *BucketVersions) Get(ctx context.Context, key, id string) (*BucketVersion, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	res := v.col.FindOne(ctx, bson.M{"_id": oid, "bucket_key": key})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeBucketVersion(raw), nil
+}
+
+// GetAt returns the version of a bucket that was current at the given time.
+func (v *BucketVersions) GetAt(ctx context.Context, key string, at time.Time) (*BucketVersion, error) {
+	res := v.col.FindOne(ctx, bson.M{
+		"bucket_key": key,
+		"created_at": bson.M{"$lte": at},
+	}, options.FindOne().SetSort(bson.D{{"_id", -1}}))
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeBucketVersion(raw), nil
+}
+
+// GetAfter returns the oldest version of a bucket that was created after the given time.
+func (v *BucketVersions) GetAfter(ctx context.Context, key string, at time.Time) (*BucketVersion, error) {
+	res := v.col.FindOne(ctx, bson.M{
+		"bucket_key": key,
+		"created_at": bson.M{"$gt": at},
+	}, options.FindOne().SetSort(bson.D{{"_id", 1}}))
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeBucketVersion(raw), nil
+}
+
+// List returns versions of a bucket, newest first.
+// All versions are returned if limit is zero.
+func (v *BucketVersions) List(ctx context.Context, key string, limit int64) ([]BucketVersion, error) {
+	return v.ListBefore(ctx, key, "", limit)
+}
+
+// ListBefore returns versions of a bucket that are older than the version with ID before, newest first.
+// Use an empty before to start with the newest version.
+// All versions are returned if limit is zero.
+func (v *BucketVersions) ListBefore(ctx context.Context, key, before string, limit int64) ([]BucketVersion, error) {
+	filter := bson.M{"bucket_key": key}
+	if before != "" {
+		oid, err := primitive.ObjectIDFromHex(before)
+		if err != nil {
+			return nil, err
+		}
+		filter["_id"] = bson.M{"$lt": oid}
+	}
+	opts := options.Find().SetSort(bson.D{{"_id", -1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	cursor, err := v.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []BucketVersion
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		docs = append(docs, *decodeBucketVersion(raw))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+func (v *BucketVersions) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := v.col.DeleteMany(ctx, bson.M{"bucket_key": key})
+	return err
+}
+
+func decodeBucketVersion(raw bson.M) *BucketVersion {
+	var created time.Time
+	if v, ok := raw["created_at"]; ok {
+		created = v.(primitive.DateTime).Time()
+	}
+	return &BucketVersion{
+		ID:        raw["_id"].(primitive.ObjectID).Hex(),
+		BucketKey: raw["bucket_key"].(string),
+		Path:      raw["path"].(string),
+		Author:    raw["author"].(string),
+		Message:   raw["message"].(string),
+		CreatedAt: created,
+	}
+}
@@ -0,0 +1,131 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestBucketVersions_Create(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketVersions(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, "buck", "/ipfs/root1", "jon", "initial")
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+
+	got, err := col.Get(ctx, "buck", created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "/ipfs/root1", got.Path)
+	assert.Equal(t, "jon", got.Author)
+	assert.Equal(t, "initial", got.Message)
+
+	_, err = col.Get(ctx, "other", created.ID)
+	require.Equal(t, mongo.ErrNoDocuments, err)
+	_, err = col.Get(ctx, "buck", "bad")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}
+
+func TestBucketVersions_List(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketVersions(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Create(ctx, "buck", "/ipfs/root1", "jon", "")
+	require.NoError(t, err)
+	_, err = col.Create(ctx, "buck", "/ipfs/root2", "jon", "")
+	require.NoError(t, err)
+	_, err = col.Create(ctx, "other", "/ipfs/root3", "jon", "")
+	require.NoError(t, err)
+
+	list, err := col.List(ctx, "buck", 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	assert.Equal(t, "/ipfs/root2", list[0].Path)
+
+	list, err = col.List(ctx, "buck", 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(list))
+
+	err = col.DeleteByBucket(ctx, "buck")
+	require.NoError(t, err)
+	list, err = col.List(ctx, "buck", 0)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
+
+func TestBucketVersions_ListBefore(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketVersions(ctx, db)
+	require.NoError(t, err)
+
+	for _, p := range []string{"/ipfs/root1", "/ipfs/root2", "/ipfs/root3"} {
+		_, err = col.Create(ctx, "buck", p, "jon", "")
+		require.NoError(t, err)
+	}
+
+	page, err := col.ListBefore(ctx, "buck", "", 2)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(page))
+	assert.Equal(t, "/ipfs/root3", page[0].Path)
+	page, err = col.ListBefore(ctx, "buck", page[1].ID, 2)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(page))
+	assert.Equal(t, "/ipfs/root1", page[0].Path)
+
+	_, err = col.ListBefore(ctx, "buck", "bad", 2)
+	require.Error(t, err)
+}
+
+func TestBucketVersions_GetAt(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketVersions(ctx, db)
+	require.NoError(t, err)
+
+	v1, err := col.Create(ctx, "buck", "/ipfs/root1", "jon", "")
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 10)
+	v2, err := col.Create(ctx, "buck", "/ipfs/root2", "jon", "")
+	require.NoError(t, err)
+
+	_, err = col.GetAt(ctx, "buck", v1.CreatedAt.Add(-time.Second))
+	require.Equal(t, mongo.ErrNoDocuments, err)
+	got, err := col.GetAt(ctx, "buck", v1.CreatedAt.Add(time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, v1.ID, got.ID)
+	got, err = col.GetAt(ctx, "buck", v2.CreatedAt.Add(time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, v2.ID, got.ID)
+}
+
+func TestBucketVersions_GetAfter(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewBucketVersions(ctx, db)
+	require.NoError(t, err)
+
+	v1, err := col.Create(ctx, "buck", "/ipfs/root1", "jon", "")
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond * 10)
+	v2, err := col.Create(ctx, "buck", "/ipfs/root2", "jon", "")
+	require.NoError(t, err)
+
+	got, err := col.GetAfter(ctx, "buck", v1.CreatedAt.Add(-time.Second))
+	require.NoError(t, err)
+	assert.Equal(t, v1.ID, got.ID)
+	got, err = col.GetAfter(ctx, "buck", v1.CreatedAt.Add(time.Millisecond))
+	require.NoError(t, err)
+	assert.Equal(t, v2.ID, got.ID)
+	_, err = col.GetAfter(ctx, "buck", v2.CreatedAt)
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}
@@ -23,13 +23,41 @@ type Collections struct {
 	Accounts *Accounts
 	Invites  *Invites
 
-	Threads         *Threads
-	APIKeys         *APIKeys
-	IPNSKeys        *IPNSKeys
-	FFSInstances    *FFSInstances
-	ArchiveTracking *ArchiveTracking
+	Threads            *Threads
+	APIKeys            *APIKeys
+	IPNSKeys           *IPNSKeys
+	FFSInstances       *FFSInstances
+	ArchiveTracking    *ArchiveTracking
+	Tags               *Tags
+	LegalHolds         *LegalHolds
+	AuditLogs          *AuditLogs
+	WebConfigs         *WebConfigs
+	BucketVersions     *BucketVersions
+	BucketSnapshots    *BucketSnapshots
+	BucketLicenses     *BucketLicenses
+	UploadSessions     *UploadSessions
+	ContentRefs        *ContentRefs
+	BucketLifecycles   *BucketLifecycles
+	ReplicationTargets *ReplicationTargets
+	BucketImports      *BucketImports
+	PinMirrors         *PinMirrors
+	Domains            *Domains
+	ShareLinks         *ShareLinks
+	Webhooks           *Webhooks
+	WebhookDeliveries  *WebhookDeliveries
+	SearchIndex        *SearchIndex
+	SearchIndexStates  *SearchIndexStates
+	Thumbnails         *Thumbnails
+	ThumbnailStates    *ThumbnailStates
+	RootPins           *RootPins
+	Migrations         *Migrations
+	PushPolicies       *PushPolicies
+	ArchiveConfigs     *ArchiveConfigs
 
-	Users *Users
+	Users         *Users
+	RevokedTokens *RevokedTokens
+	Devices       *Devices
+	AdminTokens   *AdminTokens
 }
 
 // NewCollections gets or create store instances for active collections.
@@ -66,10 +94,34 @@ func NewCollections(ctx context.Context, uri, dbName string, hub bool) (*Collect
 		if err != nil {
 			return nil, err
 		}
+		c.RevokedTokens, err = NewRevokedTokens(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		c.Devices, err = NewDevices(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		c.AdminTokens, err = NewAdminTokens(ctx, db)
+		if err != nil {
+			return nil, err
+		}
 		c.ArchiveTracking, err = NewArchiveTracking(ctx, db)
 		if err != nil {
 			return nil, err
 		}
+		c.AuditLogs, err = NewAuditLogs(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		c.PushPolicies, err = NewPushPolicies(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		c.ArchiveConfigs, err = NewArchiveConfigs(ctx, db)
+		if err != nil {
+			return nil, err
+		}
 	}
 	c.IPNSKeys, err = NewIPNSKeys(ctx, db)
 	if err != nil {
@@ -79,6 +131,94 @@ func NewCollections(ctx context.Context, uri, dbName string, hub bool) (*Collect
 	if err != nil {
 		return nil, err
 	}
+	c.Tags, err = NewTags(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.LegalHolds, err = NewLegalHolds(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.WebConfigs, err = NewWebConfigs(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.BucketVersions, err = NewBucketVersions(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.BucketSnapshots, err = NewBucketSnapshots(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.BucketLicenses, err = NewBucketLicenses(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.UploadSessions, err = NewUploadSessions(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.ContentRefs, err = NewContentRefs(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.BucketLifecycles, err = NewBucketLifecycles(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.ReplicationTargets, err = NewReplicationTargets(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.BucketImports, err = NewBucketImports(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.PinMirrors, err = NewPinMirrors(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.Domains, err = NewDomains(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.ShareLinks, err = NewShareLinks(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.Webhooks, err = NewWebhooks(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.WebhookDeliveries, err = NewWebhookDeliveries(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.SearchIndex, err = NewSearchIndex(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.SearchIndexStates, err = NewSearchIndexStates(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.Thumbnails, err = NewThumbnails(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.ThumbnailStates, err = NewThumbnailStates(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.RootPins, err = NewRootPins(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+	c.Migrations, err = NewMigrations(ctx, db)
+	if err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
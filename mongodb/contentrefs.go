@@ -0,0 +1,199 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ContentRef counts the bucket files of an owner that share the same content.
+// Content that is referenced more than once is only counted once toward the owner's storage usage.
+type ContentRef struct {
+	Owner     crypto.PubKey
+	Cid       cid.Cid
+	Size      int64
+	Refs      int64
+	CreatedAt time.Time
+}
+
+// ContentStats summarizes the deduplicated content of an owner.
+type ContentStats struct {
+	// Objects is the number of unique files.
+	Objects int64
+	// Refs is the number of bucket files referencing the unique files.
+	Refs int64
+	// StoredSize is the size of the unique files.
+	StoredSize int64
+	// TotalSize is the size of all referencing bucket files.
+	TotalSize int64
+}
+
+// SavedSize returns the number of bytes saved by deduplication.
+func (s ContentStats) SavedSize() int64 {
+	return s.TotalSize - s.StoredSize
+}
+
+type ContentRefs struct {
+	col *mongo.Collection
+}
+
+func NewContentRefs(_ context.Context, db *mongo.Database) (*ContentRefs, error) {
+	return &ContentRefs{col: db.Collection("contentrefs")}, nil
+}
+
+// Add references content with cid c for owner, returning the new number of references.
+// A result greater than one means the content was already stored by the owner.
+func (r *ContentRefs) Add(ctx context.Context, owner crypto.PubKey, c cid.Cid, size int64) (int64, error) {
+	id, err := contentRefID(owner, c)
+	if err != nil {
+		return 0, err
+	}
+	res := r.col.FindOneAndUpdate(ctx, bson.M{"_id": id}, bson.M{
+		"$inc":         bson.M{"refs": int64(1)},
+		"$setOnInsert": bson.M{"size": size, "created_at": time.Now()},
+	}, options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After))
+	if res.Err() != nil {
+		return 0, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return 0, err
+	}
+	doc, err := decodeContentRef(raw)
+	if err != nil {
+		return 0, err
+	}
+	return doc.Refs, nil
+}
+
+// Remove dereferences content with cid c for owner, returning the remaining number of references and the content size.
+// The content is forgotten when it's no longer referenced.
+func (r *ContentRefs) Remove(ctx context.Context, owner crypto.PubKey, c cid.Cid) (int64, int64, error) {
+	id, err := contentRefID(owner, c)
+	if err != nil {
+		return 0, 0, err
+	}
+	res := r.col.FindOneAndUpdate(ctx, bson.M{"_id": id, "refs": bson.M{"$gt": 0}}, bson.M{
+		"$inc": bson.M{"refs": int64(-1)},
+	}, options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if res.Err() != nil {
+		return 0, 0, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return 0, 0, err
+	}
+	doc, err := decodeContentRef(raw)
+	if err != nil {
+		return 0, 0, err
+	}
+	if doc.Refs <= 0 {
+		if _, err := r.col.DeleteOne(ctx, bson.M{"_id": id, "refs": bson.M{"$lte": 0}}); err != nil {
+			return 0, 0, err
+		}
+	}
+	return doc.Refs, doc.Size, nil
+}
+
+// Get returns the reference to content with cid c for owner.
+func (r *ContentRefs) Get(ctx context.Context, owner crypto.PubKey, c cid.Cid) (*ContentRef, error) {
+	id, err := contentRefID(owner, c)
+	if err != nil {
+		return nil, err
+	}
+	res := r.col.FindOne(ctx, bson.M{"_id": id})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeContentRef(raw)
+}
+
+// Stats returns deduplication stats for owner.
+func (r *ContentRefs) Stats(ctx context.Context, owner crypto.PubKey) (*ContentStats, error) {
+	ownerID, err := crypto.MarshalPublicKey(owner)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := r.col.Find(ctx, bson.M{"_id.owner": ownerID})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	stats := &ContentStats{}
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		doc, err := decodeContentRef(raw)
+		if err != nil {
+			return nil, err
+		}
+		stats.Objects++
+		stats.Refs += doc.Refs
+		stats.StoredSize += doc.Size
+		stats.TotalSize += doc.Size * doc.Refs
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// DeleteByOwner removes all content references of owner.
+func (r *ContentRefs) DeleteByOwner(ctx context.Context, owner crypto.PubKey) error {
+	ownerID, err := crypto.MarshalPublicKey(owner)
+	if err != nil {
+		return err
+	}
+	_, err = r.col.DeleteMany(ctx, bson.M{"_id.owner": ownerID})
+	return err
+}
+
+func contentRefID(owner crypto.PubKey, c cid.Cid) (bson.D, error) {
+	ownerID, err := crypto.MarshalPublicKey(owner)
+	if err != nil {
+		return nil, err
+	}
+	return bson.D{{"owner", ownerID}, {"cid", c.Bytes()}}, nil
+}
+
+func decodeContentRef(raw bson.M) (*ContentRef, error) {
+	rid := raw["_id"].(bson.M)
+	owner, err := crypto.UnmarshalPublicKey(rid["owner"].(primitive.Binary).Data)
+	if err != nil {
+		return nil, err
+	}
+	c, err := cid.Cast(rid["cid"].(primitive.Binary).Data)
+	if err != nil {
+		return nil, err
+	}
+	var size, refs int64
+	if v, ok := raw["size"]; ok {
+		size = v.(int64)
+	}
+	if v, ok := raw["refs"]; ok {
+		refs = v.(int64)
+	}
+	var created time.Time
+	if v, ok := raw["created_at"]; ok {
+		created = v.(primitive.DateTime).Time()
+	}
+	return &ContentRef{
+		Owner:     owner,
+		Cid:       c,
+		Size:      size,
+		Refs:      refs,
+		CreatedAt: created,
+	}, nil
+}
@@ -0,0 +1,116 @@
+package mongodb_test
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	"github.com/ipfs/go-cid"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	mh "github.com/multiformats/go-multihash"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestContentRefs_Add(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewContentRefs(ctx, db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	c := newContentCid(t, "foo")
+
+	refs, err := col.Add(ctx, owner, c, 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), refs)
+	refs, err = col.Add(ctx, owner, c, 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), refs)
+
+	got, err := col.Get(ctx, owner, c)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), got.Size)
+	assert.True(t, got.Cid.Equals(c))
+	assert.True(t, got.Owner.Equals(owner))
+}
+
+func TestContentRefs_Remove(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewContentRefs(ctx, db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	c := newContentCid(t, "foo")
+
+	_, _, err = col.Remove(ctx, owner, c)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	_, err = col.Add(ctx, owner, c, 100)
+	require.NoError(t, err)
+	_, err = col.Add(ctx, owner, c, 100)
+	require.NoError(t, err)
+
+	refs, size, err := col.Remove(ctx, owner, c)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), refs)
+	assert.Equal(t, int64(100), size)
+	refs, _, err = col.Remove(ctx, owner, c)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), refs)
+
+	_, err = col.Get(ctx, owner, c)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+}
+
+func TestContentRefs_Stats(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewContentRefs(ctx, db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	_, other, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+
+	c1 := newContentCid(t, "foo")
+	c2 := newContentCid(t, "bar")
+	for i := 0; i < 3; i++ {
+		_, err = col.Add(ctx, owner, c1, 100)
+		require.NoError(t, err)
+	}
+	_, err = col.Add(ctx, owner, c2, 50)
+	require.NoError(t, err)
+	_, err = col.Add(ctx, other, c2, 50)
+	require.NoError(t, err)
+
+	stats, err := col.Stats(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), stats.Objects)
+	assert.Equal(t, int64(4), stats.Refs)
+	assert.Equal(t, int64(150), stats.StoredSize)
+	assert.Equal(t, int64(350), stats.TotalSize)
+	assert.Equal(t, int64(200), stats.SavedSize())
+
+	err = col.DeleteByOwner(ctx, owner)
+	require.NoError(t, err)
+	stats, err = col.Stats(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), stats.Objects)
+	stats, err = col.Stats(ctx, other)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), stats.Objects)
+}
+
+func newContentCid(t *testing.T, data string) cid.Cid {
+	sum, err := mh.Sum([]byte(data), mh.SHA2_256, -1)
+	require.NoError(t, err)
+	return cid.NewCidV1(cid.Raw, sum)
+}
@@ -0,0 +1,121 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Device is a mobile device that receives push notifications for a user.
+type Device struct {
+	Token     string
+	Platform  string
+	Owner     crypto.PubKey
+	CreatedAt time.Time
+}
+
+type device struct {
+	Token     string    `bson:"_id"`
+	Platform  string    `bson:"platform"`
+	Owner     []byte    `bson:"owner"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+type Devices struct {
+	col *mongo.Collection
+}
+
+func NewDevices(ctx context.Context, db *mongo.Database) (*Devices, error) {
+	d := &Devices{col: db.Collection("devices")}
+	_, err := d.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"owner", 1}},
+	})
+	return d, err
+}
+
+// Register adds a device token for owner.
+// A token that is already registered is moved to owner, e.g., if another user signs in on the device.
+func (d *Devices) Register(ctx context.Context, token, platform string, owner crypto.PubKey) (*Device, error) {
+	ownerID, err := crypto.MarshalPublicKey(owner)
+	if err != nil {
+		return nil, err
+	}
+	doc := &Device{
+		Token:     token,
+		Platform:  platform,
+		Owner:     owner,
+		CreatedAt: time.Now(),
+	}
+	if _, err := d.col.ReplaceOne(ctx, bson.M{"_id": token}, device{
+		Token:     doc.Token,
+		Platform:  doc.Platform,
+		Owner:     ownerID,
+		CreatedAt: doc.CreatedAt,
+	}, options.Replace().SetUpsert(true)); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// ListByOwner returns the devices of owner, oldest first.
+func (d *Devices) ListByOwner(ctx context.Context, owner crypto.PubKey) ([]Device, error) {
+	ownerID, err := crypto.MarshalPublicKey(owner)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := d.col.Find(ctx, bson.M{"owner": ownerID}, options.Find().SetSort(bson.D{{"created_at", 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var list []Device
+	for cursor.Next(ctx) {
+		var doc device
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		key, err := crypto.UnmarshalPublicKey(doc.Owner)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, Device{
+			Token:     doc.Token,
+			Platform:  doc.Platform,
+			Owner:     key,
+			CreatedAt: doc.CreatedAt,
+		})
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Delete removes the device with token from owner.
+func (d *Devices) Delete(ctx context.Context, token string, owner crypto.PubKey) error {
+	ownerID, err := crypto.MarshalPublicKey(owner)
+	if err != nil {
+		return err
+	}
+	res, err := d.col.DeleteOne(ctx, bson.M{"_id": token, "owner": ownerID})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (d *Devices) DeleteByOwner(ctx context.Context, owner crypto.PubKey) error {
+	ownerID, err := crypto.MarshalPublicKey(owner)
+	if err != nil {
+		return err
+	}
+	_, err = d.col.DeleteMany(ctx, bson.M{"owner": ownerID})
+	return err
+}
@@ -0,0 +1,69 @@
+package mongodb_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+)
+
+func TestDevices_Register(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewDevices(ctx, db)
+	require.NoError(t, err)
+
+	_, owner1, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	_, owner2, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	_, err = col.Register(ctx, "token", "fcm", owner1)
+	require.NoError(t, err)
+
+	list, err := col.ListByOwner(ctx, owner1)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	assert.Equal(t, "token", list[0].Token)
+	assert.Equal(t, "fcm", list[0].Platform)
+	assert.True(t, list[0].Owner.Equals(owner1))
+
+	// Registering the token again moves it to the new owner
+	_, err = col.Register(ctx, "token", "fcm", owner2)
+	require.NoError(t, err)
+	list, err = col.ListByOwner(ctx, owner1)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	list, err = col.ListByOwner(ctx, owner2)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(list))
+}
+
+func TestDevices_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewDevices(ctx, db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	_, err = col.Register(ctx, "token1", "apns", owner)
+	require.NoError(t, err)
+	_, err = col.Register(ctx, "token2", "fcm", owner)
+	require.NoError(t, err)
+
+	err = col.Delete(ctx, "token1", owner)
+	require.NoError(t, err)
+	list, err := col.ListByOwner(ctx, owner)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(list))
+
+	err = col.DeleteByOwner(ctx, owner)
+	require.NoError(t, err)
+	list, err = col.ListByOwner(ctx, owner)
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
@@ -0,0 +1,360 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Domain is a custom domain whose DNSLink record points at the root of a bucket.
+type Domain struct {
+	ID        string
+	BucketKey string
+	DbID      thread.ID
+	DbToken   thread.Token
+
+	// Name is the domain name.
+	Name string
+	// Challenge is the value of the TXT record that verifies ownership of the domain.
+	Challenge string
+
+	// Provider is the DNS provider that hosts the domain's zone.
+	Provider string
+	// ZoneID is the provider's ID of the zone containing the domain.
+	ZoneID string
+	// Token, AccessKey, and SecretKey authenticate with the provider.
+	Token     string
+	AccessKey string
+	SecretKey string
+
+	Verified   bool
+	VerifiedAt time.Time
+
+	// Pending is true if the domain is not verified yet or the bucket root has changed.
+	Pending bool
+	// ReadyAt is the earliest time the domain can be synced again.
+	ReadyAt time.Time
+	// Seq is incremented each time the domain is marked pending.
+	Seq          int64
+	LastRoot     string
+	LastSyncedAt time.Time
+	LastError    string
+	CreatedAt    time.Time
+}
+
+// domain is an internal representation for storage.
+type domain struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	BucketKey    string             `bson:"bucket_key"`
+	DbID         thread.ID          `bson:"db_id"`
+	DbToken      thread.Token       `bson:"db_token"`
+	Name         string             `bson:"name"`
+	Challenge    string             `bson:"challenge"`
+	Provider     string             `bson:"provider"`
+	ZoneID       string             `bson:"zone_id"`
+	Token        string             `bson:"token"`
+	AccessKey    string             `bson:"access_key"`
+	SecretKey    string             `bson:"secret_key"`
+	Verified     bool               `bson:"verified"`
+	VerifiedAt   time.Time          `bson:"verified_at"`
+	Pending      bool               `bson:"pending"`
+	ReadyAt      time.Time          `bson:"ready_at"`
+	Seq          int64              `bson:"seq"`
+	LastRoot     string             `bson:"last_root"`
+	LastSyncedAt time.Time          `bson:"last_synced_at"`
+	LastError    string             `bson:"last_error"`
+	CreatedAt    time.Time          `bson:"created_at"`
+}
+
+type Domains struct {
+	col *mongo.Collection
+}
+
+func NewDomains(ctx context.Context, db *mongo.Database) (*Domains, error) {
+	d := &Domains{col: db.Collection("domains")}
+	// Names used to be unique across unverified domains too, which let anyone hold a name they don't own.
+	if _, err := d.col.Indexes().DropOne(ctx, "name_1"); err != nil && !isIndexNotFound(err) {
+		return nil, err
+	}
+	_, err := d.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"name", 1}},
+			Options: options.Index().SetName("name_verified").SetUnique(true).
+				SetPartialFilterExpression(bson.D{{"verified", true}}),
+		},
+		{
+			Keys:    bson.D{{"name", 1}, {"bucket_key", 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{"bucket_key", 1}},
+		},
+		{
+			Keys: bson.D{{"pending", 1}, {"ready_at", 1}},
+		},
+		{
+			Keys: bson.D{{"verified", 1}, {"created_at", 1}},
+		},
+	})
+	return d, err
+}
+
+// Create adds an unverified domain.
+// Any number of buckets can claim a name until one of them verifies it.
+// A duplicate key error is returned if the name is already verified or claimed by the same bucket.
+// New domains are pending so that verification is attempted as soon as possible.
+func (d *Domains) Create(ctx context.Context, dom Domain) (*Domain, error) {
+	n, err := d.col.CountDocuments(ctx, bson.M{"name": dom.Name, "verified": true})
+	if err != nil {
+		return nil, err
+	}
+	if n > 0 {
+		return nil, fmt.Errorf("%s: domain %s is verified", DuplicateErrMsg, dom.Name)
+	}
+	dom.Verified = false
+	dom.Pending = true
+	dom.ReadyAt = time.Now()
+	dom.CreatedAt = time.Now()
+	doc := domain{
+		BucketKey: dom.BucketKey,
+		DbID:      dom.DbID,
+		DbToken:   dom.DbToken,
+		Name:      dom.Name,
+		Challenge: dom.Challenge,
+		Provider:  dom.Provider,
+		ZoneID:    dom.ZoneID,
+		Token:     dom.Token,
+		AccessKey: dom.AccessKey,
+		SecretKey: dom.SecretKey,
+		Pending:   dom.Pending,
+		ReadyAt:   dom.ReadyAt,
+		CreatedAt: dom.CreatedAt,
+	}
+	res, err := d.col.InsertOne(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	dom.ID = res.InsertedID.(primitive.ObjectID).Hex()
+	return &dom, nil
+}
+
+// Get returns the domain with id.
+func (d *Domains) Get(ctx context.Context, id string) (*Domain, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	res := d.col.FindOne(ctx, bson.M{"_id": oid})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc domain
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	dom := castDomain(doc)
+	return &dom, nil
+}
+
+// GetByName returns the verified domain with name.
+func (d *Domains) GetByName(ctx context.Context, name string) (*Domain, error) {
+	res := d.col.FindOne(ctx, bson.M{"name": name, "verified": true})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc domain
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	dom := castDomain(doc)
+	return &dom, nil
+}
+
+// List returns the domains of the bucket with key, oldest first.
+func (d *Domains) List(ctx context.Context, key string) ([]Domain, error) {
+	return d.find(ctx, bson.M{"bucket_key": key}, options.Find().SetSort(bson.D{{"_id", 1}}))
+}
+
+// GetReady returns up to n pending domains that are ready to be synced.
+func (d *Domains) GetReady(ctx context.Context, n int64) ([]Domain, error) {
+	opts := options.Find().SetLimit(n).SetSort(bson.D{{"ready_at", 1}})
+	list, err := d.find(ctx, bson.M{"pending": true, "ready_at": bson.M{"$lte": time.Now()}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("querying ready domains: %s", err)
+	}
+	return list, nil
+}
+
+// MarkPending marks all domains of the bucket with key as pending.
+func (d *Domains) MarkPending(ctx context.Context, key string) error {
+	_, err := d.col.UpdateMany(ctx, bson.M{"bucket_key": key}, bson.M{
+		"$set": bson.M{"pending": true, "ready_at": time.Now()},
+		"$inc": bson.M{"seq": 1},
+	})
+	return err
+}
+
+// SetVerified marks the domain with id as verified and removes the other claims to its name.
+// A duplicate key error is returned if the name was already verified by another bucket.
+func (d *Domains) SetVerified(ctx context.Context, id string) error {
+	dom, err := d.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := d.update(ctx, id, bson.M{
+		"verified":    true,
+		"verified_at": time.Now(),
+		"last_error":  "",
+	}); err != nil {
+		return err
+	}
+	oid, _ := primitive.ObjectIDFromHex(id)
+	_, err = d.col.DeleteMany(ctx, bson.M{"name": dom.Name, "verified": false, "_id": bson.M{"$ne": oid}})
+	return err
+}
+
+// SetUnverified records that the verification record of the domain with id was not found.
+// Verification is attempted again at retryAt.
+func (d *Domains) SetUnverified(ctx context.Context, id string, retryAt time.Time) error {
+	return d.update(ctx, id, bson.M{
+		"last_error": "",
+		"ready_at":   retryAt,
+	})
+}
+
+// SetSynced records that the DNSLink record of the domain with id points at root.
+// The domain stays pending if it was marked pending again since seq.
+func (d *Domains) SetSynced(ctx context.Context, id string, seq int64, root string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	update := bson.M{
+		"last_root":      root,
+		"last_synced_at": time.Now(),
+		"last_error":     "",
+		"pending":        false,
+	}
+	res, err := d.col.UpdateOne(ctx, bson.M{"_id": oid, "seq": seq}, bson.M{"$set": update})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+	delete(update, "pending")
+	return d.update(ctx, id, update)
+}
+
+// SetFailed records a failed sync of the domain with id.
+// The sync is retried at retryAt.
+func (d *Domains) SetFailed(ctx context.Context, id string, reason string, retryAt time.Time) error {
+	return d.update(ctx, id, bson.M{
+		"last_error": reason,
+		"ready_at":   retryAt,
+	})
+}
+
+// Delete removes the domain with id.
+func (d *Domains) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	res, err := d.col.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeleteUnverified removes unverified domains created before t.
+func (d *Domains) DeleteUnverified(ctx context.Context, t time.Time) error {
+	_, err := d.col.DeleteMany(ctx, bson.M{"verified": false, "created_at": bson.M{"$lt": t}})
+	return err
+}
+
+// DeleteByBucket removes all domains of the bucket with key.
+func (d *Domains) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := d.col.DeleteMany(ctx, bson.M{"bucket_key": key})
+	return err
+}
+
+func (d *Domains) update(ctx context.Context, id string, set bson.M) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	res, err := d.col.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": set})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (d *Domains) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]Domain, error) {
+	cursor, err := d.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var list []Domain
+	for cursor.Next(ctx) {
+		var doc domain
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		list = append(list, castDomain(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func castDomain(doc domain) Domain {
+	return Domain{
+		ID:           doc.ID.Hex(),
+		BucketKey:    doc.BucketKey,
+		DbID:         doc.DbID,
+		DbToken:      doc.DbToken,
+		Name:         doc.Name,
+		Challenge:    doc.Challenge,
+		Provider:     doc.Provider,
+		ZoneID:       doc.ZoneID,
+		Token:        doc.Token,
+		AccessKey:    doc.AccessKey,
+		SecretKey:    doc.SecretKey,
+		Verified:     doc.Verified,
+		VerifiedAt:   doc.VerifiedAt,
+		Pending:      doc.Pending,
+		ReadyAt:      doc.ReadyAt,
+		Seq:          doc.Seq,
+		LastRoot:     doc.LastRoot,
+		LastSyncedAt: doc.LastSyncedAt,
+		LastError:    doc.LastError,
+		CreatedAt:    doc.CreatedAt,
+	}
+}
+
+// isIndexNotFound returns whether err is from dropping an index or collection that doesn't exist.
+func isIndexNotFound(err error) bool {
+	var cerr mongo.CommandError
+	if errors.As(err, &cerr) {
+		return cerr.Code == 26 || cerr.Code == 27
+	}
+	return false
+}
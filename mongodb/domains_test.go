@@ -0,0 +1,163 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestDomains_Create(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewDomains(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, Domain{
+		BucketKey: "buck",
+		DbID:      thread.NewIDV1(thread.Raw, 16),
+		DbToken:   thread.Token("token"),
+		Name:      "example.com",
+		Challenge: "challenge",
+		Provider:  "cloudflare",
+		ZoneID:    "zone",
+		Token:     "secret",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.True(t, created.Pending)
+	assert.False(t, created.Verified)
+
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "example.com", got.Name)
+	assert.Equal(t, "challenge", got.Challenge)
+	assert.Equal(t, "secret", got.Token)
+
+	_, err = col.Create(ctx, Domain{BucketKey: "buck", Name: "example.com"})
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), DuplicateErrMsg))
+
+	// Only verified domains are found by name.
+	_, err = col.GetByName(ctx, "example.com")
+	assert.True(t, errors.Is(err, mongo.ErrNoDocuments))
+	err = col.SetVerified(ctx, created.ID)
+	require.NoError(t, err)
+	byName, err := col.GetByName(ctx, "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, byName.ID)
+
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, ready, 1)
+	assert.Equal(t, created.ID, ready[0].ID)
+}
+
+func TestDomains_Claims(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewDomains(ctx, db)
+	require.NoError(t, err)
+
+	// Two accounts can claim the same name until one of them verifies it.
+	squatter, err := col.Create(ctx, Domain{BucketKey: "squatter", Name: "example.com"})
+	require.NoError(t, err)
+	owner, err := col.Create(ctx, Domain{BucketKey: "owner", Name: "example.com"})
+	require.NoError(t, err)
+
+	err = col.SetVerified(ctx, owner.ID)
+	require.NoError(t, err)
+	_, err = col.Get(ctx, squatter.ID)
+	assert.True(t, errors.Is(err, mongo.ErrNoDocuments))
+	got, err := col.GetByName(ctx, "example.com")
+	require.NoError(t, err)
+	assert.Equal(t, owner.ID, got.ID)
+
+	// The name can't be claimed once it's verified.
+	_, err = col.Create(ctx, Domain{BucketKey: "squatter", Name: "example.com"})
+	require.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), DuplicateErrMsg))
+}
+
+func TestDomains_DeleteUnverified(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewDomains(ctx, db)
+	require.NoError(t, err)
+
+	unverified, err := col.Create(ctx, Domain{BucketKey: "buck", Name: "example.com"})
+	require.NoError(t, err)
+	verified, err := col.Create(ctx, Domain{BucketKey: "buck", Name: "example.org"})
+	require.NoError(t, err)
+	err = col.SetVerified(ctx, verified.ID)
+	require.NoError(t, err)
+
+	err = col.DeleteUnverified(ctx, time.Now().Add(-time.Hour))
+	require.NoError(t, err)
+	_, err = col.Get(ctx, unverified.ID)
+	require.NoError(t, err)
+
+	err = col.DeleteUnverified(ctx, time.Now())
+	require.NoError(t, err)
+	_, err = col.Get(ctx, unverified.ID)
+	assert.True(t, errors.Is(err, mongo.ErrNoDocuments))
+	_, err = col.Get(ctx, verified.ID)
+	require.NoError(t, err)
+}
+
+func TestDomains_SetSynced(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewDomains(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, Domain{BucketKey: "buck", Name: "example.com"})
+	require.NoError(t, err)
+
+	err = col.SetUnverified(ctx, created.ID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+
+	err = col.SetVerified(ctx, created.ID)
+	require.NoError(t, err)
+	err = col.SetSynced(ctx, created.ID, created.Seq, "/ipfs/root1")
+	require.NoError(t, err)
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Verified)
+	assert.False(t, got.Pending)
+	assert.Equal(t, "/ipfs/root1", got.LastRoot)
+
+	// Domains marked pending during a sync stay pending.
+	err = col.MarkPending(ctx, "buck")
+	require.NoError(t, err)
+	err = col.SetSynced(ctx, created.ID, got.Seq, "/ipfs/root1")
+	require.NoError(t, err)
+	got, err = col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Pending)
+
+	err = col.SetFailed(ctx, created.ID, "boom", time.Now())
+	require.NoError(t, err)
+	got, err = col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "boom", got.LastError)
+
+	err = col.DeleteByBucket(ctx, "buck")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, created.ID)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+}
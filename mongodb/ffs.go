@@ -2,16 +2,76 @@ package mongodb
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
+	"github.com/textileio/go-threads/core/thread"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// archiveScheduleHistoryLen is the max number of runs kept in an archive schedule's history.
+const archiveScheduleHistoryLen = 20
+
 type FFSInstance struct {
-	BucketKey  string   `bson:"_id"`
-	FFSToken   string   `bson:"ffs_token"`
-	WalletAddr string   `bson:"ffs_walletaddr"`
-	Archives   Archives `bson:"archives"`
+	BucketKey  string           `bson:"_id"`
+	FFSToken   string           `bson:"ffs_token"`
+	WalletAddr string           `bson:"ffs_walletaddr"`
+	Archives   Archives         `bson:"archives"`
+	Schedule   *ArchiveSchedule `bson:"schedule,omitempty"`
+	Renewal    *ArchiveRenewal  `bson:"renewal,omitempty"`
+}
+
+// ArchiveSchedule is a policy for archiving a bucket automatically.
+// An archive runs when the interval has passed or the bucket root changed the given number of times,
+// whichever comes first.
+type ArchiveSchedule struct {
+	DbID    thread.ID    `bson:"db_id"`
+	DbToken thread.Token `bson:"db_token"`
+	// Owner is the marshaled public key of the account or user that owns the bucket, if any.
+	Owner []byte `bson:"owner,omitempty"`
+	// Interval is the number of seconds between archives. Zero disables interval archives.
+	Interval int64 `bson:"interval"`
+	// EveryChanges is the number of root changes between archives. Zero disables change-based archives.
+	EveryChanges int64 `bson:"every_changes"`
+	// Changes is the number of root changes since the last archive.
+	Changes int64 `bson:"changes"`
+	// NextRunAt is when the next archive runs in unix nanoseconds. Zero means not scheduled.
+	NextRunAt int64                `bson:"next_run_at"`
+	History   []ArchiveScheduleRun `bson:"history"`
+}
+
+// ArchiveScheduleRun is a single run of an archive schedule.
+type ArchiveScheduleRun struct {
+	RanAt int64  `bson:"ran_at"`
+	Cid   []byte `bson:"cid"`
+	JobID string `bson:"job_id"`
+	// Skipped is true if the root was already archived.
+	Skipped bool   `bson:"skipped"`
+	Error   string `bson:"error"`
+}
+
+// ArchiveRenewal is a policy for renewing the deals of a bucket's current archive before they expire.
+// Deals are renewed by Powergate. The renewal state is used to notify the bucket owner.
+type ArchiveRenewal struct {
+	DbID    thread.ID    `bson:"db_id"`
+	DbToken thread.Token `bson:"db_token"`
+	// Owner is the marshaled public key of the account or user that owns the bucket, if any.
+	Owner []byte `bson:"owner,omitempty"`
+	// Threshold is the number of epochs before expiry at which deals are renewed.
+	Threshold int64 `bson:"threshold"`
+	// Cid is the current archive when last checked.
+	Cid []byte `bson:"cid"`
+	// ExpiryEpoch is the latest expiry of the current archive's deals when last checked.
+	ExpiryEpoch uint64 `bson:"expiry_epoch"`
+	// Unfunded is true if the owner was notified that the wallet can't fund a renewal.
+	Unfunded bool `bson:"unfunded"`
+	// NextCheckAt is when the deals are checked next in unix nanoseconds.
+	NextCheckAt   int64  `bson:"next_check_at"`
+	LastCheckedAt int64  `bson:"last_checked_at"`
+	LastError     string `bson:"last_error"`
 }
 
 type Archives struct {
@@ -33,9 +93,19 @@ type FFSInstances struct {
 	col *mongo.Collection
 }
 
-func NewFFSInstances(_ context.Context, db *mongo.Database) (*FFSInstances, error) {
+func NewFFSInstances(ctx context.Context, db *mongo.Database) (*FFSInstances, error) {
 	s := &FFSInstances{col: db.Collection("ffsinstances")}
-	return s, nil
+	_, err := s.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{"schedule.next_run_at", 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			Keys:    bson.D{{"renewal.next_check_at", 1}},
+			Options: options.Index().SetSparse(true),
+		},
+	})
+	return s, err
 }
 
 func (k *FFSInstances) Create(ctx context.Context, bucketKey, ffsToken, waddr string) error {
@@ -48,8 +118,14 @@ func (k *FFSInstances) Create(ctx context.Context, bucketKey, ffsToken, waddr st
 	return err
 }
 
+// Replace replaces the token, wallet address, and archives of an instance.
+// The archive schedule is changed with SetSchedule.
 func (k *FFSInstances) Replace(ctx context.Context, ffs *FFSInstance) error {
-	res, err := k.col.ReplaceOne(ctx, bson.M{"_id": ffs.BucketKey}, ffs)
+	res, err := k.col.UpdateOne(ctx, bson.M{"_id": ffs.BucketKey}, bson.M{"$set": bson.M{
+		"ffs_token":      ffs.FFSToken,
+		"ffs_walletaddr": ffs.WalletAddr,
+		"archives":       ffs.Archives,
+	}})
 	if err != nil {
 		return err
 	}
@@ -70,3 +146,173 @@ func (k *FFSInstances) Get(ctx context.Context, bucketKey string) (*FFSInstance,
 	}
 	return &raw, nil
 }
+
+// SetSchedule sets the archive schedule of the instance with bucketKey.
+// The history and change count of an existing schedule are kept. A nil schedule removes the schedule.
+func (k *FFSInstances) SetSchedule(ctx context.Context, bucketKey string, schedule *ArchiveSchedule) error {
+	var update bson.M
+	if schedule == nil {
+		update = bson.M{"$unset": bson.M{"schedule": ""}}
+	} else {
+		var nextRunAt int64
+		if schedule.Interval > 0 {
+			nextRunAt = time.Now().Add(time.Duration(schedule.Interval) * time.Second).UnixNano()
+		}
+		update = bson.M{"$set": bson.M{
+			"schedule.db_id":         schedule.DbID,
+			"schedule.db_token":      schedule.DbToken,
+			"schedule.owner":         schedule.Owner,
+			"schedule.interval":      schedule.Interval,
+			"schedule.every_changes": schedule.EveryChanges,
+			"schedule.next_run_at":   nextRunAt,
+		}}
+	}
+	res, err := k.col.UpdateOne(ctx, bson.M{"_id": bucketKey}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// AddScheduleChange counts a root change of the bucket with bucketKey.
+// The next archive is scheduled to run now if the change count reaches the schedule's threshold.
+// Instances without a change-based schedule are not affected.
+func (k *FFSInstances) AddScheduleChange(ctx context.Context, bucketKey string) error {
+	res := k.col.FindOneAndUpdate(ctx,
+		bson.M{"_id": bucketKey, "schedule.every_changes": bson.M{"$gt": 0}},
+		bson.M{"$inc": bson.M{"schedule.changes": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After))
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return nil
+		}
+		return res.Err()
+	}
+	var raw FFSInstance
+	if err := res.Decode(&raw); err != nil {
+		return err
+	}
+	if raw.Schedule.Changes < raw.Schedule.EveryChanges {
+		return nil
+	}
+	_, err := k.col.UpdateOne(ctx, bson.M{"_id": bucketKey}, bson.M{"$set": bson.M{
+		"schedule.next_run_at": time.Now().UnixNano(),
+	}})
+	return err
+}
+
+// GetScheduleReady returns up to n instances with an archive schedule that is due.
+func (k *FFSInstances) GetScheduleReady(ctx context.Context, n int64) ([]FFSInstance, error) {
+	opts := options.Find().SetLimit(n).SetSort(bson.D{{"schedule.next_run_at", 1}})
+	cursor, err := k.col.Find(ctx, bson.M{"schedule.next_run_at": bson.M{"$gt": 0, "$lte": time.Now().UnixNano()}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("querying ready archive schedules: %s", err)
+	}
+	defer cursor.Close(ctx)
+	var list []FFSInstance
+	for cursor.Next(ctx) {
+		var raw FFSInstance
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		list = append(list, raw)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// AddScheduleRun records a run of the archive schedule of the instance with bucketKey
+// and schedules the next run at nextRunAt. Zero means the next run waits for root changes.
+// The change count is reset unless the run failed.
+func (k *FFSInstances) AddScheduleRun(ctx context.Context, bucketKey string, run ArchiveScheduleRun, nextRunAt int64) error {
+	set := bson.M{"schedule.next_run_at": nextRunAt}
+	if run.Error == "" {
+		set["schedule.changes"] = 0
+	}
+	res, err := k.col.UpdateOne(ctx, bson.M{"_id": bucketKey, "schedule": bson.M{"$exists": true}}, bson.M{
+		"$set": set,
+		"$push": bson.M{"schedule.history": bson.M{
+			"$each":  []ArchiveScheduleRun{run},
+			"$slice": -archiveScheduleHistoryLen,
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// SetRenewal sets the archive renewal policy of the instance with bucketKey.
+// The deals are checked right away. A nil renewal removes the policy.
+func (k *FFSInstances) SetRenewal(ctx context.Context, bucketKey string, renewal *ArchiveRenewal) error {
+	var update bson.M
+	if renewal == nil {
+		update = bson.M{"$unset": bson.M{"renewal": ""}}
+	} else {
+		update = bson.M{"$set": bson.M{
+			"renewal.db_id":         renewal.DbID,
+			"renewal.db_token":      renewal.DbToken,
+			"renewal.owner":         renewal.Owner,
+			"renewal.threshold":     renewal.Threshold,
+			"renewal.next_check_at": time.Now().UnixNano(),
+		}}
+	}
+	res, err := k.col.UpdateOne(ctx, bson.M{"_id": bucketKey}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// GetRenewalReady returns up to n instances with an archive renewal policy that is due for a check.
+func (k *FFSInstances) GetRenewalReady(ctx context.Context, n int64) ([]FFSInstance, error) {
+	opts := options.Find().SetLimit(n).SetSort(bson.D{{"renewal.next_check_at", 1}})
+	cursor, err := k.col.Find(ctx, bson.M{"renewal.next_check_at": bson.M{"$lte": time.Now().UnixNano()}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("querying ready archive renewals: %s", err)
+	}
+	defer cursor.Close(ctx)
+	var list []FFSInstance
+	for cursor.Next(ctx) {
+		var raw FFSInstance
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		list = append(list, raw)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// SetRenewalChecked records a check of the archive renewal of the instance with bucketKey
+// and schedules the next check at nextCheckAt.
+func (k *FFSInstances) SetRenewalChecked(ctx context.Context, bucketKey string, c []byte, expiryEpoch uint64, unfunded bool, lastError string, nextCheckAt int64) error {
+	res, err := k.col.UpdateOne(ctx, bson.M{"_id": bucketKey, "renewal": bson.M{"$exists": true}}, bson.M{"$set": bson.M{
+		"renewal.cid":             c,
+		"renewal.expiry_epoch":    expiryEpoch,
+		"renewal.unfunded":        unfunded,
+		"renewal.last_error":      lastError,
+		"renewal.last_checked_at": time.Now().UnixNano(),
+		"renewal.next_check_at":   nextCheckAt,
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
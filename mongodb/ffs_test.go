@@ -2,12 +2,14 @@ package mongodb_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/ipfs/go-cid"
 	"github.com/stretchr/testify/require"
 	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
 )
 
 func TestFFSInstances_Create(t *testing.T) {
@@ -69,3 +71,88 @@ func TestFFSInstances_Replace(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, ffs, ffs2)
 }
+
+func TestFFSInstances_Schedule(t *testing.T) {
+	ctx := context.Background()
+	db := newDB(t)
+	col, err := NewFFSInstances(ctx, db)
+	require.NoError(t, err)
+
+	err = col.Create(ctx, "buckkey1", "ffstoken1", "waddr1")
+	require.NoError(t, err)
+	err = col.SetSchedule(ctx, "buckkey1", &ArchiveSchedule{EveryChanges: 2})
+	require.NoError(t, err)
+
+	list, err := col.GetScheduleReady(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, list)
+
+	require.NoError(t, col.AddScheduleChange(ctx, "buckkey1"))
+	list, err = col.GetScheduleReady(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, list)
+	require.NoError(t, col.AddScheduleChange(ctx, "buckkey1"))
+	list, err = col.GetScheduleReady(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, int64(2), list[0].Schedule.Changes)
+
+	err = col.AddScheduleRun(ctx, "buckkey1", ArchiveScheduleRun{RanAt: time.Now().UnixNano(), JobID: "JobID1"}, 0)
+	require.NoError(t, err)
+	got, err := col.Get(ctx, "buckkey1")
+	require.NoError(t, err)
+	require.Equal(t, int64(0), got.Schedule.Changes)
+	require.Equal(t, int64(0), got.Schedule.NextRunAt)
+	require.Len(t, got.Schedule.History, 1)
+
+	// Replacing archive data keeps the schedule.
+	got.Archives.Current = Archive{JobID: "JobID1"}
+	require.NoError(t, col.Replace(ctx, got))
+	got, err = col.Get(ctx, "buckkey1")
+	require.NoError(t, err)
+	require.NotNil(t, got.Schedule)
+
+	require.NoError(t, col.SetSchedule(ctx, "buckkey1", nil))
+	got, err = col.Get(ctx, "buckkey1")
+	require.NoError(t, err)
+	require.Nil(t, got.Schedule)
+
+	// Buckets without a schedule ignore changes.
+	require.NoError(t, col.AddScheduleChange(ctx, "buckkey1"))
+	err = col.AddScheduleRun(ctx, "buckkey1", ArchiveScheduleRun{}, 0)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+}
+
+func TestFFSInstances_Renewal(t *testing.T) {
+	ctx := context.Background()
+	db := newDB(t)
+	col, err := NewFFSInstances(ctx, db)
+	require.NoError(t, err)
+
+	err = col.Create(ctx, "buckkey1", "ffstoken1", "waddr1")
+	require.NoError(t, err)
+	err = col.SetRenewal(ctx, "buckkey1", &ArchiveRenewal{Threshold: 100})
+	require.NoError(t, err)
+
+	list, err := col.GetRenewalReady(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	require.Equal(t, int64(100), list[0].Renewal.Threshold)
+
+	next := time.Now().Add(time.Hour).UnixNano()
+	err = col.SetRenewalChecked(ctx, "buckkey1", []byte("cid1"), 5000, true, "", next)
+	require.NoError(t, err)
+	list, err = col.GetRenewalReady(ctx, 10)
+	require.NoError(t, err)
+	require.Empty(t, list)
+	got, err := col.Get(ctx, "buckkey1")
+	require.NoError(t, err)
+	require.Equal(t, []byte("cid1"), got.Renewal.Cid)
+	require.Equal(t, uint64(5000), got.Renewal.ExpiryEpoch)
+	require.True(t, got.Renewal.Unfunded)
+	require.Equal(t, next, got.Renewal.NextCheckAt)
+
+	require.NoError(t, col.SetRenewal(ctx, "buckkey1", nil))
+	err = col.SetRenewalChecked(ctx, "buckkey1", nil, 0, false, "", next)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+}
@@ -11,8 +11,11 @@ import (
 )
 
 type IPNSKey struct {
-	Name      string
-	Cid       string
+	Name string
+	Cid  string
+	// IPNSName is the name the key publishes to if it was imported.
+	// Hub-managed keys publish to Cid.
+	IPNSName  string
 	ThreadID  thread.ID
 	CreatedAt time.Time
 }
@@ -92,6 +95,37 @@ func (k *IPNSKeys) ListByThreadID(ctx context.Context, threadID thread.ID) ([]IP
 	return docs, nil
 }
 
+// HasName returns whether or not a key publishes to the IPNS name.
+func (k *IPNSKeys) HasName(ctx context.Context, name string) (bool, error) {
+	n, err := k.col.CountDocuments(ctx, bson.M{"$or": bson.A{
+		bson.M{"cid": name, "ipns_name": bson.M{"$in": bson.A{nil, ""}}},
+		bson.M{"ipns_name": name},
+	}})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+// Replace swaps the key with name for the key newName, which publishes to ipnsName.
+// The cid of the key is kept so that the key can still be found by cid.
+func (k *IPNSKeys) Replace(ctx context.Context, name, newName, ipnsName string) error {
+	old, err := k.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	if _, err = k.col.InsertOne(ctx, bson.M{
+		"_id":        newName,
+		"cid":        old.Cid,
+		"ipns_name":  ipnsName,
+		"thread_id":  old.ThreadID.Bytes(),
+		"created_at": old.CreatedAt,
+	}); err != nil {
+		return err
+	}
+	return k.Delete(ctx, name)
+}
+
 func (k *IPNSKeys) Delete(ctx context.Context, name string) error {
 	res, err := k.col.DeleteOne(ctx, bson.M{"_id": name})
 	if err != nil {
@@ -112,9 +146,14 @@ func decodeIPNSKey(raw bson.M) (*IPNSKey, error) {
 	if v, ok := raw["created_at"]; ok {
 		created = v.(primitive.DateTime).Time()
 	}
+	var ipnsName string
+	if v, ok := raw["ipns_name"]; ok {
+		ipnsName = v.(string)
+	}
 	return &IPNSKey{
 		Name:      raw["_id"].(string),
 		Cid:       raw["cid"].(string),
+		IPNSName:  ipnsName,
 		ThreadID:  threadID,
 		CreatedAt: created,
 	}, nil
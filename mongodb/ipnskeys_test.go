@@ -82,3 +82,33 @@ func TestIPNSKeys_Delete(t *testing.T) {
 	_, err = col.Get(context.Background(), "foo")
 	require.Error(t, err)
 }
+
+func TestIPNSKeys_Replace(t *testing.T) {
+	db := newDB(t)
+	col, err := NewIPNSKeys(context.Background(), db)
+	require.NoError(t, err)
+
+	threadID := thread.NewIDV1(thread.Raw, 32)
+	err = col.Create(context.Background(), "foo", "cid", threadID)
+	require.NoError(t, err)
+
+	has, err := col.HasName(context.Background(), "cid")
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	err = col.Replace(context.Background(), "foo", "bar", "name")
+	require.NoError(t, err)
+	has, err = col.HasName(context.Background(), "cid")
+	require.NoError(t, err)
+	assert.False(t, has)
+	has, err = col.HasName(context.Background(), "name")
+	require.NoError(t, err)
+	assert.True(t, has)
+	_, err = col.Get(context.Background(), "foo")
+	require.Error(t, err)
+	got, err := col.GetByCid(context.Background(), "cid")
+	require.NoError(t, err)
+	assert.Equal(t, "bar", got.Name)
+	assert.Equal(t, "name", got.IPNSName)
+	assert.Equal(t, threadID, got.ThreadID)
+}
@@ -0,0 +1,92 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// LegalHold blocks deletions and overwrites in a bucket until released.
+type LegalHold struct {
+	BucketKey string
+	Actor     string
+	Reason    string
+	CreatedAt time.Time
+}
+
+type LegalHolds struct {
+	col *mongo.Collection
+}
+
+func NewLegalHolds(_ context.Context, db *mongo.Database) (*LegalHolds, error) {
+	return &LegalHolds{col: db.Collection("legalholds")}, nil
+}
+
+// Create places a legal hold on a bucket.
+// An existing hold is replaced.
+func (h *LegalHolds) Create(ctx context.Context, bucketKey, actor, reason string) (*LegalHold, error) {
+	doc := &LegalHold{
+		BucketKey: bucketKey,
+		Actor:     actor,
+		Reason:    reason,
+		CreatedAt: time.Now(),
+	}
+	if _, err := h.col.ReplaceOne(ctx, bson.M{"_id": bucketKey}, bson.M{
+		"_id":        doc.BucketKey,
+		"actor":      doc.Actor,
+		"reason":     doc.Reason,
+		"created_at": doc.CreatedAt,
+	}, options.Replace().SetUpsert(true)); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+func (h *LegalHolds) Get(ctx context.Context, bucketKey string) (*LegalHold, error) {
+	res := h.col.FindOne(ctx, bson.M{"_id": bucketKey})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeLegalHold(raw)
+}
+
+// IsHeld returns whether or not a bucket is under legal hold.
+func (h *LegalHolds) IsHeld(ctx context.Context, bucketKey string) (bool, error) {
+	n, err := h.col.CountDocuments(ctx, bson.M{"_id": bucketKey})
+	if err != nil {
+		return false, err
+	}
+	return n > 0, nil
+}
+
+func (h *LegalHolds) Delete(ctx context.Context, bucketKey string) error {
+	res, err := h.col.DeleteOne(ctx, bson.M{"_id": bucketKey})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func decodeLegalHold(raw bson.M) (*LegalHold, error) {
+	var created time.Time
+	if v, ok := raw["created_at"]; ok {
+		created = v.(primitive.DateTime).Time()
+	}
+	return &LegalHold{
+		BucketKey: raw["_id"].(string),
+		Actor:     raw["actor"].(string),
+		Reason:    raw["reason"].(string),
+		CreatedAt: created,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestLegalHolds_Create(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewLegalHolds(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, "buck", "jane", "litigation")
+	require.NoError(t, err)
+	assert.Equal(t, "buck", created.BucketKey)
+
+	got, err := col.Get(ctx, "buck")
+	require.NoError(t, err)
+	assert.Equal(t, "jane", got.Actor)
+	assert.Equal(t, "litigation", got.Reason)
+
+	_, err = col.Create(ctx, "buck", "john", "audit")
+	require.NoError(t, err)
+	got, err = col.Get(ctx, "buck")
+	require.NoError(t, err)
+	assert.Equal(t, "john", got.Actor)
+}
+
+func TestLegalHolds_IsHeld(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewLegalHolds(ctx, db)
+	require.NoError(t, err)
+
+	held, err := col.IsHeld(ctx, "buck")
+	require.NoError(t, err)
+	assert.False(t, held)
+
+	_, err = col.Create(ctx, "buck", "jane", "litigation")
+	require.NoError(t, err)
+	held, err = col.IsHeld(ctx, "buck")
+	require.NoError(t, err)
+	assert.True(t, held)
+}
+
+func TestLegalHolds_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewLegalHolds(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Create(ctx, "buck", "jane", "litigation")
+	require.NoError(t, err)
+	err = col.Delete(ctx, "buck")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, "buck")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+	err = col.Delete(ctx, "buck")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}
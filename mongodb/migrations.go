@@ -0,0 +1,133 @@
+package mongodb
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Migration is a versioned change to existing documents.
+// Migrations must be safe to run more than once.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      func(ctx context.Context, db *mongo.Database) error
+}
+
+// MigrationStatus describes whether or not a migration has been applied.
+type MigrationStatus struct {
+	Migration
+	Applied   bool
+	AppliedAt time.Time
+}
+
+// migrations are applied in order of version.
+// Versions must never be reused or reordered.
+var migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "set_threads_is_db",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			_, err := db.Collection("threads").UpdateMany(ctx,
+				bson.M{"is_db": bson.M{"$exists": false}},
+				bson.M{"$set": bson.M{"is_db": true}})
+			return err
+		},
+	},
+	{
+		Version: 2,
+		Name:    "set_buckets_total_size",
+		Up: func(ctx context.Context, db *mongo.Database) error {
+			for _, name := range []string{"accounts", "users"} {
+				if _, err := db.Collection(name).UpdateMany(ctx,
+					bson.M{"buckets_total_size": bson.M{"$exists": false}},
+					bson.M{"$set": bson.M{"buckets_total_size": int64(0)}}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	},
+}
+
+type Migrations struct {
+	col *mongo.Collection
+	db  *mongo.Database
+}
+
+func NewMigrations(_ context.Context, db *mongo.Database) (*Migrations, error) {
+	return &Migrations{col: db.Collection("migrations"), db: db}, nil
+}
+
+// List returns the status of all known migrations, ordered by version.
+func (m *Migrations) List(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list := make([]MigrationStatus, len(migrations))
+	for i, mg := range migrations {
+		at, ok := applied[mg.Version]
+		list[i] = MigrationStatus{
+			Migration: mg,
+			Applied:   ok,
+			AppliedAt: at,
+		}
+	}
+	return list, nil
+}
+
+// Run applies all pending migrations in order, returning the migrations that were applied.
+// Run stops at the first failing migration.
+func (m *Migrations) Run(ctx context.Context) ([]Migration, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var ran []Migration
+	for _, mg := range migrations {
+		if _, ok := applied[mg.Version]; ok {
+			continue
+		}
+		if err := mg.Up(ctx, m.db); err != nil {
+			return ran, err
+		}
+		if _, err := m.col.InsertOne(ctx, bson.M{
+			"_id":        mg.Version,
+			"name":       mg.Name,
+			"applied_at": time.Now(),
+		}); err != nil && !strings.Contains(err.Error(), DuplicateErrMsg) {
+			return ran, err
+		}
+		ran = append(ran, mg)
+	}
+	return ran, nil
+}
+
+func (m *Migrations) applied(ctx context.Context) (map[int64]time.Time, error) {
+	cursor, err := m.col.Find(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	applied := make(map[int64]time.Time)
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		var at time.Time
+		if v, ok := raw["applied_at"]; ok {
+			at = v.(primitive.DateTime).Time()
+		}
+		applied[raw["_id"].(int64)] = at
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return applied, nil
+}
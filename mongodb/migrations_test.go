@@ -0,0 +1,39 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+)
+
+func TestMigrations_Run(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewMigrations(ctx, db)
+	require.NoError(t, err)
+
+	list, err := col.List(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, list)
+	for _, m := range list {
+		assert.False(t, m.Applied)
+	}
+
+	ran, err := col.Run(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, len(list), len(ran))
+
+	ran, err = col.Run(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, ran)
+
+	list, err = col.List(ctx)
+	require.NoError(t, err)
+	for _, m := range list {
+		assert.True(t, m.Applied)
+		assert.False(t, m.AppliedAt.IsZero())
+	}
+}
@@ -0,0 +1,264 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// PinMirror is a remote pinning service that pins the root of a bucket.
+type PinMirror struct {
+	ID        string
+	BucketKey string
+	DbID      thread.ID
+	DbToken   thread.Token
+
+	// Endpoint is the base URL of the pinning service API.
+	Endpoint string
+	// Token authenticates with the pinning service.
+	Token string
+
+	// Pending is true if the bucket root has changed or the remote pin is not settled yet.
+	Pending bool
+	// ReadyAt is the earliest time the mirror can be synced again.
+	ReadyAt time.Time
+	// Seq is incremented each time the mirror is marked pending.
+	Seq int64
+	// RequestID identifies the remote pin request.
+	RequestID string
+	// Status is the status of the remote pin request.
+	Status       string
+	LastRoot     string
+	LastSyncedAt time.Time
+	LastError    string
+	CreatedAt    time.Time
+}
+
+// pinMirror is an internal representation for storage.
+type pinMirror struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	BucketKey    string             `bson:"bucket_key"`
+	DbID         thread.ID          `bson:"db_id"`
+	DbToken      thread.Token       `bson:"db_token"`
+	Endpoint     string             `bson:"endpoint"`
+	Token        string             `bson:"token"`
+	Pending      bool               `bson:"pending"`
+	ReadyAt      time.Time          `bson:"ready_at"`
+	Seq          int64              `bson:"seq"`
+	RequestID    string             `bson:"request_id"`
+	Status       string             `bson:"status"`
+	LastRoot     string             `bson:"last_root"`
+	LastSyncedAt time.Time          `bson:"last_synced_at"`
+	LastError    string             `bson:"last_error"`
+	CreatedAt    time.Time          `bson:"created_at"`
+}
+
+type PinMirrors struct {
+	col *mongo.Collection
+}
+
+func NewPinMirrors(ctx context.Context, db *mongo.Database) (*PinMirrors, error) {
+	m := &PinMirrors{col: db.Collection("pinmirrors")}
+	_, err := m.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"bucket_key", 1}},
+		},
+		{
+			Keys: bson.D{{"pending", 1}, {"ready_at", 1}},
+		},
+	})
+	return m, err
+}
+
+// Create adds a pin mirror.
+// New mirrors are pending so that the bucket root is pinned as soon as possible.
+func (m *PinMirrors) Create(ctx context.Context, pm PinMirror) (*PinMirror, error) {
+	pm.Pending = true
+	pm.ReadyAt = time.Now()
+	pm.CreatedAt = time.Now()
+	doc := pinMirror{
+		BucketKey: pm.BucketKey,
+		DbID:      pm.DbID,
+		DbToken:   pm.DbToken,
+		Endpoint:  pm.Endpoint,
+		Token:     pm.Token,
+		Pending:   pm.Pending,
+		ReadyAt:   pm.ReadyAt,
+		CreatedAt: pm.CreatedAt,
+	}
+	res, err := m.col.InsertOne(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	pm.ID = res.InsertedID.(primitive.ObjectID).Hex()
+	return &pm, nil
+}
+
+// Get returns the pin mirror with id.
+func (m *PinMirrors) Get(ctx context.Context, id string) (*PinMirror, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	res := m.col.FindOne(ctx, bson.M{"_id": oid})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc pinMirror
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	pm := castPinMirror(doc)
+	return &pm, nil
+}
+
+// List returns the pin mirrors of the bucket with key, oldest first.
+func (m *PinMirrors) List(ctx context.Context, key string) ([]PinMirror, error) {
+	return m.find(ctx, bson.M{"bucket_key": key}, options.Find().SetSort(bson.D{{"_id", 1}}))
+}
+
+// GetReady returns up to n pending pin mirrors that are ready to be synced.
+func (m *PinMirrors) GetReady(ctx context.Context, n int64) ([]PinMirror, error) {
+	opts := options.Find().SetLimit(n).SetSort(bson.D{{"ready_at", 1}})
+	list, err := m.find(ctx, bson.M{"pending": true, "ready_at": bson.M{"$lte": time.Now()}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("querying ready pin mirrors: %s", err)
+	}
+	return list, nil
+}
+
+// MarkPending marks all pin mirrors of the bucket with key as pending.
+func (m *PinMirrors) MarkPending(ctx context.Context, key string) error {
+	_, err := m.col.UpdateMany(ctx, bson.M{"bucket_key": key}, bson.M{
+		"$set": bson.M{"pending": true, "ready_at": time.Now()},
+		"$inc": bson.M{"seq": 1},
+	})
+	return err
+}
+
+// SetSynced records the remote pin request of root for the mirror with id.
+// If settled is false, the mirror stays pending until recheckAt so that the request status is checked again.
+// The mirror also stays pending if it was marked pending again since seq.
+func (m *PinMirrors) SetSynced(ctx context.Context, id string, seq int64, root, requestID, status string, settled bool, recheckAt time.Time) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	update := bson.M{
+		"last_root":      root,
+		"request_id":     requestID,
+		"status":         status,
+		"last_synced_at": time.Now(),
+		"last_error":     "",
+	}
+	if settled {
+		update["pending"] = false
+	} else {
+		update["ready_at"] = recheckAt
+	}
+	res, err := m.col.UpdateOne(ctx, bson.M{"_id": oid, "seq": seq}, bson.M{"$set": update})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+	delete(update, "pending")
+	delete(update, "ready_at")
+	res, err = m.col.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": update})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// SetFailed records a failed sync of the pin mirror with id.
+// The sync is retried at retryAt.
+func (m *PinMirrors) SetFailed(ctx context.Context, id string, reason string, retryAt time.Time) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	res, err := m.col.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": bson.M{
+		"last_error": reason,
+		"ready_at":   retryAt,
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// Delete removes the pin mirror with id.
+func (m *PinMirrors) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	res, err := m.col.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeleteByBucket removes all pin mirrors of the bucket with key.
+func (m *PinMirrors) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := m.col.DeleteMany(ctx, bson.M{"bucket_key": key})
+	return err
+}
+
+func (m *PinMirrors) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]PinMirror, error) {
+	cursor, err := m.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var list []PinMirror
+	for cursor.Next(ctx) {
+		var doc pinMirror
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		list = append(list, castPinMirror(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func castPinMirror(doc pinMirror) PinMirror {
+	return PinMirror{
+		ID:           doc.ID.Hex(),
+		BucketKey:    doc.BucketKey,
+		DbID:         doc.DbID,
+		DbToken:      doc.DbToken,
+		Endpoint:     doc.Endpoint,
+		Token:        doc.Token,
+		Pending:      doc.Pending,
+		ReadyAt:      doc.ReadyAt,
+		Seq:          doc.Seq,
+		RequestID:    doc.RequestID,
+		Status:       doc.Status,
+		LastRoot:     doc.LastRoot,
+		LastSyncedAt: doc.LastSyncedAt,
+		LastError:    doc.LastError,
+		CreatedAt:    doc.CreatedAt,
+	}
+}
@@ -0,0 +1,115 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestPinMirrors_Create(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewPinMirrors(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, PinMirror{
+		BucketKey: "buck",
+		DbID:      thread.NewIDV1(thread.Raw, 16),
+		DbToken:   thread.Token("token"),
+		Endpoint:  "https://pins.example.com",
+		Token:     "secret",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.True(t, created.Pending)
+
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "https://pins.example.com", got.Endpoint)
+	assert.Equal(t, "secret", got.Token)
+
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, ready, 1)
+	assert.Equal(t, created.ID, ready[0].ID)
+}
+
+func TestPinMirrors_SetSynced(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewPinMirrors(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, PinMirror{BucketKey: "buck"})
+	require.NoError(t, err)
+
+	// Unsettled pins are checked again later.
+	err = col.SetSynced(ctx, created.ID, created.Seq, "/ipfs/root1", "req1", "queued", false, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Pending)
+	assert.Equal(t, "req1", got.RequestID)
+	assert.Equal(t, "queued", got.Status)
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+
+	err = col.SetSynced(ctx, created.ID, got.Seq, "/ipfs/root1", "req1", "pinned", true, time.Time{})
+	require.NoError(t, err)
+	got, err = col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Pending)
+	assert.Equal(t, "pinned", got.Status)
+
+	// Changes during a sync keep the mirror pending.
+	err = col.MarkPending(ctx, "buck")
+	require.NoError(t, err)
+	err = col.SetSynced(ctx, created.ID, got.Seq, "/ipfs/root1", "req1", "pinned", true, time.Time{})
+	require.NoError(t, err)
+	got, err = col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Pending)
+	ready, err = col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Len(t, ready, 1)
+}
+
+func TestPinMirrors_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewPinMirrors(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, PinMirror{BucketKey: "buck"})
+	require.NoError(t, err)
+	err = col.SetFailed(ctx, created.ID, "boom", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "boom", got.LastError)
+
+	err = col.Delete(ctx, created.ID)
+	require.NoError(t, err)
+	_, err = col.Get(ctx, created.ID)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	_, err = col.Create(ctx, PinMirror{BucketKey: "buck"})
+	require.NoError(t, err)
+	err = col.DeleteByBucket(ctx, "buck")
+	require.NoError(t, err)
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
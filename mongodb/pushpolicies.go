@@ -0,0 +1,99 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// PushPolicy holds rules that are evaluated before changes to an org's buckets are accepted.
+type PushPolicy struct {
+	Org                 string
+	MaxFileSize         int64
+	ForbiddenExtensions []string
+	RequiredPaths       []string
+	RequireLicense      bool
+	UpdatedAt           time.Time
+}
+
+type PushPolicies struct {
+	col *mongo.Collection
+}
+
+func NewPushPolicies(_ context.Context, db *mongo.Database) (*PushPolicies, error) {
+	return &PushPolicies{col: db.Collection("pushpolicies")}, nil
+}
+
+// Set replaces the push policy for an org.
+func (p *PushPolicies) Set(ctx context.Context, policy PushPolicy) (*PushPolicy, error) {
+	policy.UpdatedAt = time.Now()
+	if _, err := p.col.ReplaceOne(ctx, bson.M{"_id": policy.Org}, bson.M{
+		"_id":                  policy.Org,
+		"max_file_size":        policy.MaxFileSize,
+		"forbidden_extensions": policy.ForbiddenExtensions,
+		"required_paths":       policy.RequiredPaths,
+		"require_license":      policy.RequireLicense,
+		"updated_at":           policy.UpdatedAt,
+	}, options.Replace().SetUpsert(true)); err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (p *PushPolicies) Get(ctx context.Context, org string) (*PushPolicy, error) {
+	res := p.col.FindOne(ctx, bson.M{"_id": org})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodePushPolicy(raw)
+}
+
+func (p *PushPolicies) Delete(ctx context.Context, org string) error {
+	res, err := p.col.DeleteOne(ctx, bson.M{"_id": org})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func decodePushPolicy(raw bson.M) (*PushPolicy, error) {
+	var updated time.Time
+	if v, ok := raw["updated_at"]; ok {
+		updated = v.(primitive.DateTime).Time()
+	}
+	var requireLicense bool
+	if v, ok := raw["require_license"]; ok {
+		requireLicense = v.(bool)
+	}
+	return &PushPolicy{
+		Org:                 raw["_id"].(string),
+		MaxFileSize:         raw["max_file_size"].(int64),
+		ForbiddenExtensions: decodeStrings(raw["forbidden_extensions"]),
+		RequiredPaths:       decodeStrings(raw["required_paths"]),
+		RequireLicense:      requireLicense,
+		UpdatedAt:           updated,
+	}, nil
+}
+
+func decodeStrings(v interface{}) []string {
+	a, ok := v.(bson.A)
+	if !ok {
+		return nil
+	}
+	list := make([]string, len(a))
+	for i, s := range a {
+		list[i] = s.(string)
+	}
+	return list
+}
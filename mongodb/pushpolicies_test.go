@@ -0,0 +1,59 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestPushPolicies_Set(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewPushPolicies(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Get(ctx, "org")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+
+	_, err = col.Set(ctx, PushPolicy{
+		Org:                 "org",
+		MaxFileSize:         1024,
+		ForbiddenExtensions: []string{".exe"},
+		RequiredPaths:       []string{"LICENSE"},
+		RequireLicense:      true,
+	})
+	require.NoError(t, err)
+
+	got, err := col.Get(ctx, "org")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1024), got.MaxFileSize)
+	assert.Equal(t, []string{".exe"}, got.ForbiddenExtensions)
+	assert.Equal(t, []string{"LICENSE"}, got.RequiredPaths)
+	assert.True(t, got.RequireLicense)
+
+	_, err = col.Set(ctx, PushPolicy{Org: "org"})
+	require.NoError(t, err)
+	got, err = col.Get(ctx, "org")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), got.MaxFileSize)
+	assert.Empty(t, got.RequiredPaths)
+	assert.False(t, got.RequireLicense)
+}
+
+func TestPushPolicies_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewPushPolicies(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Set(ctx, PushPolicy{Org: "org", MaxFileSize: 1})
+	require.NoError(t, err)
+	err = col.Delete(ctx, "org")
+	require.NoError(t, err)
+	err = col.Delete(ctx, "org")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}
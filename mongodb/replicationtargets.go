@@ -0,0 +1,274 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ReplicationTarget is a bucket on a remote hub that mirrors a local bucket.
+type ReplicationTarget struct {
+	ID        string
+	BucketKey string
+	DbID      thread.ID
+	DbToken   thread.Token
+
+	// Address is the API address of the remote hub.
+	Address string
+	// Insecure disables TLS for connections to the remote hub.
+	Insecure bool
+	// APIKey and APISecret authenticate with the remote hub.
+	APIKey    string
+	APISecret string
+	// RemoteThread and RemoteKey identify the mirrored bucket on the remote hub.
+	RemoteThread thread.ID
+	// RemoteToken is an optional thread token for the remote thread.
+	RemoteToken thread.Token
+	RemoteKey   string
+
+	// Pending is true if the local bucket has changed since the last replication.
+	Pending bool
+	// ReadyAt is the earliest time the next replication can start.
+	ReadyAt time.Time
+	// Seq is incremented each time the target is marked pending.
+	Seq          int64
+	LastRoot     string
+	LastSyncedAt time.Time
+	LastError    string
+	CreatedAt    time.Time
+}
+
+// replicationTarget is an internal representation for storage.
+type replicationTarget struct {
+	ID           primitive.ObjectID `bson:"_id,omitempty"`
+	BucketKey    string             `bson:"bucket_key"`
+	DbID         thread.ID          `bson:"db_id"`
+	DbToken      thread.Token       `bson:"db_token"`
+	Address      string             `bson:"address"`
+	Insecure     bool               `bson:"insecure"`
+	APIKey       string             `bson:"api_key"`
+	APISecret    string             `bson:"api_secret"`
+	RemoteThread thread.ID          `bson:"remote_thread"`
+	RemoteToken  thread.Token       `bson:"remote_token"`
+	RemoteKey    string             `bson:"remote_key"`
+	Pending      bool               `bson:"pending"`
+	ReadyAt      time.Time          `bson:"ready_at"`
+	Seq          int64              `bson:"seq"`
+	LastRoot     string             `bson:"last_root"`
+	LastSyncedAt time.Time          `bson:"last_synced_at"`
+	LastError    string             `bson:"last_error"`
+	CreatedAt    time.Time          `bson:"created_at"`
+}
+
+type ReplicationTargets struct {
+	col *mongo.Collection
+}
+
+func NewReplicationTargets(ctx context.Context, db *mongo.Database) (*ReplicationTargets, error) {
+	r := &ReplicationTargets{col: db.Collection("replicationtargets")}
+	_, err := r.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"bucket_key", 1}},
+		},
+		{
+			Keys: bson.D{{"pending", 1}, {"ready_at", 1}},
+		},
+	})
+	return r, err
+}
+
+// Create adds a replication target.
+// New targets are pending so that the bucket is replicated as soon as possible.
+func (r *ReplicationTargets) Create(ctx context.Context, t ReplicationTarget) (*ReplicationTarget, error) {
+	t.Pending = true
+	t.ReadyAt = time.Now()
+	t.CreatedAt = time.Now()
+	doc := replicationTarget{
+		BucketKey:    t.BucketKey,
+		DbID:         t.DbID,
+		DbToken:      t.DbToken,
+		Address:      t.Address,
+		Insecure:     t.Insecure,
+		APIKey:       t.APIKey,
+		APISecret:    t.APISecret,
+		RemoteThread: t.RemoteThread,
+		RemoteToken:  t.RemoteToken,
+		RemoteKey:    t.RemoteKey,
+		Pending:      t.Pending,
+		ReadyAt:      t.ReadyAt,
+		CreatedAt:    t.CreatedAt,
+	}
+	res, err := r.col.InsertOne(ctx, doc)
+	if err != nil {
+		return nil, err
+	}
+	t.ID = res.InsertedID.(primitive.ObjectID).Hex()
+	return &t, nil
+}
+
+// Get returns the replication target with id.
+func (r *ReplicationTargets) Get(ctx context.Context, id string) (*ReplicationTarget, error) {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	res := r.col.FindOne(ctx, bson.M{"_id": oid})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc replicationTarget
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	t := castReplicationTarget(doc)
+	return &t, nil
+}
+
+// List returns the replication targets of the bucket with key, oldest first.
+func (r *ReplicationTargets) List(ctx context.Context, key string) ([]ReplicationTarget, error) {
+	return r.find(ctx, bson.M{"bucket_key": key}, options.Find().SetSort(bson.D{{"_id", 1}}))
+}
+
+// GetReady returns up to n pending replication targets that are ready to be replicated.
+func (r *ReplicationTargets) GetReady(ctx context.Context, n int64) ([]ReplicationTarget, error) {
+	opts := options.Find().SetLimit(n).SetSort(bson.D{{"ready_at", 1}})
+	list, err := r.find(ctx, bson.M{"pending": true, "ready_at": bson.M{"$lte": time.Now()}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("querying ready replication targets: %s", err)
+	}
+	return list, nil
+}
+
+// MarkPending marks all replication targets of the bucket with key as pending.
+func (r *ReplicationTargets) MarkPending(ctx context.Context, key string) error {
+	_, err := r.col.UpdateMany(ctx, bson.M{"bucket_key": key}, bson.M{
+		"$set": bson.M{"pending": true, "ready_at": time.Now()},
+		"$inc": bson.M{"seq": 1},
+	})
+	return err
+}
+
+// SetSynced records a successful replication of root to the target with id.
+// The target stays pending if it was marked pending again since seq.
+func (r *ReplicationTargets) SetSynced(ctx context.Context, id string, seq int64, root string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	now := time.Now()
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": oid, "seq": seq}, bson.M{"$set": bson.M{
+		"pending":        false,
+		"last_root":      root,
+		"last_synced_at": now,
+		"last_error":     "",
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+	res, err = r.col.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": bson.M{
+		"last_root":      root,
+		"last_synced_at": now,
+		"last_error":     "",
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// SetFailed records a failed replication to the target with id.
+// The replication is retried at retryAt.
+func (r *ReplicationTargets) SetFailed(ctx context.Context, id string, reason string, retryAt time.Time) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	res, err := r.col.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{"$set": bson.M{
+		"last_error": reason,
+		"ready_at":   retryAt,
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// Delete removes the replication target with id.
+func (r *ReplicationTargets) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	res, err := r.col.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeleteByBucket removes all replication targets of the bucket with key.
+func (r *ReplicationTargets) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := r.col.DeleteMany(ctx, bson.M{"bucket_key": key})
+	return err
+}
+
+func (r *ReplicationTargets) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]ReplicationTarget, error) {
+	cursor, err := r.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var list []ReplicationTarget
+	for cursor.Next(ctx) {
+		var doc replicationTarget
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		list = append(list, castReplicationTarget(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func castReplicationTarget(doc replicationTarget) ReplicationTarget {
+	return ReplicationTarget{
+		ID:           doc.ID.Hex(),
+		BucketKey:    doc.BucketKey,
+		DbID:         doc.DbID,
+		DbToken:      doc.DbToken,
+		Address:      doc.Address,
+		Insecure:     doc.Insecure,
+		APIKey:       doc.APIKey,
+		APISecret:    doc.APISecret,
+		RemoteThread: doc.RemoteThread,
+		RemoteToken:  doc.RemoteToken,
+		RemoteKey:    doc.RemoteKey,
+		Pending:      doc.Pending,
+		ReadyAt:      doc.ReadyAt,
+		Seq:          doc.Seq,
+		LastRoot:     doc.LastRoot,
+		LastSyncedAt: doc.LastSyncedAt,
+		LastError:    doc.LastError,
+		CreatedAt:    doc.CreatedAt,
+	}
+}
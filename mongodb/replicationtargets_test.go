@@ -0,0 +1,121 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestReplicationTargets_Create(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewReplicationTargets(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, ReplicationTarget{
+		BucketKey:    "buck",
+		DbID:         thread.NewIDV1(thread.Raw, 16),
+		DbToken:      thread.Token("token"),
+		Address:      "remote:3006",
+		APIKey:       "key",
+		APISecret:    "secret",
+		RemoteThread: thread.NewIDV1(thread.Raw, 16),
+		RemoteKey:    "remote",
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.True(t, created.Pending)
+
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "remote:3006", got.Address)
+	assert.Equal(t, created.RemoteThread, got.RemoteThread)
+
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, ready, 1)
+	assert.Equal(t, created.ID, ready[0].ID)
+}
+
+func TestReplicationTargets_SetSynced(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewReplicationTargets(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, ReplicationTarget{BucketKey: "buck", RemoteKey: "remote"})
+	require.NoError(t, err)
+
+	err = col.SetSynced(ctx, created.ID, created.Seq, "/ipfs/root1")
+	require.NoError(t, err)
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.False(t, got.Pending)
+	assert.Equal(t, "/ipfs/root1", got.LastRoot)
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+
+	// Changes during a replication keep the target pending.
+	err = col.MarkPending(ctx, "buck")
+	require.NoError(t, err)
+	err = col.SetSynced(ctx, created.ID, got.Seq, "/ipfs/root2")
+	require.NoError(t, err)
+	got, err = col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Pending)
+	assert.Equal(t, "/ipfs/root2", got.LastRoot)
+}
+
+func TestReplicationTargets_SetFailed(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewReplicationTargets(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, ReplicationTarget{BucketKey: "buck", RemoteKey: "remote"})
+	require.NoError(t, err)
+	err = col.SetFailed(ctx, created.ID, "unreachable", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.True(t, got.Pending)
+	assert.Equal(t, "unreachable", got.LastError)
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+}
+
+func TestReplicationTargets_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewReplicationTargets(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, ReplicationTarget{BucketKey: "buck", RemoteKey: "remote"})
+	require.NoError(t, err)
+	_, err = col.Create(ctx, ReplicationTarget{BucketKey: "buck", RemoteKey: "remote2"})
+	require.NoError(t, err)
+
+	err = col.Delete(ctx, created.ID)
+	require.NoError(t, err)
+	_, err = col.Get(ctx, created.ID)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	err = col.DeleteByBucket(ctx, "buck")
+	require.NoError(t, err)
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
@@ -0,0 +1,61 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RevokedTokens tracks when the thread tokens of a user were revoked.
+// Tokens issued to the user before that time are no longer accepted.
+type RevokedTokens struct {
+	col *mongo.Collection
+}
+
+func NewRevokedTokens(_ context.Context, db *mongo.Database) (*RevokedTokens, error) {
+	return &RevokedTokens{col: db.Collection("revokedtokens")}, nil
+}
+
+// Revoke revokes all tokens issued to key up until now.
+func (r *RevokedTokens) Revoke(ctx context.Context, key crypto.PubKey) error {
+	id, err := crypto.MarshalPublicKey(key)
+	if err != nil {
+		return err
+	}
+	_, err = r.col.ReplaceOne(ctx, bson.M{"_id": id}, bson.M{
+		"_id":        id,
+		"revoked_at": time.Now(),
+	}, options.Replace().SetUpsert(true))
+	return err
+}
+
+// IsRevoked returns whether or not a token issued to key at issuedAt has been revoked.
+func (r *RevokedTokens) IsRevoked(ctx context.Context, key crypto.PubKey, issuedAt time.Time) (bool, error) {
+	id, err := crypto.MarshalPublicKey(key)
+	if err != nil {
+		return false, err
+	}
+	res := r.col.FindOne(ctx, bson.M{"_id": id})
+	if res.Err() != nil {
+		if errors.Is(res.Err(), mongo.ErrNoDocuments) {
+			return false, nil
+		}
+		return false, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return false, err
+	}
+	var revokedAt time.Time
+	if v, ok := raw["revoked_at"]; ok {
+		revokedAt = v.(primitive.DateTime).Time()
+	}
+	// Token issue times only have second precision
+	return !issuedAt.After(revokedAt.Truncate(time.Second)), nil
+}
@@ -0,0 +1,36 @@
+package mongodb_test
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+)
+
+func TestRevokedTokens_Revoke(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewRevokedTokens(ctx, db)
+	require.NoError(t, err)
+
+	_, key, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	issued := time.Now().Add(-time.Minute)
+	revoked, err := col.IsRevoked(ctx, key, issued)
+	require.NoError(t, err)
+	assert.False(t, revoked)
+
+	err = col.Revoke(ctx, key)
+	require.NoError(t, err)
+	revoked, err = col.IsRevoked(ctx, key, issued)
+	require.NoError(t, err)
+	assert.True(t, revoked)
+	revoked, err = col.IsRevoked(ctx, key, time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	assert.False(t, revoked)
+}
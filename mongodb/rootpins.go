@@ -0,0 +1,95 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RootPin records a bucket root pinned by the buckets API.
+// The pin garbage collector unpins tracked roots that are no longer referenced by their bucket.
+type RootPin struct {
+	BucketKey string
+	Path      string
+	DbID      thread.ID
+	DbToken   thread.Token
+	CreatedAt time.Time
+}
+
+type rootPin struct {
+	BucketKey string       `bson:"bucket_key"`
+	Path      string       `bson:"path"`
+	DbID      thread.ID    `bson:"db_id"`
+	DbToken   thread.Token `bson:"db_token"`
+	CreatedAt time.Time    `bson:"created_at"`
+}
+
+type RootPins struct {
+	col *mongo.Collection
+}
+
+func NewRootPins(ctx context.Context, db *mongo.Database) (*RootPins, error) {
+	p := &RootPins{col: db.Collection("rootpins")}
+	_, err := p.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{"bucket_key", 1}, {"path", 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{"created_at", 1}},
+		},
+	})
+	return p, err
+}
+
+// Track records that pth is pinned as the root of the bucket with key.
+// Tracking an already tracked root does not change its creation time.
+func (p *RootPins) Track(ctx context.Context, key, pth string, dbID thread.ID, dbToken thread.Token) error {
+	_, err := p.col.UpdateOne(ctx, bson.M{"bucket_key": key, "path": pth}, bson.M{
+		"$set":         bson.M{"db_id": dbID, "db_token": dbToken},
+		"$setOnInsert": bson.M{"created_at": time.Now()},
+	}, options.Update().SetUpsert(true))
+	return err
+}
+
+// Untrack removes the record of root pth of the bucket with key.
+func (p *RootPins) Untrack(ctx context.Context, key, pth string) error {
+	_, err := p.col.DeleteOne(ctx, bson.M{"bucket_key": key, "path": pth})
+	return err
+}
+
+// ListBefore returns the tracked roots that were pinned before t, oldest first.
+func (p *RootPins) ListBefore(ctx context.Context, t time.Time) ([]RootPin, error) {
+	opts := options.Find().SetSort(bson.D{{"created_at", 1}})
+	cursor, err := p.col.Find(ctx, bson.M{"created_at": bson.M{"$lt": t}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var list []RootPin
+	for cursor.Next(ctx) {
+		var doc rootPin
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		list = append(list, castRootPin(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func castRootPin(doc rootPin) RootPin {
+	return RootPin{
+		BucketKey: doc.BucketKey,
+		Path:      doc.Path,
+		DbID:      doc.DbID,
+		DbToken:   doc.DbToken,
+		CreatedAt: doc.CreatedAt,
+	}
+}
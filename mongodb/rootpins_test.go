@@ -0,0 +1,61 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/mongodb"
+)
+
+func TestRootPins_Track(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewRootPins(ctx, db)
+	require.NoError(t, err)
+
+	dbID := thread.NewIDV1(thread.Raw, 16)
+	err = col.Track(ctx, "buck", "/ipfs/root1", dbID, thread.Token("token"))
+	require.NoError(t, err)
+	err = col.Track(ctx, "buck", "/ipfs/root2", dbID, thread.Token("token"))
+	require.NoError(t, err)
+
+	list, err := col.ListBefore(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	assert.Equal(t, "/ipfs/root1", list[0].Path)
+	assert.Equal(t, "buck", list[0].BucketKey)
+	assert.Equal(t, dbID, list[0].DbID)
+
+	created := list[0].CreatedAt
+	err = col.Track(ctx, "buck", "/ipfs/root1", dbID, thread.Token("token"))
+	require.NoError(t, err)
+	list, err = col.ListBefore(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	assert.True(t, created.Equal(list[0].CreatedAt))
+
+	list, err = col.ListBefore(ctx, created)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(list))
+}
+
+func TestRootPins_Untrack(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewRootPins(ctx, db)
+	require.NoError(t, err)
+
+	dbID := thread.NewIDV1(thread.Raw, 16)
+	err = col.Track(ctx, "buck", "/ipfs/root", dbID, thread.Token("token"))
+	require.NoError(t, err)
+	err = col.Untrack(ctx, "buck", "/ipfs/root")
+	require.NoError(t, err)
+
+	list, err := col.ListBefore(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(list))
+}
@@ -0,0 +1,170 @@
+package mongodb
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// searchIndexBatchSize is the max number of entries inserted at once.
+const searchIndexBatchSize = 1000
+
+// SearchEntry is an indexed bucket item.
+type SearchEntry struct {
+	Path        string
+	Name        string
+	Cid         string
+	Size        int64
+	IsDir       bool
+	ContentType string
+	Attributes  map[string]string
+}
+
+type searchEntry struct {
+	BucketKey   string            `bson:"bucket_key"`
+	Gen         string            `bson:"gen"`
+	Path        string            `bson:"path"`
+	Name        string            `bson:"name"`
+	Cid         string            `bson:"cid"`
+	Size        int64             `bson:"size"`
+	IsDir       bool              `bson:"is_dir"`
+	ContentType string            `bson:"content_type"`
+	Attributes  map[string]string `bson:"attributes"`
+	// Terms are the searchable strings of the entry.
+	Terms []string `bson:"terms"`
+}
+
+// SearchQuery selects search index entries.
+type SearchQuery struct {
+	// Pattern is matched against entry names and metadata.
+	// Matching is done here rather than in the database, whose regex engine backtracks.
+	// A nil pattern matches all entries.
+	Pattern *regexp.Regexp
+	// Prefix limits results to entries at or below a path.
+	Prefix string
+	// Filter is an optional final filter of entries.
+	Filter func(SearchEntry) bool
+	// Limit is the max number of results.
+	Limit int64
+}
+
+type SearchIndex struct {
+	col *mongo.Collection
+}
+
+func NewSearchIndex(ctx context.Context, db *mongo.Database) (*SearchIndex, error) {
+	s := &SearchIndex{col: db.Collection("searchindex")}
+	_, err := s.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"bucket_key", 1}, {"gen", 1}, {"path", 1}},
+	})
+	return s, err
+}
+
+// Insert adds entries of the bucket with key to the index generation gen.
+func (s *SearchIndex) Insert(ctx context.Context, key, gen string, entries []SearchEntry) error {
+	for len(entries) > 0 {
+		n := len(entries)
+		if n > searchIndexBatchSize {
+			n = searchIndexBatchSize
+		}
+		docs := make([]interface{}, n)
+		for i, e := range entries[:n] {
+			terms := []string{e.Name}
+			if e.ContentType != "" {
+				terms = append(terms, e.ContentType)
+			}
+			for k, v := range e.Attributes {
+				terms = append(terms, k+"="+v)
+			}
+			docs[i] = searchEntry{
+				BucketKey:   key,
+				Gen:         gen,
+				Path:        e.Path,
+				Name:        e.Name,
+				Cid:         e.Cid,
+				Size:        e.Size,
+				IsDir:       e.IsDir,
+				ContentType: e.ContentType,
+				Attributes:  e.Attributes,
+				Terms:       terms,
+			}
+		}
+		if _, err := s.col.InsertMany(ctx, docs); err != nil {
+			return err
+		}
+		entries = entries[n:]
+	}
+	return nil
+}
+
+// Search returns entries of the bucket with key in index generation gen that match q, in path order.
+func (s *SearchIndex) Search(ctx context.Context, key, gen string, q SearchQuery) ([]SearchEntry, error) {
+	filter := bson.M{"bucket_key": key, "gen": gen}
+	if prefix := strings.Trim(q.Prefix, "/"); prefix != "" {
+		filter["path"] = primitive.Regex{Pattern: "^" + regexp.QuoteMeta(prefix) + "(/|$)"}
+	}
+	opts := options.Find().SetSort(bson.D{{"path", 1}})
+	if q.Pattern == nil && q.Filter == nil && q.Limit > 0 {
+		opts.SetLimit(q.Limit)
+	}
+	cursor, err := s.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var list []SearchEntry
+	for cursor.Next(ctx) {
+		var doc searchEntry
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		if q.Pattern != nil && !matchAny(q.Pattern, doc.Terms) {
+			continue
+		}
+		e := SearchEntry{
+			Path:        doc.Path,
+			Name:        doc.Name,
+			Cid:         doc.Cid,
+			Size:        doc.Size,
+			IsDir:       doc.IsDir,
+			ContentType: doc.ContentType,
+			Attributes:  doc.Attributes,
+		}
+		if q.Filter != nil && !q.Filter(e) {
+			continue
+		}
+		list = append(list, e)
+		if q.Limit > 0 && int64(len(list)) == q.Limit {
+			break
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func matchAny(re *regexp.Regexp, terms []string) bool {
+	for _, t := range terms {
+		if re.MatchString(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteStale removes entries of the bucket with key that are not in index generation gen.
+func (s *SearchIndex) DeleteStale(ctx context.Context, key, gen string) error {
+	_, err := s.col.DeleteMany(ctx, bson.M{"bucket_key": key, "gen": bson.M{"$ne": gen}})
+	return err
+}
+
+func (s *SearchIndex) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := s.col.DeleteMany(ctx, bson.M{"bucket_key": key})
+	return err
+}
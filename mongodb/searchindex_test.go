@@ -0,0 +1,81 @@
+package mongodb_test
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+)
+
+func TestSearchIndex_Search(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewSearchIndex(ctx, db)
+	require.NoError(t, err)
+
+	err = col.Insert(ctx, "buck", "gen1", []SearchEntry{
+		{Path: "dir", Name: "dir", IsDir: true},
+		{Path: "dir/cat.jpg", Name: "cat.jpg", ContentType: "image/jpeg"},
+		{Path: "dir/notes.txt", Name: "notes.txt", Attributes: map[string]string{"author": "carol"}},
+		{Path: "dog.jpg", Name: "dog.jpg", ContentType: "image/jpeg"},
+	})
+	require.NoError(t, err)
+	err = col.Insert(ctx, "buck", "gen0", []SearchEntry{{Path: "old.jpg", Name: "old.jpg"}})
+	require.NoError(t, err)
+
+	list, err := col.Search(ctx, "buck", "gen1", SearchQuery{Pattern: regexp.MustCompile("\\.jpg$")})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	assert.Equal(t, "dir/cat.jpg", list[0].Path)
+
+	list, err = col.Search(ctx, "buck", "gen1", SearchQuery{Pattern: regexp.MustCompile("^image/")})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(list))
+
+	list, err = col.Search(ctx, "buck", "gen1", SearchQuery{Pattern: regexp.MustCompile("author=carol")})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	assert.Equal(t, "carol", list[0].Attributes["author"])
+
+	list, err = col.Search(ctx, "buck", "gen1", SearchQuery{Pattern: regexp.MustCompile("(j+)+pg$"), Limit: 1})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	assert.Equal(t, "dir/cat.jpg", list[0].Path)
+
+	list, err = col.Search(ctx, "buck", "gen1", SearchQuery{Prefix: "dir"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, len(list))
+
+	list, err = col.Search(ctx, "buck", "gen1", SearchQuery{
+		Filter: func(e SearchEntry) bool { return strings.HasPrefix(e.Name, "d") },
+		Limit:  1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	assert.Equal(t, "dir", list[0].Path)
+}
+
+func TestSearchIndex_DeleteStale(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewSearchIndex(ctx, db)
+	require.NoError(t, err)
+
+	err = col.Insert(ctx, "buck", "gen0", []SearchEntry{{Path: "old.jpg", Name: "old.jpg"}})
+	require.NoError(t, err)
+	err = col.Insert(ctx, "buck", "gen1", []SearchEntry{{Path: "new.jpg", Name: "new.jpg"}})
+	require.NoError(t, err)
+	err = col.DeleteStale(ctx, "buck", "gen1")
+	require.NoError(t, err)
+
+	list, err := col.Search(ctx, "buck", "gen0", SearchQuery{})
+	require.NoError(t, err)
+	assert.Empty(t, list)
+	list, err = col.Search(ctx, "buck", "gen1", SearchQuery{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(list))
+}
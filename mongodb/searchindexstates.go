@@ -0,0 +1,173 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SearchIndexState tracks the search index of a bucket.
+type SearchIndexState struct {
+	BucketKey string
+	DbID      thread.ID
+	DbToken   thread.Token
+
+	// Pending is true if the bucket has changed since it was last indexed.
+	Pending bool
+	// ReadyAt is the earliest time the next indexing can start.
+	ReadyAt time.Time
+	// Seq is incremented each time the bucket is marked pending.
+	Seq int64
+	// Gen identifies the current index entries.
+	// An empty gen means the bucket has not been indexed yet.
+	Gen string
+	// Root is the bucket root of the current index entries.
+	Root      string
+	IndexedAt time.Time
+	LastError string
+}
+
+type searchIndexState struct {
+	BucketKey string       `bson:"_id"`
+	DbID      thread.ID    `bson:"db_id"`
+	DbToken   thread.Token `bson:"db_token"`
+	Pending   bool         `bson:"pending"`
+	ReadyAt   time.Time    `bson:"ready_at"`
+	Seq       int64        `bson:"seq"`
+	Gen       string       `bson:"gen"`
+	Root      string       `bson:"root"`
+	IndexedAt time.Time    `bson:"indexed_at"`
+	LastError string       `bson:"last_error"`
+}
+
+type SearchIndexStates struct {
+	col *mongo.Collection
+}
+
+func NewSearchIndexStates(ctx context.Context, db *mongo.Database) (*SearchIndexStates, error) {
+	s := &SearchIndexStates{col: db.Collection("searchindexstates")}
+	_, err := s.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"pending", 1}, {"ready_at", 1}},
+	})
+	return s, err
+}
+
+// MarkPending marks the bucket with key as needing to be indexed.
+// The state is created if it doesn't exist.
+func (s *SearchIndexStates) MarkPending(ctx context.Context, key string, dbID thread.ID, dbToken thread.Token) error {
+	_, err := s.col.UpdateOne(ctx, bson.M{"_id": key}, bson.M{
+		"$set": bson.M{"db_id": dbID, "db_token": dbToken, "pending": true, "ready_at": time.Now()},
+		"$inc": bson.M{"seq": 1},
+	}, options.Update().SetUpsert(true))
+	return err
+}
+
+// Get returns the index state of the bucket with key.
+func (s *SearchIndexStates) Get(ctx context.Context, key string) (*SearchIndexState, error) {
+	res := s.col.FindOne(ctx, bson.M{"_id": key})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc searchIndexState
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	st := castSearchIndexState(doc)
+	return &st, nil
+}
+
+// GetReady returns up to n pending buckets that are ready to be indexed.
+func (s *SearchIndexStates) GetReady(ctx context.Context, n int64) ([]SearchIndexState, error) {
+	opts := options.Find().SetLimit(n).SetSort(bson.D{{"ready_at", 1}})
+	cursor, err := s.col.Find(ctx, bson.M{"pending": true, "ready_at": bson.M{"$lte": time.Now()}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("querying ready search index states: %s", err)
+	}
+	defer cursor.Close(ctx)
+	var list []SearchIndexState
+	for cursor.Next(ctx) {
+		var doc searchIndexState
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		list = append(list, castSearchIndexState(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// SetIndexed records that the bucket with key was indexed at root, creating entries with gen.
+// The bucket stays pending if it was marked pending again since seq.
+func (s *SearchIndexStates) SetIndexed(ctx context.Context, key string, seq int64, gen, root string) error {
+	now := time.Now()
+	res, err := s.col.UpdateOne(ctx, bson.M{"_id": key, "seq": seq}, bson.M{"$set": bson.M{
+		"pending":    false,
+		"gen":        gen,
+		"root":       root,
+		"indexed_at": now,
+		"last_error": "",
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+	res, err = s.col.UpdateOne(ctx, bson.M{"_id": key}, bson.M{"$set": bson.M{
+		"gen":        gen,
+		"root":       root,
+		"indexed_at": now,
+		"last_error": "",
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// SetFailed records a failed indexing of the bucket with key.
+// The indexing is retried at retryAt.
+func (s *SearchIndexStates) SetFailed(ctx context.Context, key string, reason string, retryAt time.Time) error {
+	res, err := s.col.UpdateOne(ctx, bson.M{"_id": key}, bson.M{"$set": bson.M{
+		"last_error": reason,
+		"ready_at":   retryAt,
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// Delete removes the index state of the bucket with key.
+func (s *SearchIndexStates) Delete(ctx context.Context, key string) error {
+	_, err := s.col.DeleteOne(ctx, bson.M{"_id": key})
+	return err
+}
+
+func castSearchIndexState(doc searchIndexState) SearchIndexState {
+	return SearchIndexState{
+		BucketKey: doc.BucketKey,
+		DbID:      doc.DbID,
+		DbToken:   doc.DbToken,
+		Pending:   doc.Pending,
+		ReadyAt:   doc.ReadyAt,
+		Seq:       doc.Seq,
+		Gen:       doc.Gen,
+		Root:      doc.Root,
+		IndexedAt: doc.IndexedAt,
+		LastError: doc.LastError,
+	}
+}
@@ -0,0 +1,71 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/mongodb"
+)
+
+func TestSearchIndexStates_MarkPending(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewSearchIndexStates(ctx, db)
+	require.NoError(t, err)
+
+	dbID := thread.NewIDV1(thread.Raw, 16)
+	err = col.MarkPending(ctx, "buck", dbID, thread.Token("token"))
+	require.NoError(t, err)
+
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ready))
+	assert.Equal(t, "buck", ready[0].BucketKey)
+	assert.Equal(t, dbID, ready[0].DbID)
+	assert.Empty(t, ready[0].Gen)
+
+	err = col.SetIndexed(ctx, "buck", ready[0].Seq, "gen", "root")
+	require.NoError(t, err)
+	ready, err = col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+
+	got, err := col.Get(ctx, "buck")
+	require.NoError(t, err)
+	assert.False(t, got.Pending)
+	assert.Equal(t, "gen", got.Gen)
+	assert.Equal(t, "root", got.Root)
+}
+
+func TestSearchIndexStates_SetIndexedStale(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewSearchIndexStates(ctx, db)
+	require.NoError(t, err)
+
+	dbID := thread.NewIDV1(thread.Raw, 16)
+	err = col.MarkPending(ctx, "buck", dbID, "")
+	require.NoError(t, err)
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ready))
+
+	err = col.MarkPending(ctx, "buck", dbID, "")
+	require.NoError(t, err)
+	err = col.SetIndexed(ctx, "buck", ready[0].Seq, "gen", "root")
+	require.NoError(t, err)
+	got, err := col.Get(ctx, "buck")
+	require.NoError(t, err)
+	assert.True(t, got.Pending)
+	assert.Equal(t, "gen", got.Gen)
+
+	err = col.SetFailed(ctx, "buck", "boom", time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	ready, err = col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+}
@@ -0,0 +1,203 @@
+package mongodb
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/util"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	// sharePasswordSaltLen is the length of the random salt used to hash share link passwords.
+	sharePasswordSaltLen = 16
+	// sharePasswordKeyLen is the length of share link password hashes.
+	sharePasswordKeyLen = 32
+)
+
+// ShareLink grants read-only gateway access to a bucket path until it expires.
+// The token is the secret part of the link URL.
+type ShareLink struct {
+	Token     string
+	BucketKey string
+	DbID      thread.ID
+	DbToken   thread.Token
+	Path      string
+	Author    string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+
+	// PasswordHash is the argon2id hash of the link's password.
+	// Links without a password have an empty hash.
+	PasswordHash []byte
+	PasswordSalt []byte
+}
+
+// Expired returns whether or not the link has expired.
+func (l *ShareLink) Expired() bool {
+	return time.Now().After(l.ExpiresAt)
+}
+
+// Protected returns whether or not the link requires a password.
+func (l *ShareLink) Protected() bool {
+	return len(l.PasswordHash) > 0
+}
+
+// SetPassword sets the link's password hash.
+// An empty password removes the password.
+func (l *ShareLink) SetPassword(password string) {
+	if password == "" {
+		l.PasswordHash = nil
+		l.PasswordSalt = nil
+		return
+	}
+	l.PasswordSalt = util.GenerateRandomBytes(sharePasswordSaltLen)
+	l.PasswordHash = hashSharePassword(password, l.PasswordSalt)
+}
+
+// CheckPassword returns whether or not password matches the link's password.
+func (l *ShareLink) CheckPassword(password string) bool {
+	if !l.Protected() {
+		return true
+	}
+	return subtle.ConstantTimeCompare(hashSharePassword(password, l.PasswordSalt), l.PasswordHash) == 1
+}
+
+func hashSharePassword(password string, salt []byte) []byte {
+	return argon2.IDKey([]byte(password), salt, 1, 64*1024, 4, sharePasswordKeyLen)
+}
+
+type ShareLinks struct {
+	col *mongo.Collection
+}
+
+func NewShareLinks(ctx context.Context, db *mongo.Database) (*ShareLinks, error) {
+	s := &ShareLinks{col: db.Collection("sharelinks")}
+	_, err := s.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"bucket_key", 1}, {"created_at", -1}},
+		},
+		{
+			Keys:    bson.D{{"expires_at", 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	return s, err
+}
+
+// Create adds a share link with a new random token.
+func (s *ShareLinks) Create(ctx context.Context, link ShareLink) (*ShareLink, error) {
+	link.Token = util.MakeToken(tokenLen)
+	link.CreatedAt = time.Now()
+	if _, err := s.col.InsertOne(ctx, bson.M{
+		"_id":        link.Token,
+		"bucket_key": link.BucketKey,
+		"db_id":      link.DbID.Bytes(),
+		"db_token":   string(link.DbToken),
+		"path":       link.Path,
+		"author":     link.Author,
+		"expires_at": link.ExpiresAt,
+		"created_at": link.CreatedAt,
+		"pw_hash":    link.PasswordHash,
+		"pw_salt":    link.PasswordSalt,
+	}); err != nil {
+		return nil, err
+	}
+	return &link, nil
+}
+
+// Get returns the share link with token.
+// Expired links are returned until they are removed, use Expired to check.
+func (s *ShareLinks) Get(ctx context.Context, token string) (*ShareLink, error) {
+	res := s.col.FindOne(ctx, bson.M{"_id": token})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeShareLink(raw)
+}
+
+// List returns the active share links of a bucket, newest first.
+func (s *ShareLinks) List(ctx context.Context, key string) ([]ShareLink, error) {
+	filter := bson.M{"bucket_key": key, "expires_at": bson.M{"$gt": time.Now()}}
+	cursor, err := s.col.Find(ctx, filter, options.Find().SetSort(bson.D{{"created_at", -1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []ShareLink
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		link, err := decodeShareLink(raw)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, *link)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// Delete removes the share link with token from the bucket with key.
+func (s *ShareLinks) Delete(ctx context.Context, key, token string) error {
+	res, err := s.col.DeleteOne(ctx, bson.M{"_id": token, "bucket_key": key})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (s *ShareLinks) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := s.col.DeleteMany(ctx, bson.M{"bucket_key": key})
+	return err
+}
+
+func decodeShareLink(raw bson.M) (*ShareLink, error) {
+	dbID, err := thread.Cast(raw["db_id"].(primitive.Binary).Data)
+	if err != nil {
+		return nil, err
+	}
+	var expires, created time.Time
+	if v, ok := raw["expires_at"]; ok {
+		expires = v.(primitive.DateTime).Time()
+	}
+	if v, ok := raw["created_at"]; ok {
+		created = v.(primitive.DateTime).Time()
+	}
+	var hash, salt []byte
+	if v, ok := raw["pw_hash"].(primitive.Binary); ok {
+		hash = v.Data
+	}
+	if v, ok := raw["pw_salt"].(primitive.Binary); ok {
+		salt = v.Data
+	}
+	return &ShareLink{
+		Token:        raw["_id"].(string),
+		BucketKey:    raw["bucket_key"].(string),
+		DbID:         dbID,
+		DbToken:      thread.Token(raw["db_token"].(string)),
+		Path:         raw["path"].(string),
+		Author:       raw["author"].(string),
+		ExpiresAt:    expires,
+		CreatedAt:    created,
+		PasswordHash: hash,
+		PasswordSalt: salt,
+	}, nil
+}
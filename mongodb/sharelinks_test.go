@@ -0,0 +1,106 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/go-threads/core/thread"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestShareLinks_Create(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewShareLinks(ctx, db)
+	require.NoError(t, err)
+
+	dbID := thread.NewIDV1(thread.Raw, 16)
+	created, err := col.Create(ctx, ShareLink{
+		BucketKey: "buck",
+		DbID:      dbID,
+		DbToken:   thread.Token("token"),
+		Path:      "dir/file.jpg",
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.Token)
+	assert.False(t, created.Expired())
+
+	got, err := col.Get(ctx, created.Token)
+	require.NoError(t, err)
+	assert.Equal(t, "buck", got.BucketKey)
+	assert.Equal(t, dbID, got.DbID)
+	assert.Equal(t, thread.Token("token"), got.DbToken)
+	assert.Equal(t, "dir/file.jpg", got.Path)
+}
+
+func TestShareLinks_List(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewShareLinks(ctx, db)
+	require.NoError(t, err)
+
+	dbID := thread.NewIDV1(thread.Raw, 16)
+	_, err = col.Create(ctx, ShareLink{BucketKey: "buck", DbID: dbID, Path: "a", ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	_, err = col.Create(ctx, ShareLink{BucketKey: "buck", DbID: dbID, Path: "b", ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+	expired, err := col.Create(ctx, ShareLink{BucketKey: "buck", DbID: dbID, Path: "c", ExpiresAt: time.Now().Add(-time.Hour)})
+	require.NoError(t, err)
+	assert.True(t, expired.Expired())
+	_, err = col.Create(ctx, ShareLink{BucketKey: "other", DbID: dbID, Path: "a", ExpiresAt: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	assert.Equal(t, "b", list[0].Path)
+}
+
+func TestShareLinks_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewShareLinks(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, ShareLink{
+		BucketKey: "buck",
+		DbID:      thread.NewIDV1(thread.Raw, 16),
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	err = col.Delete(ctx, "other", created.Token)
+	require.Equal(t, mongo.ErrNoDocuments, err)
+	err = col.Delete(ctx, "buck", created.Token)
+	require.NoError(t, err)
+	_, err = col.Get(ctx, created.Token)
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}
+
+func TestShareLinks_Password(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewShareLinks(ctx, db)
+	require.NoError(t, err)
+
+	link := ShareLink{
+		BucketKey: "buck",
+		DbID:      thread.NewIDV1(thread.Raw, 16),
+		ExpiresAt: time.Now().Add(time.Hour),
+	}
+	assert.False(t, link.Protected())
+	link.SetPassword("secret")
+	assert.True(t, link.Protected())
+	created, err := col.Create(ctx, link)
+	require.NoError(t, err)
+
+	got, err := col.Get(ctx, created.Token)
+	require.NoError(t, err)
+	assert.True(t, got.Protected())
+	assert.True(t, got.CheckPassword("secret"))
+	assert.False(t, got.CheckPassword("wrong"))
+}
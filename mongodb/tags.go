@@ -0,0 +1,233 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	maxTags      = 50
+	maxTagKeyLen = 128
+	maxTagValLen = 256
+)
+
+var (
+	// ErrTooManyTags indicates the number of tags exceeds the max allowed.
+	ErrTooManyTags = fmt.Errorf("a resource may have at most %d tags", maxTags)
+	// ErrInvalidTag indicates a tag key or value is not valid.
+	ErrInvalidTag = fmt.Errorf("tag keys must be 1-%d characters without '.' or '$' and values at most %d characters", maxTagKeyLen, maxTagValLen)
+)
+
+// ResourceType is the type of a taggable resource.
+type ResourceType int
+
+const (
+	ThreadResource ResourceType = iota
+	BucketResource
+)
+
+func (r ResourceType) String() (s string) {
+	switch r {
+	case ThreadResource:
+		s = "thread"
+	case BucketResource:
+		s = "bucket"
+	}
+	return
+}
+
+// ResourceTags holds the key/value tags for a single resource.
+type ResourceTags struct {
+	Type      ResourceType
+	ID        string
+	Owner     crypto.PubKey
+	Tags      map[string]string
+	UpdatedAt time.Time
+}
+
+type Tags struct {
+	col *mongo.Collection
+}
+
+func NewTags(ctx context.Context, db *mongo.Database) (*Tags, error) {
+	t := &Tags{col: db.Collection("tags")}
+	_, err := t.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"owner_id", 1}, {"_id.type", 1}},
+		},
+	})
+	return t, err
+}
+
+// ValidateTags returns an error if tags contains invalid keys or values.
+func ValidateTags(tags map[string]string) error {
+	if len(tags) > maxTags {
+		return ErrTooManyTags
+	}
+	for k, v := range tags {
+		if len(k) == 0 || len(k) > maxTagKeyLen || len(v) > maxTagValLen || strings.ContainsAny(k, ".$") {
+			return ErrInvalidTag
+		}
+	}
+	return nil
+}
+
+// Set replaces the tags for a resource. Owner is optional.
+// Setting an empty map of tags removes the resource's tags.
+func (t *Tags) Set(ctx context.Context, typ ResourceType, id string, owner crypto.PubKey, tags map[string]string) error {
+	if err := ValidateTags(tags); err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		err := t.Delete(ctx, typ, id)
+		if err == mongo.ErrNoDocuments {
+			return nil
+		}
+		return err
+	}
+	raw := bson.M{
+		"tags":       tags,
+		"updated_at": time.Now(),
+	}
+	if owner != nil {
+		ownerID, err := crypto.MarshalPublicKey(owner)
+		if err != nil {
+			return err
+		}
+		raw["owner_id"] = ownerID
+	}
+	_, err := t.col.UpdateOne(
+		ctx,
+		bson.M{"_id": bson.D{{"type", int32(typ)}, {"id", id}}},
+		bson.M{"$set": raw},
+		options.Update().SetUpsert(true))
+	return err
+}
+
+// Get returns the tags for a resource.
+func (t *Tags) Get(ctx context.Context, typ ResourceType, id string) (*ResourceTags, error) {
+	res := t.col.FindOne(ctx, bson.M{"_id": bson.D{{"type", int32(typ)}, {"id", id}}})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeResourceTags(raw)
+}
+
+// GetMap returns the tags for a resource as a map.
+// An empty map is returned if the resource has not been tagged.
+func (t *Tags) GetMap(ctx context.Context, typ ResourceType, id string) (map[string]string, error) {
+	rt, err := t.Get(ctx, typ, id)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	return rt.Tags, nil
+}
+
+// ListByOwner returns tagged resources of typ for owner that match all of the key/value pairs in filter.
+func (t *Tags) ListByOwner(ctx context.Context, typ ResourceType, owner crypto.PubKey, filter map[string]string) ([]ResourceTags, error) {
+	ownerID, err := crypto.MarshalPublicKey(owner)
+	if err != nil {
+		return nil, err
+	}
+	query := bson.M{"owner_id": ownerID, "_id.type": int32(typ)}
+	for k, v := range filter {
+		query["tags."+k] = v
+	}
+	cursor, err := t.col.Find(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var docs []ResourceTags
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		doc, err := decodeResourceTags(raw)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, *doc)
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// Delete removes the tags for a resource.
+func (t *Tags) Delete(ctx context.Context, typ ResourceType, id string) error {
+	res, err := t.col.DeleteOne(ctx, bson.M{"_id": bson.D{{"type", int32(typ)}, {"id", id}}})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeleteByOwner removes the tags for all resources owned by owner.
+func (t *Tags) DeleteByOwner(ctx context.Context, owner crypto.PubKey) error {
+	ownerID, err := crypto.MarshalPublicKey(owner)
+	if err != nil {
+		return err
+	}
+	_, err = t.col.DeleteMany(ctx, bson.M{"owner_id": ownerID})
+	return err
+}
+
+// MatchTags returns true if tags contains all of the key/value pairs in filter.
+func MatchTags(tags, filter map[string]string) bool {
+	for k, v := range filter {
+		if tv, ok := tags[k]; !ok || tv != v {
+			return false
+		}
+	}
+	return true
+}
+
+func decodeResourceTags(raw bson.M) (*ResourceTags, error) {
+	rid := raw["_id"].(bson.M)
+	var owner crypto.PubKey
+	if v, ok := raw["owner_id"]; ok {
+		var err error
+		owner, err = crypto.UnmarshalPublicKey(v.(primitive.Binary).Data)
+		if err != nil {
+			return nil, err
+		}
+	}
+	tags := make(map[string]string)
+	if v, ok := raw["tags"]; ok {
+		for k, tv := range v.(bson.M) {
+			tags[k] = tv.(string)
+		}
+	}
+	var updated time.Time
+	if v, ok := raw["updated_at"]; ok {
+		updated = v.(primitive.DateTime).Time()
+	}
+	return &ResourceTags{
+		Type:      ResourceType(rid["type"].(int32)),
+		ID:        rid["id"].(string),
+		Owner:     owner,
+		Tags:      tags,
+		UpdatedAt: updated,
+	}, nil
+}
@@ -0,0 +1,106 @@
+package mongodb_test
+
+import (
+	"context"
+	"crypto/rand"
+	"strings"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestTags_Set(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewTags(ctx, db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	err = col.Set(ctx, BucketResource, "foo", owner, map[string]string{"team": "eng"})
+	require.NoError(t, err)
+	err = col.Set(ctx, BucketResource, "foo", owner, map[string]string{"team": "ops", "project": "bar"})
+	require.NoError(t, err)
+
+	got, err := col.Get(ctx, BucketResource, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"team": "ops", "project": "bar"}, got.Tags)
+	assert.True(t, got.Owner.Equals(owner))
+
+	err = col.Set(ctx, BucketResource, "foo", owner, map[string]string{"a.b": "c"})
+	require.Error(t, err)
+	err = col.Set(ctx, BucketResource, "foo", owner, map[string]string{"a": strings.Repeat("c", 1000)})
+	require.Error(t, err)
+
+	err = col.Set(ctx, BucketResource, "foo", owner, nil)
+	require.NoError(t, err)
+	_, err = col.Get(ctx, BucketResource, "foo")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}
+
+func TestTags_GetMap(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewTags(ctx, db)
+	require.NoError(t, err)
+
+	tags, err := col.GetMap(ctx, ThreadResource, "foo")
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	err = col.Set(ctx, ThreadResource, "foo", nil, map[string]string{"team": "eng"})
+	require.NoError(t, err)
+	tags, err = col.GetMap(ctx, ThreadResource, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, "eng", tags["team"])
+}
+
+func TestTags_ListByOwner(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewTags(ctx, db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	err = col.Set(ctx, ThreadResource, "t1", owner, map[string]string{"team": "eng"})
+	require.NoError(t, err)
+	err = col.Set(ctx, ThreadResource, "t2", owner, map[string]string{"team": "ops"})
+	require.NoError(t, err)
+	err = col.Set(ctx, BucketResource, "b1", owner, map[string]string{"team": "eng"})
+	require.NoError(t, err)
+
+	list, err := col.ListByOwner(ctx, ThreadResource, owner, nil)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	list, err = col.ListByOwner(ctx, ThreadResource, owner, map[string]string{"team": "eng"})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "t1", list[0].ID)
+}
+
+func TestTags_DeleteByOwner(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewTags(ctx, db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	err = col.Set(ctx, ThreadResource, "t1", owner, map[string]string{"team": "eng"})
+	require.NoError(t, err)
+	err = col.Set(ctx, BucketResource, "b1", owner, map[string]string{"team": "eng"})
+	require.NoError(t, err)
+
+	err = col.DeleteByOwner(ctx, owner)
+	require.NoError(t, err)
+	_, err = col.Get(ctx, ThreadResource, "t1")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+	_, err = col.Get(ctx, BucketResource, "b1")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}
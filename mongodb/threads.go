@@ -106,6 +106,23 @@ func (t *Threads) Get(ctx context.Context, id thread.ID, owner crypto.PubKey) (*
 	return decodeThread(raw)
 }
 
+// GetOwner returns the owner of thread id.
+func (t *Threads) GetOwner(ctx context.Context, id thread.ID) (crypto.PubKey, error) {
+	res := t.col.FindOne(ctx, bson.M{"_id.thread": id.Bytes()})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	doc, err := decodeThread(raw)
+	if err != nil {
+		return nil, err
+	}
+	return doc.Owner, nil
+}
+
 func (t *Threads) GetByName(ctx context.Context, name string, owner crypto.PubKey) (*Thread, error) {
 	ownerID, err := crypto.MarshalPublicKey(owner)
 	if err != nil {
@@ -189,6 +206,30 @@ func (t *Threads) Delete(ctx context.Context, id thread.ID, owner crypto.PubKey)
 	return nil
 }
 
+// Transfer moves thread id from owner to newOwner.
+// The thread keeps its name, API key, and creation time.
+func (t *Threads) Transfer(ctx context.Context, id thread.ID, owner, newOwner crypto.PubKey) error {
+	ownerID, err := crypto.MarshalPublicKey(owner)
+	if err != nil {
+		return err
+	}
+	newOwnerID, err := crypto.MarshalPublicKey(newOwner)
+	if err != nil {
+		return err
+	}
+	// The owner is part of the document ID, so the document has to be replaced
+	var raw bson.M
+	if err := t.col.FindOne(ctx, bson.M{"_id": bson.D{{"owner", ownerID}, {"thread", id.Bytes()}}}).Decode(&raw); err != nil {
+		return err
+	}
+	raw["_id"] = bson.D{{"owner", newOwnerID}, {"thread", id.Bytes()}}
+	if _, err := t.col.InsertOne(ctx, raw); err != nil {
+		return err
+	}
+	_, err = t.col.DeleteOne(ctx, bson.M{"_id": bson.D{{"owner", ownerID}, {"thread", id.Bytes()}}})
+	return err
+}
+
 func (t *Threads) DeleteByOwner(ctx context.Context, owner crypto.PubKey) error {
 	ownerID, err := crypto.MarshalPublicKey(owner)
 	if err != nil {
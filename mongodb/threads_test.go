@@ -140,6 +140,29 @@ func TestThreads_Delete(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestThreads_Transfer(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewThreads(ctx, db)
+	require.NoError(t, err)
+
+	_, owner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	_, newOwner, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	created, err := col.Create(common.NewThreadNameContext(ctx, "db1"), thread.NewIDV1(thread.Raw, 32), owner, true)
+	require.NoError(t, err)
+
+	err = col.Transfer(ctx, created.ID, owner, newOwner)
+	require.NoError(t, err)
+	_, err = col.Get(ctx, created.ID, owner)
+	require.Error(t, err)
+	got, err := col.Get(ctx, created.ID, newOwner)
+	require.NoError(t, err)
+	assert.Equal(t, "db1", got.Name)
+	assert.True(t, got.IsDB)
+}
+
 func TestThreads_DeleteByOwner(t *testing.T) {
 	db := newDB(t)
 	ctx := context.Background()
@@ -0,0 +1,124 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Thumbnail holds the thumbnails of an image in a bucket.
+type Thumbnail struct {
+	BucketKey string
+	Path      string
+	// Cid is the CID of the image the thumbnails were generated from.
+	Cid string
+	// ContentType is the content type of the thumbnails.
+	ContentType string
+	// Images maps thumbnail size names to encoded thumbnails.
+	Images map[string][]byte
+	// Error is set instead of images if thumbnails could not be generated from the image.
+	Error     string
+	CreatedAt time.Time
+}
+
+type thumbnail struct {
+	BucketKey   string            `bson:"bucket_key"`
+	Path        string            `bson:"path"`
+	Cid         string            `bson:"cid"`
+	ContentType string            `bson:"content_type"`
+	Images      map[string][]byte `bson:"images"`
+	Error       string            `bson:"error"`
+	CreatedAt   time.Time         `bson:"created_at"`
+}
+
+type Thumbnails struct {
+	col *mongo.Collection
+}
+
+func NewThumbnails(ctx context.Context, db *mongo.Database) (*Thumbnails, error) {
+	t := &Thumbnails{col: db.Collection("thumbnails")}
+	_, err := t.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{"bucket_key", 1}, {"path", 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	return t, err
+}
+
+// Put sets the thumbnails of the image at a bucket path, replacing any previous thumbnails.
+func (t *Thumbnails) Put(ctx context.Context, thumb Thumbnail) error {
+	doc := thumbnail{
+		BucketKey:   thumb.BucketKey,
+		Path:        thumb.Path,
+		Cid:         thumb.Cid,
+		ContentType: thumb.ContentType,
+		Images:      thumb.Images,
+		Error:       thumb.Error,
+		CreatedAt:   time.Now(),
+	}
+	_, err := t.col.ReplaceOne(ctx, bson.M{"bucket_key": thumb.BucketKey, "path": thumb.Path}, doc,
+		options.Replace().SetUpsert(true))
+	return err
+}
+
+// Get returns the thumbnails of the image at pth in the bucket with key.
+func (t *Thumbnails) Get(ctx context.Context, key, pth string) (*Thumbnail, error) {
+	res := t.col.FindOne(ctx, bson.M{"bucket_key": key, "path": pth})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc thumbnail
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	thumb := castThumbnail(doc)
+	return &thumb, nil
+}
+
+// ListCids returns the source image CIDs of all thumbnails of the bucket with key, keyed by path.
+func (t *Thumbnails) ListCids(ctx context.Context, key string) (map[string]string, error) {
+	opts := options.Find().SetProjection(bson.M{"path": 1, "cid": 1})
+	cursor, err := t.col.Find(ctx, bson.M{"bucket_key": key}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	cids := make(map[string]string)
+	for cursor.Next(ctx) {
+		var doc thumbnail
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		cids[doc.Path] = doc.Cid
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return cids, nil
+}
+
+// Delete removes the thumbnails of the image at pth in the bucket with key.
+func (t *Thumbnails) Delete(ctx context.Context, key, pth string) error {
+	_, err := t.col.DeleteOne(ctx, bson.M{"bucket_key": key, "path": pth})
+	return err
+}
+
+// DeleteByBucket removes all thumbnails of the bucket with key.
+func (t *Thumbnails) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := t.col.DeleteMany(ctx, bson.M{"bucket_key": key})
+	return err
+}
+
+func castThumbnail(doc thumbnail) Thumbnail {
+	return Thumbnail{
+		BucketKey:   doc.BucketKey,
+		Path:        doc.Path,
+		Cid:         doc.Cid,
+		ContentType: doc.ContentType,
+		Images:      doc.Images,
+		Error:       doc.Error,
+		CreatedAt:   doc.CreatedAt,
+	}
+}
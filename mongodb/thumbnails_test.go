@@ -0,0 +1,69 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestThumbnails_Put(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewThumbnails(ctx, db)
+	require.NoError(t, err)
+
+	err = col.Put(ctx, Thumbnail{
+		BucketKey:   "buck",
+		Path:        "a.jpg",
+		Cid:         "cid1",
+		ContentType: "image/jpeg",
+		Images:      map[string][]byte{"small": []byte("small")},
+	})
+	require.NoError(t, err)
+	err = col.Put(ctx, Thumbnail{
+		BucketKey:   "buck",
+		Path:        "a.jpg",
+		Cid:         "cid2",
+		ContentType: "image/jpeg",
+		Images:      map[string][]byte{"small": []byte("small2")},
+	})
+	require.NoError(t, err)
+	err = col.Put(ctx, Thumbnail{BucketKey: "buck", Path: "b.png", Cid: "cid3", Error: "bad image"})
+	require.NoError(t, err)
+
+	got, err := col.Get(ctx, "buck", "a.jpg")
+	require.NoError(t, err)
+	assert.Equal(t, "cid2", got.Cid)
+	assert.Equal(t, []byte("small2"), got.Images["small"])
+
+	cids, err := col.ListCids(ctx, "buck")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"a.jpg": "cid2", "b.png": "cid3"}, cids)
+}
+
+func TestThumbnails_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewThumbnails(ctx, db)
+	require.NoError(t, err)
+
+	err = col.Put(ctx, Thumbnail{BucketKey: "buck", Path: "a.jpg", Cid: "cid1"})
+	require.NoError(t, err)
+	err = col.Put(ctx, Thumbnail{BucketKey: "buck", Path: "b.jpg", Cid: "cid2"})
+	require.NoError(t, err)
+
+	err = col.Delete(ctx, "buck", "a.jpg")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, "buck", "a.jpg")
+	assert.Equal(t, mongo.ErrNoDocuments, err)
+
+	err = col.DeleteByBucket(ctx, "buck")
+	require.NoError(t, err)
+	cids, err := col.ListCids(ctx, "buck")
+	require.NoError(t, err)
+	assert.Empty(t, cids)
+}
@@ -0,0 +1,163 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/textileio/go-threads/core/thread"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ThumbnailState tracks the thumbnails of a bucket's images.
+type ThumbnailState struct {
+	BucketKey string
+	DbID      thread.ID
+	DbToken   thread.Token
+
+	// Pending is true if the bucket has changed since its thumbnails were last generated.
+	Pending bool
+	// ReadyAt is the earliest time the next generation can start.
+	ReadyAt time.Time
+	// Seq is incremented each time the bucket is marked pending.
+	Seq int64
+	// Root is the bucket root of the current thumbnails.
+	Root        string
+	GeneratedAt time.Time
+	LastError   string
+}
+
+type thumbnailState struct {
+	BucketKey   string       `bson:"_id"`
+	DbID        thread.ID    `bson:"db_id"`
+	DbToken     thread.Token `bson:"db_token"`
+	Pending     bool         `bson:"pending"`
+	ReadyAt     time.Time    `bson:"ready_at"`
+	Seq         int64        `bson:"seq"`
+	Root        string       `bson:"root"`
+	GeneratedAt time.Time    `bson:"generated_at"`
+	LastError   string       `bson:"last_error"`
+}
+
+type ThumbnailStates struct {
+	col *mongo.Collection
+}
+
+func NewThumbnailStates(ctx context.Context, db *mongo.Database) (*ThumbnailStates, error) {
+	s := &ThumbnailStates{col: db.Collection("thumbnailstates")}
+	_, err := s.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"pending", 1}, {"ready_at", 1}},
+	})
+	return s, err
+}
+
+// MarkPending marks the bucket with key as needing its thumbnails generated.
+// The state is created if it doesn't exist.
+func (s *ThumbnailStates) MarkPending(ctx context.Context, key string, dbID thread.ID, dbToken thread.Token) error {
+	_, err := s.col.UpdateOne(ctx, bson.M{"_id": key}, bson.M{
+		"$set": bson.M{"db_id": dbID, "db_token": dbToken, "pending": true, "ready_at": time.Now()},
+		"$inc": bson.M{"seq": 1},
+	}, options.Update().SetUpsert(true))
+	return err
+}
+
+// Get returns the thumbnail state of the bucket with key.
+func (s *ThumbnailStates) Get(ctx context.Context, key string) (*ThumbnailState, error) {
+	res := s.col.FindOne(ctx, bson.M{"_id": key})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var doc thumbnailState
+	if err := res.Decode(&doc); err != nil {
+		return nil, err
+	}
+	st := castThumbnailState(doc)
+	return &st, nil
+}
+
+// GetReady returns up to n pending buckets that are ready to have their thumbnails generated.
+func (s *ThumbnailStates) GetReady(ctx context.Context, n int64) ([]ThumbnailState, error) {
+	opts := options.Find().SetLimit(n).SetSort(bson.D{{"ready_at", 1}})
+	cursor, err := s.col.Find(ctx, bson.M{"pending": true, "ready_at": bson.M{"$lte": time.Now()}}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("querying ready thumbnail states: %s", err)
+	}
+	defer cursor.Close(ctx)
+	var list []ThumbnailState
+	for cursor.Next(ctx) {
+		var doc thumbnailState
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		list = append(list, castThumbnailState(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// SetGenerated records that the thumbnails of the bucket with key were generated at root.
+// The bucket stays pending if it was marked pending again since seq.
+func (s *ThumbnailStates) SetGenerated(ctx context.Context, key string, seq int64, root string) error {
+	update := bson.M{
+		"root":         root,
+		"generated_at": time.Now(),
+		"last_error":   "",
+		"pending":      false,
+	}
+	res, err := s.col.UpdateOne(ctx, bson.M{"_id": key, "seq": seq}, bson.M{"$set": update})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount > 0 {
+		return nil
+	}
+	delete(update, "pending")
+	res, err = s.col.UpdateOne(ctx, bson.M{"_id": key}, bson.M{"$set": update})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// SetFailed records a failed generation of the thumbnails of the bucket with key.
+// The generation is retried at retryAt.
+func (s *ThumbnailStates) SetFailed(ctx context.Context, key string, reason string, retryAt time.Time) error {
+	res, err := s.col.UpdateOne(ctx, bson.M{"_id": key}, bson.M{"$set": bson.M{
+		"last_error": reason,
+		"ready_at":   retryAt,
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// Delete removes the thumbnail state of the bucket with key.
+func (s *ThumbnailStates) Delete(ctx context.Context, key string) error {
+	_, err := s.col.DeleteOne(ctx, bson.M{"_id": key})
+	return err
+}
+
+func castThumbnailState(doc thumbnailState) ThumbnailState {
+	return ThumbnailState{
+		BucketKey:   doc.BucketKey,
+		DbID:        doc.DbID,
+		DbToken:     doc.DbToken,
+		Pending:     doc.Pending,
+		ReadyAt:     doc.ReadyAt,
+		Seq:         doc.Seq,
+		Root:        doc.Root,
+		GeneratedAt: doc.GeneratedAt,
+		LastError:   doc.LastError,
+	}
+}
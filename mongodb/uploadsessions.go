@@ -0,0 +1,138 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/textileio/textile/util"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	uploadSessionDur = time.Hour * 24
+)
+
+// UploadSession tracks a resumable upload to a bucket path.
+// Uploaded bytes are staged outside of the database until the session is completed.
+type UploadSession struct {
+	ID        string
+	Thread    string
+	BucketKey string
+	Path      string
+	Root      string
+	Message   string
+	Size      int64
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+type UploadSessions struct {
+	col *mongo.Collection
+}
+
+func NewUploadSessions(ctx context.Context, db *mongo.Database) (*UploadSessions, error) {
+	s := &UploadSessions{col: db.Collection("uploadsessions")}
+	_, err := s.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"expires_at", 1}},
+	})
+	return s, err
+}
+
+// Create starts a new upload session.
+// Sessions expire after one day.
+func (s *UploadSessions) Create(ctx context.Context, session UploadSession) (*UploadSession, error) {
+	session.ID = util.MakeToken(tokenLen)
+	session.CreatedAt = time.Now()
+	session.ExpiresAt = session.CreatedAt.Add(uploadSessionDur)
+	if _, err := s.col.InsertOne(ctx, bson.M{
+		"_id":        session.ID,
+		"thread":     session.Thread,
+		"bucket_key": session.BucketKey,
+		"path":       session.Path,
+		"root":       session.Root,
+		"message":    session.Message,
+		"size":       session.Size,
+		"created_at": session.CreatedAt,
+		"expires_at": session.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// Get returns an upload session.
+// Expired sessions are not returned.
+func (s *UploadSessions) Get(ctx context.Context, id string) (*UploadSession, error) {
+	res := s.col.FindOne(ctx, bson.M{"_id": id, "expires_at": bson.M{"$gt": time.Now()}})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeUploadSession(raw), nil
+}
+
+func (s *UploadSessions) Delete(ctx context.Context, id string) error {
+	res, err := s.col.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// DeleteExpired removes all expired sessions, returning their IDs
+// so that staged data can be cleaned up.
+func (s *UploadSessions) DeleteExpired(ctx context.Context) ([]string, error) {
+	filter := bson.M{"expires_at": bson.M{"$lte": time.Now()}}
+	cursor, err := s.col.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var ids []string
+	for cursor.Next(ctx) {
+		var raw bson.M
+		if err := cursor.Decode(&raw); err != nil {
+			return nil, err
+		}
+		ids = append(ids, raw["_id"].(string))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	if _, err := s.col.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}}); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func decodeUploadSession(raw bson.M) *UploadSession {
+	var created, expiry time.Time
+	if v, ok := raw["created_at"]; ok {
+		created = v.(primitive.DateTime).Time()
+	}
+	if v, ok := raw["expires_at"]; ok {
+		expiry = v.(primitive.DateTime).Time()
+	}
+	return &UploadSession{
+		ID:        raw["_id"].(string),
+		Thread:    raw["thread"].(string),
+		BucketKey: raw["bucket_key"].(string),
+		Path:      raw["path"].(string),
+		Root:      raw["root"].(string),
+		Message:   raw["message"].(string),
+		Size:      raw["size"].(int64),
+		CreatedAt: created,
+		ExpiresAt: expiry,
+	}
+}
@@ -0,0 +1,56 @@
+package mongodb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestUploadSessions_Create(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewUploadSessions(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, UploadSession{
+		Thread:    "thread",
+		BucketKey: "buck",
+		Path:      "dir/file.bin",
+		Message:   "big file",
+		Size:      1024,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.True(t, created.ExpiresAt.After(created.CreatedAt))
+
+	got, err := col.Get(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "buck", got.BucketKey)
+	assert.Equal(t, "dir/file.bin", got.Path)
+	assert.Equal(t, int64(1024), got.Size)
+}
+
+func TestUploadSessions_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewUploadSessions(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, UploadSession{Thread: "thread", BucketKey: "buck", Path: "file"})
+	require.NoError(t, err)
+	err = col.Delete(ctx, created.ID)
+	require.NoError(t, err)
+	_, err = col.Get(ctx, created.ID)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+	err = col.Delete(ctx, created.ID)
+	require.True(t, errors.Is(err, mongo.ErrNoDocuments))
+
+	ids, err := col.DeleteExpired(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
@@ -0,0 +1,130 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/textileio/textile/buckets"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// WebConfig holds settings used by the gateway to render a bucket as a website.
+type WebConfig struct {
+	BucketKey string
+	// Redirects are compiled from the bucket's redirects file.
+	Redirects []buckets.Redirect
+	// Website is a copy of the bucket's website config.
+	Website   *buckets.Website
+	UpdatedAt time.Time
+}
+
+// AllRedirects returns the rules of the redirects file followed by the website redirects.
+func (c *WebConfig) AllRedirects() []buckets.Redirect {
+	if c.Website == nil || len(c.Website.Redirects) == 0 {
+		return c.Redirects
+	}
+	rules := make([]buckets.Redirect, 0, len(c.Redirects)+len(c.Website.Redirects))
+	rules = append(rules, c.Redirects...)
+	return append(rules, c.Website.Redirects...)
+}
+
+type WebConfigs struct {
+	col *mongo.Collection
+}
+
+func NewWebConfigs(_ context.Context, db *mongo.Database) (*WebConfigs, error) {
+	return &WebConfigs{col: db.Collection("webconfigs")}, nil
+}
+
+// SetRedirects replaces the redirect rules for a bucket.
+func (w *WebConfigs) SetRedirects(ctx context.Context, bucketKey string, rules []buckets.Redirect) error {
+	raw := make(bson.A, len(rules))
+	for i, r := range rules {
+		raw[i] = bson.M{
+			"from":   r.From,
+			"to":     r.To,
+			"status": int32(r.Status),
+			"force":  r.Force,
+		}
+	}
+	_, err := w.col.UpdateOne(
+		ctx,
+		bson.M{"_id": bucketKey},
+		bson.M{"$set": bson.M{"redirects": raw, "updated_at": time.Now()}},
+		options.Update().SetUpsert(true))
+	return err
+}
+
+// SetWebsite replaces the website config for a bucket.
+// A nil website removes the config.
+func (w *WebConfigs) SetWebsite(ctx context.Context, bucketKey string, website *buckets.Website) error {
+	update := bson.M{"$set": bson.M{"website": website, "updated_at": time.Now()}}
+	if website == nil {
+		update = bson.M{"$unset": bson.M{"website": ""}, "$set": bson.M{"updated_at": time.Now()}}
+	}
+	_, err := w.col.UpdateOne(ctx, bson.M{"_id": bucketKey}, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// Get returns the web config for a bucket.
+func (w *WebConfigs) Get(ctx context.Context, bucketKey string) (*WebConfig, error) {
+	res := w.col.FindOne(ctx, bson.M{"_id": bucketKey})
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	var raw bson.M
+	if err := res.Decode(&raw); err != nil {
+		return nil, err
+	}
+	return decodeWebConfig(raw)
+}
+
+func (w *WebConfigs) Delete(ctx context.Context, bucketKey string) error {
+	res, err := w.col.DeleteOne(ctx, bson.M{"_id": bucketKey})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func decodeWebConfig(raw bson.M) (*WebConfig, error) {
+	var redirects []buckets.Redirect
+	if v, ok := raw["redirects"]; ok {
+		for _, r := range v.(bson.A) {
+			rule := r.(bson.M)
+			redirects = append(redirects, buckets.Redirect{
+				From:   rule["from"].(string),
+				To:     rule["to"].(string),
+				Status: int(rule["status"].(int32)),
+				Force:  rule["force"].(bool),
+			})
+		}
+	}
+	var website *buckets.Website
+	if v, ok := raw["website"]; ok && v != nil {
+		data, err := bson.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		website = &buckets.Website{}
+		if err := bson.Unmarshal(data, website); err != nil {
+			return nil, err
+		}
+	}
+	var updated time.Time
+	if v, ok := raw["updated_at"]; ok {
+		updated = v.(primitive.DateTime).Time()
+	}
+	return &WebConfig{
+		BucketKey: raw["_id"].(string),
+		Redirects: redirects,
+		Website:   website,
+		UpdatedAt: updated,
+	}, nil
+}
@@ -0,0 +1,86 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/textileio/textile/buckets"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestWebConfigs_SetRedirects(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewWebConfigs(ctx, db)
+	require.NoError(t, err)
+
+	rules := []buckets.Redirect{
+		{From: "/old", To: "/new", Status: 301},
+		{From: "/app/*", To: "/index.html", Status: 200, Force: true},
+	}
+	err = col.SetRedirects(ctx, "buck", rules)
+	require.NoError(t, err)
+
+	got, err := col.Get(ctx, "buck")
+	require.NoError(t, err)
+	assert.Equal(t, "buck", got.BucketKey)
+	assert.Equal(t, rules, got.Redirects)
+
+	err = col.SetRedirects(ctx, "buck", rules[:1])
+	require.NoError(t, err)
+	got, err = col.Get(ctx, "buck")
+	require.NoError(t, err)
+	assert.Equal(t, rules[:1], got.Redirects)
+}
+
+func TestWebConfigs_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewWebConfigs(ctx, db)
+	require.NoError(t, err)
+
+	err = col.SetRedirects(ctx, "buck", []buckets.Redirect{{From: "/a", To: "/b", Status: 302}})
+	require.NoError(t, err)
+	err = col.Delete(ctx, "buck")
+	require.NoError(t, err)
+	_, err = col.Get(ctx, "buck")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+	err = col.Delete(ctx, "buck")
+	require.Equal(t, mongo.ErrNoDocuments, err)
+}
+
+func TestWebConfigs_SetWebsite(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewWebConfigs(ctx, db)
+	require.NoError(t, err)
+
+	err = col.SetRedirects(ctx, "buck", []buckets.Redirect{{From: "/a", To: "/b", Status: 302}})
+	require.NoError(t, err)
+	website := &buckets.Website{
+		IndexDocument: "home.html",
+		ErrorDocument: "404.html",
+		Redirects:     []buckets.Redirect{{From: "/app/*", To: "/home.html", Status: 200}},
+		Headers:       []buckets.Header{{Path: "**", Name: "X-Frame-Options", Value: "DENY"}},
+	}
+	err = col.SetWebsite(ctx, "buck", website)
+	require.NoError(t, err)
+
+	got, err := col.Get(ctx, "buck")
+	require.NoError(t, err)
+	assert.Equal(t, website, got.Website)
+	assert.Equal(t, []buckets.Redirect{
+		{From: "/a", To: "/b", Status: 302},
+		{From: "/app/*", To: "/home.html", Status: 200},
+	}, got.AllRedirects())
+
+	err = col.SetWebsite(ctx, "buck", nil)
+	require.NoError(t, err)
+	got, err = col.Get(ctx, "buck")
+	require.NoError(t, err)
+	assert.Nil(t, got.Website)
+	assert.Len(t, got.Redirects, 1)
+}
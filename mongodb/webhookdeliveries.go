@@ -0,0 +1,176 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// WebhookDelivery is a queued webhook callback.
+// Deliveries that keep failing are marked dead and kept for inspection.
+type WebhookDelivery struct {
+	ID        string
+	WebhookID string
+	BucketKey string
+	URL       string
+	Secret    string
+	Event     string
+	Payload   []byte
+
+	Attempts  int
+	ReadyAt   time.Time
+	LastError string
+	Dead      bool
+	CreatedAt time.Time
+}
+
+type webhookDelivery struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	WebhookID string             `bson:"webhook_id"`
+	BucketKey string             `bson:"bucket_key"`
+	URL       string             `bson:"url"`
+	Secret    string             `bson:"secret"`
+	Event     string             `bson:"event"`
+	Payload   []byte             `bson:"payload"`
+	Attempts  int                `bson:"attempts"`
+	ReadyAt   time.Time          `bson:"ready_at"`
+	LastError string             `bson:"last_error"`
+	Dead      bool               `bson:"dead"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+type WebhookDeliveries struct {
+	col *mongo.Collection
+}
+
+func NewWebhookDeliveries(ctx context.Context, db *mongo.Database) (*WebhookDeliveries, error) {
+	d := &WebhookDeliveries{col: db.Collection("webhookdeliveries")}
+	_, err := d.col.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys: bson.D{{"dead", 1}, {"ready_at", 1}},
+		},
+		{
+			Keys: bson.D{{"bucket_key", 1}, {"dead", 1}},
+		},
+		{
+			Keys: bson.D{{"webhook_id", 1}},
+		},
+	})
+	return d, err
+}
+
+// Enqueue adds a delivery of an event with payload to hook.
+// The delivery is ready immediately.
+func (d *WebhookDeliveries) Enqueue(ctx context.Context, hook Webhook, event string, payload []byte) error {
+	now := time.Now()
+	_, err := d.col.InsertOne(ctx, webhookDelivery{
+		WebhookID: hook.ID,
+		BucketKey: hook.BucketKey,
+		URL:       hook.URL,
+		Secret:    hook.Secret,
+		Event:     event,
+		Payload:   payload,
+		ReadyAt:   now,
+		CreatedAt: now,
+	})
+	return err
+}
+
+// GetReady returns up to n deliveries that are ready to be sent, oldest first.
+func (d *WebhookDeliveries) GetReady(ctx context.Context, n int64) ([]WebhookDelivery, error) {
+	opts := options.Find().SetLimit(n).SetSort(bson.D{{"ready_at", 1}})
+	return d.find(ctx, bson.M{"dead": false, "ready_at": bson.M{"$lte": time.Now()}}, opts)
+}
+
+// ListDead returns the dead deliveries of the bucket with key, newest first.
+func (d *WebhookDeliveries) ListDead(ctx context.Context, key string) ([]WebhookDelivery, error) {
+	opts := options.Find().SetSort(bson.D{{"_id", -1}})
+	return d.find(ctx, bson.M{"bucket_key": key, "dead": true}, opts)
+}
+
+// SetFailed records a failed attempt of the delivery with id.
+// The delivery is retried at retryAt, unless dead is true.
+func (d *WebhookDeliveries) SetFailed(ctx context.Context, id string, reason string, retryAt time.Time, dead bool) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	res, err := d.col.UpdateOne(ctx, bson.M{"_id": oid}, bson.M{
+		"$set": bson.M{"last_error": reason, "ready_at": retryAt, "dead": dead},
+		"$inc": bson.M{"attempts": 1},
+	})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// Delete removes the delivery with id.
+func (d *WebhookDeliveries) Delete(ctx context.Context, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	res, err := d.col.DeleteOne(ctx, bson.M{"_id": oid})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (d *WebhookDeliveries) DeleteByWebhook(ctx context.Context, id string) error {
+	_, err := d.col.DeleteMany(ctx, bson.M{"webhook_id": id})
+	return err
+}
+
+func (d *WebhookDeliveries) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := d.col.DeleteMany(ctx, bson.M{"bucket_key": key})
+	return err
+}
+
+func (d *WebhookDeliveries) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]WebhookDelivery, error) {
+	cursor, err := d.col.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var list []WebhookDelivery
+	for cursor.Next(ctx) {
+		var doc webhookDelivery
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		list = append(list, castWebhookDelivery(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func castWebhookDelivery(doc webhookDelivery) WebhookDelivery {
+	return WebhookDelivery{
+		ID:        doc.ID.Hex(),
+		WebhookID: doc.WebhookID,
+		BucketKey: doc.BucketKey,
+		URL:       doc.URL,
+		Secret:    doc.Secret,
+		Event:     doc.Event,
+		Payload:   doc.Payload,
+		Attempts:  doc.Attempts,
+		ReadyAt:   doc.ReadyAt,
+		LastError: doc.LastError,
+		Dead:      doc.Dead,
+		CreatedAt: doc.CreatedAt,
+	}
+}
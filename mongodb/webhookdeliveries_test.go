@@ -0,0 +1,67 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+)
+
+func TestWebhookDeliveries_GetReady(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewWebhookDeliveries(ctx, db)
+	require.NoError(t, err)
+
+	hook := Webhook{ID: "hook", BucketKey: "buck", URL: "https://example.com/hook", Secret: "secret"}
+	err = col.Enqueue(ctx, hook, "path.pushed", []byte(`{}`))
+	require.NoError(t, err)
+
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ready))
+	assert.Equal(t, "hook", ready[0].WebhookID)
+	assert.Equal(t, "secret", ready[0].Secret)
+	assert.Equal(t, []byte(`{}`), ready[0].Payload)
+
+	err = col.SetFailed(ctx, ready[0].ID, "boom", time.Now().Add(time.Hour), false)
+	require.NoError(t, err)
+	ready, err = col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+}
+
+func TestWebhookDeliveries_ListDead(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewWebhookDeliveries(ctx, db)
+	require.NoError(t, err)
+
+	hook := Webhook{ID: "hook", BucketKey: "buck", URL: "https://example.com/hook"}
+	err = col.Enqueue(ctx, hook, "path.pushed", []byte(`{}`))
+	require.NoError(t, err)
+	ready, err := col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(ready))
+
+	err = col.SetFailed(ctx, ready[0].ID, "boom", time.Now(), true)
+	require.NoError(t, err)
+	ready, err = col.GetReady(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ready)
+
+	dead, err := col.ListDead(ctx, "buck")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(dead))
+	assert.Equal(t, 1, dead[0].Attempts)
+	assert.Equal(t, "boom", dead[0].LastError)
+
+	err = col.Delete(ctx, dead[0].ID)
+	require.NoError(t, err)
+	dead, err = col.ListDead(ctx, "buck")
+	require.NoError(t, err)
+	assert.Empty(t, dead)
+}
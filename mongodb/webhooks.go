@@ -0,0 +1,128 @@
+package mongodb
+
+import (
+	"context"
+	"time"
+
+	"github.com/textileio/textile/util"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Webhook is a URL that receives signed callbacks for bucket events.
+// A webhook without events receives all events.
+type Webhook struct {
+	ID        string
+	BucketKey string
+	URL       string
+	Secret    string
+	Events    []string
+	CreatedAt time.Time
+}
+
+// Matches returns whether or not the webhook receives event.
+func (w *Webhook) Matches(event string) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, e := range w.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+type webhook struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	BucketKey string             `bson:"bucket_key"`
+	URL       string             `bson:"url"`
+	Secret    string             `bson:"secret"`
+	Events    []string           `bson:"events"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+type Webhooks struct {
+	col *mongo.Collection
+}
+
+func NewWebhooks(ctx context.Context, db *mongo.Database) (*Webhooks, error) {
+	w := &Webhooks{col: db.Collection("webhooks")}
+	_, err := w.col.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{"bucket_key", 1}},
+	})
+	return w, err
+}
+
+// Create adds a webhook with a new random signing secret.
+func (w *Webhooks) Create(ctx context.Context, hook Webhook) (*Webhook, error) {
+	hook.Secret = util.MakeToken(tokenLen)
+	hook.CreatedAt = time.Now()
+	res, err := w.col.InsertOne(ctx, webhook{
+		BucketKey: hook.BucketKey,
+		URL:       hook.URL,
+		Secret:    hook.Secret,
+		Events:    hook.Events,
+		CreatedAt: hook.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	hook.ID = res.InsertedID.(primitive.ObjectID).Hex()
+	return &hook, nil
+}
+
+// List returns the webhooks of the bucket with key, oldest first.
+func (w *Webhooks) List(ctx context.Context, key string) ([]Webhook, error) {
+	cursor, err := w.col.Find(ctx, bson.M{"bucket_key": key}, options.Find().SetSort(bson.D{{"_id", 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+	var list []Webhook
+	for cursor.Next(ctx) {
+		var doc webhook
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, err
+		}
+		list = append(list, castWebhook(doc))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// Delete removes the webhook with id from the bucket with key.
+func (w *Webhooks) Delete(ctx context.Context, key, id string) error {
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	res, err := w.col.DeleteOne(ctx, bson.M{"_id": oid, "bucket_key": key})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (w *Webhooks) DeleteByBucket(ctx context.Context, key string) error {
+	_, err := w.col.DeleteMany(ctx, bson.M{"bucket_key": key})
+	return err
+}
+
+func castWebhook(doc webhook) Webhook {
+	return Webhook{
+		ID:        doc.ID.Hex(),
+		BucketKey: doc.BucketKey,
+		URL:       doc.URL,
+		Secret:    doc.Secret,
+		Events:    doc.Events,
+		CreatedAt: doc.CreatedAt,
+	}
+}
@@ -0,0 +1,66 @@
+package mongodb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	. "github.com/textileio/textile/mongodb"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestWebhooks_Create(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewWebhooks(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, Webhook{
+		BucketKey: "buck",
+		URL:       "https://example.com/hook",
+		Events:    []string{"path.pushed"},
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, created.ID)
+	assert.NotEmpty(t, created.Secret)
+	assert.True(t, created.Matches("path.pushed"))
+	assert.False(t, created.Matches("path.removed"))
+	assert.True(t, (&Webhook{}).Matches("path.removed"))
+}
+
+func TestWebhooks_List(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewWebhooks(ctx, db)
+	require.NoError(t, err)
+
+	_, err = col.Create(ctx, Webhook{BucketKey: "buck", URL: "https://example.com/a"})
+	require.NoError(t, err)
+	_, err = col.Create(ctx, Webhook{BucketKey: "buck", URL: "https://example.com/b"})
+	require.NoError(t, err)
+	_, err = col.Create(ctx, Webhook{BucketKey: "other", URL: "https://example.com/a"})
+	require.NoError(t, err)
+
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(list))
+	assert.Equal(t, "https://example.com/a", list[0].URL)
+}
+
+func TestWebhooks_Delete(t *testing.T) {
+	db := newDB(t)
+	ctx := context.Background()
+	col, err := NewWebhooks(ctx, db)
+	require.NoError(t, err)
+
+	created, err := col.Create(ctx, Webhook{BucketKey: "buck", URL: "https://example.com/a"})
+	require.NoError(t, err)
+	err = col.Delete(ctx, "other", created.ID)
+	require.Equal(t, mongo.ErrNoDocuments, err)
+	err = col.Delete(ctx, "buck", created.ID)
+	require.NoError(t, err)
+	list, err := col.List(ctx, "buck")
+	require.NoError(t, err)
+	assert.Empty(t, list)
+}
@@ -0,0 +1,98 @@
+// Package notify sends mobile push notifications for user events.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	logging "github.com/ipfs/go-log"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	mdb "github.com/textileio/textile/mongodb"
+)
+
+var log = logging.Logger("notify")
+
+const (
+	// FCM is the platform of Firebase Cloud Messaging device tokens.
+	FCM = "fcm"
+	// APNs is the platform of Apple Push Notification service device tokens.
+	APNs = "apns"
+
+	// MessageReceived is sent when a message is delivered to a user's inbox.
+	MessageReceived = "message.received"
+
+	// notifyTimeout bounds the time spent notifying the devices of a user.
+	notifyTimeout = time.Second * 30
+)
+
+// Platforms are the valid device platforms.
+var Platforms = []string{FCM, APNs}
+
+// ValidPlatform returns whether or not platform is valid.
+func ValidPlatform(platform string) bool {
+	for _, p := range Platforms {
+		if p == platform {
+			return true
+		}
+	}
+	return false
+}
+
+// Notification is sent to the devices of a user.
+// Data holds event details for the app, e.g., the message ID.
+type Notification struct {
+	Event string
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// Notifier sends a notification to a device.
+// Implementations wrap a push service, e.g., FCM or APNs, and must handle the platforms they support.
+type Notifier interface {
+	Notify(ctx context.Context, d mdb.Device, n Notification) error
+}
+
+// NotifierFunc is an adapter that allows the use of ordinary functions as a Notifier.
+type NotifierFunc func(ctx context.Context, d mdb.Device, n Notification) error
+
+// Notify calls f(ctx, d, n).
+func (f NotifierFunc) Notify(ctx context.Context, d mdb.Device, n Notification) error {
+	return f(ctx, d, n)
+}
+
+// Dispatcher sends notifications to all of a user's registered devices.
+type Dispatcher struct {
+	Devices  *mdb.Devices
+	Notifier Notifier
+}
+
+// NotifyUser sends n to all devices of the user with key in the background.
+// Failures are logged; notifications are best-effort and never fail the triggering request.
+// NotifyUser is a no-op on a nil Dispatcher or one without a Notifier.
+func (d *Dispatcher) NotifyUser(key crypto.PubKey, n Notification) {
+	if d == nil || d.Notifier == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+		defer cancel()
+		if err := d.notify(ctx, key, n); err != nil {
+			log.Errorf("notifying user: %v", err)
+		}
+	}()
+}
+
+func (d *Dispatcher) notify(ctx context.Context, key crypto.PubKey, n Notification) error {
+	devices, err := d.Devices.ListByOwner(ctx, key)
+	if err != nil {
+		return fmt.Errorf("listing devices: %v", err)
+	}
+	for _, dev := range devices {
+		if err := d.Notifier.Notify(ctx, dev, n); err != nil {
+			log.Errorf("notifying %s device: %v", dev.Platform, err)
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -49,14 +50,94 @@ var (
 
 // Bucket represents the buckets threaddb collection schema.
 type Bucket struct {
-	Key       string   `json:"_id"`
-	Name      string   `json:"name"`
-	Path      string   `json:"path"`
-	EncKey    string   `json:"key,omitempty"`
-	DNSRecord string   `json:"dns_record,omitempty"`
-	Archives  Archives `json:"archives"`
-	CreatedAt int64    `json:"created_at"`
-	UpdatedAt int64    `json:"updated_at"`
+	Key              string                   `json:"_id"`
+	Name             string                   `json:"name"`
+	Path             string                   `json:"path"`
+	EncKey           string                   `json:"key,omitempty"`
+	DNSRecord        string                   `json:"dns_record,omitempty"`
+	Archives         Archives                 `json:"archives"`
+	Metadata         map[string]Metadata      `json:"metadata,omitempty"`
+	MaxSize          int64                    `json:"max_size,omitempty"`
+	Lifecycle        *Lifecycle               `json:"lifecycle,omitempty"`
+	Website          *buckets.Website         `json:"website,omitempty"`
+	ConflictStrategy buckets.ConflictStrategy `json:"conflict_strategy,omitempty"`
+	CreatedAt        int64                    `json:"created_at"`
+	UpdatedAt        int64                    `json:"updated_at"`
+}
+
+// Metadata contains user and content metadata for a bucket item.
+// Bucket metadata is keyed by item path relative to the bucket root.
+// ContentEncoding is set if the item is compressed at rest, e.g., "gzip".
+type Metadata struct {
+	ContentType     string            `json:"content_type,omitempty"`
+	ContentEncoding string            `json:"content_encoding,omitempty"`
+	Attributes      map[string]string `json:"attributes,omitempty"`
+	UpdatedAt       int64             `json:"updated_at"`
+}
+
+// Lifecycle actions.
+const (
+	// LifecycleArchive archives an idle bucket to Filecoin.
+	LifecycleArchive = "archive"
+	// LifecycleExpire removes items under a path prefix once they reach an age.
+	LifecycleExpire = "expire"
+)
+
+// Lifecycle contains the lifecycle rules of a bucket.
+// Rules are applied periodically by the lifecycle scheduler.
+type Lifecycle struct {
+	Rules []LifecycleRule `json:"rules"`
+}
+
+// LifecycleRule applies an action to a bucket after a number of days.
+// Archive rules apply to buckets that have not changed in Days.
+// Expire rules apply to items under Prefix that were added more than Days ago.
+type LifecycleRule struct {
+	ID     string          `json:"id"`
+	Action string          `json:"action"`
+	Prefix string          `json:"prefix,omitempty"`
+	Days   int             `json:"days"`
+	Status LifecycleStatus `json:"status"`
+}
+
+// LifecycleStatus describes the last run of a lifecycle rule.
+type LifecycleStatus struct {
+	LastRunAt int64  `json:"last_run_at"`
+	Affected  int64  `json:"affected"`
+	Error     string `json:"error,omitempty"`
+}
+
+// SetMetadataAtPath sets the metadata of the item at pth.
+func (b *Bucket) SetMetadataAtPath(pth string, md Metadata) {
+	if b.Metadata == nil {
+		b.Metadata = make(map[string]Metadata)
+	}
+	md.UpdatedAt = time.Now().UnixNano()
+	b.Metadata[pth] = md
+}
+
+// UnsetMetadataWithPrefix removes the metadata of the item at pth and all items below it.
+func (b *Bucket) UnsetMetadataWithPrefix(pth string) {
+	for p := range b.Metadata {
+		if pth == "" || p == pth || strings.HasPrefix(p, pth+"/") {
+			delete(b.Metadata, p)
+		}
+	}
+}
+
+// MoveMetadataWithPrefix moves the metadata of the item at from and all items below it to to.
+// Metadata is moved as-is, so update times are preserved.
+func (b *Bucket) MoveMetadataWithPrefix(from, to string) {
+	moved := make(map[string]Metadata)
+	for p, md := range b.Metadata {
+		if p == from || strings.HasPrefix(p, from+"/") {
+			moved[to+strings.TrimPrefix(p, from)] = md
+			delete(b.Metadata, p)
+		}
+	}
+	for p, md := range moved {
+		b.Metadata[p] = md
+	}
 }
 
 // GetEncKey returns the encryption key as bytes if present.
@@ -88,9 +169,10 @@ type Deal struct {
 
 // BucketOptions defines options for interacting with buckets.
 type BucketOptions struct {
-	Name  string
-	Key   []byte
-	Token thread.Token
+	Name          string
+	Key           []byte
+	Token         thread.Token
+	StorageConfig *ffs.StorageConfig
 }
 
 // BucketOption holds a bucket option.
@@ -118,6 +200,14 @@ func WithNewBucketToken(t thread.Token) BucketOption {
 	}
 }
 
+// WithNewBucketStorageConfig sets the default storage config of the bucket's FFS instance.
+// The hub default is used if not set.
+func WithNewBucketStorageConfig(c *ffs.StorageConfig) BucketOption {
+	return func(args *BucketOptions) {
+		args.StorageConfig = c
+	}
+}
+
 func init() {
 	reflector := jsonschema.Reflector{ExpandedStruct: true}
 	bucketsSchema = reflector.Reflect(&Bucket{})
@@ -192,19 +282,40 @@ func (b *Buckets) New(ctx context.Context, dbID thread.ID, key string, pth path.
 	}
 	bucket.Key = string(id)
 
-	if err := b.createFFSInstance(ctx, key); err != nil {
+	if err := b.createFFSInstance(ctx, key, args.StorageConfig); err != nil {
 		return nil, fmt.Errorf("creating FFS instance for bucket: %s", err)
 	}
 
 	return bucket, nil
 }
 
+// Rename sets the name of the bucket with key.
+// Gateway links, DNS records, and IPNS keys are derived from the bucket key,
+// so they remain valid and are not changed.
+func (b *Buckets) Rename(ctx context.Context, dbID thread.ID, key, name string, opts ...Option) (*Bucket, error) {
+	bucket := &Bucket{}
+	if err := b.Get(ctx, dbID, key, bucket, opts...); err != nil {
+		return nil, err
+	}
+	bucket.Name = name
+	bucket.UpdatedAt = time.Now().UnixNano()
+	if err := b.SaveSafe(ctx, dbID, bucket, opts...); err != nil {
+		return nil, err
+	}
+	return bucket, nil
+}
+
+// DefaultStorageConfig returns the hub default storage config of bucket FFS instances.
+func (b *Buckets) DefaultStorageConfig() ffs.StorageConfig {
+	return b.buckCidConfig
+}
+
 // IsArchivingEnabled returns whether or not Powergate archiving is enabled.
 func (b *Buckets) IsArchivingEnabled() bool {
 	return b.pgClient != nil
 }
 
-func (b *Buckets) createFFSInstance(ctx context.Context, bucketKey string) error {
+func (b *Buckets) createFFSInstance(ctx context.Context, bucketKey string, conf *ffs.StorageConfig) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 	// If the Powergate client isn't configured, don't do anything.
@@ -225,10 +336,13 @@ func (b *Buckets) createFFSInstance(ctx context.Context, bucketKey string) error
 	if err := b.ffsCol.Create(ctx, bucketKey, token, waddr); err != nil {
 		return fmt.Errorf("saving FFS instances data: %s", err)
 	}
+	if conf == nil {
+		conf = &b.buckCidConfig
+	}
 	defaultBucketCidConfig := ffs.StorageConfig{
-		Cold:       b.buckCidConfig.Cold,
-		Hot:        b.buckCidConfig.Hot,
-		Repairable: b.buckCidConfig.Repairable,
+		Cold:       conf.Cold,
+		Hot:        conf.Hot,
+		Repairable: conf.Repairable,
 	}
 	defaultBucketCidConfig.Cold.Filecoin.Addr = waddr
 	if err := b.pgClient.FFS.SetDefaultStorageConfig(ctxFFS, defaultBucketCidConfig); err != nil {
@@ -244,6 +358,9 @@ func (b *Buckets) SaveSafe(ctx context.Context, dbID thread.ID, bucket *Bucket,
 }
 
 func ensureNoNulls(b *Bucket) {
+	if b.Lifecycle != nil && b.Lifecycle.Rules == nil {
+		b.Lifecycle.Rules = []LifecycleRule{}
+	}
 	if len(b.Archives.History) == 0 {
 		current := b.Archives.Current
 		if len(current.Deals) == 0 {
@@ -33,6 +33,8 @@ var (
 		Path: "to",
 	}, {
 		Path: "created_at",
+	}, {
+		Path: "read_at",
 	}}
 	sentboxConfig db.CollectionConfig
 
@@ -42,23 +44,27 @@ var (
 
 // InboxMessage represents the inbox threaddb collection schema.
 type InboxMessage struct {
-	ID        string `json:"_id"`
-	From      string `json:"from"`
-	To        string `json:"to"`
-	Body      string `json:"body"`
-	Signature string `json:"signature"`
-	CreatedAt int64  `json:"created_at"`
-	ReadAt    int64  `json:"read_at"`
+	ID          string `json:"_id"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Body        string `json:"body"`
+	Signature   string `json:"signature"`
+	CreatedAt   int64  `json:"created_at"`
+	DeliveredAt int64  `json:"delivered_at"`
+	ReadAt      int64  `json:"read_at"`
 }
 
 // SentboxMessage represents the sentbox threaddb collection schema.
+// DeliveredAt and ReadAt are receipts, which are set when the message reaches and is read in the recipient's inbox.
 type SentboxMessage struct {
-	ID        string `json:"_id"`
-	From      string `json:"from"`
-	To        string `json:"to"`
-	Body      string `json:"body"`
-	Signature string `json:"signature"`
-	CreatedAt int64  `json:"created_at"`
+	ID          string `json:"_id"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+	Body        string `json:"body"`
+	Signature   string `json:"signature"`
+	CreatedAt   int64  `json:"created_at"`
+	DeliveredAt int64  `json:"delivered_at"`
+	ReadAt      int64  `json:"read_at"`
 }
 
 func init() {